@@ -0,0 +1,94 @@
+//go:build unit
+
+package main
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPGArgs(t *testing.T) {
+	assert.Equal(t,
+		[]string{"--format=custom", "--snapshot=00000003-1", "--no-owner", "--no-privileges", "--file=app.dump"},
+		dumpArgs("app.dump", "00000003-1"))
+	assert.Equal(t,
+		[]string{"--dbname=app", "--clean", "--if-exists", "--no-owner", "--no-privileges", "--single-transaction", "--exit-on-error", "app.dump"},
+		restoreArgs("app", "app.dump"))
+}
+
+func TestManifestDiff(t *testing.T) {
+	want := &Manifest{Revision: "039", Tables: map[string]int64{"public.users": 3, "public.reservations": 10}}
+
+	t.Run("identical counts match", func(t *testing.T) {
+		got := &Manifest{Revision: "039", Tables: map[string]int64{"public.users": 3, "public.reservations": 10, "public.extra": 1}}
+		assert.Empty(t, want.diff(got))
+	})
+
+	t.Run("missing tables, count and revision drift are reported", func(t *testing.T) {
+		got := &Manifest{Revision: "038", Tables: map[string]int64{"public.users": 2}}
+		assert.Equal(t, []string{
+			`migration revision "038", want "039"`,
+			"public.reservations: missing (want 10 rows)",
+			"public.users: 2 rows, want 3",
+		}, want.diff(got))
+	})
+}
+
+func TestManifestRoundTrip(t *testing.T) {
+	path := manifestPath(filepath.Join(t.TempDir(), "app.dump"))
+	assert.True(t, strings.HasSuffix(path, "app.dump.manifest.json"))
+
+	m := &Manifest{Database: "app", Revision: "039", Tables: map[string]int64{"public.users": 3}}
+	require.NoError(t, writeManifest(path, m))
+
+	got, err := loadManifest(path)
+	require.NoError(t, err)
+	assert.Equal(t, m.Tables, got.Tables)
+	assert.Equal(t, "039", got.Revision)
+	assert.Equal(t, int64(3), got.rows())
+}
+
+func TestHooksAround(t *testing.T) {
+	ctx := context.Background()
+	log := filepath.Join(t.TempDir(), "hooks.log")
+	h := &hooks{
+		pre:     `echo "$ADMIN_HOOK $ADMIN_COMMAND $ADMIN_FILE" >> ` + log,
+		post:    `echo "$ADMIN_HOOK" >> ` + log,
+		command: "backup",
+		file:    "app.dump",
+	}
+
+	t.Run("post hook runs after a failed task", func(t *testing.T) {
+		taskErr := errors.New("pg_dump failed")
+		err := h.around(ctx, func() error { return taskErr })
+		assert.ErrorIs(t, err, taskErr)
+
+		data, err := os.ReadFile(log)
+		require.NoError(t, err)
+		assert.Equal(t, "pre backup app.dump\npost\n", string(data))
+	})
+
+	t.Run("failing pre hook skips the task", func(t *testing.T) {
+		failing := *h
+		failing.pre = "exit 3"
+		ran := false
+		err := failing.around(ctx, func() error { ran = true; return nil })
+		assert.ErrorContains(t, err, "pre hook failed")
+		assert.False(t, ran)
+	})
+}
+
+func TestRealMainUsage(t *testing.T) {
+	assert.Equal(t, 2, realMain(nil))
+	assert.Equal(t, 2, realMain([]string{"vacuum"}))
+	assert.Equal(t, 2, realMain([]string{"restore", "-in", "app.dump"}))
+	assert.Equal(t, 2, realMain([]string{"anonymize", "-yes"}))
+	assert.Equal(t, 2, realMain([]string{"anonymize", "-seed", "staging"}))
+}
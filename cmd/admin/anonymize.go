@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"gin-clean-starter/internal/pkg/config"
+	"gin-clean-starter/internal/pkg/password"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// anonymizeStep rewrites one kind of personal data. Statements read the seed through
+// the pg_temp helpers, so they take no parameters.
+type anonymizeStep struct {
+	Name string
+	SQL  string
+}
+
+// anonymizeHelpers derive fake values from md5(seed:key): the same seed and row always
+// give the same value, so repeated refreshes stay comparable, and without the seed the
+// originals cannot be matched back. anon_text builds filler of about the original length.
+var anonymizeHelpers = []string{
+	`CREATE FUNCTION pg_temp.anon_hash(key text) RETURNS text LANGUAGE sql STABLE AS $$
+    SELECT md5(current_setting('anonymize.seed') || ':' || key)
+$$`,
+	`CREATE FUNCTION pg_temp.anon_text(key text, target int) RETURNS text LANGUAGE sql STABLE AS $$
+    SELECT rtrim(left(string_agg(w, ' ' ORDER BY i), greatest(target, 1)))
+    FROM (
+        SELECT i, (ARRAY['quiet', 'bright', 'clean', 'spacious', 'friendly', 'convenient', 'room',
+            'desk', 'view', 'meeting', 'booking', 'staff', 'coffee', 'window', 'chairs', 'screen',
+            'location', 'early', 'late', 'again'])[1 + ('x' || substr(pg_temp.anon_hash(key || ':' || i), 1, 7))::bit(28)::int % 20] AS w
+        FROM generate_series(1, greatest(target, 1) / 5 + 1) AS i
+    ) words
+$$`,
+}
+
+// anonymizeSteps cover every column that holds personal data or free text written by
+// users. Keys, timestamps, ratings, statuses and amounts are kept, so relations, rating
+// distributions and reports look like production's.
+var anonymizeSteps = []anonymizeStep{
+	{"users", `UPDATE users SET
+    email = 'user-' || left(pg_temp.anon_hash(id::text), 16) || '@example.test',
+    phone_ciphertext = NULL,
+    external_id = CASE WHEN external_id IS NOT NULL THEN 'ext-' || left(pg_temp.anon_hash(external_id), 16) END`},
+	{"user passwords", `UPDATE users SET password_hash = current_setting('anonymize.password_hash')
+WHERE current_setting('anonymize.password_hash') <> ''`},
+	// Both read the old subject, which stays unique per provider once hashed.
+	{"sign-in identities", `UPDATE user_identities SET
+    email = 'identity-' || left(pg_temp.anon_hash(provider || ':' || subject || ':email'), 16) || '@example.test',
+    subject = 'sub-' || pg_temp.anon_hash(provider || ':' || subject)`},
+	{"invites", `UPDATE invites SET email = 'invite-' || left(pg_temp.anon_hash(id::text), 16) || '@example.test'`},
+	{"companies", `UPDATE companies SET name = 'Company ' || left(pg_temp.anon_hash(id::text), 12)`},
+	{"company branding", `UPDATE company_settings SET
+    brand_reply_to = 'reply-' || left(pg_temp.anon_hash(company_id::text), 16) || '@example.test',
+    brand_footer = CASE WHEN brand_footer IS NOT NULL THEN pg_temp.anon_text(company_id::text, length(brand_footer)) END
+WHERE brand_reply_to IS NOT NULL OR brand_footer IS NOT NULL`},
+	{"review comments", `UPDATE reviews SET comment = pg_temp.anon_text(id::text, least(length(comment), 1000))`},
+	// Summaries quote the original comments; the summary job rewrites them from the fakes.
+	{"review summaries", `UPDATE resource_rating_stats SET summary = NULL, summary_review_count = NULL, summary_updated_at = NULL
+WHERE summary IS NOT NULL`},
+	{"reservation messages", `UPDATE reservation_messages SET
+    body = pg_temp.anon_text(id::text, coalesce(length(body), 80)),
+    body_ciphertext = NULL`},
+	{"reservation custom fields", `UPDATE reservations r SET custom_fields = (
+    SELECT jsonb_object_agg(f.key, CASE
+        WHEN jsonb_typeof(f.value) = 'string' AND coalesce(d.field_type, 'text') = 'text'
+            THEN to_jsonb(pg_temp.anon_text(r.id::text || f.key, length(f.value #>> '{}')))
+        ELSE f.value END)
+    FROM jsonb_each(r.custom_fields) f
+    JOIN resources res ON res.id = r.resource_id
+    LEFT JOIN custom_field_definitions d ON d.company_id = res.company_id AND d.key = f.key
+)
+WHERE r.custom_fields <> '{}'::jsonb`},
+	{"reservation attachments", `UPDATE reservation_attachments SET
+    filename = 'attachment-' || left(pg_temp.anon_hash(id::text), 8) || coalesce(substring(filename from '\.[A-Za-z0-9]{1,8}$'), '')`},
+	{"approval reasons", `UPDATE reservation_approvals SET reason = pg_temp.anon_text(reservation_id::text, length(reason)) WHERE reason IS NOT NULL`},
+	{"resource block reasons", `UPDATE resource_blocks SET reason = pg_temp.anon_text(id::text, length(reason))`},
+	{"tos acceptance addresses", `UPDATE tos_acceptances SET ip_address = NULL WHERE ip_address IS NOT NULL`},
+	{"device fingerprints", `UPDATE user_devices SET fingerprint = pg_temp.anon_hash(user_id::text || fingerprint)`},
+	// Hashed like user_devices above, so a session still names its device.
+	{"session clients", `UPDATE user_sessions SET
+    device_fingerprint = CASE WHEN device_fingerprint <> '' THEN pg_temp.anon_hash(user_id::text || device_fingerprint) ELSE '' END,
+    ip_address = '',
+    user_agent = ''`},
+	{"audit client details", `UPDATE audit_logs SET metadata = metadata - '{ip,user_agent,email}'::text[]
+WHERE metadata ?| '{ip,user_agent,email}'::text[]`},
+	// Queued emails carry real addresses, and captures point at production storage.
+	{"notification jobs", `DELETE FROM notification_jobs`},
+	{"request recordings", `DELETE FROM request_recordings`},
+	// Lockouts are keyed by email and address and expire on their own.
+	{"login failures", `DELETE FROM login_failures`},
+	// Staging must not act on production billing accounts.
+	{"billing provider links", `UPDATE subscriptions SET provider_customer_id = NULL, provider_subscription_id = NULL
+WHERE provider_customer_id IS NOT NULL OR provider_subscription_id IS NOT NULL`},
+}
+
+func anonymizeMain(ctx context.Context, args []string) int {
+	fs := flag.NewFlagSet("anonymize", flag.ExitOnError)
+	seed := fs.String("seed", "", "secret the fake values derive from; the same seed gives the same values")
+	newPassword := fs.String("password", "", "set every user's password to this, so staging logins work")
+	yes := fs.Bool("yes", false, "confirm that personal data in DB_NAME is overwritten")
+	_ = fs.Parse(args)
+
+	if *seed == "" || !*yes {
+		fmt.Fprintln(os.Stderr, "anonymize needs -seed and -yes: it overwrites personal data in DB_NAME")
+		return 2
+	}
+	cfg, err := loadDBConfig()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+	var passwordHash string
+	if *newPassword != "" {
+		if passwordHash, err = password.HashPassword(*newPassword); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 2
+		}
+	}
+
+	if err := anonymize(ctx, cfg, *seed, passwordHash); err != nil {
+		fmt.Fprintln(os.Stderr, "anonymize:", err)
+		return 1
+	}
+	fmt.Printf("anonymized %s\n", cfg.DBName)
+	return 0
+}
+
+// anonymize runs every step in one transaction: a failed step leaves no table half done.
+func anonymize(ctx context.Context, cfg config.DBConfig, seed, passwordHash string) error {
+	conn, err := pgx.Connect(ctx, cfg.BuildDSN())
+	if err != nil {
+		return fmt.Errorf("failed to connect: %w", err)
+	}
+	defer conn.Close(context.WithoutCancel(ctx))
+
+	return pgx.BeginFunc(ctx, conn, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, "SELECT set_config('anonymize.seed', $1, true), set_config('anonymize.password_hash', $2, true)", seed, passwordHash); err != nil {
+			return fmt.Errorf("failed to set seed: %w", err)
+		}
+		for _, helper := range anonymizeHelpers {
+			if _, err := tx.Exec(ctx, helper); err != nil {
+				return fmt.Errorf("failed to create helper: %w", err)
+			}
+		}
+		for _, step := range anonymizeSteps {
+			tag, err := tx.Exec(ctx, step.SQL)
+			if err != nil {
+				return fmt.Errorf("%s: %w", step.Name, err)
+			}
+			fmt.Printf("%-26s %d rows\n", step.Name, tag.RowsAffected())
+		}
+		return nil
+	})
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"gin-clean-starter/internal/pkg/config"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Manifest records what a backup should contain, read from the snapshot it was dumped from.
+type Manifest struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Database  string    `json:"database"`
+	// Latest applied Atlas migration, empty when the database was never migrated.
+	Revision string           `json:"revision,omitempty"`
+	Tables   map[string]int64 `json:"tables"`
+}
+
+const userTablesSQL = `SELECT format('%I.%I', schemaname, tablename) FROM pg_tables
+WHERE schemaname NOT IN ('pg_catalog', 'information_schema') ORDER BY 1`
+
+const revisionSQL = `SELECT COALESCE((SELECT max(version) FROM atlas_schema_revisions.atlas_schema_revisions), '')`
+
+func backupMain(ctx context.Context, args []string) int {
+	fs := flag.NewFlagSet("backup", flag.ExitOnError)
+	out := fs.String("out", "backup-"+time.Now().UTC().Format("20060102T150405Z")+".dump", "archive to write; the manifest goes to <out>.manifest.json")
+	h := newHooks(fs, "backup")
+	_ = fs.Parse(args)
+
+	cfg, err := loadDBConfig()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+	h.file = *out
+
+	var manifest *Manifest
+	err = h.around(ctx, func() error {
+		manifest, err = backup(ctx, cfg, *out)
+		return err
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "backup:", err)
+		return 1
+	}
+
+	fmt.Printf("backup written to %s: %d tables, %d rows, revision %q\n", *out, len(manifest.Tables), manifest.rows(), manifest.Revision)
+	return 0
+}
+
+// backup exports a snapshot from a read-only repeatable-read transaction, counts rows in
+// it and has pg_dump read the same snapshot, so the archive and the manifest agree even
+// while the API keeps writing. The transaction stays open until pg_dump is done.
+func backup(ctx context.Context, cfg config.DBConfig, out string) (*Manifest, error) {
+	conn, err := pgx.Connect(ctx, cfg.BuildDSN())
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect: %w", err)
+	}
+	defer conn.Close(context.WithoutCancel(ctx))
+
+	tx, err := conn.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin snapshot transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback(context.WithoutCancel(ctx)) }()
+
+	var snapshot string
+	if err := tx.QueryRow(ctx, "SELECT pg_export_snapshot()").Scan(&snapshot); err != nil {
+		return nil, fmt.Errorf("failed to export snapshot: %w", err)
+	}
+	manifest, err := readManifest(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	manifest.Database = cfg.DBName
+
+	fmt.Printf("dumping %s from snapshot %s\n", cfg.DBName, snapshot)
+	if _, err := runPG(ctx, cfg, "pg_dump", dumpArgs(out, snapshot)...); err != nil {
+		return nil, err
+	}
+	// A truncated or corrupt archive fails to list.
+	if _, err := runPG(ctx, cfg, "pg_restore", "--list", out); err != nil {
+		return nil, fmt.Errorf("archive does not verify: %w", err)
+	}
+
+	if err := writeManifest(manifestPath(out), manifest); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+func dumpArgs(out, snapshot string) []string {
+	return []string{"--format=custom", "--snapshot=" + snapshot, "--no-owner", "--no-privileges", "--file=" + out}
+}
+
+// querier is satisfied by both the snapshot transaction and a plain connection.
+type querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// readManifest counts every user table, Atlas' revision table included.
+func readManifest(ctx context.Context, q querier) (*Manifest, error) {
+	rows, err := q.Query(ctx, userTablesSQL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tables: %w", err)
+	}
+	tables, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tables: %w", err)
+	}
+
+	m := &Manifest{CreatedAt: time.Now().UTC(), Tables: make(map[string]int64, len(tables))}
+	for _, table := range tables {
+		var n int64
+		// table is already quoted by format('%I.%I')
+		if err := q.QueryRow(ctx, "SELECT count(*) FROM "+table).Scan(&n); err != nil {
+			return nil, fmt.Errorf("failed to count %s: %w", table, err)
+		}
+		m.Tables[table] = n
+	}
+	if _, ok := m.Tables["atlas_schema_revisions.atlas_schema_revisions"]; ok {
+		if err := q.QueryRow(ctx, revisionSQL).Scan(&m.Revision); err != nil {
+			return nil, fmt.Errorf("failed to read migration revision: %w", err)
+		}
+	}
+	return m, nil
+}
+
+func (m *Manifest) rows() int64 {
+	var total int64
+	for _, n := range m.Tables {
+		total += n
+	}
+	return total
+}
+
+// diff lists how got differs from m, one line per table, sorted.
+func (m *Manifest) diff(got *Manifest) []string {
+	var problems []string
+	for table, want := range m.Tables {
+		n, ok := got.Tables[table]
+		switch {
+		case !ok:
+			problems = append(problems, fmt.Sprintf("%s: missing (want %d rows)", table, want))
+		case n != want:
+			problems = append(problems, fmt.Sprintf("%s: %d rows, want %d", table, n, want))
+		}
+	}
+	if m.Revision != got.Revision {
+		problems = append(problems, fmt.Sprintf("migration revision %q, want %q", got.Revision, m.Revision))
+	}
+	sort.Strings(problems)
+	return problems
+}
+
+func manifestPath(archive string) string {
+	return archive + ".manifest.json"
+}
+
+func writeManifest(path string, m *Manifest) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+	return nil
+}
+
+func loadManifest(path string) (*Manifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+	var m Manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("invalid manifest %s: %w", path, err)
+	}
+	return &m, nil
+}
@@ -0,0 +1,140 @@
+// Command admin runs operator tasks against the database configured by the DB_* variables.
+//
+//	go run ./cmd/admin backup  [-out app.dump] [-pre-hook cmd] [-post-hook cmd]
+//	go run ./cmd/admin restore -in app.dump -yes [-pre-hook cmd] [-post-hook cmd]
+//	go run ./cmd/admin anonymize -seed <secret> -yes [-password <staging password>]
+//
+// backup takes a pg_dump custom-format archive from one exported snapshot and writes a
+// manifest of per-table row counts read from that same snapshot next to it. restore
+// replays an archive with pg_restore in a single transaction and checks the restored row
+// counts against the manifest. anonymize replaces personal data with deterministic fakes
+// once a production dump is restored into staging. pg_dump and pg_restore must be on PATH
+// and should match the server's major version.
+//
+// Hooks are shell commands run before and after the work; the post hook runs even when
+// the work fails. Scheduled jobs and the usage, telemetry and deprecation buffers live in
+// the API process, so quiescing writers means stopping or scaling down the API there
+// (e.g. -pre-hook "docker compose stop app" -post-hook "docker compose start app").
+// Hooks see ADMIN_HOOK (pre or post), ADMIN_COMMAND and ADMIN_FILE.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"syscall"
+
+	"gin-clean-starter/internal/pkg/config"
+
+	"github.com/kelseyhightower/envconfig"
+)
+
+func main() {
+	os.Exit(realMain(os.Args[1:]))
+}
+
+func realMain(args []string) int {
+	if len(args) == 0 {
+		usage()
+		return 2
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	switch args[0] {
+	case "backup":
+		return backupMain(ctx, args[1:])
+	case "restore":
+		return restoreMain(ctx, args[1:])
+	case "anonymize":
+		return anonymizeMain(ctx, args[1:])
+	default:
+		usage()
+		return 2
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: admin <backup|restore|anonymize> [flags]  (admin <command> -h for flags)")
+}
+
+func loadDBConfig() (config.DBConfig, error) {
+	var cfg config.DBConfig
+	if err := envconfig.Process("", &cfg); err != nil {
+		return config.DBConfig{}, fmt.Errorf("failed to process env config: %w", err)
+	}
+	return cfg, nil
+}
+
+// pgEnv passes the connection to pg_dump and pg_restore through libpq's variables, which
+// keeps the password off the command line.
+func pgEnv(cfg config.DBConfig) []string {
+	return append(os.Environ(),
+		"PGHOST="+cfg.Host,
+		"PGPORT="+cfg.Port,
+		"PGUSER="+cfg.User,
+		"PGPASSWORD="+cfg.Password,
+		"PGDATABASE="+cfg.DBName,
+		"PGSSLMODE="+cfg.SSLMode,
+		"PGAPPNAME="+cfg.ApplicationName+"-admin",
+	)
+}
+
+// hooks runs the optional pre and post commands around one task.
+type hooks struct {
+	pre, post string
+	command   string
+	file      string
+}
+
+func newHooks(fs *flag.FlagSet, command string) *hooks {
+	h := &hooks{command: command}
+	fs.StringVar(&h.pre, "pre-hook", "", "shell command run before the task, e.g. to stop writers")
+	fs.StringVar(&h.post, "post-hook", "", "shell command run after the task, even when it failed")
+	return h
+}
+
+func (h *hooks) run(ctx context.Context, phase, cmdline string) error {
+	if cmdline == "" {
+		return nil
+	}
+	fmt.Printf("running %s hook: %s\n", phase, cmdline)
+
+	cmd := exec.CommandContext(ctx, "sh", "-c", cmdline)
+	cmd.Stdout, cmd.Stderr = os.Stdout, os.Stderr
+	cmd.Env = append(os.Environ(), "ADMIN_HOOK="+phase, "ADMIN_COMMAND="+h.command, "ADMIN_FILE="+h.file)
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%s hook failed: %w", phase, err)
+	}
+	return nil
+}
+
+// around runs task between the hooks. The post hook still runs after an interrupt, so a
+// cancelled backup does not leave the API stopped.
+func (h *hooks) around(ctx context.Context, task func() error) error {
+	err := h.run(ctx, "pre", h.pre)
+	if err == nil {
+		err = task()
+	}
+	if postErr := h.run(context.WithoutCancel(ctx), "post", h.post); postErr != nil && err == nil {
+		err = postErr
+	} else if postErr != nil {
+		fmt.Fprintln(os.Stderr, postErr)
+	}
+	return err
+}
+
+func runPG(ctx context.Context, cfg config.DBConfig, name string, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Env = pgEnv(cfg)
+	cmd.Stderr = os.Stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("%s failed: %w", name, err)
+	}
+	return out, nil
+}
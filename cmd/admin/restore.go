@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"gin-clean-starter/internal/pkg/config"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func restoreMain(ctx context.Context, args []string) int {
+	fs := flag.NewFlagSet("restore", flag.ExitOnError)
+	in := fs.String("in", "", "archive written by backup; its manifest must sit next to it")
+	yes := fs.Bool("yes", false, "confirm that existing objects in the target database are dropped and replaced")
+	h := newHooks(fs, "restore")
+	_ = fs.Parse(args)
+
+	if *in == "" || !*yes {
+		fmt.Fprintln(os.Stderr, "restore needs -in and -yes: it replaces the contents of DB_NAME")
+		return 2
+	}
+	cfg, err := loadDBConfig()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+	want, err := loadManifest(manifestPath(*in))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+	h.file = *in
+
+	var problems []string
+	err = h.around(ctx, func() error {
+		problems, err = restore(ctx, cfg, *in, want)
+		return err
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "restore:", err)
+		return 1
+	}
+	if len(problems) > 0 {
+		fmt.Fprintln(os.Stderr, "restore: restored data does not match the manifest:")
+		for _, p := range problems {
+			fmt.Fprintln(os.Stderr, "  "+p)
+		}
+		return 1
+	}
+
+	fmt.Printf("restored %s into %s: %d tables, %d rows, revision %q\n", *in, cfg.DBName, len(want.Tables), want.rows(), want.Revision)
+	return 0
+}
+
+// restore replays the archive in one transaction, so a failure leaves the database as it
+// was, then recounts the tables the manifest lists.
+func restore(ctx context.Context, cfg config.DBConfig, in string, want *Manifest) ([]string, error) {
+	fmt.Printf("restoring %s into %s\n", in, cfg.DBName)
+	if _, err := runPG(ctx, cfg, "pg_restore", restoreArgs(cfg.DBName, in)...); err != nil {
+		return nil, err
+	}
+
+	conn, err := pgx.Connect(ctx, cfg.BuildDSN())
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect: %w", err)
+	}
+	defer conn.Close(context.WithoutCancel(ctx))
+
+	got, err := readManifest(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+	return want.diff(got), nil
+}
+
+func restoreArgs(dbName, in string) []string {
+	return []string{"--dbname=" + dbName, "--clean", "--if-exists", "--no-owner", "--no-privileges", "--single-transaction", "--exit-on-error", in}
+}
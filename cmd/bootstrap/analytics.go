@@ -0,0 +1,21 @@
+package bootstrap
+
+import (
+	"gin-clean-starter/internal/infra/analytics"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"go.uber.org/fx"
+)
+
+var AnalyticsModule = fx.Module("analytics",
+	fx.Provide(
+		// Swap in an exporter to the analytics pipeline here.
+		fx.Annotate(
+			func(uow shared.UnitOfWork, q *sqlc.Queries) *analytics.PostgresSink {
+				return analytics.NewPostgresSink(uow, q)
+			},
+			fx.As(new(shared.AnalyticsSink)),
+		),
+	),
+)
@@ -0,0 +1,21 @@
+package bootstrap
+
+import (
+	"gin-clean-starter/internal/infra/billing"
+	"gin-clean-starter/internal/pkg/config"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"go.uber.org/fx"
+)
+
+var BillingModule = fx.Module("billing",
+	fx.Provide(
+		// Swap in an adapter for a hosted billing provider here.
+		fx.Annotate(
+			func(cfg config.Config) *billing.HMACProvider {
+				return billing.NewHMACProvider(cfg.Billing.WebhookSecret)
+			},
+			fx.As(new(shared.BillingProvider)),
+		),
+	),
+)
@@ -4,6 +4,7 @@ import (
 	"gin-clean-starter/internal/handler"
 	"gin-clean-starter/internal/handler/api"
 	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/handler/render"
 
 	"go.uber.org/fx"
 )
@@ -12,8 +13,60 @@ var HandlerModule = fx.Module("handler",
 	fx.Provide(
 		api.NewAuthHandler,
 		api.NewReservationHandler,
+		api.NewQuoteHandler,
 		api.NewReviewHandler,
+		api.NewRetentionHandler,
+		api.NewRoleHandler,
+		api.NewResourceOperatorHandler,
+		api.NewInviteHandler,
+		api.NewCompanyHandler,
+		api.NewSupportHandler,
+		api.NewReferralHandler,
+		api.NewLoyaltyHandler,
+		api.NewSecurityEventHandler,
+		api.NewUserActivityHandler,
+		api.NewActivityHandler,
+		api.NewAdminSearchHandler,
+		api.NewAPIKeyHandler,
+		api.NewSessionHandler,
+		api.NewUserMergeHandler,
+		api.NewNotificationPreferencesHandler,
+		api.NewResourceBlockHandler,
+		api.NewReservationMessageHandler,
+		api.NewReservationAttachmentHandler,
+		api.NewReservationTransferHandler,
+		api.NewReservationBulkCancelHandler,
+		api.NewTOSHandler,
+		api.NewUsageHandler,
+		api.NewBillingHandler,
+		api.NewTelemetryHandler,
+		api.NewDeprecationHandler,
+		api.NewFeatureHandler,
+		api.NewBrandingHandler,
+		api.NewProvisioningHandler,
+		api.NewSAMLHandler,
+		api.NewOIDCHandler,
+		api.NewReservationApprovalHandler,
+		api.NewCustomFieldHandler,
+		api.NewMaintenanceHandler,
+		api.NewPublicResourceHandler,
+		api.NewPublicStatsHandler,
+		api.NewRequestRecordingHandler,
+		api.NewCompanyExportHandler,
+		api.NewCompanyDeletionHandler,
+		render.NewCreatedResponder,
 		middleware.NewAuthMiddleware,
+		middleware.NewUsageMiddleware,
+		middleware.NewProvisioningMiddleware,
+		middleware.NewTelemetryMiddleware,
+		middleware.NewDeprecationMiddleware,
+		middleware.NewRecordingMiddleware,
+		middleware.NewProxyMiddleware,
+		middleware.NewResponseFormatMiddleware,
+		middleware.NewIPFilterMiddleware,
+		middleware.NewCSRFMiddleware,
+		middleware.NewRateLimitMiddleware,
 	),
 	fx.Invoke(handler.NewRouter),
+	testRoutesOption,
 )
@@ -0,0 +1,243 @@
+package components
+
+import (
+	"context"
+
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/infra/db"
+	"gin-clean-starter/internal/pkg/config"
+	"gin-clean-starter/internal/pkg/scheduler"
+	"gin-clean-starter/internal/usecase/commands"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"go.uber.org/fx"
+)
+
+var JobsModule = fx.Module("jobs",
+	fx.Invoke(
+		registerRetentionJob,
+		registerReferralRewardJob,
+		registerLoyaltyJobs,
+		registerReviewSummaryJob,
+		registerUsageFlushJob,
+		registerTelemetryFlushJob,
+		registerDeprecationFlushJob,
+		registerAdminIPRulesReloadJob,
+		registerCursorCheckJob,
+		registerApprovalSweepJob,
+		registerRequestRecordingPurgeJob,
+		registerCompanyExportJob,
+		registerCompanyDeletionJob,
+		registerReadReplicaProbeJob,
+		registerPlatformStatsJob,
+		registerNotificationDigestJob,
+	),
+)
+
+func registerRetentionJob(cfg config.Config, s *scheduler.Scheduler, retention commands.RetentionCommands) {
+	if !cfg.Retention.Enabled {
+		return
+	}
+
+	s.Every("retention", cfg.Retention.Interval, func(ctx context.Context) error {
+		_, err := retention.Purge(ctx)
+		return err
+	})
+}
+
+func registerReferralRewardJob(cfg config.Config, s *scheduler.Scheduler, referrals commands.ReferralCommands) {
+	if !cfg.Referral.RewardsEnabled {
+		return
+	}
+
+	s.Every("referral_rewards", cfg.Referral.RewardInterval, func(ctx context.Context) error {
+		_, err := referrals.IssueRewards(ctx)
+		return err
+	})
+}
+
+func registerLoyaltyJobs(cfg config.Config, s *scheduler.Scheduler, loyalty commands.LoyaltyCommands) {
+	if !cfg.Loyalty.JobsEnabled {
+		return
+	}
+
+	s.Every("loyalty_accrual", cfg.Loyalty.AccrualInterval, func(ctx context.Context) error {
+		_, err := loyalty.AccruePoints(ctx)
+		return err
+	})
+	s.Every("loyalty_expiry", cfg.Loyalty.ExpiryInterval, func(ctx context.Context) error {
+		_, err := loyalty.ExpirePoints(ctx)
+		return err
+	})
+}
+
+func registerReviewSummaryJob(cfg config.Config, s *scheduler.Scheduler, summaries commands.ReviewSummaryCommands) {
+	if !cfg.Summary.JobEnabled {
+		return
+	}
+
+	s.Every("review_summaries", cfg.Summary.Interval, func(ctx context.Context) error {
+		_, err := summaries.RefreshSummaries(ctx)
+		return err
+	})
+}
+
+func registerUsageFlushJob(cfg config.Config, lc fx.Lifecycle, s *scheduler.Scheduler, meter shared.UsageMeter) {
+	if !cfg.Usage.MeteringEnabled {
+		return
+	}
+
+	s.Every("usage_flush", cfg.Usage.FlushInterval, func(ctx context.Context) error {
+		_, err := meter.Flush(ctx)
+		return err
+	})
+	// Registered after the database module, so this runs before the pool closes.
+	lc.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			_, err := meter.Flush(ctx)
+			return err
+		},
+	})
+}
+
+func registerTelemetryFlushJob(cfg config.Config, lc fx.Lifecycle, s *scheduler.Scheduler, telemetry shared.FeatureTelemetry) {
+	if !cfg.Telemetry.Enabled {
+		return
+	}
+
+	s.Every("telemetry_flush", cfg.Telemetry.FlushInterval, func(ctx context.Context) error {
+		_, err := telemetry.Flush(ctx)
+		return err
+	})
+	lc.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			_, err := telemetry.Flush(ctx)
+			return err
+		},
+	})
+}
+
+func registerDeprecationFlushJob(cfg config.Config, lc fx.Lifecycle, s *scheduler.Scheduler, tracker shared.DeprecationTracker) {
+	if !cfg.Deprecation.TrackingEnabled {
+		return
+	}
+
+	s.Every("deprecation_flush", cfg.Deprecation.FlushInterval, func(ctx context.Context) error {
+		_, err := tracker.Flush(ctx)
+		return err
+	})
+	lc.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			_, err := tracker.Flush(ctx)
+			return err
+		},
+	})
+}
+
+func registerAdminIPRulesReloadJob(cfg config.Config, s *scheduler.Scheduler, ipFilter *middleware.IPFilterMiddleware) {
+	if cfg.AdminIP.RulesFile == "" {
+		return
+	}
+
+	s.Every("admin_ip_rules_reload", cfg.AdminIP.RulesReloadInterval, func(context.Context) error {
+		_, err := ipFilter.Reload()
+		return err
+	})
+}
+
+func registerCursorCheckJob(cfg config.Config, s *scheduler.Scheduler, cursors commands.CursorCommands) {
+	if !cfg.Maintenance.CursorCheckEnabled {
+		return
+	}
+
+	s.Every("cursor_check", cfg.Maintenance.CursorCheckInterval, func(ctx context.Context) error {
+		_, err := cursors.Check(ctx, cfg.Maintenance.CursorAutoRepair, nil)
+		return err
+	})
+}
+
+func registerApprovalSweepJob(cfg config.Config, s *scheduler.Scheduler, approvals commands.ReservationApprovalCommands) {
+	if !cfg.Approval.JobEnabled {
+		return
+	}
+
+	s.Every("approval_sweep", cfg.Approval.JobInterval, func(ctx context.Context) error {
+		_, err := approvals.Sweep(ctx)
+		return err
+	})
+}
+
+func registerRequestRecordingPurgeJob(cfg config.Config, s *scheduler.Scheduler, recordings commands.RequestRecordingCommands) {
+	if !cfg.Recording.JobEnabled {
+		return
+	}
+
+	s.Every("request_recording_purge", cfg.Recording.JobInterval, func(ctx context.Context) error {
+		_, err := recordings.Purge(ctx)
+		return err
+	})
+}
+
+// registerCompanyExportJob builds queued archives, then purges expired ones.
+func registerCompanyExportJob(cfg config.Config, s *scheduler.Scheduler, exports commands.CompanyExportCommands) {
+	if !cfg.Export.JobEnabled {
+		return
+	}
+
+	s.Every("company_export", cfg.Export.JobInterval, func(ctx context.Context) error {
+		if _, err := exports.Run(ctx); err != nil {
+			return err
+		}
+		_, err := exports.Purge(ctx)
+		return err
+	})
+}
+
+// registerCompanyDeletionJob purges queued company deletions.
+func registerCompanyDeletionJob(cfg config.Config, s *scheduler.Scheduler, deletions commands.CompanyDeletionCommands) {
+	if !cfg.Deletion.JobEnabled {
+		return
+	}
+
+	s.Every("company_deletion", cfg.Deletion.JobInterval, func(ctx context.Context) error {
+		_, err := deletions.Run(ctx)
+		return err
+	})
+}
+
+func registerReadReplicaProbeJob(cfg config.Config, lc fx.Lifecycle, s *scheduler.Scheduler, reads *db.ReadRouter) {
+	if len(cfg.Replicas.Endpoints) == 0 || cfg.Replicas.Routing == string(db.ReadPolicyPrimary) {
+		return
+	}
+
+	s.Every("db_read_probe", cfg.Replicas.ProbeInterval, func(ctx context.Context) error {
+		reads.Probe(ctx)
+		return nil
+	})
+	// Probe once at startup so healthy replicas serve reads before the first tick.
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			reads.Probe(ctx)
+			return nil
+		},
+	})
+}
+
+func registerPlatformStatsJob(cfg config.Config, s *scheduler.Scheduler, stats commands.PlatformStatsCommands) {
+	if !cfg.PublicSite.StatsJobEnabled {
+		return
+	}
+
+	s.Every("platform_stats", cfg.PublicSite.StatsInterval, stats.Refresh)
+}
+
+func registerNotificationDigestJob(cfg config.Config, s *scheduler.Scheduler, digests commands.NotificationDigestCommands) {
+	if !cfg.Digest.JobEnabled {
+		return
+	}
+
+	s.Every("notification_digest", cfg.Digest.JobInterval, func(ctx context.Context) error {
+		_, err := digests.Run(ctx)
+		return err
+	})
+}
@@ -1,10 +1,14 @@
 package components
 
 import (
+	"context"
+
+	"gin-clean-starter/internal/infra/db"
 	"gin-clean-starter/internal/infra/readstore"
 	"gin-clean-starter/internal/infra/repository"
 	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
 	"gin-clean-starter/internal/infra/uow"
+	"gin-clean-starter/internal/pkg/config"
 	"gin-clean-starter/internal/usecase/queries"
 	"gin-clean-starter/internal/usecase/shared"
 
@@ -33,6 +37,7 @@ var readstoreModule = fx.Module("persistence/readstore",
 		fx.Annotate(
 			readstore.NewResourceReadStore,
 			fx.As(new(shared.ResourceReadStore)),
+			fx.As(new(queries.PublicResourceReadStore)),
 		),
 		// Coupon
 		fx.Annotate(
@@ -71,6 +76,22 @@ var readstoreModule = fx.Module("persistence/readstore",
 			fx.As(new(queries.ReservationReadStore)),
 			fx.As(new(shared.ReservationSnapshotReadStore)),
 		),
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(readstore.ReservationMessageReadQueries)),
+		),
+		fx.Annotate(
+			readstore.NewReservationMessageReadStore,
+			fx.As(new(queries.ReservationMessageReadStore)),
+		),
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(readstore.ReservationAttachmentReadQueries)),
+		),
+		fx.Annotate(
+			readstore.NewReservationAttachmentReadStore,
+			fx.As(new(shared.ReservationAttachmentReadStore)),
+		),
 		// Review
 		fx.Annotate(
 			NewSQLQueries,
@@ -81,12 +102,339 @@ var readstoreModule = fx.Module("persistence/readstore",
 			fx.As(new(queries.ReviewReadStore)),
 			fx.As(new(shared.ReviewReadStore)),
 		),
+		// Role
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(readstore.RoleReadQueries)),
+		),
+		fx.Annotate(
+			readstore.NewRoleReadStore,
+			fx.As(new(queries.RoleReadStore)),
+			fx.As(new(shared.RolePermissionReadStore)),
+		),
+		// ResourceOperator
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(readstore.ResourceOperatorReadQueries)),
+		),
+		fx.Annotate(
+			readstore.NewResourceOperatorReadStore,
+			fx.As(new(queries.ResourceOperatorReadStore)),
+			fx.As(new(shared.ResourceAssignmentReadStore)),
+		),
+		// Invite
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(readstore.InviteReadQueries)),
+		),
+		fx.Annotate(
+			readstore.NewInviteReadStore,
+			fx.As(new(queries.InviteReadStore)),
+			fx.As(new(shared.InviteReadStore)),
+		),
+		// ReservationTransfer
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(readstore.ReservationTransferReadQueries)),
+		),
+		fx.Annotate(
+			readstore.NewReservationTransferReadStore,
+			fx.As(new(shared.ReservationTransferReadStore)),
+		),
+		// Referral
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(readstore.ReferralReadQueries)),
+		),
+		fx.Annotate(
+			readstore.NewReferralReadStore,
+			fx.As(new(queries.ReferralReadStore)),
+			fx.As(new(shared.ReferralReadStore)),
+		),
+		// Loyalty
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(readstore.LoyaltyReadQueries)),
+		),
+		fx.Annotate(
+			readstore.NewLoyaltyReadStore,
+			fx.As(new(queries.LoyaltyReadStore)),
+			fx.As(new(shared.LoyaltyReadStore)),
+		),
+		// Resource blocks
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(readstore.ResourceBlockReadQueries)),
+		),
+		fx.Annotate(
+			readstore.NewResourceBlockReadStore,
+			fx.As(new(queries.ResourceBlockReadStore)),
+		),
+		// Security events
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(readstore.SecurityEventReadQueries)),
+		),
+		fx.Annotate(
+			readstore.NewSecurityEventReadStore,
+			fx.As(new(queries.SecurityEventReadStore)),
+		),
+		// Activity timeline
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(readstore.ActivityReadQueries)),
+		),
+		fx.Annotate(
+			readstore.NewActivityReadStore,
+			fx.As(new(queries.ActivityReadStore)),
+		),
+		// Admin search
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(readstore.AdminSearchReadQueries)),
+		),
+		fx.Annotate(
+			readstore.NewAdminSearchReadStore,
+			fx.As(new(queries.AdminSearchReadStore)),
+		),
+		// Admin activity lists
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(readstore.AuditLogReadQueries)),
+		),
+		fx.Annotate(
+			readstore.NewAuditLogReadStore,
+			fx.As(new(queries.AuditLogReadStore)),
+		),
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(readstore.NotificationJobReadQueries)),
+		),
+		fx.Annotate(
+			readstore.NewNotificationJobReadStore,
+			fx.As(new(queries.NotificationJobReadStore)),
+		),
+		// TOS
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(readstore.TOSReadQueries)),
+		),
+		fx.Annotate(
+			readstore.NewTOSReadStore,
+			fx.As(new(shared.TOSReadStore)),
+		),
+		// User merges
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(repository.UserMergeWriteQueries)),
+		),
+		fx.Annotate(
+			repository.NewUserMergeRepository,
+			fx.As(new(shared.UserMergeRepository)),
+		),
+		// Notification digests
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(repository.NotificationDigestWriteQueries)),
+		),
+		fx.Annotate(
+			repository.NewNotificationDigestRepository,
+			fx.As(new(shared.NotificationDigestRepository)),
+		),
+		// Refresh tokens
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(readstore.RefreshTokenReadQueries)),
+		),
+		fx.Annotate(
+			readstore.NewRefreshTokenReadStore,
+			fx.As(new(shared.RefreshTokenReadStore)),
+		),
+		// Plan
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(readstore.PlanReadQueries)),
+		),
+		fx.Annotate(
+			readstore.NewPlanReadStore,
+			fx.As(new(shared.PlanReadStore)),
+		),
+		// Telemetry
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(readstore.TelemetryReadQueries)),
+		),
+		fx.Annotate(
+			readstore.NewTelemetryReadStore,
+			fx.As(new(queries.TelemetryReadStore)),
+			fx.As(new(shared.TelemetryReadStore)),
+		),
+		// Features
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(readstore.FeatureReadQueries)),
+		),
+		fx.Annotate(
+			readstore.NewFeatureReadStore,
+			fx.As(new(shared.FeatureReadStore)),
+		),
+		// Branding
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(readstore.BrandingReadQueries)),
+		),
+		fx.Annotate(
+			readstore.NewBrandingReadStore,
+			fx.As(new(shared.BrandingReadStore)),
+		),
+		// Provisioning
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(readstore.ProvisioningReadQueries)),
+		),
+		fx.Annotate(
+			readstore.NewProvisioningReadStore,
+			fx.As(new(queries.ProvisioningReadStore)),
+		),
+		// SAML
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(readstore.SAMLConnectionReadQueries)),
+		),
+		fx.Annotate(
+			readstore.NewSAMLConnectionReadStore,
+			fx.As(new(shared.SAMLConnectionReadStore)),
+		),
+		// Reservation approval
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(readstore.ReservationApprovalReadQueries)),
+		),
+		fx.Annotate(
+			readstore.NewReservationApprovalReadStore,
+			fx.As(new(shared.ReservationApprovalReadStore)),
+			fx.As(new(queries.ReservationApprovalReadStore)),
+		),
+		// Custom fields
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(readstore.CustomFieldReadQueries)),
+		),
+		fx.Annotate(
+			readstore.NewCustomFieldReadStore,
+			fx.As(new(shared.CustomFieldReadStore)),
+			fx.As(new(queries.CustomFieldReadStore)),
+		),
+		// Cursors
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(repository.CursorQueries)),
+		),
+		fx.Annotate(
+			repository.NewCursorRepository,
+			fx.As(new(shared.CursorRepository)),
+		),
+		// Usage
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(readstore.UsageReadQueries)),
+		),
+		fx.Annotate(
+			readstore.NewUsageReadStore,
+			fx.As(new(queries.UsageReadStore)),
+			fx.As(new(shared.UsageReadStore)),
+		),
+		// Deprecated routes
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(readstore.DeprecationReadQueries)),
+		),
+		fx.Annotate(
+			readstore.NewDeprecationReadStore,
+			fx.As(new(queries.DeprecationReadStore)),
+		),
+		// Request recordings
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(readstore.RequestRecordingReadQueries)),
+		),
+		fx.Annotate(
+			readstore.NewRequestRecordingReadStore,
+			fx.As(new(queries.RequestRecordingReadStore)),
+			fx.As(new(shared.RequestRecordingReadStore)),
+		),
+		// Company exports
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(readstore.CompanyExportReadQueries)),
+		),
+		fx.Annotate(
+			readstore.NewCompanyExportReadStore,
+			fx.As(new(queries.CompanyExportReadStore)),
+			fx.As(new(shared.CompanyExportReadStore)),
+		),
+		// Company deletions
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(readstore.CompanyDeletionReadQueries)),
+		),
+		fx.Annotate(
+			readstore.NewCompanyDeletionReadStore,
+			fx.As(new(queries.CompanyDeletionReadStore)),
+		),
+		// Platform stats
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(readstore.PlatformStatsReadQueries)),
+		),
+		fx.Annotate(
+			readstore.NewPlatformStatsReadStore,
+			fx.As(new(queries.PlatformStatsReadStore)),
+		),
+		// API keys
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(readstore.APIKeyReadQueries)),
+		),
+		fx.Annotate(
+			readstore.NewAPIKeyReadStore,
+			fx.As(new(queries.APIKeyReadStore)),
+		),
+		// Sessions
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(readstore.SessionReadQueries)),
+		),
+		fx.Annotate(
+			readstore.NewSessionReadStore,
+			fx.As(new(queries.SessionReadStore)),
+		),
+		// Notification preferences
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(readstore.NotificationPreferencesReadQueries)),
+		),
+		fx.Annotate(
+			readstore.NewNotificationPreferencesReadStore,
+			fx.As(new(queries.NotificationPreferencesReadStore)),
+		),
 	),
 )
 
 var repositoryModule = fx.Module("persistence/repository",
 	fx.Provide(
 		// UnitOfWork
+		func(cfg config.Config) uow.Options {
+			return uow.Options{
+				TagTransactions: cfg.DB.TagTransactions,
+				ApplicationName: cfg.DB.ApplicationName,
+			}
+		},
+		fx.Annotate(
+			NewReadRouter,
+			fx.As(fx.Self()),
+			fx.As(new(uow.ReadRouter)),
+		),
 		fx.Annotate(
 			uow.NewPostgresUoW,
 			fx.As(new(shared.UnitOfWork)),
@@ -109,6 +457,30 @@ var repositoryModule = fx.Module("persistence/repository",
 			repository.NewReservationRepository,
 			fx.As(new(shared.ReservationRepository)),
 		),
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(repository.ReservationMessageWriteQueries)),
+		),
+		fx.Annotate(
+			repository.NewReservationMessageRepository,
+			fx.As(new(shared.ReservationMessageRepository)),
+		),
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(repository.ReservationAttachmentWriteQueries)),
+		),
+		fx.Annotate(
+			repository.NewReservationAttachmentRepository,
+			fx.As(new(shared.ReservationAttachmentRepository)),
+		),
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(repository.ReservationTransferWriteQueries)),
+		),
+		fx.Annotate(
+			repository.NewReservationTransferRepository,
+			fx.As(new(shared.ReservationTransferRepository)),
+		),
 		// Review
 		fx.Annotate(
 			NewSQLQueries,
@@ -145,6 +517,267 @@ var repositoryModule = fx.Module("persistence/repository",
 			repository.NewNotificationRepository,
 			fx.As(new(shared.NotificationRepository)),
 		),
+		// Role
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(repository.RoleWriteQueries)),
+		),
+		fx.Annotate(
+			repository.NewRoleRepository,
+			fx.As(new(shared.RoleRepository)),
+		),
+		// ResourceOperator
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(repository.ResourceOperatorWriteQueries)),
+		),
+		fx.Annotate(
+			repository.NewResourceOperatorRepository,
+			fx.As(new(shared.ResourceOperatorRepository)),
+		),
+		// Invite
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(repository.InviteWriteQueries)),
+		),
+		fx.Annotate(
+			repository.NewInviteRepository,
+			fx.As(new(shared.InviteRepository)),
+		),
+		// Company
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(repository.CompanyWriteQueries)),
+		),
+		fx.Annotate(
+			repository.NewCompanyRepository,
+			fx.As(new(shared.CompanyRepository)),
+		),
+		// Resource
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(repository.ResourceWriteQueries)),
+		),
+		fx.Annotate(
+			repository.NewResourceRepository,
+			fx.As(new(shared.ResourceRepository)),
+		),
+		// Audit
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(repository.AuditWriteQueries)),
+		),
+		fx.Annotate(
+			repository.NewAuditRepository,
+			fx.As(new(shared.AuditRepository)),
+		),
+		// Referral
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(repository.ReferralWriteQueries)),
+		),
+		fx.Annotate(
+			repository.NewReferralRepository,
+			fx.As(new(shared.ReferralRepository)),
+		),
+		// Loyalty
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(repository.LoyaltyWriteQueries)),
+		),
+		fx.Annotate(
+			repository.NewLoyaltyRepository,
+			fx.As(new(shared.LoyaltyRepository)),
+		),
+		// Resource blocks
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(repository.ResourceBlockWriteQueries)),
+		),
+		fx.Annotate(
+			repository.NewResourceBlockRepository,
+			fx.As(new(shared.ResourceBlockRepository)),
+		),
+		// TOS
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(repository.TOSWriteQueries)),
+		),
+		fx.Annotate(
+			repository.NewTOSRepository,
+			fx.As(new(shared.TOSRepository)),
+		),
+		// Billing
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(repository.BillingWriteQueries)),
+		),
+		fx.Annotate(
+			repository.NewBillingRepository,
+			fx.As(new(shared.BillingRepository)),
+		),
+		// Provisioning
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(repository.ProvisioningWriteQueries)),
+		),
+		fx.Annotate(
+			repository.NewProvisioningRepository,
+			fx.As(new(shared.ProvisioningRepository)),
+		),
+		// SAML
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(repository.SAMLConnectionWriteQueries)),
+		),
+		fx.Annotate(
+			repository.NewSAMLConnectionRepository,
+			fx.As(new(shared.SAMLConnectionRepository)),
+		),
+		// Reservation approval
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(repository.ReservationApprovalWriteQueries)),
+		),
+		fx.Annotate(
+			repository.NewReservationApprovalRepository,
+			fx.As(new(shared.ReservationApprovalRepository)),
+		),
+		// Custom fields
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(repository.CustomFieldWriteQueries)),
+		),
+		fx.Annotate(
+			repository.NewCustomFieldRepository,
+			fx.As(new(shared.CustomFieldRepository)),
+		),
+		// Activity timeline
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(repository.ActivityWriteQueries)),
+		),
+		fx.Annotate(
+			repository.NewActivityRepository,
+			fx.As(new(shared.ActivityRepository)),
+		),
+		// Retention
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(repository.RetentionQueries)),
+		),
+		fx.Annotate(
+			repository.NewRetentionRepository,
+			fx.As(new(shared.RetentionRepository)),
+		),
+		// Usage
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(repository.UsageWriteQueries)),
+		),
+		fx.Annotate(
+			repository.NewUsageRepository,
+			fx.As(new(shared.UsageRepository)),
+		),
+		// Deprecated routes
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(repository.DeprecationWriteQueries)),
+		),
+		fx.Annotate(
+			repository.NewDeprecationRepository,
+			fx.As(new(shared.DeprecationRepository)),
+		),
+		// Request recordings
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(repository.RequestRecordingWriteQueries)),
+		),
+		fx.Annotate(
+			repository.NewRequestRecordingRepository,
+			fx.As(new(shared.RequestRecordingRepository)),
+		),
+		// Company exports
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(repository.CompanyExportWriteQueries)),
+		),
+		fx.Annotate(
+			repository.NewCompanyExportRepository,
+			fx.As(new(shared.CompanyExportRepository)),
+		),
+		// Company deletions
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(repository.CompanyDeletionWriteQueries)),
+		),
+		fx.Annotate(
+			repository.NewCompanyDeletionRepository,
+			fx.As(new(shared.CompanyDeletionRepository)),
+		),
+		// Refresh tokens
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(repository.RefreshTokenWriteQueries)),
+		),
+		fx.Annotate(
+			repository.NewRefreshTokenRepository,
+			fx.As(new(shared.RefreshTokenRepository)),
+		),
+		// Email verifications
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(repository.EmailVerificationWriteQueries)),
+		),
+		fx.Annotate(
+			repository.NewEmailVerificationRepository,
+			fx.As(new(shared.EmailVerificationRepository)),
+		),
+		// Two-factor authentication
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(repository.MFAWriteQueries)),
+		),
+		fx.Annotate(
+			repository.NewMFARepository,
+			fx.As(new(shared.MFARepository)),
+		),
+		// OpenID Connect identities
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(repository.UserIdentityWriteQueries)),
+		),
+		fx.Annotate(
+			repository.NewUserIdentityRepository,
+			fx.As(new(shared.UserIdentityRepository)),
+		),
+		// Platform stats
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(repository.PlatformStatsWriteQueries)),
+		),
+		fx.Annotate(
+			repository.NewPlatformStatsRepository,
+			fx.As(new(shared.PlatformStatsRepository)),
+		),
+		// API keys
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(repository.APIKeyWriteQueries)),
+		),
+		fx.Annotate(
+			repository.NewAPIKeyRepository,
+			fx.As(new(shared.APIKeyRepository)),
+		),
+		// Login lockout
+		fx.Annotate(
+			NewSQLQueries,
+			fx.As(new(repository.LoginFailureWriteQueries)),
+		),
+		fx.Annotate(
+			repository.NewLoginFailureRepository,
+			fx.As(new(shared.LoginFailureRepository)),
+		),
 	),
 )
 
@@ -155,3 +788,28 @@ func NewSQLQueries(_ *pgxpool.Pool) *sqlc.Queries {
 func NewDBTX(pool *pgxpool.Pool) sqlc.DBTX {
 	return pool
 }
+
+// NewReadRouter connects the configured read replicas. Without any, or with the primary
+// policy, every read stays on the primary.
+func NewReadRouter(lc fx.Lifecycle, cfg config.Config, pool *pgxpool.Pool) (*db.ReadRouter, error) {
+	policy, err := db.ParseReadPolicy(cfg.Replicas.Routing, cfg.Replicas.Region)
+	if err != nil {
+		return nil, err
+	}
+	if policy == db.ReadPolicyPrimary || len(cfg.Replicas.Endpoints) == 0 {
+		return db.NewReadRouter(pool, db.ReadPolicyPrimary, "", 0, nil), nil
+	}
+
+	replicas, cleanup, err := db.ConnectReplicas(cfg.DB, cfg.Replicas.Endpoints)
+	if err != nil {
+		return nil, err
+	}
+	lc.Append(fx.Hook{
+		OnStop: func(_ context.Context) error {
+			cleanup()
+			return nil
+		},
+	})
+
+	return db.NewReadRouter(pool, policy, cfg.Replicas.Region, cfg.Replicas.MaxLag, replicas), nil
+}
@@ -0,0 +1,15 @@
+//go:build !e2e
+
+package components
+
+import (
+	"gin-clean-starter/internal/pkg/clock"
+
+	"go.uber.org/fx"
+)
+
+// Release builds run on the real clock and mount no test routes; see testhooks_e2e.go.
+var (
+	clockOption      = fx.Provide(clock.NewRealClock)
+	testRoutesOption = fx.Options()
+)
@@ -0,0 +1,25 @@
+//go:build e2e
+
+package components
+
+import (
+	"gin-clean-starter/internal/handler"
+	"gin-clean-starter/internal/handler/api"
+	"gin-clean-starter/internal/pkg/clock"
+
+	"go.uber.org/fx"
+)
+
+// E2E builds share one OffsetClock between the use cases and POST /api/_test/clock, and
+// GET /api/_test/slow holds a query open for cancellation tests.
+var (
+	clockOption = fx.Provide(
+		clock.NewOffsetClock,
+		func(c *clock.OffsetClock) clock.Clock { return c },
+	)
+	// Registered after handler.NewRouter so the routes get the global middleware.
+	testRoutesOption = fx.Options(
+		fx.Provide(api.NewTestClockHandler, api.NewTestSlowHandler),
+		fx.Invoke(handler.RegisterTestRoutes),
+	)
+)
@@ -1,16 +1,27 @@
 package components
 
 import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"gin-clean-starter/internal/domain/loyalty"
+	"gin-clean-starter/internal/domain/referral"
 	"gin-clean-starter/internal/domain/reservation"
+	domreview "gin-clean-starter/internal/domain/review"
 	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/config"
 	"gin-clean-starter/internal/usecase"
 	"gin-clean-starter/internal/usecase/commands"
 	"gin-clean-starter/internal/usecase/queries"
+	"gin-clean-starter/internal/usecase/shared"
 
 	"go.uber.org/fx"
 )
 
 var UseCaseModule = fx.Module("usecase",
+	clockOption,
 	usecaseBaseOption,
 	usecaseQueriesModule,
 	usecaseValidatorsModule,
@@ -18,15 +29,300 @@ var UseCaseModule = fx.Module("usecase",
 )
 
 var usecaseBaseOption = fx.Provide(
-	clock.NewRealClock,
 	fx.Annotate(
 		reservation.NewDefaultPriceCalculator,
 		fx.As(new(reservation.PriceCalculator)),
 	),
-	func(clock clock.Clock, calc reservation.PriceCalculator) *reservation.Services {
+	fx.Annotate(
+		func(cfg config.Config) *reservation.DefaultTaxCalculator {
+			return reservation.NewDefaultTaxCalculator(cfg.Pricing.TaxRateBasisPoints)
+		},
+		fx.As(new(reservation.TaxCalculator)),
+	),
+	func(cfg config.Config) (reservation.StackingPolicy, error) {
+		policy, err := reservation.NewStackingPolicy(cfg.Pricing.CouponStacking, cfg.Pricing.CouponStackCapBasisPoints)
+		if err != nil {
+			return reservation.StackingPolicy{}, fmt.Errorf("invalid PRICING_COUPON_STACKING / PRICING_COUPON_STACK_CAP_BPS: %w", err)
+		}
+		return policy, nil
+	},
+	func(cfg config.Config) (reservation.PointsPolicy, error) {
+		policy, err := reservation.NewPointsPolicy(cfg.Loyalty.CentsPerPoint, cfg.Loyalty.MaxRedemptionBasisPoints)
+		if err != nil {
+			return reservation.PointsPolicy{}, fmt.Errorf("invalid LOYALTY_CENTS_PER_POINT / LOYALTY_MAX_REDEMPTION_BPS: %w", err)
+		}
+		return policy, nil
+	},
+	func(cfg config.Config, clock clock.Clock, calc reservation.PriceCalculator, tax reservation.TaxCalculator, stacking reservation.StackingPolicy, points reservation.PointsPolicy) (*reservation.Services, error) {
+		if err := validateClockSkew(cfg.Scheduling.ClockSkew); err != nil {
+			return nil, err
+		}
 		return &reservation.Services{
 			Clock:           clock,
 			PriceCalculator: calc,
+			TaxCalculator:   tax,
+			Stacking:        stacking,
+			Points:          points,
+			ClockSkew:       cfg.Scheduling.ClockSkew,
+		}, nil
+	},
+	func(cfg config.Config) (commands.DeviceBindingMode, error) {
+		mode := commands.DeviceBindingMode(cfg.JWT.DeviceBinding)
+		switch mode {
+		case commands.DeviceBindingOff, commands.DeviceBindingOptional, commands.DeviceBindingRequired:
+			return mode, nil
+		}
+		return "", fmt.Errorf("invalid JWT_DEVICE_BINDING: %q", cfg.JWT.DeviceBinding)
+	},
+	func(cfg config.Config) commands.QuotePolicy {
+		return commands.QuotePolicy{TTL: cfg.Pricing.QuoteTTL}
+	},
+	func(cfg config.Config) (commands.InvitePolicy, error) {
+		if u, err := url.Parse(cfg.Invite.AcceptURL); err != nil || u.Scheme == "" || u.Host == "" {
+			return commands.InvitePolicy{}, fmt.Errorf("invalid INVITE_ACCEPT_URL: %q", cfg.Invite.AcceptURL)
+		}
+		return commands.InvitePolicy{TTL: cfg.Invite.TTL, AcceptURL: cfg.Invite.AcceptURL}, nil
+	},
+	func(cfg config.Config) (commands.RegistrationPolicy, error) {
+		if !cfg.Signup.Enabled {
+			return commands.RegistrationPolicy{}, nil
+		}
+		if u, err := url.Parse(cfg.Signup.VerifyURL); err != nil || u.Scheme == "" || u.Host == "" {
+			return commands.RegistrationPolicy{}, fmt.Errorf("invalid SIGNUP_VERIFY_URL: %q", cfg.Signup.VerifyURL)
+		}
+		if cfg.Signup.VerificationTTL <= 0 {
+			return commands.RegistrationPolicy{}, fmt.Errorf("invalid SIGNUP_VERIFICATION_TTL: %s", cfg.Signup.VerificationTTL)
+		}
+		return commands.RegistrationPolicy{Enabled: true, TTL: cfg.Signup.VerificationTTL, VerifyURL: cfg.Signup.VerifyURL}, nil
+	},
+	func(cfg config.Config) (commands.MFAPolicy, error) {
+		if cfg.MFA.Issuer == "" || strings.Contains(cfg.MFA.Issuer, ":") {
+			return commands.MFAPolicy{}, fmt.Errorf("invalid MFA_ISSUER: %q", cfg.MFA.Issuer)
+		}
+		if cfg.MFA.ChallengeTTL <= 0 {
+			return commands.MFAPolicy{}, fmt.Errorf("invalid MFA_CHALLENGE_TTL: %s", cfg.MFA.ChallengeTTL)
+		}
+		if cfg.MFA.RecoveryCodes <= 0 {
+			return commands.MFAPolicy{}, fmt.Errorf("invalid MFA_RECOVERY_CODES: %d", cfg.MFA.RecoveryCodes)
+		}
+		if cfg.MFA.MaxAttempts <= 0 {
+			return commands.MFAPolicy{}, fmt.Errorf("invalid MFA_MAX_ATTEMPTS: %d", cfg.MFA.MaxAttempts)
+		}
+		if cfg.MFA.Lockout <= 0 {
+			return commands.MFAPolicy{}, fmt.Errorf("invalid MFA_LOCKOUT: %s", cfg.MFA.Lockout)
+		}
+		return commands.MFAPolicy{
+			Issuer:        cfg.MFA.Issuer,
+			ChallengeTTL:  cfg.MFA.ChallengeTTL,
+			RecoveryCodes: cfg.MFA.RecoveryCodes,
+			MaxAttempts:   cfg.MFA.MaxAttempts,
+			Lockout:       cfg.MFA.Lockout,
+		}, nil
+	},
+	func(cfg config.Config) (commands.LoginLockoutPolicy, error) {
+		if cfg.Lockout.MaxAttempts < 0 {
+			return commands.LoginLockoutPolicy{}, fmt.Errorf("invalid LOGIN_LOCKOUT_MAX_ATTEMPTS: %d", cfg.Lockout.MaxAttempts)
+		}
+		if cfg.Lockout.MaxAttempts > 0 && cfg.Lockout.Cooldown <= 0 {
+			return commands.LoginLockoutPolicy{}, fmt.Errorf("invalid LOGIN_LOCKOUT_COOLDOWN: %s", cfg.Lockout.Cooldown)
+		}
+		return commands.LoginLockoutPolicy{MaxAttempts: cfg.Lockout.MaxAttempts, Cooldown: cfg.Lockout.Cooldown}, nil
+	},
+	func(cfg config.Config) (commands.ReservationTransferPolicy, error) {
+		if u, err := url.Parse(cfg.Transfer.AcceptURL); err != nil || u.Scheme == "" || u.Host == "" {
+			return commands.ReservationTransferPolicy{}, fmt.Errorf("invalid RESERVATION_TRANSFER_ACCEPT_URL: %q", cfg.Transfer.AcceptURL)
+		}
+		if cfg.Transfer.TTL <= 0 {
+			return commands.ReservationTransferPolicy{}, fmt.Errorf("invalid RESERVATION_TRANSFER_TTL: %s", cfg.Transfer.TTL)
+		}
+		return commands.ReservationTransferPolicy{TTL: cfg.Transfer.TTL, AcceptURL: cfg.Transfer.AcceptURL}, nil
+	},
+	func(cfg config.Config) (commands.RequestRecordingPolicy, error) {
+		if cfg.Recording.TTL <= 0 {
+			return commands.RequestRecordingPolicy{}, fmt.Errorf("invalid REQUEST_RECORDING_TTL: %s", cfg.Recording.TTL)
+		}
+		if cfg.Recording.MaxRequests <= 0 {
+			return commands.RequestRecordingPolicy{}, fmt.Errorf("invalid REQUEST_RECORDING_MAX_REQUESTS: %d", cfg.Recording.MaxRequests)
+		}
+		if cfg.Recording.MaxBodyBytes < 0 {
+			return commands.RequestRecordingPolicy{}, fmt.Errorf("invalid REQUEST_RECORDING_MAX_BODY_BYTES: %d", cfg.Recording.MaxBodyBytes)
+		}
+		if cfg.Recording.BatchSize <= 0 {
+			return commands.RequestRecordingPolicy{}, fmt.Errorf("invalid REQUEST_RECORDING_BATCH_SIZE: %d", cfg.Recording.BatchSize)
+		}
+		return commands.RequestRecordingPolicy{
+			TTL:         cfg.Recording.TTL,
+			MaxRequests: cfg.Recording.MaxRequests,
+			BatchSize:   cfg.Recording.BatchSize,
+		}, nil
+	},
+	func(cfg config.Config) (commands.CompanyExportPolicy, error) {
+		if cfg.Export.TTL <= 0 {
+			return commands.CompanyExportPolicy{}, fmt.Errorf("invalid COMPANY_EXPORT_TTL: %s", cfg.Export.TTL)
+		}
+		if cfg.Export.StaleAfter <= 0 {
+			return commands.CompanyExportPolicy{}, fmt.Errorf("invalid COMPANY_EXPORT_STALE_AFTER: %s", cfg.Export.StaleAfter)
+		}
+		if cfg.Export.BatchSize <= 0 {
+			return commands.CompanyExportPolicy{}, fmt.Errorf("invalid COMPANY_EXPORT_BATCH_SIZE: %d", cfg.Export.BatchSize)
+		}
+		return commands.CompanyExportPolicy{
+			TTL:        cfg.Export.TTL,
+			StaleAfter: cfg.Export.StaleAfter,
+			BatchSize:  cfg.Export.BatchSize,
+		}, nil
+	},
+	func(cfg config.Config) (queries.CompanyExportLinkPolicy, error) {
+		if cfg.Export.LinkTTL <= 0 {
+			return queries.CompanyExportLinkPolicy{}, fmt.Errorf("invalid COMPANY_EXPORT_LINK_TTL: %s", cfg.Export.LinkTTL)
+		}
+		return queries.CompanyExportLinkPolicy{TTL: cfg.Export.LinkTTL}, nil
+	},
+	func(cfg config.Config) (commands.CompanyDeletionPolicy, error) {
+		if cfg.Deletion.StaleAfter <= 0 {
+			return commands.CompanyDeletionPolicy{}, fmt.Errorf("invalid COMPANY_DELETION_STALE_AFTER: %s", cfg.Deletion.StaleAfter)
+		}
+		if cfg.Deletion.BatchSize <= 0 {
+			return commands.CompanyDeletionPolicy{}, fmt.Errorf("invalid COMPANY_DELETION_BATCH_SIZE: %d", cfg.Deletion.BatchSize)
+		}
+		return commands.CompanyDeletionPolicy{
+			StaleAfter: cfg.Deletion.StaleAfter,
+			BatchSize:  cfg.Deletion.BatchSize,
+		}, nil
+	},
+	func(cfg config.Config) (commands.NotificationDigestPolicy, error) {
+		if cfg.Digest.BatchSize <= 0 {
+			return commands.NotificationDigestPolicy{}, fmt.Errorf("invalid NOTIFICATION_DIGEST_BATCH_SIZE: %d", cfg.Digest.BatchSize)
+		}
+		return commands.NotificationDigestPolicy{BatchSize: cfg.Digest.BatchSize}, nil
+	},
+	func(cfg config.Config) (commands.ApprovalPolicy, error) {
+		if cfg.Approval.TTL <= 0 {
+			return commands.ApprovalPolicy{}, fmt.Errorf("invalid APPROVAL_TTL: %s", cfg.Approval.TTL)
+		}
+		if cfg.Approval.EscalateAfter <= 0 {
+			return commands.ApprovalPolicy{}, fmt.Errorf("invalid APPROVAL_ESCALATE_AFTER: %s", cfg.Approval.EscalateAfter)
+		}
+		if cfg.Approval.BatchSize <= 0 {
+			return commands.ApprovalPolicy{}, fmt.Errorf("invalid APPROVAL_BATCH_SIZE: %d", cfg.Approval.BatchSize)
+		}
+		return commands.ApprovalPolicy{
+			TTL:           cfg.Approval.TTL,
+			EscalateAfter: cfg.Approval.EscalateAfter,
+			BatchSize:     cfg.Approval.BatchSize,
+		}, nil
+	},
+	func(cfg config.Config) (commands.CompanyPolicy, error) {
+		if _, err := time.LoadLocation(cfg.Company.DefaultTimezone); err != nil {
+			return commands.CompanyPolicy{}, fmt.Errorf("invalid COMPANY_DEFAULT_TIMEZONE: %q", cfg.Company.DefaultTimezone)
+		}
+		if cfg.Company.DefaultLeadTimeMin < 0 {
+			return commands.CompanyPolicy{}, fmt.Errorf("invalid COMPANY_DEFAULT_LEAD_TIME_MIN: %d", cfg.Company.DefaultLeadTimeMin)
+		}
+		return commands.CompanyPolicy{
+			RegistrationEnabled: cfg.Company.RegistrationEnabled,
+			DefaultTimezone:     cfg.Company.DefaultTimezone,
+			DefaultLeadTimeMin:  cfg.Company.DefaultLeadTimeMin,
+			SampleResources:     cfg.Company.SampleResources,
+			DefaultPlan:         cfg.Billing.DefaultPlan,
+		}, nil
+	},
+	func(cfg config.Config) (commands.SupportPolicy, error) {
+		if cfg.Support.SessionTTL <= 0 {
+			return commands.SupportPolicy{}, fmt.Errorf("invalid SUPPORT_SESSION_TTL: %s", cfg.Support.SessionTTL)
+		}
+		return commands.SupportPolicy{SessionTTL: cfg.Support.SessionTTL}, nil
+	},
+	func(cfg config.Config) (commands.ReferralPolicy, error) {
+		reward, err := referral.NewRewardPolicy(cfg.Referral.ReferrerRewardCents, cfg.Referral.ReferredRewardCents)
+		if err != nil {
+			return commands.ReferralPolicy{}, fmt.Errorf("invalid REFERRAL_REFERRER_REWARD_CENTS / REFERRAL_REFERRED_REWARD_CENTS: %w", err)
+		}
+		if cfg.Referral.BatchSize <= 0 {
+			return commands.ReferralPolicy{}, fmt.Errorf("invalid REFERRAL_REWARD_BATCH_SIZE: %d", cfg.Referral.BatchSize)
+		}
+		return commands.ReferralPolicy{Reward: reward, BatchSize: cfg.Referral.BatchSize}, nil
+	},
+	func(cfg config.Config) (commands.LoyaltyPolicy, error) {
+		earn, err := loyalty.NewEarnPolicy(cfg.Loyalty.PointsPerDollar, cfg.Loyalty.PointsTTL)
+		if err != nil {
+			return commands.LoyaltyPolicy{}, fmt.Errorf("invalid LOYALTY_POINTS_PER_DOLLAR / LOYALTY_POINTS_TTL: %w", err)
+		}
+		if cfg.Loyalty.BatchSize <= 0 {
+			return commands.LoyaltyPolicy{}, fmt.Errorf("invalid LOYALTY_BATCH_SIZE: %d", cfg.Loyalty.BatchSize)
+		}
+		if cfg.Loyalty.AccrualLookback <= 0 {
+			return commands.LoyaltyPolicy{}, fmt.Errorf("invalid LOYALTY_ACCRUAL_LOOKBACK: %s", cfg.Loyalty.AccrualLookback)
+		}
+		return commands.LoyaltyPolicy{Earn: earn, BatchSize: cfg.Loyalty.BatchSize, AccrualLookback: cfg.Loyalty.AccrualLookback}, nil
+	},
+	func(cfg config.Config) (commands.AttachmentPolicy, error) {
+		if cfg.Attachment.MaxBytes <= 0 {
+			return commands.AttachmentPolicy{}, fmt.Errorf("invalid ATTACHMENT_MAX_BYTES: %d", cfg.Attachment.MaxBytes)
+		}
+		if len(cfg.Attachment.AllowedTypes) == 0 {
+			return commands.AttachmentPolicy{}, fmt.Errorf("ATTACHMENT_ALLOWED_TYPES must list at least one type")
+		}
+		return commands.AttachmentPolicy{MaxBytes: cfg.Attachment.MaxBytes, AllowedTypes: cfg.Attachment.AllowedTypes}, nil
+	},
+	func(cfg config.Config) (commands.ReviewSummaryPolicy, error) {
+		if cfg.Summary.BatchSize <= 0 {
+			return commands.ReviewSummaryPolicy{}, fmt.Errorf("invalid REVIEW_SUMMARY_BATCH_SIZE: %d", cfg.Summary.BatchSize)
+		}
+		if cfg.Summary.RecentReviews <= 0 {
+			return commands.ReviewSummaryPolicy{}, fmt.Errorf("invalid REVIEW_SUMMARY_RECENT_REVIEWS: %d", cfg.Summary.RecentReviews)
+		}
+		return commands.ReviewSummaryPolicy{BatchSize: cfg.Summary.BatchSize, RecentReviews: cfg.Summary.RecentReviews}, nil
+	},
+	func(cfg config.Config) (commands.ReviewPolicy, error) {
+		if cfg.Moderation.DuplicateThreshold < 0 || cfg.Moderation.DuplicateThreshold > 1 {
+			return commands.ReviewPolicy{}, fmt.Errorf("invalid REVIEW_DUPLICATE_THRESHOLD: %v", cfg.Moderation.DuplicateThreshold)
+		}
+		if cfg.Moderation.DuplicateLookback <= 0 {
+			return commands.ReviewPolicy{}, fmt.Errorf("invalid REVIEW_DUPLICATE_LOOKBACK: %d", cfg.Moderation.DuplicateLookback)
+		}
+		if err := validateClockSkew(cfg.Scheduling.ClockSkew); err != nil {
+			return commands.ReviewPolicy{}, err
+		}
+		if cfg.Eligibility.OpensAfter < 0 {
+			return commands.ReviewPolicy{}, fmt.Errorf("invalid REVIEW_WINDOW_OPENS_AFTER: %v", cfg.Eligibility.OpensAfter)
+		}
+		closesAfter := time.Duration(cfg.Eligibility.WindowDays) * 24 * time.Hour
+		if cfg.Eligibility.WindowDays < 0 || (cfg.Eligibility.WindowDays > 0 && closesAfter <= cfg.Eligibility.OpensAfter) {
+			return commands.ReviewPolicy{}, fmt.Errorf("invalid REVIEW_WINDOW_DAYS: %d", cfg.Eligibility.WindowDays)
+		}
+		return commands.ReviewPolicy{
+			DuplicateThreshold: cfg.Moderation.DuplicateThreshold,
+			DuplicateLookback:  cfg.Moderation.DuplicateLookback,
+			ClockSkew:          cfg.Scheduling.ClockSkew,
+			Window:             domreview.Window{OpensAfter: cfg.Eligibility.OpensAfter, ClosesAfter: closesAfter},
+		}, nil
+	},
+	func(cfg config.Config) (commands.CursorCheckPolicy, error) {
+		if cfg.Maintenance.CursorClockSkew < 0 {
+			return commands.CursorCheckPolicy{}, fmt.Errorf("invalid MAINTENANCE_CURSOR_CLOCK_SKEW: %v", cfg.Maintenance.CursorClockSkew)
+		}
+		return commands.CursorCheckPolicy{ClockSkew: cfg.Maintenance.CursorClockSkew}, nil
+	},
+	shared.NewRetentionMetrics,
+	shared.NewLoginReplayMetrics,
+	shared.NewCanceledRequestMetrics,
+	func(clock clock.Clock, cfg config.Config, metrics *shared.LoginReplayMetrics) shared.LoginReplayCache {
+		return usecase.NewLoginReplayCache(clock, cfg.JWT.LoginReplayTTL, metrics)
+	},
+	func(cfg config.Config) shared.RetentionPolicies {
+		return shared.RetentionPolicies{
+			BatchSize: cfg.Retention.BatchSize,
+			Policies: []shared.RetentionPolicy{
+				{Table: shared.RetentionTableNotificationJobs, MaxAge: cfg.Retention.NotificationsMaxAge},
+				{Table: shared.RetentionTableIdempotencyKeys, MaxAge: cfg.Retention.IdempotencyMaxAge},
+				{Table: shared.RetentionTableAuditLogs, MaxAge: cfg.Retention.AuditLogsMaxAge},
+				{Table: shared.RetentionTableRefreshTokens, MaxAge: cfg.Retention.RefreshTokensMaxAge},
+				{Table: shared.RetentionTableRevokedTokens},
+				{Table: shared.RetentionTableEmailVerifications, MaxAge: cfg.Retention.UnverifiedUsersMaxAge},
+				{Table: shared.RetentionTableLoginFailures},
+				{Table: shared.RetentionTableUserSessions},
+			},
 		}
 	},
 )
@@ -35,7 +331,41 @@ var usecaseCommandsModule = fx.Module("usecase/commands",
 	fx.Provide(
 		commands.NewAuthCommands,
 		commands.NewReservationCommands,
+		commands.NewQuoteCommands,
 		commands.NewReviewCommands,
+		commands.NewRetentionCommands,
+		commands.NewRoleCommands,
+		commands.NewResourceOperatorCommands,
+		commands.NewInviteCommands,
+		commands.NewCompanyCommands,
+		commands.NewBrandingCommands,
+		commands.NewProvisioningCommands,
+		commands.NewSAMLCommands,
+		commands.NewOIDCCommands,
+		commands.NewSupportCommands,
+		commands.NewReferralCommands,
+		commands.NewLoyaltyCommands,
+		commands.NewTOSCommands,
+		commands.NewResourceBlockCommands,
+		commands.NewReservationMessageCommands,
+		commands.NewReservationAttachmentCommands,
+		commands.NewReservationTransferCommands,
+		commands.NewReservationApprovalCommands,
+		commands.NewCustomFieldCommands,
+		commands.NewReservationBulkCancelCommands,
+		commands.NewReviewSummaryCommands,
+		commands.NewPlanGuard,
+		commands.NewBillingCommands,
+		commands.NewAdminAccessCommands,
+		commands.NewFeatureCommands,
+		commands.NewCursorCommands,
+		commands.NewRequestRecordingCommands,
+		commands.NewCompanyExportCommands,
+		commands.NewCompanyDeletionCommands,
+		commands.NewPlatformStatsCommands,
+		commands.NewAPIKeyCommands,
+		commands.NewUserMergeCommands,
+		commands.NewNotificationDigestCommands,
 	),
 )
 
@@ -44,11 +374,80 @@ var usecaseQueriesModule = fx.Module("usecase/queries",
 		queries.NewUserQueries,
 		queries.NewReservationQueries,
 		queries.NewReviewQueries,
+		queries.NewRetentionQueries,
+		queries.NewRoleQueries,
+		queries.NewResourceOperatorQueries,
+		queries.NewInviteQueries,
+		queries.NewReferralQueries,
+		queries.NewLoyaltyQueries,
+		queries.NewSecurityEventQueries,
+		queries.NewActivityQueries,
+		queries.NewAuditLogQueries,
+		queries.NewAdminSearchQueries,
+		queries.NewNotificationJobQueries,
+		queries.NewResourceBlockQueries,
+		queries.NewReservationMessageQueries,
+		queries.NewReservationAttachmentQueries,
+		queries.NewUsageQueries,
+		queries.NewTelemetryQueries,
+		queries.NewDeprecationQueries,
+		queries.NewFeatureQueries,
+		queries.NewBrandingQueries,
+		queries.NewProvisioningQueries,
+		queries.NewSAMLQueries,
+		queries.NewReservationApprovalQueries,
+		queries.NewCustomFieldQueries,
+		queries.NewPublicResourceQueries,
+		queries.NewRequestRecordingQueries,
+		queries.NewCompanyExportQueries,
+		queries.NewCompanyDeletionQueries,
+		queries.NewPlatformStatsQueries,
+		queries.NewAPIKeyQueries,
+		queries.NewSessionQueries,
+		queries.NewNotificationPreferencesQueries,
 	),
 )
 
 var usecaseValidatorsModule = fx.Module("usecase/validators",
 	fx.Provide(
 		usecase.NewTokenValidator,
+		func(uow shared.UnitOfWork, store shared.RolePermissionReadStore, clock clock.Clock, cfg config.Config) shared.PermissionResolver {
+			return usecase.NewPermissionResolver(uow, store, clock, cfg.Authz.PermissionCacheTTL)
+		},
+		usecase.NewResourceAuthorizer,
+		func(uow shared.UnitOfWork, store shared.TOSReadStore, clock clock.Clock, cfg config.Config) shared.TOSGate {
+			return usecase.NewTOSGate(uow, store, clock, cfg.Authz.TOSCacheTTL)
+		},
+		func(uow shared.UnitOfWork, store shared.RefreshTokenReadStore, clock clock.Clock, cfg config.Config) shared.SessionGate {
+			return usecase.NewSessionGate(uow, store, clock, cfg.Authz.SessionCacheTTL)
+		},
+		usecase.NewUsageMeter,
+		usecase.NewDeprecationTracker,
+		func(uow shared.UnitOfWork, store shared.UsageReadStore, clock clock.Clock, cfg config.Config) shared.UsageQuotaGate {
+			return usecase.NewUsageQuotaGate(uow, store, clock, cfg.Usage.QuotaCacheTTL)
+		},
+		func(uow shared.UnitOfWork, store shared.RequestRecordingReadStore, repo shared.RequestRecordingRepository, storage shared.FileStorage, clock clock.Clock, cfg config.Config) shared.RequestRecorder {
+			return usecase.NewRequestRecorder(uow, store, repo, storage, clock, cfg.Recording.CacheTTL)
+		},
+		func(uow shared.UnitOfWork, store shared.TelemetryReadStore, sink shared.AnalyticsSink, clock clock.Clock, cfg config.Config) shared.FeatureTelemetry {
+			return usecase.NewFeatureTelemetry(uow, store, sink, clock, cfg.Telemetry.Salt)
+		},
+		func(uow shared.UnitOfWork, store shared.FeatureReadStore, clock clock.Clock, cfg config.Config) (shared.FeatureFlags, error) {
+			for _, f := range cfg.Features.Defaults {
+				if !shared.IsFeature(f) {
+					return nil, fmt.Errorf("invalid FEATURES_DEFAULT_ON: unknown feature %q", f)
+				}
+			}
+			return usecase.NewFeatureFlags(uow, store, clock, cfg.Features.Defaults, cfg.Features.CacheTTL), nil
+		},
 	),
 )
+
+const maxClockSkew = 5 * time.Minute
+
+func validateClockSkew(skew time.Duration) error {
+	if skew < 0 || skew > maxClockSkew {
+		return fmt.Errorf("invalid SCHEDULING_CLOCK_SKEW: %s (want 0 to %s)", skew, maxClockSkew)
+	}
+	return nil
+}
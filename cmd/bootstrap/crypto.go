@@ -0,0 +1,35 @@
+package bootstrap
+
+import (
+	"fmt"
+	"log/slog"
+
+	"gin-clean-starter/internal/pkg/config"
+	"gin-clean-starter/internal/pkg/crypto"
+
+	"go.uber.org/fx"
+)
+
+var CryptoModule = fx.Module("crypto",
+	fx.Provide(
+		NewEnvelope,
+	),
+)
+
+// Without configured keys the envelope refuses to encrypt: reservation messages fall back
+// to plaintext and phone numbers cannot be stored.
+func NewEnvelope(cfg config.Config) (*crypto.Envelope, error) {
+	keys, err := crypto.ParseKeys(cfg.Crypto.Keys)
+	if err != nil {
+		return nil, fmt.Errorf("invalid CRYPTO_KEYS: %w", err)
+	}
+
+	envelope, err := crypto.NewEnvelope(keys, cfg.Crypto.ActiveKeyID)
+	if err != nil {
+		return nil, err
+	}
+	if !envelope.CanEncrypt() {
+		slog.Warn("No active column encryption key; reservation messages are stored in plaintext")
+	}
+	return envelope, nil
+}
@@ -0,0 +1,18 @@
+package bootstrap
+
+import (
+	"gin-clean-starter/internal/infra/email"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"go.uber.org/fx"
+)
+
+var EmailModule = fx.Module("email",
+	fx.Provide(
+		// Swap in a hosted template service here.
+		fx.Annotate(
+			email.NewTemplateRenderer,
+			fx.As(new(shared.EmailRenderer)),
+		),
+	),
+)
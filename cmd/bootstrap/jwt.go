@@ -1,6 +1,7 @@
 package bootstrap
 
 import (
+	"log/slog"
 	"time"
 
 	"gin-clean-starter/internal/pkg/config"
@@ -26,5 +27,25 @@ func NewJWTService(cfg config.Config) *jwt.Service {
 		panic("invalid JWT_REFRESH_TOKEN_DURATION: " + err.Error())
 	}
 
-	return jwt.NewService(cfg.JWT.Secret, accessTokenDuration, refreshTokenDuration)
+	until := cfg.JWT.RotatedAt.Add(cfg.JWT.RotationWindow)
+	previous, err := jwt.ParseKeys(cfg.JWT.PreviousKeys, until)
+	if err != nil {
+		panic("invalid JWT_PREVIOUS_KEYS: " + err.Error())
+	}
+	if len(previous) > 0 {
+		if cfg.JWT.RotatedAt.IsZero() {
+			panic("JWT_ROTATED_AT is required with JWT_PREVIOUS_KEYS")
+		}
+		for _, key := range previous {
+			if key.ID == cfg.JWT.KeyID {
+				panic("JWT_PREVIOUS_KEYS cannot reuse JWT_KEY_ID " + key.ID)
+			}
+		}
+		if !time.Now().Before(until) {
+			slog.Warn("JWT_PREVIOUS_KEYS are past the rotation window and no longer verify tokens; remove them")
+		}
+	}
+
+	signingKey := jwt.Key{ID: cfg.JWT.KeyID, Secret: []byte(cfg.JWT.Secret)}
+	return jwt.NewRotatingService(signingKey, previous, accessTokenDuration, refreshTokenDuration)
 }
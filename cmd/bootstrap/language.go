@@ -0,0 +1,18 @@
+package bootstrap
+
+import (
+	"gin-clean-starter/internal/infra/language"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"go.uber.org/fx"
+)
+
+var LanguageModule = fx.Module("language",
+	fx.Provide(
+		// Swap in a detection service here.
+		fx.Annotate(
+			language.NewHeuristicDetector,
+			fx.As(new(shared.LanguageDetector)),
+		),
+	),
+)
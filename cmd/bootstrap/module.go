@@ -11,7 +11,19 @@ var Module = fx.Options(
 	LoggerModule,
 	DBModule,
 	JWTModule,
+	SignerModule,
+	CryptoModule,
+	StorageModule,
+	SummaryModule,
+	LanguageModule,
+	EmailModule,
+	BillingModule,
+	SAMLModule,
+	OIDCModule,
+	AnalyticsModule,
+	SchedulerModule,
 	components.PersistenceModule,
 	components.UseCaseModule,
 	components.HandlerModule,
+	components.JobsModule,
 )
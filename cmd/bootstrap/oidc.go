@@ -0,0 +1,58 @@
+package bootstrap
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"gin-clean-starter/internal/pkg/config"
+	"gin-clean-starter/internal/pkg/oauth"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"go.uber.org/fx"
+)
+
+const (
+	googleProvider = "google"
+	googleIssuer   = "https://accounts.google.com"
+	// oidcTimeout bounds each call to a provider, which a sign-in waits on.
+	oidcTimeout = 10 * time.Second
+)
+
+var OIDCModule = fx.Module("oidc",
+	fx.Provide(newOIDCProviders),
+)
+
+// newOIDCProviders builds the providers the config sets up; with none, every sign-in URL
+// is not found.
+func newOIDCProviders(cfg config.Config) (shared.OIDCProviders, error) {
+	client := &http.Client{Timeout: oidcTimeout}
+	providers := shared.OIDCProviders{}
+	add := func(name, issuer, clientID, clientSecret string) {
+		providers[name] = oauth.NewProvider(oauth.Config{
+			Issuer:       issuer,
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			RedirectURL:  strings.TrimSuffix(cfg.OIDC.BaseURL, "/") + "/api/auth/oidc/" + name + "/callback",
+		}, client)
+	}
+
+	if cfg.OIDC.GoogleClientID != "" {
+		add(googleProvider, googleIssuer, cfg.OIDC.GoogleClientID, cfg.OIDC.GoogleClientSecret)
+	}
+	if cfg.OIDC.ProviderIssuer != "" {
+		name := cfg.OIDC.ProviderName
+		if name == "" || strings.ContainsAny(name, "/?#") {
+			return nil, fmt.Errorf("invalid OIDC_PROVIDER_NAME: %q", name)
+		}
+		if _, taken := providers[name]; taken {
+			return nil, fmt.Errorf("OIDC_PROVIDER_NAME %q is taken by a built-in provider", name)
+		}
+		if cfg.OIDC.ProviderClientID == "" {
+			return nil, fmt.Errorf("OIDC_PROVIDER_CLIENT_ID is required with OIDC_PROVIDER_ISSUER")
+		}
+		add(name, cfg.OIDC.ProviderIssuer, cfg.OIDC.ProviderClientID, cfg.OIDC.ProviderClientSecret)
+	}
+	return providers, nil
+}
@@ -0,0 +1,20 @@
+package bootstrap
+
+import (
+	"gin-clean-starter/internal/infra/sso"
+	"gin-clean-starter/internal/pkg/config"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"go.uber.org/fx"
+)
+
+var SAMLModule = fx.Module("saml",
+	fx.Provide(
+		fx.Annotate(
+			func(cfg config.Config) *sso.SAMLProvider {
+				return sso.NewSAMLProvider(cfg.SAML.BaseURL, cfg.SAML.AllowIDPInitiated)
+			},
+			fx.As(new(shared.SAMLServiceProvider)),
+		),
+	),
+)
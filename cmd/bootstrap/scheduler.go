@@ -0,0 +1,32 @@
+package bootstrap
+
+import (
+	"context"
+
+	"gin-clean-starter/internal/pkg/scheduler"
+
+	"go.uber.org/fx"
+)
+
+var SchedulerModule = fx.Module("scheduler",
+	fx.Provide(
+		NewScheduler,
+	),
+)
+
+// Jobs are registered from fx.Invoke before OnStart fires, so Start sees all of them.
+func NewScheduler(lc fx.Lifecycle) *scheduler.Scheduler {
+	s := scheduler.New()
+
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			s.Start()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			return s.Stop(ctx)
+		},
+	})
+
+	return s
+}
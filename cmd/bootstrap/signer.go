@@ -0,0 +1,19 @@
+package bootstrap
+
+import (
+	"gin-clean-starter/internal/pkg/config"
+	"gin-clean-starter/internal/pkg/signedtoken"
+
+	"go.uber.org/fx"
+)
+
+var SignerModule = fx.Module("signer",
+	fx.Provide(
+		NewSigner,
+	),
+)
+
+// Reuses the JWT secret; signed tokens are domain-separated by purpose.
+func NewSigner(cfg config.Config) *signedtoken.Signer {
+	return signedtoken.NewSigner([]byte(cfg.JWT.Secret))
+}
@@ -0,0 +1,33 @@
+package bootstrap
+
+import (
+	"fmt"
+
+	"gin-clean-starter/internal/infra/storage"
+	"gin-clean-starter/internal/pkg/config"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"go.uber.org/fx"
+)
+
+var StorageModule = fx.Module("storage",
+	fx.Provide(
+		fx.Annotate(
+			NewFileStorage,
+			fx.As(new(shared.FileStorage)),
+		),
+		// Swap in a scanner backed by an antivirus service here.
+		fx.Annotate(
+			storage.NewNoopFileScanner,
+			fx.As(new(shared.FileScanner)),
+		),
+	),
+)
+
+func NewFileStorage(cfg config.Config) (*storage.LocalFileStorage, error) {
+	fs, err := storage.NewLocalFileStorage(cfg.Attachment.StorageDir)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ATTACHMENT_STORAGE_DIR: %w", err)
+	}
+	return fs, nil
+}
@@ -0,0 +1,18 @@
+package bootstrap
+
+import (
+	"gin-clean-starter/internal/infra/summary"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"go.uber.org/fx"
+)
+
+var SummaryModule = fx.Module("summary",
+	fx.Provide(
+		// Swap in an LLM-backed summarizer here.
+		fx.Annotate(
+			summary.NewTemplateSummarizer,
+			fx.As(new(shared.Summarizer)),
+		),
+	),
+)
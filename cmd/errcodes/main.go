@@ -0,0 +1,477 @@
+// Command errcodes regenerates the error code registry from the source tree.
+//
+//	go generate ./internal/pkg/errs   (or: go run ./cmd/errcodes [-check])
+//
+// It collects every errs.NewCoded sentinel and every errs.Code constant under internal/
+// and writes internal/pkg/errs/registry_gen.go and docs/error_codes.md. With -check it
+// writes nothing and exits 1 when either file is stale.
+//
+// The HTTP statuses of a code are read off the error mappings: a composite literal, call or
+// assignment that names both a declaration carrying the code and an HTTP status (such as
+// {commands.ErrX, http.StatusNotFound, ...} or httperr.AbortWithError(c, http.StatusConflict,
+// ErrY, ...)) reports the code with that status, and so does a reply under an if or case
+// that tests errors.Is(err, ErrX). Domain codes are never reported with 5xx.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	registryPath = "internal/pkg/errs/registry_gen.go"
+	docsPath     = "docs/error_codes.md"
+)
+
+type entry struct {
+	Code        string
+	Description string
+	Sources     []string
+	Statuses    []int
+	// domain codes come from errs.NewCoded and are only reported below 500.
+	domain bool
+}
+
+// parsedFile is a source file with the directory it sits in, relative to the walked root.
+type parsedFile struct {
+	dir  string
+	file *ast.File
+}
+
+func main() {
+	os.Exit(realMain())
+}
+
+func realMain() int {
+	check := flag.Bool("check", false, "report stale generated files instead of writing them")
+	flag.Parse()
+
+	root, err := findModuleRoot()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "errcodes:", err)
+		return 1
+	}
+	outputs, err := generate(root)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "errcodes:", err)
+		return 1
+	}
+
+	stale := false
+	for _, path := range []string{registryPath, docsPath} {
+		full := filepath.Join(root, path)
+		if *check {
+			current, _ := os.ReadFile(full)
+			if !bytes.Equal(current, outputs[path]) {
+				fmt.Fprintf(os.Stderr, "errcodes: %s is stale; run go generate ./internal/pkg/errs\n", path)
+				stale = true
+			}
+			continue
+		}
+		if err := os.WriteFile(full, outputs[path], 0o644); err != nil {
+			fmt.Fprintln(os.Stderr, "errcodes:", err)
+			return 1
+		}
+	}
+	if stale {
+		return 1
+	}
+	return 0
+}
+
+// generate returns the contents of every generated file keyed by repo-relative path.
+func generate(root string) (map[string][]byte, error) {
+	entries, err := collect(filepath.Join(root, "internal"))
+	if err != nil {
+		return nil, err
+	}
+	src, err := renderGo(entries)
+	if err != nil {
+		return nil, err
+	}
+	return map[string][]byte{
+		registryPath: src,
+		docsPath:     renderMarkdown(entries),
+	}, nil
+}
+
+func collect(dir string) ([]entry, error) {
+	byCode := map[string]*entry{}
+	bySource := map[string]*entry{}
+	add := func(code, desc, source string, domain bool) {
+		e, ok := byCode[code]
+		if !ok {
+			e = &entry{Code: code, Description: desc, domain: domain}
+			byCode[code] = e
+		}
+		e.Sources = append(e.Sources, source)
+		bySource[source] = e
+	}
+
+	fset := token.NewFileSet()
+	var files []parsedFile
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		if !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") || strings.HasSuffix(path, "_gen.go") {
+			return nil
+		}
+		file, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, filepath.Dir(path))
+		if err != nil {
+			return err
+		}
+		files = append(files, parsedFile{dir: filepath.ToSlash(rel), file: file})
+		return collectFile(fset, file, add)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := newStatusResolver(files)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		r.collectStatuses(f.file, bySource)
+	}
+
+	entries := make([]entry, 0, len(byCode))
+	for _, e := range byCode {
+		sort.Strings(e.Sources)
+		sort.Ints(e.Statuses)
+		entries = append(entries, *e)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Code < entries[j].Code })
+	return entries, nil
+}
+
+func collectFile(fset *token.FileSet, file *ast.File, add func(code, desc, source string, domain bool)) error {
+	pkg := file.Name.Name
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || (gen.Tok != token.VAR && gen.Tok != token.CONST) {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			vs := spec.(*ast.ValueSpec)
+			for i, name := range vs.Names {
+				if i >= len(vs.Values) {
+					continue
+				}
+				source := pkg + "." + name.Name
+
+				// var ErrX = errs.NewCoded("CODE", "message")
+				if call, ok := vs.Values[i].(*ast.CallExpr); ok && isSelector(call.Fun, "errs", "NewCoded") {
+					if len(call.Args) != 2 {
+						return fmt.Errorf("%s: NewCoded takes a code and a message", fset.Position(call.Pos()))
+					}
+					code, cok := stringLit(call.Args[0])
+					msg, mok := stringLit(call.Args[1])
+					if !cok || !mok {
+						return fmt.Errorf("%s: NewCoded arguments must be string literals", fset.Position(call.Pos()))
+					}
+					add(code, msg, source, true)
+					continue
+				}
+
+				// const CodeX errs.Code = "CODE" // description
+				if gen.Tok == token.CONST && vs.Type != nil && isSelector(vs.Type, "errs", "Code") {
+					code, ok := stringLit(vs.Values[i])
+					if !ok {
+						return fmt.Errorf("%s: errs.Code constants must be string literals", fset.Position(vs.Pos()))
+					}
+					desc := strings.TrimSpace(vs.Comment.Text())
+					if desc == "" {
+						return fmt.Errorf("%s: errs.Code constant %s needs a trailing comment describing it", fset.Position(vs.Pos()), name.Name)
+					}
+					add(code, desc, source, false)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// statusResolver names the declarations and HTTP statuses an expression refers to.
+type statusResolver struct {
+	// packages maps a directory relative to the walked root to its package name.
+	packages map[string]string
+	// statuses holds net/http's Status constants as http.Name and the tree's own int
+	// Status constants as package.Name.
+	statuses map[string]int
+}
+
+func newStatusResolver(files []parsedFile) (*statusResolver, error) {
+	r := &statusResolver{packages: map[string]string{}, statuses: map[string]int{}}
+	nethttp, err := importer.Default().Import("net/http")
+	if err != nil {
+		return nil, fmt.Errorf("load net/http: %w", err)
+	}
+	for _, name := range nethttp.Scope().Names() {
+		if c, ok := nethttp.Scope().Lookup(name).(*types.Const); ok && strings.HasPrefix(name, "Status") {
+			if v, exact := constant.Int64Val(c.Val()); exact {
+				r.statuses["http."+name] = int(v)
+			}
+		}
+	}
+
+	for _, f := range files {
+		pkg := f.file.Name.Name
+		r.packages[f.dir] = pkg
+		// const StatusX = 499
+		for _, decl := range f.file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.CONST {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				vs := spec.(*ast.ValueSpec)
+				for i, name := range vs.Names {
+					if i >= len(vs.Values) || !strings.HasPrefix(name.Name, "Status") {
+						continue
+					}
+					if lit, ok := vs.Values[i].(*ast.BasicLit); ok && lit.Kind == token.INT {
+						if v, err := strconv.Atoi(lit.Value); err == nil {
+							r.statuses[pkg+"."+name.Name] = v
+						}
+					}
+				}
+			}
+		}
+	}
+	return r, nil
+}
+
+// collectStatuses adds the statuses that file maps declarations of bySource to.
+func (r *statusResolver) collectStatuses(file *ast.File, bySource map[string]*entry) {
+	pkg := file.Name.Name
+	imports := map[string]string{} // local name -> package name
+	for _, imp := range file.Imports {
+		importPath, _ := strconv.Unquote(imp.Path.Value)
+		name := r.packageOf(importPath)
+		local := name
+		if imp.Name != nil {
+			local = imp.Name.Name
+		}
+		imports[local] = name
+	}
+	qualified := func(expr ast.Expr) string {
+		switch e := expr.(type) {
+		case *ast.Ident:
+			return pkg + "." + e.Name
+		case *ast.SelectorExpr:
+			if id, ok := e.X.(*ast.Ident); ok {
+				if name, ok := imports[id.Name]; ok {
+					return name + "." + e.Sel.Name
+				}
+			}
+		}
+		return ""
+	}
+
+	names := func(exprs []ast.Expr) []string {
+		out := make([]string, len(exprs))
+		for i, expr := range exprs {
+			out[i] = qualified(expr)
+		}
+		return out
+	}
+	record := func(group []string) {
+		var statuses []int
+		var targets []*entry
+		for _, name := range group {
+			if status, ok := r.statuses[name]; ok && status >= 400 {
+				statuses = append(statuses, status)
+			}
+			if e, ok := bySource[name]; ok {
+				targets = append(targets, e)
+			}
+		}
+		for _, e := range targets {
+			for _, status := range statuses {
+				if (e.domain && status >= 500) || slices.Contains(e.Statuses, status) {
+					continue
+				}
+				e.Statuses = append(e.Statuses, status)
+			}
+		}
+	}
+
+	// guarded pairs the errors.Is targets of a condition with the statuses its block replies with.
+	guarded := func(conds []ast.Expr, body []ast.Stmt) {
+		var group []string
+		for _, cond := range conds {
+			ast.Inspect(cond, func(n ast.Node) bool {
+				if call, ok := n.(*ast.CallExpr); ok && isSelector(call.Fun, "errors", "Is") && len(call.Args) == 2 {
+					group = append(group, qualified(call.Args[1]))
+				}
+				return true
+			})
+		}
+		if len(group) == 0 {
+			return
+		}
+		for _, stmt := range body {
+			ast.Inspect(stmt, func(n ast.Node) bool {
+				switch n := n.(type) {
+				case *ast.IfStmt, *ast.SwitchStmt, *ast.TypeSwitchStmt, *ast.FuncLit:
+					return false
+				case *ast.CallExpr:
+					group = append(group, names(n.Args)...)
+				}
+				return true
+			})
+		}
+		record(group)
+	}
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.CompositeLit:
+			var fields []ast.Expr
+			for _, elt := range n.Elts {
+				kv, ok := elt.(*ast.KeyValueExpr)
+				switch {
+				case !ok:
+					fields = append(fields, elt)
+				case isIdent(kv.Key):
+					// struct field
+					fields = append(fields, kv.Value)
+				default:
+					// map entry such as http.StatusNotFound: CodeNotFound
+					record(names([]ast.Expr{kv.Key, kv.Value}))
+				}
+			}
+			record(names(fields))
+		case *ast.CallExpr:
+			record(names(n.Args))
+		case *ast.AssignStmt:
+			record(names(n.Rhs))
+		case *ast.IfStmt:
+			// if errors.Is(err, ErrX) { httperr.AbortWithError(c, http.StatusNotFound, ...) }
+			guarded([]ast.Expr{n.Cond}, n.Body.List)
+		case *ast.CaseClause:
+			// case errors.Is(err, ErrX): httperr.AbortWithError(c, http.StatusNotFound, ...)
+			guarded(n.List, n.Body)
+		}
+		return true
+	})
+}
+
+// packageOf is the name of the package at importPath, or its last element when the
+// package is outside the walked tree.
+func (r *statusResolver) packageOf(importPath string) string {
+	for dir, name := range r.packages {
+		if dir != "." && strings.HasSuffix(importPath, "/"+dir) {
+			return name
+		}
+	}
+	return path.Base(importPath)
+}
+
+func isIdent(expr ast.Expr) bool {
+	_, ok := expr.(*ast.Ident)
+	return ok
+}
+
+func isSelector(expr ast.Expr, pkg, name string) bool {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != name {
+		return false
+	}
+	id, ok := sel.X.(*ast.Ident)
+	return ok && id.Name == pkg
+}
+
+func stringLit(expr ast.Expr) (string, bool) {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	s, err := strconv.Unquote(lit.Value)
+	return s, err == nil
+}
+
+func renderGo(entries []entry) ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteString("// Code generated by cmd/errcodes; DO NOT EDIT.\n\n")
+	b.WriteString("package errs\n\n")
+	b.WriteString("// Registry lists every code the API can report, sorted by code.\n")
+	b.WriteString("var Registry = []CodeInfo{\n")
+	for _, e := range entries {
+		fmt.Fprintf(&b, "\t{Code: %q, Description: %q, Statuses: []int{", e.Code, e.Description)
+		for i, status := range e.Statuses {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			fmt.Fprintf(&b, "%d", status)
+		}
+		b.WriteString("}, Sources: []string{")
+		for i, s := range e.Sources {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			fmt.Fprintf(&b, "%q", s)
+		}
+		b.WriteString("}},\n")
+	}
+	b.WriteString("}\n")
+	return format.Source(b.Bytes())
+}
+
+func renderMarkdown(entries []entry) []byte {
+	var b bytes.Buffer
+	b.WriteString("<!-- Code generated by cmd/errcodes; DO NOT EDIT. -->\n\n")
+	b.WriteString("# API error codes\n\n")
+	b.WriteString("Every error response carries `error.code`. Codes are stable; messages are not.\n")
+	b.WriteString("Server errors (5xx) always report a generic code. The same catalog is served at\n")
+	b.WriteString("`GET /api/meta/errors`.\n\n")
+	b.WriteString("| Code | Status | Description | Declared in |\n")
+	b.WriteString("| --- | --- | --- | --- |\n")
+	for _, e := range entries {
+		statuses := make([]string, len(e.Statuses))
+		for i, status := range e.Statuses {
+			statuses[i] = strconv.Itoa(status)
+		}
+		fmt.Fprintf(&b, "| `%s` | %s | %s | %s |\n", e.Code, strings.Join(statuses, ", "), e.Description, "`"+strings.Join(e.Sources, "`, `")+"`")
+	}
+	return b.Bytes()
+}
+
+func findModuleRoot() (string, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+	for {
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return dir, nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", fmt.Errorf("go.mod not found")
+		}
+		dir = parent
+	}
+}
@@ -0,0 +1,110 @@
+//go:build unit
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratedFilesAreUpToDate(t *testing.T) {
+	root, err := findModuleRoot()
+	require.NoError(t, err)
+
+	outputs, err := generate(root)
+	require.NoError(t, err)
+
+	for path, want := range outputs {
+		got, err := os.ReadFile(filepath.Join(root, path))
+		require.NoError(t, err)
+		assert.Equal(t, string(want), string(got), "%s is stale; run go generate ./internal/pkg/errs", path)
+	}
+}
+
+func TestCollectRejectsNonLiteralCodes(t *testing.T) {
+	dir := t.TempDir()
+	src := `package x
+
+import "gin-clean-starter/internal/pkg/errs"
+
+const code = "DYNAMIC"
+
+var ErrX = errs.NewCoded(code, "x")
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "x.go"), []byte(src), 0o644))
+
+	_, err := collect(dir)
+	assert.ErrorContains(t, err, "string literals")
+}
+
+func TestCollectStatuses(t *testing.T) {
+	dir := t.TempDir()
+	src := `package x
+
+import (
+	"errors"
+	"net/http"
+
+	"gin-clean-starter/internal/pkg/errs"
+)
+
+const StatusGone = 499
+
+const (
+	CodeTeapot errs.Code = "TEAPOT" // short and stout
+	CodeServer errs.Code = "SERVER" // server failure
+)
+
+var (
+	ErrMissing  = errs.NewCoded("MISSING", "missing")
+	ErrTaken    = errs.NewCoded("TAKEN", "taken")
+	ErrBroken   = errs.NewCoded("BROKEN", "broken")
+	ErrUnmapped = errs.NewCoded("UNMAPPED", "unmapped")
+)
+
+var rules = []struct {
+	err    error
+	status int
+}{
+	{ErrMissing, http.StatusNotFound},
+	{ErrBroken, http.StatusBadGateway},
+}
+
+var codes = map[int]errs.Code{
+	http.StatusTeapot:              CodeTeapot,
+	http.StatusInternalServerError: CodeServer,
+}
+
+func reply(err error, abort func(int, error)) {
+	switch {
+	case errors.Is(err, ErrTaken):
+		abort(http.StatusConflict, err)
+	default:
+		abort(StatusGone, ErrMissing)
+	}
+	if errors.Is(err, ErrTaken) {
+		abort(http.StatusGone, err)
+	}
+}
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "x.go"), []byte(src), 0o644))
+
+	entries, err := collect(dir)
+	require.NoError(t, err)
+	statuses := map[string][]int{}
+	for _, e := range entries {
+		statuses[e.Code] = e.Statuses
+	}
+	assert.Equal(t, map[string][]int{
+		"MISSING":  {404, 499},
+		"TAKEN":    {409, 410},
+		"BROKEN":   nil, // domain codes are never reported with 5xx
+		"UNMAPPED": nil,
+		"TEAPOT":   {418},
+		"SERVER":   {500},
+	}, statuses)
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	reqdto "gin-clean-starter/internal/handler/dto/request"
+	resdto "gin-clean-starter/internal/handler/dto/response"
+
+	"github.com/google/uuid"
+)
+
+// Client is a typed HTTP client for the endpoints exercised by the scenarios.
+// Request and response bodies reuse the handler DTOs so the runner breaks at
+// compile time when the API contract changes.
+type Client struct {
+	baseURL string
+	http    *http.Client
+}
+
+// StatusError is returned for any response outside the expected 2xx status.
+type StatusError struct {
+	Status int
+	Body   string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("unexpected status %d: %s", e.Status, e.Body)
+}
+
+type reviewPage struct {
+	Reviews    []*resdto.ReviewListItemResponse `json:"reviews"`
+	NextCursor string                           `json:"nextCursor"`
+}
+
+func NewClient(baseURL string, timeout time.Duration, conns int) *Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConns = conns
+	transport.MaxIdleConnsPerHost = conns
+
+	return &Client{
+		baseURL: baseURL,
+		http:    &http.Client{Timeout: timeout, Transport: transport},
+	}
+}
+
+// Token uses the body-token endpoint, so the target must run with JWT_BODY_TOKENS_ENABLED=true.
+func (c *Client) Token(ctx context.Context, email, password string) (*resdto.TokenResponse, error) {
+	var out resdto.TokenResponse
+	err := c.do(ctx, http.MethodPost, "/api/auth/token", nil, reqdto.LoginRequest{Email: email, Password: password}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) CreateReservation(ctx context.Context, accessToken string, idempotencyKey uuid.UUID, req reqdto.CreateReservationRequest) (*resdto.ReservationResponse, error) {
+	headers := http.Header{}
+	headers.Set("Authorization", "Bearer "+accessToken)
+	headers.Set("Idempotency-Key", idempotencyKey.String())
+
+	var out resdto.ReservationResponse
+	if err := c.do(ctx, http.MethodPost, "/api/reservations", headers, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) ListResourceReviews(ctx context.Context, resourceID uuid.UUID, after string, limit int) (*reviewPage, error) {
+	q := url.Values{}
+	q.Set("limit", strconv.Itoa(limit))
+	if after != "" {
+		q.Set("after", after)
+	}
+
+	var out reviewPage
+	path := "/api/resources/" + resourceID.String() + "/reviews?" + q.Encode()
+	if err := c.do(ctx, http.MethodGet, path, nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) do(ctx context.Context, method, path string, headers http.Header, body, out any) error {
+	var reader io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("encode request: %w", err)
+		}
+		reader = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reader)
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	for k, vs := range headers {
+		req.Header[k] = vs
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return &StatusError{Status: resp.StatusCode, Body: string(bytes.TrimSpace(msg))}
+	}
+	if out == nil {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+// Command loadtest drives built-in scenarios against a running API and reports
+// latency percentiles against an error budget.
+//
+//	go run ./cmd/loadtest -target http://localhost:8888 -scenario reserve -resource <uuid>
+//
+// The target must have JWT_BODY_TOKENS_ENABLED=true. The exit status is 1 when
+// any scenario breaks its budget, so runs can gate CI or compare branches.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func main() {
+	os.Exit(realMain())
+}
+
+func realMain() int {
+	var (
+		target      = flag.String("target", "http://localhost:8888", "base URL of the API")
+		scenarioArg = flag.String("scenario", "all", "scenario to run: "+scenarioNames()+" or all")
+		duration    = flag.Duration("duration", 30*time.Second, "load duration per scenario")
+		concurrency = flag.Int("concurrency", 20, "number of concurrent virtual users")
+		timeout     = flag.Duration("timeout", 5*time.Second, "per-request timeout")
+		email       = flag.String("email", "test@example.com", "login email")
+		password    = flag.String("password", "password123", "login password")
+		resource    = flag.String("resource", "", "resource ID used by the reserve and reviews scenarios")
+		slots       = flag.Int("slots", 50, "number of one-hour slots contended by the reserve scenario")
+		pageSize    = flag.Int("page-size", 20, "page size for the reviews scenario")
+		p99Budget   = flag.Duration("p99", 500*time.Millisecond, "p99 latency budget (0 disables)")
+		errBudget   = flag.Float64("error-budget", 0.01, "maximum fraction of unexpected failures (0 disables)")
+	)
+	flag.Parse()
+
+	opts := Options{
+		Email:    *email,
+		Password: *password,
+		Slots:    *slots,
+		PageSize: *pageSize,
+	}
+	if *resource != "" {
+		id, err := uuid.Parse(*resource)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "invalid -resource: %v\n", err)
+			return 2
+		}
+		opts.ResourceID = id
+	}
+	if *concurrency < 1 || *slots < 1 || *pageSize < 1 {
+		fmt.Fprintln(os.Stderr, "-concurrency, -slots and -page-size must be positive")
+		return 2
+	}
+
+	selected, err := selectScenarios(*scenarioArg, opts.ResourceID != uuid.Nil)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	client := NewClient(strings.TrimRight(*target, "/"), *timeout, *concurrency)
+	budget := Budget{P99: *p99Budget, ErrorRate: *errBudget}
+
+	failed := false
+	for _, sc := range selected {
+		fmt.Printf("running %s for %s with %d users: %s\n", sc.Name, *duration, *concurrency, sc.Description)
+
+		w, err := sc.Setup(ctx, client, opts)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s setup failed: %v\n", sc.Name, err)
+			return 1
+		}
+
+		rec := NewRecorder()
+		elapsed := run(ctx, w, *concurrency, *duration, rec)
+		summary := rec.Summarize(sc.Name, elapsed, budget)
+		summary.Print(os.Stdout)
+		fmt.Println()
+
+		if len(summary.Violation) > 0 {
+			failed = true
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	if failed {
+		return 1
+	}
+	return 0
+}
+
+// selectScenarios resolves -scenario. "all" skips the resource-bound scenarios
+// when no resource was given instead of failing their setup.
+func selectScenarios(arg string, haveResource bool) ([]Scenario, error) {
+	if arg != "all" {
+		var out []Scenario
+		for _, name := range strings.Split(arg, ",") {
+			sc, ok := findScenario(strings.TrimSpace(name))
+			if !ok {
+				return nil, fmt.Errorf("unknown scenario %q (available: %s)", name, scenarioNames())
+			}
+			out = append(out, sc)
+		}
+		return out, nil
+	}
+
+	var out []Scenario
+	for _, sc := range scenarios {
+		if sc.NeedsResource && !haveResource {
+			fmt.Fprintf(os.Stderr, "skipping %s: -resource not set\n", sc.Name)
+			continue
+		}
+		out = append(out, sc)
+	}
+	return out, nil
+}
+
+func scenarioNames() string {
+	names := make([]string, len(scenarios))
+	for i, s := range scenarios {
+		names[i] = s.Name
+	}
+	return strings.Join(names, ", ")
+}
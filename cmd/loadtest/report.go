@@ -0,0 +1,160 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"sync"
+	"time"
+)
+
+// outcome classifies a single request. Expected failures (e.g. 409 on a contended
+// slot) are part of the scenario and do not consume the error budget.
+type outcome int
+
+const (
+	outcomeOK outcome = iota
+	outcomeExpected
+	outcomeError
+)
+
+// Budget is the pass/fail threshold for a scenario run; zero values disable a check.
+type Budget struct {
+	P99       time.Duration
+	ErrorRate float64 // fraction of requests, 0.01 = 1%
+}
+
+type Recorder struct {
+	mu        sync.Mutex
+	latencies []time.Duration
+	expected  int
+	errors    int
+	statuses  map[string]int
+}
+
+func NewRecorder() *Recorder {
+	return &Recorder{statuses: make(map[string]int)}
+}
+
+func (r *Recorder) Record(latency time.Duration, o outcome, label string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.latencies = append(r.latencies, latency)
+	r.statuses[label]++
+	switch o {
+	case outcomeExpected:
+		r.expected++
+	case outcomeError:
+		r.errors++
+	}
+}
+
+type Summary struct {
+	Scenario  string
+	Requests  int
+	Expected  int
+	Errors    int
+	Elapsed   time.Duration
+	P50       time.Duration
+	P90       time.Duration
+	P95       time.Duration
+	P99       time.Duration
+	Max       time.Duration
+	Statuses  map[string]int
+	Violation []string
+}
+
+func (s Summary) ErrorRate() float64 {
+	if s.Requests == 0 {
+		return 0
+	}
+	return float64(s.Errors) / float64(s.Requests)
+}
+
+func (s Summary) Throughput() float64 {
+	if s.Elapsed <= 0 {
+		return 0
+	}
+	return float64(s.Requests) / s.Elapsed.Seconds()
+}
+
+func (r *Recorder) Summarize(scenario string, elapsed time.Duration, budget Budget) Summary {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	sorted := slices.Clone(r.latencies)
+	slices.Sort(sorted)
+
+	s := Summary{
+		Scenario: scenario,
+		Requests: len(sorted),
+		Expected: r.expected,
+		Errors:   r.errors,
+		Elapsed:  elapsed,
+		P50:      percentile(sorted, 50),
+		P90:      percentile(sorted, 90),
+		P95:      percentile(sorted, 95),
+		P99:      percentile(sorted, 99),
+		Statuses: make(map[string]int, len(r.statuses)),
+	}
+	if len(sorted) > 0 {
+		s.Max = sorted[len(sorted)-1]
+	}
+	for k, v := range r.statuses {
+		s.Statuses[k] = v
+	}
+
+	if s.Requests == 0 {
+		s.Violation = append(s.Violation, "no requests completed")
+	}
+	if budget.P99 > 0 && s.P99 > budget.P99 {
+		s.Violation = append(s.Violation, fmt.Sprintf("p99 %s exceeds budget %s", s.P99, budget.P99))
+	}
+	if budget.ErrorRate > 0 && s.ErrorRate() > budget.ErrorRate {
+		s.Violation = append(s.Violation, fmt.Sprintf("error rate %.2f%% exceeds budget %.2f%%", s.ErrorRate()*100, budget.ErrorRate*100))
+	}
+	return s
+}
+
+// percentile uses the nearest-rank method on an ascending slice.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+func (s Summary) Print(w io.Writer) {
+	fmt.Fprintf(w, "== %s ==\n", s.Scenario)
+	fmt.Fprintf(w, "requests  %d in %s (%.1f req/s)\n", s.Requests, s.Elapsed.Round(time.Millisecond), s.Throughput())
+	fmt.Fprintf(w, "latency   p50=%s p90=%s p95=%s p99=%s max=%s\n",
+		round(s.P50), round(s.P90), round(s.P95), round(s.P99), round(s.Max))
+	fmt.Fprintf(w, "outcomes  errors=%d (%.2f%%) expected=%d\n", s.Errors, s.ErrorRate()*100, s.Expected)
+
+	labels := make([]string, 0, len(s.Statuses))
+	for k := range s.Statuses {
+		labels = append(labels, k)
+	}
+	slices.Sort(labels)
+	for _, k := range labels {
+		fmt.Fprintf(w, "  %-10s %d\n", k, s.Statuses[k])
+	}
+
+	if len(s.Violation) == 0 {
+		fmt.Fprintln(w, "budget    PASS")
+		return
+	}
+	for _, v := range s.Violation {
+		fmt.Fprintf(w, "budget    FAIL: %s\n", v)
+	}
+}
+
+func round(d time.Duration) time.Duration {
+	return d.Round(100 * time.Microsecond)
+}
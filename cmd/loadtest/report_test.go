@@ -0,0 +1,90 @@
+//go:build unit
+
+package main
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPercentile(t *testing.T) {
+	sorted := make([]time.Duration, 100)
+	for i := range sorted {
+		sorted[i] = time.Duration(i+1) * time.Millisecond
+	}
+
+	assert.Equal(t, 50*time.Millisecond, percentile(sorted, 50))
+	assert.Equal(t, 99*time.Millisecond, percentile(sorted, 99))
+	assert.Equal(t, 100*time.Millisecond, percentile(sorted, 100))
+	assert.Equal(t, 1*time.Millisecond, percentile(sorted, 0))
+	assert.Equal(t, time.Duration(0), percentile(nil, 99))
+}
+
+func TestSummarizeBudget(t *testing.T) {
+	newRecorder := func(errs int) *Recorder {
+		rec := NewRecorder()
+		for i := range 100 {
+			o, label := outcomeOK, "201"
+			switch {
+			case i < errs:
+				o, label = outcomeError, "500"
+			case i < errs+30:
+				o, label = outcomeExpected, "409"
+			}
+			rec.Record(time.Duration(i+1)*time.Millisecond, o, label)
+		}
+		return rec
+	}
+
+	t.Run("expected failures do not consume the error budget", func(t *testing.T) {
+		s := newRecorder(0).Summarize("reserve", time.Second, Budget{P99: time.Second, ErrorRate: 0.01})
+
+		assert.Empty(t, s.Violation)
+		assert.Equal(t, 30, s.Expected)
+		assert.Equal(t, map[string]int{"201": 70, "409": 30}, s.Statuses)
+		assert.InDelta(t, 100.0, s.Throughput(), 0.001)
+	})
+
+	t.Run("error rate over budget", func(t *testing.T) {
+		s := newRecorder(2).Summarize("reserve", time.Second, Budget{ErrorRate: 0.01})
+
+		assert.InDelta(t, 0.02, s.ErrorRate(), 0.0001)
+		assert.Len(t, s.Violation, 1)
+	})
+
+	t.Run("p99 over budget", func(t *testing.T) {
+		s := newRecorder(0).Summarize("reserve", time.Second, Budget{P99: 50 * time.Millisecond})
+
+		assert.Len(t, s.Violation, 1)
+	})
+
+	t.Run("empty run fails", func(t *testing.T) {
+		s := NewRecorder().Summarize("reserve", time.Second, Budget{})
+
+		assert.Equal(t, []string{"no requests completed"}, s.Violation)
+	})
+}
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		wantOut   outcome
+		wantLabel string
+	}{
+		{"success", nil, outcomeOK, "201"},
+		{"expected conflict", &StatusError{Status: 409}, outcomeExpected, "409"},
+		{"unexpected status", &StatusError{Status: 500}, outcomeError, "500"},
+		{"transport error", errors.New("connection refused"), outcomeError, "transport"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o, label := classify(tt.err, 201, 409)
+			assert.Equal(t, tt.wantOut, o)
+			assert.Equal(t, tt.wantLabel, label)
+		})
+	}
+}
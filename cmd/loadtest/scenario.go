@@ -0,0 +1,170 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	reqdto "gin-clean-starter/internal/handler/dto/request"
+
+	"github.com/google/uuid"
+)
+
+// Options carries the flags shared by every scenario.
+type Options struct {
+	Email      string
+	Password   string
+	ResourceID uuid.UUID
+	Slots      int
+	PageSize   int
+}
+
+// step issues one request and classifies it; the runner measures its latency.
+type step func(ctx context.Context) (outcome, string)
+
+// worker returns the step loop of one virtual user. Per-user state such as a
+// pagination cursor lives in the closure.
+type worker func(id int) step
+
+type Scenario struct {
+	Name        string
+	Description string
+	// NeedsResource scenarios target -resource; "all" skips them when it is unset.
+	NeedsResource bool
+	// Setup runs once before the load phase and returns the worker factory.
+	Setup func(ctx context.Context, c *Client, opts Options) (worker, error)
+}
+
+var scenarios = []Scenario{
+	{
+		Name:        "login",
+		Description: "login storm: every iteration exchanges credentials for tokens (bcrypt-bound)",
+		Setup:       setupLogin,
+	},
+	{
+		Name:          "reserve",
+		Description:   "reservation create against a small pool of slots, so most requests hit the overlap constraint",
+		NeedsResource: true,
+		Setup:         setupReserve,
+	},
+	{
+		Name:          "reviews",
+		Description:   "public review listing, walking keyset pages of one resource",
+		NeedsResource: true,
+		Setup:         setupReviews,
+	},
+}
+
+func findScenario(name string) (Scenario, bool) {
+	for _, s := range scenarios {
+		if s.Name == name {
+			return s, true
+		}
+	}
+	return Scenario{}, false
+}
+
+func setupLogin(_ context.Context, c *Client, opts Options) (worker, error) {
+	return func(int) step {
+		return func(ctx context.Context) (outcome, string) {
+			_, err := c.Token(ctx, opts.Email, opts.Password)
+			return classify(err, http.StatusOK)
+		}
+	}, nil
+}
+
+func setupReserve(ctx context.Context, c *Client, opts Options) (worker, error) {
+	if opts.ResourceID == uuid.Nil {
+		return nil, errors.New("reserve scenario requires -resource")
+	}
+	tokens, err := c.Token(ctx, opts.Email, opts.Password)
+	if err != nil {
+		return nil, fmt.Errorf("login: %w", err)
+	}
+
+	// Slots start at a random week well in the future so repeated runs do not
+	// collide with reservations left behind by earlier ones.
+	base := time.Now().UTC().Truncate(time.Hour).AddDate(0, 0, 30+7*rand.IntN(520))
+
+	return func(int) step {
+		return func(ctx context.Context) (outcome, string) {
+			start := base.Add(time.Duration(rand.IntN(opts.Slots)) * time.Hour)
+			_, err := c.CreateReservation(ctx, tokens.AccessToken, uuid.New(), reqdto.CreateReservationRequest{
+				ResourceID: opts.ResourceID,
+				StartTime:  start,
+				EndTime:    start.Add(time.Hour),
+			})
+			return classify(err, http.StatusCreated, http.StatusConflict)
+		}
+	}, nil
+}
+
+func setupReviews(_ context.Context, c *Client, opts Options) (worker, error) {
+	if opts.ResourceID == uuid.Nil {
+		return nil, errors.New("reviews scenario requires -resource")
+	}
+
+	return func(int) step {
+		var cursor string
+		return func(ctx context.Context) (outcome, string) {
+			page, err := c.ListResourceReviews(ctx, opts.ResourceID, cursor, opts.PageSize)
+			if err == nil {
+				cursor = page.NextCursor // empty at the last page, restarting the walk
+			}
+			return classify(err, http.StatusOK)
+		}
+	}, nil
+}
+
+// classify labels a request by its status code. The first status in expected is
+// the success status; any further ones are expected failures.
+func classify(err error, expected ...int) (outcome, string) {
+	if err == nil {
+		return outcomeOK, strconv.Itoa(expected[0])
+	}
+
+	var se *StatusError
+	if errors.As(err, &se) {
+		label := strconv.Itoa(se.Status)
+		for _, s := range expected[1:] {
+			if se.Status == s {
+				return outcomeExpected, label
+			}
+		}
+		return outcomeError, label
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return outcomeError, "timeout"
+	}
+	return outcomeError, "transport"
+}
+
+// run drives concurrency workers until duration elapses or ctx is canceled.
+func run(ctx context.Context, w worker, concurrency int, duration time.Duration, rec *Recorder) time.Duration {
+	ctx, cancel := context.WithTimeout(ctx, duration)
+	defer cancel()
+
+	began := time.Now()
+	var wg sync.WaitGroup
+	for i := range concurrency {
+		wg.Add(1)
+		go func(s step) {
+			defer wg.Done()
+			for ctx.Err() == nil {
+				t0 := time.Now()
+				o, label := s(ctx)
+				if ctx.Err() != nil {
+					return // interrupted by the end of the run, not a server error
+				}
+				rec.Record(time.Since(t0), o, label)
+			}
+		}(w(i))
+	}
+	wg.Wait()
+	return time.Since(began)
+}
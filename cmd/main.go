@@ -6,6 +6,7 @@ import (
 	"os"
 
 	"gin-clean-starter/cmd/bootstrap"
+	"gin-clean-starter/internal/pkg/buildinfo"
 	"gin-clean-starter/internal/pkg/config"
 
 	"github.com/gin-gonic/gin"
@@ -33,7 +34,18 @@ func startServer(lc fx.Lifecycle, engine *gin.Engine, cfg config.Config, logger
 		OnStart: func(_ context.Context) error {
 			gin.EnableJsonDecoderDisallowUnknownFields()
 			listenAddr := ":" + cfg.Server.Port
-			logger.Info("🚀 Starting server", "address", listenAddr, "mode", gin.Mode())
+			build := buildinfo.Get()
+			logger.Info("🚀 Starting server",
+				"address", listenAddr,
+				"mode", gin.Mode(),
+				slog.Group("build",
+					"version", build.Version,
+					"commit", build.Commit,
+					"build_time", build.BuildTime,
+					"go_version", build.GoVersion,
+					"modified", build.Modified,
+				),
+			)
 			go func() {
 				if err := engine.Run(listenAddr); err != nil {
 					logger.Error("Failed to start server", "error", err)
@@ -0,0 +1,78 @@
+// Command schemacheck catches drift between migrations/ and the sqlc-generated models
+// before a deploy.
+//
+//	go run ./cmd/schemacheck [-image postgres:17]
+//
+// It starts a throwaway PostgreSQL container, applies every migration in file name
+// order, and checks that each table and view has a struct in
+// internal/infra/sqlc/generated/models.go whose fields cover exactly its columns, with
+// the Go types sqlc maps their column types to. It prints every mismatch and exits 1 when
+// there is one, e.g. after a migration was added without rerunning sqlc.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const modelsPath = "internal/infra/sqlc/generated/models.go"
+
+func main() {
+	os.Exit(realMain())
+}
+
+func realMain() int {
+	image := flag.String("image", "postgres:17", "PostgreSQL image to apply the migrations to")
+	flag.Parse()
+
+	root, err := findModuleRoot()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "schemacheck:", err)
+		return 1
+	}
+	models, err := parseModels(filepath.Join(root, modelsPath))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "schemacheck:", err)
+		return 1
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+	relations, err := migratedSchema(ctx, *image, filepath.Join(root, "migrations"))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "schemacheck:", err)
+		return 1
+	}
+
+	drifts := compare(relations, models)
+	for _, d := range drifts {
+		fmt.Fprintln(os.Stderr, "schemacheck:", d)
+	}
+	if len(drifts) > 0 {
+		fmt.Fprintf(os.Stderr, "schemacheck: %d mismatches between %s and migrations/; run sqlc generate\n", len(drifts), modelsPath)
+		return 1
+	}
+	fmt.Printf("schemacheck: %d tables and views match %s\n", len(relations), modelsPath)
+	return 0
+}
+
+func findModuleRoot() (string, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+	for {
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return dir, nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", fmt.Errorf("go.mod not found")
+		}
+		dir = parent
+	}
+}
@@ -0,0 +1,71 @@
+//go:build unit
+
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStructName(t *testing.T) {
+	assert.Equal(t, "ApiKeys", structName("api_keys"))
+	assert.Equal(t, "PlatformStats", structName("platform_stats"))
+	assert.Equal(t, "ResourceID", structName("resource_id"))
+}
+
+func TestParseModels(t *testing.T) {
+	root, err := findModuleRoot()
+	require.NoError(t, err)
+
+	models, err := parseModels(filepath.Join(root, modelsPath))
+	require.NoError(t, err)
+
+	keys, ok := models["ApiKeys"]
+	require.True(t, ok)
+	assert.Equal(t, "uuid.UUID", keys.Fields["id"])
+	assert.Equal(t, "pgtype.UUID", keys.Fields["created_by"])
+	assert.Equal(t, "[]string", keys.Fields["scopes"])
+	assert.Equal(t, "pgtype.Timestamptz", keys.Fields["expires_at"])
+}
+
+func TestCompare(t *testing.T) {
+	models := map[string]model{
+		"Widgets": {Name: "Widgets", Fields: map[string]string{
+			"id":         "uuid.UUID",
+			"owner_id":   "pgtype.UUID",
+			"name":       "string",
+			"legacy":     "string",
+			"created_at": "pgtype.Timestamptz",
+		}},
+		"WidgetTotals": {Name: "WidgetTotals", Fields: map[string]string{"total": "int64"}},
+		"Gadgets":      {Name: "Gadgets", Fields: map[string]string{"id": "uuid.UUID"}},
+	}
+	relations := []relation{
+		{Name: "widgets", Columns: []column{
+			{Name: "id", Type: "uuid"},
+			{Name: "owner_id", Type: "uuid", Nullable: true},
+			{Name: "name", Type: "text", Nullable: true},
+			{Name: "created_at", Type: "timestamptz"},
+			{Name: "rating", Type: "int4"},
+			{Name: "location", Type: "point"},
+		}},
+		{Name: "widget_totals", View: true, Columns: []column{{Name: "total", Type: "int8", Nullable: true}}},
+		{Name: "sprockets", Columns: []column{{Name: "id", Type: "uuid"}}},
+	}
+
+	assert.Equal(t, []string{
+		"Gadgets: no table or view for it",
+		"sprockets: no Sprockets struct",
+		"widgets.legacy: in Widgets but not in the schema",
+		"widgets.location: missing from Widgets",
+		"widgets.name: Widgets is string, want pgtype.Text for text null",
+		"widgets.rating: missing from Widgets",
+	}, compare(relations, models))
+
+	t.Run("success: models matching the schema", func(t *testing.T) {
+		assert.Empty(t, compare(relations[1:2], map[string]model{"WidgetTotals": models["WidgetTotals"]}))
+	})
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// model is a sqlc-generated struct: the Go type of each field, keyed by the column its
+// json tag names.
+type model struct {
+	Name   string
+	Fields map[string]string
+}
+
+// parseModels reads the structs of sqlc's models.go, keyed by struct name.
+func parseModels(path string) (map[string]model, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	models := make(map[string]model)
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			ts := spec.(*ast.TypeSpec)
+			st, ok := ts.Type.(*ast.StructType)
+			if !ok {
+				continue
+			}
+			m := model{Name: ts.Name.Name, Fields: make(map[string]string)}
+			for _, field := range st.Fields.List {
+				if field.Tag == nil {
+					return nil, fmt.Errorf("%s: field without a json tag", ts.Name.Name)
+				}
+				tag, err := strconv.Unquote(field.Tag.Value)
+				if err != nil {
+					return nil, err
+				}
+				col, _, _ := strings.Cut(reflect.StructTag(tag).Get("json"), ",")
+				m.Fields[col] = types.ExprString(field.Type)
+			}
+			models[m.Name] = m
+		}
+	}
+	return models, nil
+}
+
+// structName is the struct sqlc generates for a table with emit_exact_table_names: each
+// underscore-separated word title-cased, with "id" as the one initialism.
+func structName(table string) string {
+	var b strings.Builder
+	for _, word := range strings.Split(table, "_") {
+		if word == "id" {
+			b.WriteString("ID")
+			continue
+		}
+		if word != "" {
+			b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/docker/go-connections/nat"
+	"github.com/jackc/pgx/v5/pgxpool"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	dbUser     = "schemacheck"
+	dbPassword = "schemacheck"
+)
+
+// relation is a table or view of the migrated database, its columns in table order.
+type relation struct {
+	Name    string
+	View    bool
+	Columns []column
+}
+
+type column struct {
+	Name string
+	// Type is the column's udt_name, e.g. int4, timestamptz or _text for text[].
+	Type     string
+	Nullable bool
+}
+
+// migratedSchema applies the migrations to a fresh container and reads back every table
+// and view in the public schema.
+func migratedSchema(ctx context.Context, image, migrationsDir string) ([]relation, error) {
+	container, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        image,
+			ExposedPorts: []string{"5432/tcp"},
+			Env: map[string]string{
+				"POSTGRES_USER":     dbUser,
+				"POSTGRES_PASSWORD": dbPassword,
+				"POSTGRES_DB":       "postgres",
+			},
+			Tmpfs: map[string]string{
+				"/var/lib/postgresql/data": "rw,size=256m",
+			},
+			Cmd: []string{"postgres", "-c", "fsync=off"},
+			WaitingFor: wait.ForSQL("5432/tcp", "pgx", func(host string, port nat.Port) string {
+				return dsn(host, port.Port())
+			}).WithStartupTimeout(60 * time.Second),
+			Labels: map[string]string{"purpose": "schemacheck"},
+		},
+		Started: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start PostgreSQL container: %w", err)
+	}
+	defer func() {
+		terminateCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := container.Terminate(terminateCtx); err != nil {
+			slog.Warn("Failed to terminate PostgreSQL container", "error", err.Error())
+		}
+	}()
+
+	host, err := container.Host(ctx)
+	if err != nil {
+		return nil, err
+	}
+	port, err := container.MappedPort(ctx, "5432/tcp")
+	if err != nil {
+		return nil, err
+	}
+	pool, err := pgxpool.New(ctx, dsn(host, port.Port()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer pool.Close()
+
+	if err := applyMigrations(ctx, pool, migrationsDir); err != nil {
+		return nil, err
+	}
+	return loadRelations(ctx, pool)
+}
+
+func dsn(host, port string) string {
+	return fmt.Sprintf("postgres://%s:%s@%s:%s/postgres?sslmode=disable", dbUser, dbPassword, host, port)
+}
+
+// applyMigrations runs migrations/*.sql in file name order, as sqlc reads them.
+func applyMigrations(ctx context.Context, pool *pgxpool.Pool, dir string) error {
+	files, err := filepath.Glob(filepath.Join(dir, "*.sql"))
+	if err != nil {
+		return fmt.Errorf("failed to list migrations: %w", err)
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no migrations in %s", dir)
+	}
+	sort.Strings(files)
+
+	for _, file := range files {
+		sqlContent, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("failed to read migration file %s: %w", file, err)
+		}
+		if _, err := pool.Exec(ctx, string(sqlContent)); err != nil {
+			return fmt.Errorf("failed to execute migration %s: %w", file, err)
+		}
+	}
+	return nil
+}
+
+func loadRelations(ctx context.Context, pool *pgxpool.Pool) ([]relation, error) {
+	rows, err := pool.Query(ctx, `
+		SELECT c.table_name, t.table_type = 'VIEW', c.column_name, c.udt_name, c.is_nullable = 'YES'
+		FROM information_schema.columns c
+		JOIN information_schema.tables t ON t.table_schema = c.table_schema AND t.table_name = c.table_name
+		WHERE c.table_schema = 'public'
+		ORDER BY c.table_name, c.ordinal_position`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read columns: %w", err)
+	}
+	defer rows.Close()
+
+	var relations []relation
+	for rows.Next() {
+		var table string
+		var view bool
+		var col column
+		if err := rows.Scan(&table, &view, &col.Name, &col.Type, &col.Nullable); err != nil {
+			return nil, err
+		}
+		if len(relations) == 0 || relations[len(relations)-1].Name != table {
+			relations = append(relations, relation{Name: table, View: view})
+		}
+		last := &relations[len(relations)-1]
+		last.Columns = append(last.Columns, col)
+	}
+	return relations, rows.Err()
+}
@@ -10,6 +10,7 @@ import (
 var (
 	ErrCouponExpired     = errors.New("coupon has expired")
 	ErrCouponNotYetValid = errors.New("coupon is not yet valid")
+	ErrInvalidValidity   = errors.New("coupon validity must start before it ends")
 )
 
 type Coupon struct {
@@ -39,6 +40,10 @@ func NewCoupon(
 		return nil, err
 	}
 
+	if validFrom != nil && validTo != nil && validFrom.After(*validTo) {
+		return nil, ErrInvalidValidity
+	}
+
 	return &Coupon{
 		id:        id,
 		code:      couponCode,
@@ -0,0 +1,89 @@
+//go:build unit
+
+package coupon_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"testing/quick"
+	"time"
+
+	"gin-clean-starter/internal/domain/coupon"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var propertyConfig = &quick.Config{MaxCount: 2000}
+
+var propertyBase = time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+
+func minutesFrom(n int32) time.Time {
+	return propertyBase.Add(time.Duration(n%(2*366*24*60)) * time.Minute)
+}
+
+func TestCoupon_Properties(t *testing.T) {
+	amount := int32(500)
+
+	t.Run("a coupon is only built with a window that starts before it ends", func(t *testing.T) {
+		prop := func(from, to int32) bool {
+			validFrom, validTo := minutesFrom(from), minutesFrom(to)
+			_, err := coupon.NewCoupon(uuid.New(), "SUMMER", &amount, nil, &validFrom, &validTo)
+			if validFrom.After(validTo) {
+				return errors.Is(err, coupon.ErrInvalidValidity)
+			}
+			return err == nil
+		}
+		assert.NoError(t, quick.Check(prop, propertyConfig))
+	})
+
+	t.Run("usage is valid exactly inside the window", func(t *testing.T) {
+		prop := func(from, length uint16, at int32, openFrom, openTo bool) bool {
+			var validFrom, validTo *time.Time
+			if !openFrom {
+				v := minutesFrom(int32(from))
+				validFrom = &v
+			}
+			if !openTo {
+				v := minutesFrom(int32(from) + int32(length))
+				validTo = &v
+			}
+			c, err := coupon.NewCoupon(uuid.New(), "SUMMER", &amount, nil, validFrom, validTo)
+			if err != nil {
+				return false
+			}
+
+			now := minutesFrom(at)
+			inside := (validFrom == nil || !now.Before(*validFrom)) && (validTo == nil || !now.After(*validTo))
+			useErr := c.ValidateUsage(now)
+			return c.IsValidAt(now) == inside && (useErr == nil) == inside
+		}
+		assert.NoError(t, quick.Check(prop, propertyConfig))
+	})
+
+	t.Run("a discount never raises the price or makes it negative", func(t *testing.T) {
+		prop := func(amountOff int32, percentOff float64, usePercent bool, price uint32) bool {
+			var d coupon.Discount
+			var err error
+			if usePercent {
+				d, err = coupon.NewPercentageDiscount(percentOff)
+			} else {
+				d, err = coupon.NewFixedDiscount(int(amountOff))
+			}
+			if err != nil {
+				return true // rejected values are covered below
+			}
+			got := d.Apply(int64(price))
+			return got >= 0 && got <= int64(price)
+		}
+		assert.NoError(t, quick.Check(prop, propertyConfig))
+	})
+
+	t.Run("percentages outside 0-100 are rejected", func(t *testing.T) {
+		for _, p := range []float64{-0.01, 100.01, math.NaN(), math.Inf(1), math.Inf(-1)} {
+			_, err := coupon.NewPercentageDiscount(p)
+			assert.ErrorIs(t, err, coupon.ErrInvalidDiscountPercent, "%v", p)
+		}
+	})
+}
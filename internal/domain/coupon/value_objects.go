@@ -41,7 +41,7 @@ func NewFixedDiscount(amountOffCents int) (Discount, error) {
 }
 
 func NewPercentageDiscount(percentOff float64) (Discount, error) {
-	if percentOff < 0 || percentOff > 100 {
+	if !(percentOff >= 0 && percentOff <= 100) { // also rejects NaN
 		return Discount{}, ErrInvalidDiscountPercent
 	}
 	return Discount{percentOff: &percentOff}, nil
@@ -0,0 +1,14 @@
+package loyalty
+
+// EntryKind is the type of a points ledger entry.
+type EntryKind string
+
+const (
+	EntryAccrual    EntryKind = "accrual"
+	EntryRedemption EntryKind = "redemption"
+	EntryExpiry     EntryKind = "expiry"
+)
+
+func (k EntryKind) String() string {
+	return string(k)
+}
@@ -0,0 +1,94 @@
+package loyalty
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidEarnRate    = errors.New("points per dollar must be positive")
+	ErrInvalidPointsTTL   = errors.New("points lifetime must be positive")
+	ErrInsufficientPoints = errors.New("insufficient loyalty points")
+)
+
+// EarnPolicy is how many points a completed reservation earns and how long they last.
+type EarnPolicy struct {
+	pointsPerDollar int64
+	ttl             time.Duration
+}
+
+func NewEarnPolicy(pointsPerDollar int64, ttl time.Duration) (EarnPolicy, error) {
+	if pointsPerDollar <= 0 {
+		return EarnPolicy{}, ErrInvalidEarnRate
+	}
+	if ttl <= 0 {
+		return EarnPolicy{}, ErrInvalidPointsTTL
+	}
+	return EarnPolicy{pointsPerDollar: pointsPerDollar, ttl: ttl}, nil
+}
+
+// Points rounds down to whole points.
+func (p EarnPolicy) Points(priceCents int64) int64 {
+	if priceCents <= 0 {
+		return 0
+	}
+	return priceCents * p.pointsPerDollar / 100
+}
+
+// MinPriceCents is the smallest price that earns a point.
+func (p EarnPolicy) MinPriceCents() int64 {
+	return (100 + p.pointsPerDollar - 1) / p.pointsPerDollar
+}
+
+// ExpiresAt dates the lot earned at earnedAt, i.e. when the reservation's slot ended.
+func (p EarnPolicy) ExpiresAt(earnedAt time.Time) time.Time {
+	return earnedAt.Add(p.ttl)
+}
+
+// Lot is the unspent part of an accrual.
+type Lot struct {
+	ID        uuid.UUID
+	Remaining int64
+	ExpiresAt time.Time
+}
+
+// Draw takes Points from one lot.
+type Draw struct {
+	LotID  uuid.UUID
+	Points int64
+}
+
+// Allocate draws points from the lots that expire soonest, so a redemption never lets
+// points lapse that it could have used up.
+func Allocate(lots []Lot, points int64) ([]Draw, error) {
+	if points <= 0 {
+		return nil, nil
+	}
+
+	ordered := make([]Lot, len(lots))
+	copy(ordered, lots)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].ExpiresAt.Before(ordered[j].ExpiresAt)
+	})
+
+	var draws []Draw
+	left := points
+	for _, lot := range ordered {
+		if left == 0 {
+			break
+		}
+		if lot.Remaining <= 0 {
+			continue
+		}
+		take := min(lot.Remaining, left)
+		draws = append(draws, Draw{LotID: lot.ID, Points: take})
+		left -= take
+	}
+	if left > 0 {
+		return nil, ErrInsufficientPoints
+	}
+	return draws, nil
+}
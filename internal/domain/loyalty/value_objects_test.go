@@ -0,0 +1,97 @@
+//go:build unit
+
+package loyalty_test
+
+import (
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/domain/loyalty"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEarnPolicy(t *testing.T) {
+	t.Run("rounds down to whole points", func(t *testing.T) {
+		p, err := loyalty.NewEarnPolicy(1, time.Hour)
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(1000), p.Points(100000))
+		assert.Equal(t, int64(1), p.Points(199))
+		assert.Zero(t, p.Points(99))
+		assert.Zero(t, p.Points(-100))
+		assert.Equal(t, int64(100), p.MinPriceCents())
+	})
+
+	t.Run("minimum price follows the earn rate", func(t *testing.T) {
+		p, err := loyalty.NewEarnPolicy(3, time.Hour)
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(34), p.MinPriceCents())
+		assert.Equal(t, int64(1), p.Points(p.MinPriceCents()))
+		assert.Zero(t, p.Points(p.MinPriceCents()-1))
+	})
+
+	t.Run("lots expire a lifetime after they are earned", func(t *testing.T) {
+		p, err := loyalty.NewEarnPolicy(1, 24*time.Hour)
+		require.NoError(t, err)
+
+		earned := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+		assert.Equal(t, earned.Add(24*time.Hour), p.ExpiresAt(earned))
+	})
+
+	t.Run("rejects non-positive settings", func(t *testing.T) {
+		_, err := loyalty.NewEarnPolicy(0, time.Hour)
+		assert.ErrorIs(t, err, loyalty.ErrInvalidEarnRate)
+
+		_, err = loyalty.NewEarnPolicy(1, 0)
+		assert.ErrorIs(t, err, loyalty.ErrInvalidPointsTTL)
+	})
+}
+
+func TestAllocate(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	late := loyalty.Lot{ID: uuid.New(), Remaining: 50, ExpiresAt: base.Add(48 * time.Hour)}
+	soon := loyalty.Lot{ID: uuid.New(), Remaining: 30, ExpiresAt: base.Add(24 * time.Hour)}
+	spent := loyalty.Lot{ID: uuid.New(), Remaining: 0, ExpiresAt: base}
+
+	t.Run("draws the soonest-expiring lots first", func(t *testing.T) {
+		draws, err := loyalty.Allocate([]loyalty.Lot{late, spent, soon}, 40)
+		require.NoError(t, err)
+
+		assert.Equal(t, []loyalty.Draw{
+			{LotID: soon.ID, Points: 30},
+			{LotID: late.ID, Points: 10},
+		}, draws)
+	})
+
+	t.Run("stops at the first lot that covers the rest", func(t *testing.T) {
+		draws, err := loyalty.Allocate([]loyalty.Lot{late, soon}, 30)
+		require.NoError(t, err)
+
+		assert.Equal(t, []loyalty.Draw{{LotID: soon.ID, Points: 30}}, draws)
+	})
+
+	t.Run("can use up every lot", func(t *testing.T) {
+		draws, err := loyalty.Allocate([]loyalty.Lot{late, soon}, 80)
+		require.NoError(t, err)
+
+		assert.Len(t, draws, 2)
+	})
+
+	t.Run("insufficient balance", func(t *testing.T) {
+		_, err := loyalty.Allocate([]loyalty.Lot{late, soon}, 81)
+		assert.ErrorIs(t, err, loyalty.ErrInsufficientPoints)
+
+		_, err = loyalty.Allocate(nil, 1)
+		assert.ErrorIs(t, err, loyalty.ErrInsufficientPoints)
+	})
+
+	t.Run("nothing to draw", func(t *testing.T) {
+		draws, err := loyalty.Allocate([]loyalty.Lot{late}, 0)
+		require.NoError(t, err)
+		assert.Empty(t, draws)
+	})
+}
@@ -0,0 +1,24 @@
+package referral
+
+type Status string
+
+const (
+	StatusPending  Status = "pending"
+	StatusRewarded Status = "rewarded"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// Party is the side of a referral a reward credit goes to.
+type Party string
+
+const (
+	PartyReferrer Party = "referrer"
+	PartyReferred Party = "referred"
+)
+
+func (p Party) String() string {
+	return string(p)
+}
@@ -0,0 +1,74 @@
+package referral
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidReferralCode = errors.New("invalid referral code format")
+	ErrInvalidRewardAmount = errors.New("referral reward cannot be negative")
+)
+
+// Generated codes are 10 upper-case hex characters; the wider range leaves room for vanity codes.
+var referralCodeRegex = regexp.MustCompile(`^[A-Z0-9]{6,32}$`)
+
+type Code string
+
+func NewCode(code string) (Code, error) {
+	code = strings.TrimSpace(strings.ToUpper(code))
+	if !referralCodeRegex.MatchString(code) {
+		return Code(""), ErrInvalidReferralCode
+	}
+	return Code(code), nil
+}
+
+// GenerateCode returns a random 10-character code. Codes are unique per user, so callers
+// retry with a fresh code when the one drawn is already taken.
+func GenerateCode() (Code, error) {
+	b := make([]byte, 5)
+	if _, err := rand.Read(b); err != nil {
+		return Code(""), err
+	}
+	return Code(strings.ToUpper(hex.EncodeToString(b))), nil
+}
+
+func (c Code) String() string {
+	return string(c)
+}
+
+// RewardPolicy is the credit each party receives once a referral completes.
+// A zero amount issues no credit to that party.
+type RewardPolicy struct {
+	referrerCents int32
+	referredCents int32
+}
+
+func NewRewardPolicy(referrerCents, referredCents int32) (RewardPolicy, error) {
+	if referrerCents < 0 || referredCents < 0 {
+		return RewardPolicy{}, ErrInvalidRewardAmount
+	}
+	return RewardPolicy{referrerCents: referrerCents, referredCents: referredCents}, nil
+}
+
+type Credit struct {
+	UserID      uuid.UUID
+	Party       Party
+	AmountCents int32
+}
+
+func (p RewardPolicy) Credits(referrerID, referredID uuid.UUID) []Credit {
+	credits := make([]Credit, 0, 2)
+	if p.referrerCents > 0 {
+		credits = append(credits, Credit{UserID: referrerID, Party: PartyReferrer, AmountCents: p.referrerCents})
+	}
+	if p.referredCents > 0 {
+		credits = append(credits, Credit{UserID: referredID, Party: PartyReferred, AmountCents: p.referredCents})
+	}
+	return credits
+}
@@ -0,0 +1,82 @@
+//go:build unit
+
+package referral_test
+
+import (
+	"testing"
+
+	"gin-clean-starter/internal/domain/referral"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCode(t *testing.T) {
+	cases := []struct {
+		name  string
+		input string
+		want  referral.Code
+		err   error
+	}{
+		{name: "generated code", input: "A1B2C3D4E5", want: "A1B2C3D4E5"},
+		{name: "normalized to upper case", input: "  a1b2c3d4e5 ", want: "A1B2C3D4E5"},
+		{name: "too short", input: "AB12", err: referral.ErrInvalidReferralCode},
+		{name: "symbols rejected", input: "ABC-1234", err: referral.ErrInvalidReferralCode},
+		{name: "empty", input: "", err: referral.ErrInvalidReferralCode},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			code, err := referral.NewCode(tc.input)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, code)
+		})
+	}
+}
+
+func TestGenerateCode(t *testing.T) {
+	code, err := referral.GenerateCode()
+	require.NoError(t, err)
+
+	parsed, err := referral.NewCode(code.String())
+	require.NoError(t, err, "generated codes must pass validation")
+	assert.Equal(t, code, parsed)
+	assert.Len(t, code.String(), 10)
+
+	other, err := referral.GenerateCode()
+	require.NoError(t, err)
+	assert.NotEqual(t, code, other)
+}
+
+func TestRewardPolicy_Credits(t *testing.T) {
+	referrerID, referredID := uuid.New(), uuid.New()
+
+	t.Run("both parties are credited", func(t *testing.T) {
+		policy, err := referral.NewRewardPolicy(1000, 500)
+		require.NoError(t, err)
+
+		assert.Equal(t, []referral.Credit{
+			{UserID: referrerID, Party: referral.PartyReferrer, AmountCents: 1000},
+			{UserID: referredID, Party: referral.PartyReferred, AmountCents: 500},
+		}, policy.Credits(referrerID, referredID))
+	})
+
+	t.Run("zero amount skips that party", func(t *testing.T) {
+		policy, err := referral.NewRewardPolicy(1000, 0)
+		require.NoError(t, err)
+
+		credits := policy.Credits(referrerID, referredID)
+		require.Len(t, credits, 1)
+		assert.Equal(t, referral.PartyReferrer, credits[0].Party)
+	})
+
+	t.Run("negative amount is rejected", func(t *testing.T) {
+		_, err := referral.NewRewardPolicy(-1, 500)
+		assert.ErrorIs(t, err, referral.ErrInvalidRewardAmount)
+	})
+}
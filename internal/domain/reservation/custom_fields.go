@@ -0,0 +1,167 @@
+package reservation
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+type FieldType string
+
+const (
+	FieldTypeText   FieldType = "text"
+	FieldTypeNumber FieldType = "number"
+	FieldTypeSelect FieldType = "select"
+)
+
+func (t FieldType) IsValid() bool {
+	switch t {
+	case FieldTypeText, FieldTypeNumber, FieldTypeSelect:
+		return true
+	}
+	return false
+}
+
+// Limits on custom field definitions and values; text is counted in characters, like notes.
+const (
+	MaxFieldLabelLength = 100
+	MaxFieldTextLength  = 500
+	MaxFieldOptions     = 50
+)
+
+var (
+	ErrInvalidFieldDefinition = errors.New("invalid custom field definition")
+	ErrInvalidCustomFields    = errors.New("invalid custom field values")
+)
+
+// Keys are stored in reservations' custom_fields and used as export columns and filter
+// names, so they are kept to lowercase identifiers.
+var fieldKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,39}$`)
+
+// FieldDefinition describes one custom field a company collects on reservations.
+type FieldDefinition struct {
+	Key      string
+	Label    string
+	Type     FieldType
+	Required bool
+	Options  []string // choices of a select field, in display order
+}
+
+// NewFieldDefinition trims the label and options. Only select fields take options, and
+// they need at least one.
+func NewFieldDefinition(key, label string, typ FieldType, required bool, options []string) (FieldDefinition, error) {
+	if !fieldKeyPattern.MatchString(key) {
+		return FieldDefinition{}, fmt.Errorf("%w: key must be a lowercase identifier of at most 40 characters", ErrInvalidFieldDefinition)
+	}
+	label = strings.TrimSpace(label)
+	if label == "" || utf8.RuneCountInString(label) > MaxFieldLabelLength {
+		return FieldDefinition{}, fmt.Errorf("%w: label must be 1 to %d characters", ErrInvalidFieldDefinition, MaxFieldLabelLength)
+	}
+	if !typ.IsValid() {
+		return FieldDefinition{}, fmt.Errorf("%w: unknown type %q", ErrInvalidFieldDefinition, typ)
+	}
+
+	var opts []string
+	for _, o := range options {
+		o = strings.TrimSpace(o)
+		if o == "" || slices.Contains(opts, o) {
+			return FieldDefinition{}, fmt.Errorf("%w: options must be distinct and non-empty", ErrInvalidFieldDefinition)
+		}
+		opts = append(opts, o)
+	}
+	switch {
+	case typ == FieldTypeSelect && (len(opts) == 0 || len(opts) > MaxFieldOptions):
+		return FieldDefinition{}, fmt.Errorf("%w: a select field needs 1 to %d options", ErrInvalidFieldDefinition, MaxFieldOptions)
+	case typ != FieldTypeSelect && len(opts) > 0:
+		return FieldDefinition{}, fmt.Errorf("%w: only select fields take options", ErrInvalidFieldDefinition)
+	}
+
+	return FieldDefinition{Key: key, Label: label, Type: typ, Required: required, Options: opts}, nil
+}
+
+// CustomFieldError names the field a value was rejected for. It matches ErrInvalidCustomFields.
+type CustomFieldError struct {
+	Key    string
+	Reason string
+}
+
+func (e *CustomFieldError) Error() string {
+	return fmt.Sprintf("custom field %q: %s", e.Key, e.Reason)
+}
+
+func (e *CustomFieldError) Unwrap() error {
+	return ErrInvalidCustomFields
+}
+
+// CustomFields maps field keys to values: strings for text and select fields, float64 for
+// number fields.
+type CustomFields map[string]any
+
+// NewCustomFields checks values against the definitions that apply to a reservation.
+// Unknown keys are rejected, required fields must be present, and text is trimmed; an
+// empty text counts as absent. The result holds only the fields that were given.
+func NewCustomFields(defs []FieldDefinition, values map[string]any) (CustomFields, error) {
+	byKey := make(map[string]FieldDefinition, len(defs))
+	for _, def := range defs {
+		byKey[def.Key] = def
+	}
+	for key := range values {
+		if _, ok := byKey[key]; !ok {
+			return nil, &CustomFieldError{Key: key, Reason: "unknown field"}
+		}
+	}
+
+	fields := CustomFields{}
+	for _, def := range defs {
+		raw, ok := values[def.Key]
+		if ok && raw != nil {
+			value, err := def.normalize(raw)
+			if err != nil {
+				return nil, err
+			}
+			if value != nil {
+				fields[def.Key] = value
+				continue
+			}
+		}
+		if def.Required {
+			return nil, &CustomFieldError{Key: def.Key, Reason: "required"}
+		}
+	}
+	return fields, nil
+}
+
+// normalize returns nil for an empty text value.
+func (d FieldDefinition) normalize(raw any) (any, error) {
+	switch d.Type {
+	case FieldTypeNumber:
+		n, ok := raw.(float64)
+		if !ok || math.IsNaN(n) || math.IsInf(n, 0) {
+			return nil, &CustomFieldError{Key: d.Key, Reason: "must be a number"}
+		}
+		return n, nil
+	case FieldTypeSelect:
+		s, ok := raw.(string)
+		if !ok || !slices.Contains(d.Options, s) {
+			return nil, &CustomFieldError{Key: d.Key, Reason: "must be one of the field's options"}
+		}
+		return s, nil
+	default:
+		s, ok := raw.(string)
+		if !ok {
+			return nil, &CustomFieldError{Key: d.Key, Reason: "must be a string"}
+		}
+		s = strings.TrimSpace(s)
+		if utf8.RuneCountInString(s) > MaxFieldTextLength {
+			return nil, &CustomFieldError{Key: d.Key, Reason: fmt.Sprintf("must be at most %d characters", MaxFieldTextLength)}
+		}
+		if s == "" {
+			return nil, nil
+		}
+		return s, nil
+	}
+}
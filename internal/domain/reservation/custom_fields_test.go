@@ -0,0 +1,95 @@
+//go:build unit
+
+package reservation_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"gin-clean-starter/internal/domain/reservation"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFieldDefinition(t *testing.T) {
+	testCases := []struct {
+		name    string
+		key     string
+		typ     reservation.FieldType
+		options []string
+		wantErr bool
+	}{
+		{"text field", "purpose", reservation.FieldTypeText, nil, false},
+		{"select field with options", "room_layout", reservation.FieldTypeSelect, []string{"theater", " boardroom "}, false},
+		{"key with uppercase letters", "Purpose", reservation.FieldTypeText, nil, true},
+		{"key starting with a digit", "1st_choice", reservation.FieldTypeText, nil, true},
+		{"unknown type", "purpose", reservation.FieldType("date"), nil, true},
+		{"select field without options", "room_layout", reservation.FieldTypeSelect, nil, true},
+		{"duplicate options", "room_layout", reservation.FieldTypeSelect, []string{"theater", "theater"}, true},
+		{"options on a number field", "attendees", reservation.FieldTypeNumber, []string{"1"}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			def, err := reservation.NewFieldDefinition(tc.key, " Label ", tc.typ, false, tc.options)
+			if tc.wantErr {
+				assert.ErrorIs(t, err, reservation.ErrInvalidFieldDefinition)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "Label", def.Label)
+			for _, o := range def.Options {
+				assert.Equal(t, strings.TrimSpace(o), o)
+			}
+		})
+	}
+}
+
+func TestNewCustomFields(t *testing.T) {
+	defs := []reservation.FieldDefinition{
+		{Key: "purpose", Label: "Purpose", Type: reservation.FieldTypeText, Required: true},
+		{Key: "attendees", Label: "Attendees", Type: reservation.FieldTypeNumber},
+		{Key: "room_layout", Label: "Layout", Type: reservation.FieldTypeSelect, Options: []string{"theater", "boardroom"}},
+	}
+
+	testCases := []struct {
+		name    string
+		values  map[string]any
+		want    reservation.CustomFields
+		wantKey string
+	}{
+		{
+			name:   "all fields given",
+			values: map[string]any{"purpose": " Board meeting ", "attendees": float64(12), "room_layout": "boardroom"},
+			want:   reservation.CustomFields{"purpose": "Board meeting", "attendees": float64(12), "room_layout": "boardroom"},
+		},
+		{
+			name:   "optional fields left out",
+			values: map[string]any{"purpose": "Interview", "attendees": nil},
+			want:   reservation.CustomFields{"purpose": "Interview"},
+		},
+		{name: "required field missing", values: map[string]any{"attendees": float64(3)}, wantKey: "purpose"},
+		{name: "required text blank", values: map[string]any{"purpose": "   "}, wantKey: "purpose"},
+		{name: "unknown field", values: map[string]any{"purpose": "Interview", "catering": "yes"}, wantKey: "catering"},
+		{name: "number given as a string", values: map[string]any{"purpose": "Interview", "attendees": "12"}, wantKey: "attendees"},
+		{name: "option not offered", values: map[string]any{"purpose": "Interview", "room_layout": "classroom"}, wantKey: "room_layout"},
+		{name: "text too long", values: map[string]any{"purpose": strings.Repeat("a", reservation.MaxFieldTextLength+1)}, wantKey: "purpose"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fields, err := reservation.NewCustomFields(defs, tc.values)
+			if tc.wantKey != "" {
+				require.ErrorIs(t, err, reservation.ErrInvalidCustomFields)
+				var fieldErr *reservation.CustomFieldError
+				require.True(t, errors.As(err, &fieldErr))
+				assert.Equal(t, tc.wantKey, fieldErr.Key)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, fields)
+		})
+	}
+}
@@ -25,12 +25,19 @@ type ResourceSpec struct {
 
 type CouponSpec struct {
 	ID             uuid.UUID
+	Code           string
+	Priority       int // higher applies first when coupons stack
 	AmountOffCents *int32
 	PercentOff     *float64
 	ValidFrom      *time.Time
 	ValidTo        *time.Time
 }
 
+// ValidAt reports whether the coupon may be applied at now.
+func (c CouponSpec) ValidAt(now time.Time) bool {
+	return (c.ValidFrom == nil || !now.Before(*c.ValidFrom)) && (c.ValidTo == nil || !now.After(*c.ValidTo))
+}
+
 type ResourcePriceContext struct {
 	ResourceID uuid.UUID
 }
@@ -38,6 +45,17 @@ type ResourcePriceContext struct {
 type Services struct {
 	Clock           clock.Clock
 	PriceCalculator PriceCalculator
+	TaxCalculator   TaxCalculator
+	Stacking        StackingPolicy // zero value is StackingExclusive
+	Points          PointsPolicy   // zero value redeems no points
+	ClockSkew       time.Duration  // how far a client clock may run behind ours; see ValidateLeadTime
+}
+
+// ValidateLeadTime checks slot against the resource's lead time. A slot starting up to
+// ClockSkew too early still passes, so a client whose clock runs slightly behind ours is not
+// refused a booking that looked valid on its side.
+func (s *Services) ValidateLeadTime(res ResourceSpec, slot TimeSlot) error {
+	return slot.ValidateLeadTimeAt(s.Clock.Now().Add(-s.ClockSkew), max(res.LeadTimeMin, 0))
 }
 
 type PriceCalculator interface {
@@ -52,7 +70,10 @@ type Reservation struct {
 	status     Status
 	price      Money
 	couponID   *uuid.UUID
+	discounts  []AppliedDiscount
+	points     RedeemedPoints
 	note       Note
+	fields     CustomFields
 	createdAt  time.Time
 	updatedAt  time.Time
 }
@@ -62,48 +83,60 @@ func NewReservation(
 	res ResourceSpec,
 	userID uuid.UUID,
 	slot TimeSlot,
-	coup *CouponSpec,
+	coupons []CouponSpec,
+	points int64,
 	note Note,
 ) (*Reservation, error) {
-	lead := res.LeadTimeMin
-	if lead < 0 {
-		lead = 0
-	}
-	if err := slot.ValidateLeadTimeAt(services.Clock.Now(), lead); err != nil {
+	quote, err := NewQuote(services, res, slot, coupons, points)
+	if err != nil {
 		return nil, err
 	}
 
-	base := services.PriceCalculator.CalculatePriceCents(ResourcePriceContext{ResourceID: res.ID}, slot)
-	if base < 0 {
+	return newConfirmed(res.ID, userID, slot, quote.Subtotal(), quote.Discounts(), quote.Points(), note), nil
+}
+
+// NewQuotedReservation honors a previously issued quote instead of re-pricing,
+// so a coupon or rate change between quote and create does not alter the price.
+// Lead time is still enforced against the current clock.
+func NewQuotedReservation(
+	services *Services,
+	res ResourceSpec,
+	userID uuid.UUID,
+	slot TimeSlot,
+	discounts []AppliedDiscount,
+	points RedeemedPoints,
+	note Note,
+	quotedSubtotalCents int64,
+) (*Reservation, error) {
+	if err := services.ValidateLeadTime(res, slot); err != nil {
+		return nil, err
+	}
+	if quotedSubtotalCents < 0 {
 		return nil, ErrNegativePrice
 	}
 
-	if coup != nil {
-		now := services.Clock.Now()
-		if (coup.ValidFrom != nil && now.Before(*coup.ValidFrom)) ||
-			(coup.ValidTo != nil && now.After(*coup.ValidTo)) {
-			return nil, ErrInvalidCoupon
-		}
-		base = applyDiscount(base, coup.AmountOffCents, coup.PercentOff)
-	}
+	return newConfirmed(res.ID, userID, slot, NewMoney(quotedSubtotalCents), discounts, points, note), nil
+}
 
-	price := NewMoney(base)
+// The first applied coupon is kept as the reservation's primary coupon.
+func newConfirmed(resourceID, userID uuid.UUID, slot TimeSlot, price Money, discounts []AppliedDiscount, points RedeemedPoints, note Note) *Reservation {
 	var couponID *uuid.UUID
-	if coup != nil {
-		id := coup.ID
+	if len(discounts) > 0 {
+		id := discounts[0].CouponID
 		couponID = &id
 	}
-
 	return &Reservation{
 		id:         uuid.New(),
-		resourceID: res.ID,
+		resourceID: resourceID,
 		userID:     userID,
 		timeSlot:   slot,
 		status:     StatusConfirmed,
 		price:      price,
 		couponID:   couponID,
+		discounts:  discounts,
+		points:     points,
 		note:       note,
-	}, nil
+	}
 }
 
 func ReconstructReservation(
@@ -129,6 +162,22 @@ func ReconstructReservation(
 	}
 }
 
+// RequireApproval holds a new reservation for an approver's decision; it keeps its slot meanwhile.
+func (r *Reservation) RequireApproval() {
+	if r.status == StatusConfirmed {
+		r.status = StatusPendingApproval
+	}
+}
+
+// SetCustomFields attaches values already checked by NewCustomFields.
+func (r *Reservation) SetCustomFields(fields CustomFields) {
+	r.fields = fields
+}
+
+func (r *Reservation) IsPendingApproval() bool {
+	return r.status == StatusPendingApproval
+}
+
 func (r *Reservation) IsActive() bool {
 	return r.status == StatusConfirmed
 }
@@ -141,16 +190,19 @@ func (r *Reservation) HasExpired(now time.Time) bool {
 	return now.After(r.timeSlot.End())
 }
 
-func (r *Reservation) ID() uuid.UUID         { return r.id }
-func (r *Reservation) ResourceID() uuid.UUID { return r.resourceID }
-func (r *Reservation) UserID() uuid.UUID     { return r.userID }
-func (r *Reservation) TimeSlot() TimeSlot    { return r.timeSlot }
-func (r *Reservation) Status() Status        { return r.status }
-func (r *Reservation) Price() Money          { return r.price }
-func (r *Reservation) CouponID() *uuid.UUID  { return r.couponID }
-func (r *Reservation) Note() Note            { return r.note }
-func (r *Reservation) CreatedAt() time.Time  { return r.createdAt }
-func (r *Reservation) UpdatedAt() time.Time  { return r.updatedAt }
+func (r *Reservation) ID() uuid.UUID                { return r.id }
+func (r *Reservation) ResourceID() uuid.UUID        { return r.resourceID }
+func (r *Reservation) UserID() uuid.UUID            { return r.userID }
+func (r *Reservation) TimeSlot() TimeSlot           { return r.timeSlot }
+func (r *Reservation) Status() Status               { return r.status }
+func (r *Reservation) Price() Money                 { return r.price }
+func (r *Reservation) CouponID() *uuid.UUID         { return r.couponID }
+func (r *Reservation) Discounts() []AppliedDiscount { return r.discounts }
+func (r *Reservation) Points() RedeemedPoints       { return r.points }
+func (r *Reservation) Note() Note                   { return r.note }
+func (r *Reservation) CustomFields() CustomFields   { return r.fields }
+func (r *Reservation) CreatedAt() time.Time         { return r.createdAt }
+func (r *Reservation) UpdatedAt() time.Time         { return r.updatedAt }
 
 type DefaultPriceCalculator struct {
 	HourlyRateCents int64
@@ -176,8 +228,6 @@ func applyDiscount(base int64, amountOff *int32, percentOff *float64) int64 {
 	if percentOff != nil {
 		result = int64(float64(result) * (100.0 - *percentOff) / 100.0)
 	}
-	if result < 0 {
-		result = 0
-	}
-	return result
+	// A negative amount or percentage never raises the price.
+	return min(max(result, 0), base)
 }
@@ -0,0 +1,36 @@
+package reservation
+
+import "fmt"
+
+// PointsPolicy prices loyalty points redeemed on a reservation. Points apply after
+// coupons and may cover at most MaxBasisPoints of what the coupons leave; points
+// beyond that are not spent.
+type PointsPolicy struct {
+	CentsPerPoint  int64
+	MaxBasisPoints int64 // 10000 = the whole post-coupon amount
+}
+
+func NewPointsPolicy(centsPerPoint, maxBasisPoints int64) (PointsPolicy, error) {
+	if centsPerPoint <= 0 {
+		return PointsPolicy{}, fmt.Errorf("point value must be positive, got %d cents", centsPerPoint)
+	}
+	if maxBasisPoints < 0 || maxBasisPoints > 10000 {
+		return PointsPolicy{}, fmt.Errorf("points redemption cap must be between 0 and 10000 basis points, got %d", maxBasisPoints)
+	}
+	return PointsPolicy{CentsPerPoint: centsPerPoint, MaxBasisPoints: maxBasisPoints}, nil
+}
+
+// RedeemedPoints is the points line item of a quote or reservation.
+type RedeemedPoints struct {
+	Points int64
+	Amount Money
+}
+
+// Redeem spends up to requested points against amount. The zero policy redeems nothing.
+func (p PointsPolicy) Redeem(amount, requested int64) RedeemedPoints {
+	if p.CentsPerPoint <= 0 || requested <= 0 || amount <= 0 {
+		return RedeemedPoints{}
+	}
+	usable := min(requested, amount*p.MaxBasisPoints/10000/p.CentsPerPoint)
+	return RedeemedPoints{Points: usable, Amount: NewMoney(usable * p.CentsPerPoint)}
+}
@@ -0,0 +1,176 @@
+//go:build unit
+
+package reservation_test
+
+import (
+	"strings"
+	"testing"
+	"testing/quick"
+	"time"
+	"unicode/utf8"
+
+	"gin-clean-starter/internal/domain/reservation"
+	"gin-clean-starter/internal/pkg/clock"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var propertyConfig = &quick.Config{MaxCount: 2000}
+
+var propertyBase = time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+
+// minutesFrom spreads a generated number over about two years around propertyBase.
+func minutesFrom(n int32) time.Time {
+	return propertyBase.Add(time.Duration(n%(2*366*24*60)) * time.Minute)
+}
+
+func TestTimeSlot_Properties(t *testing.T) {
+	t.Run("a constructed slot starts before it ends", func(t *testing.T) {
+		prop := func(a, b int32) bool {
+			start, end := minutesFrom(a), minutesFrom(b)
+			slot, err := reservation.NewTimeSlot(start, end)
+			if !start.Before(end) {
+				return err != nil
+			}
+			return err == nil && slot.Duration() > 0 && slot.Start().Equal(start) && slot.End().Equal(end)
+		}
+		assert.NoError(t, quick.Check(prop, propertyConfig))
+	})
+
+	t.Run("an unset start is rejected", func(t *testing.T) {
+		prop := func(b int32) bool {
+			_, err := reservation.NewTimeSlot(time.Time{}, minutesFrom(b))
+			return err != nil
+		}
+		assert.NoError(t, quick.Check(prop, propertyConfig))
+	})
+
+	t.Run("overlap is symmetric, reflexive and half-open", func(t *testing.T) {
+		prop := func(a, lenA, b, lenB uint16) bool {
+			x := mustSlot(t, int32(a), int32(lenA)+1)
+			y := mustSlot(t, int32(b), int32(lenB)+1)
+			intersect := x.Start().Before(y.End()) && y.Start().Before(x.End())
+			backToBack := x.Overlaps(mustSlot(t, int32(a)+int32(lenA)+1, 1))
+			return x.Overlaps(y) == intersect && x.Overlaps(y) == y.Overlaps(x) && x.Overlaps(x) && !backToBack
+		}
+		assert.NoError(t, quick.Check(prop, propertyConfig))
+	})
+}
+
+func mustSlot(t *testing.T, startMinute, lengthMinutes int32) reservation.TimeSlot {
+	t.Helper()
+	start := propertyBase.Add(time.Duration(startMinute) * time.Minute)
+	slot, err := reservation.NewTimeSlot(start, start.Add(time.Duration(lengthMinutes)*time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return slot
+}
+
+func TestLeadTime_Properties(t *testing.T) {
+	t.Run("lead time is met exactly when the slot starts after now plus the lead", func(t *testing.T) {
+		prop := func(startIn int32, lead uint16) bool {
+			now := propertyBase
+			slot := mustSlot(t, startIn%100000, 60)
+			required := now.Add(time.Duration(lead) * time.Minute)
+			meets := slot.MeetsLeadTimeAt(now, int(lead))
+			return meets == slot.Start().After(required) && (meets == (slot.ValidateLeadTimeAt(now, int(lead)) == nil))
+		}
+		assert.NoError(t, quick.Check(prop, propertyConfig))
+	})
+
+	t.Run("meeting a longer lead time meets every shorter one", func(t *testing.T) {
+		prop := func(startIn int32, longer, shorter uint16) bool {
+			if shorter > longer {
+				longer, shorter = shorter, longer
+			}
+			slot := mustSlot(t, startIn%100000, 60)
+			return !slot.MeetsLeadTimeAt(propertyBase, int(longer)) || slot.MeetsLeadTimeAt(propertyBase, int(shorter))
+		}
+		assert.NoError(t, quick.Check(prop, propertyConfig))
+	})
+
+	t.Run("a negative resource lead time counts as none", func(t *testing.T) {
+		prop := func(startIn int16, lead uint16) bool {
+			services := &reservation.Services{
+				Clock:           clock.NewMockClock(propertyBase),
+				PriceCalculator: reservation.NewDefaultPriceCalculator(),
+			}
+			slot := mustSlot(t, int32(startIn), 60)
+			_, err := reservation.NewQuote(services, reservation.ResourceSpec{ID: uuid.New(), LeadTimeMin: -int(lead)}, slot, nil, 0)
+			return (err == nil) == slot.Start().After(propertyBase)
+		}
+		assert.NoError(t, quick.Check(prop, propertyConfig))
+	})
+}
+
+func TestCouponWindow_Properties(t *testing.T) {
+	services := func() *reservation.Services {
+		return &reservation.Services{
+			Clock:           clock.NewMockClock(propertyBase),
+			PriceCalculator: reservation.NewDefaultPriceCalculator(),
+		}
+	}
+	slot := mustSlot(t, 24*60, 60)
+
+	t.Run("a coupon applies exactly within its validity window", func(t *testing.T) {
+		prop := func(from, to int32, openFrom, openTo bool) bool {
+			c := amountCoupon("WINDOW", 0, 500)
+			inWindow := true
+			if !openFrom {
+				v := minutesFrom(from)
+				c.ValidFrom = &v
+				inWindow = inWindow && !propertyBase.Before(v)
+			}
+			if !openTo {
+				v := minutesFrom(to)
+				c.ValidTo = &v
+				inWindow = inWindow && !propertyBase.After(v)
+			}
+			_, err := reservation.NewQuote(services(), reservation.ResourceSpec{ID: uuid.New()}, slot, []reservation.CouponSpec{c}, 0)
+			return (err == nil) == inWindow
+		}
+		assert.NoError(t, quick.Check(prop, propertyConfig))
+	})
+
+	t.Run("discounts stay between zero and the base price", func(t *testing.T) {
+		prop := func(amountOff int32, percentOff float64, usePercent bool, rule uint8) bool {
+			c := amountCoupon("ANY", 0, amountOff)
+			if usePercent {
+				c = percentCoupon("ANY", 0, percentOff)
+			}
+			rules := []reservation.StackingRule{reservation.StackingExclusive, reservation.StackingUpToCap, reservation.StackingBestOf}
+			s := services()
+			s.Stacking = reservation.StackingPolicy{Rule: rules[int(rule)%len(rules)], CapBasisPoints: 10000}
+
+			q, err := reservation.NewQuote(s, reservation.ResourceSpec{ID: uuid.New()}, slot, []reservation.CouponSpec{c}, 0)
+			if err != nil {
+				return false
+			}
+			return q.Discount().Cents() >= 0 && q.Subtotal().Cents() >= 0 && q.Subtotal().Cents() <= q.Base().Cents()
+		}
+		assert.NoError(t, quick.Check(prop, propertyConfig))
+	})
+}
+
+func TestNote_Properties(t *testing.T) {
+	t.Run("notes are trimmed, bounded in characters and stable", func(t *testing.T) {
+		prop := func(s string, repeat uint8) bool {
+			s = strings.Repeat(s, int(repeat)+1)
+			note, err := reservation.NewNote(s)
+			trimmed := strings.TrimSpace(s)
+			if utf8.RuneCountInString(trimmed) > reservation.MaxNoteLength {
+				return err != nil
+			}
+			again, err2 := reservation.NewNote(note.String())
+			return err == nil && err2 == nil && note.String() == trimmed && again == note
+		}
+		assert.NoError(t, quick.Check(prop, propertyConfig))
+	})
+
+	t.Run("a note of MaxNoteLength multi-byte characters fits", func(t *testing.T) {
+		_, err := reservation.NewNote(strings.Repeat("予", reservation.MaxNoteLength))
+		assert.NoError(t, err)
+	})
+}
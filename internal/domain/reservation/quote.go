@@ -0,0 +1,90 @@
+package reservation
+
+type TaxCalculator interface {
+	CalculateTaxCents(ctx ResourcePriceContext, subtotalCents int64) int64
+}
+
+// Quote is the itemized price for a slot: base - discount = subtotal, subtotal + tax = total.
+// The discount is the sum of the applied coupon line items and the redeemed points. The
+// reservation itself stores the subtotal; tax is reported to the client only.
+type Quote struct {
+	base      Money
+	discounts []AppliedDiscount
+	points    RedeemedPoints
+	tax       Money
+}
+
+// NewQuote prices slot with coupons, then redeems up to points loyalty points against
+// what the coupons leave. Whether the user holds that many points is checked by the caller.
+func NewQuote(services *Services, res ResourceSpec, slot TimeSlot, coupons []CouponSpec, points int64) (Quote, error) {
+	if err := services.ValidateLeadTime(res, slot); err != nil {
+		return Quote{}, err
+	}
+
+	priceCtx := ResourcePriceContext{ResourceID: res.ID}
+	base := services.PriceCalculator.CalculatePriceCents(priceCtx, slot)
+	if base < 0 {
+		return Quote{}, ErrNegativePrice
+	}
+
+	now := services.Clock.Now()
+	for _, coup := range coupons {
+		if !coup.ValidAt(now) {
+			return Quote{}, ErrInvalidCoupon
+		}
+	}
+	discounts, err := services.Stacking.Apply(base, coupons)
+	if err != nil {
+		return Quote{}, err
+	}
+
+	afterCoupons := base - totalDiscount(discounts)
+	redeemed := services.Points.Redeem(afterCoupons, points)
+
+	var tax int64
+	if services.TaxCalculator != nil {
+		tax = services.TaxCalculator.CalculateTaxCents(priceCtx, afterCoupons-int64(redeemed.Amount.Cents()))
+	}
+
+	return Quote{
+		base:      NewMoney(base),
+		discounts: discounts,
+		points:    redeemed,
+		tax:       NewMoney(tax),
+	}, nil
+}
+
+func (q Quote) Base() Money                  { return q.base }
+func (q Quote) Discounts() []AppliedDiscount { return q.discounts }
+func (q Quote) Points() RedeemedPoints       { return q.points }
+func (q Quote) Tax() Money                   { return q.tax }
+
+func (q Quote) Discount() Money {
+	return NewMoney(totalDiscount(q.discounts) + int64(q.points.Amount.Cents()))
+}
+
+func (q Quote) Subtotal() Money {
+	return NewMoney(int64(q.base.Cents()) - int64(q.Discount().Cents()))
+}
+
+func (q Quote) Total() Money {
+	return q.Subtotal().Add(q.tax)
+}
+
+type DefaultTaxCalculator struct {
+	RateBasisPoints int64
+}
+
+func NewDefaultTaxCalculator(rateBasisPoints int64) *DefaultTaxCalculator {
+	return &DefaultTaxCalculator{
+		RateBasisPoints: rateBasisPoints,
+	}
+}
+
+// Rounds half up to the nearest cent
+func (tc *DefaultTaxCalculator) CalculateTaxCents(_ ResourcePriceContext, subtotalCents int64) int64 {
+	if tc.RateBasisPoints <= 0 || subtotalCents <= 0 {
+		return 0
+	}
+	return (subtotalCents*tc.RateBasisPoints + 5000) / 10000
+}
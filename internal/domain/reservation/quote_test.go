@@ -0,0 +1,226 @@
+//go:build unit
+
+package reservation_test
+
+import (
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/domain/reservation"
+	"gin-clean-starter/internal/pkg/clock"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuote(t *testing.T) {
+	now := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	services := &reservation.Services{
+		Clock:           clock.NewMockClock(now),
+		PriceCalculator: reservation.NewDefaultPriceCalculator(),
+		TaxCalculator:   reservation.NewDefaultTaxCalculator(1000),
+	}
+	res := reservation.ResourceSpec{ID: uuid.New(), LeadTimeMin: 30}
+	slot, err := reservation.NewTimeSlot(now.Add(2*time.Hour), now.Add(4*time.Hour))
+	require.NoError(t, err)
+
+	t.Run("itemizes base, discount, tax and total", func(t *testing.T) {
+		amountOff := int32(50000)
+		coup := reservation.CouponSpec{ID: uuid.New(), Code: "half", AmountOffCents: &amountOff}
+
+		q, err := reservation.NewQuote(services, res, slot, []reservation.CouponSpec{coup}, 0)
+		require.NoError(t, err)
+
+		assert.Equal(t, 200000, q.Base().Cents())
+		assert.Equal(t, 50000, q.Discount().Cents())
+		assert.Equal(t, 150000, q.Subtotal().Cents())
+		assert.Equal(t, 15000, q.Tax().Cents())
+		assert.Equal(t, 165000, q.Total().Cents())
+		require.Len(t, q.Discounts(), 1)
+		assert.Equal(t, coup.ID, q.Discounts()[0].CouponID)
+	})
+
+	t.Run("no coupon means no discount", func(t *testing.T) {
+		q, err := reservation.NewQuote(services, res, slot, nil, 0)
+		require.NoError(t, err)
+
+		assert.Equal(t, 0, q.Discount().Cents())
+		assert.Equal(t, q.Base().Cents(), q.Subtotal().Cents())
+	})
+
+	t.Run("tax calculator is optional", func(t *testing.T) {
+		noTax := &reservation.Services{Clock: services.Clock, PriceCalculator: services.PriceCalculator}
+		q, err := reservation.NewQuote(noTax, res, slot, nil, 0)
+		require.NoError(t, err)
+
+		assert.Equal(t, 0, q.Tax().Cents())
+		assert.Equal(t, q.Subtotal().Cents(), q.Total().Cents())
+	})
+
+	t.Run("expired coupon is rejected", func(t *testing.T) {
+		validTo := now.Add(-time.Minute)
+		percentOff := 10.0
+		coup := reservation.CouponSpec{ID: uuid.New(), PercentOff: &percentOff, ValidTo: &validTo}
+
+		_, err := reservation.NewQuote(services, res, slot, []reservation.CouponSpec{coup}, 0)
+		assert.ErrorIs(t, err, reservation.ErrInvalidCoupon)
+	})
+
+	t.Run("second coupon is rejected under the default policy", func(t *testing.T) {
+		percentOff := 10.0
+		coupons := []reservation.CouponSpec{
+			{ID: uuid.New(), Code: "a", PercentOff: &percentOff},
+			{ID: uuid.New(), Code: "b", PercentOff: &percentOff},
+		}
+
+		_, err := reservation.NewQuote(services, res, slot, coupons, 0)
+		assert.ErrorIs(t, err, reservation.ErrCouponStackingNotAllowed)
+	})
+
+	t.Run("points apply after coupons and before tax", func(t *testing.T) {
+		withPoints := *services
+		withPoints.Points = reservation.PointsPolicy{CentsPerPoint: 10, MaxBasisPoints: 5000}
+		amountOff := int32(50000)
+		coup := reservation.CouponSpec{ID: uuid.New(), Code: "half", AmountOffCents: &amountOff}
+
+		q, err := reservation.NewQuote(&withPoints, res, slot, []reservation.CouponSpec{coup}, 1000)
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(1000), q.Points().Points)
+		assert.Equal(t, 10000, q.Points().Amount.Cents())
+		assert.Equal(t, 60000, q.Discount().Cents())
+		assert.Equal(t, 140000, q.Subtotal().Cents())
+		assert.Equal(t, 14000, q.Tax().Cents())
+	})
+
+	t.Run("points beyond the cap are not spent", func(t *testing.T) {
+		withPoints := *services
+		withPoints.Points = reservation.PointsPolicy{CentsPerPoint: 10, MaxBasisPoints: 5000}
+
+		q, err := reservation.NewQuote(&withPoints, res, slot, nil, 1_000_000)
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(10000), q.Points().Points)
+		assert.Equal(t, 100000, q.Points().Amount.Cents())
+		assert.Equal(t, 100000, q.Subtotal().Cents())
+	})
+
+	t.Run("points are ignored without a points policy", func(t *testing.T) {
+		q, err := reservation.NewQuote(services, res, slot, nil, 500)
+		require.NoError(t, err)
+
+		assert.Zero(t, q.Points().Points)
+		assert.Equal(t, q.Base().Cents(), q.Subtotal().Cents())
+	})
+
+	t.Run("slot inside lead time is rejected", func(t *testing.T) {
+		soon, err := reservation.NewTimeSlot(now.Add(10*time.Minute), now.Add(time.Hour))
+		require.NoError(t, err)
+
+		_, err = reservation.NewQuote(services, res, soon, nil, 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("quoted reservation keeps the quoted price", func(t *testing.T) {
+		r, err := reservation.NewQuotedReservation(services, res, uuid.New(), slot, nil, reservation.RedeemedPoints{}, reservation.Note{}, 12345)
+		require.NoError(t, err)
+
+		assert.Equal(t, 12345, r.Price().Cents())
+		assert.True(t, r.IsActive())
+	})
+}
+
+func TestDefaultTaxCalculator(t *testing.T) {
+	cases := []struct {
+		name     string
+		rateBps  int64
+		subtotal int64
+		want     int64
+	}{
+		{name: "zero rate", rateBps: 0, subtotal: 10000, want: 0},
+		{name: "ten percent", rateBps: 1000, subtotal: 10000, want: 1000},
+		{name: "rounds half up", rateBps: 1000, subtotal: 5, want: 1},
+		{name: "rounds down below half", rateBps: 1000, subtotal: 4, want: 0},
+		{name: "zero subtotal", rateBps: 1000, subtotal: 0, want: 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			calc := reservation.NewDefaultTaxCalculator(tc.rateBps)
+			assert.Equal(t, tc.want, calc.CalculateTaxCents(reservation.ResourcePriceContext{}, tc.subtotal))
+		})
+	}
+}
+
+func TestNewPointsPolicy(t *testing.T) {
+	p, err := reservation.NewPointsPolicy(1, 5000)
+	require.NoError(t, err)
+	assert.Equal(t, reservation.PointsPolicy{CentsPerPoint: 1, MaxBasisPoints: 5000}, p)
+
+	_, err = reservation.NewPointsPolicy(0, 5000)
+	assert.Error(t, err)
+
+	_, err = reservation.NewPointsPolicy(1, 10001)
+	assert.Error(t, err)
+}
+
+func TestServices_ValidateLeadTime(t *testing.T) {
+	now := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	res := reservation.ResourceSpec{ID: uuid.New(), LeadTimeMin: 60}
+	slotAt := func(start time.Time) reservation.TimeSlot {
+		slot, err := reservation.NewTimeSlot(start, start.Add(time.Hour))
+		require.NoError(t, err)
+		return slot
+	}
+	earliest := now.Add(time.Hour) // the slot must start after this
+
+	testCases := []struct {
+		name    string
+		skew    time.Duration
+		start   time.Time
+		wantErr bool
+	}{
+		{name: "no skew: just after the lead time", start: earliest.Add(time.Nanosecond)},
+		{name: "no skew: exactly at the lead time", start: earliest, wantErr: true},
+		{name: "skew: within the tolerance", skew: 30 * time.Second, start: earliest.Add(-29 * time.Second)},
+		{name: "skew: at the edge of the tolerance", skew: 30 * time.Second, start: earliest.Add(-30 * time.Second), wantErr: true},
+		{name: "skew: beyond the tolerance", skew: 30 * time.Second, start: earliest.Add(-time.Minute), wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			services := &reservation.Services{
+				Clock:           clock.NewMockClock(now),
+				PriceCalculator: reservation.NewDefaultPriceCalculator(),
+				ClockSkew:       tc.skew,
+			}
+			slot := slotAt(tc.start)
+
+			err := services.ValidateLeadTime(res, slot)
+			_, quoteErr := reservation.NewQuote(services, res, slot, nil, 0)
+			_, quotedErr := reservation.NewQuotedReservation(services, res, uuid.New(), slot, nil, reservation.RedeemedPoints{}, reservation.Note{}, 100)
+
+			if tc.wantErr {
+				assert.ErrorIs(t, err, reservation.ErrLeadTimeNotMet)
+				assert.ErrorIs(t, quoteErr, reservation.ErrLeadTimeNotMet)
+				assert.ErrorIs(t, quotedErr, reservation.ErrLeadTimeNotMet)
+				return
+			}
+			assert.NoError(t, err)
+			assert.NoError(t, quoteErr)
+			assert.NoError(t, quotedErr)
+		})
+	}
+}
+
+func TestCouponSpec_ValidAt(t *testing.T) {
+	now := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	before, after := now.Add(-time.Minute), now.Add(time.Minute)
+
+	assert.True(t, reservation.CouponSpec{}.ValidAt(now))
+	assert.True(t, reservation.CouponSpec{ValidFrom: &now, ValidTo: &now}.ValidAt(now), "both bounds are inclusive")
+	assert.True(t, reservation.CouponSpec{ValidFrom: &before, ValidTo: &after}.ValidAt(now))
+	assert.False(t, reservation.CouponSpec{ValidFrom: &after}.ValidAt(now))
+	assert.False(t, reservation.CouponSpec{ValidTo: &before}.ValidAt(now))
+}
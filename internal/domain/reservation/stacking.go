@@ -0,0 +1,134 @@
+package reservation
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrCouponStackingNotAllowed = errors.New("coupons cannot be combined")
+	ErrDuplicateCoupon          = errors.New("coupon applied more than once")
+)
+
+// StackingRule decides how several coupons on one reservation combine.
+type StackingRule string
+
+const (
+	// StackingExclusive accepts a single coupon per reservation.
+	StackingExclusive StackingRule = "exclusive"
+	// StackingUpToCap applies every coupon in priority order, each to the running subtotal,
+	// until the combined discount reaches CapBasisPoints of the base price.
+	StackingUpToCap StackingRule = "stack_up_to_cap"
+	// StackingBestOf applies only the coupon with the largest discount on its own.
+	StackingBestOf StackingRule = "best_of"
+)
+
+type StackingPolicy struct {
+	Rule           StackingRule
+	CapBasisPoints int64 // stack_up_to_cap only; 10000 = the whole base price
+}
+
+func NewStackingPolicy(rule string, capBasisPoints int64) (StackingPolicy, error) {
+	switch StackingRule(rule) {
+	case StackingExclusive, StackingUpToCap, StackingBestOf:
+	default:
+		return StackingPolicy{}, fmt.Errorf("unknown coupon stacking rule %q", rule)
+	}
+	if capBasisPoints < 0 || capBasisPoints > 10000 {
+		return StackingPolicy{}, fmt.Errorf("coupon stacking cap must be between 0 and 10000 basis points, got %d", capBasisPoints)
+	}
+	return StackingPolicy{Rule: StackingRule(rule), CapBasisPoints: capBasisPoints}, nil
+}
+
+// AppliedDiscount is one discount line item of a quote or reservation.
+type AppliedDiscount struct {
+	CouponID uuid.UUID
+	Code     string
+	Amount   Money
+}
+
+// Apply returns the discount line items for base, in the order they were applied.
+// Coupons are ordered by descending priority, then by code, so the result does not
+// depend on the order the client listed them in.
+func (p StackingPolicy) Apply(base int64, coupons []CouponSpec) ([]AppliedDiscount, error) {
+	if len(coupons) == 0 {
+		return nil, nil
+	}
+
+	seen := make(map[uuid.UUID]struct{}, len(coupons))
+	for _, c := range coupons {
+		if _, dup := seen[c.ID]; dup {
+			return nil, ErrDuplicateCoupon
+		}
+		seen[c.ID] = struct{}{}
+	}
+
+	ordered := make([]CouponSpec, len(coupons))
+	copy(ordered, coupons)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		if ordered[i].Priority != ordered[j].Priority {
+			return ordered[i].Priority > ordered[j].Priority
+		}
+		return ordered[i].Code < ordered[j].Code
+	})
+
+	switch p.Rule {
+	case StackingUpToCap:
+		return p.stackUpToCap(base, ordered), nil
+	case StackingBestOf:
+		return bestOf(base, ordered), nil
+	default:
+		if len(ordered) > 1 {
+			return nil, ErrCouponStackingNotAllowed
+		}
+		return []AppliedDiscount{discountLine(ordered[0], base)}, nil
+	}
+}
+
+func (p StackingPolicy) stackUpToCap(base int64, ordered []CouponSpec) []AppliedDiscount {
+	remainingCap := base * p.CapBasisPoints / 10000
+	running := base
+	lines := make([]AppliedDiscount, 0, len(ordered))
+	for _, c := range ordered {
+		line := discountLine(c, running)
+		amount := int64(line.Amount.Cents())
+		if amount > remainingCap {
+			amount = remainingCap
+			line.Amount = NewMoney(amount)
+		}
+		remainingCap -= amount
+		running -= amount
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// Ties keep the earlier coupon, i.e. the one with the higher priority.
+func bestOf(base int64, ordered []CouponSpec) []AppliedDiscount {
+	best := discountLine(ordered[0], base)
+	for _, c := range ordered[1:] {
+		if line := discountLine(c, base); line.Amount.Cents() > best.Amount.Cents() {
+			best = line
+		}
+	}
+	return []AppliedDiscount{best}
+}
+
+func discountLine(c CouponSpec, amount int64) AppliedDiscount {
+	return AppliedDiscount{
+		CouponID: c.ID,
+		Code:     c.Code,
+		Amount:   NewMoney(amount - applyDiscount(amount, c.AmountOffCents, c.PercentOff)),
+	}
+}
+
+func totalDiscount(lines []AppliedDiscount) int64 {
+	var total int64
+	for _, l := range lines {
+		total += int64(l.Amount.Cents())
+	}
+	return total
+}
@@ -0,0 +1,154 @@
+//go:build unit
+
+package reservation_test
+
+import (
+	"testing"
+
+	"gin-clean-starter/internal/domain/reservation"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func percentCoupon(code string, priority int, percent float64) reservation.CouponSpec {
+	return reservation.CouponSpec{ID: uuid.New(), Code: code, Priority: priority, PercentOff: &percent}
+}
+
+func amountCoupon(code string, priority int, cents int32) reservation.CouponSpec {
+	return reservation.CouponSpec{ID: uuid.New(), Code: code, Priority: priority, AmountOffCents: &cents}
+}
+
+func discountCodes(lines []reservation.AppliedDiscount) []string {
+	codes := make([]string, len(lines))
+	for i, l := range lines {
+		codes[i] = l.Code
+	}
+	return codes
+}
+
+func discountAmounts(lines []reservation.AppliedDiscount) []int {
+	amounts := make([]int, len(lines))
+	for i, l := range lines {
+		amounts[i] = l.Amount.Cents()
+	}
+	return amounts
+}
+
+func TestStackingPolicy_Apply(t *testing.T) {
+	const base = 10000
+
+	t.Run("no coupons yields no lines", func(t *testing.T) {
+		lines, err := reservation.StackingPolicy{}.Apply(base, nil)
+		require.NoError(t, err)
+		assert.Empty(t, lines)
+	})
+
+	t.Run("exclusive accepts a single coupon", func(t *testing.T) {
+		policy := reservation.StackingPolicy{Rule: reservation.StackingExclusive}
+		lines, err := policy.Apply(base, []reservation.CouponSpec{amountCoupon("flat", 0, 1500)})
+		require.NoError(t, err)
+		assert.Equal(t, []int{1500}, discountAmounts(lines))
+	})
+
+	t.Run("exclusive rejects a second coupon", func(t *testing.T) {
+		policy := reservation.StackingPolicy{Rule: reservation.StackingExclusive}
+		_, err := policy.Apply(base, []reservation.CouponSpec{
+			amountCoupon("a", 0, 100),
+			amountCoupon("b", 0, 100),
+		})
+		assert.ErrorIs(t, err, reservation.ErrCouponStackingNotAllowed)
+	})
+
+	t.Run("duplicate coupon is rejected", func(t *testing.T) {
+		c := amountCoupon("dup", 0, 100)
+		policy := reservation.StackingPolicy{Rule: reservation.StackingUpToCap, CapBasisPoints: 10000}
+		_, err := policy.Apply(base, []reservation.CouponSpec{c, c})
+		assert.ErrorIs(t, err, reservation.ErrDuplicateCoupon)
+	})
+
+	t.Run("stack applies coupons by priority to the running subtotal", func(t *testing.T) {
+		policy := reservation.StackingPolicy{Rule: reservation.StackingUpToCap, CapBasisPoints: 10000}
+		lines, err := policy.Apply(base, []reservation.CouponSpec{
+			percentCoupon("tenpct", 0, 10),
+			amountCoupon("flat", 5, 2000),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"flat", "tenpct"}, discountCodes(lines))
+		assert.Equal(t, []int{2000, 800}, discountAmounts(lines))
+	})
+
+	t.Run("stack clamps at the cap", func(t *testing.T) {
+		policy := reservation.StackingPolicy{Rule: reservation.StackingUpToCap, CapBasisPoints: 2500}
+		lines, err := policy.Apply(base, []reservation.CouponSpec{
+			amountCoupon("first", 2, 2000),
+			amountCoupon("second", 1, 2000),
+			amountCoupon("third", 0, 2000),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []int{2000, 500, 0}, discountAmounts(lines))
+	})
+
+	t.Run("equal priority orders by code regardless of input order", func(t *testing.T) {
+		policy := reservation.StackingPolicy{Rule: reservation.StackingUpToCap, CapBasisPoints: 10000}
+		a, b := percentCoupon("alpha", 0, 10), amountCoupon("beta", 0, 1000)
+
+		forward, err := policy.Apply(base, []reservation.CouponSpec{a, b})
+		require.NoError(t, err)
+		reversed, err := policy.Apply(base, []reservation.CouponSpec{b, a})
+		require.NoError(t, err)
+
+		assert.Equal(t, forward, reversed)
+		assert.Equal(t, []string{"alpha", "beta"}, discountCodes(forward))
+	})
+
+	t.Run("best_of keeps the largest discount", func(t *testing.T) {
+		policy := reservation.StackingPolicy{Rule: reservation.StackingBestOf}
+		lines, err := policy.Apply(base, []reservation.CouponSpec{
+			amountCoupon("small", 9, 500),
+			percentCoupon("big", 0, 30),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"big"}, discountCodes(lines))
+		assert.Equal(t, []int{3000}, discountAmounts(lines))
+	})
+
+	t.Run("best_of tie goes to the higher priority", func(t *testing.T) {
+		policy := reservation.StackingPolicy{Rule: reservation.StackingBestOf}
+		lines, err := policy.Apply(base, []reservation.CouponSpec{
+			amountCoupon("low", 0, 1000),
+			amountCoupon("high", 1, 1000),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"high"}, discountCodes(lines))
+	})
+}
+
+func TestNewStackingPolicy(t *testing.T) {
+	cases := []struct {
+		name    string
+		rule    string
+		capBps  int64
+		wantErr bool
+	}{
+		{name: "exclusive", rule: "exclusive"},
+		{name: "stack up to cap", rule: "stack_up_to_cap", capBps: 5000},
+		{name: "best of", rule: "best_of"},
+		{name: "unknown rule", rule: "everything", wantErr: true},
+		{name: "negative cap", rule: "stack_up_to_cap", capBps: -1, wantErr: true},
+		{name: "cap above base", rule: "stack_up_to_cap", capBps: 10001, wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			policy, err := reservation.NewStackingPolicy(tc.rule, tc.capBps)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, reservation.StackingRule(tc.rule), policy.Rule)
+		})
+	}
+}
@@ -3,8 +3,9 @@ package reservation
 type Status string
 
 const (
-	StatusConfirmed Status = "confirmed"
-	StatusCanceled  Status = "canceled"
+	StatusConfirmed       Status = "confirmed"
+	StatusCanceled        Status = "canceled"
+	StatusPendingApproval Status = "pending_approval"
 )
 
 func (s Status) String() string {
@@ -13,7 +14,7 @@ func (s Status) String() string {
 
 func (s Status) IsValid() bool {
 	switch s {
-	case StatusConfirmed, StatusCanceled:
+	case StatusConfirmed, StatusCanceled, StatusPendingApproval:
 		return true
 	default:
 		return false
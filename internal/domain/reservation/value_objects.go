@@ -3,6 +3,7 @@ package reservation
 import (
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"gin-clean-starter/internal/pkg/errs"
 )
@@ -12,7 +13,11 @@ type TimeSlot struct {
 	end   time.Time
 }
 
+// NewTimeSlot rejects empty slots and unset (zero) times, which JSON binding lets through.
 func NewTimeSlot(start, end time.Time) (TimeSlot, error) {
+	if start.IsZero() {
+		return TimeSlot{}, errs.New("start time is required")
+	}
 	if start.After(end) || start.Equal(end) {
 		return TimeSlot{}, errs.New("start time must be before end time")
 	}
@@ -35,6 +40,12 @@ func (ts TimeSlot) Duration() time.Duration {
 	return ts.end.Sub(ts.start)
 }
 
+// Overlaps treats slots as half-open, like the reservations' tstzrange: back-to-back
+// slots do not overlap.
+func (ts TimeSlot) Overlaps(other TimeSlot) bool {
+	return ts.start.Before(other.end) && other.start.Before(ts.end)
+}
+
 func (ts TimeSlot) MeetsLeadTimeAt(now time.Time, leadTimeMinutes int) bool {
 	requiredTime := now.Add(time.Duration(leadTimeMinutes) * time.Minute)
 	return ts.start.After(requiredTime)
@@ -42,7 +53,7 @@ func (ts TimeSlot) MeetsLeadTimeAt(now time.Time, leadTimeMinutes int) bool {
 
 func (ts TimeSlot) ValidateLeadTimeAt(now time.Time, leadTimeMinutes int) error {
 	if !ts.MeetsLeadTimeAt(now, leadTimeMinutes) {
-		return errs.New("insufficient lead time")
+		return ErrLeadTimeNotMet
 	}
 	return nil
 }
@@ -74,6 +85,7 @@ func (m Money) Add(other Money) Money {
 	return Money{cents: m.cents + other.cents}
 }
 
+// MaxNoteLength is counted in characters, like review comments.
 const MaxNoteLength = 1000
 
 var ErrNoteTooLong = errs.New("note exceeds maximum length")
@@ -84,7 +96,7 @@ type Note struct {
 
 func NewNote(value string) (Note, error) {
 	v := strings.TrimSpace(value)
-	if len(v) > MaxNoteLength {
+	if utf8.RuneCountInString(v) > MaxNoteLength {
 		return Note{}, ErrNoteTooLong
 	}
 	return Note{value: v}, nil
@@ -0,0 +1,41 @@
+//go:build unit
+
+package reservation_test
+
+import (
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/domain/reservation"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeSlot_Overlaps(t *testing.T) {
+	base := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+	slot := func(fromHour, toHour int) reservation.TimeSlot {
+		s, err := reservation.NewTimeSlot(base.Add(time.Duration(fromHour)*time.Hour), base.Add(time.Duration(toHour)*time.Hour))
+		require.NoError(t, err)
+		return s
+	}
+
+	testCases := []struct {
+		name     string
+		a, b     reservation.TimeSlot
+		overlaps bool
+	}{
+		{"identical slots", slot(0, 2), slot(0, 2), true},
+		{"partial overlap", slot(0, 2), slot(1, 3), true},
+		{"one contains the other", slot(0, 4), slot(1, 2), true},
+		{"back to back", slot(0, 2), slot(2, 3), false},
+		{"disjoint", slot(0, 1), slot(3, 4), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.overlaps, tc.a.Overlaps(tc.b))
+			assert.Equal(t, tc.overlaps, tc.b.Overlaps(tc.a))
+		})
+	}
+}
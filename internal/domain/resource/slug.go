@@ -0,0 +1,63 @@
+package resource
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidSlug = errors.New("invalid resource slug")
+
+const (
+	MaxSlugLength = 80
+	// slugBaseLength leaves room for the id suffix SlugFor appends.
+	slugBaseLength = 60
+)
+
+var slugRegex = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// Slug names a resource in public URLs in place of its id: lower-case ASCII letters and
+// digits in hyphen-separated words.
+type Slug string
+
+func NewSlug(s string) (Slug, error) {
+	if len(s) > MaxSlugLength || !slugRegex.MatchString(s) {
+		return Slug(""), ErrInvalidSlug
+	}
+	return Slug(s), nil
+}
+
+// SlugFor derives a resource's slug from its name, suffixed with the first 8 characters of
+// its id so resources sharing a name stay apart. Characters outside [a-z0-9] separate words;
+// a name without any leaves the suffix alone. Migration 037 backfills slugs the same way.
+func SlugFor(name string, id uuid.UUID) Slug {
+	var b strings.Builder
+	pendingHyphen := false
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if pendingHyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			pendingHyphen = false
+			b.WriteRune(r)
+			continue
+		}
+		pendingHyphen = true
+	}
+	base := b.String()
+	if len(base) > slugBaseLength {
+		base = strings.TrimRight(base[:slugBaseLength], "-")
+	}
+
+	suffix := id.String()[:8]
+	if base == "" {
+		return Slug(suffix)
+	}
+	return Slug(base + "-" + suffix)
+}
+
+func (s Slug) String() string {
+	return string(s)
+}
@@ -0,0 +1,55 @@
+//go:build unit
+
+package resource_test
+
+import (
+	"strings"
+	"testing"
+
+	"gin-clean-starter/internal/domain/resource"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlugFor(t *testing.T) {
+	id := uuid.MustParse("0b9f3c2e-6a4d-4c1e-9d7a-2f5b8e1c3a40")
+
+	testCases := []struct {
+		name     string
+		resource string
+		expected resource.Slug
+	}{
+		{"words are joined by single hyphens", "Meeting Room A", "meeting-room-a-0b9f3c2e"},
+		{"punctuation and edges are dropped", "  Studio #2 (Annex) ", "studio-2-annex-0b9f3c2e"},
+		{"non-ASCII letters separate words", "Café Zürich", "caf-z-rich-0b9f3c2e"},
+		{"a name without ASCII letters keeps only the suffix", "会議室", "0b9f3c2e"},
+		{"long names are cut at a word boundary", strings.Repeat("a", 59) + " bcd", resource.Slug(strings.Repeat("a", 59) + "-0b9f3c2e")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			slug := resource.SlugFor(tc.resource, id)
+			assert.Equal(t, tc.expected, slug)
+
+			_, err := resource.NewSlug(slug.String())
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestNewSlug(t *testing.T) {
+	valid := []string{"room-a", "a", "2f-b8"}
+	for _, s := range valid {
+		got, err := resource.NewSlug(s)
+		require.NoError(t, err, s)
+		assert.Equal(t, s, got.String())
+	}
+
+	invalid := []string{"", "Room-A", "room--a", "-room", "room-", "room_a", "room a", strings.Repeat("a", resource.MaxSlugLength+1)}
+	for _, s := range invalid {
+		_, err := resource.NewSlug(s)
+		assert.ErrorIs(t, err, resource.ErrInvalidSlug, s)
+	}
+}
@@ -11,6 +11,17 @@ import (
 var (
 	ErrReservationNotEligible = errs.New("reservation is not eligible for review")
 	ErrReviewAlreadyExists    = errs.New("review already exists for this reservation")
+	ErrReviewWindowNotOpen    = errs.New("review window has not opened yet")
+	ErrReviewWindowClosed     = errs.New("review window has closed")
+	ErrImportedTimestamps     = errs.New("imported review must be created in the past and updated after it was created")
+)
+
+// Status says whether a review is listed publicly or held for moderation.
+type Status string
+
+const (
+	StatusPublished Status = "published"
+	StatusFlagged   Status = "flagged"
 )
 
 type Review struct {
@@ -20,6 +31,9 @@ type Review struct {
 	reservationID uuid.UUID
 	rating        Rating
 	comment       Comment
+	status        Status
+	duplicateOf   *uuid.UUID
+	language      string
 	createdAt     time.Time
 	updatedAt     time.Time
 }
@@ -46,16 +60,67 @@ func NewReview(id, userID, resourceID, reservationID uuid.UUID, ratingValue int,
 		reservationID: reservationID,
 		rating:        rating,
 		comment:       comment,
+		status:        StatusPublished,
 		createdAt:     now,
 		updatedAt:     now,
 	}, nil
 }
 
+// NewImportedReview carries over a review written in another system. It keeps its original
+// timestamps and has no reservation; a zero updatedAt means it was never edited.
+func NewImportedReview(userID, resourceID uuid.UUID, ratingValue int, commentText string, createdAt, updatedAt, now time.Time) (*Review, error) {
+	if updatedAt.IsZero() {
+		updatedAt = createdAt
+	}
+	if createdAt.IsZero() || updatedAt.After(now) || updatedAt.Before(createdAt) {
+		return nil, ErrImportedTimestamps
+	}
+	r, err := NewReview(uuid.Nil, userID, resourceID, uuid.Nil, ratingValue, commentText, createdAt)
+	if err != nil {
+		return nil, err
+	}
+	r.updatedAt = updatedAt
+	return r, nil
+}
+
+// Window is when a reservation can be reviewed, counted from its end: from OpensAfter until
+// ClosesAfter. A zero ClosesAfter never closes.
+type Window struct {
+	OpensAfter  time.Duration
+	ClosesAfter time.Duration
+}
+
+// Check reports whether a reservation ending at end can be reviewed at now. Both edges
+// give way by skew, so a client whose clock is slightly off ours sees the same window.
+func (w Window) Check(end, now time.Time, skew time.Duration) error {
+	if !end.Add(w.OpensAfter).Before(now.Add(skew)) {
+		return ErrReviewWindowNotOpen
+	}
+	if w.ClosesAfter > 0 && now.Add(-skew).After(end.Add(w.ClosesAfter)) {
+		return ErrReviewWindowClosed
+	}
+	return nil
+}
+
+// FlagAsDuplicateOf holds the review for moderation as a near copy of original.
+func (r *Review) FlagAsDuplicateOf(original uuid.UUID) {
+	r.status = StatusFlagged
+	r.duplicateOf = &original
+}
+
+// TagLanguage records the comment's language as an ISO 639-1 code; "" leaves it unknown.
+func (r *Review) TagLanguage(code string) {
+	r.language = code
+}
+
 func (r *Review) ID() uuid.UUID            { return r.id }
 func (r *Review) UserID() uuid.UUID        { return r.userID }
 func (r *Review) ResourceID() uuid.UUID    { return r.resourceID }
-func (r *Review) ReservationID() uuid.UUID { return r.reservationID }
+func (r *Review) ReservationID() uuid.UUID { return r.reservationID } // Nil when imported
 func (r *Review) Rating() Rating           { return r.rating }
 func (r *Review) Comment() Comment         { return r.comment }
+func (r *Review) Status() Status           { return r.status }
+func (r *Review) DuplicateOf() *uuid.UUID  { return r.duplicateOf }
+func (r *Review) Language() string         { return r.language }
 func (r *Review) CreatedAt() time.Time     { return r.createdAt }
 func (r *Review) UpdatedAt() time.Time     { return r.updatedAt }
@@ -34,6 +34,19 @@ func TestReview(t *testing.T) {
 		assert.Equal(t, "Excellent service!", actual.Comment().String())
 	})
 
+	t.Run("flagging as a duplicate", func(t *testing.T) {
+		actual, err := builder.NewReviewBuilder().BuildDomain()
+		require.NoError(t, err)
+		assert.Equal(t, review.StatusPublished, actual.Status())
+		assert.Nil(t, actual.DuplicateOf())
+
+		original := uuid.New()
+		actual.FlagAsDuplicateOf(original)
+		assert.Equal(t, review.StatusFlagged, actual.Status())
+		require.NotNil(t, actual.DuplicateOf())
+		assert.Equal(t, original, *actual.DuplicateOf())
+	})
+
 	t.Run("rating validation", func(t *testing.T) {
 		runCases(t, []testCase{
 			{
@@ -150,3 +163,86 @@ func runCases(t *testing.T, cases []testCase) {
 		})
 	}
 }
+
+func TestWindow_Check(t *testing.T) {
+	end := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	window := review.Window{OpensAfter: time.Hour, ClosesAfter: 90 * day}
+	opens := end.Add(time.Hour)
+	closes := end.Add(90 * day)
+
+	testCases := []struct {
+		name    string
+		window  review.Window
+		now     time.Time
+		skew    time.Duration
+		wantErr error
+	}{
+		{name: "default window: just after the end", now: end.Add(time.Nanosecond)},
+		{name: "default window: at the end", now: end, wantErr: review.ErrReviewWindowNotOpen},
+		{name: "default window: years later", now: end.Add(10 * 365 * day)},
+		{name: "before the window opens", window: window, now: opens.Add(-time.Minute), wantErr: review.ErrReviewWindowNotOpen},
+		{name: "exactly when the window opens", window: window, now: opens, wantErr: review.ErrReviewWindowNotOpen},
+		{name: "inside the window", window: window, now: opens.Add(day)},
+		{name: "exactly when the window closes", window: window, now: closes},
+		{name: "after the window closes", window: window, now: closes.Add(time.Nanosecond), wantErr: review.ErrReviewWindowClosed},
+		{name: "skew: opening within the tolerance", window: window, now: opens.Add(-29 * time.Second), skew: 30 * time.Second},
+		{name: "skew: opening at the edge of the tolerance", window: window, now: opens.Add(-30 * time.Second), skew: 30 * time.Second, wantErr: review.ErrReviewWindowNotOpen},
+		{name: "skew: closing within the tolerance", window: window, now: closes.Add(30 * time.Second), skew: 30 * time.Second},
+		{name: "skew: closing beyond the tolerance", window: window, now: closes.Add(31 * time.Second), skew: 30 * time.Second, wantErr: review.ErrReviewWindowClosed},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.window.Check(end, tc.now, tc.skew)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestNewImportedReview(t *testing.T) {
+	now := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+	created := now.Add(-30 * 24 * time.Hour)
+	userID, resourceID := uuid.New(), uuid.New()
+
+	t.Run("keeps the original timestamps", func(t *testing.T) {
+		actual, err := review.NewImportedReview(userID, resourceID, 4, " Quiet room ", created, created.Add(time.Hour), now)
+		require.NoError(t, err)
+		assert.NotEqual(t, uuid.Nil, actual.ID())
+		assert.Equal(t, uuid.Nil, actual.ReservationID())
+		assert.Equal(t, review.StatusPublished, actual.Status())
+		assert.Equal(t, "Quiet room", actual.Comment().String())
+		assert.Equal(t, created, actual.CreatedAt())
+		assert.Equal(t, created.Add(time.Hour), actual.UpdatedAt())
+	})
+
+	t.Run("a zero updatedAt means never edited", func(t *testing.T) {
+		actual, err := review.NewImportedReview(userID, resourceID, 4, "Quiet room", created, time.Time{}, now)
+		require.NoError(t, err)
+		assert.Equal(t, created, actual.UpdatedAt())
+	})
+
+	testCases := []struct {
+		name      string
+		rating    int
+		createdAt time.Time
+		updatedAt time.Time
+		errIs     error
+	}{
+		{name: "missing createdAt", rating: 4, errIs: review.ErrImportedTimestamps},
+		{name: "created in the future", rating: 4, createdAt: now.Add(time.Minute), errIs: review.ErrImportedTimestamps},
+		{name: "updated before created", rating: 4, createdAt: created, updatedAt: created.Add(-time.Minute), errIs: review.ErrImportedTimestamps},
+		{name: "updated in the future", rating: 4, createdAt: created, updatedAt: now.Add(time.Minute), errIs: review.ErrImportedTimestamps},
+		{name: "rating out of range", rating: 6, createdAt: created, errIs: review.ErrInvalidRating},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := review.NewImportedReview(userID, resourceID, tc.rating, "Quiet room", tc.createdAt, tc.updatedAt, now)
+			assert.ErrorIs(t, err, tc.errIs)
+		})
+	}
+}
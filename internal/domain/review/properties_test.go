@@ -0,0 +1,59 @@
+//go:build unit
+
+package review_test
+
+import (
+	"strings"
+	"testing"
+	"testing/quick"
+	"time"
+	"unicode/utf8"
+
+	"gin-clean-starter/internal/domain/review"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var propertyConfig = &quick.Config{MaxCount: 2000}
+
+func TestReview_Properties(t *testing.T) {
+	t.Run("a rating is built exactly for 1 to 5", func(t *testing.T) {
+		prop := func(v int) bool {
+			r, err := review.NewRating(v)
+			if v < 1 || v > 5 {
+				return err != nil
+			}
+			return err == nil && r.Value() == v
+		}
+		assert.NoError(t, quick.Check(prop, propertyConfig))
+	})
+
+	t.Run("a comment is trimmed, non-empty, bounded in characters and stable", func(t *testing.T) {
+		prop := func(s string, repeat uint8) bool {
+			s = strings.Repeat(s, int(repeat)+1)
+			c, err := review.NewComment(s)
+			trimmed := strings.TrimSpace(s)
+			if trimmed == "" || utf8.RuneCountInString(trimmed) > review.MaxCommentLength {
+				return err != nil
+			}
+			again, err2 := review.NewComment(c.String())
+			return err == nil && err2 == nil && c.String() == trimmed && again == c
+		}
+		assert.NoError(t, quick.Check(prop, propertyConfig))
+	})
+
+	t.Run("a built review carries a valid rating and comment", func(t *testing.T) {
+		now := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+		prop := func(rating int8, comment string) bool {
+			r, err := review.NewReview(uuid.Nil, uuid.New(), uuid.New(), uuid.New(), int(rating), comment, now)
+			if err != nil {
+				return r == nil
+			}
+			_, ratingErr := review.NewRating(r.Rating().Value())
+			_, commentErr := review.NewComment(r.Comment().String())
+			return ratingErr == nil && commentErr == nil && r.ID() != uuid.Nil && r.Status() == review.StatusPublished
+		}
+		assert.NoError(t, quick.Check(prop, propertyConfig))
+	})
+}
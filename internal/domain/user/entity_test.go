@@ -85,9 +85,13 @@ func TestUser(t *testing.T) {
 				name:   "viewer ロールOK",
 				mutate: func(b *builder.UserBuilder) { b.WithRole("viewer") },
 			},
+			{
+				name:   "カスタムロールOK",
+				mutate: func(b *builder.UserBuilder) { b.WithRole("support_agent") },
+			},
 			{
 				name:   "無効なロールNG",
-				mutate: func(b *builder.UserBuilder) { b.WithRole("invalid_role") },
+				mutate: func(b *builder.UserBuilder) { b.WithRole("Invalid-Role!") },
 				errIs:  user.ErrInvalidRole,
 			},
 			{
@@ -128,6 +132,33 @@ func TestUser(t *testing.T) {
 	})
 }
 
+func TestChangeRole(t *testing.T) {
+	actorID := uuid.New()
+	userID := uuid.New()
+
+	t.Run("他ユーザーのロール変更OK", func(t *testing.T) {
+		role, err := user.ChangeRole(actorID, userID, user.RoleViewer, "support_agent")
+		require.NoError(t, err)
+		assert.Equal(t, user.Role("support_agent"), role)
+	})
+
+	t.Run("自分のロール変更NG", func(t *testing.T) {
+		_, err := user.ChangeRole(actorID, actorID, user.RoleAdmin, "viewer")
+		require.ErrorIs(t, err, user.ErrOwnRoleChange)
+	})
+
+	t.Run("自分の現在のロール指定OK", func(t *testing.T) {
+		role, err := user.ChangeRole(actorID, actorID, user.RoleAdmin, "admin")
+		require.NoError(t, err)
+		assert.Equal(t, user.RoleAdmin, role)
+	})
+
+	t.Run("無効なロールNG", func(t *testing.T) {
+		_, err := user.ChangeRole(actorID, userID, user.RoleViewer, "Invalid-Role!")
+		require.ErrorIs(t, err, user.ErrInvalidRole)
+	})
+}
+
 func runCases(t *testing.T, cases []testCase) {
 	t.Helper()
 	for _, c := range cases {
@@ -1,20 +1,42 @@
 package user
 
+import (
+	"regexp"
+
+	"gin-clean-starter/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
 type Role string
 
+// Built-in roles; admins may define further roles whose permissions live in the database.
 const (
 	RoleViewer   Role = "viewer"
 	RoleOperator Role = "operator"
 	RoleAdmin    Role = "admin"
+	// RoleOwner runs a self-registered company; its grants are scoped to assigned resources.
+	RoleOwner Role = "owner"
 )
 
+// ErrOwnRoleChange keeps users from changing their own role: an admin could lock
+// themselves out, or grant themselves a role other admins keep from them.
+var ErrOwnRoleChange = errs.New("users cannot change their own role")
+
+// Mirrors the CHECK constraint on roles.name
+var roleNameRegex = regexp.MustCompile(`^[a-z][a-z0-9_]{1,49}$`)
+
 func (r Role) String() string {
 	return string(r)
 }
 
 func (r Role) IsValid() bool {
+	return roleNameRegex.MatchString(string(r))
+}
+
+func (r Role) IsBuiltin() bool {
 	switch r {
-	case RoleViewer, RoleOperator, RoleAdmin:
+	case RoleViewer, RoleOperator, RoleAdmin, RoleOwner:
 		return true
 	default:
 		return false
@@ -28,3 +50,16 @@ func NewRole(s string) (Role, error) {
 	}
 	return role, nil
 }
+
+// ChangeRole checks that actorID may move userID from the current role to the one named
+// next, and returns that role. Whether it exists is up to the roles table.
+func ChangeRole(actorID, userID uuid.UUID, current Role, next string) (Role, error) {
+	role, err := NewRole(next)
+	if err != nil {
+		return "", err
+	}
+	if actorID == userID && role != current {
+		return "", ErrOwnRoleChange
+	}
+	return role, nil
+}
@@ -0,0 +1,112 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/handler/render"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ActivityHandler serves the admin lists of what the system did: the audit log and the
+// notification job queue.
+type ActivityHandler struct {
+	auditLogQueries        queries.AuditLogQueries
+	notificationJobQueries queries.NotificationJobQueries
+}
+
+func NewActivityHandler(auditLogQueries queries.AuditLogQueries, notificationJobQueries queries.NotificationJobQueries) *ActivityHandler {
+	return &ActivityHandler{
+		auditLogQueries:        auditLogQueries,
+		notificationJobQueries: notificationJobQueries,
+	}
+}
+
+// @Summary List audit log entries
+// @Description List audit log entries newest first, optionally only one action or actor (audit_logs:read)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param action query string false "Only entries with this action, e.g. reservation.transfer_override"
+// @Param actor_id query string false "Only entries by this user"
+// @Param after query string false "Pagination cursor"
+// @Param limit query int false "Page size"
+// @Success 200 {object} response.AuditLogListResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/audit-logs [get]
+func (h *ActivityHandler) AuditLogs(c *gin.Context) {
+	var filter queries.AuditLogFilter
+	if action := c.Query("action"); action != "" {
+		filter.Action = &action
+	}
+	if actorIDStr := c.Query("actor_id"); actorIDStr != "" {
+		actorID, err := uuid.Parse(actorIDStr)
+		if err != nil {
+			httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid actor ID format", nil)
+			return
+		}
+		filter.ActorID = &actorID
+	}
+
+	limit, cursor := parseListParams(c)
+	entries, next, err := h.auditLogQueries.List(c.Request.Context(), filter, cursor, limit)
+	if err != nil {
+		if errors.Is(err, queries.ErrInvalidCursor) {
+			httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid cursor", nil)
+			return
+		}
+		slog.Error("Failed to list audit logs", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		return
+	}
+
+	render.JSON(c, http.StatusOK, resdto.NewAuditLogPage(entries, next))
+}
+
+// @Summary List notification jobs
+// @Description List queued and processed notification jobs newest first, optionally only one status or topic. Payloads are not returned (notification_jobs:read)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Only jobs in this status: queued, done or error"
+// @Param topic query string false "Only jobs on this topic, e.g. reservation_created"
+// @Param after query string false "Pagination cursor"
+// @Param limit query int false "Page size"
+// @Success 200 {object} response.NotificationJobListResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/notification-jobs [get]
+func (h *ActivityHandler) NotificationJobs(c *gin.Context) {
+	var filter queries.NotificationJobFilter
+	if status := c.Query("status"); status != "" {
+		filter.Status = &status
+	}
+	if topic := c.Query("topic"); topic != "" {
+		filter.Topic = &topic
+	}
+
+	limit, cursor := parseListParams(c)
+	jobs, next, err := h.notificationJobQueries.List(c.Request.Context(), filter, cursor, limit)
+	if err != nil {
+		if errors.Is(err, queries.ErrInvalidCursor) {
+			httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid cursor", nil)
+			return
+		}
+		slog.Error("Failed to list notification jobs", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		return
+	}
+
+	render.JSON(c, http.StatusOK, resdto.NewNotificationJobPage(jobs, next))
+}
@@ -0,0 +1,60 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/handler/render"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AdminSearchHandler struct {
+	searchQueries queries.AdminSearchQueries
+}
+
+func NewAdminSearchHandler(searchQueries queries.AdminSearchQueries) *AdminSearchHandler {
+	return &AdminSearchHandler{
+		searchQueries: searchQueries,
+	}
+}
+
+// @Summary Search across entities
+// @Description Search every company's users by email, reservations by ID or note, resources by name and reviews by comment, case-insensitively. Results are grouped by type, closest match first; limit caps each group. q needs at least 3 characters; a UUID is looked up as a reservation ID, and encrypted notes are not searched (search:read)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param q query string true "Search term"
+// @Param limit query int false "Results per type (default 5, max 20)"
+// @Success 200 {object} response.AdminSearchResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/search [get]
+func (h *AdminSearchHandler) Search(c *gin.Context) {
+	limit := queries.DefaultSearchLimit
+	if v := c.Query("limit"); v != "" {
+		if iv, err := strconv.Atoi(v); err == nil {
+			limit = iv
+		}
+	}
+
+	results, err := h.searchQueries.Search(c.Request.Context(), c.Query("q"), limit)
+	if err != nil {
+		if errors.Is(err, queries.ErrSearchQueryTooShort) {
+			httperr.AbortWithError(c, http.StatusBadRequest, err, "Search query must have at least 3 characters", nil)
+			return
+		}
+		slog.Error("Failed to search", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		return
+	}
+
+	render.JSON(c, http.StatusOK, resdto.NewAdminSearchResponse(results))
+}
@@ -0,0 +1,150 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	reqdto "gin-clean-starter/internal/handler/dto/request"
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/commands"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+var ErrInvalidUserAPIKeyPathID = errs.NewCoded("INVALID_ID_FORMAT", "invalid user or key ID format")
+
+type APIKeyHandler struct {
+	apiKeyCommands commands.APIKeyCommands
+	apiKeyQueries  queries.APIKeyQueries
+}
+
+func NewAPIKeyHandler(apiKeyCommands commands.APIKeyCommands, apiKeyQueries queries.APIKeyQueries) *APIKeyHandler {
+	return &APIKeyHandler{
+		apiKeyCommands: apiKeyCommands,
+		apiKeyQueries:  apiKeyQueries,
+	}
+}
+
+// @Summary Issue API key
+// @Description Issue a key a machine client sends as X-API-Key to act as the user. It carries the user's current role, limited to the scopes, which must be permission names; routes that check no permission accept any key. The key is only shown in this response. Recorded as an auth.api_key_issued security event (api_keys:manage)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Param request body request.IssueAPIKeyRequest true "Issue API key request"
+// @Success 201 {object} response.IssuedAPIKeyResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/users/{id}/api-keys [post]
+func (h *APIKeyHandler) Issue(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidUserAPIKeyPathID, "Invalid user ID format", nil)
+		return
+	}
+	var req reqdto.IssueAPIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		slog.Info("Invalid request format in issue api key", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid request format", nil)
+		return
+	}
+
+	actorID, _ := middleware.GetUserID(c)
+	issued, err := h.apiKeyCommands.Issue(c.Request.Context(), userID, actorID, req)
+	if err != nil {
+		handleAPIKeyError(c, "issue api key", err)
+		return
+	}
+
+	slog.Info("API key issued", "actor_id", actorID, "user_id", userID, "key_id", issued.ID)
+	c.JSON(http.StatusCreated, resdto.FromIssuedAPIKey(issued))
+}
+
+// @Summary List API keys
+// @Description The user's API keys, revoked and expired ones included, newest first (api_keys:manage)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Success 200 {array} response.APIKeyResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/users/{id}/api-keys [get]
+func (h *APIKeyHandler) List(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidUserAPIKeyPathID, "Invalid user ID format", nil)
+		return
+	}
+
+	keys, err := h.apiKeyQueries.ListByUser(c.Request.Context(), userID)
+	if err != nil {
+		handleAPIKeyError(c, "list api keys", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resdto.FromAPIKeyViews(keys))
+}
+
+// @Summary Revoke API key
+// @Description Revoke the user's API key; the next request with it is rejected with INVALID_API_KEY. Recorded as an auth.api_key_revoked security event (api_keys:manage)
+// @Tags admin
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Param keyId path string true "Key ID"
+// @Success 204
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/users/{id}/api-keys/{keyId} [delete]
+func (h *APIKeyHandler) Revoke(c *gin.Context) {
+	userID, uerr := uuid.Parse(c.Param("id"))
+	keyID, kerr := uuid.Parse(c.Param("keyId"))
+	if uerr != nil || kerr != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidUserAPIKeyPathID, "Invalid user or key ID format", nil)
+		return
+	}
+
+	actorID, _ := middleware.GetUserID(c)
+	if err := h.apiKeyCommands.Revoke(c.Request.Context(), userID, keyID, actorID); err != nil {
+		handleAPIKeyError(c, "revoke api key", err)
+		return
+	}
+
+	slog.Info("API key revoked", "actor_id", actorID, "user_id", userID, "key_id", keyID)
+	c.Status(http.StatusNoContent)
+}
+
+var apiKeyErrorRules = []createReservationErrorRule{
+	{commands.ErrUserNotFound, http.StatusNotFound, "User not found", nil},
+	{commands.ErrAPIKeyNotFound, http.StatusNotFound, "API key not found", nil},
+	{commands.ErrUnknownPermission, http.StatusBadRequest, "Scopes must be permission names", nil},
+	{commands.ErrAPIKeyExpiryInvalid, http.StatusBadRequest, "Expiry must be in the future", nil},
+}
+
+func handleAPIKeyError(c *gin.Context, op string, err error) {
+	for _, rule := range apiKeyErrorRules {
+		if errors.Is(err, rule.err) {
+			slog.Warn("API key error", "op", op, "error", err.Error(), "status", rule.status)
+			httperr.AbortWithError(c, rule.status, err, rule.message, rule.extra)
+			return
+		}
+	}
+
+	slog.Error("Unexpected error in api key", "op", op, "error", err.Error())
+	httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+}
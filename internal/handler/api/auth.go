@@ -3,46 +3,67 @@ package api
 import (
 	"errors"
 	"log/slog"
+	"math"
 	"net/http"
+	"strconv"
 
 	reqdto "gin-clean-starter/internal/handler/dto/request"
 	resdto "gin-clean-starter/internal/handler/dto/response"
 	"gin-clean-starter/internal/handler/httperr"
 	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/pkg/clock"
 	"gin-clean-starter/internal/pkg/config"
 	"gin-clean-starter/internal/pkg/cookie"
+	"gin-clean-starter/internal/pkg/errs"
 	"gin-clean-starter/internal/pkg/jwt"
+	"gin-clean-starter/internal/pkg/signedtoken"
 	"gin-clean-starter/internal/usecase/commands"
 	"gin-clean-starter/internal/usecase/queries"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
+var ErrInvalidUserPathID = errs.NewCoded("INVALID_ID_FORMAT", "invalid user ID format")
+
+// DeviceKeyHeader carries the client-generated key that refresh tokens are bound to.
+const DeviceKeyHeader = "X-Device-Key"
+
 type AuthHandler struct {
 	authCommands commands.AuthCommands
 	userQueries  queries.UserQueries
 	jwtService   *jwt.Service
 	cfg          config.Config
+	signer       *signedtoken.Signer
+	clock        clock.Clock
 }
 
-func NewAuthHandler(authCommands commands.AuthCommands, userQueries queries.UserQueries, jwtService *jwt.Service, cfg config.Config) *AuthHandler {
+func NewAuthHandler(authCommands commands.AuthCommands, userQueries queries.UserQueries, jwtService *jwt.Service, cfg config.Config, signer *signedtoken.Signer, clock clock.Clock) *AuthHandler {
 	return &AuthHandler{
 		authCommands: authCommands,
 		userQueries:  userQueries,
 		jwtService:   jwtService,
 		cfg:          cfg,
+		signer:       signer,
+		clock:        clock,
 	}
 }
 
 // @Summary User login
-// @Description Login with email and password
+// @Description Login with email and password. A user with two-factor authentication gets 202 with an mfa_pending token instead, to redeem with a code at /auth/mfa/verify. LOGIN_LOCKOUT_MAX_ATTEMPTS failures for an email from one IP lock it there for LOGIN_LOCKOUT_COOLDOWN (423 ACCOUNT_LOCKED with Retry-After). Limited per client IP and email (429 RATE_LIMITED with Retry-After)
 // @Tags auth
 // @Accept json
 // @Produce json
 // @Param request body request.LoginRequest true "Login request"
+// @Param X-Device-Key header string false "Client-generated device key that the refresh token is bound to"
+// @Param Idempotency-Key header string false "UUID per login submission; a resubmission with the same key and credentials within LOGIN_REPLAY_TTL returns the first tokens with Idempotent-Replayed: true"
 // @Success 200 {object} response.LoginResponse
-// @Failure 400 {object} map[string]string
-// @Failure 401 {object} map[string]string
+// @Success 202 {object} response.MFAChallengeResponse
+// @Failure 400 {object} httperr.Response "DEVICE_KEY_REQUIRED when JWT_DEVICE_BINDING is required and X-Device-Key is missing"
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response "USER_INACTIVE"
+// @Failure 423 {object} httperr.Response
+// @Failure 429 {object} httperr.Response
 // @Router /auth/login [post]
 func (h *AuthHandler) Login(c *gin.Context) {
 	var req reqdto.LoginRequest
@@ -53,9 +74,41 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		return
 	}
 
-	result, err := h.authCommands.Login(c.Request.Context(), req)
+	result, user, ok := h.authenticate(c, req)
+	if !ok {
+		return
+	}
+
+	cookie.SetTokenCookies(c, h.cfg.Cookie, result.TokenPair.AccessToken, result.TokenPair.RefreshToken,
+		h.jwtService.GetAccessTokenDuration(), h.jwtService.GetRefreshTokenDuration())
+
+	slog.Info("User logged in successfully", "user_id", user.ID)
+	response := resdto.LoginResponse{User: user}
+	c.JSON(http.StatusOK, response)
+}
+
+// authenticate runs the login use case and loads the user; on failure it writes the error response.
+// An optional Idempotency-Key lets a double-submitted login get the first submission's tokens.
+// A login that needs a second factor is answered here with the MFA challenge.
+func (h *AuthHandler) authenticate(c *gin.Context, req reqdto.LoginRequest) (*commands.LoginResult, *queries.AuthorizedUserView, bool) {
+	nonce := c.GetHeader("Idempotency-Key")
+	if nonce != "" {
+		if _, err := uuid.Parse(nonce); err != nil {
+			httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidIdempotencyKeyFormat,
+				"Idempotency-Key must be a UUID", nil)
+			return nil, nil, false
+		}
+	}
+
+	result, err := h.authCommands.Login(c.Request.Context(), req, c.GetHeader(DeviceKeyHeader), nonce)
 	if err != nil {
+		var locked *commands.AccountLockedError
 		switch {
+		case errors.As(err, &locked):
+			slog.Warn("Login refused during lockout", "email", req.Email, "locked_until", locked.Until)
+			c.Header("Retry-After", strconv.Itoa(int(math.Max(1, math.Ceil(locked.Until.Sub(h.clock.Now()).Seconds())))))
+			httperr.AbortWithError(c, http.StatusLocked, err,
+				"Too many failed sign-ins; try again later", map[string]any{"lockedUntil": locked.Until})
 		case errors.Is(err, commands.ErrInvalidCredentials),
 			errors.Is(err, commands.ErrUserNotFound):
 			slog.Warn("Login failed due to invalid credentials",
@@ -67,50 +120,532 @@ func (h *AuthHandler) Login(c *gin.Context) {
 				"email", req.Email, "error", err.Error())
 			httperr.AbortWithError(c, http.StatusForbidden, err,
 				"Account is inactive", nil)
+		case errors.Is(err, commands.ErrDeviceKeyRequired):
+			slog.Warn("Login failed due to missing device key", "email", req.Email)
+			httperr.AbortWithError(c, http.StatusBadRequest, err,
+				"Device key required", map[string]string{"header": DeviceKeyHeader})
 		default:
 			slog.Error("Unexpected error in login", "error", err.Error())
 			httperr.AbortWithError(c, http.StatusInternalServerError, err,
 				"Internal server error", nil)
 		}
-		return
+		return nil, nil, false
 	}
 
-	user, err := h.userQueries.GetCurrentUser(c.Request.Context(), result.UserID)
+	if result.IsReplayed {
+		c.Header("Idempotent-Replayed", "true")
+	}
+	if result.MFAChallenge != nil {
+		slog.Info("Login awaits a second factor", "user_id", result.UserID)
+		c.JSON(http.StatusAccepted, resdto.FromMFAChallenge(result.MFAChallenge))
+		return nil, nil, false
+	}
+
+	user, ok := h.loadLoggedInUser(c, result.UserID)
+	if !ok {
+		return nil, nil, false
+	}
+	return result, user, true
+}
+
+func (h *AuthHandler) loadLoggedInUser(c *gin.Context, userID uuid.UUID) (*queries.AuthorizedUserView, bool) {
+	user, err := h.userQueries.GetCurrentUser(c.Request.Context(), userID)
 	if err != nil {
-		slog.Error("Failed to retrieve user data after successful login", "user_id", result.UserID, "error", err.Error())
+		slog.Error("Failed to retrieve user data after successful login", "user_id", userID, "error", err.Error())
 		httperr.AbortWithError(c, http.StatusInternalServerError, err,
 			"Internal server error", nil)
-		return
+		return nil, false
 	}
-
-	cookie.SetTokenCookies(c, h.cfg.Cookie, result.TokenPair.AccessToken, result.TokenPair.RefreshToken,
-		h.jwtService.GetAccessTokenDuration(), h.jwtService.GetRefreshTokenDuration())
-
-	slog.Info("User logged in successfully", "user_id", user.ID)
-	response := resdto.LoginResponse{User: user}
-	c.JSON(http.StatusOK, response)
+	return user, true
 }
 
 // @Summary User logout
-// @Description Logout current user session
+// @Description Logout current user session; the session's refresh token is revoked and its access tokens stop working within AUTHZ_SESSION_CACHE_TTL. Tokens issued before sessions were tracked are denylisted instead, including the refresh token cookie
 // @Tags auth
 // @Security BearerAuth
 // @Success 204 "No Content"
-// @Failure 401 {object} map[string]string
+// @Failure 401 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
 // @Router /auth/logout [post]
 func (h *AuthHandler) Logout(c *gin.Context) {
+	if token, ok := middleware.GetAccessToken(c); ok {
+		if err := h.authCommands.Logout(c.Request.Context(), token, cookie.GetRefreshToken(c)); err != nil {
+			slog.Error("Failed to revoke tokens on logout", "user_id", token.UserID, "error", err.Error())
+			httperr.AbortWithError(c, http.StatusInternalServerError, err,
+				"Internal server error", nil)
+			return
+		}
+	}
+
 	cookie.ClearTokenCookies(c, h.cfg.Cookie)
 	c.JSON(http.StatusNoContent, nil)
 }
 
+// @Summary Revoke all sessions
+// @Description Sign the user out everywhere: every refresh token is revoked and access tokens stop working within AUTHZ_SESSION_CACHE_TTL. Recorded as an auth.sessions_revoked security event (sessions:revoke)
+// @Tags admin
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/users/{id}/sessions [delete]
+func (h *AuthHandler) RevokeSessions(c *gin.Context) {
+	actorID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError, ErrMissingUserContext, "Internal server error", nil)
+		return
+	}
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidUserPathID, "Invalid user ID format", nil)
+		return
+	}
+
+	if err := h.authCommands.RevokeAllSessions(c.Request.Context(), userID, actorID); err != nil {
+		switch {
+		case errors.Is(err, commands.ErrUserNotFound):
+			httperr.AbortWithError(c, http.StatusNotFound, err, "User not found", nil)
+		default:
+			slog.Error("Unexpected error in revoke sessions", "user_id", userID, "error", err.Error())
+			httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		}
+		return
+	}
+
+	slog.Info("User signed out of every session", "actor_id", actorID, "user_id", userID)
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary Lift sign-in lockouts
+// @Description Let the user sign in again from every IP that too many failed logins locked out, before LOGIN_LOCKOUT_COOLDOWN ends. Recorded as an auth.account_unlocked security event (logins:unlock)
+// @Tags admin
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/users/{id}/login-lockout [delete]
+func (h *AuthHandler) UnlockLogin(c *gin.Context) {
+	actorID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError, ErrMissingUserContext, "Internal server error", nil)
+		return
+	}
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidUserPathID, "Invalid user ID format", nil)
+		return
+	}
+
+	if err := h.authCommands.UnlockLogin(c.Request.Context(), userID, actorID); err != nil {
+		switch {
+		case errors.Is(err, commands.ErrUserNotFound):
+			httperr.AbortWithError(c, http.StatusNotFound, err, "User not found", nil)
+		default:
+			slog.Error("Unexpected error in unlock login", "user_id", userID, "error", err.Error())
+			httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		}
+		return
+	}
+
+	slog.Info("Sign-in lockouts lifted", "actor_id", actorID, "user_id", userID)
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary Get a CSRF token
+// @Description Returns the CSRF token to echo in an X-CSRF-Token header and sets it as the csrf_token cookie. With COOKIE_CSRF on (always with COOKIE_PROFILE=cross-site), POST, PUT, PATCH and DELETE requests that carry the auth cookies are refused without it. The token is reused while at least half its lifetime is left
+// @Tags auth
+// @Produce json
+// @Success 200 {object} response.CSRFTokenResponse
+// @Failure 500 {object} httperr.Response
+// @Router /auth/csrf [get]
+func (h *AuthHandler) CSRFToken(c *gin.Context) {
+	token, expiresAt, err := cookie.IssueCSRFToken(c, h.cfg.Cookie, h.signer, h.clock.Now())
+	if err != nil {
+		slog.Error("Failed to issue CSRF token", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, resdto.CSRFTokenResponse{CSRFToken: token, ExpiresAt: expiresAt})
+}
+
+// @Summary Register an account
+// @Description Creates an inactive viewer account and mails a verification link (SIGNUP_VERIFY_URL with the token appended); the account can sign in once the link is redeemed via /auth/verify-email. Returns 404 unless SIGNUP_ENABLED
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body request.RegisterRequest true "Register request"
+// @Success 202 {object} response.RegisterResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 409 {object} httperr.Response
+// @Router /auth/register [post]
+func (h *AuthHandler) Register(c *gin.Context) {
+	var req reqdto.RegisterRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		slog.Info("Invalid request format in register", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid request format", nil)
+		return
+	}
+
+	result, err := h.authCommands.Register(c.Request.Context(), req)
+	if err != nil {
+		handleRegistrationError(c, "register", err)
+		return
+	}
+
+	slog.Info("User registered; verification pending", "user_id", result.UserID)
+	c.JSON(http.StatusAccepted, resdto.FromRegisterResult(result))
+}
+
+// @Summary Verify an email address
+// @Description Redeems the token from a verification link and activates the account. A link works once
+// @Tags auth
+// @Accept json
+// @Param request body request.VerifyEmailRequest true "Verify email request"
+// @Success 204
+// @Failure 400 {object} httperr.Response
+// @Failure 410 {object} httperr.Response
+// @Router /auth/verify-email [post]
+func (h *AuthHandler) VerifyEmail(c *gin.Context) {
+	var req reqdto.VerifyEmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		slog.Info("Invalid request format in verify email", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid request format", nil)
+		return
+	}
+
+	if err := h.authCommands.VerifyEmail(c.Request.Context(), req.Token); err != nil {
+		handleRegistrationError(c, "verify_email", err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+var registrationErrorRules = []createReservationErrorRule{
+	{commands.ErrRegisterInvalidEmail, http.StatusBadRequest, "Invalid email", nil},
+	{commands.ErrWeakPassword, http.StatusBadRequest, "Password does not meet requirements", nil},
+	{commands.ErrInvalidVerification, http.StatusBadRequest, "Invalid verification token", nil},
+	{commands.ErrRegistrationDisabled, http.StatusNotFound, "Not found", nil},
+	{commands.ErrRegisterEmailTaken, http.StatusConflict, "Email already registered", nil},
+	{commands.ErrVerificationExpired, http.StatusGone, "Verification link expired", nil},
+}
+
+func handleRegistrationError(c *gin.Context, op string, err error) {
+	for _, rule := range registrationErrorRules {
+		if errors.Is(err, rule.err) {
+			slog.Warn("Registration command error", "op", op, "error", err.Error(), "status", rule.status)
+			httperr.AbortWithError(c, rule.status, err, rule.message, rule.extra)
+			return
+		}
+	}
+
+	slog.Error("Unexpected error in registration command", "op", op, "error", err.Error())
+	httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+}
+
+// @Summary Change password
+// @Description Replaces the caller's password after checking the current one. Every session of the user is signed out, this one included; the caller continues in a new session whose tokens are set as cookies, or returned in the body for Authorization-header clients when JWT_BODY_TOKENS_ENABLED
+// @Tags auth
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body request.ChangePasswordRequest true "Change password request"
+// @Param X-Device-Key header string false "Client-generated device key that the new refresh token is bound to"
+// @Success 200 {object} response.TokenResponse
+// @Success 204 "No Content"
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Router /auth/password [put]
+func (h *AuthHandler) ChangePassword(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("User ID not found in context")
+		httperr.AbortWithError(c, http.StatusInternalServerError,
+			errors.New("user ID not found in context"),
+			"Internal server error", nil)
+		return
+	}
+
+	var req reqdto.ChangePasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		slog.Info("Invalid request format in change password", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid request format", nil)
+		return
+	}
+
+	pair, err := h.authCommands.ChangePassword(c.Request.Context(), userID, req, c.GetHeader(DeviceKeyHeader))
+	if err != nil {
+		handlePasswordError(c, userID, err)
+		return
+	}
+
+	slog.Info("Password changed; other sessions signed out", "user_id", userID)
+	if c.GetHeader("Authorization") != "" && h.cfg.JWT.BodyTokensEnabled {
+		c.JSON(http.StatusOK, h.tokenResponse(pair))
+		return
+	}
+	cookie.SetTokenCookies(c, h.cfg.Cookie, pair.AccessToken, pair.RefreshToken,
+		h.jwtService.GetAccessTokenDuration(), h.jwtService.GetRefreshTokenDuration())
+	c.Status(http.StatusNoContent)
+}
+
+var passwordErrorRules = []createReservationErrorRule{
+	{commands.ErrWeakPassword, http.StatusBadRequest, "Password does not meet requirements", nil},
+	{commands.ErrPasswordUnchanged, http.StatusBadRequest, "New password must differ from the current one", nil},
+	{commands.ErrDeviceKeyRequired, http.StatusBadRequest, "Device key required", map[string]string{"header": DeviceKeyHeader}},
+	{commands.ErrCurrentPasswordWrong, http.StatusForbidden, "Current password is incorrect", nil},
+	{commands.ErrUserInactive, http.StatusForbidden, "Account is inactive", nil},
+	{commands.ErrUserNotFound, http.StatusNotFound, "User not found", nil},
+}
+
+func handlePasswordError(c *gin.Context, userID uuid.UUID, err error) {
+	for _, rule := range passwordErrorRules {
+		if errors.Is(err, rule.err) {
+			slog.Warn("Password change refused", "user_id", userID, "error", err.Error(), "status", rule.status)
+			httperr.AbortWithError(c, rule.status, err, rule.message, rule.extra)
+			return
+		}
+	}
+
+	slog.Error("Unexpected error in password change", "user_id", userID, "error", err.Error())
+	httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+}
+
+// @Summary Complete a login with a second factor
+// @Description Redeems the mfa_pending token of a login with a TOTP code or an unused recovery code, and sets the token cookies like /auth/login. A TOTP code is accepted once. MFA_MAX_ATTEMPTS wrong codes in a row lock the second factor for MFA_LOCKOUT (429 MFA_LOCKED)
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body request.VerifyMFARequest true "Verify MFA request"
+// @Param X-Device-Key header string false "Client-generated device key that the refresh token is bound to"
+// @Success 200 {object} response.LoginResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 429 {object} httperr.Response
+// @Router /auth/mfa/verify [post]
+func (h *AuthHandler) VerifyMFA(c *gin.Context) {
+	result, user, ok := h.verifyMFA(c)
+	if !ok {
+		return
+	}
+
+	cookie.SetTokenCookies(c, h.cfg.Cookie, result.TokenPair.AccessToken, result.TokenPair.RefreshToken,
+		h.jwtService.GetAccessTokenDuration(), h.jwtService.GetRefreshTokenDuration())
+
+	slog.Info("User logged in with a second factor", "user_id", user.ID)
+	c.JSON(http.StatusOK, resdto.LoginResponse{User: user})
+}
+
+// @Summary Complete an API token login with a second factor
+// @Description Like /auth/mfa/verify for a login through /auth/token; the tokens are returned in the body
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body request.VerifyMFARequest true "Verify MFA request"
+// @Param X-Device-Key header string false "Client-generated device key that the refresh token is bound to"
+// @Success 200 {object} response.TokenResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 429 {object} httperr.Response
+// @Router /auth/token/mfa [post]
+func (h *AuthHandler) TokenMFA(c *gin.Context) {
+	if !h.bodyTokensEnabled(c) {
+		return
+	}
+
+	result, user, ok := h.verifyMFA(c)
+	if !ok {
+		return
+	}
+
+	slog.Info("User issued API tokens with a second factor", "user_id", user.ID)
+	response := h.tokenResponse(result.TokenPair)
+	response.User = user
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AuthHandler) verifyMFA(c *gin.Context) (*commands.LoginResult, *queries.AuthorizedUserView, bool) {
+	var req reqdto.VerifyMFARequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		slog.Info("Invalid request format in verify MFA", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid request format", nil)
+		return nil, nil, false
+	}
+
+	result, err := h.authCommands.VerifyMFA(c.Request.Context(), req, c.GetHeader(DeviceKeyHeader))
+	if err != nil {
+		handleMFAError(c, mfaVerifyErrorRules, "verify", err)
+		return nil, nil, false
+	}
+
+	user, ok := h.loadLoggedInUser(c, result.UserID)
+	if !ok {
+		return nil, nil, false
+	}
+	return result, user, true
+}
+
+// @Summary Start two-factor enrollment
+// @Description Generates a TOTP secret for the caller and returns it with its otpauth:// URI to show as a QR code. Two-factor authentication is on once a code of the secret is confirmed at /auth/mfa/enable; enrolling again before that replaces the secret. Requires CRYPTO_KEYS, as the secret is stored encrypted
+// @Tags auth
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} response.MFAEnrollmentResponse
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 409 {object} httperr.Response
+// @Router /auth/mfa/enroll [post]
+func (h *AuthHandler) EnrollMFA(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError, ErrMissingUserContext, "Internal server error", nil)
+		return
+	}
+
+	enrollment, err := h.authCommands.EnrollMFA(c.Request.Context(), userID)
+	if err != nil {
+		handleMFAError(c, mfaSettingsErrorRules, "enroll", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resdto.FromMFAEnrollment(enrollment))
+}
+
+// @Summary Enable two-factor authentication
+// @Description Confirms the pending enrollment with a code from the authenticator app. From then on logins ask for a code. Returns MFA_RECOVERY_CODES single-use recovery codes, which are not shown again; enabling replaces earlier ones. Recorded as an auth.2fa_enabled security event
+// @Tags auth
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body request.MFACodeRequest true "TOTP code"
+// @Success 200 {object} response.MFARecoveryCodesResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 409 {object} httperr.Response
+// @Router /auth/mfa/enable [post]
+func (h *AuthHandler) EnableMFA(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError, ErrMissingUserContext, "Internal server error", nil)
+		return
+	}
+
+	var req reqdto.MFACodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		slog.Info("Invalid request format in enable MFA", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid request format", nil)
+		return
+	}
+
+	codes, err := h.authCommands.EnableMFA(c.Request.Context(), userID, req.Code)
+	if err != nil {
+		handleMFAError(c, mfaSettingsErrorRules, "enable", err)
+		return
+	}
+
+	slog.Info("Two-factor authentication enabled", "user_id", userID)
+	c.JSON(http.StatusOK, resdto.MFARecoveryCodesResponse{RecoveryCodes: codes})
+}
+
+// @Summary Disable two-factor authentication
+// @Description Turns two-factor authentication off and drops the secret and recovery codes, given a TOTP code or an unused recovery code. Recorded as an auth.2fa_disabled security event
+// @Tags auth
+// @Security BearerAuth
+// @Accept json
+// @Param request body request.MFACodeRequest true "TOTP or recovery code"
+// @Success 204
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 409 {object} httperr.Response
+// @Failure 429 {object} httperr.Response
+// @Router /auth/mfa/disable [post]
+func (h *AuthHandler) DisableMFA(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError, ErrMissingUserContext, "Internal server error", nil)
+		return
+	}
+
+	var req reqdto.MFACodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		slog.Info("Invalid request format in disable MFA", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid request format", nil)
+		return
+	}
+
+	if err := h.authCommands.DisableMFA(c.Request.Context(), userID, req.Code); err != nil {
+		handleMFAError(c, mfaSettingsErrorRules, "disable", err)
+		return
+	}
+
+	slog.Info("Two-factor authentication disabled", "user_id", userID)
+	c.Status(http.StatusNoContent)
+}
+
+var mfaVerifyErrorRules = []createReservationErrorRule{
+	{commands.ErrDeviceKeyRequired, http.StatusBadRequest, "Device key required", map[string]string{"header": DeviceKeyHeader}},
+	{commands.ErrMFAChallengeExpired, http.StatusUnauthorized, "MFA token expired; sign in again", nil},
+	{commands.ErrMFAChallengeInvalid, http.StatusUnauthorized, "Invalid MFA token", nil},
+	{commands.ErrUserNotFound, http.StatusUnauthorized, "Invalid MFA token", nil},
+	{commands.ErrMFACodeInvalid, http.StatusUnauthorized, "Invalid two-factor code", nil},
+	{commands.ErrUserInactive, http.StatusForbidden, "Account is inactive", nil},
+	{commands.ErrMFALocked, http.StatusTooManyRequests, "Too many invalid two-factor codes; try again later", nil},
+}
+
+// The caller is signed in for these, so a wrong code is refused with 403 rather than a
+// 401 that clients take as a lost session.
+var mfaSettingsErrorRules = []createReservationErrorRule{
+	{commands.ErrMFACodeInvalid, http.StatusForbidden, "Invalid two-factor code", nil},
+	{commands.ErrUserInactive, http.StatusForbidden, "Account is inactive", nil},
+	{commands.ErrUserNotFound, http.StatusNotFound, "User not found", nil},
+	{commands.ErrMFAAlreadyEnabled, http.StatusConflict, "Two-factor authentication is already enabled", nil},
+	{commands.ErrMFANotEnrolled, http.StatusConflict, "Start enrollment first", nil},
+	{commands.ErrMFANotEnabled, http.StatusConflict, "Two-factor authentication is not enabled", nil},
+	{commands.ErrMFALocked, http.StatusTooManyRequests, "Too many invalid two-factor codes; try again later", nil},
+}
+
+func handleMFAError(c *gin.Context, rules []createReservationErrorRule, op string, err error) {
+	for _, rule := range rules {
+		if errors.Is(err, rule.err) {
+			slog.Warn("Two-factor authentication refused", "op", op, "error", err.Error(), "status", rule.status)
+			httperr.AbortWithError(c, rule.status, err, rule.message, rule.extra)
+			return
+		}
+	}
+
+	slog.Error("Unexpected error in two-factor authentication", "op", op, "error", err.Error())
+	httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+}
+
 // @Summary Get current user
 // @Description Get current authenticated user information
 // @Tags auth
 // @Security BearerAuth
 // @Produce json
 // @Success 200 {object} queries.AuthorizedUserView
-// @Failure 401 {object} map[string]string
-// @Failure 404 {object} map[string]string
+// @Failure 401 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
 // @Router /auth/me [get]
 func (h *AuthHandler) Me(c *gin.Context) {
 	userID, ok := middleware.GetUserID(c)
@@ -145,11 +680,13 @@ func (h *AuthHandler) Me(c *gin.Context) {
 }
 
 // @Summary Refresh access token
-// @Description Refresh access token using refresh token from cookie
+// @Description Refresh access token using refresh token from cookie. Each refresh token is single-use: presenting one that was already rotated revokes its whole session (401 REFRESH_TOKEN_REUSED). Limited per client IP (429 RATE_LIMITED with Retry-After)
 // @Tags auth
 // @Produce json
+// @Param X-Device-Key header string false "Client-generated device key that the refresh token is bound to"
 // @Success 200 {object} map[string]string
-// @Failure 401 {object} map[string]string
+// @Failure 401 {object} httperr.Response
+// @Failure 429 {object} httperr.Response
 // @Router /auth/refresh [post]
 func (h *AuthHandler) Refresh(c *gin.Context) {
 	refreshToken := cookie.GetRefreshToken(c)
@@ -161,7 +698,7 @@ func (h *AuthHandler) Refresh(c *gin.Context) {
 		return
 	}
 
-	pair, err := h.authCommands.RefreshToken(c.Request.Context(), refreshToken)
+	pair, err := h.authCommands.RefreshToken(c.Request.Context(), refreshToken, c.GetHeader(DeviceKeyHeader))
 	if err != nil {
 		slog.Warn("Token refresh failed", "error", err.Error())
 		httperr.AbortWithError(c, http.StatusUnauthorized, err,
@@ -177,3 +714,101 @@ func (h *AuthHandler) Refresh(c *gin.Context) {
 		"message": "Token refreshed successfully",
 	})
 }
+
+// @Summary Issue API tokens
+// @Description Login for non-browser clients; access and refresh tokens are returned in the body instead of cookies. A user with two-factor authentication gets 202 with an mfa_pending token instead, to redeem with a code at /auth/token/mfa. LOGIN_LOCKOUT_MAX_ATTEMPTS failures for an email from one IP lock it there for LOGIN_LOCKOUT_COOLDOWN (423 ACCOUNT_LOCKED with Retry-After). Limited per client IP and email (429 RATE_LIMITED with Retry-After)
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body request.LoginRequest true "Login request"
+// @Param X-Device-Key header string false "Client-generated device key that the refresh token is bound to"
+// @Param Idempotency-Key header string false "UUID per login submission; a resubmission with the same key and credentials within LOGIN_REPLAY_TTL returns the first tokens with Idempotent-Replayed: true"
+// @Success 200 {object} response.TokenResponse
+// @Success 202 {object} response.MFAChallengeResponse
+// @Failure 400 {object} httperr.Response "DEVICE_KEY_REQUIRED when JWT_DEVICE_BINDING is required and X-Device-Key is missing"
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response "USER_INACTIVE"
+// @Failure 404 {object} httperr.Response
+// @Failure 423 {object} httperr.Response
+// @Failure 429 {object} httperr.Response
+// @Router /auth/token [post]
+func (h *AuthHandler) Token(c *gin.Context) {
+	if !h.bodyTokensEnabled(c) {
+		return
+	}
+
+	var req reqdto.LoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		slog.Warn("Invalid request format in token login", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusBadRequest, err,
+			"Invalid request format", nil)
+		return
+	}
+
+	result, user, ok := h.authenticate(c, req)
+	if !ok {
+		return
+	}
+
+	slog.Info("User issued API tokens", "user_id", user.ID)
+	response := h.tokenResponse(result.TokenPair)
+	response.User = user
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Refresh API tokens
+// @Description Rotate tokens for non-browser clients using the refresh token from the Authorization header. Each refresh token is single-use: presenting one that was already rotated revokes its whole session (401 REFRESH_TOKEN_REUSED). Limited per client IP (429 RATE_LIMITED with Retry-After)
+// @Tags auth
+// @Produce json
+// @Param Authorization header string true "Bearer <refresh token>"
+// @Param X-Device-Key header string false "Client-generated device key that the refresh token is bound to"
+// @Success 200 {object} response.TokenResponse
+// @Failure 401 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 429 {object} httperr.Response
+// @Router /auth/token/refresh [post]
+func (h *AuthHandler) TokenRefresh(c *gin.Context) {
+	if !h.bodyTokensEnabled(c) {
+		return
+	}
+
+	refreshToken := middleware.BearerToken(c)
+	if refreshToken == "" {
+		slog.Warn("Refresh token not found in Authorization header")
+		httperr.AbortWithError(c, http.StatusUnauthorized,
+			errors.New("refresh token not found in authorization header"),
+			"Refresh token not found", nil)
+		return
+	}
+
+	pair, err := h.authCommands.RefreshToken(c.Request.Context(), refreshToken, c.GetHeader(DeviceKeyHeader))
+	if err != nil {
+		slog.Warn("Token refresh failed", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusUnauthorized, err,
+			"Invalid or expired refresh token", nil)
+		return
+	}
+
+	slog.Info("API tokens refreshed successfully")
+	c.JSON(http.StatusOK, h.tokenResponse(pair))
+}
+
+func (h *AuthHandler) bodyTokensEnabled(c *gin.Context) bool {
+	if h.cfg.JWT.BodyTokensEnabled {
+		return true
+	}
+	httperr.AbortWithError(c, http.StatusNotFound,
+		errors.New("body token delivery is disabled"),
+		"Not found", nil)
+	return false
+}
+
+func (h *AuthHandler) tokenResponse(pair *commands.TokenPair) resdto.TokenResponse {
+	return resdto.TokenResponse{
+		AccessToken:      pair.AccessToken,
+		RefreshToken:     pair.RefreshToken,
+		TokenType:        "Bearer",
+		ExpiresIn:        int64(h.jwtService.GetAccessTokenDuration().Seconds()),
+		RefreshExpiresIn: int64(h.jwtService.GetRefreshTokenDuration().Seconds()),
+	}
+}
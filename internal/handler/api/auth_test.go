@@ -5,15 +5,22 @@ package api_test
 import (
 	"errors"
 	"net/http"
+	nethttptest "net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"gin-clean-starter/internal/handler/api"
+	reqdto "gin-clean-starter/internal/handler/dto/request"
 	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/pkg/clock"
 	"gin-clean-starter/internal/pkg/config"
+	"gin-clean-starter/internal/pkg/cookie"
 	"gin-clean-starter/internal/pkg/jwt"
+	"gin-clean-starter/internal/pkg/signedtoken"
 	"gin-clean-starter/internal/usecase/commands"
 	"gin-clean-starter/internal/usecase/queries"
+	"gin-clean-starter/internal/usecase/shared"
 	"gin-clean-starter/tests/common/builder"
 	"gin-clean-starter/tests/common/httptest"
 	"gin-clean-starter/tests/common/testutil"
@@ -33,6 +40,7 @@ type AuthHandlerTestSuite struct {
 	mockCommands *commandsmock.MockAuthCommands
 	mockQueries  *queriesmock.MockUserQueries
 	handler      *api.AuthHandler
+	accessToken  shared.IssuedToken
 }
 
 func (s *AuthHandlerTestSuite) SetupTest() {
@@ -43,10 +51,23 @@ func (s *AuthHandlerTestSuite) SetupTest() {
 	s.mockCommands = commandsmock.NewMockAuthCommands(s.mockCtrl)
 	s.mockQueries = queriesmock.NewMockUserQueries(s.mockCtrl)
 	mockJWTService := &jwt.Service{} // Mock JWT service for testing
-	s.handler = api.NewAuthHandler(s.mockCommands, s.mockQueries, mockJWTService, config.NewTestConfig())
+	s.handler = api.NewAuthHandler(s.mockCommands, s.mockQueries, mockJWTService, config.NewTestConfig(), signedtoken.NewSigner([]byte("test-secret")), clock.NewRealClock())
+	sessionID := uuid.New()
+	s.accessToken = shared.IssuedToken{ID: uuid.New(), UserID: uuid.New(), SessionID: &sessionID}
 
+	s.router.GET("/auth/csrf", s.handler.CSRFToken)
 	s.router.POST("/auth/login", s.handler.Login)
-	s.router.POST("/auth/logout", s.handler.Logout)
+	s.router.POST("/auth/logout", func(c *gin.Context) {
+		// Mock middleware behavior: the test token is s.accessToken
+		if authHeader := c.GetHeader("Authorization"); authHeader != "" {
+			c.Set("access_token", s.accessToken)
+		}
+		s.handler.Logout(c)
+	})
+	s.router.POST("/auth/register", s.handler.Register)
+	s.router.POST("/auth/verify-email", s.handler.VerifyEmail)
+	s.router.POST("/auth/token", s.handler.Token)
+	s.router.POST("/auth/token/refresh", s.handler.TokenRefresh)
 	s.router.GET("/auth/me", func(c *gin.Context) {
 		// Mock middleware behavior for /auth/me
 		if authHeader := c.GetHeader("Authorization"); authHeader != "" {
@@ -54,6 +75,25 @@ func (s *AuthHandlerTestSuite) SetupTest() {
 		}
 		s.handler.Me(c)
 	})
+	s.router.POST("/auth/mfa/verify", s.handler.VerifyMFA)
+	s.router.POST("/auth/mfa/enable", func(c *gin.Context) {
+		c.Set("user_id", s.accessToken.UserID)
+		s.handler.EnableMFA(c)
+	})
+	s.router.PUT("/auth/password", func(c *gin.Context) {
+		c.Set("user_id", s.accessToken.UserID)
+		s.handler.ChangePassword(c)
+	})
+	s.router.DELETE("/admin/users/:id/sessions", func(c *gin.Context) {
+		if authHeader := c.GetHeader("Authorization"); authHeader != "" {
+			c.Set("user_id", s.accessToken.UserID)
+		}
+		s.handler.RevokeSessions(c)
+	})
+	s.router.DELETE("/admin/users/:id/login-lockout", func(c *gin.Context) {
+		c.Set("user_id", s.accessToken.UserID)
+		s.handler.UnlockLogin(c)
+	})
 }
 
 func (s *AuthHandlerTestSuite) TearDownTest() {
@@ -80,7 +120,7 @@ func (s *AuthHandlerTestSuite) TestLogin() {
 	expectedRefresh := "test-refresh-token"
 
 	s.Run("success: returns 200 OK for valid credentials", func() {
-		s.mockCommands.EXPECT().Login(gomock.Any(), reqBody).
+		s.mockCommands.EXPECT().Login(gomock.Any(), reqBody, "", "").
 			Return(&commands.LoginResult{
 				UserID:     returnUser.ID,
 				TokenPair:  &commands.TokenPair{AccessToken: expectedToken, RefreshToken: expectedRefresh},
@@ -124,7 +164,7 @@ func (s *AuthHandlerTestSuite) TestLogin() {
 						email, _ := requestMap["email"].(string)
 						password, _ := requestMap["password"].(string)
 						expectedReq := (&builder.AuthBuilder{Email: email, Password: password}).BuildDTO()
-						s.mockCommands.EXPECT().Login(gomock.Any(), expectedReq).
+						s.mockCommands.EXPECT().Login(gomock.Any(), expectedReq, "", "").
 							Return(&commands.LoginResult{
 								UserID:     returnUser.ID,
 								TokenPair:  &commands.TokenPair{AccessToken: expectedToken, RefreshToken: expectedRefresh},
@@ -169,6 +209,12 @@ func (s *AuthHandlerTestSuite) TestLogin() {
 				expectedStatus: http.StatusForbidden,
 				expectedMsg:    "Account is inactive",
 			},
+			{
+				name:           "device key required",
+				commandsError:  commands.ErrDeviceKeyRequired,
+				expectedStatus: http.StatusBadRequest,
+				expectedMsg:    "Device key required",
+			},
 			{
 				name:           "internal server error",
 				commandsError:  errors.New("database error"),
@@ -179,7 +225,7 @@ func (s *AuthHandlerTestSuite) TestLogin() {
 
 		for _, tc := range testCases {
 			s.Run(tc.name, func() {
-				s.mockCommands.EXPECT().Login(gomock.Any(), reqBody).
+				s.mockCommands.EXPECT().Login(gomock.Any(), reqBody, "", "").
 					Return(nil, tc.commandsError).Times(1)
 
 				rec := httptest.PerformRequest(s.T(), s.router, http.MethodPost, url, reqBody, "")
@@ -187,13 +233,341 @@ func (s *AuthHandlerTestSuite) TestLogin() {
 			})
 		}
 	})
+
+	s.Run("success: resubmission with the same Idempotency-Key is marked replayed", func() {
+		nonce := uuid.NewString()
+		s.mockCommands.EXPECT().Login(gomock.Any(), reqBody, "", nonce).
+			Return(&commands.LoginResult{
+				UserID:     returnUser.ID,
+				TokenPair:  &commands.TokenPair{AccessToken: expectedToken, RefreshToken: expectedRefresh},
+				IsReplayed: true,
+			}, nil).Times(1)
+		s.mockQueries.EXPECT().GetCurrentUser(gomock.Any(), returnUser.ID).
+			Return(returnUser, nil).Times(1)
+
+		rec := httptest.PerformRequestWithHeaders(s.T(), s.router, http.MethodPost, url, reqBody, "", map[string]string{"Idempotency-Key": nonce})
+		httptest.AssertSuccessResponse(s.T(), rec, http.StatusOK, &resdto.LoginResponse{})
+		httptest.AssertHeaders(s.T(), rec, map[string]string{"Idempotent-Replayed": "true"})
+	})
+
+	s.Run("error: 400 Bad Request on a malformed Idempotency-Key", func() {
+		rec := httptest.PerformRequestWithHeaders(s.T(), s.router, http.MethodPost, url, reqBody, "", map[string]string{"Idempotency-Key": "not-a-uuid"})
+		httptest.AssertErrorCode(s.T(), rec, http.StatusBadRequest, "INVALID_IDEMPOTENCY_KEY")
+	})
+
+	s.Run("success: 202 Accepted with an mfa_pending token when a second factor is due", func() {
+		expiresAt := time.Now().Add(5 * time.Minute).UTC().Truncate(time.Second)
+		s.mockCommands.EXPECT().Login(gomock.Any(), reqBody, "", "").
+			Return(&commands.LoginResult{
+				UserID:       returnUser.ID,
+				MFAChallenge: &commands.MFAChallenge{Token: "mfa-token", ExpiresAt: expiresAt},
+			}, nil).Times(1)
+
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodPost, url, reqBody, "")
+		var body resdto.MFAChallengeResponse
+		httptest.AssertSuccessResponse(s.T(), rec, http.StatusAccepted, &body)
+		s.Equal("mfa_pending", body.Status)
+		s.Equal("mfa-token", body.MFAToken)
+		s.True(expiresAt.Equal(body.ExpiresAt))
+		s.Empty(rec.Result().Cookies())
+	})
+
+	s.Run("error: 423 Locked with Retry-After while the sign-in is locked out", func() {
+		until := time.Now().Add(10 * time.Minute)
+		s.mockCommands.EXPECT().Login(gomock.Any(), reqBody, "", "").
+			Return(nil, &commands.AccountLockedError{Until: until}).Times(1)
+
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodPost, url, reqBody, "")
+		httptest.AssertErrorCode(s.T(), rec, http.StatusLocked, "ACCOUNT_LOCKED")
+		httptest.AssertHeaders(s.T(), rec, map[string]string{"Retry-After": "600"})
+		s.Empty(rec.Result().Cookies())
+	})
 }
 
 func (s *AuthHandlerTestSuite) TestLogout() {
-	s.Run("success: returns 204 No Content", func() {
+	s.Run("success: revokes the session and returns 204 No Content", func() {
+		s.mockCommands.EXPECT().Logout(gomock.Any(), s.accessToken, "").Return(nil).Times(1)
+
 		rec := httptest.PerformRequest(s.T(), s.router, http.MethodPost, "/auth/logout", nil, "bearer-token")
 		s.Equal(http.StatusNoContent, rec.Code)
 	})
+
+	s.Run("success: request without an access token only clears cookies", func() {
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodPost, "/auth/logout", nil, "")
+		s.Equal(http.StatusNoContent, rec.Code)
+	})
+
+	s.Run("error: 500 Internal Server Error when the session cannot be revoked", func() {
+		s.mockCommands.EXPECT().Logout(gomock.Any(), s.accessToken, "").Return(commands.ErrLogoutFailed).Times(1)
+
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodPost, "/auth/logout", nil, "bearer-token")
+		s.Equal(http.StatusInternalServerError, rec.Code)
+	})
+}
+
+func (s *AuthHandlerTestSuite) TestRevokeSessions() {
+	userID := uuid.New()
+	url := "/admin/users/" + userID.String() + "/sessions"
+
+	s.Run("success: returns 204 No Content", func() {
+		s.mockCommands.EXPECT().RevokeAllSessions(gomock.Any(), userID, s.accessToken.UserID).Return(nil).Times(1)
+
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodDelete, url, nil, "bearer-token")
+		s.Equal(http.StatusNoContent, rec.Code)
+	})
+
+	s.Run("error: 400 Bad Request for an invalid user ID", func() {
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodDelete, "/admin/users/not-a-uuid/sessions", nil, "bearer-token")
+		httptest.AssertErrorCode(s.T(), rec, http.StatusBadRequest, "INVALID_ID_FORMAT")
+	})
+
+	s.Run("error: 404 Not Found when the user does not exist", func() {
+		s.mockCommands.EXPECT().RevokeAllSessions(gomock.Any(), userID, s.accessToken.UserID).Return(commands.ErrUserNotFound).Times(1)
+
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodDelete, url, nil, "bearer-token")
+		s.Equal(http.StatusNotFound, rec.Code)
+	})
+
+	s.Run("error: 500 Internal Server Error without a user in context", func() {
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodDelete, url, nil, "")
+		s.Equal(http.StatusInternalServerError, rec.Code)
+	})
+}
+
+func (s *AuthHandlerTestSuite) TestUnlockLogin() {
+	userID := uuid.New()
+	url := "/admin/users/" + userID.String() + "/login-lockout"
+
+	s.Run("success: returns 204 No Content", func() {
+		s.mockCommands.EXPECT().UnlockLogin(gomock.Any(), userID, s.accessToken.UserID).Return(nil).Times(1)
+
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodDelete, url, nil, "bearer-token")
+		s.Equal(http.StatusNoContent, rec.Code)
+	})
+
+	s.Run("error: 404 Not Found when the user does not exist", func() {
+		s.mockCommands.EXPECT().UnlockLogin(gomock.Any(), userID, s.accessToken.UserID).Return(commands.ErrUserNotFound).Times(1)
+
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodDelete, url, nil, "bearer-token")
+		httptest.AssertErrorCode(s.T(), rec, http.StatusNotFound, "USER_NOT_FOUND")
+	})
+}
+
+func (s *AuthHandlerTestSuite) TestRegister() {
+	req := reqdto.RegisterRequest{Email: "new@example.com", Password: "password123"}
+
+	s.Run("success: returns 202 with the pending account", func() {
+		userID := uuid.New()
+		s.mockCommands.EXPECT().Register(gomock.Any(), req).
+			Return(&commands.RegisterResult{UserID: userID, Email: req.Email, ExpiresAt: time.Now().Add(time.Hour)}, nil).Times(1)
+
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodPost, "/auth/register", req, "")
+		var body resdto.RegisterResponse
+		httptest.AssertSuccessResponse(s.T(), rec, http.StatusAccepted, &body)
+		s.Equal(userID, body.UserID)
+	})
+
+	s.Run("error: 409 Conflict for a registered email", func() {
+		s.mockCommands.EXPECT().Register(gomock.Any(), req).Return(nil, commands.ErrRegisterEmailTaken).Times(1)
+
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodPost, "/auth/register", req, "")
+		httptest.AssertErrorCode(s.T(), rec, http.StatusConflict, "EMAIL_ALREADY_REGISTERED")
+	})
+
+	s.Run("error: 404 Not Found when registration is disabled", func() {
+		s.mockCommands.EXPECT().Register(gomock.Any(), req).Return(nil, commands.ErrRegistrationDisabled).Times(1)
+
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodPost, "/auth/register", req, "")
+		s.Equal(http.StatusNotFound, rec.Code)
+	})
+
+	s.Run("error: 400 Bad Request for a malformed body", func() {
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodPost, "/auth/register", map[string]string{"email": "nope"}, "")
+		s.Equal(http.StatusBadRequest, rec.Code)
+	})
+}
+
+func (s *AuthHandlerTestSuite) TestVerifyEmail() {
+	req := reqdto.VerifyEmailRequest{Token: "signed-token"}
+
+	s.Run("success: returns 204 No Content", func() {
+		s.mockCommands.EXPECT().VerifyEmail(gomock.Any(), req.Token).Return(nil).Times(1)
+
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodPost, "/auth/verify-email", req, "")
+		s.Equal(http.StatusNoContent, rec.Code)
+	})
+
+	s.Run("error: 400 Bad Request for a spent or forged token", func() {
+		s.mockCommands.EXPECT().VerifyEmail(gomock.Any(), req.Token).Return(commands.ErrInvalidVerification).Times(1)
+
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodPost, "/auth/verify-email", req, "")
+		httptest.AssertErrorCode(s.T(), rec, http.StatusBadRequest, "EMAIL_VERIFICATION_INVALID")
+	})
+
+	s.Run("error: 410 Gone for an expired link", func() {
+		s.mockCommands.EXPECT().VerifyEmail(gomock.Any(), req.Token).Return(commands.ErrVerificationExpired).Times(1)
+
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodPost, "/auth/verify-email", req, "")
+		httptest.AssertErrorCode(s.T(), rec, http.StatusGone, "EMAIL_VERIFICATION_EXPIRED")
+	})
+}
+
+func (s *AuthHandlerTestSuite) TestVerifyMFA() {
+	url := "/auth/mfa/verify"
+	req := reqdto.VerifyMFARequest{MFAToken: "mfa-token", Code: "123456"}
+	returnUser := builder.NewUserBuilder().BuildReadModel()
+
+	s.Run("success: sets the token cookies", func() {
+		s.mockCommands.EXPECT().VerifyMFA(gomock.Any(), req, "").
+			Return(&commands.LoginResult{
+				UserID:    returnUser.ID,
+				TokenPair: &commands.TokenPair{AccessToken: "access", RefreshToken: "refresh"},
+			}, nil).Times(1)
+		s.mockQueries.EXPECT().GetCurrentUser(gomock.Any(), returnUser.ID).Return(returnUser, nil).Times(1)
+
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodPost, url, req, "")
+		var body resdto.LoginResponse
+		httptest.AssertSuccessResponse(s.T(), rec, http.StatusOK, &body)
+		s.Equal(returnUser.Email, body.User.Email)
+		cookies := map[string]string{}
+		for _, c := range rec.Result().Cookies() {
+			cookies[c.Name] = c.Value
+		}
+		s.Equal("access", cookies[cookie.AccessTokenCookieName])
+	})
+
+	testCases := []struct {
+		name           string
+		commandsError  error
+		expectedStatus int
+		expectedCode   string
+	}{
+		{"wrong code", commands.ErrMFACodeInvalid, http.StatusUnauthorized, "MFA_CODE_INVALID"},
+		{"expired token", commands.ErrMFAChallengeExpired, http.StatusUnauthorized, "MFA_TOKEN_EXPIRED"},
+		{"forged token", commands.ErrMFAChallengeInvalid, http.StatusUnauthorized, "MFA_TOKEN_INVALID"},
+		{"locked out", commands.ErrMFALocked, http.StatusTooManyRequests, "MFA_LOCKED"},
+	}
+	for _, tc := range testCases {
+		s.Run("error: "+tc.name, func() {
+			s.mockCommands.EXPECT().VerifyMFA(gomock.Any(), req, "").Return(nil, tc.commandsError).Times(1)
+
+			rec := httptest.PerformRequest(s.T(), s.router, http.MethodPost, url, req, "")
+			httptest.AssertErrorCode(s.T(), rec, tc.expectedStatus, tc.expectedCode)
+		})
+	}
+
+	s.Run("error: 400 Bad Request without a code", func() {
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodPost, url, map[string]string{"mfaToken": "mfa-token"}, "")
+		s.Equal(http.StatusBadRequest, rec.Code)
+	})
+}
+
+func (s *AuthHandlerTestSuite) TestEnableMFA() {
+	url := "/auth/mfa/enable"
+	req := reqdto.MFACodeRequest{Code: "123456"}
+
+	s.Run("success: returns the recovery codes", func() {
+		codes := []string{"abcde-fghij", "klmno-pqrst"}
+		s.mockCommands.EXPECT().EnableMFA(gomock.Any(), s.accessToken.UserID, req.Code).Return(codes, nil).Times(1)
+
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodPost, url, req, "")
+		var body resdto.MFARecoveryCodesResponse
+		httptest.AssertSuccessResponse(s.T(), rec, http.StatusOK, &body)
+		s.Equal(codes, body.RecoveryCodes)
+	})
+
+	s.Run("error: 403 Forbidden for a wrong code", func() {
+		s.mockCommands.EXPECT().EnableMFA(gomock.Any(), s.accessToken.UserID, req.Code).Return(nil, commands.ErrMFACodeInvalid).Times(1)
+
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodPost, url, req, "")
+		httptest.AssertErrorCode(s.T(), rec, http.StatusForbidden, "MFA_CODE_INVALID")
+	})
+
+	s.Run("error: 409 Conflict without a pending enrollment", func() {
+		s.mockCommands.EXPECT().EnableMFA(gomock.Any(), s.accessToken.UserID, req.Code).Return(nil, commands.ErrMFANotEnrolled).Times(1)
+
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodPost, url, req, "")
+		httptest.AssertErrorCode(s.T(), rec, http.StatusConflict, "MFA_NOT_ENROLLED")
+	})
+}
+
+func (s *AuthHandlerTestSuite) TestChangePassword() {
+	url := "/auth/password"
+	req := reqdto.ChangePasswordRequest{CurrentPassword: "password123", NewPassword: "password456"}
+	pair := &commands.TokenPair{AccessToken: "access", RefreshToken: "refresh"}
+
+	s.Run("success: cookie clients get the new session as cookies", func() {
+		s.mockCommands.EXPECT().ChangePassword(gomock.Any(), s.accessToken.UserID, req, "").Return(pair, nil).Times(1)
+
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodPut, url, req, "")
+		s.Equal(http.StatusNoContent, rec.Code)
+		cookies := map[string]string{}
+		for _, c := range rec.Result().Cookies() {
+			cookies[c.Name] = c.Value
+		}
+		s.Equal("access", cookies[cookie.AccessTokenCookieName])
+		s.Equal("refresh", cookies[cookie.RefreshTokenCookieName])
+	})
+
+	s.Run("success: bearer clients get the new session in the body", func() {
+		s.mockCommands.EXPECT().ChangePassword(gomock.Any(), s.accessToken.UserID, req, "").Return(pair, nil).Times(1)
+
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodPut, url, req, "bearer-token")
+		var body resdto.TokenResponse
+		httptest.AssertSuccessResponse(s.T(), rec, http.StatusOK, &body)
+		s.Equal("access", body.AccessToken)
+		s.Empty(rec.Result().Cookies())
+	})
+
+	s.Run("error: 403 Forbidden for a wrong current password", func() {
+		s.mockCommands.EXPECT().ChangePassword(gomock.Any(), s.accessToken.UserID, req, "").Return(nil, commands.ErrCurrentPasswordWrong).Times(1)
+
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodPut, url, req, "")
+		httptest.AssertErrorCode(s.T(), rec, http.StatusForbidden, "CURRENT_PASSWORD_INCORRECT")
+	})
+
+	s.Run("error: 400 Bad Request for a weak password", func() {
+		s.mockCommands.EXPECT().ChangePassword(gomock.Any(), s.accessToken.UserID, req, "").Return(nil, commands.ErrWeakPassword).Times(1)
+
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodPut, url, req, "")
+		httptest.AssertErrorCode(s.T(), rec, http.StatusBadRequest, "WEAK_PASSWORD")
+	})
+
+	s.Run("error: 400 Bad Request without the current password", func() {
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodPut, url, map[string]string{"newPassword": "password456"}, "")
+		s.Equal(http.StatusBadRequest, rec.Code)
+	})
+}
+
+func (s *AuthHandlerTestSuite) TestCSRFToken() {
+	url := "/auth/csrf"
+
+	s.Run("success: sets the token as a cookie and reuses it", func() {
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodGet, url, nil, "")
+		s.Require().Equal(http.StatusOK, rec.Code)
+		var body resdto.CSRFTokenResponse
+		s.Require().NoError(httptest.DecodeResponseBody(s.T(), rec.Body, &body))
+		s.NotEmpty(body.CSRFToken)
+
+		var issued *http.Cookie
+		for _, c := range rec.Result().Cookies() {
+			if c.Name == cookie.CSRFTokenCookieName {
+				issued = c
+			}
+		}
+		s.Require().NotNil(issued)
+		s.Equal(body.CSRFToken, issued.Value)
+		s.False(issued.HttpOnly, "the SPA reads the cookie back")
+
+		req := nethttptest.NewRequest(http.MethodGet, url, nil)
+		req.AddCookie(issued)
+		rec = nethttptest.NewRecorder()
+		s.router.ServeHTTP(rec, req)
+		s.Require().Equal(http.StatusOK, rec.Code)
+		var again resdto.CSRFTokenResponse
+		s.Require().NoError(httptest.DecodeResponseBody(s.T(), rec.Body, &again))
+		s.Equal(body.CSRFToken, again.CSRFToken)
+	})
 }
 
 func (s *AuthHandlerTestSuite) TestMe() {
@@ -254,3 +628,91 @@ func (s *AuthHandlerTestSuite) TestMe() {
 		}
 	})
 }
+
+func (s *AuthHandlerTestSuite) TestToken() {
+	url := "/auth/token"
+	reqBody := builder.NewAuthBuilder().BuildDTO()
+	returnUser := builder.NewUserBuilder().BuildReadModel()
+
+	s.Run("success: returns tokens in body without cookies", func() {
+		s.mockCommands.EXPECT().Login(gomock.Any(), reqBody, "", "").
+			Return(&commands.LoginResult{
+				UserID:    returnUser.ID,
+				TokenPair: &commands.TokenPair{AccessToken: "access", RefreshToken: "refresh"},
+			}, nil).Times(1)
+		s.mockQueries.EXPECT().GetCurrentUser(gomock.Any(), returnUser.ID).
+			Return(returnUser, nil).Times(1)
+
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodPost, url, reqBody, "")
+
+		var response resdto.TokenResponse
+		httptest.AssertSuccessResponse(s.T(), rec, http.StatusOK, &response)
+		s.Equal("access", response.AccessToken)
+		s.Equal("refresh", response.RefreshToken)
+		s.Equal("Bearer", response.TokenType)
+		s.Equal(returnUser.Email, response.User.Email)
+		s.Empty(rec.Result().Cookies())
+	})
+
+	s.Run("error: 401 for invalid credentials", func() {
+		s.mockCommands.EXPECT().Login(gomock.Any(), reqBody, "", "").
+			Return(nil, commands.ErrInvalidCredentials).Times(1)
+
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodPost, url, reqBody, "")
+		httptest.AssertErrorResponse(s.T(), rec, http.StatusUnauthorized, "Invalid email or password")
+	})
+
+	s.Run("error: 404 when body tokens are disabled", func() {
+		cfg := config.NewTestConfig()
+		cfg.JWT.BodyTokensEnabled = false
+		handler := api.NewAuthHandler(s.mockCommands, s.mockQueries, &jwt.Service{}, cfg, signedtoken.NewSigner([]byte("test-secret")), clock.NewRealClock())
+		router := gin.New()
+		router.POST(url, handler.Token)
+
+		rec := httptest.PerformRequest(s.T(), router, http.MethodPost, url, reqBody, "")
+		httptest.AssertErrorResponse(s.T(), rec, http.StatusNotFound, "Not found")
+	})
+}
+
+func (s *AuthHandlerTestSuite) TestTokenRefresh() {
+	url := "/auth/token/refresh"
+
+	s.Run("success: rotates tokens from Authorization header", func() {
+		s.mockCommands.EXPECT().RefreshToken(gomock.Any(), "old-refresh", "").
+			Return(&commands.TokenPair{AccessToken: "new-access", RefreshToken: "new-refresh"}, nil).Times(1)
+
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodPost, url, nil, "old-refresh")
+
+		var response resdto.TokenResponse
+		httptest.AssertSuccessResponse(s.T(), rec, http.StatusOK, &response)
+		s.Equal("new-access", response.AccessToken)
+		s.Equal("new-refresh", response.RefreshToken)
+		s.Nil(response.User)
+	})
+
+	s.Run("success: forwards the device key header", func() {
+		s.mockCommands.EXPECT().RefreshToken(gomock.Any(), "bound-refresh", "device-123").
+			Return(&commands.TokenPair{AccessToken: "a", RefreshToken: "r"}, nil).Times(1)
+
+		req := nethttptest.NewRequest(http.MethodPost, url, nil)
+		req.Header.Set("Authorization", "Bearer bound-refresh")
+		req.Header.Set(api.DeviceKeyHeader, "device-123")
+		rec := nethttptest.NewRecorder()
+		s.router.ServeHTTP(rec, req)
+
+		s.Equal(http.StatusOK, rec.Code)
+	})
+
+	s.Run("error: 401 when Authorization header is missing", func() {
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodPost, url, nil, "")
+		httptest.AssertErrorResponse(s.T(), rec, http.StatusUnauthorized, "Refresh token not found")
+	})
+
+	s.Run("error: 401 when refresh token is rejected", func() {
+		s.mockCommands.EXPECT().RefreshToken(gomock.Any(), "bad", "").
+			Return(nil, errors.New("invalid token")).Times(1)
+
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodPost, url, nil, "bad")
+		httptest.AssertErrorResponse(s.T(), rec, http.StatusUnauthorized, "Invalid or expired refresh token")
+	})
+}
@@ -0,0 +1,72 @@
+package api
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/commands"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Webhook bodies above this size are rejected unread.
+const maxBillingWebhookBytes = 1 << 20
+
+type BillingHandler struct {
+	billingCommands commands.BillingCommands
+}
+
+func NewBillingHandler(billingCommands commands.BillingCommands) *BillingHandler {
+	return &BillingHandler{
+		billingCommands: billingCommands,
+	}
+}
+
+// @Summary Billing provider webhook
+// @Description Sync a company's subscription from a signed billing provider delivery (X-Billing-Signature: sha256=<hex HMAC of the body>). Retried and out-of-order deliveries are accepted without changing anything
+// @Tags billing
+// @Accept json
+// @Param X-Billing-Signature header string true "sha256=<hex HMAC-SHA256 of the raw body>"
+// @Success 204 "No Content"
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 422 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /billing/webhook [post]
+func (h *BillingHandler) Webhook(c *gin.Context) {
+	payload, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxBillingWebhookBytes))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, errs.Mark(err, commands.ErrBillingPayloadInvalid), "Invalid webhook payload", nil)
+		return
+	}
+
+	if err := h.billingCommands.HandleWebhook(c.Request.Context(), payload, c.Request.Header); err != nil {
+		handleBillingError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+var billingErrorRules = []createReservationErrorRule{
+	{commands.ErrBillingSignatureInvalid, http.StatusUnauthorized, "Invalid webhook signature", nil},
+	{commands.ErrBillingPayloadInvalid, http.StatusBadRequest, "Invalid webhook payload", nil},
+	{commands.ErrBillingUnknownReference, http.StatusUnprocessableEntity, "Unknown company or plan", nil},
+}
+
+func handleBillingError(c *gin.Context, err error) {
+	for _, rule := range billingErrorRules {
+		if errors.Is(err, rule.err) {
+			slog.Warn("Billing webhook error", "error", err.Error(), "status", rule.status)
+			httperr.AbortWithError(c, rule.status, err, rule.message, rule.extra)
+			return
+		}
+	}
+
+	slog.Error("Unexpected error in billing webhook", "error", err.Error())
+	httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+}
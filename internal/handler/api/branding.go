@@ -0,0 +1,142 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	reqdto "gin-clean-starter/internal/handler/dto/request"
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/usecase/commands"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type BrandingHandler struct {
+	brandingCommands commands.BrandingCommands
+	brandingQueries  queries.BrandingQueries
+}
+
+func NewBrandingHandler(brandingCommands commands.BrandingCommands, brandingQueries queries.BrandingQueries) *BrandingHandler {
+	return &BrandingHandler{
+		brandingCommands: brandingCommands,
+		brandingQueries:  brandingQueries,
+	}
+}
+
+// @Summary Get company branding
+// @Description The company's own email branding. Empty fields use the product defaults
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Company ID"
+// @Success 200 {object} response.BrandingResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/companies/{id}/branding [get]
+func (h *BrandingHandler) Get(c *gin.Context) {
+	companyID, ok := parseFeatureCompanyID(c)
+	if !ok {
+		return
+	}
+	h.respondWithBranding(c, companyID)
+}
+
+// @Summary Set company branding
+// @Description Replace the logo, colors, reply-to address and footer of the company's transactional emails. Omitted fields use the product defaults; the logo must be served over https
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Company ID"
+// @Param request body request.SetBrandingRequest true "Branding"
+// @Success 200 {object} response.BrandingResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/companies/{id}/branding [put]
+func (h *BrandingHandler) Set(c *gin.Context) {
+	companyID, ok := parseFeatureCompanyID(c)
+	if !ok {
+		return
+	}
+	var req reqdto.SetBrandingRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		slog.Info("Invalid request format in set branding", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid request format", nil)
+		return
+	}
+
+	actorID, _ := middleware.GetUserID(c)
+	if err := h.brandingCommands.Set(c.Request.Context(), companyID, req, actorID); err != nil {
+		handleBrandingError(c, "set branding", err)
+		return
+	}
+
+	h.respondWithBranding(c, companyID)
+}
+
+// @Summary Preview company branding
+// @Description Render a sample reservation email in the company's current branding
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Company ID"
+// @Success 200 {object} response.BrandingPreviewResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/companies/{id}/branding/preview [get]
+func (h *BrandingHandler) Preview(c *gin.Context) {
+	companyID, ok := parseFeatureCompanyID(c)
+	if !ok {
+		return
+	}
+	rendered, err := h.brandingQueries.Preview(c.Request.Context(), companyID)
+	if err != nil {
+		handleBrandingError(c, "preview branding", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resdto.FromRenderedEmail(rendered))
+}
+
+func (h *BrandingHandler) respondWithBranding(c *gin.Context, companyID uuid.UUID) {
+	branding, err := h.brandingQueries.Get(c.Request.Context(), companyID)
+	if err != nil {
+		handleBrandingError(c, "get branding", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resdto.FromCompanyBranding(branding))
+}
+
+var brandingErrorRules = []createReservationErrorRule{
+	{commands.ErrInvalidLogoURL, http.StatusBadRequest, "Logo URL must use https", nil},
+	{commands.ErrBrandingCompanyNotFound, http.StatusNotFound, "Company not found", nil},
+	{queries.ErrBrandingCompanyNotFound, http.StatusNotFound, "Company not found", nil},
+}
+
+func handleBrandingError(c *gin.Context, op string, err error) {
+	for _, rule := range brandingErrorRules {
+		if errors.Is(err, rule.err) {
+			slog.Warn("Branding error", "op", op, "error", err.Error(), "status", rule.status)
+			httperr.AbortWithError(c, rule.status, err, rule.message, rule.extra)
+			return
+		}
+	}
+
+	slog.Error("Unexpected error in branding", "op", op, "error", err.Error())
+	httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+}
@@ -0,0 +1,131 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	reqdto "gin-clean-starter/internal/handler/dto/request"
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/usecase/commands"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CompanyHandler struct {
+	companyCommands commands.CompanyCommands
+}
+
+func NewCompanyHandler(companyCommands commands.CompanyCommands) *CompanyHandler {
+	return &CompanyHandler{
+		companyCommands: companyCommands,
+	}
+}
+
+// @Summary Register company
+// @Description Create a company workspace with default settings, its owner user and sample resources in one transaction
+// @Tags companies
+// @Accept json
+// @Produce json
+// @Param request body request.RegisterCompanyRequest true "Company registration request"
+// @Success 201 {object} response.RegisterCompanyResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 409 {object} httperr.Response
+// @Router /companies [post]
+func (h *CompanyHandler) Register(c *gin.Context) {
+	var req reqdto.RegisterCompanyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		slog.Info("Invalid request format in register company", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid request format", nil)
+		return
+	}
+
+	result, err := h.companyCommands.Register(c.Request.Context(), req)
+	if err != nil {
+		handleCompanyError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resdto.FromRegisterCompanyResult(result))
+}
+
+// @Summary Set company review window
+// @Description Replace when the company's reservations can be reviewed, counted from the reservation's end: from opensAfterMinutes until windowDays. A null field uses the server default (REVIEW_WINDOW_OPENS_AFTER, REVIEW_WINDOW_DAYS)
+// @Tags admin
+// @Accept json
+// @Security BearerAuth
+// @Param id path string true "Company ID"
+// @Param request body request.SetReviewWindowRequest true "Review window"
+// @Success 204 "No Content"
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/companies/{id}/review-window [put]
+func (h *CompanyHandler) SetReviewWindow(c *gin.Context) {
+	companyID, ok := parseFeatureCompanyID(c)
+	if !ok {
+		return
+	}
+	var req reqdto.SetReviewWindowRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		slog.Info("Invalid request format in set review window", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid request format", nil)
+		return
+	}
+
+	actorID, _ := middleware.GetUserID(c)
+	if err := h.companyCommands.SetReviewWindow(c.Request.Context(), companyID, req, actorID); err != nil {
+		handleCompanySettingsError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+var companyErrorRules = []createReservationErrorRule{
+	{commands.ErrInvalidCompanyName, http.StatusBadRequest, "Invalid company name", nil},
+	{commands.ErrCompanyInvalidEmail, http.StatusBadRequest, "Invalid email", nil},
+	{commands.ErrCompanyWeakPassword, http.StatusBadRequest, "Password does not meet requirements", nil},
+	{commands.ErrInvalidTimezone, http.StatusBadRequest, "Invalid timezone", nil},
+	{commands.ErrInvalidReferralCode, http.StatusBadRequest, "Invalid referral code", nil},
+	{commands.ErrCompanyRegistrationDisabled, http.StatusForbidden, "Company registration is disabled", nil},
+	{commands.ErrPlanResourceLimit, http.StatusForbidden, "Default plan does not allow the sample resources", nil},
+	{commands.ErrCompanyAlreadyExists, http.StatusConflict, "Company name already taken", nil},
+	{commands.ErrCompanyEmailTaken, http.StatusConflict, "Email already registered", nil},
+}
+
+func handleCompanyError(c *gin.Context, err error) {
+	for _, rule := range companyErrorRules {
+		if errors.Is(err, rule.err) {
+			slog.Warn("Company registration error", "error", err.Error(), "status", rule.status)
+			httperr.AbortWithError(c, rule.status, err, rule.message, rule.extra)
+			return
+		}
+	}
+
+	slog.Error("Unexpected error in company registration", "error", err.Error())
+	httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+}
+
+var companySettingsErrorRules = []createReservationErrorRule{
+	{commands.ErrInvalidReviewWindow, http.StatusBadRequest, "Review window closes before it opens", nil},
+	{commands.ErrCompanyNotFound, http.StatusNotFound, "Company not found", nil},
+}
+
+func handleCompanySettingsError(c *gin.Context, err error) {
+	for _, rule := range companySettingsErrorRules {
+		if errors.Is(err, rule.err) {
+			slog.Warn("Company settings error", "error", err.Error(), "status", rule.status)
+			httperr.AbortWithError(c, rule.status, err, rule.message, rule.extra)
+			return
+		}
+	}
+
+	slog.Error("Unexpected error in company settings", "error", err.Error())
+	httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+}
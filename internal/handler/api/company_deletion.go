@@ -0,0 +1,116 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/commands"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+var ErrInvalidDeletionPathID = errs.NewCoded("INVALID_ID_FORMAT", "invalid company or deletion ID format")
+
+type CompanyDeletionHandler struct {
+	deletionCommands commands.CompanyDeletionCommands
+	deletionQueries  queries.CompanyDeletionQueries
+}
+
+func NewCompanyDeletionHandler(deletionCommands commands.CompanyDeletionCommands, deletionQueries queries.CompanyDeletionQueries) *CompanyDeletionHandler {
+	return &CompanyDeletionHandler{
+		deletionCommands: deletionCommands,
+		deletionQueries:  deletionQueries,
+	}
+}
+
+// @Summary Delete company
+// @Description Permanently delete a company. Its members are deactivated and its SSO, SCIM tokens and invites revoked at once; a background job then purges it stage by stage: upcoming reservations are canceled (users outside the company are emailed), reviews its members wrote about other companies' resources are kept under a deleted-user placeholder, stored files are deleted, then its members, resources and the company itself. Poll the returned deletion for progress; once done it carries the certificate also written to the audit log. confirm must repeat the company name, and staff cannot delete their own company (companies:delete)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Company ID"
+// @Param confirm query string true "The company's name, exactly"
+// @Success 202 {object} response.CompanyDeletionResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 409 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/companies/{id} [delete]
+func (h *CompanyDeletionHandler) Request(c *gin.Context) {
+	actorID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError, ErrMissingUserContext, "Internal server error", nil)
+		return
+	}
+	companyID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidDeletionPathID, "Invalid company ID format", nil)
+		return
+	}
+
+	deletion, err := h.deletionCommands.Request(c.Request.Context(), companyID, actorID, c.Query("confirm"))
+	if err != nil {
+		handleCompanyDeletionError(c, "request", err)
+		return
+	}
+
+	slog.Info("Company deletion requested", "actor_id", actorID, "company_id", companyID, "deletion_id", deletion.ID)
+	c.JSON(http.StatusAccepted, resdto.FromCompanyDeletion(deletion))
+}
+
+// @Summary Get company deletion
+// @Description A deletion's status, the stage it has reached and running counts of what it purged. A failed run leaves the error here and is retried from the last checkpoint (companies:delete)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Deletion ID"
+// @Success 200 {object} response.CompanyDeletionResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/company-deletions/{id} [get]
+func (h *CompanyDeletionHandler) Get(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidDeletionPathID, "Invalid deletion ID format", nil)
+		return
+	}
+
+	detail, err := h.deletionQueries.Get(c.Request.Context(), id)
+	if err != nil {
+		handleCompanyDeletionError(c, "get", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resdto.FromCompanyDeletionDetail(detail))
+}
+
+func handleCompanyDeletionError(c *gin.Context, op string, err error) {
+	switch {
+	case errors.Is(err, commands.ErrCompanyNotFound):
+		httperr.AbortWithError(c, http.StatusNotFound, err, "Company not found", nil)
+	case errors.Is(err, commands.ErrCompanyDeletionUnconfirmed):
+		httperr.AbortWithError(c, http.StatusBadRequest, err, "Confirm the deletion with the company's name", nil)
+	case errors.Is(err, commands.ErrCompanyDeletionOwnCompany):
+		httperr.AbortWithError(c, http.StatusConflict, err, "You cannot delete the company you belong to", nil)
+	case errors.Is(err, commands.ErrCompanyDeletionInProgress):
+		httperr.AbortWithError(c, http.StatusConflict, err, "A deletion of this company is already in progress", nil)
+	case errors.Is(err, queries.ErrCompanyDeletionNotFound):
+		httperr.AbortWithError(c, http.StatusNotFound, err, "Company deletion not found", nil)
+	default:
+		slog.Error("Unexpected error in company deletion", "op", op, "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+	}
+}
@@ -0,0 +1,153 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"mime"
+	"net/http"
+
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/commands"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+var ErrInvalidExportPathID = errs.NewCoded("INVALID_ID_FORMAT", "invalid company or export ID format")
+
+type CompanyExportHandler struct {
+	exportCommands commands.CompanyExportCommands
+	exportQueries  queries.CompanyExportQueries
+}
+
+func NewCompanyExportHandler(exportCommands commands.CompanyExportCommands, exportQueries queries.CompanyExportQueries) *CompanyExportHandler {
+	return &CompanyExportHandler{
+		exportCommands: exportCommands,
+		exportQueries:  exportQueries,
+	}
+}
+
+// @Summary Request company export
+// @Description Queue an archive of everything the company holds (profile, settings, users, resources, reservations and reviews) for an offboarding request. The archive is built in the background; poll the returned export for progress and its download link. Password hashes and encrypted phone numbers are left out. A company has one export in progress at a time (company_exports:manage)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Company ID"
+// @Success 202 {object} response.CompanyExportResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 409 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/companies/{id}/exports [post]
+func (h *CompanyExportHandler) Request(c *gin.Context) {
+	actorID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError, ErrMissingUserContext, "Internal server error", nil)
+		return
+	}
+	companyID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidExportPathID, "Invalid company ID format", nil)
+		return
+	}
+
+	export, err := h.exportCommands.Request(c.Request.Context(), companyID, actorID)
+	if err != nil {
+		handleCompanyExportError(c, "request", err)
+		return
+	}
+
+	slog.Info("Company export requested", "actor_id", actorID, "company_id", companyID, "export_id", export.ID)
+	c.JSON(http.StatusAccepted, resdto.FromCompanyExport(export))
+}
+
+// @Summary Get company export
+// @Description An export's status and progress in percent. Once done, the response carries a signed download link that is valid for a short time; each poll issues a new one until the archive expires (company_exports:manage)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Export ID"
+// @Success 200 {object} response.CompanyExportResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/company-exports/{id} [get]
+func (h *CompanyExportHandler) Get(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidExportPathID, "Invalid export ID format", nil)
+		return
+	}
+
+	detail, err := h.exportQueries.Get(c.Request.Context(), id)
+	if err != nil {
+		handleCompanyExportError(c, "get", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resdto.FromCompanyExportDetail(detail))
+}
+
+// @Summary Download company export
+// @Description The zip archive of a finished export: manifest.json, company.json and one JSON Lines file per section. Authenticated by the signed link from the export's status, so it can be handed to the departing customer
+// @Tags company-exports
+// @Produce application/zip
+// @Param token query string true "Signed download token"
+// @Success 200 {file} file
+// @Failure 400 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 410 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /company-exports/download [get]
+func (h *CompanyExportHandler) Download(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		httperr.AbortWithError(c, http.StatusBadRequest, queries.ErrInvalidExportLink, "Download token is required", nil)
+		return
+	}
+
+	detail, content, err := h.exportQueries.OpenDownload(c.Request.Context(), token)
+	if err != nil {
+		handleCompanyExportError(c, "download", err)
+		return
+	}
+	defer content.Close()
+
+	size := int64(-1)
+	if detail.SizeBytes != nil {
+		size = *detail.SizeBytes
+	}
+	filename := "company-" + detail.CompanyID.String() + "-export.zip"
+	c.DataFromReader(http.StatusOK, size, "application/zip", content, map[string]string{
+		"Content-Disposition":    mime.FormatMediaType("attachment", map[string]string{"filename": filename}),
+		"Cache-Control":          "no-store",
+		"X-Content-Type-Options": "nosniff",
+	})
+}
+
+func handleCompanyExportError(c *gin.Context, op string, err error) {
+	switch {
+	case errors.Is(err, commands.ErrCompanyNotFound):
+		httperr.AbortWithError(c, http.StatusNotFound, err, "Company not found", nil)
+	case errors.Is(err, commands.ErrCompanyExportInProgress):
+		httperr.AbortWithError(c, http.StatusConflict, err, "An export of this company is already in progress", nil)
+	case errors.Is(err, queries.ErrCompanyExportNotFound):
+		httperr.AbortWithError(c, http.StatusNotFound, err, "Company export not found", nil)
+	case errors.Is(err, queries.ErrInvalidExportLink):
+		httperr.AbortWithError(c, http.StatusForbidden, err, "Invalid download link", nil)
+	case errors.Is(err, queries.ErrExportLinkExpired):
+		httperr.AbortWithError(c, http.StatusGone, err, "Download link expired; request a new one", nil)
+	default:
+		slog.Error("Unexpected error in company export", "op", op, "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+	}
+}
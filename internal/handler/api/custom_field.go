@@ -0,0 +1,179 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	reqdto "gin-clean-starter/internal/handler/dto/request"
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/commands"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+var ErrInvalidCustomFieldPathID = errs.NewCoded("INVALID_ID_FORMAT", "invalid company, resource or field ID format")
+
+type CustomFieldHandler struct {
+	customFieldCommands commands.CustomFieldCommands
+	customFieldQueries  queries.CustomFieldQueries
+}
+
+func NewCustomFieldHandler(customFieldCommands commands.CustomFieldCommands, customFieldQueries queries.CustomFieldQueries) *CustomFieldHandler {
+	return &CustomFieldHandler{
+		customFieldCommands: customFieldCommands,
+		customFieldQueries:  customFieldQueries,
+	}
+}
+
+// @Summary List company custom fields
+// @Description List the custom fields the company collects on reservations, company-wide and per resource, oldest first
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Company ID"
+// @Success 200 {array} response.CustomFieldResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/companies/{id}/custom-fields [get]
+func (h *CustomFieldHandler) List(c *gin.Context) {
+	companyID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidCustomFieldPathID, "Invalid company ID format", nil)
+		return
+	}
+
+	fields, err := h.customFieldQueries.ListByCompany(c.Request.Context(), companyID)
+	if err != nil {
+		handleCustomFieldError(c, "list custom fields", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resdto.FromCustomFieldDefinitions(fields))
+}
+
+// @Summary Create company custom field
+// @Description Collect a text, number or select field on the company's reservations, or only on one of its resources with resourceId. Keys are lowercase identifiers, unique within the company, and name the field in reservation customFields, the admin field[key] filter and exports
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Company ID"
+// @Param request body request.CreateCustomFieldRequest true "Custom field"
+// @Success 201 {object} response.CustomFieldResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 409 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/companies/{id}/custom-fields [post]
+func (h *CustomFieldHandler) Create(c *gin.Context) {
+	companyID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidCustomFieldPathID, "Invalid company ID format", nil)
+		return
+	}
+	var req reqdto.CreateCustomFieldRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		slog.Info("Invalid request format in create custom field", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid request format", nil)
+		return
+	}
+
+	actorID, _ := middleware.GetUserID(c)
+	field, err := h.customFieldCommands.Create(c.Request.Context(), companyID, req, actorID)
+	if err != nil {
+		handleCustomFieldError(c, "create custom field", err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resdto.FromCustomFieldDefinition(field))
+}
+
+// @Summary Delete company custom field
+// @Description Stop collecting a custom field. Values already given on reservations are kept
+// @Tags admin
+// @Security BearerAuth
+// @Param id path string true "Company ID"
+// @Param fieldId path string true "Custom field ID"
+// @Success 204
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/companies/{id}/custom-fields/{fieldId} [delete]
+func (h *CustomFieldHandler) Delete(c *gin.Context) {
+	companyID, cerr := uuid.Parse(c.Param("id"))
+	fieldID, ferr := uuid.Parse(c.Param("fieldId"))
+	if cerr != nil || ferr != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidCustomFieldPathID, "Invalid company or field ID format", nil)
+		return
+	}
+
+	actorID, _ := middleware.GetUserID(c)
+	if err := h.customFieldCommands.Delete(c.Request.Context(), companyID, fieldID, actorID); err != nil {
+		handleCustomFieldError(c, "delete custom field", err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary List resource custom fields
+// @Description The custom fields a reservation of the resource is asked for: its company's company-wide fields and its own, oldest first
+// @Tags reservations
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Resource ID"
+// @Success 200 {array} response.CustomFieldResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /resources/{id}/custom-fields [get]
+func (h *CustomFieldHandler) ListForResource(c *gin.Context) {
+	resourceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidCustomFieldPathID, "Invalid resource ID format", nil)
+		return
+	}
+
+	fields, err := h.customFieldQueries.ListForResource(c.Request.Context(), resourceID)
+	if err != nil {
+		handleCustomFieldError(c, "list resource custom fields", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resdto.FromCustomFieldDefinitions(fields))
+}
+
+var customFieldErrorRules = []createReservationErrorRule{
+	{commands.ErrCustomFieldInvalid, http.StatusBadRequest, "Invalid custom field", nil},
+	{commands.ErrCustomFieldKeyTaken, http.StatusConflict, "Custom field key already in use", nil},
+	{commands.ErrCompanyNotFound, http.StatusNotFound, "Company not found", nil},
+	{commands.ErrCustomFieldResourceNotFound, http.StatusNotFound, "Resource not found in company", nil},
+	{commands.ErrCustomFieldNotFound, http.StatusNotFound, "Custom field not found", nil},
+	{queries.ErrCustomFieldResourceNotFound, http.StatusNotFound, "Resource not found", nil},
+}
+
+func handleCustomFieldError(c *gin.Context, op string, err error) {
+	for _, rule := range customFieldErrorRules {
+		if errors.Is(err, rule.err) {
+			slog.Warn("Custom field error", "op", op, "error", err.Error(), "status", rule.status)
+			httperr.AbortWithError(c, rule.status, err, rule.message, rule.extra)
+			return
+		}
+	}
+
+	slog.Error("Unexpected error in custom field", "op", op, "error", err.Error())
+	httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+}
@@ -0,0 +1,82 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/gin-gonic/gin"
+)
+
+var ErrInvalidDeprecationReportDate = errs.NewCoded("INVALID_DEPRECATION_REPORT_DATE", "dates must be formatted as YYYY-MM-DD")
+
+type DeprecationHandler struct {
+	deprecationQueries queries.DeprecationQueries
+}
+
+func NewDeprecationHandler(deprecationQueries queries.DeprecationQueries) *DeprecationHandler {
+	return &DeprecationHandler{
+		deprecationQueries: deprecationQueries,
+	}
+}
+
+// @Summary Deprecated route report
+// @Description Calls to deprecated routes per consumer, grouped by route with the busiest consumer first, to find who still has to migrate before a route's sunset. Anonymous calls are one row per route without a user, and counts are written in batches (usage:read)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param from query string false "First day as YYYY-MM-DD (default: 29 days before to)"
+// @Param to query string false "Last day as YYYY-MM-DD, inclusive (default: today, UTC)"
+// @Param route query string false "Only this route, e.g. GET /api/reservations/:id"
+// @Success 200 {object} response.DeprecationReportResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/usage/deprecated-routes [get]
+func (h *DeprecationHandler) Report(c *gin.Context) {
+	from, ok := parseDeprecationReportDay(c, "from")
+	if !ok {
+		return
+	}
+	to, ok := parseDeprecationReportDay(c, "to")
+	if !ok {
+		return
+	}
+	var route *string
+	if v := c.Query("route"); v != "" {
+		route = &v
+	}
+
+	report, err := h.deprecationQueries.Report(c.Request.Context(), from, to, route)
+	if err != nil {
+		if errors.Is(err, queries.ErrDeprecationRangeInvalid) {
+			httperr.AbortWithError(c, http.StatusBadRequest, err, "from must not be after to, and the range may span at most a year", nil)
+			return
+		}
+		slog.Error("Failed to build deprecated route report", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, resdto.FromDeprecationReport(report))
+}
+
+func parseDeprecationReportDay(c *gin.Context, param string) (*time.Time, bool) {
+	v := c.Query(param)
+	if v == "" {
+		return nil, true
+	}
+	day, err := time.Parse("2006-01-02", v)
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidDeprecationReportDate, "Invalid "+param+" date", nil)
+		return nil, false
+	}
+	return &day, true
+}
@@ -0,0 +1,160 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	reqdto "gin-clean-starter/internal/handler/dto/request"
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/commands"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+var ErrInvalidFeatureCompanyID = errs.NewCoded("INVALID_ID_FORMAT", "invalid company ID format")
+
+type FeatureHandler struct {
+	featureCommands commands.FeatureCommands
+	featureQueries  queries.FeatureQueries
+}
+
+func NewFeatureHandler(featureCommands commands.FeatureCommands, featureQueries queries.FeatureQueries) *FeatureHandler {
+	return &FeatureHandler{
+		featureCommands: featureCommands,
+		featureQueries:  featureQueries,
+	}
+}
+
+// @Summary List company features
+// @Description Every feature that can be rolled out per company, whether it is on for the company, and whether that comes from the company's own setting or the default (FEATURES_DEFAULT_ON)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Company ID"
+// @Success 200 {array} response.FeatureStateResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/companies/{id}/features [get]
+func (h *FeatureHandler) List(c *gin.Context) {
+	companyID, ok := parseFeatureCompanyID(c)
+	if !ok {
+		return
+	}
+	h.respondWithFeatures(c, companyID)
+}
+
+// @Summary Set company feature
+// @Description Turn a feature on or off for one company regardless of the default. Applies on other instances within FEATURES_CACHE_TTL
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Company ID"
+// @Param feature path string true "Feature" Enums(review_moderation)
+// @Param request body request.SetFeatureRequest true "Feature setting"
+// @Success 200 {array} response.FeatureStateResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/companies/{id}/features/{feature} [put]
+func (h *FeatureHandler) Set(c *gin.Context) {
+	companyID, ok := parseFeatureCompanyID(c)
+	if !ok {
+		return
+	}
+	var req reqdto.SetFeatureRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		slog.Info("Invalid request format in set feature", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid request format", nil)
+		return
+	}
+
+	actorID, _ := middleware.GetUserID(c)
+	if err := h.featureCommands.Set(c.Request.Context(), companyID, c.Param("feature"), *req.Enabled, actorID); err != nil {
+		handleFeatureCommandError(c, "set feature", err)
+		return
+	}
+
+	h.respondWithFeatures(c, companyID)
+}
+
+// @Summary Reset company feature
+// @Description Drop the company's own setting for a feature, so the default applies again
+// @Tags admin
+// @Security BearerAuth
+// @Param id path string true "Company ID"
+// @Param feature path string true "Feature" Enums(review_moderation)
+// @Success 204 "No Content"
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/companies/{id}/features/{feature} [delete]
+func (h *FeatureHandler) Reset(c *gin.Context) {
+	companyID, ok := parseFeatureCompanyID(c)
+	if !ok {
+		return
+	}
+
+	actorID, _ := middleware.GetUserID(c)
+	if err := h.featureCommands.Reset(c.Request.Context(), companyID, c.Param("feature"), actorID); err != nil {
+		handleFeatureCommandError(c, "reset feature", err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *FeatureHandler) respondWithFeatures(c *gin.Context, companyID uuid.UUID) {
+	states, err := h.featureQueries.List(c.Request.Context(), companyID)
+	if err != nil {
+		if errors.Is(err, queries.ErrFeatureCompanyNotFound) {
+			httperr.AbortWithError(c, http.StatusNotFound, err, "Company not found", nil)
+			return
+		}
+		slog.Error("Failed to list company features", "company_id", companyID, "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, resdto.FromFeatureStates(states))
+}
+
+func parseFeatureCompanyID(c *gin.Context) (uuid.UUID, bool) {
+	companyID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidFeatureCompanyID, "Invalid company ID format", nil)
+		return uuid.Nil, false
+	}
+	return companyID, true
+}
+
+var featureCommandErrorRules = []createReservationErrorRule{
+	{commands.ErrUnknownFeature, http.StatusBadRequest, "Unknown feature", nil},
+	{commands.ErrFeatureCompanyNotFound, http.StatusNotFound, "Company not found", nil},
+}
+
+func handleFeatureCommandError(c *gin.Context, op string, err error) {
+	for _, rule := range featureCommandErrorRules {
+		if errors.Is(err, rule.err) {
+			slog.Warn("Feature command error", "op", op, "error", err.Error(), "status", rule.status)
+			httperr.AbortWithError(c, rule.status, err, rule.message, rule.extra)
+			return
+		}
+	}
+
+	slog.Error("Unexpected error in feature command", "op", op, "error", err.Error())
+	httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+}
@@ -0,0 +1,236 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	reqdto "gin-clean-starter/internal/handler/dto/request"
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/commands"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+var ErrInvalidInviteIDFormat = errs.NewCoded("INVALID_ID_FORMAT", "invalid invite ID format")
+
+type InviteHandler struct {
+	inviteCommands commands.InviteCommands
+	inviteQueries  queries.InviteQueries
+}
+
+func NewInviteHandler(inviteCommands commands.InviteCommands, inviteQueries queries.InviteQueries) *InviteHandler {
+	return &InviteHandler{
+		inviteCommands: inviteCommands,
+		inviteQueries:  inviteQueries,
+	}
+}
+
+// @Summary Invite company member
+// @Description Email a signed invite link; the invitee joins the caller's company with the given role (default viewer). Inviting into a role other than the caller's own requires roles:manage.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body request.CreateInviteRequest true "Invite request"
+// @Success 201 {object} response.InviteResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 409 {object} httperr.Response
+// @Failure 422 {object} httperr.Response
+// @Router /admin/invites [post]
+func (h *InviteHandler) Create(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError, ErrMissingUserContext, "Internal server error", nil)
+		return
+	}
+	role, _ := middleware.GetUserRole(c)
+
+	var req reqdto.CreateInviteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		slog.Info("Invalid request format in create invite", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid request format", nil)
+		return
+	}
+
+	result, err := h.inviteCommands.Create(c.Request.Context(), req, userID, string(role))
+	if err != nil {
+		handleInviteError(c, "create", err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resdto.FromInviteResult(result))
+}
+
+// @Summary List company invites
+// @Description List invites of the caller's company (or the support session's company), newest first
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} response.InviteListResponse
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 422 {object} httperr.Response
+// @Router /admin/invites [get]
+func (h *InviteHandler) List(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError, ErrMissingUserContext, "Internal server error", nil)
+		return
+	}
+
+	var (
+		invites []*queries.InviteView
+		err     error
+	)
+	if scope, ok := middleware.GetSupportScope(c); ok {
+		invites, err = h.inviteQueries.ListByCompany(c.Request.Context(), scope.CompanyID)
+	} else {
+		invites, err = h.inviteQueries.List(c.Request.Context(), userID)
+	}
+	if err != nil {
+		if errors.Is(err, queries.ErrInviteCompanyMissing) {
+			httperr.AbortWithError(c, http.StatusUnprocessableEntity, err, "Caller does not belong to a company", nil)
+			return
+		}
+		slog.Error("Failed to list invites", "user_id", userID, "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, resdto.FromInviteViews(invites))
+}
+
+// @Summary Resend invite
+// @Description Email a new invite link with a fresh expiry; previously sent links stop working
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Invite ID"
+// @Success 200 {object} response.InviteResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 409 {object} httperr.Response
+// @Router /admin/invites/{id}/resend [post]
+func (h *InviteHandler) Resend(c *gin.Context) {
+	inviteID, userID, ok := parseInvitePath(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.inviteCommands.Resend(c.Request.Context(), inviteID, userID)
+	if err != nil {
+		handleInviteError(c, "resend", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resdto.FromInviteResult(result))
+}
+
+// @Summary Revoke invite
+// @Description Revoke a pending invite so its link can no longer be accepted
+// @Tags admin
+// @Security BearerAuth
+// @Param id path string true "Invite ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 409 {object} httperr.Response
+// @Router /admin/invites/{id} [delete]
+func (h *InviteHandler) Revoke(c *gin.Context) {
+	inviteID, userID, ok := parseInvitePath(c)
+	if !ok {
+		return
+	}
+
+	if err := h.inviteCommands.Revoke(c.Request.Context(), inviteID, userID); err != nil {
+		handleInviteError(c, "revoke", err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary Accept invite
+// @Description Create an account from an invite link token; the account joins the inviting company with the invited role
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body request.AcceptInviteRequest true "Invite token and new password"
+// @Success 201 {object} response.AcceptInviteResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 409 {object} httperr.Response
+// @Failure 410 {object} httperr.Response
+// @Router /auth/accept-invite [post]
+func (h *InviteHandler) Accept(c *gin.Context) {
+	var req reqdto.AcceptInviteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		slog.Info("Invalid request format in accept invite", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid request format", nil)
+		return
+	}
+
+	result, err := h.inviteCommands.Accept(c.Request.Context(), req)
+	if err != nil {
+		handleInviteError(c, "accept", err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resdto.FromAcceptInviteResult(result))
+}
+
+func parseInvitePath(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	inviteID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidInviteIDFormat, "Invalid invite ID format", nil)
+		return uuid.Nil, uuid.Nil, false
+	}
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError, ErrMissingUserContext, "Internal server error", nil)
+		return uuid.Nil, uuid.Nil, false
+	}
+	return inviteID, userID, true
+}
+
+var inviteErrorRules = []createReservationErrorRule{
+	{commands.ErrInviteInvalidEmail, http.StatusBadRequest, "Invalid email", nil},
+	{commands.ErrInviteUnknownRole, http.StatusBadRequest, "Unknown role", nil},
+	{commands.ErrInviteWeakPassword, http.StatusBadRequest, "Password does not meet requirements", nil},
+	{commands.ErrInvalidInvite, http.StatusBadRequest, "Invalid invite", nil},
+	{commands.ErrInvalidReferralCode, http.StatusBadRequest, "Invalid referral code", nil},
+	{commands.ErrInviteRoleForbidden, http.StatusForbidden, "Not allowed to invite into this role", nil},
+	{commands.ErrInviteNotFound, http.StatusNotFound, "Invite not found", nil},
+	{commands.ErrInviteEmailTaken, http.StatusConflict, "Email already registered", nil},
+	{commands.ErrInviteAlreadyPending, http.StatusConflict, "Invite already pending for this email", nil},
+	{commands.ErrInviteNotPending, http.StatusConflict, "Invite is no longer pending", nil},
+	{commands.ErrInviteExpired, http.StatusGone, "Invite expired", nil},
+	{commands.ErrInviteCompanyMissing, http.StatusUnprocessableEntity, "Caller does not belong to a company", nil},
+}
+
+func handleInviteError(c *gin.Context, op string, err error) {
+	for _, rule := range inviteErrorRules {
+		if errors.Is(err, rule.err) {
+			slog.Warn("Invite command error", "op", op, "error", err.Error(), "status", rule.status)
+			httperr.AbortWithError(c, rule.status, err, rule.message, rule.extra)
+			return
+		}
+	}
+
+	slog.Error("Unexpected error in invite command", "op", op, "error", err.Error())
+	httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+}
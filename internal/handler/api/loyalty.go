@@ -0,0 +1,60 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/handler/render"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/gin-gonic/gin"
+)
+
+type LoyaltyHandler struct {
+	loyaltyQueries queries.LoyaltyQueries
+}
+
+func NewLoyaltyHandler(loyaltyQueries queries.LoyaltyQueries) *LoyaltyHandler {
+	return &LoyaltyHandler{
+		loyaltyQueries: loyaltyQueries,
+	}
+}
+
+// @Summary Get my points history
+// @Description Get the caller's spendable loyalty points balance and their points ledger, newest first
+// @Tags users
+// @Produce json
+// @Security BearerAuth
+// @Param after query string false "Pagination cursor"
+// @Param limit query int false "Page size"
+// @Success 200 {object} response.PointsHistoryResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /users/me/points [get]
+func (h *LoyaltyHandler) GetMyPoints(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError, ErrMissingUserContext, "Internal server error", nil)
+		return
+	}
+
+	limit, cursor := parseListParams(c)
+	history, next, err := h.loyaltyQueries.GetHistory(c.Request.Context(), userID, cursor, limit)
+	if err != nil {
+		if errors.Is(err, queries.ErrInvalidCursor) {
+			httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid cursor", nil)
+			return
+		}
+		slog.Error("Failed to get points history", "user_id", userID, "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		return
+	}
+
+	render.JSON(c, http.StatusOK, resdto.NewPointsHistoryPage(history, next))
+}
@@ -0,0 +1,60 @@
+package api
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/commands"
+
+	"github.com/gin-gonic/gin"
+)
+
+var ErrInvalidRepairFlag = errs.NewCoded("INVALID_REPAIR_FLAG", "repair must be true or false")
+
+type MaintenanceHandler struct {
+	cursorCommands commands.CursorCommands
+}
+
+func NewMaintenanceHandler(cursorCommands commands.CursorCommands) *MaintenanceHandler {
+	return &MaintenanceHandler{
+		cursorCommands: cursorCommands,
+	}
+}
+
+// @Summary Check stored cursors
+// @Description Find stored positions that event processing resumes from (billing webhook watermarks, message read markers, queued notification run times) that are ahead of the clock by more than MAINTENANCE_CURSOR_CLOCK_SKEW, as a data migration can leave them. Dry run by default; with repair=true webhook watermarks are cleared and the others moved back to now, each repair audited
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param repair query bool false "Repair the invalid cursors"
+// @Success 200 {object} response.CursorCheckResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/maintenance/cursors [post]
+func (h *MaintenanceHandler) CheckCursors(c *gin.Context) {
+	repair := false
+	if v := c.Query("repair"); v != "" {
+		var err error
+		if repair, err = strconv.ParseBool(v); err != nil {
+			httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidRepairFlag, "Invalid repair flag", nil)
+			return
+		}
+	}
+
+	actorID, _ := middleware.GetUserID(c)
+	results, err := h.cursorCommands.Check(c.Request.Context(), repair, &actorID)
+	if err != nil {
+		slog.Error("Failed to check stored cursors", "repair", repair, "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, resdto.FromCursorCheckResults(repair, results))
+}
@@ -0,0 +1,104 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	reqdto "gin-clean-starter/internal/handler/dto/request"
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/usecase/commands"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/gin-gonic/gin"
+)
+
+type NotificationPreferencesHandler struct {
+	digestCommands     commands.NotificationDigestCommands
+	preferencesQueries queries.NotificationPreferencesQueries
+}
+
+func NewNotificationPreferencesHandler(digestCommands commands.NotificationDigestCommands, preferencesQueries queries.NotificationPreferencesQueries) *NotificationPreferencesHandler {
+	return &NotificationPreferencesHandler{
+		digestCommands:     digestCommands,
+		preferencesQueries: preferencesQueries,
+	}
+}
+
+// @Summary Get notification preferences
+// @Description How the caller gets non-urgent notification emails: one by one (off) or in a daily or weekly digest, and how many wait for the next digest
+// @Tags users
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.NotificationPreferencesResponse
+// @Failure 401 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /users/me/notification-preferences [get]
+func (h *NotificationPreferencesHandler) Get(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+	prefs, err := h.preferencesQueries.Get(c.Request.Context(), userID)
+	if err != nil {
+		handleNotificationPreferencesError(c, "get notification preferences", err)
+		return
+	}
+	c.JSON(http.StatusOK, resdto.FromNotificationPreferencesView(prefs))
+}
+
+// @Summary Update notification preferences
+// @Description Choose how non-urgent notification emails (reservations confirmed or approved, operator messages, referral rewards) reach the caller: off sends each as it happens, daily or weekly folds them into one summary email once the oldest has waited that long. Cancellations, rejections and other urgent emails always go out at once. Turning the digest off sends what is held in one last digest
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body request.UpdateNotificationPreferencesRequest true "Digest mode"
+// @Success 200 {object} response.NotificationPreferencesResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /users/me/notification-preferences [put]
+func (h *NotificationPreferencesHandler) Update(c *gin.Context) {
+	var req reqdto.UpdateNotificationPreferencesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		slog.Info("Invalid request format in update notification preferences", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid request format", nil)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+	ctx := c.Request.Context()
+	if err := h.digestCommands.SetMode(ctx, userID, req.Digest); err != nil {
+		handleNotificationPreferencesError(c, "update notification preferences", err)
+		return
+	}
+	prefs, err := h.preferencesQueries.Get(ctx, userID)
+	if err != nil {
+		handleNotificationPreferencesError(c, "get notification preferences", err)
+		return
+	}
+
+	slog.Info("Notification preferences updated", "user_id", userID, "digest", req.Digest)
+	c.JSON(http.StatusOK, resdto.FromNotificationPreferencesView(prefs))
+}
+
+var notificationPreferencesErrorRules = []createReservationErrorRule{
+	{commands.ErrInvalidNotificationDigest, http.StatusBadRequest, "Digest must be off, daily or weekly", nil},
+	{commands.ErrUserNotFound, http.StatusNotFound, "User not found", nil},
+	{queries.ErrUserNotFound, http.StatusNotFound, "User not found", nil},
+}
+
+func handleNotificationPreferencesError(c *gin.Context, op string, err error) {
+	for _, rule := range notificationPreferencesErrorRules {
+		if errors.Is(err, rule.err) {
+			slog.Warn("Notification preferences error", "op", op, "error", err.Error(), "status", rule.status)
+			httperr.AbortWithError(c, rule.status, err, rule.message, rule.extra)
+			return
+		}
+	}
+
+	slog.Error("Unexpected error in notification preferences", "op", op, "error", err.Error())
+	httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+}
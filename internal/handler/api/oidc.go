@@ -0,0 +1,142 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/pkg/config"
+	"gin-clean-starter/internal/pkg/cookie"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/pkg/jwt"
+	"gin-clean-starter/internal/usecase/commands"
+
+	"github.com/gin-gonic/gin"
+)
+
+// OIDCStateCookieName carries the signed sign-in state from the start of an OpenID Connect
+// sign-in to its callback.
+const OIDCStateCookieName = "oidc_state"
+
+var ErrOIDCAuthorizationDenied = errs.NewCoded("OIDC_AUTHORIZATION_DENIED", "sign-in not completed at the provider")
+
+type OIDCHandler struct {
+	oidcCommands commands.OIDCCommands
+	jwtService   *jwt.Service
+	cfg          config.Config
+}
+
+func NewOIDCHandler(oidcCommands commands.OIDCCommands, jwtService *jwt.Service, cfg config.Config) *OIDCHandler {
+	return &OIDCHandler{
+		oidcCommands: oidcCommands,
+		jwtService:   jwtService,
+		cfg:          cfg,
+	}
+}
+
+// @Summary Start OpenID Connect sign-in
+// @Description Redirect the browser to the provider's sign-in page. Providers are google (OIDC_GOOGLE_CLIENT_ID) and OIDC_PROVIDER_NAME (OIDC_PROVIDER_ISSUER); others are not found
+// @Tags auth
+// @Param provider path string true "Provider name"
+// @Success 302 "Redirect to the provider"
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /auth/oidc/{provider}/start [get]
+func (h *OIDCHandler) Start(c *gin.Context) {
+	provider := c.Param("provider")
+	start, err := h.oidcCommands.StartLogin(c.Request.Context(), provider)
+	if err != nil {
+		handleOIDCError(c, "start oidc login", err)
+		return
+	}
+
+	h.setStateCookie(c, provider, start.State, time.Until(start.ExpiresAt))
+	c.Redirect(http.StatusFound, start.RedirectURL)
+}
+
+// @Summary OpenID Connect callback
+// @Description Redeem the provider's authorization code, set the token cookies and redirect to OIDC_REDIRECT_URL. An identity signing in for the first time gets a new viewer account unless its email is taken. Users with two-factor authentication are redirected with an mfaToken in the URL fragment to redeem at /auth/mfa/verify instead. Fails with DEVICE_KEY_REQUIRED when JWT_DEVICE_BINDING is required
+// @Tags auth
+// @Param provider path string true "Provider name"
+// @Param code query string false "Authorization code"
+// @Param state query string false "State sent to the provider"
+// @Param error query string false "Error the provider reports"
+// @Success 303 "Redirect to OIDC_REDIRECT_URL"
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 409 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /auth/oidc/{provider}/callback [get]
+func (h *OIDCHandler) Callback(c *gin.Context) {
+	provider := c.Param("provider")
+	signedState, _ := c.Cookie(OIDCStateCookieName)
+	h.setStateCookie(c, provider, "", -time.Second)
+
+	code := c.Query("code")
+	if providerErr := c.Query("error"); providerErr != "" || code == "" {
+		slog.Info("OpenID Connect sign-in not completed", "provider", provider, "error", providerErr)
+		httperr.AbortWithError(c, http.StatusUnauthorized, ErrOIDCAuthorizationDenied, "Sign-in was not completed at the provider", nil)
+		return
+	}
+
+	result, err := h.oidcCommands.Login(c.Request.Context(), provider, code, c.Query("state"), signedState)
+	if err != nil {
+		handleOIDCError(c, "oidc login", err)
+		return
+	}
+
+	if result.MFAChallenge != nil {
+		// A fragment is not sent to servers, so the token stays out of their logs.
+		fragment := url.Values{"mfaToken": {result.MFAChallenge.Token}}.Encode()
+		c.Redirect(http.StatusSeeOther, h.cfg.OIDC.RedirectURL+"#"+fragment)
+		return
+	}
+	cookie.SetTokenCookies(c, h.cfg.Cookie, result.TokenPair.AccessToken, result.TokenPair.RefreshToken,
+		h.jwtService.GetAccessTokenDuration(), h.jwtService.GetRefreshTokenDuration())
+
+	slog.Info("User logged in through OpenID Connect", "user_id", result.UserID, "provider", provider)
+	c.Redirect(http.StatusSeeOther, h.cfg.OIDC.RedirectURL)
+}
+
+// setStateCookie scopes the state to the provider's callback. The provider redirects the
+// browser there with a top-level GET, which SameSite=Lax cookies are sent on.
+func (h *OIDCHandler) setStateCookie(c *gin.Context, provider, state string, maxAge time.Duration) {
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:     OIDCStateCookieName,
+		Value:    state,
+		Path:     "/api/auth/oidc/" + url.PathEscape(provider) + "/callback",
+		Domain:   h.cfg.Cookie.Domain,
+		MaxAge:   int(maxAge.Seconds()),
+		Secure:   h.cfg.Cookie.Secure,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+var oidcErrorRules = []createReservationErrorRule{
+	{commands.ErrOIDCProviderNotFound, http.StatusNotFound, "Unknown sign-in provider", nil},
+	{commands.ErrOIDCStateInvalid, http.StatusBadRequest, "Sign-in expired or was started in another browser", nil},
+	{commands.ErrOIDCIdentityInvalid, http.StatusUnauthorized, "Identity provider response rejected", nil},
+	{commands.ErrOIDCEmailUnverified, http.StatusUnauthorized, "Identity provider reports no verified email", nil},
+	{commands.ErrOIDCEmailTaken, http.StatusConflict, "An account with this email exists; sign in with its password", nil},
+	{commands.ErrUserInactive, http.StatusForbidden, "Account is inactive", nil},
+	{commands.ErrDeviceKeyRequired, http.StatusBadRequest, "OpenID Connect sign-in is unavailable while device keys are required", nil},
+}
+
+func handleOIDCError(c *gin.Context, op string, err error) {
+	for _, rule := range oidcErrorRules {
+		if errors.Is(err, rule.err) {
+			slog.Warn("OpenID Connect error", "op", op, "error", err.Error(), "status", rule.status)
+			httperr.AbortWithError(c, rule.status, err, rule.message, rule.extra)
+			return
+		}
+	}
+
+	slog.Error("Unexpected error in OpenID Connect", "op", op, "error", err.Error())
+	httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+}
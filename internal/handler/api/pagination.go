@@ -0,0 +1,37 @@
+package api
+
+import (
+	"strconv"
+
+	"gin-clean-starter/internal/pkg/keyset"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/gin-gonic/gin"
+)
+
+// parseListParams reads the limit and after cursor of a keyset-paged list.
+func parseListParams(c *gin.Context) (int, *queries.Cursor) {
+	return parsePageParams(c, "after", "limit")
+}
+
+// parseMessagePageParams reads the thread paging parameters of the reservation detail.
+func parseMessagePageParams(c *gin.Context) (int, *queries.Cursor) {
+	return parsePageParams(c, "messages_after", "messages_limit")
+}
+
+// parsePageParams passes the raw cursor on; the query layer decodes it and clamps the
+// limit through package keyset, the single source of truth for both. A limit that is not
+// a number falls back to the default.
+func parsePageParams(c *gin.Context, afterParam, limitParam string) (int, *queries.Cursor) {
+	limit := keyset.DefaultLimit
+	if v := c.Query(limitParam); v != "" {
+		if iv, err := strconv.Atoi(v); err == nil {
+			limit = iv
+		}
+	}
+	var cursor *queries.Cursor
+	if after := c.Query(afterParam); after != "" {
+		cursor = &queries.Cursor{After: after}
+	}
+	return limit, cursor
+}
@@ -0,0 +1,397 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"math"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	scimdto "gin-clean-starter/internal/handler/dto/scim"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/handler/render"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/pkg/keyset"
+	"gin-clean-starter/internal/usecase/commands"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidProvisioningTokenID = errs.NewCoded("INVALID_ID_FORMAT", "invalid company or token ID format")
+	errSCIMInvalidFilter          = errs.NewCoded("SCIM_INVALID_FILTER", "unsupported SCIM filter")
+	errSCIMInvalidPatch           = errs.NewCoded("SCIM_INVALID_PATCH", "unsupported SCIM patch operation")
+)
+
+// scimFilter matches the one filter form identity providers use to look a user up:
+// `userName eq "a@example.com"` or `externalId eq "00u1"`.
+var scimFilter = regexp.MustCompile(`^\s*(\w+)\s+(?i:eq)\s+("(?:[^"\\]|\\.)*")\s*$`)
+
+type ProvisioningHandler struct {
+	provisioningCommands commands.ProvisioningCommands
+	provisioningQueries  queries.ProvisioningQueries
+}
+
+func NewProvisioningHandler(provisioningCommands commands.ProvisioningCommands, provisioningQueries queries.ProvisioningQueries) *ProvisioningHandler {
+	return &ProvisioningHandler{
+		provisioningCommands: provisioningCommands,
+		provisioningQueries:  provisioningQueries,
+	}
+}
+
+// @Summary Issue provisioning token
+// @Description Issue a bearer token for the company's identity provider to call the SCIM provisioning API with. The token is only shown in this response
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Company ID"
+// @Success 201 {object} response.ProvisioningTokenResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/companies/{id}/provisioning-tokens [post]
+func (h *ProvisioningHandler) IssueToken(c *gin.Context) {
+	companyID, ok := parseFeatureCompanyID(c)
+	if !ok {
+		return
+	}
+
+	actorID, _ := middleware.GetUserID(c)
+	issued, err := h.provisioningCommands.IssueToken(c.Request.Context(), companyID, actorID)
+	if err != nil {
+		handleProvisioningTokenError(c, "issue provisioning token", err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resdto.FromIssuedProvisioningToken(issued))
+}
+
+// @Summary Revoke provisioning token
+// @Description Revoke a provisioning token; the identity provider's next call with it is rejected
+// @Tags admin
+// @Security BearerAuth
+// @Param id path string true "Company ID"
+// @Param tokenId path string true "Token ID"
+// @Success 204
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/companies/{id}/provisioning-tokens/{tokenId} [delete]
+func (h *ProvisioningHandler) RevokeToken(c *gin.Context) {
+	companyID, cerr := uuid.Parse(c.Param("id"))
+	tokenID, terr := uuid.Parse(c.Param("tokenId"))
+	if cerr != nil || terr != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidProvisioningTokenID, "Invalid company or token ID format", nil)
+		return
+	}
+
+	actorID, _ := middleware.GetUserID(c)
+	if err := h.provisioningCommands.RevokeToken(c.Request.Context(), companyID, tokenID, actorID); err != nil {
+		handleProvisioningTokenError(c, "revoke provisioning token", err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+var provisioningTokenErrorRules = []createReservationErrorRule{
+	{commands.ErrCompanyNotFound, http.StatusNotFound, "Company not found", nil},
+	{commands.ErrProvisioningTokenNotFound, http.StatusNotFound, "Provisioning token not found", nil},
+}
+
+func handleProvisioningTokenError(c *gin.Context, op string, err error) {
+	for _, rule := range provisioningTokenErrorRules {
+		if errors.Is(err, rule.err) {
+			slog.Warn("Provisioning token error", "op", op, "error", err.Error(), "status", rule.status)
+			httperr.AbortWithError(c, rule.status, err, rule.message, rule.extra)
+			return
+		}
+	}
+
+	slog.Error("Unexpected error in provisioning token", "op", op, "error", err.Error())
+	httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+}
+
+// @Summary List users (SCIM)
+// @Description List the company's members, oldest first. Supports startIndex/count paging and a single userName or externalId "eq" filter
+// @Tags provisioning
+// @Produce application/scim+json
+// @Security BearerAuth
+// @Param filter query string false "SCIM filter"
+// @Param startIndex query int false "1-based index of the first result" default(1)
+// @Param count query int false "Page size (max 200)" default(20)
+// @Success 200 {object} scim.ListResponse
+// @Failure 400 {object} scim.ErrorResponse
+// @Failure 401 {object} scim.ErrorResponse
+// @Failure 500 {object} scim.ErrorResponse
+// @Router /scim/v2/Users [get]
+func (h *ProvisioningHandler) ListUsers(c *gin.Context) {
+	companyID, _ := middleware.GetProvisioningCompanyID(c)
+	filter, err := parseSCIMFilter(c.Query("filter"))
+	if err != nil {
+		render.SCIMError(c, http.StatusBadRequest, err, scimdto.ErrorTypeInvalidFilter, "Only userName eq and externalId eq filters are supported")
+		return
+	}
+	startIndex := max(scimIntQuery(c, "startIndex", 1), 1)
+	count := scimIntQuery(c, "count", keyset.DefaultLimit)
+
+	users, total, err := h.provisioningQueries.ListUsers(c.Request.Context(), companyID, filter, startIndex-1, count)
+	if err != nil {
+		handleSCIMError(c, "list users", err)
+		return
+	}
+
+	render.SCIM(c, http.StatusOK, scimdto.FromProvisionedUsers(users, total, startIndex))
+}
+
+// @Summary Get user (SCIM)
+// @Tags provisioning
+// @Produce application/scim+json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Success 200 {object} scim.User
+// @Failure 401 {object} scim.ErrorResponse
+// @Failure 404 {object} scim.ErrorResponse
+// @Failure 500 {object} scim.ErrorResponse
+// @Router /scim/v2/Users/{id} [get]
+func (h *ProvisioningHandler) GetUser(c *gin.Context) {
+	userID, ok := parseSCIMUserID(c)
+	if !ok {
+		return
+	}
+	h.respondWithUser(c, http.StatusOK, userID)
+}
+
+// @Summary Create user (SCIM)
+// @Description Provision a viewer in the token's company. userName is the sign-in email; without a password the user cannot sign in with one
+// @Tags provisioning
+// @Accept application/scim+json
+// @Produce application/scim+json
+// @Security BearerAuth
+// @Param request body scim.CreateUserRequest true "User"
+// @Success 201 {object} scim.User
+// @Failure 400 {object} scim.ErrorResponse
+// @Failure 401 {object} scim.ErrorResponse
+// @Failure 409 {object} scim.ErrorResponse
+// @Failure 500 {object} scim.ErrorResponse
+// @Router /scim/v2/Users [post]
+func (h *ProvisioningHandler) CreateUser(c *gin.Context) {
+	companyID, _ := middleware.GetProvisioningCompanyID(c)
+	var req scimdto.CreateUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		slog.Info("Invalid request format in SCIM create user", "error", err.Error())
+		render.SCIMError(c, http.StatusBadRequest, err, scimdto.ErrorTypeInvalidValue, "Invalid request format")
+		return
+	}
+
+	userID, err := h.provisioningCommands.CreateUser(c.Request.Context(), companyID, req)
+	if err != nil {
+		handleSCIMError(c, "create user", err)
+		return
+	}
+
+	h.respondWithUser(c, http.StatusCreated, userID)
+}
+
+// @Summary Update user (SCIM)
+// @Description Replace the user's active flag, by path ("active") or as a field of the value. Other attributes cannot be patched
+// @Tags provisioning
+// @Accept application/scim+json
+// @Produce application/scim+json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Param request body scim.PatchUserRequest true "Patch operations"
+// @Success 200 {object} scim.User
+// @Failure 400 {object} scim.ErrorResponse
+// @Failure 401 {object} scim.ErrorResponse
+// @Failure 404 {object} scim.ErrorResponse
+// @Failure 409 {object} scim.ErrorResponse
+// @Failure 500 {object} scim.ErrorResponse
+// @Router /scim/v2/Users/{id} [patch]
+func (h *ProvisioningHandler) PatchUser(c *gin.Context) {
+	userID, ok := parseSCIMUserID(c)
+	if !ok {
+		return
+	}
+	var req scimdto.PatchUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		slog.Info("Invalid request format in SCIM patch user", "error", err.Error())
+		render.SCIMError(c, http.StatusBadRequest, err, scimdto.ErrorTypeInvalidValue, "Invalid request format")
+		return
+	}
+	active, err := patchedActive(req.Operations)
+	if err != nil {
+		render.SCIMError(c, http.StatusBadRequest, err, scimdto.ErrorTypeInvalidPath, "Only replacing active is supported")
+		return
+	}
+
+	companyID, _ := middleware.GetProvisioningCompanyID(c)
+	if err := h.provisioningCommands.SetActive(c.Request.Context(), companyID, userID, active); err != nil {
+		handleSCIMError(c, "patch user", err)
+		return
+	}
+
+	h.respondWithUser(c, http.StatusOK, userID)
+}
+
+// @Summary Deactivate user (SCIM)
+// @Description Deactivate the user. Accounts are never deleted, so their reservations and reviews stay attributed
+// @Tags provisioning
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Success 204
+// @Failure 401 {object} scim.ErrorResponse
+// @Failure 404 {object} scim.ErrorResponse
+// @Failure 500 {object} scim.ErrorResponse
+// @Router /scim/v2/Users/{id} [delete]
+func (h *ProvisioningHandler) DeleteUser(c *gin.Context) {
+	userID, ok := parseSCIMUserID(c)
+	if !ok {
+		return
+	}
+
+	companyID, _ := middleware.GetProvisioningCompanyID(c)
+	if err := h.provisioningCommands.SetActive(c.Request.Context(), companyID, userID, false); err != nil {
+		handleSCIMError(c, "delete user", err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *ProvisioningHandler) respondWithUser(c *gin.Context, status int, userID uuid.UUID) {
+	companyID, _ := middleware.GetProvisioningCompanyID(c)
+	user, err := h.provisioningQueries.GetUser(c.Request.Context(), companyID, userID)
+	if err != nil {
+		handleSCIMError(c, "get user", err)
+		return
+	}
+
+	render.SCIM(c, status, scimdto.FromProvisionedUser(user))
+}
+
+// A malformed ID cannot name a user, so it is reported like an unknown one.
+func parseSCIMUserID(c *gin.Context) (uuid.UUID, bool) {
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		render.SCIMError(c, http.StatusNotFound, queries.ErrUserNotFound, "", "User not found")
+		return uuid.Nil, false
+	}
+	return userID, true
+}
+
+func parseSCIMFilter(raw string) (queries.ProvisionedUserFilter, error) {
+	var filter queries.ProvisionedUserFilter
+	if strings.TrimSpace(raw) == "" {
+		return filter, nil
+	}
+	m := scimFilter.FindStringSubmatch(raw)
+	if m == nil {
+		return filter, errSCIMInvalidFilter
+	}
+	value, err := strconv.Unquote(m[2])
+	if err != nil {
+		return filter, errs.Mark(err, errSCIMInvalidFilter)
+	}
+	// Attribute names are case-insensitive (RFC 7643 section 2.1).
+	switch strings.ToLower(m[1]) {
+	case "username":
+		filter.Email = &value
+	case "externalid":
+		filter.ExternalID = &value
+	default:
+		return filter, errSCIMInvalidFilter
+	}
+	return filter, nil
+}
+
+// scimIntQuery falls back to def when the parameter is missing or not a number.
+func scimIntQuery(c *gin.Context, name string, def int) int {
+	v, err := strconv.Atoi(c.Query(name))
+	if err != nil {
+		return def
+	}
+	return min(v, math.MaxInt32)
+}
+
+// patchedActive returns the active flag the operations leave the user with. Identity
+// providers send either {"path": "active", "value": false} or {"value": {"active": false}},
+// and some send the boolean as a string.
+func patchedActive(ops []scimdto.PatchOperation) (bool, error) {
+	var active *bool
+	for _, op := range ops {
+		if !strings.EqualFold(op.Op, "replace") {
+			return false, errSCIMInvalidPatch
+		}
+		value := op.Value
+		if op.Path == "" {
+			fields, ok := value.(map[string]any)
+			if !ok || len(fields) != 1 {
+				return false, errSCIMInvalidPatch
+			}
+			if value, ok = fields["active"]; !ok {
+				return false, errSCIMInvalidPatch
+			}
+		} else if !strings.EqualFold(op.Path, "active") {
+			return false, errSCIMInvalidPatch
+		}
+		b, ok := scimBool(value)
+		if !ok {
+			return false, errSCIMInvalidPatch
+		}
+		active = &b
+	}
+	if active == nil {
+		return false, errSCIMInvalidPatch
+	}
+	return *active, nil
+}
+
+func scimBool(v any) (bool, bool) {
+	switch x := v.(type) {
+	case bool:
+		return x, true
+	case string:
+		b, err := strconv.ParseBool(x)
+		return b, err == nil
+	}
+	return false, false
+}
+
+type scimErrorRule struct {
+	err      error
+	status   int
+	scimType string
+	detail   string
+}
+
+var scimErrorRules = []scimErrorRule{
+	{commands.ErrProvisioningInvalidEmail, http.StatusBadRequest, scimdto.ErrorTypeInvalidValue, "userName must be an email address"},
+	{commands.ErrProvisioningWeakPassword, http.StatusBadRequest, scimdto.ErrorTypeInvalidValue, "Password must be at least 8 characters long"},
+	{commands.ErrProvisionedUserExists, http.StatusConflict, scimdto.ErrorTypeUniqueness, "A user with this userName or externalId already exists"},
+	{commands.ErrUserNotFound, http.StatusNotFound, "", "User not found"},
+	{queries.ErrUserNotFound, http.StatusNotFound, "", "User not found"},
+}
+
+func handleSCIMError(c *gin.Context, op string, err error) {
+	for _, rule := range scimErrorRules {
+		if errors.Is(err, rule.err) {
+			slog.Warn("SCIM error", "op", op, "error", err.Error(), "status", rule.status)
+			render.SCIMError(c, rule.status, err, rule.scimType, rule.detail)
+			return
+		}
+	}
+
+	slog.Error("Unexpected error in SCIM", "op", op, "error", err.Error())
+	render.SCIMError(c, http.StatusInternalServerError, err, "", "Internal server error")
+}
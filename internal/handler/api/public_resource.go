@@ -0,0 +1,110 @@
+package api
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/pkg/config"
+	"gin-clean-starter/internal/pkg/sitemap"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PublicResourceHandler serves the data of the SEO-facing resource pages and their
+// sitemap. Pages are named by slug, so no internal id leaves through these routes.
+type PublicResourceHandler struct {
+	q       queries.PublicResourceQueries
+	siteURL string
+}
+
+func NewPublicResourceHandler(q queries.PublicResourceQueries, cfg config.Config) *PublicResourceHandler {
+	return &PublicResourceHandler{
+		q:       q,
+		siteURL: strings.TrimRight(cfg.PublicSite.SiteURL, "/"),
+	}
+}
+
+func (h *PublicResourceHandler) pageURL(slug string) string {
+	return h.siteURL + "/resources/" + slug
+}
+
+// @Summary Get public resource page
+// @Description Data for a resource's public page, looked up by slug: name, company, lead time and rating summary. Cacheable for PUBLIC_CACHE_MAX_AGE; send the ETag back in If-None-Match to get 304 Not Modified while the page is unchanged.
+// @Tags public
+// @Produce json
+// @Param slug path string true "Resource slug"
+// @Param If-None-Match header string false "ETag of a cached copy"
+// @Success 200 {object} response.PublicResourceResponse
+// @Success 304 "Not Modified"
+// @Header 200 {string} ETag "Changes whenever the page data does"
+// @Header 200 {string} Last-Modified "Latest change to the page data"
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /public/resources/{slug} [get]
+func (h *PublicResourceHandler) Get(c *gin.Context) {
+	page, err := h.q.GetBySlug(c.Request.Context(), c.Param("slug"))
+	if err != nil {
+		switch {
+		case errors.Is(err, queries.ErrPublicResourceNotFound):
+			slog.Info("Public resource not found", "slug", c.Param("slug"))
+			httperr.AbortWithError(c, http.StatusNotFound, err, "Resource not found", nil)
+		default:
+			slog.Error("Failed to get public resource", "slug", c.Param("slug"), "error", err.Error())
+			httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		}
+		return
+	}
+
+	etag := h.q.GenerateETag(page)
+	c.Header("ETag", etag)
+	c.Header("Last-Modified", page.LastModified.UTC().Format(http.TimeFormat))
+	if c.GetHeader("If-None-Match") == etag {
+		c.Status(http.StatusNotModified)
+		return
+	}
+	c.JSON(http.StatusOK, resdto.FromPublicResourcePage(page, h.pageURL(page.Slug)))
+}
+
+// @Summary Sitemap
+// @Description Sitemap of the public resource pages on PUBLIC_SITE_URL, by slug, each with the time its data last changed. Cacheable for PUBLIC_CACHE_MAX_AGE.
+// @Tags public
+// @Produce application/xml
+// @Success 200 {string} string "Sitemap 0.9 document"
+// @Header 200 {string} Last-Modified "Latest change among the listed pages"
+// @Failure 500 {object} httperr.Response
+// @Router /sitemap.xml [get]
+func (h *PublicResourceHandler) Sitemap(c *gin.Context) {
+	links, err := h.q.ListForSitemap(c.Request.Context())
+	if err != nil {
+		slog.Error("Failed to list public resources for sitemap", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		return
+	}
+
+	urls := make([]sitemap.URL, len(links))
+	var lastModified time.Time
+	for i, link := range links {
+		urls[i] = sitemap.URL{Loc: h.pageURL(link.Slug), LastMod: link.LastModified}
+		if link.LastModified.After(lastModified) {
+			lastModified = link.LastModified
+		}
+	}
+
+	var body bytes.Buffer
+	if err := sitemap.Write(&body, urls); err != nil {
+		slog.Error("Failed to encode sitemap", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		return
+	}
+	if !lastModified.IsZero() {
+		c.Header("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+	}
+	c.Data(http.StatusOK, sitemap.ContentType, body.Bytes())
+}
@@ -0,0 +1,44 @@
+package api
+
+import (
+	"log/slog"
+	"net/http"
+
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PublicStatsHandler serves the platform-wide totals marketing pages show. They come from
+// a table the platform_stats job refreshes, so anonymous traffic never aggregates live data.
+type PublicStatsHandler struct {
+	q queries.PlatformStatsQueries
+}
+
+func NewPublicStatsHandler(q queries.PlatformStatsQueries) *PublicStatsHandler {
+	return &PublicStatsHandler{q: q}
+}
+
+// @Summary Get platform stats
+// @Description Total resources, total reviews and the platform's average rating, as of the platform_stats job's last run every PUBLIC_STATS_INTERVAL. Cacheable for PUBLIC_CACHE_MAX_AGE.
+// @Tags public
+// @Produce json
+// @Success 200 {object} response.PlatformStatsResponse
+// @Header 200 {string} Last-Modified "When the stats were computed"
+// @Failure 500 {object} httperr.Response
+// @Router /public/stats [get]
+func (h *PublicStatsHandler) Get(c *gin.Context) {
+	stats, err := h.q.Get(c.Request.Context())
+	if err != nil {
+		slog.Error("Failed to get platform stats", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		return
+	}
+
+	if stats.ComputedAt != nil {
+		c.Header("Last-Modified", stats.ComputedAt.UTC().Format(http.TimeFormat))
+	}
+	c.JSON(http.StatusOK, resdto.FromPlatformStats(stats))
+}
@@ -0,0 +1,90 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	reqdto "gin-clean-starter/internal/handler/dto/request"
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/usecase/commands"
+
+	"github.com/gin-gonic/gin"
+)
+
+type QuoteHandler struct {
+	quoteCommands commands.QuoteCommands
+}
+
+func NewQuoteHandler(quoteCommands commands.QuoteCommands) *QuoteHandler {
+	return &QuoteHandler{
+		quoteCommands: quoteCommands,
+	}
+}
+
+// @Summary Create price quote
+// @Description Price a slot (base, discount, tax, total) and return a short-lived quote ID that reservation creation can reference to lock the price
+// @Tags quotes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body request.CreateQuoteRequest true "Quote request"
+// @Success 201 {object} response.QuoteResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 422 {object} httperr.Response
+// @Router /quotes [post]
+func (h *QuoteHandler) Create(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError,
+			ErrMissingUserContext,
+			"Internal server error", nil)
+		return
+	}
+
+	var req reqdto.CreateQuoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		slog.Warn("Invalid request format in create quote", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusBadRequest, err,
+			"Invalid request format", nil)
+		return
+	}
+
+	result, err := h.quoteCommands.CreateQuote(c.Request.Context(), req, userID)
+	if err != nil {
+		h.handleCreateQuoteError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resdto.FromQuoteResult(result))
+}
+
+var createQuoteErrorRules = []createReservationErrorRule{
+	{commands.ErrResourceNotFound, http.StatusNotFound, "Resource not found", nil},
+	{commands.ErrCouponNotFound, http.StatusNotFound, "Coupon not found", nil},
+	{commands.ErrInvalidTimeSlot, http.StatusBadRequest, "Invalid request parameters", nil},
+	{commands.ErrInsufficientLeadTime, http.StatusBadRequest, "Invalid request parameters", nil},
+	{commands.ErrInvalidCoupon, http.StatusBadRequest, "Invalid request parameters", nil},
+	{commands.ErrCouponNotStackable, http.StatusUnprocessableEntity, "Coupons cannot be combined", nil},
+	{commands.ErrInsufficientPoints, http.StatusUnprocessableEntity, "Insufficient loyalty points", nil},
+	{commands.ErrDuplicateCoupon, http.StatusBadRequest, "Coupon applied more than once", nil},
+	{commands.ErrDomainValidation, http.StatusBadRequest, "Invalid request parameters", nil},
+}
+
+func (h *QuoteHandler) handleCreateQuoteError(c *gin.Context, err error) {
+	for _, rule := range createQuoteErrorRules {
+		if errors.Is(err, rule.err) {
+			slog.Warn("Create quote error", "error", err.Error(), "status", rule.status)
+			httperr.AbortWithError(c, rule.status, err, rule.message, rule.extra)
+			return
+		}
+	}
+
+	slog.Error("Unexpected error in create quote", "error", err.Error())
+	httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+}
@@ -0,0 +1,56 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ReferralHandler struct {
+	referralQueries queries.ReferralQueries
+}
+
+func NewReferralHandler(referralQueries queries.ReferralQueries) *ReferralHandler {
+	return &ReferralHandler{
+		referralQueries: referralQueries,
+	}
+}
+
+// @Summary Get my referrals
+// @Description Get the caller's referral code, earned referral credit and the users they referred with their reward status
+// @Tags users
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.ReferralOverviewResponse
+// @Failure 401 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /users/me/referrals [get]
+func (h *ReferralHandler) GetMine(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError, ErrMissingUserContext, "Internal server error", nil)
+		return
+	}
+
+	overview, err := h.referralQueries.GetOverview(c.Request.Context(), userID)
+	if err != nil {
+		if errors.Is(err, queries.ErrUserNotFound) {
+			httperr.AbortWithError(c, http.StatusNotFound, err, "User not found", nil)
+			return
+		}
+		slog.Error("Failed to get referrals", "user_id", userID, "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, resdto.FromReferralOverview(overview))
+}
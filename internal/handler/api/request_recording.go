@@ -0,0 +1,195 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	reqdto "gin-clean-starter/internal/handler/dto/request"
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/commands"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+var ErrInvalidRequestRecordingPathID = errs.NewCoded("INVALID_ID_FORMAT", "invalid user, recording or request ID format")
+
+type RequestRecordingHandler struct {
+	recordingCommands commands.RequestRecordingCommands
+	recordingQueries  queries.RequestRecordingQueries
+}
+
+func NewRequestRecordingHandler(recordingCommands commands.RequestRecordingCommands, recordingQueries queries.RequestRecordingQueries) *RequestRecordingHandler {
+	return &RequestRecordingHandler{
+		recordingCommands: recordingCommands,
+		recordingQueries:  recordingQueries,
+	}
+}
+
+// @Summary Start request recording
+// @Description Record the user's next requests and responses, with credentials and secret fields masked, to replay a problem they reported. Only with the user's consent: the reason and consent reference are written to the audit log. The recording expires automatically (request_recordings:manage)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Param request body request.EnableRequestRecordingRequest true "Recording request"
+// @Success 201 {object} response.RequestRecordingResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/users/{id}/request-recordings [post]
+func (h *RequestRecordingHandler) Enable(c *gin.Context) {
+	actorID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError, ErrMissingUserContext, "Internal server error", nil)
+		return
+	}
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidRequestRecordingPathID, "Invalid user ID format", nil)
+		return
+	}
+
+	var req reqdto.EnableRequestRecordingRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		slog.Info("Invalid request format in enable request recording", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid request format", nil)
+		return
+	}
+
+	recording, err := h.recordingCommands.Enable(c.Request.Context(), userID, req, actorID)
+	if err != nil {
+		handleRequestRecordingError(c, "enable", err)
+		return
+	}
+
+	slog.Info("Request recording enabled", "actor_id", actorID, "user_id", userID, "recording_id", recording.ID)
+	c.JSON(http.StatusCreated, resdto.FromRequestRecording(recording))
+}
+
+// @Summary Get request recording
+// @Description A recording with the requests captured so far, oldest first (request_recordings:manage)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Recording ID"
+// @Success 200 {object} response.RequestRecordingDetailResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/request-recordings/{id} [get]
+func (h *RequestRecordingHandler) Get(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidRequestRecordingPathID, "Invalid recording ID format", nil)
+		return
+	}
+
+	detail, err := h.recordingQueries.Get(c.Request.Context(), id)
+	if err != nil {
+		handleRequestRecordingError(c, "get", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resdto.FromRequestRecordingDetail(detail))
+}
+
+// @Summary Get recorded request
+// @Description The sanitized request and response of one capture, as recorded: method, path, masked query, status, duration, headers and bodies. JSON bodies are kept with secret fields masked; other or oversized bodies are replaced by a note of their size and type (request_recordings:manage)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Recording ID"
+// @Param seq path int true "Capture number, from 1"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/request-recordings/{id}/requests/{seq} [get]
+func (h *RequestRecordingHandler) GetRequest(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidRequestRecordingPathID, "Invalid recording ID format", nil)
+		return
+	}
+	seq, err := strconv.ParseInt(c.Param("seq"), 10, 32)
+	if err != nil || seq < 1 {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidRequestRecordingPathID, "Invalid request number", nil)
+		return
+	}
+
+	_, content, err := h.recordingQueries.OpenCapture(c.Request.Context(), id, int32(seq))
+	if err != nil {
+		handleRequestRecordingError(c, "get request", err)
+		return
+	}
+	defer content.Close()
+
+	c.DataFromReader(http.StatusOK, -1, "application/json", content, map[string]string{
+		"X-Content-Type-Options": "nosniff",
+	})
+}
+
+// @Summary Delete request recording
+// @Description Stop a recording and delete everything it captured before it expires (request_recordings:manage)
+// @Tags admin
+// @Security BearerAuth
+// @Param id path string true "Recording ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/request-recordings/{id} [delete]
+func (h *RequestRecordingHandler) Delete(c *gin.Context) {
+	actorID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError, ErrMissingUserContext, "Internal server error", nil)
+		return
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidRequestRecordingPathID, "Invalid recording ID format", nil)
+		return
+	}
+
+	if err := h.recordingCommands.Delete(c.Request.Context(), id, actorID); err != nil {
+		handleRequestRecordingError(c, "delete", err)
+		return
+	}
+
+	slog.Info("Request recording deleted", "actor_id", actorID, "recording_id", id)
+	c.Status(http.StatusNoContent)
+}
+
+func handleRequestRecordingError(c *gin.Context, op string, err error) {
+	switch {
+	case errors.Is(err, commands.ErrRequestRecordingLimitInvalid):
+		httperr.AbortWithError(c, http.StatusBadRequest, err, "maxRequests exceeds the allowed maximum", nil)
+	case errors.Is(err, commands.ErrUserNotFound):
+		httperr.AbortWithError(c, http.StatusNotFound, err, "User not found", nil)
+	case errors.Is(err, commands.ErrRequestRecordingNotFound), errors.Is(err, queries.ErrRequestRecordingNotFound):
+		httperr.AbortWithError(c, http.StatusNotFound, err, "Request recording not found", nil)
+	case errors.Is(err, queries.ErrRecordedRequestNotFound):
+		httperr.AbortWithError(c, http.StatusNotFound, err, "Recorded request not found", nil)
+	default:
+		slog.Error("Unexpected error in request recording", "op", op, "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+	}
+}
@@ -2,17 +2,19 @@ package api
 
 import (
 	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
-	"strconv"
 
 	reqdto "gin-clean-starter/internal/handler/dto/request"
 	resdto "gin-clean-starter/internal/handler/dto/response"
 	"gin-clean-starter/internal/handler/httperr"
 	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/handler/render"
 	"gin-clean-starter/internal/pkg/errs"
 	"gin-clean-starter/internal/usecase/commands"
 	"gin-clean-starter/internal/usecase/queries"
+	"gin-clean-starter/internal/usecase/shared"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -20,37 +22,46 @@ import (
 
 var (
 	ErrMissingUserContext          = errs.New("user context missing")
-	ErrIdempotencyKeyRequired      = errs.New("idempotency key required")
-	ErrInvalidIdempotencyKeyFormat = errs.New("invalid idempotency key format")
-	ErrInvalidReservationIDFormat  = errs.New("invalid reservation ID format")
+	ErrIdempotencyKeyRequired      = errs.NewCoded("IDEMPOTENCY_KEY_REQUIRED", "idempotency key required")
+	ErrInvalidIdempotencyKeyFormat = errs.NewCoded("INVALID_IDEMPOTENCY_KEY", "invalid idempotency key format")
+	ErrInvalidReservationIDFormat  = errs.NewCoded("INVALID_ID_FORMAT", "invalid reservation ID format")
+	ErrTooManyFieldFilters         = errs.NewCoded("TOO_MANY_FIELD_FILTERS", "too many custom field filters")
 )
 
 type ReservationHandler struct {
 	reservationCommands commands.ReservationCommands
 	reservationQueries  queries.ReservationQueries
+	messageQueries      queries.ReservationMessageQueries
+	created             *render.CreatedResponder
 }
 
-func NewReservationHandler(reservationCommands commands.ReservationCommands, reservationQueries queries.ReservationQueries) *ReservationHandler {
+func NewReservationHandler(reservationCommands commands.ReservationCommands, reservationQueries queries.ReservationQueries, messageQueries queries.ReservationMessageQueries, created *render.CreatedResponder) *ReservationHandler {
 	return &ReservationHandler{
 		reservationCommands: reservationCommands,
 		reservationQueries:  reservationQueries,
+		messageQueries:      messageQueries,
+		created:             created,
 	}
 }
 
 // @Summary Create reservation
-// @Description Create a new reservation with idempotency key
+// @Description Create a new reservation with idempotency key. customFields must answer the custom fields of the resource's company (see GET /resources/{id}/custom-fields); a rejected value fails with INVALID_CUSTOM_FIELDS naming the field in detail.field. A live quoteId holds its price even if a coupon expired since; an expired one is requoted without the coupons no longer valid, to retry with the fresh quote's quoteId and couponCodes
 // @Tags reservations
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param Idempotency-Key header string true "Idempotency key for duplicate prevention"
+// @Param Prefer header string false "return=minimal replies with only the id; return=representation with the reservation"
 // @Param request body request.CreateReservationRequest true "Reservation request"
-// @Success 201 {object} response.ReservationResponse
-// @Failure 400 {object} map[string]string
-// @Failure 401 {object} map[string]string
-// @Failure 404 {object} map[string]string
-// @Failure 409 {object} map[string]string
-// @Failure 422 {object} map[string]string
+// @Success 200 {object} response.ReservationResponse "Replay of an earlier request with the same Idempotency-Key"
+// @Success 201 {object} response.ReservationResponse "Body is response.CreatedResponse under Prefer: return=minimal"
+// @Header 201 {string} Location "URL of the created reservation"
+// @Success 202 {object} httperr.Response "An earlier request with the same Idempotency-Key is still in progress"
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 409 {object} httperr.Response "QUOTE_EXPIRED carries detail.quote and detail.droppedCoupons (response.RequoteResponse)"
+// @Failure 422 {object} httperr.Response
 // @Router /reservations [post]
 func (h *ReservationHandler) CreateReservation(c *gin.Context) {
 	userID, ok := middleware.GetUserID(c)
@@ -84,34 +95,41 @@ func (h *ReservationHandler) CreateReservation(c *gin.Context) {
 		return
 	}
 
-	reservationView, err := h.reservationQueries.GetByID(c.Request.Context(), userID, result.ReservationID)
-	if err != nil {
-		httperr.AbortWithError(c, http.StatusInternalServerError, err,
-			"Failed to retrieve created reservation", map[string]string{"code": "RESERVATION_QUERY_FAILED"})
-		return
+	load := func() (any, error) {
+		view, err := h.reservationQueries.GetByID(c.Request.Context(), userID, result.ReservationID)
+		if err != nil {
+			return nil, err
+		}
+		thread, err := h.messageQueries.Thread(c.Request.Context(), view, shared.MessageSideUser, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		resp := resdto.FromReservationView(view)
+		resp.Messages = resdto.NewReservationMessagePage(thread)
+		return resp, nil
 	}
 
-	response := resdto.FromReservationView(reservationView)
-
-	c.Header("Location", "/reservations/"+result.ReservationID.String())
 	if result.IsReplayed {
 		c.Header("Idempotent-Replayed", "true")
-		c.JSON(http.StatusOK, response)
-	} else {
-		c.JSON(http.StatusCreated, response)
+		h.created.Respond(c, http.StatusOK, result.ReservationID, load)
+		return
 	}
+	h.created.Created(c, result.ReservationID, load)
 }
 
 // @Summary Get reservation
-// @Description Get reservation by ID
+// @Description Get reservation by ID with a page of its message thread, oldest first. The ETag covers only the first message page. fields keeps only the listed fields (dotted paths; lists apply them to each item).
 // @Tags reservations
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "Reservation ID"
+// @Param messages_after query string false "Message page cursor"
+// @Param messages_limit query int false "Message page size"
+// @Param fields query string false "Comma-separated response fields to keep, e.g. id,status,messages.messages.body"
 // @Success 200 {object} response.ReservationResponse
-// @Failure 400 {object} map[string]string
-// @Failure 401 {object} map[string]string
-// @Failure 404 {object} map[string]string
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
 // @Router /reservations/{id} [get]
 func (h *ReservationHandler) GetReservation(c *gin.Context) {
 	idStr := c.Param("id")
@@ -132,6 +150,11 @@ func (h *ReservationHandler) GetReservation(c *gin.Context) {
 		return
 	}
 
+	fields, ok := render.ParseFields(c, resdto.ReservationResponse{}, "")
+	if !ok {
+		return
+	}
+
 	reservationRM, err := h.reservationQueries.GetByID(c.Request.Context(), userID, id)
 	if err != nil {
 		switch {
@@ -147,16 +170,41 @@ func (h *ReservationHandler) GetReservation(c *gin.Context) {
 		return
 	}
 
-	etag := h.reservationQueries.GenerateETag(reservationRM)
-	if match := c.GetHeader("If-None-Match"); match == etag {
+	limit, after := parseMessagePageParams(c)
+	// Message writes bump updated_at, so the ETag tracks the first message page too.
+	if after == nil {
+		etag := fields.ETag(h.reservationQueries.GenerateETag(reservationRM))
+		if match := c.GetHeader("If-None-Match"); match == etag {
+			c.Header("ETag", etag)
+			c.Status(http.StatusNotModified)
+			return
+		}
 		c.Header("ETag", etag)
-		c.Status(http.StatusNotModified)
+	}
+
+	response, ok := h.withMessages(c, reservationRM, shared.MessageSideUser, after, limit)
+	if !ok {
 		return
 	}
+	render.JSONFields(c, http.StatusOK, response, fields)
+}
 
-	c.Header("ETag", etag)
-	response := resdto.FromReservationView(reservationRM)
-	c.JSON(http.StatusOK, response)
+// withMessages renders the reservation with one page of its thread as seen by side.
+func (h *ReservationHandler) withMessages(c *gin.Context, view *queries.ReservationView, side string, after *queries.Cursor, limit int) (*resdto.ReservationResponse, bool) {
+	thread, err := h.messageQueries.Thread(c.Request.Context(), view, side, after, limit)
+	if err != nil {
+		if errors.Is(err, queries.ErrInvalidCursor) {
+			httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid cursor", nil)
+			return nil, false
+		}
+		slog.Error("Failed to load reservation messages", "reservation_id", view.ID, "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		return nil, false
+	}
+
+	response := resdto.FromReservationView(view)
+	response.Messages = resdto.NewReservationMessagePage(thread)
+	return response, true
 }
 
 // @Summary Get user reservations
@@ -164,8 +212,8 @@ func (h *ReservationHandler) GetReservation(c *gin.Context) {
 // @Tags reservations
 // @Produce json
 // @Security BearerAuth
-// @Success 200 {array} response.ReservationListResponse
-// @Failure 401 {object} map[string]string
+// @Success 200 {object} response.ReservationListPageResponse
+// @Failure 401 {object} httperr.Response
 // @Router /reservations [get]
 func (h *ReservationHandler) GetUserReservations(c *gin.Context) {
 	userID, ok := middleware.GetUserID(c)
@@ -177,42 +225,262 @@ func (h *ReservationHandler) GetUserReservations(c *gin.Context) {
 		return
 	}
 
-	afterStr := c.Query("after")
-	limitStr := c.Query("limit")
+	limit, after := parseListParams(c)
+	reservationsRM, nextCursor, err := h.reservationQueries.ListByUser(c.Request.Context(), userID, after, limit)
+	if err != nil {
+		slog.Error("Unexpected error in get user reservations", "user_id", userID, "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err,
+			"Internal server error", nil)
+		return
+	}
+
+	render.JSON(c, http.StatusOK, resdto.NewReservationListPage(reservationsRM, nextCursor))
+}
 
-	var after *queries.Cursor
-	if afterStr != "" {
-		after = &queries.Cursor{After: afterStr}
+// @Summary Cancel reservation
+// @Description Cancel a confirmed reservation that has not ended. Owners may cancel their own; operators need a cancel permission covering the resource.
+// @Tags reservations
+// @Security BearerAuth
+// @Param id path string true "Reservation ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 409 {object} httperr.Response
+// @Router /reservations/{id}/cancel [post]
+func (h *ReservationHandler) CancelReservation(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		slog.Warn("Invalid reservation ID format", "id", idStr, "error", err.Error())
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidReservationIDFormat,
+			"Invalid reservation ID format", nil)
+		return
 	}
 
-	limit := 20
-	if limitStr != "" {
-		if parsedLimit, err := strconv.Atoi(limitStr); err == nil {
-			limit = queries.ValidateLimit(parsedLimit)
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError,
+			ErrMissingUserContext,
+			"Internal server error", nil)
+		return
+	}
+	role, _ := middleware.GetUserRole(c)
+
+	if err := h.reservationCommands.Cancel(c.Request.Context(), id, userID, string(role)); err != nil {
+		for _, rule := range cancelReservationErrorRules {
+			if errors.Is(err, rule.err) {
+				slog.Warn("Cancel reservation error", "reservation_id", id, "error", err.Error(), "status", rule.status)
+				httperr.AbortWithError(c, rule.status, err, rule.message, rule.extra)
+				return
+			}
 		}
+		slog.Error("Unexpected error in cancel reservation", "reservation_id", id, "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		return
 	}
 
-	reservationsRM, nextCursor, err := h.reservationQueries.ListByUser(c.Request.Context(), userID, after, limit)
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary List reservations (admin)
+// @Description List reservations across users. Callers with reservations:read:any see every reservation; reservations:read:assigned limits results to resources the caller operates. Each field[key]=value parameter keeps only reservations whose custom field key holds that value.
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param resource_id query string false "Filter by resource ID"
+// @Param field[key] query string false "Filter by a custom field value; repeat for several fields"
+// @Param after query string false "Pagination cursor"
+// @Param limit query int false "Page size"
+// @Success 200 {object} response.AdminReservationListPageResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Router /admin/reservations [get]
+func (h *ReservationHandler) ListAdminReservations(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError,
+			ErrMissingUserContext,
+			"Internal server error", nil)
+		return
+	}
+	role, _ := middleware.GetUserRole(c)
+
+	resourceID, fields, ok := parseAdminReservationFilter(c)
+	if !ok {
+		return
+	}
+
+	limit, after := parseListParams(c)
+	items, nextCursor, err := h.reservationQueries.ListForAdmin(c.Request.Context(), userID, string(role), supportCompanyID(c), resourceID, fields, after, limit)
 	if err != nil {
-		slog.Error("Unexpected error in get user reservations", "user_id", userID, "error", err.Error())
-		httperr.AbortWithError(c, http.StatusInternalServerError, err,
+		switch {
+		case errors.Is(err, queries.ErrReservationForbidden):
+			httperr.AbortWithError(c, http.StatusForbidden, err, "Insufficient permissions", nil)
+		case errors.Is(err, queries.ErrInvalidCursor):
+			httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid cursor", nil)
+		default:
+			slog.Error("Unexpected error in list admin reservations", "user_id", userID, "error", err.Error())
+			httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		}
+		return
+	}
+
+	render.JSON(c, http.StatusOK, resdto.NewAdminReservationListPage(items, nextCursor))
+}
+
+// @Summary Export reservations (admin)
+// @Description Download every reservation the admin listing would return for the same filters, newest first. The body is streamed: CSV with a header line, or newline-delimited JSON with one response.AdminReservationExportRow per line. The CSV custom_fields column holds the values as a JSON object.
+// @Tags admin
+// @Produce text/csv
+// @Produce application/x-ndjson
+// @Security BearerAuth
+// @Param format query string false "csv or json (default json)" Enums(csv, json)
+// @Param resource_id query string false "Filter by resource ID"
+// @Param field[key] query string false "Filter by a custom field value; repeat for several fields"
+// @Success 200 {object} response.AdminReservationExportRow "One row per reservation"
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/reservations/export [get]
+func (h *ReservationHandler) ExportAdminReservations(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError,
+			ErrMissingUserContext,
 			"Internal server error", nil)
 		return
 	}
+	role, _ := middleware.GetUserRole(c)
 
-	response := make([]*resdto.ReservationListResponse, len(reservationsRM))
-	for i, rm := range reservationsRM {
-		response[i] = resdto.FromReservationListItem(rm)
+	format, ok := render.ParseStreamFormat(c.DefaultQuery("format", string(render.StreamJSON)))
+	if !ok {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidExportFormat, "Invalid format", nil)
+		return
+	}
+	resourceID, fields, ok := parseAdminReservationFilter(c)
+	if !ok {
+		return
 	}
 
-	result := map[string]any{
-		"reservations": response,
+	stream := render.NewStream(c, format, "reservations", resdto.AdminReservationExportCSVHeader)
+	err := h.reservationQueries.ExportForAdmin(c.Request.Context(), userID, string(role), supportCompanyID(c), resourceID, fields, func(item *queries.AdminReservationListItem) error {
+		return stream.Write(resdto.FromAdminReservationExportItem(item))
+	})
+	if err == nil {
+		err = stream.Close()
 	}
-	if nextCursor != nil {
-		result["next_cursor"] = nextCursor.After
+	if err != nil {
+		if !stream.Started() {
+			if errors.Is(err, queries.ErrReservationForbidden) {
+				httperr.AbortWithError(c, http.StatusForbidden, err, "Insufficient permissions", nil)
+				return
+			}
+			slog.Error("Export admin reservations failed", "user_id", userID, "error", err.Error())
+			httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+			return
+		}
+		// The status is already sent; the client sees a truncated download.
+		slog.Error("Export admin reservations aborted mid-stream", "user_id", userID, "error", err.Error())
+		c.Abort()
+	}
+}
+
+// maxCustomFieldFilters bounds the field[key]=value parameters of the admin listing.
+const maxCustomFieldFilters = 10
+
+// parseAdminReservationFilter reads the resource_id and field[key]=value filters shared
+// by the admin listing and export.
+func parseAdminReservationFilter(c *gin.Context) (*uuid.UUID, map[string]string, bool) {
+	var resourceID *uuid.UUID
+	if resourceIDStr := c.Query("resource_id"); resourceIDStr != "" {
+		parsed, err := uuid.Parse(resourceIDStr)
+		if err != nil {
+			httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid resource ID format", nil)
+			return nil, nil, false
+		}
+		resourceID = &parsed
 	}
 
-	c.JSON(http.StatusOK, result)
+	fields := c.QueryMap("field")
+	if len(fields) > maxCustomFieldFilters {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrTooManyFieldFilters,
+			fmt.Sprintf("At most %d custom field filters are allowed", maxCustomFieldFilters), nil)
+		return nil, nil, false
+	}
+	return resourceID, fields, true
+}
+
+// @Summary Get reservation (admin)
+// @Description Get any reservation the caller may read through reservations:read:any or an operator assignment, with a page of its message thread
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Reservation ID"
+// @Param messages_after query string false "Message page cursor"
+// @Param messages_limit query int false "Message page size"
+// @Param fields query string false "Comma-separated response fields to keep, e.g. id,status,messages.messages.body"
+// @Success 200 {object} response.ReservationResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Router /admin/reservations/{id} [get]
+func (h *ReservationHandler) GetAdminReservation(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		slog.Warn("Invalid reservation ID format", "id", idStr, "error", err.Error())
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidReservationIDFormat,
+			"Invalid reservation ID format", nil)
+		return
+	}
+
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError,
+			ErrMissingUserContext,
+			"Internal server error", nil)
+		return
+	}
+	role, _ := middleware.GetUserRole(c)
+
+	fields, ok := render.ParseFields(c, resdto.ReservationResponse{}, "")
+	if !ok {
+		return
+	}
+
+	reservationRM, err := h.reservationQueries.GetByIDWithRole(c.Request.Context(), userID, string(role), supportCompanyID(c), id)
+	if err != nil {
+		if errors.Is(err, queries.ErrReservationNotFound) {
+			httperr.AbortWithError(c, http.StatusNotFound, err, "Reservation not found", nil)
+			return
+		}
+		slog.Error("Unexpected error in get admin reservation", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		return
+	}
+
+	limit, after := parseMessagePageParams(c)
+	response, ok := h.withMessages(c, reservationRM, shared.MessageSideOperator, after, limit)
+	if !ok {
+		return
+	}
+	render.JSONFields(c, http.StatusOK, response, fields)
+}
+
+// supportCompanyID is the company a support session is pinned to, or nil for a normal session.
+func supportCompanyID(c *gin.Context) *uuid.UUID {
+	if scope, ok := middleware.GetSupportScope(c); ok {
+		return &scope.CompanyID
+	}
+	return nil
 }
 
 type createReservationErrorRule struct {
@@ -228,13 +496,42 @@ var createReservationErrorRules = []createReservationErrorRule{
 	{commands.ErrInvalidTimeSlot, http.StatusBadRequest, "Invalid request parameters", nil},
 	{commands.ErrInsufficientLeadTime, http.StatusBadRequest, "Invalid request parameters", nil},
 	{commands.ErrInvalidCoupon, http.StatusBadRequest, "Invalid request parameters", nil},
+	{commands.ErrCouponNotStackable, http.StatusUnprocessableEntity, "Coupons cannot be combined", nil},
+	{commands.ErrInsufficientPoints, http.StatusUnprocessableEntity, "Insufficient loyalty points", nil},
+	{commands.ErrDuplicateCoupon, http.StatusBadRequest, "Coupon applied more than once", nil},
 	{commands.ErrDomainValidation, http.StatusBadRequest, "Invalid request parameters", nil},
+	{commands.ErrInvalidQuote, http.StatusBadRequest, "Quote does not match the reservation request", nil},
+	{commands.ErrQuoteExpired, http.StatusConflict, "Quote expired", nil},
 	{commands.ErrDuplicateReservation, http.StatusConflict, "Reservation conflict", nil},
 	{commands.ErrReservationConflict, http.StatusConflict, "Reservation conflict", nil},
+	{commands.ErrResourceBlocked, http.StatusConflict, "Time slot is blocked", nil},
 	{commands.ErrIdempotencyInProgress, http.StatusAccepted, "Reservation request is currently being processed", nil},
 }
 
+var cancelReservationErrorRules = []createReservationErrorRule{
+	{commands.ErrReservationNotFoundWrite, http.StatusNotFound, "Reservation not found", nil},
+	{commands.ErrReservationNotOwned, http.StatusForbidden, "Forbidden", nil},
+	{commands.ErrReservationNotCancelable, http.StatusConflict, "Reservation cannot be canceled", nil},
+}
+
 func (h *ReservationHandler) handleCreateReservationError(c *gin.Context, err error, idempotencyKey uuid.UUID) {
+	var fieldsErr *commands.CustomFieldsError
+	if errors.As(err, &fieldsErr) {
+		slog.Warn("Create reservation custom field rejected", "field", fieldsErr.Field, "reason", fieldsErr.Reason)
+		detail := map[string]any{"field": fieldsErr.Field, "reason": fieldsErr.Reason}
+		if fieldsErr.Item != nil {
+			detail["item"] = *fieldsErr.Item
+		}
+		httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid custom fields", detail)
+		return
+	}
+	var expired *commands.QuoteExpiredError
+	if errors.As(err, &expired) {
+		slog.Warn("Create reservation quote expired", "total_cents", expired.Quote.TotalCents, "dropped_coupons", len(expired.DroppedCoupons))
+		httperr.AbortWithError(c, http.StatusConflict, err, "Quote expired", resdto.FromQuoteExpiredError(expired))
+		return
+	}
+
 	for _, rule := range createReservationErrorRules {
 		if errors.Is(err, rule.err) {
 			if errors.Is(err, commands.ErrIdempotencyInProgress) {
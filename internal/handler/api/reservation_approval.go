@@ -0,0 +1,245 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	reqdto "gin-clean-starter/internal/handler/dto/request"
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/usecase/commands"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type ReservationApprovalHandler struct {
+	approvalCommands commands.ReservationApprovalCommands
+	approvalQueries  queries.ReservationApprovalQueries
+}
+
+func NewReservationApprovalHandler(approvalCommands commands.ReservationApprovalCommands, approvalQueries queries.ReservationApprovalQueries) *ReservationApprovalHandler {
+	return &ReservationApprovalHandler{
+		approvalCommands: approvalCommands,
+		approvalQueries:  approvalQueries,
+	}
+}
+
+// @Summary List pending approvals
+// @Description The caller's approval queue: reservations pending approval on resources they approve, or on every resource with reservations:approve:any. Soonest to expire first, at most 100
+// @Tags reservations
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} response.PendingApprovalResponse
+// @Failure 401 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /reservation-approvals [get]
+func (h *ReservationApprovalHandler) ListPending(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError, ErrMissingUserContext, "Internal server error", nil)
+		return
+	}
+	role, _ := middleware.GetUserRole(c)
+
+	pending, err := h.approvalQueries.ListPending(c.Request.Context(), userID, string(role))
+	if err != nil {
+		handleReservationApprovalError(c, "list pending", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resdto.FromPendingApprovalViews(pending))
+}
+
+// @Summary Approve reservation
+// @Description Confirm a reservation pending approval. Designated approvers of its resource and holders of reservations:approve:any may decide, except on their own reservations. The user is notified and the decision is audited as reservation.approved
+// @Tags reservations
+// @Security BearerAuth
+// @Param id path string true "Reservation ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 409 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /reservations/{id}/approve [post]
+func (h *ReservationApprovalHandler) Approve(c *gin.Context) {
+	reservationID, userID, ok := parseApprovalPath(c)
+	if !ok {
+		return
+	}
+	role, _ := middleware.GetUserRole(c)
+
+	if err := h.approvalCommands.Approve(c.Request.Context(), reservationID, userID, string(role)); err != nil {
+		handleReservationApprovalError(c, "approve", err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary Reject reservation
+// @Description Cancel a reservation pending approval and pass the reason on to its user. Who may decide is the same as for approval; the decision is audited as reservation.rejected
+// @Tags reservations
+// @Accept json
+// @Security BearerAuth
+// @Param id path string true "Reservation ID"
+// @Param request body request.RejectReservationRequest false "Reason"
+// @Success 204 "No Content"
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 409 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /reservations/{id}/reject [post]
+func (h *ReservationApprovalHandler) Reject(c *gin.Context) {
+	reservationID, userID, ok := parseApprovalPath(c)
+	if !ok {
+		return
+	}
+	role, _ := middleware.GetUserRole(c)
+
+	var req reqdto.RejectReservationRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			slog.Info("Invalid request format in reject reservation", "error", err.Error())
+			httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid request format", nil)
+			return
+		}
+	}
+
+	if err := h.approvalCommands.Reject(c.Request.Context(), reservationID, req.Reason, userID, string(role)); err != nil {
+		handleReservationApprovalError(c, "reject", err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary List resource approvers
+// @Description List the users who approve reservations on a resource. A resource with approvers holds new reservations in pending_approval
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Resource ID"
+// @Success 200 {array} response.ResourceApproverResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/resources/{id}/approvers [get]
+func (h *ReservationApprovalHandler) ListApprovers(c *gin.Context) {
+	resourceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidOperatorPathID, "Invalid resource ID format", nil)
+		return
+	}
+
+	approvers, err := h.approvalQueries.ListApprovers(c.Request.Context(), resourceID)
+	if err != nil {
+		handleReservationApprovalError(c, "list approvers", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resdto.FromResourceApproverViews(approvers))
+}
+
+// @Summary Add resource approver
+// @Description Designate a user to approve reservations on a resource. From then on, new reservations on it wait in pending_approval for a decision
+// @Tags admin
+// @Security BearerAuth
+// @Param id path string true "Resource ID"
+// @Param userId path string true "User ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/resources/{id}/approvers/{userId} [put]
+func (h *ReservationApprovalHandler) AddApprover(c *gin.Context) {
+	resourceID, userID, ok := parseOperatorPath(c)
+	if !ok {
+		return
+	}
+
+	if err := h.approvalCommands.AddApprover(c.Request.Context(), resourceID, userID); err != nil {
+		handleReservationApprovalError(c, "add approver", err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary Remove resource approver
+// @Description Remove a user's approver designation from a resource. Once the last approver is removed, new reservations are confirmed right away; pending ones stay pending
+// @Tags admin
+// @Security BearerAuth
+// @Param id path string true "Resource ID"
+// @Param userId path string true "User ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/resources/{id}/approvers/{userId} [delete]
+func (h *ReservationApprovalHandler) RemoveApprover(c *gin.Context) {
+	resourceID, userID, ok := parseOperatorPath(c)
+	if !ok {
+		return
+	}
+
+	if err := h.approvalCommands.RemoveApprover(c.Request.Context(), resourceID, userID); err != nil {
+		handleReservationApprovalError(c, "remove approver", err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func parseApprovalPath(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	reservationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidReservationIDFormat, "Invalid reservation ID format", nil)
+		return uuid.Nil, uuid.Nil, false
+	}
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError, ErrMissingUserContext, "Internal server error", nil)
+		return uuid.Nil, uuid.Nil, false
+	}
+	return reservationID, userID, true
+}
+
+var reservationApprovalErrorRules = []createReservationErrorRule{
+	{commands.ErrReservationNotFoundWrite, http.StatusNotFound, "Reservation not found", nil},
+	{commands.ErrApprovalOwnReservation, http.StatusForbidden, "Cannot decide on your own reservation", nil},
+	{commands.ErrNotReservationApprover, http.StatusForbidden, "Not an approver of this resource", nil},
+	{commands.ErrReservationNotPendingApproval, http.StatusConflict, "Reservation is not pending approval", nil},
+	{commands.ErrApprovalExpired, http.StatusConflict, "Approval request expired", nil},
+	{commands.ErrApproverTargetNotFound, http.StatusNotFound, "Resource or user not found", nil},
+	{commands.ErrApproverNotFound, http.StatusNotFound, "Approver not found", nil},
+	{queries.ErrApproverResourceNotFound, http.StatusNotFound, "Resource not found", nil},
+}
+
+func handleReservationApprovalError(c *gin.Context, op string, err error) {
+	for _, rule := range reservationApprovalErrorRules {
+		if errors.Is(err, rule.err) {
+			slog.Warn("Reservation approval error", "op", op, "error", err.Error(), "status", rule.status)
+			httperr.AbortWithError(c, rule.status, err, rule.message, rule.extra)
+			return
+		}
+	}
+
+	slog.Error("Unexpected error in reservation approval", "op", op, "error", err.Error())
+	httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+}
@@ -0,0 +1,352 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strconv"
+
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/commands"
+	"gin-clean-starter/internal/usecase/queries"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidAttachmentPathID = errs.NewCoded("INVALID_ID_FORMAT", "invalid reservation or attachment ID format")
+	ErrInvalidAttachmentForm   = errs.NewCoded("INVALID_ATTACHMENT", "invalid attachment upload form")
+)
+
+type ReservationAttachmentHandler struct {
+	attachmentCommands commands.ReservationAttachmentCommands
+	attachmentQueries  queries.ReservationAttachmentQueries
+}
+
+func NewReservationAttachmentHandler(attachmentCommands commands.ReservationAttachmentCommands, attachmentQueries queries.ReservationAttachmentQueries) *ReservationAttachmentHandler {
+	return &ReservationAttachmentHandler{
+		attachmentCommands: attachmentCommands,
+		attachmentQueries:  attachmentQueries,
+	}
+}
+
+// @Summary Attach file to reservation
+// @Description Upload a file (e.g. a signed form) to a reservation the caller owns. The type is sniffed from the content and checked against ATTACHMENT_ALLOWED_TYPES; files over ATTACHMENT_MAX_BYTES are rejected, and every file passes the configured scanner.
+// @Tags reservations
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Reservation ID"
+// @Param file formData file true "File"
+// @Success 201 {object} response.ReservationAttachmentResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 413 {object} httperr.Response
+// @Failure 415 {object} httperr.Response
+// @Failure 422 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /reservations/{id}/attachments [post]
+func (h *ReservationAttachmentHandler) Upload(c *gin.Context) {
+	h.upload(c, shared.MessageSideUser)
+}
+
+// @Summary List reservation attachments
+// @Description List the files on a reservation the caller owns, oldest first; operator-only files are not shown
+// @Tags reservations
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Reservation ID"
+// @Success 200 {array} response.ReservationAttachmentResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /reservations/{id}/attachments [get]
+func (h *ReservationAttachmentHandler) List(c *gin.Context) {
+	h.list(c, shared.MessageSideUser)
+}
+
+// @Summary Download reservation attachment
+// @Description Download a file on a reservation the caller owns
+// @Tags reservations
+// @Produce application/octet-stream
+// @Security BearerAuth
+// @Param id path string true "Reservation ID"
+// @Param attachmentId path string true "Attachment ID"
+// @Success 200 {file} file
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /reservations/{id}/attachments/{attachmentId} [get]
+func (h *ReservationAttachmentHandler) Download(c *gin.Context) {
+	h.download(c, shared.MessageSideUser)
+}
+
+// @Summary Delete reservation attachment
+// @Description Delete a file the caller uploaded to a reservation they own
+// @Tags reservations
+// @Security BearerAuth
+// @Param id path string true "Reservation ID"
+// @Param attachmentId path string true "Attachment ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /reservations/{id}/attachments/{attachmentId} [delete]
+func (h *ReservationAttachmentHandler) Delete(c *gin.Context) {
+	h.delete(c, shared.MessageSideUser)
+}
+
+// @Summary Attach file to reservation (operator)
+// @Description Upload a file (e.g. a layout plan) to a reservation as the operator side; operator_only hides it from the reservation's user. Requires reservation_attachments:write:any, or :assigned on resources the caller operates.
+// @Tags admin
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Reservation ID"
+// @Param file formData file true "File"
+// @Param operator_only formData bool false "Hide the file from the reservation's user"
+// @Success 201 {object} response.ReservationAttachmentResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 413 {object} httperr.Response
+// @Failure 415 {object} httperr.Response
+// @Failure 422 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/reservations/{id}/attachments [post]
+func (h *ReservationAttachmentHandler) AdminUpload(c *gin.Context) {
+	h.upload(c, shared.MessageSideOperator)
+}
+
+// @Summary List reservation attachments (operator)
+// @Description List every file on a reservation, including operator-only ones. Requires reservations:read:any, or :assigned on resources the caller operates.
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Reservation ID"
+// @Success 200 {array} response.ReservationAttachmentResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/reservations/{id}/attachments [get]
+func (h *ReservationAttachmentHandler) AdminList(c *gin.Context) {
+	h.list(c, shared.MessageSideOperator)
+}
+
+// @Summary Download reservation attachment (operator)
+// @Description Download any file on a reservation. Requires reservations:read:any, or :assigned on resources the caller operates.
+// @Tags admin
+// @Produce application/octet-stream
+// @Security BearerAuth
+// @Param id path string true "Reservation ID"
+// @Param attachmentId path string true "Attachment ID"
+// @Success 200 {file} file
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/reservations/{id}/attachments/{attachmentId} [get]
+func (h *ReservationAttachmentHandler) AdminDownload(c *gin.Context) {
+	h.download(c, shared.MessageSideOperator)
+}
+
+// @Summary Delete reservation attachment (operator)
+// @Description Delete any file on a reservation. Requires reservation_attachments:write:any, or :assigned on resources the caller operates.
+// @Tags admin
+// @Security BearerAuth
+// @Param id path string true "Reservation ID"
+// @Param attachmentId path string true "Attachment ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/reservations/{id}/attachments/{attachmentId} [delete]
+func (h *ReservationAttachmentHandler) AdminDelete(c *gin.Context) {
+	h.delete(c, shared.MessageSideOperator)
+}
+
+func (h *ReservationAttachmentHandler) upload(c *gin.Context, side string) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError, ErrMissingUserContext, "Internal server error", nil)
+		return
+	}
+	role, _ := middleware.GetUserRole(c)
+
+	reservationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidAttachmentPathID, "Invalid reservation ID format", nil)
+		return
+	}
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		slog.Info("Missing file in attachment upload", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidAttachmentForm, "Invalid request format", nil)
+		return
+	}
+	operatorOnly := false
+	if v := c.PostForm("operator_only"); v != "" {
+		if operatorOnly, err = strconv.ParseBool(v); err != nil {
+			httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidAttachmentForm, "Invalid request format", nil)
+			return
+		}
+	}
+	file, err := header.Open()
+	if err != nil {
+		slog.Error("Failed to open uploaded file", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		return
+	}
+	defer file.Close()
+
+	attachment, err := h.attachmentCommands.Upload(c.Request.Context(), commands.UploadAttachmentInput{
+		ReservationID: reservationID,
+		ActorID:       userID,
+		ActorRole:     string(role),
+		Side:          side,
+		Filename:      header.Filename,
+		Content:       file,
+		OperatorOnly:  operatorOnly,
+	})
+	if err != nil {
+		handleReservationAttachmentError(c, "upload", err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resdto.FromReservationAttachment(attachment))
+}
+
+func (h *ReservationAttachmentHandler) list(c *gin.Context, side string) {
+	reservationID, access, ok := attachmentReadRequest(c, side)
+	if !ok {
+		return
+	}
+
+	attachments, err := h.attachmentQueries.List(c.Request.Context(), reservationID, access)
+	if err != nil {
+		handleReservationAttachmentError(c, "list", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resdto.FromReservationAttachments(attachments))
+}
+
+func (h *ReservationAttachmentHandler) download(c *gin.Context, side string) {
+	reservationID, access, ok := attachmentReadRequest(c, side)
+	if !ok {
+		return
+	}
+	attachmentID, err := uuid.Parse(c.Param("attachmentId"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidAttachmentPathID, "Invalid attachment ID format", nil)
+		return
+	}
+
+	attachment, content, err := h.attachmentQueries.Open(c.Request.Context(), reservationID, attachmentID, access)
+	if err != nil {
+		handleReservationAttachmentError(c, "download", err)
+		return
+	}
+	defer content.Close()
+
+	c.DataFromReader(http.StatusOK, attachment.SizeBytes, attachment.ContentType, content, map[string]string{
+		"Content-Disposition":    mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Filename}),
+		"X-Content-Type-Options": "nosniff",
+	})
+}
+
+func (h *ReservationAttachmentHandler) delete(c *gin.Context, side string) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError, ErrMissingUserContext, "Internal server error", nil)
+		return
+	}
+	role, _ := middleware.GetUserRole(c)
+
+	reservationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidAttachmentPathID, "Invalid reservation ID format", nil)
+		return
+	}
+	attachmentID, err := uuid.Parse(c.Param("attachmentId"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidAttachmentPathID, "Invalid attachment ID format", nil)
+		return
+	}
+
+	if err := h.attachmentCommands.Delete(c.Request.Context(), reservationID, attachmentID, userID, string(role), side); err != nil {
+		handleReservationAttachmentError(c, "delete", err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// attachmentReadRequest parses the reservation ID and describes the caller for the query layer.
+func attachmentReadRequest(c *gin.Context, side string) (uuid.UUID, queries.AttachmentAccess, bool) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError, ErrMissingUserContext, "Internal server error", nil)
+		return uuid.Nil, queries.AttachmentAccess{}, false
+	}
+	role, _ := middleware.GetUserRole(c)
+
+	reservationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidAttachmentPathID, "Invalid reservation ID format", nil)
+		return uuid.Nil, queries.AttachmentAccess{}, false
+	}
+
+	return reservationID, queries.AttachmentAccess{
+		ActorID:   userID,
+		ActorRole: string(role),
+		Side:      side,
+		CompanyID: supportCompanyID(c),
+	}, true
+}
+
+var reservationAttachmentErrorRules = []createReservationErrorRule{
+	{commands.ErrReservationNotFoundWrite, http.StatusNotFound, "Reservation not found", nil},
+	{queries.ErrReservationNotFound, http.StatusNotFound, "Reservation not found", nil},
+	{commands.ErrReservationAttachmentNotFound, http.StatusNotFound, "Attachment not found", nil},
+	{queries.ErrReservationAttachmentNotFound, http.StatusNotFound, "Attachment not found", nil},
+	{commands.ErrReservationNotOwned, http.StatusForbidden, "Reservation not owned by user", nil},
+	{commands.ErrReservationAttachmentForbidden, http.StatusForbidden, "Insufficient permissions", nil},
+	{commands.ErrInvalidAttachment, http.StatusBadRequest, "Invalid attachment", nil},
+	{commands.ErrAttachmentTooLarge, http.StatusRequestEntityTooLarge, "Attachment too large", nil},
+	{commands.ErrAttachmentTypeNotAllowed, http.StatusUnsupportedMediaType, "Attachment type not allowed", nil},
+	{commands.ErrAttachmentRejected, http.StatusUnprocessableEntity, "Attachment rejected", nil},
+}
+
+func handleReservationAttachmentError(c *gin.Context, op string, err error) {
+	for _, rule := range reservationAttachmentErrorRules {
+		if errors.Is(err, rule.err) {
+			slog.Warn("Reservation attachment error", "op", op, "error", err.Error(), "status", rule.status)
+			httperr.AbortWithError(c, rule.status, err, rule.message, rule.extra)
+			return
+		}
+	}
+
+	slog.Error("Unexpected error in reservation attachment", "op", op, "error", err.Error())
+	httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+}
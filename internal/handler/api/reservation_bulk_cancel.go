@@ -0,0 +1,91 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	reqdto "gin-clean-starter/internal/handler/dto/request"
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/usecase/commands"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type ReservationBulkCancelHandler struct {
+	bulkCancelCommands commands.ReservationBulkCancelCommands
+}
+
+func NewReservationBulkCancelHandler(bulkCancelCommands commands.ReservationBulkCancelCommands) *ReservationBulkCancelHandler {
+	return &ReservationBulkCancelHandler{
+		bulkCancelCommands: bulkCancelCommands,
+	}
+}
+
+// @Summary Cancel reservations in a time range
+// @Description Cancel every confirmed reservation on the resource that overlaps [startTime, endTime) and has not ended, e.g. for a facility closure; the range may span at most 92 days. Each affected user gets one email: the usual cancellation email for a single reservation, or one listing all of theirs. With dryRun the affected reservations are listed and nothing changes. Requires reservations:cancel:any, or :assigned on resources the caller operates.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Resource ID"
+// @Param request body request.CancelReservationRangeRequest true "Range to cancel"
+// @Success 200 {object} response.CancelRangeResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/resources/{id}/cancel-range [post]
+func (h *ReservationBulkCancelHandler) CancelRange(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError, ErrMissingUserContext, "Internal server error", nil)
+		return
+	}
+	role, _ := middleware.GetUserRole(c)
+
+	resourceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidBlockPathID, "Invalid resource ID format", nil)
+		return
+	}
+
+	var req reqdto.CancelReservationRangeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		slog.Info("Invalid request format in cancel reservation range", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid request format", nil)
+		return
+	}
+
+	result, err := h.bulkCancelCommands.CancelRange(c.Request.Context(), req, resourceID, userID, string(role))
+	if err != nil {
+		handleReservationBulkCancelError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resdto.FromCancelRangeResult(result))
+}
+
+var reservationBulkCancelErrorRules = []createReservationErrorRule{
+	{commands.ErrResourceNotFound, http.StatusNotFound, "Resource not found", nil},
+	{commands.ErrCancelRangeForbidden, http.StatusForbidden, "Insufficient permissions", nil},
+	{commands.ErrInvalidCancelRange, http.StatusBadRequest, "Invalid request parameters", nil},
+}
+
+func handleReservationBulkCancelError(c *gin.Context, err error) {
+	for _, rule := range reservationBulkCancelErrorRules {
+		if errors.Is(err, rule.err) {
+			slog.Warn("Reservation range cancel error", "error", err.Error(), "status", rule.status)
+			httperr.AbortWithError(c, rule.status, err, rule.message, rule.extra)
+			return
+		}
+	}
+
+	slog.Error("Unexpected error in reservation range cancel", "error", err.Error())
+	httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+}
@@ -0,0 +1,130 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	reqdto "gin-clean-starter/internal/handler/dto/request"
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/commands"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+var ErrInvalidReservationGroupID = errs.NewCoded("INVALID_ID_FORMAT", "invalid reservation group ID format")
+
+// @Summary Create reservation group
+// @Description Reserve several resources at once (e.g. a room and a projector), all or nothing. Every item is checked before anything is booked; a 409 RESERVATION_GROUP_CONFLICT lists each conflicting item in detail.items. Items are priced without coupons, quotes or loyalty points. One Idempotency-Key covers the whole group. A rejected custom field value fails with INVALID_CUSTOM_FIELDS naming the item in detail.item.
+// @Tags reservations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param Idempotency-Key header string true "Idempotency key for duplicate prevention"
+// @Param Prefer header string false "return=minimal replies with only the id; return=representation with the group"
+// @Param request body request.CreateReservationGroupRequest true "Reservation group request"
+// @Success 200 {object} response.ReservationGroupResponse "Replay of an earlier request with the same Idempotency-Key"
+// @Success 201 {object} response.ReservationGroupResponse "Body is response.CreatedResponse under Prefer: return=minimal"
+// @Header 201 {string} Location "URL of the created group"
+// @Success 202 {object} httperr.Response "An earlier request with the same Idempotency-Key is still in progress"
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 409 {object} httperr.Response "RESERVATION_GROUP_CONFLICT carries detail.items ([]response.ReservationGroupIssueResponse)"
+// @Failure 422 {object} httperr.Response
+// @Router /reservation-groups [post]
+func (h *ReservationHandler) CreateReservationGroup(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError, ErrMissingUserContext, "Internal server error", nil)
+		return
+	}
+
+	idempotencyKey, err := h.getIdempotencyKey(c)
+	if err != nil {
+		slog.Warn("Invalid idempotency key", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusBadRequest, err, err.Error(), nil)
+		return
+	}
+
+	var req reqdto.CreateReservationGroupRequest
+	if bindErr := c.ShouldBindJSON(&req); bindErr != nil {
+		slog.Warn("Invalid request format in create reservation group", "error", bindErr.Error())
+		httperr.AbortWithError(c, http.StatusBadRequest, bindErr, "Invalid request format", nil)
+		return
+	}
+
+	result, err := h.reservationCommands.CreateGroup(c.Request.Context(), req, userID, idempotencyKey)
+	if err != nil {
+		var conflict *commands.ReservationGroupConflictError
+		if errors.As(err, &conflict) {
+			slog.Warn("Create reservation group conflict", "items", len(conflict.Issues))
+			httperr.AbortWithError(c, http.StatusConflict, err, "Some items cannot be reserved", map[string]any{
+				"items": resdto.FromReservationGroupIssues(conflict.Issues),
+			})
+			return
+		}
+		h.handleCreateReservationError(c, err, idempotencyKey)
+		return
+	}
+
+	load := func() (any, error) {
+		views, err := h.reservationQueries.GetGroup(c.Request.Context(), userID, result.GroupID)
+		if err != nil {
+			return nil, err
+		}
+		return resdto.FromReservationGroup(result.GroupID, views), nil
+	}
+
+	if result.IsReplayed {
+		c.Header("Idempotent-Replayed", "true")
+		h.created.Respond(c, http.StatusOK, result.GroupID, load)
+		return
+	}
+	h.created.Created(c, result.GroupID, load)
+}
+
+// @Summary Get reservation group
+// @Description Get the reservations booked together in one group request, earliest slot first
+// @Tags reservations
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Reservation group ID"
+// @Success 200 {object} response.ReservationGroupResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Router /reservation-groups/{id} [get]
+func (h *ReservationHandler) GetReservationGroup(c *gin.Context) {
+	groupID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidReservationGroupID, "Invalid reservation group ID format", nil)
+		return
+	}
+
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError, ErrMissingUserContext, "Internal server error", nil)
+		return
+	}
+
+	views, err := h.reservationQueries.GetGroup(c.Request.Context(), userID, groupID)
+	if err != nil {
+		if errors.Is(err, queries.ErrReservationGroupNotFound) {
+			httperr.AbortWithError(c, http.StatusNotFound, err, "Reservation group not found", nil)
+			return
+		}
+		slog.Error("Unexpected error in get reservation group", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, resdto.FromReservationGroup(groupID, views))
+}
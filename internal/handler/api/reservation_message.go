@@ -0,0 +1,171 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	reqdto "gin-clean-starter/internal/handler/dto/request"
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/usecase/commands"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type ReservationMessageHandler struct {
+	messageCommands commands.ReservationMessageCommands
+}
+
+func NewReservationMessageHandler(messageCommands commands.ReservationMessageCommands) *ReservationMessageHandler {
+	return &ReservationMessageHandler{
+		messageCommands: messageCommands,
+	}
+}
+
+// @Summary Post reservation message
+// @Description Add a message to the thread of a reservation the caller owns; the resource's operators are notified
+// @Tags reservations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Reservation ID"
+// @Param request body request.PostReservationMessageRequest true "Message"
+// @Success 201 {object} response.ReservationMessageResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /reservations/{id}/messages [post]
+func (h *ReservationMessageHandler) Post(c *gin.Context) {
+	h.post(c, shared.MessageSideUser)
+}
+
+// @Summary Mark reservation messages read
+// @Description Clear the caller's unread indicator on a reservation they own
+// @Tags reservations
+// @Security BearerAuth
+// @Param id path string true "Reservation ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /reservations/{id}/messages/read [post]
+func (h *ReservationMessageHandler) MarkRead(c *gin.Context) {
+	h.markRead(c, shared.MessageSideUser)
+}
+
+// @Summary Post reservation message (operator)
+// @Description Reply in a reservation's thread as the operator side; the reservation's user is notified. Requires reservation_messages:write:any, or :assigned on resources the caller operates.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Reservation ID"
+// @Param request body request.PostReservationMessageRequest true "Message"
+// @Success 201 {object} response.ReservationMessageResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/reservations/{id}/messages [post]
+func (h *ReservationMessageHandler) AdminPost(c *gin.Context) {
+	h.post(c, shared.MessageSideOperator)
+}
+
+// @Summary Mark reservation messages read (operator)
+// @Description Clear the operator side's unread indicator. Requires reservations:read:any, or :assigned on resources the caller operates.
+// @Tags admin
+// @Security BearerAuth
+// @Param id path string true "Reservation ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/reservations/{id}/messages/read [post]
+func (h *ReservationMessageHandler) AdminMarkRead(c *gin.Context) {
+	h.markRead(c, shared.MessageSideOperator)
+}
+
+func (h *ReservationMessageHandler) post(c *gin.Context, side string) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError, ErrMissingUserContext, "Internal server error", nil)
+		return
+	}
+	role, _ := middleware.GetUserRole(c)
+
+	reservationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidReservationIDFormat, "Invalid reservation ID format", nil)
+		return
+	}
+
+	var req reqdto.PostReservationMessageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		slog.Info("Invalid request format in post reservation message", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid request format", nil)
+		return
+	}
+
+	result, err := h.messageCommands.Post(c.Request.Context(), reservationID, userID, string(role), side, req.Body)
+	if err != nil {
+		handleReservationMessageError(c, "post", err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resdto.FromPostReservationMessageResult(result))
+}
+
+func (h *ReservationMessageHandler) markRead(c *gin.Context, side string) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError, ErrMissingUserContext, "Internal server error", nil)
+		return
+	}
+	role, _ := middleware.GetUserRole(c)
+
+	reservationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidReservationIDFormat, "Invalid reservation ID format", nil)
+		return
+	}
+
+	if err := h.messageCommands.MarkRead(c.Request.Context(), reservationID, userID, string(role), side); err != nil {
+		handleReservationMessageError(c, "mark_read", err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+var reservationMessageErrorRules = []createReservationErrorRule{
+	{commands.ErrReservationNotFoundWrite, http.StatusNotFound, "Reservation not found", nil},
+	{commands.ErrReservationNotOwned, http.StatusForbidden, "Reservation not owned by user", nil},
+	{commands.ErrReservationMessageForbidden, http.StatusForbidden, "Insufficient permissions", nil},
+	{commands.ErrInvalidReservationMessage, http.StatusBadRequest, "Invalid message", nil},
+}
+
+func handleReservationMessageError(c *gin.Context, op string, err error) {
+	for _, rule := range reservationMessageErrorRules {
+		if errors.Is(err, rule.err) {
+			slog.Warn("Reservation message command error", "op", op, "error", err.Error(), "status", rule.status)
+			httperr.AbortWithError(c, rule.status, err, rule.message, rule.extra)
+			return
+		}
+	}
+
+	slog.Error("Unexpected error in reservation message command", "op", op, "error", err.Error())
+	httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+}
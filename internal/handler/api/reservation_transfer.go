@@ -0,0 +1,135 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	reqdto "gin-clean-starter/internal/handler/dto/request"
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/usecase/commands"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type ReservationTransferHandler struct {
+	transferCommands commands.ReservationTransferCommands
+}
+
+func NewReservationTransferHandler(transferCommands commands.ReservationTransferCommands) *ReservationTransferHandler {
+	return &ReservationTransferHandler{
+		transferCommands: transferCommands,
+	}
+}
+
+// @Summary Transfer reservation
+// @Description Offer a confirmed, upcoming reservation to another user by email. The recipient is emailed a signed link and the offer stays pending until they accept it; a new offer replaces a pending one. Operators with reservations:transfer covering the resource move the reservation right away (status accepted, audited as reservation.transfer_override).
+// @Tags reservations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Reservation ID"
+// @Param request body request.TransferReservationRequest true "Recipient"
+// @Success 201 {object} response.ReservationTransferResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 409 {object} httperr.Response
+// @Failure 422 {object} httperr.Response
+// @Router /reservations/{id}/transfer [post]
+func (h *ReservationTransferHandler) Initiate(c *gin.Context) {
+	reservationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidReservationIDFormat, "Invalid reservation ID format", nil)
+		return
+	}
+
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError, ErrMissingUserContext, "Internal server error", nil)
+		return
+	}
+	role, _ := middleware.GetUserRole(c)
+
+	var req reqdto.TransferReservationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		slog.Info("Invalid request format in transfer reservation", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid request format", nil)
+		return
+	}
+
+	result, err := h.transferCommands.Initiate(c.Request.Context(), reservationID, req.Email, userID, string(role))
+	if err != nil {
+		handleReservationTransferError(c, "initiate", err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resdto.FromReservationTransferResult(result))
+}
+
+// @Summary Accept reservation transfer
+// @Description Accept a transfer offer with the token from its link. The caller must be the recipient; the reservation becomes theirs and both parties are notified.
+// @Tags reservations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body request.AcceptReservationTransferRequest true "Transfer token"
+// @Success 200 {object} response.ReservationTransferResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 409 {object} httperr.Response
+// @Failure 410 {object} httperr.Response
+// @Router /reservation-transfers/accept [post]
+func (h *ReservationTransferHandler) Accept(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError, ErrMissingUserContext, "Internal server error", nil)
+		return
+	}
+
+	var req reqdto.AcceptReservationTransferRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		slog.Info("Invalid request format in accept reservation transfer", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid request format", nil)
+		return
+	}
+
+	result, err := h.transferCommands.Accept(c.Request.Context(), req.Token, userID)
+	if err != nil {
+		handleReservationTransferError(c, "accept", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resdto.FromReservationTransferResult(result))
+}
+
+var reservationTransferErrorRules = []createReservationErrorRule{
+	{commands.ErrInvalidTransferToken, http.StatusBadRequest, "Invalid transfer token", nil},
+	{commands.ErrReservationNotOwned, http.StatusForbidden, "Forbidden", nil},
+	{commands.ErrTransferWrongRecipient, http.StatusForbidden, "Transfer is addressed to another user", nil},
+	{commands.ErrReservationNotFoundWrite, http.StatusNotFound, "Reservation not found", nil},
+	{commands.ErrReservationNotTransferable, http.StatusConflict, "Reservation cannot be transferred", nil},
+	{commands.ErrTransferNotPending, http.StatusConflict, "Transfer is no longer pending", nil},
+	{commands.ErrTransferExpired, http.StatusGone, "Transfer expired", nil},
+	{commands.ErrTransferInvalidRecipient, http.StatusUnprocessableEntity, "Recipient must be another registered user", nil},
+}
+
+func handleReservationTransferError(c *gin.Context, op string, err error) {
+	for _, rule := range reservationTransferErrorRules {
+		if errors.Is(err, rule.err) {
+			slog.Warn("Reservation transfer error", "op", op, "error", err.Error(), "status", rule.status)
+			httperr.AbortWithError(c, rule.status, err, rule.message, rule.extra)
+			return
+		}
+	}
+
+	slog.Error("Unexpected error in reservation transfer", "op", op, "error", err.Error())
+	httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+}
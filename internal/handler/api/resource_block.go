@@ -0,0 +1,256 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	reqdto "gin-clean-starter/internal/handler/dto/request"
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/commands"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidBlockPathID   = errs.NewCoded("INVALID_ID_FORMAT", "invalid resource or block ID format")
+	ErrInvalidScheduleQuery = errs.NewCoded("INVALID_TIME_WINDOW", "from and to must be RFC 3339 timestamps")
+)
+
+type ResourceBlockHandler struct {
+	blockCommands commands.ResourceBlockCommands
+	blockQueries  queries.ResourceBlockQueries
+}
+
+func NewResourceBlockHandler(blockCommands commands.ResourceBlockCommands, blockQueries queries.ResourceBlockQueries) *ResourceBlockHandler {
+	return &ResourceBlockHandler{
+		blockCommands: blockCommands,
+		blockQueries:  blockQueries,
+	}
+}
+
+// @Summary Block resource time
+// @Description Take a resource out of service for a time range (maintenance, private events), optionally repeated daily or weekly. Reservations cannot overlap blocks; the request fails if any occurrence overlaps a confirmed reservation. Requires resource_blocks:manage:any, or :assigned on resources the caller operates.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Resource ID"
+// @Param request body request.CreateResourceBlockRequest true "Block request"
+// @Success 201 {object} response.ResourceBlockSeriesResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 409 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/resources/{id}/blocks [post]
+func (h *ResourceBlockHandler) Create(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError, ErrMissingUserContext, "Internal server error", nil)
+		return
+	}
+	role, _ := middleware.GetUserRole(c)
+
+	resourceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidBlockPathID, "Invalid resource ID format", nil)
+		return
+	}
+
+	var req reqdto.CreateResourceBlockRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		slog.Info("Invalid request format in create resource block", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid request format", nil)
+		return
+	}
+
+	result, err := h.blockCommands.Create(c.Request.Context(), req, resourceID, userID, string(role))
+	if err != nil {
+		handleResourceBlockError(c, "create", err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resdto.FromCreateResourceBlocksResult(result))
+}
+
+// @Summary List resource blocks
+// @Description List blocks overlapping [from, to), by start time. from defaults to now and to to 30 days after from; the window may span at most 92 days.
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Resource ID"
+// @Param from query string false "Window start (RFC 3339)"
+// @Param to query string false "Window end (RFC 3339)"
+// @Success 200 {array} response.ResourceBlockResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/resources/{id}/blocks [get]
+func (h *ResourceBlockHandler) List(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError, ErrMissingUserContext, "Internal server error", nil)
+		return
+	}
+	role, _ := middleware.GetUserRole(c)
+
+	resourceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidBlockPathID, "Invalid resource ID format", nil)
+		return
+	}
+	from, to, ok := parseScheduleWindow(c)
+	if !ok {
+		return
+	}
+
+	blocks, err := h.blockQueries.List(c.Request.Context(), resourceID, from, to, userID, string(role))
+	if err != nil {
+		handleScheduleQueryError(c, resourceID, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resdto.FromResourceBlockViews(blocks))
+}
+
+// @Summary Delete resource block
+// @Description Remove a block; with following=true also remove the later occurrences of its series
+// @Tags admin
+// @Security BearerAuth
+// @Param id path string true "Resource ID"
+// @Param blockId path string true "Block ID"
+// @Param following query bool false "Also delete the later occurrences of the series"
+// @Success 204 "No Content"
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/resources/{id}/blocks/{blockId} [delete]
+func (h *ResourceBlockHandler) Delete(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError, ErrMissingUserContext, "Internal server error", nil)
+		return
+	}
+	role, _ := middleware.GetUserRole(c)
+
+	resourceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidBlockPathID, "Invalid resource ID format", nil)
+		return
+	}
+	blockID, err := uuid.Parse(c.Param("blockId"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidBlockPathID, "Invalid block ID format", nil)
+		return
+	}
+
+	following := c.Query("following") == "true"
+	if err := h.blockCommands.Delete(c.Request.Context(), resourceID, blockID, following, userID, string(role)); err != nil {
+		handleResourceBlockError(c, "delete", err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary Get resource availability
+// @Description List the time ranges in [from, to) in which the resource cannot be booked: confirmed reservations and operator blocks, by start time. from defaults to now and to to 30 days after from; the window may span at most 92 days.
+// @Tags reservations
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Resource ID"
+// @Param from query string false "Window start (RFC 3339)"
+// @Param to query string false "Window end (RFC 3339)"
+// @Success 200 {object} response.AvailabilityResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /resources/{id}/availability [get]
+func (h *ResourceBlockHandler) Availability(c *gin.Context) {
+	resourceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidBlockPathID, "Invalid resource ID format", nil)
+		return
+	}
+	from, to, ok := parseScheduleWindow(c)
+	if !ok {
+		return
+	}
+
+	slots, err := h.blockQueries.Availability(c.Request.Context(), resourceID, from, to)
+	if err != nil {
+		handleScheduleQueryError(c, resourceID, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resdto.NewAvailabilityResponse(slots))
+}
+
+// parseScheduleWindow reads the optional from/to query parameters; absent ones stay zero.
+func parseScheduleWindow(c *gin.Context) (time.Time, time.Time, bool) {
+	var from, to time.Time
+	for name, dst := range map[string]*time.Time{"from": &from, "to": &to} {
+		v := c.Query(name)
+		if v == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidScheduleQuery, "Invalid time window", nil)
+			return time.Time{}, time.Time{}, false
+		}
+		*dst = t
+	}
+	return from, to, true
+}
+
+func handleScheduleQueryError(c *gin.Context, resourceID uuid.UUID, err error) {
+	switch {
+	case errors.Is(err, queries.ErrScheduleResourceNotFound):
+		httperr.AbortWithError(c, http.StatusNotFound, err, "Resource not found", nil)
+	case errors.Is(err, queries.ErrInvalidScheduleWindow):
+		httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid time window", nil)
+	case errors.Is(err, queries.ErrResourceBlocksForbidden):
+		httperr.AbortWithError(c, http.StatusForbidden, err, "Insufficient permissions", nil)
+	default:
+		slog.Error("Failed to read resource schedule", "resource_id", resourceID, "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+	}
+}
+
+var resourceBlockErrorRules = []createReservationErrorRule{
+	{commands.ErrResourceNotFound, http.StatusNotFound, "Resource not found", nil},
+	{commands.ErrResourceBlockNotFound, http.StatusNotFound, "Resource block not found", nil},
+	{commands.ErrResourceBlockForbidden, http.StatusForbidden, "Insufficient permissions", nil},
+	{commands.ErrInvalidResourceBlock, http.StatusBadRequest, "Invalid request parameters", nil},
+	{commands.ErrResourceBlockConflict, http.StatusConflict, "Block overlaps a confirmed reservation", nil},
+}
+
+func handleResourceBlockError(c *gin.Context, op string, err error) {
+	for _, rule := range resourceBlockErrorRules {
+		if errors.Is(err, rule.err) {
+			slog.Warn("Resource block command error", "op", op, "error", err.Error(), "status", rule.status)
+			httperr.AbortWithError(c, rule.status, err, rule.message, rule.extra)
+			return
+		}
+	}
+
+	slog.Error("Unexpected error in resource block command", "op", op, "error", err.Error())
+	httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+}
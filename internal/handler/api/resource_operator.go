@@ -0,0 +1,151 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/commands"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+var ErrInvalidOperatorPathID = errs.NewCoded("INVALID_ID_FORMAT", "invalid resource or user ID format")
+
+type ResourceOperatorHandler struct {
+	operatorCommands commands.ResourceOperatorCommands
+	operatorQueries  queries.ResourceOperatorQueries
+}
+
+func NewResourceOperatorHandler(operatorCommands commands.ResourceOperatorCommands, operatorQueries queries.ResourceOperatorQueries) *ResourceOperatorHandler {
+	return &ResourceOperatorHandler{
+		operatorCommands: operatorCommands,
+		operatorQueries:  operatorQueries,
+	}
+}
+
+// @Summary List resource operators
+// @Description List users assigned to operate a resource
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Resource ID"
+// @Success 200 {array} response.ResourceOperatorResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/resources/{id}/operators [get]
+func (h *ResourceOperatorHandler) List(c *gin.Context) {
+	resourceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidOperatorPathID, "Invalid resource ID format", nil)
+		return
+	}
+
+	operators, err := h.operatorQueries.List(c.Request.Context(), resourceID)
+	if err != nil {
+		if errors.Is(err, queries.ErrOperatorResourceNotFound) {
+			httperr.AbortWithError(c, http.StatusNotFound, err, "Resource not found", nil)
+			return
+		}
+		slog.Error("Failed to list resource operators", "resource_id", resourceID, "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, resdto.FromResourceOperatorViews(operators))
+}
+
+// @Summary Assign resource operator
+// @Description Assign a user to operate a resource; assigned-scope permissions then apply to its reservations and reviews. Users new to the company count against its plan's operator limit
+// @Tags admin
+// @Security BearerAuth
+// @Param id path string true "Resource ID"
+// @Param userId path string true "User ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/resources/{id}/operators/{userId} [put]
+func (h *ResourceOperatorHandler) Assign(c *gin.Context) {
+	resourceID, userID, ok := parseOperatorPath(c)
+	if !ok {
+		return
+	}
+
+	if err := h.operatorCommands.Assign(c.Request.Context(), resourceID, userID); err != nil {
+		handleResourceOperatorError(c, "assign", err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary Unassign resource operator
+// @Description Remove a user's operator assignment from a resource
+// @Tags admin
+// @Security BearerAuth
+// @Param id path string true "Resource ID"
+// @Param userId path string true "User ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/resources/{id}/operators/{userId} [delete]
+func (h *ResourceOperatorHandler) Unassign(c *gin.Context) {
+	resourceID, userID, ok := parseOperatorPath(c)
+	if !ok {
+		return
+	}
+
+	if err := h.operatorCommands.Unassign(c.Request.Context(), resourceID, userID); err != nil {
+		handleResourceOperatorError(c, "unassign", err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func parseOperatorPath(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	resourceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidOperatorPathID, "Invalid resource ID format", nil)
+		return uuid.Nil, uuid.Nil, false
+	}
+	userID, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidOperatorPathID, "Invalid user ID format", nil)
+		return uuid.Nil, uuid.Nil, false
+	}
+	return resourceID, userID, true
+}
+
+var resourceOperatorErrorRules = []createReservationErrorRule{
+	{commands.ErrOperatorTargetNotFound, http.StatusNotFound, "Resource or user not found", nil},
+	{commands.ErrOperatorAssignmentNotFound, http.StatusNotFound, "Operator assignment not found", nil},
+	{commands.ErrPlanOperatorLimit, http.StatusForbidden, "Plan operator limit reached", nil},
+}
+
+func handleResourceOperatorError(c *gin.Context, op string, err error) {
+	for _, rule := range resourceOperatorErrorRules {
+		if errors.Is(err, rule.err) {
+			slog.Warn("Resource operator command error", "op", op, "error", err.Error(), "status", rule.status)
+			httperr.AbortWithError(c, rule.status, err, rule.message, rule.extra)
+			return
+		}
+	}
+
+	slog.Error("Unexpected error in resource operator command", "op", op, "error", err.Error())
+	httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+}
@@ -0,0 +1,43 @@
+package api
+
+import (
+	"log/slog"
+	"net/http"
+
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RetentionHandler struct {
+	retentionQueries queries.RetentionQueries
+}
+
+func NewRetentionHandler(retentionQueries queries.RetentionQueries) *RetentionHandler {
+	return &RetentionHandler{
+		retentionQueries: retentionQueries,
+	}
+}
+
+// @Summary Retention dry-run report
+// @Description List each retention policy with the rows the next purge would delete and the progress of previous purges (admin only)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.RetentionReportResponse
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/retention/report [get]
+func (h *RetentionHandler) Report(c *gin.Context) {
+	report, err := h.retentionQueries.DryRun(c.Request.Context())
+	if err != nil {
+		slog.Error("Failed to build retention report", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, resdto.FromRetentionReport(report))
+}
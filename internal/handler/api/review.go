@@ -1,50 +1,65 @@
 package api
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"log/slog"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	reqdto "gin-clean-starter/internal/handler/dto/request"
 	resdto "gin-clean-starter/internal/handler/dto/response"
 	"gin-clean-starter/internal/handler/httperr"
 	"gin-clean-starter/internal/handler/middleware"
-	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/handler/render"
 	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/pkg/feed"
 	"gin-clean-starter/internal/usecase/commands"
 	"gin-clean-starter/internal/usecase/queries"
+	"gin-clean-starter/internal/usecase/shared"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 )
 
 var (
-	ErrUserNotAuthenticated = errs.New("user not authenticated")
+	ErrUserNotAuthenticated   = errs.New("user not authenticated")
+	ErrInvalidFlaggedReviewID = errs.NewCoded("INVALID_ID_FORMAT", "invalid resource or review ID format")
+	ErrInvalidLanguageFilter  = errs.NewCoded("INVALID_LANGUAGE", "language filter is not an ISO 639-1 code")
+	ErrInvalidExportFormat    = errs.NewCoded("INVALID_EXPORT_FORMAT", "export format must be csv or json")
+	ErrFeatureNotEnabled      = errs.NewCoded("FEATURE_NOT_ENABLED", "feature not enabled for the company")
 )
 
 type ReviewHandler struct {
-	cmds commands.ReviewCommands
-	q    queries.ReviewQueries
+	cmds     commands.ReviewCommands
+	q        queries.ReviewQueries
+	created  *render.CreatedResponder
+	features shared.FeatureFlags
 }
 
-func NewReviewHandler(cmds commands.ReviewCommands, q queries.ReviewQueries) *ReviewHandler {
-	return &ReviewHandler{cmds: cmds, q: q}
+func NewReviewHandler(cmds commands.ReviewCommands, q queries.ReviewQueries, created *render.CreatedResponder, features shared.FeatureFlags) *ReviewHandler {
+	return &ReviewHandler{cmds: cmds, q: q, created: created, features: features}
 }
 
 // @Summary Create review
-// @Description Create a new review for a completed reservation
+// @Description Create a new review for a completed reservation. A comment that nearly repeats one of the author's recent reviews is held for moderation (status "flagged") and stays out of listings and rating stats until approved.
 // @Tags reviews
 // @Accept json
 // @Produce json
 // @Security BearerAuth
+// @Param Prefer header string false "return=minimal replies with only the id; return=representation with the review"
 // @Param request body request.CreateReviewRequest true "Create review request"
-// @Success 201 {object} map[string]string
-// @Failure 400 {object} map[string]string
-// @Failure 401 {object} map[string]string
-// @Failure 404 {object} map[string]string
+// @Success 201 {object} response.ReviewResponse "Body is response.CreatedResponse under Prefer: return=minimal"
+// @Header 201 {string} Location "URL of the created review"
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 409 {object} httperr.Response
+// @Failure 422 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
 // @Router /reviews [post]
 func (h *ReviewHandler) Create(c *gin.Context) {
 	userID, ok := middleware.GetUserID(c)
@@ -66,34 +81,49 @@ func (h *ReviewHandler) Create(c *gin.Context) {
 	defer cancel()
 	result, err := h.cmds.Create(ctx, req, userID)
 	if err != nil {
-		switch {
-		case infra.IsKind(err, infra.KindDuplicateKey):
-			slog.Info("Duplicate review", "user_id", userID, "error", err.Error())
-			httperr.AbortWithError(c, http.StatusConflict, err, "Review already exists for this reservation", nil)
-			return
-		case errors.Is(err, commands.ErrDomainValidationFailed):
-			slog.Info("Invalid review data", "user_id", userID, "error", err.Error())
-			httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid request", nil)
-			return
-		default:
-			slog.Error("Unexpected error", "user_id", userID, "error", err.Error())
-			httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal error", nil)
+		h.handleCreateReviewError(c, err, userID)
+		return
+	}
+
+	h.created.Created(c, result.ReviewID, func() (any, error) {
+		view, err := h.q.GetByID(c.Request.Context(), result.ReviewID)
+		if err != nil {
+			return nil, err
+		}
+		return resdto.FromReviewView(view), nil
+	})
+}
+
+var createReviewErrorRules = []createReservationErrorRule{
+	{commands.ErrReservationNotFoundWrite, http.StatusNotFound, "Reservation not found", nil},
+	{commands.ErrReviewAlreadyExists, http.StatusConflict, "Review already exists for this reservation", nil},
+	{commands.ErrReviewNotEligible, http.StatusUnprocessableEntity, "Reservation is not eligible for review", nil},
+	{commands.ErrReviewTooEarly, http.StatusUnprocessableEntity, "Reservation cannot be reviewed yet", nil},
+	{commands.ErrReviewWindowExpired, http.StatusUnprocessableEntity, "Review window has expired", nil},
+	{commands.ErrDomainValidationFailed, http.StatusBadRequest, "Invalid request", nil},
+}
+
+func (h *ReviewHandler) handleCreateReviewError(c *gin.Context, err error, userID uuid.UUID) {
+	for _, rule := range createReviewErrorRules {
+		if errors.Is(err, rule.err) {
+			slog.Info("Create review error", "user_id", userID, "error", err.Error(), "status", rule.status)
+			httperr.AbortWithError(c, rule.status, err, rule.message, rule.extra)
 			return
 		}
 	}
 
-	c.Header("Location", "/reviews/"+result.ReviewID.String())
-	c.JSON(http.StatusCreated, gin.H{"id": result.ReviewID.String()})
+	slog.Error("Unexpected error", "user_id", userID, "error", err.Error())
+	httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal error", nil)
 }
 
 // @Summary Get review
-// @Description Get a review by ID
+// @Description Get a published review by ID; reviews held for moderation are not found
 // @Tags reviews
 // @Produce json
 // @Param id path string true "Review ID"
 // @Success 200 {object} response.ReviewResponse
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
+// @Failure 400 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
 // @Router /reviews/{id} [get]
 func (h *ReviewHandler) Get(c *gin.Context) {
 	id, err := uuid.Parse(c.Param("id"))
@@ -104,7 +134,7 @@ func (h *ReviewHandler) Get(c *gin.Context) {
 	}
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 2*time.Second)
 	defer cancel()
-	view, err := h.q.GetByID(ctx, id)
+	view, err := h.q.GetPublishedByID(ctx, id)
 	if err != nil {
 		switch {
 		case errors.Is(err, queries.ErrReviewNotFound):
@@ -129,10 +159,10 @@ func (h *ReviewHandler) Get(c *gin.Context) {
 // @Param id path string true "Review ID"
 // @Param request body request.UpdateReviewRequest true "Update review request"
 // @Success 204 "No Content"
-// @Failure 400 {object} map[string]string
-// @Failure 401 {object} map[string]string
-// @Failure 403 {object} map[string]string
-// @Failure 404 {object} map[string]string
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
 // @Router /reviews/{id} [put]
 func (h *ReviewHandler) Update(c *gin.Context) {
 	id, err := uuid.Parse(c.Param("id"))
@@ -185,10 +215,10 @@ func (h *ReviewHandler) Update(c *gin.Context) {
 // @Security BearerAuth
 // @Param id path string true "Review ID"
 // @Success 204 "No Content"
-// @Failure 400 {object} map[string]string
-// @Failure 401 {object} map[string]string
-// @Failure 403 {object} map[string]string
-// @Failure 404 {object} map[string]string
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
 // @Router /reviews/{id} [delete]
 func (h *ReviewHandler) Delete(c *gin.Context) {
 	id, err := uuid.Parse(c.Param("id"))
@@ -226,17 +256,19 @@ func (h *ReviewHandler) Delete(c *gin.Context) {
 }
 
 // @Summary List resource reviews
-// @Description List reviews for a resource with optional rating filters and keyset pagination
+// @Description List reviews for a resource with optional rating and language filters and keyset pagination
 // @Tags reviews
 // @Produce json
 // @Param id path string true "Resource ID"
 // @Param min_rating query int false "Minimum rating (1-5)"
 // @Param max_rating query int false "Maximum rating (1-5)"
+// @Param lang query string false "Detected comment language (ISO 639-1, e.g. en)"
 // @Param limit query int false "Max items (default 20)"
 // @Param after query string false "Cursor for keyset pagination"
-// @Success 200 {array} response.ReviewListItemResponse
-// @Failure 400 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Param fields query string false "Comma-separated review fields to keep, e.g. id,rating,comment; nextCursor is always kept"
+// @Success 200 {object} response.ReviewListPageResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
 // @Router /resources/{id}/reviews [get]
 func (h *ReviewHandler) ListByResource(c *gin.Context) {
 	resourceID, err := uuid.Parse(c.Param("id"))
@@ -262,12 +294,26 @@ func (h *ReviewHandler) ListByResource(c *gin.Context) {
 		httperr.AbortWithError(c, http.StatusBadRequest, errors.New("invalid rating range"), "Invalid rating range", nil)
 		return
 	}
+	var langPtr *string
+	if v := c.Query("lang"); v != "" {
+		lang := strings.ToLower(v)
+		if !isLanguageCode(lang) {
+			slog.Info("Invalid language filter in list reviews", "lang", v)
+			httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidLanguageFilter, "Invalid language", nil)
+			return
+		}
+		langPtr = &lang
+	}
 
+	fields, ok := render.ParseFields(c, resdto.ReviewListPageResponse{}, "reviews")
+	if !ok {
+		return
+	}
 	// Common list params
 	limit, cursor := parseListParams(c)
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 2*time.Second)
 	defer cancel()
-	items, next, err := h.q.ListByResource(ctx, resourceID, queries.ReviewFilters{MinRating: minPtr, MaxRating: maxPtr}, cursor, limit)
+	items, next, err := h.q.ListByResource(ctx, resourceID, queries.ReviewFilters{MinRating: minPtr, MaxRating: maxPtr, Language: langPtr}, cursor, limit)
 	if err != nil {
 		switch {
 		case errors.Is(err, queries.ErrInvalidCursorQuery):
@@ -279,11 +325,7 @@ func (h *ReviewHandler) ListByResource(c *gin.Context) {
 		}
 		return
 	}
-	resp := gin.H{"reviews": resdto.FromReviewList(items)}
-	if next != nil {
-		resp["next_cursor"] = next.After
-	}
-	c.JSON(http.StatusOK, resp)
+	render.JSONFields(c, http.StatusOK, resdto.NewReviewListPage(items, next), fields)
 }
 
 // @Summary List user reviews
@@ -294,10 +336,11 @@ func (h *ReviewHandler) ListByResource(c *gin.Context) {
 // @Param id path string true "User ID"
 // @Param limit query int false "Max items (default 20)"
 // @Param after query string false "Cursor for keyset pagination"
-// @Success 200 {array} response.ReviewListItemResponse
-// @Failure 400 {object} map[string]string
-// @Failure 401 {object} map[string]string
-// @Failure 403 {object} map[string]string
+// @Param fields query string false "Comma-separated review fields to keep, e.g. id,rating,comment; nextCursor is always kept"
+// @Success 200 {object} response.ReviewListPageResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
 // @Router /users/{id}/reviews [get]
 func (h *ReviewHandler) ListByUser(c *gin.Context) {
 	userID, err := uuid.Parse(c.Param("id"))
@@ -308,6 +351,10 @@ func (h *ReviewHandler) ListByUser(c *gin.Context) {
 	}
 	actorID, _ := middleware.GetUserID(c)
 	role, _ := middleware.GetUserRole(c)
+	fields, ok := render.ParseFields(c, resdto.ReviewListPageResponse{}, "reviews")
+	if !ok {
+		return
+	}
 	// Common list params
 	limit, cursor := parseListParams(c)
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 2*time.Second)
@@ -327,21 +374,62 @@ func (h *ReviewHandler) ListByUser(c *gin.Context) {
 		}
 		return
 	}
-	resp := gin.H{"reviews": resdto.FromReviewList(items)}
-	if next != nil {
-		resp["next_cursor"] = next.After
+	render.JSONFields(c, http.StatusOK, resdto.NewReviewListPage(items, next), fields)
+}
+
+// @Summary Export my reviews
+// @Description Download all of the caller's reviews, flagged ones included, oldest first, with resource names and dates. The body is streamed: CSV with a header line, or newline-delimited JSON with one response.ReviewExportRow per line.
+// @Tags reviews
+// @Produce text/csv
+// @Produce application/x-ndjson
+// @Security BearerAuth
+// @Param format query string false "csv or json (default json)" Enums(csv, json)
+// @Success 200 {object} response.ReviewExportRow "One row per review"
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /users/me/reviews/export [get]
+func (h *ReviewHandler) ExportMine(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		// This error should not occur since authentication check has passed
+		slog.Error("user_id not found")
+		httperr.AbortWithError(c, http.StatusInternalServerError, ErrUserNotAuthenticated, "Internal error", nil)
+		return
+	}
+	format, ok := render.ParseStreamFormat(c.DefaultQuery("format", string(render.StreamJSON)))
+	if !ok {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidExportFormat, "Invalid format", nil)
+		return
+	}
+
+	stream := render.NewStream(c, format, "reviews", resdto.ReviewExportCSVHeader)
+	err := h.q.ExportByUser(c.Request.Context(), userID, func(item *queries.ReviewExportItem) error {
+		return stream.Write(resdto.FromReviewExportItem(item))
+	})
+	if err == nil {
+		err = stream.Close()
+	}
+	if err != nil {
+		if !stream.Started() {
+			slog.Error("Export user reviews failed", "user_id", userID, "error", err.Error())
+			httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal error", nil)
+			return
+		}
+		// The status is already sent; the client sees a truncated download.
+		slog.Error("Export user reviews aborted mid-stream", "user_id", userID, "error", err.Error())
+		c.Abort()
 	}
-	c.JSON(http.StatusOK, resp)
 }
 
 // @Summary Resource rating stats
-// @Description Get rating statistics for a resource
+// @Description Get rating statistics for a resource, with a pros/cons summary of recent reviews once the summary job has run
 // @Tags reviews
 // @Produce json
 // @Param id path string true "Resource ID"
 // @Success 200 {object} response.ResourceRatingStatsResponse
-// @Failure 400 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
 // @Router /resources/{id}/rating-stats [get]
 func (h *ReviewHandler) ResourceRatingStats(c *gin.Context) {
 	resourceID, err := uuid.Parse(c.Param("id"))
@@ -361,19 +449,202 @@ func (h *ReviewHandler) ResourceRatingStats(c *gin.Context) {
 	c.JSON(http.StatusOK, resdto.FromResourceRatingStats(stats))
 }
 
-// parses common list parameters such as limit and after cursor.
-func parseListParams(c *gin.Context) (int, *queries.Cursor) {
-	// Default limit; queries side also validates.
-	limit := 20
-	if v := c.Query("limit"); v != "" {
-		if iv, e := strconv.Atoi(v); e == nil {
-			limit = iv
+// @Summary Resource review feed
+// @Description Atom feed of the resource's 50 newest published reviews, newest first. Entries carry the rating and comment but not the author's email. Send the ETag back in If-None-Match to get 304 Not Modified while the feed is unchanged.
+// @Tags reviews
+// @Produce application/atom+xml
+// @Param id path string true "Resource ID"
+// @Param If-None-Match header string false "ETag of a cached copy"
+// @Success 200 {string} string "Atom 1.0 document"
+// @Success 304 "Not Modified"
+// @Header 200 {string} ETag "Changes whenever an entry is added, edited or removed"
+// @Header 200 {string} Last-Modified "Latest update among the entries"
+// @Failure 400 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /resources/{id}/reviews.atom [get]
+func (h *ReviewHandler) Feed(c *gin.Context) {
+	resourceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		slog.Info("Invalid resource ID format in review feed", "id", c.Param("id"), "error", err.Error())
+		httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid resource id", nil)
+		return
+	}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 2*time.Second)
+	defer cancel()
+	reviewFeed, err := h.q.Feed(ctx, resourceID)
+	if err != nil {
+		switch {
+		case errors.Is(err, queries.ErrReviewFeedResource):
+			slog.Info("Resource not found for review feed", "resource_id", resourceID)
+			httperr.AbortWithError(c, http.StatusNotFound, err, "Resource not found", nil)
+		default:
+			slog.Error("Failed to load review feed", "resource_id", resourceID, "error", err.Error())
+			httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal error", nil)
+		}
+		return
+	}
+
+	etag := h.q.GenerateFeedETag(reviewFeed)
+	c.Header("ETag", etag)
+	if !reviewFeed.Updated.IsZero() {
+		c.Header("Last-Modified", reviewFeed.Updated.UTC().Format(http.TimeFormat))
+	}
+	if c.GetHeader("If-None-Match") == etag {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	var body bytes.Buffer
+	if err := feed.Write(&body, resdto.FromReviewFeed(reviewFeed, c.Request.URL.Path, time.Now())); err != nil {
+		slog.Error("Failed to encode review feed", "resource_id", resourceID, "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal error", nil)
+		return
+	}
+	c.Data(http.StatusOK, feed.ContentType, body.Bytes())
+}
+
+// @Summary List flagged reviews
+// @Description Reviews on the resource held for moderation as near duplicates, oldest first (at most 100). Requires reviews:delete:any, or reviews:delete:assigned for resources the caller operates, and the review_moderation feature for the resource's company (FEATURE_NOT_ENABLED otherwise).
+// @Tags reviews
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Resource ID"
+// @Success 200 {object} response.FlaggedReviewListResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/resources/{id}/reviews/flagged [get]
+func (h *ReviewHandler) ListFlagged(c *gin.Context) {
+	resourceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		slog.Info("Invalid resource ID format in list flagged reviews", "id", c.Param("id"), "error", err.Error())
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidFlaggedReviewID, "Invalid resource id", nil)
+		return
+	}
+	if !h.requireFeature(c, resourceID, shared.FeatureReviewModeration) {
+		return
+	}
+	actorID, _ := middleware.GetUserID(c)
+	role, _ := middleware.GetUserRole(c)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 2*time.Second)
+	defer cancel()
+	items, err := h.q.ListFlagged(ctx, resourceID, actorID, string(role))
+	if err != nil {
+		switch {
+		case errors.Is(err, queries.ErrReviewModeration):
+			slog.Info("Access denied in list flagged reviews", "resource_id", resourceID, "actor_id", actorID, "role", string(role))
+			httperr.AbortWithError(c, http.StatusForbidden, err, "Insufficient permissions", nil)
+		default:
+			slog.Error("List flagged reviews failed", "resource_id", resourceID, "actor_id", actorID, "error", err.Error())
+			httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal error", nil)
+		}
+		return
+	}
+	render.JSON(c, http.StatusOK, resdto.NewFlaggedReviewList(items))
+}
+
+// requireFeature aborts unless feature is on for the company owning resourceID.
+func (h *ReviewHandler) requireFeature(c *gin.Context, resourceID uuid.UUID, feature string) bool {
+	enabled, err := h.features.EnabledForResource(c.Request.Context(), resourceID, feature)
+	if err != nil {
+		slog.Error("Feature lookup failed", "resource_id", resourceID, "feature", feature, "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal error", nil)
+		return false
+	}
+	if !enabled {
+		httperr.AbortWithError(c, http.StatusForbidden, ErrFeatureNotEnabled, "Feature not enabled", map[string]any{"feature": feature})
+		return false
+	}
+	return true
+}
+
+// @Summary Approve flagged review
+// @Description Publish a review held for moderation and count it in the resource's rating stats. Reject one by deleting it. Works even after review_moderation is turned off for the company, so reviews held before are not stranded.
+// @Tags reviews
+// @Security BearerAuth
+// @Param id path string true "Review ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 409 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/reviews/{id}/approve [post]
+func (h *ReviewHandler) Approve(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		slog.Info("Invalid review ID format in approve", "id", c.Param("id"), "error", err.Error())
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidFlaggedReviewID, "Invalid id", nil)
+		return
+	}
+	actorID, _ := middleware.GetUserID(c)
+	role, _ := middleware.GetUserRole(c)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 2*time.Second)
+	defer cancel()
+	if err := h.cmds.Approve(ctx, id, actorID, string(role)); err != nil {
+		for _, rule := range approveReviewErrorRules {
+			if errors.Is(err, rule.err) {
+				slog.Info("Approve review error", "review_id", id, "actor_id", actorID, "error", err.Error(), "status", rule.status)
+				httperr.AbortWithError(c, rule.status, err, rule.message, rule.extra)
+				return
+			}
 		}
+		slog.Error("Approve review failed", "review_id", id, "actor_id", actorID, "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal error", nil)
+		return
 	}
-	// Pass raw cursor; queries decode (single source of truth).
-	var cursor *queries.Cursor
-	if after := c.Query("after"); after != "" {
-		cursor = &queries.Cursor{After: after}
+	c.Status(http.StatusNoContent)
+}
+
+var approveReviewErrorRules = []createReservationErrorRule{
+	{commands.ErrReviewNotFoundWrite, http.StatusNotFound, "Not found", nil},
+	{commands.ErrReviewModerationDenied, http.StatusForbidden, "Insufficient permissions", nil},
+	{commands.ErrReviewNotFlagged, http.StatusConflict, "Review is not held for moderation", nil},
+}
+
+// @Summary Import reviews
+// @Description Carry over up to 500 historical reviews from a legacy system, keeping their original timestamps. Imported reviews are published as is and have no reservation (reservationId is null). Each review is checked on its own and the report says what became of each: imported, duplicate (source already imported its externalId) or rejected with a code (VALIDATION_FAILED, RESOURCE_NOT_FOUND, REVIEW_AUTHOR_NOT_FOUND). Authors must exist: the member of the resource's company with authorExternalId as SCIM external ID, else the user with authorEmail. Rating stats are recalculated once per resource at the end. Requires reviews:import.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body request.ImportReviewsRequest true "Reviews to import"
+// @Success 200 {object} response.ReviewImportResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/reviews/import [post]
+func (h *ReviewHandler) Import(c *gin.Context) {
+	actorID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError, ErrMissingUserContext, "Internal server error", nil)
+		return
+	}
+
+	var req reqdto.ImportReviewsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		slog.Info("Invalid request format in import reviews", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid request format", nil)
+		return
+	}
+
+	report, err := h.cmds.Import(c.Request.Context(), req, actorID)
+	if err != nil {
+		slog.Error("Import reviews failed", "actor_id", actorID, "source", req.Source, "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal error", nil)
+		return
 	}
-	return limit, cursor
+	slog.Info("Reviews imported", "actor_id", actorID, "source", req.Source,
+		"imported", report.Imported, "duplicates", report.Duplicates, "rejected", report.Rejected)
+	c.JSON(http.StatusOK, resdto.FromReviewImportReport(report))
+}
+
+// isLanguageCode reports whether s has the shape of a lowercase ISO 639-1 code.
+func isLanguageCode(s string) bool {
+	return len(s) == 2 && s[0] >= 'a' && s[0] <= 'z' && s[1] >= 'a' && s[1] <= 'z'
 }
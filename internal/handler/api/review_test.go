@@ -3,16 +3,24 @@
 package api_test
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"gin-clean-starter/internal/domain/user"
 	"gin-clean-starter/internal/handler/api"
+	reqdto "gin-clean-starter/internal/handler/dto/request"
 	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/render"
+	"gin-clean-starter/internal/pkg/config"
+	"gin-clean-starter/internal/pkg/errs"
 	"gin-clean-starter/internal/usecase/commands"
 	"gin-clean-starter/internal/usecase/queries"
+	"gin-clean-starter/internal/usecase/shared"
 	"gin-clean-starter/tests/common/builder"
 	"gin-clean-starter/tests/common/httptest"
 	"gin-clean-starter/tests/common/testutil"
@@ -31,9 +39,29 @@ type ReviewHandlerTestSuite struct {
 	mockCtrl     *gomock.Controller
 	mockCommands *commandsmock.MockReviewCommands
 	mockQueries  *queriesmock.MockReviewQueries
+	features     *featureFlagsStub
 	handler      *api.ReviewHandler
 }
 
+// featureFlagsStub turns every feature on or off for every company.
+type featureFlagsStub struct {
+	enabled bool
+}
+
+func (f *featureFlagsStub) Enabled(context.Context, uuid.UUID, string) (bool, error) {
+	return f.enabled, nil
+}
+
+func (f *featureFlagsStub) EnabledForResource(context.Context, uuid.UUID, string) (bool, error) {
+	return f.enabled, nil
+}
+
+func (f *featureFlagsStub) States(context.Context, uuid.UUID) ([]shared.FeatureState, error) {
+	return nil, nil
+}
+
+func (f *featureFlagsStub) Invalidate(uuid.UUID) {}
+
 func (s *ReviewHandlerTestSuite) SetupTest() {
 	gin.SetMode(gin.TestMode)
 	s.router = gin.New()
@@ -41,7 +69,12 @@ func (s *ReviewHandlerTestSuite) SetupTest() {
 	s.mockCtrl = gomock.NewController(s.T())
 	s.mockCommands = commandsmock.NewMockReviewCommands(s.mockCtrl)
 	s.mockQueries = queriesmock.NewMockReviewQueries(s.mockCtrl)
-	s.handler = api.NewReviewHandler(s.mockCommands, s.mockQueries)
+	cfg := config.NewTestConfig()
+	cfg.Server.CreatedResponseBody = string(render.CreatedBodyMinimal)
+	created, err := render.NewCreatedResponder(cfg)
+	s.Require().NoError(err)
+	s.features = &featureFlagsStub{enabled: true}
+	s.handler = api.NewReviewHandler(s.mockCommands, s.mockQueries, created, s.features)
 
 	// Mock authentication middleware for testing
 	authMiddleware := func(c *gin.Context) {
@@ -61,8 +94,13 @@ func (s *ReviewHandlerTestSuite) SetupTest() {
 	s.router.PUT("/reviews/:id", authMiddleware, s.handler.Update)
 	s.router.DELETE("/reviews/:id", authMiddleware, s.handler.Delete)
 	s.router.GET("/resources/:id/reviews", s.handler.ListByResource)
+	s.router.GET("/resources/:id/reviews.atom", s.handler.Feed)
 	s.router.GET("/users/:id/reviews", authMiddleware, s.handler.ListByUser)
+	s.router.GET("/users/me/reviews/export", authMiddleware, s.handler.ExportMine)
 	s.router.GET("/resources/:id/rating-stats", s.handler.ResourceRatingStats)
+	s.router.GET("/admin/resources/:id/reviews/flagged", authMiddleware, s.handler.ListFlagged)
+	s.router.POST("/admin/reviews/:id/approve", authMiddleware, s.handler.Approve)
+	s.router.POST("/admin/reviews/import", authMiddleware, s.handler.Import)
 }
 
 func (s *ReviewHandlerTestSuite) TearDownTest() {
@@ -122,7 +160,42 @@ func (s *ReviewHandlerTestSuite) TestCreate() {
 		var body map[string]string
 		httptest.AssertSuccessResponse(s.T(), rec, http.StatusCreated, &body)
 		s.Equal(returnView.ID.String(), body["id"])
-		httptest.AssertHeaders(s.T(), rec, map[string]string{"Location": "/reviews/" + returnView.ID.String()})
+		httptest.AssertHeaders(s.T(), rec, map[string]string{
+			"Location":           "/reviews/" + returnView.ID.String(),
+			"Preference-Applied": "return=minimal",
+		})
+	})
+
+	s.Run("success: Prefer return=representation replies with the created review", func() {
+		s.mockCommands.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(expectedResult, nil).Times(1)
+		s.mockQueries.EXPECT().GetByID(gomock.Any(), returnView.ID).
+			Return(returnView, nil).Times(1)
+		rec := httptest.PerformRequestWithHeaders(s.T(), s.router, http.MethodPost, url, reqBody, "bearer-token",
+			map[string]string{"Prefer": "return=representation"})
+
+		var body resdto.ReviewResponse
+		httptest.AssertSuccessResponse(s.T(), rec, http.StatusCreated, &body)
+		s.Equal(returnView.ID.String(), body.ID)
+		s.Equal(returnView.Comment, body.Comment)
+		httptest.AssertHeaders(s.T(), rec, map[string]string{
+			"Location":           "/reviews/" + returnView.ID.String(),
+			"Preference-Applied": "return=representation",
+		})
+	})
+
+	s.Run("success: representation lookup failure still replies 201 with the id", func() {
+		s.mockCommands.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(expectedResult, nil).Times(1)
+		s.mockQueries.EXPECT().GetByID(gomock.Any(), returnView.ID).
+			Return(nil, errors.New("replica lag")).Times(1)
+		rec := httptest.PerformRequestWithHeaders(s.T(), s.router, http.MethodPost, url, reqBody, "bearer-token",
+			map[string]string{"Prefer": "return=representation"})
+
+		var body resdto.CreatedResponse
+		httptest.AssertSuccessResponse(s.T(), rec, http.StatusCreated, &body)
+		s.Equal(returnView.ID.String(), body.ID)
+		httptest.AssertHeaders(s.T(), rec, map[string]string{"Preference-Applied": "return=minimal"})
 	})
 
 	s.Run("error: 400 Bad Request on validation errors", func() {
@@ -166,6 +239,24 @@ func (s *ReviewHandlerTestSuite) TestCreate() {
 				expectedStatus: http.StatusBadRequest,
 				expectedMsg:    "Invalid request",
 			},
+			{
+				name:           "reservation not found",
+				commandsError:  errs.Mark(errors.New("no rows"), commands.ErrReservationNotFoundWrite),
+				expectedStatus: http.StatusNotFound,
+				expectedMsg:    "Reservation not found",
+			},
+			{
+				name:           "duplicate review",
+				commandsError:  errs.Mark(errs.Mark(errors.New("unique violation"), commands.ErrReviewAlreadyExists), commands.ErrTransactionFailed),
+				expectedStatus: http.StatusConflict,
+				expectedMsg:    "Review already exists for this reservation",
+			},
+			{
+				name:           "reservation not eligible",
+				commandsError:  commands.ErrReviewNotEligible,
+				expectedStatus: http.StatusUnprocessableEntity,
+				expectedMsg:    "Reservation is not eligible for review",
+			},
 			{
 				name:           "review creation failed",
 				commandsError:  commands.ErrReviewCreationFailed,
@@ -206,7 +297,7 @@ func (s *ReviewHandlerTestSuite) TestGet() {
 	returnView.ID = reviewID
 
 	s.Run("success: returns 200 OK with ReviewResponse", func() {
-		s.mockQueries.EXPECT().GetByID(gomock.Any(), reviewID).
+		s.mockQueries.EXPECT().GetPublishedByID(gomock.Any(), reviewID).
 			Return(returnView, nil).Times(1)
 
 		rec := httptest.PerformRequest(s.T(), s.router, http.MethodGet, url, nil, "")
@@ -216,6 +307,8 @@ func (s *ReviewHandlerTestSuite) TestGet() {
 		s.Equal(reviewID.String(), response.ID)
 		s.Equal(returnView.Rating, response.Rating)
 		s.Equal(returnView.Comment, response.Comment)
+		s.Equal("published", response.Status)
+		httptest.AssertSnapshot(s.T(), rec, httptest.IgnoreIDs(), httptest.IgnoreFields("createdAt", "updatedAt"))
 	})
 
 	s.Run("error: 400 Bad Request for invalid UUID", func() {
@@ -225,11 +318,12 @@ func (s *ReviewHandlerTestSuite) TestGet() {
 	})
 
 	s.Run("error: 404 Not Found for missing review", func() {
-		s.mockQueries.EXPECT().GetByID(gomock.Any(), reviewID).
+		s.mockQueries.EXPECT().GetPublishedByID(gomock.Any(), reviewID).
 			Return(nil, queries.ErrReviewNotFound).Times(1)
 
 		rec := httptest.PerformRequest(s.T(), s.router, http.MethodGet, url, nil, "")
 		httptest.AssertErrorResponse(s.T(), rec, http.StatusNotFound, "Not found")
+		httptest.AssertSnapshot(s.T(), rec)
 	})
 
 	s.Run("error: maps usecase errors to proper statuses", func() {
@@ -261,7 +355,7 @@ func (s *ReviewHandlerTestSuite) TestGet() {
 
 		for _, tc := range testCases {
 			s.Run(tc.name, func() {
-				s.mockQueries.EXPECT().GetByID(gomock.Any(), reviewID).
+				s.mockQueries.EXPECT().GetPublishedByID(gomock.Any(), reviewID).
 					Return(nil, tc.queriesError).Times(1)
 
 				rec := httptest.PerformRequest(s.T(), s.router, http.MethodGet, url, nil, "")
@@ -514,7 +608,8 @@ func (s *ReviewHandlerTestSuite) TestListByResource() {
 		reviews, ok := response["reviews"].([]any)
 		s.True(ok)
 		s.Equal(2, len(reviews))
-		s.Equal("next_cursor456", response["next_cursor"])
+		s.Equal("next_cursor456", response["nextCursor"])
+		httptest.AssertSnapshot(s.T(), rec, httptest.IgnoreIDs(), httptest.IgnoreFields("reviews.createdAt"))
 	})
 
 	s.Run("error: 400 Bad Request for invalid resource UUID", func() {
@@ -523,6 +618,21 @@ func (s *ReviewHandlerTestSuite) TestListByResource() {
 		httptest.AssertErrorResponse(s.T(), rec, http.StatusBadRequest, "Invalid resource id")
 	})
 
+	s.Run("success: lang filter is lowercased", func() {
+		lang := "de"
+		expectedFilters := queries.ReviewFilters{Language: &lang}
+		s.mockQueries.EXPECT().ListByResource(gomock.Any(), resourceID, expectedFilters, (*queries.Cursor)(nil), 20).
+			Return(items[:1], nil, nil).Times(1)
+
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodGet, baseURL+"?lang=DE", nil, "")
+		httptest.AssertSuccessResponse(s.T(), rec, http.StatusOK, nil)
+	})
+
+	s.Run("error: 400 Bad Request for a lang that is not an ISO 639-1 code", func() {
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodGet, baseURL+"?lang=german", nil, "")
+		httptest.AssertErrorCode(s.T(), rec, http.StatusBadRequest, "INVALID_LANGUAGE")
+	})
+
 	s.Run("error: returns 500 Internal Server Error on query error", func() {
 		expectedFilters := queries.ReviewFilters{}
 		s.mockQueries.EXPECT().ListByResource(gomock.Any(), resourceID, expectedFilters, (*queries.Cursor)(nil), 20).
@@ -621,7 +731,7 @@ func (s *ReviewHandlerTestSuite) TestListByUser() {
 		reviews, ok := response["reviews"].([]any)
 		s.True(ok)
 		s.Equal(1, len(reviews))
-		s.Equal("next_cursor456", response["next_cursor"])
+		s.Equal("next_cursor456", response["nextCursor"])
 	})
 
 	s.Run("error: 400 Bad Request for invalid user UUID", func() {
@@ -772,3 +882,288 @@ func (s *ReviewHandlerTestSuite) TestResourceRatingStats() {
 		}
 	})
 }
+
+// ================================================================================
+// TestListFlagged
+// ================================================================================
+
+func (s *ReviewHandlerTestSuite) TestListFlagged() {
+	resourceID := uuid.New()
+	url := "/admin/resources/" + resourceID.String() + "/reviews/flagged"
+
+	s.Run("success: returns the moderation queue", func() {
+		original := uuid.New()
+		flagged := &queries.FlaggedReview{ID: uuid.New(), UserID: uuid.New(), UserEmail: "spam@example.com", Rating: 5, Comment: "Great!", DuplicateOf: &original}
+		s.mockQueries.EXPECT().ListFlagged(gomock.Any(), resourceID, gomock.Any(), string(user.RoleViewer)).
+			Return([]*queries.FlaggedReview{flagged}, nil).Times(1)
+
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodGet, url, nil, "bearer-token")
+
+		var response resdto.FlaggedReviewListResponse
+		httptest.AssertSuccessResponse(s.T(), rec, http.StatusOK, &response)
+		s.Require().Len(response.Reviews, 1)
+		s.Equal(flagged.ID, response.Reviews[0].ID)
+		s.Equal(&original, response.Reviews[0].DuplicateOf)
+	})
+
+	s.Run("error: 400 Bad Request for invalid resource UUID", func() {
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodGet, "/admin/resources/invalid-uuid/reviews/flagged", nil, "bearer-token")
+		httptest.AssertErrorCode(s.T(), rec, http.StatusBadRequest, "INVALID_ID_FORMAT")
+	})
+
+	s.Run("error: 403 Forbidden without moderation permission", func() {
+		s.mockQueries.EXPECT().ListFlagged(gomock.Any(), resourceID, gomock.Any(), gomock.Any()).
+			Return(nil, queries.ErrReviewModeration).Times(1)
+
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodGet, url, nil, "bearer-token")
+		httptest.AssertErrorResponse(s.T(), rec, http.StatusForbidden, "Insufficient permissions")
+	})
+
+	s.Run("error: 403 Forbidden when review moderation is off for the company", func() {
+		s.features.enabled = false
+		defer func() { s.features.enabled = true }()
+
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodGet, url, nil, "bearer-token")
+		httptest.AssertErrorCode(s.T(), rec, http.StatusForbidden, "FEATURE_NOT_ENABLED")
+	})
+}
+
+// ================================================================================
+// TestApprove
+// ================================================================================
+
+func (s *ReviewHandlerTestSuite) TestApprove() {
+	reviewID := uuid.New()
+	url := "/admin/reviews/" + reviewID.String() + "/approve"
+
+	s.Run("success: returns 204 No Content", func() {
+		s.mockCommands.EXPECT().Approve(gomock.Any(), reviewID, gomock.Any(), string(user.RoleViewer)).
+			Return(nil).Times(1)
+
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodPost, url, nil, "bearer-token")
+		s.Equal(http.StatusNoContent, rec.Code)
+	})
+
+	s.Run("error: maps usecase errors to proper statuses", func() {
+		testCases := []struct {
+			name           string
+			commandsError  error
+			expectedStatus int
+			expectedMsg    string
+		}{
+			{"review not found", errs.Mark(errors.New("no rows"), commands.ErrReviewNotFoundWrite), http.StatusNotFound, "Not found"},
+			{"not allowed", commands.ErrReviewModerationDenied, http.StatusForbidden, "Insufficient permissions"},
+			{"not flagged", errs.Mark(errors.New("not flagged"), commands.ErrReviewNotFlagged), http.StatusConflict, "Review is not held for moderation"},
+			{"internal server error", errors.New("database error"), http.StatusInternalServerError, "Internal error"},
+		}
+
+		for _, tc := range testCases {
+			s.Run(tc.name, func() {
+				s.mockCommands.EXPECT().Approve(gomock.Any(), reviewID, gomock.Any(), gomock.Any()).
+					Return(tc.commandsError).Times(1)
+
+				rec := httptest.PerformRequest(s.T(), s.router, http.MethodPost, url, nil, "bearer-token")
+				httptest.AssertErrorResponse(s.T(), rec, tc.expectedStatus, tc.expectedMsg)
+			})
+		}
+	})
+}
+
+// ================================================================================
+// TestImport
+// ================================================================================
+
+func (s *ReviewHandlerTestSuite) TestImport() {
+	url := "/admin/reviews/import"
+	body := map[string]any{
+		"source": "legacy-crm",
+		"reviews": []map[string]any{
+			{"externalId": "r-1", "resourceId": uuid.NewString(), "authorEmail": "guest@example.com", "rating": 4, "comment": "Quiet", "createdAt": "2023-05-01T10:00:00Z"},
+			{"externalId": "r-2", "resourceId": uuid.NewString(), "authorEmail": "nobody@example.com", "rating": 5, "comment": "Great", "createdAt": "2023-05-02T10:00:00Z"},
+		},
+	}
+
+	s.Run("success: returns the import report", func() {
+		reviewID := uuid.New()
+		s.mockCommands.EXPECT().Import(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req reqdto.ImportReviewsRequest, _ uuid.UUID) (*commands.ReviewImportReport, error) {
+				s.Equal("legacy-crm", req.Source)
+				s.Require().Len(req.Reviews, 2)
+				s.Equal(time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC), req.Reviews[0].CreatedAt)
+				s.Nil(req.Reviews[0].UpdatedAt)
+				return &commands.ReviewImportReport{Imported: 1, Rejected: 1, Items: []commands.ReviewImportItem{
+					{Index: 0, ExternalID: "r-1", Status: commands.ReviewImportImported, ReviewID: &reviewID},
+					{Index: 1, ExternalID: "r-2", Status: commands.ReviewImportRejected, Code: errs.CodeOf(commands.ErrReviewImportAuthorNotFound)},
+				}}, nil
+			}).Times(1)
+
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodPost, url, body, "bearer-token")
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+		var got resdto.ReviewImportResponse
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+		s.Equal(1, got.Imported)
+		s.Equal(1, got.Rejected)
+		s.Require().Len(got.Items, 2)
+		s.Equal(&reviewID, got.Items[0].ReviewID)
+		s.Equal("imported", got.Items[0].Status)
+		s.Equal("rejected", got.Items[1].Status)
+		s.Equal("REVIEW_AUTHOR_NOT_FOUND", got.Items[1].Code)
+	})
+
+	s.Run("error: invalid requests are rejected before the import", func() {
+		for name, req := range map[string]map[string]any{
+			"missing source": {"reviews": body["reviews"]},
+			"no reviews":     {"source": "legacy-crm", "reviews": []map[string]any{}},
+		} {
+			s.Run(name, func() {
+				rec := httptest.PerformRequest(s.T(), s.router, http.MethodPost, url, req, "bearer-token")
+				s.Equal(http.StatusBadRequest, rec.Code)
+			})
+		}
+	})
+
+	s.Run("error: a failed import is an internal error", func() {
+		s.mockCommands.EXPECT().Import(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, errs.Mark(errors.New("database error"), commands.ErrReviewImportFailed)).Times(1)
+
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodPost, url, body, "bearer-token")
+		httptest.AssertErrorResponse(s.T(), rec, http.StatusInternalServerError, "Internal error")
+	})
+}
+
+// ================================================================================
+// TestExportMine
+// ================================================================================
+
+func (s *ReviewHandlerTestSuite) TestExportMine() {
+	url := "/users/me/reviews/export"
+	lang := "en"
+	created := time.Date(2025, 3, 1, 9, 30, 0, 0, time.UTC)
+	reservationID := uuid.New()
+	item := &queries.ReviewExportItem{
+		ID:            uuid.New(),
+		ResourceID:    uuid.New(),
+		ResourceName:  "Room A",
+		ReservationID: &reservationID,
+		Rating:        4,
+		Comment:       "=cmd, quiet room",
+		Status:        "published",
+		Language:      &lang,
+		CreatedAt:     created,
+		UpdatedAt:     created,
+	}
+	emitOne := func(_ context.Context, _ uuid.UUID, emit func(*queries.ReviewExportItem) error) error {
+		return emit(item)
+	}
+
+	s.Run("success: csv has a header line and quotes formula-like cells", func() {
+		s.mockQueries.EXPECT().ExportByUser(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(emitOne).Times(1)
+
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodGet, url+"?format=csv", nil, "bearer-token")
+
+		s.Equal(http.StatusOK, rec.Code)
+		s.Equal("text/csv; charset=utf-8", rec.Header().Get("Content-Type"))
+		s.Equal(`attachment; filename="reviews.csv"`, rec.Header().Get("Content-Disposition"))
+		lines := strings.Split(strings.TrimSpace(rec.Body.String()), "\n")
+		s.Require().Len(lines, 2)
+		s.Equal(strings.Join(resdto.ReviewExportCSVHeader, ","), lines[0])
+		s.Contains(lines[1], `Room A,`)
+		s.Contains(lines[1], `"'=cmd, quiet room"`)
+		s.Contains(lines[1], "2025-03-01T09:30:00Z")
+	})
+
+	s.Run("success: json is the default and writes one object per line", func() {
+		s.mockQueries.EXPECT().ExportByUser(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(emitOne).Times(1)
+
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodGet, url, nil, "bearer-token")
+
+		s.Equal(http.StatusOK, rec.Code)
+		s.Equal("application/x-ndjson", rec.Header().Get("Content-Type"))
+		var row resdto.ReviewExportRow
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &row))
+		s.Equal(item.ID, row.ID)
+		s.Equal("=cmd, quiet room", row.Comment)
+		s.Equal("en", row.Language)
+	})
+
+	s.Run("error: 400 Bad Request for an unknown format", func() {
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodGet, url+"?format=xml", nil, "bearer-token")
+		httptest.AssertErrorCode(s.T(), rec, http.StatusBadRequest, "INVALID_EXPORT_FORMAT")
+	})
+
+	s.Run("error: 500 Internal Server Error when the first batch fails", func() {
+		s.mockQueries.EXPECT().ExportByUser(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("database error")).Times(1)
+
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodGet, url+"?format=csv", nil, "bearer-token")
+		httptest.AssertErrorResponse(s.T(), rec, http.StatusInternalServerError, "Internal error")
+	})
+}
+
+// ================================================================================
+// TestFeed
+// ================================================================================
+
+func (s *ReviewHandlerTestSuite) TestFeed() {
+	resourceID := uuid.New()
+	url := "/resources/" + resourceID.String() + "/reviews.atom"
+	lang := "en"
+	updated := time.Date(2025, 3, 1, 9, 30, 0, 0, time.UTC)
+	reviewFeed := &queries.ReviewFeed{
+		ResourceID:   resourceID,
+		ResourceName: "Room A",
+		Updated:      updated,
+		Entries: []*queries.ReviewFeedEntry{
+			{ID: uuid.New(), Rating: 4, Comment: "Quiet <room>", Language: &lang, CreatedAt: updated, UpdatedAt: updated},
+		},
+	}
+	etag := `W/"feed-1"`
+
+	s.Run("success: returns the Atom document with caching headers", func() {
+		s.mockQueries.EXPECT().Feed(gomock.Any(), resourceID).Return(reviewFeed, nil).Times(1)
+		s.mockQueries.EXPECT().GenerateFeedETag(reviewFeed).Return(etag).Times(1)
+
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodGet, url, nil, "")
+
+		s.Equal(http.StatusOK, rec.Code)
+		s.Equal("application/atom+xml; charset=utf-8", rec.Header().Get("Content-Type"))
+		s.Equal(etag, rec.Header().Get("ETag"))
+		s.Equal("Sat, 01 Mar 2025 09:30:00 GMT", rec.Header().Get("Last-Modified"))
+		body := rec.Body.String()
+		s.Contains(body, `<feed xmlns="http://www.w3.org/2005/Atom">`)
+		s.Contains(body, "<title>Room A reviews</title>")
+		s.Contains(body, "<title>Rated 4 out of 5</title>")
+		s.Contains(body, "Quiet &lt;room&gt;")
+		s.Contains(body, `href="`+url+`"`)
+		s.NotContains(body, "@")
+	})
+
+	s.Run("success: 304 Not Modified when If-None-Match matches", func() {
+		s.mockQueries.EXPECT().Feed(gomock.Any(), resourceID).Return(reviewFeed, nil).Times(1)
+		s.mockQueries.EXPECT().GenerateFeedETag(reviewFeed).Return(etag).Times(1)
+
+		rec := httptest.PerformRequestWithHeaders(s.T(), s.router, http.MethodGet, url, nil, "", map[string]string{"If-None-Match": etag})
+
+		s.Equal(http.StatusNotModified, rec.Code)
+		s.Equal(etag, rec.Header().Get("ETag"))
+		s.Empty(rec.Body.String())
+	})
+
+	s.Run("error: 400 Bad Request for invalid resource UUID", func() {
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodGet, "/resources/invalid-uuid/reviews.atom", nil, "")
+		httptest.AssertErrorResponse(s.T(), rec, http.StatusBadRequest, "Invalid resource id")
+	})
+
+	s.Run("error: 404 Not Found for an unknown resource", func() {
+		s.mockQueries.EXPECT().Feed(gomock.Any(), resourceID).Return(nil, queries.ErrReviewFeedResource).Times(1)
+
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodGet, url, nil, "")
+		httptest.AssertErrorCode(s.T(), rec, http.StatusNotFound, "RESOURCE_NOT_FOUND")
+	})
+
+	s.Run("error: 500 Internal Server Error when the query fails", func() {
+		s.mockQueries.EXPECT().Feed(gomock.Any(), resourceID).Return(nil, errors.New("database error")).Times(1)
+
+		rec := httptest.PerformRequest(s.T(), s.router, http.MethodGet, url, nil, "")
+		httptest.AssertErrorResponse(s.T(), rec, http.StatusInternalServerError, "Internal error")
+	})
+}
@@ -0,0 +1,258 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	reqdto "gin-clean-starter/internal/handler/dto/request"
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/usecase/commands"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type RoleHandler struct {
+	roleCommands commands.RoleCommands
+	roleQueries  queries.RoleQueries
+}
+
+func NewRoleHandler(roleCommands commands.RoleCommands, roleQueries queries.RoleQueries) *RoleHandler {
+	return &RoleHandler{
+		roleCommands: roleCommands,
+		roleQueries:  roleQueries,
+	}
+}
+
+// @Summary List permissions
+// @Description List every permission that can be granted to a role
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} response.PermissionResponse
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/permissions [get]
+func (h *RoleHandler) ListPermissions(c *gin.Context) {
+	permissions, err := h.roleQueries.ListPermissions(c.Request.Context())
+	if err != nil {
+		slog.Error("Failed to list permissions", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, resdto.FromPermissionViews(permissions))
+}
+
+// @Summary List roles
+// @Description List system and custom roles with their granted permissions
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} response.RoleResponse
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/roles [get]
+func (h *RoleHandler) List(c *gin.Context) {
+	roles, err := h.roleQueries.List(c.Request.Context())
+	if err != nil {
+		slog.Error("Failed to list roles", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, resdto.FromRoleViews(roles))
+}
+
+// @Summary Get role
+// @Description Get a role with its granted permissions
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param name path string true "Role name"
+// @Success 200 {object} response.RoleResponse
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/roles/{name} [get]
+func (h *RoleHandler) Get(c *gin.Context) {
+	role, err := h.roleQueries.Get(c.Request.Context(), c.Param("name"))
+	if err != nil {
+		if errors.Is(err, queries.ErrRoleNotFound) {
+			httperr.AbortWithError(c, http.StatusNotFound, err, "Role not found", nil)
+			return
+		}
+		slog.Error("Failed to get role", "role", c.Param("name"), "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, resdto.FromRoleView(role))
+}
+
+// @Summary Create custom role
+// @Description Create a custom role and grant it permissions
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body request.CreateRoleRequest true "Create role request"
+// @Success 201 {object} response.RoleResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 409 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/roles [post]
+func (h *RoleHandler) Create(c *gin.Context) {
+	var req reqdto.CreateRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		slog.Info("Invalid request format in create role", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid request format", nil)
+		return
+	}
+
+	if err := h.roleCommands.Create(c.Request.Context(), req); err != nil {
+		handleRoleCommandError(c, "create role", err)
+		return
+	}
+
+	h.respondWithRole(c, http.StatusCreated, req.Name)
+}
+
+// @Summary Replace role permissions
+// @Description Replace the permissions granted to a custom role; changes apply to existing sessions within the permission cache TTL
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param name path string true "Role name"
+// @Param request body request.UpdateRolePermissionsRequest true "Permissions to grant"
+// @Success 200 {object} response.RoleResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/roles/{name}/permissions [put]
+func (h *RoleHandler) UpdatePermissions(c *gin.Context) {
+	var req reqdto.UpdateRolePermissionsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		slog.Info("Invalid request format in update role permissions", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid request format", nil)
+		return
+	}
+
+	name := c.Param("name")
+	if err := h.roleCommands.UpdatePermissions(c.Request.Context(), name, req); err != nil {
+		handleRoleCommandError(c, "update role permissions", err)
+		return
+	}
+
+	h.respondWithRole(c, http.StatusOK, name)
+}
+
+// @Summary Delete custom role
+// @Description Delete a custom role that is no longer assigned to any user
+// @Tags admin
+// @Security BearerAuth
+// @Param name path string true "Role name"
+// @Success 204 "No Content"
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 409 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/roles/{name} [delete]
+func (h *RoleHandler) Delete(c *gin.Context) {
+	if err := h.roleCommands.Delete(c.Request.Context(), c.Param("name")); err != nil {
+		handleRoleCommandError(c, "delete role", err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary Change user role
+// @Description Assign the user another system or custom role. The user is signed out of every session and picks up the new role at the next sign-in. Admins cannot change their own role. Recorded as an auth.role_changed security event (roles:manage)
+// @Tags admin
+// @Accept json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Param request body request.ChangeUserRoleRequest true "Role to assign"
+// @Success 204 "No Content"
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/users/{id}/role [put]
+func (h *RoleHandler) ChangeUserRole(c *gin.Context) {
+	actorID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError, ErrMissingUserContext, "Internal server error", nil)
+		return
+	}
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidUserPathID, "Invalid user ID format", nil)
+		return
+	}
+	var req reqdto.ChangeUserRoleRequest
+	if err = c.ShouldBindJSON(&req); err != nil {
+		slog.Info("Invalid request format in change user role", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid request format", nil)
+		return
+	}
+
+	if err = h.roleCommands.ChangeUserRole(c.Request.Context(), userID, req, actorID); err != nil {
+		handleRoleCommandError(c, "change user role", err)
+		return
+	}
+
+	slog.Info("User role changed", "actor_id", actorID, "user_id", userID, "role", req.Role)
+	c.Status(http.StatusNoContent)
+}
+
+func (h *RoleHandler) respondWithRole(c *gin.Context, status int, name string) {
+	role, err := h.roleQueries.Get(c.Request.Context(), name)
+	if err != nil {
+		slog.Error("Failed to load role after write", "role", name, "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		return
+	}
+
+	c.JSON(status, resdto.FromRoleView(role))
+}
+
+var roleCommandErrorRules = []createReservationErrorRule{
+	{commands.ErrInvalidRoleName, http.StatusBadRequest, "Invalid role name", nil},
+	{commands.ErrUnknownPermission, http.StatusBadRequest, "Unknown permission", nil},
+	{commands.ErrRoleNotFound, http.StatusNotFound, "Role not found", nil},
+	{commands.ErrUserNotFound, http.StatusNotFound, "User not found", nil},
+	{commands.ErrOwnRoleChange, http.StatusForbidden, "You cannot change your own role", nil},
+	{commands.ErrSystemRoleImmutable, http.StatusForbidden, "System roles cannot be modified", nil},
+	{commands.ErrRoleAlreadyExists, http.StatusConflict, "Role already exists", nil},
+	{commands.ErrRoleInUse, http.StatusConflict, "Role is still assigned to users", nil},
+}
+
+func handleRoleCommandError(c *gin.Context, op string, err error) {
+	for _, rule := range roleCommandErrorRules {
+		if errors.Is(err, rule.err) {
+			slog.Warn("Role command error", "op", op, "error", err.Error(), "status", rule.status)
+			httperr.AbortWithError(c, rule.status, err, rule.message, rule.extra)
+			return
+		}
+	}
+
+	slog.Error("Unexpected error in role command", "op", op, "error", err.Error())
+	httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+}
@@ -0,0 +1,288 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	reqdto "gin-clean-starter/internal/handler/dto/request"
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/pkg/config"
+	"gin-clean-starter/internal/pkg/cookie"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/pkg/jwt"
+	"gin-clean-starter/internal/usecase/commands"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// SAMLStateCookieName carries the signed login state from the login redirect to the ACS.
+const SAMLStateCookieName = "saml_state"
+
+var ErrSAMLResponseMissing = errs.NewCoded("SAML_RESPONSE_MISSING", "SAMLResponse missing")
+
+type SAMLHandler struct {
+	samlCommands commands.SAMLCommands
+	samlQueries  queries.SAMLQueries
+	jwtService   *jwt.Service
+	cfg          config.Config
+}
+
+func NewSAMLHandler(samlCommands commands.SAMLCommands, samlQueries queries.SAMLQueries, jwtService *jwt.Service, cfg config.Config) *SAMLHandler {
+	return &SAMLHandler{
+		samlCommands: samlCommands,
+		samlQueries:  samlQueries,
+		jwtService:   jwtService,
+		cfg:          cfg,
+	}
+}
+
+// @Summary SAML service provider metadata
+// @Description The company's SAML service provider metadata, to register with its identity provider. Not found unless SAML_ENABLED
+// @Tags auth
+// @Produce xml
+// @Param id path string true "Company ID"
+// @Success 200 {string} string "SAML metadata XML"
+// @Failure 400 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /auth/saml/{id}/metadata [get]
+func (h *SAMLHandler) Metadata(c *gin.Context) {
+	if !h.samlEnabled(c) {
+		return
+	}
+	companyID, ok := parseFeatureCompanyID(c)
+	if !ok {
+		return
+	}
+	metadata, err := h.samlQueries.Metadata(c.Request.Context(), companyID)
+	if err != nil {
+		handleSAMLError(c, "saml metadata", err)
+		return
+	}
+
+	c.Data(http.StatusOK, "application/samlmetadata+xml", metadata)
+}
+
+// @Summary Start SAML sign-in
+// @Description Redirect the browser to the company's identity provider with an AuthnRequest. Not found unless SAML_ENABLED
+// @Tags auth
+// @Param id path string true "Company ID"
+// @Success 302 "Redirect to the identity provider"
+// @Failure 400 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /auth/saml/{id}/login [get]
+func (h *SAMLHandler) Login(c *gin.Context) {
+	if !h.samlEnabled(c) {
+		return
+	}
+	companyID, ok := parseFeatureCompanyID(c)
+	if !ok {
+		return
+	}
+	start, err := h.samlCommands.StartLogin(c.Request.Context(), companyID)
+	if err != nil {
+		handleSAMLError(c, "start saml login", err)
+		return
+	}
+
+	h.setStateCookie(c, companyID, start.State, time.Until(start.ExpiresAt))
+	c.Redirect(http.StatusFound, start.RedirectURL)
+}
+
+// @Summary SAML assertion consumer service
+// @Description Verify the SAMLResponse the identity provider posts, set the token cookies and redirect to SAML_REDIRECT_URL. Users new to the company are created with the role the assertion maps to. Fails with DEVICE_KEY_REQUIRED when JWT_DEVICE_BINDING is required. Not found unless SAML_ENABLED
+// @Tags auth
+// @Accept x-www-form-urlencoded
+// @Param id path string true "Company ID"
+// @Param SAMLResponse formData string true "Base64 SAML response"
+// @Param RelayState formData string false "Ignored"
+// @Success 303 "Redirect to SAML_REDIRECT_URL"
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /auth/saml/{id}/acs [post]
+func (h *SAMLHandler) ACS(c *gin.Context) {
+	if !h.samlEnabled(c) {
+		return
+	}
+	companyID, ok := parseFeatureCompanyID(c)
+	if !ok {
+		return
+	}
+	samlResponse := c.PostForm("SAMLResponse")
+	if samlResponse == "" {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrSAMLResponseMissing, "SAMLResponse is required", nil)
+		return
+	}
+	state, _ := c.Cookie(SAMLStateCookieName)
+	h.setStateCookie(c, companyID, "", -time.Second)
+
+	result, err := h.samlCommands.Login(c.Request.Context(), companyID, samlResponse, state)
+	if err != nil {
+		handleSAMLError(c, "saml login", err)
+		return
+	}
+
+	cookie.SetTokenCookies(c, h.cfg.Cookie, result.TokenPair.AccessToken, result.TokenPair.RefreshToken,
+		h.jwtService.GetAccessTokenDuration(), h.jwtService.GetRefreshTokenDuration())
+
+	slog.Info("User logged in through SAML", "user_id", result.UserID, "company_id", companyID)
+	// RelayState is not followed: redirecting wherever the IdP post says would be an open redirect.
+	c.Redirect(http.StatusSeeOther, h.cfg.SAML.RedirectURL)
+}
+
+// @Summary Get company SAML connection
+// @Description The identity provider the company trusts and the service provider URLs to register with it
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Company ID"
+// @Success 200 {object} response.SAMLConnectionResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/companies/{id}/saml [get]
+func (h *SAMLHandler) GetConnection(c *gin.Context) {
+	companyID, ok := parseFeatureCompanyID(c)
+	if !ok {
+		return
+	}
+	h.respondWithConnection(c, companyID)
+}
+
+// @Summary Set company SAML connection
+// @Description Trust the identity provider described by the uploaded metadata and map an assertion attribute to roles. Mapping to a role other than the caller's own requires roles:manage
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Company ID"
+// @Param request body request.SetSAMLConnectionRequest true "SAML connection"
+// @Success 200 {object} response.SAMLConnectionResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/companies/{id}/saml [put]
+func (h *SAMLHandler) SetConnection(c *gin.Context) {
+	companyID, ok := parseFeatureCompanyID(c)
+	if !ok {
+		return
+	}
+	var req reqdto.SetSAMLConnectionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		slog.Info("Invalid request format in set SAML connection", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid request format", nil)
+		return
+	}
+
+	actorID, _ := middleware.GetUserID(c)
+	actorRole, _ := middleware.GetUserRole(c)
+	if err := h.samlCommands.SetConnection(c.Request.Context(), companyID, req, actorID, actorRole.String()); err != nil {
+		handleSAMLError(c, "set saml connection", err)
+		return
+	}
+
+	h.respondWithConnection(c, companyID)
+}
+
+// @Summary Remove company SAML connection
+// @Description Stop trusting the company's identity provider. Sessions it already started stay valid until they expire
+// @Tags admin
+// @Security BearerAuth
+// @Param id path string true "Company ID"
+// @Success 204
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/companies/{id}/saml [delete]
+func (h *SAMLHandler) DeleteConnection(c *gin.Context) {
+	companyID, ok := parseFeatureCompanyID(c)
+	if !ok {
+		return
+	}
+
+	actorID, _ := middleware.GetUserID(c)
+	if err := h.samlCommands.DeleteConnection(c.Request.Context(), companyID, actorID); err != nil {
+		handleSAMLError(c, "delete saml connection", err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *SAMLHandler) respondWithConnection(c *gin.Context, companyID uuid.UUID) {
+	view, err := h.samlQueries.GetConnection(c.Request.Context(), companyID)
+	if err != nil {
+		handleSAMLError(c, "get saml connection", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resdto.FromSAMLConnectionView(view))
+}
+
+func (h *SAMLHandler) samlEnabled(c *gin.Context) bool {
+	if h.cfg.SAML.Enabled {
+		return true
+	}
+	httperr.AbortWithError(c, http.StatusNotFound,
+		errors.New("SAML sign-in is disabled"),
+		"Not found", nil)
+	return false
+}
+
+// setStateCookie scopes the state to the company's ACS. The IdP posts there from another
+// site, so the cookie must be SameSite=None.
+func (h *SAMLHandler) setStateCookie(c *gin.Context, companyID uuid.UUID, state string, maxAge time.Duration) {
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:     SAMLStateCookieName,
+		Value:    state,
+		Path:     "/api/auth/saml/" + companyID.String() + "/acs",
+		Domain:   h.cfg.Cookie.Domain,
+		MaxAge:   int(maxAge.Seconds()),
+		Secure:   h.cfg.Cookie.Secure,
+		HttpOnly: true,
+		SameSite: http.SameSiteNoneMode,
+	})
+}
+
+var samlErrorRules = []createReservationErrorRule{
+	{commands.ErrSAMLInvalidMetadata, http.StatusBadRequest, "Invalid identity provider metadata", nil},
+	{commands.ErrSAMLUnknownRole, http.StatusBadRequest, "Unknown role", nil},
+	{commands.ErrSAMLRoleForbidden, http.StatusForbidden, "Not allowed to grant this role", nil},
+	{commands.ErrCompanyNotFound, http.StatusNotFound, "Company not found", nil},
+	{commands.ErrSAMLConnectionNotFound, http.StatusNotFound, "SAML connection not found", nil},
+	{queries.ErrSAMLConnectionNotFound, http.StatusNotFound, "SAML connection not found", nil},
+	{commands.ErrSAMLAssertionInvalid, http.StatusUnauthorized, "SAML response rejected", nil},
+	{commands.ErrSAMLEmailInvalid, http.StatusUnauthorized, "SAML assertion carries no valid email", nil},
+	{commands.ErrSSOUserOtherCompany, http.StatusForbidden, "User belongs to another company", nil},
+	{commands.ErrUserInactive, http.StatusForbidden, "Account is inactive", nil},
+	{commands.ErrDeviceKeyRequired, http.StatusBadRequest, "SAML sign-in is unavailable while device keys are required", nil},
+}
+
+func handleSAMLError(c *gin.Context, op string, err error) {
+	for _, rule := range samlErrorRules {
+		if errors.Is(err, rule.err) {
+			slog.Warn("SAML error", "op", op, "error", err.Error(), "status", rule.status)
+			httperr.AbortWithError(c, rule.status, err, rule.message, rule.extra)
+			return
+		}
+	}
+
+	slog.Error("Unexpected error in SAML", "op", op, "error", err.Error())
+	httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+}
@@ -0,0 +1,60 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/handler/render"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SecurityEventHandler struct {
+	securityEventQueries queries.SecurityEventQueries
+}
+
+func NewSecurityEventHandler(securityEventQueries queries.SecurityEventQueries) *SecurityEventHandler {
+	return &SecurityEventHandler{
+		securityEventQueries: securityEventQueries,
+	}
+}
+
+// @Summary List my security events
+// @Description List password and email changes, logins from new devices and two-factor changes on the caller's account, newest first
+// @Tags users
+// @Produce json
+// @Security BearerAuth
+// @Param after query string false "Pagination cursor"
+// @Param limit query int false "Page size"
+// @Success 200 {object} response.SecurityEventListResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /users/me/security-events [get]
+func (h *SecurityEventHandler) ListMine(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError, ErrMissingUserContext, "Internal server error", nil)
+		return
+	}
+
+	limit, cursor := parseListParams(c)
+	events, next, err := h.securityEventQueries.ListMine(c.Request.Context(), userID, cursor, limit)
+	if err != nil {
+		if errors.Is(err, queries.ErrInvalidCursor) {
+			httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid cursor", nil)
+			return
+		}
+		slog.Error("Failed to list security events", "user_id", userID, "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		return
+	}
+
+	render.JSON(c, http.StatusOK, resdto.NewSecurityEventPage(events, next))
+}
@@ -0,0 +1,102 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/commands"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+var ErrInvalidSessionID = errs.NewCoded("INVALID_ID_FORMAT", "invalid session ID format")
+
+type SessionHandler struct {
+	authCommands   commands.AuthCommands
+	sessionQueries queries.SessionQueries
+}
+
+func NewSessionHandler(authCommands commands.AuthCommands, sessionQueries queries.SessionQueries) *SessionHandler {
+	return &SessionHandler{
+		authCommands:   authCommands,
+		sessionQueries: sessionQueries,
+	}
+}
+
+// @Summary List sessions
+// @Description The caller's signed-in devices that are neither signed out nor expired, most recently seen first. current marks the session of the access token making the request
+// @Tags auth
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} response.SessionResponse
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /auth/sessions [get]
+func (h *SessionHandler) List(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+	sessions, err := h.sessionQueries.ListByUser(c.Request.Context(), userID)
+	if err != nil {
+		handleSessionError(c, "list sessions", err)
+		return
+	}
+
+	var current uuid.UUID
+	if token, ok := middleware.GetAccessToken(c); ok && token.SessionID != nil {
+		current = *token.SessionID
+	}
+	c.JSON(http.StatusOK, resdto.FromSessionViews(sessions, current))
+}
+
+// @Summary Revoke session
+// @Description Sign one of the caller's devices out: its refresh token is refused with SESSION_REVOKED, and its access token within AUTHZ_SESSION_CACHE_TTL. Recorded as an auth.session_revoked security event
+// @Tags auth
+// @Security BearerAuth
+// @Param id path string true "Session ID"
+// @Success 204
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /auth/sessions/{id} [delete]
+func (h *SessionHandler) Revoke(c *gin.Context) {
+	sessionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidSessionID, "Invalid session ID format", nil)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+	if rerr := h.authCommands.RevokeSession(c.Request.Context(), userID, sessionID); rerr != nil {
+		handleSessionError(c, "revoke session", rerr)
+		return
+	}
+
+	slog.Info("Session revoked", "user_id", userID, "session_id", sessionID)
+	c.Status(http.StatusNoContent)
+}
+
+var sessionErrorRules = []createReservationErrorRule{
+	{commands.ErrSessionNotFound, http.StatusNotFound, "Session not found", nil},
+}
+
+func handleSessionError(c *gin.Context, op string, err error) {
+	for _, rule := range sessionErrorRules {
+		if errors.Is(err, rule.err) {
+			slog.Warn("Session error", "op", op, "error", err.Error(), "status", rule.status)
+			httperr.AbortWithError(c, rule.status, err, rule.message, rule.extra)
+			return
+		}
+	}
+
+	slog.Error("Unexpected error in session", "op", op, "error", err.Error())
+	httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+}
@@ -0,0 +1,72 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	reqdto "gin-clean-starter/internal/handler/dto/request"
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/usecase/commands"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SupportHandler struct {
+	supportCommands commands.SupportCommands
+}
+
+func NewSupportHandler(supportCommands commands.SupportCommands) *SupportHandler {
+	return &SupportHandler{
+		supportCommands: supportCommands,
+	}
+}
+
+// @Summary Start support session
+// @Description Issue a short-lived, non-refreshable bearer token scoped to a company for troubleshooting. Every mutating request made with it is rejected, and every request is written to the audit log.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body request.StartSupportSessionRequest true "Support session request"
+// @Success 201 {object} response.SupportSessionResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Router /admin/support-sessions [post]
+func (h *SupportHandler) Start(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError, ErrMissingUserContext, "Internal server error", nil)
+		return
+	}
+	role, _ := middleware.GetUserRole(c)
+
+	var req reqdto.StartSupportSessionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		slog.Info("Invalid request format in start support session", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid request format", nil)
+		return
+	}
+
+	result, err := h.supportCommands.Start(c.Request.Context(), req, userID, role)
+	if err != nil {
+		switch {
+		case errors.Is(err, commands.ErrInvalidSupportCompanyID):
+			httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid company ID", nil)
+		case errors.Is(err, commands.ErrSupportCompanyNotFound):
+			httperr.AbortWithError(c, http.StatusNotFound, err, "Company not found", nil)
+		default:
+			slog.Error("Failed to start support session", "user_id", userID, "error", err.Error())
+			httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		}
+		return
+	}
+
+	slog.Info("Support session started", "user_id", userID, "company_id", result.CompanyID, "session_id", result.SessionID)
+	c.JSON(http.StatusCreated, resdto.FromSupportSessionResult(result))
+}
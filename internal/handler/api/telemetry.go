@@ -0,0 +1,103 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/gin-gonic/gin"
+)
+
+var ErrInvalidAdoptionDate = errs.NewCoded("INVALID_ADOPTION_DATE", "dates must be formatted as YYYY-MM-DD")
+
+type TelemetryHandler struct {
+	telemetryQueries queries.TelemetryQueries
+}
+
+func NewTelemetryHandler(telemetryQueries queries.TelemetryQueries) *TelemetryHandler {
+	return &TelemetryHandler{
+		telemetryQueries: telemetryQueries,
+	}
+}
+
+// @Summary Feature adoption report
+// @Description Requests per route and how many companies used it, most widely adopted first. Companies are counted by an anonymized key, opted-out companies (company_settings.telemetry_opt_out) are not counted, and counts are written in batches (telemetry:read)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param from query string false "First day as YYYY-MM-DD (default: 29 days before to)"
+// @Param to query string false "Last day as YYYY-MM-DD, inclusive (default: today, UTC)"
+// @Success 200 {object} response.AdoptionReportResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/adoption [get]
+func (h *TelemetryHandler) Adoption(c *gin.Context) {
+	from, ok := parseAdoptionDay(c, "from")
+	if !ok {
+		return
+	}
+	to, ok := parseAdoptionDay(c, "to")
+	if !ok {
+		return
+	}
+
+	report, err := h.telemetryQueries.Adoption(c.Request.Context(), from, to)
+	if err != nil {
+		if errors.Is(err, queries.ErrAdoptionRangeInvalid) {
+			httperr.AbortWithError(c, http.StatusBadRequest, err, "from must not be after to, and the range may span at most a year", nil)
+			return
+		}
+		slog.Error("Failed to build adoption report", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, resdto.FromAdoptionReport(report))
+}
+
+// @Summary Login replay counters
+// @Description Duplicate login submissions answered from the replay cache since this instance started: replayed after the first login finished, or coalesced while it was still running (telemetry:read)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.LoginReplayStatsResponse
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Router /admin/login-replays [get]
+func (h *TelemetryHandler) LoginReplays(c *gin.Context) {
+	c.JSON(http.StatusOK, resdto.FromLoginReplayStats(h.telemetryQueries.LoginReplays()))
+}
+
+// @Summary Canceled request counters
+// @Description Requests whose client disconnected before the reply since this instance started, in total and by route. Their queries and transactions are canceled with them, and a failure they cause is logged with status 499 instead of 500 (telemetry:read)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.CanceledRequestStatsResponse
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Router /admin/canceled-requests [get]
+func (h *TelemetryHandler) CanceledRequests(c *gin.Context) {
+	c.JSON(http.StatusOK, resdto.FromCanceledRequestStats(h.telemetryQueries.CanceledRequests()))
+}
+
+func parseAdoptionDay(c *gin.Context, param string) (*time.Time, bool) {
+	v := c.Query(param)
+	if v == "" {
+		return nil, true
+	}
+	day, err := time.Parse("2006-01-02", v)
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidAdoptionDate, "Invalid "+param+" date", nil)
+		return nil, false
+	}
+	return &day, true
+}
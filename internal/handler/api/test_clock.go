@@ -0,0 +1,50 @@
+//go:build e2e
+
+package api
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	reqdto "gin-clean-starter/internal/handler/dto/request"
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/pkg/clock"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TestClockHandler moves the injected clock so e2e tests can cross time windows
+// (review eligibility, quote expiry) without sleeping. It only exists in e2e builds.
+type TestClockHandler struct {
+	clock *clock.OffsetClock
+}
+
+func NewTestClockHandler(clock *clock.OffsetClock) *TestClockHandler {
+	return &TestClockHandler{
+		clock: clock,
+	}
+}
+
+func (h *TestClockHandler) Set(c *gin.Context) {
+	var req reqdto.SetTestClockRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid request format", nil)
+		return
+	}
+
+	offset, err := time.ParseDuration(req.Offset)
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid offset", nil)
+		return
+	}
+
+	h.clock.SetOffset(offset)
+	slog.Info("Test clock adjusted", "offset", offset.String())
+
+	c.JSON(http.StatusOK, resdto.TestClockResponse{
+		Now:    h.clock.Now(),
+		Offset: offset.String(),
+	})
+}
@@ -0,0 +1,66 @@
+//go:build e2e
+
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// TestSlowHandler holds a query open on the database so e2e tests can disconnect mid-request
+// and check the query stops with them. It only exists in e2e builds.
+type TestSlowHandler struct {
+	uow shared.UnitOfWork
+}
+
+func NewTestSlowHandler(uow shared.UnitOfWork) *TestSlowHandler {
+	return &TestSlowHandler{
+		uow: uow,
+	}
+}
+
+// Sleep runs pg_sleep(?seconds=) on a read connection, or inside a transaction with ?via=tx.
+// The statement ends in a comment with ?tag= so tests can find it in pg_stat_activity.
+func (h *TestSlowHandler) Sleep(c *gin.Context) {
+	seconds, err := strconv.ParseFloat(c.DefaultQuery("seconds", "5"), 64)
+	if err == nil && seconds <= 0 {
+		err = errors.New("seconds must be positive")
+	}
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid seconds", nil)
+		return
+	}
+	tag, err := uuid.Parse(c.Query("tag"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid tag", nil)
+		return
+	}
+	query := "SELECT pg_sleep($1) -- " + tag.String()
+
+	ctx := c.Request.Context()
+	switch c.DefaultQuery("via", "read") {
+	case "read":
+		_, err = h.uow.ReadDB(ctx).Exec(ctx, query, seconds)
+	case "tx":
+		err = h.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+			_, execErr := tx.DB().Exec(ctx, query, seconds)
+			return execErr
+		})
+	default:
+		httperr.AbortWithError(c, http.StatusBadRequest, errors.New("via must be read or tx"), "Invalid via", nil)
+		return
+	}
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	reqdto "gin-clean-starter/internal/handler/dto/request"
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/handler/render"
+	"gin-clean-starter/internal/usecase/commands"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TOSHandler struct {
+	tosCommands commands.TOSCommands
+}
+
+func NewTOSHandler(tosCommands commands.TOSCommands) *TOSHandler {
+	return &TOSHandler{
+		tosCommands: tosCommands,
+	}
+}
+
+// @Summary Accept terms of service
+// @Description Record that the caller accepted the current terms-of-service version, with the time and client IP. Until they do, other authenticated requests fail with TOS_ACCEPTANCE_REQUIRED.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body request.AcceptTOSRequest true "Accepted version"
+// @Success 200 {object} response.TOSAcceptanceResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 409 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /users/me/accept-tos [post]
+func (h *TOSHandler) Accept(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError, ErrMissingUserContext, "Internal server error", nil)
+		return
+	}
+
+	var req reqdto.AcceptTOSRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		slog.Info("Invalid request format in accept terms of service", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid request format", nil)
+		return
+	}
+
+	result, err := h.tosCommands.Accept(c.Request.Context(), req, userID, c.ClientIP())
+	if err != nil {
+		switch {
+		case errors.Is(err, commands.ErrTOSVersionNotFound):
+			httperr.AbortWithError(c, http.StatusNotFound, err, "No terms of service published", nil)
+		case errors.Is(err, commands.ErrTOSVersionOutdated):
+			httperr.AbortWithError(c, http.StatusConflict, err, "Terms of service version is not the current one", nil)
+		default:
+			slog.Error("Failed to accept terms of service", "user_id", userID, "error", err.Error())
+			httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		}
+		return
+	}
+
+	slog.Info("Terms of service accepted", "user_id", userID, "version", result.Version)
+	render.JSON(c, http.StatusOK, resdto.FromTOSAcceptResult(result))
+}
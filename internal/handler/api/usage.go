@@ -0,0 +1,74 @@
+package api
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidUsageCompanyID = errs.NewCoded("INVALID_ID_FORMAT", "invalid company ID format")
+	ErrInvalidUsageMonth     = errs.NewCoded("INVALID_USAGE_MONTH", "month must be formatted as YYYY-MM")
+)
+
+type UsageHandler struct {
+	usageQueries queries.UsageQueries
+}
+
+func NewUsageHandler(usageQueries queries.UsageQueries) *UsageHandler {
+	return &UsageHandler{
+		usageQueries: usageQueries,
+	}
+}
+
+// @Summary API usage report
+// @Description Metered API requests and bytes per company for a month, busiest first, with each company's quotas. Usage is written in batches, so the current month lags live traffic slightly (usage:read)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param month query string false "Month as YYYY-MM (default: current month, UTC)"
+// @Param company query string false "Only this company ID"
+// @Success 200 {object} response.UsageReportResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/usage [get]
+func (h *UsageHandler) Report(c *gin.Context) {
+	var month *time.Time
+	if v := c.Query("month"); v != "" {
+		parsed, err := time.Parse("2006-01", v)
+		if err != nil {
+			httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidUsageMonth, "Invalid month", nil)
+			return
+		}
+		month = &parsed
+	}
+
+	var companyID *uuid.UUID
+	if v := c.Query("company"); v != "" {
+		parsed, err := uuid.Parse(v)
+		if err != nil {
+			httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidUsageCompanyID, "Invalid company ID format", nil)
+			return
+		}
+		companyID = &parsed
+	}
+
+	report, err := h.usageQueries.Report(c.Request.Context(), month, companyID)
+	if err != nil {
+		slog.Error("Failed to build usage report", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, resdto.FromUsageReport(report))
+}
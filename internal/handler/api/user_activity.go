@@ -0,0 +1,77 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"slices"
+
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/handler/render"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/queries"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/gin-gonic/gin"
+)
+
+var ErrInvalidActivityKind = errs.NewCoded("INVALID_ACTIVITY_KIND", "unknown activity kind")
+
+// UserActivityHandler serves the caller's own timeline; the admin lists of what the
+// system did are ActivityHandler's.
+type UserActivityHandler struct {
+	activityQueries queries.ActivityQueries
+}
+
+func NewUserActivityHandler(activityQueries queries.ActivityQueries) *UserActivityHandler {
+	return &UserActivityHandler{
+		activityQueries: activityQueries,
+	}
+}
+
+// @Summary List my activity
+// @Description The caller's reservations made and canceled, reviews posted, referral credits and loyalty points earned, and notifications others' actions sent them (approval decisions, transfers, operator messages), newest first in one timeline
+// @Tags users
+// @Produce json
+// @Security BearerAuth
+// @Param kind query string false "Only entries of this kind" Enums(reservation_created, reservation_canceled, review_posted, credit_earned, points_earned, notification)
+// @Param after query string false "Pagination cursor"
+// @Param limit query int false "Page size"
+// @Success 200 {object} response.ActivityListResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /users/me/activity [get]
+func (h *UserActivityHandler) ListMine(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError, ErrMissingUserContext, "Internal server error", nil)
+		return
+	}
+
+	var kind *string
+	if k := c.Query("kind"); k != "" {
+		if !slices.Contains(shared.ActivityKinds, shared.ActivityKind(k)) {
+			httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidActivityKind, "Invalid activity kind", nil)
+			return
+		}
+		kind = &k
+	}
+
+	limit, cursor := parseListParams(c)
+	items, next, err := h.activityQueries.ListMine(c.Request.Context(), userID, kind, cursor, limit)
+	if err != nil {
+		if errors.Is(err, queries.ErrInvalidCursor) {
+			httperr.AbortWithError(c, http.StatusBadRequest, err, "Invalid cursor", nil)
+			return
+		}
+		slog.Error("Failed to list activity", "user_id", userID, "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		return
+	}
+
+	render.JSON(c, http.StatusOK, resdto.NewActivityPage(items, next))
+}
@@ -0,0 +1,106 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/commands"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidUserMergeID     = errs.NewCoded("INVALID_ID_FORMAT", "invalid user or into ID format")
+	ErrInvalidUserMergeDryRun = errs.NewCoded("INVALID_DRY_RUN_FLAG", "dryRun must be true or false")
+)
+
+type UserMergeHandler struct {
+	userMergeCommands commands.UserMergeCommands
+}
+
+func NewUserMergeHandler(userMergeCommands commands.UserMergeCommands) *UserMergeHandler {
+	return &UserMergeHandler{
+		userMergeCommands: userMergeCommands,
+	}
+}
+
+// @Summary Merge duplicate account
+// @Description Move the account's reservations, reviews, referral credits, loyalty points, activity timeline, OIDC and SAML identities and messages to the account given by into, re-point its reservation transfers (dropping those between the two accounts), sign it out of every session and deactivate it. Both accounts must belong to the same company and into must be active. With dryRun the counts are reported and nothing changes. Recorded as a user.merged audit entry (users:merge)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID of the account to merge away"
+// @Param into query string true "ID of the account to keep"
+// @Param dryRun query bool false "Only report what would move"
+// @Success 200 {object} response.UserMergeResponse
+// @Failure 400 {object} httperr.Response
+// @Failure 401 {object} httperr.Response
+// @Failure 403 {object} httperr.Response
+// @Failure 404 {object} httperr.Response
+// @Failure 409 {object} httperr.Response
+// @Failure 500 {object} httperr.Response
+// @Router /admin/users/{id}/merge [post]
+func (h *UserMergeHandler) Merge(c *gin.Context) {
+	actorID, ok := middleware.GetUserID(c)
+	if !ok {
+		slog.Error("Failed to get user ID from context")
+		httperr.AbortWithError(c, http.StatusInternalServerError, ErrMissingUserContext, "Internal server error", nil)
+		return
+	}
+	sourceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidUserMergeID, "Invalid user ID format", nil)
+		return
+	}
+	targetID, err := uuid.Parse(c.Query("into"))
+	if err != nil {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidUserMergeID, "Invalid into ID format", nil)
+		return
+	}
+	dryRun := false
+	if v := c.Query("dryRun"); v != "" {
+		if dryRun, err = strconv.ParseBool(v); err != nil {
+			httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidUserMergeDryRun, "Invalid dryRun flag", nil)
+			return
+		}
+	}
+
+	result, err := h.userMergeCommands.Merge(c.Request.Context(), sourceID, targetID, actorID, dryRun)
+	if err != nil {
+		handleUserMergeError(c, err)
+		return
+	}
+
+	if !dryRun {
+		slog.Info("User merged", "actor_id", actorID, "user_id", sourceID, "into", targetID)
+	}
+	c.JSON(http.StatusOK, resdto.FromUserMergeResult(result))
+}
+
+var userMergeErrorRules = []createReservationErrorRule{
+	{commands.ErrUserMergeSameAccount, http.StatusBadRequest, "Cannot merge an account into itself", nil},
+	{commands.ErrUserMergeOwnAccount, http.StatusForbidden, "You cannot merge away your own account", nil},
+	{commands.ErrUserNotFound, http.StatusNotFound, "User not found", nil},
+	{commands.ErrUserMergeCompanyMismatch, http.StatusConflict, "Accounts belong to different companies", nil},
+	{commands.ErrUserMergeTargetInactive, http.StatusConflict, "Cannot merge into an inactive account", nil},
+}
+
+func handleUserMergeError(c *gin.Context, err error) {
+	for _, rule := range userMergeErrorRules {
+		if errors.Is(err, rule.err) {
+			slog.Warn("User merge error", "error", err.Error(), "status", rule.status)
+			httperr.AbortWithError(c, rule.status, err, rule.message, rule.extra)
+			return
+		}
+	}
+
+	slog.Error("Unexpected error in user merge", "error", err.Error())
+	httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+}
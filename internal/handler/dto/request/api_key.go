@@ -0,0 +1,12 @@
+package request
+
+import "time"
+
+type IssueAPIKeyRequest struct {
+	// Name tells keys apart in listings, e.g. the client that uses it.
+	Name string `json:"name" binding:"required,max=100"`
+	// Scopes are permission names; the key may only use permissions its user's role also grants.
+	Scopes []string `json:"scopes" binding:"required,min=1,dive,required,max=100"`
+	// ExpiresAt is omitted for a key that never expires.
+	ExpiresAt *time.Time `json:"expiresAt"`
+}
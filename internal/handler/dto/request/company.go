@@ -0,0 +1,28 @@
+package request
+
+type RegisterCompanyRequest struct {
+	CompanyName   string `json:"companyName" binding:"required,max=100"`
+	AdminEmail    string `json:"adminEmail" binding:"required,email,max=254"`
+	AdminPassword string `json:"adminPassword" binding:"required,min=8"`
+	// Timezone is an IANA name; the server default applies when omitted.
+	Timezone string `json:"timezone" binding:"omitempty,max=64"`
+	// ReferralCode attributes the new admin to the referring user.
+	ReferralCode *string `json:"referralCode,omitempty" binding:"omitempty,max=32"`
+}
+
+// SetReviewWindowRequest replaces a company's review window. A null field falls back to the
+// server default (REVIEW_WINDOW_OPENS_AFTER, REVIEW_WINDOW_DAYS).
+type SetReviewWindowRequest struct {
+	OpensAfterMinutes *int32 `json:"opensAfterMinutes" binding:"omitempty,min=0,max=525600"`
+	WindowDays        *int32 `json:"windowDays" binding:"omitempty,min=1,max=3650"`
+}
+
+// SetBrandingRequest replaces a company's email branding. Omitted fields use the product
+// defaults.
+type SetBrandingRequest struct {
+	LogoURL      string `json:"logoUrl" binding:"omitempty,url,max=2048"`
+	PrimaryColor string `json:"primaryColor" binding:"omitempty,hexcolor,len=4|len=7"`
+	AccentColor  string `json:"accentColor" binding:"omitempty,hexcolor,len=4|len=7"`
+	ReplyTo      string `json:"replyTo" binding:"omitempty,email,max=254"`
+	Footer       string `json:"footer" binding:"omitempty,max=500"`
+}
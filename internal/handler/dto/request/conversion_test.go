@@ -0,0 +1,146 @@
+//go:build unit
+
+package request_test
+
+import (
+	"strings"
+	"testing"
+	"testing/quick"
+	"time"
+	"unicode/utf8"
+
+	"gin-clean-starter/internal/domain/reservation"
+	"gin-clean-starter/internal/domain/review"
+	"gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// Whatever passes binding, ToDomain either fails or returns values that satisfy every
+// domain invariant; it never builds an invalid aggregate.
+
+var propertyConfig = &quick.Config{MaxCount: 2000}
+
+var propertyBase = time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+
+// timeFrom maps a generated number to a time around propertyBase, or the zero time that a
+// missing JSON field binds to.
+func timeFrom(n int32, unset bool) time.Time {
+	if unset {
+		return time.Time{}
+	}
+	return propertyBase.Add(time.Duration(n) * time.Minute)
+}
+
+func TestCreateReservationRequest_ToDomain_Properties(t *testing.T) {
+	prop := func(start, end int32, startUnset bool, note string, hasNote bool, repeat uint8) bool {
+		req := request.CreateReservationRequest{
+			ResourceID: uuid.New(),
+			StartTime:  timeFrom(start, startUnset),
+			EndTime:    timeFrom(end, false),
+		}
+		if hasNote {
+			note = strings.Repeat(note, int(repeat)+1)
+			req.Note = &note
+		}
+
+		got, err := req.ToDomain()
+		if err != nil {
+			return got == nil
+		}
+		_, slotErr := reservation.NewTimeSlot(got.TimeSlot.Start(), got.TimeSlot.End())
+		_, noteErr := reservation.NewNote(got.Note.String())
+		return slotErr == nil && noteErr == nil &&
+			!got.TimeSlot.Start().IsZero() &&
+			utf8.RuneCountInString(got.Note.String()) <= reservation.MaxNoteLength
+	}
+	assert.NoError(t, quick.Check(prop, propertyConfig))
+}
+
+func TestCreateReservationGroupRequest_ToDomain_Properties(t *testing.T) {
+	prop := func(starts, lengths []int16) bool {
+		var req request.CreateReservationGroupRequest
+		for i := range min(len(starts), len(lengths)) {
+			start := timeFrom(int32(starts[i]), false)
+			req.Items = append(req.Items, request.ReservationGroupItemRequest{
+				ResourceID: uuid.New(),
+				StartTime:  start,
+				EndTime:    start.Add(time.Duration(lengths[i]) * time.Minute),
+			})
+		}
+
+		slots, err := req.ToDomain()
+		if err != nil {
+			return slots == nil
+		}
+		if len(slots) != len(req.Items) {
+			return false
+		}
+		for i, slot := range slots {
+			if slot.Duration() <= 0 || !slot.Start().Equal(req.Items[i].StartTime) {
+				return false
+			}
+		}
+		return true
+	}
+	assert.NoError(t, quick.Check(prop, propertyConfig))
+}
+
+func TestReviewRequests_ToDomain_Properties(t *testing.T) {
+	now := propertyBase
+
+	t.Run("create", func(t *testing.T) {
+		prop := func(rating int8, comment string) bool {
+			req := request.CreateReviewRequest{
+				ResourceID:    uuid.New(),
+				ReservationID: uuid.New(),
+				Rating:        int(rating),
+				Comment:       comment,
+			}
+			r, err := req.ToDomain(uuid.New(), now)
+			if err != nil {
+				return r == nil
+			}
+			return validReview(r) && r.ResourceID() == req.ResourceID && r.ReservationID() == req.ReservationID
+		}
+		assert.NoError(t, quick.Check(prop, propertyConfig))
+	})
+
+	t.Run("update keeps unset fields and validates the merged review", func(t *testing.T) {
+		prop := func(rating int8, comment string, setRating, setComment bool) bool {
+			existing := &shared.ReviewSnapshot{
+				ID:            uuid.New(),
+				UserID:        uuid.New(),
+				ResourceID:    uuid.New(),
+				ReservationID: uuid.New(),
+				Rating:        4,
+				Comment:       "Good",
+			}
+			var req request.UpdateReviewRequest
+			wantRating, wantComment := existing.Rating, existing.Comment
+			if setRating {
+				v := int(rating)
+				req.Rating, wantRating = &v, v
+			}
+			if setComment {
+				req.Comment, wantComment = &comment, strings.TrimSpace(comment)
+			}
+
+			r, err := req.ToDomain(existing, now)
+			if err != nil {
+				return r == nil
+			}
+			return validReview(r) && r.ID() == existing.ID &&
+				r.Rating().Value() == wantRating && r.Comment().String() == wantComment
+		}
+		assert.NoError(t, quick.Check(prop, propertyConfig))
+	})
+}
+
+func validReview(r *review.Review) bool {
+	_, ratingErr := review.NewRating(r.Rating().Value())
+	_, commentErr := review.NewComment(r.Comment().String())
+	return ratingErr == nil && commentErr == nil
+}
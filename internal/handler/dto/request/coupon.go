@@ -0,0 +1,18 @@
+package request
+
+import "strings"
+
+func mergeCouponCodes(single *string, list []string) []string {
+	var codes []string
+	if single != nil {
+		if trimmed := strings.TrimSpace(*single); trimmed != "" {
+			codes = append(codes, trimmed)
+		}
+	}
+	for _, code := range list {
+		if trimmed := strings.TrimSpace(code); trimmed != "" {
+			codes = append(codes, trimmed)
+		}
+	}
+	return codes
+}
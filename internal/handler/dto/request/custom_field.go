@@ -0,0 +1,14 @@
+package request
+
+import "github.com/google/uuid"
+
+// CreateCustomFieldRequest defines a field collected on the company's reservations, or only
+// on ResourceID's when set. Type is text, number or select; Options are a select's choices.
+type CreateCustomFieldRequest struct {
+	Key        string     `json:"key" binding:"required,max=40"`
+	Label      string     `json:"label" binding:"required,max=100"`
+	Type       string     `json:"type" binding:"required,oneof=text number select"`
+	Required   bool       `json:"required"`
+	Options    []string   `json:"options" binding:"omitempty,max=50,dive,max=100"`
+	ResourceID *uuid.UUID `json:"resourceId,omitempty"`
+}
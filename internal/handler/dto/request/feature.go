@@ -0,0 +1,5 @@
+package request
+
+type SetFeatureRequest struct {
+	Enabled *bool `json:"enabled" binding:"required"`
+}
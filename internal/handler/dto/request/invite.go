@@ -0,0 +1,16 @@
+package request
+
+type CreateInviteRequest struct {
+	Email string `json:"email" binding:"required,email,max=254"`
+	// Role defaults to viewer when omitted.
+	Role string `json:"role" binding:"omitempty,max=50"`
+}
+
+type AcceptInviteRequest struct {
+	Token    string `json:"token" binding:"required"`
+	Password string `json:"password" binding:"required,min=8"`
+	// ReferralCode attributes the new member to the referring user.
+	ReferralCode *string `json:"referralCode,omitempty" binding:"omitempty,max=32"`
+	// Phone is stored encrypted and requires CRYPTO_KEYS; E.164, e.g. +81312345678.
+	Phone *string `json:"phone,omitempty" binding:"omitempty,e164"`
+}
@@ -0,0 +1,6 @@
+package request
+
+type UpdateNotificationPreferencesRequest struct {
+	// Digest is off (each email as it happens), daily or weekly.
+	Digest string `json:"digest" binding:"required"`
+}
@@ -0,0 +1,8 @@
+package request
+
+func redeemPoints(points *int32) int64 {
+	if points == nil || *points < 0 {
+		return 0
+	}
+	return int64(*points)
+}
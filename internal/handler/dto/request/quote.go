@@ -0,0 +1,33 @@
+package request
+
+import (
+	"time"
+
+	"gin-clean-starter/internal/domain/reservation"
+
+	"github.com/google/uuid"
+)
+
+type CreateQuoteRequest struct {
+	ResourceID uuid.UUID `json:"resourceId" binding:"required"`
+	StartTime  time.Time `json:"startTime" binding:"required"`
+	EndTime    time.Time `json:"endTime" binding:"required"`
+	// CouponCode is the single-coupon form kept for existing clients; it is merged with CouponCodes.
+	CouponCode  *string  `json:"couponCode,omitempty"`
+	CouponCodes []string `json:"couponCodes,omitempty" binding:"omitempty,max=5"`
+	// RedeemPoints spends up to this many loyalty points as a discount; see LOYALTY_MAX_REDEMPTION_BPS.
+	RedeemPoints *int32 `json:"redeemPoints,omitempty" binding:"omitempty,min=1"`
+}
+
+// GetCouponCodes returns the trimmed, non-empty codes of CouponCode and CouponCodes.
+func (r CreateQuoteRequest) GetCouponCodes() []string {
+	return mergeCouponCodes(r.CouponCode, r.CouponCodes)
+}
+
+func (r CreateQuoteRequest) GetRedeemPoints() int64 {
+	return redeemPoints(r.RedeemPoints)
+}
+
+func (r CreateQuoteRequest) ToDomain() (reservation.TimeSlot, error) {
+	return reservation.NewTimeSlot(r.StartTime, r.EndTime)
+}
@@ -0,0 +1,9 @@
+package request
+
+type EnableRequestRecordingRequest struct {
+	// MaxRequests is how many of the user's next requests are captured.
+	MaxRequests int32  `json:"maxRequests" binding:"required,min=1"`
+	Reason      string `json:"reason" binding:"required,max=500"`
+	// ConsentReference points at where the user agreed to be recorded, e.g. a ticket.
+	ConsentReference string `json:"consentReference" binding:"required,max=500"`
+}
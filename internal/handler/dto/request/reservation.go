@@ -13,15 +13,31 @@ type CreateReservationRequest struct {
 	ResourceID uuid.UUID `json:"resourceId" binding:"required"`
 	StartTime  time.Time `json:"startTime" binding:"required"`
 	EndTime    time.Time `json:"endTime" binding:"required"`
-	CouponCode *string   `json:"couponCode,omitempty"`
-	Note       *string   `json:"note,omitempty"`
+	// CouponCode is the single-coupon form kept for existing clients; it is merged with CouponCodes.
+	CouponCode  *string  `json:"couponCode,omitempty"`
+	CouponCodes []string `json:"couponCodes,omitempty" binding:"omitempty,max=5"`
+	Note        *string  `json:"note,omitempty"`
+	QuoteID     *string  `json:"quoteId,omitempty"`
+	// RedeemPoints spends up to this many loyalty points as a discount; see LOYALTY_MAX_REDEMPTION_BPS.
+	RedeemPoints *int32 `json:"redeemPoints,omitempty" binding:"omitempty,min=1"`
+	// CustomFields holds values for the custom fields of the resource's company, by key.
+	CustomFields map[string]any `json:"customFields,omitempty" binding:"omitempty,max=50"`
 }
 
-func (r CreateReservationRequest) GetCouponCode() *string {
-	if r.CouponCode == nil {
+// GetCouponCodes returns the trimmed, non-empty codes of CouponCode and CouponCodes.
+func (r CreateReservationRequest) GetCouponCodes() []string {
+	return mergeCouponCodes(r.CouponCode, r.CouponCodes)
+}
+
+func (r CreateReservationRequest) GetRedeemPoints() int64 {
+	return redeemPoints(r.RedeemPoints)
+}
+
+func (r CreateReservationRequest) GetQuoteID() *string {
+	if r.QuoteID == nil {
 		return nil
 	}
-	trimmed := strings.TrimSpace(*r.CouponCode)
+	trimmed := strings.TrimSpace(*r.QuoteID)
 	if trimmed == "" {
 		return nil
 	}
@@ -57,3 +73,30 @@ func (r CreateReservationRequest) ToDomain() (*DomainConversion, error) {
 		Note:     note,
 	}, nil
 }
+
+// CreateReservationGroupRequest books every item or none of them (e.g. a room and a
+// projector for the same meeting). Items are priced without coupons, quotes or points.
+type CreateReservationGroupRequest struct {
+	Items []ReservationGroupItemRequest `json:"items" binding:"required,min=2,max=10,dive"`
+}
+
+type ReservationGroupItemRequest struct {
+	ResourceID uuid.UUID `json:"resourceId" binding:"required"`
+	StartTime  time.Time `json:"startTime" binding:"required"`
+	EndTime    time.Time `json:"endTime" binding:"required"`
+	// CustomFields holds values for the custom fields of the item's resource, by key.
+	CustomFields map[string]any `json:"customFields,omitempty" binding:"omitempty,max=50"`
+}
+
+// ToDomain converts each item's times, in request order.
+func (r CreateReservationGroupRequest) ToDomain() ([]reservation.TimeSlot, error) {
+	slots := make([]reservation.TimeSlot, len(r.Items))
+	for i, item := range r.Items {
+		slot, err := reservation.NewTimeSlot(item.StartTime, item.EndTime)
+		if err != nil {
+			return nil, err
+		}
+		slots[i] = slot
+	}
+	return slots, nil
+}
@@ -0,0 +1,6 @@
+package request
+
+// RejectReservationRequest carries the reason passed on to the reservation's user.
+type RejectReservationRequest struct {
+	Reason string `json:"reason" binding:"omitempty,max=500"`
+}
@@ -0,0 +1,12 @@
+package request
+
+import "time"
+
+type CancelReservationRangeRequest struct {
+	StartTime time.Time `json:"startTime" binding:"required"`
+	EndTime   time.Time `json:"endTime" binding:"required"`
+	// Reason is included in the cancellation emails, e.g. "Facility closed for repairs".
+	Reason string `json:"reason" binding:"required,max=200"`
+	// DryRun lists the reservations that would be canceled without canceling them.
+	DryRun bool `json:"dryRun"`
+}
@@ -0,0 +1,5 @@
+package request
+
+type PostReservationMessageRequest struct {
+	Body string `json:"body" binding:"required,max=1000"`
+}
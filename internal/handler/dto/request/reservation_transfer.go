@@ -0,0 +1,10 @@
+package request
+
+type TransferReservationRequest struct {
+	// Email of the recipient, who must already have an account.
+	Email string `json:"email" binding:"required,email,max=254"`
+}
+
+type AcceptReservationTransferRequest struct {
+	Token string `json:"token" binding:"required"`
+}
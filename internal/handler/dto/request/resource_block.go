@@ -0,0 +1,17 @@
+package request
+
+import "time"
+
+type CreateResourceBlockRequest struct {
+	StartTime time.Time `json:"startTime" binding:"required"`
+	EndTime   time.Time `json:"endTime" binding:"required"`
+	// Reason is shown to operators only, e.g. "Maintenance" or "Private event".
+	Reason     string                  `json:"reason" binding:"required,max=200"`
+	Recurrence *BlockRecurrenceRequest `json:"recurrence,omitempty"`
+}
+
+// BlockRecurrenceRequest repeats the block; Count includes the first occurrence.
+type BlockRecurrenceRequest struct {
+	Frequency string `json:"frequency" binding:"required,oneof=daily weekly"`
+	Count     int    `json:"count" binding:"required,min=2,max=52"`
+}
@@ -22,6 +22,28 @@ type UpdateReviewRequest struct {
 	Comment *string `json:"comment" binding:"omitempty,max=1000"`
 }
 
+// ImportReviewsRequest carries reviews over from a legacy system named by Source. Reviews
+// are checked one by one when imported, so a bad one does not reject the others.
+type ImportReviewsRequest struct {
+	Source  string                    `json:"source" binding:"required,max=100"`
+	Reviews []ImportReviewItemRequest `json:"reviews" binding:"required,min=1,max=500"`
+}
+
+// ImportReviewItemRequest is one historical review. ExternalID is its ID in the source,
+// which a second import of the same review is recognised by. The author is the member of
+// the resource's company with AuthorExternalID as SCIM external ID, else the user with
+// AuthorEmail. A missing UpdatedAt means the review was never edited.
+type ImportReviewItemRequest struct {
+	ExternalID       string     `json:"externalId"`
+	ResourceID       uuid.UUID  `json:"resourceId"`
+	AuthorExternalID string     `json:"authorExternalId,omitempty"`
+	AuthorEmail      string     `json:"authorEmail,omitempty"`
+	Rating           int        `json:"rating"`
+	Comment          string     `json:"comment"`
+	CreatedAt        time.Time  `json:"createdAt"`
+	UpdatedAt        *time.Time `json:"updatedAt,omitempty"`
+}
+
 func (r *CreateReviewRequest) ToDomain(userID uuid.UUID, now time.Time) (*domreview.Review, error) {
 	return domreview.NewReview(uuid.Nil, userID, r.ResourceID, r.ReservationID, r.Rating, r.Comment, now)
 }
@@ -32,3 +54,11 @@ func (r *UpdateReviewRequest) ToDomain(existing *shared.ReviewSnapshot, now time
 
 	return domreview.NewReview(existing.ID, existing.UserID, existing.ResourceID, existing.ReservationID, rating, comment, now)
 }
+
+func (r ImportReviewItemRequest) ToDomain(userID uuid.UUID, now time.Time) (*domreview.Review, error) {
+	var updatedAt time.Time
+	if r.UpdatedAt != nil {
+		updatedAt = *r.UpdatedAt
+	}
+	return domreview.NewImportedReview(userID, r.ResourceID, r.Rating, r.Comment, r.CreatedAt, updatedAt, now)
+}
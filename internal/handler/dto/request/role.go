@@ -0,0 +1,15 @@
+package request
+
+type CreateRoleRequest struct {
+	Name        string   `json:"name" binding:"required,max=50"`
+	Description string   `json:"description" binding:"max=200"`
+	Permissions []string `json:"permissions" binding:"omitempty,dive,required,max=100"`
+}
+
+type UpdateRolePermissionsRequest struct {
+	Permissions []string `json:"permissions" binding:"omitempty,dive,required,max=100"`
+}
+
+type ChangeUserRoleRequest struct {
+	Role string `json:"role" binding:"required,max=50"`
+}
@@ -0,0 +1,11 @@
+package request
+
+// SetSAMLConnectionRequest trusts the company's identity provider. RoleMapping maps values
+// of RoleAttribute to roles; users matching none get DefaultRole (viewer when omitted).
+type SetSAMLConnectionRequest struct {
+	MetadataXML    string            `json:"metadataXml" binding:"required,max=262144"`
+	EmailAttribute string            `json:"emailAttribute" binding:"omitempty,max=255"`
+	RoleAttribute  string            `json:"roleAttribute" binding:"omitempty,max=255"`
+	RoleMapping    map[string]string `json:"roleMapping" binding:"omitempty,max=100,dive,keys,min=1,max=255,endkeys,min=1,max=50"`
+	DefaultRole    string            `json:"defaultRole" binding:"omitempty,max=50"`
+}
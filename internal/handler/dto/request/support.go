@@ -0,0 +1,7 @@
+package request
+
+type StartSupportSessionRequest struct {
+	CompanyID string `json:"companyId" binding:"required,uuid"`
+	// Reason is kept in the audit trail, e.g. a ticket reference.
+	Reason string `json:"reason" binding:"required,max=500"`
+}
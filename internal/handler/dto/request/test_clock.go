@@ -0,0 +1,8 @@
+//go:build e2e
+
+package request
+
+type SetTestClockRequest struct {
+	// Offset from real time as a Go duration, e.g. "72h"; "0s" restores real time.
+	Offset string `json:"offset" binding:"required"`
+}
@@ -0,0 +1,6 @@
+package request
+
+type AcceptTOSRequest struct {
+	// Version is the terms-of-service version the user was shown.
+	Version string `json:"version" binding:"required,max=64"`
+}
@@ -0,0 +1,82 @@
+package response
+
+import (
+	"encoding/json"
+	"time"
+
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/google/uuid"
+)
+
+type AuditLogListResponse struct {
+	Entries    []AuditLogResponse `json:"entries"`
+	NextCursor string             `json:"nextCursor,omitempty"`
+}
+
+type AuditLogResponse struct {
+	ID         uuid.UUID       `json:"id" validate:"required"`
+	ActorID    *uuid.UUID      `json:"actorId,omitempty"`
+	CompanyID  *uuid.UUID      `json:"companyId,omitempty"`
+	Action     string          `json:"action" validate:"required"`
+	TargetType string          `json:"targetType,omitempty"`
+	TargetID   string          `json:"targetId,omitempty"`
+	Metadata   json.RawMessage `json:"metadata" swaggertype:"object"`
+	CreatedAt  time.Time       `json:"createdAt" validate:"required"`
+}
+
+type NotificationJobListResponse struct {
+	Jobs       []NotificationJobResponse `json:"jobs"`
+	NextCursor string                    `json:"nextCursor,omitempty"`
+}
+
+type NotificationJobResponse struct {
+	ID        uuid.UUID `json:"id" validate:"required"`
+	Kind      string    `json:"kind" validate:"required"`   // email or webhook
+	Topic     string    `json:"topic" validate:"required"`  // e.g. reservation_created
+	Status    string    `json:"status" validate:"required"` // queued, done or error
+	Attempts  int32     `json:"attempts"`
+	LastError string    `json:"lastError,omitempty"`
+	RunAt     time.Time `json:"runAt" validate:"required"`
+	CreatedAt time.Time `json:"createdAt" validate:"required"`
+}
+
+func NewAuditLogPage(entries []*queries.AuditLogEntry, next *queries.Cursor) AuditLogListResponse {
+	page := AuditLogListResponse{Entries: make([]AuditLogResponse, len(entries))}
+	for i, e := range entries {
+		page.Entries[i] = AuditLogResponse{
+			ID:         e.ID,
+			ActorID:    e.ActorID,
+			CompanyID:  e.CompanyID,
+			Action:     e.Action,
+			TargetType: e.TargetType,
+			TargetID:   e.TargetID,
+			Metadata:   e.Metadata,
+			CreatedAt:  e.CreatedAt,
+		}
+	}
+	if next != nil {
+		page.NextCursor = next.After
+	}
+	return page
+}
+
+func NewNotificationJobPage(jobs []*queries.NotificationJob, next *queries.Cursor) NotificationJobListResponse {
+	page := NotificationJobListResponse{Jobs: make([]NotificationJobResponse, len(jobs))}
+	for i, j := range jobs {
+		page.Jobs[i] = NotificationJobResponse{
+			ID:        j.ID,
+			Kind:      j.Kind,
+			Topic:     j.Topic,
+			Status:    j.Status,
+			Attempts:  j.Attempts,
+			LastError: j.LastError,
+			RunAt:     j.RunAt,
+			CreatedAt: j.CreatedAt,
+		}
+	}
+	if next != nil {
+		page.NextCursor = next.After
+	}
+	return page
+}
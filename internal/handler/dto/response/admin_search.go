@@ -0,0 +1,95 @@
+package response
+
+import (
+	"time"
+
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/google/uuid"
+)
+
+// AdminSearchResponse groups the hits by type, each closest match first.
+type AdminSearchResponse struct {
+	Users        []SearchUserResponse        `json:"users"`
+	Reservations []SearchReservationResponse `json:"reservations"`
+	Resources    []SearchResourceResponse    `json:"resources"`
+	Reviews      []SearchReviewResponse      `json:"reviews"`
+}
+
+type SearchUserResponse struct {
+	ID        uuid.UUID  `json:"id" validate:"required"`
+	Email     string     `json:"email" validate:"required"`
+	Role      string     `json:"role" validate:"required"`
+	CompanyID *uuid.UUID `json:"companyId,omitempty"`
+	IsActive  bool       `json:"isActive"`
+}
+
+type SearchReservationResponse struct {
+	ID         uuid.UUID `json:"id" validate:"required"`
+	UserID     uuid.UUID `json:"userId" validate:"required"`
+	ResourceID uuid.UUID `json:"resourceId" validate:"required"`
+	Status     string    `json:"status" validate:"required"`
+	StartTime  time.Time `json:"startTime" validate:"required"`
+	EndTime    time.Time `json:"endTime" validate:"required"`
+}
+
+type SearchResourceResponse struct {
+	ID        uuid.UUID  `json:"id" validate:"required"`
+	Name      string     `json:"name" validate:"required"`
+	CompanyID *uuid.UUID `json:"companyId,omitempty"`
+}
+
+type SearchReviewResponse struct {
+	ID         uuid.UUID `json:"id" validate:"required"`
+	UserID     uuid.UUID `json:"userId" validate:"required"`
+	ResourceID uuid.UUID `json:"resourceId" validate:"required"`
+	Rating     int       `json:"rating" validate:"required"`
+	Comment    string    `json:"comment" validate:"required"`
+	Status     string    `json:"status" validate:"required" enums:"published,flagged"`
+}
+
+func NewAdminSearchResponse(results *queries.SearchResults) AdminSearchResponse {
+	resp := AdminSearchResponse{
+		Users:        make([]SearchUserResponse, len(results.Users)),
+		Reservations: make([]SearchReservationResponse, len(results.Reservations)),
+		Resources:    make([]SearchResourceResponse, len(results.Resources)),
+		Reviews:      make([]SearchReviewResponse, len(results.Reviews)),
+	}
+	for i, u := range results.Users {
+		resp.Users[i] = SearchUserResponse{
+			ID:        u.ID,
+			Email:     u.Email,
+			Role:      u.Role,
+			CompanyID: u.CompanyID,
+			IsActive:  u.IsActive,
+		}
+	}
+	for i, r := range results.Reservations {
+		resp.Reservations[i] = SearchReservationResponse{
+			ID:         r.ID,
+			UserID:     r.UserID,
+			ResourceID: r.ResourceID,
+			Status:     r.Status,
+			StartTime:  r.StartTime,
+			EndTime:    r.EndTime,
+		}
+	}
+	for i, r := range results.Resources {
+		resp.Resources[i] = SearchResourceResponse{
+			ID:        r.ID,
+			Name:      r.Name,
+			CompanyID: r.CompanyID,
+		}
+	}
+	for i, r := range results.Reviews {
+		resp.Reviews[i] = SearchReviewResponse{
+			ID:         r.ID,
+			UserID:     r.UserID,
+			ResourceID: r.ResourceID,
+			Rating:     r.Rating,
+			Comment:    r.Comment,
+			Status:     r.Status,
+		}
+	}
+	return resp
+}
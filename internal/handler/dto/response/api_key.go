@@ -0,0 +1,50 @@
+package response
+
+import (
+	"time"
+
+	"gin-clean-starter/internal/usecase/commands"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/google/uuid"
+)
+
+// IssuedAPIKeyResponse carries the key the machine client sends as X-API-Key. It is only
+// stored hashed, so this is the one time it is shown.
+type IssuedAPIKeyResponse struct {
+	ID  uuid.UUID `json:"id" validate:"required"`
+	Key string    `json:"key" validate:"required"`
+}
+
+func FromIssuedAPIKey(k *commands.IssuedAPIKey) IssuedAPIKeyResponse {
+	return IssuedAPIKeyResponse{
+		ID:  k.ID,
+		Key: k.Key,
+	}
+}
+
+// APIKeyResponse is an issued key without its secret. expiresAt is null for keys that do
+// not expire, revokedAt for keys still in use.
+type APIKeyResponse struct {
+	ID        uuid.UUID  `json:"id" validate:"required"`
+	Name      string     `json:"name" validate:"required"`
+	Scopes    []string   `json:"scopes" validate:"required"`
+	CreatedAt time.Time  `json:"createdAt" validate:"required"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+	RevokedAt *time.Time `json:"revokedAt"`
+}
+
+func FromAPIKeyViews(vs []*queries.APIKeyView) []APIKeyResponse {
+	out := make([]APIKeyResponse, len(vs))
+	for i, v := range vs {
+		out[i] = APIKeyResponse{
+			ID:        v.ID,
+			Name:      v.Name,
+			Scopes:    v.Scopes,
+			CreatedAt: v.CreatedAt,
+			ExpiresAt: v.ExpiresAt,
+			RevokedAt: v.RevokedAt,
+		}
+	}
+	return out
+}
@@ -1,7 +1,78 @@
 package response
 
-import "gin-clean-starter/internal/usecase/queries"
+import (
+	"time"
+
+	"gin-clean-starter/internal/usecase/commands"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/google/uuid"
+)
 
 type LoginResponse struct {
 	User *queries.AuthorizedUserView `json:"user"`
 }
+
+// TokenResponse carries tokens in the body for clients that cannot use cookies.
+type TokenResponse struct {
+	AccessToken      string                      `json:"accessToken" validate:"required"`
+	RefreshToken     string                      `json:"refreshToken" validate:"required"`
+	TokenType        string                      `json:"tokenType" validate:"required"`
+	ExpiresIn        int64                       `json:"expiresIn" validate:"required"`
+	RefreshExpiresIn int64                       `json:"refreshExpiresIn" validate:"required"`
+	User             *queries.AuthorizedUserView `json:"user,omitempty"`
+}
+
+// CSRFTokenResponse carries the token to echo in the X-CSRF-Token header.
+type CSRFTokenResponse struct {
+	CSRFToken string    `json:"csrfToken" validate:"required"`
+	ExpiresAt time.Time `json:"expiresAt" validate:"required"`
+}
+
+// RegisterResponse describes the inactive account; ExpiresAt is when the mailed
+// verification link stops working.
+type RegisterResponse struct {
+	UserID    uuid.UUID `json:"userId" validate:"required"`
+	Email     string    `json:"email" validate:"required"`
+	ExpiresAt time.Time `json:"expiresAt" validate:"required"`
+}
+
+func FromRegisterResult(r *commands.RegisterResult) *RegisterResponse {
+	return &RegisterResponse{
+		UserID:    r.UserID,
+		Email:     r.Email,
+		ExpiresAt: r.ExpiresAt,
+	}
+}
+
+// MFAChallengeResponse answers a login of a user with two-factor authentication; the
+// token is redeemed with a code before ExpiresAt.
+type MFAChallengeResponse struct {
+	Status    string    `json:"status" validate:"required"` // mfa_pending
+	MFAToken  string    `json:"mfaToken" validate:"required"`
+	ExpiresAt time.Time `json:"expiresAt" validate:"required"`
+}
+
+func FromMFAChallenge(c *commands.MFAChallenge) *MFAChallengeResponse {
+	return &MFAChallengeResponse{
+		Status:    commands.MFAPendingTokenPurpose,
+		MFAToken:  c.Token,
+		ExpiresAt: c.ExpiresAt,
+	}
+}
+
+type MFAEnrollmentResponse struct {
+	Secret          string `json:"secret" validate:"required"`
+	ProvisioningURI string `json:"provisioningUri" validate:"required"`
+}
+
+func FromMFAEnrollment(e *commands.MFAEnrollment) *MFAEnrollmentResponse {
+	return &MFAEnrollmentResponse{
+		Secret:          e.Secret,
+		ProvisioningURI: e.ProvisioningURI,
+	}
+}
+
+type MFARecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recoveryCodes" validate:"required"`
+}
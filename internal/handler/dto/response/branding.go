@@ -0,0 +1,41 @@
+package response
+
+import (
+	"gin-clean-starter/internal/usecase/shared"
+)
+
+// BrandingResponse is the company's own branding; an empty field uses the product default.
+type BrandingResponse struct {
+	LogoURL      string `json:"logoUrl"`
+	PrimaryColor string `json:"primaryColor"`
+	AccentColor  string `json:"accentColor"`
+	ReplyTo      string `json:"replyTo"`
+	Footer       string `json:"footer"`
+}
+
+type BrandingPreviewResponse struct {
+	Subject string `json:"subject" validate:"required"`
+	// ReplyTo is empty when the company sets no reply-to address.
+	ReplyTo string `json:"replyTo"`
+	HTML    string `json:"html" validate:"required"`
+	Text    string `json:"text" validate:"required"`
+}
+
+func FromCompanyBranding(b shared.CompanyBranding) *BrandingResponse {
+	return &BrandingResponse{
+		LogoURL:      b.LogoURL,
+		PrimaryColor: b.PrimaryColor,
+		AccentColor:  b.AccentColor,
+		ReplyTo:      b.ReplyTo,
+		Footer:       b.Footer,
+	}
+}
+
+func FromRenderedEmail(e shared.RenderedEmail) *BrandingPreviewResponse {
+	return &BrandingPreviewResponse{
+		Subject: e.Subject,
+		ReplyTo: e.ReplyTo,
+		HTML:    e.HTML,
+		Text:    e.Text,
+	}
+}
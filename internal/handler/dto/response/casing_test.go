@@ -0,0 +1,51 @@
+//go:build unit
+
+package response_test
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var lowerCamel = regexp.MustCompile(`^[a-z][a-zA-Z0-9]*$`)
+
+// Every JSON key of a request or response DTO is lowerCamelCase; legacy snake_case names
+// are added by the response format middleware, never by a tag.
+func TestJSONTagsAreLowerCamelCase(t *testing.T) {
+	for _, dir := range []string{".", "../request"} {
+		files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+		require.NoError(t, err)
+
+		for _, path := range files {
+			if strings.HasSuffix(path, "_test.go") {
+				continue
+			}
+			file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+			require.NoError(t, err)
+
+			ast.Inspect(file, func(n ast.Node) bool {
+				field, ok := n.(*ast.Field)
+				if !ok || field.Tag == nil {
+					return true
+				}
+				raw, err := strconv.Unquote(field.Tag.Value)
+				require.NoError(t, err)
+				name, _, _ := strings.Cut(reflect.StructTag(raw).Get("json"), ",")
+				if name != "" && name != "-" {
+					assert.Regexp(t, lowerCamel, name, "%s: json key %q", path, name)
+				}
+				return true
+			})
+		}
+	}
+}
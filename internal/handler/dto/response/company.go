@@ -0,0 +1,27 @@
+package response
+
+import (
+	"gin-clean-starter/internal/usecase/commands"
+
+	"github.com/google/uuid"
+)
+
+type RegisterCompanyResponse struct {
+	CompanyID   uuid.UUID   `json:"companyId" validate:"required"`
+	CompanyName string      `json:"companyName" validate:"required"`
+	Timezone    string      `json:"timezone" validate:"required"`
+	AdminUserID uuid.UUID   `json:"adminUserId" validate:"required"`
+	AdminEmail  string      `json:"adminEmail" validate:"required"`
+	ResourceIDs []uuid.UUID `json:"resourceIds"`
+}
+
+func FromRegisterCompanyResult(r *commands.RegisterCompanyResult) *RegisterCompanyResponse {
+	return &RegisterCompanyResponse{
+		CompanyID:   r.CompanyID,
+		CompanyName: r.CompanyName,
+		Timezone:    r.Timezone,
+		AdminUserID: r.AdminUserID,
+		AdminEmail:  r.AdminEmail,
+		ResourceIDs: r.ResourceIDs,
+	}
+}
@@ -0,0 +1,61 @@
+package response
+
+import (
+	"encoding/json"
+	"time"
+
+	"gin-clean-starter/internal/usecase/queries"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+// CompanyDeletionResponse is a deletion's progress. counts totals what the purge did so
+// far, e.g. reservations_canceled or users_deleted; certificate is the record kept in
+// the audit trail once the company is gone.
+type CompanyDeletionResponse struct {
+	ID          uuid.UUID        `json:"id" validate:"required"`
+	CompanyID   uuid.UUID        `json:"companyId" validate:"required"`
+	CompanyName string           `json:"companyName" validate:"required"`
+	RequestedBy *uuid.UUID       `json:"requestedBy,omitempty"`
+	Status      string           `json:"status" validate:"required" enums:"pending,running,done"`
+	Stage       string           `json:"stage" validate:"required" enums:"cancel_reservations,anonymize_reviews,purge_storage,delete_users,delete_company,done"`
+	Counts      map[string]int64 `json:"counts" validate:"required"`
+	Attempts    int32            `json:"attempts"`
+	Error       *string          `json:"error,omitempty"`
+	Certificate json.RawMessage  `json:"certificate,omitempty" swaggertype:"object"`
+	CreatedAt   time.Time        `json:"createdAt" validate:"required"`
+	StartedAt   *time.Time       `json:"startedAt,omitempty"`
+	CompletedAt *time.Time       `json:"completedAt,omitempty"`
+}
+
+func FromCompanyDeletion(d *shared.CompanyDeletion) *CompanyDeletionResponse {
+	return &CompanyDeletionResponse{
+		ID:          d.ID,
+		CompanyID:   d.CompanyID,
+		CompanyName: d.CompanyName,
+		RequestedBy: d.RequestedBy,
+		Status:      "pending",
+		Stage:       string(d.Stage),
+		Counts:      d.Counts,
+		CreatedAt:   d.CreatedAt,
+	}
+}
+
+func FromCompanyDeletionDetail(d *queries.CompanyDeletionDetail) *CompanyDeletionResponse {
+	return &CompanyDeletionResponse{
+		ID:          d.ID,
+		CompanyID:   d.CompanyID,
+		CompanyName: d.CompanyName,
+		RequestedBy: d.RequestedBy,
+		Status:      d.Status,
+		Stage:       d.Stage,
+		Counts:      d.Counts,
+		Attempts:    d.Attempts,
+		Error:       d.Error,
+		Certificate: d.Certificate,
+		CreatedAt:   d.CreatedAt,
+		StartedAt:   d.StartedAt,
+		CompletedAt: d.CompletedAt,
+	}
+}
@@ -0,0 +1,63 @@
+package response
+
+import (
+	"net/url"
+	"time"
+
+	"gin-clean-starter/internal/usecase/queries"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+// CompanyExportDownloadPath serves archives to anyone holding a signed link.
+const CompanyExportDownloadPath = "/api/company-exports/download"
+
+// CompanyExportResponse is an export's progress. While the archive is kept, downloadUrl
+// is a fresh signed link valid until downloadExpiresAt; poll again for a new one.
+type CompanyExportResponse struct {
+	ID                uuid.UUID  `json:"id" validate:"required"`
+	CompanyID         uuid.UUID  `json:"companyId" validate:"required"`
+	RequestedBy       *uuid.UUID `json:"requestedBy,omitempty"`
+	Status            string     `json:"status" validate:"required" enums:"pending,running,done,failed"`
+	Progress          int32      `json:"progress" validate:"required" minimum:"0" maximum:"100"`
+	SizeBytes         *int64     `json:"sizeBytes,omitempty"`
+	Error             *string    `json:"error,omitempty"`
+	CreatedAt         time.Time  `json:"createdAt" validate:"required"`
+	StartedAt         *time.Time `json:"startedAt,omitempty"`
+	CompletedAt       *time.Time `json:"completedAt,omitempty"`
+	ExpiresAt         *time.Time `json:"expiresAt,omitempty"`
+	DownloadURL       string     `json:"downloadUrl,omitempty"`
+	DownloadExpiresAt *time.Time `json:"downloadExpiresAt,omitempty"`
+}
+
+func FromCompanyExport(e *shared.CompanyExport) *CompanyExportResponse {
+	return &CompanyExportResponse{
+		ID:          e.ID,
+		CompanyID:   e.CompanyID,
+		RequestedBy: &e.RequestedBy,
+		Status:      "pending",
+		CreatedAt:   e.CreatedAt,
+	}
+}
+
+func FromCompanyExportDetail(d *queries.CompanyExportDetail) *CompanyExportResponse {
+	res := &CompanyExportResponse{
+		ID:                d.ID,
+		CompanyID:         d.CompanyID,
+		RequestedBy:       d.RequestedBy,
+		Status:            d.Status,
+		Progress:          d.Progress,
+		SizeBytes:         d.SizeBytes,
+		Error:             d.Error,
+		CreatedAt:         d.CreatedAt,
+		StartedAt:         d.StartedAt,
+		CompletedAt:       d.CompletedAt,
+		ExpiresAt:         d.ExpiresAt,
+		DownloadExpiresAt: d.DownloadExpiresAt,
+	}
+	if d.DownloadToken != "" {
+		res.DownloadURL = CompanyExportDownloadPath + "?" + url.Values{"token": {d.DownloadToken}}.Encode()
+	}
+	return res
+}
@@ -0,0 +1,6 @@
+package response
+
+// CreatedResponse is the minimal body of a 201 reply; the full representation lives at Location.
+type CreatedResponse struct {
+	ID string `json:"id" validate:"required"`
+}
@@ -0,0 +1,28 @@
+package response
+
+import (
+	"gin-clean-starter/internal/usecase/commands"
+)
+
+type CursorCheckItemResponse struct {
+	Cursor   string `json:"cursor" validate:"required"`
+	Invalid  int64  `json:"invalid" validate:"required"`
+	Repaired int64  `json:"repaired" validate:"required"`
+}
+
+type CursorCheckResponse struct {
+	Repair  bool                      `json:"repair" validate:"required"`
+	Cursors []CursorCheckItemResponse `json:"cursors"`
+}
+
+func FromCursorCheckResults(repair bool, results []commands.CursorCheckResult) *CursorCheckResponse {
+	cursors := make([]CursorCheckItemResponse, len(results))
+	for i, r := range results {
+		cursors[i] = CursorCheckItemResponse{
+			Cursor:   r.Cursor,
+			Invalid:  r.Invalid,
+			Repaired: r.Repaired,
+		}
+	}
+	return &CursorCheckResponse{Repair: repair, Cursors: cursors}
+}
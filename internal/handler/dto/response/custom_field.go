@@ -0,0 +1,47 @@
+package response
+
+import (
+	"time"
+
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+// CustomFieldResponse is a field collected on reservations; resourceId is absent for a
+// field asked on every resource of the company.
+type CustomFieldResponse struct {
+	ID         uuid.UUID  `json:"id" validate:"required"`
+	ResourceID *uuid.UUID `json:"resourceId,omitempty"`
+	Key        string     `json:"key" validate:"required"`
+	Label      string     `json:"label" validate:"required"`
+	Type       string     `json:"type" validate:"required"`
+	Required   bool       `json:"required" validate:"required"`
+	Options    []string   `json:"options"`
+	CreatedAt  time.Time  `json:"createdAt" validate:"required"`
+}
+
+func FromCustomFieldDefinition(d *shared.CustomFieldDefinition) CustomFieldResponse {
+	options := d.Options
+	if options == nil {
+		options = []string{}
+	}
+	return CustomFieldResponse{
+		ID:         d.ID,
+		ResourceID: d.ResourceID,
+		Key:        d.Key,
+		Label:      d.Label,
+		Type:       string(d.Type),
+		Required:   d.Required,
+		Options:    options,
+		CreatedAt:  d.CreatedAt,
+	}
+}
+
+func FromCustomFieldDefinitions(ds []*shared.CustomFieldDefinition) []CustomFieldResponse {
+	out := make([]CustomFieldResponse, len(ds))
+	for i, d := range ds {
+		out[i] = FromCustomFieldDefinition(d)
+	}
+	return out
+}
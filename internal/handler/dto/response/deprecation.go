@@ -0,0 +1,47 @@
+package response
+
+import (
+	"time"
+
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/google/uuid"
+)
+
+type DeprecationReportResponse struct {
+	From      string                            `json:"from" validate:"required"` // YYYY-MM-DD
+	To        string                            `json:"to" validate:"required"`   // YYYY-MM-DD, inclusive
+	Consumers []DeprecatedRouteConsumerResponse `json:"consumers"`
+}
+
+// DeprecatedRouteConsumerResponse leaves out the user for anonymous calls and the company
+// for users without one.
+type DeprecatedRouteConsumerResponse struct {
+	Route        string     `json:"route" validate:"required"` // "<METHOD> <route>", e.g. "GET /api/reservations/:id"
+	UserID       *uuid.UUID `json:"userId,omitempty"`
+	Email        *string    `json:"email,omitempty"`
+	CompanyID    *uuid.UUID `json:"companyId,omitempty"`
+	CompanyName  *string    `json:"companyName,omitempty"`
+	Calls        int64      `json:"calls" validate:"required"`
+	LastCalledAt time.Time  `json:"lastCalledAt" validate:"required"`
+}
+
+func FromDeprecationReport(report *queries.DeprecationReport) DeprecationReportResponse {
+	res := DeprecationReportResponse{
+		From:      report.From.Format("2006-01-02"),
+		To:        report.To.Format("2006-01-02"),
+		Consumers: make([]DeprecatedRouteConsumerResponse, len(report.Consumers)),
+	}
+	for i, d := range report.Consumers {
+		res.Consumers[i] = DeprecatedRouteConsumerResponse{
+			Route:        d.Route,
+			UserID:       d.UserID,
+			Email:        d.Email,
+			CompanyID:    d.CompanyID,
+			CompanyName:  d.CompanyName,
+			Calls:        d.Calls,
+			LastCalledAt: d.LastCalledAt,
+		}
+	}
+	return res
+}
@@ -0,0 +1,31 @@
+package response
+
+import (
+	"gin-clean-starter/internal/pkg/errs"
+)
+
+type ErrorCatalogResponse struct {
+	Errors []ErrorCodeResponse `json:"errors" validate:"required"` // sorted by code
+}
+
+type ErrorCodeResponse struct {
+	Code        string `json:"code" validate:"required"`
+	Description string `json:"description" validate:"required"`
+	Statuses    []int  `json:"statuses" validate:"required"` // HTTP statuses it is reported with; empty when no handler mapping names it
+}
+
+func FromErrorRegistry(registry []errs.CodeInfo) ErrorCatalogResponse {
+	out := make([]ErrorCodeResponse, len(registry))
+	for i, info := range registry {
+		statuses := info.Statuses
+		if statuses == nil {
+			statuses = []int{}
+		}
+		out[i] = ErrorCodeResponse{
+			Code:        string(info.Code),
+			Description: info.Description,
+			Statuses:    statuses,
+		}
+	}
+	return ErrorCatalogResponse{Errors: out}
+}
@@ -0,0 +1,24 @@
+package response
+
+import (
+	"gin-clean-starter/internal/usecase/shared"
+)
+
+type FeatureStateResponse struct {
+	Feature string `json:"feature" validate:"required"`
+	Enabled bool   `json:"enabled" validate:"required"`
+	// Source is "company" when the company has its own setting, "default" otherwise.
+	Source string `json:"source" validate:"required" enums:"company,default"`
+}
+
+func FromFeatureStates(states []shared.FeatureState) []*FeatureStateResponse {
+	out := make([]*FeatureStateResponse, len(states))
+	for i, s := range states {
+		source := "default"
+		if s.Overridden {
+			source = "company"
+		}
+		out[i] = &FeatureStateResponse{Feature: s.Feature, Enabled: s.Enabled, Source: source}
+	}
+	return out
+}
@@ -0,0 +1,68 @@
+package response
+
+import (
+	"time"
+
+	"gin-clean-starter/internal/usecase/commands"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/google/uuid"
+)
+
+type InviteResponse struct {
+	ID        uuid.UUID `json:"id" validate:"required"`
+	Email     string    `json:"email" validate:"required"`
+	Role      string    `json:"role" validate:"required"`
+	ExpiresAt time.Time `json:"expiresAt" validate:"required"`
+}
+
+func FromInviteResult(r *commands.InviteResult) *InviteResponse {
+	return &InviteResponse{
+		ID:        r.InviteID,
+		Email:     r.Email,
+		Role:      r.Role,
+		ExpiresAt: r.ExpiresAt,
+	}
+}
+
+type InviteListResponse struct {
+	ID             uuid.UUID `json:"id" validate:"required"`
+	Email          string    `json:"email" validate:"required"`
+	Role           string    `json:"role" validate:"required"`
+	Status         string    `json:"status" validate:"required"`
+	InvitedByEmail string    `json:"invitedByEmail" validate:"required"`
+	ExpiresAt      time.Time `json:"expiresAt" validate:"required"`
+	CreatedAt      time.Time `json:"createdAt" validate:"required"`
+}
+
+func FromInviteViews(vs []*queries.InviteView) []*InviteListResponse {
+	out := make([]*InviteListResponse, len(vs))
+	for i, v := range vs {
+		out[i] = &InviteListResponse{
+			ID:             v.ID,
+			Email:          v.Email,
+			Role:           v.Role,
+			Status:         v.Status,
+			InvitedByEmail: v.InvitedByEmail,
+			ExpiresAt:      v.ExpiresAt,
+			CreatedAt:      v.CreatedAt,
+		}
+	}
+	return out
+}
+
+type AcceptInviteResponse struct {
+	UserID    uuid.UUID `json:"userId" validate:"required"`
+	Email     string    `json:"email" validate:"required"`
+	Role      string    `json:"role" validate:"required"`
+	CompanyID uuid.UUID `json:"companyId" validate:"required"`
+}
+
+func FromAcceptInviteResult(r *commands.AcceptInviteResult) *AcceptInviteResponse {
+	return &AcceptInviteResponse{
+		UserID:    r.UserID,
+		Email:     r.Email,
+		Role:      r.Role,
+		CompanyID: r.CompanyID,
+	}
+}
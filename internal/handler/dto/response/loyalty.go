@@ -0,0 +1,44 @@
+package response
+
+import (
+	"time"
+
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/google/uuid"
+)
+
+type PointsHistoryResponse struct {
+	Balance    int64                 `json:"balance" validate:"required"`
+	Entries    []PointsEntryResponse `json:"entries"`
+	NextCursor string                `json:"nextCursor,omitempty"`
+}
+
+type PointsEntryResponse struct {
+	ID            uuid.UUID  `json:"id" validate:"required"`
+	Kind          string     `json:"kind" validate:"required"`
+	Points        int32      `json:"points" validate:"required"` // negative for redemptions and expiries
+	ReservationID *uuid.UUID `json:"reservationId,omitempty"`
+	DiscountCents *int32     `json:"discountCents,omitempty"`
+	ExpiresAt     *time.Time `json:"expiresAt,omitempty"`
+	CreatedAt     time.Time  `json:"createdAt" validate:"required"`
+}
+
+func NewPointsHistoryPage(h *queries.PointsHistory, next *queries.Cursor) PointsHistoryResponse {
+	page := PointsHistoryResponse{Balance: h.Balance, Entries: make([]PointsEntryResponse, len(h.Entries))}
+	for i, e := range h.Entries {
+		page.Entries[i] = PointsEntryResponse{
+			ID:            e.ID,
+			Kind:          e.Kind,
+			Points:        e.Points,
+			ReservationID: e.ReservationID,
+			DiscountCents: e.DiscountCents,
+			ExpiresAt:     e.ExpiresAt,
+			CreatedAt:     e.CreatedAt,
+		}
+	}
+	if next != nil {
+		page.NextCursor = next.After
+	}
+	return page
+}
@@ -0,0 +1,17 @@
+package response
+
+import "gin-clean-starter/internal/usecase/queries"
+
+// NotificationPreferencesResponse says how the user gets non-urgent notification emails:
+// digest is off, daily or weekly, and heldNotifications wait for the next digest.
+type NotificationPreferencesResponse struct {
+	Digest            string `json:"digest" validate:"required"`
+	HeldNotifications int64  `json:"heldNotifications"`
+}
+
+func FromNotificationPreferencesView(v *queries.NotificationPreferencesView) NotificationPreferencesResponse {
+	return NotificationPreferencesResponse{
+		Digest:            v.Digest,
+		HeldNotifications: v.Held,
+	}
+}
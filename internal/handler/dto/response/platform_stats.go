@@ -0,0 +1,26 @@
+package response
+
+import (
+	"time"
+
+	"gin-clean-starter/internal/usecase/queries"
+)
+
+// PlatformStatsResponse are the platform-wide totals for marketing pages. computedAt is
+// when they were last recomputed, null before the first run; averageRating is 0 until
+// the first review.
+type PlatformStatsResponse struct {
+	TotalResources int64      `json:"totalResources" validate:"required"`
+	TotalReviews   int64      `json:"totalReviews" validate:"required"`
+	AverageRating  float64    `json:"averageRating" validate:"required"`
+	ComputedAt     *time.Time `json:"computedAt"`
+}
+
+func FromPlatformStats(s *queries.PlatformStats) PlatformStatsResponse {
+	return PlatformStatsResponse{
+		TotalResources: s.TotalResources,
+		TotalReviews:   s.TotalReviews,
+		AverageRating:  s.AverageRating,
+		ComputedAt:     s.ComputedAt,
+	}
+}
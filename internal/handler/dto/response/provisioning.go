@@ -0,0 +1,20 @@
+package response
+
+import (
+	"gin-clean-starter/internal/usecase/commands"
+
+	"github.com/google/uuid"
+)
+
+// ProvisioningTokenResponse is the only time the token is shown.
+type ProvisioningTokenResponse struct {
+	ID    uuid.UUID `json:"id" validate:"required"`
+	Token string    `json:"token" validate:"required"`
+}
+
+func FromIssuedProvisioningToken(t *commands.IssuedProvisioningToken) *ProvisioningTokenResponse {
+	return &ProvisioningTokenResponse{
+		ID:    t.ID,
+		Token: t.Token,
+	}
+}
@@ -0,0 +1,33 @@
+package response
+
+import (
+	"time"
+
+	"gin-clean-starter/internal/usecase/queries"
+)
+
+// PublicResourceResponse is the data of a resource's public page. url is the page's
+// canonical address on the public site; averageRating is 0 until the first review.
+type PublicResourceResponse struct {
+	Slug          string    `json:"slug" validate:"required"`
+	URL           string    `json:"url" validate:"required"`
+	Name          string    `json:"name" validate:"required"`
+	CompanyName   *string   `json:"companyName"`
+	LeadTimeMin   int       `json:"leadTimeMin" validate:"required"`
+	TotalReviews  int32     `json:"totalReviews" validate:"required"`
+	AverageRating float64   `json:"averageRating" validate:"required"`
+	UpdatedAt     time.Time `json:"updatedAt" validate:"required"`
+}
+
+func FromPublicResourcePage(p *queries.PublicResourcePage, url string) PublicResourceResponse {
+	return PublicResourceResponse{
+		Slug:          p.Slug,
+		URL:           url,
+		Name:          p.Name,
+		CompanyName:   p.CompanyName,
+		LeadTimeMin:   p.LeadTimeMin,
+		TotalReviews:  p.TotalReviews,
+		AverageRating: p.AverageRating,
+		UpdatedAt:     p.LastModified,
+	}
+}
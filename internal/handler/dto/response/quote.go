@@ -0,0 +1,64 @@
+package response
+
+import (
+	"time"
+
+	"gin-clean-starter/internal/usecase/commands"
+
+	"github.com/google/uuid"
+)
+
+type QuoteResponse struct {
+	QuoteID             string                 `json:"quoteId" validate:"required"`
+	ResourceID          uuid.UUID              `json:"resourceId" validate:"required"`
+	StartTime           time.Time              `json:"startTime" validate:"required"`
+	EndTime             time.Time              `json:"endTime" validate:"required"`
+	CouponCodes         []string               `json:"couponCodes,omitempty"`
+	Discounts           []DiscountLineResponse `json:"discounts"`
+	PointsRedeemed      int64                  `json:"pointsRedeemed,omitempty"` // may be lower than requested when the redemption cap applies
+	PointsDiscountCents int64                  `json:"pointsDiscountCents,omitempty"`
+	BaseCents           int64                  `json:"baseCents" validate:"required"`
+	DiscountCents       int64                  `json:"discountCents" validate:"required"` // coupon lines plus the points discount
+	SubtotalCents       int64                  `json:"subtotalCents" validate:"required"`
+	TaxCents            int64                  `json:"taxCents" validate:"required"`
+	TotalCents          int64                  `json:"totalCents" validate:"required"`
+	ExpiresAt           time.Time              `json:"expiresAt" validate:"required"`
+}
+
+func FromQuoteResult(r *commands.QuoteResult) *QuoteResponse {
+	return &QuoteResponse{
+		QuoteID:             r.QuoteID,
+		ResourceID:          r.ResourceID,
+		StartTime:           r.StartTime,
+		EndTime:             r.EndTime,
+		CouponCodes:         r.CouponCodes,
+		Discounts:           fromDiscountLines(r.Discounts),
+		PointsRedeemed:      r.PointsRedeemed,
+		PointsDiscountCents: r.PointsDiscountCents,
+		BaseCents:           r.BaseCents,
+		DiscountCents:       r.DiscountCents,
+		SubtotalCents:       r.SubtotalCents,
+		TaxCents:            r.TaxCents,
+		TotalCents:          r.TotalCents,
+		ExpiresAt:           r.ExpiresAt,
+	}
+}
+
+func fromDiscountLines(lines []commands.DiscountLine) []DiscountLineResponse {
+	out := make([]DiscountLineResponse, len(lines))
+	for i, l := range lines {
+		out[i] = DiscountLineResponse{CouponID: l.CouponID, CouponCode: l.CouponCode, AmountCents: l.AmountCents}
+	}
+	return out
+}
+
+// RequoteResponse is the detail of a QUOTE_EXPIRED rejection: a fresh quote to confirm
+// and retry with, and the coupon codes it no longer applies.
+type RequoteResponse struct {
+	Quote          *QuoteResponse `json:"quote" validate:"required"`
+	DroppedCoupons []string       `json:"droppedCoupons,omitempty"`
+}
+
+func FromQuoteExpiredError(e *commands.QuoteExpiredError) *RequoteResponse {
+	return &RequoteResponse{Quote: FromQuoteResult(e.Quote), DroppedCoupons: e.DroppedCoupons}
+}
@@ -0,0 +1,55 @@
+package response
+
+import (
+	"strings"
+	"time"
+
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/google/uuid"
+)
+
+type ReferralOverviewResponse struct {
+	Code        string              `json:"code" validate:"required"`
+	CreditCents int64               `json:"creditCents" validate:"required"`
+	Referrals   []*ReferralResponse `json:"referrals"`
+}
+
+type ReferralResponse struct {
+	ID             uuid.UUID  `json:"id" validate:"required"`
+	ReferredUserID uuid.UUID  `json:"referredUserId" validate:"required"`
+	ReferredEmail  string     `json:"referredEmail" validate:"required"` // masked, e.g. "j***@example.com"
+	Status         string     `json:"status" validate:"required"`
+	RewardCents    *int32     `json:"rewardCents,omitempty"`
+	CreatedAt      time.Time  `json:"createdAt" validate:"required"`
+	RewardedAt     *time.Time `json:"rewardedAt,omitempty"`
+}
+
+func FromReferralOverview(o *queries.ReferralOverview) *ReferralOverviewResponse {
+	referrals := make([]*ReferralResponse, len(o.Referrals))
+	for i, v := range o.Referrals {
+		referrals[i] = &ReferralResponse{
+			ID:             v.ID,
+			ReferredUserID: v.ReferredUserID,
+			ReferredEmail:  maskEmail(v.ReferredEmail),
+			Status:         v.Status,
+			RewardCents:    v.RewardCents,
+			CreatedAt:      v.CreatedAt,
+			RewardedAt:     v.RewardedAt,
+		}
+	}
+	return &ReferralOverviewResponse{
+		Code:        o.Code,
+		CreditCents: o.CreditCents,
+		Referrals:   referrals,
+	}
+}
+
+// maskEmail keeps the first character of the local part and the domain.
+func maskEmail(email string) string {
+	at := strings.LastIndexByte(email, '@')
+	if at <= 0 {
+		return "***"
+	}
+	return email[:1] + "***" + email[at:]
+}
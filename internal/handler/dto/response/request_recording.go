@@ -0,0 +1,86 @@
+package response
+
+import (
+	"time"
+
+	"gin-clean-starter/internal/usecase/queries"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+type RequestRecordingResponse struct {
+	ID               uuid.UUID `json:"id" validate:"required"`
+	UserID           uuid.UUID `json:"userId" validate:"required"`
+	EnabledBy        uuid.UUID `json:"enabledBy" validate:"required"`
+	Reason           string    `json:"reason" validate:"required"`
+	ConsentReference string    `json:"consentReference" validate:"required"`
+	MaxRequests      int32     `json:"maxRequests" validate:"required"`
+	ExpiresAt        time.Time `json:"expiresAt" validate:"required"`
+	CreatedAt        time.Time `json:"createdAt" validate:"required"`
+}
+
+func FromRequestRecording(r *shared.RequestRecording) *RequestRecordingResponse {
+	return &RequestRecordingResponse{
+		ID:               r.ID,
+		UserID:           r.UserID,
+		EnabledBy:        r.EnabledBy,
+		Reason:           r.Reason,
+		ConsentReference: r.ConsentReference,
+		MaxRequests:      r.MaxRequests,
+		ExpiresAt:        r.ExpiresAt,
+		CreatedAt:        r.CreatedAt,
+	}
+}
+
+type RecordedRequestResponse struct {
+	Seq        int32     `json:"seq" validate:"required"`
+	Method     string    `json:"method" validate:"required"`
+	Path       string    `json:"path" validate:"required"`
+	Status     int32     `json:"status" validate:"required"`
+	DurationMs int32     `json:"durationMs" validate:"required"`
+	RecordedAt time.Time `json:"recordedAt" validate:"required"`
+}
+
+// RequestRecordingDetailResponse lists a recording's captures; each capture's sanitized
+// request and response are fetched separately.
+type RequestRecordingDetailResponse struct {
+	ID               uuid.UUID                 `json:"id" validate:"required"`
+	UserID           uuid.UUID                 `json:"userId" validate:"required"`
+	UserEmail        string                    `json:"userEmail" validate:"required"`
+	EnabledBy        *uuid.UUID                `json:"enabledBy,omitempty"`
+	Reason           string                    `json:"reason" validate:"required"`
+	ConsentReference string                    `json:"consentReference" validate:"required"`
+	MaxRequests      int32                     `json:"maxRequests" validate:"required"`
+	RecordedCount    int32                     `json:"recordedCount" validate:"required"`
+	ExpiresAt        time.Time                 `json:"expiresAt" validate:"required"`
+	CreatedAt        time.Time                 `json:"createdAt" validate:"required"`
+	Requests         []RecordedRequestResponse `json:"requests" validate:"required"`
+}
+
+func FromRequestRecordingDetail(d *queries.RequestRecordingDetail) *RequestRecordingDetailResponse {
+	requests := make([]RecordedRequestResponse, 0, len(d.Captures))
+	for _, capture := range d.Captures {
+		requests = append(requests, RecordedRequestResponse{
+			Seq:        capture.Seq,
+			Method:     capture.Method,
+			Path:       capture.Path,
+			Status:     capture.Status,
+			DurationMs: capture.DurationMs,
+			RecordedAt: capture.RecordedAt,
+		})
+	}
+	return &RequestRecordingDetailResponse{
+		ID:               d.ID,
+		UserID:           d.UserID,
+		UserEmail:        d.UserEmail,
+		EnabledBy:        d.EnabledBy,
+		Reason:           d.Reason,
+		ConsentReference: d.ConsentReference,
+		MaxRequests:      d.MaxRequests,
+		RecordedCount:    d.RecordedCount,
+		ExpiresAt:        d.ExpiresAt,
+		CreatedAt:        d.CreatedAt,
+		Requests:         requests,
+	}
+}
@@ -1,6 +1,8 @@
 package response
 
 import (
+	"encoding/json"
+	"strconv"
 	"time"
 
 	"gin-clean-starter/internal/usecase/queries"
@@ -9,29 +11,38 @@ import (
 )
 
 type ReservationResponse struct {
-	ID           uuid.UUID  `json:"id"`
-	ResourceID   uuid.UUID  `json:"resourceId"`
-	ResourceName string     `json:"resourceName"`
-	UserID       uuid.UUID  `json:"userId"`
-	UserEmail    string     `json:"userEmail"`
-	Slot         string     `json:"slot"`
-	Status       string     `json:"status"`
-	PriceCents   int32      `json:"priceCents"`
-	CouponID     *uuid.UUID `json:"couponId,omitempty"`
-	CouponCode   *string    `json:"couponCode,omitempty"`
-	Note         *string    `json:"note,omitempty"`
-	CreatedAt    time.Time  `json:"createdAt"`
-	UpdatedAt    time.Time  `json:"updatedAt"`
+	ID           uuid.UUID                       `json:"id" validate:"required"`
+	ResourceID   uuid.UUID                       `json:"resourceId" validate:"required"`
+	ResourceName string                          `json:"resourceName" validate:"required"`
+	UserID       uuid.UUID                       `json:"userId" validate:"required"`
+	UserEmail    string                          `json:"userEmail" validate:"required"`
+	Slot         string                          `json:"slot" validate:"required"`
+	Status       string                          `json:"status" validate:"required"`
+	PriceCents   int32                           `json:"priceCents" validate:"required"`
+	CouponID     *uuid.UUID                      `json:"couponId,omitempty"`
+	CouponCode   *string                         `json:"couponCode,omitempty"`
+	Discounts    []DiscountLineResponse          `json:"discounts"`
+	CustomFields map[string]any                  `json:"customFields" validate:"required"`
+	Messages     *ReservationMessagePageResponse `json:"messages,omitempty"`
+	CreatedAt    time.Time                       `json:"createdAt" validate:"required"`
+	UpdatedAt    time.Time                       `json:"updatedAt" validate:"required"`
+}
+
+// DiscountLineResponse is one applied coupon, in the order coupons were applied.
+type DiscountLineResponse struct {
+	CouponID    uuid.UUID `json:"couponId" validate:"required"`
+	CouponCode  string    `json:"couponCode" validate:"required"`
+	AmountCents int64     `json:"amountCents" validate:"required"`
 }
 
 type ReservationListResponse struct {
-	ID           uuid.UUID `json:"id"`
-	ResourceID   uuid.UUID `json:"resourceId"`
-	ResourceName string    `json:"resourceName"`
-	Slot         string    `json:"slot"`
-	Status       string    `json:"status"`
-	PriceCents   int32     `json:"priceCents"`
-	CreatedAt    time.Time `json:"createdAt"`
+	ID           uuid.UUID `json:"id" validate:"required"`
+	ResourceID   uuid.UUID `json:"resourceId" validate:"required"`
+	ResourceName string    `json:"resourceName" validate:"required"`
+	Slot         string    `json:"slot" validate:"required"`
+	Status       string    `json:"status" validate:"required"`
+	PriceCents   int32     `json:"priceCents" validate:"required"`
+	CreatedAt    time.Time `json:"createdAt" validate:"required"`
 }
 
 func FromReservationView(rm *queries.ReservationView) *ReservationResponse {
@@ -46,14 +57,39 @@ func FromReservationView(rm *queries.ReservationView) *ReservationResponse {
 		PriceCents:   rm.PriceCents,
 		CouponID:     rm.CouponID,
 		CouponCode:   rm.CouponCode,
-		Note:         rm.Note,
+		Discounts:    fromReservationDiscountViews(rm.Discounts),
+		CustomFields: rm.CustomFields,
 		CreatedAt:    rm.CreatedAt,
 		UpdatedAt:    rm.UpdatedAt,
 	}
 }
 
-func FromReservationListItem(rm *queries.ReservationListItem) *ReservationListResponse {
-	return &ReservationListResponse{
+func fromReservationDiscountViews(views []queries.ReservationDiscountView) []DiscountLineResponse {
+	lines := make([]DiscountLineResponse, len(views))
+	for i, v := range views {
+		lines[i] = DiscountLineResponse{CouponID: v.CouponID, CouponCode: v.CouponCode, AmountCents: int64(v.AmountCents)}
+	}
+	return lines
+}
+
+type ReservationListPageResponse struct {
+	Reservations []ReservationListResponse `json:"reservations"`
+	NextCursor   string                    `json:"nextCursor,omitempty"`
+}
+
+func NewReservationListPage(items []*queries.ReservationListItem, next *queries.Cursor) ReservationListPageResponse {
+	page := ReservationListPageResponse{Reservations: make([]ReservationListResponse, len(items))}
+	for i, rm := range items {
+		page.Reservations[i] = FromReservationListItem(rm)
+	}
+	if next != nil {
+		page.NextCursor = next.After
+	}
+	return page
+}
+
+func FromReservationListItem(rm *queries.ReservationListItem) ReservationListResponse {
+	return ReservationListResponse{
 		ID:           rm.ID,
 		ResourceID:   rm.ResourceID,
 		ResourceName: rm.ResourceName,
@@ -63,3 +99,77 @@ func FromReservationListItem(rm *queries.ReservationListItem) *ReservationListRe
 		CreatedAt:    rm.CreatedAt,
 	}
 }
+
+type AdminReservationListResponse struct {
+	ID           uuid.UUID      `json:"id" validate:"required"`
+	ResourceID   uuid.UUID      `json:"resourceId" validate:"required"`
+	ResourceName string         `json:"resourceName" validate:"required"`
+	UserID       uuid.UUID      `json:"userId" validate:"required"`
+	UserEmail    string         `json:"userEmail" validate:"required"`
+	Slot         string         `json:"slot" validate:"required"`
+	Status       string         `json:"status" validate:"required"`
+	PriceCents   int32          `json:"priceCents" validate:"required"`
+	CustomFields map[string]any `json:"customFields" validate:"required"`
+	CreatedAt    time.Time      `json:"createdAt" validate:"required"`
+}
+
+type AdminReservationListPageResponse struct {
+	Reservations []AdminReservationListResponse `json:"reservations"`
+	NextCursor   string                         `json:"nextCursor,omitempty"`
+}
+
+func NewAdminReservationListPage(items []*queries.AdminReservationListItem, next *queries.Cursor) AdminReservationListPageResponse {
+	page := AdminReservationListPageResponse{Reservations: make([]AdminReservationListResponse, len(items))}
+	for i, rm := range items {
+		page.Reservations[i] = FromAdminReservationListItem(rm)
+	}
+	if next != nil {
+		page.NextCursor = next.After
+	}
+	return page
+}
+
+func FromAdminReservationListItem(rm *queries.AdminReservationListItem) AdminReservationListResponse {
+	return AdminReservationListResponse{
+		ID:           rm.ID,
+		ResourceID:   rm.ResourceID,
+		ResourceName: rm.ResourceName,
+		UserID:       rm.UserID,
+		UserEmail:    rm.UserEmail,
+		Slot:         rm.Slot,
+		Status:       rm.Status,
+		PriceCents:   rm.PriceCents,
+		CustomFields: rm.CustomFields,
+		CreatedAt:    rm.CreatedAt,
+	}
+}
+
+// AdminReservationExportRow is one line of an admin reservation export; CSV columns follow
+// AdminReservationExportCSVHeader, with the custom fields as one JSON object column.
+type AdminReservationExportRow struct {
+	AdminReservationListResponse
+}
+
+var AdminReservationExportCSVHeader = []string{"id", "resource_id", "resource_name", "user_id", "user_email", "slot", "status", "price_cents", "created_at", "custom_fields"}
+
+func FromAdminReservationExportItem(rm *queries.AdminReservationListItem) AdminReservationExportRow {
+	row := AdminReservationExportRow{FromAdminReservationListItem(rm)}
+	row.CreatedAt = row.CreatedAt.UTC()
+	return row
+}
+
+func (r AdminReservationExportRow) CSVRecord() []string {
+	fields, _ := json.Marshal(r.CustomFields) // decoded from JSONB, so it encodes again
+	return []string{
+		r.ID.String(),
+		r.ResourceID.String(),
+		r.ResourceName,
+		r.UserID.String(),
+		r.UserEmail,
+		r.Slot,
+		r.Status,
+		strconv.Itoa(int(r.PriceCents)),
+		r.CreatedAt.Format(time.RFC3339),
+		string(fields),
+	}
+}
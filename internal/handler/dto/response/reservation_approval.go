@@ -0,0 +1,61 @@
+package response
+
+import (
+	"time"
+
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/google/uuid"
+)
+
+type ResourceApproverResponse struct {
+	UserID     uuid.UUID `json:"userId" validate:"required"`
+	Email      string    `json:"email" validate:"required"`
+	Role       string    `json:"role" validate:"required"`
+	AssignedAt time.Time `json:"assignedAt" validate:"required"`
+}
+
+func FromResourceApproverViews(vs []*queries.ResourceApproverView) []*ResourceApproverResponse {
+	out := make([]*ResourceApproverResponse, len(vs))
+	for i, v := range vs {
+		out[i] = &ResourceApproverResponse{
+			UserID:     v.UserID,
+			Email:      v.Email,
+			Role:       v.Role,
+			AssignedAt: v.AssignedAt,
+		}
+	}
+	return out
+}
+
+type PendingApprovalResponse struct {
+	ReservationID uuid.UUID  `json:"reservationId" validate:"required"`
+	ResourceID    uuid.UUID  `json:"resourceId" validate:"required"`
+	ResourceName  string     `json:"resourceName" validate:"required"`
+	UserID        uuid.UUID  `json:"userId" validate:"required"`
+	UserEmail     string     `json:"userEmail" validate:"required"`
+	StartTime     time.Time  `json:"startTime" validate:"required"`
+	EndTime       time.Time  `json:"endTime" validate:"required"`
+	ExpiresAt     time.Time  `json:"expiresAt" validate:"required"`
+	EscalatedAt   *time.Time `json:"escalatedAt,omitempty"`
+	RequestedAt   time.Time  `json:"requestedAt" validate:"required"`
+}
+
+func FromPendingApprovalViews(vs []*queries.PendingApprovalView) []*PendingApprovalResponse {
+	out := make([]*PendingApprovalResponse, len(vs))
+	for i, v := range vs {
+		out[i] = &PendingApprovalResponse{
+			ReservationID: v.ReservationID,
+			ResourceID:    v.ResourceID,
+			ResourceName:  v.ResourceName,
+			UserID:        v.UserID,
+			UserEmail:     v.UserEmail,
+			StartTime:     v.StartTime,
+			EndTime:       v.EndTime,
+			ExpiresAt:     v.ExpiresAt,
+			EscalatedAt:   v.EscalatedAt,
+			RequestedAt:   v.RequestedAt,
+		}
+	}
+	return out
+}
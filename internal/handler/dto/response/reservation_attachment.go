@@ -0,0 +1,41 @@
+package response
+
+import (
+	"time"
+
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+type ReservationAttachmentResponse struct {
+	ID           uuid.UUID `json:"id" validate:"required"`
+	Filename     string    `json:"filename" validate:"required"`
+	ContentType  string    `json:"contentType" validate:"required"`
+	SizeBytes    int64     `json:"sizeBytes" validate:"required"`
+	UploadedBy   uuid.UUID `json:"uploadedBy" validate:"required"`
+	UploaderSide string    `json:"uploaderSide" validate:"required"` // user or operator
+	OperatorOnly bool      `json:"operatorOnly"`
+	CreatedAt    time.Time `json:"createdAt" validate:"required"`
+}
+
+func FromReservationAttachment(a *shared.ReservationAttachment) ReservationAttachmentResponse {
+	return ReservationAttachmentResponse{
+		ID:           a.ID,
+		Filename:     a.Filename,
+		ContentType:  a.ContentType,
+		SizeBytes:    a.SizeBytes,
+		UploadedBy:   a.UploadedBy,
+		UploaderSide: a.UploaderSide,
+		OperatorOnly: a.OperatorOnly,
+		CreatedAt:    a.CreatedAt,
+	}
+}
+
+func FromReservationAttachments(attachments []*shared.ReservationAttachment) []ReservationAttachmentResponse {
+	resp := make([]ReservationAttachmentResponse, len(attachments))
+	for i, a := range attachments {
+		resp[i] = FromReservationAttachment(a)
+	}
+	return resp
+}
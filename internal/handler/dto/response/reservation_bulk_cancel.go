@@ -0,0 +1,37 @@
+package response
+
+import (
+	"time"
+
+	"gin-clean-starter/internal/usecase/commands"
+
+	"github.com/google/uuid"
+)
+
+type CanceledReservationResponse struct {
+	ID        uuid.UUID `json:"id" validate:"required"`
+	UserID    uuid.UUID `json:"userId" validate:"required"`
+	StartTime time.Time `json:"startTime" validate:"required"`
+	EndTime   time.Time `json:"endTime" validate:"required"`
+}
+
+// CancelRangeResponse lists the canceled reservations, or on a dry run the ones that would
+// be; emails counts the notifications, one per affected user.
+type CancelRangeResponse struct {
+	DryRun       bool                          `json:"dryRun"`
+	Reservations []CanceledReservationResponse `json:"reservations"`
+	Emails       int                           `json:"emails"`
+}
+
+func FromCancelRangeResult(r *commands.CancelRangeResult) CancelRangeResponse {
+	resp := CancelRangeResponse{DryRun: r.DryRun, Emails: r.Emails, Reservations: make([]CanceledReservationResponse, len(r.Reservations))}
+	for i, s := range r.Reservations {
+		resp.Reservations[i] = CanceledReservationResponse{
+			ID:        s.ID,
+			UserID:    s.UserID,
+			StartTime: s.StartTime,
+			EndTime:   s.EndTime,
+		}
+	}
+	return resp
+}
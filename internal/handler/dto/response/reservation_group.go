@@ -0,0 +1,43 @@
+package response
+
+import (
+	"gin-clean-starter/internal/usecase/commands"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/google/uuid"
+)
+
+type ReservationGroupResponse struct {
+	ID           uuid.UUID              `json:"id" validate:"required"`
+	Reservations []*ReservationResponse `json:"reservations"`
+}
+
+func FromReservationGroup(groupID uuid.UUID, views []*queries.ReservationView) *ReservationGroupResponse {
+	reservations := make([]*ReservationResponse, len(views))
+	for i, v := range views {
+		reservations[i] = FromReservationView(v)
+	}
+	return &ReservationGroupResponse{ID: groupID, Reservations: reservations}
+}
+
+// ReservationGroupIssueResponse is one conflicting item of a rejected group booking;
+// index is the item's position in the request.
+type ReservationGroupIssueResponse struct {
+	Index        int       `json:"index" validate:"required"`
+	ResourceID   uuid.UUID `json:"resourceId" validate:"required"`
+	Code         string    `json:"code" validate:"required" example:"RESERVATION_CONFLICT"`
+	OverlapsItem *int      `json:"overlapsItem,omitempty"`
+}
+
+func FromReservationGroupIssues(issues []commands.ReservationGroupIssue) []ReservationGroupIssueResponse {
+	items := make([]ReservationGroupIssueResponse, len(issues))
+	for i, issue := range issues {
+		items[i] = ReservationGroupIssueResponse{
+			Index:        issue.Index,
+			ResourceID:   issue.ResourceID,
+			Code:         string(issue.Code),
+			OverlapsItem: issue.OverlapsItem,
+		}
+	}
+	return items
+}
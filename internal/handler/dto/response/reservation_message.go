@@ -0,0 +1,56 @@
+package response
+
+import (
+	"time"
+
+	"gin-clean-starter/internal/usecase/commands"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/google/uuid"
+)
+
+type ReservationMessageResponse struct {
+	ID         uuid.UUID `json:"id" validate:"required"`
+	AuthorID   uuid.UUID `json:"authorId" validate:"required"`
+	AuthorSide string    `json:"authorSide" validate:"required"` // user or operator
+	Body       string    `json:"body" validate:"required"`
+	CreatedAt  time.Time `json:"createdAt" validate:"required"`
+}
+
+// ReservationMessagePageResponse is a page of a reservation's thread, oldest first.
+type ReservationMessagePageResponse struct {
+	Messages   []ReservationMessageResponse `json:"messages"`
+	NextCursor string                       `json:"nextCursor,omitempty"`
+	// Unread counts the other side's messages that the caller's side has not marked read.
+	Unread int64 `json:"unread"`
+}
+
+func NewReservationMessagePage(thread *queries.ReservationMessageThread) *ReservationMessagePageResponse {
+	page := &ReservationMessagePageResponse{
+		Messages: make([]ReservationMessageResponse, len(thread.Messages)),
+		Unread:   thread.Unread,
+	}
+	for i, m := range thread.Messages {
+		page.Messages[i] = ReservationMessageResponse{
+			ID:         m.ID,
+			AuthorID:   m.AuthorID,
+			AuthorSide: m.AuthorSide,
+			Body:       m.Body,
+			CreatedAt:  m.CreatedAt,
+		}
+	}
+	if thread.Next != nil {
+		page.NextCursor = thread.Next.After
+	}
+	return page
+}
+
+func FromPostReservationMessageResult(r *commands.PostReservationMessageResult) ReservationMessageResponse {
+	return ReservationMessageResponse{
+		ID:         r.ID,
+		AuthorID:   r.AuthorID,
+		AuthorSide: r.AuthorSide,
+		Body:       r.Body,
+		CreatedAt:  r.CreatedAt,
+	}
+}
@@ -0,0 +1,31 @@
+package response
+
+import (
+	"time"
+
+	"gin-clean-starter/internal/usecase/commands"
+
+	"github.com/google/uuid"
+)
+
+// ReservationTransferResponse describes a transfer offer: pending until the recipient
+// accepts it by expiresAt, or accepted.
+type ReservationTransferResponse struct {
+	ID            uuid.UUID `json:"id" validate:"required"`
+	ReservationID uuid.UUID `json:"reservationId" validate:"required"`
+	ToUserID      uuid.UUID `json:"toUserId" validate:"required"`
+	ToEmail       string    `json:"toEmail,omitempty"`
+	Status        string    `json:"status" validate:"required" example:"pending"`
+	ExpiresAt     time.Time `json:"expiresAt" validate:"required"`
+}
+
+func FromReservationTransferResult(r *commands.ReservationTransferResult) *ReservationTransferResponse {
+	return &ReservationTransferResponse{
+		ID:            r.TransferID,
+		ReservationID: r.ReservationID,
+		ToUserID:      r.ToUserID,
+		ToEmail:       r.ToEmail,
+		Status:        r.Status,
+		ExpiresAt:     r.ExpiresAt,
+	}
+}
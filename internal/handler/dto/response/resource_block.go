@@ -0,0 +1,69 @@
+package response
+
+import (
+	"time"
+
+	"gin-clean-starter/internal/usecase/commands"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/google/uuid"
+)
+
+type ResourceBlockResponse struct {
+	ID        uuid.UUID `json:"id" validate:"required"`
+	SeriesID  uuid.UUID `json:"seriesId" validate:"required"`
+	StartTime time.Time `json:"startTime" validate:"required"`
+	EndTime   time.Time `json:"endTime" validate:"required"`
+	Reason    string    `json:"reason" validate:"required"`
+}
+
+type ResourceBlockSeriesResponse struct {
+	SeriesID uuid.UUID               `json:"seriesId" validate:"required"`
+	Blocks   []ResourceBlockResponse `json:"blocks"`
+}
+
+type BusySlotResponse struct {
+	StartTime time.Time `json:"startTime" validate:"required"`
+	EndTime   time.Time `json:"endTime" validate:"required"`
+	Kind      string    `json:"kind" validate:"required"` // reservation or block
+}
+
+type AvailabilityResponse struct {
+	Busy []BusySlotResponse `json:"busy"`
+}
+
+func FromCreateResourceBlocksResult(r *commands.CreateResourceBlocksResult) ResourceBlockSeriesResponse {
+	resp := ResourceBlockSeriesResponse{SeriesID: r.SeriesID, Blocks: make([]ResourceBlockResponse, len(r.Blocks))}
+	for i, b := range r.Blocks {
+		resp.Blocks[i] = ResourceBlockResponse{
+			ID:        b.ID,
+			SeriesID:  r.SeriesID,
+			StartTime: b.StartTime,
+			EndTime:   b.EndTime,
+			Reason:    r.Reason,
+		}
+	}
+	return resp
+}
+
+func FromResourceBlockViews(views []*queries.ResourceBlockView) []ResourceBlockResponse {
+	resp := make([]ResourceBlockResponse, len(views))
+	for i, v := range views {
+		resp[i] = ResourceBlockResponse{
+			ID:        v.ID,
+			SeriesID:  v.SeriesID,
+			StartTime: v.StartTime,
+			EndTime:   v.EndTime,
+			Reason:    v.Reason,
+		}
+	}
+	return resp
+}
+
+func NewAvailabilityResponse(slots []*queries.BusySlot) AvailabilityResponse {
+	resp := AvailabilityResponse{Busy: make([]BusySlotResponse, len(slots))}
+	for i, s := range slots {
+		resp.Busy[i] = BusySlotResponse{StartTime: s.StartTime, EndTime: s.EndTime, Kind: s.Kind}
+	}
+	return resp
+}
@@ -0,0 +1,29 @@
+package response
+
+import (
+	"time"
+
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/google/uuid"
+)
+
+type ResourceOperatorResponse struct {
+	UserID     uuid.UUID `json:"userId" validate:"required"`
+	Email      string    `json:"email" validate:"required"`
+	Role       string    `json:"role" validate:"required"`
+	AssignedAt time.Time `json:"assignedAt" validate:"required"`
+}
+
+func FromResourceOperatorViews(vs []*queries.ResourceOperatorView) []*ResourceOperatorResponse {
+	out := make([]*ResourceOperatorResponse, len(vs))
+	for i, v := range vs {
+		out[i] = &ResourceOperatorResponse{
+			UserID:     v.UserID,
+			Email:      v.Email,
+			Role:       v.Role,
+			AssignedAt: v.AssignedAt,
+		}
+	}
+	return out
+}
@@ -0,0 +1,45 @@
+package response
+
+import (
+	"gin-clean-starter/internal/usecase/queries"
+)
+
+type RetentionTableReportResponse struct {
+	Table          string `json:"table" validate:"required"`
+	MaxAgeSeconds  int64  `json:"maxAgeSeconds" validate:"required"`
+	Cutoff         int64  `json:"cutoff" validate:"required"`
+	EligibleRows   int64  `json:"eligibleRows" validate:"required"`
+	LastRunAt      *int64 `json:"lastRunAt,omitempty"`
+	LastRunDeleted int64  `json:"lastRunDeleted" validate:"required"`
+	TotalDeleted   int64  `json:"totalDeleted" validate:"required"`
+}
+
+type RetentionReportResponse struct {
+	GeneratedAt int64                          `json:"generatedAt" validate:"required"`
+	BatchSize   int32                          `json:"batchSize" validate:"required"`
+	Tables      []RetentionTableReportResponse `json:"tables"`
+}
+
+func FromRetentionReport(r *queries.RetentionReport) *RetentionReportResponse {
+	tables := make([]RetentionTableReportResponse, len(r.Tables))
+	for i, t := range r.Tables {
+		tables[i] = RetentionTableReportResponse{
+			Table:          t.Table,
+			MaxAgeSeconds:  int64(t.MaxAge.Seconds()),
+			Cutoff:         t.Cutoff.Unix(),
+			EligibleRows:   t.EligibleRows,
+			LastRunDeleted: t.LastRunDeleted,
+			TotalDeleted:   t.TotalDeleted,
+		}
+		if t.LastRunAt != nil {
+			at := t.LastRunAt.Unix()
+			tables[i].LastRunAt = &at
+		}
+	}
+
+	return &RetentionReportResponse{
+		GeneratedAt: r.GeneratedAt.Unix(),
+		BatchSize:   r.BatchSize,
+		Tables:      tables,
+	}
+}
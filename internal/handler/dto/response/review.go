@@ -1,81 +1,236 @@
 package response
 
 import (
+	"strconv"
+	"time"
+
+	"gin-clean-starter/internal/pkg/feed"
 	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/google/uuid"
 )
 
 type ReviewResponse struct {
-	ID            string `json:"id"`
-	UserID        string `json:"userId"`
-	UserEmail     string `json:"userEmail"`
-	ResourceID    string `json:"resourceId"`
-	ResourceName  string `json:"resourceName"`
-	ReservationID string `json:"reservationId"`
-	Rating        int32  `json:"rating"`
-	Comment       string `json:"comment"`
-	CreatedAt     int64  `json:"createdAt"`
-	UpdatedAt     int64  `json:"updatedAt"`
+	ID            string  `json:"id" validate:"required"`
+	UserID        string  `json:"userId" validate:"required"`
+	UserEmail     string  `json:"userEmail" validate:"required"`
+	ResourceID    string  `json:"resourceId" validate:"required"`
+	ResourceName  string  `json:"resourceName" validate:"required"`
+	ReservationID *string `json:"reservationId"` // null for imported reviews
+	Rating        int32   `json:"rating" validate:"required"`
+	Comment       string  `json:"comment" validate:"required"`
+	Status        string  `json:"status" validate:"required"` // flagged: held for moderation as a near duplicate
+	Language      *string `json:"language,omitempty"`         // ISO 639-1; absent when undetected
+	CreatedAt     int64   `json:"createdAt" validate:"required"`
+	UpdatedAt     int64   `json:"updatedAt" validate:"required"`
 }
 
 func FromReviewView(v *queries.ReviewView) *ReviewResponse {
-	return &ReviewResponse{
-		ID:            v.ID.String(),
-		UserID:        v.UserID.String(),
-		UserEmail:     v.UserEmail,
-		ResourceID:    v.ResourceID.String(),
-		ResourceName:  v.ResourceName,
-		ReservationID: v.ReservationID.String(),
-		Rating:        v.Rating,
-		Comment:       v.Comment,
-		CreatedAt:     v.CreatedAt.Unix(),
-		UpdatedAt:     v.UpdatedAt.Unix(),
+	res := &ReviewResponse{
+		ID:           v.ID.String(),
+		UserID:       v.UserID.String(),
+		UserEmail:    v.UserEmail,
+		ResourceID:   v.ResourceID.String(),
+		ResourceName: v.ResourceName,
+		Rating:       v.Rating,
+		Comment:      v.Comment,
+		Status:       v.Status,
+		Language:     v.Language,
+		CreatedAt:    v.CreatedAt.Unix(),
+		UpdatedAt:    v.UpdatedAt.Unix(),
+	}
+	if v.ReservationID != nil {
+		id := v.ReservationID.String()
+		res.ReservationID = &id
 	}
+	return res
 }
 
 type ReviewListItemResponse struct {
-	ID        string `json:"id"`
-	UserEmail string `json:"userEmail"`
-	Rating    int32  `json:"rating"`
-	Comment   string `json:"comment"`
-	CreatedAt int64  `json:"createdAt"`
+	ID        uuid.UUID `json:"id" validate:"required"`
+	UserEmail string    `json:"userEmail" validate:"required"`
+	Rating    int32     `json:"rating" validate:"required"`
+	Comment   string    `json:"comment" validate:"required"`
+	Language  *string   `json:"language,omitempty"` // only in resource listings; absent when undetected
+	CreatedAt int64     `json:"createdAt" validate:"required"`
+	Status    string    `json:"status,omitempty"` // only in per-user listings
+}
+
+type ReviewListPageResponse struct {
+	Reviews    []ReviewListItemResponse `json:"reviews"`
+	NextCursor string                   `json:"nextCursor,omitempty"`
 }
 
-func FromReviewList(items []*queries.ReviewListItem) []*ReviewListItemResponse {
-	res := make([]*ReviewListItemResponse, len(items))
+// Items are mapped by value into one backing array: a single allocation per page.
+func FromReviewList(items []*queries.ReviewListItem) []ReviewListItemResponse {
+	res := make([]ReviewListItemResponse, len(items))
 	for i, it := range items {
-		res[i] = &ReviewListItemResponse{
-			ID:        it.ID.String(),
+		res[i] = ReviewListItemResponse{
+			ID:        it.ID,
 			UserEmail: it.UserEmail,
 			Rating:    it.Rating,
 			Comment:   it.Comment,
+			Language:  it.Language,
 			CreatedAt: it.CreatedAt.Unix(),
+			Status:    it.Status,
 		}
 	}
 	return res
 }
 
+func NewReviewListPage(items []*queries.ReviewListItem, next *queries.Cursor) ReviewListPageResponse {
+	page := ReviewListPageResponse{Reviews: FromReviewList(items)}
+	if next != nil {
+		page.NextCursor = next.After
+	}
+	return page
+}
+
+type FlaggedReviewResponse struct {
+	ID          uuid.UUID  `json:"id" validate:"required"`
+	UserID      uuid.UUID  `json:"userId" validate:"required"`
+	UserEmail   string     `json:"userEmail" validate:"required"`
+	Rating      int32      `json:"rating" validate:"required"`
+	Comment     string     `json:"comment" validate:"required"`
+	DuplicateOf *uuid.UUID `json:"duplicateOf,omitempty"` // absent once the original was deleted
+	CreatedAt   int64      `json:"createdAt" validate:"required"`
+}
+
+type FlaggedReviewListResponse struct {
+	Reviews []FlaggedReviewResponse `json:"reviews"`
+}
+
+func NewFlaggedReviewList(items []*queries.FlaggedReview) FlaggedReviewListResponse {
+	res := make([]FlaggedReviewResponse, len(items))
+	for i, it := range items {
+		res[i] = FlaggedReviewResponse{
+			ID:          it.ID,
+			UserID:      it.UserID,
+			UserEmail:   it.UserEmail,
+			Rating:      it.Rating,
+			Comment:     it.Comment,
+			DuplicateOf: it.DuplicateOf,
+			CreatedAt:   it.CreatedAt.Unix(),
+		}
+	}
+	return FlaggedReviewListResponse{Reviews: res}
+}
+
+// ReviewExportRow is one line of a review export; CSV columns follow ReviewExportCSVHeader.
+type ReviewExportRow struct {
+	ID            uuid.UUID  `json:"id" validate:"required"`
+	ResourceID    uuid.UUID  `json:"resourceId" validate:"required"`
+	ResourceName  string     `json:"resourceName" validate:"required"`
+	ReservationID *uuid.UUID `json:"reservationId"` // null for imported reviews
+	Rating        int32      `json:"rating" validate:"required"`
+	Comment       string     `json:"comment" validate:"required"`
+	Status        string     `json:"status" validate:"required"`
+	Language      string     `json:"language,omitempty"`
+	CreatedAt     time.Time  `json:"createdAt" validate:"required"`
+	UpdatedAt     time.Time  `json:"updatedAt" validate:"required"`
+}
+
+var ReviewExportCSVHeader = []string{"id", "resource_id", "resource_name", "reservation_id", "rating", "comment", "status", "language", "created_at", "updated_at"}
+
+func FromReviewExportItem(it *queries.ReviewExportItem) ReviewExportRow {
+	row := ReviewExportRow{
+		ID:            it.ID,
+		ResourceID:    it.ResourceID,
+		ResourceName:  it.ResourceName,
+		ReservationID: it.ReservationID,
+		Rating:        it.Rating,
+		Comment:       it.Comment,
+		Status:        it.Status,
+		CreatedAt:     it.CreatedAt.UTC(),
+		UpdatedAt:     it.UpdatedAt.UTC(),
+	}
+	if it.Language != nil {
+		row.Language = *it.Language
+	}
+	return row
+}
+
+func (r ReviewExportRow) CSVRecord() []string {
+	reservationID := ""
+	if r.ReservationID != nil {
+		reservationID = r.ReservationID.String()
+	}
+	return []string{
+		r.ID.String(),
+		r.ResourceID.String(),
+		r.ResourceName,
+		reservationID,
+		strconv.Itoa(int(r.Rating)),
+		r.Comment,
+		r.Status,
+		r.Language,
+		r.CreatedAt.Format(time.RFC3339),
+		r.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// reviewFeedAuthor stands in for review authors in the public feed, which unlike the JSON
+// listings is syndicated as is, so emails stay out of it.
+const reviewFeedAuthor = "Verified guest"
+
+// FromReviewFeed maps a resource's review feed to an Atom document served at selfLink. An
+// empty feed reports now as its update time.
+func FromReviewFeed(f *queries.ReviewFeed, selfLink string, now time.Time) feed.Feed {
+	updated := f.Updated
+	if updated.IsZero() {
+		updated = now
+	}
+	out := feed.Feed{
+		ID:       "urn:uuid:" + f.ResourceID.String(),
+		Title:    f.ResourceName + " reviews",
+		SelfLink: selfLink,
+		Updated:  updated,
+		Entries:  make([]feed.Entry, len(f.Entries)),
+	}
+	for i, e := range f.Entries {
+		entry := feed.Entry{
+			ID:        "urn:uuid:" + e.ID.String(),
+			Title:     "Rated " + strconv.Itoa(int(e.Rating)) + " out of 5",
+			Content:   e.Comment,
+			Author:    reviewFeedAuthor,
+			Published: e.CreatedAt,
+			Updated:   e.UpdatedAt,
+		}
+		if e.Language != nil {
+			entry.Language = *e.Language
+		}
+		out.Entries[i] = entry
+	}
+	return out
+}
+
 type ResourceRatingStatsResponse struct {
-	ResourceID    string  `json:"resourceId"`
-	TotalReviews  int32   `json:"totalReviews"`
-	AverageRating float64 `json:"averageRating"`
-	Rating1Count  int32   `json:"rating1Count"`
-	Rating2Count  int32   `json:"rating2Count"`
-	Rating3Count  int32   `json:"rating3Count"`
-	Rating4Count  int32   `json:"rating4Count"`
-	Rating5Count  int32   `json:"rating5Count"`
-	UpdatedAt     int64   `json:"updatedAt"`
+	ResourceID    string  `json:"resourceId" validate:"required"`
+	TotalReviews  int32   `json:"totalReviews" validate:"required"`
+	AverageRating float64 `json:"averageRating" validate:"required"`
+	Rating1Count  int32   `json:"rating1Count" validate:"required"`
+	Rating2Count  int32   `json:"rating2Count" validate:"required"`
+	Rating3Count  int32   `json:"rating3Count" validate:"required"`
+	Rating4Count  int32   `json:"rating4Count" validate:"required"`
+	Rating5Count  int32   `json:"rating5Count" validate:"required"`
+	UpdatedAt     int64   `json:"updatedAt" validate:"required"`
+	Summary       *string `json:"summary,omitempty"` // pros/cons of recent reviews; absent until first generated
+	// LanguageCounts maps ISO 639-1 codes to published reviews; undetected ones are not counted.
+	LanguageCounts map[string]int32 `json:"languageCounts" validate:"required"`
 }
 
 func FromResourceRatingStats(s *queries.ResourceRatingStats) *ResourceRatingStatsResponse {
 	return &ResourceRatingStatsResponse{
-		ResourceID:    s.ResourceID.String(),
-		TotalReviews:  s.TotalReviews,
-		AverageRating: s.AverageRating,
-		Rating1Count:  s.Rating1Count,
-		Rating2Count:  s.Rating2Count,
-		Rating3Count:  s.Rating3Count,
-		Rating4Count:  s.Rating4Count,
-		Rating5Count:  s.Rating5Count,
-		UpdatedAt:     s.UpdatedAt.Unix(),
+		ResourceID:     s.ResourceID.String(),
+		TotalReviews:   s.TotalReviews,
+		AverageRating:  s.AverageRating,
+		Rating1Count:   s.Rating1Count,
+		Rating2Count:   s.Rating2Count,
+		Rating3Count:   s.Rating3Count,
+		Rating4Count:   s.Rating4Count,
+		Rating5Count:   s.Rating5Count,
+		UpdatedAt:      s.UpdatedAt.Unix(),
+		Summary:        s.Summary,
+		LanguageCounts: s.LanguageCounts,
 	}
 }
@@ -0,0 +1,38 @@
+package response
+
+import (
+	"gin-clean-starter/internal/usecase/commands"
+
+	"github.com/google/uuid"
+)
+
+// ReviewImportItemResponse is one review of an import; index is its position in the
+// request. code says why a rejected review was left out.
+type ReviewImportItemResponse struct {
+	Index      int        `json:"index" validate:"required"`
+	ExternalID string     `json:"externalId" validate:"required"`
+	Status     string     `json:"status" validate:"required" example:"imported"` // imported, duplicate or rejected
+	ReviewID   *uuid.UUID `json:"reviewId,omitempty"`
+	Code       string     `json:"code,omitempty" example:"REVIEW_AUTHOR_NOT_FOUND"`
+}
+
+type ReviewImportResponse struct {
+	Imported   int                        `json:"imported"`
+	Duplicates int                        `json:"duplicates"`
+	Rejected   int                        `json:"rejected"`
+	Items      []ReviewImportItemResponse `json:"items"`
+}
+
+func FromReviewImportReport(r *commands.ReviewImportReport) ReviewImportResponse {
+	resp := ReviewImportResponse{Imported: r.Imported, Duplicates: r.Duplicates, Rejected: r.Rejected, Items: make([]ReviewImportItemResponse, len(r.Items))}
+	for i, it := range r.Items {
+		resp.Items[i] = ReviewImportItemResponse{
+			Index:      it.Index,
+			ExternalID: it.ExternalID,
+			Status:     string(it.Status),
+			ReviewID:   it.ReviewID,
+			Code:       string(it.Code),
+		}
+	}
+	return resp
+}
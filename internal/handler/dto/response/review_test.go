@@ -0,0 +1,67 @@
+//go:build unit
+
+package response_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func reviewListItems(n int) []*queries.ReviewListItem {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	items := make([]*queries.ReviewListItem, n)
+	for i := range items {
+		items[i] = &queries.ReviewListItem{
+			ID:        uuid.New(),
+			UserEmail: fmt.Sprintf("user%d@example.com", i),
+			Rating:    int32(i%5 + 1),
+			Comment:   "Quiet room, good projector.",
+			CreatedAt: base.Add(time.Duration(i) * time.Minute),
+		}
+	}
+	return items
+}
+
+// Mapping must stay a single allocation (the backing array) however long the page is.
+func TestFromReviewListAllocs(t *testing.T) {
+	for _, n := range []int{1, 1000} {
+		items := reviewListItems(n)
+
+		allocs := testing.AllocsPerRun(10, func() {
+			_ = response.FromReviewList(items)
+		})
+
+		assert.Equal(t, 1.0, allocs, "items=%d", n)
+	}
+}
+
+func TestNewReviewListPage(t *testing.T) {
+	items := reviewListItems(2)
+
+	page := response.NewReviewListPage(items, &queries.Cursor{After: "next"})
+	assert.Len(t, page.Reviews, 2)
+	assert.Equal(t, items[1].ID, page.Reviews[1].ID)
+	assert.Equal(t, "next", page.NextCursor)
+
+	last := response.NewReviewListPage(items, nil)
+	assert.Empty(t, last.NextCursor)
+}
+
+func BenchmarkFromReviewList(b *testing.B) {
+	for _, n := range []int{100, 10000} {
+		items := reviewListItems(n)
+		b.Run(fmt.Sprintf("items=%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				_ = response.FromReviewList(items)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package response
+
+import (
+	"gin-clean-starter/internal/usecase/queries"
+)
+
+type RoleResponse struct {
+	Name        string   `json:"name" validate:"required"`
+	Description string   `json:"description" validate:"required"`
+	IsSystem    bool     `json:"isSystem" validate:"required"`
+	Permissions []string `json:"permissions"`
+	CreatedAt   int64    `json:"createdAt" validate:"required"`
+	UpdatedAt   int64    `json:"updatedAt" validate:"required"`
+}
+
+func FromRoleView(v *queries.RoleView) *RoleResponse {
+	return &RoleResponse{
+		Name:        v.Name,
+		Description: v.Description,
+		IsSystem:    v.IsSystem,
+		Permissions: v.Permissions,
+		CreatedAt:   v.CreatedAt.Unix(),
+		UpdatedAt:   v.UpdatedAt.Unix(),
+	}
+}
+
+func FromRoleViews(vs []*queries.RoleView) []*RoleResponse {
+	out := make([]*RoleResponse, len(vs))
+	for i, v := range vs {
+		out[i] = FromRoleView(v)
+	}
+	return out
+}
+
+type PermissionResponse struct {
+	Name        string `json:"name" validate:"required"`
+	Description string `json:"description" validate:"required"`
+}
+
+func FromPermissionViews(vs []*queries.PermissionView) []*PermissionResponse {
+	out := make([]*PermissionResponse, len(vs))
+	for i, v := range vs {
+		out[i] = &PermissionResponse{
+			Name:        v.Name,
+			Description: v.Description,
+		}
+	}
+	return out
+}
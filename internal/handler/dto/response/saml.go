@@ -0,0 +1,35 @@
+package response
+
+import (
+	"time"
+
+	"gin-clean-starter/internal/usecase/queries"
+)
+
+// SAMLConnectionResponse is the IdP a company trusts and the service provider URLs to
+// register with it.
+type SAMLConnectionResponse struct {
+	IDPEntityID    string            `json:"idpEntityId" validate:"required"`
+	EmailAttribute string            `json:"emailAttribute"`
+	RoleAttribute  string            `json:"roleAttribute"`
+	RoleMapping    map[string]string `json:"roleMapping" validate:"required"`
+	DefaultRole    string            `json:"defaultRole" validate:"required"`
+	SPEntityID     string            `json:"spEntityId" validate:"required"`
+	ACSURL         string            `json:"acsUrl" validate:"required"`
+	LoginURL       string            `json:"loginUrl" validate:"required"`
+	UpdatedAt      time.Time         `json:"updatedAt" validate:"required"`
+}
+
+func FromSAMLConnectionView(v *queries.SAMLConnectionView) *SAMLConnectionResponse {
+	return &SAMLConnectionResponse{
+		IDPEntityID:    v.Connection.IDPEntityID,
+		EmailAttribute: v.Connection.EmailAttribute,
+		RoleAttribute:  v.Connection.RoleAttribute,
+		RoleMapping:    v.Connection.RoleMapping,
+		DefaultRole:    v.Connection.DefaultRole,
+		SPEntityID:     v.Endpoints.EntityID,
+		ACSURL:         v.Endpoints.ACSURL,
+		LoginURL:       v.Endpoints.LoginURL,
+		UpdatedAt:      v.Connection.UpdatedAt,
+	}
+}
@@ -0,0 +1,39 @@
+package response
+
+import (
+	"time"
+
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/google/uuid"
+)
+
+type SecurityEventListResponse struct {
+	Events     []SecurityEventResponse `json:"events"`
+	NextCursor string                  `json:"nextCursor,omitempty"`
+}
+
+type SecurityEventResponse struct {
+	ID        uuid.UUID `json:"id" validate:"required"`
+	Action    string    `json:"action" validate:"required"` // auth.password_changed, auth.email_changed, auth.new_device_login, auth.2fa_enabled, auth.2fa_disabled, auth.2fa_recovery_code_used, auth.refresh_token_reused, auth.sessions_revoked, auth.session_revoked, auth.api_key_issued, auth.api_key_revoked, auth.account_locked, auth.account_unlocked or auth.role_changed
+	IPAddress string    `json:"ipAddress,omitempty"`
+	UserAgent string    `json:"userAgent,omitempty"`
+	CreatedAt time.Time `json:"createdAt" validate:"required"`
+}
+
+func NewSecurityEventPage(events []*queries.SecurityEvent, next *queries.Cursor) SecurityEventListResponse {
+	page := SecurityEventListResponse{Events: make([]SecurityEventResponse, len(events))}
+	for i, e := range events {
+		page.Events[i] = SecurityEventResponse{
+			ID:        e.ID,
+			Action:    e.Action,
+			IPAddress: e.IPAddress,
+			UserAgent: e.UserAgent,
+			CreatedAt: e.CreatedAt,
+		}
+	}
+	if next != nil {
+		page.NextCursor = next.After
+	}
+	return page
+}
@@ -0,0 +1,40 @@
+package response
+
+import (
+	"time"
+
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/google/uuid"
+)
+
+// SessionResponse is a signed-in device. deviceId is stable across the session's refreshes
+// and identifies the device key or, without one, the user agent; it and userAgent are empty
+// for clients that sent neither.
+type SessionResponse struct {
+	ID         uuid.UUID `json:"id" validate:"required"`
+	DeviceID   string    `json:"deviceId"`
+	IPAddress  string    `json:"ipAddress" validate:"required"`
+	UserAgent  string    `json:"userAgent"`
+	CreatedAt  time.Time `json:"createdAt" validate:"required"`
+	LastSeenAt time.Time `json:"lastSeenAt" validate:"required"`
+	ExpiresAt  time.Time `json:"expiresAt" validate:"required"`
+	Current    bool      `json:"current" validate:"required"`
+}
+
+func FromSessionViews(vs []*queries.SessionView, current uuid.UUID) []SessionResponse {
+	out := make([]SessionResponse, len(vs))
+	for i, v := range vs {
+		out[i] = SessionResponse{
+			ID:         v.ID,
+			DeviceID:   v.DeviceFingerprint,
+			IPAddress:  v.IPAddress,
+			UserAgent:  v.UserAgent,
+			CreatedAt:  v.CreatedAt,
+			LastSeenAt: v.LastSeenAt,
+			ExpiresAt:  v.ExpiresAt,
+			Current:    v.ID == current,
+		}
+	}
+	return out
+}
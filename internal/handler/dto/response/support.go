@@ -0,0 +1,27 @@
+package response
+
+import (
+	"time"
+
+	"gin-clean-starter/internal/usecase/commands"
+
+	"github.com/google/uuid"
+)
+
+type SupportSessionResponse struct {
+	SessionID   uuid.UUID `json:"sessionId" validate:"required"`
+	CompanyID   uuid.UUID `json:"companyId" validate:"required"`
+	AccessToken string    `json:"accessToken" validate:"required"`
+	TokenType   string    `json:"tokenType" validate:"required"`
+	ExpiresAt   time.Time `json:"expiresAt" validate:"required"`
+}
+
+func FromSupportSessionResult(r *commands.SupportSessionResult) *SupportSessionResponse {
+	return &SupportSessionResponse{
+		SessionID:   r.SessionID,
+		CompanyID:   r.CompanyID,
+		AccessToken: r.AccessToken,
+		TokenType:   "Bearer",
+		ExpiresAt:   r.ExpiresAt,
+	}
+}
@@ -0,0 +1,66 @@
+package response
+
+import (
+	"time"
+
+	"gin-clean-starter/internal/usecase/queries"
+	"gin-clean-starter/internal/usecase/shared"
+)
+
+type AdoptionReportResponse struct {
+	From     string                    `json:"from" validate:"required"` // YYYY-MM-DD
+	To       string                    `json:"to" validate:"required"`   // YYYY-MM-DD, inclusive
+	Features []FeatureAdoptionResponse `json:"features"`
+}
+
+type FeatureAdoptionResponse struct {
+	Feature   string `json:"feature" validate:"required"` // "<METHOD> <route>", e.g. "GET /api/reservations/:id"
+	Requests  int64  `json:"requests" validate:"required"`
+	Companies int64  `json:"companies" validate:"required"`
+}
+
+func FromAdoptionReport(report *queries.AdoptionReport) AdoptionReportResponse {
+	res := AdoptionReportResponse{
+		From:     report.From.Format("2006-01-02"),
+		To:       report.To.Format("2006-01-02"),
+		Features: make([]FeatureAdoptionResponse, len(report.Features)),
+	}
+	for i, f := range report.Features {
+		res.Features[i] = FeatureAdoptionResponse{
+			Feature:   f.Feature,
+			Requests:  f.Requests,
+			Companies: f.Companies,
+		}
+	}
+	return res
+}
+
+type LoginReplayStatsResponse struct {
+	Stored    int64     `json:"stored" validate:"required"`    // successful logins kept for replay
+	Replayed  int64     `json:"replayed" validate:"required"`  // duplicates answered after the first login finished
+	Coalesced int64     `json:"coalesced" validate:"required"` // duplicates that waited for the first login
+	Since     time.Time `json:"since" validate:"required"`     // when this instance started counting
+}
+
+func FromLoginReplayStats(stats shared.LoginReplayStats) LoginReplayStatsResponse {
+	return LoginReplayStatsResponse{
+		Stored:    stats.Stored,
+		Replayed:  stats.Replayed,
+		Coalesced: stats.Coalesced,
+		Since:     stats.Since,
+	}
+}
+
+type CanceledRequestStatsResponse struct {
+	Total  int64            `json:"total" validate:"required"`  // requests abandoned by their client
+	Routes map[string]int64 `json:"routes" validate:"required"` // by route template, e.g. "GET /api/reservations/:id"
+	Since  time.Time        `json:"since" validate:"required"`  // when this instance started counting
+}
+
+func FromCanceledRequestStats(stats shared.CanceledRequestStats) CanceledRequestStatsResponse {
+	return CanceledRequestStatsResponse{
+		Total:  stats.Total,
+		Routes: stats.Routes,
+		Since:  stats.Since,
+	}
+}
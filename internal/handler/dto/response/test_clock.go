@@ -0,0 +1,10 @@
+//go:build e2e
+
+package response
+
+import "time"
+
+type TestClockResponse struct {
+	Now    time.Time `json:"now"`
+	Offset string    `json:"offset"`
+}
@@ -0,0 +1,19 @@
+package response
+
+import (
+	"time"
+
+	"gin-clean-starter/internal/usecase/commands"
+)
+
+type TOSAcceptanceResponse struct {
+	Version    string    `json:"version" validate:"required"`
+	AcceptedAt time.Time `json:"acceptedAt" validate:"required"`
+}
+
+func FromTOSAcceptResult(r *commands.TOSAcceptResult) *TOSAcceptanceResponse {
+	return &TOSAcceptanceResponse{
+		Version:    r.Version,
+		AcceptedAt: r.AcceptedAt,
+	}
+}
@@ -0,0 +1,45 @@
+package response
+
+import (
+	"time"
+
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/google/uuid"
+)
+
+type UsageReportResponse struct {
+	Month     string                 `json:"month" validate:"required"` // YYYY-MM
+	Companies []CompanyUsageResponse `json:"companies"`
+}
+
+type CompanyUsageResponse struct {
+	CompanyID   uuid.UUID `json:"companyId" validate:"required"`
+	CompanyName string    `json:"companyName" validate:"required"`
+	Requests    int64     `json:"requests" validate:"required"`
+	BytesIn     int64     `json:"bytesIn" validate:"required"`
+	BytesOut    int64     `json:"bytesOut" validate:"required"`
+	SoftQuota   *int64    `json:"softQuota,omitempty"`
+	HardQuota   *int64    `json:"hardQuota,omitempty"`
+	UpdatedAt   time.Time `json:"updatedAt" validate:"required"`
+}
+
+func FromUsageReport(report *queries.UsageReport) UsageReportResponse {
+	res := UsageReportResponse{
+		Month:     report.Month.Format("2006-01"),
+		Companies: make([]CompanyUsageResponse, len(report.Companies)),
+	}
+	for i, u := range report.Companies {
+		res.Companies[i] = CompanyUsageResponse{
+			CompanyID:   u.CompanyID,
+			CompanyName: u.CompanyName,
+			Requests:    u.Requests,
+			BytesIn:     u.BytesIn,
+			BytesOut:    u.BytesOut,
+			SoftQuota:   u.SoftQuota,
+			HardQuota:   u.HardQuota,
+			UpdatedAt:   u.UpdatedAt,
+		}
+	}
+	return res
+}
@@ -0,0 +1,46 @@
+package response
+
+import (
+	"encoding/json"
+	"time"
+
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/google/uuid"
+)
+
+type ActivityListResponse struct {
+	Items      []ActivityItemResponse `json:"items"`
+	NextCursor string                 `json:"nextCursor,omitempty"`
+}
+
+// ActivityItemResponse is one timeline entry. subjectId is the reservation, review or
+// referral it is about. data depends on kind: status, startTime and endTime of a
+// reservation, rating of a review, party and amountCents of a credit, points and expiresAt
+// of points, topic of a notification.
+type ActivityItemResponse struct {
+	ID         uuid.UUID       `json:"id" validate:"required"`
+	Kind       string          `json:"kind" validate:"required" enums:"reservation_created,reservation_canceled,review_posted,credit_earned,points_earned,notification"`
+	SubjectID  uuid.UUID       `json:"subjectId" validate:"required"`
+	ResourceID *uuid.UUID      `json:"resourceId,omitempty"`
+	Data       json.RawMessage `json:"data" swaggertype:"object"`
+	OccurredAt time.Time       `json:"occurredAt" validate:"required"`
+}
+
+func NewActivityPage(items []*queries.ActivityItem, next *queries.Cursor) ActivityListResponse {
+	page := ActivityListResponse{Items: make([]ActivityItemResponse, len(items))}
+	for i, item := range items {
+		page.Items[i] = ActivityItemResponse{
+			ID:         item.ID,
+			Kind:       item.Kind,
+			SubjectID:  item.SubjectID,
+			ResourceID: item.ResourceID,
+			Data:       item.Data,
+			OccurredAt: item.OccurredAt,
+		}
+	}
+	if next != nil {
+		page.NextCursor = next.After
+	}
+	return page
+}
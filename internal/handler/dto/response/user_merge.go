@@ -0,0 +1,41 @@
+package response
+
+import (
+	"gin-clean-starter/internal/usecase/commands"
+
+	"github.com/google/uuid"
+)
+
+// UserMergeResponse counts what moved to the target account, or on a dry run what would.
+// Sessions are signed out rather than moved.
+type UserMergeResponse struct {
+	DryRun          bool      `json:"dryRun"`
+	SourceID        uuid.UUID `json:"sourceId" validate:"required"`
+	TargetID        uuid.UUID `json:"targetId" validate:"required"`
+	Reservations    int64     `json:"reservations"`
+	Reviews         int64     `json:"reviews"`
+	ReferralCredits int64     `json:"referralCredits"`
+	LoyaltyEntries  int64     `json:"loyaltyEntries"`
+	ActivityEntries int64     `json:"activityEntries"`
+	Identities      int64     `json:"identities"`
+	Messages        int64     `json:"messages"`
+	Transfers       int64     `json:"transfers"`
+	Sessions        int64     `json:"sessions"`
+}
+
+func FromUserMergeResult(r *commands.UserMergeResult) UserMergeResponse {
+	return UserMergeResponse{
+		DryRun:          r.DryRun,
+		SourceID:        r.SourceID,
+		TargetID:        r.TargetID,
+		Reservations:    r.Counts.Reservations,
+		Reviews:         r.Counts.Reviews,
+		ReferralCredits: r.Counts.ReferralCredits,
+		LoyaltyEntries:  r.Counts.LoyaltyEntries,
+		ActivityEntries: r.Counts.ActivityEntries,
+		Identities:      r.Counts.Identities,
+		Messages:        r.Counts.Messages,
+		Transfers:       r.Counts.Transfers,
+		Sessions:        r.Counts.Sessions,
+	}
+}
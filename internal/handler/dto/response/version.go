@@ -0,0 +1,23 @@
+package response
+
+import (
+	"gin-clean-starter/internal/pkg/buildinfo"
+)
+
+type VersionResponse struct {
+	Version   string `json:"version" validate:"required"`
+	Commit    string `json:"commit,omitempty"`
+	BuildTime string `json:"buildTime,omitempty"` // RFC 3339
+	GoVersion string `json:"goVersion" validate:"required"`
+	Modified  bool   `json:"modified"` // built from a working tree with uncommitted changes
+}
+
+func FromBuildInfo(info buildinfo.Info) VersionResponse {
+	return VersionResponse{
+		Version:   info.Version,
+		Commit:    info.Commit,
+		BuildTime: info.BuildTime,
+		GoVersion: info.GoVersion,
+		Modified:  info.Modified,
+	}
+}
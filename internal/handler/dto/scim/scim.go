@@ -0,0 +1,117 @@
+// Package scim holds the wire format of the provisioning API, a subset of SCIM 2.0
+// (RFC 7643, RFC 7644). Its key names follow the RFCs, not the API's lowerCamelCase rule.
+package scim
+
+import (
+	"time"
+
+	"gin-clean-starter/internal/usecase/queries"
+)
+
+// ContentType is the media type of every provisioning request and reply body.
+const ContentType = "application/scim+json"
+
+const (
+	SchemaUser         = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SchemaListResponse = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	SchemaPatchOp      = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	SchemaError        = "urn:ietf:params:scim:api:messages:2.0:Error"
+)
+
+// Error types of RFC 7644 section 3.12 sent with 400 and 409 replies.
+const (
+	ErrorTypeInvalidFilter = "invalidFilter"
+	ErrorTypeInvalidValue  = "invalidValue"
+	ErrorTypeInvalidPath   = "invalidPath"
+	ErrorTypeUniqueness    = "uniqueness"
+)
+
+// CreateUserRequest provisions a member. UserName is the sign-in email; the role is
+// always viewer.
+type CreateUserRequest struct {
+	Schemas    []string `json:"schemas"`
+	UserName   string   `json:"userName" binding:"required,max=254"`
+	ExternalID string   `json:"externalId" binding:"max=255"`
+	// Active defaults to true.
+	Active   *bool  `json:"active"`
+	Password string `json:"password" binding:"max=72"`
+}
+
+// PatchUserRequest changes a member. Only "active" can be replaced.
+type PatchUserRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations" binding:"required,min=1,dive"`
+}
+
+// PatchOperation replaces "active", either by path or as a field of an object value.
+type PatchOperation struct {
+	Op    string `json:"op" binding:"required"`
+	Path  string `json:"path"`
+	Value any    `json:"value"`
+}
+
+type User struct {
+	Schemas    []string `json:"schemas"`
+	ID         string   `json:"id"`
+	ExternalID string   `json:"externalId,omitempty"`
+	UserName   string   `json:"userName"`
+	Active     bool     `json:"active"`
+	Emails     []Email  `json:"emails"`
+	Meta       Meta     `json:"meta"`
+}
+
+type Email struct {
+	Value   string `json:"value"`
+	Primary bool   `json:"primary"`
+}
+
+type Meta struct {
+	ResourceType string    `json:"resourceType"`
+	Created      time.Time `json:"created"`
+	LastModified time.Time `json:"lastModified"`
+}
+
+type ListResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int64    `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []User   `json:"Resources"`
+}
+
+type ErrorResponse struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail"`
+}
+
+func FromProvisionedUser(u *queries.ProvisionedUser) User {
+	return User{
+		Schemas:    []string{SchemaUser},
+		ID:         u.ID.String(),
+		ExternalID: u.ExternalID,
+		UserName:   u.Email,
+		Active:     u.Active,
+		Emails:     []Email{{Value: u.Email, Primary: true}},
+		Meta: Meta{
+			ResourceType: "User",
+			Created:      u.CreatedAt,
+			LastModified: u.UpdatedAt,
+		},
+	}
+}
+
+func FromProvisionedUsers(users []*queries.ProvisionedUser, total int64, startIndex int) ListResponse {
+	resources := make([]User, len(users))
+	for i, u := range users {
+		resources[i] = FromProvisionedUser(u)
+	}
+	return ListResponse{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	}
+}
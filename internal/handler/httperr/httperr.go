@@ -1,24 +1,102 @@
 package httperr
 
 import (
+	"context"
+	"errors"
+	"net/http"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/pkg/errs"
+
 	"github.com/gin-gonic/gin"
 )
 
+// Response is the body of every error reply; it is the documented failure schema.
 type Response struct {
 	Status int `json:"-"`
 	Error  struct {
-		Message string `json:"message"`
-	} `json:"error"`
+		Code    errs.Code `json:"code" validate:"required" swaggertype:"string" example:"RESERVATION_CONFLICT"`
+		Message string    `json:"message" validate:"required"`
+	} `json:"error" validate:"required"`
 	Detail any `json:"detail,omitempty"`
 }
 
+// Codes for errors without a code of their own, chosen by status.
+const (
+	CodeBadRequest          errs.Code = "BAD_REQUEST"           // malformed or invalid request
+	CodeUnauthorized        errs.Code = "UNAUTHORIZED"          // authentication missing or invalid
+	CodeForbidden           errs.Code = "FORBIDDEN"             // authenticated but not allowed
+	CodeNotFound            errs.Code = "NOT_FOUND"             // target does not exist
+	CodeConflict            errs.Code = "CONFLICT"              // conflicts with the current state
+	CodeUnprocessableEntity errs.Code = "UNPROCESSABLE_ENTITY"  // well-formed but semantically invalid
+	CodeTooManyRequests     errs.Code = "TOO_MANY_REQUESTS"     // rate limit exceeded
+	CodeInternal            errs.Code = "INTERNAL_ERROR"        // unexpected server failure
+	CodeUnavailable         errs.Code = "SERVICE_UNAVAILABLE"   // temporarily unavailable; retry later
+	CodeClientClosed        errs.Code = "CLIENT_CLOSED_REQUEST" // client went away before the reply
+)
+
+// StatusClientClosedRequest is the nginx status for a request its client abandoned before
+// the reply; net/http has no name for it. Nobody reads the body, but logs and metrics see
+// the status instead of a server failure.
+const StatusClientClosedRequest = 499
+
+// Codes for repository constraint violations that fell through to a 500.
+const (
+	CodeAlreadyExists       errs.Code = "ALREADY_EXISTS"       // unique constraint violated
+	CodeDataConflict        errs.Code = "DATA_CONFLICT"        // exclusion constraint violated (e.g. overlapping slot)
+	CodeReferenceNotFound   errs.Code = "REFERENCE_NOT_FOUND"  // referenced row does not exist
+	CodeConstraintViolation errs.Code = "CONSTRAINT_VIOLATION" // check constraint violated
+)
+
+var statusCodes = map[int]errs.Code{
+	http.StatusBadRequest:          CodeBadRequest,
+	http.StatusUnauthorized:        CodeUnauthorized,
+	http.StatusForbidden:           CodeForbidden,
+	http.StatusNotFound:            CodeNotFound,
+	http.StatusConflict:            CodeConflict,
+	http.StatusUnprocessableEntity: CodeUnprocessableEntity,
+	http.StatusTooManyRequests:     CodeTooManyRequests,
+	http.StatusInternalServerError: CodeInternal,
+	http.StatusServiceUnavailable:  CodeUnavailable,
+}
+
+type constraintRule struct {
+	kind    infra.RepositoryErrorKind
+	status  int
+	code    errs.Code
+	message string
+}
+
+// Constraint violations that reach a handler's 500 fallback are still caused by the
+// request, so they are reported as such instead of as a server failure.
+var constraintRules = []constraintRule{
+	{infra.KindDuplicateKey, http.StatusConflict, CodeAlreadyExists, "Resource already exists"},
+	{infra.KindConflict, http.StatusConflict, CodeDataConflict, "Request conflicts with existing data"},
+	{infra.KindForeignKeyViolated, http.StatusUnprocessableEntity, CodeReferenceNotFound, "Referenced resource does not exist"},
+	{infra.KindCheckViolated, http.StatusUnprocessableEntity, CodeConstraintViolation, "Request violates a data constraint"},
+}
+
 // preserves original error for future monitoring
 func AbortWithError(c *gin.Context, status int, err error, msg string, detail any) {
 	if err == nil {
 		panic("AbortWithError: err cannot be nil")
 	}
 
+	var code errs.Code
+	if status >= http.StatusInternalServerError && ClientGone(c) {
+		// The canceled context is what failed the queries, not the server.
+		status, code, msg = StatusClientClosedRequest, CodeClientClosed, "Client closed request"
+	} else if status == http.StatusInternalServerError {
+		if rule, ok := constraintRuleFor(err); ok {
+			status, code, msg = rule.status, rule.code, rule.message
+		}
+	}
+	if code == "" {
+		code = CodeFor(status, err)
+	}
+
 	resp := Response{Status: status}
+	resp.Error.Code = code
 	resp.Error.Message = msg
 	resp.Detail = detail
 
@@ -29,3 +107,38 @@ func AbortWithError(c *gin.Context, status int, err error, msg string, detail an
 	})
 	c.AbortWithStatusJSON(status, resp)
 }
+
+// ClientGone reports whether the client disconnected, which cancels the request context.
+func ClientGone(c *gin.Context) bool {
+	return c.Request != nil && errors.Is(c.Request.Context().Err(), context.Canceled)
+}
+
+func constraintRuleFor(err error) (constraintRule, bool) {
+	for _, rule := range constraintRules {
+		if infra.IsKind(err, rule.kind) {
+			return rule, true
+		}
+	}
+	return constraintRule{}, false
+}
+
+// CodeFor picks the code reported with status: for non-5xx replies the error's own code,
+// then its constraint code, otherwise the generic code of the status. Server errors never
+// expose a domain code.
+func CodeFor(status int, err error) errs.Code {
+	if status < http.StatusInternalServerError {
+		if code := errs.CodeOf(err); code != "" {
+			return code
+		}
+		if rule, ok := constraintRuleFor(err); ok {
+			return rule.code
+		}
+	}
+	if code, ok := statusCodes[status]; ok {
+		return code
+	}
+	if status >= http.StatusInternalServerError {
+		return CodeInternal
+	}
+	return CodeBadRequest
+}
@@ -0,0 +1,125 @@
+//go:build unit
+
+package httperr_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAbortWithError_MapsConstraintKinds(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	testCases := []struct {
+		name       string
+		status     int
+		err        error
+		wantStatus int
+	}{
+		{"duplicate key", http.StatusInternalServerError, infra.WrapRepoErr("x", nil, infra.KindDuplicateKey), http.StatusConflict},
+		{"exclusion conflict", http.StatusInternalServerError, infra.WrapRepoErr("x", nil, infra.KindConflict), http.StatusConflict},
+		{"foreign key", http.StatusInternalServerError, infra.WrapRepoErr("x", nil, infra.KindForeignKeyViolated), http.StatusUnprocessableEntity},
+		{"check violation", http.StatusInternalServerError, infra.WrapRepoErr("x", nil, infra.KindCheckViolated), http.StatusUnprocessableEntity},
+		{"db failure stays 500", http.StatusInternalServerError, infra.WrapRepoErr("x", nil, infra.KindDBFailure), http.StatusInternalServerError},
+		{"plain error stays 500", http.StatusInternalServerError, errors.New("boom"), http.StatusInternalServerError},
+		{"explicit status is kept", http.StatusNotFound, infra.WrapRepoErr("x", nil, infra.KindConflict), http.StatusNotFound},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			httperr.AbortWithError(c, tc.status, tc.err, "Internal server error", nil)
+
+			assert.Equal(t, tc.wantStatus, w.Code)
+			assert.True(t, c.IsAborted())
+		})
+	}
+}
+
+func TestAbortWithError_ReportsCodes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	errTaken := errs.NewCoded("SLOT_TAKEN", "slot taken")
+
+	testCases := []struct {
+		name     string
+		status   int
+		err      error
+		wantCode errs.Code
+	}{
+		{"sentinel code", http.StatusConflict, errs.Wrap(errTaken, "create"), "SLOT_TAKEN"},
+		{"marked with sentinel", http.StatusConflict, errs.Mark(errors.New("pg"), errTaken), "SLOT_TAKEN"},
+		{"constraint kind on explicit status", http.StatusConflict, infra.WrapRepoErr("x", nil, infra.KindDuplicateKey), httperr.CodeAlreadyExists},
+		{"uncoded falls back to status", http.StatusNotFound, errors.New("missing"), httperr.CodeNotFound},
+		{"unknown 4xx status", http.StatusTeapot, errors.New("tea"), httperr.CodeBadRequest},
+		{"server errors hide domain codes", http.StatusInternalServerError, errs.Wrap(errTaken, "unexpected"), httperr.CodeInternal},
+		{"constraint fallback", http.StatusInternalServerError, infra.WrapRepoErr("x", nil, infra.KindConflict), httperr.CodeDataConflict},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			httperr.AbortWithError(c, tc.status, tc.err, "message", nil)
+
+			var body httperr.Response
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+			assert.Equal(t, tc.wantCode, body.Error.Code)
+		})
+	}
+}
+
+func TestAbortWithError_ClientClosedRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("server errors of an abandoned request are reported as 499", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		c.Request = httptest.NewRequest(http.MethodGet, "/slow", nil).WithContext(ctx)
+
+		httperr.AbortWithError(c, http.StatusInternalServerError, context.Canceled, "Internal server error", nil)
+
+		assert.Equal(t, httperr.StatusClientClosedRequest, w.Code)
+		var body httperr.Response
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		assert.Equal(t, httperr.CodeClientClosed, body.Error.Code)
+	})
+
+	t.Run("client errors keep their status", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		c.Request = httptest.NewRequest(http.MethodGet, "/slow", nil).WithContext(ctx)
+
+		httperr.AbortWithError(c, http.StatusNotFound, errors.New("missing"), "Not found", nil)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("a live request stays 500", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/slow", nil)
+
+		httperr.AbortWithError(c, http.StatusInternalServerError, errors.New("boom"), "Internal server error", nil)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
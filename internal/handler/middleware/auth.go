@@ -1,104 +1,258 @@
 package middleware
 
 import (
+	"context"
+	"errors"
 	"log/slog"
 	"net/http"
 	"strings"
 
 	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/httperr"
 	"gin-clean-starter/internal/pkg/cookie"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/pkg/reqctx"
 	"gin-clean-starter/internal/usecase"
+	"gin-clean-starter/internal/usecase/commands"
+	"gin-clean-starter/internal/usecase/queries"
+	"gin-clean-starter/internal/usecase/shared"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 )
 
+var (
+	errAccessTokenMissing = errs.NewCoded("ACCESS_TOKEN_REQUIRED", "access token required")
+	errSupportReadOnly    = errs.NewCoded("SUPPORT_SESSION_READ_ONLY", "support session attempted a mutating request")
+	errSupportOutOfScope  = errs.NewCoded("SUPPORT_SESSION_OUT_OF_SCOPE", "support session requested a route that is not company-scoped")
+	errIdentityMissing    = errs.New("permission check ran without an authenticated identity")
+	errPermissionDenied   = errs.NewCoded("PERMISSION_DENIED", "permission denied")
+	errTOSNotAccepted     = errs.NewCoded("TOS_ACCEPTANCE_REQUIRED", "current terms of service not accepted")
+	errAPIKeyNotAllowed   = errs.NewCoded("API_KEY_NOT_ALLOWED", "api key used on a route reserved for the user")
+)
+
 type AuthMiddleware struct {
 	tokenValidator usecase.TokenValidator
+	permissions    shared.PermissionResolver
+	support        commands.SupportCommands
+	tos            shared.TOSGate
+	sessions       shared.SessionGate
+	apiKeys        queries.APIKeyQueries
 }
 
+// APIKeyHeader carries a machine client's API key, accepted where no JWT is sent.
+const APIKeyHeader = "X-API-Key"
+
 const (
-	ctxUserIDKey   = "user_id"
-	ctxUserRoleKey = "user_role"
+	ctxUserIDKey       = "user_id"
+	ctxUserRoleKey     = "user_role"
+	ctxSupportScopeKey = "support_scope"
+	ctxSupportDenied   = "support_denied"
+	ctxTOSPendingKey   = "tos_pending"
+	ctxAccessTokenKey  = "access_token"
+	ctxAPIKeyIDKey     = "api_key_id"
 )
 
-var roleHierarchy = map[user.Role]int{
-	user.RoleViewer:   1,
-	user.RoleOperator: 2,
-	user.RoleAdmin:    3,
-}
-
-func NewAuthMiddleware(tokenValidator usecase.TokenValidator) *AuthMiddleware {
+func NewAuthMiddleware(tokenValidator usecase.TokenValidator, permissions shared.PermissionResolver, support commands.SupportCommands, tos shared.TOSGate, sessions shared.SessionGate, apiKeys queries.APIKeyQueries) *AuthMiddleware {
 	return &AuthMiddleware{
 		tokenValidator: tokenValidator,
+		permissions:    permissions,
+		support:        support,
+		tos:            tos,
+		sessions:       sessions,
+		apiKeys:        apiKeys,
 	}
 }
 
 func (m *AuthMiddleware) RequireAuth() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		var token string
-
-		token = cookie.GetAccessToken(c)
-
+		token := cookie.GetAccessToken(c)
 		if token == "" {
-			authHeader := c.GetHeader("Authorization")
-			if authHeader != "" && strings.HasPrefix(authHeader, "Bearer ") {
-				token = strings.TrimSpace(authHeader[len("Bearer "):])
-			}
+			token = BearerToken(c)
 		}
 
 		if token == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{
-				"error": "Access token required",
-			})
-			c.Abort()
+			if key := c.GetHeader(APIKeyHeader); key != "" {
+				m.serveAPIKeyRequest(c, key)
+				return
+			}
+			httperr.AbortWithError(c, http.StatusUnauthorized, errAccessTokenMissing, "Access token required", nil)
 			return
 		}
 
-		userID, role, err := m.tokenValidator.ValidateToken(token)
+		identity, err := m.tokenValidator.ValidateToken(token)
 		if err != nil {
 			slog.Warn("Token validation failed in auth middleware", "error", err.Error())
-			c.JSON(http.StatusUnauthorized, gin.H{
-				"error": "Invalid or expired token",
-			})
-			c.Abort()
+			httperr.AbortWithError(c, http.StatusUnauthorized, err, "Invalid or expired token", nil)
 			return
 		}
 
-		c.Set(ctxUserIDKey, userID)
-		c.Set(ctxUserRoleKey, role)
-		c.Set("jwt_claims", map[string]any{
-			"user_id": userID.String(),
-			"role":    string(role),
-		})
+		revoked, err := m.sessionRevoked(c, identity)
+		if err != nil {
+			slog.Error("Session revocation lookup failed", "user_id", identity.UserID, "error", err.Error())
+			httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+			return
+		}
+		if revoked {
+			httperr.AbortWithError(c, http.StatusUnauthorized, commands.ErrSessionRevoked, "Session has been revoked", nil)
+			return
+		}
+
+		setIdentity(c, identity)
+		if identity.Support != nil {
+			m.serveSupportRequest(c, identity)
+			return
+		}
+		if !m.flagPendingTOS(c, identity.UserID) {
+			return
+		}
+		c.Next()
+	}
+}
+
+// serveAPIKeyRequest authenticates a machine client as the key's user. The key's scopes
+// travel on the request context, so every permission check also requires them.
+func (m *AuthMiddleware) serveAPIKeyRequest(c *gin.Context, key string) {
+	identity, err := m.apiKeys.Authenticate(c.Request.Context(), key)
+	if err != nil {
+		if errors.Is(err, queries.ErrInvalidAPIKey) {
+			slog.Warn("API key rejected", "error", err.Error())
+			httperr.AbortWithError(c, http.StatusUnauthorized, err, "Invalid, expired or revoked API key", nil)
+			return
+		}
+		slog.Error("API key lookup failed", "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		return
+	}
+	role, err := user.NewRole(identity.Role)
+	if err != nil {
+		slog.Error("API key user has an invalid role", "api_key_id", identity.KeyID, "role", identity.Role)
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		return
+	}
+
+	setIdentity(c, &usecase.AccessIdentity{UserID: identity.UserID, Role: role})
+	c.Set(ctxAPIKeyIDKey, identity.KeyID)
+	c.Request = c.Request.WithContext(reqctx.WithScopes(c.Request.Context(), identity.Scopes))
+	if !m.flagPendingTOS(c, identity.UserID) {
+		return
+	}
+	c.Next()
+}
+
+// sessionRevoked reports whether the access token was revoked: by logout, by an admin
+// signing the user out everywhere, or with its session after refresh token reuse.
+func (m *AuthMiddleware) sessionRevoked(c *gin.Context, identity *usecase.AccessIdentity) (bool, error) {
+	if identity.Token == nil {
+		return false, nil
+	}
+	return m.sessions.IsRevoked(c.Request.Context(), *identity.Token)
+}
+
+// flagPendingTOS marks the request when the user has not accepted the current terms of
+// service. Support sessions are not checked: staff accepted them when opening the session.
+func (m *AuthMiddleware) flagPendingTOS(c *gin.Context, userID uuid.UUID) bool {
+	pending, err := m.tos.PendingVersion(c.Request.Context(), userID)
+	if err != nil {
+		slog.Error("Terms of service lookup failed", "user_id", userID, "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		return false
+	}
+	if pending != nil {
+		c.Set(ctxTOSPendingKey, pending)
+	}
+	return true
+}
+
+// RequireAcceptedTOS rejects requests flagged by RequireAuth; the router installs it on
+// every route except the accept endpoint and logout.
+func RequireAcceptedTOS(c *gin.Context) {
+	v, exists := c.Get(ctxTOSPendingKey)
+	if !exists {
+		c.Next()
+		return
+	}
+	pending := v.(*shared.TOSVersion)
+	httperr.AbortWithError(c, http.StatusForbidden, errTOSNotAccepted, "Terms of service acceptance required", map[string]any{
+		"version": pending.Version,
+		"url":     pending.URL,
+	})
+}
+
+// serveSupportRequest rejects every mutating request made with a support token and
+// records each request, allowed or not, in the audit trail.
+func (m *AuthMiddleware) serveSupportRequest(c *gin.Context, identity *usecase.AccessIdentity) {
+	rejected := !isReadOnlyMethod(c.Request.Method)
+	if rejected {
+		httperr.AbortWithError(c, http.StatusForbidden, errSupportReadOnly, "Support sessions are read-only", nil)
+	} else {
+		c.Next()
+	}
+
+	rec := commands.SupportRequestRecord{
+		SessionID: identity.Support.SessionID,
+		ActorID:   identity.UserID,
+		CompanyID: identity.Support.CompanyID,
+		Method:    c.Request.Method,
+		Path:      c.Request.URL.Path,
+		Status:    c.Writer.Status(),
+		Rejected:  rejected || c.GetBool(ctxSupportDenied),
+	}
+	// Audit even when the client has gone away mid-request.
+	if err := m.support.RecordRequest(context.WithoutCancel(c.Request.Context()), rec); err != nil {
+		slog.Error("Failed to audit support request", "session_id", rec.SessionID, "path", rec.Path, "error", err.Error())
+	}
+}
+
+// RejectSupportSession refuses support tokens on routes whose handlers do not narrow
+// reads to the session's company; the router installs it on every route not marked safe.
+func RejectSupportSession(c *gin.Context) {
+	if _, ok := GetSupportScope(c); !ok {
+		c.Next()
+		return
+	}
+	c.Set(ctxSupportDenied, true)
+	httperr.AbortWithError(c, http.StatusForbidden, errSupportOutOfScope, "Not available in support sessions", nil)
+}
+
+// RejectAPIKey refuses API keys. The router puts it on every route that checks no
+// permission, since a key's scopes would limit nothing there.
+func RejectAPIKey(c *gin.Context) {
+	if _, ok := GetAPIKeyID(c); !ok {
 		c.Next()
+		return
 	}
+	httperr.AbortWithError(c, http.StatusForbidden, errAPIKeyNotAllowed, "Not available to API keys", nil)
 }
 
-func hasMinimumRole(userRole, minRole user.Role) bool {
-	userLevel, userExists := roleHierarchy[userRole]
-	minLevel, minExists := roleHierarchy[minRole]
-	return userExists && minExists && userLevel >= minLevel
+func isReadOnlyMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return false
 }
 
-// FOR FUTURE USE
-func (m *AuthMiddleware) RequireRoleAtLeast(minRole user.Role) gin.HandlerFunc {
+// RequirePermission allows the request only if the caller's role is granted permission.
+// Grants are looked up per request (cached), so role edits apply without re-issuing tokens.
+func (m *AuthMiddleware) RequirePermission(permission string) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		role, ok := GetUserRole(c)
 		if !ok {
 			// Unexpected error: should be used after RequireAuth()
-			c.JSON(http.StatusInternalServerError, gin.H{
-				"error": "Internal server error",
-			})
-			c.Abort()
+			httperr.AbortWithError(c, http.StatusInternalServerError, errIdentityMissing, "Internal server error", nil)
 			return
 		}
 
-		if !hasMinimumRole(role, minRole) {
-			c.JSON(http.StatusForbidden, gin.H{
-				"error": "Insufficient permissions",
-			})
-			c.Abort()
+		allowed, err := m.permissions.HasPermission(c.Request.Context(), string(role), permission)
+		if err != nil {
+			slog.Error("Permission lookup failed", "role", string(role), "permission", permission, "error", err.Error())
+			httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+			return
+		}
+
+		if !allowed {
+			httperr.AbortWithError(c, http.StatusForbidden, errPermissionDenied, "Insufficient permissions", nil)
 			return
 		}
 
@@ -109,13 +263,9 @@ func (m *AuthMiddleware) RequireRoleAtLeast(minRole user.Role) gin.HandlerFunc {
 // FOR FUTURE USE: authenticates the request if a token is present, but does not abort on failure.
 func (m *AuthMiddleware) OptionalAuth() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		var token string
-		token = cookie.GetAccessToken(c)
+		token := cookie.GetAccessToken(c)
 		if token == "" {
-			authHeader := c.GetHeader("Authorization")
-			if authHeader != "" && strings.HasPrefix(authHeader, "Bearer ") {
-				token = strings.TrimSpace(authHeader[len("Bearer "):])
-			}
+			token = BearerToken(c)
 		}
 
 		if token == "" {
@@ -124,23 +274,55 @@ func (m *AuthMiddleware) OptionalAuth() gin.HandlerFunc {
 			return
 		}
 
-		userID, role, err := m.tokenValidator.ValidateToken(token)
+		identity, err := m.tokenValidator.ValidateToken(token)
 		if err != nil {
 			// Invalid token; continue without aborting.
 			c.Next()
 			return
 		}
+		if revoked, rerr := m.sessionRevoked(c, identity); rerr != nil || revoked {
+			// Revoked or unverifiable session; continue as anonymous.
+			c.Next()
+			return
+		}
 
-		c.Set(ctxUserIDKey, userID)
-		c.Set(ctxUserRoleKey, role)
-		c.Set("jwt_claims", map[string]any{
-			"user_id": userID.String(),
-			"role":    string(role),
-		})
+		setIdentity(c, identity)
+		if identity.Support != nil {
+			m.serveSupportRequest(c, identity)
+			return
+		}
 		c.Next()
 	}
 }
 
+func setIdentity(c *gin.Context, identity *usecase.AccessIdentity) {
+	c.Set(ctxUserIDKey, identity.UserID)
+	c.Set(ctxUserRoleKey, identity.Role)
+	if identity.Token != nil {
+		c.Set(ctxAccessTokenKey, *identity.Token)
+	}
+	c.Request = c.Request.WithContext(reqctx.WithUserID(c.Request.Context(), identity.UserID))
+	claims := map[string]any{
+		"user_id": identity.UserID.String(),
+		"role":    string(identity.Role),
+	}
+	if identity.Support != nil {
+		c.Set(ctxSupportScopeKey, identity.Support)
+		claims["support_session_id"] = identity.Support.SessionID.String()
+		claims["support_company_id"] = identity.Support.CompanyID.String()
+	}
+	c.Set("jwt_claims", claims)
+}
+
+// BearerToken returns the token from an "Authorization: Bearer <token>" header, or "".
+func BearerToken(c *gin.Context) string {
+	authHeader := c.GetHeader("Authorization")
+	if !strings.HasPrefix(authHeader, "Bearer ") {
+		return ""
+	}
+	return strings.TrimSpace(authHeader[len("Bearer "):])
+}
+
 func GetUserID(c *gin.Context) (uuid.UUID, bool) {
 	userID, exists := c.Get(ctxUserIDKey)
 	if !exists {
@@ -151,7 +333,7 @@ func GetUserID(c *gin.Context) (uuid.UUID, bool) {
 	return id, ok
 }
 
-// FOR FUTURE USE: GetUserRole returns the authenticated user role from context
+// GetUserRole returns the authenticated user role from context
 func GetUserRole(c *gin.Context) (user.Role, bool) {
 	userRole, exists := c.Get(ctxUserRoleKey)
 	if !exists {
@@ -161,3 +343,37 @@ func GetUserRole(c *gin.Context) (user.Role, bool) {
 	role, ok := userRole.(user.Role)
 	return role, ok
 }
+
+// GetAccessToken returns the access token the request was authenticated with; support
+// tokens are not reported.
+func GetAccessToken(c *gin.Context) (shared.IssuedToken, bool) {
+	v, exists := c.Get(ctxAccessTokenKey)
+	if !exists {
+		return shared.IssuedToken{}, false
+	}
+
+	token, ok := v.(shared.IssuedToken)
+	return token, ok
+}
+
+// GetAPIKeyID returns the key a request authenticated with X-API-Key used.
+func GetAPIKeyID(c *gin.Context) (uuid.UUID, bool) {
+	v, exists := c.Get(ctxAPIKeyIDKey)
+	if !exists {
+		return uuid.Nil, false
+	}
+
+	id, ok := v.(uuid.UUID)
+	return id, ok
+}
+
+// GetSupportScope returns the company scope when the request uses a support token.
+func GetSupportScope(c *gin.Context) (*usecase.SupportScope, bool) {
+	v, exists := c.Get(ctxSupportScopeKey)
+	if !exists {
+		return nil, false
+	}
+
+	scope, ok := v.(*usecase.SupportScope)
+	return scope, ok
+}
@@ -0,0 +1,264 @@
+//go:build unit
+
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	nethttptest "net/http/httptest"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/pkg/jwt"
+	"gin-clean-starter/internal/pkg/reqctx"
+	"gin-clean-starter/internal/usecase"
+	"gin-clean-starter/internal/usecase/commands"
+	"gin-clean-starter/internal/usecase/queries"
+	"gin-clean-starter/internal/usecase/shared"
+	commandsmock "gin-clean-starter/tests/mock/commands"
+	queriesmock "gin-clean-starter/tests/mock/queries"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRequireAuth_TokenTypes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	jwtService := jwt.NewService("test-jwt-secret-key", 15*time.Minute, 168*time.Hour)
+	revokedSession := uuid.New()
+	m := middleware.NewAuthMiddleware(usecase.NewTokenValidator(jwtService), nil, nil, tosGateStub{}, sessionGateStub{revoked: revokedSession}, nil)
+
+	router := gin.New()
+	router.GET("/protected", m.RequireAuth(), func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+
+	userID := uuid.New()
+	access, err := jwtService.GenerateAccessToken(userID, user.RoleViewer, uuid.Nil)
+	require.NoError(t, err)
+	sessionAccess, err := jwtService.GenerateAccessToken(userID, user.RoleViewer, uuid.New())
+	require.NoError(t, err)
+	revokedAccess, err := jwtService.GenerateAccessToken(userID, user.RoleViewer, revokedSession)
+	require.NoError(t, err)
+	refresh, err := jwtService.GenerateRefreshToken(userID, user.RoleViewer, "", uuid.New(), uuid.Nil)
+	require.NoError(t, err)
+	boundRefresh, err := jwtService.GenerateRefreshToken(userID, user.RoleViewer, "device-key", uuid.New(), uuid.Nil)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name           string
+		token          string
+		expectedStatus int
+	}{
+		{name: "success: access token", token: access, expectedStatus: http.StatusNoContent},
+		{name: "success: access token of a live session", token: sessionAccess, expectedStatus: http.StatusNoContent},
+		{name: "error: access token of a revoked session", token: revokedAccess, expectedStatus: http.StatusUnauthorized},
+		{name: "error: refresh token", token: refresh, expectedStatus: http.StatusUnauthorized},
+		{name: "error: device-bound refresh token", token: boundRefresh, expectedStatus: http.StatusUnauthorized},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := nethttptest.NewRequest(http.MethodGet, "/protected", nil)
+			req.Header.Set("Authorization", "Bearer "+tc.token)
+			w := nethttptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestRejectSupportSession(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	jwtService := jwt.NewService("test-jwt-secret-key", 15*time.Minute, 168*time.Hour)
+	ctrl := gomock.NewController(t)
+	support := commandsmock.NewMockSupportCommands(ctrl)
+	m := middleware.NewAuthMiddleware(usecase.NewTokenValidator(jwtService), nil, support, tosGateStub{}, sessionGateStub{}, nil)
+
+	router := gin.New()
+	router.Use(m.RequireAuth())
+	router.GET("/scoped", func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+	router.GET("/unscoped", middleware.RejectSupportSession, func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+
+	userID := uuid.New()
+	access, err := jwtService.GenerateAccessToken(userID, user.RoleAdmin, uuid.Nil)
+	require.NoError(t, err)
+	supportToken, err := jwtService.GenerateSupportToken(userID, user.RoleAdmin, uuid.New(), uuid.New(), time.Minute)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name           string
+		path           string
+		token          string
+		expectRejected *bool
+		expectedStatus int
+	}{
+		{name: "success: access token on unscoped route", path: "/unscoped", token: access, expectedStatus: http.StatusNoContent},
+		{name: "success: support token on scoped route", path: "/scoped", token: supportToken, expectRejected: ptr(false), expectedStatus: http.StatusNoContent},
+		{name: "error: support token on unscoped route", path: "/unscoped", token: supportToken, expectRejected: ptr(true), expectedStatus: http.StatusForbidden},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.expectRejected != nil {
+				support.EXPECT().RecordRequest(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, rec commands.SupportRequestRecord) error {
+						assert.Equal(t, *tc.expectRejected, rec.Rejected)
+						assert.Equal(t, tc.expectedStatus, rec.Status)
+						return nil
+					})
+			}
+			req := nethttptest.NewRequest(http.MethodGet, tc.path, nil)
+			req.Header.Set("Authorization", "Bearer "+tc.token)
+			w := nethttptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestRequireAuth_APIKey(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	jwtService := jwt.NewService("test-jwt-secret-key", 15*time.Minute, 168*time.Hour)
+	ctrl := gomock.NewController(t)
+	apiKeys := queriesmock.NewMockAPIKeyQueries(ctrl)
+	m := middleware.NewAuthMiddleware(usecase.NewTokenValidator(jwtService), nil, nil, tosGateStub{}, sessionGateStub{}, apiKeys)
+
+	identity := &queries.APIKeyIdentity{KeyID: uuid.New(), UserID: uuid.New(), Role: user.RoleOperator.String(), Scopes: []string{shared.PermissionReservationsReadAssigned}}
+	apiKeys.EXPECT().Authenticate(gomock.Any(), "gcsk_live").Return(identity, nil).AnyTimes()
+	apiKeys.EXPECT().Authenticate(gomock.Any(), "gcsk_revoked").Return(nil, queries.ErrInvalidAPIKey).AnyTimes()
+
+	router := gin.New()
+	router.Use(m.RequireAuth())
+	router.GET("/whoami", func(c *gin.Context) {
+		userID, _ := middleware.GetUserID(c)
+		role, _ := middleware.GetUserRole(c)
+		keyID, _ := middleware.GetAPIKeyID(c)
+		scopes, _ := reqctx.Scopes(c.Request.Context())
+		assert.Equal(t, identity.UserID, userID)
+		assert.Equal(t, user.RoleOperator, role)
+		assert.Equal(t, identity.KeyID, keyID)
+		assert.Equal(t, identity.Scopes, scopes)
+		c.Status(http.StatusNoContent)
+	})
+	router.PUT("/password", middleware.RejectAPIKey, func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+
+	access, err := jwtService.GenerateAccessToken(uuid.New(), user.RoleViewer, uuid.Nil)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name           string
+		method         string
+		path           string
+		key            string
+		token          string
+		expectedStatus int
+		expectedCode   string
+	}{
+		{name: "success: live key acts as its user", method: http.MethodGet, path: "/whoami", key: "gcsk_live", expectedStatus: http.StatusNoContent},
+		{name: "success: bearer token on a route refusing keys", method: http.MethodPut, path: "/password", token: access, expectedStatus: http.StatusNoContent},
+		{name: "error: revoked key", method: http.MethodGet, path: "/whoami", key: "gcsk_revoked", expectedStatus: http.StatusUnauthorized, expectedCode: "INVALID_API_KEY"},
+		{name: "error: key on a route refusing keys", method: http.MethodPut, path: "/password", key: "gcsk_live", expectedStatus: http.StatusForbidden, expectedCode: "API_KEY_NOT_ALLOWED"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := nethttptest.NewRequest(tc.method, tc.path, nil)
+			if tc.key != "" {
+				req.Header.Set(middleware.APIKeyHeader, tc.key)
+			}
+			if tc.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tc.token)
+			}
+			w := nethttptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.expectedStatus, w.Code)
+			if tc.expectedCode != "" {
+				assert.Contains(t, w.Body.String(), tc.expectedCode)
+			}
+		})
+	}
+}
+
+func TestRequireAcceptedTOS(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	jwtService := jwt.NewService("test-jwt-secret-key", 15*time.Minute, 168*time.Hour)
+	pending := &shared.TOSVersion{ID: uuid.New(), Version: "2026-10", URL: "https://example.com/tos/2026-10"}
+
+	access, err := jwtService.GenerateAccessToken(uuid.New(), user.RoleViewer, uuid.Nil)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name           string
+		pending        *shared.TOSVersion
+		path           string
+		expectedStatus int
+	}{
+		{name: "success: current version accepted", path: "/guarded", expectedStatus: http.StatusNoContent},
+		{name: "success: exempt route while acceptance is pending", pending: pending, path: "/accept", expectedStatus: http.StatusNoContent},
+		{name: "error: acceptance pending", pending: pending, path: "/guarded", expectedStatus: http.StatusForbidden},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := middleware.NewAuthMiddleware(usecase.NewTokenValidator(jwtService), nil, nil, tosGateStub{pending: tc.pending}, sessionGateStub{}, nil)
+			router := gin.New()
+			router.Use(m.RequireAuth())
+			router.GET("/guarded", middleware.RequireAcceptedTOS, func(c *gin.Context) {
+				c.Status(http.StatusNoContent)
+			})
+			router.GET("/accept", func(c *gin.Context) {
+				c.Status(http.StatusNoContent)
+			})
+
+			req := nethttptest.NewRequest(http.MethodGet, tc.path, nil)
+			req.Header.Set("Authorization", "Bearer "+access)
+			w := nethttptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.expectedStatus, w.Code)
+			if tc.expectedStatus == http.StatusForbidden {
+				assert.Contains(t, w.Body.String(), "TOS_ACCEPTANCE_REQUIRED")
+				assert.Contains(t, w.Body.String(), pending.Version)
+			}
+		})
+	}
+}
+
+// tosGateStub reports pending as the version every user still has to accept.
+type tosGateStub struct {
+	pending *shared.TOSVersion
+}
+
+func (s tosGateStub) PendingVersion(context.Context, uuid.UUID) (*shared.TOSVersion, error) {
+	return s.pending, nil
+}
+
+// sessionGateStub reports revoked as the only revoked session.
+type sessionGateStub struct {
+	revoked uuid.UUID
+}
+
+func (s sessionGateStub) IsRevoked(_ context.Context, token shared.IssuedToken) (bool, error) {
+	return token.SessionID != nil && *token.SessionID == s.revoked, nil
+}
+
+func ptr[T any](v T) *T { return &v }
@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const headerCacheControl = "Cache-Control"
+
+// CachePolicy is the route metadata of a route whose responses may be cached, sent as
+// its Cache-Control header.
+type CachePolicy struct {
+	// Public lets shared caches such as CDNs store the response; otherwise only the
+	// client's own cache may (private).
+	Public bool
+	MaxAge time.Duration
+	// StaleWhileRevalidate is how long past MaxAge a cache may keep serving the response
+	// while it fetches a fresh one in the background (RFC 5861); zero omits the directive.
+	StaleWhileRevalidate time.Duration
+	// NoStore forbids caching altogether, e.g. for responses carrying a secret; the other
+	// fields are ignored.
+	NoStore bool
+}
+
+// String renders p as a Cache-Control value, e.g. "public, max-age=300, stale-while-revalidate=60".
+func (p CachePolicy) String() string {
+	if p.NoStore {
+		return "no-store"
+	}
+	directives := []string{"private"}
+	if p.Public {
+		directives[0] = "public"
+	}
+	directives = append(directives, "max-age="+seconds(p.MaxAge))
+	if p.StaleWhileRevalidate > 0 {
+		directives = append(directives, "stale-while-revalidate="+seconds(p.StaleWhileRevalidate))
+	}
+	return strings.Join(directives, ", ")
+}
+
+func seconds(d time.Duration) string {
+	return strconv.FormatInt(int64(d/time.Second), 10)
+}
+
+// CacheControl sends p on the route's successful and 304 responses; a handler setting
+// Cache-Control itself wins. Error responses go out as no-store, so a CDN does not keep
+// serving a failure after it is resolved.
+func CacheControl(p CachePolicy) gin.HandlerFunc {
+	value := p.String()
+	return func(c *gin.Context) {
+		c.Header(headerCacheControl, value)
+		c.Writer = &cacheControlWriter{ResponseWriter: c.Writer}
+	}
+}
+
+// cacheControlWriter replaces the route's Cache-Control with no-store when the status it
+// is about to send is an error.
+type cacheControlWriter struct {
+	gin.ResponseWriter
+	checked bool
+}
+
+func (w *cacheControlWriter) Write(data []byte) (int, error) {
+	w.check()
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *cacheControlWriter) WriteString(s string) (int, error) {
+	w.check()
+	return w.ResponseWriter.WriteString(s)
+}
+
+func (w *cacheControlWriter) WriteHeaderNow() {
+	w.check()
+	w.ResponseWriter.WriteHeaderNow()
+}
+
+func (w *cacheControlWriter) check() {
+	if w.checked {
+		return
+	}
+	w.checked = true
+	if w.Status() >= http.StatusBadRequest {
+		w.Header().Set(headerCacheControl, "no-store")
+	}
+}
@@ -0,0 +1,104 @@
+//go:build unit
+
+package middleware_test
+
+import (
+	"net/http"
+	nethttptest "net/http/httptest"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/handler/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCachePolicy_String(t *testing.T) {
+	testCases := []struct {
+		name     string
+		policy   middleware.CachePolicy
+		expected string
+	}{
+		{
+			name:     "public with stale-while-revalidate",
+			policy:   middleware.CachePolicy{Public: true, MaxAge: 5 * time.Minute, StaleWhileRevalidate: time.Minute},
+			expected: "public, max-age=300, stale-while-revalidate=60",
+		},
+		{
+			name:     "private without stale-while-revalidate",
+			policy:   middleware.CachePolicy{MaxAge: 30 * time.Second},
+			expected: "private, max-age=30",
+		},
+		{
+			name:     "no-store ignores the rest",
+			policy:   middleware.CachePolicy{NoStore: true, Public: true, MaxAge: time.Hour},
+			expected: "no-store",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.policy.String())
+		})
+	}
+}
+
+func TestCacheControl(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	policy := middleware.CachePolicy{Public: true, MaxAge: time.Minute, StaleWhileRevalidate: time.Minute}
+
+	testCases := []struct {
+		name     string
+		handler  gin.HandlerFunc
+		status   int
+		expected string
+	}{
+		{
+			name:     "success: policy on a successful response",
+			handler:  func(c *gin.Context) { c.JSON(http.StatusOK, gin.H{"ok": true}) },
+			status:   http.StatusOK,
+			expected: "public, max-age=60, stale-while-revalidate=60",
+		},
+		{
+			name:     "success: policy on a bodyless 304",
+			handler:  func(c *gin.Context) { c.Status(http.StatusNotModified) },
+			status:   http.StatusNotModified,
+			expected: "public, max-age=60, stale-while-revalidate=60",
+		},
+		{
+			name: "success: the handler's own header wins",
+			handler: func(c *gin.Context) {
+				c.Header("Cache-Control", "private, max-age=5")
+				c.JSON(http.StatusOK, gin.H{"ok": true})
+			},
+			status:   http.StatusOK,
+			expected: "private, max-age=5",
+		},
+		{
+			name:     "error: error bodies are not stored",
+			handler:  func(c *gin.Context) { c.JSON(http.StatusNotFound, gin.H{"error": "not found"}) },
+			status:   http.StatusNotFound,
+			expected: "no-store",
+		},
+		{
+			name:     "error: bodyless aborts are not stored",
+			handler:  func(c *gin.Context) { c.AbortWithStatus(http.StatusServiceUnavailable) },
+			status:   http.StatusServiceUnavailable,
+			expected: "no-store",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			router := gin.New()
+			router.GET("/items", middleware.CacheControl(policy), tc.handler)
+
+			w := nethttptest.NewRecorder()
+			router.ServeHTTP(w, nethttptest.NewRequest(http.MethodGet, "/items", nil))
+
+			assert.Equal(t, tc.status, w.Code)
+			assert.Equal(t, tc.expected, w.Header().Get("Cache-Control"))
+		})
+	}
+}
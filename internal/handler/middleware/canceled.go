@@ -0,0 +1,29 @@
+package middleware
+
+import (
+	"log/slog"
+
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CanceledRequests counts requests whose client disconnected before the handler finished.
+// The request context is canceled then, so the handler's queries and transactions stop and
+// its server errors go out as 499 (see httperr.AbortWithError).
+func CanceledRequests(metrics *shared.CanceledRequestMetrics) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if !httperr.ClientGone(c) {
+			return
+		}
+		route := ""
+		if c.FullPath() != "" {
+			route = c.Request.Method + " " + c.FullPath()
+		}
+		metrics.Record(route)
+		slog.Info("Client closed request", "path", c.Request.URL.Path, "route", route, "status_code", c.Writer.Status())
+	}
+}
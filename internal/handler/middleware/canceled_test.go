@@ -0,0 +1,78 @@
+//go:build unit
+
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	nethttptest "net/http/httptest"
+	"testing"
+
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanceledRequests(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	testCases := []struct {
+		name         string
+		path         string
+		disconnect   bool
+		expectStatus int
+		expectTotal  int64
+		expectRoutes map[string]int64
+	}{
+		{
+			name:         "success: abandoned request counted by route template as 499",
+			path:         "/items/42",
+			disconnect:   true,
+			expectStatus: httperr.StatusClientClosedRequest,
+			expectTotal:  1,
+			expectRoutes: map[string]int64{"GET /items/:id": 1},
+		},
+		{
+			name:         "success: abandoned unmatched request counted without a route",
+			path:         "/missing",
+			disconnect:   true,
+			expectStatus: http.StatusNotFound,
+			expectTotal:  1,
+			expectRoutes: map[string]int64{},
+		},
+		{
+			name:         "success: finished request not counted",
+			path:         "/items/42",
+			expectStatus: http.StatusInternalServerError,
+			expectRoutes: map[string]int64{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			metrics := shared.NewCanceledRequestMetrics()
+			r := gin.New()
+			r.Use(middleware.CanceledRequests(metrics))
+			r.GET("/items/:id", func(c *gin.Context) {
+				httperr.AbortWithError(c, http.StatusInternalServerError, errors.New("query failed"), "Internal server error", nil)
+			})
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tc.disconnect {
+				cancel()
+			}
+			w := nethttptest.NewRecorder()
+			r.ServeHTTP(w, nethttptest.NewRequestWithContext(ctx, http.MethodGet, tc.path, nil))
+
+			assert.Equal(t, tc.expectStatus, w.Code)
+			stats := metrics.Stats()
+			assert.Equal(t, tc.expectTotal, stats.Total)
+			assert.Equal(t, tc.expectRoutes, stats.Routes)
+		})
+	}
+}
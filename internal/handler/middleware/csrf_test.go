@@ -0,0 +1,120 @@
+//go:build unit
+
+package middleware_test
+
+import (
+	"net/http"
+	nethttptest "net/http/httptest"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/config"
+	"gin-clean-starter/internal/pkg/cookie"
+	"gin-clean-starter/internal/pkg/signedtoken"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCSRFMiddleware_Protect(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	signer := signedtoken.NewSigner([]byte("test-secret"))
+
+	newRouter := func(enabled bool) *gin.Engine {
+		cfg := config.NewTestConfig()
+		cfg.Cookie.CSRF = enabled
+		router := gin.New()
+		router.Use(middleware.NewCSRFMiddleware(cfg, signer, clock.NewRealClock()).Protect())
+		router.GET("/csrf", func(c *gin.Context) {
+			token, _, err := cookie.IssueCSRFToken(c, cfg.Cookie, signer, time.Now())
+			require.NoError(t, err)
+			c.String(http.StatusOK, token)
+		})
+		router.POST("/reservations", func(c *gin.Context) {
+			c.Status(http.StatusCreated)
+		})
+		return router
+	}
+
+	router := newRouter(true)
+	w := nethttptest.NewRecorder()
+	router.ServeHTTP(w, nethttptest.NewRequest(http.MethodGet, "/csrf", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	token := w.Body.String()
+	other := signedtoken.NewSigner([]byte("other-secret"))
+	forged, err := other.Sign("csrf", "nonce", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		router   *gin.Engine
+		cookies  map[string]string
+		headers  map[string]string
+		expected int
+	}{
+		{
+			name:     "passes: matching token",
+			router:   router,
+			cookies:  map[string]string{cookie.AccessTokenCookieName: "jwt", cookie.CSRFTokenCookieName: token},
+			headers:  map[string]string{cookie.CSRFTokenHeader: token},
+			expected: http.StatusCreated,
+		},
+		{
+			name:     "passes: bearer token requests cannot be forged",
+			router:   router,
+			cookies:  map[string]string{cookie.AccessTokenCookieName: "jwt"},
+			headers:  map[string]string{"Authorization": "Bearer jwt"},
+			expected: http.StatusCreated,
+		},
+		{
+			name:     "passes: no auth cookie",
+			router:   router,
+			expected: http.StatusCreated,
+		},
+		{
+			name:     "passes: protection off",
+			router:   newRouter(false),
+			cookies:  map[string]string{cookie.AccessTokenCookieName: "jwt"},
+			expected: http.StatusCreated,
+		},
+		{
+			name:     "refused: auth cookie without a token",
+			router:   router,
+			cookies:  map[string]string{cookie.RefreshTokenCookieName: "jwt", cookie.CSRFTokenCookieName: token},
+			expected: http.StatusForbidden,
+		},
+		{
+			name:     "refused: header does not repeat the cookie",
+			router:   router,
+			cookies:  map[string]string{cookie.AccessTokenCookieName: "jwt", cookie.CSRFTokenCookieName: token},
+			headers:  map[string]string{cookie.CSRFTokenHeader: token + "x"},
+			expected: http.StatusForbidden,
+		},
+		{
+			name:     "refused: token signed with another secret",
+			router:   router,
+			cookies:  map[string]string{cookie.AccessTokenCookieName: "jwt", cookie.CSRFTokenCookieName: forged},
+			headers:  map[string]string{cookie.CSRFTokenHeader: forged},
+			expected: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := nethttptest.NewRequest(http.MethodPost, "/reservations", nil)
+			for name, value := range tc.cookies {
+				req.AddCookie(&http.Cookie{Name: name, Value: value})
+			}
+			for name, value := range tc.headers {
+				req.Header.Set(name, value)
+			}
+			w := nethttptest.NewRecorder()
+			tc.router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.expected, w.Code, w.Body.String())
+		})
+	}
+}
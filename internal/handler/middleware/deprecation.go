@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"gin-clean-starter/internal/pkg/config"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	headerDeprecation = "Deprecation"
+	headerSunset      = "Sunset"
+	headerLink        = "Link"
+
+	ctxDeprecatedRouteKey = "deprecated_route"
+)
+
+// Deprecation is the route metadata of a deprecated route.
+type Deprecation struct {
+	// Since is when the route was deprecated, sent as an RFC 9745 Deprecation header.
+	Since time.Time
+	// Sunset is when the route stops being served, sent as an RFC 8594 Sunset header; zero
+	// when no date has been set yet.
+	Sunset time.Time
+	// Successor is the path of the route replacing this one, e.g. "/api/v1/reservations",
+	// sent as a Link with rel="successor-version".
+	Successor string
+}
+
+// Deprecated announces d on every response of the route, errors included, and flags the
+// request for DeprecationMiddleware.Track.
+func Deprecated(d Deprecation) gin.HandlerFunc {
+	deprecation := "@" + strconv.FormatInt(d.Since.Unix(), 10)
+	var sunset, link string
+	if !d.Sunset.IsZero() {
+		sunset = d.Sunset.UTC().Format(http.TimeFormat)
+	}
+	if d.Successor != "" {
+		link = "<" + d.Successor + `>; rel="successor-version"`
+	}
+	return func(c *gin.Context) {
+		c.Header(headerDeprecation, deprecation)
+		if sunset != "" {
+			c.Header(headerSunset, sunset)
+		}
+		if link != "" {
+			c.Writer.Header().Add(headerLink, link)
+		}
+		c.Set(ctxDeprecatedRouteKey, true)
+	}
+}
+
+type DeprecationMiddleware struct {
+	enabled bool
+	tracker shared.DeprecationTracker
+}
+
+func NewDeprecationMiddleware(cfg config.Config, tracker shared.DeprecationTracker) *DeprecationMiddleware {
+	return &DeprecationMiddleware{
+		enabled: cfg.Deprecation.TrackingEnabled,
+		tracker: tracker,
+	}
+}
+
+// Track counts calls to routes flagged by Deprecated per consumer, e.g. under
+// "GET /api/reservations/:id". It is installed on the engine like telemetry, so it reads
+// the identity RequireAuth sets further down the chain; anonymous calls are counted
+// without a user and support sessions are not counted.
+func (m *DeprecationMiddleware) Track() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if !m.enabled || !c.GetBool(ctxDeprecatedRouteKey) {
+			return
+		}
+		if _, support := GetSupportScope(c); support {
+			return
+		}
+		var consumer *uuid.UUID
+		if userID, ok := GetUserID(c); ok {
+			consumer = &userID
+		}
+		m.tracker.Record(c.Request.Method+" "+c.FullPath(), consumer)
+	}
+}
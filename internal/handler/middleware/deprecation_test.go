@@ -0,0 +1,172 @@
+//go:build unit
+
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	nethttptest "net/http/httptest"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/pkg/config"
+	"gin-clean-starter/internal/pkg/jwt"
+	"gin-clean-starter/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordedDeprecatedCall struct {
+	route  string
+	userID *uuid.UUID
+}
+
+type deprecationTrackerStub struct {
+	recorded []recordedDeprecatedCall
+}
+
+func (s *deprecationTrackerStub) Record(route string, userID *uuid.UUID) {
+	s.recorded = append(s.recorded, recordedDeprecatedCall{route, userID})
+}
+
+func (s *deprecationTrackerStub) Flush(context.Context) (int, error) { return 0, nil }
+
+func TestDeprecated(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	since := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	sunset := time.Date(2027, 4, 1, 9, 0, 0, 0, time.FixedZone("JST", 9*60*60))
+
+	testCases := []struct {
+		name         string
+		deprecation  middleware.Deprecation
+		status       int
+		expectSunset string
+		expectLink   []string
+	}{
+		{
+			name:         "success: all headers announced",
+			deprecation:  middleware.Deprecation{Since: since, Sunset: sunset, Successor: "/api/v1/items/:id"},
+			status:       http.StatusOK,
+			expectSunset: "Thu, 01 Apr 2027 00:00:00 GMT",
+			expectLink:   []string{`</api/v1/items/:id>; rel="successor-version"`},
+		},
+		{
+			name:        "success: sunset and successor are optional",
+			deprecation: middleware.Deprecation{Since: since},
+			status:      http.StatusOK,
+		},
+		{
+			name:         "success: error responses are announced too",
+			deprecation:  middleware.Deprecation{Since: since, Sunset: sunset},
+			status:       http.StatusNotFound,
+			expectSunset: "Thu, 01 Apr 2027 00:00:00 GMT",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			router := gin.New()
+			router.GET("/items/:id", middleware.Deprecated(tc.deprecation), func(c *gin.Context) {
+				c.Status(tc.status)
+			})
+
+			w := nethttptest.NewRecorder()
+			router.ServeHTTP(w, nethttptest.NewRequest(http.MethodGet, "/items/42", nil))
+
+			require.Equal(t, tc.status, w.Code)
+			assert.Equal(t, "@1790812800", w.Header().Get("Deprecation"))
+			assert.Equal(t, tc.expectSunset, w.Header().Get("Sunset"))
+			assert.Equal(t, tc.expectLink, w.Header().Values("Link"))
+		})
+	}
+}
+
+func TestDeprecationMiddleware_Track(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	jwtService := jwt.NewService("test-jwt-secret-key", 15*time.Minute, 168*time.Hour)
+	auth := middleware.NewAuthMiddleware(usecase.NewTokenValidator(jwtService), nil, nil, tosGateStub{}, sessionGateStub{}, nil)
+
+	userID := uuid.New()
+	token, err := jwtService.GenerateAccessToken(userID, user.RoleViewer, uuid.Nil)
+	require.NoError(t, err)
+
+	enabled := config.Config{Deprecation: config.DeprecationConfig{TrackingEnabled: true}}
+
+	testCases := []struct {
+		name           string
+		cfg            config.Config
+		path           string
+		token          string
+		expectRecorded []recordedDeprecatedCall
+	}{
+		{
+			name:           "success: consumer recorded under the route template",
+			cfg:            enabled,
+			path:           "/items/42",
+			token:          token,
+			expectRecorded: []recordedDeprecatedCall{{"GET /items/:id", &userID}},
+		},
+		{
+			name:           "success: failed calls are recorded",
+			cfg:            enabled,
+			path:           "/broken",
+			token:          token,
+			expectRecorded: []recordedDeprecatedCall{{"GET /broken", &userID}},
+		},
+		{
+			name:           "success: anonymous call recorded without a user",
+			cfg:            enabled,
+			path:           "/public",
+			expectRecorded: []recordedDeprecatedCall{{"GET /public", nil}},
+		},
+		{
+			name:  "success: routes that are not deprecated are not recorded",
+			cfg:   enabled,
+			path:  "/current",
+			token: token,
+		},
+		{
+			name:  "success: tracking disabled",
+			cfg:   config.Config{},
+			path:  "/items/42",
+			token: token,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tracker := &deprecationTrackerStub{}
+			m := middleware.NewDeprecationMiddleware(tc.cfg, tracker)
+			deprecated := middleware.Deprecated(middleware.Deprecation{Since: time.Now()})
+
+			router := gin.New()
+			router.Use(m.Track())
+			router.GET("/items/:id", auth.RequireAuth(), deprecated, func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+			router.GET("/broken", auth.RequireAuth(), deprecated, func(c *gin.Context) {
+				c.Status(http.StatusUnprocessableEntity)
+			})
+			router.GET("/public", deprecated, func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+			router.GET("/current", auth.RequireAuth(), func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			req := nethttptest.NewRequest(http.MethodGet, tc.path, nil)
+			if tc.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tc.token)
+			}
+			w := nethttptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.expectRecorded, tracker.recorded)
+		})
+	}
+}
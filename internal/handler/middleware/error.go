@@ -33,7 +33,10 @@ func ErrorHandler() gin.HandlerFunc {
 			c.Writer.WriteHeaderNow()
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": gin.H{"message": "Internal server error"}})
+		resp := httperr.Response{Status: http.StatusInternalServerError}
+		resp.Error.Code = httperr.CodeInternal
+		resp.Error.Message = "Internal server error"
+		c.JSON(http.StatusInternalServerError, resp)
 	}
 }
 
@@ -44,6 +47,7 @@ func CustomRecovery() gin.HandlerFunc {
 				slog.Error("recovered from panic", "error", err, "path", c.Request.URL.Path)
 
 				resp := httperr.Response{Status: http.StatusInternalServerError}
+				resp.Error.Code = httperr.CodeInternal
 				resp.Error.Message = "Internal server error"
 
 				c.JSON(http.StatusInternalServerError, resp)
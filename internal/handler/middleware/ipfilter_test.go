@@ -0,0 +1,149 @@
+//go:build unit
+
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	nethttptest "net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/pkg/config"
+	"gin-clean-starter/internal/usecase/commands"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type adminAccessStub struct {
+	denied []commands.AdminAccessDenial
+}
+
+func (s *adminAccessStub) RecordDenied(_ context.Context, denial commands.AdminAccessDenial) error {
+	s.denied = append(s.denied, denial)
+	return nil
+}
+
+func serveAdmin(t *testing.T, m *middleware.IPFilterMiddleware, remoteAddr string) int {
+	t.Helper()
+
+	router := gin.New()
+	router.GET("/api/admin/roles", m.Restrict(), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	req := nethttptest.NewRequest(http.MethodGet, "/api/admin/roles", nil)
+	req.RemoteAddr = remoteAddr
+	w := nethttptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	if w.Code == http.StatusForbidden {
+		assert.Contains(t, w.Body.String(), "IP_NOT_ALLOWED")
+	}
+	return w.Code
+}
+
+func TestIPFilterMiddleware_Restrict(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	testCases := []struct {
+		name           string
+		cfg            config.AdminIPConfig
+		remoteAddr     string
+		expectedStatus int
+		expectReason   string
+	}{
+		{
+			name:           "success: no rules let everyone through",
+			remoteAddr:     "198.51.100.20:4567",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "success: address in the allow list",
+			cfg:            config.AdminIPConfig{Allow: []string{"10.0.0.0/8", "192.0.2.10"}},
+			remoteAddr:     "192.0.2.10:4567",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "error: address outside the allow list",
+			cfg:            config.AdminIPConfig{Allow: []string{"10.0.0.0/8"}},
+			remoteAddr:     "198.51.100.20:4567",
+			expectedStatus: http.StatusForbidden,
+			expectReason:   "not_allowed",
+		},
+		{
+			name:           "error: deny entry wins over allow",
+			cfg:            config.AdminIPConfig{Allow: []string{"10.0.0.0/8"}, Deny: []string{"10.9.0.0/16"}},
+			remoteAddr:     "10.9.1.1:4567",
+			expectedStatus: http.StatusForbidden,
+			expectReason:   "denied",
+		},
+		{
+			name:           "error: IPv6 address denied",
+			cfg:            config.AdminIPConfig{Deny: []string{"2001:db8::/32"}},
+			remoteAddr:     "[2001:db8::1]:4567",
+			expectedStatus: http.StatusForbidden,
+			expectReason:   "denied",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			access := &adminAccessStub{}
+			m, err := middleware.NewIPFilterMiddleware(config.Config{AdminIP: tc.cfg}, access)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, serveAdmin(t, m, tc.remoteAddr))
+			if tc.expectReason == "" {
+				assert.Empty(t, access.denied)
+				return
+			}
+			require.Len(t, access.denied, 1)
+			assert.Equal(t, tc.expectReason, access.denied[0].Reason)
+			assert.Equal(t, "/api/admin/roles", access.denied[0].Path)
+		})
+	}
+}
+
+func TestIPFilterMiddleware_Reload(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	path := filepath.Join(t.TempDir(), "admin-ip.rules")
+	write := func(content string, modTime time.Time) {
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		require.NoError(t, os.Chtimes(path, modTime, modTime))
+	}
+	start := time.Now().Add(-time.Hour)
+
+	write("# office\nallow 10.0.0.0/8\n", start)
+	m, err := middleware.NewIPFilterMiddleware(config.Config{AdminIP: config.AdminIPConfig{RulesFile: path, Allow: []string{"0.0.0.0/0"}}}, &adminAccessStub{})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, serveAdmin(t, m, "10.1.2.3:4567"))
+	assert.Equal(t, http.StatusForbidden, serveAdmin(t, m, "198.51.100.20:4567"), "file replaces the env lists")
+
+	reloaded, err := m.Reload()
+	require.NoError(t, err)
+	assert.False(t, reloaded, "unchanged file is not re-read")
+
+	write("allow 10.0.0.0/8\ndeny 10.1.2.3\n", start.Add(time.Minute))
+	reloaded, err = m.Reload()
+	require.NoError(t, err)
+	assert.True(t, reloaded)
+	assert.Equal(t, http.StatusForbidden, serveAdmin(t, m, "10.1.2.3:4567"))
+
+	write("allow 10.0.0.0/8\npermit 10.1.2.3\n", start.Add(2*time.Minute))
+	_, err = m.Reload()
+	require.Error(t, err)
+	assert.Equal(t, http.StatusForbidden, serveAdmin(t, m, "10.1.2.3:4567"), "broken file keeps the previous rules")
+	assert.Equal(t, http.StatusOK, serveAdmin(t, m, "10.4.5.6:4567"))
+}
+
+func TestNewIPFilterMiddleware_InvalidEntry(t *testing.T) {
+	_, err := middleware.NewIPFilterMiddleware(config.Config{AdminIP: config.AdminIPConfig{Deny: []string{"not-an-ip"}}}, &adminAccessStub{})
+	assert.Error(t, err)
+
+	_, err = middleware.NewIPFilterMiddleware(config.Config{AdminIP: config.AdminIPConfig{RulesFile: filepath.Join(t.TempDir(), "missing")}}, &adminAccessStub{})
+	assert.Error(t, err)
+}
@@ -12,6 +12,7 @@ import (
 
 	"gin-clean-starter/internal/domain/user"
 	"gin-clean-starter/internal/pkg/config"
+	"gin-clean-starter/internal/pkg/reqctx"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -75,6 +76,9 @@ func (l *Logger) LoggingMiddleware() gin.HandlerFunc {
 		requestID := l.generateRequestID()
 
 		c.Set("request_id", requestID)
+		ctx := reqctx.WithRequestID(c.Request.Context(), requestID)
+		ctx = reqctx.WithClient(ctx, reqctx.Client{IP: c.ClientIP(), UserAgent: c.Request.UserAgent()})
+		c.Request = c.Request.WithContext(ctx)
 
 		userID, role := extractUserContext(c)
 
@@ -84,6 +88,9 @@ func (l *Logger) LoggingMiddleware() gin.HandlerFunc {
 			slog.String("path", c.Request.URL.Path),
 			slog.String("client_ip", c.ClientIP()),
 		}
+		if trace, ok := reqctx.TraceInfo(ctx); ok {
+			logAttrs = append(logAttrs, slog.String("trace_id", trace.TraceIDString()))
+		}
 
 		if userID != "" {
 			logAttrs = append(logAttrs, slog.String("user_id", userID))
@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"gin-clean-starter/internal/handler/render"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+var errProvisioningTokenMissing = errs.NewCoded("PROVISIONING_TOKEN_REQUIRED", "provisioning token required")
+
+const ctxProvisioningCompanyKey = "provisioning_company"
+
+type ProvisioningMiddleware struct {
+	provisioning queries.ProvisioningQueries
+}
+
+func NewProvisioningMiddleware(provisioning queries.ProvisioningQueries) *ProvisioningMiddleware {
+	return &ProvisioningMiddleware{
+		provisioning: provisioning,
+	}
+}
+
+// RequireToken authenticates an identity provider by its bearer provisioning token and
+// scopes the request to the token's company. Failures are SCIM error bodies.
+func (m *ProvisioningMiddleware) RequireToken() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := BearerToken(c)
+		if token == "" {
+			render.SCIMError(c, http.StatusUnauthorized, errProvisioningTokenMissing, "", "Provisioning token required")
+			return
+		}
+
+		companyID, err := m.provisioning.Authenticate(c.Request.Context(), token)
+		if err != nil {
+			if errors.Is(err, queries.ErrInvalidProvisioningToken) {
+				slog.Warn("Provisioning token rejected", "error", err.Error())
+				render.SCIMError(c, http.StatusUnauthorized, err, "", "Invalid or revoked provisioning token")
+				return
+			}
+			slog.Error("Provisioning token lookup failed", "error", err.Error())
+			render.SCIMError(c, http.StatusInternalServerError, err, "", "Internal server error")
+			return
+		}
+
+		c.Set(ctxProvisioningCompanyKey, companyID)
+		c.Next()
+	}
+}
+
+// GetProvisioningCompanyID returns the company a provisioning request acts for.
+func GetProvisioningCompanyID(c *gin.Context) (uuid.UUID, bool) {
+	v, exists := c.Get(ctxProvisioningCompanyKey)
+	if !exists {
+		return uuid.Nil, false
+	}
+	id, ok := v.(uuid.UUID)
+	return id, ok
+}
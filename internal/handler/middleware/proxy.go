@@ -0,0 +1,92 @@
+package middleware
+
+import (
+	"fmt"
+	"net/netip"
+
+	"gin-clean-starter/internal/pkg/config"
+	"gin-clean-starter/internal/pkg/reqctx"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Headers only a trusted proxy may set. From any other peer they are removed before the
+// request reaches logging or handlers.
+var proxyOnlyHeaders = []string{"Traceparent", "Tracestate", "X-User-ID", "X-User-Role"}
+
+// ProxyMiddleware decides which forwarding headers to believe. Only requests whose
+// direct peer is listed in TRUSTED_PROXIES may name the client IP or join an upstream
+// trace; everyone else gets the connection's address and a new trace.
+type ProxyMiddleware struct {
+	trustedProxies  []string
+	trusted         []netip.Prefix
+	clientIPHeaders []string
+}
+
+func NewProxyMiddleware(cfg config.Config) (*ProxyMiddleware, error) {
+	trusted := make([]netip.Prefix, 0, len(cfg.Server.TrustedProxies))
+	for _, entry := range cfg.Server.TrustedProxies {
+		prefix, err := parsePrefix(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy: %w", err)
+		}
+		trusted = append(trusted, prefix)
+	}
+	return &ProxyMiddleware{
+		trustedProxies:  cfg.Server.TrustedProxies,
+		trusted:         trusted,
+		clientIPHeaders: cfg.Server.ClientIPHeaders,
+	}, nil
+}
+
+// parsePrefix accepts a CIDR or a single address, which becomes a one-address prefix.
+func parsePrefix(entry string) (netip.Prefix, error) {
+	if prefix, err := netip.ParsePrefix(entry); err == nil {
+		return prefix.Masked(), nil
+	}
+	addr, err := netip.ParseAddr(entry)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("%q is not an IP or CIDR", entry)
+	}
+	return netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()), nil
+}
+
+func containsAddr(prefixes []netip.Prefix, addr netip.Addr) bool {
+	for _, prefix := range prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// Configure makes gin's ClientIP apply the same trust. gin trusts every peer by default,
+// which lets any client choose the IP that audit logs and security events record.
+func (m *ProxyMiddleware) Configure(engine *gin.Engine) error {
+	engine.ForwardedByClientIP = true
+	engine.RemoteIPHeaders = m.clientIPHeaders
+	return engine.SetTrustedProxies(m.trustedProxies)
+}
+
+// Trace strips proxy-only headers from untrusted peers and puts the request's trace
+// context on the request context. It must run before LoggingMiddleware.
+func (m *ProxyMiddleware) Trace() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !m.isTrusted(c.RemoteIP()) {
+			for _, h := range proxyOnlyHeaders {
+				c.Request.Header.Del(h)
+			}
+		}
+		trace := reqctx.ContinueTrace(c.GetHeader("traceparent"), c.GetHeader("tracestate"))
+		c.Request = c.Request.WithContext(reqctx.WithTrace(c.Request.Context(), trace))
+		c.Next()
+	}
+}
+
+func (m *ProxyMiddleware) isTrusted(remoteIP string) bool {
+	addr, err := netip.ParseAddr(remoteIP)
+	if err != nil {
+		return false
+	}
+	return containsAddr(m.trusted, addr.Unmap())
+}
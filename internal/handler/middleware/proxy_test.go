@@ -0,0 +1,113 @@
+//go:build unit
+
+package middleware_test
+
+import (
+	"net/http"
+	nethttptest "net/http/httptest"
+	"testing"
+
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/pkg/config"
+	"gin-clean-starter/internal/pkg/reqctx"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const upstreamTraceparent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+func TestProxyMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	testCases := []struct {
+		name             string
+		trustedProxies   []string
+		remoteAddr       string
+		headers          map[string]string
+		expectClientIP   string
+		expectTraceID    string // empty: a new trace was started
+		expectUserHeader string
+	}{
+		{
+			name:             "success: trusted proxy sets client IP and trace",
+			trustedProxies:   []string{"10.0.0.0/8"},
+			remoteAddr:       "10.1.2.3:4567",
+			headers:          map[string]string{"X-Forwarded-For": "203.0.113.7, 10.1.2.3", "traceparent": upstreamTraceparent, "X-User-ID": "u-1"},
+			expectClientIP:   "203.0.113.7",
+			expectTraceID:    "4bf92f3577b34da6a3ce929d0e0e4736",
+			expectUserHeader: "u-1",
+		},
+		{
+			name:           "success: single trusted address and X-Real-IP",
+			trustedProxies: []string{"192.0.2.10"},
+			remoteAddr:     "192.0.2.10:4567",
+			headers:        map[string]string{"X-Real-IP": "198.51.100.9"},
+			expectClientIP: "198.51.100.9",
+		},
+		{
+			name:           "success: malformed traceparent starts a new trace",
+			trustedProxies: []string{"10.0.0.0/8"},
+			remoteAddr:     "10.1.2.3:4567",
+			headers:        map[string]string{"traceparent": "00-00000000000000000000000000000000-00f067aa0ba902b7-01"},
+			expectClientIP: "10.1.2.3",
+		},
+		{
+			name:           "error: untrusted peer cannot spoof client IP, trace or identity",
+			trustedProxies: []string{"10.0.0.0/8"},
+			remoteAddr:     "198.51.100.20:4567",
+			headers:        map[string]string{"X-Forwarded-For": "203.0.113.7", "X-Real-IP": "203.0.113.8", "traceparent": upstreamTraceparent, "X-User-ID": "u-1"},
+			expectClientIP: "198.51.100.20",
+		},
+		{
+			name:           "error: no trusted proxies configured",
+			remoteAddr:     "10.1.2.3:4567",
+			headers:        map[string]string{"X-Forwarded-For": "203.0.113.7"},
+			expectClientIP: "10.1.2.3",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := config.Config{Server: config.ServerConfig{TrustedProxies: tc.trustedProxies, ClientIPHeaders: []string{"X-Forwarded-For", "X-Real-IP"}}}
+			m, err := middleware.NewProxyMiddleware(cfg)
+			require.NoError(t, err)
+
+			router := gin.New()
+			require.NoError(t, m.Configure(router))
+			router.Use(m.Trace())
+
+			var clientIP, userHeader string
+			var trace reqctx.Trace
+			router.GET("/", func(c *gin.Context) {
+				clientIP = c.ClientIP()
+				userHeader = c.GetHeader("X-User-ID")
+				trace, _ = reqctx.TraceInfo(c.Request.Context())
+				c.Status(http.StatusOK)
+			})
+
+			req := nethttptest.NewRequest(http.MethodGet, "/", nil)
+			req.RemoteAddr = tc.remoteAddr
+			for k, v := range tc.headers {
+				req.Header.Set(k, v)
+			}
+			router.ServeHTTP(nethttptest.NewRecorder(), req)
+
+			assert.Equal(t, tc.expectClientIP, clientIP)
+			assert.Equal(t, tc.expectUserHeader, userHeader)
+			if tc.expectTraceID != "" {
+				assert.Equal(t, tc.expectTraceID, trace.TraceIDString())
+				assert.True(t, trace.Sampled)
+			} else {
+				assert.NotEqual(t, "4bf92f3577b34da6a3ce929d0e0e4736", trace.TraceIDString())
+				assert.Equal(t, [8]byte{}, trace.ParentID)
+			}
+		})
+	}
+}
+
+func TestNewProxyMiddleware_InvalidEntry(t *testing.T) {
+	_, err := middleware.NewProxyMiddleware(config.Config{Server: config.ServerConfig{TrustedProxies: []string{"10.0.0.0/33"}}})
+	assert.Error(t, err)
+}
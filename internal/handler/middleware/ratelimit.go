@@ -0,0 +1,186 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/config"
+	"gin-clean-starter/internal/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+var errRateLimited = errs.NewCoded("RATE_LIMITED", "too many requests from this client or for this account")
+
+// emailBodyLimit bounds how much of a request body is read for its email; sign-in bodies
+// are far smaller.
+const emailBodyLimit = 4 << 10
+
+// RateLimiter admits at most limit requests per key within any window: a sliding window
+// that remembers when each admitted request arrived. Rejected requests are not counted.
+type RateLimiter struct {
+	limit  int
+	window time.Duration
+	clock  clock.Clock
+
+	mu    sync.Mutex
+	hits  map[string][]time.Time // oldest first, at most limit each
+	swept time.Time
+}
+
+func NewRateLimiter(limit int, window time.Duration, clock clock.Clock) *RateLimiter {
+	return &RateLimiter{
+		limit:  limit,
+		window: window,
+		clock:  clock,
+		hits:   make(map[string][]time.Time),
+	}
+}
+
+// Allow counts a request for key when it is within the limit. Otherwise it reports how
+// long until the oldest counted request leaves the window.
+func (l *RateLimiter) Allow(key string) (time.Duration, bool) {
+	now := l.clock.Now()
+	start := now.Add(-l.window)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.sweep(now, start)
+
+	hits := l.hits[key]
+	live := 0
+	for live < len(hits) && !hits[live].After(start) {
+		live++
+	}
+	hits = hits[live:]
+	if len(hits) >= l.limit {
+		l.hits[key] = hits
+		return hits[0].Sub(start), false
+	}
+	l.hits[key] = append(hits, now)
+	return 0, true
+}
+
+// sweep forgets keys without a request in the window, at most once per window, so the
+// map only holds recently active clients.
+func (l *RateLimiter) sweep(now, start time.Time) {
+	if now.Sub(l.swept) < l.window {
+		return
+	}
+	for key, hits := range l.hits {
+		if !hits[len(hits)-1].After(start) {
+			delete(l.hits, key)
+		}
+	}
+	l.swept = now
+}
+
+// RateLimitKey picks what a request is counted under; "" leaves it uncounted.
+type RateLimitKey func(c *gin.Context) string
+
+// RateLimitRule counts requests under Key in Limiter; a nil Limiter is off. Scope names
+// the rule in logs.
+type RateLimitRule struct {
+	Scope   string
+	Limiter *RateLimiter
+	Key     RateLimitKey
+}
+
+// RateLimit rejects a request with 429 and Retry-After once a rule's limiter has no room
+// for its key. Rules are checked in order; the ones before a rejection still count it.
+func RateLimit(rules ...RateLimitRule) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		for _, rule := range rules {
+			if rule.Limiter == nil {
+				continue
+			}
+			key := rule.Key(c)
+			if key == "" {
+				continue
+			}
+			if retryAfter, ok := rule.Limiter.Allow(key); !ok {
+				seconds := int(math.Ceil(retryAfter.Seconds()))
+				slog.Warn("Rate limit exceeded", "scope", rule.Scope, "path", c.Request.URL.Path, "client_ip", c.ClientIP(), "retry_after", seconds)
+				c.Header("Retry-After", strconv.Itoa(seconds))
+				httperr.AbortWithError(c, http.StatusTooManyRequests, errRateLimited, "Too many requests; try again later", nil)
+				return
+			}
+		}
+		c.Next()
+	}
+}
+
+// ClientIPKey counts requests per client IP, as resolved under TRUSTED_PROXIES.
+func ClientIPKey(c *gin.Context) string {
+	return c.ClientIP()
+}
+
+// JSONEmailKey counts requests per the "email" field of a JSON body, case-insensitively.
+// The body is put back for the handler.
+func JSONEmailKey(c *gin.Context) string {
+	r := c.Request
+	if r.Body == nil || r.Body == http.NoBody {
+		return ""
+	}
+	head, err := io.ReadAll(io.LimitReader(r.Body, emailBodyLimit))
+	r.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(head), r.Body), Closer: r.Body}
+	if err != nil {
+		return ""
+	}
+	var body struct {
+		Email string `json:"email"`
+	}
+	if json.Unmarshal(head, &body) != nil {
+		return ""
+	}
+	return strings.ToLower(strings.TrimSpace(body.Email))
+}
+
+// RateLimitMiddleware limits the sign-in endpoints per client IP and per email
+// (RATE_LIMIT_*). The endpoints share one budget per IP.
+type RateLimitMiddleware struct {
+	byIP    *RateLimiter
+	byEmail *RateLimiter
+}
+
+func NewRateLimitMiddleware(cfg config.Config, clock clock.Clock) (*RateLimitMiddleware, error) {
+	rl := cfg.RateLimit
+	if rl.PerIP < 0 || rl.PerEmail < 0 {
+		return nil, fmt.Errorf("invalid RATE_LIMIT_PER_IP or RATE_LIMIT_PER_EMAIL: %d, %d", rl.PerIP, rl.PerEmail)
+	}
+	if (rl.PerIP > 0 || rl.PerEmail > 0) && rl.Window <= 0 {
+		return nil, fmt.Errorf("invalid RATE_LIMIT_WINDOW: %s", rl.Window)
+	}
+	m := &RateLimitMiddleware{}
+	if rl.PerIP > 0 {
+		m.byIP = NewRateLimiter(rl.PerIP, rl.Window, clock)
+	}
+	if rl.PerEmail > 0 {
+		m.byEmail = NewRateLimiter(rl.PerEmail, rl.Window, clock)
+	}
+	return m, nil
+}
+
+// Login limits password sign-ins per client IP and per submitted email.
+func (m *RateLimitMiddleware) Login() gin.HandlerFunc {
+	return RateLimit(
+		RateLimitRule{Scope: "ip", Limiter: m.byIP, Key: ClientIPKey},
+		RateLimitRule{Scope: "email", Limiter: m.byEmail, Key: JSONEmailKey},
+	)
+}
+
+// Refresh limits token refreshes per client IP; they carry no email.
+func (m *RateLimitMiddleware) Refresh() gin.HandlerFunc {
+	return RateLimit(RateLimitRule{Scope: "ip", Limiter: m.byIP, Key: ClientIPKey})
+}
@@ -0,0 +1,117 @@
+//go:build unit
+
+package middleware_test
+
+import (
+	"net/http"
+	nethttptest "net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/config"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter_Allow(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	clk := clock.NewMockClock(now)
+	limiter := middleware.NewRateLimiter(2, time.Minute, clk)
+
+	_, ok := limiter.Allow("a")
+	assert.True(t, ok)
+	clk.Add(20 * time.Second)
+	_, ok = limiter.Allow("a")
+	assert.True(t, ok)
+
+	clk.Add(10 * time.Second)
+	retryAfter, ok := limiter.Allow("a")
+	assert.False(t, ok)
+	assert.Equal(t, 30*time.Second, retryAfter, "until the first request leaves the window")
+	_, ok = limiter.Allow("b")
+	assert.True(t, ok, "keys are counted apart")
+
+	clk.Add(30 * time.Second)
+	_, ok = limiter.Allow("a")
+	assert.True(t, ok, "the window slides past the first request")
+	_, ok = limiter.Allow("a")
+	assert.False(t, ok, "rejected requests are not counted, the second one still is")
+}
+
+func TestRateLimitMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	newRouter := func(t *testing.T, rl config.RateLimitConfig) *gin.Engine {
+		t.Helper()
+		m, err := middleware.NewRateLimitMiddleware(config.Config{RateLimit: rl}, clock.NewMockClock(now))
+		require.NoError(t, err)
+		r := gin.New()
+		r.POST("/login", m.Login(), func(c *gin.Context) {
+			var body struct {
+				Email string `json:"email"`
+			}
+			if err := c.ShouldBindJSON(&body); err != nil {
+				c.Status(http.StatusBadRequest)
+				return
+			}
+			c.String(http.StatusOK, body.Email)
+		})
+		r.POST("/refresh", m.Refresh(), func(c *gin.Context) { c.Status(http.StatusOK) })
+		return r
+	}
+	post := func(r *gin.Engine, path, ip, body string) *nethttptest.ResponseRecorder {
+		req := nethttptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.RemoteAddr = ip + ":1234"
+		w := nethttptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("success: per-email limit ignores case and keeps the body", func(t *testing.T) {
+		r := newRouter(t, config.RateLimitConfig{Window: time.Minute, PerEmail: 2})
+
+		w := post(r, "/login", "192.0.2.1", `{"email":"user@example.com"}`)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "user@example.com", w.Body.String())
+		assert.Equal(t, http.StatusOK, post(r, "/login", "192.0.2.2", `{"email":" User@Example.com"}`).Code)
+
+		w = post(r, "/login", "192.0.2.3", `{"email":"USER@example.com"}`)
+		assert.Equal(t, http.StatusTooManyRequests, w.Code)
+		assert.Equal(t, "60", w.Header().Get("Retry-After"))
+		assert.Contains(t, w.Body.String(), "RATE_LIMITED")
+
+		assert.Equal(t, http.StatusOK, post(r, "/login", "192.0.2.3", `{"email":"other@example.com"}`).Code)
+		assert.Equal(t, http.StatusOK, post(r, "/refresh", "192.0.2.3", "").Code, "refreshes carry no email")
+	})
+
+	t.Run("success: per-IP limit is shared by login and refresh", func(t *testing.T) {
+		r := newRouter(t, config.RateLimitConfig{Window: time.Minute, PerIP: 2})
+
+		assert.Equal(t, http.StatusOK, post(r, "/login", "192.0.2.1", `{"email":"a@example.com"}`).Code)
+		assert.Equal(t, http.StatusOK, post(r, "/refresh", "192.0.2.1", "").Code)
+		assert.Equal(t, http.StatusTooManyRequests, post(r, "/login", "192.0.2.1", `{"email":"b@example.com"}`).Code)
+		assert.Equal(t, http.StatusTooManyRequests, post(r, "/refresh", "192.0.2.1", "").Code)
+		assert.Equal(t, http.StatusOK, post(r, "/refresh", "192.0.2.2", "").Code)
+	})
+
+	t.Run("success: zero limits are off", func(t *testing.T) {
+		r := newRouter(t, config.RateLimitConfig{})
+		for range 5 {
+			assert.Equal(t, http.StatusOK, post(r, "/login", "192.0.2.1", `{"email":"a@example.com"}`).Code)
+		}
+	})
+
+	t.Run("error: invalid config", func(t *testing.T) {
+		_, err := middleware.NewRateLimitMiddleware(config.Config{RateLimit: config.RateLimitConfig{PerIP: -1}}, clock.NewMockClock(now))
+		assert.Error(t, err)
+		_, err = middleware.NewRateLimitMiddleware(config.Config{RateLimit: config.RateLimitConfig{PerEmail: 1}}, clock.NewMockClock(now))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,191 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
+
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/config"
+	"gin-clean-starter/internal/pkg/redact"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RecordingMiddleware struct {
+	recorder     shared.RequestRecorder
+	clock        clock.Clock
+	maxBodyBytes int64
+}
+
+func NewRecordingMiddleware(cfg config.Config, recorder shared.RequestRecorder, clock clock.Clock) *RecordingMiddleware {
+	return &RecordingMiddleware{
+		recorder:     recorder,
+		clock:        clock,
+		maxBodyBytes: cfg.Recording.MaxBodyBytes,
+	}
+}
+
+// recordedExchange is the document stored for one capture.
+type recordedExchange struct {
+	Method     string          `json:"method"`
+	Path       string          `json:"path"`
+	Query      string          `json:"query,omitempty"`
+	Status     int             `json:"status"`
+	DurationMs int64           `json:"durationMs"`
+	RecordedAt time.Time       `json:"recordedAt"`
+	Request    recordedMessage `json:"request"`
+	Response   recordedMessage `json:"response"`
+}
+
+type recordedMessage struct {
+	Headers map[string][]string `json:"headers"`
+	Body    any                 `json:"body,omitempty"`
+}
+
+// Capture records the request and response of users with an active recording, with
+// credentials and secret fields masked. JSON bodies up to the configured size are kept;
+// other bodies are replaced by a note of their size and type. It must run after
+// RequireAuth; support sessions are never recorded. A failed capture never fails the
+// request.
+func (m *RecordingMiddleware) Capture() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, ok := GetUserID(c)
+		if !ok {
+			c.Next()
+			return
+		}
+		if _, support := GetSupportScope(c); support {
+			c.Next()
+			return
+		}
+
+		slot, err := m.recorder.Claim(c.Request.Context(), userID)
+		if err != nil {
+			slog.Warn("Request recording claim failed", "user_id", userID, "error", err.Error())
+		}
+		if slot == nil {
+			c.Next()
+			return
+		}
+
+		start := m.clock.Now()
+		requestBody, requestTruncated := m.peekBody(c.Request)
+		writer := &teeWriter{ResponseWriter: c.Writer, limit: m.maxBodyBytes}
+		c.Writer = writer
+
+		c.Next()
+
+		c.Writer = writer.ResponseWriter
+		exchange := recordedExchange{
+			Method:     c.Request.Method,
+			Path:       c.Request.URL.Path,
+			Query:      redact.Query(c.Request.URL.RawQuery),
+			Status:     writer.Status(),
+			DurationMs: m.clock.Now().Sub(start).Milliseconds(),
+			RecordedAt: start,
+			Request: recordedMessage{
+				Headers: redact.Headers(c.Request.Header),
+				Body:    capturedBody(c.Request.Header.Get("Content-Type"), requestBody, requestTruncated),
+			},
+			Response: recordedMessage{
+				Headers: redact.Headers(writer.Header()),
+				Body:    capturedBody(writer.Header().Get("Content-Type"), writer.buf.Bytes(), writer.truncated),
+			},
+		}
+		document, err := json.Marshal(exchange)
+		if err != nil {
+			slog.Error("Failed to encode recorded request", "recording_id", slot.RecordingID, "error", err.Error())
+			return
+		}
+
+		err = m.recorder.Save(context.WithoutCancel(c.Request.Context()), *slot, shared.RequestCapture{
+			Method:     exchange.Method,
+			Path:       exchange.Path,
+			Status:     exchange.Status,
+			Duration:   m.clock.Now().Sub(start),
+			RecordedAt: start,
+			Document:   document,
+		})
+		if err != nil {
+			slog.Error("Failed to save recorded request", "recording_id", slot.RecordingID, "seq", slot.Seq, "error", err.Error())
+		}
+	}
+}
+
+// peekBody reads up to the size limit of the request body and puts it back for the
+// handler. It reports true when the body is longer than the limit.
+func (m *RecordingMiddleware) peekBody(r *http.Request) ([]byte, bool) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return nil, false
+	}
+	head, err := io.ReadAll(io.LimitReader(r.Body, m.maxBodyBytes+1))
+	r.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(head), r.Body), Closer: r.Body}
+	if err != nil {
+		return nil, true
+	}
+	if int64(len(head)) > m.maxBodyBytes {
+		return head[:m.maxBodyBytes], true
+	}
+	return head, false
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// capturedBody is the redacted JSON of body, or a note of what was left out.
+func capturedBody(contentType string, body []byte, truncated bool) any {
+	if len(body) == 0 && !truncated {
+		return nil
+	}
+	if isJSON(contentType) && !truncated {
+		if v, ok := redact.JSON(body); ok {
+			return v
+		}
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = "unknown type"
+	}
+	size := strconv.Itoa(len(body))
+	if truncated {
+		size = "more than " + size
+	}
+	return "<" + size + " bytes of " + mediaType + " not captured>"
+}
+
+// teeWriter keeps a copy of the first limit bytes of the response body.
+type teeWriter struct {
+	gin.ResponseWriter
+	buf       bytes.Buffer
+	limit     int64
+	truncated bool
+}
+
+func (w *teeWriter) Write(data []byte) (int, error) {
+	w.keep(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *teeWriter) WriteString(s string) (int, error) {
+	w.keep([]byte(s))
+	return w.ResponseWriter.WriteString(s)
+}
+
+func (w *teeWriter) keep(data []byte) {
+	room := w.limit - int64(w.buf.Len())
+	if int64(len(data)) > room {
+		data = data[:max(room, 0)]
+		w.truncated = true
+	}
+	w.buf.Write(data)
+}
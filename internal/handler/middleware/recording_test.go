@@ -0,0 +1,168 @@
+//go:build unit
+
+package middleware_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	nethttptest "net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/config"
+	"gin-clean-starter/internal/pkg/jwt"
+	"gin-clean-starter/internal/usecase"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type requestRecorderStub struct {
+	recorded map[uuid.UUID]bool
+	saved    []shared.RequestCapture
+}
+
+func (s *requestRecorderStub) Claim(_ context.Context, userID uuid.UUID) (*shared.RecordingSlot, error) {
+	if !s.recorded[userID] {
+		return nil, nil
+	}
+	return &shared.RecordingSlot{RecordingID: uuid.New(), Seq: int32(len(s.saved) + 1)}, nil
+}
+
+func (s *requestRecorderStub) Save(_ context.Context, _ shared.RecordingSlot, capture shared.RequestCapture) error {
+	s.saved = append(s.saved, capture)
+	return nil
+}
+
+func (s *requestRecorderStub) Invalidate() {}
+
+type capturedExchange struct {
+	Method   string `json:"method"`
+	Path     string `json:"path"`
+	Query    string `json:"query"`
+	Status   int    `json:"status"`
+	Request  capturedMessage
+	Response capturedMessage
+}
+
+type capturedMessage struct {
+	Headers map[string][]string `json:"headers"`
+	Body    any                 `json:"body"`
+}
+
+func TestRecordingMiddleware_Capture(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	jwtService := jwt.NewService("test-jwt-secret-key", 15*time.Minute, 168*time.Hour)
+	auth := middleware.NewAuthMiddleware(usecase.NewTokenValidator(jwtService), nil, nil, tosGateStub{}, sessionGateStub{}, nil)
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+
+	recordedID := uuid.New()
+	recordedToken, err := jwtService.GenerateAccessToken(recordedID, user.RoleViewer, uuid.Nil)
+	require.NoError(t, err)
+	otherToken, err := jwtService.GenerateAccessToken(uuid.New(), user.RoleViewer, uuid.Nil)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name            string
+		path            string
+		body            string
+		token           string
+		expectCaptured  bool
+		expectQuery     string
+		expectReqBody   any
+		expectRespBody  any
+		expectHandlerIn string
+	}{
+		{
+			name:            "success: secrets in JSON bodies, query and headers are masked",
+			path:            "/items?q=room&token=abc",
+			body:            `{"name":"Room A","password":"hunter2"}`,
+			token:           recordedToken,
+			expectCaptured:  true,
+			expectQuery:     "q=room&token=%5BREDACTED%5D",
+			expectReqBody:   map[string]any{"name": "Room A", "password": "[REDACTED]"},
+			expectRespBody:  map[string]any{"received": float64(38), "accessToken": "[REDACTED]"},
+			expectHandlerIn: `{"name":"Room A","password":"hunter2"}`,
+		},
+		{
+			name:            "success: oversized bodies are noted, not captured, and still reach the handler",
+			path:            "/items",
+			body:            `{"name":"` + strings.Repeat("x", 100) + `"}`,
+			token:           recordedToken,
+			expectCaptured:  true,
+			expectReqBody:   "<more than 64 bytes of application/json not captured>",
+			expectRespBody:  map[string]any{"received": float64(111), "accessToken": "[REDACTED]"},
+			expectHandlerIn: `{"name":"` + strings.Repeat("x", 100) + `"}`,
+		},
+		{
+			name:            "success: users who are not recorded are not captured",
+			path:            "/items",
+			body:            `{}`,
+			token:           otherToken,
+			expectHandlerIn: `{}`,
+		},
+		{
+			name:            "success: anonymous requests are not captured",
+			path:            "/public",
+			body:            `{}`,
+			expectHandlerIn: `{}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := &requestRecorderStub{recorded: map[uuid.UUID]bool{recordedID: true}}
+			cfg := config.Config{Recording: config.RequestRecordingConfig{MaxBodyBytes: 64}}
+			m := middleware.NewRecordingMiddleware(cfg, recorder, clock.NewMockClock(now))
+
+			var handlerIn string
+			echo := func(c *gin.Context) {
+				body, _ := io.ReadAll(c.Request.Body)
+				handlerIn = string(body)
+				c.JSON(http.StatusCreated, gin.H{"received": len(body), "accessToken": "secret"})
+			}
+			router := gin.New()
+			router.POST("/items", auth.RequireAuth(), m.Capture(), echo)
+			router.POST("/public", m.Capture(), echo)
+
+			req := nethttptest.NewRequest(http.MethodPost, tc.path, strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			if tc.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tc.token)
+			}
+			w := nethttptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusCreated, w.Code)
+			assert.Equal(t, tc.expectHandlerIn, handlerIn)
+			if !tc.expectCaptured {
+				assert.Empty(t, recorder.saved)
+				return
+			}
+
+			require.Len(t, recorder.saved, 1)
+			saved := recorder.saved[0]
+			assert.Equal(t, http.MethodPost, saved.Method)
+			assert.Equal(t, "/items", saved.Path)
+			assert.Equal(t, http.StatusCreated, saved.Status)
+			assert.Equal(t, now, saved.RecordedAt)
+
+			var doc capturedExchange
+			require.NoError(t, json.Unmarshal(saved.Document, &doc))
+			assert.Equal(t, tc.expectQuery, doc.Query)
+			assert.Equal(t, http.StatusCreated, doc.Status)
+			assert.Equal(t, []string{"[REDACTED]"}, doc.Request.Headers["Authorization"])
+			assert.Equal(t, tc.expectReqBody, doc.Request.Body)
+			assert.Equal(t, tc.expectRespBody, doc.Response.Body)
+		})
+	}
+}
@@ -0,0 +1,220 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+
+	"gin-clean-starter/internal/pkg/config"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ResponseFormat selects how JSON reply bodies are shaped on the way out. Keys are always
+// lowerCamelCase; the formats differ in what surrounds them.
+type ResponseFormat string
+
+const (
+	// ResponseFormatCompat also writes the snake_case keys older clients read (next_cursor)
+	// next to their camelCase names.
+	ResponseFormatCompat ResponseFormat = "compat"
+	// ResponseFormatPlain writes bodies as the handlers encode them.
+	ResponseFormatPlain ResponseFormat = "plain"
+	// ResponseFormatEnvelope wraps bodies as {"data", "meta"} and errors as {"errors", "meta"}.
+	ResponseFormatEnvelope ResponseFormat = "envelope"
+)
+
+const headerResponseFormat = "X-Response-Format"
+
+// Snake_case keys replies carried before the casing was unified, by their current name.
+var legacyKeys = map[string]string{
+	"nextCursor": "next_cursor",
+}
+
+// ResponseFormatMiddleware applies the configured response format. Clients override it per
+// request with an X-Response-Format header naming another format.
+type ResponseFormatMiddleware struct {
+	defaultFormat ResponseFormat
+}
+
+func NewResponseFormatMiddleware(cfg config.Config) (*ResponseFormatMiddleware, error) {
+	format := ResponseFormat(cfg.Server.ResponseFormat)
+	if !format.valid() {
+		return nil, fmt.Errorf("invalid response format %q: want %q, %q or %q", format, ResponseFormatCompat, ResponseFormatPlain, ResponseFormatEnvelope)
+	}
+	return &ResponseFormatMiddleware{defaultFormat: format}, nil
+}
+
+func (f ResponseFormat) valid() bool {
+	switch f {
+	case ResponseFormatCompat, ResponseFormatPlain, ResponseFormatEnvelope:
+		return true
+	}
+	return false
+}
+
+// Shape buffers JSON replies and rewrites them in the requested format once the handler
+// is done. Other content types (exports, streams) pass through untouched.
+func (m *ResponseFormatMiddleware) Shape() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Writer.Header().Add("Vary", headerResponseFormat)
+		format := m.requested(c)
+		if format == ResponseFormatPlain {
+			c.Next()
+			return
+		}
+
+		w := &shapingWriter{ResponseWriter: c.Writer}
+		c.Writer = w
+		c.Next()
+		c.Writer = w.ResponseWriter
+
+		if w.buf == nil {
+			return
+		}
+		body := w.buf.Bytes()
+		switch format {
+		case ResponseFormatCompat:
+			body = withLegacyKeys(body)
+		case ResponseFormatEnvelope:
+			body = envelope(body, c.Writer.Status(), GetRequestID(c))
+		}
+		c.Writer.Header().Del("Content-Length")
+		_, _ = c.Writer.Write(body)
+	}
+}
+
+func (m *ResponseFormatMiddleware) requested(c *gin.Context) ResponseFormat {
+	if format := ResponseFormat(strings.ToLower(strings.TrimSpace(c.GetHeader(headerResponseFormat)))); format.valid() {
+		return format
+	}
+	return m.defaultFormat
+}
+
+// shapingWriter holds back the body of a JSON reply; anything else is written through.
+type shapingWriter struct {
+	gin.ResponseWriter
+	buf *bytes.Buffer
+}
+
+func (w *shapingWriter) Write(data []byte) (int, error) {
+	if w.buf == nil && !isJSON(w.Header().Get("Content-Type")) {
+		return w.ResponseWriter.Write(data)
+	}
+	if w.buf == nil {
+		w.buf = new(bytes.Buffer)
+	}
+	return w.buf.Write(data)
+}
+
+func (w *shapingWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+func (w *shapingWriter) WriteHeaderNow() {
+	if w.buf == nil {
+		w.ResponseWriter.WriteHeaderNow()
+	}
+}
+
+func (w *shapingWriter) Written() bool {
+	return w.buf != nil || w.ResponseWriter.Written()
+}
+
+func (w *shapingWriter) Size() int {
+	if w.buf != nil {
+		return w.buf.Len()
+	}
+	return w.ResponseWriter.Size()
+}
+
+// Flush is a no-op while a JSON body is held back; it is written whole at the end.
+func (w *shapingWriter) Flush() {
+	if w.buf == nil {
+		w.ResponseWriter.Flush()
+	}
+}
+
+func isJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && mediaType == "application/json"
+}
+
+// withLegacyKeys copies top-level keys that have a legacy name under that name as well.
+// Bodies that are not objects or carry no such key are returned as they are.
+func withLegacyKeys(body []byte) []byte {
+	found := false
+	for key := range legacyKeys {
+		if bytes.Contains(body, []byte(`"`+key+`"`)) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return body
+	}
+
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(body, &object); err != nil {
+		return body
+	}
+	for key, legacy := range legacyKeys {
+		if value, ok := object[key]; ok {
+			object[legacy] = value
+		}
+	}
+	out, err := json.Marshal(object)
+	if err != nil {
+		return body
+	}
+	return out
+}
+
+type envelopeMeta struct {
+	RequestID string `json:"requestId,omitempty"`
+}
+
+type envelopeError struct {
+	Code    string          `json:"code"`
+	Message string          `json:"message"`
+	Detail  json.RawMessage `json:"detail,omitempty"`
+}
+
+// envelope wraps a success body as data and an error body (httperr.Response) as a
+// one-element errors list. A body that is not valid JSON is returned as it is.
+func envelope(body []byte, status int, requestID string) []byte {
+	if !json.Valid(body) {
+		return body
+	}
+	meta := envelopeMeta{RequestID: requestID}
+
+	var wrapped any
+	if status >= http.StatusBadRequest {
+		var failure struct {
+			Error  *envelopeError  `json:"error"`
+			Detail json.RawMessage `json:"detail"`
+		}
+		if err := json.Unmarshal(body, &failure); err == nil && failure.Error != nil {
+			failure.Error.Detail = failure.Detail
+			wrapped = struct {
+				Errors []envelopeError `json:"errors"`
+				Meta   envelopeMeta    `json:"meta"`
+			}{Errors: []envelopeError{*failure.Error}, Meta: meta}
+		}
+	}
+	if wrapped == nil {
+		wrapped = struct {
+			Data json.RawMessage `json:"data"`
+			Meta envelopeMeta    `json:"meta"`
+		}{Data: bytes.TrimSpace(body), Meta: meta}
+	}
+
+	out, err := json.Marshal(wrapped)
+	if err != nil {
+		return body
+	}
+	return out
+}
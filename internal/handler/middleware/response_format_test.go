@@ -0,0 +1,132 @@
+//go:build unit
+
+package middleware_test
+
+import (
+	"errors"
+	"net/http"
+	nethttptest "net/http/httptest"
+	"testing"
+
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/pkg/config"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func responseFormatRouter(t *testing.T, format string) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	m, err := middleware.NewResponseFormatMiddleware(config.Config{Server: config.ServerConfig{ResponseFormat: format}})
+	require.NoError(t, err)
+
+	router := gin.New()
+	router.Use(m.Shape(), middleware.ErrorHandler())
+	router.GET("/page", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"items": []int{1, 2}, "nextCursor": "abc"})
+	})
+	router.GET("/fail", func(c *gin.Context) {
+		httperr.AbortWithError(c, http.StatusConflict, errors.New("taken"), "Slot taken", map[string]any{"slot": 3})
+	})
+	router.GET("/csv", func(c *gin.Context) {
+		c.Data(http.StatusOK, "text/csv", []byte("id\n1\n"))
+	})
+	router.GET("/empty", func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+	return router
+}
+
+func TestResponseFormat(t *testing.T) {
+	testCases := []struct {
+		name     string
+		format   string
+		header   string
+		path     string
+		wantCode int
+		wantBody string
+	}{
+		{
+			name:     "compat adds the legacy cursor key",
+			format:   "compat",
+			path:     "/page",
+			wantCode: http.StatusOK,
+			wantBody: `{"items":[1,2],"nextCursor":"abc","next_cursor":"abc"}`,
+		},
+		{
+			name:     "plain writes camelCase only",
+			format:   "plain",
+			path:     "/page",
+			wantCode: http.StatusOK,
+			wantBody: `{"items":[1,2],"nextCursor":"abc"}`,
+		},
+		{
+			name:     "envelope wraps data",
+			format:   "envelope",
+			path:     "/page",
+			wantCode: http.StatusOK,
+			wantBody: `{"data":{"items":[1,2],"nextCursor":"abc"},"meta":{}}`,
+		},
+		{
+			name:     "envelope lists errors",
+			format:   "envelope",
+			path:     "/fail",
+			wantCode: http.StatusConflict,
+			wantBody: `{"errors":[{"code":"CONFLICT","message":"Slot taken","detail":{"slot":3}}],"meta":{}}`,
+		},
+		{
+			name:     "header overrides the configured format",
+			format:   "compat",
+			header:   "Envelope",
+			path:     "/page",
+			wantCode: http.StatusOK,
+			wantBody: `{"data":{"items":[1,2],"nextCursor":"abc"},"meta":{}}`,
+		},
+		{
+			name:     "unknown header value keeps the configured format",
+			format:   "plain",
+			header:   "xml",
+			path:     "/page",
+			wantCode: http.StatusOK,
+			wantBody: `{"items":[1,2],"nextCursor":"abc"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := nethttptest.NewRequest(http.MethodGet, tc.path, nil)
+			if tc.header != "" {
+				req.Header.Set("X-Response-Format", tc.header)
+			}
+			w := nethttptest.NewRecorder()
+			responseFormatRouter(t, tc.format).ServeHTTP(w, req)
+
+			assert.Equal(t, tc.wantCode, w.Code)
+			assert.JSONEq(t, tc.wantBody, w.Body.String())
+			assert.Equal(t, "X-Response-Format", w.Header().Get("Vary"))
+		})
+	}
+
+	t.Run("non-JSON and empty replies pass through", func(t *testing.T) {
+		router := responseFormatRouter(t, "envelope")
+
+		w := nethttptest.NewRecorder()
+		router.ServeHTTP(w, nethttptest.NewRequest(http.MethodGet, "/csv", nil))
+		assert.Equal(t, "id\n1\n", w.Body.String())
+
+		w = nethttptest.NewRecorder()
+		router.ServeHTTP(w, nethttptest.NewRequest(http.MethodGet, "/empty", nil))
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		assert.Empty(t, w.Body.String())
+	})
+}
+
+func TestNewResponseFormatMiddleware_RejectsUnknownFormat(t *testing.T) {
+	_, err := middleware.NewResponseFormatMiddleware(config.Config{Server: config.ServerConfig{ResponseFormat: "snake"}})
+
+	assert.Error(t, err)
+}
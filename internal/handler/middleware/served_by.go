@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"gin-clean-starter/internal/pkg/reqctx"
+
+	"github.com/gin-gonic/gin"
+)
+
+const headerServedBy = "X-Served-By"
+
+// ServedBy names the database endpoint that answered the request's lag-tolerant reads
+// (DB_SERVED_BY_HEADER), so stale-looking responses can be traced to a replica. Requests
+// that only touch the primary through transactions carry no header.
+func ServedBy() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := reqctx.WithServedBy(c.Request.Context(), func(endpoint string) {
+			c.Header(headerServedBy, endpoint)
+		})
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
@@ -0,0 +1,37 @@
+//go:build unit
+
+package middleware_test
+
+import (
+	"net/http"
+	nethttptest "net/http/httptest"
+	"testing"
+
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/pkg/reqctx"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServedBy(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(middleware.ServedBy())
+	router.GET("/read", func(c *gin.Context) {
+		reqctx.ReportServedBy(c.Request.Context(), "eu/replica-1:5432")
+		c.Status(http.StatusOK)
+	})
+	router.GET("/write", func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+
+	w := nethttptest.NewRecorder()
+	router.ServeHTTP(w, nethttptest.NewRequest(http.MethodGet, "/read", nil))
+	assert.Equal(t, "eu/replica-1:5432", w.Header().Get("X-Served-By"))
+
+	w = nethttptest.NewRecorder()
+	router.ServeHTTP(w, nethttptest.NewRequest(http.MethodGet, "/write", nil))
+	assert.Empty(t, w.Header().Get("X-Served-By"))
+}
@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"net/http"
+
+	"gin-clean-starter/internal/pkg/config"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TelemetryMiddleware struct {
+	enabled   bool
+	telemetry shared.FeatureTelemetry
+}
+
+func NewTelemetryMiddleware(cfg config.Config, telemetry shared.FeatureTelemetry) *TelemetryMiddleware {
+	return &TelemetryMiddleware{
+		enabled:   cfg.Telemetry.Enabled,
+		telemetry: telemetry,
+	}
+}
+
+// Track records the route template a request was served by, e.g. "GET /api/reservations/:id".
+// It is installed on the engine and reads the identity RequireAuth sets further down the
+// chain; anonymous requests, support sessions, unmatched paths and failed requests are
+// not recorded.
+func (m *TelemetryMiddleware) Track() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if !m.enabled || c.FullPath() == "" || c.Writer.Status() >= http.StatusBadRequest {
+			return
+		}
+		userID, ok := GetUserID(c)
+		if !ok {
+			return
+		}
+		if _, support := GetSupportScope(c); support {
+			return
+		}
+		m.telemetry.Record(userID, c.Request.Method+" "+c.FullPath())
+	}
+}
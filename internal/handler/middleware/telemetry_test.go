@@ -0,0 +1,111 @@
+//go:build unit
+
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	nethttptest "net/http/httptest"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/pkg/config"
+	"gin-clean-starter/internal/pkg/jwt"
+	"gin-clean-starter/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type featureTelemetryStub struct {
+	recorded []string
+}
+
+func (s *featureTelemetryStub) Record(_ uuid.UUID, feature string) {
+	s.recorded = append(s.recorded, feature)
+}
+
+func (s *featureTelemetryStub) Flush(context.Context) (int, error) { return 0, nil }
+
+func TestTelemetryMiddleware_Track(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	jwtService := jwt.NewService("test-jwt-secret-key", 15*time.Minute, 168*time.Hour)
+	auth := middleware.NewAuthMiddleware(usecase.NewTokenValidator(jwtService), nil, nil, tosGateStub{}, sessionGateStub{}, nil)
+
+	token, err := jwtService.GenerateAccessToken(uuid.New(), user.RoleViewer, uuid.Nil)
+	require.NoError(t, err)
+
+	enabled := config.Config{Telemetry: config.TelemetryConfig{Enabled: true}}
+
+	testCases := []struct {
+		name           string
+		cfg            config.Config
+		path           string
+		token          string
+		expectRecorded []string
+	}{
+		{
+			name:           "success: route template recorded",
+			cfg:            enabled,
+			path:           "/items/42",
+			token:          token,
+			expectRecorded: []string{"GET /items/:id"},
+		},
+		{
+			name:  "success: anonymous request not recorded",
+			cfg:   enabled,
+			path:  "/public",
+			token: "",
+		},
+		{
+			name:  "success: failed request not recorded",
+			cfg:   enabled,
+			path:  "/broken",
+			token: token,
+		},
+		{
+			name:  "success: unmatched path not recorded",
+			cfg:   enabled,
+			path:  "/missing",
+			token: token,
+		},
+		{
+			name:  "success: telemetry disabled",
+			cfg:   config.Config{},
+			path:  "/items/42",
+			token: token,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			telemetry := &featureTelemetryStub{}
+			m := middleware.NewTelemetryMiddleware(tc.cfg, telemetry)
+
+			router := gin.New()
+			router.Use(m.Track())
+			router.GET("/items/:id", auth.RequireAuth(), func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+			router.GET("/broken", auth.RequireAuth(), func(c *gin.Context) {
+				c.Status(http.StatusUnprocessableEntity)
+			})
+			router.GET("/public", func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			req := nethttptest.NewRequest(http.MethodGet, tc.path, nil)
+			if tc.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tc.token)
+			}
+			w := nethttptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.expectRecorded, telemetry.recorded)
+		})
+	}
+}
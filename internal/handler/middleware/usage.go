@@ -0,0 +1,109 @@
+package middleware
+
+import (
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/config"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/gin-gonic/gin"
+)
+
+var errUsageQuotaExceeded = errs.NewCoded("USAGE_QUOTA_EXCEEDED", "monthly API request quota exceeded")
+
+const (
+	headerUsageQuotaWarning  = "X-Usage-Quota-Warning"
+	headerRateLimitLimit     = "X-RateLimit-Limit"
+	headerRateLimitRemaining = "X-RateLimit-Remaining"
+	headerRateLimitReset     = "X-RateLimit-Reset"
+)
+
+type UsageMiddleware struct {
+	enabled bool
+	meter   shared.UsageMeter
+	quotas  shared.UsageQuotaGate
+	clock   clock.Clock
+}
+
+func NewUsageMiddleware(cfg config.Config, meter shared.UsageMeter, quotas shared.UsageQuotaGate, clock clock.Clock) *UsageMiddleware {
+	return &UsageMiddleware{
+		enabled: cfg.Usage.MeteringEnabled,
+		meter:   meter,
+		quotas:  quotas,
+		clock:   clock,
+	}
+}
+
+// Meter enforces the caller's company quota and counts the request once it has been
+// served. It must run after RequireAuth; support sessions are neither metered nor limited.
+// Callers under a quota get X-RateLimit-* headers on every response, 429s included, so
+// they can slow down before the hard limit.
+func (m *UsageMiddleware) Meter() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !m.enabled {
+			c.Next()
+			return
+		}
+		userID, ok := GetUserID(c)
+		if !ok {
+			c.Next()
+			return
+		}
+		if _, support := GetSupportScope(c); support {
+			c.Next()
+			return
+		}
+
+		status, err := m.quotas.Check(c.Request.Context(), userID)
+		if err != nil {
+			// A failed lookup must not take the API down with it; serve unmetered.
+			slog.Warn("Usage quota lookup failed", "user_id", userID, "error", err.Error())
+		}
+		if status != nil {
+			setRateLimitHeaders(c, status)
+			if status.HardExceeded() {
+				c.Header("Retry-After", strconv.Itoa(retryAfterSeconds(status.ResetsAt.Sub(m.clock.Now()))))
+				httperr.AbortWithError(c, http.StatusTooManyRequests, errUsageQuotaExceeded, "Monthly API quota exceeded", map[string]any{
+					"limit":     *status.HardLimit,
+					"used":      status.Used,
+					"resets_at": status.ResetsAt,
+				})
+				return
+			}
+			if status.SoftExceeded() {
+				c.Header(headerUsageQuotaWarning, "monthly request quota of "+strconv.FormatInt(*status.SoftLimit, 10)+" exceeded")
+			}
+		}
+
+		c.Next()
+
+		m.meter.Record(userID, c.Request.ContentLength, int64(c.Writer.Size()))
+	}
+}
+
+// setRateLimitHeaders reports the limit that applies (the hard one, else the soft one),
+// the requests left after this one and the reset time in Unix seconds.
+func setRateLimitHeaders(c *gin.Context, status *shared.UsageQuotaStatus) {
+	limit := status.HardLimit
+	if limit == nil {
+		limit = status.SoftLimit
+	}
+	if limit == nil {
+		return
+	}
+	remaining := max(*limit-status.Used-1, 0)
+	c.Header(headerRateLimitLimit, strconv.FormatInt(*limit, 10))
+	c.Header(headerRateLimitRemaining, strconv.FormatInt(remaining, 10))
+	c.Header(headerRateLimitReset, strconv.FormatInt(status.ResetsAt.Unix(), 10))
+}
+
+func retryAfterSeconds(d time.Duration) int {
+	return int(math.Max(1, math.Ceil(d.Seconds())))
+}
@@ -0,0 +1,158 @@
+//go:build unit
+
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	nethttptest "net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/config"
+	"gin-clean-starter/internal/pkg/jwt"
+	"gin-clean-starter/internal/usecase"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordedUsage struct {
+	userID            uuid.UUID
+	bytesIn, bytesOut int64
+}
+
+type usageMeterStub struct {
+	recorded []recordedUsage
+}
+
+func (s *usageMeterStub) Record(userID uuid.UUID, bytesIn, bytesOut int64) {
+	s.recorded = append(s.recorded, recordedUsage{userID, bytesIn, bytesOut})
+}
+
+func (s *usageMeterStub) Flush(context.Context) (int, error) { return 0, nil }
+
+type quotaGateStub struct {
+	status *shared.UsageQuotaStatus
+	err    error
+}
+
+func (s quotaGateStub) Check(context.Context, uuid.UUID) (*shared.UsageQuotaStatus, error) {
+	return s.status, s.err
+}
+
+func TestUsageMiddleware_Meter(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	jwtService := jwt.NewService("test-jwt-secret-key", 15*time.Minute, 168*time.Hour)
+	auth := middleware.NewAuthMiddleware(usecase.NewTokenValidator(jwtService), nil, nil, tosGateStub{}, sessionGateStub{}, nil)
+	now := time.Date(2026, 10, 31, 23, 59, 30, 0, time.UTC)
+	resetsAt := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+
+	userID := uuid.New()
+	token, err := jwtService.GenerateAccessToken(userID, user.RoleViewer, uuid.Nil)
+	require.NoError(t, err)
+
+	enabled := config.Config{Usage: config.UsageConfig{MeteringEnabled: true}}
+
+	testCases := []struct {
+		name           string
+		cfg            config.Config
+		gate           quotaGateStub
+		expectedStatus int
+		expectWarning  bool
+		expectRecorded bool
+		// expectRateLimit is the X-RateLimit-Limit and -Remaining pair; nil expects neither.
+		expectRateLimit []string
+	}{
+		{
+			name:           "success: no quota, request metered",
+			cfg:            enabled,
+			expectedStatus: http.StatusOK,
+			expectRecorded: true,
+		},
+		{
+			name:            "success: soft quota exceeded adds a warning",
+			cfg:             enabled,
+			gate:            quotaGateStub{status: &shared.UsageQuotaStatus{Used: 120, SoftLimit: ptr(int64(100)), HardLimit: ptr(int64(200)), ResetsAt: resetsAt}},
+			expectedStatus:  http.StatusOK,
+			expectWarning:   true,
+			expectRecorded:  true,
+			expectRateLimit: []string{"200", "79"},
+		},
+		{
+			name:            "success: soft quota alone is reported as the limit",
+			cfg:             enabled,
+			gate:            quotaGateStub{status: &shared.UsageQuotaStatus{Used: 10, SoftLimit: ptr(int64(100)), ResetsAt: resetsAt}},
+			expectedStatus:  http.StatusOK,
+			expectRecorded:  true,
+			expectRateLimit: []string{"100", "89"},
+		},
+		{
+			name:           "success: failed quota lookup serves the request",
+			cfg:            enabled,
+			gate:           quotaGateStub{err: errors.New("connection refused")},
+			expectedStatus: http.StatusOK,
+			expectRecorded: true,
+		},
+		{
+			name:           "success: metering disabled",
+			cfg:            config.Config{},
+			gate:           quotaGateStub{status: &shared.UsageQuotaStatus{Used: 500, HardLimit: ptr(int64(200)), ResetsAt: resetsAt}},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:            "error: hard quota exceeded",
+			cfg:             enabled,
+			gate:            quotaGateStub{status: &shared.UsageQuotaStatus{Used: 200, HardLimit: ptr(int64(200)), ResetsAt: resetsAt}},
+			expectedStatus:  http.StatusTooManyRequests,
+			expectRateLimit: []string{"200", "0"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			meter := &usageMeterStub{}
+			m := middleware.NewUsageMiddleware(tc.cfg, meter, tc.gate, clock.NewMockClock(now))
+
+			router := gin.New()
+			router.POST("/metered", auth.RequireAuth(), m.Meter(), func(c *gin.Context) {
+				c.String(http.StatusOK, "hello")
+			})
+
+			req := nethttptest.NewRequest(http.MethodPost, "/metered", strings.NewReader(`{"a":1}`))
+			req.Header.Set("Authorization", "Bearer "+token)
+			w := nethttptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedStatus, w.Code, w.Body.String())
+			if tc.expectedStatus == http.StatusTooManyRequests {
+				assert.Contains(t, w.Body.String(), "USAGE_QUOTA_EXCEEDED")
+				assert.Equal(t, "30", w.Header().Get("Retry-After"))
+			}
+			assert.Equal(t, tc.expectWarning, w.Header().Get("X-Usage-Quota-Warning") != "")
+			if tc.expectRateLimit != nil {
+				assert.Equal(t, tc.expectRateLimit[0], w.Header().Get("X-RateLimit-Limit"))
+				assert.Equal(t, tc.expectRateLimit[1], w.Header().Get("X-RateLimit-Remaining"))
+				assert.Equal(t, strconv.FormatInt(resetsAt.Unix(), 10), w.Header().Get("X-RateLimit-Reset"))
+			} else {
+				assert.Empty(t, w.Header().Get("X-RateLimit-Limit"))
+				assert.Empty(t, w.Header().Get("X-RateLimit-Remaining"))
+				assert.Empty(t, w.Header().Get("X-RateLimit-Reset"))
+			}
+			if tc.expectRecorded {
+				assert.Equal(t, []recordedUsage{{userID, 7, 5}}, meter.recorded)
+			} else {
+				assert.Empty(t, meter.recorded)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package middleware
+
+import (
+	"gin-clean-starter/internal/pkg/buildinfo"
+
+	"github.com/gin-gonic/gin"
+)
+
+const headerAppVersion = "X-App-Version"
+
+// AppVersion stamps every response with the running version so operators can see which
+// release served a request, including during a rolling deploy.
+func AppVersion() gin.HandlerFunc {
+	version := buildinfo.Get().Version
+	return func(c *gin.Context) {
+		c.Header(headerAppVersion, version)
+		c.Next()
+	}
+}
@@ -0,0 +1,34 @@
+//go:build unit
+
+package middleware_test
+
+import (
+	"net/http"
+	nethttptest "net/http/httptest"
+	"testing"
+
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/pkg/buildinfo"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppVersion(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(middleware.AppVersion())
+	router.GET("/ok", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	router.GET("/fail", func(c *gin.Context) {
+		c.AbortWithStatus(http.StatusInternalServerError)
+	})
+
+	for _, path := range []string{"/ok", "/fail", "/missing"} {
+		w := nethttptest.NewRecorder()
+		router.ServeHTTP(w, nethttptest.NewRequest(http.MethodGet, path, nil))
+		assert.Equal(t, buildinfo.Version, w.Header().Get("X-App-Version"), path)
+	}
+}
@@ -0,0 +1,80 @@
+package render
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	resdto "gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/pkg/config"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// CreatedBody selects what a create endpoint returns alongside Location.
+type CreatedBody string
+
+const (
+	CreatedBodyMinimal        CreatedBody = "minimal"        // {"id": ...}
+	CreatedBodyRepresentation CreatedBody = "representation" // the same body as GET Location
+)
+
+// CreatedResponder writes the replies of create endpoints so they agree on status, Location
+// and body. Clients override the configured body per request with RFC 7240
+// "Prefer: return=minimal" or "Prefer: return=representation".
+type CreatedResponder struct {
+	defaultBody CreatedBody
+}
+
+func NewCreatedResponder(cfg config.Config) (*CreatedResponder, error) {
+	body := CreatedBody(cfg.Server.CreatedResponseBody)
+	switch body {
+	case CreatedBodyMinimal, CreatedBodyRepresentation:
+		return &CreatedResponder{defaultBody: body}, nil
+	default:
+		return nil, fmt.Errorf("invalid created response body %q: want %q or %q", body, CreatedBodyMinimal, CreatedBodyRepresentation)
+	}
+}
+
+// Created replies 201 for the resource id created under the current collection route.
+// load is called only when the representation is wanted; if it fails the resource still
+// exists, so the reply degrades to the minimal body instead of an error.
+func (r *CreatedResponder) Created(c *gin.Context, id uuid.UUID, load func() (any, error)) {
+	r.Respond(c, http.StatusCreated, id, load)
+}
+
+// Respond is Created with an explicit status, for replays of an earlier create (200).
+func (r *CreatedResponder) Respond(c *gin.Context, status int, id uuid.UUID, load func() (any, error)) {
+	c.Header("Location", strings.TrimSuffix(c.FullPath(), "/")+"/"+id.String())
+	c.Header("Vary", "Prefer")
+
+	body := r.preferredBody(c)
+	if body == CreatedBodyRepresentation {
+		v, err := load()
+		if err == nil {
+			c.Header("Preference-Applied", "return=representation")
+			c.JSON(status, v)
+			return
+		}
+		slog.Warn("Failed to load created resource; replying with minimal body", "path", c.FullPath(), "id", id, "error", err.Error())
+	}
+
+	c.Header("Preference-Applied", "return=minimal")
+	c.JSON(status, resdto.CreatedResponse{ID: id.String()})
+}
+
+func (r *CreatedResponder) preferredBody(c *gin.Context) CreatedBody {
+	for _, header := range c.Request.Header.Values("Prefer") {
+		for _, pref := range strings.Split(header, ",") {
+			switch strings.ToLower(strings.TrimSpace(pref)) {
+			case "return=minimal":
+				return CreatedBodyMinimal
+			case "return=representation":
+				return CreatedBodyRepresentation
+			}
+		}
+	}
+	return r.defaultBody
+}
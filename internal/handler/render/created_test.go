@@ -0,0 +1,75 @@
+//go:build unit
+
+package render_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gin-clean-starter/internal/handler/render"
+	"gin-clean-starter/internal/pkg/config"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCreatedResponder_RejectsUnknownBody(t *testing.T) {
+	cfg := config.NewTestConfig()
+	cfg.Server.CreatedResponseBody = "full"
+
+	_, err := render.NewCreatedResponder(cfg)
+	assert.Error(t, err)
+}
+
+func TestCreatedResponder_Created(t *testing.T) {
+	id := uuid.New()
+	representation := map[string]string{"id": id.String(), "name": "Room A"}
+
+	testCases := []struct {
+		name        string
+		defaultBody render.CreatedBody
+		prefer      string
+		loadErr     error
+		wantBody    map[string]string
+		wantApplied string
+	}{
+		{"configured representation", render.CreatedBodyRepresentation, "", nil, representation, "return=representation"},
+		{"configured minimal", render.CreatedBodyMinimal, "", nil, map[string]string{"id": id.String()}, "return=minimal"},
+		{"prefer overrides config", render.CreatedBodyRepresentation, "respond-async, return=minimal", nil, map[string]string{"id": id.String()}, "return=minimal"},
+		{"prefer is case-insensitive", render.CreatedBodyMinimal, "Return=Representation", nil, representation, "return=representation"},
+		{"load failure degrades to minimal", render.CreatedBodyRepresentation, "", assert.AnError, map[string]string{"id": id.String()}, "return=minimal"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := config.NewTestConfig()
+			cfg.Server.CreatedResponseBody = string(tc.defaultBody)
+			responder, err := render.NewCreatedResponder(cfg)
+			require.NoError(t, err)
+
+			router := gin.New()
+			router.POST("/api/resources/", func(c *gin.Context) {
+				responder.Created(c, id, func() (any, error) { return representation, tc.loadErr })
+			})
+
+			req := httptest.NewRequest(http.MethodPost, "/api/resources/", nil)
+			if tc.prefer != "" {
+				req.Header.Set("Prefer", tc.prefer)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusCreated, w.Code)
+			assert.Equal(t, "/api/resources/"+id.String(), w.Header().Get("Location"))
+			assert.Equal(t, tc.wantApplied, w.Header().Get("Preference-Applied"))
+
+			var body map[string]string
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+			assert.Equal(t, tc.wantBody, body)
+		})
+	}
+}
@@ -0,0 +1,253 @@
+package render
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"log/slog"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/pkg/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+// FieldsParam is the query parameter holding a sparse fieldset.
+const FieldsParam = "fields"
+
+// Bounds the work one request can ask of the projection.
+const maxFieldPaths = 64
+
+var ErrInvalidFields = errs.NewCoded("INVALID_FIELDS", "fields must list fields of the response")
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// fieldTree maps a JSON key to the keys kept below it; a nil subtree keeps the whole value.
+type fieldTree map[string]fieldTree
+
+// Fieldset is a parsed fields parameter: comma-separated dotted JSON paths such as
+// "id,status,messages.messages.body". Lists are transparent, so a path through a list selects
+// inside each element. The zero value keeps everything.
+type Fieldset struct {
+	tree fieldTree
+	key  string
+}
+
+// ParseFields reads the fields parameter and checks every path against the JSON shape of
+// shape's type. With within set, shape is a page whose within key is a list: paths select
+// inside its items and the page's other keys (cursors) are always kept. It aborts with 400
+// and returns false when a path names no field.
+func ParseFields(c *gin.Context, shape any, within string) (Fieldset, bool) {
+	raw := strings.TrimSpace(c.Query(FieldsParam))
+	if raw == "" {
+		return Fieldset{}, true
+	}
+
+	root := reflect.TypeOf(shape)
+	itemType := root
+	if within != "" {
+		t, ok := fieldType(root, within)
+		if !ok {
+			panic(fmt.Sprintf("render: %s has no field %q", root, within))
+		}
+		itemType = t
+	}
+
+	paths := strings.Split(raw, ",")
+	if len(paths) > maxFieldPaths {
+		httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidFields, "Too many fields", nil)
+		return Fieldset{}, false
+	}
+	tree := fieldTree{}
+	canonical := make([]string, 0, len(paths))
+	for _, p := range paths {
+		p = strings.TrimSpace(p)
+		if !validPath(itemType, p) {
+			httperr.AbortWithError(c, http.StatusBadRequest, ErrInvalidFields, "Invalid fields", map[string]any{"field": p})
+			return Fieldset{}, false
+		}
+		tree.add(strings.Split(p, "."))
+		canonical = append(canonical, p)
+	}
+	slices.Sort(canonical)
+	canonical = slices.Compact(canonical)
+
+	if within != "" {
+		page := fieldTree{within: tree}
+		for _, name := range jsonFieldNames(root) {
+			if name != within {
+				page[name] = nil
+			}
+		}
+		tree = page
+	}
+	return Fieldset{tree: tree, key: strings.Join(canonical, ",")}, true
+}
+
+func (f Fieldset) Empty() bool {
+	return f.tree == nil
+}
+
+// ETag derives the tag of the pruned representation from the full one's, so caches never
+// match a pruned body against a full one or one with other fields.
+func (f Fieldset) ETag(etag string) string {
+	if f.Empty() || !strings.HasSuffix(etag, `"`) {
+		return etag
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(f.key))
+	return fmt.Sprintf(`%s;f%08x"`, strings.TrimSuffix(etag, `"`), h.Sum32())
+}
+
+// JSONFields writes v like JSON, keeping only the fields in f.
+func JSONFields(c *gin.Context, status int, v any, f Fieldset) {
+	if f.Empty() {
+		JSON(c, status, v)
+		return
+	}
+
+	raw, err := json.Marshal(v)
+	if err != nil {
+		slog.Error("Failed to encode JSON response", "path", c.FullPath(), "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		return
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var doc any
+	if err := dec.Decode(&doc); err != nil {
+		slog.Error("Failed to decode JSON response for projection", "path", c.FullPath(), "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		return
+	}
+	JSON(c, status, f.tree.prune(doc))
+}
+
+func (t fieldTree) add(segments []string) {
+	head := segments[0]
+	sub, exists := t[head]
+	if len(segments) == 1 {
+		t[head] = nil
+		return
+	}
+	if exists && sub == nil {
+		return // the whole value is already kept
+	}
+	if sub == nil {
+		sub = fieldTree{}
+		t[head] = sub
+	}
+	sub.add(segments[1:])
+}
+
+func (t fieldTree) prune(v any) any {
+	switch x := v.(type) {
+	case map[string]any:
+		for k, val := range x {
+			sub, ok := t[k]
+			switch {
+			case !ok:
+				delete(x, k)
+			case sub != nil:
+				x[k] = sub.prune(val)
+			}
+		}
+	case []any:
+		for i := range x {
+			x[i] = t.prune(x[i])
+		}
+	}
+	return v
+}
+
+func validPath(t reflect.Type, path string) bool {
+	if path == "" {
+		return false
+	}
+	for _, name := range strings.Split(path, ".") {
+		next, ok := fieldType(t, name)
+		if !ok {
+			return false
+		}
+		t = next
+	}
+	return true
+}
+
+// fieldType returns the type of the JSON field name of t, looking through pointers and
+// lists. Types with their own JSON encoding (time.Time) have no fields.
+func fieldType(t reflect.Type, name string) (reflect.Type, bool) {
+	t = elem(t)
+	if !isObject(t) {
+		return nil, false
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous && f.Tag.Get("json") == "" {
+			if ft, ok := fieldType(f.Type, name); ok {
+				return ft, true
+			}
+			continue
+		}
+		if jsonName(f) == name {
+			return f.Type, true
+		}
+	}
+	return nil, false
+}
+
+func jsonFieldNames(t reflect.Type) []string {
+	t = elem(t)
+	var names []string
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous && f.Tag.Get("json") == "" {
+			names = append(names, jsonFieldNames(f.Type)...)
+		} else if name := jsonName(f); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func jsonName(f reflect.StructField) string {
+	if !f.IsExported() {
+		return ""
+	}
+	name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+	switch name {
+	case "-":
+		return ""
+	case "":
+		return f.Name
+	}
+	return name
+}
+
+func elem(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		if t.Implements(jsonMarshalerType) || t.Implements(textMarshalerType) {
+			break
+		}
+		t = t.Elem()
+	}
+	return t
+}
+
+func isObject(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	p := reflect.PointerTo(t)
+	return !t.Implements(jsonMarshalerType) && !t.Implements(textMarshalerType) &&
+		!p.Implements(jsonMarshalerType) && !p.Implements(textMarshalerType)
+}
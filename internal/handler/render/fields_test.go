@@ -0,0 +1,107 @@
+//go:build unit
+
+package render_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/render"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func fieldsContext(fields string) (*gin.Context, *httptest.ResponseRecorder) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/?"+url.Values{render.FieldsParam: {fields}}.Encode(), nil)
+	return c, w
+}
+
+func reservation() response.ReservationResponse {
+	at := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	return response.ReservationResponse{
+		ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		ResourceID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		Status:     "confirmed",
+		PriceCents: 1200,
+		Discounts:  []response.DiscountLineResponse{{CouponCode: "SPRING", AmountCents: 200}},
+		CreatedAt:  at,
+		UpdatedAt:  at,
+	}
+}
+
+func TestJSONFields(t *testing.T) {
+	t.Run("keeps only the listed fields, inside lists too", func(t *testing.T) {
+		c, w := fieldsContext("id, status,discounts.amountCents")
+		fields, ok := render.ParseFields(c, response.ReservationResponse{}, "")
+		require.True(t, ok)
+
+		render.JSONFields(c, http.StatusOK, reservation(), fields)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"id":"00000000-0000-0000-0000-000000000001","status":"confirmed","discounts":[{"amountCents":200}]}`, w.Body.String())
+	})
+
+	t.Run("paths select inside page items and keep the cursor", func(t *testing.T) {
+		c, w := fieldsContext("rating")
+		fields, ok := render.ParseFields(c, response.ReviewListPageResponse{}, "reviews")
+		require.True(t, ok)
+
+		render.JSONFields(c, http.StatusOK, reviewPage(2), fields)
+
+		assert.JSONEq(t, `{"reviews":[{"rating":1},{"rating":2}],"nextCursor":"next"}`, w.Body.String())
+	})
+
+	t.Run("without fields the body is unchanged", func(t *testing.T) {
+		c, w := fieldsContext("")
+		fields, ok := render.ParseFields(c, response.ReservationResponse{}, "")
+		require.True(t, ok)
+		assert.True(t, fields.Empty())
+		assert.Equal(t, `W/"x"`, fields.ETag(`W/"x"`))
+
+		render.JSONFields(c, http.StatusOK, map[string]int{"a": 1}, fields)
+
+		assert.JSONEq(t, `{"a":1}`, w.Body.String())
+	})
+}
+
+func TestParseFields(t *testing.T) {
+	for _, fields := range []string{
+		"unknown",
+		"id.value",      // uuid has no fields
+		"createdAt.day", // neither has time.Time
+		"discounts.",
+		"id,,status",
+	} {
+		t.Run(fields, func(t *testing.T) {
+			c, w := fieldsContext(fields)
+			_, ok := render.ParseFields(c, response.ReservationResponse{}, "")
+
+			assert.False(t, ok)
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			assert.Contains(t, w.Body.String(), "INVALID_FIELDS")
+		})
+	}
+
+	t.Run("ETag depends on the fieldset, not its order", func(t *testing.T) {
+		parse := func(raw string) render.Fieldset {
+			c, _ := fieldsContext(raw)
+			f, ok := render.ParseFields(c, response.ReservationResponse{}, "")
+			require.True(t, ok)
+			return f
+		}
+
+		etag := `W/"abc-1"`
+		assert.Equal(t, parse("id,status").ETag(etag), parse("status,id").ETag(etag))
+		assert.NotEqual(t, etag, parse("id").ETag(etag))
+		assert.NotEqual(t, parse("id").ETag(etag), parse("status").ETag(etag))
+	})
+}
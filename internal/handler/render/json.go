@@ -0,0 +1,43 @@
+package render
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"sync"
+
+	"gin-clean-starter/internal/handler/httperr"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Buffers that grew past this (e.g. a max-limit page) are dropped instead of
+// pinning their memory in the pool.
+const maxPooledBufferSize = 1 << 20
+
+var bufferPool = sync.Pool{
+	New: func() any { return new(bytes.Buffer) },
+}
+
+// JSON encodes v into a pooled buffer and writes it in one call. List endpoints
+// use it instead of c.JSON so large pages do not allocate a fresh output slice
+// per request. Encoding happens before any header is written, so a failure can
+// still be reported as a 500.
+func JSON(c *gin.Context, status int, v any) {
+	buf := bufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
+	defer func() {
+		if buf.Cap() <= maxPooledBufferSize {
+			bufferPool.Put(buf)
+		}
+	}()
+
+	if err := json.NewEncoder(buf).Encode(v); err != nil {
+		slog.Error("Failed to encode JSON response", "path", c.FullPath(), "error", err.Error())
+		httperr.AbortWithError(c, http.StatusInternalServerError, err, "Internal server error", nil)
+		return
+	}
+
+	c.Data(status, "application/json; charset=utf-8", buf.Bytes())
+}
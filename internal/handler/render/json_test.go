@@ -0,0 +1,89 @@
+//go:build unit
+
+package render_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/render"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func reviewPage(n int) response.ReviewListPageResponse {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	items := make([]*queries.ReviewListItem, n)
+	for i := range items {
+		items[i] = &queries.ReviewListItem{
+			ID:        uuid.New(),
+			UserEmail: fmt.Sprintf("user%d@example.com", i),
+			Rating:    int32(i%5 + 1),
+			Comment:   "Quiet room, good projector.",
+			CreatedAt: base.Add(time.Duration(i) * time.Minute),
+		}
+	}
+	return response.NewReviewListPage(items, &queries.Cursor{After: "next"})
+}
+
+func TestJSON(t *testing.T) {
+	t.Run("matches c.JSON output", func(t *testing.T) {
+		page := reviewPage(3)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		render.JSON(c, http.StatusOK, page)
+
+		want, err := json.Marshal(page)
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.JSONEq(t, string(want), w.Body.String())
+	})
+
+	t.Run("encoding failure becomes 500", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		render.JSON(c, http.StatusOK, map[string]float64{"bad": math.Inf(1)})
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.True(t, c.IsAborted())
+	})
+}
+
+// Compares against gin's c.JSON on the same payload; run with -benchmem.
+func BenchmarkListResponse(b *testing.B) {
+	for _, n := range []int{20, 200} {
+		page := reviewPage(n)
+
+		b.Run(fmt.Sprintf("render/items=%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				c, _ := gin.CreateTestContext(httptest.NewRecorder())
+				render.JSON(c, http.StatusOK, page)
+			}
+		})
+		b.Run(fmt.Sprintf("gin/items=%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				c, _ := gin.CreateTestContext(httptest.NewRecorder())
+				c.JSON(http.StatusOK, page)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package render
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	scimdto "gin-clean-starter/internal/handler/dto/scim"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SCIM writes v as a provisioning API body. The SCIM media type also keeps the response
+// format middleware from reshaping it.
+func SCIM(c *gin.Context, status int, v any) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		slog.Error("Failed to encode SCIM response", "path", c.FullPath(), "error", err.Error())
+		SCIMError(c, http.StatusInternalServerError, err, "", "Internal server error")
+		return
+	}
+	c.Data(status, scimdto.ContentType, body)
+}
+
+// SCIMError aborts with an RFC 7644 error body; err is kept on the context for the
+// request log. scimType is empty for errors without a SCIM error type.
+func SCIMError(c *gin.Context, status int, err error, scimType, detail string) {
+	_ = c.Error(err)
+	c.Abort()
+	body, _ := json.Marshal(scimdto.ErrorResponse{
+		Schemas:  []string{scimdto.SchemaError},
+		Status:   strconv.Itoa(status),
+		ScimType: scimType,
+		Detail:   detail,
+	})
+	c.Data(status, scimdto.ContentType, body)
+}
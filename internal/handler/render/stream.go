@@ -0,0 +1,134 @@
+package render
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// StreamFormat selects how a Stream encodes its rows.
+type StreamFormat string
+
+const (
+	StreamCSV  StreamFormat = "csv"
+	StreamJSON StreamFormat = "json" // newline-delimited JSON, one object per row
+)
+
+// Rows are flushed to the client in groups of this size.
+const streamFlushEvery = 100
+
+// Row is one record of a Stream: its JSON encoding for StreamJSON, CSVRecord for StreamCSV.
+type Row interface {
+	CSVRecord() []string
+}
+
+// ParseStreamFormat accepts the values of a ?format= query parameter.
+func ParseStreamFormat(s string) (StreamFormat, bool) {
+	switch f := StreamFormat(strings.ToLower(s)); f {
+	case StreamCSV, StreamJSON:
+		return f, true
+	}
+	return "", false
+}
+
+// Stream writes a download row by row, so an export never holds more than one batch in
+// memory. Status and headers go out with the first row; until Started reports true a
+// failure can still be answered with an error response.
+type Stream struct {
+	c         *gin.Context
+	format    StreamFormat
+	filename  string
+	csvHeader []string
+	csv       *csv.Writer
+	enc       *json.Encoder
+	started   bool
+	pending   int
+}
+
+// NewStream prepares an attachment named filename plus the format's extension. csvHeader
+// is written as the first CSV line and ignored for JSON.
+func NewStream(c *gin.Context, format StreamFormat, filename string, csvHeader []string) *Stream {
+	return &Stream{c: c, format: format, filename: filename, csvHeader: csvHeader}
+}
+
+func (s *Stream) Started() bool {
+	return s.started
+}
+
+func (s *Stream) Write(row Row) error {
+	if err := s.start(); err != nil {
+		return err
+	}
+	var err error
+	if s.format == StreamCSV {
+		err = s.csv.Write(csvSafe(row.CSVRecord()))
+	} else {
+		err = s.enc.Encode(row)
+	}
+	if err != nil {
+		return err
+	}
+	s.pending++
+	if s.pending >= streamFlushEvery {
+		return s.flush()
+	}
+	return nil
+}
+
+// Close flushes the remaining rows; an export without rows still gets its headers.
+func (s *Stream) Close() error {
+	if err := s.start(); err != nil {
+		return err
+	}
+	return s.flush()
+}
+
+func (s *Stream) start() error {
+	if s.started {
+		return nil
+	}
+	s.started = true
+
+	ext, contentType := ".ndjson", "application/x-ndjson"
+	if s.format == StreamCSV {
+		ext, contentType = ".csv", "text/csv; charset=utf-8"
+	}
+	h := s.c.Writer.Header()
+	h.Set("Content-Type", contentType)
+	h.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", s.filename+ext))
+	h.Set("Cache-Control", "no-store")
+	s.c.Status(http.StatusOK)
+
+	if s.format == StreamCSV {
+		s.csv = csv.NewWriter(s.c.Writer)
+		return s.csv.Write(s.csvHeader)
+	}
+	s.enc = json.NewEncoder(s.c.Writer)
+	return nil
+}
+
+func (s *Stream) flush() error {
+	s.pending = 0
+	if s.csv != nil {
+		s.csv.Flush()
+		if err := s.csv.Error(); err != nil {
+			return err
+		}
+	}
+	s.c.Writer.Flush()
+	return nil
+}
+
+// csvSafe quotes cells a spreadsheet would otherwise evaluate as a formula.
+func csvSafe(record []string) []string {
+	for i, cell := range record {
+		if cell != "" && strings.ContainsRune("=+-@\t\r", rune(cell[0])) {
+			record[i] = "'" + cell
+		}
+	}
+	return record
+}
@@ -0,0 +1,84 @@
+//go:build unit
+
+package render_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"gin-clean-starter/internal/handler/render"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testRow struct {
+	Name string `json:"name"`
+	Note string `json:"note"`
+}
+
+func (r testRow) CSVRecord() []string { return []string{r.Name, r.Note} }
+
+func serveStream(t *testing.T, format render.StreamFormat, rows []testRow) *httptest.ResponseRecorder {
+	t.Helper()
+
+	router := gin.New()
+	router.GET("/export", func(c *gin.Context) {
+		stream := render.NewStream(c, format, "export", []string{"name", "note"})
+		for _, row := range rows {
+			require.NoError(t, stream.Write(row))
+		}
+		require.NoError(t, stream.Close())
+	})
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/export", nil))
+	return w
+}
+
+func TestParseStreamFormat(t *testing.T) {
+	for _, in := range []string{"csv", "CSV", "json"} {
+		_, ok := render.ParseStreamFormat(in)
+		assert.True(t, ok, in)
+	}
+	_, ok := render.ParseStreamFormat("xml")
+	assert.False(t, ok)
+}
+
+func TestStream_CSV(t *testing.T) {
+	rows := make([]testRow, 250)
+	for i := range rows {
+		rows[i] = testRow{Name: "row", Note: "fine"}
+	}
+	rows[0].Note = "+1 for the view"
+	rows[1].Note = "-"
+
+	w := serveStream(t, render.StreamCSV, rows)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/csv; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="export.csv"`, w.Header().Get("Content-Disposition"))
+	lines := strings.Split(strings.TrimSpace(w.Body.String()), "\n")
+	require.Len(t, lines, 251, "header plus every row across several flushes")
+	assert.Equal(t, "name,note", lines[0])
+	assert.Equal(t, "row,'+1 for the view", lines[1])
+	assert.Equal(t, "row,'-", lines[2])
+}
+
+func TestStream_JSON(t *testing.T) {
+	w := serveStream(t, render.StreamJSON, []testRow{{Name: "a", Note: "=x"}, {Name: "b"}})
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/x-ndjson", w.Header().Get("Content-Type"))
+	assert.Equal(t, `{"name":"a","note":"=x"}`+"\n"+`{"name":"b","note":""}`+"\n", w.Body.String())
+}
+
+func TestStream_EmptyExportStillSendsHeaders(t *testing.T) {
+	w := serveStream(t, render.StreamCSV, nil)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `attachment; filename="export.csv"`, w.Header().Get("Content-Disposition"))
+	assert.Equal(t, "name,note\n", w.Body.String())
+}
@@ -2,14 +2,19 @@ package handler
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
 
 	"gin-clean-starter/internal/handler/api"
+	resdto "gin-clean-starter/internal/handler/dto/response"
 	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/pkg/buildinfo"
 	"gin-clean-starter/internal/pkg/config"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
 )
 
 type route struct {
@@ -17,63 +22,209 @@ type route struct {
 	Path    string
 	Handler gin.HandlerFunc
 	Mw      []gin.HandlerFunc
+	// Support marks read routes whose handlers narrow results to a support session's
+	// company; every other route rejects support tokens.
+	Support bool
+	// TOSExempt marks routes a user reaches before accepting the current terms of service.
+	TOSExempt bool
+	// Deprecated routes answer with Deprecation, Sunset and successor Link headers, and
+	// their calls are counted per consumer for the deprecated-route report.
+	Deprecated *middleware.Deprecation
+	// Cache is the Cache-Control of the route's successful responses; nil leaves it to the handler.
+	Cache *middleware.CachePolicy
+	// APIKey marks routes that take API keys. Only set it on routes guarded by a
+	// RequirePermission in Mw, whose permission a key's scopes must then grant; every
+	// other route refuses keys.
+	APIKey bool
 }
 
-func NewRouter(engine *gin.Engine, cfg config.Config, authHandler *api.AuthHandler, reservationHandler *api.ReservationHandler, reviewHandler *api.ReviewHandler, authMiddleware *middleware.AuthMiddleware) {
-	setupMiddleware(engine, cfg)
-	setupRoutes(engine, authHandler, reservationHandler, reviewHandler, authMiddleware)
+func NewRouter(engine *gin.Engine, cfg config.Config, authHandler *api.AuthHandler, reservationHandler *api.ReservationHandler, quoteHandler *api.QuoteHandler, reviewHandler *api.ReviewHandler, retentionHandler *api.RetentionHandler, roleHandler *api.RoleHandler, operatorHandler *api.ResourceOperatorHandler, inviteHandler *api.InviteHandler, companyHandler *api.CompanyHandler, supportHandler *api.SupportHandler, referralHandler *api.ReferralHandler, loyaltyHandler *api.LoyaltyHandler, securityEventHandler *api.SecurityEventHandler, userActivityHandler *api.UserActivityHandler, tosHandler *api.TOSHandler, blockHandler *api.ResourceBlockHandler, messageHandler *api.ReservationMessageHandler, attachmentHandler *api.ReservationAttachmentHandler, transferHandler *api.ReservationTransferHandler, bulkCancelHandler *api.ReservationBulkCancelHandler, usageHandler *api.UsageHandler, billingHandler *api.BillingHandler, telemetryHandler *api.TelemetryHandler, featureHandler *api.FeatureHandler, brandingHandler *api.BrandingHandler, provisioningHandler *api.ProvisioningHandler, samlHandler *api.SAMLHandler, oidcHandler *api.OIDCHandler, approvalHandler *api.ReservationApprovalHandler, customFieldHandler *api.CustomFieldHandler, maintenanceHandler *api.MaintenanceHandler, activityHandler *api.ActivityHandler, publicResourceHandler *api.PublicResourceHandler, publicStatsHandler *api.PublicStatsHandler, deprecationHandler *api.DeprecationHandler, recordingHandler *api.RequestRecordingHandler, exportHandler *api.CompanyExportHandler, deletionHandler *api.CompanyDeletionHandler, searchHandler *api.AdminSearchHandler, apiKeyHandler *api.APIKeyHandler, sessionHandler *api.SessionHandler, userMergeHandler *api.UserMergeHandler, notificationPreferencesHandler *api.NotificationPreferencesHandler, authMiddleware *middleware.AuthMiddleware, usageMiddleware *middleware.UsageMiddleware, provisioningMiddleware *middleware.ProvisioningMiddleware, telemetryMiddleware *middleware.TelemetryMiddleware, proxyMiddleware *middleware.ProxyMiddleware, responseFormat *middleware.ResponseFormatMiddleware, ipFilter *middleware.IPFilterMiddleware, deprecationMiddleware *middleware.DeprecationMiddleware, recordingMiddleware *middleware.RecordingMiddleware, csrfMiddleware *middleware.CSRFMiddleware, canceledRequests *shared.CanceledRequestMetrics, rateLimit *middleware.RateLimitMiddleware) error {
+	if err := proxyMiddleware.Configure(engine); err != nil {
+		return err
+	}
+	setupMiddleware(engine, cfg, telemetryMiddleware, deprecationMiddleware, proxyMiddleware, responseFormat, csrfMiddleware, canceledRequests)
+	setupRoutes(engine, cfg, authHandler, reservationHandler, quoteHandler, reviewHandler, retentionHandler, roleHandler, operatorHandler, inviteHandler, companyHandler, supportHandler, referralHandler, loyaltyHandler, securityEventHandler, userActivityHandler, tosHandler, blockHandler, messageHandler, attachmentHandler, transferHandler, bulkCancelHandler, usageHandler, billingHandler, telemetryHandler, featureHandler, brandingHandler, provisioningHandler, samlHandler, oidcHandler, approvalHandler, customFieldHandler, maintenanceHandler, activityHandler, publicResourceHandler, publicStatsHandler, deprecationHandler, recordingHandler, exportHandler, deletionHandler, searchHandler, apiKeyHandler, sessionHandler, userMergeHandler, notificationPreferencesHandler, authMiddleware, usageMiddleware, recordingMiddleware, provisioningMiddleware, ipFilter, rateLimit)
+	return nil
 }
 
-func setupMiddleware(engine *gin.Engine, cfg config.Config) {
-	// Recovery must be first (outermost) to catch panics from all other middleware
+func setupMiddleware(engine *gin.Engine, cfg config.Config, telemetryMiddleware *middleware.TelemetryMiddleware, deprecationMiddleware *middleware.DeprecationMiddleware, proxyMiddleware *middleware.ProxyMiddleware, responseFormat *middleware.ResponseFormatMiddleware, csrfMiddleware *middleware.CSRFMiddleware, canceledRequests *shared.CanceledRequestMetrics) {
+	// Shaping wraps everything, including the 500 recovery writes, so every JSON body leaves
+	// in one format
+	engine.Use(responseFormat.Shape())
+	// Recovery comes next to catch panics from all other middleware
 	engine.Use(middleware.CustomRecovery())
+	engine.Use(proxyMiddleware.Trace())
 	engine.Use(middleware.NewCORSMiddleware(cfg.CORS))
+	engine.Use(middleware.AppVersion())
 	engine.Use(middleware.LoggingMiddleware(nil, cfg.Log))
 	engine.Use(middleware.ErrorHandler())
+	// Counts requests whose client disconnected before the reply
+	engine.Use(middleware.CanceledRequests(canceledRequests))
+	// Before any handler runs, so a forged request changes nothing
+	engine.Use(csrfMiddleware.Protect())
+	engine.Use(telemetryMiddleware.Track())
+	engine.Use(deprecationMiddleware.Track())
+	if cfg.Replicas.ServedByHeader {
+		engine.Use(middleware.ServedBy())
+	}
 }
 
-func setupRoutes(engine *gin.Engine, authHandler *api.AuthHandler, reservationHandler *api.ReservationHandler, reviewHandler *api.ReviewHandler, authMiddleware *middleware.AuthMiddleware) {
+func setupRoutes(engine *gin.Engine, cfg config.Config, authHandler *api.AuthHandler, reservationHandler *api.ReservationHandler, quoteHandler *api.QuoteHandler, reviewHandler *api.ReviewHandler, retentionHandler *api.RetentionHandler, roleHandler *api.RoleHandler, operatorHandler *api.ResourceOperatorHandler, inviteHandler *api.InviteHandler, companyHandler *api.CompanyHandler, supportHandler *api.SupportHandler, referralHandler *api.ReferralHandler, loyaltyHandler *api.LoyaltyHandler, securityEventHandler *api.SecurityEventHandler, userActivityHandler *api.UserActivityHandler, tosHandler *api.TOSHandler, blockHandler *api.ResourceBlockHandler, messageHandler *api.ReservationMessageHandler, attachmentHandler *api.ReservationAttachmentHandler, transferHandler *api.ReservationTransferHandler, bulkCancelHandler *api.ReservationBulkCancelHandler, usageHandler *api.UsageHandler, billingHandler *api.BillingHandler, telemetryHandler *api.TelemetryHandler, featureHandler *api.FeatureHandler, brandingHandler *api.BrandingHandler, provisioningHandler *api.ProvisioningHandler, samlHandler *api.SAMLHandler, oidcHandler *api.OIDCHandler, approvalHandler *api.ReservationApprovalHandler, customFieldHandler *api.CustomFieldHandler, maintenanceHandler *api.MaintenanceHandler, activityHandler *api.ActivityHandler, publicResourceHandler *api.PublicResourceHandler, publicStatsHandler *api.PublicStatsHandler, deprecationHandler *api.DeprecationHandler, recordingHandler *api.RequestRecordingHandler, exportHandler *api.CompanyExportHandler, deletionHandler *api.CompanyDeletionHandler, searchHandler *api.AdminSearchHandler, apiKeyHandler *api.APIKeyHandler, sessionHandler *api.SessionHandler, userMergeHandler *api.UserMergeHandler, notificationPreferencesHandler *api.NotificationPreferencesHandler, authMiddleware *middleware.AuthMiddleware, usageMiddleware *middleware.UsageMiddleware, recordingMiddleware *middleware.RecordingMiddleware, provisioningMiddleware *middleware.ProvisioningMiddleware, ipFilter *middleware.IPFilterMiddleware, rateLimit *middleware.RateLimitMiddleware) {
+	// Cache-Control of the anonymous reads browsers and CDNs may hold
+	publicPages := &middleware.CachePolicy{Public: true, MaxAge: cfg.PublicSite.CacheMaxAge, StaleWhileRevalidate: cfg.PublicSite.StaleWhileRevalidate}
+	publicReviews := &middleware.CachePolicy{Public: true, MaxAge: cfg.PublicSite.ReviewsCacheMaxAge, StaleWhileRevalidate: cfg.PublicSite.StaleWhileRevalidate}
+	reviewFeed := &middleware.CachePolicy{Public: true, MaxAge: 5 * time.Minute, StaleWhileRevalidate: cfg.PublicSite.StaleWhileRevalidate}
+	noStore := &middleware.CachePolicy{NoStore: true}
+	// The error catalog only changes with a deploy
+	errorCatalogCache := &middleware.CachePolicy{Public: true, MaxAge: time.Hour}
+
 	engine.GET("/health", healthCheck)
+	engine.GET("/sitemap.xml", middleware.CacheControl(*publicPages), publicResourceHandler.Sitemap)
 
 	if gin.Mode() == gin.DebugMode {
-		engine.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+		engine.GET("/swagger/*any", ipFilter.Restrict(), ginSwagger.WrapHandler(swaggerFiles.Handler))
 	}
 
 	apiGroup := engine.Group("/api")
 	{
+		addRoutes(apiGroup, []route{
+			{Method: http.MethodGet, Path: "/version", Handler: versionInfo},
+			{Method: http.MethodGet, Path: "/meta/errors", Handler: errorCatalog, Cache: errorCatalogCache},
+		})
+
 		auth := apiGroup.Group("/auth")
 		{
+			// Sign-in rate limits (RATE_LIMIT_*)
+			limitLogin := rateLimit.Login()
+			limitRefresh := rateLimit.Refresh()
 			addRoutes(auth, []route{
-				{Method: http.MethodPost, Path: "/login", Handler: authHandler.Login},
-				{Method: http.MethodPost, Path: "/refresh", Handler: authHandler.Refresh},
+				{Method: http.MethodGet, Path: "/csrf", Handler: authHandler.CSRFToken, Cache: noStore},
+				{Method: http.MethodPost, Path: "/login", Handler: authHandler.Login, Mw: []gin.HandlerFunc{limitLogin}},
+				{Method: http.MethodPost, Path: "/refresh", Handler: authHandler.Refresh, Mw: []gin.HandlerFunc{limitRefresh}},
+				// Body-delivered tokens for non-browser clients (JWT_BODY_TOKENS_ENABLED)
+				{Method: http.MethodPost, Path: "/token", Handler: authHandler.Token, Mw: []gin.HandlerFunc{limitLogin}},
+				{Method: http.MethodPost, Path: "/token/refresh", Handler: authHandler.TokenRefresh, Mw: []gin.HandlerFunc{limitRefresh}},
+				// Second factor of a login that answered with an mfa_pending token
+				{Method: http.MethodPost, Path: "/mfa/verify", Handler: authHandler.VerifyMFA},
+				{Method: http.MethodPost, Path: "/token/mfa", Handler: authHandler.TokenMFA},
+				{Method: http.MethodPost, Path: "/accept-invite", Handler: inviteHandler.Accept},
+				// Self-registration (SIGNUP_ENABLED); accounts stay inactive until verified
+				{Method: http.MethodPost, Path: "/register", Handler: authHandler.Register},
+				{Method: http.MethodPost, Path: "/verify-email", Handler: authHandler.VerifyEmail},
+				// SAML single sign-on (SAML_ENABLED); the IdP posts the browser to the ACS
+				{Method: http.MethodGet, Path: "/saml/:id/metadata", Handler: samlHandler.Metadata},
+				{Method: http.MethodGet, Path: "/saml/:id/login", Handler: samlHandler.Login},
+				{Method: http.MethodPost, Path: "/saml/:id/acs", Handler: samlHandler.ACS},
+				// OpenID Connect social login; the provider redirects the browser to the callback
+				{Method: http.MethodGet, Path: "/oidc/:provider/start", Handler: oidcHandler.Start},
+				{Method: http.MethodGet, Path: "/oidc/:provider/callback", Handler: oidcHandler.Callback},
 			})
 
 			authRequired := auth.Group("")
-			authRequired.Use(authMiddleware.RequireAuth())
+			authRequired.Use(authMiddleware.RequireAuth(), usageMiddleware.Meter(), recordingMiddleware.Capture())
 			addRoutes(authRequired, []route{
-				{Method: http.MethodPost, Path: "/logout", Handler: authHandler.Logout},
-				{Method: http.MethodGet, Path: "/me", Handler: authHandler.Me},
+				{Method: http.MethodPost, Path: "/logout", Handler: authHandler.Logout, TOSExempt: true},
+				{Method: http.MethodGet, Path: "/me", Handler: authHandler.Me, Support: true},
+				// Credentials stay with the user: never let the machine clients acting as them (APIKey) in
+				{Method: http.MethodPut, Path: "/password", Handler: authHandler.ChangePassword, TOSExempt: true},
+				{Method: http.MethodPost, Path: "/mfa/enroll", Handler: authHandler.EnrollMFA, TOSExempt: true, Cache: noStore},
+				{Method: http.MethodPost, Path: "/mfa/enable", Handler: authHandler.EnableMFA, TOSExempt: true, Cache: noStore},
+				{Method: http.MethodPost, Path: "/mfa/disable", Handler: authHandler.DisableMFA, TOSExempt: true},
+				{Method: http.MethodGet, Path: "/sessions", Handler: sessionHandler.List, TOSExempt: true},
+				{Method: http.MethodDelete, Path: "/sessions/:id", Handler: sessionHandler.Revoke, TOSExempt: true},
+			})
+		}
+
+		// Self-service workspace registration (COMPANY_REGISTRATION_ENABLED)
+		addRoutes(apiGroup, []route{
+			{Method: http.MethodPost, Path: "/companies", Handler: companyHandler.Register},
+		})
+
+		// Billing provider deliveries, authenticated by their signature
+		addRoutes(apiGroup, []route{
+			{Method: http.MethodPost, Path: "/billing/webhook", Handler: billingHandler.Webhook},
+		})
+
+		// Company export archives, authenticated by the signed link from the export's status
+		addRoutes(apiGroup, []route{
+			{Method: http.MethodGet, Path: "/company-exports/download", Handler: exportHandler.Download},
+		})
+
+		// SCIM 2.0 subset for identity providers, authenticated by a company's provisioning token
+		scim := apiGroup.Group("/scim/v2")
+		scim.Use(provisioningMiddleware.RequireToken())
+		{
+			addRoutes(scim, []route{
+				{Method: http.MethodGet, Path: "/Users", Handler: provisioningHandler.ListUsers},
+				{Method: http.MethodPost, Path: "/Users", Handler: provisioningHandler.CreateUser},
+				{Method: http.MethodGet, Path: "/Users/:id", Handler: provisioningHandler.GetUser},
+				{Method: http.MethodPatch, Path: "/Users/:id", Handler: provisioningHandler.PatchUser},
+				{Method: http.MethodDelete, Path: "/Users/:id", Handler: provisioningHandler.DeleteUser},
 			})
 		}
 
 		reservations := apiGroup.Group("/reservations")
-		reservations.Use(authMiddleware.RequireAuth())
+		reservations.Use(authMiddleware.RequireAuth(), usageMiddleware.Meter(), recordingMiddleware.Capture())
 		{
 			addRoutes(reservations, []route{
 				{Method: http.MethodPost, Path: "", Handler: reservationHandler.CreateReservation},
 				{Method: http.MethodGet, Path: "", Handler: reservationHandler.GetUserReservations},
 				{Method: http.MethodGet, Path: "/:id", Handler: reservationHandler.GetReservation},
+				{Method: http.MethodPost, Path: "/:id/cancel", Handler: reservationHandler.CancelReservation},
+				{Method: http.MethodPost, Path: "/:id/messages", Handler: messageHandler.Post},
+				{Method: http.MethodPost, Path: "/:id/messages/read", Handler: messageHandler.MarkRead},
+				{Method: http.MethodGet, Path: "/:id/attachments", Handler: attachmentHandler.List},
+				{Method: http.MethodPost, Path: "/:id/attachments", Handler: attachmentHandler.Upload},
+				{Method: http.MethodGet, Path: "/:id/attachments/:attachmentId", Handler: attachmentHandler.Download},
+				{Method: http.MethodDelete, Path: "/:id/attachments/:attachmentId", Handler: attachmentHandler.Delete},
+				{Method: http.MethodPost, Path: "/:id/transfer", Handler: transferHandler.Initiate},
+				// Approvers are checked per resource in the command layer
+				{Method: http.MethodPost, Path: "/:id/approve", Handler: approvalHandler.Approve},
+				{Method: http.MethodPost, Path: "/:id/reject", Handler: approvalHandler.Reject},
+			})
+		}
+
+		reservationApprovals := apiGroup.Group("/reservation-approvals")
+		reservationApprovals.Use(authMiddleware.RequireAuth(), usageMiddleware.Meter(), recordingMiddleware.Capture())
+		{
+			addRoutes(reservationApprovals, []route{
+				{Method: http.MethodGet, Path: "", Handler: approvalHandler.ListPending},
+			})
+		}
+
+		reservationTransfers := apiGroup.Group("/reservation-transfers")
+		reservationTransfers.Use(authMiddleware.RequireAuth(), usageMiddleware.Meter(), recordingMiddleware.Capture())
+		{
+			addRoutes(reservationTransfers, []route{
+				{Method: http.MethodPost, Path: "/accept", Handler: transferHandler.Accept},
+			})
+		}
+
+		reservationGroups := apiGroup.Group("/reservation-groups")
+		reservationGroups.Use(authMiddleware.RequireAuth(), usageMiddleware.Meter(), recordingMiddleware.Capture())
+		{
+			addRoutes(reservationGroups, []route{
+				{Method: http.MethodPost, Path: "", Handler: reservationHandler.CreateReservationGroup},
+				{Method: http.MethodGet, Path: "/:id", Handler: reservationHandler.GetReservationGroup},
+			})
+		}
+
+		quotes := apiGroup.Group("/quotes")
+		quotes.Use(authMiddleware.RequireAuth(), usageMiddleware.Meter(), recordingMiddleware.Capture())
+		{
+			addRoutes(quotes, []route{
+				{Method: http.MethodPost, Path: "", Handler: quoteHandler.Create},
 			})
 		}
 
 		reviews := apiGroup.Group("/reviews")
 		{
 			addRoutes(reviews, []route{
-				{Method: http.MethodGet, Path: "/:id", Handler: reviewHandler.Get},
+				{Method: http.MethodGet, Path: "/:id", Handler: reviewHandler.Get, Cache: publicReviews},
 			})
 			// Auth required for write operations
 			authReviews := reviews.Group("")
-			authReviews.Use(authMiddleware.RequireAuth())
+			authReviews.Use(authMiddleware.RequireAuth(), usageMiddleware.Meter(), recordingMiddleware.Capture())
 			addRoutes(authReviews, []route{
 				{Method: http.MethodPost, Path: "", Handler: reviewHandler.Create},
 				{Method: http.MethodPut, Path: "/:id", Handler: reviewHandler.Update},
@@ -81,18 +232,155 @@ func setupRoutes(engine *gin.Engine, authHandler *api.AuthHandler, reservationHa
 			})
 		}
 
-		// Resource-specific reviews and stats (public)
+		// Resource-specific reviews, review feed and stats (public)
+		addRoutes(apiGroup, []route{
+			{Method: http.MethodGet, Path: "/resources/:id/reviews", Handler: reviewHandler.ListByResource, Cache: publicReviews},
+			{Method: http.MethodGet, Path: "/resources/:id/reviews.atom", Handler: reviewHandler.Feed, Cache: reviewFeed},
+			{Method: http.MethodGet, Path: "/resources/:id/rating-stats", Handler: reviewHandler.ResourceRatingStats, Cache: publicReviews},
+		})
+
+		// Public resource pages by slug and platform stats, for the SEO-facing frontend
 		addRoutes(apiGroup, []route{
-			{Method: http.MethodGet, Path: "/resources/:id/reviews", Handler: reviewHandler.ListByResource},
-			{Method: http.MethodGet, Path: "/resources/:id/rating-stats", Handler: reviewHandler.ResourceRatingStats},
+			{Method: http.MethodGet, Path: "/public/resources/:slug", Handler: publicResourceHandler.Get, Cache: publicPages},
+			{Method: http.MethodGet, Path: "/public/stats", Handler: publicStatsHandler.Get, Cache: publicPages},
+		})
+
+		// Busy slots only; block reasons and reservation owners stay behind the admin routes
+		resources := apiGroup.Group("/resources")
+		resources.Use(authMiddleware.RequireAuth(), usageMiddleware.Meter(), recordingMiddleware.Capture())
+		addRoutes(resources, []route{
+			{Method: http.MethodGet, Path: "/:id/availability", Handler: blockHandler.Availability},
+			{Method: http.MethodGet, Path: "/:id/custom-fields", Handler: customFieldHandler.ListForResource},
 		})
 
-		// User reviews (requires auth for RBAC)
-		userReviews := apiGroup.Group("/users")
-		userReviews.Use(authMiddleware.RequireAuth())
-		addRoutes(userReviews, []route{
+		// User reviews (requires auth for RBAC) and the caller's own referrals, points, security events, activity timeline, terms acceptance, review export and notification preferences
+		users := apiGroup.Group("/users")
+		users.Use(authMiddleware.RequireAuth(), usageMiddleware.Meter(), recordingMiddleware.Capture())
+		addRoutes(users, []route{
+			{Method: http.MethodGet, Path: "/me/referrals", Handler: referralHandler.GetMine},
+			{Method: http.MethodGet, Path: "/me/points", Handler: loyaltyHandler.GetMyPoints},
+			{Method: http.MethodGet, Path: "/me/security-events", Handler: securityEventHandler.ListMine},
+			{Method: http.MethodGet, Path: "/me/activity", Handler: userActivityHandler.ListMine},
+			{Method: http.MethodPost, Path: "/me/accept-tos", Handler: tosHandler.Accept, TOSExempt: true},
+			{Method: http.MethodGet, Path: "/me/reviews/export", Handler: reviewHandler.ExportMine},
+			{Method: http.MethodGet, Path: "/me/notification-preferences", Handler: notificationPreferencesHandler.Get},
+			{Method: http.MethodPut, Path: "/me/notification-preferences", Handler: notificationPreferencesHandler.Update},
 			{Method: http.MethodGet, Path: "/:id/reviews", Handler: reviewHandler.ListByUser},
 		})
+
+		admin := apiGroup.Group("/admin")
+		admin.Use(ipFilter.Restrict(), authMiddleware.RequireAuth(), usageMiddleware.Meter(), recordingMiddleware.Capture())
+		retention := authMiddleware.RequirePermission(shared.PermissionRetentionRead)
+		manageRoles := authMiddleware.RequirePermission(shared.PermissionRolesManage)
+		manageOperators := authMiddleware.RequirePermission(shared.PermissionResourceOperatorsManage)
+		manageApprovers := authMiddleware.RequirePermission(shared.PermissionResourceApproversManage)
+		manageInvites := authMiddleware.RequirePermission(shared.PermissionInvitesManage)
+		supportAccess := authMiddleware.RequirePermission(shared.PermissionSupportAccess)
+		readUsage := authMiddleware.RequirePermission(shared.PermissionUsageRead)
+		readTelemetry := authMiddleware.RequirePermission(shared.PermissionTelemetryRead)
+		manageFeatures := authMiddleware.RequirePermission(shared.PermissionFeaturesManage)
+		manageCompanySettings := authMiddleware.RequirePermission(shared.PermissionCompanySettingsManage)
+		manageProvisioning := authMiddleware.RequirePermission(shared.PermissionProvisioningManage)
+		manageSSO := authMiddleware.RequirePermission(shared.PermissionSSOManage)
+		manageCustomFields := authMiddleware.RequirePermission(shared.PermissionCustomFieldsManage)
+		runMaintenance := authMiddleware.RequirePermission(shared.PermissionMaintenanceRun)
+		readAuditLogs := authMiddleware.RequirePermission(shared.PermissionAuditLogsRead)
+		readNotificationJobs := authMiddleware.RequirePermission(shared.PermissionNotificationJobsRead)
+		manageRecordings := authMiddleware.RequirePermission(shared.PermissionRequestRecordingsManage)
+		manageExports := authMiddleware.RequirePermission(shared.PermissionCompanyExportsManage)
+		deleteCompanies := authMiddleware.RequirePermission(shared.PermissionCompaniesDelete)
+		search := authMiddleware.RequirePermission(shared.PermissionSearchRead)
+		revokeSessions := authMiddleware.RequirePermission(shared.PermissionSessionsRevoke)
+		manageAPIKeys := authMiddleware.RequirePermission(shared.PermissionAPIKeysManage)
+		unlockLogins := authMiddleware.RequirePermission(shared.PermissionLoginsUnlock)
+		importReviews := authMiddleware.RequirePermission(shared.PermissionReviewsImport)
+		mergeUsers := authMiddleware.RequirePermission(shared.PermissionUsersMerge)
+		addRoutes(admin, []route{
+			// Reservation reads are scoped in the query layer (any vs. assigned resources, support company)
+			{Method: http.MethodGet, Path: "/reservations", Handler: reservationHandler.ListAdminReservations, Support: true},
+			{Method: http.MethodGet, Path: "/reservations/export", Handler: reservationHandler.ExportAdminReservations, Support: true},
+			{Method: http.MethodGet, Path: "/reservations/:id", Handler: reservationHandler.GetAdminReservation, Support: true},
+			// Operator-side message permissions are checked per resource in the command layer
+			{Method: http.MethodPost, Path: "/reservations/:id/messages", Handler: messageHandler.AdminPost},
+			{Method: http.MethodPost, Path: "/reservations/:id/messages/read", Handler: messageHandler.AdminMarkRead},
+			// Attachment permissions are checked per resource in the command and query layer; reads honour the support company
+			{Method: http.MethodGet, Path: "/reservations/:id/attachments", Handler: attachmentHandler.AdminList, Support: true},
+			{Method: http.MethodPost, Path: "/reservations/:id/attachments", Handler: attachmentHandler.AdminUpload},
+			{Method: http.MethodGet, Path: "/reservations/:id/attachments/:attachmentId", Handler: attachmentHandler.AdminDownload, Support: true},
+			{Method: http.MethodDelete, Path: "/reservations/:id/attachments/:attachmentId", Handler: attachmentHandler.AdminDelete},
+			{Method: http.MethodGet, Path: "/retention/report", Handler: retentionHandler.Report, Mw: []gin.HandlerFunc{retention}, APIKey: true},
+			{Method: http.MethodGet, Path: "/usage", Handler: usageHandler.Report, Mw: []gin.HandlerFunc{readUsage}, APIKey: true},
+			{Method: http.MethodGet, Path: "/usage/deprecated-routes", Handler: deprecationHandler.Report, Mw: []gin.HandlerFunc{readUsage}, APIKey: true},
+			{Method: http.MethodGet, Path: "/adoption", Handler: telemetryHandler.Adoption, Mw: []gin.HandlerFunc{readTelemetry}, APIKey: true},
+			{Method: http.MethodGet, Path: "/login-replays", Handler: telemetryHandler.LoginReplays, Mw: []gin.HandlerFunc{readTelemetry}, APIKey: true},
+			{Method: http.MethodGet, Path: "/canceled-requests", Handler: telemetryHandler.CanceledRequests, Mw: []gin.HandlerFunc{readTelemetry}, APIKey: true},
+			{Method: http.MethodGet, Path: "/companies/:id/features", Handler: featureHandler.List, Mw: []gin.HandlerFunc{manageFeatures}, APIKey: true},
+			{Method: http.MethodPut, Path: "/companies/:id/features/:feature", Handler: featureHandler.Set, Mw: []gin.HandlerFunc{manageFeatures}, APIKey: true},
+			{Method: http.MethodDelete, Path: "/companies/:id/features/:feature", Handler: featureHandler.Reset, Mw: []gin.HandlerFunc{manageFeatures}, APIKey: true},
+			{Method: http.MethodPut, Path: "/companies/:id/review-window", Handler: companyHandler.SetReviewWindow, Mw: []gin.HandlerFunc{manageCompanySettings}, APIKey: true},
+			{Method: http.MethodGet, Path: "/companies/:id/branding", Handler: brandingHandler.Get, Mw: []gin.HandlerFunc{manageCompanySettings}, APIKey: true},
+			{Method: http.MethodPut, Path: "/companies/:id/branding", Handler: brandingHandler.Set, Mw: []gin.HandlerFunc{manageCompanySettings}, APIKey: true},
+			{Method: http.MethodGet, Path: "/companies/:id/branding/preview", Handler: brandingHandler.Preview, Mw: []gin.HandlerFunc{manageCompanySettings}, APIKey: true},
+			{Method: http.MethodPost, Path: "/companies/:id/provisioning-tokens", Handler: provisioningHandler.IssueToken, Mw: []gin.HandlerFunc{manageProvisioning}, APIKey: true},
+			{Method: http.MethodDelete, Path: "/companies/:id/provisioning-tokens/:tokenId", Handler: provisioningHandler.RevokeToken, Mw: []gin.HandlerFunc{manageProvisioning}, APIKey: true},
+			{Method: http.MethodGet, Path: "/companies/:id/saml", Handler: samlHandler.GetConnection, Mw: []gin.HandlerFunc{manageSSO}, APIKey: true},
+			{Method: http.MethodPut, Path: "/companies/:id/saml", Handler: samlHandler.SetConnection, Mw: []gin.HandlerFunc{manageSSO}, APIKey: true},
+			{Method: http.MethodDelete, Path: "/companies/:id/saml", Handler: samlHandler.DeleteConnection, Mw: []gin.HandlerFunc{manageSSO}, APIKey: true},
+			{Method: http.MethodGet, Path: "/companies/:id/custom-fields", Handler: customFieldHandler.List, Mw: []gin.HandlerFunc{manageCustomFields}, APIKey: true},
+			{Method: http.MethodPost, Path: "/companies/:id/custom-fields", Handler: customFieldHandler.Create, Mw: []gin.HandlerFunc{manageCustomFields}, APIKey: true},
+			{Method: http.MethodDelete, Path: "/companies/:id/custom-fields/:fieldId", Handler: customFieldHandler.Delete, Mw: []gin.HandlerFunc{manageCustomFields}, APIKey: true},
+			{Method: http.MethodPost, Path: "/maintenance/cursors", Handler: maintenanceHandler.CheckCursors, Mw: []gin.HandlerFunc{runMaintenance}, APIKey: true},
+			{Method: http.MethodGet, Path: "/audit-logs", Handler: activityHandler.AuditLogs, Mw: []gin.HandlerFunc{readAuditLogs}, APIKey: true},
+			{Method: http.MethodGet, Path: "/notification-jobs", Handler: activityHandler.NotificationJobs, Mw: []gin.HandlerFunc{readNotificationJobs}, APIKey: true},
+			{Method: http.MethodGet, Path: "/permissions", Handler: roleHandler.ListPermissions, Mw: []gin.HandlerFunc{manageRoles}, APIKey: true},
+			{Method: http.MethodGet, Path: "/roles", Handler: roleHandler.List, Mw: []gin.HandlerFunc{manageRoles}, APIKey: true},
+			{Method: http.MethodPost, Path: "/roles", Handler: roleHandler.Create, Mw: []gin.HandlerFunc{manageRoles}, APIKey: true},
+			{Method: http.MethodGet, Path: "/roles/:name", Handler: roleHandler.Get, Mw: []gin.HandlerFunc{manageRoles}, APIKey: true},
+			{Method: http.MethodPut, Path: "/roles/:name/permissions", Handler: roleHandler.UpdatePermissions, Mw: []gin.HandlerFunc{manageRoles}, APIKey: true},
+			{Method: http.MethodDelete, Path: "/roles/:name", Handler: roleHandler.Delete, Mw: []gin.HandlerFunc{manageRoles}, APIKey: true},
+			{Method: http.MethodGet, Path: "/resources/:id/operators", Handler: operatorHandler.List, Mw: []gin.HandlerFunc{manageOperators}, APIKey: true},
+			{Method: http.MethodPut, Path: "/resources/:id/operators/:userId", Handler: operatorHandler.Assign, Mw: []gin.HandlerFunc{manageOperators}, APIKey: true},
+			{Method: http.MethodDelete, Path: "/resources/:id/operators/:userId", Handler: operatorHandler.Unassign, Mw: []gin.HandlerFunc{manageOperators}, APIKey: true},
+			{Method: http.MethodGet, Path: "/resources/:id/approvers", Handler: approvalHandler.ListApprovers, Mw: []gin.HandlerFunc{manageApprovers}, APIKey: true},
+			{Method: http.MethodPut, Path: "/resources/:id/approvers/:userId", Handler: approvalHandler.AddApprover, Mw: []gin.HandlerFunc{manageApprovers}, APIKey: true},
+			{Method: http.MethodDelete, Path: "/resources/:id/approvers/:userId", Handler: approvalHandler.RemoveApprover, Mw: []gin.HandlerFunc{manageApprovers}, APIKey: true},
+			// Block permissions are checked per resource in the command and query layer (any vs. assigned)
+			{Method: http.MethodGet, Path: "/resources/:id/blocks", Handler: blockHandler.List},
+			{Method: http.MethodPost, Path: "/resources/:id/blocks", Handler: blockHandler.Create},
+			{Method: http.MethodDelete, Path: "/resources/:id/blocks/:blockId", Handler: blockHandler.Delete},
+			// Cancel grants are checked per resource in the command layer (any vs. assigned)
+			{Method: http.MethodPost, Path: "/resources/:id/cancel-range", Handler: bulkCancelHandler.CancelRange},
+			{Method: http.MethodGet, Path: "/invites", Handler: inviteHandler.List, Mw: []gin.HandlerFunc{manageInvites}, APIKey: true, Support: true},
+			{Method: http.MethodPost, Path: "/invites", Handler: inviteHandler.Create, Mw: []gin.HandlerFunc{manageInvites}, APIKey: true},
+			{Method: http.MethodPost, Path: "/invites/:id/resend", Handler: inviteHandler.Resend, Mw: []gin.HandlerFunc{manageInvites}, APIKey: true},
+			{Method: http.MethodDelete, Path: "/invites/:id", Handler: inviteHandler.Revoke, Mw: []gin.HandlerFunc{manageInvites}, APIKey: true},
+			// Review moderation is checked per resource in the command and query layer (any vs. assigned)
+			{Method: http.MethodGet, Path: "/resources/:id/reviews/flagged", Handler: reviewHandler.ListFlagged},
+			{Method: http.MethodPost, Path: "/reviews/:id/approve", Handler: reviewHandler.Approve},
+			{Method: http.MethodPost, Path: "/reviews/import", Handler: reviewHandler.Import, Mw: []gin.HandlerFunc{importReviews}, APIKey: true},
+			// Issues read-only tokens; RequireAuth rejects every mutating request made with them
+			{Method: http.MethodPost, Path: "/support-sessions", Handler: supportHandler.Start, Mw: []gin.HandlerFunc{supportAccess}, APIKey: true},
+			// Recordings capture a consenting user's own requests; captured bodies are masked
+			{Method: http.MethodPost, Path: "/users/:id/request-recordings", Handler: recordingHandler.Enable, Mw: []gin.HandlerFunc{manageRecordings}, APIKey: true},
+			{Method: http.MethodGet, Path: "/request-recordings/:id", Handler: recordingHandler.Get, Mw: []gin.HandlerFunc{manageRecordings}, APIKey: true},
+			{Method: http.MethodGet, Path: "/request-recordings/:id/requests/:seq", Handler: recordingHandler.GetRequest, Mw: []gin.HandlerFunc{manageRecordings}, APIKey: true},
+			{Method: http.MethodDelete, Path: "/request-recordings/:id", Handler: recordingHandler.Delete, Mw: []gin.HandlerFunc{manageRecordings}, APIKey: true},
+			// Revokes every refresh token and denylists tokens issued before sessions were tracked
+			{Method: http.MethodDelete, Path: "/users/:id/sessions", Handler: authHandler.RevokeSessions, Mw: []gin.HandlerFunc{revokeSessions}, APIKey: true},
+			{Method: http.MethodDelete, Path: "/users/:id/login-lockout", Handler: authHandler.UnlockLogin, Mw: []gin.HandlerFunc{unlockLogins}, APIKey: true},
+			{Method: http.MethodPut, Path: "/users/:id/role", Handler: roleHandler.ChangeUserRole, Mw: []gin.HandlerFunc{manageRoles}, APIKey: true},
+			{Method: http.MethodPost, Path: "/users/:id/merge", Handler: userMergeHandler.Merge, Mw: []gin.HandlerFunc{mergeUsers}, APIKey: true},
+			{Method: http.MethodGet, Path: "/users/:id/api-keys", Handler: apiKeyHandler.List, Mw: []gin.HandlerFunc{manageAPIKeys}, APIKey: true},
+			{Method: http.MethodPost, Path: "/users/:id/api-keys", Handler: apiKeyHandler.Issue, Mw: []gin.HandlerFunc{manageAPIKeys}, APIKey: true},
+			{Method: http.MethodDelete, Path: "/users/:id/api-keys/:keyId", Handler: apiKeyHandler.Revoke, Mw: []gin.HandlerFunc{manageAPIKeys}, APIKey: true},
+			// Offboarding archives are built in the background; poll the export for its link
+			{Method: http.MethodPost, Path: "/companies/:id/exports", Handler: exportHandler.Request, Mw: []gin.HandlerFunc{manageExports}, APIKey: true},
+			{Method: http.MethodGet, Path: "/company-exports/:id", Handler: exportHandler.Get, Mw: []gin.HandlerFunc{manageExports}, APIKey: true},
+			{Method: http.MethodDelete, Path: "/companies/:id", Handler: deletionHandler.Request, Mw: []gin.HandlerFunc{deleteCompanies}, APIKey: true},
+			{Method: http.MethodGet, Path: "/company-deletions/:id", Handler: deletionHandler.Get, Mw: []gin.HandlerFunc{deleteCompanies}, APIKey: true},
+			// Searches every company; not scoped to the support company
+			{Method: http.MethodGet, Path: "/search", Handler: searchHandler.Search, Mw: []gin.HandlerFunc{search}, APIKey: true},
+		})
 	}
 }
 
@@ -109,12 +397,45 @@ func healthCheck(c *gin.Context) {
 	})
 }
 
+// @Summary Build info
+// @Description Version, commit and build time of the running binary; every response also carries the version in X-App-Version
+// @Tags health
+// @Produce json
+// @Success 200 {object} response.VersionResponse
+// @Router /version [get]
+func versionInfo(c *gin.Context) {
+	c.JSON(http.StatusOK, resdto.FromBuildInfo(buildinfo.Get()))
+}
+
+// @Summary Error code catalog
+// @Description Every error.code the API can report, with its description and the HTTP statuses it is reported with, generated from the error declarations (see docs/error_codes.md). Client SDKs can build their error types from it
+// @Tags meta
+// @Produce json
+// @Success 200 {object} response.ErrorCatalogResponse
+// @Router /meta/errors [get]
+func errorCatalog(c *gin.Context) {
+	c.JSON(http.StatusOK, resdto.FromErrorRegistry(errs.Registry))
+}
+
 func addRoutes(g *gin.RouterGroup, rs []route) {
 	for _, r := range rs {
-		h := r.Handler
-		if len(r.Mw) > 0 {
-			h = chainHandlers(append(r.Mw, r.Handler)...)
+		mw := r.Mw
+		if !r.TOSExempt {
+			mw = append([]gin.HandlerFunc{middleware.RequireAcceptedTOS}, mw...)
+		}
+		if !r.APIKey {
+			mw = append([]gin.HandlerFunc{middleware.RejectAPIKey}, mw...)
+		}
+		if !r.Support {
+			mw = append([]gin.HandlerFunc{middleware.RejectSupportSession}, mw...)
+		}
+		if r.Deprecated != nil {
+			mw = append([]gin.HandlerFunc{middleware.Deprecated(*r.Deprecated)}, mw...)
+		}
+		if r.Cache != nil {
+			mw = append([]gin.HandlerFunc{middleware.CacheControl(*r.Cache)}, mw...)
 		}
+		h := chainHandlers(append(mw, r.Handler)...)
 		switch r.Method {
 		case http.MethodGet:
 			g.GET(r.Path, h)
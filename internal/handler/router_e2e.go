@@ -0,0 +1,20 @@
+//go:build e2e
+
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"gin-clean-starter/internal/handler/api"
+)
+
+// RegisterTestRoutes mounts the e2e-only control endpoints under /api/_test. They are
+// unauthenticated and undocumented, so they must never be compiled into a release build.
+func RegisterTestRoutes(engine *gin.Engine, testClockHandler *api.TestClockHandler, testSlowHandler *api.TestSlowHandler) {
+	addRoutes(engine.Group("/api/_test"), []route{
+		{Method: http.MethodPost, Path: "/clock", Handler: testClockHandler.Set},
+		{Method: http.MethodGet, Path: "/slow", Handler: testSlowHandler.Sleep},
+	})
+}
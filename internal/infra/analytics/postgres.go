@@ -0,0 +1,44 @@
+package analytics
+
+import (
+	"context"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+)
+
+type FeatureUsageQueries interface {
+	AddFeatureUsage(ctx context.Context, db sqlc.DBTX, arg sqlc.AddFeatureUsageParams) error
+}
+
+// PostgresSink adds counts to the feature_usage table the adoption report reads.
+type PostgresSink struct {
+	uow     shared.UnitOfWork
+	queries FeatureUsageQueries
+}
+
+func NewPostgresSink(uow shared.UnitOfWork, queries FeatureUsageQueries) *PostgresSink {
+	return &PostgresSink{
+		uow:     uow,
+		queries: queries,
+	}
+}
+
+func (s *PostgresSink) Emit(ctx context.Context, counts []shared.FeatureCount) error {
+	return s.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		for _, c := range counts {
+			err := s.queries.AddFeatureUsage(ctx, tx.DB(), sqlc.AddFeatureUsageParams{
+				Day:          pgconv.DateToPgtype(c.Day),
+				Feature:      c.Feature,
+				CompanyHash:  c.CompanyHash,
+				RequestCount: c.Requests,
+			})
+			if err != nil {
+				return infra.WrapRepoErr("failed to add feature usage", err)
+			}
+		}
+		return nil
+	})
+}
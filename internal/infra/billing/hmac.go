@@ -0,0 +1,101 @@
+package billing
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+// SignatureHeader carries "sha256=<hex HMAC-SHA256 of the raw body>".
+const SignatureHeader = "X-Billing-Signature"
+
+var errMissingField = errs.New("billing event is missing a required field")
+
+// HMACProvider accepts deliveries in a provider-neutral JSON format, signed with a shared
+// secret. A relay or a thin adapter in front of the real provider produces them:
+//
+//	{"id": "evt_1", "type": "subscription.updated", "created_at": "2025-01-01T00:00:00Z",
+//	 "subscription": {"id": "sub_1", "customer_id": "cus_1", "company_id": "<uuid>",
+//	                  "plan": "pro", "status": "active", "current_period_end": "..."}}
+type HMACProvider struct {
+	secret []byte
+}
+
+// NewHMACProvider rejects every delivery when secret is empty.
+func NewHMACProvider(secret string) *HMACProvider {
+	return &HMACProvider{secret: []byte(secret)}
+}
+
+type webhookPayload struct {
+	ID           string               `json:"id"`
+	Type         string               `json:"type"`
+	CreatedAt    time.Time            `json:"created_at"`
+	Subscription *subscriptionPayload `json:"subscription"`
+}
+
+type subscriptionPayload struct {
+	ID               string     `json:"id"`
+	CustomerID       string     `json:"customer_id"`
+	CompanyID        uuid.UUID  `json:"company_id"`
+	Plan             string     `json:"plan"`
+	Status           string     `json:"status"`
+	CurrentPeriodEnd *time.Time `json:"current_period_end"`
+}
+
+func (p *HMACProvider) VerifyWebhook(payload []byte, header http.Header) bool {
+	if len(p.secret) == 0 {
+		return false
+	}
+	sig, ok := strings.CutPrefix(header.Get(SignatureHeader), "sha256=")
+	if !ok {
+		return false
+	}
+	got, err := hex.DecodeString(sig)
+	if err != nil {
+		return false
+	}
+	return hmac.Equal(got, Sign(p.secret, payload))
+}
+
+func (p *HMACProvider) ParseWebhook(payload []byte) (*shared.BillingEvent, error) {
+	var body webhookPayload
+	if err := json.Unmarshal(payload, &body); err != nil {
+		return nil, errs.Wrap(err, "failed to decode billing event")
+	}
+	if body.ID == "" || body.Type == "" || body.CreatedAt.IsZero() {
+		return nil, errMissingField
+	}
+
+	event := &shared.BillingEvent{ID: body.ID, Type: body.Type}
+	if sub := body.Subscription; sub != nil {
+		if sub.CompanyID == uuid.Nil || sub.Plan == "" || sub.Status == "" {
+			return nil, errMissingField
+		}
+		event.Subscription = &shared.SubscriptionState{
+			CompanyID:        sub.CompanyID,
+			PlanID:           sub.Plan,
+			Status:           sub.Status,
+			CustomerID:       sub.CustomerID,
+			SubscriptionID:   sub.ID,
+			CurrentPeriodEnd: sub.CurrentPeriodEnd,
+			UpdatedAt:        body.CreatedAt,
+		}
+	}
+	return event, nil
+}
+
+// Sign returns the HMAC-SHA256 of payload, for senders and tests.
+func Sign(secret, payload []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,113 @@
+//go:build unit
+
+package billing_test
+
+import (
+	"encoding/hex"
+	"net/http"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/infra/billing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func signed(secret string, payload []byte) http.Header {
+	h := http.Header{}
+	h.Set(billing.SignatureHeader, "sha256="+hex.EncodeToString(billing.Sign([]byte(secret), payload)))
+	return h
+}
+
+func TestHMACProvider_VerifyWebhook(t *testing.T) {
+	payload := []byte(`{"id":"evt_1"}`)
+
+	testCases := []struct {
+		name     string
+		secret   string
+		header   http.Header
+		expected bool
+	}{
+		{
+			name:     "success: signature matches",
+			secret:   "whsec",
+			header:   signed("whsec", payload),
+			expected: true,
+		},
+		{
+			name:   "error: signed with another secret",
+			secret: "whsec",
+			header: signed("other", payload),
+		},
+		{
+			name:   "error: signature header missing",
+			secret: "whsec",
+			header: http.Header{},
+		},
+		{
+			name:   "error: signature is not hex",
+			secret: "whsec",
+			header: http.Header{billing.SignatureHeader: []string{"sha256=zz"}},
+		},
+		{
+			name:   "error: no secret configured",
+			secret: "",
+			header: signed("", payload),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			provider := billing.NewHMACProvider(tc.secret)
+			assert.Equal(t, tc.expected, provider.VerifyWebhook(payload, tc.header))
+		})
+	}
+}
+
+func TestHMACProvider_ParseWebhook(t *testing.T) {
+	companyID := uuid.MustParse("0b3a7c1e-6d8f-4a52-9e2b-1f4c5d6e7a8b")
+	provider := billing.NewHMACProvider("whsec")
+
+	t.Run("success: subscription event", func(t *testing.T) {
+		event, err := provider.ParseWebhook([]byte(`{
+			"id": "evt_1",
+			"type": "subscription.updated",
+			"created_at": "2025-03-01T10:00:00Z",
+			"subscription": {
+				"id": "sub_1",
+				"customer_id": "cus_1",
+				"company_id": "` + companyID.String() + `",
+				"plan": "pro",
+				"status": "active",
+				"current_period_end": "2025-04-01T00:00:00Z"
+			}
+		}`))
+		require.NoError(t, err)
+		assert.Equal(t, "evt_1", event.ID)
+		require.NotNil(t, event.Subscription)
+		assert.Equal(t, companyID, event.Subscription.CompanyID)
+		assert.Equal(t, "pro", event.Subscription.PlanID)
+		assert.Equal(t, "sub_1", event.Subscription.SubscriptionID)
+		assert.Equal(t, time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC), event.Subscription.UpdatedAt)
+		require.NotNil(t, event.Subscription.CurrentPeriodEnd)
+	})
+
+	t.Run("success: event without a subscription", func(t *testing.T) {
+		event, err := provider.ParseWebhook([]byte(`{"id":"evt_2","type":"invoice.paid","created_at":"2025-03-01T10:00:00Z"}`))
+		require.NoError(t, err)
+		assert.Nil(t, event.Subscription)
+	})
+
+	t.Run("error: malformed payloads", func(t *testing.T) {
+		for _, payload := range []string{
+			`not json`,
+			`{"type":"invoice.paid","created_at":"2025-03-01T10:00:00Z"}`,
+			`{"id":"evt_3","type":"subscription.updated","created_at":"2025-03-01T10:00:00Z","subscription":{"plan":"pro","status":"active"}}`,
+		} {
+			_, err := provider.ParseWebhook([]byte(payload))
+			assert.Error(t, err, payload)
+		}
+	})
+}
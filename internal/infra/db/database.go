@@ -3,22 +3,38 @@ package db
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"strings"
 	"time"
 
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
 	"gin-clean-starter/internal/pkg/config"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgconn/ctxwatch"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// cancelDeadlineDelay is how long a canceled query may take to stop on the server before
+// the connection is dropped instead.
+const cancelDeadlineDelay = time.Second
+
 func Connect(cfg config.DBConfig) (*pgxpool.Pool, func(), error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	pool, err := pgxpool.New(ctx, cfg.BuildDSN())
+	poolConfig, err := NewPoolConfig(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create connection pool: %w", err)
 	}
 
+	// Ping opens the first connection, which also runs the statement warm-up.
 	if err := pool.Ping(ctx); err != nil {
 		pool.Close()
 		return nil, nil, fmt.Errorf("failed to ping database: %w", err)
@@ -30,3 +46,90 @@ func Connect(cfg config.DBConfig) (*pgxpool.Pool, func(), error) {
 
 	return pool, cleanup, nil
 }
+
+// NewPoolConfig applies the statement cache settings on top of the DSN.
+// Zero values keep the pgx defaults.
+func NewPoolConfig(cfg config.DBConfig) (*pgxpool.Config, error) {
+	poolConfig, err := pgxpool.ParseConfig(cfg.BuildDSN())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse database config: %w", err)
+	}
+
+	connConfig := poolConfig.ConnConfig
+	// A canceled context (a client that went away) stops the statement on the server. The
+	// pgx default only drops the connection, and the server keeps running the statement
+	// until it next writes to it.
+	connConfig.BuildContextWatcherHandler = func(pgConn *pgconn.PgConn) ctxwatch.Handler {
+		return &pgconn.CancelRequestContextWatcherHandler{Conn: pgConn, DeadlineDelay: cancelDeadlineDelay}
+	}
+	if cfg.ApplicationName != "" {
+		connConfig.RuntimeParams["application_name"] = cfg.ApplicationName
+	}
+	if cfg.QueryExecMode != "" {
+		mode, err := parseQueryExecMode(cfg.QueryExecMode)
+		if err != nil {
+			return nil, err
+		}
+		connConfig.DefaultQueryExecMode = mode
+	}
+	if cfg.StatementCacheSize < 0 {
+		return nil, fmt.Errorf("DB_STATEMENT_CACHE_SIZE must not be negative: %d", cfg.StatementCacheSize)
+	}
+	if cfg.StatementCacheSize > 0 {
+		connConfig.StatementCacheCapacity = cfg.StatementCacheSize
+		connConfig.DescriptionCacheCapacity = cfg.StatementCacheSize
+	}
+
+	if cfg.PrepareHotStatements && connConfig.DefaultQueryExecMode == pgx.QueryExecModeCacheStatement {
+		statements := sqlc.HotStatements()
+		poolConfig.AfterConnect = func(ctx context.Context, conn *pgx.Conn) error {
+			prepareStatements(ctx, conn, statements)
+			return nil
+		}
+	}
+
+	return poolConfig, nil
+}
+
+func parseQueryExecMode(mode string) (pgx.QueryExecMode, error) {
+	switch mode {
+	case "cache_statement":
+		return pgx.QueryExecModeCacheStatement, nil
+	case "cache_describe":
+		return pgx.QueryExecModeCacheDescribe, nil
+	case "describe_exec":
+		return pgx.QueryExecModeDescribeExec, nil
+	case "exec":
+		return pgx.QueryExecModeExec, nil
+	case "simple_protocol":
+		return pgx.QueryExecModeSimpleProtocol, nil
+	default:
+		return 0, fmt.Errorf("unknown DB_QUERY_EXEC_MODE %q", mode)
+	}
+}
+
+// prepareStatements prepares each query under its own SQL text, which pgx checks
+// before the LRU cache, so these stay prepared however the cache churns.
+// Failures are logged and skipped: a statement that cannot be prepared (e.g.
+// before migrations have run) still works through the regular cache path.
+func prepareStatements(ctx context.Context, conn *pgx.Conn, statements []string) {
+	prepared := 0
+	for _, sql := range statements {
+		if _, err := conn.Prepare(ctx, sql, sql); err != nil {
+			slog.Warn("Failed to prepare statement", "statement", statementName(sql), "error", err.Error())
+			continue
+		}
+		prepared++
+	}
+	slog.Debug("Prepared hot statements", "prepared", prepared, "total", len(statements))
+}
+
+// statementName extracts X from sqlc's leading "-- name: X :kind" comment.
+func statementName(sql string) string {
+	line, _, _ := strings.Cut(sql, "\n")
+	fields := strings.Fields(strings.TrimPrefix(line, "-- name:"))
+	if len(fields) == 0 {
+		return ""
+	}
+	return fields[0]
+}
@@ -0,0 +1,88 @@
+//go:build unit
+
+package db
+
+import (
+	"strings"
+	"testing"
+
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/config"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPoolConfig(t *testing.T) {
+	base := config.NewTestConfig().DB
+
+	t.Run("defaults prepare hot statements in cache_statement mode", func(t *testing.T) {
+		cfg := base
+		cfg.StatementCacheSize = 128
+
+		pc, err := NewPoolConfig(cfg)
+		require.NoError(t, err)
+
+		assert.Equal(t, pgx.QueryExecModeCacheStatement, pc.ConnConfig.DefaultQueryExecMode)
+		assert.Equal(t, 128, pc.ConnConfig.StatementCacheCapacity)
+		assert.Equal(t, 128, pc.ConnConfig.DescriptionCacheCapacity)
+		assert.NotNil(t, pc.AfterConnect)
+		assert.Equal(t, "gin-clean-starter-test", pc.ConnConfig.RuntimeParams["application_name"])
+		// Canceled contexts stop the statement on the server, not just the client's wait
+		assert.IsType(t, &pgconn.CancelRequestContextWatcherHandler{}, pc.ConnConfig.BuildContextWatcherHandler(nil))
+	})
+
+	t.Run("proxy-safe modes never prepare", func(t *testing.T) {
+		for _, mode := range []string{"exec", "simple_protocol", "cache_describe", "describe_exec"} {
+			cfg := base
+			cfg.QueryExecMode = mode
+
+			pc, err := NewPoolConfig(cfg)
+			require.NoError(t, err, mode)
+			assert.Nil(t, pc.AfterConnect, mode)
+		}
+	})
+
+	t.Run("zero values keep pgx defaults", func(t *testing.T) {
+		cfg := base
+		cfg.QueryExecMode = ""
+		cfg.StatementCacheSize = 0
+		cfg.PrepareHotStatements = false
+
+		pc, err := NewPoolConfig(cfg)
+		require.NoError(t, err)
+
+		assert.Equal(t, pgx.QueryExecModeCacheStatement, pc.ConnConfig.DefaultQueryExecMode)
+		assert.Equal(t, 512, pc.ConnConfig.StatementCacheCapacity)
+		assert.Nil(t, pc.AfterConnect)
+	})
+
+	t.Run("invalid settings", func(t *testing.T) {
+		cfg := base
+		cfg.QueryExecMode = "prepared"
+		_, err := NewPoolConfig(cfg)
+		assert.ErrorContains(t, err, "DB_QUERY_EXEC_MODE")
+
+		cfg = base
+		cfg.StatementCacheSize = -1
+		_, err = NewPoolConfig(cfg)
+		assert.ErrorContains(t, err, "DB_STATEMENT_CACHE_SIZE")
+	})
+}
+
+func TestHotStatements(t *testing.T) {
+	statements := sqlc.HotStatements()
+	require.NotEmpty(t, statements)
+
+	seen := map[string]bool{}
+	for _, sql := range statements {
+		name := statementName(sql)
+		require.NotEmpty(t, name, "statement without sqlc name comment: %q", sql)
+		assert.False(t, seen[name], "duplicate hot statement %s", name)
+		assert.True(t, strings.Contains(sql, "$1"), "%s has no parameters; pgx runs those over the simple protocol", name)
+		seen[name] = true
+	}
+	assert.True(t, seen["FindUserByEmail"])
+}
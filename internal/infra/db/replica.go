@@ -0,0 +1,215 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/config"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PrimaryEndpoint names the primary in X-Served-By.
+const PrimaryEndpoint = "primary"
+
+// ReadPolicy decides where lag-tolerant reads go.
+type ReadPolicy string
+
+const (
+	// ReadPolicyPrimary ignores replicas.
+	ReadPolicyPrimary ReadPolicy = "primary"
+	// ReadPolicyNearest picks the healthy replica with the lowest probe latency.
+	ReadPolicyNearest ReadPolicy = "nearest"
+	// ReadPolicyRegion prefers healthy replicas in the configured region, then the nearest.
+	ReadPolicyRegion ReadPolicy = "region"
+)
+
+// probeTimeout bounds one replica probe; a replica slower than this is unhealthy.
+const probeTimeout = 2 * time.Second
+
+// Replication lag in seconds; 0 when the replica has replayed everything it received, so
+// an idle primary does not make a caught-up replica look stale.
+const replicaLagSQL = `SELECT CASE
+    WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+    ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+END::float8`
+
+// Replica is one read endpoint. Name identifies it in logs and X-Served-By.
+type Replica struct {
+	Name   string
+	Region string
+	DB     sqlc.DBTX
+}
+
+type replicaState struct {
+	Replica
+	healthy bool
+	latency time.Duration
+	lag     time.Duration
+}
+
+// ReadRouter spreads lag-tolerant reads over read replicas. Replicas count as healthy
+// once a probe has answered within probeTimeout and lags at most maxLag; until then, and
+// whenever none is healthy, reads go to the primary.
+type ReadRouter struct {
+	primary sqlc.DBTX
+	policy  ReadPolicy
+	region  string
+	maxLag  time.Duration
+
+	mu       sync.RWMutex
+	replicas []*replicaState
+}
+
+func NewReadRouter(primary sqlc.DBTX, policy ReadPolicy, region string, maxLag time.Duration, replicas []Replica) *ReadRouter {
+	states := make([]*replicaState, 0, len(replicas))
+	for _, r := range replicas {
+		states = append(states, &replicaState{Replica: r})
+	}
+	return &ReadRouter{
+		primary:  primary,
+		policy:   policy,
+		region:   region,
+		maxLag:   maxLag,
+		replicas: states,
+	}
+}
+
+// Pick returns the endpoint the next lag-tolerant read goes to and its name.
+func (r *ReadRouter) Pick() (string, sqlc.DBTX) {
+	if r.policy == ReadPolicyPrimary {
+		return PrimaryEndpoint, r.primary
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var best *replicaState
+	for _, rep := range r.replicas {
+		if rep.healthy && (best == nil || r.preferred(rep, best)) {
+			best = rep
+		}
+	}
+	if best == nil {
+		return PrimaryEndpoint, r.primary
+	}
+	return best.Name, best.DB
+}
+
+func (r *ReadRouter) preferred(a, b *replicaState) bool {
+	if r.policy == ReadPolicyRegion {
+		if inA, inB := a.Region == r.region, b.Region == r.region; inA != inB {
+			return inA
+		}
+	}
+	return a.latency < b.latency
+}
+
+// Probe measures every replica's round trip and replication lag and updates which ones
+// may serve reads. Latency is smoothed over probes so one slow answer does not flip the
+// route. It reports how many replicas are healthy.
+func (r *ReadRouter) Probe(ctx context.Context) int {
+	healthy := 0
+	for _, rep := range r.replicas {
+		latency, lag, err := probeReplica(ctx, rep.DB)
+
+		r.mu.Lock()
+		wasHealthy := rep.healthy
+		rep.healthy = err == nil && lag <= r.maxLag
+		if err == nil {
+			if rep.latency == 0 {
+				rep.latency = latency
+			} else {
+				rep.latency = (rep.latency*7 + latency*3) / 10
+			}
+			rep.lag = lag
+		}
+		now := rep.healthy
+		r.mu.Unlock()
+
+		switch {
+		case now:
+			healthy++
+			if !wasHealthy {
+				slog.Info("Read replica available", "replica", rep.Name, "latency", latency, "lag", lag)
+			}
+		case wasHealthy && err != nil:
+			slog.Warn("Read replica probe failed", "replica", rep.Name, "error", err.Error())
+		case wasHealthy:
+			slog.Warn("Read replica lagging", "replica", rep.Name, "lag", lag, "max_lag", r.maxLag)
+		}
+	}
+	return healthy
+}
+
+func probeReplica(ctx context.Context, db sqlc.DBTX) (time.Duration, time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+
+	started := time.Now()
+	var lagSeconds float64
+	if err := db.QueryRow(ctx, replicaLagSQL).Scan(&lagSeconds); err != nil {
+		return 0, 0, err
+	}
+	return time.Since(started), time.Duration(lagSeconds * float64(time.Second)), nil
+}
+
+// ParseReadPolicy validates DB_READ_ROUTING; the region policy needs DB_READ_REGION.
+func ParseReadPolicy(policy, region string) (ReadPolicy, error) {
+	switch p := ReadPolicy(policy); p {
+	case ReadPolicyPrimary, ReadPolicyNearest:
+		return p, nil
+	case ReadPolicyRegion:
+		if region == "" {
+			return "", fmt.Errorf("DB_READ_ROUTING=region needs DB_READ_REGION")
+		}
+		return p, nil
+	default:
+		return "", fmt.Errorf("unknown DB_READ_ROUTING %q", policy)
+	}
+}
+
+// ConnectReplicas opens a pool per "<region>=<host>:<port>" entry with the primary's
+// credentials and settings. Pools connect lazily, so an unreachable replica does not stop
+// startup; the probe keeps it out of rotation.
+func ConnectReplicas(cfg config.DBConfig, entries []string) ([]Replica, func(), error) {
+	var pools []*pgxpool.Pool
+	cleanup := func() {
+		for _, pool := range pools {
+			pool.Close()
+		}
+	}
+
+	replicas := make([]Replica, 0, len(entries))
+	for _, entry := range entries {
+		region, addr, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		host, port, err := net.SplitHostPort(addr)
+		if !ok || region == "" || err != nil || host == "" {
+			cleanup()
+			return nil, nil, fmt.Errorf("invalid DB_READ_REPLICAS entry %q (want <region>=<host>:<port>)", entry)
+		}
+
+		replicaCfg := cfg
+		replicaCfg.Host, replicaCfg.Port = host, port
+		poolConfig, err := NewPoolConfig(replicaCfg)
+		if err != nil {
+			cleanup()
+			return nil, nil, err
+		}
+		pool, err := pgxpool.NewWithConfig(context.Background(), poolConfig)
+		if err != nil {
+			cleanup()
+			return nil, nil, fmt.Errorf("failed to create replica pool %s: %w", addr, err)
+		}
+		pools = append(pools, pool)
+		replicas = append(replicas, Replica{Name: region + "/" + addr, Region: region, DB: pool})
+	}
+
+	return replicas, cleanup, nil
+}
@@ -0,0 +1,150 @@
+//go:build unit
+
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/config"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// probeDBTX answers the lag probe with lagSeconds, or fails with err.
+type probeDBTX struct {
+	sqlc.DBTX
+	lagSeconds float64
+	err        error
+}
+
+func (p *probeDBTX) QueryRow(context.Context, string, ...any) pgx.Row {
+	return probeRow{p}
+}
+
+type probeRow struct{ p *probeDBTX }
+
+func (r probeRow) Scan(dest ...any) error {
+	if r.p.err != nil {
+		return r.p.err
+	}
+	*dest[0].(*float64) = r.p.lagSeconds
+	return nil
+}
+
+func TestReadRouter(t *testing.T) {
+	ctx := context.Background()
+	primary := &probeDBTX{}
+
+	t.Run("primary policy never uses replicas", func(t *testing.T) {
+		router := NewReadRouter(primary, ReadPolicyPrimary, "", time.Second, []Replica{{Name: "eu/r1", Region: "eu", DB: &probeDBTX{}}})
+		router.Probe(ctx)
+
+		name, db := router.Pick()
+		assert.Equal(t, PrimaryEndpoint, name)
+		assert.Same(t, primary, db)
+	})
+
+	t.Run("replicas serve only after a healthy probe", func(t *testing.T) {
+		replica := &probeDBTX{}
+		router := NewReadRouter(primary, ReadPolicyNearest, "", time.Second, []Replica{{Name: "eu/r1", Region: "eu", DB: replica}})
+
+		name, _ := router.Pick()
+		assert.Equal(t, PrimaryEndpoint, name)
+
+		require.Equal(t, 1, router.Probe(ctx))
+		name, db := router.Pick()
+		assert.Equal(t, "eu/r1", name)
+		assert.Same(t, replica, db)
+	})
+
+	t.Run("failing or lagging replicas fall back to the primary", func(t *testing.T) {
+		failing := &probeDBTX{}
+		lagging := &probeDBTX{}
+		router := NewReadRouter(primary, ReadPolicyNearest, "", time.Second, []Replica{
+			{Name: "eu/r1", Region: "eu", DB: failing},
+			{Name: "us/r2", Region: "us", DB: lagging},
+		})
+		require.Equal(t, 2, router.Probe(ctx))
+
+		failing.err = errors.New("connection refused")
+		lagging.lagSeconds = 30
+		assert.Equal(t, 0, router.Probe(ctx))
+
+		name, db := router.Pick()
+		assert.Equal(t, PrimaryEndpoint, name)
+		assert.Same(t, primary, db)
+
+		lagging.lagSeconds = 0
+		require.Equal(t, 1, router.Probe(ctx))
+		name, _ = router.Pick()
+		assert.Equal(t, "us/r2", name)
+	})
+
+	t.Run("nearest picks the lowest latency", func(t *testing.T) {
+		router := NewReadRouter(primary, ReadPolicyNearest, "", time.Second, nil)
+		router.replicas = []*replicaState{
+			{Replica: Replica{Name: "eu/r1", Region: "eu"}, healthy: true, latency: 40 * time.Millisecond},
+			{Replica: Replica{Name: "us/r2", Region: "us"}, healthy: true, latency: 5 * time.Millisecond},
+			{Replica: Replica{Name: "ap/r3", Region: "ap"}, healthy: false, latency: time.Millisecond},
+		}
+
+		name, _ := router.Pick()
+		assert.Equal(t, "us/r2", name)
+	})
+
+	t.Run("region prefers local replicas over faster remote ones", func(t *testing.T) {
+		router := NewReadRouter(primary, ReadPolicyRegion, "eu", time.Second, nil)
+		router.replicas = []*replicaState{
+			{Replica: Replica{Name: "us/r2", Region: "us"}, healthy: true, latency: 5 * time.Millisecond},
+			{Replica: Replica{Name: "eu/r1", Region: "eu"}, healthy: true, latency: 40 * time.Millisecond},
+			{Replica: Replica{Name: "eu/r3", Region: "eu"}, healthy: true, latency: 20 * time.Millisecond},
+		}
+		name, _ := router.Pick()
+		assert.Equal(t, "eu/r3", name)
+
+		router.replicas[1].healthy, router.replicas[2].healthy = false, false
+		name, _ = router.Pick()
+		assert.Equal(t, "us/r2", name)
+	})
+}
+
+func TestParseReadPolicy(t *testing.T) {
+	for _, policy := range []string{"primary", "nearest"} {
+		got, err := ParseReadPolicy(policy, "")
+		require.NoError(t, err)
+		assert.Equal(t, ReadPolicy(policy), got)
+	}
+
+	got, err := ParseReadPolicy("region", "eu")
+	require.NoError(t, err)
+	assert.Equal(t, ReadPolicyRegion, got)
+
+	_, err = ParseReadPolicy("region", "")
+	assert.ErrorContains(t, err, "DB_READ_REGION")
+	_, err = ParseReadPolicy("random", "")
+	assert.ErrorContains(t, err, "unknown DB_READ_ROUTING")
+}
+
+func TestConnectReplicas(t *testing.T) {
+	cfg := config.NewTestConfig().DB
+
+	replicas, cleanup, err := ConnectReplicas(cfg, []string{"eu=replica-eu:5432", " us=10.0.0.2:6432"})
+	require.NoError(t, err)
+	defer cleanup()
+
+	require.Len(t, replicas, 2)
+	assert.Equal(t, "eu/replica-eu:5432", replicas[0].Name)
+	assert.Equal(t, "eu", replicas[0].Region)
+	assert.Equal(t, "us/10.0.0.2:6432", replicas[1].Name)
+
+	for _, entry := range []string{"replica-eu:5432", "=replica-eu:5432", "eu=replica-eu", "eu=:5432"} {
+		_, _, err := ConnectReplicas(cfg, []string{entry})
+		assert.ErrorContains(t, err, "invalid DB_READ_REPLICAS entry", entry)
+	}
+}
@@ -0,0 +1,99 @@
+package email
+
+import (
+	"html/template"
+	"strings"
+
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+)
+
+// Product defaults for companies that leave a branding field empty.
+const (
+	DefaultCompanyName  = "Reservations"
+	DefaultPrimaryColor = "#1f2937"
+	DefaultAccentColor  = "#2563eb"
+	DefaultFooter       = "You are receiving this email because of a reservation you made."
+)
+
+var layout = template.Must(template.New("email").Parse(`<!DOCTYPE html>
+<html>
+<body style="margin:0;padding:24px;background:#f3f4f6;font-family:Helvetica,Arial,sans-serif">
+<table role="presentation" width="100%" style="max-width:600px;margin:0 auto;background:#ffffff">
+<tr><td style="padding:16px 24px;background:{{.Primary}};color:#ffffff">
+{{- if .LogoURL}}<img src="{{.LogoURL}}" alt="{{.CompanyName}}" height="32">{{else}}<strong>{{.CompanyName}}</strong>{{end -}}
+</td></tr>
+<tr><td style="padding:24px">
+<h1 style="margin:0 0 16px;font-size:20px;color:{{.Primary}}">{{.Email.Heading}}</h1>
+{{- range .Email.Paragraphs}}
+<p style="margin:0 0 12px;line-height:1.5">{{.}}</p>
+{{- end}}
+{{- if .Email.ActionURL}}
+<p style="margin:24px 0"><a href="{{.Email.ActionURL}}" style="display:inline-block;padding:10px 18px;background:{{.Accent}};color:#ffffff;text-decoration:none">{{.Email.ActionLabel}}</a></p>
+{{- end}}
+</td></tr>
+<tr><td style="padding:16px 24px;font-size:12px;color:#6b7280">{{.Footer}}</td></tr>
+</table>
+</body>
+</html>
+`))
+
+type layoutData struct {
+	CompanyName string
+	LogoURL     string
+	Primary     string
+	Accent      string
+	Footer      string
+	Email       shared.Email
+}
+
+// TemplateRenderer renders every message into one HTML layout and a plain-text copy.
+type TemplateRenderer struct{}
+
+func NewTemplateRenderer() *TemplateRenderer {
+	return &TemplateRenderer{}
+}
+
+func (TemplateRenderer) Render(branding shared.CompanyBranding, email shared.Email) (shared.RenderedEmail, error) {
+	data := layoutData{
+		CompanyName: or(branding.CompanyName, DefaultCompanyName),
+		LogoURL:     branding.LogoURL,
+		Primary:     or(branding.PrimaryColor, DefaultPrimaryColor),
+		Accent:      or(branding.AccentColor, DefaultAccentColor),
+		Footer:      or(branding.Footer, DefaultFooter),
+		Email:       email,
+	}
+
+	var html strings.Builder
+	if err := layout.Execute(&html, data); err != nil {
+		return shared.RenderedEmail{}, errs.Wrap(err, "failed to render email")
+	}
+	return shared.RenderedEmail{
+		Subject: data.CompanyName + ": " + email.Subject,
+		ReplyTo: branding.ReplyTo,
+		HTML:    html.String(),
+		Text:    plainText(data),
+	}, nil
+}
+
+func plainText(data layoutData) string {
+	var b strings.Builder
+	b.WriteString(data.Email.Heading)
+	b.WriteString("\n\n")
+	for _, p := range data.Email.Paragraphs {
+		b.WriteString(p)
+		b.WriteString("\n\n")
+	}
+	if data.Email.ActionURL != "" {
+		b.WriteString(data.Email.ActionLabel + ": " + data.Email.ActionURL + "\n\n")
+	}
+	b.WriteString("-- \n" + data.Footer + "\n")
+	return b.String()
+}
+
+func or(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
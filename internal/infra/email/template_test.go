@@ -0,0 +1,67 @@
+//go:build unit
+
+package email_test
+
+import (
+	"testing"
+
+	"gin-clean-starter/internal/infra/email"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplateRenderer_Render(t *testing.T) {
+	message := shared.Email{
+		Subject:     "Reservation confirmed",
+		Heading:     "See you soon",
+		Paragraphs:  []string{"Room A, 1 June 10:00-11:00."},
+		ActionLabel: "View reservation",
+		ActionURL:   "https://example.com/reservations/1",
+	}
+
+	t.Run("success: company branding is applied", func(t *testing.T) {
+		out, err := email.NewTemplateRenderer().Render(shared.CompanyBranding{
+			CompanyName:  "Acme",
+			LogoURL:      "https://cdn.example.com/acme.png",
+			PrimaryColor: "#112233",
+			AccentColor:  "#abc",
+			ReplyTo:      "help@acme.example",
+			Footer:       "Acme Inc., 1 Main St",
+		}, message)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Acme: Reservation confirmed", out.Subject)
+		assert.Equal(t, "help@acme.example", out.ReplyTo)
+		assert.Contains(t, out.HTML, `<img src="https://cdn.example.com/acme.png" alt="Acme"`)
+		assert.Contains(t, out.HTML, "background:#112233")
+		assert.Contains(t, out.HTML, "background:#abc")
+		assert.Contains(t, out.HTML, "Acme Inc., 1 Main St")
+		assert.Contains(t, out.Text, "View reservation: https://example.com/reservations/1")
+	})
+
+	t.Run("success: empty fields use the product defaults", func(t *testing.T) {
+		out, err := email.NewTemplateRenderer().Render(shared.CompanyBranding{}, message)
+
+		require.NoError(t, err)
+		assert.Equal(t, email.DefaultCompanyName+": Reservation confirmed", out.Subject)
+		assert.Empty(t, out.ReplyTo)
+		assert.Contains(t, out.HTML, "<strong>"+email.DefaultCompanyName+"</strong>")
+		assert.Contains(t, out.HTML, "background:"+email.DefaultPrimaryColor)
+		assert.Contains(t, out.Text, email.DefaultFooter)
+	})
+
+	t.Run("success: company text is escaped", func(t *testing.T) {
+		out, err := email.NewTemplateRenderer().Render(shared.CompanyBranding{
+			CompanyName: "<b>Acme</b>",
+			LogoURL:     "javascript:alert(1)",
+			Footer:      "<script>x</script>",
+		}, message)
+
+		require.NoError(t, err)
+		assert.NotContains(t, out.HTML, "<script>")
+		assert.NotContains(t, out.HTML, "javascript:")
+		assert.Contains(t, out.HTML, "&lt;b&gt;Acme&lt;/b&gt;")
+	})
+}
@@ -0,0 +1,20 @@
+package infra
+
+import "github.com/google/uuid"
+
+// Associated data for encrypted columns binds each ciphertext to its column and owning
+// user, so a value copied into another user's row fails to decrypt.
+
+// ReservationMessageAAD binds a message to its author. It keeps the prefix of the
+// reservations.note column the thread replaced, so migrated notes decrypt unchanged.
+func ReservationMessageAAD(authorID uuid.UUID) string {
+	return "reservations.note:" + authorID.String()
+}
+
+func UserPhoneAAD(userID uuid.UUID) string {
+	return "users.phone:" + userID.String()
+}
+
+func UserTOTPSecretAAD(userID uuid.UUID) string {
+	return "user_mfa.secret:" + userID.String()
+}
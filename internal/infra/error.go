@@ -56,6 +56,7 @@ const (
 	KindDuplicateKey       RepositoryErrorKind = "DUPLICATE_KEY"
 	KindForeignKeyViolated RepositoryErrorKind = "FOREIGN_KEY_VIOLATED"
 	KindConflict           RepositoryErrorKind = "CONFLICT"
+	KindCheckViolated      RepositoryErrorKind = "CHECK_VIOLATED"
 )
 
 func classifyPgErr(err error) RepositoryErrorKind {
@@ -68,6 +69,8 @@ func classifyPgErr(err error) RepositoryErrorKind {
 			return KindForeignKeyViolated
 		case "23P01": // exclusion_violation (e.g., EXCLUDE constraints like tstzrange overlap)
 			return KindConflict
+		case "23514": // check_violation
+			return KindCheckViolated
 		default:
 			return KindDBFailure
 		}
@@ -0,0 +1,40 @@
+//go:build unit
+
+package infra_test
+
+import (
+	"errors"
+	"testing"
+
+	"gin-clean-starter/internal/infra"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWrapRepoErr_ClassifiesPgErrors(t *testing.T) {
+	testCases := []struct {
+		name string
+		err  error
+		want infra.RepositoryErrorKind
+	}{
+		{"unique violation", &pgconn.PgError{Code: "23505"}, infra.KindDuplicateKey},
+		{"foreign key violation", &pgconn.PgError{Code: "23503"}, infra.KindForeignKeyViolated},
+		{"exclusion violation", &pgconn.PgError{Code: "23P01"}, infra.KindConflict},
+		{"check violation", &pgconn.PgError{Code: "23514"}, infra.KindCheckViolated},
+		{"other pg error", &pgconn.PgError{Code: "40001"}, infra.KindDBFailure},
+		{"non-pg error", errors.New("connection reset"), infra.KindDBFailure},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := infra.WrapRepoErr("failed", tc.err)
+			assert.True(t, infra.IsKind(err, tc.want), "expected kind [%v] but got %v", tc.want, err)
+		})
+	}
+}
+
+func TestWrapRepoErr_ExplicitKindWins(t *testing.T) {
+	err := infra.WrapRepoErr("not cancelable", &pgconn.PgError{Code: "23514"}, infra.KindConflict)
+	assert.True(t, infra.IsKind(err, infra.KindConflict))
+}
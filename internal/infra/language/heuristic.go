@@ -0,0 +1,124 @@
+package language
+
+import (
+	"context"
+	"strings"
+	"unicode"
+)
+
+// minStopwordHits is how many common words a Latin-script comment needs before a guess
+// is made; fewer than that and short comments like "Great!" would be coin flips.
+const minStopwordHits = 2
+
+// scripts maps writing systems used by a single language to its code. Kana is checked
+// separately because Japanese text mixes it with Han characters.
+var scripts = []struct {
+	table *unicode.RangeTable
+	code  string
+}{
+	{unicode.Hangul, "ko"},
+	{unicode.Han, "zh"},
+	{unicode.Cyrillic, "ru"},
+	{unicode.Arabic, "ar"},
+	{unicode.Greek, "el"},
+	{unicode.Hebrew, "he"},
+	{unicode.Thai, "th"},
+}
+
+// stopwords are frequent function words, chosen to overlap as little as possible
+// between the Latin-script languages.
+var stopwords = map[string][]string{
+	"en": {"the", "and", "was", "is", "it", "with", "very", "for", "this", "but", "not", "of", "to", "were", "would"},
+	"es": {"el", "la", "los", "las", "y", "muy", "con", "pero", "es", "fue", "una", "para", "por", "sala", "está"},
+	"fr": {"le", "la", "les", "et", "très", "avec", "mais", "est", "était", "une", "pour", "pas", "nous", "salle", "c'est"},
+	"de": {"der", "die", "das", "und", "sehr", "mit", "aber", "ist", "war", "ein", "eine", "nicht", "für", "wir", "raum"},
+	"it": {"il", "lo", "gli", "e", "molto", "con", "ma", "è", "era", "una", "per", "non", "della", "sala", "stanza"},
+	"pt": {"o", "os", "as", "e", "muito", "com", "mas", "é", "foi", "uma", "para", "não", "sala", "ótimo", "bem"},
+	"nl": {"de", "het", "en", "erg", "met", "maar", "is", "was", "een", "voor", "niet", "zeer", "wij", "ruimte", "goed"},
+}
+
+var stopwordIndex = buildStopwordIndex()
+
+func buildStopwordIndex() map[string][]string {
+	index := make(map[string][]string)
+	for code, words := range stopwords {
+		for _, w := range words {
+			index[w] = append(index[w], code)
+		}
+	}
+	return index
+}
+
+// HeuristicDetector names the language from the dominant non-Latin script, or for
+// Latin-script text from which language's common words appear most often.
+type HeuristicDetector struct{}
+
+func NewHeuristicDetector() *HeuristicDetector {
+	return &HeuristicDetector{}
+}
+
+func (HeuristicDetector) Detect(_ context.Context, text string) (string, error) {
+	if code := detectScript(text); code != "" {
+		return code, nil
+	}
+	return detectLatin(text), nil
+}
+
+// detectScript returns the language of a script covering more than half the letters.
+func detectScript(text string) string {
+	var letters, kana int
+	counts := make([]int, len(scripts))
+	for _, r := range text {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		letters++
+		if unicode.In(r, unicode.Hiragana, unicode.Katakana) {
+			kana++
+			continue
+		}
+		for i, s := range scripts {
+			if unicode.Is(s.table, r) {
+				counts[i]++
+				break
+			}
+		}
+	}
+	if letters == 0 {
+		return ""
+	}
+	if kana > 0 && 2*(kana+counts[1]) > letters {
+		return "ja"
+	}
+	for i, s := range scripts {
+		if 2*counts[i] > letters {
+			return s.code
+		}
+	}
+	return ""
+}
+
+func detectLatin(text string) string {
+	hits := make(map[string]int)
+	for _, word := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && r != '\''
+	}) {
+		for _, code := range stopwordIndex[strings.Trim(word, "'")] {
+			hits[code]++
+		}
+	}
+
+	best, bestHits, tied := "", 0, false
+	for code, n := range hits {
+		switch {
+		case n > bestHits:
+			best, bestHits, tied = code, n, false
+		case n == bestHits:
+			tied = true
+		}
+	}
+	if bestHits < minStopwordHits || tied {
+		return ""
+	}
+	return best
+}
@@ -0,0 +1,83 @@
+//go:build unit
+
+package language_test
+
+import (
+	"context"
+	"testing"
+
+	"gin-clean-starter/internal/infra/language"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeuristicDetector_Detect(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{
+			name:     "success: english from common words",
+			text:     "The room was quiet and the projector worked very well.",
+			expected: "en",
+		},
+		{
+			name:     "success: german from common words",
+			text:     "Der Raum war sehr ruhig und die Technik hat funktioniert.",
+			expected: "de",
+		},
+		{
+			name:     "success: spanish from common words",
+			text:     "La sala es muy cómoda, pero el proyector fue lento.",
+			expected: "es",
+		},
+		{
+			name:     "success: french from common words",
+			text:     "La salle était très calme et nous avons bien travaillé, c'est parfait.",
+			expected: "fr",
+		},
+		{
+			name:     "success: japanese from kana mixed with kanji",
+			text:     "会議室はとても静かで使いやすかったです。",
+			expected: "ja",
+		},
+		{
+			name:     "success: chinese from han characters alone",
+			text:     "会议室很安静，投影仪也很好用。",
+			expected: "zh",
+		},
+		{
+			name:     "success: korean from hangul",
+			text:     "회의실이 조용하고 좋았습니다.",
+			expected: "ko",
+		},
+		{
+			name:     "success: russian from cyrillic",
+			text:     "Очень тихая переговорная, всё работало.",
+			expected: "ru",
+		},
+		{
+			name:     "undetected: too few common words",
+			text:     "Great!",
+			expected: "",
+		},
+		{
+			name:     "undetected: no letters",
+			text:     "5/5 :)",
+			expected: "",
+		},
+	}
+
+	detector := language.NewHeuristicDetector()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			code, err := detector.Detect(ctx, tc.text)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, code)
+		})
+	}
+}
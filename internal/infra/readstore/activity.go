@@ -0,0 +1,69 @@
+package readstore
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/google/uuid"
+)
+
+type ActivityReadQueries interface {
+	ListUserActivityFirstPage(ctx context.Context, db sqlc.DBTX, arg sqlc.ListUserActivityFirstPageParams) ([]sqlc.UserActivity, error)
+	ListUserActivityKeyset(ctx context.Context, db sqlc.DBTX, arg sqlc.ListUserActivityKeysetParams) ([]sqlc.UserActivity, error)
+}
+
+type ActivityReadStore struct {
+	queries ActivityReadQueries
+}
+
+func NewActivityReadStore(queries ActivityReadQueries) *ActivityReadStore {
+	return &ActivityReadStore{
+		queries: queries,
+	}
+}
+
+func (r *ActivityReadStore) FindFirstPage(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, kind *string, limit int32) ([]*queries.ActivityItem, error) {
+	rows, err := r.queries.ListUserActivityFirstPage(ctx, db, sqlc.ListUserActivityFirstPageParams{
+		UserID:     userID,
+		Kind:       pgconv.StringPtrToPgtype(kind),
+		LimitCount: limit,
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list activity first page", err)
+	}
+	return toActivityItems(rows), nil
+}
+
+func (r *ActivityReadStore) FindKeyset(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, kind *string, lastOccurredAt time.Time, lastID uuid.UUID, limit int32) ([]*queries.ActivityItem, error) {
+	rows, err := r.queries.ListUserActivityKeyset(ctx, db, sqlc.ListUserActivityKeysetParams{
+		UserID:         userID,
+		Kind:           pgconv.StringPtrToPgtype(kind),
+		LastOccurredAt: pgconv.TimeToPgtype(lastOccurredAt),
+		LastID:         lastID,
+		LimitCount:     limit,
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list activity keyset", err)
+	}
+	return toActivityItems(rows), nil
+}
+
+func toActivityItems(rows []sqlc.UserActivity) []*queries.ActivityItem {
+	result := make([]*queries.ActivityItem, len(rows))
+	for i, row := range rows {
+		result[i] = &queries.ActivityItem{
+			ID:         row.ID,
+			Kind:       row.Kind,
+			SubjectID:  row.SubjectID,
+			ResourceID: pgconv.UUIDPtrFromPgtype(row.ResourceID),
+			Data:       row.Data,
+			OccurredAt: pgconv.TimeFromPgtype(row.OccurredAt),
+		}
+	}
+	return result
+}
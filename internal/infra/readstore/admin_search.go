@@ -0,0 +1,141 @@
+package readstore
+
+import (
+	"context"
+	"strings"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/google/uuid"
+)
+
+type AdminSearchReadQueries interface {
+	AdminSearchUsers(ctx context.Context, db sqlc.DBTX, arg sqlc.AdminSearchUsersParams) ([]sqlc.AdminSearchUsersRow, error)
+	AdminSearchReservationByID(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (sqlc.AdminSearchReservationByIDRow, error)
+	AdminSearchReservationNotes(ctx context.Context, db sqlc.DBTX, arg sqlc.AdminSearchReservationNotesParams) ([]sqlc.AdminSearchReservationNotesRow, error)
+	AdminSearchResources(ctx context.Context, db sqlc.DBTX, arg sqlc.AdminSearchResourcesParams) ([]sqlc.AdminSearchResourcesRow, error)
+	AdminSearchReviews(ctx context.Context, db sqlc.DBTX, arg sqlc.AdminSearchReviewsParams) ([]sqlc.AdminSearchReviewsRow, error)
+}
+
+type AdminSearchReadStore struct {
+	queries AdminSearchReadQueries
+}
+
+func NewAdminSearchReadStore(queries AdminSearchReadQueries) *AdminSearchReadStore {
+	return &AdminSearchReadStore{
+		queries: queries,
+	}
+}
+
+// likeEscaper makes the term's wildcards match literally.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func containsPattern(term string) string {
+	return "%" + likeEscaper.Replace(term) + "%"
+}
+
+func (r *AdminSearchReadStore) FindUsers(ctx context.Context, db sqlc.DBTX, term string, limit int32) ([]*queries.SearchUserHit, error) {
+	rows, err := r.queries.AdminSearchUsers(ctx, db, sqlc.AdminSearchUsersParams{
+		Pattern:    containsPattern(term),
+		Query:      term,
+		LimitCount: limit,
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to search users", err)
+	}
+	hits := make([]*queries.SearchUserHit, len(rows))
+	for i, row := range rows {
+		hits[i] = &queries.SearchUserHit{
+			ID:        row.ID,
+			Email:     row.Email,
+			Role:      row.Role,
+			CompanyID: pgconv.UUIDPtrFromPgtype(row.CompanyID),
+			IsActive:  row.IsActive,
+		}
+	}
+	return hits, nil
+}
+
+func (r *AdminSearchReadStore) FindReservationByID(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (*queries.SearchReservationHit, error) {
+	row, err := r.queries.AdminSearchReservationByID(ctx, db, id)
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return nil, nil
+		}
+		return nil, infra.WrapRepoErr("failed to search reservations by id", err)
+	}
+	return toSearchReservationHit(sqlc.AdminSearchReservationNotesRow(row)), nil
+}
+
+func (r *AdminSearchReadStore) FindReservationsByNote(ctx context.Context, db sqlc.DBTX, term string, limit int32) ([]*queries.SearchReservationHit, error) {
+	rows, err := r.queries.AdminSearchReservationNotes(ctx, db, sqlc.AdminSearchReservationNotesParams{
+		Pattern:    containsPattern(term),
+		Query:      term,
+		LimitCount: limit,
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to search reservation notes", err)
+	}
+	hits := make([]*queries.SearchReservationHit, len(rows))
+	for i, row := range rows {
+		hits[i] = toSearchReservationHit(row)
+	}
+	return hits, nil
+}
+
+func toSearchReservationHit(row sqlc.AdminSearchReservationNotesRow) *queries.SearchReservationHit {
+	return &queries.SearchReservationHit{
+		ID:         row.ID,
+		UserID:     row.UserID,
+		ResourceID: row.ResourceID,
+		Status:     row.Status,
+		StartTime:  pgconv.TimeFromPgtype(row.StartTime),
+		EndTime:    pgconv.TimeFromPgtype(row.EndTime),
+	}
+}
+
+func (r *AdminSearchReadStore) FindResources(ctx context.Context, db sqlc.DBTX, term string, limit int32) ([]*queries.SearchResourceHit, error) {
+	rows, err := r.queries.AdminSearchResources(ctx, db, sqlc.AdminSearchResourcesParams{
+		Pattern:    containsPattern(term),
+		Query:      term,
+		LimitCount: limit,
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to search resources", err)
+	}
+	hits := make([]*queries.SearchResourceHit, len(rows))
+	for i, row := range rows {
+		hits[i] = &queries.SearchResourceHit{
+			ID:        row.ID,
+			Name:      row.Name,
+			CompanyID: pgconv.UUIDPtrFromPgtype(row.CompanyID),
+		}
+	}
+	return hits, nil
+}
+
+func (r *AdminSearchReadStore) FindReviews(ctx context.Context, db sqlc.DBTX, term string, limit int32) ([]*queries.SearchReviewHit, error) {
+	rows, err := r.queries.AdminSearchReviews(ctx, db, sqlc.AdminSearchReviewsParams{
+		Pattern:    containsPattern(term),
+		Query:      term,
+		LimitCount: limit,
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to search reviews", err)
+	}
+	hits := make([]*queries.SearchReviewHit, len(rows))
+	for i, row := range rows {
+		hits[i] = &queries.SearchReviewHit{
+			ID:         row.ID,
+			UserID:     row.UserID,
+			ResourceID: row.ResourceID,
+			Rating:     int(row.Rating),
+			Comment:    row.Comment,
+			Status:     row.Status,
+		}
+	}
+	return hits, nil
+}
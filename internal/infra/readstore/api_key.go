@@ -0,0 +1,69 @@
+package readstore
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/pkg/ptr"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/google/uuid"
+)
+
+type APIKeyReadQueries interface {
+	FindAPIKeyIdentity(ctx context.Context, db sqlc.DBTX, arg sqlc.FindAPIKeyIdentityParams) (sqlc.FindAPIKeyIdentityRow, error)
+	ListUserAPIKeys(ctx context.Context, db sqlc.DBTX, userID uuid.UUID) ([]sqlc.ListUserAPIKeysRow, error)
+}
+
+type APIKeyReadStore struct {
+	queries APIKeyReadQueries
+}
+
+func NewAPIKeyReadStore(queries APIKeyReadQueries) *APIKeyReadStore {
+	return &APIKeyReadStore{
+		queries: queries,
+	}
+}
+
+func (r *APIKeyReadStore) FindIdentity(ctx context.Context, db sqlc.DBTX, keyHash []byte, now time.Time) (*queries.APIKeyIdentity, error) {
+	row, err := r.queries.FindAPIKeyIdentity(ctx, db, sqlc.FindAPIKeyIdentityParams{
+		KeyHash: keyHash,
+		Now:     pgconv.TimeToPgtype(now),
+	})
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return nil, infra.WrapRepoErr("api key not found", err, infra.KindNotFound)
+		}
+		return nil, infra.WrapRepoErr("failed to find api key", err)
+	}
+
+	return &queries.APIKeyIdentity{
+		KeyID:  row.ID,
+		UserID: row.UserID,
+		Role:   row.Role,
+		Scopes: row.Scopes,
+	}, nil
+}
+
+func (r *APIKeyReadStore) ListByUser(ctx context.Context, db sqlc.DBTX, userID uuid.UUID) ([]*queries.APIKeyView, error) {
+	rows, err := r.queries.ListUserAPIKeys(ctx, db, userID)
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list api keys", err)
+	}
+
+	result := make([]*queries.APIKeyView, len(rows))
+	for i, row := range rows {
+		result[i] = &queries.APIKeyView{
+			ID:        row.ID,
+			Name:      row.Name,
+			Scopes:    row.Scopes,
+			CreatedAt: pgconv.TimeFromPgtype(row.CreatedAt),
+			ExpiresAt: ptr.TimeFromPgtype(row.ExpiresAt),
+			RevokedAt: ptr.TimeFromPgtype(row.RevokedAt),
+		}
+	}
+	return result, nil
+}
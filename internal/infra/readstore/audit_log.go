@@ -0,0 +1,71 @@
+package readstore
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/google/uuid"
+)
+
+type AuditLogReadQueries interface {
+	ListAuditLogsFirstPage(ctx context.Context, db sqlc.DBTX, arg sqlc.ListAuditLogsFirstPageParams) ([]sqlc.AuditLogs, error)
+	ListAuditLogsKeyset(ctx context.Context, db sqlc.DBTX, arg sqlc.ListAuditLogsKeysetParams) ([]sqlc.AuditLogs, error)
+}
+
+type AuditLogReadStore struct {
+	queries AuditLogReadQueries
+}
+
+func NewAuditLogReadStore(queries AuditLogReadQueries) *AuditLogReadStore {
+	return &AuditLogReadStore{
+		queries: queries,
+	}
+}
+
+func (r *AuditLogReadStore) FindFirstPage(ctx context.Context, db sqlc.DBTX, filter queries.AuditLogFilter, limit int32) ([]*queries.AuditLogEntry, error) {
+	rows, err := r.queries.ListAuditLogsFirstPage(ctx, db, sqlc.ListAuditLogsFirstPageParams{
+		Action:     pgconv.StringPtrToPgtype(filter.Action),
+		ActorID:    pgconv.UUIDPtrToPgtype(filter.ActorID),
+		LimitCount: limit,
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list audit logs first page", err)
+	}
+	return toAuditLogEntries(rows), nil
+}
+
+func (r *AuditLogReadStore) FindKeyset(ctx context.Context, db sqlc.DBTX, filter queries.AuditLogFilter, lastCreatedAt time.Time, lastID uuid.UUID, limit int32) ([]*queries.AuditLogEntry, error) {
+	rows, err := r.queries.ListAuditLogsKeyset(ctx, db, sqlc.ListAuditLogsKeysetParams{
+		Action:        pgconv.StringPtrToPgtype(filter.Action),
+		ActorID:       pgconv.UUIDPtrToPgtype(filter.ActorID),
+		LastCreatedAt: pgconv.TimeToPgtype(lastCreatedAt),
+		LastID:        lastID,
+		LimitCount:    limit,
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list audit logs keyset", err)
+	}
+	return toAuditLogEntries(rows), nil
+}
+
+func toAuditLogEntries(rows []sqlc.AuditLogs) []*queries.AuditLogEntry {
+	result := make([]*queries.AuditLogEntry, len(rows))
+	for i, row := range rows {
+		result[i] = &queries.AuditLogEntry{
+			ID:         row.ID,
+			ActorID:    pgconv.UUIDPtrFromPgtype(row.ActorID),
+			CompanyID:  pgconv.UUIDPtrFromPgtype(row.CompanyID),
+			Action:     row.Action,
+			TargetType: row.TargetType,
+			TargetID:   row.TargetID,
+			Metadata:   row.Metadata,
+			CreatedAt:  pgconv.TimeFromPgtype(row.CreatedAt),
+		}
+	}
+	return result
+}
@@ -0,0 +1,44 @@
+package readstore
+
+import (
+	"context"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+type BrandingReadQueries interface {
+	GetCompanyBranding(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (sqlc.GetCompanyBrandingRow, error)
+}
+
+type BrandingReadStore struct {
+	queries BrandingReadQueries
+}
+
+func NewBrandingReadStore(queries BrandingReadQueries) *BrandingReadStore {
+	return &BrandingReadStore{
+		queries: queries,
+	}
+}
+
+func (r *BrandingReadStore) FindBranding(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID) (shared.CompanyBranding, error) {
+	row, err := r.queries.GetCompanyBranding(ctx, db, companyID)
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return shared.CompanyBranding{}, infra.WrapRepoErr("company not found", err, infra.KindNotFound)
+		}
+		return shared.CompanyBranding{}, infra.WrapRepoErr("failed to get company branding", err)
+	}
+	return shared.CompanyBranding{
+		CompanyName:  row.Name,
+		LogoURL:      row.BrandLogoUrl.String,
+		PrimaryColor: row.BrandPrimaryColor.String,
+		AccentColor:  row.BrandAccentColor.String,
+		ReplyTo:      row.BrandReplyTo.String,
+		Footer:       row.BrandFooter.String,
+	}, nil
+}
@@ -0,0 +1,57 @@
+package readstore
+
+import (
+	"context"
+	"encoding/json"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/google/uuid"
+)
+
+type CompanyDeletionReadQueries interface {
+	GetCompanyDeletion(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (sqlc.CompanyDeletions, error)
+}
+
+type CompanyDeletionReadStore struct {
+	queries CompanyDeletionReadQueries
+}
+
+func NewCompanyDeletionReadStore(queries CompanyDeletionReadQueries) *CompanyDeletionReadStore {
+	return &CompanyDeletionReadStore{
+		queries: queries,
+	}
+}
+
+func (r *CompanyDeletionReadStore) FindByID(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (*queries.CompanyDeletionDetail, error) {
+	row, err := r.queries.GetCompanyDeletion(ctx, db, id)
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return nil, infra.WrapRepoErr("company deletion not found", err, infra.KindNotFound)
+		}
+		return nil, infra.WrapRepoErr("failed to find company deletion", err)
+	}
+
+	counts := map[string]int64{}
+	if err := json.Unmarshal(row.Counts, &counts); err != nil {
+		return nil, infra.WrapRepoErr("failed to decode company deletion counts", err)
+	}
+	return &queries.CompanyDeletionDetail{
+		ID:          row.ID,
+		CompanyID:   row.CompanyID,
+		CompanyName: row.CompanyName,
+		RequestedBy: pgconv.UUIDPtrFromPgtype(row.RequestedBy),
+		Status:      row.Status,
+		Stage:       row.Stage,
+		Counts:      counts,
+		Attempts:    row.Attempts,
+		Error:       pgconv.StringPtrFromPgtype(row.Error),
+		Certificate: row.Certificate,
+		CreatedAt:   pgconv.TimeFromPgtype(row.CreatedAt),
+		StartedAt:   timePtrFromPgtype(row.StartedAt),
+		CompletedAt: timePtrFromPgtype(row.CompletedAt),
+	}, nil
+}
@@ -0,0 +1,135 @@
+package readstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/queries"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+type CompanyExportReadQueries interface {
+	GetCompanyExport(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (sqlc.CompanyExports, error)
+	CountCompanyExportRows(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID) (sqlc.CountCompanyExportRowsRow, error)
+	GetCompanyExportProfile(ctx context.Context, db sqlc.DBTX, id uuid.UUID) ([]byte, error)
+	ListCompanyExportUsers(ctx context.Context, db sqlc.DBTX, arg sqlc.ListCompanyExportUsersParams) ([]sqlc.ListCompanyExportUsersRow, error)
+	ListCompanyExportResources(ctx context.Context, db sqlc.DBTX, arg sqlc.ListCompanyExportResourcesParams) ([]sqlc.ListCompanyExportResourcesRow, error)
+	ListCompanyExportReservations(ctx context.Context, db sqlc.DBTX, arg sqlc.ListCompanyExportReservationsParams) ([]sqlc.ListCompanyExportReservationsRow, error)
+	ListCompanyExportReviews(ctx context.Context, db sqlc.DBTX, arg sqlc.ListCompanyExportReviewsParams) ([]sqlc.ListCompanyExportReviewsRow, error)
+	ListExpiredCompanyExports(ctx context.Context, db sqlc.DBTX, arg sqlc.ListExpiredCompanyExportsParams) ([]uuid.UUID, error)
+}
+
+type CompanyExportReadStore struct {
+	queries CompanyExportReadQueries
+}
+
+func NewCompanyExportReadStore(queries CompanyExportReadQueries) *CompanyExportReadStore {
+	return &CompanyExportReadStore{
+		queries: queries,
+	}
+}
+
+func (r *CompanyExportReadStore) FindByID(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (*queries.CompanyExportDetail, error) {
+	row, err := r.queries.GetCompanyExport(ctx, db, id)
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return nil, infra.WrapRepoErr("company export not found", err, infra.KindNotFound)
+		}
+		return nil, infra.WrapRepoErr("failed to find company export", err)
+	}
+	return &queries.CompanyExportDetail{
+		ID:          row.ID,
+		CompanyID:   row.CompanyID,
+		RequestedBy: pgconv.UUIDPtrFromPgtype(row.RequestedBy),
+		Status:      row.Status,
+		Progress:    row.Progress,
+		StorageKey:  row.StorageKey.String,
+		SizeBytes:   pgconv.Int64PtrFromPgtype(row.SizeBytes),
+		Error:       pgconv.StringPtrFromPgtype(row.Error),
+		CreatedAt:   pgconv.TimeFromPgtype(row.CreatedAt),
+		StartedAt:   timePtrFromPgtype(row.StartedAt),
+		CompletedAt: timePtrFromPgtype(row.CompletedAt),
+		ExpiresAt:   timePtrFromPgtype(row.ExpiresAt),
+	}, nil
+}
+
+func (r *CompanyExportReadStore) CountRows(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID) (map[shared.CompanyExportSection]int64, error) {
+	row, err := r.queries.CountCompanyExportRows(ctx, db, companyID)
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to count company export rows", err)
+	}
+	return map[shared.CompanyExportSection]int64{
+		shared.CompanyExportUsers:        row.Users,
+		shared.CompanyExportResources:    row.Resources,
+		shared.CompanyExportReservations: row.Reservations,
+		shared.CompanyExportReviews:      row.Reviews,
+	}, nil
+}
+
+func (r *CompanyExportReadStore) FindProfile(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID) (json.RawMessage, error) {
+	profile, err := r.queries.GetCompanyExportProfile(ctx, db, companyID)
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return nil, infra.WrapRepoErr("company not found", err, infra.KindNotFound)
+		}
+		return nil, infra.WrapRepoErr("failed to find company profile", err)
+	}
+	return profile, nil
+}
+
+func (r *CompanyExportReadStore) ListSection(ctx context.Context, db sqlc.DBTX, section shared.CompanyExportSection, companyID, afterID uuid.UUID, limit int32) ([]shared.CompanyExportRecord, error) {
+	var (
+		records []shared.CompanyExportRecord
+		err     error
+	)
+	switch section {
+	case shared.CompanyExportUsers:
+		var rows []sqlc.ListCompanyExportUsersRow
+		rows, err = r.queries.ListCompanyExportUsers(ctx, db, sqlc.ListCompanyExportUsersParams{CompanyID: companyID, AfterID: afterID, BatchSize: limit})
+		for _, row := range rows {
+			records = append(records, shared.CompanyExportRecord{ID: row.ID, Doc: row.Doc})
+		}
+	case shared.CompanyExportResources:
+		var rows []sqlc.ListCompanyExportResourcesRow
+		rows, err = r.queries.ListCompanyExportResources(ctx, db, sqlc.ListCompanyExportResourcesParams{CompanyID: companyID, AfterID: afterID, BatchSize: limit})
+		for _, row := range rows {
+			records = append(records, shared.CompanyExportRecord{ID: row.ID, Doc: row.Doc})
+		}
+	case shared.CompanyExportReservations:
+		var rows []sqlc.ListCompanyExportReservationsRow
+		rows, err = r.queries.ListCompanyExportReservations(ctx, db, sqlc.ListCompanyExportReservationsParams{CompanyID: companyID, AfterID: afterID, BatchSize: limit})
+		for _, row := range rows {
+			records = append(records, shared.CompanyExportRecord{ID: row.ID, Doc: row.Doc})
+		}
+	case shared.CompanyExportReviews:
+		var rows []sqlc.ListCompanyExportReviewsRow
+		rows, err = r.queries.ListCompanyExportReviews(ctx, db, sqlc.ListCompanyExportReviewsParams{CompanyID: companyID, AfterID: afterID, BatchSize: limit})
+		for _, row := range rows {
+			records = append(records, shared.CompanyExportRecord{ID: row.ID, Doc: row.Doc})
+		}
+	default:
+		return nil, infra.WrapRepoErr(fmt.Sprintf("unknown company export section %q", section), nil)
+	}
+	if err != nil {
+		return nil, infra.WrapRepoErr(fmt.Sprintf("failed to list company export %s", section), err)
+	}
+	return records, nil
+}
+
+func (r *CompanyExportReadStore) ListExpired(ctx context.Context, db sqlc.DBTX, now time.Time, limit int32) ([]uuid.UUID, error) {
+	ids, err := r.queries.ListExpiredCompanyExports(ctx, db, sqlc.ListExpiredCompanyExportsParams{
+		Now:       pgconv.TimeToPgtype(now),
+		BatchSize: limit,
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list expired company exports", err)
+	}
+	return ids, nil
+}
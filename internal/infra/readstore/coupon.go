@@ -60,6 +60,7 @@ func toCouponSnapshotFromRow(row sqlc.Coupons) *shared.CouponSnapshot {
 	return &shared.CouponSnapshot{
 		ID:             row.ID,
 		Code:           row.Code,
+		Priority:       int(row.Priority),
 		AmountOffCents: pgconv.Int32PtrFromPgtype(row.AmountOffCents),
 		PercentOff:     percentOff,
 		ValidFrom:      validFrom,
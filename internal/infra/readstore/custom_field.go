@@ -0,0 +1,80 @@
+package readstore
+
+import (
+	"context"
+
+	"gin-clean-starter/internal/domain/reservation"
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+type CustomFieldReadQueries interface {
+	ListCustomFieldDefinitionsByCompany(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID) ([]sqlc.CustomFieldDefinitions, error)
+	ListCustomFieldDefinitionsForResource(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID) ([]sqlc.CustomFieldDefinitions, error)
+}
+
+type CustomFieldReadStore struct {
+	queries CustomFieldReadQueries
+}
+
+func NewCustomFieldReadStore(queries CustomFieldReadQueries) *CustomFieldReadStore {
+	return &CustomFieldReadStore{
+		queries: queries,
+	}
+}
+
+func (r *CustomFieldReadStore) FieldDefinitionsForResource(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID) ([]reservation.FieldDefinition, error) {
+	rows, err := r.queries.ListCustomFieldDefinitionsForResource(ctx, db, resourceID)
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list custom fields for resource", err)
+	}
+	defs := make([]reservation.FieldDefinition, 0, len(rows))
+	for _, row := range rows {
+		defs = append(defs, toFieldDefinition(row))
+	}
+	return defs, nil
+}
+
+func (r *CustomFieldReadStore) ListByCompany(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID) ([]*shared.CustomFieldDefinition, error) {
+	rows, err := r.queries.ListCustomFieldDefinitionsByCompany(ctx, db, companyID)
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list company custom fields", err)
+	}
+	return toCustomFieldDefinitions(rows), nil
+}
+
+func (r *CustomFieldReadStore) ListForResource(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID) ([]*shared.CustomFieldDefinition, error) {
+	rows, err := r.queries.ListCustomFieldDefinitionsForResource(ctx, db, resourceID)
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list custom fields for resource", err)
+	}
+	return toCustomFieldDefinitions(rows), nil
+}
+
+func toCustomFieldDefinitions(rows []sqlc.CustomFieldDefinitions) []*shared.CustomFieldDefinition {
+	defs := make([]*shared.CustomFieldDefinition, 0, len(rows))
+	for _, row := range rows {
+		defs = append(defs, &shared.CustomFieldDefinition{
+			ID:              row.ID,
+			CompanyID:       row.CompanyID,
+			ResourceID:      pgconv.UUIDPtrFromPgtype(row.ResourceID),
+			FieldDefinition: toFieldDefinition(row),
+			CreatedAt:       row.CreatedAt.Time,
+		})
+	}
+	return defs
+}
+
+func toFieldDefinition(row sqlc.CustomFieldDefinitions) reservation.FieldDefinition {
+	return reservation.FieldDefinition{
+		Key:      row.Key,
+		Label:    row.Label,
+		Type:     reservation.FieldType(row.FieldType),
+		Required: row.Required,
+		Options:  row.Options,
+	}
+}
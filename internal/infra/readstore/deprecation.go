@@ -0,0 +1,50 @@
+package readstore
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/queries"
+)
+
+type DeprecationReadQueries interface {
+	ListDeprecatedRouteCalls(ctx context.Context, db sqlc.DBTX, arg sqlc.ListDeprecatedRouteCallsParams) ([]sqlc.ListDeprecatedRouteCallsRow, error)
+}
+
+type DeprecationReadStore struct {
+	queries DeprecationReadQueries
+}
+
+func NewDeprecationReadStore(queries DeprecationReadQueries) *DeprecationReadStore {
+	return &DeprecationReadStore{
+		queries: queries,
+	}
+}
+
+func (r *DeprecationReadStore) ListCalls(ctx context.Context, db sqlc.DBTX, from, to time.Time, route *string) ([]*queries.DeprecatedRouteConsumer, error) {
+	rows, err := r.queries.ListDeprecatedRouteCalls(ctx, db, sqlc.ListDeprecatedRouteCallsParams{
+		FromDay: pgconv.DateToPgtype(from),
+		ToDay:   pgconv.DateToPgtype(to),
+		Route:   pgconv.StringPtrToPgtype(route),
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list deprecated route calls", err)
+	}
+
+	result := make([]*queries.DeprecatedRouteConsumer, len(rows))
+	for i, row := range rows {
+		result[i] = &queries.DeprecatedRouteConsumer{
+			Route:        row.Route,
+			UserID:       pgconv.UUIDPtrFromPgtype(row.UserID),
+			Email:        pgconv.StringPtrFromPgtype(row.Email),
+			CompanyID:    pgconv.UUIDPtrFromPgtype(row.CompanyID),
+			CompanyName:  pgconv.StringPtrFromPgtype(row.CompanyName),
+			Calls:        row.CallCount,
+			LastCalledAt: pgconv.TimeFromPgtype(row.LastCalledAt),
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,64 @@
+package readstore
+
+import (
+	"context"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type FeatureReadQueries interface {
+	ListCompanyFeatures(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID) ([]sqlc.ListCompanyFeaturesRow, error)
+	GetResourceCompanyID(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (pgtype.UUID, error)
+	CompanyExists(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (bool, error)
+}
+
+type FeatureReadStore struct {
+	queries FeatureReadQueries
+}
+
+func NewFeatureReadStore(queries FeatureReadQueries) *FeatureReadStore {
+	return &FeatureReadStore{
+		queries: queries,
+	}
+}
+
+func (r *FeatureReadStore) ListCompanyFeatures(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID) (map[string]bool, error) {
+	rows, err := r.queries.ListCompanyFeatures(ctx, db, companyID)
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list company features", err)
+	}
+
+	result := make(map[string]bool, len(rows))
+	for _, row := range rows {
+		result[row.Feature] = row.Enabled
+	}
+	return result, nil
+}
+
+// FindResourceCompany returns uuid.Nil for resources without a company.
+func (r *FeatureReadStore) FindResourceCompany(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID) (uuid.UUID, error) {
+	companyID, err := r.queries.GetResourceCompanyID(ctx, db, resourceID)
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return uuid.Nil, infra.WrapRepoErr("resource not found", err, infra.KindNotFound)
+		}
+		return uuid.Nil, infra.WrapRepoErr("failed to find resource company", err)
+	}
+	if id := pgconv.UUIDPtrFromPgtype(companyID); id != nil {
+		return *id, nil
+	}
+	return uuid.Nil, nil
+}
+
+func (r *FeatureReadStore) CompanyExists(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID) (bool, error) {
+	exists, err := r.queries.CompanyExists(ctx, db, companyID)
+	if err != nil {
+		return false, infra.WrapRepoErr("failed to check company", err)
+	}
+	return exists, nil
+}
@@ -0,0 +1,69 @@
+package readstore
+
+import (
+	"context"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/queries"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+type InviteReadQueries interface {
+	GetInviteByID(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (sqlc.Invites, error)
+	ListInvitesByCompany(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID) ([]sqlc.ListInvitesByCompanyRow, error)
+}
+
+type InviteReadStore struct {
+	queries InviteReadQueries
+}
+
+func NewInviteReadStore(queries InviteReadQueries) *InviteReadStore {
+	return &InviteReadStore{
+		queries: queries,
+	}
+}
+
+func (r *InviteReadStore) FindSnapshotByID(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (*shared.InviteSnapshot, error) {
+	row, err := r.queries.GetInviteByID(ctx, db, id)
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return nil, infra.WrapRepoErr("invite not found", err, infra.KindNotFound)
+		}
+		return nil, infra.WrapRepoErr("failed to find invite by ID", err)
+	}
+
+	return &shared.InviteSnapshot{
+		ID:        row.ID,
+		Email:     row.Email,
+		CompanyID: row.CompanyID,
+		Role:      row.Role,
+		Nonce:     row.Nonce,
+		Status:    row.Status,
+		ExpiresAt: pgconv.TimeFromPgtype(row.ExpiresAt),
+	}, nil
+}
+
+func (r *InviteReadStore) ListByCompany(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID) ([]*queries.InviteView, error) {
+	rows, err := r.queries.ListInvitesByCompany(ctx, db, companyID)
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list invites", err)
+	}
+
+	result := make([]*queries.InviteView, len(rows))
+	for i, row := range rows {
+		result[i] = &queries.InviteView{
+			ID:             row.ID,
+			Email:          row.Email,
+			Role:           row.Role,
+			Status:         row.Status,
+			InvitedByEmail: row.InvitedByEmail,
+			ExpiresAt:      pgconv.TimeFromPgtype(row.ExpiresAt),
+			CreatedAt:      pgconv.TimeFromPgtype(row.CreatedAt),
+		}
+	}
+	return result, nil
+}
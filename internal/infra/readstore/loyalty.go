@@ -0,0 +1,126 @@
+package readstore
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/queries"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type LoyaltyReadQueries interface {
+	GetLoyaltyBalance(ctx context.Context, db sqlc.DBTX, arg sqlc.GetLoyaltyBalanceParams) (int64, error)
+	ListAccruableReservations(ctx context.Context, db sqlc.DBTX, arg sqlc.ListAccruableReservationsParams) ([]sqlc.ListAccruableReservationsRow, error)
+	ListLoyaltyEntriesFirstPage(ctx context.Context, db sqlc.DBTX, arg sqlc.ListLoyaltyEntriesFirstPageParams) ([]sqlc.ListLoyaltyEntriesFirstPageRow, error)
+	ListLoyaltyEntriesKeyset(ctx context.Context, db sqlc.DBTX, arg sqlc.ListLoyaltyEntriesKeysetParams) ([]sqlc.ListLoyaltyEntriesKeysetRow, error)
+}
+
+type LoyaltyReadStore struct {
+	queries LoyaltyReadQueries
+}
+
+func NewLoyaltyReadStore(queries LoyaltyReadQueries) *LoyaltyReadStore {
+	return &LoyaltyReadStore{
+		queries: queries,
+	}
+}
+
+func (r *LoyaltyReadStore) Balance(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, now time.Time) (int64, error) {
+	balance, err := r.queries.GetLoyaltyBalance(ctx, db, sqlc.GetLoyaltyBalanceParams{
+		UserID:    userID,
+		ExpiresAt: pgconv.TimeToPgtype(now),
+	})
+	if err != nil {
+		return 0, infra.WrapRepoErr("failed to get loyalty balance", err)
+	}
+	return balance, nil
+}
+
+// ListAccruable returns reservations that completed in (since, now] and earn points.
+func (r *LoyaltyReadStore) ListAccruable(ctx context.Context, db sqlc.DBTX, since, now time.Time, minPriceCents int64, limit int32) ([]shared.AccruableReservation, error) {
+	minPrice, err := pgconv.SafeIntToInt32(int(minPriceCents))
+	if err != nil {
+		return nil, infra.WrapRepoErr("minimum accrual price out of range", err)
+	}
+
+	rows, err := r.queries.ListAccruableReservations(ctx, db, sqlc.ListAccruableReservationsParams{
+		Now:           pgconv.TimeToPgtype(now),
+		Since:         pgconv.TimeToPgtype(since),
+		MinPriceCents: minPrice,
+		BatchSize:     limit,
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list accruable reservations", err)
+	}
+
+	result := make([]shared.AccruableReservation, len(rows))
+	for i, row := range rows {
+		result[i] = shared.AccruableReservation{
+			ID:          row.ID,
+			UserID:      row.UserID,
+			PriceCents:  int64(row.PriceCents),
+			CompletedAt: pgconv.TimeFromPgtype(row.CompletedAt),
+		}
+	}
+	return result, nil
+}
+
+func (r *LoyaltyReadStore) FindEntriesFirstPage(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, limit int32) ([]*queries.PointsEntry, error) {
+	rows, err := r.queries.ListLoyaltyEntriesFirstPage(ctx, db, sqlc.ListLoyaltyEntriesFirstPageParams{
+		UserID: userID,
+		Limit:  limit,
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list loyalty entries first page", err)
+	}
+
+	result := make([]*queries.PointsEntry, len(rows))
+	for i, row := range rows {
+		result[i] = toPointsEntry(sqlc.ListLoyaltyEntriesKeysetRow(row))
+	}
+	return result, nil
+}
+
+func (r *LoyaltyReadStore) FindEntriesKeyset(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, lastCreatedAt time.Time, lastID uuid.UUID, limit int32) ([]*queries.PointsEntry, error) {
+	rows, err := r.queries.ListLoyaltyEntriesKeyset(ctx, db, sqlc.ListLoyaltyEntriesKeysetParams{
+		UserID:    userID,
+		CreatedAt: pgconv.TimeToPgtype(lastCreatedAt),
+		ID:        lastID,
+		Limit:     limit,
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list loyalty entries keyset", err)
+	}
+
+	result := make([]*queries.PointsEntry, len(rows))
+	for i, row := range rows {
+		result[i] = toPointsEntry(row)
+	}
+	return result, nil
+}
+
+func toPointsEntry(row sqlc.ListLoyaltyEntriesKeysetRow) *queries.PointsEntry {
+	return &queries.PointsEntry{
+		ID:            row.ID,
+		Kind:          row.Kind,
+		Points:        row.Points,
+		ReservationID: pgconv.UUIDPtrFromPgtype(row.ReservationID),
+		DiscountCents: pgconv.Int32PtrFromPgtype(row.DiscountCents),
+		ExpiresAt:     timePtrFromPgtype(row.ExpiresAt),
+		CreatedAt:     pgconv.TimeFromPgtype(row.CreatedAt),
+	}
+}
+
+func timePtrFromPgtype(pt pgtype.Timestamptz) *time.Time {
+	if !pt.Valid {
+		return nil
+	}
+	t := pt.Time
+	return &t
+}
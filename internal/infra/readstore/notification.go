@@ -5,6 +5,7 @@ import (
 
 	"gin-clean-starter/internal/infra"
 	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
 	"gin-clean-starter/internal/usecase/queries"
 )
 
@@ -49,6 +50,7 @@ func toNotificationJobViewFromRow(row sqlc.NotificationJobs) *queries.Notificati
 		Status:    row.Status,
 		CreatedAt: row.CreatedAt.Time,
 		UpdatedAt: row.UpdatedAt.Time,
+		UserID:    pgconv.UUIDPtrFromPgtype(row.UserID),
 	}
 
 	if row.LastError.Valid {
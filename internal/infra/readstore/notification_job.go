@@ -0,0 +1,77 @@
+package readstore
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/google/uuid"
+)
+
+type NotificationJobReadQueries interface {
+	ListNotificationJobsFirstPage(ctx context.Context, db sqlc.DBTX, arg sqlc.ListNotificationJobsFirstPageParams) ([]sqlc.ListNotificationJobsFirstPageRow, error)
+	ListNotificationJobsKeyset(ctx context.Context, db sqlc.DBTX, arg sqlc.ListNotificationJobsKeysetParams) ([]sqlc.ListNotificationJobsKeysetRow, error)
+}
+
+type NotificationJobReadStore struct {
+	queries NotificationJobReadQueries
+}
+
+func NewNotificationJobReadStore(queries NotificationJobReadQueries) *NotificationJobReadStore {
+	return &NotificationJobReadStore{
+		queries: queries,
+	}
+}
+
+func (r *NotificationJobReadStore) FindFirstPage(ctx context.Context, db sqlc.DBTX, filter queries.NotificationJobFilter, limit int32) ([]*queries.NotificationJob, error) {
+	rows, err := r.queries.ListNotificationJobsFirstPage(ctx, db, sqlc.ListNotificationJobsFirstPageParams{
+		Status:     pgconv.StringPtrToPgtype(filter.Status),
+		Topic:      pgconv.StringPtrToPgtype(filter.Topic),
+		LimitCount: limit,
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list notification jobs first page", err)
+	}
+
+	result := make([]*queries.NotificationJob, len(rows))
+	for i, row := range rows {
+		result[i] = toNotificationJob(sqlc.ListNotificationJobsKeysetRow(row))
+	}
+	return result, nil
+}
+
+func (r *NotificationJobReadStore) FindKeyset(ctx context.Context, db sqlc.DBTX, filter queries.NotificationJobFilter, lastCreatedAt time.Time, lastID uuid.UUID, limit int32) ([]*queries.NotificationJob, error) {
+	rows, err := r.queries.ListNotificationJobsKeyset(ctx, db, sqlc.ListNotificationJobsKeysetParams{
+		Status:        pgconv.StringPtrToPgtype(filter.Status),
+		Topic:         pgconv.StringPtrToPgtype(filter.Topic),
+		LastCreatedAt: pgconv.TimeToPgtype(lastCreatedAt),
+		LastID:        lastID,
+		LimitCount:    limit,
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list notification jobs keyset", err)
+	}
+
+	result := make([]*queries.NotificationJob, len(rows))
+	for i, row := range rows {
+		result[i] = toNotificationJob(row)
+	}
+	return result, nil
+}
+
+func toNotificationJob(row sqlc.ListNotificationJobsKeysetRow) *queries.NotificationJob {
+	return &queries.NotificationJob{
+		ID:        row.ID,
+		Kind:      row.Kind,
+		Topic:     row.Topic,
+		Status:    row.Status,
+		Attempts:  row.Attempts,
+		LastError: row.LastError.String,
+		RunAt:     pgconv.TimeFromPgtype(row.RunAt),
+		CreatedAt: pgconv.TimeFromPgtype(row.CreatedAt),
+	}
+}
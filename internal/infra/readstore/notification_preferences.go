@@ -0,0 +1,40 @@
+package readstore
+
+import (
+	"context"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/google/uuid"
+)
+
+type NotificationPreferencesReadQueries interface {
+	GetNotificationPreferences(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (sqlc.GetNotificationPreferencesRow, error)
+}
+
+type NotificationPreferencesReadStore struct {
+	queries NotificationPreferencesReadQueries
+}
+
+func NewNotificationPreferencesReadStore(queries NotificationPreferencesReadQueries) *NotificationPreferencesReadStore {
+	return &NotificationPreferencesReadStore{
+		queries: queries,
+	}
+}
+
+func (r *NotificationPreferencesReadStore) Get(ctx context.Context, db sqlc.DBTX, userID uuid.UUID) (*queries.NotificationPreferencesView, error) {
+	row, err := r.queries.GetNotificationPreferences(ctx, db, userID)
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return nil, infra.WrapRepoErr("user not found", err, infra.KindNotFound)
+		}
+		return nil, infra.WrapRepoErr("failed to get notification preferences", err)
+	}
+	return &queries.NotificationPreferencesView{
+		Digest: row.NotificationDigest,
+		Held:   row.Held,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package readstore
+
+import (
+	"context"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type PlanReadQueries interface {
+	GetCompanyPlan(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID) (sqlc.GetCompanyPlanRow, error)
+	CountCompanyResources(ctx context.Context, db sqlc.DBTX, companyID pgtype.UUID) (int64, error)
+	CountResourceCompanyOperators(ctx context.Context, db sqlc.DBTX, arg sqlc.CountResourceCompanyOperatorsParams) (sqlc.CountResourceCompanyOperatorsRow, error)
+}
+
+type PlanReadStore struct {
+	queries PlanReadQueries
+}
+
+func NewPlanReadStore(queries PlanReadQueries) *PlanReadStore {
+	return &PlanReadStore{
+		queries: queries,
+	}
+}
+
+func (r *PlanReadStore) FindCompanyPlan(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID) (*shared.CompanyPlan, error) {
+	row, err := r.queries.GetCompanyPlan(ctx, db, companyID)
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return nil, infra.WrapRepoErr("company has no subscription", err, infra.KindNotFound)
+		}
+		return nil, infra.WrapRepoErr("failed to find company plan", err)
+	}
+	return &shared.CompanyPlan{
+		PlanID:              row.PlanID,
+		Status:              row.Status,
+		MaxResources:        pgconv.Int32PtrFromPgtype(row.MaxResources),
+		MaxOperators:        pgconv.Int32PtrFromPgtype(row.MaxOperators),
+		MonthlyRequestQuota: pgconv.Int64PtrFromPgtype(row.MonthlyRequestQuota),
+	}, nil
+}
+
+func (r *PlanReadStore) CountResources(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID) (int64, error) {
+	count, err := r.queries.CountCompanyResources(ctx, db, pgconv.UUIDToPgtype(companyID))
+	if err != nil {
+		return 0, infra.WrapRepoErr("failed to count company resources", err)
+	}
+	return count, nil
+}
+
+func (r *PlanReadStore) CountOperators(ctx context.Context, db sqlc.DBTX, resourceID, userID uuid.UUID) (*shared.CompanyOperators, error) {
+	row, err := r.queries.CountResourceCompanyOperators(ctx, db, sqlc.CountResourceCompanyOperatorsParams{
+		UserID:     userID,
+		ResourceID: resourceID,
+	})
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return nil, infra.WrapRepoErr("resource not found", err, infra.KindNotFound)
+		}
+		return nil, infra.WrapRepoErr("failed to count company operators", err)
+	}
+	return &shared.CompanyOperators{
+		CompanyID:  pgconv.UUIDPtrFromPgtype(row.CompanyID),
+		Count:      row.OperatorCount,
+		IsOperator: row.IsOperator,
+	}, nil
+}
@@ -0,0 +1,42 @@
+package readstore
+
+import (
+	"context"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/queries"
+)
+
+type PlatformStatsReadQueries interface {
+	GetPlatformStats(ctx context.Context, db sqlc.DBTX) (sqlc.GetPlatformStatsRow, error)
+}
+
+type PlatformStatsReadStore struct {
+	queries PlatformStatsReadQueries
+}
+
+func NewPlatformStatsReadStore(queries PlatformStatsReadQueries) *PlatformStatsReadStore {
+	return &PlatformStatsReadStore{
+		queries: queries,
+	}
+}
+
+func (r *PlatformStatsReadStore) Get(ctx context.Context, db sqlc.DBTX) (*queries.PlatformStats, error) {
+	row, err := r.queries.GetPlatformStats(ctx, db)
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return nil, infra.WrapRepoErr("platform stats not computed", err, infra.KindNotFound)
+		}
+		return nil, infra.WrapRepoErr("failed to get platform stats", err)
+	}
+
+	computedAt := pgconv.TimeFromPgtype(row.ComputedAt)
+	return &queries.PlatformStats{
+		TotalResources: row.TotalResources,
+		TotalReviews:   row.TotalReviews,
+		AverageRating:  row.AverageRating,
+		ComputedAt:     &computedAt,
+	}, nil
+}
@@ -0,0 +1,98 @@
+package readstore
+
+import (
+	"context"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/google/uuid"
+)
+
+type ProvisioningReadQueries interface {
+	FindProvisioningTokenCompany(ctx context.Context, db sqlc.DBTX, tokenHash []byte) (uuid.UUID, error)
+	GetProvisionedUser(ctx context.Context, db sqlc.DBTX, arg sqlc.GetProvisionedUserParams) (sqlc.GetProvisionedUserRow, error)
+	ListProvisionedUsers(ctx context.Context, db sqlc.DBTX, arg sqlc.ListProvisionedUsersParams) ([]sqlc.ListProvisionedUsersRow, error)
+	CountProvisionedUsers(ctx context.Context, db sqlc.DBTX, arg sqlc.CountProvisionedUsersParams) (int64, error)
+}
+
+type ProvisioningReadStore struct {
+	queries ProvisioningReadQueries
+}
+
+func NewProvisioningReadStore(queries ProvisioningReadQueries) *ProvisioningReadStore {
+	return &ProvisioningReadStore{
+		queries: queries,
+	}
+}
+
+func (r *ProvisioningReadStore) FindTokenCompany(ctx context.Context, db sqlc.DBTX, tokenHash []byte) (uuid.UUID, error) {
+	companyID, err := r.queries.FindProvisioningTokenCompany(ctx, db, tokenHash)
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return uuid.Nil, infra.WrapRepoErr("provisioning token not found", err, infra.KindNotFound)
+		}
+		return uuid.Nil, infra.WrapRepoErr("failed to find provisioning token", err)
+	}
+	return companyID, nil
+}
+
+func (r *ProvisioningReadStore) FindUser(ctx context.Context, db sqlc.DBTX, companyID, userID uuid.UUID) (*queries.ProvisionedUser, error) {
+	row, err := r.queries.GetProvisionedUser(ctx, db, sqlc.GetProvisionedUserParams{
+		ID:        userID,
+		CompanyID: pgconv.UUIDToPgtype(companyID),
+	})
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return nil, infra.WrapRepoErr("provisioned user not found", err, infra.KindNotFound)
+		}
+		return nil, infra.WrapRepoErr("failed to get provisioned user", err)
+	}
+	return &queries.ProvisionedUser{
+		ID:         row.ID,
+		Email:      row.Email,
+		ExternalID: row.ExternalID.String,
+		Active:     row.IsActive,
+		CreatedAt:  pgconv.TimeFromPgtype(row.CreatedAt),
+		UpdatedAt:  pgconv.TimeFromPgtype(row.UpdatedAt),
+	}, nil
+}
+
+func (r *ProvisioningReadStore) ListUsers(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID, filter queries.ProvisionedUserFilter, offset, limit int32) ([]*queries.ProvisionedUser, error) {
+	rows, err := r.queries.ListProvisionedUsers(ctx, db, sqlc.ListProvisionedUsersParams{
+		CompanyID:   pgconv.UUIDToPgtype(companyID),
+		Email:       pgconv.StringPtrToPgtype(filter.Email),
+		ExternalID:  pgconv.StringPtrToPgtype(filter.ExternalID),
+		LimitCount:  limit,
+		OffsetCount: offset,
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list provisioned users", err)
+	}
+	users := make([]*queries.ProvisionedUser, len(rows))
+	for i, row := range rows {
+		users[i] = &queries.ProvisionedUser{
+			ID:         row.ID,
+			Email:      row.Email,
+			ExternalID: row.ExternalID.String,
+			Active:     row.IsActive,
+			CreatedAt:  pgconv.TimeFromPgtype(row.CreatedAt),
+			UpdatedAt:  pgconv.TimeFromPgtype(row.UpdatedAt),
+		}
+	}
+	return users, nil
+}
+
+func (r *ProvisioningReadStore) CountUsers(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID, filter queries.ProvisionedUserFilter) (int64, error) {
+	count, err := r.queries.CountProvisionedUsers(ctx, db, sqlc.CountProvisionedUsersParams{
+		CompanyID:  pgconv.UUIDToPgtype(companyID),
+		Email:      pgconv.StringPtrToPgtype(filter.Email),
+		ExternalID: pgconv.StringPtrToPgtype(filter.ExternalID),
+	})
+	if err != nil {
+		return 0, infra.WrapRepoErr("failed to count provisioned users", err)
+	}
+	return count, nil
+}
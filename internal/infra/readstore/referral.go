@@ -0,0 +1,103 @@
+package readstore
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/queries"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+type ReferralReadQueries interface {
+	FindReferrerByCode(ctx context.Context, db sqlc.DBTX, referralCode string) (uuid.UUID, error)
+	ListRewardableReferrals(ctx context.Context, db sqlc.DBTX, arg sqlc.ListRewardableReferralsParams) ([]sqlc.ListRewardableReferralsRow, error)
+	GetReferralSummary(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (sqlc.GetReferralSummaryRow, error)
+	ListReferralsByReferrer(ctx context.Context, db sqlc.DBTX, referrerID uuid.UUID) ([]sqlc.ListReferralsByReferrerRow, error)
+}
+
+type ReferralReadStore struct {
+	queries ReferralReadQueries
+}
+
+func NewReferralReadStore(queries ReferralReadQueries) *ReferralReadStore {
+	return &ReferralReadStore{
+		queries: queries,
+	}
+}
+
+// FindReferrerByCode matches case-insensitively and only returns active users.
+func (r *ReferralReadStore) FindReferrerByCode(ctx context.Context, db sqlc.DBTX, code string) (uuid.UUID, error) {
+	id, err := r.queries.FindReferrerByCode(ctx, db, code)
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return uuid.Nil, infra.WrapRepoErr("referral code not found", err, infra.KindNotFound)
+		}
+		return uuid.Nil, infra.WrapRepoErr("failed to find referrer by code", err)
+	}
+	return id, nil
+}
+
+func (r *ReferralReadStore) ListRewardable(ctx context.Context, db sqlc.DBTX, now time.Time, limit int32) ([]shared.RewardableReferral, error) {
+	rows, err := r.queries.ListRewardableReferrals(ctx, db, sqlc.ListRewardableReferralsParams{
+		Now:       pgconv.TimeToPgtype(now),
+		BatchSize: limit,
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list rewardable referrals", err)
+	}
+
+	result := make([]shared.RewardableReferral, len(rows))
+	for i, row := range rows {
+		result[i] = shared.RewardableReferral{
+			ID:            row.ID,
+			ReferrerID:    row.ReferrerID,
+			ReferredID:    row.ReferredID,
+			ReservationID: row.ReservationID,
+		}
+	}
+	return result, nil
+}
+
+func (r *ReferralReadStore) FindOverview(ctx context.Context, db sqlc.DBTX, userID uuid.UUID) (*queries.ReferralOverview, error) {
+	summary, err := r.queries.GetReferralSummary(ctx, db, userID)
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return nil, infra.WrapRepoErr("user not found", err, infra.KindNotFound)
+		}
+		return nil, infra.WrapRepoErr("failed to get referral summary", err)
+	}
+
+	rows, err := r.queries.ListReferralsByReferrer(ctx, db, userID)
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list referrals", err)
+	}
+
+	referrals := make([]*queries.ReferralView, len(rows))
+	for i, row := range rows {
+		var rewardedAt *time.Time
+		if row.RewardedAt.Valid {
+			t := row.RewardedAt.Time
+			rewardedAt = &t
+		}
+		referrals[i] = &queries.ReferralView{
+			ID:             row.ID,
+			ReferredUserID: row.ReferredID,
+			ReferredEmail:  row.ReferredEmail,
+			Status:         row.Status,
+			RewardCents:    pgconv.Int32PtrFromPgtype(row.RewardCents),
+			CreatedAt:      pgconv.TimeFromPgtype(row.CreatedAt),
+			RewardedAt:     rewardedAt,
+		}
+	}
+
+	return &queries.ReferralOverview{
+		Code:        summary.ReferralCode,
+		CreditCents: summary.CreditCents,
+		Referrals:   referrals,
+	}, nil
+}
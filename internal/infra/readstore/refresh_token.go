@@ -0,0 +1,47 @@
+package readstore
+
+import (
+	"context"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+type RefreshTokenReadQueries interface {
+	IsRefreshTokenSessionRevoked(ctx context.Context, db sqlc.DBTX, sessionID uuid.UUID) (bool, error)
+	IsSessionlessTokenRevoked(ctx context.Context, db sqlc.DBTX, arg sqlc.IsSessionlessTokenRevokedParams) (bool, error)
+}
+
+type RefreshTokenReadStore struct {
+	queries RefreshTokenReadQueries
+}
+
+func NewRefreshTokenReadStore(queries RefreshTokenReadQueries) *RefreshTokenReadStore {
+	return &RefreshTokenReadStore{
+		queries: queries,
+	}
+}
+
+func (r *RefreshTokenReadStore) IsSessionRevoked(ctx context.Context, db sqlc.DBTX, sessionID uuid.UUID) (bool, error) {
+	revoked, err := r.queries.IsRefreshTokenSessionRevoked(ctx, db, sessionID)
+	if err != nil {
+		return false, infra.WrapRepoErr("failed to check session revocation", err)
+	}
+	return revoked, nil
+}
+
+func (r *RefreshTokenReadStore) IsTokenRevoked(ctx context.Context, db sqlc.DBTX, token shared.IssuedToken) (bool, error) {
+	revoked, err := r.queries.IsSessionlessTokenRevoked(ctx, db, sqlc.IsSessionlessTokenRevokedParams{
+		Jti:      token.ID,
+		UserID:   token.UserID,
+		IssuedAt: pgconv.TimeToPgtype(token.IssuedAt),
+	})
+	if err != nil {
+		return false, infra.WrapRepoErr("failed to check token revocation", err)
+	}
+	return revoked, nil
+}
@@ -0,0 +1,113 @@
+package readstore
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/queries"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type RequestRecordingReadQueries interface {
+	GetRequestRecording(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (sqlc.GetRequestRecordingRow, error)
+	ListRecordedRequests(ctx context.Context, db sqlc.DBTX, recordingID uuid.UUID) ([]sqlc.RecordedRequests, error)
+	GetRecordedRequest(ctx context.Context, db sqlc.DBTX, arg sqlc.GetRecordedRequestParams) (sqlc.RecordedRequests, error)
+	ListActiveRequestRecordingUsers(ctx context.Context, db sqlc.DBTX, now pgtype.Timestamptz) ([]uuid.UUID, error)
+	ListExpiredRequestRecordings(ctx context.Context, db sqlc.DBTX, arg sqlc.ListExpiredRequestRecordingsParams) ([]uuid.UUID, error)
+}
+
+type RequestRecordingReadStore struct {
+	queries RequestRecordingReadQueries
+}
+
+func NewRequestRecordingReadStore(queries RequestRecordingReadQueries) *RequestRecordingReadStore {
+	return &RequestRecordingReadStore{
+		queries: queries,
+	}
+}
+
+func (r *RequestRecordingReadStore) FindByID(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (*queries.RequestRecordingDetail, error) {
+	row, err := r.queries.GetRequestRecording(ctx, db, id)
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return nil, infra.WrapRepoErr("request recording not found", err, infra.KindNotFound)
+		}
+		return nil, infra.WrapRepoErr("failed to find request recording", err)
+	}
+	return &queries.RequestRecordingDetail{
+		ID:               row.ID,
+		UserID:           row.UserID,
+		UserEmail:        row.Email,
+		EnabledBy:        pgconv.UUIDPtrFromPgtype(row.EnabledBy),
+		Reason:           row.Reason,
+		ConsentReference: row.ConsentReference,
+		MaxRequests:      row.MaxRequests,
+		RecordedCount:    row.RecordedCount,
+		ExpiresAt:        pgconv.TimeFromPgtype(row.ExpiresAt),
+		CreatedAt:        pgconv.TimeFromPgtype(row.CreatedAt),
+	}, nil
+}
+
+func (r *RequestRecordingReadStore) ListCaptures(ctx context.Context, db sqlc.DBTX, id uuid.UUID) ([]*shared.RecordedRequest, error) {
+	rows, err := r.queries.ListRecordedRequests(ctx, db, id)
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list recorded requests", err)
+	}
+	captures := make([]*shared.RecordedRequest, 0, len(rows))
+	for _, row := range rows {
+		captures = append(captures, toRecordedRequest(row))
+	}
+	return captures, nil
+}
+
+func (r *RequestRecordingReadStore) FindCapture(ctx context.Context, db sqlc.DBTX, id uuid.UUID, seq int32) (*shared.RecordedRequest, error) {
+	row, err := r.queries.GetRecordedRequest(ctx, db, sqlc.GetRecordedRequestParams{
+		RecordingID: id,
+		Seq:         seq,
+	})
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return nil, infra.WrapRepoErr("recorded request not found", err, infra.KindNotFound)
+		}
+		return nil, infra.WrapRepoErr("failed to find recorded request", err)
+	}
+	return toRecordedRequest(row), nil
+}
+
+func (r *RequestRecordingReadStore) ListActiveUsers(ctx context.Context, db sqlc.DBTX, now time.Time) ([]uuid.UUID, error) {
+	users, err := r.queries.ListActiveRequestRecordingUsers(ctx, db, pgconv.TimeToPgtype(now))
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list recorded users", err)
+	}
+	return users, nil
+}
+
+func (r *RequestRecordingReadStore) ListExpired(ctx context.Context, db sqlc.DBTX, now time.Time, limit int32) ([]uuid.UUID, error) {
+	ids, err := r.queries.ListExpiredRequestRecordings(ctx, db, sqlc.ListExpiredRequestRecordingsParams{
+		Now:       pgconv.TimeToPgtype(now),
+		BatchSize: limit,
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list expired request recordings", err)
+	}
+	return ids, nil
+}
+
+func toRecordedRequest(row sqlc.RecordedRequests) *shared.RecordedRequest {
+	return &shared.RecordedRequest{
+		RecordingID: row.RecordingID,
+		Seq:         row.Seq,
+		Method:      row.Method,
+		Path:        row.Path,
+		Status:      row.Status,
+		DurationMs:  row.DurationMs,
+		StorageKey:  row.StorageKey,
+		RecordedAt:  pgconv.TimeFromPgtype(row.RecordedAt),
+	}
+}
@@ -2,6 +2,7 @@ package readstore
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"strings"
@@ -14,12 +15,19 @@ import (
 	"gin-clean-starter/internal/usecase/shared"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type ReservationViewQueries interface {
 	GetReservationByID(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (sqlc.GetReservationByIDRow, error)
+	GetReservationDiscounts(ctx context.Context, db sqlc.DBTX, reservationID uuid.UUID) ([]sqlc.GetReservationDiscountsRow, error)
 	GetReservationsByUserIDFirstPage(ctx context.Context, db sqlc.DBTX, arg sqlc.GetReservationsByUserIDFirstPageParams) ([]sqlc.GetReservationsByUserIDFirstPageRow, error)
 	GetReservationsByUserIDKeyset(ctx context.Context, db sqlc.DBTX, arg sqlc.GetReservationsByUserIDKeysetParams) ([]sqlc.GetReservationsByUserIDKeysetRow, error)
+	GetReservationsForAdminFirstPage(ctx context.Context, db sqlc.DBTX, arg sqlc.GetReservationsForAdminFirstPageParams) ([]sqlc.GetReservationsForAdminFirstPageRow, error)
+	GetReservationsForAdminKeyset(ctx context.Context, db sqlc.DBTX, arg sqlc.GetReservationsForAdminKeysetParams) ([]sqlc.GetReservationsForAdminKeysetRow, error)
+	GetReservationGroupID(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (pgtype.UUID, error)
+	GetReservationIDsByGroup(ctx context.Context, db sqlc.DBTX, arg sqlc.GetReservationIDsByGroupParams) ([]uuid.UUID, error)
+	ListCancelableReservationsInRange(ctx context.Context, db sqlc.DBTX, arg sqlc.ListCancelableReservationsInRangeParams) ([]sqlc.ListCancelableReservationsInRangeRow, error)
 }
 
 type ReservationReadStore struct {
@@ -41,24 +49,35 @@ func (r *ReservationReadStore) FindByID(ctx context.Context, db sqlc.DBTX, id uu
 		return nil, infra.WrapRepoErr("failed to find reservation by ID", err)
 	}
 
-	return rowToReservationView(row), nil
+	discounts, err := r.queries.GetReservationDiscounts(ctx, db, id)
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to find reservation discounts", err)
+	}
+
+	view := rowToReservationView(row)
+	view.Discounts = make([]queries.ReservationDiscountView, len(discounts))
+	for i, d := range discounts {
+		view.Discounts[i] = queries.ReservationDiscountView{CouponID: d.CouponID, CouponCode: d.Code, AmountCents: d.AmountCents}
+	}
+	return view, nil
 }
 
 func rowToReservationView(row sqlc.GetReservationByIDRow) *queries.ReservationView {
 	return &queries.ReservationView{
-		ID:           row.ID,
-		ResourceID:   row.ResourceID,
-		ResourceName: row.ResourceName,
-		UserID:       row.UserID,
-		UserEmail:    row.UserEmail,
-		Slot:         formatTstzrangeToISO8601(row.RSlot),
-		Status:       row.Status,
-		PriceCents:   row.PriceCents,
-		CouponID:     pgconv.UUIDPtrFromPgtype(row.CouponID),
-		CouponCode:   pgconv.StringPtrFromPgtype(row.CouponCode),
-		Note:         pgconv.StringPtrFromPgtype(row.Note),
-		CreatedAt:    pgconv.TimeFromPgtype(row.CreatedAt),
-		UpdatedAt:    pgconv.TimeFromPgtype(row.UpdatedAt),
+		ID:                row.ID,
+		ResourceID:        row.ResourceID,
+		ResourceName:      row.ResourceName,
+		UserID:            row.UserID,
+		UserEmail:         row.UserEmail,
+		Slot:              formatTstzrangeToISO8601(row.RSlot),
+		Status:            row.Status,
+		PriceCents:        row.PriceCents,
+		CouponID:          pgconv.UUIDPtrFromPgtype(row.CouponID),
+		CouponCode:        pgconv.StringPtrFromPgtype(row.CouponCode),
+		CreatedAt:         pgconv.TimeFromPgtype(row.CreatedAt),
+		UpdatedAt:         pgconv.TimeFromPgtype(row.UpdatedAt),
+		ResourceCompanyID: pgconv.UUIDPtrFromPgtype(row.ResourceCompanyID),
+		CustomFields:      decodeCustomFields(row.CustomFields),
 	}
 }
 
@@ -102,6 +121,52 @@ func (r *ReservationReadStore) FindByUserIDKeyset(ctx context.Context, db sqlc.D
 	return result, nil
 }
 
+func (r *ReservationReadStore) FindForAdminFirstPage(ctx context.Context, db sqlc.DBTX, filter queries.AdminReservationFilter, limit int32) ([]*queries.AdminReservationListItem, error) {
+	params := sqlc.GetReservationsForAdminFirstPageParams{
+		Limit:        limit,
+		ResourceID:   pgconv.UUIDPtrToPgtype(filter.ResourceID),
+		OperatorID:   pgconv.UUIDPtrToPgtype(filter.OperatorID),
+		CompanyID:    pgconv.UUIDPtrToPgtype(filter.CompanyID),
+		CustomFields: encodeCustomFieldFilter(filter.CustomFields),
+	}
+
+	rows, err := r.queries.GetReservationsForAdminFirstPage(ctx, db, params)
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to find admin reservations first page", err)
+	}
+
+	result := make([]*queries.AdminReservationListItem, len(rows))
+	for i, row := range rows {
+		result[i] = toAdminReservationListItemFromFirstPageRow(row)
+	}
+
+	return result, nil
+}
+
+func (r *ReservationReadStore) FindForAdminKeyset(ctx context.Context, db sqlc.DBTX, filter queries.AdminReservationFilter, lastCreatedAt time.Time, lastID uuid.UUID, limit int32) ([]*queries.AdminReservationListItem, error) {
+	params := sqlc.GetReservationsForAdminKeysetParams{
+		CreatedAt:    pgconv.TimeToPgtype(lastCreatedAt),
+		ID:           lastID,
+		Limit:        limit,
+		ResourceID:   pgconv.UUIDPtrToPgtype(filter.ResourceID),
+		OperatorID:   pgconv.UUIDPtrToPgtype(filter.OperatorID),
+		CompanyID:    pgconv.UUIDPtrToPgtype(filter.CompanyID),
+		CustomFields: encodeCustomFieldFilter(filter.CustomFields),
+	}
+
+	rows, err := r.queries.GetReservationsForAdminKeyset(ctx, db, params)
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to find admin reservations keyset", err)
+	}
+
+	result := make([]*queries.AdminReservationListItem, len(rows))
+	for i, row := range rows {
+		result[i] = toAdminReservationListItemFromKeysetRow(row)
+	}
+
+	return result, nil
+}
+
 func (r *ReservationReadStore) FindSnapshotByID(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (*shared.ReservationSnapshot, error) {
 	row, err := r.queries.GetReservationByID(ctx, db, id)
 	if err != nil {
@@ -121,6 +186,47 @@ func (r *ReservationReadStore) FindSnapshotByID(ctx context.Context, db sqlc.DBT
 	return snap, nil
 }
 
+func (r *ReservationReadStore) FindGroupID(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (*uuid.UUID, error) {
+	groupID, err := r.queries.GetReservationGroupID(ctx, db, id)
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return nil, infra.WrapRepoErr("reservation not found", err, infra.KindNotFound)
+		}
+		return nil, infra.WrapRepoErr("failed to find reservation group", err)
+	}
+	return pgconv.UUIDPtrFromPgtype(groupID), nil
+}
+
+func (r *ReservationReadStore) ListCancelableInRange(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID, window shared.TimeRange, now time.Time) ([]shared.ReservationSlot, error) {
+	rows, err := r.queries.ListCancelableReservationsInRange(ctx, db, sqlc.ListCancelableReservationsInRangeParams{
+		ResourceID: resourceID,
+		FromTime:   pgconv.TimeToPgtype(window.Start),
+		ToTime:     pgconv.TimeToPgtype(window.End),
+		Now:        pgconv.TimeToPgtype(now),
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list cancelable reservations", err)
+	}
+
+	result := make([]shared.ReservationSlot, len(rows))
+	for i, row := range rows {
+		result[i] = shared.ReservationSlot{ID: row.ID, UserID: row.UserID, StartTime: row.StartTime.Time, EndTime: row.EndTime.Time}
+	}
+	return result, nil
+}
+
+// FindIDsByGroup returns the user's reservations in the group, earliest slot first.
+func (r *ReservationReadStore) FindIDsByGroup(ctx context.Context, db sqlc.DBTX, groupID, userID uuid.UUID) ([]uuid.UUID, error) {
+	ids, err := r.queries.GetReservationIDsByGroup(ctx, db, sqlc.GetReservationIDsByGroupParams{
+		GroupID: pgconv.UUIDToPgtype(groupID),
+		UserID:  userID,
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to find reservations by group", err)
+	}
+	return ids, nil
+}
+
 func parseSlotEndTime(slot string) time.Time {
 	parts := strings.Split(slot, "/")
 	if len(parts) != 2 {
@@ -157,6 +263,53 @@ func toReservationListItemFromUserKeysetRow(row sqlc.GetReservationsByUserIDKeys
 	}
 }
 
+func toAdminReservationListItemFromFirstPageRow(row sqlc.GetReservationsForAdminFirstPageRow) *queries.AdminReservationListItem {
+	return &queries.AdminReservationListItem{
+		ID:           row.ID,
+		ResourceID:   row.ResourceID,
+		ResourceName: row.ResourceName,
+		UserID:       row.UserID,
+		UserEmail:    row.UserEmail,
+		Slot:         formatTstzrangeToISO8601(row.RSlot),
+		Status:       row.Status,
+		PriceCents:   row.PriceCents,
+		CreatedAt:    pgconv.TimeFromPgtype(row.CreatedAt),
+		CustomFields: decodeCustomFields(row.CustomFields),
+	}
+}
+
+func toAdminReservationListItemFromKeysetRow(row sqlc.GetReservationsForAdminKeysetRow) *queries.AdminReservationListItem {
+	return &queries.AdminReservationListItem{
+		ID:           row.ID,
+		ResourceID:   row.ResourceID,
+		ResourceName: row.ResourceName,
+		UserID:       row.UserID,
+		UserEmail:    row.UserEmail,
+		Slot:         formatTstzrangeToISO8601(row.RSlot),
+		Status:       row.Status,
+		PriceCents:   row.PriceCents,
+		CreatedAt:    pgconv.TimeFromPgtype(row.CreatedAt),
+		CustomFields: decodeCustomFields(row.CustomFields),
+	}
+}
+
+// decodeCustomFields reads a reservation's custom_fields column. It is a JSONB object, so it
+// always decodes.
+func decodeCustomFields(raw []byte) map[string]any {
+	fields := map[string]any{}
+	_ = json.Unmarshal(raw, &fields)
+	return fields
+}
+
+// encodeCustomFieldFilter returns nil, which disables the filter, when fields is empty.
+func encodeCustomFieldFilter(fields map[string]string) []byte {
+	if len(fields) == 0 {
+		return nil
+	}
+	encoded, _ := json.Marshal(fields) // a map of strings always encodes
+	return encoded
+}
+
 func formatTstzrangeToISO8601(tstzrange string) string {
 	cleaned := strings.Trim(tstzrange, "[]()")
 
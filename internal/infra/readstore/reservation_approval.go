@@ -0,0 +1,138 @@
+package readstore
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/queries"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+type ReservationApprovalReadQueries interface {
+	GetReservationApproval(ctx context.Context, db sqlc.DBTX, reservationID uuid.UUID) (sqlc.GetReservationApprovalRow, error)
+	IsResourceApprover(ctx context.Context, db sqlc.DBTX, arg sqlc.IsResourceApproverParams) (bool, error)
+	ListPendingReservationApprovals(ctx context.Context, db sqlc.DBTX, arg sqlc.ListPendingReservationApprovalsParams) ([]sqlc.ListPendingReservationApprovalsRow, error)
+	ListReservationApprovalsToEscalate(ctx context.Context, db sqlc.DBTX, arg sqlc.ListReservationApprovalsToEscalateParams) ([]sqlc.ListReservationApprovalsToEscalateRow, error)
+	ListResourceApproverIDs(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID) ([]uuid.UUID, error)
+	ListResourceApprovers(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID) ([]sqlc.ListResourceApproversRow, error)
+}
+
+type ReservationApprovalReadStore struct {
+	queries ReservationApprovalReadQueries
+}
+
+func NewReservationApprovalReadStore(queries ReservationApprovalReadQueries) *ReservationApprovalReadStore {
+	return &ReservationApprovalReadStore{
+		queries: queries,
+	}
+}
+
+func (r *ReservationApprovalReadStore) ApproverIDs(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID) ([]uuid.UUID, error) {
+	ids, err := r.queries.ListResourceApproverIDs(ctx, db, resourceID)
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list resource approver IDs", err)
+	}
+	return ids, nil
+}
+
+func (r *ReservationApprovalReadStore) IsApprover(ctx context.Context, db sqlc.DBTX, resourceID, userID uuid.UUID) (bool, error) {
+	approver, err := r.queries.IsResourceApprover(ctx, db, sqlc.IsResourceApproverParams{
+		ResourceID: resourceID,
+		UserID:     userID,
+	})
+	if err != nil {
+		return false, infra.WrapRepoErr("failed to check resource approver", err)
+	}
+	return approver, nil
+}
+
+func (r *ReservationApprovalReadStore) FindApproval(ctx context.Context, db sqlc.DBTX, reservationID uuid.UUID) (*shared.ReservationApproval, error) {
+	row, err := r.queries.GetReservationApproval(ctx, db, reservationID)
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return nil, infra.WrapRepoErr("reservation approval not found", err, infra.KindNotFound)
+		}
+		return nil, infra.WrapRepoErr("failed to find reservation approval", err)
+	}
+
+	return &shared.ReservationApproval{
+		ReservationID: row.ReservationID,
+		Status:        row.Status,
+		ExpiresAt:     pgconv.TimeFromPgtype(row.ExpiresAt),
+		EscalatedAt:   timePtrFromPgtype(row.EscalatedAt),
+	}, nil
+}
+
+func (r *ReservationApprovalReadStore) ListToEscalate(ctx context.Context, db sqlc.DBTX, waitedSince, now time.Time, limit int32) ([]shared.ApprovalEscalation, error) {
+	rows, err := r.queries.ListReservationApprovalsToEscalate(ctx, db, sqlc.ListReservationApprovalsToEscalateParams{
+		WaitedSince: pgconv.TimeToPgtype(waitedSince),
+		Now:         pgconv.TimeToPgtype(now),
+		LimitCount:  limit,
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list reservation approvals to escalate", err)
+	}
+
+	result := make([]shared.ApprovalEscalation, len(rows))
+	for i, row := range rows {
+		result[i] = shared.ApprovalEscalation{
+			ReservationID: row.ReservationID,
+			ResourceID:    row.ResourceID,
+			UserID:        row.UserID,
+			CompanyID:     pgconv.UUIDPtrFromPgtype(row.CompanyID),
+			ExpiresAt:     pgconv.TimeFromPgtype(row.ExpiresAt),
+		}
+	}
+	return result, nil
+}
+
+func (r *ReservationApprovalReadStore) ListApprovers(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID) ([]*queries.ResourceApproverView, error) {
+	rows, err := r.queries.ListResourceApprovers(ctx, db, resourceID)
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list resource approvers", err)
+	}
+
+	result := make([]*queries.ResourceApproverView, len(rows))
+	for i, row := range rows {
+		result[i] = &queries.ResourceApproverView{
+			UserID:     row.UserID,
+			Email:      row.Email,
+			Role:       row.Role,
+			AssignedAt: pgconv.TimeFromPgtype(row.CreatedAt),
+		}
+	}
+	return result, nil
+}
+
+func (r *ReservationApprovalReadStore) ListPending(ctx context.Context, db sqlc.DBTX, approverID uuid.UUID, allResources bool, limit int32) ([]*queries.PendingApprovalView, error) {
+	rows, err := r.queries.ListPendingReservationApprovals(ctx, db, sqlc.ListPendingReservationApprovalsParams{
+		AllResources: allResources,
+		ApproverID:   approverID,
+		LimitCount:   limit,
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list pending reservation approvals", err)
+	}
+
+	result := make([]*queries.PendingApprovalView, len(rows))
+	for i, row := range rows {
+		result[i] = &queries.PendingApprovalView{
+			ReservationID: row.ReservationID,
+			ResourceID:    row.ResourceID,
+			ResourceName:  row.ResourceName,
+			UserID:        row.UserID,
+			UserEmail:     row.UserEmail,
+			StartTime:     pgconv.TimeFromPgtype(row.StartTime),
+			EndTime:       pgconv.TimeFromPgtype(row.EndTime),
+			ExpiresAt:     pgconv.TimeFromPgtype(row.ExpiresAt),
+			EscalatedAt:   timePtrFromPgtype(row.EscalatedAt),
+			RequestedAt:   pgconv.TimeFromPgtype(row.CreatedAt),
+		}
+	}
+	return result, nil
+}
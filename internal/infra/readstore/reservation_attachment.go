@@ -0,0 +1,72 @@
+package readstore
+
+import (
+	"context"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+type ReservationAttachmentReadQueries interface {
+	GetReservationAttachment(ctx context.Context, db sqlc.DBTX, arg sqlc.GetReservationAttachmentParams) (sqlc.ReservationAttachments, error)
+	ListReservationAttachments(ctx context.Context, db sqlc.DBTX, arg sqlc.ListReservationAttachmentsParams) ([]sqlc.ReservationAttachments, error)
+}
+
+type ReservationAttachmentReadStore struct {
+	queries ReservationAttachmentReadQueries
+}
+
+func NewReservationAttachmentReadStore(queries ReservationAttachmentReadQueries) *ReservationAttachmentReadStore {
+	return &ReservationAttachmentReadStore{
+		queries: queries,
+	}
+}
+
+func (r *ReservationAttachmentReadStore) FindByID(ctx context.Context, db sqlc.DBTX, reservationID, id uuid.UUID) (*shared.ReservationAttachment, error) {
+	row, err := r.queries.GetReservationAttachment(ctx, db, sqlc.GetReservationAttachmentParams{
+		ID:            id,
+		ReservationID: reservationID,
+	})
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return nil, infra.WrapRepoErr("reservation attachment not found", err, infra.KindNotFound)
+		}
+		return nil, infra.WrapRepoErr("failed to find reservation attachment", err)
+	}
+	return toReservationAttachment(row), nil
+}
+
+func (r *ReservationAttachmentReadStore) FindByReservation(ctx context.Context, db sqlc.DBTX, reservationID uuid.UUID, includeOperatorOnly bool) ([]*shared.ReservationAttachment, error) {
+	rows, err := r.queries.ListReservationAttachments(ctx, db, sqlc.ListReservationAttachmentsParams{
+		ReservationID:       reservationID,
+		IncludeOperatorOnly: includeOperatorOnly,
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list reservation attachments", err)
+	}
+
+	result := make([]*shared.ReservationAttachment, len(rows))
+	for i, row := range rows {
+		result[i] = toReservationAttachment(row)
+	}
+	return result, nil
+}
+
+func toReservationAttachment(row sqlc.ReservationAttachments) *shared.ReservationAttachment {
+	return &shared.ReservationAttachment{
+		ID:            row.ID,
+		ReservationID: row.ReservationID,
+		UploadedBy:    row.UploadedBy,
+		UploaderSide:  row.UploaderSide,
+		Filename:      row.Filename,
+		ContentType:   row.ContentType,
+		SizeBytes:     row.SizeBytes,
+		StorageKey:    row.StorageKey,
+		OperatorOnly:  row.OperatorOnly,
+		CreatedAt:     row.CreatedAt.Time,
+	}
+}
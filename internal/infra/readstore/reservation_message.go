@@ -0,0 +1,101 @@
+package readstore
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/crypto"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/google/uuid"
+)
+
+type ReservationMessageReadQueries interface {
+	ListReservationMessagesFirstPage(ctx context.Context, db sqlc.DBTX, arg sqlc.ListReservationMessagesFirstPageParams) ([]sqlc.ListReservationMessagesFirstPageRow, error)
+	ListReservationMessagesKeyset(ctx context.Context, db sqlc.DBTX, arg sqlc.ListReservationMessagesKeysetParams) ([]sqlc.ListReservationMessagesKeysetRow, error)
+	CountUnreadReservationMessages(ctx context.Context, db sqlc.DBTX, arg sqlc.CountUnreadReservationMessagesParams) (int64, error)
+}
+
+type ReservationMessageReadStore struct {
+	queries  ReservationMessageReadQueries
+	envelope *crypto.Envelope
+}
+
+func NewReservationMessageReadStore(queries ReservationMessageReadQueries, envelope *crypto.Envelope) *ReservationMessageReadStore {
+	return &ReservationMessageReadStore{
+		queries:  queries,
+		envelope: envelope,
+	}
+}
+
+func (r *ReservationMessageReadStore) FindFirstPage(ctx context.Context, db sqlc.DBTX, reservationID uuid.UUID, limit int32) ([]*queries.ReservationMessageView, error) {
+	rows, err := r.queries.ListReservationMessagesFirstPage(ctx, db, sqlc.ListReservationMessagesFirstPageParams{
+		ReservationID: reservationID,
+		LimitCount:    limit,
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list reservation messages first page", err)
+	}
+
+	result := make([]*queries.ReservationMessageView, len(rows))
+	for i, row := range rows {
+		if result[i], err = r.toView(sqlc.ListReservationMessagesKeysetRow(row)); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (r *ReservationMessageReadStore) FindKeyset(ctx context.Context, db sqlc.DBTX, reservationID uuid.UUID, lastCreatedAt time.Time, lastID uuid.UUID, limit int32) ([]*queries.ReservationMessageView, error) {
+	rows, err := r.queries.ListReservationMessagesKeyset(ctx, db, sqlc.ListReservationMessagesKeysetParams{
+		ReservationID: reservationID,
+		LastCreatedAt: pgconv.TimeToPgtype(lastCreatedAt),
+		LastID:        lastID,
+		LimitCount:    limit,
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list reservation messages keyset", err)
+	}
+
+	result := make([]*queries.ReservationMessageView, len(rows))
+	for i, row := range rows {
+		if result[i], err = r.toView(row); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (r *ReservationMessageReadStore) CountUnread(ctx context.Context, db sqlc.DBTX, reservationID uuid.UUID, side string) (int64, error) {
+	count, err := r.queries.CountUnreadReservationMessages(ctx, db, sqlc.CountUnreadReservationMessagesParams{
+		ReservationID: reservationID,
+		ReaderSide:    side,
+	})
+	if err != nil {
+		return 0, infra.WrapRepoErr("failed to count unread reservation messages", err)
+	}
+	return count, nil
+}
+
+// toView prefers the ciphertext column; rows written without a configured key only
+// have the plaintext one.
+func (r *ReservationMessageReadStore) toView(row sqlc.ListReservationMessagesKeysetRow) (*queries.ReservationMessageView, error) {
+	body := row.Body.String
+	if row.BodyCiphertext.Valid {
+		var err error
+		body, err = r.envelope.DecryptString(row.BodyCiphertext.String, infra.ReservationMessageAAD(row.AuthorID))
+		if err != nil {
+			return nil, infra.WrapRepoErr("failed to decrypt reservation message", err)
+		}
+	}
+	return &queries.ReservationMessageView{
+		ID:         row.ID,
+		AuthorID:   row.AuthorID,
+		AuthorSide: row.AuthorSide,
+		Body:       body,
+		CreatedAt:  pgconv.TimeFromPgtype(row.CreatedAt),
+	}, nil
+}
@@ -0,0 +1,46 @@
+package readstore
+
+import (
+	"context"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+type ReservationTransferReadQueries interface {
+	GetReservationTransferByID(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (sqlc.ReservationTransfers, error)
+}
+
+type ReservationTransferReadStore struct {
+	queries ReservationTransferReadQueries
+}
+
+func NewReservationTransferReadStore(queries ReservationTransferReadQueries) *ReservationTransferReadStore {
+	return &ReservationTransferReadStore{
+		queries: queries,
+	}
+}
+
+func (r *ReservationTransferReadStore) FindSnapshotByID(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (*shared.ReservationTransferSnapshot, error) {
+	row, err := r.queries.GetReservationTransferByID(ctx, db, id)
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return nil, infra.WrapRepoErr("reservation transfer not found", err, infra.KindNotFound)
+		}
+		return nil, infra.WrapRepoErr("failed to find reservation transfer by ID", err)
+	}
+
+	return &shared.ReservationTransferSnapshot{
+		ID:            row.ID,
+		ReservationID: row.ReservationID,
+		FromUserID:    row.FromUserID,
+		ToUserID:      row.ToUserID,
+		Nonce:         row.Nonce,
+		Status:        row.Status,
+		ExpiresAt:     pgconv.TimeFromPgtype(row.ExpiresAt),
+	}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"gin-clean-starter/internal/infra"
 	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
 	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/queries"
 	"gin-clean-starter/internal/usecase/shared"
 
 	"github.com/google/uuid"
@@ -15,6 +16,8 @@ import (
 type ResourceReadQueries interface {
 	GetAllResources(ctx context.Context, db sqlc.DBTX) ([]sqlc.Resources, error)
 	GetResourceByID(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (sqlc.Resources, error)
+	GetPublicResourceBySlug(ctx context.Context, db sqlc.DBTX, slug string) (sqlc.GetPublicResourceBySlugRow, error)
+	ListPublicResourceSlugs(ctx context.Context, db sqlc.DBTX, maxUrls int32) ([]sqlc.ListPublicResourceSlugsRow, error)
 	SearchResourcesByName(ctx context.Context, db sqlc.DBTX, name pgtype.Text) ([]sqlc.Resources, error)
 }
 
@@ -69,6 +72,43 @@ func (r *ResourceReadStore) SearchByName(ctx context.Context, db sqlc.DBTX, name
 	return result, nil
 }
 
+func (r *ResourceReadStore) FindPublicBySlug(ctx context.Context, db sqlc.DBTX, slug string) (*queries.PublicResourcePage, error) {
+	row, err := r.queries.GetPublicResourceBySlug(ctx, db, slug)
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return nil, infra.WrapRepoErr("resource not found", err, infra.KindNotFound)
+		}
+		return nil, infra.WrapRepoErr("failed to find public resource by slug", err)
+	}
+
+	return &queries.PublicResourcePage{
+		Slug:          row.Slug,
+		Name:          row.Name,
+		CompanyName:   pgconv.StringPtrFromPgtype(row.CompanyName),
+		LeadTimeMin:   int(row.LeadTimeMin),
+		TotalReviews:  row.TotalReviews,
+		AverageRating: row.AverageRating,
+		LastModified:  pgconv.TimeFromPgtype(row.LastModified),
+	}, nil
+}
+
+func (r *ResourceReadStore) ListPublic(ctx context.Context, db sqlc.DBTX, limit int32) ([]*queries.PublicResourceLink, error) {
+	rows, err := r.queries.ListPublicResourceSlugs(ctx, db, limit)
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list public resources", err)
+	}
+
+	result := make([]*queries.PublicResourceLink, len(rows))
+	for i, row := range rows {
+		result[i] = &queries.PublicResourceLink{
+			Slug:         row.Slug,
+			LastModified: pgconv.TimeFromPgtype(row.LastModified),
+		}
+	}
+
+	return result, nil
+}
+
 func toResourceSnapshotFromRow(row sqlc.Resources) *shared.ResourceSnapshot {
 	return &shared.ResourceSnapshot{
 		ID:          row.ID,
@@ -0,0 +1,75 @@
+package readstore
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/google/uuid"
+)
+
+type ResourceBlockReadQueries interface {
+	ListResourceBlocks(ctx context.Context, db sqlc.DBTX, arg sqlc.ListResourceBlocksParams) ([]sqlc.ListResourceBlocksRow, error)
+	ListResourceBusySlots(ctx context.Context, db sqlc.DBTX, arg sqlc.ListResourceBusySlotsParams) ([]sqlc.ListResourceBusySlotsRow, error)
+}
+
+type ResourceBlockReadStore struct {
+	queries ResourceBlockReadQueries
+}
+
+func NewResourceBlockReadStore(queries ResourceBlockReadQueries) *ResourceBlockReadStore {
+	return &ResourceBlockReadStore{
+		queries: queries,
+	}
+}
+
+func (r *ResourceBlockReadStore) List(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID, from, to time.Time, limit int32) ([]*queries.ResourceBlockView, error) {
+	rows, err := r.queries.ListResourceBlocks(ctx, db, sqlc.ListResourceBlocksParams{
+		ResourceID: resourceID,
+		FromTime:   pgconv.TimeToPgtype(from),
+		ToTime:     pgconv.TimeToPgtype(to),
+		MaxRows:    limit,
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list resource blocks", err)
+	}
+
+	result := make([]*queries.ResourceBlockView, len(rows))
+	for i, row := range rows {
+		result[i] = &queries.ResourceBlockView{
+			ID:        row.ID,
+			SeriesID:  row.SeriesID,
+			StartTime: pgconv.TimeFromPgtype(row.StartTime),
+			EndTime:   pgconv.TimeFromPgtype(row.EndTime),
+			Reason:    row.Reason,
+			CreatedBy: row.CreatedBy,
+			CreatedAt: pgconv.TimeFromPgtype(row.CreatedAt),
+		}
+	}
+	return result, nil
+}
+
+func (r *ResourceBlockReadStore) BusySlots(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID, from, to time.Time) ([]*queries.BusySlot, error) {
+	rows, err := r.queries.ListResourceBusySlots(ctx, db, sqlc.ListResourceBusySlotsParams{
+		ResourceID: resourceID,
+		FromTime:   pgconv.TimeToPgtype(from),
+		ToTime:     pgconv.TimeToPgtype(to),
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list resource busy slots", err)
+	}
+
+	result := make([]*queries.BusySlot, len(rows))
+	for i, row := range rows {
+		result[i] = &queries.BusySlot{
+			StartTime: pgconv.TimeFromPgtype(row.StartTime),
+			EndTime:   pgconv.TimeFromPgtype(row.EndTime),
+			Kind:      row.Kind,
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,56 @@
+package readstore
+
+import (
+	"context"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/google/uuid"
+)
+
+type ResourceOperatorReadQueries interface {
+	IsResourceOperator(ctx context.Context, db sqlc.DBTX, arg sqlc.IsResourceOperatorParams) (bool, error)
+	ListResourceOperators(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID) ([]sqlc.ListResourceOperatorsRow, error)
+}
+
+type ResourceOperatorReadStore struct {
+	queries ResourceOperatorReadQueries
+}
+
+func NewResourceOperatorReadStore(queries ResourceOperatorReadQueries) *ResourceOperatorReadStore {
+	return &ResourceOperatorReadStore{
+		queries: queries,
+	}
+}
+
+func (r *ResourceOperatorReadStore) IsAssigned(ctx context.Context, db sqlc.DBTX, resourceID, userID uuid.UUID) (bool, error) {
+	assigned, err := r.queries.IsResourceOperator(ctx, db, sqlc.IsResourceOperatorParams{
+		ResourceID: resourceID,
+		UserID:     userID,
+	})
+	if err != nil {
+		return false, infra.WrapRepoErr("failed to check resource operator", err)
+	}
+	return assigned, nil
+}
+
+func (r *ResourceOperatorReadStore) ListByResource(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID) ([]*queries.ResourceOperatorView, error) {
+	rows, err := r.queries.ListResourceOperators(ctx, db, resourceID)
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list resource operators", err)
+	}
+
+	result := make([]*queries.ResourceOperatorView, len(rows))
+	for i, row := range rows {
+		result[i] = &queries.ResourceOperatorView{
+			UserID:     row.UserID,
+			Email:      row.Email,
+			Role:       row.Role,
+			AssignedAt: pgconv.TimeFromPgtype(row.CreatedAt),
+		}
+	}
+	return result, nil
+}
@@ -21,6 +21,15 @@ type ReviewReadQueries interface {
 	GetReviewsByUserFirstPage(ctx context.Context, db sqlc.DBTX, arg sqlc.GetReviewsByUserFirstPageParams) ([]sqlc.GetReviewsByUserFirstPageRow, error)
 	GetReviewsByUserKeyset(ctx context.Context, db sqlc.DBTX, arg sqlc.GetReviewsByUserKeysetParams) ([]sqlc.GetReviewsByUserKeysetRow, error)
 	GetResourceRatingStats(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID) (sqlc.ResourceRatingStats, error)
+	CountReviewsByLanguage(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID) ([]sqlc.CountReviewsByLanguageRow, error)
+	ListStaleReviewSummaries(ctx context.Context, db sqlc.DBTX, limit int32) ([]sqlc.ListStaleReviewSummariesRow, error)
+	ListRecentReviewsForSummary(ctx context.Context, db sqlc.DBTX, arg sqlc.ListRecentReviewsForSummaryParams) ([]sqlc.ListRecentReviewsForSummaryRow, error)
+	FindSimilarRecentReview(ctx context.Context, db sqlc.DBTX, arg sqlc.FindSimilarRecentReviewParams) (uuid.UUID, error)
+	ListFlaggedReviewsByResource(ctx context.Context, db sqlc.DBTX, arg sqlc.ListFlaggedReviewsByResourceParams) ([]sqlc.ListFlaggedReviewsByResourceRow, error)
+	ListReviewsForExport(ctx context.Context, db sqlc.DBTX, arg sqlc.ListReviewsForExportParams) ([]sqlc.ListReviewsForExportRow, error)
+	ListRecentPublishedReviews(ctx context.Context, db sqlc.DBTX, arg sqlc.ListRecentPublishedReviewsParams) ([]sqlc.ListRecentPublishedReviewsRow, error)
+	GetResourceReviewWindow(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (sqlc.GetResourceReviewWindowRow, error)
+	FindReviewImportAuthor(ctx context.Context, db sqlc.DBTX, arg sqlc.FindReviewImportAuthorParams) (uuid.UUID, error)
 }
 
 type ReviewReadStore struct {
@@ -47,20 +56,23 @@ func (r *ReviewReadStore) FindByID(ctx context.Context, db sqlc.DBTX, id uuid.UU
 		UserEmail:     row.UserEmail,
 		ResourceID:    row.ResourceID,
 		ResourceName:  row.ResourceName,
-		ReservationID: row.ReservationID,
+		ReservationID: pgconv.UUIDPtrFromPgtype(row.ReservationID),
 		Rating:        row.Rating,
 		Comment:       row.Comment,
+		Status:        row.Status,
+		Language:      pgconv.StringPtrFromPgtype(row.Language),
 		CreatedAt:     pgconv.TimeFromPgtype(row.CreatedAt),
 		UpdatedAt:     pgconv.TimeFromPgtype(row.UpdatedAt),
 	}, nil
 }
 
-func (r *ReviewReadStore) FindByResourceFirstPage(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID, limit int32, minRating, maxRating *int) ([]*queries.ReviewListItem, error) {
+func (r *ReviewReadStore) FindByResourceFirstPage(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID, limit int32, filters queries.ReviewFilters) ([]*queries.ReviewListItem, error) {
 	params := sqlc.GetReviewsByResourceFirstPageParams{
 		ResourceID: resourceID,
 		Limit:      limit,
-		MinRating:  toPgInt4(minRating),
-		MaxRating:  toPgInt4(maxRating),
+		MinRating:  toPgInt4(filters.MinRating),
+		MaxRating:  toPgInt4(filters.MaxRating),
+		Language:   pgconv.StringPtrToPgtype(filters.Language),
 	}
 
 	rows, err := r.queries.GetReviewsByResourceFirstPage(ctx, db, params)
@@ -70,14 +82,15 @@ func (r *ReviewReadStore) FindByResourceFirstPage(ctx context.Context, db sqlc.D
 	return mapResourceFirstPageRows(rows), nil
 }
 
-func (r *ReviewReadStore) FindByResourceKeyset(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID, lastCreatedAt time.Time, lastID uuid.UUID, limit int32, minRating, maxRating *int) ([]*queries.ReviewListItem, error) {
+func (r *ReviewReadStore) FindByResourceKeyset(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID, lastCreatedAt time.Time, lastID uuid.UUID, limit int32, filters queries.ReviewFilters) ([]*queries.ReviewListItem, error) {
 	params := sqlc.GetReviewsByResourceKeysetParams{
 		ResourceID: resourceID,
 		CreatedAt:  pgconv.TimeToPgtype(lastCreatedAt),
 		ID:         lastID,
 		Limit:      limit,
-		MinRating:  toPgInt4(minRating),
-		MaxRating:  toPgInt4(maxRating),
+		MinRating:  toPgInt4(filters.MinRating),
+		MaxRating:  toPgInt4(filters.MaxRating),
+		Language:   pgconv.StringPtrToPgtype(filters.Language),
 	}
 	rows, err := r.queries.GetReviewsByResourceKeyset(ctx, db, params)
 	if err != nil {
@@ -114,25 +127,35 @@ func (r *ReviewReadStore) GetResourceRatingStats(ctx context.Context, db sqlc.DB
 	if err != nil {
 		if pgconv.IsNoRows(err) {
 			// return zero stats if not initialized yet
-			return &queries.ResourceRatingStats{ResourceID: resourceID}, nil
+			return &queries.ResourceRatingStats{ResourceID: resourceID, LanguageCounts: map[string]int32{}}, nil
 		}
 		return nil, infra.WrapRepoErr("failed to get resource rating stats", err)
 	}
+	langRows, err := r.queries.CountReviewsByLanguage(ctx, db, resourceID)
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to count reviews by language", err)
+	}
+	languageCounts := make(map[string]int32, len(langRows))
+	for _, lr := range langRows {
+		languageCounts[lr.Language] = lr.ReviewCount
+	}
 	avgPtr, _ := pgconv.Float64PtrFromNumeric(row.AverageRating)
 	avg := 0.0
 	if avgPtr != nil {
 		avg = *avgPtr
 	}
 	return &queries.ResourceRatingStats{
-		ResourceID:    row.ResourceID,
-		TotalReviews:  row.TotalReviews,
-		AverageRating: avg,
-		Rating1Count:  row.Rating1Count,
-		Rating2Count:  row.Rating2Count,
-		Rating3Count:  row.Rating3Count,
-		Rating4Count:  row.Rating4Count,
-		Rating5Count:  row.Rating5Count,
-		UpdatedAt:     pgconv.TimeFromPgtype(row.UpdatedAt),
+		ResourceID:     row.ResourceID,
+		TotalReviews:   row.TotalReviews,
+		AverageRating:  avg,
+		Rating1Count:   row.Rating1Count,
+		Rating2Count:   row.Rating2Count,
+		Rating3Count:   row.Rating3Count,
+		Rating4Count:   row.Rating4Count,
+		Rating5Count:   row.Rating5Count,
+		UpdatedAt:      pgconv.TimeFromPgtype(row.UpdatedAt),
+		Summary:        pgconv.StringPtrFromPgtype(row.Summary),
+		LanguageCounts: languageCounts,
 	}, nil
 }
 
@@ -149,12 +172,164 @@ func (r *ReviewReadStore) FindSnapshotByID(ctx context.Context, db sqlc.DBTX, id
 		ID:            row.ID,
 		UserID:        row.UserID,
 		ResourceID:    row.ResourceID,
-		ReservationID: row.ReservationID,
+		ReservationID: uuid.UUID(row.ReservationID.Bytes),
 		Rating:        int(row.Rating),
 		Comment:       row.Comment,
+		Status:        row.Status,
+	}, nil
+}
+
+func (r *ReviewReadStore) FindReviewWindow(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID) (shared.ReviewWindowSetting, error) {
+	row, err := r.queries.GetResourceReviewWindow(ctx, db, resourceID)
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return shared.ReviewWindowSetting{}, infra.WrapRepoErr("resource not found", err, infra.KindNotFound)
+		}
+		return shared.ReviewWindowSetting{}, infra.WrapRepoErr("failed to get review window", err)
+	}
+	return shared.ReviewWindowSetting{
+		OpensAfterMin: pgconv.Int32PtrFromPgtype(row.ReviewOpensAfterMin),
+		WindowDays:    pgconv.Int32PtrFromPgtype(row.ReviewWindowDays),
 	}, nil
 }
 
+func (r *ReviewReadStore) FindImportAuthor(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID, externalID, email string) (uuid.UUID, error) {
+	id, err := r.queries.FindReviewImportAuthor(ctx, db, sqlc.FindReviewImportAuthorParams{
+		ResourceID: resourceID,
+		ExternalID: externalID,
+		Email:      email,
+	})
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return uuid.Nil, infra.WrapRepoErr("review author not found", err, infra.KindNotFound)
+		}
+		return uuid.Nil, infra.WrapRepoErr("failed to find review import author", err)
+	}
+	return id, nil
+}
+
+func (r *ReviewReadStore) ListStaleSummaries(ctx context.Context, db sqlc.DBTX, limit int32) ([]shared.StaleReviewSummary, error) {
+	rows, err := r.queries.ListStaleReviewSummaries(ctx, db, limit)
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list stale review summaries", err)
+	}
+	stale := make([]shared.StaleReviewSummary, len(rows))
+	for i, row := range rows {
+		stale[i] = shared.StaleReviewSummary{
+			ResourceID:   row.ResourceID,
+			TotalReviews: row.TotalReviews,
+		}
+	}
+	return stale, nil
+}
+
+func (r *ReviewReadStore) ListRecentForSummary(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID, limit int32) ([]shared.ReviewExcerpt, error) {
+	rows, err := r.queries.ListRecentReviewsForSummary(ctx, db, sqlc.ListRecentReviewsForSummaryParams{
+		ResourceID: resourceID,
+		Limit:      limit,
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list recent reviews for summary", err)
+	}
+	excerpts := make([]shared.ReviewExcerpt, len(rows))
+	for i, row := range rows {
+		excerpts[i] = shared.ReviewExcerpt{
+			Rating:  row.Rating,
+			Comment: row.Comment,
+		}
+	}
+	return excerpts, nil
+}
+
+func (r *ReviewReadStore) FindSimilarRecent(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, comment string, lookback int32, threshold float32) (uuid.UUID, error) {
+	id, err := r.queries.FindSimilarRecentReview(ctx, db, sqlc.FindSimilarRecentReviewParams{
+		UserID:    userID,
+		Lookback:  lookback,
+		Comment:   comment,
+		Threshold: threshold,
+	})
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return uuid.Nil, infra.WrapRepoErr("no similar review", err, infra.KindNotFound)
+		}
+		return uuid.Nil, infra.WrapRepoErr("failed to find similar review", err)
+	}
+	return id, nil
+}
+
+func (r *ReviewReadStore) FindFlaggedByResource(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID, limit int32) ([]*queries.FlaggedReview, error) {
+	rows, err := r.queries.ListFlaggedReviewsByResource(ctx, db, sqlc.ListFlaggedReviewsByResourceParams{
+		ResourceID: resourceID,
+		Limit:      limit,
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list flagged reviews", err)
+	}
+	flagged := make([]*queries.FlaggedReview, len(rows))
+	for i, row := range rows {
+		flagged[i] = &queries.FlaggedReview{
+			ID:          row.ID,
+			UserID:      row.UserID,
+			UserEmail:   row.UserEmail,
+			Rating:      row.Rating,
+			Comment:     row.Comment,
+			DuplicateOf: pgconv.UUIDPtrFromPgtype(row.DuplicateOf),
+			CreatedAt:   pgconv.TimeFromPgtype(row.CreatedAt),
+		}
+	}
+	return flagged, nil
+}
+
+func (r *ReviewReadStore) FindForExport(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, afterCreatedAt time.Time, afterID uuid.UUID, limit int32) ([]*queries.ReviewExportItem, error) {
+	rows, err := r.queries.ListReviewsForExport(ctx, db, sqlc.ListReviewsForExportParams{
+		UserID:         userID,
+		AfterCreatedAt: pgconv.TimeToPgtype(afterCreatedAt),
+		AfterID:        afterID,
+		BatchSize:      limit,
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list reviews for export", err)
+	}
+	items := make([]*queries.ReviewExportItem, len(rows))
+	for i, row := range rows {
+		items[i] = &queries.ReviewExportItem{
+			ID:            row.ID,
+			ResourceID:    row.ResourceID,
+			ResourceName:  row.ResourceName,
+			ReservationID: pgconv.UUIDPtrFromPgtype(row.ReservationID),
+			Rating:        row.Rating,
+			Comment:       row.Comment,
+			Status:        row.Status,
+			Language:      pgconv.StringPtrFromPgtype(row.Language),
+			CreatedAt:     pgconv.TimeFromPgtype(row.CreatedAt),
+			UpdatedAt:     pgconv.TimeFromPgtype(row.UpdatedAt),
+		}
+	}
+	return items, nil
+}
+
+func (r *ReviewReadStore) FindRecentPublished(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID, limit int32) ([]*queries.ReviewFeedEntry, error) {
+	rows, err := r.queries.ListRecentPublishedReviews(ctx, db, sqlc.ListRecentPublishedReviewsParams{
+		ResourceID: resourceID,
+		BatchSize:  limit,
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list recent published reviews", err)
+	}
+	entries := make([]*queries.ReviewFeedEntry, len(rows))
+	for i, row := range rows {
+		entries[i] = &queries.ReviewFeedEntry{
+			ID:        row.ID,
+			Rating:    row.Rating,
+			Comment:   row.Comment,
+			Language:  pgconv.StringPtrFromPgtype(row.Language),
+			CreatedAt: pgconv.TimeFromPgtype(row.CreatedAt),
+			UpdatedAt: pgconv.TimeFromPgtype(row.UpdatedAt),
+		}
+	}
+	return entries, nil
+}
+
 func toPgInt4(v *int) pgtype.Int4 {
 	if v == nil {
 		return pgtype.Int4{Valid: false}
@@ -170,6 +345,7 @@ func mapResourceFirstPageRows(rows []sqlc.GetReviewsByResourceFirstPageRow) []*q
 			UserEmail: row.UserEmail,
 			Rating:    row.Rating,
 			Comment:   row.Comment,
+			Language:  pgconv.StringPtrFromPgtype(row.Language),
 			CreatedAt: pgconv.TimeFromPgtype(row.CreatedAt),
 		}
 	}
@@ -184,6 +360,7 @@ func mapResourceKeysetRows(rows []sqlc.GetReviewsByResourceKeysetRow) []*queries
 			UserEmail: row.UserEmail,
 			Rating:    row.Rating,
 			Comment:   row.Comment,
+			Language:  pgconv.StringPtrFromPgtype(row.Language),
 			CreatedAt: pgconv.TimeFromPgtype(row.CreatedAt),
 		}
 	}
@@ -199,6 +376,7 @@ func mapUserFirstPageRows(rows []sqlc.GetReviewsByUserFirstPageRow) []*queries.R
 			Rating:    row.Rating,
 			Comment:   row.Comment,
 			CreatedAt: pgconv.TimeFromPgtype(row.CreatedAt),
+			Status:    row.Status,
 		}
 	}
 	return result
@@ -213,6 +391,7 @@ func mapUserKeysetRows(rows []sqlc.GetReviewsByUserKeysetRow) []*queries.ReviewL
 			Rating:    row.Rating,
 			Comment:   row.Comment,
 			CreatedAt: pgconv.TimeFromPgtype(row.CreatedAt),
+			Status:    row.Status,
 		}
 	}
 	return result
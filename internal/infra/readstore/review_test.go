@@ -11,6 +11,8 @@ import (
 	"gin-clean-starter/internal/infra"
 	"gin-clean-starter/internal/infra/readstore"
 	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/queries"
 	readstoremock "gin-clean-starter/tests/mock/readstore"
 
 	"github.com/google/uuid"
@@ -49,7 +51,7 @@ func TestReadStore_FindByID(t *testing.T) {
 					UserEmail:     "test@example.com",
 					ResourceID:    uuid.New(),
 					ResourceName:  "Test Resource",
-					ReservationID: uuid.New(),
+					ReservationID: pgtype.UUID{Bytes: uuid.New(), Valid: true},
 					Rating:        5,
 					Comment:       "Great service!",
 					CreatedAt:     pgtype.Timestamptz{Time: time.Now(), Valid: true},
@@ -137,6 +139,7 @@ type ReviewTestCase struct {
 	name          string
 	minRating     *int
 	maxRating     *int
+	language      *string
 	limit         int32
 	setupMock     func(mock *readstoremock.MockReviewReadQueries)
 	expectedCount int
@@ -199,6 +202,19 @@ func testFilterCases(t *testing.T, ctx context.Context) {
 			},
 			expectedCount: 2,
 		},
+		{
+			name:     "language filter - lang=de",
+			language: strPtr("de"),
+			limit:    20,
+			setupMock: func(mock *readstoremock.MockReviewReadQueries) {
+				row := createReviewRow(5, "Der Raum war sehr ruhig")
+				row.Language = pgconv.StringToPgtype("de")
+				mock.EXPECT().GetReviewsByResourceFirstPage(ctx, gomock.Any(), gomock.Cond(func(p sqlc.GetReviewsByResourceFirstPageParams) bool {
+					return p.Language == pgconv.StringToPgtype("de")
+				})).Return([]sqlc.GetReviewsByResourceFirstPageRow{row}, nil)
+			},
+			expectedCount: 1,
+		},
 		{
 			name:      "no results - minRating too high",
 			minRating: intPtr(6),
@@ -310,7 +326,7 @@ func runReviewTestCases(t *testing.T, ctx context.Context, testCases []ReviewTes
 
 			tc.setupMock(mockQueries)
 
-			results, actualError := store.FindByResourceFirstPage(ctx, mockDB, resourceID, tc.limit, tc.minRating, tc.maxRating)
+			results, actualError := store.FindByResourceFirstPage(ctx, mockDB, resourceID, tc.limit, queries.ReviewFilters{MinRating: tc.minRating, MaxRating: tc.maxRating, Language: tc.language})
 
 			if tc.expectedError {
 				require.Error(t, actualError)
@@ -341,6 +357,10 @@ func intPtr(i int) *int {
 	return &i
 }
 
+func strPtr(s string) *string {
+	return &s
+}
+
 // =============================================================================
 // FindByResourceKeyset Tests
 // =============================================================================
@@ -545,7 +565,7 @@ func runKeysetReviewTestCases(t *testing.T, ctx context.Context, testCases []Rev
 
 			tc.setupMock(mockQueries)
 
-			results, actualError := store.FindByResourceKeyset(ctx, mockDB, resourceID, lastCreatedAt, lastID, tc.limit, tc.minRating, tc.maxRating)
+			results, actualError := store.FindByResourceKeyset(ctx, mockDB, resourceID, lastCreatedAt, lastID, tc.limit, queries.ReviewFilters{MinRating: tc.minRating, MaxRating: tc.maxRating})
 
 			if tc.expectedError {
 				require.Error(t, actualError)
@@ -859,9 +879,14 @@ func testRatingStatsNormalCases(t *testing.T, ctx context.Context) {
 					UpdatedAt:     pgtype.Timestamptz{Time: time.Now(), Valid: true},
 				}
 				mock.EXPECT().GetResourceRatingStats(ctx, gomock.Any(), resourceID).Return(expectedRow, nil)
+				mock.EXPECT().CountReviewsByLanguage(ctx, gomock.Any(), resourceID).Return([]sqlc.CountReviewsByLanguageRow{
+					{Language: "en", ReviewCount: 6},
+					{Language: "de", ReviewCount: 3},
+				}, nil)
 			},
 			expectedTotalReviews: 10,
 			expectedAvgRating:    4.5,
+			expectedLanguages:    map[string]int32{"en": 6, "de": 3},
 		},
 		{
 			name: "success - no stats found returns zero stats",
@@ -891,6 +916,7 @@ func testRatingStatsNormalCases(t *testing.T, ctx context.Context) {
 					UpdatedAt:     pgtype.Timestamptz{Time: time.Now(), Valid: true},
 				}
 				mock.EXPECT().GetResourceRatingStats(ctx, gomock.Any(), resourceID).Return(expectedRow, nil)
+				mock.EXPECT().CountReviewsByLanguage(ctx, gomock.Any(), resourceID).Return([]sqlc.CountReviewsByLanguageRow{}, nil)
 			},
 			expectedTotalReviews: 5,
 			expectedAvgRating:    5.0,
@@ -915,6 +941,7 @@ func testRatingStatsNormalCases(t *testing.T, ctx context.Context) {
 					UpdatedAt:     pgtype.Timestamptz{Time: time.Now(), Valid: true},
 				}
 				mock.EXPECT().GetResourceRatingStats(ctx, gomock.Any(), resourceID).Return(expectedRow, nil)
+				mock.EXPECT().CountReviewsByLanguage(ctx, gomock.Any(), resourceID).Return([]sqlc.CountReviewsByLanguageRow{}, nil)
 			},
 			expectedTotalReviews: 3,
 			expectedAvgRating:    1.0,
@@ -934,6 +961,15 @@ func testRatingStatsErrorCases(t *testing.T, ctx context.Context) {
 			expectedError: true,
 			expectKind:    infra.KindDBFailure,
 		},
+		{
+			name: "language count error",
+			setupMock: func(mock *readstoremock.MockReviewReadQueries, resourceID uuid.UUID) {
+				mock.EXPECT().GetResourceRatingStats(ctx, gomock.Any(), resourceID).Return(sqlc.ResourceRatingStats{ResourceID: resourceID}, nil)
+				mock.EXPECT().CountReviewsByLanguage(ctx, gomock.Any(), resourceID).Return(nil, errDBConnectionLost)
+			},
+			expectedError: true,
+			expectKind:    infra.KindDBFailure,
+		},
 	}
 
 	runRatingStatsTestCases(t, ctx, testCases)
@@ -944,6 +980,7 @@ type RatingStatsTestCase struct {
 	setupMock            func(mock *readstoremock.MockReviewReadQueries, resourceID uuid.UUID)
 	expectedTotalReviews int32
 	expectedAvgRating    float64
+	expectedLanguages    map[string]int32
 	expectedError        bool
 	expectKind           infra.RepositoryErrorKind
 }
@@ -975,6 +1012,11 @@ func runRatingStatsTestCases(t *testing.T, ctx context.Context, testCases []Rati
 				assert.Equal(t, resourceID, result.ResourceID)
 				assert.Equal(t, tc.expectedTotalReviews, result.TotalReviews)
 				assert.InDelta(t, tc.expectedAvgRating, result.AverageRating, 0.001)
+				if tc.expectedLanguages == nil {
+					assert.Empty(t, result.LanguageCounts)
+				} else {
+					assert.Equal(t, tc.expectedLanguages, result.LanguageCounts)
+				}
 			}
 		})
 	}
@@ -998,3 +1040,63 @@ func (m *mockDBTX) Query(ctx context.Context, sql string, args ...any) (pgx.Rows
 func (m *mockDBTX) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
 	panic("mockDBTX.QueryRow was called unexpectedly. Use sqlc mock instead.")
 }
+
+func (m *mockDBTX) CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
+	panic("mockDBTX.CopyFrom was called unexpectedly. Use sqlc mock instead.")
+}
+
+func (m *mockDBTX) SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults {
+	panic("mockDBTX.SendBatch was called unexpectedly. Use sqlc mock instead.")
+}
+
+// =============================================================================
+// FindSimilarRecent Tests
+// =============================================================================
+
+func TestReadStore_FindSimilarRecent(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	originalID := uuid.New()
+	params := sqlc.FindSimilarRecentReviewParams{
+		UserID:    userID,
+		Lookback:  20,
+		Comment:   "Great service!",
+		Threshold: 0.8,
+	}
+
+	testCases := []struct {
+		name          string
+		returnID      uuid.UUID
+		returnErr     error
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{name: "success: similar review found", returnID: originalID},
+		{name: "error: no similar review", returnErr: pgx.ErrNoRows, expectedError: true, expectKind: infra.KindNotFound},
+		{name: "error: database error", returnErr: errDBConnectionLost, expectedError: true, expectKind: infra.KindDBFailure},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := readstoremock.NewMockReviewReadQueries(ctrl)
+			mockDB := &mockDBTX{}
+			store := readstore.NewReviewReadStore(mockQueries)
+
+			mockQueries.EXPECT().FindSimilarRecentReview(ctx, mockDB, params).Return(tc.returnID, tc.returnErr)
+
+			id, actualError := store.FindSimilarRecent(ctx, mockDB, userID, params.Comment, params.Lookback, params.Threshold)
+
+			if tc.expectedError {
+				require.Error(t, actualError)
+				assert.True(t, infra.IsKind(actualError, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, actualError, actualError)
+				assert.Equal(t, uuid.Nil, id)
+			} else {
+				assert.NoError(t, actualError)
+				assert.Equal(t, originalID, id)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package readstore
+
+import (
+	"context"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/queries"
+)
+
+type RoleReadQueries interface {
+	GetRole(ctx context.Context, db sqlc.DBTX, name string) (sqlc.Roles, error)
+	ListRoles(ctx context.Context, db sqlc.DBTX) ([]sqlc.Roles, error)
+	ListRolePermissions(ctx context.Context, db sqlc.DBTX) ([]sqlc.RolePermissions, error)
+	ListPermissionNamesByRole(ctx context.Context, db sqlc.DBTX, roleName string) ([]string, error)
+	ListPermissions(ctx context.Context, db sqlc.DBTX) ([]sqlc.Permissions, error)
+}
+
+type RoleReadStore struct {
+	queries RoleReadQueries
+}
+
+func NewRoleReadStore(queries RoleReadQueries) *RoleReadStore {
+	return &RoleReadStore{
+		queries: queries,
+	}
+}
+
+func (r *RoleReadStore) FindByName(ctx context.Context, db sqlc.DBTX, name string) (*queries.RoleView, error) {
+	row, err := r.queries.GetRole(ctx, db, name)
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return nil, infra.WrapRepoErr("role not found", err, infra.KindNotFound)
+		}
+		return nil, infra.WrapRepoErr("failed to get role", err)
+	}
+
+	permissions, err := r.PermissionsByRole(ctx, db, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return toRoleView(row, permissions), nil
+}
+
+func (r *RoleReadStore) List(ctx context.Context, db sqlc.DBTX) ([]*queries.RoleView, error) {
+	rows, err := r.queries.ListRoles(ctx, db)
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list roles", err)
+	}
+
+	grants, err := r.queries.ListRolePermissions(ctx, db)
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list role permissions", err)
+	}
+	byRole := make(map[string][]string, len(rows))
+	for _, g := range grants {
+		byRole[g.RoleName] = append(byRole[g.RoleName], g.PermissionName)
+	}
+
+	out := make([]*queries.RoleView, len(rows))
+	for i, row := range rows {
+		out[i] = toRoleView(row, byRole[row.Name])
+	}
+	return out, nil
+}
+
+func (r *RoleReadStore) ListPermissions(ctx context.Context, db sqlc.DBTX) ([]*queries.PermissionView, error) {
+	rows, err := r.queries.ListPermissions(ctx, db)
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list permissions", err)
+	}
+
+	out := make([]*queries.PermissionView, len(rows))
+	for i, row := range rows {
+		out[i] = &queries.PermissionView{
+			Name:        row.Name,
+			Description: row.Description,
+		}
+	}
+	return out, nil
+}
+
+// PermissionsByRole returns the permission names granted to role; unknown roles have none.
+func (r *RoleReadStore) PermissionsByRole(ctx context.Context, db sqlc.DBTX, role string) ([]string, error) {
+	names, err := r.queries.ListPermissionNamesByRole(ctx, db, role)
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list role permission names", err)
+	}
+	if names == nil {
+		names = []string{}
+	}
+	return names, nil
+}
+
+func toRoleView(row sqlc.Roles, permissions []string) *queries.RoleView {
+	if permissions == nil {
+		permissions = []string{}
+	}
+	return &queries.RoleView{
+		Name:        row.Name,
+		Description: row.Description,
+		IsSystem:    row.IsSystem,
+		Permissions: permissions,
+		CreatedAt:   pgconv.TimeFromPgtype(row.CreatedAt),
+		UpdatedAt:   pgconv.TimeFromPgtype(row.UpdatedAt),
+	}
+}
@@ -0,0 +1,53 @@
+package readstore
+
+import (
+	"context"
+	"encoding/json"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+type SAMLConnectionReadQueries interface {
+	GetSAMLConnection(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID) (sqlc.GetSAMLConnectionRow, error)
+}
+
+type SAMLConnectionReadStore struct {
+	queries SAMLConnectionReadQueries
+}
+
+func NewSAMLConnectionReadStore(queries SAMLConnectionReadQueries) *SAMLConnectionReadStore {
+	return &SAMLConnectionReadStore{
+		queries: queries,
+	}
+}
+
+func (r *SAMLConnectionReadStore) FindSAMLConnection(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID) (*shared.SAMLConnection, error) {
+	row, err := r.queries.GetSAMLConnection(ctx, db, companyID)
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return nil, infra.WrapRepoErr("SAML connection not found", err, infra.KindNotFound)
+		}
+		return nil, infra.WrapRepoErr("failed to get SAML connection", err)
+	}
+	mapping := map[string]string{}
+	if len(row.RoleMapping) > 0 {
+		if err := json.Unmarshal(row.RoleMapping, &mapping); err != nil {
+			return nil, infra.WrapRepoErr("failed to decode SAML role mapping", err)
+		}
+	}
+	return &shared.SAMLConnection{
+		CompanyID:      row.CompanyID,
+		IDPEntityID:    row.IdpEntityID,
+		IDPMetadata:    []byte(row.IdpMetadata),
+		EmailAttribute: row.EmailAttribute.String,
+		RoleAttribute:  row.RoleAttribute.String,
+		RoleMapping:    mapping,
+		DefaultRole:    row.DefaultRole,
+		UpdatedAt:      row.UpdatedAt.Time,
+	}, nil
+}
@@ -0,0 +1,84 @@
+package readstore
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/queries"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+type SecurityEventReadQueries interface {
+	ListUserSecurityEventsFirstPage(ctx context.Context, db sqlc.DBTX, arg sqlc.ListUserSecurityEventsFirstPageParams) ([]sqlc.ListUserSecurityEventsFirstPageRow, error)
+	ListUserSecurityEventsKeyset(ctx context.Context, db sqlc.DBTX, arg sqlc.ListUserSecurityEventsKeysetParams) ([]sqlc.ListUserSecurityEventsKeysetRow, error)
+}
+
+type SecurityEventReadStore struct {
+	queries SecurityEventReadQueries
+}
+
+func NewSecurityEventReadStore(queries SecurityEventReadQueries) *SecurityEventReadStore {
+	return &SecurityEventReadStore{
+		queries: queries,
+	}
+}
+
+func (r *SecurityEventReadStore) FindFirstPage(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, limit int32) ([]*queries.SecurityEvent, error) {
+	rows, err := r.queries.ListUserSecurityEventsFirstPage(ctx, db, sqlc.ListUserSecurityEventsFirstPageParams{
+		UserID:     userID.String(),
+		Actions:    shared.SecurityEventActions,
+		LimitCount: limit,
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list security events first page", err)
+	}
+
+	result := make([]*queries.SecurityEvent, len(rows))
+	for i, row := range rows {
+		result[i] = toSecurityEvent(sqlc.ListUserSecurityEventsKeysetRow(row))
+	}
+	return result, nil
+}
+
+func (r *SecurityEventReadStore) FindKeyset(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, lastCreatedAt time.Time, lastID uuid.UUID, limit int32) ([]*queries.SecurityEvent, error) {
+	rows, err := r.queries.ListUserSecurityEventsKeyset(ctx, db, sqlc.ListUserSecurityEventsKeysetParams{
+		UserID:        userID.String(),
+		Actions:       shared.SecurityEventActions,
+		LastCreatedAt: pgconv.TimeToPgtype(lastCreatedAt),
+		LastID:        lastID,
+		LimitCount:    limit,
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list security events keyset", err)
+	}
+
+	result := make([]*queries.SecurityEvent, len(rows))
+	for i, row := range rows {
+		result[i] = toSecurityEvent(row)
+	}
+	return result, nil
+}
+
+// toSecurityEvent lifts the client details out of the audit metadata; entries written
+// outside a request have none, and a malformed payload just leaves them empty.
+func toSecurityEvent(row sqlc.ListUserSecurityEventsKeysetRow) *queries.SecurityEvent {
+	var client struct {
+		IP        string `json:"ip"`
+		UserAgent string `json:"user_agent"`
+	}
+	_ = json.Unmarshal(row.Metadata, &client)
+
+	return &queries.SecurityEvent{
+		ID:        row.ID,
+		Action:    row.Action,
+		IPAddress: client.IP,
+		UserAgent: client.UserAgent,
+		CreatedAt: pgconv.TimeFromPgtype(row.CreatedAt),
+	}
+}
@@ -0,0 +1,51 @@
+package readstore
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/queries"
+
+	"github.com/google/uuid"
+)
+
+type SessionReadQueries interface {
+	ListUserSessions(ctx context.Context, db sqlc.DBTX, arg sqlc.ListUserSessionsParams) ([]sqlc.ListUserSessionsRow, error)
+}
+
+type SessionReadStore struct {
+	queries SessionReadQueries
+}
+
+func NewSessionReadStore(queries SessionReadQueries) *SessionReadStore {
+	return &SessionReadStore{
+		queries: queries,
+	}
+}
+
+func (r *SessionReadStore) ListLive(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, now time.Time) ([]*queries.SessionView, error) {
+	rows, err := r.queries.ListUserSessions(ctx, db, sqlc.ListUserSessionsParams{
+		UserID: userID,
+		Now:    pgconv.TimeToPgtype(now),
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list sessions", err)
+	}
+
+	result := make([]*queries.SessionView, len(rows))
+	for i, row := range rows {
+		result[i] = &queries.SessionView{
+			ID:                row.ID,
+			DeviceFingerprint: row.DeviceFingerprint,
+			IPAddress:         row.IpAddress,
+			UserAgent:         row.UserAgent,
+			CreatedAt:         pgconv.TimeFromPgtype(row.CreatedAt),
+			LastSeenAt:        pgconv.TimeFromPgtype(row.LastSeenAt),
+			ExpiresAt:         pgconv.TimeFromPgtype(row.ExpiresAt),
+		}
+	}
+	return result, nil
+}
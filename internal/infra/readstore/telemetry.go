@@ -0,0 +1,62 @@
+package readstore
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/queries"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+type TelemetryReadQueries interface {
+	ListTelemetryCompanies(ctx context.Context, db sqlc.DBTX, userIds []uuid.UUID) ([]sqlc.ListTelemetryCompaniesRow, error)
+	ListFeatureAdoption(ctx context.Context, db sqlc.DBTX, arg sqlc.ListFeatureAdoptionParams) ([]sqlc.ListFeatureAdoptionRow, error)
+}
+
+type TelemetryReadStore struct {
+	queries TelemetryReadQueries
+}
+
+func NewTelemetryReadStore(queries TelemetryReadQueries) *TelemetryReadStore {
+	return &TelemetryReadStore{
+		queries: queries,
+	}
+}
+
+func (r *TelemetryReadStore) ListCompanies(ctx context.Context, db sqlc.DBTX, userIDs []uuid.UUID) (map[uuid.UUID]shared.TelemetryCompany, error) {
+	rows, err := r.queries.ListTelemetryCompanies(ctx, db, userIDs)
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list telemetry companies", err)
+	}
+
+	result := make(map[uuid.UUID]shared.TelemetryCompany, len(rows))
+	for _, row := range rows {
+		result[row.UserID] = shared.TelemetryCompany{CompanyID: row.CompanyID, OptedOut: row.OptedOut}
+	}
+	return result, nil
+}
+
+func (r *TelemetryReadStore) ListAdoption(ctx context.Context, db sqlc.DBTX, from, to time.Time) ([]*queries.FeatureAdoption, error) {
+	rows, err := r.queries.ListFeatureAdoption(ctx, db, sqlc.ListFeatureAdoptionParams{
+		FromDay: pgconv.DateToPgtype(from),
+		ToDay:   pgconv.DateToPgtype(to),
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list feature adoption", err)
+	}
+
+	result := make([]*queries.FeatureAdoption, len(rows))
+	for i, row := range rows {
+		result[i] = &queries.FeatureAdoption{
+			Feature:   row.Feature,
+			Requests:  row.RequestCount,
+			Companies: row.CompanyCount,
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,57 @@
+package readstore
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type TOSReadQueries interface {
+	GetCurrentTOSVersion(ctx context.Context, db sqlc.DBTX, now pgtype.Timestamptz) (sqlc.GetCurrentTOSVersionRow, error)
+	HasAcceptedTOSVersion(ctx context.Context, db sqlc.DBTX, arg sqlc.HasAcceptedTOSVersionParams) (bool, error)
+}
+
+type TOSReadStore struct {
+	queries TOSReadQueries
+}
+
+func NewTOSReadStore(queries TOSReadQueries) *TOSReadStore {
+	return &TOSReadStore{
+		queries: queries,
+	}
+}
+
+// FindCurrent reports KindNotFound until a first version is published.
+func (r *TOSReadStore) FindCurrent(ctx context.Context, db sqlc.DBTX, now time.Time) (*shared.TOSVersion, error) {
+	row, err := r.queries.GetCurrentTOSVersion(ctx, db, pgconv.TimeToPgtype(now))
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return nil, infra.WrapRepoErr("no terms of service version published", err, infra.KindNotFound)
+		}
+		return nil, infra.WrapRepoErr("failed to find current terms of service version", err)
+	}
+	return &shared.TOSVersion{
+		ID:          row.ID,
+		Version:     row.Version,
+		URL:         row.Url,
+		PublishedAt: pgconv.TimeFromPgtype(row.PublishedAt),
+	}, nil
+}
+
+func (r *TOSReadStore) HasAccepted(ctx context.Context, db sqlc.DBTX, userID, versionID uuid.UUID) (bool, error) {
+	accepted, err := r.queries.HasAcceptedTOSVersion(ctx, db, sqlc.HasAcceptedTOSVersionParams{
+		UserID:       userID,
+		TosVersionID: versionID,
+	})
+	if err != nil {
+		return false, infra.WrapRepoErr("failed to check terms of service acceptance", err)
+	}
+	return accepted, nil
+}
@@ -0,0 +1,73 @@
+package readstore
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/queries"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+type UsageReadQueries interface {
+	GetUserUsageQuota(ctx context.Context, db sqlc.DBTX, arg sqlc.GetUserUsageQuotaParams) (sqlc.GetUserUsageQuotaRow, error)
+	ListAPIUsageByMonth(ctx context.Context, db sqlc.DBTX, arg sqlc.ListAPIUsageByMonthParams) ([]sqlc.ListAPIUsageByMonthRow, error)
+}
+
+type UsageReadStore struct {
+	queries UsageReadQueries
+}
+
+func NewUsageReadStore(queries UsageReadQueries) *UsageReadStore {
+	return &UsageReadStore{
+		queries: queries,
+	}
+}
+
+func (r *UsageReadStore) FindQuota(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, month time.Time) (*shared.UserUsageQuota, error) {
+	row, err := r.queries.GetUserUsageQuota(ctx, db, sqlc.GetUserUsageQuotaParams{
+		Month:  pgconv.DateToPgtype(month),
+		UserID: userID,
+	})
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return nil, infra.WrapRepoErr("user has no company", err, infra.KindNotFound)
+		}
+		return nil, infra.WrapRepoErr("failed to find usage quota", err)
+	}
+	return &shared.UserUsageQuota{
+		CompanyID: row.CompanyID,
+		Used:      row.RequestCount,
+		SoftLimit: pgconv.Int64PtrFromPgtype(row.MonthlyRequestSoftQuota),
+		HardLimit: pgconv.Int64PtrFromPgtype(row.MonthlyRequestHardQuota),
+	}, nil
+}
+
+func (r *UsageReadStore) ListByMonth(ctx context.Context, db sqlc.DBTX, month time.Time, companyID *uuid.UUID) ([]*queries.CompanyUsage, error) {
+	rows, err := r.queries.ListAPIUsageByMonth(ctx, db, sqlc.ListAPIUsageByMonthParams{
+		Month:     pgconv.DateToPgtype(month),
+		CompanyID: pgconv.UUIDPtrToPgtype(companyID),
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list API usage", err)
+	}
+
+	result := make([]*queries.CompanyUsage, len(rows))
+	for i, row := range rows {
+		result[i] = &queries.CompanyUsage{
+			CompanyID:   row.CompanyID,
+			CompanyName: row.CompanyName,
+			Requests:    row.RequestCount,
+			BytesIn:     row.BytesIn,
+			BytesOut:    row.BytesOut,
+			SoftQuota:   pgconv.Int64PtrFromPgtype(row.MonthlyRequestSoftQuota),
+			HardQuota:   pgconv.Int64PtrFromPgtype(row.MonthlyRequestHardQuota),
+			UpdatedAt:   pgconv.TimeFromPgtype(row.UpdatedAt),
+		}
+	}
+	return result, nil
+}
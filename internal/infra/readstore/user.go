@@ -2,13 +2,13 @@ package readstore
 
 import (
 	"context"
-	"database/sql"
-	"errors"
 
 	"github.com/google/uuid"
 
 	"gin-clean-starter/internal/infra"
 	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/crypto"
+	"gin-clean-starter/internal/pkg/pgconv"
 	"gin-clean-starter/internal/usecase/queries"
 )
 
@@ -18,32 +18,41 @@ type UserReadQueries interface {
 }
 
 type UserReadStore struct {
-	queries UserReadQueries
+	queries  UserReadQueries
+	envelope *crypto.Envelope
 }
 
-func NewUserReadStore(queries UserReadQueries) *UserReadStore {
+func NewUserReadStore(queries UserReadQueries, envelope *crypto.Envelope) *UserReadStore {
 	return &UserReadStore{
-		queries: queries,
+		queries:  queries,
+		envelope: envelope,
 	}
 }
 
 func (r *UserReadStore) FindByID(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (*queries.AuthorizedUserView, error) {
 	row, err := r.queries.FindUserByID(ctx, db, id)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
+		if pgconv.IsNoRows(err) {
 			return nil, infra.WrapRepoErr("user not found", err, infra.KindNotFound)
 		}
 		return nil, infra.WrapRepoErr("failed to find user by ID", err)
 	}
 
 	readModel := toAuthorizedUserViewFromFindByIDRow(row)
+	if row.PhoneCiphertext.Valid {
+		phone, derr := r.envelope.DecryptString(row.PhoneCiphertext.String, infra.UserPhoneAAD(row.ID))
+		if derr != nil {
+			return nil, infra.WrapRepoErr("failed to decrypt user phone", derr)
+		}
+		readModel.Phone = &phone
+	}
 	return readModel, nil
 }
 
 func (r *UserReadStore) FindByEmail(ctx context.Context, db sqlc.DBTX, email string) (*queries.AuthorizedUserView, string, error) {
 	row, err := r.queries.FindUserByEmail(ctx, db, email)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
+		if pgconv.IsNoRows(err) {
 			return nil, "", infra.WrapRepoErr("user not found", err, infra.KindNotFound)
 		}
 		return nil, "", infra.WrapRepoErr("failed to find user by email", err)
@@ -86,7 +86,7 @@ func TestFindByEmail(t *testing.T) {
 			mockQueries := new(MockUserReadQueries)
 			mockQueries.On("FindUserByEmail", mock.Anything, mock.Anything, tt.email).Return(tt.mockReturn, tt.mockError)
 
-			readStore := NewUserReadStore(mockQueries)
+			readStore := NewUserReadStore(mockQueries, nil)
 
 			userReadModel, hash, err := readStore.FindByEmail(context.Background(), (sqlc.DBTX)(nil), tt.email)
 
@@ -190,7 +190,7 @@ func TestFindByID(t *testing.T) {
 			mockQueries := new(MockUserReadQueries)
 			mockQueries.On("FindUserByID", mock.Anything, mock.Anything, tt.userID).Return(tt.mockReturn, tt.mockError)
 
-			readStore := NewUserReadStore(mockQueries)
+			readStore := NewUserReadStore(mockQueries, nil)
 
 			userReadModel, err := readStore.FindByID(context.Background(), (sqlc.DBTX)(nil), tt.userID)
 
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+type ActivityWriteQueries interface {
+	CreateUserActivity(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateUserActivityParams) error
+	DeleteUserActivityBySubject(ctx context.Context, db sqlc.DBTX, arg sqlc.DeleteUserActivityBySubjectParams) error
+}
+
+type ActivityRepository struct {
+	queries ActivityWriteQueries
+}
+
+func NewActivityRepository(queries ActivityWriteQueries) *ActivityRepository {
+	return &ActivityRepository{
+		queries: queries,
+	}
+}
+
+// Record reports KindForeignKeyViolated when the user does not exist.
+func (r *ActivityRepository) Record(ctx context.Context, tx sqlc.DBTX, entry shared.ActivityEntry) error {
+	data := []byte("{}")
+	if len(entry.Data) > 0 {
+		b, err := json.Marshal(entry.Data)
+		if err != nil {
+			return infra.WrapRepoErr("failed to encode activity data", err)
+		}
+		data = b
+	}
+
+	err := r.queries.CreateUserActivity(ctx, tx, sqlc.CreateUserActivityParams{
+		UserID:     entry.UserID,
+		Kind:       string(entry.Kind),
+		SubjectID:  entry.SubjectID,
+		ResourceID: pgconv.UUIDPtrToPgtype(entry.ResourceID),
+		Data:       data,
+		OccurredAt: pgconv.TimeToPgtype(entry.OccurredAt),
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to record activity", err)
+	}
+	return nil
+}
+
+func (r *ActivityRepository) Remove(ctx context.Context, tx sqlc.DBTX, kind shared.ActivityKind, subjectID uuid.UUID) error {
+	err := r.queries.DeleteUserActivityBySubject(ctx, tx, sqlc.DeleteUserActivityBySubjectParams{
+		Kind:      string(kind),
+		SubjectID: subjectID,
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to remove activity", err)
+	}
+	return nil
+}
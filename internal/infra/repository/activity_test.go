@@ -0,0 +1,106 @@
+//go:build unit
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/infra/repository"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+	repositorymock "gin-clean-starter/tests/mock/repository"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestActivityRepository_Record(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	userID := uuid.New()
+	reviewID := uuid.New()
+	resourceID := uuid.New()
+
+	testCases := []struct {
+		name          string
+		entry         shared.ActivityEntry
+		setupMock     func(*repositorymock.MockActivityWriteQueries, sqlc.DBTX)
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name: "success: data encoded as JSON",
+			entry: shared.ActivityEntry{
+				UserID:     userID,
+				Kind:       shared.ActivityReviewPosted,
+				SubjectID:  reviewID,
+				ResourceID: &resourceID,
+				Data:       map[string]any{"rating": 4},
+				OccurredAt: now,
+			},
+			setupMock: func(mock *repositorymock.MockActivityWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().CreateUserActivity(ctx, db, sqlc.CreateUserActivityParams{
+					UserID:     userID,
+					Kind:       "review_posted",
+					SubjectID:  reviewID,
+					ResourceID: pgconv.UUIDToPgtype(resourceID),
+					Data:       []byte(`{"rating":4}`),
+					OccurredAt: pgconv.TimeToPgtype(now),
+				}).Return(nil)
+			},
+		},
+		{
+			name:  "success: missing resource and data stored as NULL and empty object",
+			entry: shared.ActivityEntry{UserID: userID, Kind: shared.ActivityReservationCanceled, SubjectID: reviewID, OccurredAt: now},
+			setupMock: func(mock *repositorymock.MockActivityWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().CreateUserActivity(ctx, db, sqlc.CreateUserActivityParams{
+					UserID:     userID,
+					Kind:       "reservation_canceled",
+					SubjectID:  reviewID,
+					ResourceID: pgtype.UUID{},
+					Data:       []byte("{}"),
+					OccurredAt: pgconv.TimeToPgtype(now),
+				}).Return(nil)
+			},
+		},
+		{
+			name:  "error: unknown user violates foreign key",
+			entry: shared.ActivityEntry{UserID: userID, Kind: shared.ActivityCreditEarned, SubjectID: uuid.New(), OccurredAt: now},
+			setupMock: func(mock *repositorymock.MockActivityWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().CreateUserActivity(ctx, db, gomock.Any()).Return(&pgconn.PgError{Code: "23503"})
+			},
+			expectedError: true,
+			expectKind:    infra.KindForeignKeyViolated,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockActivityWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewActivityRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			err := repo.Record(ctx, mockDB, tc.entry)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+
+	"github.com/google/uuid"
+)
+
+type APIKeyWriteQueries interface {
+	CreateAPIKey(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateAPIKeyParams) (uuid.UUID, error)
+	RevokeAPIKey(ctx context.Context, db sqlc.DBTX, arg sqlc.RevokeAPIKeyParams) (int64, error)
+}
+
+type APIKeyRepository struct {
+	queries APIKeyWriteQueries
+}
+
+func NewAPIKeyRepository(queries APIKeyWriteQueries) *APIKeyRepository {
+	return &APIKeyRepository{
+		queries: queries,
+	}
+}
+
+func (r *APIKeyRepository) Create(ctx context.Context, tx sqlc.DBTX, userID uuid.UUID, name string, keyHash []byte, scopes []string, createdBy uuid.UUID, at time.Time, expiresAt *time.Time) (uuid.UUID, error) {
+	id, err := r.queries.CreateAPIKey(ctx, tx, sqlc.CreateAPIKeyParams{
+		UserID:    userID,
+		Name:      name,
+		KeyHash:   keyHash,
+		Scopes:    scopes,
+		CreatedBy: pgconv.UUIDToPgtype(createdBy),
+		CreatedAt: pgconv.TimeToPgtype(at),
+		ExpiresAt: pgconv.TimePtrToPgtype(expiresAt),
+	})
+	if err != nil {
+		return uuid.Nil, infra.WrapRepoErr("failed to create api key", err)
+	}
+	return id, nil
+}
+
+func (r *APIKeyRepository) Revoke(ctx context.Context, tx sqlc.DBTX, userID, keyID uuid.UUID, at time.Time) error {
+	rows, err := r.queries.RevokeAPIKey(ctx, tx, sqlc.RevokeAPIKeyParams{
+		RevokedAt: pgconv.TimeToPgtype(at),
+		ID:        keyID,
+		UserID:    userID,
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to revoke api key", err)
+	}
+	if rows == 0 {
+		return infra.WrapRepoErr("api key not found", nil, infra.KindNotFound)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+)
+
+type AuditWriteQueries interface {
+	CreateAuditLog(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateAuditLogParams) error
+}
+
+type AuditRepository struct {
+	queries AuditWriteQueries
+}
+
+func NewAuditRepository(queries AuditWriteQueries) *AuditRepository {
+	return &AuditRepository{
+		queries: queries,
+	}
+}
+
+// Record reports KindForeignKeyViolated when the actor or company does not exist.
+func (r *AuditRepository) Record(ctx context.Context, tx sqlc.DBTX, entry shared.AuditEntry) error {
+	metadata := []byte("{}")
+	if len(entry.Metadata) > 0 {
+		b, err := json.Marshal(entry.Metadata)
+		if err != nil {
+			return infra.WrapRepoErr("failed to encode audit metadata", err)
+		}
+		metadata = b
+	}
+
+	err := r.queries.CreateAuditLog(ctx, tx, sqlc.CreateAuditLogParams{
+		ActorID:    pgconv.UUIDPtrToPgtype(entry.ActorID),
+		CompanyID:  pgconv.UUIDPtrToPgtype(entry.CompanyID),
+		Action:     entry.Action,
+		TargetType: entry.TargetType,
+		TargetID:   entry.TargetID,
+		Metadata:   metadata,
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to record audit entry", err)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+//go:build unit
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/infra/repository"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+	repositorymock "gin-clean-starter/tests/mock/repository"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAuditRepository_Record(t *testing.T) {
+	ctx := context.Background()
+	actorID := uuid.New()
+	companyID := uuid.New()
+
+	testCases := []struct {
+		name          string
+		entry         shared.AuditEntry
+		setupMock     func(*repositorymock.MockAuditWriteQueries, sqlc.DBTX)
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name: "success: metadata encoded as JSON",
+			entry: shared.AuditEntry{
+				ActorID:    &actorID,
+				CompanyID:  &companyID,
+				Action:     "support.request",
+				TargetType: "company",
+				TargetID:   companyID.String(),
+				Metadata:   map[string]any{"path": "/api/admin/invites"},
+			},
+			setupMock: func(mock *repositorymock.MockAuditWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().CreateAuditLog(ctx, db, sqlc.CreateAuditLogParams{
+					ActorID:    pgconv.UUIDToPgtype(actorID),
+					CompanyID:  pgconv.UUIDToPgtype(companyID),
+					Action:     "support.request",
+					TargetType: "company",
+					TargetID:   companyID.String(),
+					Metadata:   []byte(`{"path":"/api/admin/invites"}`),
+				}).Return(nil)
+			},
+		},
+		{
+			name:  "success: missing actor and metadata stored as NULL and empty object",
+			entry: shared.AuditEntry{Action: "system.event"},
+			setupMock: func(mock *repositorymock.MockAuditWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().CreateAuditLog(ctx, db, sqlc.CreateAuditLogParams{
+					ActorID:   pgtype.UUID{},
+					CompanyID: pgtype.UUID{},
+					Action:    "system.event",
+					Metadata:  []byte("{}"),
+				}).Return(nil)
+			},
+		},
+		{
+			name:  "error: unknown company violates foreign key",
+			entry: shared.AuditEntry{CompanyID: &companyID, Action: "support.session_started"},
+			setupMock: func(mock *repositorymock.MockAuditWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().CreateAuditLog(ctx, db, gomock.Any()).Return(&pgconn.PgError{Code: "23503"})
+			},
+			expectedError: true,
+			expectKind:    infra.KindForeignKeyViolated,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockAuditWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewAuditRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			err := repo.Record(ctx, mockDB, tc.entry)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
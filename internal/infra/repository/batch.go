@@ -0,0 +1,15 @@
+package repository
+
+// firstBatchError drains a sqlc :batchexec result and returns the first statement
+// error. Inside a transaction every statement after a failure reports "current
+// transaction is aborted", so only the first one carries the real cause (and its
+// SQLSTATE for WrapRepoErr).
+func firstBatchError(exec func(func(int, error))) error {
+	var first error
+	exec(func(_ int, err error) {
+		if err != nil && first == nil {
+			first = err
+		}
+	})
+	return first
+}
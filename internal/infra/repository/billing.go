@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type BillingWriteQueries interface {
+	CreateSubscription(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateSubscriptionParams) error
+	SyncSubscription(ctx context.Context, db sqlc.DBTX, arg sqlc.SyncSubscriptionParams) error
+	RecordBillingEvent(ctx context.Context, db sqlc.DBTX, arg sqlc.RecordBillingEventParams) (int64, error)
+}
+
+type BillingRepository struct {
+	queries BillingWriteQueries
+}
+
+func NewBillingRepository(queries BillingWriteQueries) *BillingRepository {
+	return &BillingRepository{
+		queries: queries,
+	}
+}
+
+func (r *BillingRepository) CreateSubscription(ctx context.Context, tx sqlc.DBTX, companyID uuid.UUID, planID, status string) error {
+	err := r.queries.CreateSubscription(ctx, tx, sqlc.CreateSubscriptionParams{
+		CompanyID: companyID,
+		PlanID:    planID,
+		Status:    status,
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to create subscription", err)
+	}
+	return nil
+}
+
+func (r *BillingRepository) SyncSubscription(ctx context.Context, tx sqlc.DBTX, state shared.SubscriptionState) error {
+	err := r.queries.SyncSubscription(ctx, tx, sqlc.SyncSubscriptionParams{
+		CompanyID:              state.CompanyID,
+		PlanID:                 state.PlanID,
+		Status:                 state.Status,
+		ProviderCustomerID:     optionalText(state.CustomerID),
+		ProviderSubscriptionID: optionalText(state.SubscriptionID),
+		CurrentPeriodEnd:       pgconv.TimePtrToPgtype(state.CurrentPeriodEnd),
+		ProviderUpdatedAt:      pgconv.TimeToPgtype(state.UpdatedAt),
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to sync subscription", err)
+	}
+	return nil
+}
+
+func (r *BillingRepository) RecordEvent(ctx context.Context, tx sqlc.DBTX, eventID, eventType string) (bool, error) {
+	rows, err := r.queries.RecordBillingEvent(ctx, tx, sqlc.RecordBillingEventParams{
+		ProviderEventID: eventID,
+		EventType:       eventType,
+	})
+	if err != nil {
+		return false, infra.WrapRepoErr("failed to record billing event", err)
+	}
+	return rows > 0, nil
+}
+
+// optionalText stores an empty provider reference as NULL, keeping the unique index
+// on provider_subscription_id free for companies the provider has not seen yet.
+func optionalText(s string) pgtype.Text {
+	if s == "" {
+		return pgtype.Text{}
+	}
+	return pgconv.StringToPgtype(s)
+}
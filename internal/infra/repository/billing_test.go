@@ -0,0 +1,170 @@
+//go:build unit
+
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/infra/repository"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+	repositorymock "gin-clean-starter/tests/mock/repository"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestBillingRepository_SyncSubscription(t *testing.T) {
+	ctx := context.Background()
+	companyID := uuid.New()
+	updatedAt := time.Date(2026, 10, 16, 9, 30, 0, 0, time.UTC)
+	periodEnd := time.Date(2026, 11, 16, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		state         shared.SubscriptionState
+		setupMock     func(*repositorymock.MockBillingWriteQueries, sqlc.DBTX)
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name: "success: provider references stored",
+			state: shared.SubscriptionState{
+				CompanyID:        companyID,
+				PlanID:           "pro",
+				Status:           shared.SubscriptionActive,
+				CustomerID:       "cus_1",
+				SubscriptionID:   "sub_1",
+				CurrentPeriodEnd: &periodEnd,
+				UpdatedAt:        updatedAt,
+			},
+			setupMock: func(mock *repositorymock.MockBillingWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().SyncSubscription(ctx, db, sqlc.SyncSubscriptionParams{
+					CompanyID:              companyID,
+					PlanID:                 "pro",
+					Status:                 shared.SubscriptionActive,
+					ProviderCustomerID:     pgconv.StringToPgtype("cus_1"),
+					ProviderSubscriptionID: pgconv.StringToPgtype("sub_1"),
+					CurrentPeriodEnd:       pgconv.TimeToPgtype(periodEnd),
+					ProviderUpdatedAt:      pgconv.TimeToPgtype(updatedAt),
+				}).Return(nil)
+			},
+		},
+		{
+			name: "success: missing provider references stored as NULL",
+			state: shared.SubscriptionState{
+				CompanyID: companyID,
+				PlanID:    "free",
+				Status:    shared.SubscriptionCanceled,
+				UpdatedAt: updatedAt,
+			},
+			setupMock: func(mock *repositorymock.MockBillingWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().SyncSubscription(ctx, db, sqlc.SyncSubscriptionParams{
+					CompanyID:              companyID,
+					PlanID:                 "free",
+					Status:                 shared.SubscriptionCanceled,
+					ProviderCustomerID:     pgtype.Text{},
+					ProviderSubscriptionID: pgtype.Text{},
+					CurrentPeriodEnd:       pgtype.Timestamptz{},
+					ProviderUpdatedAt:      pgconv.TimeToPgtype(updatedAt),
+				}).Return(nil)
+			},
+		},
+		{
+			name:  "error: unknown company or plan",
+			state: shared.SubscriptionState{CompanyID: companyID, PlanID: "gold", Status: shared.SubscriptionActive, UpdatedAt: updatedAt},
+			setupMock: func(mock *repositorymock.MockBillingWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().SyncSubscription(ctx, db, gomock.Any()).Return(&pgconn.PgError{Code: "23503"})
+			},
+			expectedError: true,
+			expectKind:    infra.KindForeignKeyViolated,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockBillingWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewBillingRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			err := repo.SyncSubscription(ctx, mockDB, tc.state)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestBillingRepository_RecordEvent(t *testing.T) {
+	ctx := context.Background()
+	params := sqlc.RecordBillingEventParams{ProviderEventID: "evt_1", EventType: "subscription.updated"}
+
+	testCases := []struct {
+		name           string
+		setupMock      func(*repositorymock.MockBillingWriteQueries, sqlc.DBTX)
+		expectRecorded bool
+		expectedError  bool
+	}{
+		{
+			name: "success: first delivery recorded",
+			setupMock: func(mock *repositorymock.MockBillingWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().RecordBillingEvent(ctx, db, params).Return(int64(1), nil)
+			},
+			expectRecorded: true,
+		},
+		{
+			name: "success: retried delivery not recorded again",
+			setupMock: func(mock *repositorymock.MockBillingWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().RecordBillingEvent(ctx, db, params).Return(int64(0), nil)
+			},
+		},
+		{
+			name: "error: database error occurs",
+			setupMock: func(mock *repositorymock.MockBillingWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().RecordBillingEvent(ctx, db, params).Return(int64(0), errors.New("database connection error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockBillingWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewBillingRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			recorded, err := repo.RecordEvent(ctx, mockDB, "evt_1", "subscription.updated")
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, infra.KindDBFailure))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectRecorded, recorded)
+		})
+	}
+}
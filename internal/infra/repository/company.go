@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+type CompanyWriteQueries interface {
+	CreateCompany(ctx context.Context, db sqlc.DBTX, name string) (uuid.UUID, error)
+	CreateCompanySettings(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateCompanySettingsParams) error
+	SetCompanyFeature(ctx context.Context, db sqlc.DBTX, arg sqlc.SetCompanyFeatureParams) error
+	DeleteCompanyFeature(ctx context.Context, db sqlc.DBTX, arg sqlc.DeleteCompanyFeatureParams) error
+	SetCompanyReviewWindow(ctx context.Context, db sqlc.DBTX, arg sqlc.SetCompanyReviewWindowParams) error
+	SetCompanyBranding(ctx context.Context, db sqlc.DBTX, arg sqlc.SetCompanyBrandingParams) error
+}
+
+type CompanyRepository struct {
+	queries CompanyWriteQueries
+}
+
+func NewCompanyRepository(queries CompanyWriteQueries) *CompanyRepository {
+	return &CompanyRepository{
+		queries: queries,
+	}
+}
+
+// Create reports KindDuplicateKey when the company name is already taken.
+func (r *CompanyRepository) Create(ctx context.Context, tx sqlc.DBTX, name string) (uuid.UUID, error) {
+	id, err := r.queries.CreateCompany(ctx, tx, name)
+	if err != nil {
+		return uuid.Nil, infra.WrapRepoErr("failed to create company", err)
+	}
+	return id, nil
+}
+
+func (r *CompanyRepository) CreateSettings(ctx context.Context, tx sqlc.DBTX, params sqlc.CreateCompanySettingsParams) error {
+	if err := r.queries.CreateCompanySettings(ctx, tx, params); err != nil {
+		return infra.WrapRepoErr("failed to create company settings", err)
+	}
+	return nil
+}
+
+// SetFeature reports KindForeignKeyViolated when the company does not exist.
+func (r *CompanyRepository) SetFeature(ctx context.Context, tx sqlc.DBTX, companyID uuid.UUID, feature string, enabled bool, at time.Time) error {
+	err := r.queries.SetCompanyFeature(ctx, tx, sqlc.SetCompanyFeatureParams{
+		CompanyID: companyID,
+		Feature:   feature,
+		Enabled:   enabled,
+		UpdatedAt: pgconv.TimeToPgtype(at),
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to set company feature", err)
+	}
+	return nil
+}
+
+func (r *CompanyRepository) ClearFeature(ctx context.Context, tx sqlc.DBTX, companyID uuid.UUID, feature string) error {
+	err := r.queries.DeleteCompanyFeature(ctx, tx, sqlc.DeleteCompanyFeatureParams{
+		CompanyID: companyID,
+		Feature:   feature,
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to clear company feature", err)
+	}
+	return nil
+}
+
+// SetReviewWindow reports KindForeignKeyViolated when the company does not exist.
+func (r *CompanyRepository) SetReviewWindow(ctx context.Context, tx sqlc.DBTX, companyID uuid.UUID, setting shared.ReviewWindowSetting, at time.Time) error {
+	err := r.queries.SetCompanyReviewWindow(ctx, tx, sqlc.SetCompanyReviewWindowParams{
+		CompanyID:           companyID,
+		ReviewOpensAfterMin: pgconv.Int32PtrToPgtype(setting.OpensAfterMin),
+		ReviewWindowDays:    pgconv.Int32PtrToPgtype(setting.WindowDays),
+		UpdatedAt:           pgconv.TimeToPgtype(at),
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to set review window", err)
+	}
+	return nil
+}
+
+// SetBranding stores empty fields as NULL; CompanyName is not stored.
+func (r *CompanyRepository) SetBranding(ctx context.Context, tx sqlc.DBTX, companyID uuid.UUID, branding shared.CompanyBranding, at time.Time) error {
+	err := r.queries.SetCompanyBranding(ctx, tx, sqlc.SetCompanyBrandingParams{
+		CompanyID:         companyID,
+		BrandLogoUrl:      optionalText(branding.LogoURL),
+		BrandPrimaryColor: optionalText(branding.PrimaryColor),
+		BrandAccentColor:  optionalText(branding.AccentColor),
+		BrandReplyTo:      optionalText(branding.ReplyTo),
+		BrandFooter:       optionalText(branding.Footer),
+		UpdatedAt:         pgconv.TimeToPgtype(at),
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to set company branding", err)
+	}
+	return nil
+}
@@ -0,0 +1,242 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+type CompanyDeletionWriteQueries interface {
+	GetCompanyDeletionTarget(ctx context.Context, db sqlc.DBTX, arg sqlc.GetCompanyDeletionTargetParams) (sqlc.GetCompanyDeletionTargetRow, error)
+	CreateCompanyDeletion(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateCompanyDeletionParams) error
+	LockCompanyForDeletion(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID) (int64, error)
+	ClaimCompanyDeletion(ctx context.Context, db sqlc.DBTX, arg sqlc.ClaimCompanyDeletionParams) (sqlc.ClaimCompanyDeletionRow, error)
+	CheckpointCompanyDeletion(ctx context.Context, db sqlc.DBTX, arg sqlc.CheckpointCompanyDeletionParams) error
+	ReleaseCompanyDeletion(ctx context.Context, db sqlc.DBTX, arg sqlc.ReleaseCompanyDeletionParams) error
+	CompleteCompanyDeletion(ctx context.Context, db sqlc.DBTX, arg sqlc.CompleteCompanyDeletionParams) error
+	CancelCompanyReservations(ctx context.Context, db sqlc.DBTX, arg sqlc.CancelCompanyReservationsParams) ([]sqlc.CancelCompanyReservationsRow, error)
+	EnsureDeletedUser(ctx context.Context, db sqlc.DBTX, id uuid.UUID) error
+	AnonymizeCompanyReviews(ctx context.Context, db sqlc.DBTX, arg sqlc.AnonymizeCompanyReviewsParams) (int64, error)
+	ListCompanyObjects(ctx context.Context, db sqlc.DBTX, arg sqlc.ListCompanyObjectsParams) ([]string, error)
+	DeleteCompanyObjects(ctx context.Context, db sqlc.DBTX, storageKeys []string) error
+	DeleteCompanyUsers(ctx context.Context, db sqlc.DBTX, arg sqlc.DeleteCompanyUsersParams) (int64, error)
+	DeleteCompanyResources(ctx context.Context, db sqlc.DBTX, arg sqlc.DeleteCompanyResourcesParams) (int64, error)
+	DeleteCompany(ctx context.Context, db sqlc.DBTX, id uuid.UUID) error
+}
+
+type CompanyDeletionRepository struct {
+	queries CompanyDeletionWriteQueries
+}
+
+func NewCompanyDeletionRepository(queries CompanyDeletionWriteQueries) *CompanyDeletionRepository {
+	return &CompanyDeletionRepository{
+		queries: queries,
+	}
+}
+
+func (r *CompanyDeletionRepository) FindTarget(ctx context.Context, tx sqlc.DBTX, companyID, actorID uuid.UUID) (*shared.CompanyDeletionTarget, error) {
+	row, err := r.queries.GetCompanyDeletionTarget(ctx, tx, sqlc.GetCompanyDeletionTargetParams{
+		ActorID:   actorID,
+		CompanyID: companyID,
+	})
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return nil, infra.WrapRepoErr("company not found", err, infra.KindNotFound)
+		}
+		return nil, infra.WrapRepoErr("failed to find company to delete", err)
+	}
+	return &shared.CompanyDeletionTarget{Name: row.Name, ActorIsMember: row.Member}, nil
+}
+
+func (r *CompanyDeletionRepository) Create(ctx context.Context, tx sqlc.DBTX, deletion shared.CompanyDeletion) error {
+	counts, err := json.Marshal(deletion.Counts)
+	if err != nil {
+		return infra.WrapRepoErr("failed to encode company deletion counts", err)
+	}
+	err = r.queries.CreateCompanyDeletion(ctx, tx, sqlc.CreateCompanyDeletionParams{
+		ID:          deletion.ID,
+		CompanyID:   deletion.CompanyID,
+		CompanyName: deletion.CompanyName,
+		RequestedBy: pgconv.UUIDPtrToPgtype(deletion.RequestedBy),
+		Stage:       string(deletion.Stage),
+		Counts:      counts,
+		CreatedAt:   pgconv.TimeToPgtype(deletion.CreatedAt),
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to create company deletion", err)
+	}
+	return nil
+}
+
+func (r *CompanyDeletionRepository) Lock(ctx context.Context, tx sqlc.DBTX, companyID uuid.UUID) (int64, error) {
+	n, err := r.queries.LockCompanyForDeletion(ctx, tx, companyID)
+	if err != nil {
+		return 0, infra.WrapRepoErr("failed to lock company for deletion", err)
+	}
+	return n, nil
+}
+
+func (r *CompanyDeletionRepository) Claim(ctx context.Context, db sqlc.DBTX, now, staleBefore time.Time) (*shared.CompanyDeletion, error) {
+	row, err := r.queries.ClaimCompanyDeletion(ctx, db, sqlc.ClaimCompanyDeletionParams{
+		Now:         pgconv.TimeToPgtype(now),
+		StaleBefore: pgconv.TimeToPgtype(staleBefore),
+	})
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return nil, infra.WrapRepoErr("no company deletion queued", err, infra.KindNotFound)
+		}
+		return nil, infra.WrapRepoErr("failed to claim company deletion", err)
+	}
+
+	counts := map[string]int64{}
+	if err := json.Unmarshal(row.Counts, &counts); err != nil {
+		return nil, infra.WrapRepoErr("failed to decode company deletion counts", err)
+	}
+	return &shared.CompanyDeletion{
+		ID:          row.ID,
+		CompanyID:   row.CompanyID,
+		CompanyName: row.CompanyName,
+		RequestedBy: pgconv.UUIDPtrFromPgtype(row.RequestedBy),
+		Stage:       shared.CompanyDeletionStage(row.Stage),
+		Counts:      counts,
+		CreatedAt:   pgconv.TimeFromPgtype(row.CreatedAt),
+		StartedAt:   pgconv.TimeFromPgtype(row.StartedAt),
+	}, nil
+}
+
+func (r *CompanyDeletionRepository) Checkpoint(ctx context.Context, tx sqlc.DBTX, id uuid.UUID, stage shared.CompanyDeletionStage, counts map[string]int64, now time.Time) error {
+	raw, err := json.Marshal(counts)
+	if err != nil {
+		return infra.WrapRepoErr("failed to encode company deletion counts", err)
+	}
+	err = r.queries.CheckpointCompanyDeletion(ctx, tx, sqlc.CheckpointCompanyDeletionParams{
+		ID:        id,
+		Stage:     string(stage),
+		Counts:    raw,
+		UpdatedAt: pgconv.TimeToPgtype(now),
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to checkpoint company deletion", err)
+	}
+	return nil
+}
+
+func (r *CompanyDeletionRepository) Release(ctx context.Context, db sqlc.DBTX, id uuid.UUID, reason string, now time.Time) error {
+	err := r.queries.ReleaseCompanyDeletion(ctx, db, sqlc.ReleaseCompanyDeletionParams{
+		ID:        id,
+		Error:     pgconv.StringToPgtype(reason),
+		UpdatedAt: pgconv.TimeToPgtype(now),
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to release company deletion", err)
+	}
+	return nil
+}
+
+func (r *CompanyDeletionRepository) Complete(ctx context.Context, tx sqlc.DBTX, id uuid.UUID, certificate json.RawMessage, completedAt time.Time) error {
+	err := r.queries.CompleteCompanyDeletion(ctx, tx, sqlc.CompleteCompanyDeletionParams{
+		ID:          id,
+		Certificate: certificate,
+		CompletedAt: pgconv.TimeToPgtype(completedAt),
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to complete company deletion", err)
+	}
+	return nil
+}
+
+func (r *CompanyDeletionRepository) CancelReservations(ctx context.Context, tx sqlc.DBTX, companyID uuid.UUID, now time.Time, limit int32) ([]shared.PurgedReservation, error) {
+	rows, err := r.queries.CancelCompanyReservations(ctx, tx, sqlc.CancelCompanyReservationsParams{
+		Now:       pgconv.TimeToPgtype(now),
+		CompanyID: companyID,
+		BatchSize: limit,
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to cancel company reservations", err)
+	}
+	canceled := make([]shared.PurgedReservation, len(rows))
+	for i, row := range rows {
+		canceled[i] = shared.PurgedReservation{
+			ID:         row.ID,
+			UserID:     row.UserID,
+			ResourceID: row.ResourceID,
+			StartTime:  pgconv.TimeFromPgtype(row.StartTime),
+			EndTime:    pgconv.TimeFromPgtype(row.EndTime),
+			Member:     row.Member,
+		}
+	}
+	return canceled, nil
+}
+
+func (r *CompanyDeletionRepository) AnonymizeReviews(ctx context.Context, tx sqlc.DBTX, companyID uuid.UUID, limit int32) (int64, error) {
+	if err := r.queries.EnsureDeletedUser(ctx, tx, shared.DeletedUserID); err != nil {
+		return 0, infra.WrapRepoErr("failed to create deleted-user placeholder", err)
+	}
+	n, err := r.queries.AnonymizeCompanyReviews(ctx, tx, sqlc.AnonymizeCompanyReviewsParams{
+		DeletedUserID: shared.DeletedUserID,
+		CompanyID:     companyID,
+		BatchSize:     limit,
+	})
+	if err != nil {
+		return 0, infra.WrapRepoErr("failed to anonymize company reviews", err)
+	}
+	return n, nil
+}
+
+func (r *CompanyDeletionRepository) ListObjects(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID, limit int32) ([]string, error) {
+	keys, err := r.queries.ListCompanyObjects(ctx, db, sqlc.ListCompanyObjectsParams{
+		CompanyID: companyID,
+		BatchSize: limit,
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list company files", err)
+	}
+	return keys, nil
+}
+
+func (r *CompanyDeletionRepository) DeleteObjects(ctx context.Context, tx sqlc.DBTX, keys []string) error {
+	if err := r.queries.DeleteCompanyObjects(ctx, tx, keys); err != nil {
+		return infra.WrapRepoErr("failed to delete company file rows", err)
+	}
+	return nil
+}
+
+func (r *CompanyDeletionRepository) DeleteUsers(ctx context.Context, tx sqlc.DBTX, companyID uuid.UUID, limit int32) (int64, error) {
+	if err := r.queries.EnsureDeletedUser(ctx, tx, shared.DeletedUserID); err != nil {
+		return 0, infra.WrapRepoErr("failed to create deleted-user placeholder", err)
+	}
+	n, err := r.queries.DeleteCompanyUsers(ctx, tx, sqlc.DeleteCompanyUsersParams{
+		CompanyID:     companyID,
+		BatchSize:     limit,
+		DeletedUserID: shared.DeletedUserID,
+	})
+	if err != nil {
+		return 0, infra.WrapRepoErr("failed to delete company users", err)
+	}
+	return n, nil
+}
+
+func (r *CompanyDeletionRepository) DeleteResources(ctx context.Context, tx sqlc.DBTX, companyID uuid.UUID, limit int32) (int64, error) {
+	n, err := r.queries.DeleteCompanyResources(ctx, tx, sqlc.DeleteCompanyResourcesParams{
+		CompanyID: companyID,
+		BatchSize: limit,
+	})
+	if err != nil {
+		return 0, infra.WrapRepoErr("failed to delete company resources", err)
+	}
+	return n, nil
+}
+
+func (r *CompanyDeletionRepository) DeleteCompany(ctx context.Context, tx sqlc.DBTX, companyID uuid.UUID) error {
+	if err := r.queries.DeleteCompany(ctx, tx, companyID); err != nil {
+		return infra.WrapRepoErr("failed to delete company", err)
+	}
+	return nil
+}
@@ -0,0 +1,241 @@
+//go:build unit
+
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/infra/repository"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+	repositorymock "gin-clean-starter/tests/mock/repository"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCompanyDeletionRepository_Create(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	actorID := uuid.New()
+	deletion := shared.CompanyDeletion{
+		ID:          uuid.New(),
+		CompanyID:   uuid.New(),
+		CompanyName: "Acme",
+		RequestedBy: &actorID,
+		Stage:       shared.CompanyDeletionCancelReservations,
+		Counts:      map[string]int64{"users_deactivated": 3},
+		CreatedAt:   now,
+	}
+
+	testCases := []struct {
+		name          string
+		setupMock     func(*repositorymock.MockCompanyDeletionWriteQueries, sqlc.DBTX)
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name: "success: deletion queued with its counts",
+			setupMock: func(mock *repositorymock.MockCompanyDeletionWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().CreateCompanyDeletion(ctx, db, sqlc.CreateCompanyDeletionParams{
+					ID:          deletion.ID,
+					CompanyID:   deletion.CompanyID,
+					CompanyName: "Acme",
+					RequestedBy: pgconv.UUIDToPgtype(actorID),
+					Stage:       "cancel_reservations",
+					Counts:      []byte(`{"users_deactivated":3}`),
+					CreatedAt:   pgconv.TimeToPgtype(now),
+				}).Return(nil)
+			},
+		},
+		{
+			name: "error: a deletion is already in progress",
+			setupMock: func(mock *repositorymock.MockCompanyDeletionWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().CreateCompanyDeletion(ctx, db, gomock.Any()).Return(&pgconn.PgError{Code: "23505"})
+			},
+			expectedError: true,
+			expectKind:    infra.KindDuplicateKey,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockCompanyDeletionWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewCompanyDeletionRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			err := repo.Create(ctx, mockDB, deletion)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCompanyDeletionRepository_Claim(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	staleBefore := now.Add(-10 * time.Minute)
+	deletionID := uuid.New()
+	companyID := uuid.New()
+
+	testCases := []struct {
+		name          string
+		setupMock     func(*repositorymock.MockCompanyDeletionWriteQueries, sqlc.DBTX)
+		expected      *shared.CompanyDeletion
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name: "success: resumes at the checkpointed stage",
+			setupMock: func(mock *repositorymock.MockCompanyDeletionWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().ClaimCompanyDeletion(ctx, db, sqlc.ClaimCompanyDeletionParams{
+					Now:         pgconv.TimeToPgtype(now),
+					StaleBefore: pgconv.TimeToPgtype(staleBefore),
+				}).Return(sqlc.ClaimCompanyDeletionRow{
+					ID:          deletionID,
+					CompanyID:   companyID,
+					CompanyName: "Acme",
+					Stage:       "purge_storage",
+					Counts:      []byte(`{"reservations_canceled":4}`),
+					CreatedAt:   pgconv.TimeToPgtype(now),
+					StartedAt:   pgconv.TimeToPgtype(now),
+				}, nil)
+			},
+			expected: &shared.CompanyDeletion{
+				ID:          deletionID,
+				CompanyID:   companyID,
+				CompanyName: "Acme",
+				Stage:       shared.CompanyDeletionPurgeStorage,
+				Counts:      map[string]int64{"reservations_canceled": 4},
+				CreatedAt:   now,
+				StartedAt:   now,
+			},
+		},
+		{
+			name: "error: nothing queued",
+			setupMock: func(mock *repositorymock.MockCompanyDeletionWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().ClaimCompanyDeletion(ctx, db, gomock.Any()).Return(sqlc.ClaimCompanyDeletionRow{}, pgx.ErrNoRows)
+			},
+			expectedError: true,
+			expectKind:    infra.KindNotFound,
+		},
+		{
+			name: "error: database error occurs",
+			setupMock: func(mock *repositorymock.MockCompanyDeletionWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().ClaimCompanyDeletion(ctx, db, gomock.Any()).Return(sqlc.ClaimCompanyDeletionRow{}, errors.New("database connection error"))
+			},
+			expectedError: true,
+			expectKind:    infra.KindDBFailure,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockCompanyDeletionWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewCompanyDeletionRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			deletion, err := repo.Claim(ctx, mockDB, now, staleBefore)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, deletion)
+		})
+	}
+}
+
+func TestCompanyDeletionRepository_DeleteUsers(t *testing.T) {
+	ctx := context.Background()
+	companyID := uuid.New()
+
+	testCases := []struct {
+		name          string
+		setupMock     func(*repositorymock.MockCompanyDeletionWriteQueries, sqlc.DBTX)
+		expected      int64
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name: "success: placeholder ensured before the batch",
+			setupMock: func(mock *repositorymock.MockCompanyDeletionWriteQueries, db sqlc.DBTX) {
+				gomock.InOrder(
+					mock.EXPECT().EnsureDeletedUser(ctx, db, shared.DeletedUserID).Return(nil),
+					mock.EXPECT().DeleteCompanyUsers(ctx, db, sqlc.DeleteCompanyUsersParams{
+						CompanyID:     companyID,
+						BatchSize:     50,
+						DeletedUserID: shared.DeletedUserID,
+					}).Return(int64(12), nil),
+				)
+			},
+			expected: 12,
+		},
+		{
+			name: "error: placeholder cannot be created",
+			setupMock: func(mock *repositorymock.MockCompanyDeletionWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().EnsureDeletedUser(ctx, db, shared.DeletedUserID).Return(errors.New("database connection error"))
+			},
+			expectedError: true,
+			expectKind:    infra.KindDBFailure,
+		},
+		{
+			name: "error: a row still refers to a member",
+			setupMock: func(mock *repositorymock.MockCompanyDeletionWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().EnsureDeletedUser(ctx, db, shared.DeletedUserID).Return(nil)
+				mock.EXPECT().DeleteCompanyUsers(ctx, db, gomock.Any()).Return(int64(0), &pgconn.PgError{Code: "23503"})
+			},
+			expectedError: true,
+			expectKind:    infra.KindForeignKeyViolated,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockCompanyDeletionWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewCompanyDeletionRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			n, err := repo.DeleteUsers(ctx, mockDB, companyID, 50)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, n)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type CompanyExportWriteQueries interface {
+	CreateCompanyExport(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateCompanyExportParams) error
+	ClaimCompanyExport(ctx context.Context, db sqlc.DBTX, arg sqlc.ClaimCompanyExportParams) (sqlc.ClaimCompanyExportRow, error)
+	SetCompanyExportProgress(ctx context.Context, db sqlc.DBTX, arg sqlc.SetCompanyExportProgressParams) error
+	CompleteCompanyExport(ctx context.Context, db sqlc.DBTX, arg sqlc.CompleteCompanyExportParams) error
+	FailCompanyExport(ctx context.Context, db sqlc.DBTX, arg sqlc.FailCompanyExportParams) error
+	DeleteCompanyExport(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (pgtype.Text, error)
+}
+
+type CompanyExportRepository struct {
+	queries CompanyExportWriteQueries
+}
+
+func NewCompanyExportRepository(queries CompanyExportWriteQueries) *CompanyExportRepository {
+	return &CompanyExportRepository{
+		queries: queries,
+	}
+}
+
+func (r *CompanyExportRepository) Create(ctx context.Context, tx sqlc.DBTX, export shared.CompanyExport) error {
+	err := r.queries.CreateCompanyExport(ctx, tx, sqlc.CreateCompanyExportParams{
+		ID:          export.ID,
+		CompanyID:   export.CompanyID,
+		RequestedBy: pgconv.UUIDToPgtype(export.RequestedBy),
+		CreatedAt:   pgconv.TimeToPgtype(export.CreatedAt),
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to create company export", err)
+	}
+	return nil
+}
+
+func (r *CompanyExportRepository) Claim(ctx context.Context, db sqlc.DBTX, now, staleBefore time.Time) (*shared.CompanyExport, error) {
+	row, err := r.queries.ClaimCompanyExport(ctx, db, sqlc.ClaimCompanyExportParams{
+		Now:         pgconv.TimeToPgtype(now),
+		StaleBefore: pgconv.TimeToPgtype(staleBefore),
+	})
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return nil, infra.WrapRepoErr("no company export queued", err, infra.KindNotFound)
+		}
+		return nil, infra.WrapRepoErr("failed to claim company export", err)
+	}
+	return &shared.CompanyExport{ID: row.ID, CompanyID: row.CompanyID}, nil
+}
+
+func (r *CompanyExportRepository) SetProgress(ctx context.Context, db sqlc.DBTX, id uuid.UUID, progress int32) error {
+	err := r.queries.SetCompanyExportProgress(ctx, db, sqlc.SetCompanyExportProgressParams{
+		ID:       id,
+		Progress: progress,
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to update company export progress", err)
+	}
+	return nil
+}
+
+func (r *CompanyExportRepository) Complete(ctx context.Context, db sqlc.DBTX, id uuid.UUID, storageKey string, sizeBytes int64, completedAt, expiresAt time.Time) error {
+	err := r.queries.CompleteCompanyExport(ctx, db, sqlc.CompleteCompanyExportParams{
+		ID:          id,
+		StorageKey:  pgconv.StringToPgtype(storageKey),
+		SizeBytes:   pgtype.Int8{Int64: sizeBytes, Valid: true},
+		CompletedAt: pgconv.TimeToPgtype(completedAt),
+		ExpiresAt:   pgconv.TimeToPgtype(expiresAt),
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to complete company export", err)
+	}
+	return nil
+}
+
+func (r *CompanyExportRepository) Fail(ctx context.Context, db sqlc.DBTX, id uuid.UUID, reason string, completedAt, expiresAt time.Time) error {
+	err := r.queries.FailCompanyExport(ctx, db, sqlc.FailCompanyExportParams{
+		ID:          id,
+		Error:       pgconv.StringToPgtype(reason),
+		CompletedAt: pgconv.TimeToPgtype(completedAt),
+		ExpiresAt:   pgconv.TimeToPgtype(expiresAt),
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to record company export failure", err)
+	}
+	return nil
+}
+
+func (r *CompanyExportRepository) Delete(ctx context.Context, tx sqlc.DBTX, id uuid.UUID) (string, error) {
+	key, err := r.queries.DeleteCompanyExport(ctx, tx, id)
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return "", infra.WrapRepoErr("company export not found", err, infra.KindNotFound)
+		}
+		return "", infra.WrapRepoErr("failed to delete company export", err)
+	}
+	return key.String, nil
+}
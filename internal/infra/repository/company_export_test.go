@@ -0,0 +1,218 @@
+//go:build unit
+
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/infra/repository"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+	repositorymock "gin-clean-starter/tests/mock/repository"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCompanyExportRepository_Create(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	export := shared.CompanyExport{
+		ID:          uuid.New(),
+		CompanyID:   uuid.New(),
+		RequestedBy: uuid.New(),
+		CreatedAt:   now,
+	}
+
+	testCases := []struct {
+		name          string
+		setupMock     func(*repositorymock.MockCompanyExportWriteQueries, sqlc.DBTX)
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name: "success: export queued",
+			setupMock: func(mock *repositorymock.MockCompanyExportWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().CreateCompanyExport(ctx, db, sqlc.CreateCompanyExportParams{
+					ID:          export.ID,
+					CompanyID:   export.CompanyID,
+					RequestedBy: pgconv.UUIDToPgtype(export.RequestedBy),
+					CreatedAt:   pgconv.TimeToPgtype(now),
+				}).Return(nil)
+			},
+		},
+		{
+			name: "error: an export is already in progress",
+			setupMock: func(mock *repositorymock.MockCompanyExportWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().CreateCompanyExport(ctx, db, gomock.Any()).Return(&pgconn.PgError{Code: "23505"})
+			},
+			expectedError: true,
+			expectKind:    infra.KindDuplicateKey,
+		},
+		{
+			name: "error: company does not exist",
+			setupMock: func(mock *repositorymock.MockCompanyExportWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().CreateCompanyExport(ctx, db, gomock.Any()).Return(&pgconn.PgError{Code: "23503"})
+			},
+			expectedError: true,
+			expectKind:    infra.KindForeignKeyViolated,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockCompanyExportWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewCompanyExportRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			err := repo.Create(ctx, mockDB, export)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCompanyExportRepository_Claim(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	staleBefore := now.Add(-30 * time.Minute)
+	exportID := uuid.New()
+	companyID := uuid.New()
+
+	testCases := []struct {
+		name          string
+		setupMock     func(*repositorymock.MockCompanyExportWriteQueries, sqlc.DBTX)
+		expected      *shared.CompanyExport
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name: "success: oldest export claimed",
+			setupMock: func(mock *repositorymock.MockCompanyExportWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().ClaimCompanyExport(ctx, db, sqlc.ClaimCompanyExportParams{
+					Now:         pgconv.TimeToPgtype(now),
+					StaleBefore: pgconv.TimeToPgtype(staleBefore),
+				}).Return(sqlc.ClaimCompanyExportRow{ID: exportID, CompanyID: companyID}, nil)
+			},
+			expected: &shared.CompanyExport{ID: exportID, CompanyID: companyID},
+		},
+		{
+			name: "error: nothing queued",
+			setupMock: func(mock *repositorymock.MockCompanyExportWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().ClaimCompanyExport(ctx, db, gomock.Any()).Return(sqlc.ClaimCompanyExportRow{}, pgx.ErrNoRows)
+			},
+			expectedError: true,
+			expectKind:    infra.KindNotFound,
+		},
+		{
+			name: "error: database error occurs",
+			setupMock: func(mock *repositorymock.MockCompanyExportWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().ClaimCompanyExport(ctx, db, gomock.Any()).Return(sqlc.ClaimCompanyExportRow{}, errors.New("database connection error"))
+			},
+			expectedError: true,
+			expectKind:    infra.KindDBFailure,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockCompanyExportWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewCompanyExportRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			export, err := repo.Claim(ctx, mockDB, now, staleBefore)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, export)
+		})
+	}
+}
+
+func TestCompanyExportRepository_Delete(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+
+	testCases := []struct {
+		name          string
+		setupMock     func(*repositorymock.MockCompanyExportWriteQueries, sqlc.DBTX)
+		expected      string
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name: "success: archive key returned",
+			setupMock: func(mock *repositorymock.MockCompanyExportWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().DeleteCompanyExport(ctx, db, id).Return(pgconv.StringToPgtype("company-exports/a.zip"), nil)
+			},
+			expected: "company-exports/a.zip",
+		},
+		{
+			name: "success: failed export has no archive",
+			setupMock: func(mock *repositorymock.MockCompanyExportWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().DeleteCompanyExport(ctx, db, id).Return(pgtype.Text{}, nil)
+			},
+			expected: "",
+		},
+		{
+			name: "error: export not found",
+			setupMock: func(mock *repositorymock.MockCompanyExportWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().DeleteCompanyExport(ctx, db, id).Return(pgtype.Text{}, pgx.ErrNoRows)
+			},
+			expectedError: true,
+			expectKind:    infra.KindNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockCompanyExportWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewCompanyExportRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			key, err := repo.Delete(ctx, mockDB, id)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, key)
+		})
+	}
+}
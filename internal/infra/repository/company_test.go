@@ -0,0 +1,220 @@
+//go:build unit
+
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/infra/repository"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/usecase/shared"
+	repositorymock "gin-clean-starter/tests/mock/repository"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCompanyRepository_Create(t *testing.T) {
+	ctx := context.Background()
+	companyID := uuid.New()
+
+	testCases := []struct {
+		name          string
+		setupMock     func(*repositorymock.MockCompanyWriteQueries, sqlc.DBTX)
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name: "success: company created",
+			setupMock: func(mock *repositorymock.MockCompanyWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().CreateCompany(ctx, db, "Acme").Return(companyID, nil)
+			},
+		},
+		{
+			name: "error: duplicate company name",
+			setupMock: func(mock *repositorymock.MockCompanyWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().CreateCompany(ctx, db, "Acme").Return(uuid.Nil, &pgconn.PgError{Code: "23505"})
+			},
+			expectedError: true,
+			expectKind:    infra.KindDuplicateKey,
+		},
+		{
+			name: "error: database failure",
+			setupMock: func(mock *repositorymock.MockCompanyWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().CreateCompany(ctx, db, "Acme").Return(uuid.Nil, errors.New("connection reset"))
+			},
+			expectedError: true,
+			expectKind:    infra.KindDBFailure,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockCompanyWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewCompanyRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			id, err := repo.Create(ctx, mockDB, "Acme")
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, companyID, id)
+		})
+	}
+}
+
+func TestCompanyRepository_CreateSettings(t *testing.T) {
+	ctx := context.Background()
+	params := sqlc.CreateCompanySettingsParams{CompanyID: uuid.New(), Timezone: "Asia/Tokyo", DefaultLeadTimeMin: 30}
+
+	testCases := []struct {
+		name          string
+		setupMock     func(*repositorymock.MockCompanyWriteQueries, sqlc.DBTX)
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name: "success: settings stored",
+			setupMock: func(mock *repositorymock.MockCompanyWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().CreateCompanySettings(ctx, db, params).Return(nil)
+			},
+		},
+		{
+			name: "error: unknown company violates foreign key",
+			setupMock: func(mock *repositorymock.MockCompanyWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().CreateCompanySettings(ctx, db, params).Return(&pgconn.PgError{Code: "23503"})
+			},
+			expectedError: true,
+			expectKind:    infra.KindForeignKeyViolated,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockCompanyWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewCompanyRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			err := repo.CreateSettings(ctx, mockDB, params)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCompanyRepository_SetReviewWindow(t *testing.T) {
+	ctx := context.Background()
+	companyID := uuid.New()
+	at := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+	days := int32(90)
+
+	testCases := []struct {
+		name          string
+		setting       shared.ReviewWindowSetting
+		setupMock     func(*repositorymock.MockCompanyWriteQueries, sqlc.DBTX)
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name:    "success: unset fields are stored as NULL",
+			setting: shared.ReviewWindowSetting{WindowDays: &days},
+			setupMock: func(mock *repositorymock.MockCompanyWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().SetCompanyReviewWindow(ctx, db, sqlc.SetCompanyReviewWindowParams{
+					CompanyID:           companyID,
+					ReviewOpensAfterMin: pgtype.Int4{},
+					ReviewWindowDays:    pgtype.Int4{Int32: 90, Valid: true},
+					UpdatedAt:           pgtype.Timestamptz{Time: at, Valid: true},
+				}).Return(nil)
+			},
+		},
+		{
+			name: "error: unknown company violates foreign key",
+			setupMock: func(mock *repositorymock.MockCompanyWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().SetCompanyReviewWindow(ctx, db, gomock.Any()).Return(&pgconn.PgError{Code: "23503"})
+			},
+			expectedError: true,
+			expectKind:    infra.KindForeignKeyViolated,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockCompanyWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewCompanyRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			err := repo.SetReviewWindow(ctx, mockDB, companyID, tc.setting, at)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCompanyRepository_SetBranding(t *testing.T) {
+	ctx := context.Background()
+	companyID := uuid.New()
+	at := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQueries := repositorymock.NewMockCompanyWriteQueries(ctrl)
+	mockDB := &mockDBTX{}
+	repo := repository.NewCompanyRepository(mockQueries)
+
+	mockQueries.EXPECT().SetCompanyBranding(ctx, mockDB, sqlc.SetCompanyBrandingParams{
+		CompanyID:         companyID,
+		BrandLogoUrl:      pgtype.Text{String: "https://cdn.example.com/acme.png", Valid: true},
+		BrandPrimaryColor: pgtype.Text{String: "#112233", Valid: true},
+		BrandAccentColor:  pgtype.Text{},
+		BrandReplyTo:      pgtype.Text{},
+		BrandFooter:       pgtype.Text{String: "Acme Inc.", Valid: true},
+		UpdatedAt:         pgtype.Timestamptz{Time: at, Valid: true},
+	}).Return(nil)
+
+	err := repo.SetBranding(ctx, mockDB, companyID, shared.CompanyBranding{
+		CompanyName:  "ignored",
+		LogoURL:      "https://cdn.example.com/acme.png",
+		PrimaryColor: "#112233",
+		Footer:       "Acme Inc.",
+	}, at)
+
+	require.NoError(t, err)
+}
@@ -1,6 +1,7 @@
 package converter
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
 	"time"
@@ -8,6 +9,7 @@ import (
 	"gin-clean-starter/internal/domain/reservation"
 	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
@@ -21,11 +23,12 @@ func ReservationToInfra(res *reservation.Reservation) sqlc.CreateReservationPara
 	}
 
 	params := sqlc.CreateReservationParams{
-		ResourceID: res.ResourceID(),
-		UserID:     res.UserID(),
-		Slot:       tstzrange,
-		Status:     res.Status().String(),
-		PriceCents: int32(cents),
+		ResourceID:   res.ResourceID(),
+		UserID:       res.UserID(),
+		Slot:         tstzrange,
+		Status:       res.Status().String(),
+		PriceCents:   int32(cents),
+		CustomFields: customFieldsToInfra(res.CustomFields()),
 	}
 
 	if couponID := res.CouponID(); couponID != nil {
@@ -34,12 +37,32 @@ func ReservationToInfra(res *reservation.Reservation) sqlc.CreateReservationPara
 		params.CouponID = pgtype.UUID{Valid: false}
 	}
 
-	noteStr := res.Note().String()
-	if noteStr != "" {
-		params.Note = pgtype.Text{String: noteStr, Valid: true}
-	} else {
-		params.Note = pgtype.Text{Valid: false}
+	return params
+}
+
+// customFieldsToInfra writes an empty object rather than NULL for a reservation without values.
+func customFieldsToInfra(fields reservation.CustomFields) []byte {
+	if len(fields) == 0 {
+		return []byte("{}")
 	}
+	data, err := json.Marshal(fields)
+	if err != nil {
+		// NewCustomFields only admits strings and finite numbers
+		panic(fmt.Sprintf("custom fields not encodable: %v", err))
+	}
+	return data
+}
 
+// ReservationDiscountsToInfra numbers the line items in application order.
+func ReservationDiscountsToInfra(reservationID uuid.UUID, discounts []reservation.AppliedDiscount) []sqlc.CreateReservationDiscountParams {
+	params := make([]sqlc.CreateReservationDiscountParams, len(discounts))
+	for i, d := range discounts {
+		params[i] = sqlc.CreateReservationDiscountParams{
+			ReservationID: reservationID,
+			Position:      int16(i),
+			CouponID:      d.CouponID,
+			AmountCents:   int32(d.Amount.Cents()),
+		}
+	}
 	return params
 }
@@ -6,6 +6,7 @@ import (
 	"gin-clean-starter/internal/pkg/pgconv"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 func ReviewToCreateParams(r *review.Review) sqlc.CreateReviewParams {
@@ -13,16 +14,40 @@ func ReviewToCreateParams(r *review.Review) sqlc.CreateReviewParams {
 		ID:            r.ID(),
 		UserID:        r.UserID(),
 		ResourceID:    r.ResourceID(),
-		ReservationID: r.ReservationID(),
+		ReservationID: pgconv.UUIDToPgtype(r.ReservationID()),
 		Rating:        pgconv.IntToInt32(r.Rating().Value()),
 		Comment:       r.Comment().String(),
+		Status:        string(r.Status()),
+		DuplicateOf:   pgconv.UUIDPtrToPgtype(r.DuplicateOf()),
+		Language:      languageToPgtype(r.Language()),
+	}
+}
+
+func ReviewToImportParams(r *review.Review, source, externalID string) sqlc.ImportReviewParams {
+	return sqlc.ImportReviewParams{
+		ID:           r.ID(),
+		UserID:       r.UserID(),
+		ResourceID:   r.ResourceID(),
+		Rating:       pgconv.IntToInt32(r.Rating().Value()),
+		Comment:      r.Comment().String(),
+		Language:     languageToPgtype(r.Language()),
+		ImportSource: pgconv.StringToPgtype(source),
+		ExternalID:   pgconv.StringToPgtype(externalID),
+		CreatedAt:    pgconv.TimeToPgtype(r.CreatedAt()),
+		UpdatedAt:    pgconv.TimeToPgtype(r.UpdatedAt()),
 	}
 }
 
 func ReviewToUpdateParams(id uuid.UUID, r *review.Review) sqlc.UpdateReviewParams {
 	return sqlc.UpdateReviewParams{
-		ID:      id,
-		Rating:  pgconv.IntToInt32(r.Rating().Value()),
-		Comment: r.Comment().String(),
+		ID:       id,
+		Rating:   pgconv.IntToInt32(r.Rating().Value()),
+		Comment:  r.Comment().String(),
+		Language: languageToPgtype(r.Language()),
 	}
 }
+
+// languageToPgtype stores an undetected language as NULL.
+func languageToPgtype(code string) pgtype.Text {
+	return pgtype.Text{String: code, Valid: code != ""}
+}
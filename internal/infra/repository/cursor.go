@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var errUnsupportedCursor = errs.New("unsupported cursor")
+
+type CursorQueries interface {
+	CountFutureSubscriptionWatermarks(ctx context.Context, db sqlc.DBTX, limitAt pgtype.Timestamptz) (int64, error)
+	ClearFutureSubscriptionWatermarks(ctx context.Context, db sqlc.DBTX, limitAt pgtype.Timestamptz) (int64, error)
+	CountFutureMessageReads(ctx context.Context, db sqlc.DBTX, limitAt pgtype.Timestamptz) (int64, error)
+	ClampFutureMessageReads(ctx context.Context, db sqlc.DBTX, arg sqlc.ClampFutureMessageReadsParams) (int64, error)
+	CountFutureNotificationJobs(ctx context.Context, db sqlc.DBTX, limitAt pgtype.Timestamptz) (int64, error)
+	ReleaseFutureNotificationJobs(ctx context.Context, db sqlc.DBTX, arg sqlc.ReleaseFutureNotificationJobsParams) (int64, error)
+}
+
+type CursorRepository struct {
+	queries CursorQueries
+}
+
+func NewCursorRepository(queries CursorQueries) *CursorRepository {
+	return &CursorRepository{
+		queries: queries,
+	}
+}
+
+func (r *CursorRepository) CountInvalid(ctx context.Context, db sqlc.DBTX, cursor string, limit time.Time) (int64, error) {
+	ts := pgtype.Timestamptz{Time: limit, Valid: true}
+
+	var (
+		count int64
+		err   error
+	)
+	switch cursor {
+	case shared.CursorBillingWebhooks:
+		count, err = r.queries.CountFutureSubscriptionWatermarks(ctx, db, ts)
+	case shared.CursorMessageReads:
+		count, err = r.queries.CountFutureMessageReads(ctx, db, ts)
+	case shared.CursorNotificationOutbox:
+		count, err = r.queries.CountFutureNotificationJobs(ctx, db, ts)
+	default:
+		return 0, infra.WrapRepoErr("failed to count invalid cursors", errs.Wrap(errUnsupportedCursor, cursor))
+	}
+	if err != nil {
+		return 0, infra.WrapRepoErr("failed to count invalid cursors in "+cursor, err)
+	}
+
+	return count, nil
+}
+
+func (r *CursorRepository) Repair(ctx context.Context, db sqlc.DBTX, cursor string, limit, now time.Time) (int64, error) {
+	limitAt := pgtype.Timestamptz{Time: limit, Valid: true}
+	nowAt := pgtype.Timestamptz{Time: now, Valid: true}
+
+	var (
+		repaired int64
+		err      error
+	)
+	switch cursor {
+	case shared.CursorBillingWebhooks:
+		repaired, err = r.queries.ClearFutureSubscriptionWatermarks(ctx, db, limitAt)
+	case shared.CursorMessageReads:
+		repaired, err = r.queries.ClampFutureMessageReads(ctx, db, sqlc.ClampFutureMessageReadsParams{
+			Now:     nowAt,
+			LimitAt: limitAt,
+		})
+	case shared.CursorNotificationOutbox:
+		repaired, err = r.queries.ReleaseFutureNotificationJobs(ctx, db, sqlc.ReleaseFutureNotificationJobsParams{
+			Now:     nowAt,
+			LimitAt: limitAt,
+		})
+	default:
+		return 0, infra.WrapRepoErr("failed to repair cursors", errs.Wrap(errUnsupportedCursor, cursor))
+	}
+	if err != nil {
+		return 0, infra.WrapRepoErr("failed to repair cursors in "+cursor, err)
+	}
+
+	return repaired, nil
+}
@@ -0,0 +1,110 @@
+//go:build unit
+
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/infra/repository"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/usecase/shared"
+	repositorymock "gin-clean-starter/tests/mock/repository"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCursorRepository_Repair(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	limit := now.Add(5 * time.Minute)
+	nowAt := pgtype.Timestamptz{Time: now, Valid: true}
+	limitAt := pgtype.Timestamptz{Time: limit, Valid: true}
+
+	testCases := []struct {
+		name           string
+		cursor         string
+		setupMock      func(*repositorymock.MockCursorQueries, sqlc.DBTX)
+		expectRepaired int64
+		expectedError  bool
+		expectKind     infra.RepositoryErrorKind
+	}{
+		{
+			name:   "success: webhook watermarks cleared",
+			cursor: shared.CursorBillingWebhooks,
+			setupMock: func(mock *repositorymock.MockCursorQueries, db sqlc.DBTX) {
+				mock.EXPECT().ClearFutureSubscriptionWatermarks(ctx, db, limitAt).Return(int64(2), nil)
+			},
+			expectRepaired: 2,
+		},
+		{
+			name:   "success: read markers clamped to now",
+			cursor: shared.CursorMessageReads,
+			setupMock: func(mock *repositorymock.MockCursorQueries, db sqlc.DBTX) {
+				mock.EXPECT().ClampFutureMessageReads(ctx, db, sqlc.ClampFutureMessageReadsParams{
+					Now:     nowAt,
+					LimitAt: limitAt,
+				}).Return(int64(3), nil)
+			},
+			expectRepaired: 3,
+		},
+		{
+			name:   "success: outbox jobs released to run now",
+			cursor: shared.CursorNotificationOutbox,
+			setupMock: func(mock *repositorymock.MockCursorQueries, db sqlc.DBTX) {
+				mock.EXPECT().ReleaseFutureNotificationJobs(ctx, db, sqlc.ReleaseFutureNotificationJobsParams{
+					Now:     nowAt,
+					LimitAt: limitAt,
+				}).Return(int64(1), nil)
+			},
+			expectRepaired: 1,
+		},
+		{
+			name:   "error: database error occurs",
+			cursor: shared.CursorMessageReads,
+			setupMock: func(mock *repositorymock.MockCursorQueries, db sqlc.DBTX) {
+				mock.EXPECT().ClampFutureMessageReads(ctx, db, gomock.Any()).Return(int64(0), errors.New("database connection error"))
+			},
+			expectedError: true,
+			expectKind:    infra.KindDBFailure,
+		},
+		{
+			name:          "error: cursor without a repair query",
+			cursor:        "reservations",
+			setupMock:     func(*repositorymock.MockCursorQueries, sqlc.DBTX) {},
+			expectedError: true,
+			expectKind:    infra.KindDBFailure,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockCursorQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewCursorRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			repaired, err := repo.Repair(ctx, mockDB, tc.cursor, limit, now)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				if tc.expectKind != "" {
+					assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectRepaired, repaired)
+		})
+	}
+}
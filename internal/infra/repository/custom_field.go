@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+type CustomFieldWriteQueries interface {
+	CreateCustomFieldDefinition(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateCustomFieldDefinitionParams) (uuid.UUID, error)
+	DeleteCustomFieldDefinition(ctx context.Context, db sqlc.DBTX, arg sqlc.DeleteCustomFieldDefinitionParams) (int64, error)
+}
+
+type CustomFieldRepository struct {
+	queries CustomFieldWriteQueries
+}
+
+func NewCustomFieldRepository(queries CustomFieldWriteQueries) *CustomFieldRepository {
+	return &CustomFieldRepository{
+		queries: queries,
+	}
+}
+
+func (r *CustomFieldRepository) Create(ctx context.Context, tx sqlc.DBTX, def shared.CustomFieldDefinition) (uuid.UUID, error) {
+	options := def.Options
+	if options == nil {
+		options = []string{}
+	}
+	id, err := r.queries.CreateCustomFieldDefinition(ctx, tx, sqlc.CreateCustomFieldDefinitionParams{
+		CompanyID:  def.CompanyID,
+		ResourceID: pgconv.UUIDPtrToPgtype(def.ResourceID),
+		Key:        def.Key,
+		Label:      def.Label,
+		FieldType:  string(def.Type),
+		Required:   def.Required,
+		Options:    options,
+		CreatedAt:  pgconv.TimeToPgtype(def.CreatedAt),
+	})
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return uuid.Nil, infra.WrapRepoErr("resource not found in company", err, infra.KindNotFound)
+		}
+		return uuid.Nil, infra.WrapRepoErr("failed to create custom field", err)
+	}
+	return id, nil
+}
+
+func (r *CustomFieldRepository) Delete(ctx context.Context, tx sqlc.DBTX, companyID, id uuid.UUID) error {
+	rows, err := r.queries.DeleteCustomFieldDefinition(ctx, tx, sqlc.DeleteCustomFieldDefinitionParams{
+		ID:        id,
+		CompanyID: companyID,
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to delete custom field", err)
+	}
+	if rows == 0 {
+		return infra.WrapRepoErr("custom field not found", nil, infra.KindNotFound)
+	}
+	return nil
+}
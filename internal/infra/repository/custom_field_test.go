@@ -0,0 +1,106 @@
+//go:build unit
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/domain/reservation"
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/infra/repository"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+	repositorymock "gin-clean-starter/tests/mock/repository"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCustomFieldRepository_Create(t *testing.T) {
+	ctx := context.Background()
+	companyID, resourceID, fieldID := uuid.New(), uuid.New(), uuid.New()
+	at := time.Date(2026, 10, 16, 9, 30, 0, 0, time.UTC)
+	def := shared.CustomFieldDefinition{
+		CompanyID: companyID,
+		FieldDefinition: reservation.FieldDefinition{
+			Key:   "purpose",
+			Label: "Purpose",
+			Type:  reservation.FieldTypeText,
+		},
+		CreatedAt: at,
+	}
+
+	t.Run("success: company-wide field with no options", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockQueries := repositorymock.NewMockCustomFieldWriteQueries(ctrl)
+		mockDB := &mockDBTX{}
+		mockQueries.EXPECT().CreateCustomFieldDefinition(ctx, mockDB, sqlc.CreateCustomFieldDefinitionParams{
+			CompanyID: companyID,
+			Key:       "purpose",
+			Label:     "Purpose",
+			FieldType: "text",
+			Options:   []string{},
+			CreatedAt: pgconv.TimeToPgtype(at),
+		}).Return(fieldID, nil)
+
+		id, err := repository.NewCustomFieldRepository(mockQueries).Create(ctx, mockDB, def)
+		require.NoError(t, err)
+		assert.Equal(t, fieldID, id)
+	})
+
+	testCases := []struct {
+		name     string
+		queryErr error
+		wantKind infra.RepositoryErrorKind
+	}{
+		{"error: key taken", &pgconn.PgError{Code: "23505"}, infra.KindDuplicateKey},
+		{"error: unknown company", &pgconn.PgError{Code: "23503"}, infra.KindForeignKeyViolated},
+		{"error: resource of another company", pgx.ErrNoRows, infra.KindNotFound},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockQueries := repositorymock.NewMockCustomFieldWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			mockQueries.EXPECT().CreateCustomFieldDefinition(ctx, mockDB, gomock.Any()).Return(uuid.Nil, tc.queryErr)
+
+			withResource := def
+			withResource.ResourceID = &resourceID
+			_, err := repository.NewCustomFieldRepository(mockQueries).Create(ctx, mockDB, withResource)
+			require.Error(t, err)
+			assert.True(t, infra.IsKind(err, tc.wantKind))
+		})
+	}
+}
+
+func TestCustomFieldRepository_Delete(t *testing.T) {
+	ctx := context.Background()
+	companyID, fieldID := uuid.New(), uuid.New()
+	params := sqlc.DeleteCustomFieldDefinitionParams{ID: fieldID, CompanyID: companyID}
+
+	t.Run("success: field removed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockQueries := repositorymock.NewMockCustomFieldWriteQueries(ctrl)
+		mockDB := &mockDBTX{}
+		mockQueries.EXPECT().DeleteCustomFieldDefinition(ctx, mockDB, params).Return(int64(1), nil)
+
+		require.NoError(t, repository.NewCustomFieldRepository(mockQueries).Delete(ctx, mockDB, companyID, fieldID))
+	})
+
+	t.Run("error: field of another company", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockQueries := repositorymock.NewMockCustomFieldWriteQueries(ctrl)
+		mockDB := &mockDBTX{}
+		mockQueries.EXPECT().DeleteCustomFieldDefinition(ctx, mockDB, params).Return(int64(0), nil)
+
+		err := repository.NewCustomFieldRepository(mockQueries).Delete(ctx, mockDB, companyID, fieldID)
+		assert.True(t, infra.IsKind(err, infra.KindNotFound))
+	})
+}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+)
+
+type DeprecationWriteQueries interface {
+	AddDeprecatedRouteCalls(ctx context.Context, db sqlc.DBTX, arg sqlc.AddDeprecatedRouteCallsParams) error
+}
+
+type DeprecationRepository struct {
+	queries DeprecationWriteQueries
+}
+
+func NewDeprecationRepository(queries DeprecationWriteQueries) *DeprecationRepository {
+	return &DeprecationRepository{
+		queries: queries,
+	}
+}
+
+func (r *DeprecationRepository) Add(ctx context.Context, tx sqlc.DBTX, calls shared.DeprecatedRouteCalls) error {
+	err := r.queries.AddDeprecatedRouteCalls(ctx, tx, sqlc.AddDeprecatedRouteCallsParams{
+		Day:          pgconv.DateToPgtype(calls.Day),
+		Route:        calls.Route,
+		UserID:       pgconv.UUIDPtrToPgtype(calls.UserID),
+		CallCount:    calls.Calls,
+		LastCalledAt: pgconv.TimeToPgtype(calls.LastCalledAt),
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to add deprecated route calls", err)
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+//go:build unit
+
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/infra/repository"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+	repositorymock "gin-clean-starter/tests/mock/repository"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDeprecationRepository_Add(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	at := time.Date(2026, 10, 16, 9, 30, 0, 0, time.UTC)
+	day := pgtype.Date{Time: time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC), Valid: true}
+
+	testCases := []struct {
+		name          string
+		calls         shared.DeprecatedRouteCalls
+		setupMock     func(*repositorymock.MockDeprecationWriteQueries, sqlc.DBTX)
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name:  "success: consumer calls added to the day",
+			calls: shared.DeprecatedRouteCalls{Day: shared.TelemetryDay(at), Route: "GET /api/reservations", UserID: &userID, Calls: 3, LastCalledAt: at},
+			setupMock: func(mock *repositorymock.MockDeprecationWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().AddDeprecatedRouteCalls(ctx, db, sqlc.AddDeprecatedRouteCallsParams{
+					Day:          day,
+					Route:        "GET /api/reservations",
+					UserID:       pgtype.UUID{Bytes: userID, Valid: true},
+					CallCount:    3,
+					LastCalledAt: pgconv.TimeToPgtype(at),
+				}).Return(nil)
+			},
+		},
+		{
+			name:  "success: anonymous calls are stored without a user",
+			calls: shared.DeprecatedRouteCalls{Day: shared.TelemetryDay(at), Route: "GET /api/reviews/:id", Calls: 1, LastCalledAt: at},
+			setupMock: func(mock *repositorymock.MockDeprecationWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().AddDeprecatedRouteCalls(ctx, db, sqlc.AddDeprecatedRouteCallsParams{
+					Day:          day,
+					Route:        "GET /api/reviews/:id",
+					CallCount:    1,
+					LastCalledAt: pgconv.TimeToPgtype(at),
+				}).Return(nil)
+			},
+		},
+		{
+			name:  "error: database error occurs",
+			calls: shared.DeprecatedRouteCalls{Day: shared.TelemetryDay(at), Route: "GET /api/reservations", Calls: 1, LastCalledAt: at},
+			setupMock: func(mock *repositorymock.MockDeprecationWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().AddDeprecatedRouteCalls(ctx, db, gomock.Any()).Return(errors.New("database connection error"))
+			},
+			expectedError: true,
+			expectKind:    infra.KindDBFailure,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockDeprecationWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewDeprecationRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			err := repo.Add(ctx, mockDB, tc.calls)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
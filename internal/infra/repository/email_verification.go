@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type EmailVerificationWriteQueries interface {
+	CreateEmailVerification(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateEmailVerificationParams) error
+	ConsumeEmailVerification(ctx context.Context, db sqlc.DBTX, arg sqlc.ConsumeEmailVerificationParams) (pgtype.Timestamptz, error)
+}
+
+type EmailVerificationRepository struct {
+	queries EmailVerificationWriteQueries
+}
+
+func NewEmailVerificationRepository(queries EmailVerificationWriteQueries) *EmailVerificationRepository {
+	return &EmailVerificationRepository{
+		queries: queries,
+	}
+}
+
+func (r *EmailVerificationRepository) Create(ctx context.Context, tx sqlc.DBTX, userID, nonce uuid.UUID, expiresAt, createdAt time.Time) error {
+	err := r.queries.CreateEmailVerification(ctx, tx, sqlc.CreateEmailVerificationParams{
+		UserID:    userID,
+		Nonce:     nonce,
+		ExpiresAt: pgconv.TimeToPgtype(expiresAt),
+		CreatedAt: pgconv.TimeToPgtype(createdAt),
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to create email verification", err)
+	}
+	return nil
+}
+
+func (r *EmailVerificationRepository) Consume(ctx context.Context, tx sqlc.DBTX, userID, nonce uuid.UUID) (time.Time, error) {
+	expiresAt, err := r.queries.ConsumeEmailVerification(ctx, tx, sqlc.ConsumeEmailVerificationParams{
+		UserID: userID,
+		Nonce:  nonce,
+	})
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return time.Time{}, infra.WrapRepoErr("email verification not found", err, infra.KindNotFound)
+		}
+		return time.Time{}, infra.WrapRepoErr("failed to consume email verification", err)
+	}
+	return pgconv.TimeFromPgtype(expiresAt), nil
+}
@@ -0,0 +1,80 @@
+//go:build unit
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/infra/repository"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	repositorymock "gin-clean-starter/tests/mock/repository"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestEmailVerificationRepository_Consume(t *testing.T) {
+	ctx := context.Background()
+	expiresAt := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+	userID := uuid.New()
+	nonce := uuid.New()
+	params := sqlc.ConsumeEmailVerificationParams{UserID: userID, Nonce: nonce}
+
+	testCases := []struct {
+		name       string
+		setupMock  func(*repositorymock.MockEmailVerificationWriteQueries, sqlc.DBTX)
+		expected   time.Time
+		expectKind infra.RepositoryErrorKind
+	}{
+		{
+			name: "success: returns the expiry",
+			setupMock: func(mock *repositorymock.MockEmailVerificationWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().ConsumeEmailVerification(ctx, db, params).Return(pgconv.TimeToPgtype(expiresAt), nil)
+			},
+			expected: expiresAt,
+		},
+		{
+			name: "error: no pending verification with the nonce",
+			setupMock: func(mock *repositorymock.MockEmailVerificationWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().ConsumeEmailVerification(ctx, db, params).Return(pgtype.Timestamptz{}, pgx.ErrNoRows)
+			},
+			expectKind: infra.KindNotFound,
+		},
+		{
+			name: "error: database failure",
+			setupMock: func(mock *repositorymock.MockEmailVerificationWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().ConsumeEmailVerification(ctx, db, params).Return(pgtype.Timestamptz{}, assert.AnError)
+			},
+			expectKind: infra.KindDBFailure,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockEmailVerificationWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			tc.setupMock(mockQueries, mockDB)
+
+			got, err := repository.NewEmailVerificationRepository(mockQueries).Consume(ctx, mockDB, userID, nonce)
+
+			if tc.expectKind != "" {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "got %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tc.expected.Equal(got))
+		})
+	}
+}
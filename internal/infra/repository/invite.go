@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/pkg/pgconv"
+
+	"github.com/google/uuid"
+)
+
+var errInviteNotPending = errs.New("invite is no longer pending")
+
+type InviteWriteQueries interface {
+	CreateInvite(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateInviteParams) (uuid.UUID, error)
+	ReissueInvite(ctx context.Context, db sqlc.DBTX, arg sqlc.ReissueInviteParams) (int64, error)
+	RevokeInvite(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (int64, error)
+	AcceptInvite(ctx context.Context, db sqlc.DBTX, arg sqlc.AcceptInviteParams) (int64, error)
+}
+
+type InviteRepository struct {
+	queries InviteWriteQueries
+}
+
+func NewInviteRepository(queries InviteWriteQueries) *InviteRepository {
+	return &InviteRepository{
+		queries: queries,
+	}
+}
+
+// Create reports KindDuplicateKey while a pending invite exists for the same company and email.
+func (r *InviteRepository) Create(ctx context.Context, tx sqlc.DBTX, params sqlc.CreateInviteParams) (uuid.UUID, error) {
+	id, err := r.queries.CreateInvite(ctx, tx, params)
+	if err != nil {
+		return uuid.Nil, infra.WrapRepoErr("failed to create invite", err)
+	}
+	return id, nil
+}
+
+// The update methods only touch pending invites; anything else reports KindConflict.
+
+func (r *InviteRepository) Reissue(ctx context.Context, tx sqlc.DBTX, inviteID, nonce uuid.UUID, expiresAt time.Time) error {
+	affected, err := r.queries.ReissueInvite(ctx, tx, sqlc.ReissueInviteParams{
+		ID:        inviteID,
+		Nonce:     nonce,
+		ExpiresAt: pgconv.TimeToPgtype(expiresAt),
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to reissue invite", err)
+	}
+	return expectPendingInvite(affected)
+}
+
+func (r *InviteRepository) Revoke(ctx context.Context, tx sqlc.DBTX, inviteID uuid.UUID) error {
+	affected, err := r.queries.RevokeInvite(ctx, tx, inviteID)
+	if err != nil {
+		return infra.WrapRepoErr("failed to revoke invite", err)
+	}
+	return expectPendingInvite(affected)
+}
+
+func (r *InviteRepository) MarkAccepted(ctx context.Context, tx sqlc.DBTX, inviteID, nonce, userID uuid.UUID) error {
+	affected, err := r.queries.AcceptInvite(ctx, tx, sqlc.AcceptInviteParams{
+		ID:             inviteID,
+		Nonce:          nonce,
+		AcceptedUserID: pgconv.UUIDToPgtype(userID),
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to accept invite", err)
+	}
+	return expectPendingInvite(affected)
+}
+
+func expectPendingInvite(affected int64) error {
+	if affected == 0 {
+		return infra.WrapRepoErr("invite not pending", errInviteNotPending, infra.KindConflict)
+	}
+	return nil
+}
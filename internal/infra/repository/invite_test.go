@@ -0,0 +1,138 @@
+//go:build unit
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/infra/repository"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	repositorymock "gin-clean-starter/tests/mock/repository"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestInviteRepository_Create(t *testing.T) {
+	ctx := context.Background()
+	params := sqlc.CreateInviteParams{
+		Email:     "new.member@example.com",
+		CompanyID: uuid.New(),
+		Role:      "viewer",
+		InvitedBy: uuid.New(),
+		Nonce:     uuid.New(),
+		ExpiresAt: pgconv.TimeToPgtype(time.Now().Add(72 * time.Hour)),
+	}
+
+	testCases := []struct {
+		name          string
+		setupMock     func(*repositorymock.MockInviteWriteQueries, sqlc.DBTX)
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name: "success: invite stored",
+			setupMock: func(mock *repositorymock.MockInviteWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().CreateInvite(ctx, db, params).Return(uuid.New(), nil)
+			},
+		},
+		{
+			name: "error: pending invite for the same email",
+			setupMock: func(mock *repositorymock.MockInviteWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().CreateInvite(ctx, db, params).Return(uuid.Nil, &pgconn.PgError{Code: "23505"})
+			},
+			expectedError: true,
+			expectKind:    infra.KindDuplicateKey,
+		},
+		{
+			name: "error: unknown role violates foreign key",
+			setupMock: func(mock *repositorymock.MockInviteWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().CreateInvite(ctx, db, params).Return(uuid.Nil, &pgconn.PgError{Code: "23503"})
+			},
+			expectedError: true,
+			expectKind:    infra.KindForeignKeyViolated,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockInviteWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewInviteRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			id, err := repo.Create(ctx, mockDB, params)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotEqual(t, uuid.Nil, id)
+		})
+	}
+}
+
+func TestInviteRepository_MarkAccepted(t *testing.T) {
+	ctx := context.Background()
+	inviteID := uuid.New()
+	nonce := uuid.New()
+	userID := uuid.New()
+	params := sqlc.AcceptInviteParams{ID: inviteID, Nonce: nonce, AcceptedUserID: pgconv.UUIDToPgtype(userID)}
+
+	testCases := []struct {
+		name          string
+		setupMock     func(*repositorymock.MockInviteWriteQueries, sqlc.DBTX)
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name: "success: pending invite accepted",
+			setupMock: func(mock *repositorymock.MockInviteWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().AcceptInvite(ctx, db, params).Return(int64(1), nil)
+			},
+		},
+		{
+			name: "error: invite already accepted, revoked or reissued",
+			setupMock: func(mock *repositorymock.MockInviteWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().AcceptInvite(ctx, db, params).Return(int64(0), nil)
+			},
+			expectedError: true,
+			expectKind:    infra.KindConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockInviteWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewInviteRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			err := repo.MarkAccepted(ctx, mockDB, inviteID, nonce, userID)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
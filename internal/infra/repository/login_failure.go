@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type LoginFailureWriteQueries interface {
+	GetLoginLock(ctx context.Context, db sqlc.DBTX, arg sqlc.GetLoginLockParams) (pgtype.Timestamptz, error)
+	RecordLoginFailure(ctx context.Context, db sqlc.DBTX, arg sqlc.RecordLoginFailureParams) (int32, error)
+	LockLogin(ctx context.Context, db sqlc.DBTX, arg sqlc.LockLoginParams) error
+	ClearLoginFailures(ctx context.Context, db sqlc.DBTX, arg sqlc.ClearLoginFailuresParams) error
+	UnlockLogins(ctx context.Context, db sqlc.DBTX, arg sqlc.UnlockLoginsParams) (int64, error)
+}
+
+type LoginFailureRepository struct {
+	queries LoginFailureWriteQueries
+}
+
+func NewLoginFailureRepository(queries LoginFailureWriteQueries) *LoginFailureRepository {
+	return &LoginFailureRepository{
+		queries: queries,
+	}
+}
+
+func (r *LoginFailureRepository) LockedUntil(ctx context.Context, db sqlc.DBTX, email, ip string, now time.Time) (*time.Time, error) {
+	until, err := r.queries.GetLoginLock(ctx, db, sqlc.GetLoginLockParams{
+		Email:     email,
+		IpAddress: ip,
+		Now:       pgconv.TimeToPgtype(now),
+	})
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return nil, nil
+		}
+		return nil, infra.WrapRepoErr("failed to get login lock", err)
+	}
+	t := pgconv.TimeFromPgtype(until)
+	return &t, nil
+}
+
+func (r *LoginFailureRepository) RecordFailure(ctx context.Context, db sqlc.DBTX, email, ip string, now, expiresAt time.Time) (int, error) {
+	attempts, err := r.queries.RecordLoginFailure(ctx, db, sqlc.RecordLoginFailureParams{
+		Email:     email,
+		IpAddress: ip,
+		ExpiresAt: pgconv.TimeToPgtype(expiresAt),
+		Now:       pgconv.TimeToPgtype(now),
+	})
+	if err != nil {
+		return 0, infra.WrapRepoErr("failed to record login failure", err)
+	}
+	return int(attempts), nil
+}
+
+func (r *LoginFailureRepository) Lock(ctx context.Context, db sqlc.DBTX, email, ip string, until time.Time) error {
+	if err := r.queries.LockLogin(ctx, db, sqlc.LockLoginParams{
+		LockedUntil: pgconv.TimeToPgtype(until),
+		Email:       email,
+		IpAddress:   ip,
+	}); err != nil {
+		return infra.WrapRepoErr("failed to lock login", err)
+	}
+	return nil
+}
+
+func (r *LoginFailureRepository) Clear(ctx context.Context, db sqlc.DBTX, email, ip string) error {
+	if err := r.queries.ClearLoginFailures(ctx, db, sqlc.ClearLoginFailuresParams{
+		Email:     email,
+		IpAddress: ip,
+	}); err != nil {
+		return infra.WrapRepoErr("failed to clear login failures", err)
+	}
+	return nil
+}
+
+func (r *LoginFailureRepository) Unlock(ctx context.Context, db sqlc.DBTX, email string, now time.Time) (int64, error) {
+	rows, err := r.queries.UnlockLogins(ctx, db, sqlc.UnlockLoginsParams{
+		Email: email,
+		Now:   pgconv.TimeToPgtype(now),
+	})
+	if err != nil {
+		return 0, infra.WrapRepoErr("failed to unlock logins", err)
+	}
+	return rows, nil
+}
@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/domain/loyalty"
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var (
+	errPointsAlreadyAccrued = errs.New("reservation already accrued points")
+	errLotOverdrawn         = errs.New("loyalty lot has fewer points than drawn")
+)
+
+type LoyaltyWriteQueries interface {
+	CreateLoyaltyAccrual(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateLoyaltyAccrualParams) (int64, error)
+	LockOpenLoyaltyLots(ctx context.Context, db sqlc.DBTX, arg sqlc.LockOpenLoyaltyLotsParams) ([]sqlc.LockOpenLoyaltyLotsRow, error)
+	DrawLoyaltyLot(ctx context.Context, db sqlc.DBTX, arg sqlc.DrawLoyaltyLotParams) (int64, error)
+	CreateLoyaltyRedemption(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateLoyaltyRedemptionParams) error
+	ExpireLoyaltyLots(ctx context.Context, db sqlc.DBTX, arg sqlc.ExpireLoyaltyLotsParams) (int64, error)
+}
+
+type LoyaltyRepository struct {
+	queries LoyaltyWriteQueries
+}
+
+func NewLoyaltyRepository(queries LoyaltyWriteQueries) *LoyaltyRepository {
+	return &LoyaltyRepository{
+		queries: queries,
+	}
+}
+
+// Accrue reports KindConflict when the reservation already has an accrual, so
+// overlapping accrual runs credit each reservation once.
+func (r *LoyaltyRepository) Accrue(ctx context.Context, tx sqlc.DBTX, accrual shared.PointsAccrual, at time.Time) error {
+	points, err := pgconv.SafeIntToInt32(int(accrual.Points))
+	if err != nil {
+		return infra.WrapRepoErr("loyalty points out of range", err)
+	}
+
+	affected, err := r.queries.CreateLoyaltyAccrual(ctx, tx, sqlc.CreateLoyaltyAccrualParams{
+		UserID:        accrual.UserID,
+		Points:        points,
+		ExpiresAt:     pgconv.TimeToPgtype(accrual.ExpiresAt),
+		ReservationID: pgconv.UUIDToPgtype(accrual.ReservationID),
+		CreatedAt:     pgconv.TimeToPgtype(at),
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to accrue loyalty points", err)
+	}
+	if affected == 0 {
+		return infra.WrapRepoErr("loyalty points already accrued", errPointsAlreadyAccrued, infra.KindConflict)
+	}
+	return nil
+}
+
+func (r *LoyaltyRepository) LockOpenLots(ctx context.Context, tx sqlc.DBTX, userID uuid.UUID, now time.Time) ([]loyalty.Lot, error) {
+	rows, err := r.queries.LockOpenLoyaltyLots(ctx, tx, sqlc.LockOpenLoyaltyLotsParams{
+		UserID:    userID,
+		ExpiresAt: pgconv.TimeToPgtype(now),
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to lock loyalty lots", err)
+	}
+
+	lots := make([]loyalty.Lot, len(rows))
+	for i, row := range rows {
+		lots[i] = loyalty.Lot{
+			ID:        row.ID,
+			Remaining: int64(row.RemainingPoints.Int32),
+			ExpiresAt: pgconv.TimeFromPgtype(row.ExpiresAt),
+		}
+	}
+	return lots, nil
+}
+
+// Draw reports KindConflict when the lot no longer holds the points; lots are locked by
+// LockOpenLots, so this only happens when a draw skipped the lock.
+func (r *LoyaltyRepository) Draw(ctx context.Context, tx sqlc.DBTX, draw loyalty.Draw) error {
+	points, err := pgconv.SafeIntToInt32(int(draw.Points))
+	if err != nil {
+		return infra.WrapRepoErr("loyalty points out of range", err)
+	}
+
+	affected, err := r.queries.DrawLoyaltyLot(ctx, tx, sqlc.DrawLoyaltyLotParams{
+		Points: points,
+		ID:     draw.LotID,
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to draw loyalty lot", err)
+	}
+	if affected == 0 {
+		return infra.WrapRepoErr("loyalty lot overdrawn", errLotOverdrawn, infra.KindConflict)
+	}
+	return nil
+}
+
+func (r *LoyaltyRepository) RecordRedemption(ctx context.Context, tx sqlc.DBTX, redemption shared.PointsRedemption, at time.Time) error {
+	points, err := pgconv.SafeIntToInt32(int(redemption.Points))
+	if err != nil {
+		return infra.WrapRepoErr("loyalty points out of range", err)
+	}
+	discount, err := pgconv.SafeIntToInt32(int(redemption.DiscountCents))
+	if err != nil {
+		return infra.WrapRepoErr("loyalty discount out of range", err)
+	}
+
+	err = r.queries.CreateLoyaltyRedemption(ctx, tx, sqlc.CreateLoyaltyRedemptionParams{
+		UserID:        redemption.UserID,
+		Points:        -points,
+		ReservationID: pgconv.UUIDToPgtype(redemption.ReservationID),
+		DiscountCents: pgtype.Int4{Int32: discount, Valid: true},
+		CreatedAt:     pgconv.TimeToPgtype(at),
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to record loyalty redemption", err)
+	}
+	return nil
+}
+
+// ExpireLots forfeits the unspent points of up to batchSize lots past their expiry and
+// returns how many lots it expired. Lots locked by a concurrent redemption are skipped.
+func (r *LoyaltyRepository) ExpireLots(ctx context.Context, tx sqlc.DBTX, now time.Time, batchSize int32) (int64, error) {
+	expired, err := r.queries.ExpireLoyaltyLots(ctx, tx, sqlc.ExpireLoyaltyLotsParams{
+		Now:       pgconv.TimeToPgtype(now),
+		BatchSize: batchSize,
+	})
+	if err != nil {
+		return 0, infra.WrapRepoErr("failed to expire loyalty lots", err)
+	}
+	return expired, nil
+}
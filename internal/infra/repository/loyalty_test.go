@@ -0,0 +1,183 @@
+//go:build unit
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/domain/loyalty"
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/infra/repository"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+	repositorymock "gin-clean-starter/tests/mock/repository"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestLoyaltyRepository_Accrue(t *testing.T) {
+	ctx := context.Background()
+	at := time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC)
+	accrual := shared.PointsAccrual{
+		UserID:        uuid.New(),
+		ReservationID: uuid.New(),
+		Points:        1500,
+		ExpiresAt:     at.Add(365 * 24 * time.Hour),
+	}
+	params := sqlc.CreateLoyaltyAccrualParams{
+		UserID:        accrual.UserID,
+		Points:        1500,
+		ExpiresAt:     pgconv.TimeToPgtype(accrual.ExpiresAt),
+		ReservationID: pgconv.UUIDToPgtype(accrual.ReservationID),
+		CreatedAt:     pgconv.TimeToPgtype(at),
+	}
+
+	testCases := []struct {
+		name          string
+		setupMock     func(*repositorymock.MockLoyaltyWriteQueries, sqlc.DBTX)
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name: "success: lot created",
+			setupMock: func(mock *repositorymock.MockLoyaltyWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().CreateLoyaltyAccrual(ctx, db, params).Return(int64(1), nil)
+			},
+		},
+		{
+			name: "error: reservation already accrued",
+			setupMock: func(mock *repositorymock.MockLoyaltyWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().CreateLoyaltyAccrual(ctx, db, params).Return(int64(0), nil)
+			},
+			expectedError: true,
+			expectKind:    infra.KindConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockLoyaltyWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewLoyaltyRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			err := repo.Accrue(ctx, mockDB, accrual, at)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestLoyaltyRepository_LockOpenLots(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQueries := repositorymock.NewMockLoyaltyWriteQueries(ctrl)
+	mockDB := &mockDBTX{}
+	repo := repository.NewLoyaltyRepository(mockQueries)
+
+	userID, lotID := uuid.New(), uuid.New()
+	now := time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC)
+	expiresAt := now.Add(time.Hour)
+	mockQueries.EXPECT().
+		LockOpenLoyaltyLots(ctx, mockDB, sqlc.LockOpenLoyaltyLotsParams{UserID: userID, ExpiresAt: pgconv.TimeToPgtype(now)}).
+		Return([]sqlc.LockOpenLoyaltyLotsRow{{
+			ID:              lotID,
+			RemainingPoints: pgtype.Int4{Int32: 40, Valid: true},
+			ExpiresAt:       pgconv.TimeToPgtype(expiresAt),
+		}}, nil)
+
+	lots, err := repo.LockOpenLots(ctx, mockDB, userID, now)
+
+	require.NoError(t, err)
+	assert.Equal(t, []loyalty.Lot{{ID: lotID, Remaining: 40, ExpiresAt: expiresAt}}, lots)
+}
+
+func TestLoyaltyRepository_Draw(t *testing.T) {
+	ctx := context.Background()
+	draw := loyalty.Draw{LotID: uuid.New(), Points: 25}
+	params := sqlc.DrawLoyaltyLotParams{Points: 25, ID: draw.LotID}
+
+	testCases := []struct {
+		name          string
+		setupMock     func(*repositorymock.MockLoyaltyWriteQueries, sqlc.DBTX)
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name: "success: lot drawn down",
+			setupMock: func(mock *repositorymock.MockLoyaltyWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().DrawLoyaltyLot(ctx, db, params).Return(int64(1), nil)
+			},
+		},
+		{
+			name: "error: lot holds fewer points",
+			setupMock: func(mock *repositorymock.MockLoyaltyWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().DrawLoyaltyLot(ctx, db, params).Return(int64(0), nil)
+			},
+			expectedError: true,
+			expectKind:    infra.KindConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockLoyaltyWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewLoyaltyRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			err := repo.Draw(ctx, mockDB, draw)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestLoyaltyRepository_RecordRedemption(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQueries := repositorymock.NewMockLoyaltyWriteQueries(ctrl)
+	mockDB := &mockDBTX{}
+	repo := repository.NewLoyaltyRepository(mockQueries)
+
+	at := time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC)
+	redemption := shared.PointsRedemption{UserID: uuid.New(), ReservationID: uuid.New(), Points: 300, DiscountCents: 300}
+	mockQueries.EXPECT().CreateLoyaltyRedemption(ctx, mockDB, sqlc.CreateLoyaltyRedemptionParams{
+		UserID:        redemption.UserID,
+		Points:        -300,
+		ReservationID: pgconv.UUIDToPgtype(redemption.ReservationID),
+		DiscountCents: pgtype.Int4{Int32: 300, Valid: true},
+		CreatedAt:     pgconv.TimeToPgtype(at),
+	}).Return(nil)
+
+	require.NoError(t, repo.RecordRedemption(ctx, mockDB, redemption, at))
+}
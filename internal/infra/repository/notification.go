@@ -13,6 +13,7 @@ import (
 
 type NotificationWriteQueries interface {
 	CreateNotificationJob(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateNotificationJobParams) error
+	CreateUserNotificationJob(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateUserNotificationJobParams) error
 	UpdateNotificationJobStatus(ctx context.Context, db sqlc.DBTX, arg sqlc.UpdateNotificationJobStatusParams) error
 }
 
@@ -45,6 +46,24 @@ func (r *NotificationRepository) CreateJob(ctx context.Context, tx sqlc.DBTX, ki
 	return nil
 }
 
+func (r *NotificationRepository) CreateUserJob(ctx context.Context, tx sqlc.DBTX, userID uuid.UUID, kind, topic string, payload []byte, runAt time.Time, digestible bool) error {
+	params := sqlc.CreateUserNotificationJobParams{
+		UserID:     userID,
+		Kind:       kind,
+		Topic:      topic,
+		Payload:    payload,
+		RunAt:      pgtype.Timestamptz{Time: runAt, Valid: true},
+		Digestible: digestible,
+	}
+
+	err := r.queries.CreateUserNotificationJob(ctx, tx, params)
+	if err != nil {
+		return infra.WrapRepoErr("failed to create notification job", err)
+	}
+
+	return nil
+}
+
 func (r *NotificationRepository) UpdateJobStatus(ctx context.Context, tx sqlc.DBTX, jobID uuid.UUID, status string, lastError *string) error {
 	params := sqlc.UpdateNotificationJobStatusParams{
 		ID:     jobID,
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+type NotificationDigestWriteQueries interface {
+	SetNotificationDigest(ctx context.Context, db sqlc.DBTX, arg sqlc.SetNotificationDigestParams) (int64, error)
+	ListDueNotificationDigests(ctx context.Context, db sqlc.DBTX, arg sqlc.ListDueNotificationDigestsParams) ([]sqlc.ListDueNotificationDigestsRow, error)
+	TakeHeldNotificationJobs(ctx context.Context, db sqlc.DBTX, arg sqlc.TakeHeldNotificationJobsParams) ([]sqlc.TakeHeldNotificationJobsRow, error)
+}
+
+type NotificationDigestRepository struct {
+	queries NotificationDigestWriteQueries
+}
+
+func NewNotificationDigestRepository(queries NotificationDigestWriteQueries) *NotificationDigestRepository {
+	return &NotificationDigestRepository{
+		queries: queries,
+	}
+}
+
+func (r *NotificationDigestRepository) SetMode(ctx context.Context, tx sqlc.DBTX, userID uuid.UUID, mode string) error {
+	n, err := r.queries.SetNotificationDigest(ctx, tx, sqlc.SetNotificationDigestParams{
+		Digest: mode,
+		UserID: userID,
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to set notification digest", err)
+	}
+	if n == 0 {
+		return infra.WrapRepoErr("user not found", nil, infra.KindNotFound)
+	}
+	return nil
+}
+
+func (r *NotificationDigestRepository) ListDue(ctx context.Context, tx sqlc.DBTX, now time.Time, limit int32) ([]shared.DueNotificationDigest, error) {
+	rows, err := r.queries.ListDueNotificationDigests(ctx, tx, sqlc.ListDueNotificationDigestsParams{
+		Now:       pgconv.TimeToPgtype(now),
+		BatchSize: limit,
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list due notification digests", err)
+	}
+	due := make([]shared.DueNotificationDigest, len(rows))
+	for i, row := range rows {
+		due[i] = shared.DueNotificationDigest{UserID: row.ID, Mode: row.NotificationDigest}
+	}
+	return due, nil
+}
+
+func (r *NotificationDigestRepository) Take(ctx context.Context, tx sqlc.DBTX, userID uuid.UUID, now time.Time) ([]shared.HeldNotification, error) {
+	rows, err := r.queries.TakeHeldNotificationJobs(ctx, tx, sqlc.TakeHeldNotificationJobsParams{
+		Now:    pgconv.TimeToPgtype(now),
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to take held notifications", err)
+	}
+	held := make([]shared.HeldNotification, len(rows))
+	for i, row := range rows {
+		held[i] = shared.HeldNotification{
+			ID:        row.ID,
+			Topic:     row.Topic,
+			Payload:   row.Payload,
+			CreatedAt: row.CreatedAt.Time,
+		}
+	}
+	// UPDATE ... RETURNING has no order.
+	sort.Slice(held, func(i, j int) bool {
+		return held[i].CreatedAt.Before(held[j].CreatedAt)
+	})
+	return held, nil
+}
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type PlatformStatsWriteQueries interface {
+	RefreshPlatformStats(ctx context.Context, db sqlc.DBTX, computedAt pgtype.Timestamptz) error
+}
+
+type PlatformStatsRepository struct {
+	queries PlatformStatsWriteQueries
+}
+
+func NewPlatformStatsRepository(queries PlatformStatsWriteQueries) *PlatformStatsRepository {
+	return &PlatformStatsRepository{
+		queries: queries,
+	}
+}
+
+func (r *PlatformStatsRepository) Refresh(ctx context.Context, db sqlc.DBTX, at time.Time) error {
+	if err := r.queries.RefreshPlatformStats(ctx, db, pgconv.TimeToPgtype(at)); err != nil {
+		return infra.WrapRepoErr("failed to refresh platform stats", err)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+
+	"github.com/google/uuid"
+)
+
+type ProvisioningWriteQueries interface {
+	CreateProvisioningToken(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateProvisioningTokenParams) (uuid.UUID, error)
+	RevokeProvisioningToken(ctx context.Context, db sqlc.DBTX, arg sqlc.RevokeProvisioningTokenParams) (int64, error)
+	SetProvisionedUserActive(ctx context.Context, db sqlc.DBTX, arg sqlc.SetProvisionedUserActiveParams) (int64, error)
+}
+
+type ProvisioningRepository struct {
+	queries ProvisioningWriteQueries
+}
+
+func NewProvisioningRepository(queries ProvisioningWriteQueries) *ProvisioningRepository {
+	return &ProvisioningRepository{
+		queries: queries,
+	}
+}
+
+func (r *ProvisioningRepository) CreateToken(ctx context.Context, tx sqlc.DBTX, companyID uuid.UUID, tokenHash []byte, createdBy uuid.UUID, at time.Time) (uuid.UUID, error) {
+	id, err := r.queries.CreateProvisioningToken(ctx, tx, sqlc.CreateProvisioningTokenParams{
+		CompanyID: companyID,
+		TokenHash: tokenHash,
+		CreatedBy: pgconv.UUIDToPgtype(createdBy),
+		CreatedAt: pgconv.TimeToPgtype(at),
+	})
+	if err != nil {
+		return uuid.Nil, infra.WrapRepoErr("failed to create provisioning token", err)
+	}
+	return id, nil
+}
+
+func (r *ProvisioningRepository) RevokeToken(ctx context.Context, tx sqlc.DBTX, companyID, tokenID uuid.UUID, at time.Time) error {
+	rows, err := r.queries.RevokeProvisioningToken(ctx, tx, sqlc.RevokeProvisioningTokenParams{
+		RevokedAt: pgconv.TimeToPgtype(at),
+		ID:        tokenID,
+		CompanyID: companyID,
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to revoke provisioning token", err)
+	}
+	if rows == 0 {
+		return infra.WrapRepoErr("provisioning token not found", nil, infra.KindNotFound)
+	}
+	return nil
+}
+
+func (r *ProvisioningRepository) SetUserActive(ctx context.Context, tx sqlc.DBTX, companyID, userID uuid.UUID, active bool, at time.Time) error {
+	rows, err := r.queries.SetProvisionedUserActive(ctx, tx, sqlc.SetProvisionedUserActiveParams{
+		IsActive:  active,
+		UpdatedAt: pgconv.TimeToPgtype(at),
+		ID:        userID,
+		CompanyID: pgconv.UUIDToPgtype(companyID),
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to set provisioned user active", err)
+	}
+	if rows == 0 {
+		return infra.WrapRepoErr("provisioned user not found", nil, infra.KindNotFound)
+	}
+	return nil
+}
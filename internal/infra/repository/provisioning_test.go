@@ -0,0 +1,117 @@
+//go:build unit
+
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/infra/repository"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	repositorymock "gin-clean-starter/tests/mock/repository"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestProvisioningRepository_RevokeToken(t *testing.T) {
+	ctx := context.Background()
+	companyID, tokenID := uuid.New(), uuid.New()
+	at := time.Date(2026, 10, 16, 9, 30, 0, 0, time.UTC)
+	params := sqlc.RevokeProvisioningTokenParams{
+		RevokedAt: pgconv.TimeToPgtype(at),
+		ID:        tokenID,
+		CompanyID: companyID,
+	}
+
+	testCases := []struct {
+		name          string
+		setupMock     func(*repositorymock.MockProvisioningWriteQueries, sqlc.DBTX)
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name: "success: active token revoked",
+			setupMock: func(mock *repositorymock.MockProvisioningWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().RevokeProvisioningToken(ctx, db, params).Return(int64(1), nil)
+			},
+		},
+		{
+			name: "error: token missing, already revoked or of another company",
+			setupMock: func(mock *repositorymock.MockProvisioningWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().RevokeProvisioningToken(ctx, db, params).Return(int64(0), nil)
+			},
+			expectedError: true,
+			expectKind:    infra.KindNotFound,
+		},
+		{
+			name: "error: database failure",
+			setupMock: func(mock *repositorymock.MockProvisioningWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().RevokeProvisioningToken(ctx, db, params).Return(int64(0), errors.New("connection reset"))
+			},
+			expectedError: true,
+			expectKind:    infra.KindDBFailure,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockProvisioningWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewProvisioningRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			err := repo.RevokeToken(ctx, mockDB, companyID, tokenID, at)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestProvisioningRepository_SetUserActive(t *testing.T) {
+	ctx := context.Background()
+	companyID, userID := uuid.New(), uuid.New()
+	at := time.Date(2026, 10, 16, 9, 30, 0, 0, time.UTC)
+	params := sqlc.SetProvisionedUserActiveParams{
+		IsActive:  false,
+		UpdatedAt: pgconv.TimeToPgtype(at),
+		ID:        userID,
+		CompanyID: pgconv.UUIDToPgtype(companyID),
+	}
+
+	t.Run("success: user of the company deactivated", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockQueries := repositorymock.NewMockProvisioningWriteQueries(ctrl)
+		mockDB := &mockDBTX{}
+		mockQueries.EXPECT().SetProvisionedUserActive(ctx, mockDB, params).Return(int64(1), nil)
+
+		err := repository.NewProvisioningRepository(mockQueries).SetUserActive(ctx, mockDB, companyID, userID, false, at)
+		require.NoError(t, err)
+	})
+
+	t.Run("error: user of another company", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockQueries := repositorymock.NewMockProvisioningWriteQueries(ctrl)
+		mockDB := &mockDBTX{}
+		mockQueries.EXPECT().SetProvisionedUserActive(ctx, mockDB, params).Return(int64(0), nil)
+
+		err := repository.NewProvisioningRepository(mockQueries).SetUserActive(ctx, mockDB, companyID, userID, false, at)
+		require.Error(t, err)
+		assert.True(t, infra.IsKind(err, infra.KindNotFound))
+	})
+}
@@ -8,12 +8,15 @@ import (
 	"gin-clean-starter/internal/pkg/pgconv"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type RatingStatsQueries interface {
 	ApplyResourceRatingStatsOnCreate(ctx context.Context, db sqlc.DBTX, arg sqlc.ApplyResourceRatingStatsOnCreateParams) error
 	ApplyResourceRatingStatsOnUpdate(ctx context.Context, db sqlc.DBTX, arg sqlc.ApplyResourceRatingStatsOnUpdateParams) error
 	ApplyResourceRatingStatsOnDelete(ctx context.Context, db sqlc.DBTX, arg sqlc.ApplyResourceRatingStatsOnDeleteParams) error
+	UpdateReviewSummary(ctx context.Context, db sqlc.DBTX, arg sqlc.UpdateReviewSummaryParams) (int64, error)
+	RecalculateResourceRatingStats(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID) error
 }
 
 type RatingStatsRepository struct {
@@ -58,3 +61,26 @@ func (r *RatingStatsRepository) ApplyOnDelete(ctx context.Context, tx sqlc.DBTX,
 	}
 	return nil
 }
+
+func (r *RatingStatsRepository) Recalculate(ctx context.Context, tx sqlc.DBTX, resourceID uuid.UUID) error {
+	if err := r.queries.RecalculateResourceRatingStats(ctx, tx, resourceID); err != nil {
+		return infra.WrapRepoErr("failed to recalculate rating stats", err)
+	}
+	return nil
+}
+
+func (r *RatingStatsRepository) SaveSummary(ctx context.Context, tx sqlc.DBTX, resourceID uuid.UUID, summary string, reviewCount int32) (bool, error) {
+	text := pgtype.Text{}
+	if summary != "" {
+		text = pgconv.StringToPgtype(summary)
+	}
+	updated, err := r.queries.UpdateReviewSummary(ctx, tx, sqlc.UpdateReviewSummaryParams{
+		Summary:     text,
+		ReviewCount: reviewCount,
+		ResourceID:  resourceID,
+	})
+	if err != nil {
+		return false, infra.WrapRepoErr("failed to save review summary", err)
+	}
+	return updated > 0, nil
+}
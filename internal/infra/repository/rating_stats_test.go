@@ -13,6 +13,7 @@ import (
 	repositorymock "gin-clean-starter/tests/mock/repository"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -189,3 +190,113 @@ func TestRepository_ApplyOnDelete(t *testing.T) {
 		})
 	}
 }
+
+// =============================================================================
+// Recalculate Tests
+// =============================================================================
+
+func TestRepository_Recalculate(t *testing.T) {
+	ctx := context.Background()
+	resourceID := uuid.New()
+
+	t.Run("success: stats rebuilt", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockQueries := repositorymock.NewMockRatingStatsQueries(ctrl)
+		mockDB := &mockDBTX{}
+		mockQueries.EXPECT().RecalculateResourceRatingStats(ctx, mockDB, resourceID).Return(nil)
+
+		assert.NoError(t, repository.NewRatingStatsRepository(mockQueries, mockDB).Recalculate(ctx, mockDB, resourceID))
+	})
+
+	t.Run("error: database error occurs", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockQueries := repositorymock.NewMockRatingStatsQueries(ctrl)
+		mockDB := &mockDBTX{}
+		mockQueries.EXPECT().RecalculateResourceRatingStats(ctx, mockDB, resourceID).Return(errors.New("database connection error"))
+
+		err := repository.NewRatingStatsRepository(mockQueries, mockDB).Recalculate(ctx, mockDB, resourceID)
+		assert.True(t, infra.IsKind(err, infra.KindDBFailure), "got %v", err)
+	})
+}
+
+// =============================================================================
+// SaveSummary Tests
+// =============================================================================
+
+func TestRepository_SaveSummary(t *testing.T) {
+	ctx := context.Background()
+	resourceID := uuid.New()
+
+	testCases := []struct {
+		name          string
+		summary       string
+		setupMock     func(*repositorymock.MockRatingStatsQueries, sqlc.DBTX)
+		expectSaved   bool
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name:    "success: summary saved for the current review count",
+			summary: "2 of 2 recent reviews are positive.",
+			setupMock: func(mock *repositorymock.MockRatingStatsQueries, tx sqlc.DBTX) {
+				mock.EXPECT().UpdateReviewSummary(ctx, tx, sqlc.UpdateReviewSummaryParams{
+					Summary:     pgtype.Text{String: "2 of 2 recent reviews are positive.", Valid: true},
+					ReviewCount: 2,
+					ResourceID:  resourceID,
+				}).Return(int64(1), nil)
+			},
+			expectSaved: true,
+		},
+		{
+			name:    "success: empty summary clears the column",
+			summary: "",
+			setupMock: func(mock *repositorymock.MockRatingStatsQueries, tx sqlc.DBTX) {
+				mock.EXPECT().UpdateReviewSummary(ctx, tx, sqlc.UpdateReviewSummaryParams{
+					ReviewCount: 2,
+					ResourceID:  resourceID,
+				}).Return(int64(1), nil)
+			},
+			expectSaved: true,
+		},
+		{
+			name:    "success: review count moved on, nothing saved",
+			summary: "outdated",
+			setupMock: func(mock *repositorymock.MockRatingStatsQueries, tx sqlc.DBTX) {
+				mock.EXPECT().UpdateReviewSummary(ctx, tx, gomock.Any()).Return(int64(0), nil)
+			},
+			expectSaved: false,
+		},
+		{
+			name:    "error: database error occurs",
+			summary: "x",
+			setupMock: func(mock *repositorymock.MockRatingStatsQueries, tx sqlc.DBTX) {
+				mock.EXPECT().UpdateReviewSummary(ctx, tx, gomock.Any()).Return(int64(0), errors.New("database connection error"))
+			},
+			expectedError: true,
+			expectKind:    infra.KindDBFailure,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockRatingStatsQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewRatingStatsRepository(mockQueries, mockDB)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			saved, err := repo.SaveSummary(ctx, mockDB, resourceID, tc.summary, 2)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectSaved, saved)
+		})
+	}
+}
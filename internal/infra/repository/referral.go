@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/domain/referral"
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/pkg/pgconv"
+
+	"github.com/google/uuid"
+)
+
+var errReferralNotPending = errs.New("referral not pending")
+
+type ReferralWriteQueries interface {
+	CreateReferral(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateReferralParams) (uuid.UUID, error)
+	MarkReferralRewarded(ctx context.Context, db sqlc.DBTX, arg sqlc.MarkReferralRewardedParams) (int64, error)
+	CreateReferralCredit(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateReferralCreditParams) error
+}
+
+type ReferralRepository struct {
+	queries ReferralWriteQueries
+}
+
+func NewReferralRepository(queries ReferralWriteQueries) *ReferralRepository {
+	return &ReferralRepository{
+		queries: queries,
+	}
+}
+
+// Create reports KindDuplicateKey when the referred user already has a referral.
+func (r *ReferralRepository) Create(ctx context.Context, tx sqlc.DBTX, referrerID, referredID uuid.UUID) (uuid.UUID, error) {
+	id, err := r.queries.CreateReferral(ctx, tx, sqlc.CreateReferralParams{
+		ReferrerID: referrerID,
+		ReferredID: referredID,
+	})
+	if err != nil {
+		return uuid.Nil, infra.WrapRepoErr("failed to create referral", err)
+	}
+	return id, nil
+}
+
+// MarkRewarded reports KindConflict when the referral was already rewarded, so concurrent
+// reward runs credit each referral once.
+func (r *ReferralRepository) MarkRewarded(ctx context.Context, tx sqlc.DBTX, referralID, reservationID uuid.UUID, rewardedAt time.Time) error {
+	affected, err := r.queries.MarkReferralRewarded(ctx, tx, sqlc.MarkReferralRewardedParams{
+		ID:            referralID,
+		ReservationID: pgconv.UUIDToPgtype(reservationID),
+		RewardedAt:    pgconv.TimeToPgtype(rewardedAt),
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to mark referral rewarded", err)
+	}
+	if affected == 0 {
+		return infra.WrapRepoErr("referral not pending", errReferralNotPending, infra.KindConflict)
+	}
+	return nil
+}
+
+func (r *ReferralRepository) CreateCredit(ctx context.Context, tx sqlc.DBTX, referralID uuid.UUID, credit referral.Credit) error {
+	err := r.queries.CreateReferralCredit(ctx, tx, sqlc.CreateReferralCreditParams{
+		ReferralID:  referralID,
+		UserID:      credit.UserID,
+		Party:       credit.Party.String(),
+		AmountCents: credit.AmountCents,
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to create referral credit", err)
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+//go:build unit
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/domain/referral"
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/infra/repository"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	repositorymock "gin-clean-starter/tests/mock/repository"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReferralRepository_Create(t *testing.T) {
+	ctx := context.Background()
+	referrerID, referredID := uuid.New(), uuid.New()
+	params := sqlc.CreateReferralParams{ReferrerID: referrerID, ReferredID: referredID}
+
+	testCases := []struct {
+		name          string
+		setupMock     func(*repositorymock.MockReferralWriteQueries, sqlc.DBTX)
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name: "success: referral stored",
+			setupMock: func(mock *repositorymock.MockReferralWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().CreateReferral(ctx, db, params).Return(uuid.New(), nil)
+			},
+		},
+		{
+			name: "error: referred user already has a referral",
+			setupMock: func(mock *repositorymock.MockReferralWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().CreateReferral(ctx, db, params).Return(uuid.Nil, &pgconn.PgError{Code: "23505"})
+			},
+			expectedError: true,
+			expectKind:    infra.KindDuplicateKey,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockReferralWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewReferralRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			id, err := repo.Create(ctx, mockDB, referrerID, referredID)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotEqual(t, uuid.Nil, id)
+		})
+	}
+}
+
+func TestReferralRepository_MarkRewarded(t *testing.T) {
+	ctx := context.Background()
+	referralID, reservationID := uuid.New(), uuid.New()
+	at := time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC)
+	params := sqlc.MarkReferralRewardedParams{
+		ID:            referralID,
+		ReservationID: pgconv.UUIDToPgtype(reservationID),
+		RewardedAt:    pgconv.TimeToPgtype(at),
+	}
+
+	testCases := []struct {
+		name          string
+		setupMock     func(*repositorymock.MockReferralWriteQueries, sqlc.DBTX)
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name: "success: pending referral rewarded",
+			setupMock: func(mock *repositorymock.MockReferralWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().MarkReferralRewarded(ctx, db, params).Return(int64(1), nil)
+			},
+		},
+		{
+			name: "error: referral already rewarded by another run",
+			setupMock: func(mock *repositorymock.MockReferralWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().MarkReferralRewarded(ctx, db, params).Return(int64(0), nil)
+			},
+			expectedError: true,
+			expectKind:    infra.KindConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockReferralWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewReferralRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			err := repo.MarkRewarded(ctx, mockDB, referralID, reservationID, at)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestReferralRepository_CreateCredit(t *testing.T) {
+	ctx := context.Background()
+	referralID, userID := uuid.New(), uuid.New()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQueries := repositorymock.NewMockReferralWriteQueries(ctrl)
+	mockDB := &mockDBTX{}
+	repo := repository.NewReferralRepository(mockQueries)
+
+	mockQueries.EXPECT().CreateReferralCredit(ctx, mockDB, sqlc.CreateReferralCreditParams{
+		ReferralID:  referralID,
+		UserID:      userID,
+		Party:       "referred",
+		AmountCents: 500,
+	}).Return(nil)
+
+	err := repo.CreateCredit(ctx, mockDB, referralID, referral.Credit{UserID: userID, Party: referral.PartyReferred, AmountCents: 500})
+	require.NoError(t, err)
+}
@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/pkg/ptr"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+type RefreshTokenWriteQueries interface {
+	CreateRefreshToken(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateRefreshTokenParams) error
+	GetRefreshTokenForUpdate(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (sqlc.RefreshTokens, error)
+	MarkRefreshTokenRotated(ctx context.Context, db sqlc.DBTX, arg sqlc.MarkRefreshTokenRotatedParams) error
+	RevokeRefreshTokenSession(ctx context.Context, db sqlc.DBTX, arg sqlc.RevokeRefreshTokenSessionParams) error
+	CreateRevokedToken(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateRevokedTokenParams) error
+	UpsertUserSessionCutoff(ctx context.Context, db sqlc.DBTX, arg sqlc.UpsertUserSessionCutoffParams) error
+	RevokeUserRefreshTokens(ctx context.Context, db sqlc.DBTX, arg sqlc.RevokeUserRefreshTokensParams) error
+	UpsertUserSession(ctx context.Context, db sqlc.DBTX, arg sqlc.UpsertUserSessionParams) error
+	RevokeUserSession(ctx context.Context, db sqlc.DBTX, arg sqlc.RevokeUserSessionParams) (int64, error)
+	MarkUserSessionRevoked(ctx context.Context, db sqlc.DBTX, arg sqlc.MarkUserSessionRevokedParams) error
+	MarkUserSessionsRevoked(ctx context.Context, db sqlc.DBTX, arg sqlc.MarkUserSessionsRevokedParams) error
+}
+
+type RefreshTokenRepository struct {
+	queries RefreshTokenWriteQueries
+}
+
+func NewRefreshTokenRepository(queries RefreshTokenWriteQueries) *RefreshTokenRepository {
+	return &RefreshTokenRepository{
+		queries: queries,
+	}
+}
+
+func (r *RefreshTokenRepository) Create(ctx context.Context, tx sqlc.DBTX, token shared.RefreshToken) error {
+	err := r.queries.CreateRefreshToken(ctx, tx, sqlc.CreateRefreshTokenParams{
+		ID:        token.ID,
+		SessionID: token.SessionID,
+		UserID:    token.UserID,
+		ExpiresAt: pgconv.TimeToPgtype(token.ExpiresAt),
+		RotatedAt: pgconv.TimePtrToPgtype(token.RotatedAt),
+		CreatedAt: pgconv.TimeToPgtype(token.CreatedAt),
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to store refresh token", err)
+	}
+	return nil
+}
+
+func (r *RefreshTokenRepository) FindForUpdate(ctx context.Context, tx sqlc.DBTX, id uuid.UUID) (*shared.RefreshToken, error) {
+	row, err := r.queries.GetRefreshTokenForUpdate(ctx, tx, id)
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return nil, infra.WrapRepoErr("refresh token not found", err, infra.KindNotFound)
+		}
+		return nil, infra.WrapRepoErr("failed to find refresh token", err)
+	}
+	return &shared.RefreshToken{
+		ID:        row.ID,
+		SessionID: row.SessionID,
+		UserID:    row.UserID,
+		ExpiresAt: pgconv.TimeFromPgtype(row.ExpiresAt),
+		RotatedAt: ptr.TimeFromPgtype(row.RotatedAt),
+		RevokedAt: ptr.TimeFromPgtype(row.RevokedAt),
+		CreatedAt: pgconv.TimeFromPgtype(row.CreatedAt),
+	}, nil
+}
+
+func (r *RefreshTokenRepository) MarkRotated(ctx context.Context, tx sqlc.DBTX, id uuid.UUID, at time.Time) error {
+	err := r.queries.MarkRefreshTokenRotated(ctx, tx, sqlc.MarkRefreshTokenRotatedParams{
+		RotatedAt: pgconv.TimeToPgtype(at),
+		ID:        id,
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to mark refresh token rotated", err)
+	}
+	return nil
+}
+
+func (r *RefreshTokenRepository) TouchSession(ctx context.Context, tx sqlc.DBTX, session shared.Session) error {
+	err := r.queries.UpsertUserSession(ctx, tx, sqlc.UpsertUserSessionParams{
+		ID:                session.ID,
+		UserID:            session.UserID,
+		DeviceFingerprint: session.DeviceFingerprint,
+		IpAddress:         session.IPAddress,
+		UserAgent:         session.UserAgent,
+		SeenAt:            pgconv.TimeToPgtype(session.SeenAt),
+		ExpiresAt:         pgconv.TimeToPgtype(session.ExpiresAt),
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to record session", err)
+	}
+	return nil
+}
+
+func (r *RefreshTokenRepository) RevokeSession(ctx context.Context, tx sqlc.DBTX, sessionID uuid.UUID, at time.Time) error {
+	err := r.queries.RevokeRefreshTokenSession(ctx, tx, sqlc.RevokeRefreshTokenSessionParams{
+		RevokedAt: pgconv.TimeToPgtype(at),
+		SessionID: sessionID,
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to revoke session", err)
+	}
+	err = r.queries.MarkUserSessionRevoked(ctx, tx, sqlc.MarkUserSessionRevokedParams{
+		RevokedAt: pgconv.TimeToPgtype(at),
+		ID:        sessionID,
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to revoke session", err)
+	}
+	return nil
+}
+
+func (r *RefreshTokenRepository) RevokeUserSession(ctx context.Context, tx sqlc.DBTX, userID, sessionID uuid.UUID, at time.Time) error {
+	rows, err := r.queries.RevokeUserSession(ctx, tx, sqlc.RevokeUserSessionParams{
+		RevokedAt: pgconv.TimeToPgtype(at),
+		ID:        sessionID,
+		UserID:    userID,
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to revoke session", err)
+	}
+	if rows == 0 {
+		return infra.WrapRepoErr("session not found", nil, infra.KindNotFound)
+	}
+	err = r.queries.RevokeRefreshTokenSession(ctx, tx, sqlc.RevokeRefreshTokenSessionParams{
+		RevokedAt: pgconv.TimeToPgtype(at),
+		SessionID: sessionID,
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to revoke session", err)
+	}
+	return nil
+}
+
+func (r *RefreshTokenRepository) RevokeToken(ctx context.Context, tx sqlc.DBTX, token shared.IssuedToken, at time.Time) error {
+	err := r.queries.CreateRevokedToken(ctx, tx, sqlc.CreateRevokedTokenParams{
+		Jti:       token.ID,
+		UserID:    token.UserID,
+		ExpiresAt: pgconv.TimeToPgtype(token.ExpiresAt),
+		RevokedAt: pgconv.TimeToPgtype(at),
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to revoke token", err)
+	}
+	return nil
+}
+
+func (r *RefreshTokenRepository) RevokeUser(ctx context.Context, tx sqlc.DBTX, userID uuid.UUID, at time.Time) error {
+	err := r.queries.UpsertUserSessionCutoff(ctx, tx, sqlc.UpsertUserSessionCutoffParams{
+		UserID:        userID,
+		RevokedBefore: pgconv.TimeToPgtype(at),
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to revoke user tokens", err)
+	}
+	err = r.queries.RevokeUserRefreshTokens(ctx, tx, sqlc.RevokeUserRefreshTokensParams{
+		RevokedAt: pgconv.TimeToPgtype(at),
+		UserID:    userID,
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to revoke user sessions", err)
+	}
+	err = r.queries.MarkUserSessionsRevoked(ctx, tx, sqlc.MarkUserSessionsRevokedParams{
+		RevokedAt: pgconv.TimeToPgtype(at),
+		UserID:    userID,
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to revoke user sessions", err)
+	}
+	return nil
+}
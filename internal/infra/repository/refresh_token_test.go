@@ -0,0 +1,274 @@
+//go:build unit
+
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/infra/repository"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+	repositorymock "gin-clean-starter/tests/mock/repository"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRefreshTokenRepository_Create(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	token := shared.RefreshToken{
+		ID:        uuid.New(),
+		SessionID: uuid.New(),
+		UserID:    uuid.New(),
+		ExpiresAt: now.Add(168 * time.Hour),
+		CreatedAt: now,
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQueries := repositorymock.NewMockRefreshTokenWriteQueries(ctrl)
+	mockDB := &mockDBTX{}
+	repo := repository.NewRefreshTokenRepository(mockQueries)
+
+	mockQueries.EXPECT().CreateRefreshToken(ctx, mockDB, sqlc.CreateRefreshTokenParams{
+		ID:        token.ID,
+		SessionID: token.SessionID,
+		UserID:    token.UserID,
+		ExpiresAt: pgconv.TimeToPgtype(now.Add(168 * time.Hour)),
+		RotatedAt: pgtype.Timestamptz{},
+		CreatedAt: pgconv.TimeToPgtype(now),
+	}).Return(nil)
+
+	require.NoError(t, repo.Create(ctx, mockDB, token))
+}
+
+func TestRefreshTokenRepository_FindForUpdate(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	tokenID := uuid.New()
+	sessionID := uuid.New()
+	userID := uuid.New()
+
+	testCases := []struct {
+		name          string
+		setupMock     func(*repositorymock.MockRefreshTokenWriteQueries, sqlc.DBTX)
+		expected      *shared.RefreshToken
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name: "success: rotated token",
+			setupMock: func(mock *repositorymock.MockRefreshTokenWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().GetRefreshTokenForUpdate(ctx, db, tokenID).Return(sqlc.RefreshTokens{
+					ID:        tokenID,
+					SessionID: sessionID,
+					UserID:    userID,
+					ExpiresAt: pgconv.TimeToPgtype(now.Add(time.Hour)),
+					RotatedAt: pgconv.TimeToPgtype(now),
+					CreatedAt: pgconv.TimeToPgtype(now.Add(-time.Hour)),
+				}, nil)
+			},
+			expected: &shared.RefreshToken{
+				ID:        tokenID,
+				SessionID: sessionID,
+				UserID:    userID,
+				ExpiresAt: now.Add(time.Hour),
+				RotatedAt: &now,
+				CreatedAt: now.Add(-time.Hour),
+			},
+		},
+		{
+			name: "error: token never stored",
+			setupMock: func(mock *repositorymock.MockRefreshTokenWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().GetRefreshTokenForUpdate(ctx, db, tokenID).Return(sqlc.RefreshTokens{}, pgx.ErrNoRows)
+			},
+			expectedError: true,
+			expectKind:    infra.KindNotFound,
+		},
+		{
+			name: "error: database error occurs",
+			setupMock: func(mock *repositorymock.MockRefreshTokenWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().GetRefreshTokenForUpdate(ctx, db, tokenID).Return(sqlc.RefreshTokens{}, errors.New("database connection error"))
+			},
+			expectedError: true,
+			expectKind:    infra.KindDBFailure,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockRefreshTokenWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewRefreshTokenRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			token, err := repo.FindForUpdate(ctx, mockDB, tokenID)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, token)
+		})
+	}
+}
+
+func TestRefreshTokenRepository_RevokeUser(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	userID := uuid.New()
+
+	testCases := []struct {
+		name          string
+		setupMock     func(*repositorymock.MockRefreshTokenWriteQueries, sqlc.DBTX)
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name: "success: cutoff recorded before sessions are revoked",
+			setupMock: func(mock *repositorymock.MockRefreshTokenWriteQueries, db sqlc.DBTX) {
+				gomock.InOrder(
+					mock.EXPECT().UpsertUserSessionCutoff(ctx, db, sqlc.UpsertUserSessionCutoffParams{
+						UserID:        userID,
+						RevokedBefore: pgconv.TimeToPgtype(now),
+					}).Return(nil),
+					mock.EXPECT().RevokeUserRefreshTokens(ctx, db, sqlc.RevokeUserRefreshTokensParams{
+						RevokedAt: pgconv.TimeToPgtype(now),
+						UserID:    userID,
+					}).Return(nil),
+					mock.EXPECT().MarkUserSessionsRevoked(ctx, db, sqlc.MarkUserSessionsRevokedParams{
+						RevokedAt: pgconv.TimeToPgtype(now),
+						UserID:    userID,
+					}).Return(nil),
+				)
+			},
+		},
+		{
+			name: "error: user does not exist",
+			setupMock: func(mock *repositorymock.MockRefreshTokenWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().UpsertUserSessionCutoff(ctx, db, gomock.Any()).Return(&pgconn.PgError{Code: "23503"})
+			},
+			expectedError: true,
+			expectKind:    infra.KindForeignKeyViolated,
+		},
+		{
+			name: "error: database error occurs",
+			setupMock: func(mock *repositorymock.MockRefreshTokenWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().UpsertUserSessionCutoff(ctx, db, gomock.Any()).Return(nil)
+				mock.EXPECT().RevokeUserRefreshTokens(ctx, db, gomock.Any()).Return(errors.New("database connection error"))
+			},
+			expectedError: true,
+			expectKind:    infra.KindDBFailure,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockRefreshTokenWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewRefreshTokenRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			err := repo.RevokeUser(ctx, mockDB, userID, now)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRefreshTokenRepository_RevokeUserSession(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	userID := uuid.New()
+	sessionID := uuid.New()
+
+	testCases := []struct {
+		name          string
+		setupMock     func(*repositorymock.MockRefreshTokenWriteQueries, sqlc.DBTX)
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name: "success: session and its tokens are revoked",
+			setupMock: func(mock *repositorymock.MockRefreshTokenWriteQueries, db sqlc.DBTX) {
+				gomock.InOrder(
+					mock.EXPECT().RevokeUserSession(ctx, db, sqlc.RevokeUserSessionParams{
+						RevokedAt: pgconv.TimeToPgtype(now),
+						ID:        sessionID,
+						UserID:    userID,
+					}).Return(int64(1), nil),
+					mock.EXPECT().RevokeRefreshTokenSession(ctx, db, sqlc.RevokeRefreshTokenSessionParams{
+						RevokedAt: pgconv.TimeToPgtype(now),
+						SessionID: sessionID,
+					}).Return(nil),
+				)
+			},
+		},
+		{
+			name: "error: no live session of the user",
+			setupMock: func(mock *repositorymock.MockRefreshTokenWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().RevokeUserSession(ctx, db, gomock.Any()).Return(int64(0), nil)
+			},
+			expectedError: true,
+			expectKind:    infra.KindNotFound,
+		},
+		{
+			name: "error: database error occurs",
+			setupMock: func(mock *repositorymock.MockRefreshTokenWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().RevokeUserSession(ctx, db, gomock.Any()).Return(int64(1), nil)
+				mock.EXPECT().RevokeRefreshTokenSession(ctx, db, gomock.Any()).Return(errors.New("database connection error"))
+			},
+			expectedError: true,
+			expectKind:    infra.KindDBFailure,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockRefreshTokenWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewRefreshTokenRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			err := repo.RevokeUserSession(ctx, mockDB, userID, sessionID, now)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+type RequestRecordingWriteQueries interface {
+	CreateRequestRecording(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateRequestRecordingParams) error
+	ClaimRequestRecordingSlot(ctx context.Context, db sqlc.DBTX, arg sqlc.ClaimRequestRecordingSlotParams) (sqlc.ClaimRequestRecordingSlotRow, error)
+	CreateRecordedRequest(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateRecordedRequestParams) error
+	ListRecordedRequestKeys(ctx context.Context, db sqlc.DBTX, recordingID uuid.UUID) ([]string, error)
+	DeleteRequestRecording(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (int64, error)
+}
+
+type RequestRecordingRepository struct {
+	queries RequestRecordingWriteQueries
+}
+
+func NewRequestRecordingRepository(queries RequestRecordingWriteQueries) *RequestRecordingRepository {
+	return &RequestRecordingRepository{
+		queries: queries,
+	}
+}
+
+func (r *RequestRecordingRepository) Create(ctx context.Context, tx sqlc.DBTX, rec shared.RequestRecording) error {
+	err := r.queries.CreateRequestRecording(ctx, tx, sqlc.CreateRequestRecordingParams{
+		ID:               rec.ID,
+		UserID:           rec.UserID,
+		EnabledBy:        pgconv.UUIDToPgtype(rec.EnabledBy),
+		Reason:           rec.Reason,
+		ConsentReference: rec.ConsentReference,
+		MaxRequests:      rec.MaxRequests,
+		ExpiresAt:        pgconv.TimeToPgtype(rec.ExpiresAt),
+		CreatedAt:        pgconv.TimeToPgtype(rec.CreatedAt),
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to create request recording", err)
+	}
+	return nil
+}
+
+func (r *RequestRecordingRepository) ClaimSlot(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, now time.Time) (shared.RecordingSlot, error) {
+	row, err := r.queries.ClaimRequestRecordingSlot(ctx, db, sqlc.ClaimRequestRecordingSlotParams{
+		UserID: userID,
+		Now:    pgconv.TimeToPgtype(now),
+	})
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return shared.RecordingSlot{}, infra.WrapRepoErr("no active request recording", err, infra.KindNotFound)
+		}
+		return shared.RecordingSlot{}, infra.WrapRepoErr("failed to claim request recording slot", err)
+	}
+	return shared.RecordingSlot{RecordingID: row.ID, Seq: row.RecordedCount}, nil
+}
+
+func (r *RequestRecordingRepository) AddCapture(ctx context.Context, db sqlc.DBTX, capture shared.RecordedRequest) error {
+	err := r.queries.CreateRecordedRequest(ctx, db, sqlc.CreateRecordedRequestParams{
+		RecordingID: capture.RecordingID,
+		Seq:         capture.Seq,
+		Method:      capture.Method,
+		Path:        capture.Path,
+		Status:      capture.Status,
+		DurationMs:  capture.DurationMs,
+		StorageKey:  capture.StorageKey,
+		RecordedAt:  pgconv.TimeToPgtype(capture.RecordedAt),
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to add recorded request", err)
+	}
+	return nil
+}
+
+func (r *RequestRecordingRepository) Delete(ctx context.Context, tx sqlc.DBTX, id uuid.UUID) ([]string, error) {
+	keys, err := r.queries.ListRecordedRequestKeys(ctx, tx, id)
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to list recorded requests", err)
+	}
+	rows, err := r.queries.DeleteRequestRecording(ctx, tx, id)
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to delete request recording", err)
+	}
+	if rows == 0 {
+		return nil, infra.WrapRepoErr("request recording not found", nil, infra.KindNotFound)
+	}
+	return keys, nil
+}
@@ -0,0 +1,219 @@
+//go:build unit
+
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/infra/repository"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+	repositorymock "gin-clean-starter/tests/mock/repository"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRequestRecordingRepository_Create(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	rec := shared.RequestRecording{
+		ID:               uuid.New(),
+		UserID:           uuid.New(),
+		EnabledBy:        uuid.New(),
+		Reason:           "checkout fails for this customer",
+		ConsentReference: "TICKET-1234",
+		MaxRequests:      20,
+		ExpiresAt:        now.Add(72 * time.Hour),
+		CreatedAt:        now,
+	}
+
+	testCases := []struct {
+		name          string
+		setupMock     func(*repositorymock.MockRequestRecordingWriteQueries, sqlc.DBTX)
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name: "success: recording created",
+			setupMock: func(mock *repositorymock.MockRequestRecordingWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().CreateRequestRecording(ctx, db, sqlc.CreateRequestRecordingParams{
+					ID:               rec.ID,
+					UserID:           rec.UserID,
+					EnabledBy:        pgconv.UUIDToPgtype(rec.EnabledBy),
+					Reason:           rec.Reason,
+					ConsentReference: rec.ConsentReference,
+					MaxRequests:      20,
+					ExpiresAt:        pgconv.TimeToPgtype(rec.ExpiresAt),
+					CreatedAt:        pgconv.TimeToPgtype(now),
+				}).Return(nil)
+			},
+		},
+		{
+			name: "error: user does not exist",
+			setupMock: func(mock *repositorymock.MockRequestRecordingWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().CreateRequestRecording(ctx, db, gomock.Any()).Return(&pgconn.PgError{Code: "23503"})
+			},
+			expectedError: true,
+			expectKind:    infra.KindForeignKeyViolated,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockRequestRecordingWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewRequestRecordingRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			err := repo.Create(ctx, mockDB, rec)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRequestRecordingRepository_ClaimSlot(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	userID := uuid.New()
+	recordingID := uuid.New()
+
+	testCases := []struct {
+		name          string
+		setupMock     func(*repositorymock.MockRequestRecordingWriteQueries, sqlc.DBTX)
+		expected      shared.RecordingSlot
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name: "success: next slot claimed",
+			setupMock: func(mock *repositorymock.MockRequestRecordingWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().ClaimRequestRecordingSlot(ctx, db, sqlc.ClaimRequestRecordingSlotParams{
+					UserID: userID,
+					Now:    pgconv.TimeToPgtype(now),
+				}).Return(sqlc.ClaimRequestRecordingSlotRow{ID: recordingID, RecordedCount: 3}, nil)
+			},
+			expected: shared.RecordingSlot{RecordingID: recordingID, Seq: 3},
+		},
+		{
+			name: "error: no active recording",
+			setupMock: func(mock *repositorymock.MockRequestRecordingWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().ClaimRequestRecordingSlot(ctx, db, gomock.Any()).Return(sqlc.ClaimRequestRecordingSlotRow{}, pgx.ErrNoRows)
+			},
+			expectedError: true,
+			expectKind:    infra.KindNotFound,
+		},
+		{
+			name: "error: database error occurs",
+			setupMock: func(mock *repositorymock.MockRequestRecordingWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().ClaimRequestRecordingSlot(ctx, db, gomock.Any()).Return(sqlc.ClaimRequestRecordingSlotRow{}, errors.New("database connection error"))
+			},
+			expectedError: true,
+			expectKind:    infra.KindDBFailure,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockRequestRecordingWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewRequestRecordingRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			slot, err := repo.ClaimSlot(ctx, mockDB, userID, now)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, slot)
+		})
+	}
+}
+
+func TestRequestRecordingRepository_Delete(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+
+	testCases := []struct {
+		name          string
+		setupMock     func(*repositorymock.MockRequestRecordingWriteQueries, sqlc.DBTX)
+		expected      []string
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name: "success: storage keys returned",
+			setupMock: func(mock *repositorymock.MockRequestRecordingWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().ListRecordedRequestKeys(ctx, db, id).Return([]string{"a.json", "b.json"}, nil)
+				mock.EXPECT().DeleteRequestRecording(ctx, db, id).Return(int64(1), nil)
+			},
+			expected: []string{"a.json", "b.json"},
+		},
+		{
+			name: "error: recording not found",
+			setupMock: func(mock *repositorymock.MockRequestRecordingWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().ListRecordedRequestKeys(ctx, db, id).Return([]string{}, nil)
+				mock.EXPECT().DeleteRequestRecording(ctx, db, id).Return(int64(0), nil)
+			},
+			expectedError: true,
+			expectKind:    infra.KindNotFound,
+		},
+		{
+			name: "error: database error occurs",
+			setupMock: func(mock *repositorymock.MockRequestRecordingWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().ListRecordedRequestKeys(ctx, db, id).Return(nil, errors.New("database connection error"))
+			},
+			expectedError: true,
+			expectKind:    infra.KindDBFailure,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockRequestRecordingWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewRequestRecordingRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			keys, err := repo.Delete(ctx, mockDB, id)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, keys)
+		})
+	}
+}
@@ -2,17 +2,35 @@ package repository
 
 import (
 	"context"
+	"sort"
+	"time"
 
 	"gin-clean-starter/internal/domain/reservation"
 	"gin-clean-starter/internal/infra"
 	"gin-clean-starter/internal/infra/repository/converter"
 	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
 
 	"github.com/google/uuid"
 )
 
+var (
+	errReservationNotCanceled    = errs.New("no confirmed reservation canceled")
+	errReservationNotTransferred = errs.New("no transferable reservation updated")
+	errReservationNotPending     = errs.New("no reservation pending approval updated")
+)
+
 type ReservationWriteQueries interface {
 	CreateReservation(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateReservationParams) (uuid.UUID, error)
+	CreateReservationDiscount(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateReservationDiscountParams) error
+	CancelReservation(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (int64, error)
+	CancelReservationsInRange(ctx context.Context, db sqlc.DBTX, arg sqlc.CancelReservationsInRangeParams) ([]sqlc.CancelReservationsInRangeRow, error)
+	HasReservationInSlot(ctx context.Context, db sqlc.DBTX, arg sqlc.HasReservationInSlotParams) (bool, error)
+	ResolvePendingReservation(ctx context.Context, db sqlc.DBTX, arg sqlc.ResolvePendingReservationParams) (int64, error)
+	SetReservationGroup(ctx context.Context, db sqlc.DBTX, arg sqlc.SetReservationGroupParams) error
+	TransferReservation(ctx context.Context, db sqlc.DBTX, arg sqlc.TransferReservationParams) (int64, error)
 }
 
 type ReservationRepository struct {
@@ -29,11 +47,103 @@ func NewReservationRepository(queries ReservationWriteQueries, db sqlc.DBTX) *Re
 
 func (r *ReservationRepository) Create(ctx context.Context, tx sqlc.DBTX, res *reservation.Reservation) (uuid.UUID, error) {
 	params := converter.ReservationToInfra(res)
-
 	resultID, err := r.queries.CreateReservation(ctx, tx, params)
 	if err != nil {
 		return uuid.Nil, infra.WrapRepoErr("failed to create reservation", err)
 	}
 
+	for _, arg := range converter.ReservationDiscountsToInfra(resultID, res.Discounts()) {
+		if err = r.queries.CreateReservationDiscount(ctx, tx, arg); err != nil {
+			return uuid.Nil, infra.WrapRepoErr("failed to create reservation discount", err)
+		}
+	}
+
 	return resultID, nil
 }
+
+// Cancel moves a confirmed or pending reservation to canceled; anything else reports KindConflict.
+func (r *ReservationRepository) Cancel(ctx context.Context, tx sqlc.DBTX, reservationID uuid.UUID) error {
+	affected, err := r.queries.CancelReservation(ctx, tx, reservationID)
+	if err != nil {
+		return infra.WrapRepoErr("failed to cancel reservation", err)
+	}
+	if affected == 0 {
+		return infra.WrapRepoErr("reservation not cancelable", errReservationNotCanceled, infra.KindConflict)
+	}
+	return nil
+}
+
+func (r *ReservationRepository) CancelInRange(ctx context.Context, tx sqlc.DBTX, resourceID uuid.UUID, window shared.TimeRange, now time.Time) ([]shared.ReservationSlot, error) {
+	rows, err := r.queries.CancelReservationsInRange(ctx, tx, sqlc.CancelReservationsInRangeParams{
+		ResourceID: resourceID,
+		FromTime:   pgconv.TimeToPgtype(window.Start),
+		ToTime:     pgconv.TimeToPgtype(window.End),
+		Now:        pgconv.TimeToPgtype(now),
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to cancel reservations in range", err)
+	}
+
+	canceled := make([]shared.ReservationSlot, len(rows))
+	for i, row := range rows {
+		canceled[i] = shared.ReservationSlot{ID: row.ID, UserID: row.UserID, StartTime: row.StartTime.Time, EndTime: row.EndTime.Time}
+	}
+	// RETURNING has no order; slots on one resource never share a start time
+	sort.Slice(canceled, func(i, j int) bool { return canceled[i].StartTime.Before(canceled[j].StartTime) })
+	return canceled, nil
+}
+
+// SlotTaken reports whether any reservation on the resource overlaps [start, end), the
+// same rows reservations_no_overlap would reject an insert for.
+func (r *ReservationRepository) SlotTaken(ctx context.Context, tx sqlc.DBTX, resourceID uuid.UUID, start, end time.Time) (bool, error) {
+	taken, err := r.queries.HasReservationInSlot(ctx, tx, sqlc.HasReservationInSlotParams{
+		ResourceID: resourceID,
+		StartTime:  pgconv.TimeToPgtype(start),
+		EndTime:    pgconv.TimeToPgtype(end),
+	})
+	if err != nil {
+		return false, infra.WrapRepoErr("failed to check reservation slot", err)
+	}
+	return taken, nil
+}
+
+func (r *ReservationRepository) AssignGroup(ctx context.Context, tx sqlc.DBTX, groupID uuid.UUID, reservationIDs []uuid.UUID) error {
+	err := r.queries.SetReservationGroup(ctx, tx, sqlc.SetReservationGroupParams{
+		GroupID: pgconv.UUIDToPgtype(groupID),
+		Ids:     reservationIDs,
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to assign reservation group", err)
+	}
+	return nil
+}
+
+func (r *ReservationRepository) Transfer(ctx context.Context, tx sqlc.DBTX, reservationID, fromUserID, toUserID uuid.UUID, now time.Time) error {
+	affected, err := r.queries.TransferReservation(ctx, tx, sqlc.TransferReservationParams{
+		ToUserID:   toUserID,
+		ID:         reservationID,
+		FromUserID: fromUserID,
+		Now:        pgconv.TimeToPgtype(now),
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to transfer reservation", err)
+	}
+	if affected == 0 {
+		return infra.WrapRepoErr("reservation not transferable", errReservationNotTransferred, infra.KindConflict)
+	}
+	return nil
+}
+
+func (r *ReservationRepository) ResolvePending(ctx context.Context, tx sqlc.DBTX, reservationID uuid.UUID, status reservation.Status) error {
+	affected, err := r.queries.ResolvePendingReservation(ctx, tx, sqlc.ResolvePendingReservationParams{
+		Status: status.String(),
+		ID:     reservationID,
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to resolve pending reservation", err)
+	}
+	if affected == 0 {
+		return infra.WrapRepoErr("reservation not pending approval", errReservationNotPending, infra.KindConflict)
+	}
+	return nil
+}
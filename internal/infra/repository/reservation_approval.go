@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+var (
+	errApprovalNotDecided = errs.New("no pending approval decided")
+	errApproverNotRemoved = errs.New("no resource approver removed")
+)
+
+type ReservationApprovalWriteQueries interface {
+	AddResourceApprover(ctx context.Context, db sqlc.DBTX, arg sqlc.AddResourceApproverParams) error
+	CreateReservationApproval(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateReservationApprovalParams) error
+	DecideReservationApproval(ctx context.Context, db sqlc.DBTX, arg sqlc.DecideReservationApprovalParams) (int64, error)
+	ExpireReservationApprovals(ctx context.Context, db sqlc.DBTX, arg sqlc.ExpireReservationApprovalsParams) ([]sqlc.ExpireReservationApprovalsRow, error)
+	MarkReservationApprovalEscalated(ctx context.Context, db sqlc.DBTX, arg sqlc.MarkReservationApprovalEscalatedParams) (int64, error)
+	RemoveResourceApprover(ctx context.Context, db sqlc.DBTX, arg sqlc.RemoveResourceApproverParams) (int64, error)
+}
+
+type ReservationApprovalRepository struct {
+	queries ReservationApprovalWriteQueries
+}
+
+func NewReservationApprovalRepository(queries ReservationApprovalWriteQueries) *ReservationApprovalRepository {
+	return &ReservationApprovalRepository{
+		queries: queries,
+	}
+}
+
+func (r *ReservationApprovalRepository) Open(ctx context.Context, tx sqlc.DBTX, reservationID uuid.UUID, expiresAt time.Time) error {
+	err := r.queries.CreateReservationApproval(ctx, tx, sqlc.CreateReservationApprovalParams{
+		ReservationID: reservationID,
+		ExpiresAt:     pgconv.TimeToPgtype(expiresAt),
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to open reservation approval", err)
+	}
+	return nil
+}
+
+func (r *ReservationApprovalRepository) Decide(ctx context.Context, tx sqlc.DBTX, decision shared.ApprovalDecision) error {
+	affected, err := r.queries.DecideReservationApproval(ctx, tx, sqlc.DecideReservationApprovalParams{
+		Status:        decision.Status,
+		DecidedBy:     pgconv.UUIDToPgtype(decision.DecidedBy),
+		DecidedAt:     pgconv.TimeToPgtype(decision.DecidedAt),
+		Reason:        optionalText(decision.Reason),
+		ReservationID: decision.ReservationID,
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to decide reservation approval", err)
+	}
+	if affected == 0 {
+		return infra.WrapRepoErr("reservation approval not pending", errApprovalNotDecided, infra.KindConflict)
+	}
+	return nil
+}
+
+func (r *ReservationApprovalRepository) MarkEscalated(ctx context.Context, tx sqlc.DBTX, reservationID uuid.UUID, at time.Time) (bool, error) {
+	affected, err := r.queries.MarkReservationApprovalEscalated(ctx, tx, sqlc.MarkReservationApprovalEscalatedParams{
+		EscalatedAt:   pgconv.TimeToPgtype(at),
+		ReservationID: reservationID,
+	})
+	if err != nil {
+		return false, infra.WrapRepoErr("failed to mark reservation approval escalated", err)
+	}
+	return affected > 0, nil
+}
+
+func (r *ReservationApprovalRepository) ExpireDue(ctx context.Context, tx sqlc.DBTX, now time.Time, batchSize int32) ([]shared.ExpiredApproval, error) {
+	rows, err := r.queries.ExpireReservationApprovals(ctx, tx, sqlc.ExpireReservationApprovalsParams{
+		Now:        pgconv.TimeToPgtype(now),
+		LimitCount: batchSize,
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to expire reservation approvals", err)
+	}
+
+	expired := make([]shared.ExpiredApproval, len(rows))
+	for i, row := range rows {
+		expired[i] = shared.ExpiredApproval{ReservationID: row.ID, UserID: row.UserID}
+	}
+	return expired, nil
+}
+
+func (r *ReservationApprovalRepository) AddApprover(ctx context.Context, tx sqlc.DBTX, resourceID, userID uuid.UUID) error {
+	err := r.queries.AddResourceApprover(ctx, tx, sqlc.AddResourceApproverParams{
+		ResourceID: resourceID,
+		UserID:     userID,
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to add resource approver", err)
+	}
+	return nil
+}
+
+func (r *ReservationApprovalRepository) RemoveApprover(ctx context.Context, tx sqlc.DBTX, resourceID, userID uuid.UUID) error {
+	affected, err := r.queries.RemoveResourceApprover(ctx, tx, sqlc.RemoveResourceApproverParams{
+		ResourceID: resourceID,
+		UserID:     userID,
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to remove resource approver", err)
+	}
+	if affected == 0 {
+		return infra.WrapRepoErr("resource approver not found", errApproverNotRemoved, infra.KindNotFound)
+	}
+	return nil
+}
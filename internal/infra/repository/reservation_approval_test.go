@@ -0,0 +1,122 @@
+//go:build unit
+
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/infra/repository"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+	repositorymock "gin-clean-starter/tests/mock/repository"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReservationApprovalRepository_Decide(t *testing.T) {
+	ctx := context.Background()
+	reservationID, approverID := uuid.New(), uuid.New()
+	at := time.Date(2026, 10, 16, 9, 30, 0, 0, time.UTC)
+	decision := shared.ApprovalDecision{
+		ReservationID: reservationID,
+		Status:        shared.ApprovalStatusRejected,
+		DecidedBy:     approverID,
+		Reason:        "Room is being renovated",
+		DecidedAt:     at,
+	}
+	params := sqlc.DecideReservationApprovalParams{
+		Status:        shared.ApprovalStatusRejected,
+		DecidedBy:     pgconv.UUIDToPgtype(approverID),
+		DecidedAt:     pgconv.TimeToPgtype(at),
+		Reason:        pgtype.Text{String: "Room is being renovated", Valid: true},
+		ReservationID: reservationID,
+	}
+
+	testCases := []struct {
+		name          string
+		setupMock     func(*repositorymock.MockReservationApprovalWriteQueries, sqlc.DBTX)
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name: "success: pending approval decided",
+			setupMock: func(mock *repositorymock.MockReservationApprovalWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().DecideReservationApproval(ctx, db, params).Return(int64(1), nil)
+			},
+		},
+		{
+			name: "error: approval already decided or expired",
+			setupMock: func(mock *repositorymock.MockReservationApprovalWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().DecideReservationApproval(ctx, db, params).Return(int64(0), nil)
+			},
+			expectedError: true,
+			expectKind:    infra.KindConflict,
+		},
+		{
+			name: "error: database failure",
+			setupMock: func(mock *repositorymock.MockReservationApprovalWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().DecideReservationApproval(ctx, db, params).Return(int64(0), errors.New("connection reset"))
+			},
+			expectedError: true,
+			expectKind:    infra.KindDBFailure,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockReservationApprovalWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewReservationApprovalRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			err := repo.Decide(ctx, mockDB, decision)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestReservationApprovalRepository_RemoveApprover(t *testing.T) {
+	ctx := context.Background()
+	resourceID, userID := uuid.New(), uuid.New()
+	params := sqlc.RemoveResourceApproverParams{ResourceID: resourceID, UserID: userID}
+
+	t.Run("success: approver removed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockQueries := repositorymock.NewMockReservationApprovalWriteQueries(ctrl)
+		mockDB := &mockDBTX{}
+		mockQueries.EXPECT().RemoveResourceApprover(ctx, mockDB, params).Return(int64(1), nil)
+
+		err := repository.NewReservationApprovalRepository(mockQueries).RemoveApprover(ctx, mockDB, resourceID, userID)
+		require.NoError(t, err)
+	})
+
+	t.Run("error: user is not an approver of the resource", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockQueries := repositorymock.NewMockReservationApprovalWriteQueries(ctrl)
+		mockDB := &mockDBTX{}
+		mockQueries.EXPECT().RemoveResourceApprover(ctx, mockDB, params).Return(int64(0), nil)
+
+		err := repository.NewReservationApprovalRepository(mockQueries).RemoveApprover(ctx, mockDB, resourceID, userID)
+		require.Error(t, err)
+		assert.True(t, infra.IsKind(err, infra.KindNotFound))
+	})
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+var errAttachmentNotDeleted = errs.New("no reservation attachment deleted")
+
+type ReservationAttachmentWriteQueries interface {
+	CreateReservationAttachment(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateReservationAttachmentParams) error
+	DeleteReservationAttachment(ctx context.Context, db sqlc.DBTX, arg sqlc.DeleteReservationAttachmentParams) (int64, error)
+}
+
+type ReservationAttachmentRepository struct {
+	queries ReservationAttachmentWriteQueries
+}
+
+func NewReservationAttachmentRepository(queries ReservationAttachmentWriteQueries) *ReservationAttachmentRepository {
+	return &ReservationAttachmentRepository{
+		queries: queries,
+	}
+}
+
+func (r *ReservationAttachmentRepository) Create(ctx context.Context, tx sqlc.DBTX, a shared.ReservationAttachment) error {
+	err := r.queries.CreateReservationAttachment(ctx, tx, sqlc.CreateReservationAttachmentParams{
+		ID:            a.ID,
+		ReservationID: a.ReservationID,
+		UploadedBy:    a.UploadedBy,
+		UploaderSide:  a.UploaderSide,
+		Filename:      a.Filename,
+		ContentType:   a.ContentType,
+		SizeBytes:     a.SizeBytes,
+		StorageKey:    a.StorageKey,
+		OperatorOnly:  a.OperatorOnly,
+		CreatedAt:     pgconv.TimeToPgtype(a.CreatedAt),
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to create reservation attachment", err)
+	}
+	return nil
+}
+
+func (r *ReservationAttachmentRepository) Delete(ctx context.Context, tx sqlc.DBTX, reservationID, id uuid.UUID) error {
+	deleted, err := r.queries.DeleteReservationAttachment(ctx, tx, sqlc.DeleteReservationAttachmentParams{
+		ID:            id,
+		ReservationID: reservationID,
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to delete reservation attachment", err)
+	}
+	if deleted == 0 {
+		return infra.WrapRepoErr("reservation attachment not found", errAttachmentNotDeleted, infra.KindNotFound)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+//go:build unit
+
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/infra/repository"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	repositorymock "gin-clean-starter/tests/mock/repository"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReservationAttachmentRepository_Delete(t *testing.T) {
+	ctx := context.Background()
+	reservationID := uuid.New()
+	attachmentID := uuid.New()
+	params := sqlc.DeleteReservationAttachmentParams{
+		ID:            attachmentID,
+		ReservationID: reservationID,
+	}
+
+	testCases := []struct {
+		name          string
+		setupMock     func(*repositorymock.MockReservationAttachmentWriteQueries, sqlc.DBTX)
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name: "success: attachment deleted",
+			setupMock: func(mock *repositorymock.MockReservationAttachmentWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().DeleteReservationAttachment(ctx, db, params).Return(int64(1), nil)
+			},
+		},
+		{
+			name: "error: attachment not on the reservation",
+			setupMock: func(mock *repositorymock.MockReservationAttachmentWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().DeleteReservationAttachment(ctx, db, params).Return(int64(0), nil)
+			},
+			expectedError: true,
+			expectKind:    infra.KindNotFound,
+		},
+		{
+			name: "error: database error occurs",
+			setupMock: func(mock *repositorymock.MockReservationAttachmentWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().DeleteReservationAttachment(ctx, db, params).Return(int64(0), errors.New("database connection error"))
+			},
+			expectedError: true,
+			expectKind:    infra.KindDBFailure,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockReservationAttachmentWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewReservationAttachmentRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			err := repo.Delete(ctx, mockDB, reservationID, attachmentID)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/crypto"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type ReservationMessageWriteQueries interface {
+	CreateReservationMessage(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateReservationMessageParams) (uuid.UUID, error)
+	MarkReservationMessagesRead(ctx context.Context, db sqlc.DBTX, arg sqlc.MarkReservationMessagesReadParams) error
+	TouchReservation(ctx context.Context, db sqlc.DBTX, id uuid.UUID) error
+}
+
+type ReservationMessageRepository struct {
+	queries  ReservationMessageWriteQueries
+	envelope *crypto.Envelope
+}
+
+func NewReservationMessageRepository(queries ReservationMessageWriteQueries, envelope *crypto.Envelope) *ReservationMessageRepository {
+	return &ReservationMessageRepository{
+		queries:  queries,
+		envelope: envelope,
+	}
+}
+
+// Create stores the body encrypted when a key is configured, and in plaintext otherwise.
+func (r *ReservationMessageRepository) Create(ctx context.Context, tx sqlc.DBTX, msg shared.ReservationMessage) (uuid.UUID, error) {
+	params := sqlc.CreateReservationMessageParams{
+		ReservationID: msg.ReservationID,
+		AuthorID:      msg.AuthorID,
+		AuthorSide:    msg.AuthorSide,
+		Body:          pgtype.Text{String: msg.Body, Valid: true},
+		CreatedAt:     pgconv.TimeToPgtype(msg.CreatedAt),
+	}
+	if r.envelope.CanEncrypt() {
+		ciphertext, err := r.envelope.EncryptString(msg.Body, infra.ReservationMessageAAD(msg.AuthorID))
+		if err != nil {
+			return uuid.Nil, infra.WrapRepoErr("failed to encrypt reservation message", err)
+		}
+		params.Body = pgtype.Text{Valid: false}
+		params.BodyCiphertext = pgtype.Text{String: ciphertext, Valid: true}
+	}
+
+	id, err := r.queries.CreateReservationMessage(ctx, tx, params)
+	if err != nil {
+		return uuid.Nil, infra.WrapRepoErr("failed to create reservation message", err)
+	}
+	if err = r.queries.TouchReservation(ctx, tx, msg.ReservationID); err != nil {
+		return uuid.Nil, infra.WrapRepoErr("failed to touch reservation", err)
+	}
+	return id, nil
+}
+
+func (r *ReservationMessageRepository) MarkRead(ctx context.Context, tx sqlc.DBTX, reservationID uuid.UUID, side string, readAt time.Time) error {
+	err := r.queries.MarkReservationMessagesRead(ctx, tx, sqlc.MarkReservationMessagesReadParams{
+		ReservationID: reservationID,
+		Side:          side,
+		LastReadAt:    pgconv.TimeToPgtype(readAt),
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to mark reservation messages read", err)
+	}
+	if err = r.queries.TouchReservation(ctx, tx, reservationID); err != nil {
+		return infra.WrapRepoErr("failed to touch reservation", err)
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+//go:build unit
+
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/infra/repository"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/crypto"
+	"gin-clean-starter/internal/usecase/shared"
+	repositorymock "gin-clean-starter/tests/mock/repository"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReservationMessageRepository_Create(t *testing.T) {
+	ctx := context.Background()
+	keys, err := crypto.ParseKeys("k1:dGVzdC1jb2x1bW4tZW5jcnlwdGlvbi1rZXktMzJieXQ=")
+	require.NoError(t, err)
+	envelope, err := crypto.NewEnvelope(keys, "k1")
+	require.NoError(t, err)
+	noKeys, err := crypto.NewEnvelope(nil, "")
+	require.NoError(t, err)
+
+	msg := shared.ReservationMessage{
+		ReservationID: uuid.New(),
+		AuthorID:      uuid.New(),
+		AuthorSide:    shared.MessageSideUser,
+		Body:          "door code 4711",
+		CreatedAt:     time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC),
+	}
+
+	t.Run("success: stores ciphertext bound to the author and touches the reservation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockQueries := repositorymock.NewMockReservationMessageWriteQueries(ctrl)
+		mockDB := &mockDBTX{}
+		messageID := uuid.New()
+
+		var stored sqlc.CreateReservationMessageParams
+		mockQueries.EXPECT().CreateReservationMessage(ctx, mockDB, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ sqlc.DBTX, arg sqlc.CreateReservationMessageParams) (uuid.UUID, error) {
+				stored = arg
+				return messageID, nil
+			})
+		mockQueries.EXPECT().TouchReservation(ctx, mockDB, msg.ReservationID).Return(nil)
+
+		id, err := repository.NewReservationMessageRepository(mockQueries, envelope).Create(ctx, mockDB, msg)
+		require.NoError(t, err)
+		assert.Equal(t, messageID, id)
+
+		assert.False(t, stored.Body.Valid, "plaintext column must stay empty")
+		assert.NotContains(t, stored.BodyCiphertext.String, "4711")
+		body, err := envelope.DecryptString(stored.BodyCiphertext.String, infra.ReservationMessageAAD(msg.AuthorID))
+		require.NoError(t, err)
+		assert.Equal(t, msg.Body, body)
+		_, err = envelope.DecryptString(stored.BodyCiphertext.String, infra.ReservationMessageAAD(uuid.New()))
+		assert.Error(t, err, "ciphertext must not decrypt for another author")
+	})
+
+	t.Run("success: stores plaintext without an active key", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockQueries := repositorymock.NewMockReservationMessageWriteQueries(ctrl)
+		mockDB := &mockDBTX{}
+
+		mockQueries.EXPECT().CreateReservationMessage(ctx, mockDB, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ sqlc.DBTX, arg sqlc.CreateReservationMessageParams) (uuid.UUID, error) {
+				assert.Equal(t, msg.Body, arg.Body.String)
+				assert.False(t, arg.BodyCiphertext.Valid)
+				return uuid.New(), nil
+			})
+		mockQueries.EXPECT().TouchReservation(ctx, mockDB, msg.ReservationID).Return(nil)
+
+		_, err := repository.NewReservationMessageRepository(mockQueries, noKeys).Create(ctx, mockDB, msg)
+		require.NoError(t, err)
+	})
+
+	t.Run("error: database error occurs", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockQueries := repositorymock.NewMockReservationMessageWriteQueries(ctrl)
+		mockDB := &mockDBTX{}
+
+		mockQueries.EXPECT().CreateReservationMessage(ctx, mockDB, gomock.Any()).Return(uuid.Nil, errors.New("database connection error"))
+
+		_, err := repository.NewReservationMessageRepository(mockQueries, noKeys).Create(ctx, mockDB, msg)
+		require.Error(t, err)
+		assert.True(t, infra.IsKind(err, infra.KindDBFailure), "got %v", err)
+	})
+}
+
+func TestReservationMessageRepository_MarkRead(t *testing.T) {
+	ctx := context.Background()
+	reservationID := uuid.New()
+	readAt := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	ctrl := gomock.NewController(t)
+	mockQueries := repositorymock.NewMockReservationMessageWriteQueries(ctrl)
+	mockDB := &mockDBTX{}
+
+	mockQueries.EXPECT().MarkReservationMessagesRead(ctx, mockDB, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ sqlc.DBTX, arg sqlc.MarkReservationMessagesReadParams) error {
+			assert.Equal(t, reservationID, arg.ReservationID)
+			assert.Equal(t, shared.MessageSideOperator, arg.Side)
+			assert.True(t, arg.LastReadAt.Time.Equal(readAt))
+			return nil
+		})
+	mockQueries.EXPECT().TouchReservation(ctx, mockDB, reservationID).Return(nil)
+
+	err := repository.NewReservationMessageRepository(mockQueries, nil).MarkRead(ctx, mockDB, reservationID, shared.MessageSideOperator, readAt)
+	require.NoError(t, err)
+}
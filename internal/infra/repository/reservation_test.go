@@ -0,0 +1,98 @@
+//go:build unit
+
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/infra/repository"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/usecase/shared"
+	repositorymock "gin-clean-starter/tests/mock/repository"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReservationRepository_CancelInRange(t *testing.T) {
+	ctx := context.Background()
+	resourceID := uuid.New()
+	userID := uuid.New()
+	now := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	window := shared.TimeRange{Start: now, End: now.Add(48 * time.Hour)}
+	early, late := uuid.New(), uuid.New()
+	ts := func(d time.Duration) pgtype.Timestamptz { return pgtype.Timestamptz{Time: now.Add(d), Valid: true} }
+
+	testCases := []struct {
+		name          string
+		setupMock     func(*repositorymock.MockReservationWriteQueries, sqlc.DBTX)
+		expectIDs     []uuid.UUID
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name: "success: canceled reservations returned earliest first",
+			setupMock: func(mock *repositorymock.MockReservationWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().CancelReservationsInRange(ctx, db, sqlc.CancelReservationsInRangeParams{
+					ResourceID: resourceID,
+					FromTime:   ts(0),
+					ToTime:     ts(48 * time.Hour),
+					Now:        ts(0),
+				}).Return([]sqlc.CancelReservationsInRangeRow{
+					{ID: late, UserID: userID, StartTime: ts(26 * time.Hour), EndTime: ts(27 * time.Hour)},
+					{ID: early, UserID: userID, StartTime: ts(2 * time.Hour), EndTime: ts(3 * time.Hour)},
+				}, nil)
+			},
+			expectIDs: []uuid.UUID{early, late},
+		},
+		{
+			name: "success: nothing in range",
+			setupMock: func(mock *repositorymock.MockReservationWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().CancelReservationsInRange(ctx, db, gomock.Any()).Return(nil, nil)
+			},
+			expectIDs: []uuid.UUID{},
+		},
+		{
+			name: "error: database error occurs",
+			setupMock: func(mock *repositorymock.MockReservationWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().CancelReservationsInRange(ctx, db, gomock.Any()).Return(nil, errors.New("database connection error"))
+			},
+			expectedError: true,
+			expectKind:    infra.KindDBFailure,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockReservationWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewReservationRepository(mockQueries, mockDB)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			canceled, err := repo.CancelInRange(ctx, mockDB, resourceID, window, now)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+			ids := make([]uuid.UUID, len(canceled))
+			for i, c := range canceled {
+				ids[i] = c.ID
+			}
+			assert.Equal(t, tc.expectIDs, ids)
+		})
+	}
+}
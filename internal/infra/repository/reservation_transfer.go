@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/pkg/pgconv"
+
+	"github.com/google/uuid"
+)
+
+var errReservationTransferNotPending = errs.New("reservation transfer is no longer pending")
+
+type ReservationTransferWriteQueries interface {
+	CreateReservationTransfer(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateReservationTransferParams) (uuid.UUID, error)
+	SupersedeReservationTransfers(ctx context.Context, db sqlc.DBTX, reservationID uuid.UUID) (int64, error)
+	AcceptReservationTransfer(ctx context.Context, db sqlc.DBTX, arg sqlc.AcceptReservationTransferParams) (int64, error)
+}
+
+type ReservationTransferRepository struct {
+	queries ReservationTransferWriteQueries
+}
+
+func NewReservationTransferRepository(queries ReservationTransferWriteQueries) *ReservationTransferRepository {
+	return &ReservationTransferRepository{
+		queries: queries,
+	}
+}
+
+// Create reports KindDuplicateKey when a pending offer for the reservation was created
+// concurrently.
+func (r *ReservationTransferRepository) Create(ctx context.Context, tx sqlc.DBTX, params sqlc.CreateReservationTransferParams) (uuid.UUID, error) {
+	id, err := r.queries.CreateReservationTransfer(ctx, tx, params)
+	if err != nil {
+		return uuid.Nil, infra.WrapRepoErr("failed to create reservation transfer", err)
+	}
+	return id, nil
+}
+
+func (r *ReservationTransferRepository) SupersedePending(ctx context.Context, tx sqlc.DBTX, reservationID uuid.UUID) error {
+	if _, err := r.queries.SupersedeReservationTransfers(ctx, tx, reservationID); err != nil {
+		return infra.WrapRepoErr("failed to supersede reservation transfers", err)
+	}
+	return nil
+}
+
+func (r *ReservationTransferRepository) MarkAccepted(ctx context.Context, tx sqlc.DBTX, transferID, nonce uuid.UUID, acceptedAt time.Time) error {
+	affected, err := r.queries.AcceptReservationTransfer(ctx, tx, sqlc.AcceptReservationTransferParams{
+		ID:         transferID,
+		Nonce:      nonce,
+		AcceptedAt: pgconv.TimeToPgtype(acceptedAt),
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to accept reservation transfer", err)
+	}
+	if affected == 0 {
+		return infra.WrapRepoErr("reservation transfer not pending", errReservationTransferNotPending, infra.KindConflict)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+//go:build unit
+
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/infra/repository"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	repositorymock "gin-clean-starter/tests/mock/repository"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReservationTransferRepository_MarkAccepted(t *testing.T) {
+	ctx := context.Background()
+	transferID := uuid.New()
+	nonce := uuid.New()
+	acceptedAt := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	params := sqlc.AcceptReservationTransferParams{
+		ID:         transferID,
+		Nonce:      nonce,
+		AcceptedAt: pgtype.Timestamptz{Time: acceptedAt, Valid: true},
+	}
+
+	testCases := []struct {
+		name          string
+		setupMock     func(*repositorymock.MockReservationTransferWriteQueries, sqlc.DBTX)
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name: "success: pending transfer accepted",
+			setupMock: func(mock *repositorymock.MockReservationTransferWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().AcceptReservationTransfer(ctx, db, params).Return(int64(1), nil)
+			},
+		},
+		{
+			name: "error: transfer no longer pending",
+			setupMock: func(mock *repositorymock.MockReservationTransferWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().AcceptReservationTransfer(ctx, db, params).Return(int64(0), nil)
+			},
+			expectedError: true,
+			expectKind:    infra.KindConflict,
+		},
+		{
+			name: "error: database error occurs",
+			setupMock: func(mock *repositorymock.MockReservationTransferWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().AcceptReservationTransfer(ctx, db, gomock.Any()).Return(int64(0), errors.New("database connection error"))
+			},
+			expectedError: true,
+			expectKind:    infra.KindDBFailure,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockReservationTransferWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewReservationTransferRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			err := repo.MarkAccepted(ctx, mockDB, transferID, nonce, acceptedAt)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+)
+
+type ResourceWriteQueries interface {
+	CreateResources(ctx context.Context, db sqlc.DBTX, arg []sqlc.CreateResourcesParams) (int64, error)
+}
+
+type ResourceRepository struct {
+	queries ResourceWriteQueries
+}
+
+func NewResourceRepository(queries ResourceWriteQueries) *ResourceRepository {
+	return &ResourceRepository{
+		queries: queries,
+	}
+}
+
+func (r *ResourceRepository) CreateMany(ctx context.Context, tx sqlc.DBTX, params []sqlc.CreateResourcesParams) error {
+	if len(params) == 0 {
+		return nil
+	}
+	if _, err := r.queries.CreateResources(ctx, tx, params); err != nil {
+		return infra.WrapRepoErr("failed to create resources", err)
+	}
+	return nil
+}
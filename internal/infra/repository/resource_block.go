@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var (
+	errBlockOverlapsReservation = errs.New("resource block overlaps a confirmed reservation")
+	errBlockNotDeleted          = errs.New("no resource block deleted")
+)
+
+type ResourceBlockWriteQueries interface {
+	LockResourceForShare(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (uuid.UUID, error)
+	LockResourceForUpdate(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (uuid.UUID, error)
+	HasConfirmedReservationInSlots(ctx context.Context, db sqlc.DBTX, arg sqlc.HasConfirmedReservationInSlotsParams) (bool, error)
+	HasResourceBlockInSlot(ctx context.Context, db sqlc.DBTX, arg sqlc.HasResourceBlockInSlotParams) (bool, error)
+	CreateResourceBlocks(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateResourceBlocksParams) ([]uuid.UUID, error)
+	DeleteResourceBlock(ctx context.Context, db sqlc.DBTX, arg sqlc.DeleteResourceBlockParams) (int64, error)
+	DeleteResourceBlockSeries(ctx context.Context, db sqlc.DBTX, arg sqlc.DeleteResourceBlockSeriesParams) (int64, error)
+}
+
+type ResourceBlockRepository struct {
+	queries ResourceBlockWriteQueries
+}
+
+func NewResourceBlockRepository(queries ResourceBlockWriteQueries) *ResourceBlockRepository {
+	return &ResourceBlockRepository{
+		queries: queries,
+	}
+}
+
+// CreateSeries checks reservations only after locking the resource: the lock and the
+// check run as separate statements so the check sees reservations committed while it waited.
+func (r *ResourceBlockRepository) CreateSeries(ctx context.Context, tx sqlc.DBTX, series shared.ResourceBlockSeries) ([]uuid.UUID, error) {
+	if _, err := r.queries.LockResourceForUpdate(ctx, tx, series.ResourceID); err != nil {
+		return nil, infra.WrapRepoErr("failed to lock resource", err)
+	}
+
+	starts := make([]pgtype.Timestamptz, len(series.Occurrences))
+	ends := make([]pgtype.Timestamptz, len(series.Occurrences))
+	for i, o := range series.Occurrences {
+		starts[i] = pgconv.TimeToPgtype(o.Start)
+		ends[i] = pgconv.TimeToPgtype(o.End)
+	}
+
+	overlaps, err := r.queries.HasConfirmedReservationInSlots(ctx, tx, sqlc.HasConfirmedReservationInSlotsParams{
+		Starts:     starts,
+		Ends:       ends,
+		ResourceID: series.ResourceID,
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to check reservations in block slots", err)
+	}
+	if overlaps {
+		return nil, infra.WrapRepoErr("resource block conflicts", errBlockOverlapsReservation, infra.KindConflict)
+	}
+
+	ids, err := r.queries.CreateResourceBlocks(ctx, tx, sqlc.CreateResourceBlocksParams{
+		ResourceID: series.ResourceID,
+		SeriesID:   series.ID,
+		Reason:     series.Reason,
+		CreatedBy:  series.CreatedBy,
+		Starts:     starts,
+		Ends:       ends,
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to create resource blocks", err)
+	}
+	return ids, nil
+}
+
+func (r *ResourceBlockRepository) Delete(ctx context.Context, tx sqlc.DBTX, resourceID, blockID uuid.UUID, laterOccurrences bool) (int64, error) {
+	var deleted int64
+	var err error
+	if laterOccurrences {
+		deleted, err = r.queries.DeleteResourceBlockSeries(ctx, tx, sqlc.DeleteResourceBlockSeriesParams{ID: blockID, ResourceID: resourceID})
+	} else {
+		deleted, err = r.queries.DeleteResourceBlock(ctx, tx, sqlc.DeleteResourceBlockParams{ID: blockID, ResourceID: resourceID})
+	}
+	if err != nil {
+		return 0, infra.WrapRepoErr("failed to delete resource block", err)
+	}
+	if deleted == 0 {
+		return 0, infra.WrapRepoErr("resource block not found", errBlockNotDeleted, infra.KindNotFound)
+	}
+	return deleted, nil
+}
+
+func (r *ResourceBlockRepository) Overlaps(ctx context.Context, tx sqlc.DBTX, resourceID uuid.UUID, start, end time.Time) (bool, error) {
+	if _, err := r.queries.LockResourceForShare(ctx, tx, resourceID); err != nil {
+		return false, infra.WrapRepoErr("failed to lock resource", err)
+	}
+
+	overlaps, err := r.queries.HasResourceBlockInSlot(ctx, tx, sqlc.HasResourceBlockInSlotParams{
+		ResourceID: resourceID,
+		StartTime:  pgconv.TimeToPgtype(start),
+		EndTime:    pgconv.TimeToPgtype(end),
+	})
+	if err != nil {
+		return false, infra.WrapRepoErr("failed to check resource blocks", err)
+	}
+	return overlaps, nil
+}
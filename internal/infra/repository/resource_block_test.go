@@ -0,0 +1,174 @@
+//go:build unit
+
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/infra/repository"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+	repositorymock "gin-clean-starter/tests/mock/repository"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestResourceBlockRepository_CreateSeries(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	series := shared.ResourceBlockSeries{
+		ID:         uuid.New(),
+		ResourceID: uuid.New(),
+		Reason:     "Maintenance",
+		CreatedBy:  uuid.New(),
+		Occurrences: []shared.TimeRange{
+			{Start: start, End: start.Add(2 * time.Hour)},
+			{Start: start.AddDate(0, 0, 7), End: start.AddDate(0, 0, 7).Add(2 * time.Hour)},
+		},
+	}
+	starts := []pgtype.Timestamptz{pgconv.TimeToPgtype(series.Occurrences[0].Start), pgconv.TimeToPgtype(series.Occurrences[1].Start)}
+	ends := []pgtype.Timestamptz{pgconv.TimeToPgtype(series.Occurrences[0].End), pgconv.TimeToPgtype(series.Occurrences[1].End)}
+	blockIDs := []uuid.UUID{uuid.New(), uuid.New()}
+
+	testCases := []struct {
+		name          string
+		setupMock     func(*repositorymock.MockResourceBlockWriteQueries, sqlc.DBTX)
+		expectIDs     []uuid.UUID
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name: "success: every occurrence inserted after the lock and the reservation check",
+			setupMock: func(mock *repositorymock.MockResourceBlockWriteQueries, db sqlc.DBTX) {
+				gomock.InOrder(
+					mock.EXPECT().LockResourceForUpdate(ctx, db, series.ResourceID).Return(series.ResourceID, nil),
+					mock.EXPECT().HasConfirmedReservationInSlots(ctx, db, sqlc.HasConfirmedReservationInSlotsParams{
+						Starts:     starts,
+						Ends:       ends,
+						ResourceID: series.ResourceID,
+					}).Return(false, nil),
+					mock.EXPECT().CreateResourceBlocks(ctx, db, sqlc.CreateResourceBlocksParams{
+						ResourceID: series.ResourceID,
+						SeriesID:   series.ID,
+						Reason:     series.Reason,
+						CreatedBy:  series.CreatedBy,
+						Starts:     starts,
+						Ends:       ends,
+					}).Return(blockIDs, nil),
+				)
+			},
+			expectIDs: blockIDs,
+		},
+		{
+			name: "error: an occurrence overlaps a confirmed reservation",
+			setupMock: func(mock *repositorymock.MockResourceBlockWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().LockResourceForUpdate(ctx, db, series.ResourceID).Return(series.ResourceID, nil)
+				mock.EXPECT().HasConfirmedReservationInSlots(ctx, db, gomock.Any()).Return(true, nil)
+			},
+			expectedError: true,
+			expectKind:    infra.KindConflict,
+		},
+		{
+			name: "error: database failure while locking",
+			setupMock: func(mock *repositorymock.MockResourceBlockWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().LockResourceForUpdate(ctx, db, series.ResourceID).Return(uuid.Nil, errors.New("connection reset"))
+			},
+			expectedError: true,
+			expectKind:    infra.KindDBFailure,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockResourceBlockWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewResourceBlockRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			ids, err := repo.CreateSeries(ctx, mockDB, series)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectIDs, ids)
+		})
+	}
+}
+
+func TestResourceBlockRepository_Delete(t *testing.T) {
+	ctx := context.Background()
+	resourceID := uuid.New()
+	blockID := uuid.New()
+
+	testCases := []struct {
+		name             string
+		laterOccurrences bool
+		setupMock        func(*repositorymock.MockResourceBlockWriteQueries, sqlc.DBTX)
+		expectDeleted    int64
+		expectedError    bool
+		expectKind       infra.RepositoryErrorKind
+	}{
+		{
+			name: "success: single block deleted",
+			setupMock: func(mock *repositorymock.MockResourceBlockWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().DeleteResourceBlock(ctx, db, sqlc.DeleteResourceBlockParams{ID: blockID, ResourceID: resourceID}).Return(int64(1), nil)
+			},
+			expectDeleted: 1,
+		},
+		{
+			name:             "success: block and later occurrences deleted",
+			laterOccurrences: true,
+			setupMock: func(mock *repositorymock.MockResourceBlockWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().DeleteResourceBlockSeries(ctx, db, sqlc.DeleteResourceBlockSeriesParams{ID: blockID, ResourceID: resourceID}).Return(int64(4), nil)
+			},
+			expectDeleted: 4,
+		},
+		{
+			name: "error: block not found on the resource",
+			setupMock: func(mock *repositorymock.MockResourceBlockWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().DeleteResourceBlock(ctx, db, gomock.Any()).Return(int64(0), nil)
+			},
+			expectedError: true,
+			expectKind:    infra.KindNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockResourceBlockWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewResourceBlockRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			deleted, err := repo.Delete(ctx, mockDB, resourceID, blockID, tc.laterOccurrences)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectDeleted, deleted)
+		})
+	}
+}
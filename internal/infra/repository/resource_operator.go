@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+var errOperatorNotUnassigned = errs.New("no operator assignment removed")
+
+type ResourceOperatorWriteQueries interface {
+	AssignResourceOperator(ctx context.Context, db sqlc.DBTX, arg sqlc.AssignResourceOperatorParams) error
+	UnassignResourceOperator(ctx context.Context, db sqlc.DBTX, arg sqlc.UnassignResourceOperatorParams) (int64, error)
+}
+
+type ResourceOperatorRepository struct {
+	queries ResourceOperatorWriteQueries
+}
+
+func NewResourceOperatorRepository(queries ResourceOperatorWriteQueries) *ResourceOperatorRepository {
+	return &ResourceOperatorRepository{
+		queries: queries,
+	}
+}
+
+// Assign is idempotent; an unknown resource or user surfaces as KindForeignKeyViolated.
+func (r *ResourceOperatorRepository) Assign(ctx context.Context, tx sqlc.DBTX, resourceID, userID uuid.UUID) error {
+	err := r.queries.AssignResourceOperator(ctx, tx, sqlc.AssignResourceOperatorParams{
+		ResourceID: resourceID,
+		UserID:     userID,
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to assign resource operator", err)
+	}
+	return nil
+}
+
+func (r *ResourceOperatorRepository) Unassign(ctx context.Context, tx sqlc.DBTX, resourceID, userID uuid.UUID) error {
+	affected, err := r.queries.UnassignResourceOperator(ctx, tx, sqlc.UnassignResourceOperatorParams{
+		ResourceID: resourceID,
+		UserID:     userID,
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to unassign resource operator", err)
+	}
+	if affected == 0 {
+		return infra.WrapRepoErr("resource operator not found", errOperatorNotUnassigned, infra.KindNotFound)
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+//go:build unit
+
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/infra/repository"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	repositorymock "gin-clean-starter/tests/mock/repository"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestResourceOperatorRepository_Assign(t *testing.T) {
+	ctx := context.Background()
+	resourceID := uuid.New()
+	userID := uuid.New()
+	params := sqlc.AssignResourceOperatorParams{ResourceID: resourceID, UserID: userID}
+
+	testCases := []struct {
+		name          string
+		setupMock     func(*repositorymock.MockResourceOperatorWriteQueries, sqlc.DBTX)
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name: "success: assignment stored",
+			setupMock: func(mock *repositorymock.MockResourceOperatorWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().AssignResourceOperator(ctx, db, params).Return(nil)
+			},
+		},
+		{
+			name: "error: unknown resource or user violates foreign key",
+			setupMock: func(mock *repositorymock.MockResourceOperatorWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().AssignResourceOperator(ctx, db, params).Return(&pgconn.PgError{Code: "23503"})
+			},
+			expectedError: true,
+			expectKind:    infra.KindForeignKeyViolated,
+		},
+		{
+			name: "error: database failure",
+			setupMock: func(mock *repositorymock.MockResourceOperatorWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().AssignResourceOperator(ctx, db, params).Return(errors.New("connection reset"))
+			},
+			expectedError: true,
+			expectKind:    infra.KindDBFailure,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockResourceOperatorWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewResourceOperatorRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			err := repo.Assign(ctx, mockDB, resourceID, userID)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestResourceOperatorRepository_Unassign(t *testing.T) {
+	ctx := context.Background()
+	resourceID := uuid.New()
+	userID := uuid.New()
+	params := sqlc.UnassignResourceOperatorParams{ResourceID: resourceID, UserID: userID}
+
+	testCases := []struct {
+		name          string
+		setupMock     func(*repositorymock.MockResourceOperatorWriteQueries, sqlc.DBTX)
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name: "success: assignment removed",
+			setupMock: func(mock *repositorymock.MockResourceOperatorWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().UnassignResourceOperator(ctx, db, params).Return(int64(1), nil)
+			},
+		},
+		{
+			name: "error: no assignment reports not found",
+			setupMock: func(mock *repositorymock.MockResourceOperatorWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().UnassignResourceOperator(ctx, db, params).Return(int64(0), nil)
+			},
+			expectedError: true,
+			expectKind:    infra.KindNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockResourceOperatorWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewResourceOperatorRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			err := repo.Unassign(ctx, mockDB, resourceID, userID)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
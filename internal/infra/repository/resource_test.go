@@ -0,0 +1,79 @@
+//go:build unit
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/infra/repository"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	repositorymock "gin-clean-starter/tests/mock/repository"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestResourceRepository_CreateMany(t *testing.T) {
+	ctx := context.Background()
+	rows := []sqlc.CreateResourcesParams{
+		{ID: uuid.New(), Name: "Meeting Room A"},
+		{ID: uuid.New(), Name: "Meeting Room B"},
+	}
+
+	testCases := []struct {
+		name          string
+		params        []sqlc.CreateResourcesParams
+		setupMock     func(*repositorymock.MockResourceWriteQueries, sqlc.DBTX)
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name:   "success: rows copied in one call",
+			params: rows,
+			setupMock: func(mock *repositorymock.MockResourceWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().CreateResources(ctx, db, rows).Return(int64(len(rows)), nil)
+			},
+		},
+		{
+			name:      "success: empty input skips the round trip",
+			params:    nil,
+			setupMock: func(*repositorymock.MockResourceWriteQueries, sqlc.DBTX) {},
+		},
+		{
+			name:   "error: unknown company violates foreign key",
+			params: rows,
+			setupMock: func(mock *repositorymock.MockResourceWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().CreateResources(ctx, db, rows).Return(int64(0), &pgconn.PgError{Code: "23503"})
+			},
+			expectedError: true,
+			expectKind:    infra.KindForeignKeyViolated,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockResourceWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewResourceRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			err := repo.CreateMany(ctx, mockDB, tc.params)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var errUnsupportedRetentionTable = errs.New("unsupported retention table")
+
+type RetentionQueries interface {
+	CountRetentionNotificationJobs(ctx context.Context, db sqlc.DBTX, cutoff pgtype.Timestamptz) (int64, error)
+	DeleteRetentionNotificationJobsBatch(ctx context.Context, db sqlc.DBTX, arg sqlc.DeleteRetentionNotificationJobsBatchParams) (int64, error)
+	CountRetentionIdempotencyKeys(ctx context.Context, db sqlc.DBTX, cutoff pgtype.Timestamptz) (int64, error)
+	DeleteRetentionIdempotencyKeysBatch(ctx context.Context, db sqlc.DBTX, arg sqlc.DeleteRetentionIdempotencyKeysBatchParams) (int64, error)
+	CountRetentionAuditLogs(ctx context.Context, db sqlc.DBTX, cutoff pgtype.Timestamptz) (int64, error)
+	DeleteRetentionAuditLogsBatch(ctx context.Context, db sqlc.DBTX, arg sqlc.DeleteRetentionAuditLogsBatchParams) (int64, error)
+	CountRetentionRefreshTokens(ctx context.Context, db sqlc.DBTX, cutoff pgtype.Timestamptz) (int64, error)
+	DeleteRetentionRefreshTokensBatch(ctx context.Context, db sqlc.DBTX, arg sqlc.DeleteRetentionRefreshTokensBatchParams) (int64, error)
+	CountRetentionRevokedTokens(ctx context.Context, db sqlc.DBTX, cutoff pgtype.Timestamptz) (int64, error)
+	DeleteRetentionRevokedTokensBatch(ctx context.Context, db sqlc.DBTX, arg sqlc.DeleteRetentionRevokedTokensBatchParams) (int64, error)
+	CountRetentionUnverifiedUsers(ctx context.Context, db sqlc.DBTX, cutoff pgtype.Timestamptz) (int64, error)
+	DeleteRetentionUnverifiedUsersBatch(ctx context.Context, db sqlc.DBTX, arg sqlc.DeleteRetentionUnverifiedUsersBatchParams) (int64, error)
+	CountRetentionLoginFailures(ctx context.Context, db sqlc.DBTX, cutoff pgtype.Timestamptz) (int64, error)
+	DeleteRetentionLoginFailuresBatch(ctx context.Context, db sqlc.DBTX, arg sqlc.DeleteRetentionLoginFailuresBatchParams) (int64, error)
+	CountRetentionUserSessions(ctx context.Context, db sqlc.DBTX, cutoff pgtype.Timestamptz) (int64, error)
+	DeleteRetentionUserSessionsBatch(ctx context.Context, db sqlc.DBTX, arg sqlc.DeleteRetentionUserSessionsBatchParams) (int64, error)
+}
+
+type RetentionRepository struct {
+	queries RetentionQueries
+}
+
+func NewRetentionRepository(queries RetentionQueries) *RetentionRepository {
+	return &RetentionRepository{
+		queries: queries,
+	}
+}
+
+func (r *RetentionRepository) CountExpired(ctx context.Context, db sqlc.DBTX, table string, cutoff time.Time) (int64, error) {
+	ts := pgtype.Timestamptz{Time: cutoff, Valid: true}
+
+	var (
+		count int64
+		err   error
+	)
+	switch table {
+	case shared.RetentionTableNotificationJobs:
+		count, err = r.queries.CountRetentionNotificationJobs(ctx, db, ts)
+	case shared.RetentionTableIdempotencyKeys:
+		count, err = r.queries.CountRetentionIdempotencyKeys(ctx, db, ts)
+	case shared.RetentionTableAuditLogs:
+		count, err = r.queries.CountRetentionAuditLogs(ctx, db, ts)
+	case shared.RetentionTableRefreshTokens:
+		count, err = r.queries.CountRetentionRefreshTokens(ctx, db, ts)
+	case shared.RetentionTableRevokedTokens:
+		count, err = r.queries.CountRetentionRevokedTokens(ctx, db, ts)
+	case shared.RetentionTableEmailVerifications:
+		count, err = r.queries.CountRetentionUnverifiedUsers(ctx, db, ts)
+	case shared.RetentionTableLoginFailures:
+		count, err = r.queries.CountRetentionLoginFailures(ctx, db, ts)
+	case shared.RetentionTableUserSessions:
+		count, err = r.queries.CountRetentionUserSessions(ctx, db, ts)
+	default:
+		return 0, infra.WrapRepoErr("failed to count expired rows", errs.Wrap(errUnsupportedRetentionTable, table))
+	}
+	if err != nil {
+		return 0, infra.WrapRepoErr("failed to count expired rows in "+table, err)
+	}
+
+	return count, nil
+}
+
+func (r *RetentionRepository) DeleteExpiredBatch(ctx context.Context, db sqlc.DBTX, table string, cutoff time.Time, batchSize int32) (int64, error) {
+	ts := pgtype.Timestamptz{Time: cutoff, Valid: true}
+
+	var (
+		deleted int64
+		err     error
+	)
+	switch table {
+	case shared.RetentionTableNotificationJobs:
+		deleted, err = r.queries.DeleteRetentionNotificationJobsBatch(ctx, db, sqlc.DeleteRetentionNotificationJobsBatchParams{
+			Cutoff:    ts,
+			BatchSize: batchSize,
+		})
+	case shared.RetentionTableIdempotencyKeys:
+		deleted, err = r.queries.DeleteRetentionIdempotencyKeysBatch(ctx, db, sqlc.DeleteRetentionIdempotencyKeysBatchParams{
+			Cutoff:    ts,
+			BatchSize: batchSize,
+		})
+	case shared.RetentionTableAuditLogs:
+		deleted, err = r.queries.DeleteRetentionAuditLogsBatch(ctx, db, sqlc.DeleteRetentionAuditLogsBatchParams{
+			Cutoff:    ts,
+			BatchSize: batchSize,
+		})
+	case shared.RetentionTableRefreshTokens:
+		deleted, err = r.queries.DeleteRetentionRefreshTokensBatch(ctx, db, sqlc.DeleteRetentionRefreshTokensBatchParams{
+			Cutoff:    ts,
+			BatchSize: batchSize,
+		})
+	case shared.RetentionTableRevokedTokens:
+		deleted, err = r.queries.DeleteRetentionRevokedTokensBatch(ctx, db, sqlc.DeleteRetentionRevokedTokensBatchParams{
+			Cutoff:    ts,
+			BatchSize: batchSize,
+		})
+	case shared.RetentionTableEmailVerifications:
+		deleted, err = r.queries.DeleteRetentionUnverifiedUsersBatch(ctx, db, sqlc.DeleteRetentionUnverifiedUsersBatchParams{
+			Cutoff:    ts,
+			BatchSize: batchSize,
+		})
+	case shared.RetentionTableLoginFailures:
+		deleted, err = r.queries.DeleteRetentionLoginFailuresBatch(ctx, db, sqlc.DeleteRetentionLoginFailuresBatchParams{
+			Cutoff:    ts,
+			BatchSize: batchSize,
+		})
+	case shared.RetentionTableUserSessions:
+		deleted, err = r.queries.DeleteRetentionUserSessionsBatch(ctx, db, sqlc.DeleteRetentionUserSessionsBatchParams{
+			Cutoff:    ts,
+			BatchSize: batchSize,
+		})
+	default:
+		return 0, infra.WrapRepoErr("failed to delete expired rows", errs.Wrap(errUnsupportedRetentionTable, table))
+	}
+	if err != nil {
+		return 0, infra.WrapRepoErr("failed to delete expired rows in "+table, err)
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,111 @@
+//go:build unit
+
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/infra/repository"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/usecase/shared"
+	repositorymock "gin-clean-starter/tests/mock/repository"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRetentionRepository_DeleteExpiredBatch(t *testing.T) {
+	ctx := context.Background()
+	cutoff := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ts := pgtype.Timestamptz{Time: cutoff, Valid: true}
+
+	testCases := []struct {
+		name          string
+		table         string
+		setupMock     func(*repositorymock.MockRetentionQueries, sqlc.DBTX)
+		expectDeleted int64
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name:  "success: notification jobs batch deleted",
+			table: shared.RetentionTableNotificationJobs,
+			setupMock: func(mock *repositorymock.MockRetentionQueries, db sqlc.DBTX) {
+				mock.EXPECT().DeleteRetentionNotificationJobsBatch(ctx, db, sqlc.DeleteRetentionNotificationJobsBatchParams{
+					Cutoff:    ts,
+					BatchSize: 100,
+				}).Return(int64(100), nil)
+			},
+			expectDeleted: 100,
+		},
+		{
+			name:  "success: idempotency keys batch deleted",
+			table: shared.RetentionTableIdempotencyKeys,
+			setupMock: func(mock *repositorymock.MockRetentionQueries, db sqlc.DBTX) {
+				mock.EXPECT().DeleteRetentionIdempotencyKeysBatch(ctx, db, sqlc.DeleteRetentionIdempotencyKeysBatchParams{
+					Cutoff:    ts,
+					BatchSize: 100,
+				}).Return(int64(7), nil)
+			},
+			expectDeleted: 7,
+		},
+		{
+			name:  "success: audit logs batch deleted",
+			table: shared.RetentionTableAuditLogs,
+			setupMock: func(mock *repositorymock.MockRetentionQueries, db sqlc.DBTX) {
+				mock.EXPECT().DeleteRetentionAuditLogsBatch(ctx, db, sqlc.DeleteRetentionAuditLogsBatchParams{
+					Cutoff:    ts,
+					BatchSize: 100,
+				}).Return(int64(42), nil)
+			},
+			expectDeleted: 42,
+		},
+		{
+			name:  "error: database error occurs",
+			table: shared.RetentionTableNotificationJobs,
+			setupMock: func(mock *repositorymock.MockRetentionQueries, db sqlc.DBTX) {
+				mock.EXPECT().DeleteRetentionNotificationJobsBatch(ctx, db, gomock.Any()).Return(int64(0), errors.New("database connection error"))
+			},
+			expectedError: true,
+			expectKind:    infra.KindDBFailure,
+		},
+		{
+			name:          "error: table without a retention query",
+			table:         "users",
+			setupMock:     func(*repositorymock.MockRetentionQueries, sqlc.DBTX) {},
+			expectedError: true,
+			expectKind:    infra.KindDBFailure,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockRetentionQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewRetentionRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			deleted, err := repo.DeleteExpiredBatch(ctx, mockDB, tc.table, cutoff, 100)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				if tc.expectKind != "" {
+					assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectDeleted, deleted)
+		})
+	}
+}
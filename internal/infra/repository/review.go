@@ -7,6 +7,7 @@ import (
 	"gin-clean-starter/internal/infra"
 	"gin-clean-starter/internal/infra/repository/converter"
 	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
 
 	"github.com/google/uuid"
 )
@@ -15,6 +16,8 @@ type ReviewWriteQueries interface {
 	CreateReview(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateReviewParams) (uuid.UUID, error)
 	UpdateReview(ctx context.Context, db sqlc.DBTX, arg sqlc.UpdateReviewParams) (int32, error)
 	DeleteReview(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (int32, error)
+	PublishReview(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (int64, error)
+	ImportReview(ctx context.Context, db sqlc.DBTX, arg sqlc.ImportReviewParams) (uuid.UUID, error)
 }
 
 type ReviewRepository struct {
@@ -42,6 +45,9 @@ func (r *ReviewRepository) Update(ctx context.Context, tx sqlc.DBTX, reviewID uu
 	params := converter.ReviewToUpdateParams(reviewID, rev)
 	n, err := r.queries.UpdateReview(ctx, tx, params)
 	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return infra.WrapRepoErr("review not found", err, infra.KindNotFound)
+		}
 		return infra.WrapRepoErr("failed to update review", err)
 	}
 	if n == 0 {
@@ -53,6 +59,9 @@ func (r *ReviewRepository) Update(ctx context.Context, tx sqlc.DBTX, reviewID uu
 func (r *ReviewRepository) Delete(ctx context.Context, tx sqlc.DBTX, reviewID uuid.UUID) error {
 	n, err := r.queries.DeleteReview(ctx, tx, reviewID)
 	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return infra.WrapRepoErr("review not found", err, infra.KindNotFound)
+		}
 		return infra.WrapRepoErr("failed to delete review", err)
 	}
 	if n == 0 {
@@ -60,3 +69,27 @@ func (r *ReviewRepository) Delete(ctx context.Context, tx sqlc.DBTX, reviewID uu
 	}
 	return nil
 }
+
+func (r *ReviewRepository) Publish(ctx context.Context, tx sqlc.DBTX, reviewID uuid.UUID) error {
+	n, err := r.queries.PublishReview(ctx, tx, reviewID)
+	if err != nil {
+		return infra.WrapRepoErr("failed to publish review", err)
+	}
+	if n == 0 {
+		return infra.WrapRepoErr("flagged review not found", nil, infra.KindNotFound)
+	}
+	return nil
+}
+
+// Import inserts a review carried over from source, where it was externalID. A review the
+// source already imported is KindDuplicateKey.
+func (r *ReviewRepository) Import(ctx context.Context, tx sqlc.DBTX, rev *review.Review, source, externalID string) (uuid.UUID, error) {
+	id, err := r.queries.ImportReview(ctx, tx, converter.ReviewToImportParams(rev, source, externalID))
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return uuid.Nil, infra.WrapRepoErr("review already imported", err, infra.KindDuplicateKey)
+		}
+		return uuid.Nil, infra.WrapRepoErr("failed to import review", err)
+	}
+	return id, nil
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"gin-clean-starter/internal/domain/review"
 	"gin-clean-starter/internal/infra"
@@ -17,6 +18,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -127,6 +129,14 @@ func TestRepository_Update(t *testing.T) {
 			expectedError: true,
 			expectKind:    infra.KindNotFound,
 		},
+		{
+			name: "error: RETURNING yields no row",
+			setupMock: func(mock *repositorymock.MockReviewWriteQueries, rev *review.Review, tx sqlc.DBTX) {
+				mock.EXPECT().UpdateReview(ctx, tx, gomock.Any()).Return(int32(0), pgx.ErrNoRows)
+			},
+			expectedError: true,
+			expectKind:    infra.KindNotFound,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -194,6 +204,14 @@ func TestRepository_Delete(t *testing.T) {
 			expectedError: true,
 			expectKind:    infra.KindNotFound,
 		},
+		{
+			name: "error: RETURNING yields no row",
+			setupMock: func(mock *repositorymock.MockReviewWriteQueries, id uuid.UUID, tx sqlc.DBTX) {
+				mock.EXPECT().DeleteReview(ctx, tx, id).Return(int32(0), pgx.ErrNoRows)
+			},
+			expectedError: true,
+			expectKind:    infra.KindNotFound,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -221,6 +239,105 @@ func TestRepository_Delete(t *testing.T) {
 	}
 }
 
+// =============================================================================
+// Publish Review Tests
+// =============================================================================
+
+func TestRepository_Publish(t *testing.T) {
+	ctx := context.Background()
+	reviewID := uuid.New()
+
+	testCases := []struct {
+		name          string
+		affected      int64
+		queryErr      error
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{name: "success: flagged review published", affected: 1},
+		{name: "error: review missing or not flagged", affected: 0, expectedError: true, expectKind: infra.KindNotFound},
+		{name: "error: database error occurs", queryErr: errors.New("database connection error"), expectedError: true, expectKind: infra.KindDBFailure},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockReviewWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewReviewRepository(mockQueries, mockDB)
+
+			mockQueries.EXPECT().PublishReview(ctx, mockDB, reviewID).Return(tc.affected, tc.queryErr)
+
+			actualError := repo.Publish(ctx, mockDB, reviewID)
+
+			if tc.expectedError {
+				require.Error(t, actualError)
+				assert.True(t, infra.IsKind(actualError, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, actualError, actualError)
+			} else {
+				assert.NoError(t, actualError)
+			}
+		})
+	}
+}
+
+// =============================================================================
+// Import Review Tests
+// =============================================================================
+
+func TestRepository_Import(t *testing.T) {
+	ctx := context.Background()
+	created := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		queryErr      error
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{name: "success: review imported"},
+		{name: "error: source imported the review before", queryErr: pgx.ErrNoRows, expectedError: true, expectKind: infra.KindDuplicateKey},
+		{name: "error: database error occurs", queryErr: errors.New("database connection error"), expectedError: true, expectKind: infra.KindDBFailure},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockReviewWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewReviewRepository(mockQueries, mockDB)
+
+			rev, err := review.NewImportedReview(uuid.New(), uuid.New(), 4, "Quiet", created, time.Time{}, created.Add(time.Hour))
+			require.NoError(t, err)
+			mockQueries.EXPECT().ImportReview(ctx, mockDB, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ sqlc.DBTX, arg sqlc.ImportReviewParams) (uuid.UUID, error) {
+					assert.Equal(t, pgtype.Text{String: "legacy-crm", Valid: true}, arg.ImportSource)
+					assert.Equal(t, pgtype.Text{String: "r-1", Valid: true}, arg.ExternalID)
+					assert.Equal(t, created, arg.CreatedAt.Time)
+					assert.Equal(t, created, arg.UpdatedAt.Time)
+					if tc.queryErr != nil {
+						return uuid.Nil, tc.queryErr
+					}
+					return arg.ID, nil
+				})
+
+			id, actualError := repo.Import(ctx, mockDB, rev, "legacy-crm", "r-1")
+
+			if tc.expectedError {
+				require.Error(t, actualError)
+				assert.True(t, infra.IsKind(actualError, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, actualError, actualError)
+				assert.Equal(t, uuid.Nil, id)
+			} else {
+				assert.NoError(t, actualError)
+				assert.Equal(t, rev.ID(), id)
+			}
+		})
+	}
+}
+
 // =============================================================================
 // Test Helper Functions
 // =============================================================================
@@ -239,3 +356,11 @@ func (m *mockDBTX) Query(ctx context.Context, sql string, args ...any) (pgx.Rows
 func (m *mockDBTX) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
 	panic("mockDBTX.QueryRow was called unexpectedly. Use sqlc mock instead.")
 }
+
+func (m *mockDBTX) CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
+	panic("mockDBTX.CopyFrom was called unexpectedly. Use sqlc mock instead.")
+}
+
+func (m *mockDBTX) SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults {
+	panic("mockDBTX.SendBatch was called unexpectedly. Use sqlc mock instead.")
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/errs"
+)
+
+var errRoleNotDeleted = errs.New("no custom role deleted")
+
+type RoleWriteQueries interface {
+	CreateRole(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateRoleParams) error
+	DeleteRole(ctx context.Context, db sqlc.DBTX, name string) (int64, error)
+	DeleteRolePermissions(ctx context.Context, db sqlc.DBTX, roleName string) error
+	AddRolePermissions(ctx context.Context, db sqlc.DBTX, arg []sqlc.AddRolePermissionsParams) *sqlc.AddRolePermissionsBatchResults
+	TouchRole(ctx context.Context, db sqlc.DBTX, name string) error
+}
+
+type RoleRepository struct {
+	queries RoleWriteQueries
+}
+
+func NewRoleRepository(queries RoleWriteQueries) *RoleRepository {
+	return &RoleRepository{
+		queries: queries,
+	}
+}
+
+func (r *RoleRepository) Create(ctx context.Context, tx sqlc.DBTX, name, description string) error {
+	err := r.queries.CreateRole(ctx, tx, sqlc.CreateRoleParams{
+		Name:        name,
+		Description: description,
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to create role", err)
+	}
+	return nil
+}
+
+// ReplacePermissions swaps the role's grants for permissions; call it inside a transaction.
+func (r *RoleRepository) ReplacePermissions(ctx context.Context, tx sqlc.DBTX, name string, permissions []string) error {
+	if err := r.queries.DeleteRolePermissions(ctx, tx, name); err != nil {
+		return infra.WrapRepoErr("failed to clear role permissions", err)
+	}
+
+	if len(permissions) > 0 {
+		params := make([]sqlc.AddRolePermissionsParams, len(permissions))
+		for i, permission := range permissions {
+			params[i] = sqlc.AddRolePermissionsParams{RoleName: name, PermissionName: permission}
+		}
+		if err := firstBatchError(r.queries.AddRolePermissions(ctx, tx, params).Exec); err != nil {
+			return infra.WrapRepoErr("failed to add role permissions", err)
+		}
+	}
+
+	if err := r.queries.TouchRole(ctx, tx, name); err != nil {
+		return infra.WrapRepoErr("failed to touch role", err)
+	}
+	return nil
+}
+
+// Delete removes a custom role; system roles are never deleted and report KindNotFound.
+func (r *RoleRepository) Delete(ctx context.Context, tx sqlc.DBTX, name string) error {
+	affected, err := r.queries.DeleteRole(ctx, tx, name)
+	if err != nil {
+		return infra.WrapRepoErr("failed to delete role", err)
+	}
+	if affected == 0 {
+		return infra.WrapRepoErr("role not found", errRoleNotDeleted, infra.KindNotFound)
+	}
+	return nil
+}
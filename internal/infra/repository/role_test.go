@@ -0,0 +1,193 @@
+//go:build unit
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/infra/repository"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	repositorymock "gin-clean-starter/tests/mock/repository"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRoleRepository_ReplacePermissions(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		name          string
+		permissions   []string
+		setupMock     func(*repositorymock.MockRoleWriteQueries, sqlc.DBTX)
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name:        "success: grants replaced and role touched",
+			permissions: []string{"reviews:read:any", "reviews:delete:any"},
+			setupMock: func(mock *repositorymock.MockRoleWriteQueries, db sqlc.DBTX) {
+				gomock.InOrder(
+					mock.EXPECT().DeleteRolePermissions(ctx, db, "moderator").Return(nil),
+					mock.EXPECT().AddRolePermissions(ctx, db, []sqlc.AddRolePermissionsParams{
+						{RoleName: "moderator", PermissionName: "reviews:read:any"},
+						{RoleName: "moderator", PermissionName: "reviews:delete:any"},
+					}).DoAndReturn(scriptedBatch(nil, nil)),
+					mock.EXPECT().TouchRole(ctx, db, "moderator").Return(nil),
+				)
+			},
+		},
+		{
+			name:        "success: empty list revokes everything",
+			permissions: nil,
+			setupMock: func(mock *repositorymock.MockRoleWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().DeleteRolePermissions(ctx, db, "moderator").Return(nil)
+				mock.EXPECT().TouchRole(ctx, db, "moderator").Return(nil)
+			},
+		},
+		{
+			name:        "error: unknown permission violates foreign key",
+			permissions: []string{"nope:nope"},
+			setupMock: func(mock *repositorymock.MockRoleWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().DeleteRolePermissions(ctx, db, "moderator").Return(nil)
+				mock.EXPECT().AddRolePermissions(ctx, db, gomock.Any()).DoAndReturn(scriptedBatch(&pgconn.PgError{Code: "23503"}))
+			},
+			expectedError: true,
+			expectKind:    infra.KindForeignKeyViolated,
+		},
+		{
+			name:        "error: first failure in the batch is reported, not the aborted tail",
+			permissions: []string{"reviews:read:any", "nope:nope", "reviews:delete:any"},
+			setupMock: func(mock *repositorymock.MockRoleWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().DeleteRolePermissions(ctx, db, "moderator").Return(nil)
+				mock.EXPECT().AddRolePermissions(ctx, db, gomock.Any()).DoAndReturn(scriptedBatch(
+					nil,
+					&pgconn.PgError{Code: "23503"},
+					&pgconn.PgError{Code: "25P02"}, // in_failed_sql_transaction
+				))
+			},
+			expectedError: true,
+			expectKind:    infra.KindForeignKeyViolated,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockRoleWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewRoleRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			err := repo.ReplacePermissions(ctx, mockDB, "moderator", tc.permissions)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRoleRepository_Delete(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		name          string
+		setupMock     func(*repositorymock.MockRoleWriteQueries, sqlc.DBTX)
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name: "success: custom role deleted",
+			setupMock: func(mock *repositorymock.MockRoleWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().DeleteRole(ctx, db, "moderator").Return(int64(1), nil)
+			},
+		},
+		{
+			name: "error: nothing deleted is reported as not found",
+			setupMock: func(mock *repositorymock.MockRoleWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().DeleteRole(ctx, db, "moderator").Return(int64(0), nil)
+			},
+			expectedError: true,
+			expectKind:    infra.KindNotFound,
+		},
+		{
+			name: "error: role still referenced by users",
+			setupMock: func(mock *repositorymock.MockRoleWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().DeleteRole(ctx, db, "moderator").Return(int64(0), &pgconn.PgError{Code: "23503"})
+			},
+			expectedError: true,
+			expectKind:    infra.KindForeignKeyViolated,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockRoleWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewRoleRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			err := repo.Delete(ctx, mockDB, "moderator")
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+// scriptedBatch runs the real generated batch code against a DB whose statements
+// return errs in order (missing entries succeed).
+func scriptedBatch(errs ...error) func(context.Context, sqlc.DBTX, []sqlc.AddRolePermissionsParams) *sqlc.AddRolePermissionsBatchResults {
+	return func(ctx context.Context, _ sqlc.DBTX, arg []sqlc.AddRolePermissionsParams) *sqlc.AddRolePermissionsBatchResults {
+		return sqlc.New().AddRolePermissions(ctx, &batchDBTX{errs: errs}, arg)
+	}
+}
+
+type batchDBTX struct {
+	mockDBTX
+	errs []error
+}
+
+func (m *batchDBTX) SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults {
+	return &scriptedBatchResults{errs: m.errs}
+}
+
+type scriptedBatchResults struct {
+	errs []error
+	next int
+}
+
+func (r *scriptedBatchResults) Exec() (pgconn.CommandTag, error) {
+	var err error
+	if r.next < len(r.errs) {
+		err = r.errs[r.next]
+	}
+	r.next++
+	return pgconn.CommandTag{}, err
+}
+
+func (r *scriptedBatchResults) Query() (pgx.Rows, error) { return nil, nil }
+
+func (r *scriptedBatchResults) QueryRow() pgx.Row { return nil }
+
+func (r *scriptedBatchResults) Close() error { return nil }
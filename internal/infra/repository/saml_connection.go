@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+type SAMLConnectionWriteQueries interface {
+	DeleteSAMLConnection(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID) (int64, error)
+	UpsertSAMLConnection(ctx context.Context, db sqlc.DBTX, arg sqlc.UpsertSAMLConnectionParams) error
+}
+
+type SAMLConnectionRepository struct {
+	queries SAMLConnectionWriteQueries
+}
+
+func NewSAMLConnectionRepository(queries SAMLConnectionWriteQueries) *SAMLConnectionRepository {
+	return &SAMLConnectionRepository{
+		queries: queries,
+	}
+}
+
+func (r *SAMLConnectionRepository) Upsert(ctx context.Context, tx sqlc.DBTX, conn shared.SAMLConnection, actorID uuid.UUID) error {
+	mapping := conn.RoleMapping
+	if mapping == nil {
+		mapping = map[string]string{}
+	}
+	roleMapping, err := json.Marshal(mapping)
+	if err != nil {
+		return infra.WrapRepoErr("failed to encode SAML role mapping", err)
+	}
+	err = r.queries.UpsertSAMLConnection(ctx, tx, sqlc.UpsertSAMLConnectionParams{
+		CompanyID:      conn.CompanyID,
+		IdpEntityID:    conn.IDPEntityID,
+		IdpMetadata:    string(conn.IDPMetadata),
+		EmailAttribute: optionalText(conn.EmailAttribute),
+		RoleAttribute:  optionalText(conn.RoleAttribute),
+		RoleMapping:    roleMapping,
+		DefaultRole:    conn.DefaultRole,
+		UpdatedBy:      pgconv.UUIDToPgtype(actorID),
+		UpdatedAt:      pgconv.TimeToPgtype(conn.UpdatedAt),
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to save SAML connection", err)
+	}
+	return nil
+}
+
+func (r *SAMLConnectionRepository) Delete(ctx context.Context, tx sqlc.DBTX, companyID uuid.UUID) error {
+	rows, err := r.queries.DeleteSAMLConnection(ctx, tx, companyID)
+	if err != nil {
+		return infra.WrapRepoErr("failed to delete SAML connection", err)
+	}
+	if rows == 0 {
+		return infra.WrapRepoErr("SAML connection not found", nil, infra.KindNotFound)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+//go:build unit
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/infra/repository"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+	repositorymock "gin-clean-starter/tests/mock/repository"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSAMLConnectionRepository_Upsert(t *testing.T) {
+	ctx := context.Background()
+	companyID, actorID := uuid.New(), uuid.New()
+	at := time.Date(2026, 10, 16, 9, 30, 0, 0, time.UTC)
+
+	t.Run("success: role mapping stored as JSON, empty attributes as NULL", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockQueries := repositorymock.NewMockSAMLConnectionWriteQueries(ctrl)
+		mockDB := &mockDBTX{}
+		mockQueries.EXPECT().UpsertSAMLConnection(ctx, mockDB, sqlc.UpsertSAMLConnectionParams{
+			CompanyID:      companyID,
+			IdpEntityID:    "https://idp.example.com/metadata",
+			IdpMetadata:    "<EntityDescriptor/>",
+			EmailAttribute: pgtype.Text{},
+			RoleAttribute:  pgconv.StringToPgtype("groups"),
+			RoleMapping:    []byte(`{"ops":"operator"}`),
+			DefaultRole:    "viewer",
+			UpdatedBy:      pgconv.UUIDToPgtype(actorID),
+			UpdatedAt:      pgconv.TimeToPgtype(at),
+		}).Return(nil)
+
+		err := repository.NewSAMLConnectionRepository(mockQueries).Upsert(ctx, mockDB, shared.SAMLConnection{
+			CompanyID:     companyID,
+			IDPEntityID:   "https://idp.example.com/metadata",
+			IDPMetadata:   []byte("<EntityDescriptor/>"),
+			RoleAttribute: "groups",
+			RoleMapping:   map[string]string{"ops": "operator"},
+			DefaultRole:   "viewer",
+			UpdatedAt:     at,
+		}, actorID)
+		require.NoError(t, err)
+	})
+
+	t.Run("success: a missing role mapping is stored as an empty object", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockQueries := repositorymock.NewMockSAMLConnectionWriteQueries(ctrl)
+		mockDB := &mockDBTX{}
+		mockQueries.EXPECT().UpsertSAMLConnection(ctx, mockDB, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ sqlc.DBTX, arg sqlc.UpsertSAMLConnectionParams) error {
+				assert.JSONEq(t, `{}`, string(arg.RoleMapping))
+				return nil
+			})
+
+		err := repository.NewSAMLConnectionRepository(mockQueries).Upsert(ctx, mockDB, shared.SAMLConnection{
+			CompanyID:   companyID,
+			DefaultRole: "viewer",
+			UpdatedAt:   at,
+		}, actorID)
+		require.NoError(t, err)
+	})
+}
+
+func TestSAMLConnectionRepository_Delete(t *testing.T) {
+	ctx := context.Background()
+	companyID := uuid.New()
+
+	t.Run("success: connection removed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockQueries := repositorymock.NewMockSAMLConnectionWriteQueries(ctrl)
+		mockDB := &mockDBTX{}
+		mockQueries.EXPECT().DeleteSAMLConnection(ctx, mockDB, companyID).Return(int64(1), nil)
+
+		require.NoError(t, repository.NewSAMLConnectionRepository(mockQueries).Delete(ctx, mockDB, companyID))
+	})
+
+	t.Run("error: company has no connection", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockQueries := repositorymock.NewMockSAMLConnectionWriteQueries(ctrl)
+		mockDB := &mockDBTX{}
+		mockQueries.EXPECT().DeleteSAMLConnection(ctx, mockDB, companyID).Return(int64(0), nil)
+
+		err := repository.NewSAMLConnectionRepository(mockQueries).Delete(ctx, mockDB, companyID)
+		require.Error(t, err)
+		assert.True(t, infra.IsKind(err, infra.KindNotFound))
+	})
+}
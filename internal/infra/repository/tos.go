@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+)
+
+type TOSWriteQueries interface {
+	AcceptTOSVersion(ctx context.Context, db sqlc.DBTX, arg sqlc.AcceptTOSVersionParams) error
+}
+
+type TOSRepository struct {
+	queries TOSWriteQueries
+}
+
+func NewTOSRepository(queries TOSWriteQueries) *TOSRepository {
+	return &TOSRepository{
+		queries: queries,
+	}
+}
+
+func (r *TOSRepository) Accept(ctx context.Context, tx sqlc.DBTX, acceptance shared.TOSAcceptance) error {
+	var ip *string
+	if acceptance.IPAddress != "" {
+		ip = &acceptance.IPAddress
+	}
+	err := r.queries.AcceptTOSVersion(ctx, tx, sqlc.AcceptTOSVersionParams{
+		UserID:       acceptance.UserID,
+		TosVersionID: acceptance.VersionID,
+		AcceptedAt:   pgconv.TimeToPgtype(acceptance.AcceptedAt),
+		IpAddress:    pgconv.StringPtrToPgtype(ip),
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to record terms of service acceptance", err)
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+//go:build unit
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/infra/repository"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+	repositorymock "gin-clean-starter/tests/mock/repository"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTOSRepository_Accept(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	versionID := uuid.New()
+	acceptedAt := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		acceptance    shared.TOSAcceptance
+		setupMock     func(*repositorymock.MockTOSWriteQueries, sqlc.DBTX)
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name:       "success: acceptance stored with the client IP",
+			acceptance: shared.TOSAcceptance{UserID: userID, VersionID: versionID, AcceptedAt: acceptedAt, IPAddress: "203.0.113.7"},
+			setupMock: func(mock *repositorymock.MockTOSWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().AcceptTOSVersion(ctx, db, sqlc.AcceptTOSVersionParams{
+					UserID:       userID,
+					TosVersionID: versionID,
+					AcceptedAt:   pgconv.TimeToPgtype(acceptedAt),
+					IpAddress:    pgconv.StringToPgtype("203.0.113.7"),
+				}).Return(nil)
+			},
+		},
+		{
+			name:       "success: unknown IP stored as NULL",
+			acceptance: shared.TOSAcceptance{UserID: userID, VersionID: versionID, AcceptedAt: acceptedAt},
+			setupMock: func(mock *repositorymock.MockTOSWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().AcceptTOSVersion(ctx, db, sqlc.AcceptTOSVersionParams{
+					UserID:       userID,
+					TosVersionID: versionID,
+					AcceptedAt:   pgconv.TimeToPgtype(acceptedAt),
+					IpAddress:    pgtype.Text{},
+				}).Return(nil)
+			},
+		},
+		{
+			name:       "error: unknown version violates foreign key",
+			acceptance: shared.TOSAcceptance{UserID: userID, VersionID: versionID, AcceptedAt: acceptedAt},
+			setupMock: func(mock *repositorymock.MockTOSWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().AcceptTOSVersion(ctx, db, gomock.Any()).Return(&pgconn.PgError{Code: "23503"})
+			},
+			expectedError: true,
+			expectKind:    infra.KindForeignKeyViolated,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockTOSWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewTOSRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			err := repo.Accept(ctx, mockDB, tc.acceptance)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+)
+
+type UsageWriteQueries interface {
+	AddAPIUsage(ctx context.Context, db sqlc.DBTX, arg sqlc.AddAPIUsageParams) error
+}
+
+type UsageRepository struct {
+	queries UsageWriteQueries
+}
+
+func NewUsageRepository(queries UsageWriteQueries) *UsageRepository {
+	return &UsageRepository{
+		queries: queries,
+	}
+}
+
+func (r *UsageRepository) Add(ctx context.Context, tx sqlc.DBTX, delta shared.UsageDelta, at time.Time) error {
+	err := r.queries.AddAPIUsage(ctx, tx, sqlc.AddAPIUsageParams{
+		Month:        pgconv.DateToPgtype(delta.Month),
+		RequestCount: delta.Requests,
+		BytesIn:      delta.BytesIn,
+		BytesOut:     delta.BytesOut,
+		Now:          pgconv.TimeToPgtype(at),
+		UserID:       delta.UserID,
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to add API usage", err)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+//go:build unit
+
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/infra/repository"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+	repositorymock "gin-clean-starter/tests/mock/repository"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUsageRepository_Add(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	at := time.Date(2026, 10, 16, 9, 30, 0, 0, time.UTC)
+	delta := shared.UsageDelta{
+		UserID:   userID,
+		Month:    shared.UsageMonth(at),
+		Requests: 12,
+		BytesIn:  2048,
+		BytesOut: 65536,
+	}
+
+	testCases := []struct {
+		name          string
+		setupMock     func(*repositorymock.MockUsageWriteQueries, sqlc.DBTX)
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name: "success: delta added to the month",
+			setupMock: func(mock *repositorymock.MockUsageWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().AddAPIUsage(ctx, db, sqlc.AddAPIUsageParams{
+					Month:        pgtype.Date{Time: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+					RequestCount: 12,
+					BytesIn:      2048,
+					BytesOut:     65536,
+					Now:          pgconv.TimeToPgtype(at),
+					UserID:       userID,
+				}).Return(nil)
+			},
+		},
+		{
+			name: "error: database error occurs",
+			setupMock: func(mock *repositorymock.MockUsageWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().AddAPIUsage(ctx, db, gomock.Any()).Return(errors.New("database connection error"))
+			},
+			expectedError: true,
+			expectKind:    infra.KindDBFailure,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockUsageWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewUsageRepository(mockQueries)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			err := repo.Add(ctx, mockDB, delta, at)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
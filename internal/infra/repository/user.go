@@ -2,25 +2,45 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	"gin-clean-starter/internal/domain/referral"
 	"gin-clean-starter/internal/infra"
 	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/crypto"
+	"gin-clean-starter/internal/pkg/pgconv"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
+// maxReferralCodeAttempts bounds the retries when a generated referral code is taken.
+const maxReferralCodeAttempts = 5
+
 type UserWriteQueries interface {
 	UpdateUserLastLogin(ctx context.Context, db sqlc.DBTX, id uuid.UUID) error
 	CreateUser(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateUserParams) (uuid.UUID, error)
+	CreateInactiveUser(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateInactiveUserParams) (uuid.UUID, error)
+	ActivateUser(ctx context.Context, db sqlc.DBTX, id uuid.UUID) error
+	GetUserPasswordHashForUpdate(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (string, error)
+	SetUserPassword(ctx context.Context, db sqlc.DBTX, arg sqlc.SetUserPasswordParams) error
+	SetUserPhone(ctx context.Context, db sqlc.DBTX, arg sqlc.SetUserPhoneParams) error
+	SetUserExternalID(ctx context.Context, db sqlc.DBTX, arg sqlc.SetUserExternalIDParams) error
+	SetUserRole(ctx context.Context, db sqlc.DBTX, arg sqlc.SetUserRoleParams) error
+	TouchUserDevice(ctx context.Context, db sqlc.DBTX, arg sqlc.TouchUserDeviceParams) (bool, error)
 }
 
 type UserRepository struct {
-	queries UserWriteQueries
+	queries  UserWriteQueries
+	envelope *crypto.Envelope
 }
 
-func NewUserRepository(queries UserWriteQueries) *UserRepository {
+func NewUserRepository(queries UserWriteQueries, envelope *crypto.Envelope) *UserRepository {
 	return &UserRepository{
-		queries: queries,
+		queries:  queries,
+		envelope: envelope,
 	}
 }
 
@@ -32,10 +52,120 @@ func (r *UserRepository) UpdateLastLogin(ctx context.Context, tx sqlc.DBTX, user
 	return nil
 }
 
+// Create assigns the user a freshly generated referral code. A code collision inserts
+// nothing instead of raising, so the surrounding transaction survives and the insert is
+// retried with a new code; a taken email still fails as KindDuplicateKey.
 func (r *UserRepository) Create(ctx context.Context, tx sqlc.DBTX, params sqlc.CreateUserParams) (uuid.UUID, error) {
-	resultID, err := r.queries.CreateUser(ctx, tx, params)
+	return createWithReferralCode(func(code string) (uuid.UUID, error) {
+		params.ReferralCode = code
+		return r.queries.CreateUser(ctx, tx, params)
+	})
+}
+
+// CreateInactive is Create for accounts that cannot sign in until Activate.
+func (r *UserRepository) CreateInactive(ctx context.Context, tx sqlc.DBTX, params sqlc.CreateUserParams) (uuid.UUID, error) {
+	return createWithReferralCode(func(code string) (uuid.UUID, error) {
+		params.ReferralCode = code
+		return r.queries.CreateInactiveUser(ctx, tx, sqlc.CreateInactiveUserParams(params))
+	})
+}
+
+func (r *UserRepository) Activate(ctx context.Context, tx sqlc.DBTX, userID uuid.UUID) error {
+	err := r.queries.ActivateUser(ctx, tx, userID)
+	if err != nil {
+		return infra.WrapRepoErr("failed to activate user", err)
+	}
+	return nil
+}
+
+func createWithReferralCode(insert func(code string) (uuid.UUID, error)) (uuid.UUID, error) {
+	for range maxReferralCodeAttempts {
+		code, err := referral.GenerateCode()
+		if err != nil {
+			return uuid.Nil, infra.WrapRepoErr("failed to generate referral code", err)
+		}
+
+		resultID, err := insert(code.String())
+		if errors.Is(err, pgx.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return uuid.Nil, infra.WrapRepoErr("failed to create user", err)
+		}
+		return resultID, nil
+	}
+	return uuid.Nil, infra.WrapRepoErr("failed to create user: referral codes exhausted", nil, infra.KindConflict)
+}
+
+func (r *UserRepository) PasswordHashForUpdate(ctx context.Context, tx sqlc.DBTX, userID uuid.UUID) (string, error) {
+	hash, err := r.queries.GetUserPasswordHashForUpdate(ctx, tx, userID)
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return "", infra.WrapRepoErr("user not found", err, infra.KindNotFound)
+		}
+		return "", infra.WrapRepoErr("failed to lock user password", err)
+	}
+	return hash, nil
+}
+
+func (r *UserRepository) SetPassword(ctx context.Context, tx sqlc.DBTX, userID uuid.UUID, passwordHash string) error {
+	err := r.queries.SetUserPassword(ctx, tx, sqlc.SetUserPasswordParams{
+		ID:           userID,
+		PasswordHash: passwordHash,
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to set user password", err)
+	}
+	return nil
+}
+
+// SetPhone stores the phone number encrypted; there is no plaintext fallback, so it
+// fails with crypto.ErrNoActiveKey when no key is configured.
+func (r *UserRepository) SetPhone(ctx context.Context, tx sqlc.DBTX, userID uuid.UUID, phone string) error {
+	ciphertext, err := r.envelope.EncryptString(phone, infra.UserPhoneAAD(userID))
+	if err != nil {
+		return infra.WrapRepoErr("failed to encrypt user phone", err)
+	}
+	err = r.queries.SetUserPhone(ctx, tx, sqlc.SetUserPhoneParams{
+		ID:              userID,
+		PhoneCiphertext: pgtype.Text{String: ciphertext, Valid: true},
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to set user phone", err)
+	}
+	return nil
+}
+
+func (r *UserRepository) SetExternalID(ctx context.Context, tx sqlc.DBTX, userID uuid.UUID, externalID string) error {
+	err := r.queries.SetUserExternalID(ctx, tx, sqlc.SetUserExternalIDParams{
+		ID:         userID,
+		ExternalID: optionalText(externalID),
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to set user external ID", err)
+	}
+	return nil
+}
+
+func (r *UserRepository) SetRole(ctx context.Context, tx sqlc.DBTX, userID uuid.UUID, role string) error {
+	err := r.queries.SetUserRole(ctx, tx, sqlc.SetUserRoleParams{
+		ID:   userID,
+		Role: role,
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to set user role", err)
+	}
+	return nil
+}
+
+func (r *UserRepository) TouchDevice(ctx context.Context, tx sqlc.DBTX, userID uuid.UUID, fingerprint string, seenAt time.Time) (bool, error) {
+	inserted, err := r.queries.TouchUserDevice(ctx, tx, sqlc.TouchUserDeviceParams{
+		UserID:      userID,
+		Fingerprint: fingerprint,
+		SeenAt:      pgconv.TimeToPgtype(seenAt),
+	})
 	if err != nil {
-		return uuid.Nil, infra.WrapRepoErr("failed to create user", err)
+		return false, infra.WrapRepoErr("failed to record user device", err)
 	}
-	return resultID, nil
+	return inserted, nil
 }
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+
+	"github.com/google/uuid"
+)
+
+type UserIdentityWriteQueries interface {
+	GetUserIdentityUserID(ctx context.Context, db sqlc.DBTX, arg sqlc.GetUserIdentityUserIDParams) (uuid.UUID, error)
+	CreateUserIdentity(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateUserIdentityParams) error
+	TouchUserIdentity(ctx context.Context, db sqlc.DBTX, arg sqlc.TouchUserIdentityParams) error
+}
+
+type UserIdentityRepository struct {
+	queries UserIdentityWriteQueries
+}
+
+func NewUserIdentityRepository(queries UserIdentityWriteQueries) *UserIdentityRepository {
+	return &UserIdentityRepository{
+		queries: queries,
+	}
+}
+
+func (r *UserIdentityRepository) FindUserID(ctx context.Context, db sqlc.DBTX, provider, subject string) (uuid.UUID, error) {
+	userID, err := r.queries.GetUserIdentityUserID(ctx, db, sqlc.GetUserIdentityUserIDParams{
+		Provider: provider,
+		Subject:  subject,
+	})
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return uuid.Nil, infra.WrapRepoErr("user identity not found", err, infra.KindNotFound)
+		}
+		return uuid.Nil, infra.WrapRepoErr("failed to find user identity", err)
+	}
+	return userID, nil
+}
+
+func (r *UserIdentityRepository) Create(ctx context.Context, tx sqlc.DBTX, provider, subject string, userID uuid.UUID, email string, at time.Time) error {
+	err := r.queries.CreateUserIdentity(ctx, tx, sqlc.CreateUserIdentityParams{
+		Provider:  provider,
+		Subject:   subject,
+		UserID:    userID,
+		Email:     email,
+		CreatedAt: pgconv.TimeToPgtype(at),
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to create user identity", err)
+	}
+	return nil
+}
+
+func (r *UserIdentityRepository) Touch(ctx context.Context, tx sqlc.DBTX, provider, subject, email string, at time.Time) error {
+	err := r.queries.TouchUserIdentity(ctx, tx, sqlc.TouchUserIdentityParams{
+		Email:       email,
+		LastLoginAt: pgconv.TimeToPgtype(at),
+		Provider:    provider,
+		Subject:     subject,
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to update user identity", err)
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/crypto"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type UserMergeWriteQueries interface {
+	LockUserMergeAccounts(ctx context.Context, db sqlc.DBTX, arg sqlc.LockUserMergeAccountsParams) ([]sqlc.LockUserMergeAccountsRow, error)
+	CountUserMerge(ctx context.Context, db sqlc.DBTX, arg sqlc.CountUserMergeParams) (sqlc.CountUserMergeRow, error)
+	MergeUser(ctx context.Context, db sqlc.DBTX, arg sqlc.MergeUserParams) (sqlc.MergeUserRow, error)
+	ListUserMergeEncryptedMessages(ctx context.Context, db sqlc.DBTX, sourceID uuid.UUID) ([]sqlc.ListUserMergeEncryptedMessagesRow, error)
+	ReauthorReservationMessage(ctx context.Context, db sqlc.DBTX, arg sqlc.ReauthorReservationMessageParams) error
+}
+
+type UserMergeRepository struct {
+	queries  UserMergeWriteQueries
+	envelope *crypto.Envelope
+}
+
+func NewUserMergeRepository(queries UserMergeWriteQueries, envelope *crypto.Envelope) *UserMergeRepository {
+	return &UserMergeRepository{
+		queries:  queries,
+		envelope: envelope,
+	}
+}
+
+func (r *UserMergeRepository) Lock(ctx context.Context, tx sqlc.DBTX, sourceID, targetID uuid.UUID) ([]shared.UserMergeAccount, error) {
+	rows, err := r.queries.LockUserMergeAccounts(ctx, tx, sqlc.LockUserMergeAccountsParams{
+		SourceID: sourceID,
+		TargetID: targetID,
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to lock accounts to merge", err)
+	}
+	accounts := make([]shared.UserMergeAccount, len(rows))
+	for i, row := range rows {
+		accounts[i] = shared.UserMergeAccount{
+			ID:        row.ID,
+			CompanyID: pgconv.UUIDPtrFromPgtype(row.CompanyID),
+			IsActive:  row.IsActive,
+		}
+	}
+	return accounts, nil
+}
+
+func (r *UserMergeRepository) Count(ctx context.Context, tx sqlc.DBTX, sourceID uuid.UUID, now time.Time) (*shared.UserMergeCounts, error) {
+	row, err := r.queries.CountUserMerge(ctx, tx, sqlc.CountUserMergeParams{
+		SourceID: sourceID,
+		Now:      pgconv.TimeToPgtype(now),
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to count user merge", err)
+	}
+	return &shared.UserMergeCounts{
+		Reservations:    row.Reservations,
+		Reviews:         row.Reviews,
+		ReferralCredits: row.ReferralCredits,
+		LoyaltyEntries:  row.LoyaltyEntries,
+		ActivityEntries: row.ActivityEntries,
+		Identities:      row.Identities,
+		Messages:        row.Messages,
+		Transfers:       row.Transfers,
+		Sessions:        row.Sessions,
+	}, nil
+}
+
+func (r *UserMergeRepository) Merge(ctx context.Context, tx sqlc.DBTX, sourceID, targetID uuid.UUID, now time.Time) (*shared.UserMergeCounts, error) {
+	reauthored, err := r.reauthorMessages(ctx, tx, sourceID, targetID)
+	if err != nil {
+		return nil, err
+	}
+	row, err := r.queries.MergeUser(ctx, tx, sqlc.MergeUserParams{
+		TargetID: targetID,
+		Now:      pgconv.TimeToPgtype(now),
+		SourceID: sourceID,
+	})
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to merge user", err)
+	}
+	return &shared.UserMergeCounts{
+		Reservations:    row.Reservations,
+		Reviews:         row.Reviews,
+		ReferralCredits: row.ReferralCredits,
+		LoyaltyEntries:  row.LoyaltyEntries,
+		ActivityEntries: row.ActivityEntries,
+		Identities:      row.Identities,
+		Messages:        row.Messages + reauthored,
+		Transfers:       row.Transfers,
+		Sessions:        row.Sessions,
+	}, nil
+}
+
+// reauthorMessages hands the source's encrypted messages to the target. Message ciphertext
+// is bound to its author, so each is decrypted and sealed again for the target, or stored
+// in plaintext when no key is active, as Create would.
+func (r *UserMergeRepository) reauthorMessages(ctx context.Context, tx sqlc.DBTX, sourceID, targetID uuid.UUID) (int64, error) {
+	rows, err := r.queries.ListUserMergeEncryptedMessages(ctx, tx, sourceID)
+	if err != nil {
+		return 0, infra.WrapRepoErr("failed to list messages to merge", err)
+	}
+	for _, row := range rows {
+		body, derr := r.envelope.DecryptString(row.BodyCiphertext, infra.ReservationMessageAAD(sourceID))
+		if derr != nil {
+			return 0, infra.WrapRepoErr("failed to decrypt reservation message", derr)
+		}
+		params := sqlc.ReauthorReservationMessageParams{
+			AuthorID: targetID,
+			Body:     pgtype.Text{String: body, Valid: true},
+			ID:       row.ID,
+		}
+		if r.envelope.CanEncrypt() {
+			ciphertext, eerr := r.envelope.EncryptString(body, infra.ReservationMessageAAD(targetID))
+			if eerr != nil {
+				return 0, infra.WrapRepoErr("failed to encrypt reservation message", eerr)
+			}
+			params.Body = pgtype.Text{Valid: false}
+			params.BodyCiphertext = pgtype.Text{String: ciphertext, Valid: true}
+		}
+		if err = r.queries.ReauthorReservationMessage(ctx, tx, params); err != nil {
+			return 0, infra.WrapRepoErr("failed to move reservation message", err)
+		}
+	}
+	return int64(len(rows)), nil
+}
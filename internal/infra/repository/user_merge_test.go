@@ -0,0 +1,210 @@
+//go:build unit
+
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/infra/repository"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/crypto"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+	repositorymock "gin-clean-starter/tests/mock/repository"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUserMergeRepository_Lock(t *testing.T) {
+	ctx := context.Background()
+	sourceID, targetID, companyID := uuid.New(), uuid.New(), uuid.New()
+
+	testCases := []struct {
+		name          string
+		setupMock     func(*repositorymock.MockUserMergeWriteQueries, sqlc.DBTX)
+		expected      []shared.UserMergeAccount
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name: "success: both accounts locked, company-less ones included",
+			setupMock: func(mock *repositorymock.MockUserMergeWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().LockUserMergeAccounts(ctx, db, sqlc.LockUserMergeAccountsParams{SourceID: sourceID, TargetID: targetID}).
+					Return([]sqlc.LockUserMergeAccountsRow{
+						{ID: sourceID, CompanyID: pgconv.UUIDToPgtype(companyID), IsActive: true},
+						{ID: targetID, IsActive: false},
+					}, nil)
+			},
+			expected: []shared.UserMergeAccount{
+				{ID: sourceID, CompanyID: &companyID, IsActive: true},
+				{ID: targetID, IsActive: false},
+			},
+		},
+		{
+			name: "error: database failure",
+			setupMock: func(mock *repositorymock.MockUserMergeWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().LockUserMergeAccounts(ctx, db, gomock.Any()).Return(nil, errors.New("connection lost"))
+			},
+			expectedError: true,
+			expectKind:    infra.KindDBFailure,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockUserMergeWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewUserMergeRepository(mockQueries, nil)
+
+			tc.setupMock(mockQueries, mockDB)
+
+			accounts, err := repo.Lock(ctx, mockDB, sourceID, targetID)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, accounts)
+		})
+	}
+}
+
+func TestUserMergeRepository_Merge(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	sourceID, targetID, messageID := uuid.New(), uuid.New(), uuid.New()
+	keys, err := crypto.ParseKeys("k1:dGVzdC1jb2x1bW4tZW5jcnlwdGlvbi1rZXktMzJieXQ=")
+	require.NoError(t, err)
+	envelope, err := crypto.NewEnvelope(keys, "k1")
+	require.NoError(t, err)
+	readOnly, err := crypto.NewEnvelope(keys, "")
+	require.NoError(t, err)
+	ciphertext, err := envelope.EncryptString("door code 4711", infra.ReservationMessageAAD(sourceID))
+	require.NoError(t, err)
+	encrypted := []sqlc.ListUserMergeEncryptedMessagesRow{{ID: messageID, BodyCiphertext: ciphertext}}
+	merged := sqlc.MergeUserRow{
+		Reservations:    3,
+		Reviews:         1,
+		ReferralCredits: 2,
+		LoyaltyEntries:  4,
+		ActivityEntries: 5,
+		Identities:      1,
+		Messages:        2,
+		Transfers:       2,
+		Sessions:        1,
+	}
+
+	testCases := []struct {
+		name          string
+		envelope      *crypto.Envelope
+		setupMock     func(*testing.T, *repositorymock.MockUserMergeWriteQueries, sqlc.DBTX)
+		expected      *shared.UserMergeCounts
+		expectedError bool
+		expectKind    infra.RepositoryErrorKind
+	}{
+		{
+			name:     "success: moved rows counted, encrypted messages sealed again for the target",
+			envelope: envelope,
+			setupMock: func(t *testing.T, mock *repositorymock.MockUserMergeWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().ListUserMergeEncryptedMessages(ctx, db, sourceID).Return(encrypted, nil)
+				mock.EXPECT().ReauthorReservationMessage(ctx, db, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ sqlc.DBTX, arg sqlc.ReauthorReservationMessageParams) error {
+						assert.Equal(t, messageID, arg.ID)
+						assert.Equal(t, targetID, arg.AuthorID)
+						assert.False(t, arg.Body.Valid)
+						body, derr := envelope.DecryptString(arg.BodyCiphertext.String, infra.ReservationMessageAAD(targetID))
+						require.NoError(t, derr)
+						assert.Equal(t, "door code 4711", body)
+						return nil
+					})
+				mock.EXPECT().MergeUser(ctx, db, sqlc.MergeUserParams{
+					TargetID: targetID,
+					Now:      pgconv.TimeToPgtype(now),
+					SourceID: sourceID,
+				}).Return(merged, nil)
+			},
+			expected: &shared.UserMergeCounts{
+				Reservations:    3,
+				Reviews:         1,
+				ReferralCredits: 2,
+				LoyaltyEntries:  4,
+				ActivityEntries: 5,
+				Identities:      1,
+				Messages:        3,
+				Transfers:       2,
+				Sessions:        1,
+			},
+		},
+		{
+			name:     "success: without an active key moved messages are stored in plaintext",
+			envelope: readOnly,
+			setupMock: func(t *testing.T, mock *repositorymock.MockUserMergeWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().ListUserMergeEncryptedMessages(ctx, db, sourceID).Return(encrypted, nil)
+				mock.EXPECT().ReauthorReservationMessage(ctx, db, sqlc.ReauthorReservationMessageParams{
+					AuthorID: targetID,
+					Body:     pgtype.Text{String: "door code 4711", Valid: true},
+					ID:       messageID,
+				}).Return(nil)
+				mock.EXPECT().MergeUser(ctx, db, gomock.Any()).Return(sqlc.MergeUserRow{}, nil)
+			},
+			expected: &shared.UserMergeCounts{Messages: 1},
+		},
+		{
+			name:     "error: a message that no key decrypts stops the merge",
+			envelope: envelope,
+			setupMock: func(t *testing.T, mock *repositorymock.MockUserMergeWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().ListUserMergeEncryptedMessages(ctx, db, sourceID).
+					Return([]sqlc.ListUserMergeEncryptedMessagesRow{{ID: messageID, BodyCiphertext: "v1.k2.AAAA.AAAA"}}, nil)
+			},
+			expectedError: true,
+			expectKind:    infra.KindDBFailure,
+		},
+		{
+			name:     "error: a moved row breaks a unique constraint",
+			envelope: envelope,
+			setupMock: func(t *testing.T, mock *repositorymock.MockUserMergeWriteQueries, db sqlc.DBTX) {
+				mock.EXPECT().ListUserMergeEncryptedMessages(ctx, db, sourceID).Return(nil, nil)
+				mock.EXPECT().MergeUser(ctx, db, gomock.Any()).Return(sqlc.MergeUserRow{}, &pgconn.PgError{Code: "23505"})
+			},
+			expectedError: true,
+			expectKind:    infra.KindDuplicateKey,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQueries := repositorymock.NewMockUserMergeWriteQueries(ctrl)
+			mockDB := &mockDBTX{}
+			repo := repository.NewUserMergeRepository(mockQueries, tc.envelope)
+
+			tc.setupMock(t, mockQueries, mockDB)
+
+			counts, err := repo.Merge(ctx, mockDB, sourceID, targetID, now)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tc.expectKind), "expected kind [%v] but got [%T] (%v)", tc.expectKind, err, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, counts)
+		})
+	}
+}
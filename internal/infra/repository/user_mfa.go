@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/crypto"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/pkg/ptr"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+type MFAWriteQueries interface {
+	UpsertPendingUserMFA(ctx context.Context, db sqlc.DBTX, arg sqlc.UpsertPendingUserMFAParams) error
+	GetUserMFAForUpdate(ctx context.Context, db sqlc.DBTX, userID uuid.UUID) (sqlc.GetUserMFAForUpdateRow, error)
+	IsUserMFAEnabled(ctx context.Context, db sqlc.DBTX, userID uuid.UUID) (bool, error)
+	EnableUserMFA(ctx context.Context, db sqlc.DBTX, arg sqlc.EnableUserMFAParams) error
+	UpdateUserMFAAttempts(ctx context.Context, db sqlc.DBTX, arg sqlc.UpdateUserMFAAttemptsParams) error
+	DeleteUserMFA(ctx context.Context, db sqlc.DBTX, userID uuid.UUID) error
+	DeleteUserMFARecoveryCodes(ctx context.Context, db sqlc.DBTX, userID uuid.UUID) error
+	CreateUserMFARecoveryCode(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateUserMFARecoveryCodeParams) error
+	UseUserMFARecoveryCode(ctx context.Context, db sqlc.DBTX, arg sqlc.UseUserMFARecoveryCodeParams) (uuid.UUID, error)
+}
+
+type MFARepository struct {
+	queries  MFAWriteQueries
+	envelope *crypto.Envelope
+}
+
+func NewMFARepository(queries MFAWriteQueries, envelope *crypto.Envelope) *MFARepository {
+	return &MFARepository{
+		queries:  queries,
+		envelope: envelope,
+	}
+}
+
+// SavePending stores the secret encrypted; there is no plaintext fallback, so it fails
+// with crypto.ErrNoActiveKey when no key is configured.
+func (r *MFARepository) SavePending(ctx context.Context, tx sqlc.DBTX, userID uuid.UUID, secret string, now time.Time) error {
+	ciphertext, err := r.envelope.EncryptString(secret, infra.UserTOTPSecretAAD(userID))
+	if err != nil {
+		return infra.WrapRepoErr("failed to encrypt TOTP secret", err)
+	}
+	err = r.queries.UpsertPendingUserMFA(ctx, tx, sqlc.UpsertPendingUserMFAParams{
+		UserID:           userID,
+		SecretCiphertext: ciphertext,
+		CreatedAt:        pgconv.TimeToPgtype(now),
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to save MFA enrollment", err)
+	}
+	return nil
+}
+
+func (r *MFARepository) FindForUpdate(ctx context.Context, tx sqlc.DBTX, userID uuid.UUID) (*shared.UserMFA, error) {
+	row, err := r.queries.GetUserMFAForUpdate(ctx, tx, userID)
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return nil, infra.WrapRepoErr("MFA enrollment not found", err, infra.KindNotFound)
+		}
+		return nil, infra.WrapRepoErr("failed to find MFA enrollment", err)
+	}
+	secret, err := r.envelope.DecryptString(row.SecretCiphertext, infra.UserTOTPSecretAAD(userID))
+	if err != nil {
+		return nil, infra.WrapRepoErr("failed to decrypt TOTP secret", err)
+	}
+	return &shared.UserMFA{
+		UserID:         row.UserID,
+		Secret:         secret,
+		EnabledAt:      ptr.TimeFromPgtype(row.EnabledAt),
+		LastUsedStep:   row.LastUsedStep,
+		FailedAttempts: int(row.FailedAttempts),
+		LockedUntil:    ptr.TimeFromPgtype(row.LockedUntil),
+	}, nil
+}
+
+func (r *MFARepository) IsEnabled(ctx context.Context, db sqlc.DBTX, userID uuid.UUID) (bool, error) {
+	enabled, err := r.queries.IsUserMFAEnabled(ctx, db, userID)
+	if err != nil {
+		return false, infra.WrapRepoErr("failed to check MFA enrollment", err)
+	}
+	return enabled, nil
+}
+
+func (r *MFARepository) Enable(ctx context.Context, tx sqlc.DBTX, userID uuid.UUID, step int64, codeHashes []string, at time.Time) error {
+	err := r.queries.EnableUserMFA(ctx, tx, sqlc.EnableUserMFAParams{
+		EnabledAt:    pgconv.TimeToPgtype(at),
+		LastUsedStep: step,
+		UserID:       userID,
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to enable MFA", err)
+	}
+	if err := r.queries.DeleteUserMFARecoveryCodes(ctx, tx, userID); err != nil {
+		return infra.WrapRepoErr("failed to delete MFA recovery codes", err)
+	}
+	for _, hash := range codeHashes {
+		err := r.queries.CreateUserMFARecoveryCode(ctx, tx, sqlc.CreateUserMFARecoveryCodeParams{
+			UserID:   userID,
+			CodeHash: hash,
+		})
+		if err != nil {
+			return infra.WrapRepoErr("failed to create MFA recovery code", err)
+		}
+	}
+	return nil
+}
+
+func (r *MFARepository) SaveAttempts(ctx context.Context, tx sqlc.DBTX, m shared.UserMFA) error {
+	err := r.queries.UpdateUserMFAAttempts(ctx, tx, sqlc.UpdateUserMFAAttemptsParams{
+		LastUsedStep:   m.LastUsedStep,
+		FailedAttempts: int32(m.FailedAttempts),
+		LockedUntil:    pgconv.TimePtrToPgtype(m.LockedUntil),
+		UserID:         m.UserID,
+	})
+	if err != nil {
+		return infra.WrapRepoErr("failed to save MFA attempts", err)
+	}
+	return nil
+}
+
+func (r *MFARepository) UseRecoveryCode(ctx context.Context, tx sqlc.DBTX, userID uuid.UUID, codeHash string, at time.Time) error {
+	_, err := r.queries.UseUserMFARecoveryCode(ctx, tx, sqlc.UseUserMFARecoveryCodeParams{
+		UsedAt:   pgconv.TimeToPgtype(at),
+		UserID:   userID,
+		CodeHash: codeHash,
+	})
+	if err != nil {
+		if pgconv.IsNoRows(err) {
+			return infra.WrapRepoErr("MFA recovery code not found", err, infra.KindNotFound)
+		}
+		return infra.WrapRepoErr("failed to use MFA recovery code", err)
+	}
+	return nil
+}
+
+func (r *MFARepository) Delete(ctx context.Context, tx sqlc.DBTX, userID uuid.UUID) error {
+	if err := r.queries.DeleteUserMFA(ctx, tx, userID); err != nil {
+		return infra.WrapRepoErr("failed to delete MFA enrollment", err)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+//go:build unit
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/infra/repository"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/crypto"
+	"gin-clean-starter/internal/pkg/pgconv"
+	repositorymock "gin-clean-starter/tests/mock/repository"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMFARepository_SecretRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	keys, err := crypto.ParseKeys("k1:dGVzdC1jb2x1bW4tZW5jcnlwdGlvbi1rZXktMzJieXQ=")
+	require.NoError(t, err)
+	envelope, err := crypto.NewEnvelope(keys, "k1")
+	require.NoError(t, err)
+	userID := uuid.New()
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	const secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockQueries := repositorymock.NewMockMFAWriteQueries(ctrl)
+	mockDB := &mockDBTX{}
+	repo := repository.NewMFARepository(mockQueries, envelope)
+
+	var stored sqlc.UpsertPendingUserMFAParams
+	mockQueries.EXPECT().UpsertPendingUserMFA(ctx, mockDB, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ sqlc.DBTX, arg sqlc.UpsertPendingUserMFAParams) error {
+			stored = arg
+			return nil
+		})
+	require.NoError(t, repo.SavePending(ctx, mockDB, userID, secret, now))
+	assert.NotContains(t, stored.SecretCiphertext, secret)
+
+	row := sqlc.GetUserMFAForUpdateRow{
+		UserID:           userID,
+		SecretCiphertext: stored.SecretCiphertext,
+		EnabledAt:        pgconv.TimeToPgtype(now),
+		LastUsedStep:     42,
+		FailedAttempts:   2,
+	}
+	mockQueries.EXPECT().GetUserMFAForUpdate(ctx, mockDB, userID).Return(row, nil)
+	got, err := repo.FindForUpdate(ctx, mockDB, userID)
+	require.NoError(t, err)
+	assert.Equal(t, secret, got.Secret)
+	assert.True(t, got.Enabled())
+	assert.Equal(t, int64(42), got.LastUsedStep)
+	assert.Equal(t, 2, got.FailedAttempts)
+	assert.Nil(t, got.LockedUntil)
+
+	t.Run("error: ciphertext of another user does not decrypt", func(t *testing.T) {
+		otherID := uuid.New()
+		mockQueries.EXPECT().GetUserMFAForUpdate(ctx, mockDB, otherID).Return(sqlc.GetUserMFAForUpdateRow{
+			UserID:           otherID,
+			SecretCiphertext: stored.SecretCiphertext,
+		}, nil)
+
+		_, err := repo.FindForUpdate(ctx, mockDB, otherID)
+		assert.Error(t, err)
+	})
+
+	t.Run("error: no enrollment", func(t *testing.T) {
+		mockQueries.EXPECT().GetUserMFAForUpdate(ctx, mockDB, userID).Return(sqlc.GetUserMFAForUpdateRow{}, pgx.ErrNoRows)
+
+		_, err := repo.FindForUpdate(ctx, mockDB, userID)
+		assert.True(t, infra.IsKind(err, infra.KindNotFound), "got %v", err)
+	})
+
+	t.Run("error: spent recovery code", func(t *testing.T) {
+		mockQueries.EXPECT().UseUserMFARecoveryCode(ctx, mockDB, gomock.Any()).Return(uuid.Nil, pgx.ErrNoRows)
+
+		err := repo.UseRecoveryCode(ctx, mockDB, userID, "hash", now)
+		assert.True(t, infra.IsKind(err, infra.KindNotFound), "got %v", err)
+	})
+}
@@ -5,15 +5,19 @@ package repository
 import (
 	"context"
 	"testing"
+	"time"
 
 	"gin-clean-starter/internal/infra"
 	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/crypto"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 type MockUserWriteQueries struct {
@@ -30,6 +34,46 @@ func (m *MockUserWriteQueries) CreateUser(ctx context.Context, db sqlc.DBTX, arg
 	return args.Get(0).(uuid.UUID), args.Error(1)
 }
 
+func (m *MockUserWriteQueries) CreateInactiveUser(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateInactiveUserParams) (uuid.UUID, error) {
+	args := m.Called(ctx, db, arg)
+	return args.Get(0).(uuid.UUID), args.Error(1)
+}
+
+func (m *MockUserWriteQueries) ActivateUser(ctx context.Context, db sqlc.DBTX, id uuid.UUID) error {
+	args := m.Called(ctx, db, id)
+	return args.Error(0)
+}
+
+func (m *MockUserWriteQueries) GetUserPasswordHashForUpdate(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (string, error) {
+	args := m.Called(ctx, db, id)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockUserWriteQueries) SetUserPassword(ctx context.Context, db sqlc.DBTX, arg sqlc.SetUserPasswordParams) error {
+	args := m.Called(ctx, db, arg)
+	return args.Error(0)
+}
+
+func (m *MockUserWriteQueries) SetUserPhone(ctx context.Context, db sqlc.DBTX, arg sqlc.SetUserPhoneParams) error {
+	args := m.Called(ctx, db, arg)
+	return args.Error(0)
+}
+
+func (m *MockUserWriteQueries) SetUserExternalID(ctx context.Context, db sqlc.DBTX, arg sqlc.SetUserExternalIDParams) error {
+	args := m.Called(ctx, db, arg)
+	return args.Error(0)
+}
+
+func (m *MockUserWriteQueries) SetUserRole(ctx context.Context, db sqlc.DBTX, arg sqlc.SetUserRoleParams) error {
+	args := m.Called(ctx, db, arg)
+	return args.Error(0)
+}
+
+func (m *MockUserWriteQueries) TouchUserDevice(ctx context.Context, db sqlc.DBTX, arg sqlc.TouchUserDeviceParams) (bool, error) {
+	args := m.Called(ctx, db, arg)
+	return args.Bool(0), args.Error(1)
+}
+
 // sqlc.DBTX implementation for MockUserWriteQueries
 func (m *MockUserWriteQueries) Exec(ctx context.Context, query string, args ...interface{}) (pgconn.CommandTag, error) {
 	mockArgs := m.Called(ctx, query, args)
@@ -46,6 +90,16 @@ func (m *MockUserWriteQueries) QueryRow(ctx context.Context, query string, args
 	return mockArgs.Get(0).(pgx.Row)
 }
 
+func (m *MockUserWriteQueries) CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
+	mockArgs := m.Called(ctx, tableName, columnNames, rowSrc)
+	return mockArgs.Get(0).(int64), mockArgs.Error(1)
+}
+
+func (m *MockUserWriteQueries) SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults {
+	mockArgs := m.Called(ctx, b)
+	return mockArgs.Get(0).(pgx.BatchResults)
+}
+
 func TestUpdateLastLogin(t *testing.T) {
 	testUserID := uuid.New()
 
@@ -74,7 +128,7 @@ func TestUpdateLastLogin(t *testing.T) {
 			mockQueries := new(MockUserWriteQueries)
 			mockQueries.On("UpdateUserLastLogin", mock.Anything, mock.Anything, tt.userID).Return(tt.mockError)
 
-			repo := NewUserRepository(mockQueries)
+			repo := NewUserRepository(mockQueries, nil)
 
 			err := repo.UpdateLastLogin(context.Background(), mockQueries, tt.userID)
 
@@ -89,3 +143,189 @@ func TestUpdateLastLogin(t *testing.T) {
 		})
 	}
 }
+
+func TestSetPhone(t *testing.T) {
+	keys, err := crypto.ParseKeys("k1:dGVzdC1jb2x1bW4tZW5jcnlwdGlvbi1rZXktMzJieXQ=")
+	require.NoError(t, err)
+	envelope, err := crypto.NewEnvelope(keys, "k1")
+	require.NoError(t, err)
+	noKeys, err := crypto.NewEnvelope(nil, "")
+	require.NoError(t, err)
+
+	t.Run("success: stores ciphertext bound to the user", func(t *testing.T) {
+		userID := uuid.New()
+		var stored sqlc.SetUserPhoneParams
+		mockQueries := new(MockUserWriteQueries)
+		mockQueries.On("SetUserPhone", mock.Anything, mock.Anything, mock.AnythingOfType("sqlc.SetUserPhoneParams")).
+			Run(func(args mock.Arguments) { stored = args.Get(2).(sqlc.SetUserPhoneParams) }).
+			Return(nil)
+
+		err := NewUserRepository(mockQueries, envelope).SetPhone(context.Background(), mockQueries, userID, "+81312345678")
+		require.NoError(t, err)
+
+		assert.Equal(t, userID, stored.ID)
+		assert.NotContains(t, stored.PhoneCiphertext.String, "312345678")
+		phone, err := envelope.DecryptString(stored.PhoneCiphertext.String, infra.UserPhoneAAD(userID))
+		require.NoError(t, err)
+		assert.Equal(t, "+81312345678", phone)
+		_, err = envelope.DecryptString(stored.PhoneCiphertext.String, infra.UserPhoneAAD(uuid.New()))
+		assert.Error(t, err, "ciphertext must not decrypt for another user")
+	})
+
+	t.Run("error: no active key", func(t *testing.T) {
+		mockQueries := new(MockUserWriteQueries)
+
+		err := NewUserRepository(mockQueries, noKeys).SetPhone(context.Background(), mockQueries, uuid.New(), "+81312345678")
+		require.Error(t, err)
+		assert.ErrorIs(t, err, crypto.ErrNoActiveKey)
+		mockQueries.AssertNotCalled(t, "SetUserPhone", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestCreate(t *testing.T) {
+	params := sqlc.CreateUserParams{Email: "owner@example.com", PasswordHash: "hash", Role: "owner"}
+
+	t.Run("success: retries with a fresh code when the referral code is taken", func(t *testing.T) {
+		userID := uuid.New()
+		var codes []string
+		mockQueries := new(MockUserWriteQueries)
+		record := func(args mock.Arguments) { codes = append(codes, args.Get(2).(sqlc.CreateUserParams).ReferralCode) }
+		mockQueries.On("CreateUser", mock.Anything, mock.Anything, mock.AnythingOfType("sqlc.CreateUserParams")).
+			Run(record).Return(uuid.Nil, pgx.ErrNoRows).Once()
+		mockQueries.On("CreateUser", mock.Anything, mock.Anything, mock.AnythingOfType("sqlc.CreateUserParams")).
+			Run(record).Return(userID, nil).Once()
+
+		id, err := NewUserRepository(mockQueries, nil).Create(context.Background(), mockQueries, params)
+		require.NoError(t, err)
+		assert.Equal(t, userID, id)
+		require.Len(t, codes, 2)
+		assert.Len(t, codes[1], 10)
+		assert.NotEqual(t, codes[0], codes[1])
+		mockQueries.AssertExpectations(t)
+	})
+
+	t.Run("error: taken email is a duplicate key and is not retried", func(t *testing.T) {
+		mockQueries := new(MockUserWriteQueries)
+		mockQueries.On("CreateUser", mock.Anything, mock.Anything, mock.AnythingOfType("sqlc.CreateUserParams")).
+			Return(uuid.Nil, &pgconn.PgError{Code: "23505"}).Once()
+
+		_, err := NewUserRepository(mockQueries, nil).Create(context.Background(), mockQueries, params)
+		require.Error(t, err)
+		assert.True(t, infra.IsKind(err, infra.KindDuplicateKey), "got %v", err)
+		mockQueries.AssertNumberOfCalls(t, "CreateUser", 1)
+	})
+
+	t.Run("error: every generated code is taken", func(t *testing.T) {
+		mockQueries := new(MockUserWriteQueries)
+		mockQueries.On("CreateUser", mock.Anything, mock.Anything, mock.AnythingOfType("sqlc.CreateUserParams")).
+			Return(uuid.Nil, pgx.ErrNoRows)
+
+		_, err := NewUserRepository(mockQueries, nil).Create(context.Background(), mockQueries, params)
+		require.Error(t, err)
+		assert.True(t, infra.IsKind(err, infra.KindConflict), "got %v", err)
+		mockQueries.AssertNumberOfCalls(t, "CreateUser", maxReferralCodeAttempts)
+	})
+}
+
+func TestCreateInactive(t *testing.T) {
+	params := sqlc.CreateUserParams{Email: "new@example.com", PasswordHash: "hash", Role: "viewer"}
+
+	t.Run("success: inserts through the inactive query with a referral code", func(t *testing.T) {
+		userID := uuid.New()
+		var stored sqlc.CreateInactiveUserParams
+		mockQueries := new(MockUserWriteQueries)
+		mockQueries.On("CreateInactiveUser", mock.Anything, mock.Anything, mock.AnythingOfType("sqlc.CreateInactiveUserParams")).
+			Run(func(args mock.Arguments) { stored = args.Get(2).(sqlc.CreateInactiveUserParams) }).
+			Return(userID, nil).Once()
+
+		id, err := NewUserRepository(mockQueries, nil).CreateInactive(context.Background(), mockQueries, params)
+		require.NoError(t, err)
+		assert.Equal(t, userID, id)
+		assert.Equal(t, params.Email, stored.Email)
+		assert.Len(t, stored.ReferralCode, 10)
+		mockQueries.AssertNotCalled(t, "CreateUser", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("error: taken email is a duplicate key", func(t *testing.T) {
+		mockQueries := new(MockUserWriteQueries)
+		mockQueries.On("CreateInactiveUser", mock.Anything, mock.Anything, mock.AnythingOfType("sqlc.CreateInactiveUserParams")).
+			Return(uuid.Nil, &pgconn.PgError{Code: "23505"}).Once()
+
+		_, err := NewUserRepository(mockQueries, nil).CreateInactive(context.Background(), mockQueries, params)
+		require.Error(t, err)
+		assert.True(t, infra.IsKind(err, infra.KindDuplicateKey), "got %v", err)
+	})
+}
+
+func TestPasswordHashForUpdate(t *testing.T) {
+	userID := uuid.New()
+
+	tests := []struct {
+		name       string
+		hash       string
+		mockError  error
+		expectKind infra.RepositoryErrorKind
+	}{
+		{name: "success", hash: "hash"},
+		{name: "user not found", mockError: pgx.ErrNoRows, expectKind: infra.KindNotFound},
+		{name: "database error", mockError: assert.AnError, expectKind: infra.KindDBFailure},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockQueries := new(MockUserWriteQueries)
+			mockQueries.On("GetUserPasswordHashForUpdate", mock.Anything, mock.Anything, userID).Return(tt.hash, tt.mockError)
+
+			hash, err := NewUserRepository(mockQueries, nil).PasswordHashForUpdate(context.Background(), mockQueries, userID)
+
+			if tt.expectKind != "" {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, tt.expectKind), "got %v", err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.hash, hash)
+			}
+			mockQueries.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTouchDevice(t *testing.T) {
+	userID := uuid.New()
+	seenAt := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	params := sqlc.TouchUserDeviceParams{
+		UserID:      userID,
+		Fingerprint: "fp",
+		SeenAt:      pgtype.Timestamptz{Time: seenAt, Valid: true},
+	}
+
+	tests := []struct {
+		name      string
+		inserted  bool
+		mockError error
+		wantNew   bool
+		wantError bool
+	}{
+		{name: "success: first sighting", inserted: true, wantNew: true},
+		{name: "success: known device", inserted: false, wantNew: false},
+		{name: "database error", mockError: assert.AnError, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockQueries := new(MockUserWriteQueries)
+			mockQueries.On("TouchUserDevice", mock.Anything, mock.Anything, params).Return(tt.inserted, tt.mockError)
+
+			isNew, err := NewUserRepository(mockQueries, nil).TouchDevice(context.Background(), mockQueries, userID, "fp", seenAt)
+
+			if tt.wantError {
+				require.Error(t, err)
+				assert.True(t, infra.IsKind(err, infra.KindDBFailure))
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantNew, isNew)
+			}
+			mockQueries.AssertExpectations(t)
+		})
+	}
+}
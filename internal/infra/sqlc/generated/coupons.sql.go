@@ -20,7 +20,8 @@ SELECT
     valid_from,
     valid_to,
     created_at,
-    updated_at
+    updated_at,
+    priority
 FROM coupons 
 WHERE code = $1
 `
@@ -37,6 +38,7 @@ func (q *Queries) GetCouponByCode(ctx context.Context, db DBTX, code string) (Co
 		&i.ValidTo,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Priority,
 	)
 	return i, err
 }
@@ -50,7 +52,8 @@ SELECT
     valid_from,
     valid_to,
     created_at,
-    updated_at
+    updated_at,
+    priority
 FROM coupons 
 WHERE id = $1
 `
@@ -67,6 +70,7 @@ func (q *Queries) GetCouponByID(ctx context.Context, db DBTX, id uuid.UUID) (Cou
 		&i.ValidTo,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Priority,
 	)
 	return i, err
 }
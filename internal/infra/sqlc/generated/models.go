@@ -9,6 +9,44 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type ApiKeys struct {
+	ID        uuid.UUID          `json:"id"`
+	UserID    uuid.UUID          `json:"user_id"`
+	Name      string             `json:"name"`
+	KeyHash   []byte             `json:"key_hash"`
+	Scopes    []string           `json:"scopes"`
+	CreatedBy pgtype.UUID        `json:"created_by"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
+	RevokedAt pgtype.Timestamptz `json:"revoked_at"`
+}
+
+type ApiUsage struct {
+	CompanyID    uuid.UUID          `json:"company_id"`
+	Month        pgtype.Date        `json:"month"`
+	RequestCount int64              `json:"request_count"`
+	BytesIn      int64              `json:"bytes_in"`
+	BytesOut     int64              `json:"bytes_out"`
+	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+}
+
+type AuditLogs struct {
+	ID         uuid.UUID          `json:"id"`
+	ActorID    pgtype.UUID        `json:"actor_id"`
+	CompanyID  pgtype.UUID        `json:"company_id"`
+	Action     string             `json:"action"`
+	TargetType string             `json:"target_type"`
+	TargetID   string             `json:"target_id"`
+	Metadata   []byte             `json:"metadata"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
+type BillingEvents struct {
+	ProviderEventID string             `json:"provider_event_id"`
+	EventType       string             `json:"event_type"`
+	ReceivedAt      pgtype.Timestamptz `json:"received_at"`
+}
+
 type Companies struct {
 	ID        uuid.UUID          `json:"id"`
 	Name      string             `json:"name"`
@@ -16,6 +54,85 @@ type Companies struct {
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
 }
 
+type CompanyDeletions struct {
+	ID          uuid.UUID          `json:"id"`
+	CompanyID   uuid.UUID          `json:"company_id"`
+	CompanyName string             `json:"company_name"`
+	RequestedBy pgtype.UUID        `json:"requested_by"`
+	Status      string             `json:"status"`
+	Stage       string             `json:"stage"`
+	Counts      []byte             `json:"counts"`
+	Attempts    int32              `json:"attempts"`
+	Error       pgtype.Text        `json:"error"`
+	Certificate []byte             `json:"certificate"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+	StartedAt   pgtype.Timestamptz `json:"started_at"`
+	CompletedAt pgtype.Timestamptz `json:"completed_at"`
+}
+
+type CompanyExports struct {
+	ID          uuid.UUID          `json:"id"`
+	CompanyID   uuid.UUID          `json:"company_id"`
+	RequestedBy pgtype.UUID        `json:"requested_by"`
+	Status      string             `json:"status"`
+	Progress    int32              `json:"progress"`
+	StorageKey  pgtype.Text        `json:"storage_key"`
+	SizeBytes   pgtype.Int8        `json:"size_bytes"`
+	Error       pgtype.Text        `json:"error"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	StartedAt   pgtype.Timestamptz `json:"started_at"`
+	CompletedAt pgtype.Timestamptz `json:"completed_at"`
+	ExpiresAt   pgtype.Timestamptz `json:"expires_at"`
+}
+
+type CompanyFeatures struct {
+	CompanyID uuid.UUID          `json:"company_id"`
+	Feature   string             `json:"feature"`
+	Enabled   bool               `json:"enabled"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+}
+
+type CompanyPlans struct {
+	CompanyID           uuid.UUID   `json:"company_id"`
+	PlanID              string      `json:"plan_id"`
+	Status              string      `json:"status"`
+	MaxResources        pgtype.Int4 `json:"max_resources"`
+	MaxOperators        pgtype.Int4 `json:"max_operators"`
+	MonthlyRequestQuota pgtype.Int8 `json:"monthly_request_quota"`
+}
+
+type CompanySamlConnections struct {
+	CompanyID      uuid.UUID          `json:"company_id"`
+	IdpEntityID    string             `json:"idp_entity_id"`
+	IdpMetadata    string             `json:"idp_metadata"`
+	EmailAttribute pgtype.Text        `json:"email_attribute"`
+	RoleAttribute  pgtype.Text        `json:"role_attribute"`
+	RoleMapping    []byte             `json:"role_mapping"`
+	DefaultRole    string             `json:"default_role"`
+	UpdatedBy      pgtype.UUID        `json:"updated_by"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+}
+
+type CompanySettings struct {
+	CompanyID               uuid.UUID          `json:"company_id"`
+	Timezone                string             `json:"timezone"`
+	DefaultLeadTimeMin      int32              `json:"default_lead_time_min"`
+	CreatedAt               pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt               pgtype.Timestamptz `json:"updated_at"`
+	MonthlyRequestSoftQuota pgtype.Int8        `json:"monthly_request_soft_quota"`
+	MonthlyRequestHardQuota pgtype.Int8        `json:"monthly_request_hard_quota"`
+	TelemetryOptOut         bool               `json:"telemetry_opt_out"`
+	ReviewOpensAfterMin     pgtype.Int4        `json:"review_opens_after_min"`
+	ReviewWindowDays        pgtype.Int4        `json:"review_window_days"`
+	BrandLogoUrl            pgtype.Text        `json:"brand_logo_url"`
+	BrandPrimaryColor       pgtype.Text        `json:"brand_primary_color"`
+	BrandAccentColor        pgtype.Text        `json:"brand_accent_color"`
+	BrandReplyTo            pgtype.Text        `json:"brand_reply_to"`
+	BrandFooter             pgtype.Text        `json:"brand_footer"`
+}
+
 type Coupons struct {
 	ID             uuid.UUID          `json:"id"`
 	Code           string             `json:"code"`
@@ -25,6 +142,41 @@ type Coupons struct {
 	ValidTo        pgtype.Timestamptz `json:"valid_to"`
 	CreatedAt      pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+	Priority       int32              `json:"priority"`
+}
+
+type CustomFieldDefinitions struct {
+	ID         uuid.UUID          `json:"id"`
+	CompanyID  uuid.UUID          `json:"company_id"`
+	ResourceID pgtype.UUID        `json:"resource_id"`
+	Key        string             `json:"key"`
+	Label      string             `json:"label"`
+	FieldType  string             `json:"field_type"`
+	Required   bool               `json:"required"`
+	Options    []string           `json:"options"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
+type DeprecatedRouteCalls struct {
+	Day          pgtype.Date        `json:"day"`
+	Route        string             `json:"route"`
+	UserID       pgtype.UUID        `json:"user_id"`
+	CallCount    int64              `json:"call_count"`
+	LastCalledAt pgtype.Timestamptz `json:"last_called_at"`
+}
+
+type EmailVerifications struct {
+	UserID    uuid.UUID          `json:"user_id"`
+	Nonce     uuid.UUID          `json:"nonce"`
+	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type FeatureUsage struct {
+	Day          pgtype.Date `json:"day"`
+	Feature      string      `json:"feature"`
+	CompanyHash  string      `json:"company_hash"`
+	RequestCount int64       `json:"request_count"`
 }
 
 type IdempotencyKeys struct {
@@ -40,6 +192,41 @@ type IdempotencyKeys struct {
 	UpdatedAt           pgtype.Timestamptz `json:"updated_at"`
 }
 
+type Invites struct {
+	ID             uuid.UUID          `json:"id"`
+	Email          string             `json:"email"`
+	CompanyID      uuid.UUID          `json:"company_id"`
+	Role           string             `json:"role"`
+	InvitedBy      uuid.UUID          `json:"invited_by"`
+	Nonce          uuid.UUID          `json:"nonce"`
+	Status         string             `json:"status"`
+	ExpiresAt      pgtype.Timestamptz `json:"expires_at"`
+	AcceptedUserID pgtype.UUID        `json:"accepted_user_id"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+}
+
+type LoginFailures struct {
+	Email          string             `json:"email"`
+	IpAddress      string             `json:"ip_address"`
+	FailedAttempts int32              `json:"failed_attempts"`
+	LockedUntil    pgtype.Timestamptz `json:"locked_until"`
+	ExpiresAt      pgtype.Timestamptz `json:"expires_at"`
+}
+
+type LoyaltyLedger struct {
+	ID              uuid.UUID          `json:"id"`
+	UserID          uuid.UUID          `json:"user_id"`
+	Kind            string             `json:"kind"`
+	Points          int32              `json:"points"`
+	RemainingPoints pgtype.Int4        `json:"remaining_points"`
+	ExpiresAt       pgtype.Timestamptz `json:"expires_at"`
+	ReservationID   pgtype.UUID        `json:"reservation_id"`
+	LotID           pgtype.UUID        `json:"lot_id"`
+	DiscountCents   pgtype.Int4        `json:"discount_cents"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+}
+
 type NotificationJobs struct {
 	ID        uuid.UUID          `json:"id"`
 	Kind      string             `json:"kind"`
@@ -51,31 +238,204 @@ type NotificationJobs struct {
 	LastError pgtype.Text        `json:"last_error"`
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+	UserID    pgtype.UUID        `json:"user_id"`
+}
+
+type Permissions struct {
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
+type Plans struct {
+	ID                  string             `json:"id"`
+	Name                string             `json:"name"`
+	MaxResources        pgtype.Int4        `json:"max_resources"`
+	MaxOperators        pgtype.Int4        `json:"max_operators"`
+	MonthlyRequestQuota pgtype.Int8        `json:"monthly_request_quota"`
+	CreatedAt           pgtype.Timestamptz `json:"created_at"`
+}
+
+type PlatformStats struct {
+	ID             bool               `json:"id"`
+	TotalResources int64              `json:"total_resources"`
+	TotalReviews   int64              `json:"total_reviews"`
+	AverageRating  pgtype.Numeric     `json:"average_rating"`
+	ComputedAt     pgtype.Timestamptz `json:"computed_at"`
+}
+
+type ProvisioningTokens struct {
+	ID        uuid.UUID          `json:"id"`
+	CompanyID uuid.UUID          `json:"company_id"`
+	TokenHash []byte             `json:"token_hash"`
+	CreatedBy pgtype.UUID        `json:"created_by"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	RevokedAt pgtype.Timestamptz `json:"revoked_at"`
+}
+
+type RecordedRequests struct {
+	RecordingID uuid.UUID          `json:"recording_id"`
+	Seq         int32              `json:"seq"`
+	Method      string             `json:"method"`
+	Path        string             `json:"path"`
+	Status      int32              `json:"status"`
+	DurationMs  int32              `json:"duration_ms"`
+	StorageKey  string             `json:"storage_key"`
+	RecordedAt  pgtype.Timestamptz `json:"recorded_at"`
+}
+
+type ReferralCredits struct {
+	ID          uuid.UUID          `json:"id"`
+	ReferralID  uuid.UUID          `json:"referral_id"`
+	UserID      uuid.UUID          `json:"user_id"`
+	Party       string             `json:"party"`
+	AmountCents int32              `json:"amount_cents"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
+type Referrals struct {
+	ID            uuid.UUID          `json:"id"`
+	ReferrerID    uuid.UUID          `json:"referrer_id"`
+	ReferredID    uuid.UUID          `json:"referred_id"`
+	Status        string             `json:"status"`
+	ReservationID pgtype.UUID        `json:"reservation_id"`
+	RewardedAt    pgtype.Timestamptz `json:"rewarded_at"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+}
+
+type RefreshTokens struct {
+	ID        uuid.UUID          `json:"id"`
+	SessionID uuid.UUID          `json:"session_id"`
+	UserID    uuid.UUID          `json:"user_id"`
+	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
+	RotatedAt pgtype.Timestamptz `json:"rotated_at"`
+	RevokedAt pgtype.Timestamptz `json:"revoked_at"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type RequestRecordings struct {
+	ID               uuid.UUID          `json:"id"`
+	UserID           uuid.UUID          `json:"user_id"`
+	EnabledBy        pgtype.UUID        `json:"enabled_by"`
+	Reason           string             `json:"reason"`
+	ConsentReference string             `json:"consent_reference"`
+	MaxRequests      int32              `json:"max_requests"`
+	RecordedCount    int32              `json:"recorded_count"`
+	ExpiresAt        pgtype.Timestamptz `json:"expires_at"`
+	CreatedAt        pgtype.Timestamptz `json:"created_at"`
+}
+
+type ReservationApprovals struct {
+	ReservationID uuid.UUID          `json:"reservation_id"`
+	Status        string             `json:"status"`
+	ExpiresAt     pgtype.Timestamptz `json:"expires_at"`
+	EscalatedAt   pgtype.Timestamptz `json:"escalated_at"`
+	DecidedBy     pgtype.UUID        `json:"decided_by"`
+	DecidedAt     pgtype.Timestamptz `json:"decided_at"`
+	Reason        pgtype.Text        `json:"reason"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+}
+
+type ReservationAttachments struct {
+	ID            uuid.UUID          `json:"id"`
+	ReservationID uuid.UUID          `json:"reservation_id"`
+	UploadedBy    uuid.UUID          `json:"uploaded_by"`
+	UploaderSide  string             `json:"uploader_side"`
+	Filename      string             `json:"filename"`
+	ContentType   string             `json:"content_type"`
+	SizeBytes     int64              `json:"size_bytes"`
+	StorageKey    string             `json:"storage_key"`
+	OperatorOnly  bool               `json:"operator_only"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+}
+
+type ReservationDiscounts struct {
+	ReservationID uuid.UUID `json:"reservation_id"`
+	Position      int16     `json:"position"`
+	CouponID      uuid.UUID `json:"coupon_id"`
+	AmountCents   int32     `json:"amount_cents"`
+}
+
+type ReservationMessageReads struct {
+	ReservationID uuid.UUID          `json:"reservation_id"`
+	Side          string             `json:"side"`
+	LastReadAt    pgtype.Timestamptz `json:"last_read_at"`
+}
+
+type ReservationMessages struct {
+	ID             uuid.UUID          `json:"id"`
+	ReservationID  uuid.UUID          `json:"reservation_id"`
+	AuthorID       uuid.UUID          `json:"author_id"`
+	AuthorSide     string             `json:"author_side"`
+	Body           pgtype.Text        `json:"body"`
+	BodyCiphertext pgtype.Text        `json:"body_ciphertext"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+}
+
+type ReservationTransfers struct {
+	ID            uuid.UUID          `json:"id"`
+	ReservationID uuid.UUID          `json:"reservation_id"`
+	FromUserID    uuid.UUID          `json:"from_user_id"`
+	ToUserID      uuid.UUID          `json:"to_user_id"`
+	InitiatedBy   uuid.UUID          `json:"initiated_by"`
+	Nonce         uuid.UUID          `json:"nonce"`
+	Status        string             `json:"status"`
+	ExpiresAt     pgtype.Timestamptz `json:"expires_at"`
+	AcceptedAt    pgtype.Timestamptz `json:"accepted_at"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
 }
 
 type Reservations struct {
-	ID         uuid.UUID          `json:"id"`
+	ID           uuid.UUID          `json:"id"`
+	ResourceID   uuid.UUID          `json:"resource_id"`
+	UserID       uuid.UUID          `json:"user_id"`
+	Slot         string             `json:"slot"`
+	Status       string             `json:"status"`
+	PriceCents   int32              `json:"price_cents"`
+	CouponID     pgtype.UUID        `json:"coupon_id"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+	GroupID      pgtype.UUID        `json:"group_id"`
+	CustomFields []byte             `json:"custom_fields"`
+}
+
+type ResourceApprovers struct {
 	ResourceID uuid.UUID          `json:"resource_id"`
 	UserID     uuid.UUID          `json:"user_id"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
+type ResourceBlocks struct {
+	ID         uuid.UUID          `json:"id"`
+	ResourceID uuid.UUID          `json:"resource_id"`
+	SeriesID   uuid.UUID          `json:"series_id"`
 	Slot       string             `json:"slot"`
-	Status     string             `json:"status"`
-	PriceCents int32              `json:"price_cents"`
-	CouponID   pgtype.UUID        `json:"coupon_id"`
-	Note       pgtype.Text        `json:"note"`
+	Reason     string             `json:"reason"`
+	CreatedBy  uuid.UUID          `json:"created_by"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
+type ResourceOperators struct {
+	ResourceID uuid.UUID          `json:"resource_id"`
+	UserID     uuid.UUID          `json:"user_id"`
 	CreatedAt  pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
 }
 
 type ResourceRatingStats struct {
-	ResourceID    uuid.UUID          `json:"resource_id"`
-	TotalReviews  int32              `json:"total_reviews"`
-	AverageRating pgtype.Numeric     `json:"average_rating"`
-	Rating1Count  int32              `json:"rating_1_count"`
-	Rating2Count  int32              `json:"rating_2_count"`
-	Rating3Count  int32              `json:"rating_3_count"`
-	Rating4Count  int32              `json:"rating_4_count"`
-	Rating5Count  int32              `json:"rating_5_count"`
-	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+	ResourceID         uuid.UUID          `json:"resource_id"`
+	TotalReviews       int32              `json:"total_reviews"`
+	AverageRating      pgtype.Numeric     `json:"average_rating"`
+	Rating1Count       int32              `json:"rating_1_count"`
+	Rating2Count       int32              `json:"rating_2_count"`
+	Rating3Count       int32              `json:"rating_3_count"`
+	Rating4Count       int32              `json:"rating_4_count"`
+	Rating5Count       int32              `json:"rating_5_count"`
+	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+	Summary            pgtype.Text        `json:"summary"`
+	SummaryReviewCount pgtype.Int4        `json:"summary_review_count"`
+	SummaryUpdatedAt   pgtype.Timestamptz `json:"summary_updated_at"`
 }
 
 type Resources struct {
@@ -84,27 +444,145 @@ type Resources struct {
 	LeadTimeMin int32              `json:"lead_time_min"`
 	CreatedAt   pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+	CompanyID   pgtype.UUID        `json:"company_id"`
+	Slug        pgtype.Text        `json:"slug"`
 }
 
 type Reviews struct {
 	ID            uuid.UUID          `json:"id"`
 	UserID        uuid.UUID          `json:"user_id"`
 	ResourceID    uuid.UUID          `json:"resource_id"`
-	ReservationID uuid.UUID          `json:"reservation_id"`
+	ReservationID pgtype.UUID        `json:"reservation_id"`
 	Rating        int32              `json:"rating"`
 	Comment       string             `json:"comment"`
 	CreatedAt     pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+	Status        string             `json:"status"`
+	DuplicateOf   pgtype.UUID        `json:"duplicate_of"`
+	Language      pgtype.Text        `json:"language"`
+	ImportSource  pgtype.Text        `json:"import_source"`
+	ExternalID    pgtype.Text        `json:"external_id"`
+}
+
+type RevokedTokens struct {
+	Jti       uuid.UUID          `json:"jti"`
+	UserID    uuid.UUID          `json:"user_id"`
+	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
+	RevokedAt pgtype.Timestamptz `json:"revoked_at"`
+}
+
+type RolePermissions struct {
+	RoleName       string             `json:"role_name"`
+	PermissionName string             `json:"permission_name"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+}
+
+type Roles struct {
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	IsSystem    bool               `json:"is_system"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+}
+
+type Subscriptions struct {
+	CompanyID              uuid.UUID          `json:"company_id"`
+	PlanID                 string             `json:"plan_id"`
+	Status                 string             `json:"status"`
+	ProviderCustomerID     pgtype.Text        `json:"provider_customer_id"`
+	ProviderSubscriptionID pgtype.Text        `json:"provider_subscription_id"`
+	CurrentPeriodEnd       pgtype.Timestamptz `json:"current_period_end"`
+	ProviderUpdatedAt      pgtype.Timestamptz `json:"provider_updated_at"`
+	CreatedAt              pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt              pgtype.Timestamptz `json:"updated_at"`
+}
+
+type TosAcceptances struct {
+	UserID       uuid.UUID          `json:"user_id"`
+	TosVersionID uuid.UUID          `json:"tos_version_id"`
+	AcceptedAt   pgtype.Timestamptz `json:"accepted_at"`
+	IpAddress    pgtype.Text        `json:"ip_address"`
+}
+
+type TosVersions struct {
+	ID          uuid.UUID          `json:"id"`
+	Version     string             `json:"version"`
+	Url         string             `json:"url"`
+	PublishedAt pgtype.Timestamptz `json:"published_at"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
+type UserActivity struct {
+	ID         uuid.UUID          `json:"id"`
+	UserID     uuid.UUID          `json:"user_id"`
+	Kind       string             `json:"kind"`
+	SubjectID  uuid.UUID          `json:"subject_id"`
+	ResourceID pgtype.UUID        `json:"resource_id"`
+	Data       []byte             `json:"data"`
+	OccurredAt pgtype.Timestamptz `json:"occurred_at"`
+}
+
+type UserDevices struct {
+	UserID      uuid.UUID          `json:"user_id"`
+	Fingerprint string             `json:"fingerprint"`
+	FirstSeenAt pgtype.Timestamptz `json:"first_seen_at"`
+	LastSeenAt  pgtype.Timestamptz `json:"last_seen_at"`
+}
+
+type UserIdentities struct {
+	Provider    string             `json:"provider"`
+	Subject     string             `json:"subject"`
+	UserID      uuid.UUID          `json:"user_id"`
+	Email       string             `json:"email"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	LastLoginAt pgtype.Timestamptz `json:"last_login_at"`
+}
+
+type UserMfa struct {
+	UserID           uuid.UUID          `json:"user_id"`
+	SecretCiphertext string             `json:"secret_ciphertext"`
+	EnabledAt        pgtype.Timestamptz `json:"enabled_at"`
+	LastUsedStep     int64              `json:"last_used_step"`
+	FailedAttempts   int32              `json:"failed_attempts"`
+	LockedUntil      pgtype.Timestamptz `json:"locked_until"`
+	CreatedAt        pgtype.Timestamptz `json:"created_at"`
+}
+
+type UserMfaRecoveryCodes struct {
+	UserID   uuid.UUID          `json:"user_id"`
+	CodeHash string             `json:"code_hash"`
+	UsedAt   pgtype.Timestamptz `json:"used_at"`
+}
+
+type UserSessionCutoffs struct {
+	UserID        uuid.UUID          `json:"user_id"`
+	RevokedBefore pgtype.Timestamptz `json:"revoked_before"`
+}
+
+type UserSessions struct {
+	ID                uuid.UUID          `json:"id"`
+	UserID            uuid.UUID          `json:"user_id"`
+	DeviceFingerprint string             `json:"device_fingerprint"`
+	IpAddress         string             `json:"ip_address"`
+	UserAgent         string             `json:"user_agent"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	LastSeenAt        pgtype.Timestamptz `json:"last_seen_at"`
+	ExpiresAt         pgtype.Timestamptz `json:"expires_at"`
+	RevokedAt         pgtype.Timestamptz `json:"revoked_at"`
 }
 
 type Users struct {
-	ID           uuid.UUID          `json:"id"`
-	Email        string             `json:"email"`
-	PasswordHash string             `json:"password_hash"`
-	Role         string             `json:"role"`
-	CompanyID    pgtype.UUID        `json:"company_id"`
-	LastLogin    pgtype.Timestamptz `json:"last_login"`
-	IsActive     bool               `json:"is_active"`
-	CreatedAt    pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+	ID                 uuid.UUID          `json:"id"`
+	Email              string             `json:"email"`
+	PasswordHash       string             `json:"password_hash"`
+	Role               string             `json:"role"`
+	CompanyID          pgtype.UUID        `json:"company_id"`
+	LastLogin          pgtype.Timestamptz `json:"last_login"`
+	IsActive           bool               `json:"is_active"`
+	CreatedAt          pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+	ReferralCode       string             `json:"referral_code"`
+	PhoneCiphertext    pgtype.Text        `json:"phone_ciphertext"`
+	ExternalID         pgtype.Text        `json:"external_id"`
+	NotificationDigest string             `json:"notification_digest"`
 }
@@ -43,6 +43,50 @@ func (q *Queries) CreateNotificationJob(ctx context.Context, db DBTX, arg Create
 	return err
 }
 
+const createUserNotificationJob = `-- name: CreateUserNotificationJob :exec
+INSERT INTO notification_jobs (
+    user_id,
+    kind,
+    topic,
+    payload,
+    run_at,
+    status
+) VALUES (
+    $1::uuid,
+    $2,
+    $3,
+    $4,
+    $5,
+    CASE
+        WHEN $6::boolean
+         AND (SELECT notification_digest FROM users WHERE id = $1::uuid) <> 'off' THEN 'held'
+        ELSE 'queued'
+    END
+)
+`
+
+type CreateUserNotificationJobParams struct {
+	UserID     uuid.UUID          `json:"user_id"`
+	Kind       string             `json:"kind"`
+	Topic      string             `json:"topic"`
+	Payload    []byte             `json:"payload"`
+	RunAt      pgtype.Timestamptz `json:"run_at"`
+	Digestible bool               `json:"digestible"`
+}
+
+// A digestible job for a user who takes a digest is held for it rather than queued.
+func (q *Queries) CreateUserNotificationJob(ctx context.Context, db DBTX, arg CreateUserNotificationJobParams) error {
+	_, err := db.Exec(ctx, createUserNotificationJob,
+		arg.UserID,
+		arg.Kind,
+		arg.Topic,
+		arg.Payload,
+		arg.RunAt,
+		arg.Digestible,
+	)
+	return err
+}
+
 const getPendingNotificationJobs = `-- name: GetPendingNotificationJobs :many
 SELECT 
     id,
@@ -54,7 +98,8 @@ SELECT
     status,
     last_error,
     created_at,
-    updated_at
+    updated_at,
+    user_id
 FROM notification_jobs 
 WHERE status = 'queued' AND run_at <= NOW()
 ORDER BY run_at ASC
@@ -82,6 +127,142 @@ func (q *Queries) GetPendingNotificationJobs(ctx context.Context, db DBTX, limit
 			&i.LastError,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.UserID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listNotificationJobsFirstPage = `-- name: ListNotificationJobsFirstPage :many
+SELECT
+    id,
+    kind,
+    topic,
+    run_at,
+    attempts,
+    status,
+    last_error,
+    created_at
+FROM notification_jobs
+WHERE ($1::text IS NULL OR status = $1::text)
+  AND ($2::text IS NULL OR topic = $2::text)
+ORDER BY created_at DESC, id DESC
+LIMIT $3
+`
+
+type ListNotificationJobsFirstPageParams struct {
+	Status     pgtype.Text `json:"status"`
+	Topic      pgtype.Text `json:"topic"`
+	LimitCount int32       `json:"limit_count"`
+}
+
+type ListNotificationJobsFirstPageRow struct {
+	ID        uuid.UUID          `json:"id"`
+	Kind      string             `json:"kind"`
+	Topic     string             `json:"topic"`
+	RunAt     pgtype.Timestamptz `json:"run_at"`
+	Attempts  int32              `json:"attempts"`
+	Status    string             `json:"status"`
+	LastError pgtype.Text        `json:"last_error"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+func (q *Queries) ListNotificationJobsFirstPage(ctx context.Context, db DBTX, arg ListNotificationJobsFirstPageParams) ([]ListNotificationJobsFirstPageRow, error) {
+	rows, err := db.Query(ctx, listNotificationJobsFirstPage, arg.Status, arg.Topic, arg.LimitCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListNotificationJobsFirstPageRow
+	for rows.Next() {
+		var i ListNotificationJobsFirstPageRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Kind,
+			&i.Topic,
+			&i.RunAt,
+			&i.Attempts,
+			&i.Status,
+			&i.LastError,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listNotificationJobsKeyset = `-- name: ListNotificationJobsKeyset :many
+SELECT
+    id,
+    kind,
+    topic,
+    run_at,
+    attempts,
+    status,
+    last_error,
+    created_at
+FROM notification_jobs
+WHERE ($1::text IS NULL OR status = $1::text)
+  AND ($2::text IS NULL OR topic = $2::text)
+  AND (created_at < $3 OR (created_at = $3 AND id < $4))
+ORDER BY created_at DESC, id DESC
+LIMIT $5
+`
+
+type ListNotificationJobsKeysetParams struct {
+	Status        pgtype.Text        `json:"status"`
+	Topic         pgtype.Text        `json:"topic"`
+	LastCreatedAt pgtype.Timestamptz `json:"last_created_at"`
+	LastID        uuid.UUID          `json:"last_id"`
+	LimitCount    int32              `json:"limit_count"`
+}
+
+type ListNotificationJobsKeysetRow struct {
+	ID        uuid.UUID          `json:"id"`
+	Kind      string             `json:"kind"`
+	Topic     string             `json:"topic"`
+	RunAt     pgtype.Timestamptz `json:"run_at"`
+	Attempts  int32              `json:"attempts"`
+	Status    string             `json:"status"`
+	LastError pgtype.Text        `json:"last_error"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+func (q *Queries) ListNotificationJobsKeyset(ctx context.Context, db DBTX, arg ListNotificationJobsKeysetParams) ([]ListNotificationJobsKeysetRow, error) {
+	rows, err := db.Query(ctx, listNotificationJobsKeyset,
+		arg.Status,
+		arg.Topic,
+		arg.LastCreatedAt,
+		arg.LastID,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListNotificationJobsKeysetRow
+	for rows.Next() {
+		var i ListNotificationJobsKeysetRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Kind,
+			&i.Topic,
+			&i.RunAt,
+			&i.Attempts,
+			&i.Status,
+			&i.LastError,
+			&i.CreatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -0,0 +1,33 @@
+// Hand-written, not generated by sqlc: lists existing query constants so the
+// list breaks at compile time when a query is renamed or removed.
+
+package sqlc
+
+// hotStatements are the queries on the login, reservation create and listing
+// paths. They are prepared on every new connection so the first request served
+// by a cold connection skips the parse/describe round trip.
+var hotStatements = []string{
+	// Every UoW transaction
+	setTransactionTags,
+	// Login
+	findUserByEmail,
+	findUserByID,
+	updateUserLastLogin,
+	// Reservation create
+	getResourceByID,
+	tryInsertIdempotencyKey,
+	createReservation,
+	updateIdempotencyKeyCompleted,
+	createNotificationJob,
+	// Listings
+	getReservationsByUserIDFirstPage,
+	getReservationsByUserIDKeyset,
+	getReviewsByResourceFirstPage,
+	getReviewsByResourceKeyset,
+	getResourceRatingStats,
+}
+
+// HotStatements returns the SQL of the queries worth preparing eagerly.
+func HotStatements() []string {
+	return append([]string(nil), hotStatements...)
+}
@@ -12,6 +12,83 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const cancelReservation = `-- name: CancelReservation :execrows
+UPDATE reservations
+SET
+    status = 'canceled',
+    updated_at = NOW()
+WHERE id = $1 AND status IN ('confirmed', 'pending_approval')
+`
+
+func (q *Queries) CancelReservation(ctx context.Context, db DBTX, id uuid.UUID) (int64, error) {
+	result, err := db.Exec(ctx, cancelReservation, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const cancelReservationsInRange = `-- name: CancelReservationsInRange :many
+UPDATE reservations
+SET
+    status = 'canceled',
+    updated_at = NOW()
+WHERE resource_id = $1
+  AND status = 'confirmed'
+  AND slot && tstzrange($2::timestamptz, $3::timestamptz, '[)')
+  AND upper(slot) > $4::timestamptz
+RETURNING
+    id,
+    user_id,
+    lower(slot)::timestamptz AS start_time,
+    upper(slot)::timestamptz AS end_time
+`
+
+type CancelReservationsInRangeParams struct {
+	ResourceID uuid.UUID          `json:"resource_id"`
+	FromTime   pgtype.Timestamptz `json:"from_time"`
+	ToTime     pgtype.Timestamptz `json:"to_time"`
+	Now        pgtype.Timestamptz `json:"now"`
+}
+
+type CancelReservationsInRangeRow struct {
+	ID        uuid.UUID          `json:"id"`
+	UserID    uuid.UUID          `json:"user_id"`
+	StartTime pgtype.Timestamptz `json:"start_time"`
+	EndTime   pgtype.Timestamptz `json:"end_time"`
+}
+
+// Cancels the rows ListCancelableReservationsInRange lists.
+func (q *Queries) CancelReservationsInRange(ctx context.Context, db DBTX, arg CancelReservationsInRangeParams) ([]CancelReservationsInRangeRow, error) {
+	rows, err := db.Query(ctx, cancelReservationsInRange,
+		arg.ResourceID,
+		arg.FromTime,
+		arg.ToTime,
+		arg.Now,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CancelReservationsInRangeRow
+	for rows.Next() {
+		var i CancelReservationsInRangeRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.StartTime,
+			&i.EndTime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const createReservation = `-- name: CreateReservation :one
 INSERT INTO reservations (
     resource_id,
@@ -20,20 +97,20 @@ INSERT INTO reservations (
     status,
     price_cents,
     coupon_id,
-    note
+    custom_fields
 ) VALUES (
     $1, $2, $3, $4, $5, $6, $7
 ) RETURNING id
 `
 
 type CreateReservationParams struct {
-	ResourceID uuid.UUID   `json:"resource_id"`
-	UserID     uuid.UUID   `json:"user_id"`
-	Slot       string      `json:"slot"`
-	Status     string      `json:"status"`
-	PriceCents int32       `json:"price_cents"`
-	CouponID   pgtype.UUID `json:"coupon_id"`
-	Note       pgtype.Text `json:"note"`
+	ResourceID   uuid.UUID   `json:"resource_id"`
+	UserID       uuid.UUID   `json:"user_id"`
+	Slot         string      `json:"slot"`
+	Status       string      `json:"status"`
+	PriceCents   int32       `json:"price_cents"`
+	CouponID     pgtype.UUID `json:"coupon_id"`
+	CustomFields []byte      `json:"custom_fields"`
 }
 
 func (q *Queries) CreateReservation(ctx context.Context, db DBTX, arg CreateReservationParams) (uuid.UUID, error) {
@@ -44,13 +121,41 @@ func (q *Queries) CreateReservation(ctx context.Context, db DBTX, arg CreateRese
 		arg.Status,
 		arg.PriceCents,
 		arg.CouponID,
-		arg.Note,
+		arg.CustomFields,
 	)
 	var id uuid.UUID
 	err := row.Scan(&id)
 	return id, err
 }
 
+const createReservationDiscount = `-- name: CreateReservationDiscount :exec
+INSERT INTO reservation_discounts (
+    reservation_id,
+    position,
+    coupon_id,
+    amount_cents
+) VALUES (
+    $1, $2, $3, $4
+)
+`
+
+type CreateReservationDiscountParams struct {
+	ReservationID uuid.UUID `json:"reservation_id"`
+	Position      int16     `json:"position"`
+	CouponID      uuid.UUID `json:"coupon_id"`
+	AmountCents   int32     `json:"amount_cents"`
+}
+
+func (q *Queries) CreateReservationDiscount(ctx context.Context, db DBTX, arg CreateReservationDiscountParams) error {
+	_, err := db.Exec(ctx, createReservationDiscount,
+		arg.ReservationID,
+		arg.Position,
+		arg.CouponID,
+		arg.AmountCents,
+	)
+	return err
+}
+
 const getReservationByID = `-- name: GetReservationByID :one
 SELECT 
     r.id,
@@ -60,12 +165,13 @@ SELECT
     r.status,
     r.price_cents,
     r.coupon_id,
-    r.note,
     r.created_at,
     r.updated_at,
     res.name AS resource_name,
     u.email AS user_email,
-    c.code AS coupon_code
+    c.code AS coupon_code,
+    res.company_id AS resource_company_id,
+    r.custom_fields
 FROM reservations AS r
 INNER JOIN resources AS res ON r.resource_id = res.id
 INNER JOIN users AS u ON r.user_id = u.id
@@ -74,19 +180,20 @@ WHERE r.id = $1
 `
 
 type GetReservationByIDRow struct {
-	ID           uuid.UUID          `json:"id"`
-	ResourceID   uuid.UUID          `json:"resource_id"`
-	UserID       uuid.UUID          `json:"user_id"`
-	RSlot        string             `json:"r_slot"`
-	Status       string             `json:"status"`
-	PriceCents   int32              `json:"price_cents"`
-	CouponID     pgtype.UUID        `json:"coupon_id"`
-	Note         pgtype.Text        `json:"note"`
-	CreatedAt    pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
-	ResourceName string             `json:"resource_name"`
-	UserEmail    string             `json:"user_email"`
-	CouponCode   pgtype.Text        `json:"coupon_code"`
+	ID                uuid.UUID          `json:"id"`
+	ResourceID        uuid.UUID          `json:"resource_id"`
+	UserID            uuid.UUID          `json:"user_id"`
+	RSlot             string             `json:"r_slot"`
+	Status            string             `json:"status"`
+	PriceCents        int32              `json:"price_cents"`
+	CouponID          pgtype.UUID        `json:"coupon_id"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt         pgtype.Timestamptz `json:"updated_at"`
+	ResourceName      string             `json:"resource_name"`
+	UserEmail         string             `json:"user_email"`
+	CouponCode        pgtype.Text        `json:"coupon_code"`
+	ResourceCompanyID pgtype.UUID        `json:"resource_company_id"`
+	CustomFields      []byte             `json:"custom_fields"`
 }
 
 func (q *Queries) GetReservationByID(ctx context.Context, db DBTX, id uuid.UUID) (GetReservationByIDRow, error) {
@@ -100,16 +207,101 @@ func (q *Queries) GetReservationByID(ctx context.Context, db DBTX, id uuid.UUID)
 		&i.Status,
 		&i.PriceCents,
 		&i.CouponID,
-		&i.Note,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.ResourceName,
 		&i.UserEmail,
 		&i.CouponCode,
+		&i.ResourceCompanyID,
+		&i.CustomFields,
 	)
 	return i, err
 }
 
+const getReservationDiscounts = `-- name: GetReservationDiscounts :many
+SELECT
+    d.coupon_id,
+    c.code,
+    d.amount_cents
+FROM reservation_discounts AS d
+INNER JOIN coupons AS c ON d.coupon_id = c.id
+WHERE d.reservation_id = $1
+ORDER BY d.position
+`
+
+type GetReservationDiscountsRow struct {
+	CouponID    uuid.UUID `json:"coupon_id"`
+	Code        string    `json:"code"`
+	AmountCents int32     `json:"amount_cents"`
+}
+
+func (q *Queries) GetReservationDiscounts(ctx context.Context, db DBTX, reservationID uuid.UUID) ([]GetReservationDiscountsRow, error) {
+	rows, err := db.Query(ctx, getReservationDiscounts, reservationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetReservationDiscountsRow
+	for rows.Next() {
+		var i GetReservationDiscountsRow
+		if err := rows.Scan(
+			&i.CouponID,
+			&i.Code,
+			&i.AmountCents,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getReservationGroupID = `-- name: GetReservationGroupID :one
+SELECT group_id FROM reservations WHERE id = $1
+`
+
+func (q *Queries) GetReservationGroupID(ctx context.Context, db DBTX, id uuid.UUID) (pgtype.UUID, error) {
+	row := db.QueryRow(ctx, getReservationGroupID, id)
+	var group_id pgtype.UUID
+	err := row.Scan(&group_id)
+	return group_id, err
+}
+
+const getReservationIDsByGroup = `-- name: GetReservationIDsByGroup :many
+SELECT id
+FROM reservations
+WHERE group_id = $1 AND user_id = $2
+ORDER BY lower(slot), id
+`
+
+type GetReservationIDsByGroupParams struct {
+	GroupID pgtype.UUID `json:"group_id"`
+	UserID  uuid.UUID   `json:"user_id"`
+}
+
+func (q *Queries) GetReservationIDsByGroup(ctx context.Context, db DBTX, arg GetReservationIDsByGroupParams) ([]uuid.UUID, error) {
+	rows, err := db.Query(ctx, getReservationIDsByGroup, arg.GroupID, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []uuid.UUID{}
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getReservationsByUserIDFirstPage = `-- name: GetReservationsByUserIDFirstPage :many
 SELECT 
     r.id,
@@ -236,6 +428,349 @@ func (q *Queries) GetReservationsByUserIDKeyset(ctx context.Context, db DBTX, ar
 	return items, nil
 }
 
+const getReservationsForAdminFirstPage = `-- name: GetReservationsForAdminFirstPage :many
+SELECT
+    r.id,
+    r.resource_id,
+    r.user_id,
+    r.slot::text,
+    r.status,
+    r.price_cents,
+    r.created_at,
+    r.custom_fields,
+    res.name AS resource_name,
+    u.email AS user_email
+FROM reservations AS r
+INNER JOIN resources AS res ON r.resource_id = res.id
+INNER JOIN users AS u ON r.user_id = u.id
+WHERE ($2::uuid IS NULL OR r.resource_id = $2::uuid)
+  AND ($3::uuid IS NULL OR EXISTS (
+    SELECT 1 FROM resource_operators AS ro
+    WHERE ro.resource_id = r.resource_id AND ro.user_id = $3::uuid
+  ))
+  AND ($4::uuid IS NULL OR res.company_id = $4::uuid)
+  AND ($5::jsonb IS NULL OR NOT EXISTS (
+    SELECT 1 FROM jsonb_each_text($5::jsonb) AS f
+    WHERE r.custom_fields ->> f.key IS DISTINCT FROM f.value
+  ))
+ORDER BY r.created_at DESC, r.id DESC
+LIMIT $1
+`
+
+type GetReservationsForAdminFirstPageParams struct {
+	Limit        int32       `json:"limit"`
+	ResourceID   pgtype.UUID `json:"resource_id"`
+	OperatorID   pgtype.UUID `json:"operator_id"`
+	CompanyID    pgtype.UUID `json:"company_id"`
+	CustomFields []byte      `json:"custom_fields"`
+}
+
+type GetReservationsForAdminFirstPageRow struct {
+	ID           uuid.UUID          `json:"id"`
+	ResourceID   uuid.UUID          `json:"resource_id"`
+	UserID       uuid.UUID          `json:"user_id"`
+	RSlot        string             `json:"r_slot"`
+	Status       string             `json:"status"`
+	PriceCents   int32              `json:"price_cents"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+	CustomFields []byte             `json:"custom_fields"`
+	ResourceName string             `json:"resource_name"`
+	UserEmail    string             `json:"user_email"`
+}
+
+func (q *Queries) GetReservationsForAdminFirstPage(ctx context.Context, db DBTX, arg GetReservationsForAdminFirstPageParams) ([]GetReservationsForAdminFirstPageRow, error) {
+	rows, err := db.Query(ctx, getReservationsForAdminFirstPage,
+		arg.Limit,
+		arg.ResourceID,
+		arg.OperatorID,
+		arg.CompanyID,
+		arg.CustomFields,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetReservationsForAdminFirstPageRow
+	for rows.Next() {
+		var i GetReservationsForAdminFirstPageRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ResourceID,
+			&i.UserID,
+			&i.RSlot,
+			&i.Status,
+			&i.PriceCents,
+			&i.CreatedAt,
+			&i.CustomFields,
+			&i.ResourceName,
+			&i.UserEmail,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getReservationsForAdminKeyset = `-- name: GetReservationsForAdminKeyset :many
+SELECT
+    r.id,
+    r.resource_id,
+    r.user_id,
+    r.slot::text,
+    r.status,
+    r.price_cents,
+    r.created_at,
+    r.custom_fields,
+    res.name AS resource_name,
+    u.email AS user_email
+FROM reservations AS r
+INNER JOIN resources AS res ON r.resource_id = res.id
+INNER JOIN users AS u ON r.user_id = u.id
+WHERE (r.created_at < $1 OR (r.created_at = $1 AND r.id < $2))
+  AND ($4::uuid IS NULL OR r.resource_id = $4::uuid)
+  AND ($5::uuid IS NULL OR EXISTS (
+    SELECT 1 FROM resource_operators AS ro
+    WHERE ro.resource_id = r.resource_id AND ro.user_id = $5::uuid
+  ))
+  AND ($6::uuid IS NULL OR res.company_id = $6::uuid)
+  AND ($7::jsonb IS NULL OR NOT EXISTS (
+    SELECT 1 FROM jsonb_each_text($7::jsonb) AS f
+    WHERE r.custom_fields ->> f.key IS DISTINCT FROM f.value
+  ))
+ORDER BY r.created_at DESC, r.id DESC
+LIMIT $3
+`
+
+type GetReservationsForAdminKeysetParams struct {
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+	ID           uuid.UUID          `json:"id"`
+	Limit        int32              `json:"limit"`
+	ResourceID   pgtype.UUID        `json:"resource_id"`
+	OperatorID   pgtype.UUID        `json:"operator_id"`
+	CompanyID    pgtype.UUID        `json:"company_id"`
+	CustomFields []byte             `json:"custom_fields"`
+}
+
+type GetReservationsForAdminKeysetRow struct {
+	ID           uuid.UUID          `json:"id"`
+	ResourceID   uuid.UUID          `json:"resource_id"`
+	UserID       uuid.UUID          `json:"user_id"`
+	RSlot        string             `json:"r_slot"`
+	Status       string             `json:"status"`
+	PriceCents   int32              `json:"price_cents"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+	CustomFields []byte             `json:"custom_fields"`
+	ResourceName string             `json:"resource_name"`
+	UserEmail    string             `json:"user_email"`
+}
+
+func (q *Queries) GetReservationsForAdminKeyset(ctx context.Context, db DBTX, arg GetReservationsForAdminKeysetParams) ([]GetReservationsForAdminKeysetRow, error) {
+	rows, err := db.Query(ctx, getReservationsForAdminKeyset,
+		arg.CreatedAt,
+		arg.ID,
+		arg.Limit,
+		arg.ResourceID,
+		arg.OperatorID,
+		arg.CompanyID,
+		arg.CustomFields,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetReservationsForAdminKeysetRow
+	for rows.Next() {
+		var i GetReservationsForAdminKeysetRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ResourceID,
+			&i.UserID,
+			&i.RSlot,
+			&i.Status,
+			&i.PriceCents,
+			&i.CreatedAt,
+			&i.CustomFields,
+			&i.ResourceName,
+			&i.UserEmail,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const hasReservationInSlot = `-- name: HasReservationInSlot :one
+SELECT EXISTS (
+    SELECT 1
+    FROM reservations
+    WHERE resource_id = $1
+      AND slot && tstzrange($2::timestamptz, $3::timestamptz, '[)')
+)
+`
+
+type HasReservationInSlotParams struct {
+	ResourceID uuid.UUID          `json:"resource_id"`
+	StartTime  pgtype.Timestamptz `json:"start_time"`
+	EndTime    pgtype.Timestamptz `json:"end_time"`
+}
+
+// Mirrors reservations_no_overlap, so canceled reservations count as well.
+func (q *Queries) HasReservationInSlot(ctx context.Context, db DBTX, arg HasReservationInSlotParams) (bool, error) {
+	row := db.QueryRow(ctx, hasReservationInSlot, arg.ResourceID, arg.StartTime, arg.EndTime)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const listCancelableReservationsInRange = `-- name: ListCancelableReservationsInRange :many
+SELECT
+    id,
+    user_id,
+    lower(slot)::timestamptz AS start_time,
+    upper(slot)::timestamptz AS end_time
+FROM reservations
+WHERE resource_id = $1
+  AND status = 'confirmed'
+  AND slot && tstzrange($2::timestamptz, $3::timestamptz, '[)')
+  AND upper(slot) > $4::timestamptz
+ORDER BY lower(slot), id
+`
+
+type ListCancelableReservationsInRangeParams struct {
+	ResourceID uuid.UUID          `json:"resource_id"`
+	FromTime   pgtype.Timestamptz `json:"from_time"`
+	ToTime     pgtype.Timestamptz `json:"to_time"`
+	Now        pgtype.Timestamptz `json:"now"`
+}
+
+type ListCancelableReservationsInRangeRow struct {
+	ID        uuid.UUID          `json:"id"`
+	UserID    uuid.UUID          `json:"user_id"`
+	StartTime pgtype.Timestamptz `json:"start_time"`
+	EndTime   pgtype.Timestamptz `json:"end_time"`
+}
+
+// Confirmed reservations on the resource overlapping the range that have not ended at now.
+func (q *Queries) ListCancelableReservationsInRange(ctx context.Context, db DBTX, arg ListCancelableReservationsInRangeParams) ([]ListCancelableReservationsInRangeRow, error) {
+	rows, err := db.Query(ctx, listCancelableReservationsInRange,
+		arg.ResourceID,
+		arg.FromTime,
+		arg.ToTime,
+		arg.Now,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCancelableReservationsInRangeRow
+	for rows.Next() {
+		var i ListCancelableReservationsInRangeRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.StartTime,
+			&i.EndTime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const resolvePendingReservation = `-- name: ResolvePendingReservation :execrows
+UPDATE reservations
+SET
+    status = $1,
+    updated_at = NOW()
+WHERE id = $2 AND status = 'pending_approval'
+`
+
+type ResolvePendingReservationParams struct {
+	Status string    `json:"status"`
+	ID     uuid.UUID `json:"id"`
+}
+
+// Confirms or cancels a reservation pending approval.
+func (q *Queries) ResolvePendingReservation(ctx context.Context, db DBTX, arg ResolvePendingReservationParams) (int64, error) {
+	result, err := db.Exec(ctx, resolvePendingReservation, arg.Status, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setReservationGroup = `-- name: SetReservationGroup :exec
+UPDATE reservations
+SET group_id = $1
+WHERE id = ANY($2::uuid[])
+`
+
+type SetReservationGroupParams struct {
+	GroupID pgtype.UUID `json:"group_id"`
+	Ids     []uuid.UUID `json:"ids"`
+}
+
+func (q *Queries) SetReservationGroup(ctx context.Context, db DBTX, arg SetReservationGroupParams) error {
+	_, err := db.Exec(ctx, setReservationGroup, arg.GroupID, arg.Ids)
+	return err
+}
+
+const touchReservation = `-- name: TouchReservation :exec
+UPDATE reservations
+SET updated_at = NOW()
+WHERE id = $1
+`
+
+// Changes the reservation's ETag when its message thread changes.
+func (q *Queries) TouchReservation(ctx context.Context, db DBTX, id uuid.UUID) error {
+	_, err := db.Exec(ctx, touchReservation, id)
+	return err
+}
+
+const transferReservation = `-- name: TransferReservation :execrows
+UPDATE reservations
+SET
+    user_id = $1,
+    updated_at = NOW()
+WHERE id = $2
+  AND user_id = $3
+  AND status = 'confirmed'
+  AND upper(slot) > $4::timestamptz
+`
+
+type TransferReservationParams struct {
+	ToUserID   uuid.UUID          `json:"to_user_id"`
+	ID         uuid.UUID          `json:"id"`
+	FromUserID uuid.UUID          `json:"from_user_id"`
+	Now        pgtype.Timestamptz `json:"now"`
+}
+
+// Moves a confirmed, not yet ended reservation that still belongs to from_user_id.
+func (q *Queries) TransferReservation(ctx context.Context, db DBTX, arg TransferReservationParams) (int64, error) {
+	result, err := db.Exec(ctx, transferReservation,
+		arg.ToUserID,
+		arg.ID,
+		arg.FromUserID,
+		arg.Now,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateReservationSlot = `-- name: UpdateReservationSlot :exec
 UPDATE reservations 
 SET 
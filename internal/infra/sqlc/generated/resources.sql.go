@@ -12,13 +12,22 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type CreateResourcesParams struct {
+	ID          uuid.UUID   `json:"id"`
+	Name        string      `json:"name"`
+	LeadTimeMin int32       `json:"lead_time_min"`
+	CompanyID   pgtype.UUID `json:"company_id"`
+	Slug        pgtype.Text `json:"slug"`
+}
+
 const getAllResources = `-- name: GetAllResources :many
 SELECT 
     id,
     name,
     lead_time_min,
     created_at,
-    updated_at
+    updated_at,
+    company_id
 FROM resources 
 ORDER BY name
 `
@@ -38,6 +47,7 @@ func (q *Queries) GetAllResources(ctx context.Context, db DBTX) ([]Resources, er
 			&i.LeadTimeMin,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.CompanyID,
 		); err != nil {
 			return nil, err
 		}
@@ -49,13 +59,56 @@ func (q *Queries) GetAllResources(ctx context.Context, db DBTX) ([]Resources, er
 	return items, nil
 }
 
+const getPublicResourceBySlug = `-- name: GetPublicResourceBySlug :one
+SELECT
+    r.slug::text AS slug,
+    r.name,
+    r.lead_time_min,
+    c.name AS company_name,
+    COALESCE(s.total_reviews, 0)::int AS total_reviews,
+    COALESCE(s.average_rating, 0)::float8 AS average_rating,
+    GREATEST(r.updated_at, c.updated_at, s.updated_at)::timestamptz AS last_modified
+FROM resources r
+LEFT JOIN companies c ON c.id = r.company_id
+LEFT JOIN resource_rating_stats s ON s.resource_id = r.id
+WHERE r.slug = $1::text
+`
+
+type GetPublicResourceBySlugRow struct {
+	Slug          string             `json:"slug"`
+	Name          string             `json:"name"`
+	LeadTimeMin   int32              `json:"lead_time_min"`
+	CompanyName   pgtype.Text        `json:"company_name"`
+	TotalReviews  int32              `json:"total_reviews"`
+	AverageRating float64            `json:"average_rating"`
+	LastModified  pgtype.Timestamptz `json:"last_modified"`
+}
+
+// What a resource's public page shows. last_modified covers the resource, its company and
+// its rating stats, the sources of every field.
+func (q *Queries) GetPublicResourceBySlug(ctx context.Context, db DBTX, slug string) (GetPublicResourceBySlugRow, error) {
+	row := db.QueryRow(ctx, getPublicResourceBySlug, slug)
+	var i GetPublicResourceBySlugRow
+	err := row.Scan(
+		&i.Slug,
+		&i.Name,
+		&i.LeadTimeMin,
+		&i.CompanyName,
+		&i.TotalReviews,
+		&i.AverageRating,
+		&i.LastModified,
+	)
+	return i, err
+}
+
 const getResourceByID = `-- name: GetResourceByID :one
 SELECT 
     id,
     name,
     lead_time_min,
     created_at,
-    updated_at
+    updated_at,
+    company_id
 FROM resources 
 WHERE id = $1
 `
@@ -69,17 +122,80 @@ func (q *Queries) GetResourceByID(ctx context.Context, db DBTX, id uuid.UUID) (R
 		&i.LeadTimeMin,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.CompanyID,
 	)
 	return i, err
 }
 
+const listPublicResourceSlugs = `-- name: ListPublicResourceSlugs :many
+SELECT
+    r.slug::text AS slug,
+    GREATEST(r.updated_at, c.updated_at, s.updated_at)::timestamptz AS last_modified
+FROM resources r
+LEFT JOIN companies c ON c.id = r.company_id
+LEFT JOIN resource_rating_stats s ON s.resource_id = r.id
+WHERE r.slug IS NOT NULL
+ORDER BY r.slug
+LIMIT $1
+`
+
+type ListPublicResourceSlugsRow struct {
+	Slug         string             `json:"slug"`
+	LastModified pgtype.Timestamptz `json:"last_modified"`
+}
+
+// Public resource pages for the sitemap, by slug; last_modified as in GetPublicResourceBySlug.
+func (q *Queries) ListPublicResourceSlugs(ctx context.Context, db DBTX, maxUrls int32) ([]ListPublicResourceSlugsRow, error) {
+	rows, err := db.Query(ctx, listPublicResourceSlugs, maxUrls)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListPublicResourceSlugsRow{}
+	for rows.Next() {
+		var i ListPublicResourceSlugsRow
+		if err := rows.Scan(&i.Slug, &i.LastModified); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockResourceForShare = `-- name: LockResourceForShare :one
+SELECT id FROM resources WHERE id = $1 FOR SHARE
+`
+
+// Taken before inserting a reservation, so a resource block created concurrently is
+// either visible to the overlap check or waits for the reservation to commit.
+func (q *Queries) LockResourceForShare(ctx context.Context, db DBTX, id uuid.UUID) (uuid.UUID, error) {
+	row := db.QueryRow(ctx, lockResourceForShare, id)
+	err := row.Scan(&id)
+	return id, err
+}
+
+const lockResourceForUpdate = `-- name: LockResourceForUpdate :one
+SELECT id FROM resources WHERE id = $1 FOR UPDATE
+`
+
+// Taken before inserting resource blocks; see LockResourceForShare.
+func (q *Queries) LockResourceForUpdate(ctx context.Context, db DBTX, id uuid.UUID) (uuid.UUID, error) {
+	row := db.QueryRow(ctx, lockResourceForUpdate, id)
+	err := row.Scan(&id)
+	return id, err
+}
+
 const searchResourcesByName = `-- name: SearchResourcesByName :many
 SELECT 
     id,
     name,
     lead_time_min,
     created_at,
-    updated_at
+    updated_at,
+    company_id
 FROM resources 
 WHERE name ILIKE '%' || $1 || '%'
 ORDER BY name
@@ -100,6 +216,7 @@ func (q *Queries) SearchResourcesByName(ctx context.Context, db DBTX, dollar_1 p
 			&i.LeadTimeMin,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.CompanyID,
 		); err != nil {
 			return nil, err
 		}
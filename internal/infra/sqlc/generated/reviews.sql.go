@@ -110,6 +110,44 @@ func (q *Queries) ApplyResourceRatingStatsOnUpdate(ctx context.Context, db DBTX,
 	return err
 }
 
+const countReviewsByLanguage = `-- name: CountReviewsByLanguage :many
+SELECT
+  language::text AS language,
+  COUNT(*)::int AS review_count
+FROM reviews
+WHERE resource_id = $1
+  AND status = 'published'
+  AND language IS NOT NULL
+GROUP BY language
+ORDER BY review_count DESC, language
+`
+
+type CountReviewsByLanguageRow struct {
+	Language    string `json:"language"`
+	ReviewCount int32  `json:"review_count"`
+}
+
+// Published reviews of a resource per detected language, most common first.
+func (q *Queries) CountReviewsByLanguage(ctx context.Context, db DBTX, resourceID uuid.UUID) ([]CountReviewsByLanguageRow, error) {
+	rows, err := db.Query(ctx, countReviewsByLanguage, resourceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CountReviewsByLanguageRow{}
+	for rows.Next() {
+		var i CountReviewsByLanguageRow
+		if err := rows.Scan(&i.Language, &i.ReviewCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const createReview = `-- name: CreateReview :one
 INSERT INTO reviews (
     id,
@@ -117,19 +155,25 @@ INSERT INTO reviews (
     resource_id,
     reservation_id,
     rating,
-    comment
+    comment,
+    status,
+    duplicate_of,
+    language
 ) VALUES (
-    $1, $2, $3, $4, $5, $6
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
 ) RETURNING id
 `
 
 type CreateReviewParams struct {
-	ID            uuid.UUID `json:"id"`
-	UserID        uuid.UUID `json:"user_id"`
-	ResourceID    uuid.UUID `json:"resource_id"`
-	ReservationID uuid.UUID `json:"reservation_id"`
-	Rating        int32     `json:"rating"`
-	Comment       string    `json:"comment"`
+	ID            uuid.UUID   `json:"id"`
+	UserID        uuid.UUID   `json:"user_id"`
+	ResourceID    uuid.UUID   `json:"resource_id"`
+	ReservationID pgtype.UUID `json:"reservation_id"`
+	Rating        int32       `json:"rating"`
+	Comment       string      `json:"comment"`
+	Status        string      `json:"status"`
+	DuplicateOf   pgtype.UUID `json:"duplicate_of"`
+	Language      pgtype.Text `json:"language"`
 }
 
 func (q *Queries) CreateReview(ctx context.Context, db DBTX, arg CreateReviewParams) (uuid.UUID, error) {
@@ -140,6 +184,9 @@ func (q *Queries) CreateReview(ctx context.Context, db DBTX, arg CreateReviewPar
 		arg.ReservationID,
 		arg.Rating,
 		arg.Comment,
+		arg.Status,
+		arg.DuplicateOf,
+		arg.Language,
 	)
 	var id uuid.UUID
 	err := row.Scan(&id)
@@ -158,6 +205,36 @@ func (q *Queries) DeleteReview(ctx context.Context, db DBTX, id uuid.UUID) (int3
 	return column_1, err
 }
 
+const findReviewImportAuthor = `-- name: FindReviewImportAuthor :one
+SELECT id FROM (
+  SELECT u.id, 1 AS priority
+  FROM users u
+  JOIN resources res ON res.company_id = u.company_id
+  WHERE res.id = $1 AND u.external_id = $2::text
+  UNION ALL
+  SELECT u.id, 2 AS priority
+  FROM users u
+  WHERE u.email = $3::text
+) matches
+ORDER BY priority
+LIMIT 1
+`
+
+type FindReviewImportAuthorParams struct {
+	ResourceID uuid.UUID `json:"resource_id"`
+	ExternalID string    `json:"external_id"`
+	Email      string    `json:"email"`
+}
+
+// Who an imported review of the resource is by: the member of the resource's company with
+// the external ID, else the user with the email. Empty values match nobody.
+func (q *Queries) FindReviewImportAuthor(ctx context.Context, db DBTX, arg FindReviewImportAuthorParams) (uuid.UUID, error) {
+	row := db.QueryRow(ctx, findReviewImportAuthor, arg.ResourceID, arg.ExternalID, arg.Email)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
 const getResourceRatingStats = `-- name: GetResourceRatingStats :one
 SELECT 
   resource_id,
@@ -168,7 +245,10 @@ SELECT
   rating_3_count,
   rating_4_count,
   rating_5_count,
-  updated_at
+  updated_at,
+  summary,
+  summary_review_count,
+  summary_updated_at
 FROM resource_rating_stats
 WHERE resource_id = $1
 `
@@ -186,12 +266,15 @@ func (q *Queries) GetResourceRatingStats(ctx context.Context, db DBTX, resourceI
 		&i.Rating4Count,
 		&i.Rating5Count,
 		&i.UpdatedAt,
+		&i.Summary,
+		&i.SummaryReviewCount,
+		&i.SummaryUpdatedAt,
 	)
 	return i, err
 }
 
 const getReviewByID = `-- name: GetReviewByID :one
-SELECT id, user_id, resource_id, reservation_id, rating, comment, created_at, updated_at FROM reviews WHERE id = $1
+SELECT id, user_id, resource_id, reservation_id, rating, comment, created_at, updated_at, status, duplicate_of, language, import_source, external_id FROM reviews WHERE id = $1
 `
 
 func (q *Queries) GetReviewByID(ctx context.Context, db DBTX, id uuid.UUID) (Reviews, error) {
@@ -206,6 +289,11 @@ func (q *Queries) GetReviewByID(ctx context.Context, db DBTX, id uuid.UUID) (Rev
 		&i.Comment,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Status,
+		&i.DuplicateOf,
+		&i.Language,
+		&i.ImportSource,
+		&i.ExternalID,
 	)
 	return i, err
 }
@@ -221,7 +309,9 @@ SELECT
   r.rating,
   r.comment,
   r.created_at,
-  r.updated_at
+  r.updated_at,
+  r.status,
+  r.language
 FROM reviews r
 JOIN users u ON r.user_id = u.id
 JOIN resources res ON r.resource_id = res.id
@@ -234,11 +324,13 @@ type GetReviewViewByIDRow struct {
 	UserEmail     string             `json:"user_email"`
 	ResourceID    uuid.UUID          `json:"resource_id"`
 	ResourceName  string             `json:"resource_name"`
-	ReservationID uuid.UUID          `json:"reservation_id"`
+	ReservationID pgtype.UUID        `json:"reservation_id"`
 	Rating        int32              `json:"rating"`
 	Comment       string             `json:"comment"`
 	CreatedAt     pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+	Status        string             `json:"status"`
+	Language      pgtype.Text        `json:"language"`
 }
 
 func (q *Queries) GetReviewViewByID(ctx context.Context, db DBTX, id uuid.UUID) (GetReviewViewByIDRow, error) {
@@ -255,6 +347,8 @@ func (q *Queries) GetReviewViewByID(ctx context.Context, db DBTX, id uuid.UUID)
 		&i.Comment,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Status,
+		&i.Language,
 	)
 	return i, err
 }
@@ -265,12 +359,15 @@ SELECT
   u.email AS user_email,
   r.rating,
   r.comment,
-  r.created_at
+  r.created_at,
+  r.language
 FROM reviews r
 JOIN users u ON r.user_id = u.id
 WHERE r.resource_id = $1
+  AND r.status = 'published'
   AND ($3::int IS NULL OR r.rating >= $3::int)
   AND ($4::int IS NULL OR r.rating <= $4::int)
+  AND ($5::text IS NULL OR r.language = $5::text)
 ORDER BY r.created_at DESC, r.id DESC
 LIMIT $2
 `
@@ -280,6 +377,7 @@ type GetReviewsByResourceFirstPageParams struct {
 	Limit      int32       `json:"limit"`
 	MinRating  pgtype.Int4 `json:"min_rating"`
 	MaxRating  pgtype.Int4 `json:"max_rating"`
+	Language   pgtype.Text `json:"language"`
 }
 
 type GetReviewsByResourceFirstPageRow struct {
@@ -288,6 +386,7 @@ type GetReviewsByResourceFirstPageRow struct {
 	Rating    int32              `json:"rating"`
 	Comment   string             `json:"comment"`
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	Language  pgtype.Text        `json:"language"`
 }
 
 func (q *Queries) GetReviewsByResourceFirstPage(ctx context.Context, db DBTX, arg GetReviewsByResourceFirstPageParams) ([]GetReviewsByResourceFirstPageRow, error) {
@@ -296,6 +395,7 @@ func (q *Queries) GetReviewsByResourceFirstPage(ctx context.Context, db DBTX, ar
 		arg.Limit,
 		arg.MinRating,
 		arg.MaxRating,
+		arg.Language,
 	)
 	if err != nil {
 		return nil, err
@@ -310,6 +410,7 @@ func (q *Queries) GetReviewsByResourceFirstPage(ctx context.Context, db DBTX, ar
 			&i.Rating,
 			&i.Comment,
 			&i.CreatedAt,
+			&i.Language,
 		); err != nil {
 			return nil, err
 		}
@@ -327,13 +428,16 @@ SELECT
   u.email AS user_email,
   r.rating,
   r.comment,
-  r.created_at
+  r.created_at,
+  r.language
 FROM reviews r
 JOIN users u ON r.user_id = u.id
 WHERE r.resource_id = $1
+  AND r.status = 'published'
   AND (r.created_at < $2 OR (r.created_at = $2 AND r.id < $3))
   AND ($5::int IS NULL OR r.rating >= $5::int)
   AND ($6::int IS NULL OR r.rating <= $6::int)
+  AND ($7::text IS NULL OR r.language = $7::text)
 ORDER BY r.created_at DESC, r.id DESC
 LIMIT $4
 `
@@ -345,6 +449,7 @@ type GetReviewsByResourceKeysetParams struct {
 	Limit      int32              `json:"limit"`
 	MinRating  pgtype.Int4        `json:"min_rating"`
 	MaxRating  pgtype.Int4        `json:"max_rating"`
+	Language   pgtype.Text        `json:"language"`
 }
 
 type GetReviewsByResourceKeysetRow struct {
@@ -353,6 +458,7 @@ type GetReviewsByResourceKeysetRow struct {
 	Rating    int32              `json:"rating"`
 	Comment   string             `json:"comment"`
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	Language  pgtype.Text        `json:"language"`
 }
 
 func (q *Queries) GetReviewsByResourceKeyset(ctx context.Context, db DBTX, arg GetReviewsByResourceKeysetParams) ([]GetReviewsByResourceKeysetRow, error) {
@@ -363,6 +469,7 @@ func (q *Queries) GetReviewsByResourceKeyset(ctx context.Context, db DBTX, arg G
 		arg.Limit,
 		arg.MinRating,
 		arg.MaxRating,
+		arg.Language,
 	)
 	if err != nil {
 		return nil, err
@@ -377,6 +484,7 @@ func (q *Queries) GetReviewsByResourceKeyset(ctx context.Context, db DBTX, arg G
 			&i.Rating,
 			&i.Comment,
 			&i.CreatedAt,
+			&i.Language,
 		); err != nil {
 			return nil, err
 		}
@@ -394,7 +502,8 @@ SELECT
   u.email AS user_email,
   r.rating,
   r.comment,
-  r.created_at
+  r.created_at,
+  r.status
 FROM reviews r
 JOIN users u ON r.user_id = u.id
 WHERE r.user_id = $1
@@ -413,6 +522,7 @@ type GetReviewsByUserFirstPageRow struct {
 	Rating    int32              `json:"rating"`
 	Comment   string             `json:"comment"`
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	Status    string             `json:"status"`
 }
 
 func (q *Queries) GetReviewsByUserFirstPage(ctx context.Context, db DBTX, arg GetReviewsByUserFirstPageParams) ([]GetReviewsByUserFirstPageRow, error) {
@@ -430,6 +540,7 @@ func (q *Queries) GetReviewsByUserFirstPage(ctx context.Context, db DBTX, arg Ge
 			&i.Rating,
 			&i.Comment,
 			&i.CreatedAt,
+			&i.Status,
 		); err != nil {
 			return nil, err
 		}
@@ -447,7 +558,8 @@ SELECT
   u.email AS user_email,
   r.rating,
   r.comment,
-  r.created_at
+  r.created_at,
+  r.status
 FROM reviews r
 JOIN users u ON r.user_id = u.id
 WHERE r.user_id = $1
@@ -469,6 +581,7 @@ type GetReviewsByUserKeysetRow struct {
 	Rating    int32              `json:"rating"`
 	Comment   string             `json:"comment"`
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	Status    string             `json:"status"`
 }
 
 func (q *Queries) GetReviewsByUserKeyset(ctx context.Context, db DBTX, arg GetReviewsByUserKeysetParams) ([]GetReviewsByUserKeysetRow, error) {
@@ -491,6 +604,7 @@ func (q *Queries) GetReviewsByUserKeyset(ctx context.Context, db DBTX, arg GetRe
 			&i.Rating,
 			&i.Comment,
 			&i.CreatedAt,
+			&i.Status,
 		); err != nil {
 			return nil, err
 		}
@@ -502,24 +616,259 @@ func (q *Queries) GetReviewsByUserKeyset(ctx context.Context, db DBTX, arg GetRe
 	return items, nil
 }
 
+const importReview = `-- name: ImportReview :one
+INSERT INTO reviews (
+    id,
+    user_id,
+    resource_id,
+    rating,
+    comment,
+    status,
+    language,
+    import_source,
+    external_id,
+    created_at,
+    updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, 'published', $6, $7, $8, $9, $10
+)
+ON CONFLICT (import_source, external_id) WHERE import_source IS NOT NULL DO NOTHING
+RETURNING id
+`
+
+type ImportReviewParams struct {
+	ID           uuid.UUID          `json:"id"`
+	UserID       uuid.UUID          `json:"user_id"`
+	ResourceID   uuid.UUID          `json:"resource_id"`
+	Rating       int32              `json:"rating"`
+	Comment      string             `json:"comment"`
+	Language     pgtype.Text        `json:"language"`
+	ImportSource pgtype.Text        `json:"import_source"`
+	ExternalID   pgtype.Text        `json:"external_id"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+}
+
+// A published review from another system with its original timestamps. Nothing is
+// inserted, and no row returned, when the source's review is already in.
+func (q *Queries) ImportReview(ctx context.Context, db DBTX, arg ImportReviewParams) (uuid.UUID, error) {
+	row := db.QueryRow(ctx, importReview,
+		arg.ID,
+		arg.UserID,
+		arg.ResourceID,
+		arg.Rating,
+		arg.Comment,
+		arg.Language,
+		arg.ImportSource,
+		arg.ExternalID,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const listRecentPublishedReviews = `-- name: ListRecentPublishedReviews :many
+SELECT
+  r.id,
+  r.rating,
+  r.comment,
+  r.language,
+  r.created_at,
+  r.updated_at
+FROM reviews r
+WHERE r.resource_id = $1
+  AND r.status = 'published'
+ORDER BY r.created_at DESC, r.id DESC
+LIMIT $2
+`
+
+type ListRecentPublishedReviewsParams struct {
+	ResourceID uuid.UUID `json:"resource_id"`
+	BatchSize  int32     `json:"batch_size"`
+}
+
+type ListRecentPublishedReviewsRow struct {
+	ID        uuid.UUID          `json:"id"`
+	Rating    int32              `json:"rating"`
+	Comment   string             `json:"comment"`
+	Language  pgtype.Text        `json:"language"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+}
+
+// The newest published reviews of a resource for its public feed.
+func (q *Queries) ListRecentPublishedReviews(ctx context.Context, db DBTX, arg ListRecentPublishedReviewsParams) ([]ListRecentPublishedReviewsRow, error) {
+	rows, err := db.Query(ctx, listRecentPublishedReviews, arg.ResourceID, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListRecentPublishedReviewsRow{}
+	for rows.Next() {
+		var i ListRecentPublishedReviewsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Rating,
+			&i.Comment,
+			&i.Language,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReviewsForExport = `-- name: ListReviewsForExport :many
+SELECT
+  r.id,
+  r.resource_id,
+  res.name AS resource_name,
+  r.reservation_id,
+  r.rating,
+  r.comment,
+  r.status,
+  r.language,
+  r.created_at,
+  r.updated_at
+FROM reviews r
+JOIN resources res ON r.resource_id = res.id
+WHERE r.user_id = $1
+  AND (r.created_at, r.id) > ($2::timestamptz, $3::uuid)
+ORDER BY r.created_at, r.id
+LIMIT $4
+`
+
+type ListReviewsForExportParams struct {
+	UserID         uuid.UUID          `json:"user_id"`
+	AfterCreatedAt pgtype.Timestamptz `json:"after_created_at"`
+	AfterID        uuid.UUID          `json:"after_id"`
+	BatchSize      int32              `json:"batch_size"`
+}
+
+type ListReviewsForExportRow struct {
+	ID            uuid.UUID          `json:"id"`
+	ResourceID    uuid.UUID          `json:"resource_id"`
+	ResourceName  string             `json:"resource_name"`
+	ReservationID pgtype.UUID        `json:"reservation_id"`
+	Rating        int32              `json:"rating"`
+	Comment       string             `json:"comment"`
+	Status        string             `json:"status"`
+	Language      pgtype.Text        `json:"language"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+}
+
+// One batch of a user's reviews, oldest first, for the data export.
+func (q *Queries) ListReviewsForExport(ctx context.Context, db DBTX, arg ListReviewsForExportParams) ([]ListReviewsForExportRow, error) {
+	rows, err := db.Query(ctx, listReviewsForExport,
+		arg.UserID,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.BatchSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListReviewsForExportRow{}
+	for rows.Next() {
+		var i ListReviewsForExportRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ResourceID,
+			&i.ResourceName,
+			&i.ReservationID,
+			&i.Rating,
+			&i.Comment,
+			&i.Status,
+			&i.Language,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const recalculateResourceRatingStats = `-- name: RecalculateResourceRatingStats :exec
+INSERT INTO resource_rating_stats (
+  resource_id,
+  total_reviews,
+  average_rating,
+  rating_1_count,
+  rating_2_count,
+  rating_3_count,
+  rating_4_count,
+  rating_5_count,
+  updated_at
+)
+SELECT
+  $1::uuid,
+  COUNT(*),
+  COALESCE(ROUND(AVG(rating), 2), 0.00),
+  COUNT(*) FILTER (WHERE rating = 1),
+  COUNT(*) FILTER (WHERE rating = 2),
+  COUNT(*) FILTER (WHERE rating = 3),
+  COUNT(*) FILTER (WHERE rating = 4),
+  COUNT(*) FILTER (WHERE rating = 5),
+  NOW()
+FROM reviews
+WHERE resource_id = $1::uuid AND status = 'published'
+ON CONFLICT (resource_id) DO UPDATE SET
+  total_reviews = EXCLUDED.total_reviews,
+  average_rating = EXCLUDED.average_rating,
+  rating_1_count = EXCLUDED.rating_1_count,
+  rating_2_count = EXCLUDED.rating_2_count,
+  rating_3_count = EXCLUDED.rating_3_count,
+  rating_4_count = EXCLUDED.rating_4_count,
+  rating_5_count = EXCLUDED.rating_5_count,
+  updated_at = EXCLUDED.updated_at
+`
+
+// Rebuilds the resource's stats from its published reviews, for changes made in bulk.
+func (q *Queries) RecalculateResourceRatingStats(ctx context.Context, db DBTX, resourceID uuid.UUID) error {
+	_, err := db.Exec(ctx, recalculateResourceRatingStats, resourceID)
+	return err
+}
+
 const updateReview = `-- name: UpdateReview :one
 UPDATE reviews
 SET
     rating = $2,
     comment = $3,
+    language = $4,
     updated_at = NOW()
 WHERE id = $1
 RETURNING 1
 `
 
 type UpdateReviewParams struct {
-	ID      uuid.UUID `json:"id"`
-	Rating  int32     `json:"rating"`
-	Comment string    `json:"comment"`
+	ID       uuid.UUID   `json:"id"`
+	Rating   int32       `json:"rating"`
+	Comment  string      `json:"comment"`
+	Language pgtype.Text `json:"language"`
 }
 
 func (q *Queries) UpdateReview(ctx context.Context, db DBTX, arg UpdateReviewParams) (int32, error) {
-	row := db.QueryRow(ctx, updateReview, arg.ID, arg.Rating, arg.Comment)
+	row := db.QueryRow(ctx, updateReview,
+		arg.ID,
+		arg.Rating,
+		arg.Comment,
+		arg.Language,
+	)
 	var column_1 int32
 	err := row.Scan(&column_1)
 	return column_1, err
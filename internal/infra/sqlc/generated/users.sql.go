@@ -12,9 +12,50 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const activateUser = `-- name: ActivateUser :exec
+UPDATE users
+SET is_active = true, updated_at = NOW()
+WHERE id = $1
+`
+
+func (q *Queries) ActivateUser(ctx context.Context, db DBTX, id uuid.UUID) error {
+	_, err := db.Exec(ctx, activateUser, id)
+	return err
+}
+
+const createInactiveUser = `-- name: CreateInactiveUser :one
+INSERT INTO users (email, password_hash, role, company_id, referral_code, is_active)
+VALUES ($1, $2, $3, $4, $5, false)
+ON CONFLICT (referral_code) DO NOTHING
+RETURNING id
+`
+
+type CreateInactiveUserParams struct {
+	Email        string      `json:"email"`
+	PasswordHash string      `json:"password_hash"`
+	Role         string      `json:"role"`
+	CompanyID    pgtype.UUID `json:"company_id"`
+	ReferralCode string      `json:"referral_code"`
+}
+
+// Like CreateUser, for accounts that must verify their email before signing in.
+func (q *Queries) CreateInactiveUser(ctx context.Context, db DBTX, arg CreateInactiveUserParams) (uuid.UUID, error) {
+	row := db.QueryRow(ctx, createInactiveUser,
+		arg.Email,
+		arg.PasswordHash,
+		arg.Role,
+		arg.CompanyID,
+		arg.ReferralCode,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
 const createUser = `-- name: CreateUser :one
-INSERT INTO users (email, password_hash, role, company_id, is_active)
-VALUES ($1, $2, $3, $4, true)
+INSERT INTO users (email, password_hash, role, company_id, referral_code, is_active)
+VALUES ($1, $2, $3, $4, $5, true)
+ON CONFLICT (referral_code) DO NOTHING
 RETURNING id
 `
 
@@ -23,6 +64,7 @@ type CreateUserParams struct {
 	PasswordHash string      `json:"password_hash"`
 	Role         string      `json:"role"`
 	CompanyID    pgtype.UUID `json:"company_id"`
+	ReferralCode string      `json:"referral_code"`
 }
 
 func (q *Queries) CreateUser(ctx context.Context, db DBTX, arg CreateUserParams) (uuid.UUID, error) {
@@ -31,6 +73,7 @@ func (q *Queries) CreateUser(ctx context.Context, db DBTX, arg CreateUserParams)
 		arg.PasswordHash,
 		arg.Role,
 		arg.CompanyID,
+		arg.ReferralCode,
 	)
 	var id uuid.UUID
 	err := row.Scan(&id)
@@ -70,20 +113,21 @@ func (q *Queries) FindUserByEmail(ctx context.Context, db DBTX, email string) (U
 }
 
 const findUserByID = `-- name: FindUserByID :one
-SELECT id, email, role, company_id, last_login, is_active, created_at, updated_at
+SELECT id, email, role, company_id, last_login, is_active, created_at, updated_at, phone_ciphertext
 FROM users 
 WHERE id = $1
 `
 
 type FindUserByIDRow struct {
-	ID        uuid.UUID          `json:"id"`
-	Email     string             `json:"email"`
-	Role      string             `json:"role"`
-	CompanyID pgtype.UUID        `json:"company_id"`
-	LastLogin pgtype.Timestamptz `json:"last_login"`
-	IsActive  bool               `json:"is_active"`
-	CreatedAt pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+	ID              uuid.UUID          `json:"id"`
+	Email           string             `json:"email"`
+	Role            string             `json:"role"`
+	CompanyID       pgtype.UUID        `json:"company_id"`
+	LastLogin       pgtype.Timestamptz `json:"last_login"`
+	IsActive        bool               `json:"is_active"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+	PhoneCiphertext pgtype.Text        `json:"phone_ciphertext"`
 }
 
 func (q *Queries) FindUserByID(ctx context.Context, db DBTX, id uuid.UUID) (FindUserByIDRow, error) {
@@ -98,10 +142,110 @@ func (q *Queries) FindUserByID(ctx context.Context, db DBTX, id uuid.UUID) (Find
 		&i.IsActive,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.PhoneCiphertext,
 	)
 	return i, err
 }
 
+const getUserPasswordHashForUpdate = `-- name: GetUserPasswordHashForUpdate :one
+SELECT password_hash FROM users
+WHERE id = $1
+FOR UPDATE
+`
+
+// Locks the row so concurrent password changes are checked one after the other.
+func (q *Queries) GetUserPasswordHashForUpdate(ctx context.Context, db DBTX, id uuid.UUID) (string, error) {
+	row := db.QueryRow(ctx, getUserPasswordHashForUpdate, id)
+	var password_hash string
+	err := row.Scan(&password_hash)
+	return password_hash, err
+}
+
+const setUserExternalID = `-- name: SetUserExternalID :exec
+UPDATE users
+SET external_id = $2, updated_at = NOW()
+WHERE id = $1
+`
+
+type SetUserExternalIDParams struct {
+	ID         uuid.UUID   `json:"id"`
+	ExternalID pgtype.Text `json:"external_id"`
+}
+
+func (q *Queries) SetUserExternalID(ctx context.Context, db DBTX, arg SetUserExternalIDParams) error {
+	_, err := db.Exec(ctx, setUserExternalID, arg.ID, arg.ExternalID)
+	return err
+}
+
+const setUserPassword = `-- name: SetUserPassword :exec
+UPDATE users
+SET password_hash = $2, updated_at = NOW()
+WHERE id = $1
+`
+
+type SetUserPasswordParams struct {
+	ID           uuid.UUID `json:"id"`
+	PasswordHash string    `json:"password_hash"`
+}
+
+func (q *Queries) SetUserPassword(ctx context.Context, db DBTX, arg SetUserPasswordParams) error {
+	_, err := db.Exec(ctx, setUserPassword, arg.ID, arg.PasswordHash)
+	return err
+}
+
+const setUserPhone = `-- name: SetUserPhone :exec
+UPDATE users
+SET phone_ciphertext = $2, updated_at = NOW()
+WHERE id = $1
+`
+
+type SetUserPhoneParams struct {
+	ID              uuid.UUID   `json:"id"`
+	PhoneCiphertext pgtype.Text `json:"phone_ciphertext"`
+}
+
+func (q *Queries) SetUserPhone(ctx context.Context, db DBTX, arg SetUserPhoneParams) error {
+	_, err := db.Exec(ctx, setUserPhone, arg.ID, arg.PhoneCiphertext)
+	return err
+}
+
+const setUserRole = `-- name: SetUserRole :exec
+UPDATE users
+SET role = $2, updated_at = NOW()
+WHERE id = $1
+`
+
+type SetUserRoleParams struct {
+	ID   uuid.UUID `json:"id"`
+	Role string    `json:"role"`
+}
+
+func (q *Queries) SetUserRole(ctx context.Context, db DBTX, arg SetUserRoleParams) error {
+	_, err := db.Exec(ctx, setUserRole, arg.ID, arg.Role)
+	return err
+}
+
+const touchUserDevice = `-- name: TouchUserDevice :one
+INSERT INTO user_devices (user_id, fingerprint, first_seen_at, last_seen_at)
+VALUES ($1, $2, $3::timestamptz, $3::timestamptz)
+ON CONFLICT (user_id, fingerprint) DO UPDATE SET last_seen_at = EXCLUDED.last_seen_at
+RETURNING (xmax = 0)::boolean AS inserted
+`
+
+type TouchUserDeviceParams struct {
+	UserID      uuid.UUID          `json:"user_id"`
+	Fingerprint string             `json:"fingerprint"`
+	SeenAt      pgtype.Timestamptz `json:"seen_at"`
+}
+
+// Reports whether the fingerprint is new for the user; xmax is 0 only on a fresh insert.
+func (q *Queries) TouchUserDevice(ctx context.Context, db DBTX, arg TouchUserDeviceParams) (bool, error) {
+	row := db.QueryRow(ctx, touchUserDevice, arg.UserID, arg.Fingerprint, arg.SeenAt)
+	var inserted bool
+	err := row.Scan(&inserted)
+	return inserted, err
+}
+
 const updateUserLastLogin = `-- name: UpdateUserLastLogin :exec
 UPDATE users 
 SET last_login = NOW(), updated_at = NOW()
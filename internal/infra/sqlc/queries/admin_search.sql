@@ -0,0 +1,40 @@
+-- name: AdminSearchUsers :many
+-- Users whose email contains the pattern, closest match first.
+SELECT id, email, role, company_id, is_active
+FROM users
+WHERE email::text ILIKE @pattern::text
+ORDER BY similarity(email::text, @query::text) DESC, id
+LIMIT @limit_count;
+
+-- name: AdminSearchReservationByID :one
+SELECT id, user_id, resource_id, status, lower(slot)::timestamptz AS start_time, upper(slot)::timestamptz AS end_time
+FROM reservations
+WHERE id = @id;
+
+-- name: AdminSearchReservationNotes :many
+-- Reservations whose note, the opening message of the thread, contains the pattern.
+SELECT r.id, r.user_id, r.resource_id, r.status, lower(r.slot)::timestamptz AS start_time, upper(r.slot)::timestamptz AS end_time
+FROM reservation_messages m
+JOIN reservations r ON r.id = m.reservation_id
+WHERE m.body ILIKE @pattern::text
+  AND NOT EXISTS (
+      SELECT 1 FROM reservation_messages earlier
+      WHERE earlier.reservation_id = m.reservation_id
+        AND (earlier.created_at, earlier.id) < (m.created_at, m.id)
+  )
+ORDER BY similarity(m.body, @query::text) DESC, r.id
+LIMIT @limit_count;
+
+-- name: AdminSearchResources :many
+SELECT id, name, company_id
+FROM resources
+WHERE name ILIKE @pattern::text
+ORDER BY similarity(name, @query::text) DESC, id
+LIMIT @limit_count;
+
+-- name: AdminSearchReviews :many
+SELECT id, user_id, resource_id, rating, comment, status
+FROM reviews
+WHERE comment ILIKE @pattern::text
+ORDER BY similarity(comment, @query::text) DESC, id
+LIMIT @limit_count;
@@ -0,0 +1,28 @@
+-- name: CreateAPIKey :one
+INSERT INTO api_keys (user_id, name, key_hash, scopes, created_by, created_at, expires_at)
+VALUES (@user_id, @name, @key_hash, @scopes, @created_by, @created_at, @expires_at)
+RETURNING id;
+
+-- name: RevokeAPIKey :execrows
+UPDATE api_keys
+SET revoked_at = @revoked_at::timestamptz
+WHERE id = @id
+  AND user_id = @user_id
+  AND revoked_at IS NULL;
+
+-- name: ListUserAPIKeys :many
+SELECT id, name, scopes, created_at, expires_at, revoked_at
+FROM api_keys
+WHERE user_id = @user_id
+ORDER BY created_at DESC, id;
+
+-- name: FindAPIKeyIdentity :one
+-- The user a live key acts as, with the user's current role; keys of inactive users are
+-- refused like revoked ones.
+SELECT k.id, k.user_id, u.role, k.scopes
+FROM api_keys k
+JOIN users u ON u.id = k.user_id
+WHERE k.key_hash = @key_hash
+  AND k.revoked_at IS NULL
+  AND (k.expires_at IS NULL OR k.expires_at > @now::timestamptz)
+  AND u.is_active;
@@ -0,0 +1,71 @@
+-- name: CreateAuditLog :exec
+INSERT INTO audit_logs (
+    actor_id,
+    company_id,
+    action,
+    target_type,
+    target_id,
+    metadata
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+);
+
+-- name: ListUserSecurityEventsFirstPage :many
+SELECT
+    id,
+    action,
+    metadata,
+    created_at
+FROM audit_logs
+WHERE target_type = 'user'
+  AND target_id = @user_id::text
+  AND action = ANY(@actions::text[])
+ORDER BY created_at DESC, id DESC
+LIMIT @limit_count;
+
+-- name: ListUserSecurityEventsKeyset :many
+SELECT
+    id,
+    action,
+    metadata,
+    created_at
+FROM audit_logs
+WHERE target_type = 'user'
+  AND target_id = @user_id::text
+  AND action = ANY(@actions::text[])
+  AND (created_at < @last_created_at OR (created_at = @last_created_at AND id < @last_id))
+ORDER BY created_at DESC, id DESC
+LIMIT @limit_count;
+
+-- name: ListAuditLogsFirstPage :many
+SELECT
+    id,
+    actor_id,
+    company_id,
+    action,
+    target_type,
+    target_id,
+    metadata,
+    created_at
+FROM audit_logs
+WHERE (sqlc.narg(action)::text IS NULL OR action = sqlc.narg(action)::text)
+  AND (sqlc.narg(actor_id)::uuid IS NULL OR actor_id = sqlc.narg(actor_id)::uuid)
+ORDER BY created_at DESC, id DESC
+LIMIT @limit_count;
+
+-- name: ListAuditLogsKeyset :many
+SELECT
+    id,
+    actor_id,
+    company_id,
+    action,
+    target_type,
+    target_id,
+    metadata,
+    created_at
+FROM audit_logs
+WHERE (sqlc.narg(action)::text IS NULL OR action = sqlc.narg(action)::text)
+  AND (sqlc.narg(actor_id)::uuid IS NULL OR actor_id = sqlc.narg(actor_id)::uuid)
+  AND (created_at < @last_created_at OR (created_at = @last_created_at AND id < @last_id))
+ORDER BY created_at DESC, id DESC
+LIMIT @limit_count;
@@ -0,0 +1,54 @@
+-- name: CountCompanyResources :one
+SELECT COUNT(*) FROM resources WHERE company_id = $1;
+
+-- name: CountResourceCompanyOperators :one
+-- Distinct operators across every resource of the resource's company; company_id is
+-- NULL for shared resources. No row when the resource does not exist.
+SELECT
+    r.company_id,
+    COUNT(DISTINCT ro.user_id)::bigint AS operator_count,
+    COALESCE(bool_or(ro.user_id = @user_id), false)::boolean AS is_operator
+FROM resources r
+LEFT JOIN resources cr ON cr.company_id = r.company_id
+LEFT JOIN resource_operators ro ON ro.resource_id = cr.id
+WHERE r.id = @resource_id
+GROUP BY r.company_id;
+
+-- name: CreateSubscription :exec
+INSERT INTO subscriptions (company_id, plan_id, status)
+VALUES ($1, $2, $3);
+
+-- name: GetCompanyPlan :one
+SELECT plan_id, status, max_resources, max_operators, monthly_request_quota
+FROM company_plans
+WHERE company_id = $1;
+
+-- name: RecordBillingEvent :execrows
+-- Affects no rows when the event was already applied.
+INSERT INTO billing_events (provider_event_id, event_type)
+VALUES ($1, $2)
+ON CONFLICT (provider_event_id) DO NOTHING;
+
+-- name: SyncSubscription :exec
+-- Deliveries older than the stored state are ignored.
+INSERT INTO subscriptions (
+    company_id,
+    plan_id,
+    status,
+    provider_customer_id,
+    provider_subscription_id,
+    current_period_end,
+    provider_updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+)
+ON CONFLICT (company_id) DO UPDATE SET
+    plan_id = EXCLUDED.plan_id,
+    status = EXCLUDED.status,
+    provider_customer_id = EXCLUDED.provider_customer_id,
+    provider_subscription_id = EXCLUDED.provider_subscription_id,
+    current_period_end = EXCLUDED.current_period_end,
+    provider_updated_at = EXCLUDED.provider_updated_at,
+    updated_at = now()
+WHERE subscriptions.provider_updated_at IS NULL
+   OR subscriptions.provider_updated_at <= EXCLUDED.provider_updated_at;
@@ -0,0 +1,66 @@
+-- name: CreateCompany :one
+INSERT INTO companies (
+    name
+) VALUES (
+    $1
+)
+RETURNING id;
+
+-- name: CreateCompanySettings :exec
+INSERT INTO company_settings (
+    company_id,
+    timezone,
+    default_lead_time_min
+) VALUES (
+    $1, $2, $3
+);
+
+-- name: GetResourceReviewWindow :one
+-- Shared resources (no company) and companies without their own setting return NULLs.
+SELECT
+    cs.review_opens_after_min,
+    cs.review_window_days
+FROM resources r
+LEFT JOIN company_settings cs ON cs.company_id = r.company_id
+WHERE r.id = $1;
+
+-- name: SetCompanyReviewWindow :exec
+INSERT INTO company_settings (company_id, review_opens_after_min, review_window_days, updated_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (company_id) DO UPDATE SET
+    review_opens_after_min = EXCLUDED.review_opens_after_min,
+    review_window_days = EXCLUDED.review_window_days,
+    updated_at = EXCLUDED.updated_at;
+
+-- name: GetCompanyBranding :one
+-- Companies without settings return NULLs; unknown companies return no row.
+SELECT
+    c.name,
+    cs.brand_logo_url,
+    cs.brand_primary_color,
+    cs.brand_accent_color,
+    cs.brand_reply_to,
+    cs.brand_footer
+FROM companies c
+LEFT JOIN company_settings cs ON cs.company_id = c.id
+WHERE c.id = $1;
+
+-- name: SetCompanyBranding :exec
+INSERT INTO company_settings (
+    company_id,
+    brand_logo_url,
+    brand_primary_color,
+    brand_accent_color,
+    brand_reply_to,
+    brand_footer,
+    updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+)
+ON CONFLICT (company_id) DO UPDATE SET
+    brand_logo_url = EXCLUDED.brand_logo_url,
+    brand_primary_color = EXCLUDED.brand_primary_color,
+    brand_accent_color = EXCLUDED.brand_accent_color,
+    brand_reply_to = EXCLUDED.brand_reply_to,
+    brand_footer = EXCLUDED.brand_footer,
+    updated_at = EXCLUDED.updated_at;
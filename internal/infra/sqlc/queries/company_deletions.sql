@@ -0,0 +1,257 @@
+-- name: AnonymizeCompanyReviews :execrows
+-- Hands reviews company members wrote about other companies' resources to the deleted-user
+-- placeholder, so the reviews and their ratings survive the members.
+UPDATE reviews
+SET user_id = @deleted_user_id::uuid, updated_at = now()
+WHERE id IN (
+    SELECT rv.id FROM reviews rv
+    JOIN users u ON u.id = rv.user_id
+    JOIN resources res ON res.id = rv.resource_id
+    WHERE u.company_id = @company_id::uuid AND res.company_id IS DISTINCT FROM @company_id::uuid
+    ORDER BY rv.id
+    LIMIT @batch_size
+);
+
+-- name: CancelCompanyReservations :many
+-- Cancels upcoming reservations on the company's resources and those its members hold
+-- elsewhere, withdrawing their pending approvals. member is false for reservations of
+-- users outside the company, who are told about the cancellation.
+WITH canceled AS (
+    UPDATE reservations r
+    SET status = 'canceled', updated_at = now()
+    FROM users u
+    WHERE u.id = r.user_id AND r.id IN (
+        SELECT r2.id FROM reservations r2
+        JOIN users u2 ON u2.id = r2.user_id
+        JOIN resources res ON res.id = r2.resource_id
+        WHERE r2.status IN ('confirmed', 'pending_approval')
+          AND upper(r2.slot) > @now::timestamptz
+          AND (res.company_id = @company_id::uuid OR u2.company_id = @company_id::uuid)
+        ORDER BY r2.id
+        LIMIT @batch_size
+        FOR UPDATE OF r2
+    )
+    RETURNING r.id, r.user_id, r.resource_id, lower(r.slot) AS start_time, upper(r.slot) AS end_time,
+        u.company_id IS NOT DISTINCT FROM @company_id::uuid AS member
+),
+withdrawn AS (
+    UPDATE reservation_approvals a
+    SET status = 'withdrawn', decided_at = @now::timestamptz, updated_at = now()
+    WHERE a.status = 'pending' AND a.reservation_id IN (SELECT id FROM canceled)
+)
+SELECT id, user_id, resource_id, start_time::timestamptz AS start_time, end_time::timestamptz AS end_time, member::boolean AS member
+FROM canceled;
+
+-- name: ClaimCompanyDeletion :one
+-- Takes the least recently touched deletion that is pending, or running but not
+-- checkpointed since stale_before; SKIP LOCKED keeps concurrent runs apart.
+UPDATE company_deletions
+SET status = 'running', attempts = attempts + 1, updated_at = @now::timestamptz,
+    started_at = coalesce(started_at, @now::timestamptz)
+WHERE id = (
+    SELECT d.id FROM company_deletions d
+    WHERE d.status = 'pending' OR (d.status = 'running' AND d.updated_at < @stale_before::timestamptz)
+    ORDER BY d.updated_at
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, company_id, company_name, requested_by, stage, counts, created_at, started_at;
+
+-- name: CheckpointCompanyDeletion :exec
+UPDATE company_deletions
+SET stage = $2, counts = $3, updated_at = $4, error = NULL
+WHERE id = $1;
+
+-- name: CompleteCompanyDeletion :exec
+UPDATE company_deletions
+SET status = 'done', stage = 'done', certificate = $2, completed_at = $3, updated_at = $3, error = NULL
+WHERE id = $1;
+
+-- name: CreateCompanyDeletion :exec
+INSERT INTO company_deletions (id, company_id, company_name, requested_by, stage, counts, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $7);
+
+-- name: DeleteCompany :exec
+DELETE FROM companies WHERE id = $1;
+
+-- name: DeleteCompanyObjects :exec
+-- Removes the rows of stored files that were already deleted from file storage.
+WITH attachments AS (
+    DELETE FROM reservation_attachments WHERE storage_key = ANY(@storage_keys::text[])
+),
+captures AS (
+    DELETE FROM recorded_requests WHERE storage_key = ANY(@storage_keys::text[])
+)
+DELETE FROM company_exports WHERE storage_key = ANY(@storage_keys::text[]);
+
+-- name: DeleteCompanyResources :one
+-- Deletes a batch of the company's resources with every reservation and review on them.
+-- Loyalty, referral and idempotency rows of users outside the company only lose their
+-- link to the deleted reservation.
+WITH batch AS (
+    SELECT res.id FROM resources res
+    WHERE res.company_id = @company_id::uuid
+    ORDER BY res.id
+    LIMIT @batch_size
+),
+doomed AS (
+    SELECT r.id FROM reservations r WHERE r.resource_id IN (SELECT id FROM batch)
+),
+deleted_reviews AS (
+    DELETE FROM reviews
+    WHERE resource_id IN (SELECT id FROM batch) OR reservation_id IN (SELECT id FROM doomed)
+),
+ledger AS (
+    UPDATE loyalty_ledger SET reservation_id = NULL WHERE reservation_id IN (SELECT id FROM doomed)
+),
+referral_links AS (
+    UPDATE referrals SET reservation_id = NULL WHERE reservation_id IN (SELECT id FROM doomed)
+),
+idempotency AS (
+    UPDATE idempotency_keys SET result_reservation_id = NULL WHERE result_reservation_id IN (SELECT id FROM doomed)
+),
+deleted_reservations AS (
+    DELETE FROM reservations WHERE id IN (SELECT id FROM doomed)
+),
+stats AS (
+    DELETE FROM resource_rating_stats WHERE resource_id IN (SELECT id FROM batch)
+),
+deleted AS (
+    DELETE FROM resources WHERE id IN (SELECT id FROM batch)
+    RETURNING id
+)
+SELECT count(*) FROM deleted;
+
+-- name: DeleteCompanyUsers :one
+-- Deletes a batch of the company's members with their loyalty and referral history,
+-- idempotency keys, terms acceptances, messages, uploads, transfers and queued emails.
+-- Their reservations, reviews and resource blocks pass to the deleted-user placeholder;
+-- those on the company's own resources go with the resources.
+WITH batch AS (
+    SELECT u.id FROM users u
+    WHERE u.company_id = @company_id::uuid
+    ORDER BY u.id
+    LIMIT @batch_size
+),
+reviews AS (
+    UPDATE reviews SET user_id = @deleted_user_id::uuid, updated_at = now()
+    WHERE user_id IN (SELECT id FROM batch)
+),
+reservations AS (
+    UPDATE reservations SET user_id = @deleted_user_id::uuid, updated_at = now()
+    WHERE user_id IN (SELECT id FROM batch)
+),
+blocks AS (
+    UPDATE resource_blocks SET created_by = @deleted_user_id::uuid
+    WHERE created_by IN (SELECT id FROM batch)
+),
+idempotency AS (
+    DELETE FROM idempotency_keys WHERE user_id IN (SELECT id FROM batch)
+),
+referral_credits AS (
+    DELETE FROM referral_credits
+    WHERE user_id IN (SELECT id FROM batch) OR referral_id IN (
+        SELECT rf.id FROM referrals rf
+        WHERE rf.referrer_id IN (SELECT id FROM batch) OR rf.referred_id IN (SELECT id FROM batch)
+    )
+),
+referrals AS (
+    DELETE FROM referrals
+    WHERE referrer_id IN (SELECT id FROM batch) OR referred_id IN (SELECT id FROM batch)
+),
+ledger AS (
+    DELETE FROM loyalty_ledger WHERE user_id IN (SELECT id FROM batch)
+),
+tos AS (
+    DELETE FROM tos_acceptances WHERE user_id IN (SELECT id FROM batch)
+),
+messages AS (
+    DELETE FROM reservation_messages WHERE author_id IN (SELECT id FROM batch)
+),
+attachments AS (
+    DELETE FROM reservation_attachments WHERE uploaded_by IN (SELECT id FROM batch)
+),
+transfers AS (
+    DELETE FROM reservation_transfers
+    WHERE from_user_id IN (SELECT id FROM batch) OR to_user_id IN (SELECT id FROM batch)
+       OR initiated_by IN (SELECT id FROM batch)
+),
+emails AS (
+    DELETE FROM notification_jobs
+    WHERE status = 'queued' AND payload->>'user_id' IN (SELECT id::text FROM batch)
+),
+deleted AS (
+    DELETE FROM users WHERE id IN (SELECT id FROM batch)
+    RETURNING id
+)
+SELECT count(*) FROM deleted;
+
+-- name: EnsureDeletedUser :exec
+-- The inactive placeholder that keeps rows whose author was deleted with a company.
+INSERT INTO users (id, email, password_hash, role, is_active, referral_code)
+VALUES (@id::uuid, 'deleted-user@deleted.invalid', '!', 'viewer', false, 'DELETED-USER')
+ON CONFLICT (id) DO NOTHING;
+
+-- name: GetCompanyDeletion :one
+SELECT * FROM company_deletions
+WHERE id = $1;
+
+-- name: GetCompanyDeletionTarget :one
+-- Locks the company for the deletion request; member tells whether the requester belongs
+-- to it.
+SELECT c.name, EXISTS (
+    SELECT 1 FROM users u WHERE u.id = @actor_id::uuid AND u.company_id = c.id
+) AS member
+FROM companies c
+WHERE c.id = @company_id::uuid
+FOR UPDATE OF c;
+
+-- name: ListCompanyObjects :many
+-- Storage keys of files the company's data refers to: attachments on its reservations or
+-- uploaded by its members, captured requests of its members and export archives.
+SELECT a.storage_key FROM reservation_attachments a
+WHERE a.uploaded_by IN (SELECT u.id FROM users u WHERE u.company_id = @company_id::uuid)
+   OR a.reservation_id IN (
+        SELECT r.id FROM reservations r
+        JOIN resources res ON res.id = r.resource_id
+        WHERE res.company_id = @company_id::uuid
+   )
+UNION ALL
+SELECT q.storage_key FROM recorded_requests q
+JOIN request_recordings rr ON rr.id = q.recording_id
+JOIN users u ON u.id = rr.user_id
+WHERE u.company_id = @company_id::uuid
+UNION ALL
+SELECT e.storage_key FROM company_exports e
+WHERE e.company_id = @company_id::uuid AND e.storage_key IS NOT NULL
+LIMIT @batch_size;
+
+-- name: LockCompanyForDeletion :one
+-- Shuts the company out ahead of the purge: deactivates its members, revokes SCIM and
+-- SAML access, drops invites and exports that have not finished, and returns how many
+-- members were deactivated.
+WITH tokens AS (
+    DELETE FROM provisioning_tokens WHERE company_id = @company_id::uuid
+),
+saml AS (
+    DELETE FROM company_saml_connections WHERE company_id = @company_id::uuid
+),
+invites AS (
+    DELETE FROM invites WHERE company_id = @company_id::uuid
+),
+exports AS (
+    DELETE FROM company_exports
+    WHERE company_id = @company_id::uuid AND status IN ('pending', 'running')
+),
+deactivated AS (
+    UPDATE users SET is_active = false, updated_at = now()
+    WHERE company_id = @company_id::uuid AND is_active
+    RETURNING id
+)
+SELECT count(*) FROM deactivated;
+
+-- name: ReleaseCompanyDeletion :exec
+-- Puts a deletion whose run failed back in the queue behind the others.
+UPDATE company_deletions
+SET status = 'pending', error = $2, updated_at = $3
+WHERE id = $1;
@@ -0,0 +1,99 @@
+-- name: ClaimCompanyExport :one
+-- Starts the oldest queued export, or one whose run went stale; SKIP LOCKED lets several
+-- instances run the job without taking the same export.
+UPDATE company_exports
+SET status = 'running', progress = 0, started_at = @now::timestamptz
+WHERE id = (
+    SELECT e.id FROM company_exports e
+    WHERE e.status = 'pending' OR (e.status = 'running' AND e.started_at < @stale_before::timestamptz)
+    ORDER BY e.created_at
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, company_id;
+
+-- name: CompleteCompanyExport :exec
+UPDATE company_exports
+SET status = 'done', progress = 100, storage_key = $2, size_bytes = $3, completed_at = $4, expires_at = $5
+WHERE id = $1;
+
+-- name: CountCompanyExportRows :one
+SELECT
+    (SELECT count(*) FROM users u WHERE u.company_id = @company_id::uuid) AS users,
+    (SELECT count(*) FROM resources res WHERE res.company_id = @company_id::uuid) AS resources,
+    (SELECT count(*) FROM reservations r JOIN resources res ON res.id = r.resource_id
+        WHERE res.company_id = @company_id::uuid) AS reservations,
+    (SELECT count(*) FROM reviews rv JOIN resources res ON res.id = rv.resource_id
+        WHERE res.company_id = @company_id::uuid) AS reviews;
+
+-- name: CreateCompanyExport :exec
+INSERT INTO company_exports (id, company_id, requested_by, created_at)
+VALUES ($1, $2, $3, $4);
+
+-- name: DeleteCompanyExport :one
+DELETE FROM company_exports WHERE id = $1
+RETURNING storage_key;
+
+-- name: FailCompanyExport :exec
+UPDATE company_exports
+SET status = 'failed', error = $2, completed_at = $3, expires_at = $4
+WHERE id = $1;
+
+-- name: GetCompanyExport :one
+SELECT * FROM company_exports
+WHERE id = $1;
+
+-- name: GetCompanyExportProfile :one
+-- The company with its settings, feature overrides, custom field definitions and plan.
+SELECT jsonb_build_object(
+    'company', to_jsonb(c),
+    'settings', (SELECT to_jsonb(s) - 'company_id' FROM company_settings s WHERE s.company_id = c.id),
+    'features', (SELECT coalesce(jsonb_agg(to_jsonb(f) - 'company_id' ORDER BY f.feature), '[]'::jsonb)
+        FROM company_features f WHERE f.company_id = c.id),
+    'custom_fields', (SELECT coalesce(jsonb_agg(to_jsonb(d) - 'company_id' ORDER BY d.created_at, d.id), '[]'::jsonb)
+        FROM custom_field_definitions d WHERE d.company_id = c.id),
+    'subscription', (SELECT to_jsonb(sub) - 'company_id' FROM subscriptions sub WHERE sub.company_id = c.id)
+)::jsonb AS profile
+FROM companies c
+WHERE c.id = $1;
+
+-- name: ListCompanyExportReservations :many
+SELECT r.id, to_jsonb(r) AS doc
+FROM reservations r
+JOIN resources res ON res.id = r.resource_id
+WHERE res.company_id = @company_id::uuid AND r.id > @after_id::uuid
+ORDER BY r.id
+LIMIT @batch_size;
+
+-- name: ListCompanyExportResources :many
+SELECT res.id, to_jsonb(res) AS doc
+FROM resources res
+WHERE res.company_id = @company_id::uuid AND res.id > @after_id::uuid
+ORDER BY res.id
+LIMIT @batch_size;
+
+-- name: ListCompanyExportReviews :many
+SELECT rv.id, to_jsonb(rv) AS doc
+FROM reviews rv
+JOIN resources res ON res.id = rv.resource_id
+WHERE res.company_id = @company_id::uuid AND rv.id > @after_id::uuid
+ORDER BY rv.id
+LIMIT @batch_size;
+
+-- name: ListCompanyExportUsers :many
+-- Password hashes and phone ciphertext stay out of the archive.
+SELECT u.id, to_jsonb(u) - '{password_hash,phone_ciphertext}'::text[] AS doc
+FROM users u
+WHERE u.company_id = @company_id::uuid AND u.id > @after_id::uuid
+ORDER BY u.id
+LIMIT @batch_size;
+
+-- name: ListExpiredCompanyExports :many
+SELECT id FROM company_exports
+WHERE expires_at <= @now::timestamptz
+ORDER BY expires_at
+LIMIT @batch_size;
+
+-- name: SetCompanyExportProgress :exec
+UPDATE company_exports SET progress = $2
+WHERE id = $1 AND status = 'running';
@@ -7,7 +7,8 @@ SELECT
     valid_from,
     valid_to,
     created_at,
-    updated_at
+    updated_at,
+    priority
 FROM coupons 
 WHERE code = $1;
 
@@ -20,6 +21,7 @@ SELECT
     valid_from,
     valid_to,
     created_at,
-    updated_at
+    updated_at,
+    priority
 FROM coupons 
 WHERE id = $1;
@@ -0,0 +1,32 @@
+-- name: CountFutureSubscriptionWatermarks :one
+-- Webhook watermarks past the limit make every later delivery look stale.
+SELECT COUNT(*) FROM subscriptions
+WHERE provider_updated_at > @limit_at::timestamptz;
+
+-- name: ClearFutureSubscriptionWatermarks :execrows
+-- Cleared rather than clamped: the next delivery is accepted and sets a fresh watermark.
+UPDATE subscriptions
+SET provider_updated_at = NULL,
+    updated_at = NOW()
+WHERE provider_updated_at > @limit_at::timestamptz;
+
+-- name: CountFutureMessageReads :one
+-- Read markers past the limit hide later messages from the unread counts.
+SELECT COUNT(*) FROM reservation_message_reads
+WHERE last_read_at > @limit_at::timestamptz;
+
+-- name: ClampFutureMessageReads :execrows
+UPDATE reservation_message_reads
+SET last_read_at = @now::timestamptz
+WHERE last_read_at > @limit_at::timestamptz;
+
+-- name: CountFutureNotificationJobs :one
+-- Jobs are enqueued to run immediately, so a queued job past the limit would sit unsent.
+SELECT COUNT(*) FROM notification_jobs
+WHERE status = 'queued' AND run_at > @limit_at::timestamptz;
+
+-- name: ReleaseFutureNotificationJobs :execrows
+UPDATE notification_jobs
+SET run_at = @now::timestamptz,
+    updated_at = NOW()
+WHERE status = 'queued' AND run_at > @limit_at::timestamptz;
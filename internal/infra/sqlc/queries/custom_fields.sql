@@ -0,0 +1,37 @@
+-- name: CreateCustomFieldDefinition :one
+-- Inserts nothing when resource_id is set but not a resource of the company.
+INSERT INTO custom_field_definitions (
+    company_id,
+    resource_id,
+    key,
+    label,
+    field_type,
+    required,
+    options,
+    created_at
+)
+SELECT @company_id::uuid, sqlc.narg(resource_id)::uuid, @key::text, @label::text, @field_type::text, @required::boolean, @options::text[], @created_at::timestamptz
+WHERE sqlc.narg(resource_id)::uuid IS NULL OR EXISTS (
+    SELECT 1 FROM resources
+    WHERE id = sqlc.narg(resource_id)::uuid AND company_id = @company_id::uuid
+)
+RETURNING id;
+
+-- name: DeleteCustomFieldDefinition :execrows
+DELETE FROM custom_field_definitions
+WHERE id = @id AND company_id = @company_id;
+
+-- name: ListCustomFieldDefinitionsByCompany :many
+SELECT *
+FROM custom_field_definitions
+WHERE company_id = $1
+ORDER BY created_at, id;
+
+-- name: ListCustomFieldDefinitionsForResource :many
+-- The company-wide fields of the resource's company plus the resource's own.
+SELECT d.*
+FROM custom_field_definitions AS d
+INNER JOIN resources AS res ON res.company_id = d.company_id
+WHERE res.id = @resource_id
+  AND (d.resource_id IS NULL OR d.resource_id = @resource_id)
+ORDER BY d.created_at, d.id;
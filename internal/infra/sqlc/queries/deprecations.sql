@@ -0,0 +1,24 @@
+-- name: AddDeprecatedRouteCalls :exec
+INSERT INTO deprecated_route_calls (day, route, user_id, call_count, last_called_at)
+VALUES (@day::date, @route::text, sqlc.narg(user_id)::uuid, @call_count::bigint, @last_called_at::timestamptz)
+ON CONFLICT (day, route, user_id) DO UPDATE SET
+    call_count = deprecated_route_calls.call_count + EXCLUDED.call_count,
+    last_called_at = GREATEST(deprecated_route_calls.last_called_at, EXCLUDED.last_called_at);
+
+-- name: ListDeprecatedRouteCalls :many
+-- Anonymous calls come back as one row per route without a user.
+SELECT
+    d.route,
+    d.user_id,
+    u.email,
+    u.company_id,
+    c.name AS company_name,
+    SUM(d.call_count)::bigint AS call_count,
+    MAX(d.last_called_at)::timestamptz AS last_called_at
+FROM deprecated_route_calls d
+LEFT JOIN users u ON u.id = d.user_id
+LEFT JOIN companies c ON c.id = u.company_id
+WHERE d.day >= @from_day::date AND d.day <= @to_day::date
+  AND (sqlc.narg(route)::text IS NULL OR d.route = sqlc.narg(route)::text)
+GROUP BY d.route, d.user_id, u.email, u.company_id, c.name
+ORDER BY d.route, call_count DESC, d.user_id;
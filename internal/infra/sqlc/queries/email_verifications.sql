@@ -0,0 +1,9 @@
+-- name: CreateEmailVerification :exec
+INSERT INTO email_verifications (user_id, nonce, expires_at, created_at)
+VALUES (@user_id, @nonce, @expires_at, @created_at);
+
+-- name: ConsumeEmailVerification :one
+-- Spends the link: only the nonce last mailed matches, and only once.
+DELETE FROM email_verifications
+WHERE user_id = @user_id AND nonce = @nonce
+RETURNING expires_at;
@@ -0,0 +1,26 @@
+-- name: ListCompanyFeatures :many
+SELECT feature, enabled
+FROM company_features
+WHERE company_id = $1
+ORDER BY feature;
+
+-- name: SetCompanyFeature :exec
+INSERT INTO company_features (company_id, feature, enabled, updated_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (company_id, feature) DO UPDATE SET
+    enabled = EXCLUDED.enabled,
+    updated_at = EXCLUDED.updated_at;
+
+-- name: DeleteCompanyFeature :exec
+DELETE FROM company_features
+WHERE company_id = $1 AND feature = $2;
+
+-- name: GetResourceCompanyID :one
+SELECT company_id
+FROM resources
+WHERE id = $1;
+
+-- name: CompanyExists :one
+SELECT EXISTS (
+    SELECT 1 FROM companies WHERE id = $1
+);
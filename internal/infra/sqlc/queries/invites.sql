@@ -0,0 +1,65 @@
+-- name: CreateInvite :one
+INSERT INTO invites (
+    email,
+    company_id,
+    role,
+    invited_by,
+    nonce,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+)
+RETURNING id;
+
+-- name: GetInviteByID :one
+SELECT
+    id,
+    email,
+    company_id,
+    role,
+    invited_by,
+    nonce,
+    status,
+    expires_at,
+    accepted_user_id,
+    created_at,
+    updated_at
+FROM invites
+WHERE id = $1;
+
+-- name: ListInvitesByCompany :many
+SELECT
+    i.id,
+    i.email,
+    i.role,
+    i.status,
+    i.expires_at,
+    i.created_at,
+    u.email AS invited_by_email
+FROM invites AS i
+INNER JOIN users AS u ON i.invited_by = u.id
+WHERE i.company_id = $1
+ORDER BY i.created_at DESC, i.id DESC;
+
+-- name: ReissueInvite :execrows
+UPDATE invites
+SET
+    nonce = $2,
+    expires_at = $3,
+    updated_at = NOW()
+WHERE id = $1 AND status = 'pending';
+
+-- name: RevokeInvite :execrows
+UPDATE invites
+SET
+    status = 'revoked',
+    updated_at = NOW()
+WHERE id = $1 AND status = 'pending';
+
+-- name: AcceptInvite :execrows
+UPDATE invites
+SET
+    status = 'accepted',
+    accepted_user_id = $3,
+    updated_at = NOW()
+WHERE id = $1 AND nonce = $2 AND status = 'pending';
@@ -0,0 +1,29 @@
+-- name: GetLoginLock :one
+SELECT locked_until::timestamptz AS locked_until
+FROM login_failures
+WHERE email = @email AND ip_address = @ip_address AND locked_until > @now::timestamptz;
+
+-- name: RecordLoginFailure :one
+-- Counts a failed sign-in; a row past expires_at starts the count again.
+INSERT INTO login_failures (email, ip_address, failed_attempts, expires_at)
+VALUES (@email, @ip_address, 1, @expires_at)
+ON CONFLICT (email, ip_address) DO UPDATE
+SET failed_attempts = CASE WHEN login_failures.expires_at <= @now::timestamptz THEN 1 ELSE login_failures.failed_attempts + 1 END,
+    locked_until = CASE WHEN login_failures.expires_at <= @now::timestamptz THEN NULL ELSE login_failures.locked_until END,
+    expires_at = EXCLUDED.expires_at
+RETURNING failed_attempts;
+
+-- name: LockLogin :exec
+UPDATE login_failures
+SET locked_until = @locked_until,
+    expires_at = @locked_until
+WHERE email = @email AND ip_address = @ip_address;
+
+-- name: ClearLoginFailures :exec
+DELETE FROM login_failures
+WHERE email = @email AND ip_address = @ip_address;
+
+-- name: UnlockLogins :execrows
+-- Lifts the email's lockouts from every IP.
+DELETE FROM login_failures
+WHERE email = @email AND locked_until > @now::timestamptz;
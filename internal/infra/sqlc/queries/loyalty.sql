@@ -0,0 +1,99 @@
+-- name: ListAccruableReservations :many
+-- Confirmed reservations that ended inside the lookback window, earn at least one point
+-- and have not accrued yet.
+SELECT
+    r.id,
+    r.user_id,
+    r.price_cents,
+    upper(r.slot)::timestamptz AS completed_at
+FROM reservations AS r
+WHERE r.status = 'confirmed'
+  AND upper(r.slot) <= @now::timestamptz
+  AND upper(r.slot) > @since::timestamptz
+  AND r.price_cents >= @min_price_cents::int
+  AND NOT EXISTS (
+      SELECT 1
+      FROM loyalty_ledger AS l
+      WHERE l.reservation_id = r.id AND l.kind = 'accrual'
+  )
+ORDER BY upper(r.slot), r.id
+LIMIT @batch_size::int;
+
+-- name: CreateLoyaltyAccrual :execrows
+INSERT INTO loyalty_ledger (user_id, kind, points, remaining_points, expires_at, reservation_id, created_at)
+VALUES (@user_id, 'accrual', @points::int, @points::int, @expires_at, @reservation_id, @created_at)
+ON CONFLICT (reservation_id, kind) WHERE reservation_id IS NOT NULL DO NOTHING;
+
+-- name: LockOpenLoyaltyLots :many
+SELECT
+    id,
+    remaining_points,
+    expires_at
+FROM loyalty_ledger
+WHERE user_id = $1 AND remaining_points > 0 AND expires_at > $2
+ORDER BY expires_at, id
+FOR UPDATE;
+
+-- name: DrawLoyaltyLot :execrows
+UPDATE loyalty_ledger
+SET remaining_points = remaining_points - @points::int
+WHERE id = @id AND remaining_points >= @points::int;
+
+-- name: CreateLoyaltyRedemption :exec
+INSERT INTO loyalty_ledger (user_id, kind, points, reservation_id, discount_cents, created_at)
+VALUES ($1, 'redemption', $2, $3, $4, $5);
+
+-- name: ExpireLoyaltyLots :execrows
+-- Zeroes up to batch_size lots past their expiry and records the forfeited points.
+WITH due AS (
+    SELECT id, user_id, remaining_points
+    FROM loyalty_ledger
+    WHERE remaining_points > 0 AND expires_at <= @now::timestamptz
+    ORDER BY expires_at, id
+    LIMIT @batch_size::int
+    FOR UPDATE SKIP LOCKED
+),
+expired AS (
+    UPDATE loyalty_ledger AS lot
+    SET remaining_points = 0
+    FROM due
+    WHERE lot.id = due.id
+    RETURNING due.id, due.user_id, due.remaining_points
+)
+INSERT INTO loyalty_ledger (user_id, kind, points, lot_id, created_at)
+SELECT user_id, 'expiry', -remaining_points, id, @now::timestamptz
+FROM expired;
+
+-- name: GetLoyaltyBalance :one
+SELECT COALESCE(SUM(remaining_points), 0)::bigint AS balance
+FROM loyalty_ledger
+WHERE user_id = $1 AND remaining_points > 0 AND expires_at > $2;
+
+-- name: ListLoyaltyEntriesFirstPage :many
+SELECT
+    id,
+    kind,
+    points,
+    expires_at,
+    reservation_id,
+    discount_cents,
+    created_at
+FROM loyalty_ledger
+WHERE user_id = $1
+ORDER BY created_at DESC, id DESC
+LIMIT $2;
+
+-- name: ListLoyaltyEntriesKeyset :many
+SELECT
+    id,
+    kind,
+    points,
+    expires_at,
+    reservation_id,
+    discount_cents,
+    created_at
+FROM loyalty_ledger
+WHERE user_id = $1
+  AND (created_at < $2 OR (created_at = $2 AND id < $3))
+ORDER BY created_at DESC, id DESC
+LIMIT $4;
@@ -0,0 +1,33 @@
+-- name: GetNotificationPreferences :one
+SELECT
+    u.notification_digest,
+    (SELECT count(*) FROM notification_jobs n WHERE n.user_id = u.id AND n.status = 'held') AS held
+FROM users u
+WHERE u.id = $1;
+
+-- name: SetNotificationDigest :execrows
+UPDATE users
+SET notification_digest = @digest, updated_at = NOW()
+WHERE id = @user_id;
+
+-- name: ListDueNotificationDigests :many
+-- Users whose oldest held notification has waited out their digest period, and users who
+-- turned the digest off while notifications were still held for it.
+SELECT u.id, u.notification_digest
+FROM notification_jobs n
+JOIN users u ON u.id = n.user_id
+WHERE n.status = 'held'
+GROUP BY u.id
+HAVING u.notification_digest = 'off'
+    OR min(n.created_at) <= @now::timestamptz
+       - CASE u.notification_digest WHEN 'weekly' THEN interval '7 days' ELSE interval '1 day' END
+ORDER BY min(n.created_at)
+LIMIT @batch_size::int;
+
+-- name: TakeHeldNotificationJobs :many
+-- Marks the user's held jobs digested and returns them. A concurrent run that took them
+-- first leaves none.
+UPDATE notification_jobs
+SET status = 'digested', updated_at = @now::timestamptz
+WHERE user_id = @user_id::uuid AND status = 'held'
+RETURNING id, topic, payload, created_at;
@@ -9,6 +9,28 @@ INSERT INTO notification_jobs (
     $1, $2, $3, $4, $5
 );
 
+-- name: CreateUserNotificationJob :exec
+-- A digestible job for a user who takes a digest is held for it rather than queued.
+INSERT INTO notification_jobs (
+    user_id,
+    kind,
+    topic,
+    payload,
+    run_at,
+    status
+) VALUES (
+    @user_id::uuid,
+    @kind,
+    @topic,
+    @payload,
+    @run_at,
+    CASE
+        WHEN @digestible::boolean
+         AND (SELECT notification_digest FROM users WHERE id = @user_id::uuid) <> 'off' THEN 'held'
+        ELSE 'queued'
+    END
+);
+
 -- name: GetPendingNotificationJobs :many
 SELECT 
     id,
@@ -20,7 +42,8 @@ SELECT
     status,
     last_error,
     created_at,
-    updated_at
+    updated_at,
+    user_id
 FROM notification_jobs 
 WHERE status = 'queued' AND run_at <= NOW()
 ORDER BY run_at ASC
@@ -34,4 +57,37 @@ SET
     attempts = attempts + 1,
     last_error = $3,
     updated_at = NOW()
-WHERE id = $1;
\ No newline at end of file
+WHERE id = $1;
+
+-- name: ListNotificationJobsFirstPage :many
+SELECT
+    id,
+    kind,
+    topic,
+    run_at,
+    attempts,
+    status,
+    last_error,
+    created_at
+FROM notification_jobs
+WHERE (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status)::text)
+  AND (sqlc.narg(topic)::text IS NULL OR topic = sqlc.narg(topic)::text)
+ORDER BY created_at DESC, id DESC
+LIMIT @limit_count;
+
+-- name: ListNotificationJobsKeyset :many
+SELECT
+    id,
+    kind,
+    topic,
+    run_at,
+    attempts,
+    status,
+    last_error,
+    created_at
+FROM notification_jobs
+WHERE (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status)::text)
+  AND (sqlc.narg(topic)::text IS NULL OR topic = sqlc.narg(topic)::text)
+  AND (created_at < @last_created_at OR (created_at = @last_created_at AND id < @last_id))
+ORDER BY created_at DESC, id DESC
+LIMIT @limit_count;
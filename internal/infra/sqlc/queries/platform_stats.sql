@@ -0,0 +1,26 @@
+-- name: RefreshPlatformStats :exec
+-- average_rating weighs each resource by its review count, from the per-star counts.
+INSERT INTO platform_stats (id, total_resources, total_reviews, average_rating, computed_at)
+SELECT
+    TRUE,
+    (SELECT count(*) FROM resources),
+    COALESCE(SUM(s.rating_1_count + s.rating_2_count + s.rating_3_count + s.rating_4_count + s.rating_5_count), 0),
+    COALESCE(ROUND(
+        SUM(s.rating_1_count + 2 * s.rating_2_count + 3 * s.rating_3_count + 4 * s.rating_4_count + 5 * s.rating_5_count)::numeric
+        / NULLIF(SUM(s.rating_1_count + s.rating_2_count + s.rating_3_count + s.rating_4_count + s.rating_5_count), 0),
+        2), 0),
+    @computed_at::timestamptz
+FROM resource_rating_stats s
+ON CONFLICT (id) DO UPDATE
+SET total_resources = EXCLUDED.total_resources,
+    total_reviews = EXCLUDED.total_reviews,
+    average_rating = EXCLUDED.average_rating,
+    computed_at = EXCLUDED.computed_at;
+
+-- name: GetPlatformStats :one
+SELECT
+    total_resources,
+    total_reviews,
+    average_rating::float8 AS average_rating,
+    computed_at
+FROM platform_stats;
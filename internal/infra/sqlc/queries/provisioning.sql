@@ -0,0 +1,53 @@
+-- name: CreateProvisioningToken :one
+INSERT INTO provisioning_tokens (
+    company_id,
+    token_hash,
+    created_by,
+    created_at
+) VALUES (
+    $1, $2, $3, $4
+)
+RETURNING id;
+
+-- name: RevokeProvisioningToken :execrows
+UPDATE provisioning_tokens
+SET revoked_at = @revoked_at::timestamptz
+WHERE id = @id
+  AND company_id = @company_id
+  AND revoked_at IS NULL;
+
+-- name: FindProvisioningTokenCompany :one
+SELECT company_id
+FROM provisioning_tokens
+WHERE token_hash = $1
+  AND revoked_at IS NULL;
+
+-- name: GetProvisionedUser :one
+SELECT id, email, external_id, is_active, created_at, updated_at
+FROM users
+WHERE id = $1
+  AND company_id = $2;
+
+-- name: ListProvisionedUsers :many
+-- Offset paging: SCIM clients page with startIndex and count.
+SELECT id, email, external_id, is_active, created_at, updated_at
+FROM users
+WHERE company_id = @company_id
+  AND (sqlc.narg(email)::text IS NULL OR email = sqlc.narg(email)::text::citext)
+  AND (sqlc.narg(external_id)::text IS NULL OR external_id = sqlc.narg(external_id)::text)
+ORDER BY created_at, id
+LIMIT @limit_count OFFSET @offset_count;
+
+-- name: CountProvisionedUsers :one
+SELECT count(*)
+FROM users
+WHERE company_id = @company_id
+  AND (sqlc.narg(email)::text IS NULL OR email = sqlc.narg(email)::text::citext)
+  AND (sqlc.narg(external_id)::text IS NULL OR external_id = sqlc.narg(external_id)::text);
+
+-- name: SetProvisionedUserActive :execrows
+UPDATE users
+SET is_active = @is_active,
+    updated_at = @updated_at::timestamptz
+WHERE id = @id
+  AND company_id = @company_id;
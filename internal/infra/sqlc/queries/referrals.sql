@@ -0,0 +1,69 @@
+-- name: FindReferrerByCode :one
+SELECT id
+FROM users
+WHERE referral_code = $1 AND is_active = true;
+
+-- name: CreateReferral :one
+INSERT INTO referrals (referrer_id, referred_id)
+VALUES ($1, $2)
+RETURNING id;
+
+-- name: ListRewardableReferrals :many
+-- Pending referrals whose referred user has a confirmed reservation that already ended,
+-- paired with the earliest such reservation.
+SELECT
+    r.id,
+    r.referrer_id,
+    r.referred_id,
+    first_completed.id AS reservation_id
+FROM referrals AS r
+CROSS JOIN LATERAL (
+    SELECT res.id
+    FROM reservations AS res
+    WHERE res.user_id = r.referred_id
+      AND res.status = 'confirmed'
+      AND upper(res.slot) <= @now::timestamptz
+    ORDER BY upper(res.slot), res.id
+    LIMIT 1
+) AS first_completed
+WHERE r.status = 'pending'
+ORDER BY r.created_at, r.id
+LIMIT @batch_size::int;
+
+-- name: MarkReferralRewarded :execrows
+UPDATE referrals
+SET
+    status = 'rewarded',
+    reservation_id = $2,
+    rewarded_at = $3
+WHERE id = $1 AND status = 'pending';
+
+-- name: CreateReferralCredit :exec
+INSERT INTO referral_credits (referral_id, user_id, party, amount_cents)
+VALUES ($1, $2, $3, $4);
+
+-- name: GetReferralSummary :one
+SELECT
+    u.referral_code,
+    COALESCE((
+        SELECT SUM(c.amount_cents)
+        FROM referral_credits AS c
+        WHERE c.user_id = u.id
+    ), 0)::bigint AS credit_cents
+FROM users AS u
+WHERE u.id = $1;
+
+-- name: ListReferralsByReferrer :many
+SELECT
+    r.id,
+    r.referred_id,
+    u.email AS referred_email,
+    r.status,
+    r.created_at,
+    r.rewarded_at,
+    c.amount_cents AS reward_cents
+FROM referrals AS r
+INNER JOIN users AS u ON r.referred_id = u.id
+LEFT JOIN referral_credits AS c ON c.referral_id = r.id AND c.party = 'referrer'
+WHERE r.referrer_id = $1
+ORDER BY r.created_at DESC, r.id DESC;
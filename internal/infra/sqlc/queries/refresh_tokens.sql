@@ -0,0 +1,52 @@
+-- name: CreateRefreshToken :exec
+INSERT INTO refresh_tokens (id, session_id, user_id, expires_at, rotated_at, created_at)
+VALUES (@id, @session_id, @user_id, @expires_at, @rotated_at, @created_at);
+
+-- name: GetRefreshTokenForUpdate :one
+-- Locks the token so concurrent refreshes with it queue behind each other.
+SELECT id, session_id, user_id, expires_at, rotated_at, revoked_at, created_at
+FROM refresh_tokens
+WHERE id = @id
+FOR UPDATE;
+
+-- name: MarkRefreshTokenRotated :exec
+UPDATE refresh_tokens
+SET rotated_at = @rotated_at
+WHERE id = @id;
+
+-- name: RevokeRefreshTokenSession :exec
+UPDATE refresh_tokens
+SET revoked_at = @revoked_at
+WHERE session_id = @session_id AND revoked_at IS NULL;
+
+-- name: IsRefreshTokenSessionRevoked :one
+SELECT EXISTS (
+    SELECT 1 FROM refresh_tokens
+    WHERE session_id = @session_id AND revoked_at IS NOT NULL
+)::boolean AS revoked;
+
+-- name: RevokeUserRefreshTokens :exec
+UPDATE refresh_tokens
+SET revoked_at = @revoked_at
+WHERE user_id = @user_id AND revoked_at IS NULL;
+
+-- name: UpsertUserSessionCutoff :exec
+INSERT INTO user_session_cutoffs (user_id, revoked_before)
+VALUES (@user_id, @revoked_before)
+ON CONFLICT (user_id) DO UPDATE SET revoked_before = EXCLUDED.revoked_before;
+
+-- name: CreateRevokedToken :exec
+INSERT INTO revoked_tokens (jti, user_id, expires_at, revoked_at)
+VALUES (@jti, @user_id, @expires_at, @revoked_at)
+ON CONFLICT (jti) DO NOTHING;
+
+-- name: IsSessionlessTokenRevoked :one
+-- For tokens issued before sessions were tracked: denylisted by jti, or issued before the
+-- user was signed out everywhere.
+SELECT (
+    EXISTS (SELECT 1 FROM revoked_tokens WHERE jti = @jti)
+    OR EXISTS (
+        SELECT 1 FROM user_session_cutoffs
+        WHERE user_id = @user_id AND revoked_before > @issued_at
+    )
+)::boolean AS revoked;
@@ -0,0 +1,64 @@
+-- name: ClaimRequestRecordingSlot :one
+-- Takes the next capture of the user's oldest active recording; no row when the user has
+-- none or it is full. The outer condition is re-checked after waiting on a concurrent claim.
+UPDATE request_recordings
+SET recorded_count = recorded_count + 1
+WHERE id = (
+    SELECT r.id FROM request_recordings r
+    WHERE r.user_id = @user_id AND r.expires_at > @now::timestamptz AND r.recorded_count < r.max_requests
+    ORDER BY r.created_at
+    LIMIT 1
+    FOR UPDATE
+) AND recorded_count < max_requests
+RETURNING id, recorded_count;
+
+-- name: CreateRecordedRequest :exec
+INSERT INTO recorded_requests (recording_id, seq, method, path, status, duration_ms, storage_key, recorded_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: CreateRequestRecording :exec
+INSERT INTO request_recordings (id, user_id, enabled_by, reason, consent_reference, max_requests, expires_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: DeleteRequestRecording :execrows
+DELETE FROM request_recordings WHERE id = $1;
+
+-- name: GetRecordedRequest :one
+SELECT * FROM recorded_requests
+WHERE recording_id = $1 AND seq = $2;
+
+-- name: GetRequestRecording :one
+SELECT
+    r.id,
+    r.user_id,
+    u.email,
+    r.enabled_by,
+    r.reason,
+    r.consent_reference,
+    r.max_requests,
+    r.recorded_count,
+    r.expires_at,
+    r.created_at
+FROM request_recordings r
+JOIN users u ON u.id = r.user_id
+WHERE r.id = $1;
+
+-- name: ListActiveRequestRecordingUsers :many
+SELECT DISTINCT user_id FROM request_recordings
+WHERE expires_at > @now::timestamptz AND recorded_count < max_requests;
+
+-- name: ListExpiredRequestRecordings :many
+SELECT id FROM request_recordings
+WHERE expires_at <= @now::timestamptz
+ORDER BY expires_at
+LIMIT @batch_size;
+
+-- name: ListRecordedRequestKeys :many
+SELECT storage_key FROM recorded_requests
+WHERE recording_id = $1
+ORDER BY seq;
+
+-- name: ListRecordedRequests :many
+SELECT * FROM recorded_requests
+WHERE recording_id = $1
+ORDER BY seq;
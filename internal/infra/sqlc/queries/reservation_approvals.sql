@@ -0,0 +1,145 @@
+-- name: AddResourceApprover :exec
+INSERT INTO resource_approvers (
+    resource_id,
+    user_id
+) VALUES (
+    $1, $2
+)
+ON CONFLICT (resource_id, user_id) DO NOTHING;
+
+-- name: RemoveResourceApprover :execrows
+DELETE FROM resource_approvers
+WHERE resource_id = $1 AND user_id = $2;
+
+-- name: IsResourceApprover :one
+SELECT EXISTS (
+    SELECT 1
+    FROM resource_approvers
+    WHERE resource_id = $1 AND user_id = $2
+);
+
+-- name: ListResourceApproverIDs :many
+SELECT user_id
+FROM resource_approvers
+WHERE resource_id = $1
+ORDER BY created_at, user_id;
+
+-- name: ListResourceApprovers :many
+SELECT
+    ra.user_id,
+    u.email,
+    u.role,
+    ra.created_at
+FROM resource_approvers AS ra
+INNER JOIN users AS u ON ra.user_id = u.id
+WHERE ra.resource_id = $1
+ORDER BY ra.created_at, ra.user_id;
+
+-- name: CreateReservationApproval :exec
+INSERT INTO reservation_approvals (
+    reservation_id,
+    expires_at
+) VALUES (
+    $1, $2
+);
+
+-- name: GetReservationApproval :one
+SELECT
+    reservation_id,
+    status,
+    expires_at,
+    escalated_at
+FROM reservation_approvals
+WHERE reservation_id = $1;
+
+-- name: DecideReservationApproval :execrows
+UPDATE reservation_approvals
+SET
+    status = @status,
+    decided_by = @decided_by,
+    decided_at = @decided_at,
+    reason = @reason,
+    updated_at = NOW()
+WHERE reservation_id = @reservation_id AND status = 'pending';
+
+-- name: ListReservationApprovalsToEscalate :many
+-- Pending requests created at or before waited_since that have neither been escalated
+-- nor expired at now, oldest first.
+SELECT
+    ra.reservation_id,
+    r.resource_id,
+    r.user_id,
+    res.company_id,
+    ra.expires_at
+FROM reservation_approvals AS ra
+INNER JOIN reservations AS r ON ra.reservation_id = r.id
+INNER JOIN resources AS res ON r.resource_id = res.id
+WHERE ra.status = 'pending'
+  AND ra.escalated_at IS NULL
+  AND ra.created_at <= @waited_since
+  AND ra.expires_at > @now
+ORDER BY ra.created_at, ra.reservation_id
+LIMIT @limit_count;
+
+-- name: MarkReservationApprovalEscalated :execrows
+UPDATE reservation_approvals
+SET
+    escalated_at = @escalated_at,
+    updated_at = NOW()
+WHERE reservation_id = @reservation_id AND status = 'pending' AND escalated_at IS NULL;
+
+-- name: ExpireReservationApprovals :many
+-- Expires up to limit_count requests due at now and cancels their reservations.
+WITH expired AS (
+    UPDATE reservation_approvals
+    SET
+        status = 'expired',
+        decided_at = @now,
+        updated_at = NOW()
+    WHERE reservation_id IN (
+        SELECT reservation_id
+        FROM reservation_approvals
+        WHERE status = 'pending' AND expires_at <= @now
+        ORDER BY expires_at, reservation_id
+        LIMIT @limit_count
+        FOR UPDATE SKIP LOCKED
+    )
+    RETURNING reservation_id
+)
+UPDATE reservations AS r
+SET
+    status = 'canceled',
+    updated_at = NOW()
+FROM expired AS e
+WHERE r.id = e.reservation_id AND r.status = 'pending_approval'
+RETURNING r.id, r.user_id;
+
+-- name: ListPendingReservationApprovals :many
+-- Pending requests on resources approver_id approves, or on every resource with
+-- all_resources, soonest to expire first.
+SELECT
+    ra.reservation_id,
+    r.resource_id,
+    res.name AS resource_name,
+    r.user_id,
+    u.email AS user_email,
+    lower(r.slot)::timestamptz AS start_time,
+    upper(r.slot)::timestamptz AS end_time,
+    ra.expires_at,
+    ra.escalated_at,
+    ra.created_at
+FROM reservation_approvals AS ra
+INNER JOIN reservations AS r ON ra.reservation_id = r.id
+INNER JOIN resources AS res ON r.resource_id = res.id
+INNER JOIN users AS u ON r.user_id = u.id
+WHERE ra.status = 'pending'
+  AND (
+    @all_resources::boolean
+    OR EXISTS (
+        SELECT 1
+        FROM resource_approvers AS ap
+        WHERE ap.resource_id = r.resource_id AND ap.user_id = @approver_id
+    )
+  )
+ORDER BY ra.expires_at, ra.reservation_id
+LIMIT @limit_count;
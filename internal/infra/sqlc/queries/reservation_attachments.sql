@@ -0,0 +1,52 @@
+-- name: CreateReservationAttachment :exec
+INSERT INTO reservation_attachments (
+    id,
+    reservation_id,
+    uploaded_by,
+    uploader_side,
+    filename,
+    content_type,
+    size_bytes,
+    storage_key,
+    operator_only,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+);
+
+-- name: DeleteReservationAttachment :execrows
+DELETE FROM reservation_attachments
+WHERE id = $1 AND reservation_id = $2;
+
+-- name: GetReservationAttachment :one
+SELECT
+    id,
+    reservation_id,
+    uploaded_by,
+    uploader_side,
+    filename,
+    content_type,
+    size_bytes,
+    storage_key,
+    operator_only,
+    created_at
+FROM reservation_attachments
+WHERE id = $1 AND reservation_id = $2;
+
+-- name: ListReservationAttachments :many
+-- Operator-only files are left out unless include_operator_only is set.
+SELECT
+    id,
+    reservation_id,
+    uploaded_by,
+    uploader_side,
+    filename,
+    content_type,
+    size_bytes,
+    storage_key,
+    operator_only,
+    created_at
+FROM reservation_attachments
+WHERE reservation_id = @reservation_id
+  AND (@include_operator_only::boolean OR NOT operator_only)
+ORDER BY created_at, id;
@@ -0,0 +1,62 @@
+-- name: CreateReservationMessage :one
+INSERT INTO reservation_messages (
+    reservation_id,
+    author_id,
+    author_side,
+    body,
+    body_ciphertext,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING id;
+
+-- name: CountUnreadReservationMessages :one
+-- Counts messages from the other side newer than the reader side's last read.
+SELECT COUNT(*)
+FROM reservation_messages AS m
+WHERE m.reservation_id = @reservation_id
+  AND m.author_side <> @reader_side::text
+  AND m.created_at > COALESCE((
+      SELECT rd.last_read_at
+      FROM reservation_message_reads AS rd
+      WHERE rd.reservation_id = @reservation_id AND rd.side = @reader_side::text
+  ), '-infinity'::timestamptz);
+
+-- name: ListReservationMessagesFirstPage :many
+SELECT
+    id,
+    author_id,
+    author_side,
+    body,
+    body_ciphertext,
+    created_at
+FROM reservation_messages
+WHERE reservation_id = @reservation_id
+ORDER BY created_at, id
+LIMIT @limit_count;
+
+-- name: ListReservationMessagesKeyset :many
+SELECT
+    id,
+    author_id,
+    author_side,
+    body,
+    body_ciphertext,
+    created_at
+FROM reservation_messages
+WHERE reservation_id = @reservation_id
+  AND (created_at > @last_created_at OR (created_at = @last_created_at AND id > @last_id))
+ORDER BY created_at, id
+LIMIT @limit_count;
+
+-- name: MarkReservationMessagesRead :exec
+-- Never moves the marker backwards, so a stale request cannot resurrect unread messages.
+INSERT INTO reservation_message_reads (
+    reservation_id,
+    side,
+    last_read_at
+) VALUES (
+    $1, $2, $3
+)
+ON CONFLICT (reservation_id, side) DO UPDATE
+SET last_read_at = GREATEST(reservation_message_reads.last_read_at, EXCLUDED.last_read_at);
@@ -0,0 +1,45 @@
+-- name: CreateReservationTransfer :one
+INSERT INTO reservation_transfers (
+    reservation_id,
+    from_user_id,
+    to_user_id,
+    initiated_by,
+    nonce,
+    status,
+    expires_at,
+    accepted_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+)
+RETURNING id;
+
+-- name: GetReservationTransferByID :one
+SELECT
+    id,
+    reservation_id,
+    from_user_id,
+    to_user_id,
+    initiated_by,
+    nonce,
+    status,
+    expires_at,
+    accepted_at,
+    created_at,
+    updated_at
+FROM reservation_transfers
+WHERE id = $1;
+
+-- name: SupersedeReservationTransfers :execrows
+UPDATE reservation_transfers
+SET
+    status = 'superseded',
+    updated_at = NOW()
+WHERE reservation_id = $1 AND status = 'pending';
+
+-- name: AcceptReservationTransfer :execrows
+UPDATE reservation_transfers
+SET
+    status = 'accepted',
+    accepted_at = $3,
+    updated_at = NOW()
+WHERE id = $1 AND nonce = $2 AND status = 'pending';
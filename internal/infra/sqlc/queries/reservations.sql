@@ -6,11 +6,31 @@ INSERT INTO reservations (
     status,
     price_cents,
     coupon_id,
-    note
+    custom_fields
 ) VALUES (
     $1, $2, $3, $4, $5, $6, $7
 ) RETURNING id;
 
+-- name: CreateReservationDiscount :exec
+INSERT INTO reservation_discounts (
+    reservation_id,
+    position,
+    coupon_id,
+    amount_cents
+) VALUES (
+    $1, $2, $3, $4
+);
+
+-- name: GetReservationDiscounts :many
+SELECT
+    d.coupon_id,
+    c.code,
+    d.amount_cents
+FROM reservation_discounts AS d
+INNER JOIN coupons AS c ON d.coupon_id = c.id
+WHERE d.reservation_id = $1
+ORDER BY d.position;
+
 -- name: GetReservationByID :one
 SELECT 
     r.id,
@@ -20,12 +40,13 @@ SELECT
     r.status,
     r.price_cents,
     r.coupon_id,
-    r.note,
     r.created_at,
     r.updated_at,
     res.name AS resource_name,
     u.email AS user_email,
-    c.code AS coupon_code
+    c.code AS coupon_code,
+    res.company_id AS resource_company_id,
+    r.custom_fields
 FROM reservations AS r
 INNER JOIN resources AS res ON r.resource_id = res.id
 INNER JOIN users AS u ON r.user_id = u.id
@@ -39,6 +60,12 @@ SET
     updated_at = NOW()
 WHERE id = $1;
 
+-- name: TouchReservation :exec
+-- Changes the reservation's ETag when its message thread changes.
+UPDATE reservations
+SET updated_at = NOW()
+WHERE id = $1;
+
 -- name: UpdateReservationSlot :exec
 UPDATE reservations 
 SET 
@@ -76,4 +103,140 @@ INNER JOIN resources AS res ON r.resource_id = res.id
 WHERE r.user_id = $1 
   AND (r.created_at < $2 OR (r.created_at = $2 AND r.id < $3))
 ORDER BY r.created_at DESC, r.id DESC 
-LIMIT $4;
\ No newline at end of file
+LIMIT $4;
+
+-- name: CancelReservation :execrows
+UPDATE reservations
+SET
+    status = 'canceled',
+    updated_at = NOW()
+WHERE id = $1 AND status IN ('confirmed', 'pending_approval');
+
+-- name: TransferReservation :execrows
+-- Moves a confirmed, not yet ended reservation that still belongs to from_user_id.
+UPDATE reservations
+SET
+    user_id = @to_user_id,
+    updated_at = NOW()
+WHERE id = @id
+  AND user_id = @from_user_id
+  AND status = 'confirmed'
+  AND upper(slot) > @now::timestamptz;
+
+-- name: ResolvePendingReservation :execrows
+-- Confirms or cancels a reservation pending approval.
+UPDATE reservations
+SET
+    status = @status,
+    updated_at = NOW()
+WHERE id = @id AND status = 'pending_approval';
+
+-- name: GetReservationsForAdminFirstPage :many
+SELECT
+    r.id,
+    r.resource_id,
+    r.user_id,
+    r.slot::text,
+    r.status,
+    r.price_cents,
+    r.created_at,
+    r.custom_fields,
+    res.name AS resource_name,
+    u.email AS user_email
+FROM reservations AS r
+INNER JOIN resources AS res ON r.resource_id = res.id
+INNER JOIN users AS u ON r.user_id = u.id
+WHERE (sqlc.narg(resource_id)::uuid IS NULL OR r.resource_id = sqlc.narg(resource_id)::uuid)
+  AND (sqlc.narg(operator_id)::uuid IS NULL OR EXISTS (
+    SELECT 1 FROM resource_operators AS ro
+    WHERE ro.resource_id = r.resource_id AND ro.user_id = sqlc.narg(operator_id)::uuid
+  ))
+  AND (sqlc.narg(company_id)::uuid IS NULL OR res.company_id = sqlc.narg(company_id)::uuid)
+  AND (sqlc.narg(custom_fields)::jsonb IS NULL OR NOT EXISTS (
+    SELECT 1 FROM jsonb_each_text(sqlc.narg(custom_fields)::jsonb) AS f
+    WHERE r.custom_fields ->> f.key IS DISTINCT FROM f.value
+  ))
+ORDER BY r.created_at DESC, r.id DESC
+LIMIT $1;
+
+-- name: GetReservationsForAdminKeyset :many
+SELECT
+    r.id,
+    r.resource_id,
+    r.user_id,
+    r.slot::text,
+    r.status,
+    r.price_cents,
+    r.created_at,
+    r.custom_fields,
+    res.name AS resource_name,
+    u.email AS user_email
+FROM reservations AS r
+INNER JOIN resources AS res ON r.resource_id = res.id
+INNER JOIN users AS u ON r.user_id = u.id
+WHERE (r.created_at < $1 OR (r.created_at = $1 AND r.id < $2))
+  AND (sqlc.narg(resource_id)::uuid IS NULL OR r.resource_id = sqlc.narg(resource_id)::uuid)
+  AND (sqlc.narg(operator_id)::uuid IS NULL OR EXISTS (
+    SELECT 1 FROM resource_operators AS ro
+    WHERE ro.resource_id = r.resource_id AND ro.user_id = sqlc.narg(operator_id)::uuid
+  ))
+  AND (sqlc.narg(company_id)::uuid IS NULL OR res.company_id = sqlc.narg(company_id)::uuid)
+  AND (sqlc.narg(custom_fields)::jsonb IS NULL OR NOT EXISTS (
+    SELECT 1 FROM jsonb_each_text(sqlc.narg(custom_fields)::jsonb) AS f
+    WHERE r.custom_fields ->> f.key IS DISTINCT FROM f.value
+  ))
+ORDER BY r.created_at DESC, r.id DESC
+LIMIT $3;
+
+-- name: HasReservationInSlot :one
+-- Mirrors reservations_no_overlap, so canceled reservations count as well.
+SELECT EXISTS (
+    SELECT 1
+    FROM reservations
+    WHERE resource_id = @resource_id
+      AND slot && tstzrange(@start_time::timestamptz, @end_time::timestamptz, '[)')
+);
+
+-- name: SetReservationGroup :exec
+UPDATE reservations
+SET group_id = @group_id
+WHERE id = ANY(@ids::uuid[]);
+
+-- name: GetReservationGroupID :one
+SELECT group_id FROM reservations WHERE id = $1;
+
+-- name: GetReservationIDsByGroup :many
+SELECT id
+FROM reservations
+WHERE group_id = @group_id AND user_id = @user_id
+ORDER BY lower(slot), id;
+
+-- name: ListCancelableReservationsInRange :many
+-- Confirmed reservations on the resource overlapping the range that have not ended at now.
+SELECT
+    id,
+    user_id,
+    lower(slot)::timestamptz AS start_time,
+    upper(slot)::timestamptz AS end_time
+FROM reservations
+WHERE resource_id = @resource_id
+  AND status = 'confirmed'
+  AND slot && tstzrange(@from_time::timestamptz, @to_time::timestamptz, '[)')
+  AND upper(slot) > @now::timestamptz
+ORDER BY lower(slot), id;
+
+-- name: CancelReservationsInRange :many
+-- Cancels the rows ListCancelableReservationsInRange lists.
+UPDATE reservations
+SET
+    status = 'canceled',
+    updated_at = NOW()
+WHERE resource_id = @resource_id
+  AND status = 'confirmed'
+  AND slot && tstzrange(@from_time::timestamptz, @to_time::timestamptz, '[)')
+  AND upper(slot) > @now::timestamptz
+RETURNING
+    id,
+    user_id,
+    lower(slot)::timestamptz AS start_time,
+    upper(slot)::timestamptz AS end_time;
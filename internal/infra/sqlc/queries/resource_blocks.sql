@@ -0,0 +1,72 @@
+-- name: CreateResourceBlocks :many
+-- Inserts one row per occurrence; starts and ends are parallel arrays.
+INSERT INTO resource_blocks (resource_id, series_id, slot, reason, created_by)
+SELECT @resource_id::uuid, @series_id::uuid, tstzrange(o.start_time, o.end_time, '[)'), @reason::text, @created_by::uuid
+FROM unnest(@starts::timestamptz[], @ends::timestamptz[]) AS o(start_time, end_time)
+RETURNING id;
+
+-- name: DeleteResourceBlock :execrows
+DELETE FROM resource_blocks
+WHERE id = @id AND resource_id = @resource_id;
+
+-- name: DeleteResourceBlockSeries :execrows
+-- Deletes the block and the later occurrences of its series.
+DELETE FROM resource_blocks AS b
+USING resource_blocks AS target
+WHERE target.id = @id
+  AND target.resource_id = @resource_id
+  AND b.series_id = target.series_id
+  AND lower(b.slot) >= lower(target.slot);
+
+-- name: HasConfirmedReservationInSlots :one
+-- A reservation pending approval holds its slot, so it counts as confirmed here.
+SELECT EXISTS (
+    SELECT 1
+    FROM reservations AS r
+    JOIN unnest(@starts::timestamptz[], @ends::timestamptz[]) AS o(start_time, end_time)
+      ON r.slot && tstzrange(o.start_time, o.end_time, '[)')
+    WHERE r.resource_id = @resource_id AND r.status IN ('confirmed', 'pending_approval')
+);
+
+-- name: HasResourceBlockInSlot :one
+SELECT EXISTS (
+    SELECT 1
+    FROM resource_blocks
+    WHERE resource_id = @resource_id
+      AND slot && tstzrange(@start_time::timestamptz, @end_time::timestamptz, '[)')
+);
+
+-- name: ListResourceBlocks :many
+SELECT
+    id,
+    series_id,
+    lower(slot)::timestamptz AS start_time,
+    upper(slot)::timestamptz AS end_time,
+    reason,
+    created_by,
+    created_at
+FROM resource_blocks
+WHERE resource_id = @resource_id
+  AND slot && tstzrange(@from_time::timestamptz, @to_time::timestamptz, '[)')
+ORDER BY lower(slot), id
+LIMIT @max_rows::int;
+
+-- name: ListResourceBusySlots :many
+-- Confirmed and pending reservations and blocks overlapping the window, by start time.
+SELECT
+    lower(slot)::timestamptz AS start_time,
+    upper(slot)::timestamptz AS end_time,
+    'reservation'::text AS kind
+FROM reservations
+WHERE resource_id = @resource_id
+  AND status IN ('confirmed', 'pending_approval')
+  AND slot && tstzrange(@from_time::timestamptz, @to_time::timestamptz, '[)')
+UNION ALL
+SELECT
+    lower(slot)::timestamptz,
+    upper(slot)::timestamptz,
+    'block'::text
+FROM resource_blocks
+WHERE resource_id = @resource_id
+  AND slot && tstzrange(@from_time::timestamptz, @to_time::timestamptz, '[)')
+ORDER BY start_time;
@@ -0,0 +1,30 @@
+-- name: AssignResourceOperator :exec
+INSERT INTO resource_operators (
+    resource_id,
+    user_id
+) VALUES (
+    $1, $2
+)
+ON CONFLICT (resource_id, user_id) DO NOTHING;
+
+-- name: UnassignResourceOperator :execrows
+DELETE FROM resource_operators
+WHERE resource_id = $1 AND user_id = $2;
+
+-- name: IsResourceOperator :one
+SELECT EXISTS (
+    SELECT 1
+    FROM resource_operators
+    WHERE resource_id = $1 AND user_id = $2
+);
+
+-- name: ListResourceOperators :many
+SELECT
+    ro.user_id,
+    u.email,
+    u.role,
+    ro.created_at
+FROM resource_operators AS ro
+INNER JOIN users AS u ON ro.user_id = u.id
+WHERE ro.resource_id = $1
+ORDER BY ro.created_at, ro.user_id;
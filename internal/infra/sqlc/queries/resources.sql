@@ -4,7 +4,8 @@ SELECT
     name,
     lead_time_min,
     created_at,
-    updated_at
+    updated_at,
+    company_id
 FROM resources 
 WHERE id = $1;
 
@@ -14,7 +15,8 @@ SELECT
     name,
     lead_time_min,
     created_at,
-    updated_at
+    updated_at,
+    company_id
 FROM resources 
 ORDER BY name;
 
@@ -24,7 +26,56 @@ SELECT
     name,
     lead_time_min,
     created_at,
-    updated_at
+    updated_at,
+    company_id
 FROM resources 
 WHERE name ILIKE '%' || $1 || '%'
-ORDER BY name;
\ No newline at end of file
+ORDER BY name;
+
+-- name: CreateResources :copyfrom
+INSERT INTO resources (
+    id,
+    name,
+    lead_time_min,
+    company_id,
+    slug
+) VALUES (
+    $1, $2, $3, $4, $5
+);
+
+-- name: LockResourceForShare :one
+-- Taken before inserting a reservation, so a resource block created concurrently is
+-- either visible to the overlap check or waits for the reservation to commit.
+SELECT id FROM resources WHERE id = $1 FOR SHARE;
+
+-- name: LockResourceForUpdate :one
+-- Taken before inserting resource blocks; see LockResourceForShare.
+SELECT id FROM resources WHERE id = $1 FOR UPDATE;
+
+-- name: GetPublicResourceBySlug :one
+-- What a resource's public page shows. last_modified covers the resource, its company and
+-- its rating stats, the sources of every field.
+SELECT
+    r.slug::text AS slug,
+    r.name,
+    r.lead_time_min,
+    c.name AS company_name,
+    COALESCE(s.total_reviews, 0)::int AS total_reviews,
+    COALESCE(s.average_rating, 0)::float8 AS average_rating,
+    GREATEST(r.updated_at, c.updated_at, s.updated_at)::timestamptz AS last_modified
+FROM resources r
+LEFT JOIN companies c ON c.id = r.company_id
+LEFT JOIN resource_rating_stats s ON s.resource_id = r.id
+WHERE r.slug = sqlc.arg(slug)::text;
+
+-- name: ListPublicResourceSlugs :many
+-- Public resource pages for the sitemap, by slug; last_modified as in GetPublicResourceBySlug.
+SELECT
+    r.slug::text AS slug,
+    GREATEST(r.updated_at, c.updated_at, s.updated_at)::timestamptz AS last_modified
+FROM resources r
+LEFT JOIN companies c ON c.id = r.company_id
+LEFT JOIN resource_rating_stats s ON s.resource_id = r.id
+WHERE r.slug IS NOT NULL
+ORDER BY r.slug
+LIMIT sqlc.arg(max_urls);
@@ -0,0 +1,104 @@
+-- name: CountRetentionNotificationJobs :one
+SELECT COUNT(*) FROM notification_jobs
+WHERE status IN ('digested', 'done', 'error') AND updated_at < @cutoff::timestamptz;
+
+-- name: DeleteRetentionNotificationJobsBatch :execrows
+DELETE FROM notification_jobs
+WHERE id IN (
+    SELECT id FROM notification_jobs
+    WHERE status IN ('digested', 'done', 'error') AND updated_at < @cutoff::timestamptz
+    ORDER BY updated_at ASC
+    LIMIT @batch_size::int
+);
+
+-- name: CountRetentionIdempotencyKeys :one
+SELECT COUNT(*) FROM idempotency_keys
+WHERE created_at < @cutoff::timestamptz AND expires_at < @cutoff::timestamptz;
+
+-- name: DeleteRetentionIdempotencyKeysBatch :execrows
+DELETE FROM idempotency_keys
+WHERE (key, user_id) IN (
+    SELECT key, user_id FROM idempotency_keys
+    WHERE created_at < @cutoff::timestamptz AND expires_at < @cutoff::timestamptz
+    ORDER BY created_at ASC
+    LIMIT @batch_size::int
+);
+
+-- name: CountRetentionAuditLogs :one
+SELECT COUNT(*) FROM audit_logs
+WHERE created_at < @cutoff::timestamptz;
+
+-- name: DeleteRetentionAuditLogsBatch :execrows
+DELETE FROM audit_logs
+WHERE id IN (
+    SELECT id FROM audit_logs
+    WHERE created_at < @cutoff::timestamptz
+    ORDER BY created_at ASC
+    LIMIT @batch_size::int
+);
+
+-- name: CountRetentionRefreshTokens :one
+SELECT COUNT(*) FROM refresh_tokens
+WHERE expires_at < @cutoff::timestamptz;
+
+-- name: DeleteRetentionRefreshTokensBatch :execrows
+DELETE FROM refresh_tokens
+WHERE id IN (
+    SELECT id FROM refresh_tokens
+    WHERE expires_at < @cutoff::timestamptz
+    ORDER BY expires_at ASC
+    LIMIT @batch_size::int
+);
+
+-- name: CountRetentionRevokedTokens :one
+SELECT COUNT(*) FROM revoked_tokens
+WHERE expires_at < @cutoff::timestamptz;
+
+-- name: DeleteRetentionRevokedTokensBatch :execrows
+DELETE FROM revoked_tokens
+WHERE jti IN (
+    SELECT jti FROM revoked_tokens
+    WHERE expires_at < @cutoff::timestamptz
+    ORDER BY expires_at ASC
+    LIMIT @batch_size::int
+);
+
+-- name: CountRetentionUnverifiedUsers :one
+SELECT COUNT(*) FROM email_verifications
+WHERE expires_at < @cutoff::timestamptz;
+
+-- name: DeleteRetentionUnverifiedUsersBatch :execrows
+-- Accounts never verified cannot have signed in, so nothing else refers to them.
+DELETE FROM users
+WHERE id IN (
+    SELECT user_id FROM email_verifications
+    WHERE expires_at < @cutoff::timestamptz
+    ORDER BY expires_at ASC
+    LIMIT @batch_size::int
+);
+
+-- name: CountRetentionLoginFailures :one
+SELECT COUNT(*) FROM login_failures
+WHERE expires_at < @cutoff::timestamptz;
+
+-- name: DeleteRetentionLoginFailuresBatch :execrows
+DELETE FROM login_failures
+WHERE (email, ip_address) IN (
+    SELECT email, ip_address FROM login_failures
+    WHERE expires_at < @cutoff::timestamptz
+    ORDER BY expires_at ASC
+    LIMIT @batch_size::int
+);
+
+-- name: CountRetentionUserSessions :one
+SELECT COUNT(*) FROM user_sessions
+WHERE expires_at < @cutoff::timestamptz;
+
+-- name: DeleteRetentionUserSessionsBatch :execrows
+DELETE FROM user_sessions
+WHERE id IN (
+    SELECT id FROM user_sessions
+    WHERE expires_at < @cutoff::timestamptz
+    ORDER BY expires_at ASC
+    LIMIT @batch_size::int
+);
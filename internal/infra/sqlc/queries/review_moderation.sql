@@ -0,0 +1,37 @@
+-- name: FindSimilarRecentReview :one
+-- The author's closest recent review whose comment reaches the trigram similarity threshold.
+SELECT recent.id
+FROM (
+  SELECT id, comment
+  FROM reviews
+  WHERE user_id = @user_id
+  ORDER BY created_at DESC, id DESC
+  LIMIT @lookback::int
+) recent
+WHERE similarity(recent.comment, @comment::text) >= @threshold::real
+ORDER BY similarity(recent.comment, @comment::text) DESC
+LIMIT 1;
+
+-- name: ListFlaggedReviewsByResource :many
+-- Moderation queue for one resource, oldest first.
+SELECT
+  r.id,
+  r.user_id,
+  u.email AS user_email,
+  r.rating,
+  r.comment,
+  r.duplicate_of,
+  r.created_at
+FROM reviews r
+JOIN users u ON r.user_id = u.id
+WHERE r.resource_id = $1
+  AND r.status = 'flagged'
+ORDER BY r.created_at, r.id
+LIMIT $2;
+
+-- name: PublishReview :execrows
+UPDATE reviews
+SET status = 'published',
+    duplicate_of = NULL
+WHERE id = $1
+  AND status = 'flagged';
@@ -0,0 +1,24 @@
+-- name: ListRecentReviewsForSummary :many
+SELECT rating, comment
+FROM reviews
+WHERE resource_id = $1
+  AND status = 'published'
+ORDER BY created_at DESC, id DESC
+LIMIT $2;
+
+-- name: ListStaleReviewSummaries :many
+-- Resources whose review count changed since their summary was generated, oldest change first.
+SELECT resource_id, total_reviews
+FROM resource_rating_stats
+WHERE summary_review_count IS DISTINCT FROM total_reviews
+ORDER BY updated_at
+LIMIT $1;
+
+-- name: UpdateReviewSummary :execrows
+-- Skips the write when total_reviews moved on after the reviews were read; the next run retries.
+UPDATE resource_rating_stats
+SET summary = sqlc.narg(summary),
+    summary_review_count = @review_count,
+    summary_updated_at = NOW()
+WHERE resource_id = @resource_id
+  AND total_reviews = @review_count;
@@ -5,11 +5,35 @@ INSERT INTO reviews (
     resource_id,
     reservation_id,
     rating,
-    comment
+    comment,
+    status,
+    duplicate_of,
+    language
 ) VALUES (
-    $1, $2, $3, $4, $5, $6
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
 ) RETURNING id;
 
+-- name: ImportReview :one
+-- A published review from another system with its original timestamps. Nothing is
+-- inserted, and no row returned, when the source's review is already in.
+INSERT INTO reviews (
+    id,
+    user_id,
+    resource_id,
+    rating,
+    comment,
+    status,
+    language,
+    import_source,
+    external_id,
+    created_at,
+    updated_at
+) VALUES (
+    @id, @user_id, @resource_id, @rating, @comment, 'published', @language, @import_source, @external_id, @created_at, @updated_at
+)
+ON CONFLICT (import_source, external_id) WHERE import_source IS NOT NULL DO NOTHING
+RETURNING id;
+
 -- name: ApplyResourceRatingStatsOnCreate :exec
 INSERT INTO resource_rating_stats (
   resource_id,
@@ -74,11 +98,47 @@ SET
   updated_at = NOW()
 WHERE resource_id = sqlc.arg(resource_id)::uuid;
 
+-- name: RecalculateResourceRatingStats :exec
+-- Rebuilds the resource's stats from its published reviews, for changes made in bulk.
+INSERT INTO resource_rating_stats (
+  resource_id,
+  total_reviews,
+  average_rating,
+  rating_1_count,
+  rating_2_count,
+  rating_3_count,
+  rating_4_count,
+  rating_5_count,
+  updated_at
+)
+SELECT
+  @resource_id::uuid,
+  COUNT(*),
+  COALESCE(ROUND(AVG(rating), 2), 0.00),
+  COUNT(*) FILTER (WHERE rating = 1),
+  COUNT(*) FILTER (WHERE rating = 2),
+  COUNT(*) FILTER (WHERE rating = 3),
+  COUNT(*) FILTER (WHERE rating = 4),
+  COUNT(*) FILTER (WHERE rating = 5),
+  NOW()
+FROM reviews
+WHERE resource_id = @resource_id::uuid AND status = 'published'
+ON CONFLICT (resource_id) DO UPDATE SET
+  total_reviews = EXCLUDED.total_reviews,
+  average_rating = EXCLUDED.average_rating,
+  rating_1_count = EXCLUDED.rating_1_count,
+  rating_2_count = EXCLUDED.rating_2_count,
+  rating_3_count = EXCLUDED.rating_3_count,
+  rating_4_count = EXCLUDED.rating_4_count,
+  rating_5_count = EXCLUDED.rating_5_count,
+  updated_at = EXCLUDED.updated_at;
+
 -- name: UpdateReview :one
 UPDATE reviews
 SET
     rating = $2,
     comment = $3,
+    language = $4,
     updated_at = NOW()
 WHERE id = $1
 RETURNING 1;
@@ -88,7 +148,7 @@ DELETE FROM reviews WHERE id = $1
 RETURNING 1;
 
 -- name: GetReviewByID :one
-SELECT id, user_id, resource_id, reservation_id, rating, comment, created_at, updated_at FROM reviews WHERE id = $1;
+SELECT id, user_id, resource_id, reservation_id, rating, comment, created_at, updated_at, status, duplicate_of, language, import_source, external_id FROM reviews WHERE id = $1;
 
 -- name: GetReviewViewByID :one
 SELECT 
@@ -101,7 +161,9 @@ SELECT
   r.rating,
   r.comment,
   r.created_at,
-  r.updated_at
+  r.updated_at,
+  r.status,
+  r.language
 FROM reviews r
 JOIN users u ON r.user_id = u.id
 JOIN resources res ON r.resource_id = res.id
@@ -113,12 +175,15 @@ SELECT
   u.email AS user_email,
   r.rating,
   r.comment,
-  r.created_at
+  r.created_at,
+  r.language
 FROM reviews r
 JOIN users u ON r.user_id = u.id
 WHERE r.resource_id = $1
+  AND r.status = 'published'
   AND (sqlc.narg(min_rating)::int IS NULL OR r.rating >= sqlc.narg(min_rating)::int)
   AND (sqlc.narg(max_rating)::int IS NULL OR r.rating <= sqlc.narg(max_rating)::int)
+  AND (sqlc.narg(language)::text IS NULL OR r.language = sqlc.narg(language)::text)
 ORDER BY r.created_at DESC, r.id DESC
 LIMIT $2;
 
@@ -128,13 +193,16 @@ SELECT
   u.email AS user_email,
   r.rating,
   r.comment,
-  r.created_at
+  r.created_at,
+  r.language
 FROM reviews r
 JOIN users u ON r.user_id = u.id
 WHERE r.resource_id = $1
+  AND r.status = 'published'
   AND (r.created_at < $2 OR (r.created_at = $2 AND r.id < $3))
   AND (sqlc.narg(min_rating)::int IS NULL OR r.rating >= sqlc.narg(min_rating)::int)
   AND (sqlc.narg(max_rating)::int IS NULL OR r.rating <= sqlc.narg(max_rating)::int)
+  AND (sqlc.narg(language)::text IS NULL OR r.language = sqlc.narg(language)::text)
 ORDER BY r.created_at DESC, r.id DESC
 LIMIT $4;
 
@@ -144,7 +212,8 @@ SELECT
   u.email AS user_email,
   r.rating,
   r.comment,
-  r.created_at
+  r.created_at,
+  r.status
 FROM reviews r
 JOIN users u ON r.user_id = u.id
 WHERE r.user_id = $1
@@ -157,7 +226,8 @@ SELECT
   u.email AS user_email,
   r.rating,
   r.comment,
-  r.created_at
+  r.created_at,
+  r.status
 FROM reviews r
 JOIN users u ON r.user_id = u.id
 WHERE r.user_id = $1
@@ -175,6 +245,72 @@ SELECT
   rating_3_count,
   rating_4_count,
   rating_5_count,
-  updated_at
+  updated_at,
+  summary,
+  summary_review_count,
+  summary_updated_at
 FROM resource_rating_stats
 WHERE resource_id = $1;
+
+-- name: CountReviewsByLanguage :many
+-- Published reviews of a resource per detected language, most common first.
+SELECT
+  language::text AS language,
+  COUNT(*)::int AS review_count
+FROM reviews
+WHERE resource_id = $1
+  AND status = 'published'
+  AND language IS NOT NULL
+GROUP BY language
+ORDER BY review_count DESC, language;
+
+-- name: ListReviewsForExport :many
+-- One batch of a user's reviews, oldest first, for the data export.
+SELECT
+  r.id,
+  r.resource_id,
+  res.name AS resource_name,
+  r.reservation_id,
+  r.rating,
+  r.comment,
+  r.status,
+  r.language,
+  r.created_at,
+  r.updated_at
+FROM reviews r
+JOIN resources res ON r.resource_id = res.id
+WHERE r.user_id = sqlc.arg(user_id)
+  AND (r.created_at, r.id) > (sqlc.arg(after_created_at)::timestamptz, sqlc.arg(after_id)::uuid)
+ORDER BY r.created_at, r.id
+LIMIT sqlc.arg(batch_size);
+
+-- name: ListRecentPublishedReviews :many
+-- The newest published reviews of a resource for its public feed.
+SELECT
+  r.id,
+  r.rating,
+  r.comment,
+  r.language,
+  r.created_at,
+  r.updated_at
+FROM reviews r
+WHERE r.resource_id = sqlc.arg(resource_id)
+  AND r.status = 'published'
+ORDER BY r.created_at DESC, r.id DESC
+LIMIT sqlc.arg(batch_size);
+
+-- name: FindReviewImportAuthor :one
+-- Who an imported review of the resource is by: the member of the resource's company with
+-- the external ID, else the user with the email. Empty values match nobody.
+SELECT id FROM (
+  SELECT u.id, 1 AS priority
+  FROM users u
+  JOIN resources res ON res.company_id = u.company_id
+  WHERE res.id = @resource_id AND u.external_id = @external_id::text
+  UNION ALL
+  SELECT u.id, 2 AS priority
+  FROM users u
+  WHERE u.email = @email::text
+) matches
+ORDER BY priority
+LIMIT 1;
@@ -0,0 +1,70 @@
+-- name: ListRoles :many
+SELECT
+    name,
+    description,
+    is_system,
+    created_at,
+    updated_at
+FROM roles
+ORDER BY name;
+
+-- name: GetRole :one
+SELECT
+    name,
+    description,
+    is_system,
+    created_at,
+    updated_at
+FROM roles
+WHERE name = $1;
+
+-- name: ListPermissions :many
+SELECT
+    name,
+    description,
+    created_at
+FROM permissions
+ORDER BY name;
+
+-- name: ListRolePermissions :many
+SELECT
+    role_name,
+    permission_name,
+    created_at
+FROM role_permissions
+ORDER BY role_name, permission_name;
+
+-- name: ListPermissionNamesByRole :many
+SELECT permission_name
+FROM role_permissions
+WHERE role_name = $1
+ORDER BY permission_name;
+
+-- name: CreateRole :exec
+INSERT INTO roles (
+    name,
+    description
+) VALUES (
+    $1, $2
+);
+
+-- name: TouchRole :exec
+UPDATE roles
+SET updated_at = NOW()
+WHERE name = $1;
+
+-- name: DeleteRole :execrows
+DELETE FROM roles
+WHERE name = $1 AND is_system = false;
+
+-- name: DeleteRolePermissions :exec
+DELETE FROM role_permissions
+WHERE role_name = $1;
+
+-- name: AddRolePermissions :batchexec
+INSERT INTO role_permissions (
+    role_name,
+    permission_name
+) VALUES (
+    $1, $2
+);
@@ -0,0 +1,40 @@
+-- name: UpsertSAMLConnection :exec
+INSERT INTO company_saml_connections (
+    company_id,
+    idp_entity_id,
+    idp_metadata,
+    email_attribute,
+    role_attribute,
+    role_mapping,
+    default_role,
+    updated_by,
+    updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+)
+ON CONFLICT (company_id) DO UPDATE SET
+    idp_entity_id = EXCLUDED.idp_entity_id,
+    idp_metadata = EXCLUDED.idp_metadata,
+    email_attribute = EXCLUDED.email_attribute,
+    role_attribute = EXCLUDED.role_attribute,
+    role_mapping = EXCLUDED.role_mapping,
+    default_role = EXCLUDED.default_role,
+    updated_by = EXCLUDED.updated_by,
+    updated_at = EXCLUDED.updated_at;
+
+-- name: DeleteSAMLConnection :execrows
+DELETE FROM company_saml_connections
+WHERE company_id = $1;
+
+-- name: GetSAMLConnection :one
+SELECT
+    company_id,
+    idp_entity_id,
+    idp_metadata,
+    email_attribute,
+    role_attribute,
+    role_mapping,
+    default_role,
+    updated_at
+FROM company_saml_connections
+WHERE company_id = $1;
@@ -0,0 +1,5 @@
+-- name: SetTransactionTags :exec
+SELECT
+    set_config('application_name', sqlc.arg(application_name)::text, true),
+    set_config('app.request_id', sqlc.arg(request_id)::text, true),
+    set_config('app.user_id', sqlc.arg(user_id)::text, true);
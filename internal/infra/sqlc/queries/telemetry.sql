@@ -0,0 +1,25 @@
+-- name: AddFeatureUsage :exec
+INSERT INTO feature_usage (day, feature, company_hash, request_count)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (day, feature, company_hash) DO UPDATE SET
+    request_count = feature_usage.request_count + EXCLUDED.request_count;
+
+-- name: ListFeatureAdoption :many
+SELECT
+    feature,
+    SUM(request_count)::bigint AS request_count,
+    COUNT(DISTINCT NULLIF(company_hash, ''))::bigint AS company_count
+FROM feature_usage
+WHERE day >= @from_day::date AND day <= @to_day::date
+GROUP BY feature
+ORDER BY company_count DESC, request_count DESC, feature;
+
+-- name: ListTelemetryCompanies :many
+-- Users without a company are left out.
+SELECT
+    u.id AS user_id,
+    u.company_id::uuid AS company_id,
+    COALESCE(s.telemetry_opt_out, false)::boolean AS opted_out
+FROM users u
+LEFT JOIN company_settings s ON s.company_id = u.company_id
+WHERE u.id = ANY(@user_ids::uuid[]) AND u.company_id IS NOT NULL;
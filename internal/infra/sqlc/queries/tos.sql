@@ -0,0 +1,18 @@
+-- name: AcceptTOSVersion :exec
+-- Accepting again keeps the first acceptance as the evidence.
+INSERT INTO tos_acceptances (user_id, tos_version_id, accepted_at, ip_address)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (user_id, tos_version_id) DO NOTHING;
+
+-- name: GetCurrentTOSVersion :one
+SELECT id, version, url, published_at
+FROM tos_versions
+WHERE published_at <= @now::timestamptz
+ORDER BY published_at DESC
+LIMIT 1;
+
+-- name: HasAcceptedTOSVersion :one
+SELECT EXISTS (
+    SELECT 1 FROM tos_acceptances
+    WHERE user_id = $1 AND tos_version_id = $2
+);
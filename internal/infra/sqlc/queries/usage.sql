@@ -0,0 +1,43 @@
+-- name: AddAPIUsage :exec
+-- Usage of users without a company is not metered.
+INSERT INTO api_usage (company_id, month, request_count, bytes_in, bytes_out, updated_at)
+SELECT u.company_id, @month::date, @request_count::bigint, @bytes_in::bigint, @bytes_out::bigint, @now::timestamptz
+FROM users u
+WHERE u.id = @user_id AND u.company_id IS NOT NULL
+ON CONFLICT (company_id, month) DO UPDATE SET
+    request_count = api_usage.request_count + EXCLUDED.request_count,
+    bytes_in = api_usage.bytes_in + EXCLUDED.bytes_in,
+    bytes_out = api_usage.bytes_out + EXCLUDED.bytes_out,
+    updated_at = EXCLUDED.updated_at;
+
+-- name: GetUserUsageQuota :one
+-- No row when the user has no company. A hard quota set in the company settings
+-- overrides the plan's.
+SELECT
+    u.company_id::uuid AS company_id,
+    COALESCE(a.request_count, 0)::bigint AS request_count,
+    s.monthly_request_soft_quota,
+    COALESCE(s.monthly_request_hard_quota, cp.monthly_request_quota) AS monthly_request_hard_quota
+FROM users u
+LEFT JOIN company_settings s ON s.company_id = u.company_id
+LEFT JOIN company_plans cp ON cp.company_id = u.company_id
+LEFT JOIN api_usage a ON a.company_id = u.company_id AND a.month = @month::date
+WHERE u.id = @user_id AND u.company_id IS NOT NULL;
+
+-- name: ListAPIUsageByMonth :many
+SELECT
+    a.company_id,
+    c.name AS company_name,
+    a.request_count,
+    a.bytes_in,
+    a.bytes_out,
+    s.monthly_request_soft_quota,
+    COALESCE(s.monthly_request_hard_quota, cp.monthly_request_quota) AS monthly_request_hard_quota,
+    a.updated_at
+FROM api_usage a
+JOIN companies c ON c.id = a.company_id
+LEFT JOIN company_settings s ON s.company_id = a.company_id
+LEFT JOIN company_plans cp ON cp.company_id = a.company_id
+WHERE a.month = @month::date
+  AND (sqlc.narg(company_id)::uuid IS NULL OR a.company_id = sqlc.narg(company_id)::uuid)
+ORDER BY a.request_count DESC, a.company_id;
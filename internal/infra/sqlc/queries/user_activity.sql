@@ -0,0 +1,24 @@
+-- name: CreateUserActivity :exec
+INSERT INTO user_activity (user_id, kind, subject_id, resource_id, data, occurred_at)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: DeleteUserActivityBySubject :exec
+DELETE FROM user_activity
+WHERE kind = $1 AND subject_id = $2;
+
+-- name: ListUserActivityFirstPage :many
+SELECT id, user_id, kind, subject_id, resource_id, data, occurred_at
+FROM user_activity
+WHERE user_id = @user_id
+  AND (sqlc.narg(kind)::text IS NULL OR kind = sqlc.narg(kind)::text)
+ORDER BY occurred_at DESC, id DESC
+LIMIT @limit_count;
+
+-- name: ListUserActivityKeyset :many
+SELECT id, user_id, kind, subject_id, resource_id, data, occurred_at
+FROM user_activity
+WHERE user_id = @user_id
+  AND (sqlc.narg(kind)::text IS NULL OR kind = sqlc.narg(kind)::text)
+  AND (occurred_at < @last_occurred_at OR (occurred_at = @last_occurred_at AND id < @last_id))
+ORDER BY occurred_at DESC, id DESC
+LIMIT @limit_count;
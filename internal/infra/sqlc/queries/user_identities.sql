@@ -0,0 +1,14 @@
+-- name: GetUserIdentityUserID :one
+SELECT user_id
+FROM user_identities
+WHERE provider = @provider AND subject = @subject;
+
+-- name: CreateUserIdentity :exec
+INSERT INTO user_identities (provider, subject, user_id, email, created_at, last_login_at)
+VALUES (@provider, @subject, @user_id, @email, @created_at, @created_at);
+
+-- name: TouchUserIdentity :exec
+UPDATE user_identities
+SET email = @email,
+    last_login_at = @last_login_at
+WHERE provider = @provider AND subject = @subject;
@@ -0,0 +1,111 @@
+-- name: LockUserMergeAccounts :many
+-- Locks both accounts of a merge, in ID order so concurrent merges of the pair cannot
+-- deadlock.
+SELECT id, company_id, is_active
+FROM users
+WHERE id IN (@source_id::uuid, @target_id::uuid)
+ORDER BY id
+FOR UPDATE;
+
+-- name: CountUserMerge :one
+-- What merging the source account into the target would move.
+SELECT
+    (SELECT count(*) FROM reservations WHERE user_id = @source_id::uuid) AS reservations,
+    (SELECT count(*) FROM reviews WHERE user_id = @source_id::uuid) AS reviews,
+    (SELECT count(*) FROM referral_credits WHERE user_id = @source_id::uuid) AS referral_credits,
+    (SELECT count(*) FROM loyalty_ledger WHERE user_id = @source_id::uuid) AS loyalty_entries,
+    (SELECT count(*) FROM user_activity WHERE user_id = @source_id::uuid) AS activity_entries,
+    (SELECT count(*) FROM user_identities WHERE user_id = @source_id::uuid) AS identities,
+    (SELECT count(*) FROM reservation_messages WHERE author_id = @source_id::uuid) AS messages,
+    (SELECT count(*) FROM reservation_transfers
+     WHERE @source_id::uuid IN (from_user_id, to_user_id, initiated_by)) AS transfers,
+    (SELECT count(*) FROM user_sessions
+     WHERE user_id = @source_id::uuid AND revoked_at IS NULL AND expires_at > @now::timestamptz) AS sessions;
+
+-- name: ListUserMergeEncryptedMessages :many
+-- The source account's encrypted messages. Their ciphertext is bound to the author, so
+-- they are sealed again for the target one by one before MergeUser moves the rest.
+SELECT id, body_ciphertext::text AS body_ciphertext
+FROM reservation_messages
+WHERE author_id = @source_id::uuid AND body_ciphertext IS NOT NULL
+ORDER BY id
+FOR UPDATE;
+
+-- name: ReauthorReservationMessage :exec
+UPDATE reservation_messages
+SET author_id = @author_id::uuid, body = @body, body_ciphertext = @body_ciphertext
+WHERE id = @id::uuid;
+
+-- name: MergeUser :one
+-- Moves the source account's reservations, reviews, referral credits, loyalty points,
+-- activity timeline, sign-in identities and messages to the target, re-points transfers
+-- and deactivates it. Counts are as CountUserMerge reports them.
+WITH moved_reservations AS (
+    UPDATE reservations SET user_id = @target_id::uuid, updated_at = @now::timestamptz
+    WHERE user_id = @source_id::uuid
+    RETURNING id
+),
+-- Reviews move with their reservations, which have one review at most, so none clash.
+moved_reviews AS (
+    UPDATE reviews SET user_id = @target_id::uuid
+    WHERE user_id = @source_id::uuid
+    RETURNING id
+),
+moved_credits AS (
+    UPDATE referral_credits SET user_id = @target_id::uuid
+    WHERE user_id = @source_id::uuid
+    RETURNING id
+),
+moved_points AS (
+    UPDATE loyalty_ledger SET user_id = @target_id::uuid
+    WHERE user_id = @source_id::uuid
+    RETURNING id
+),
+moved_activity AS (
+    UPDATE user_activity SET user_id = @target_id::uuid
+    WHERE user_id = @source_id::uuid
+    RETURNING id
+),
+-- Linked OIDC and SAML identities sign in to the target from now on.
+moved_identities AS (
+    UPDATE user_identities SET user_id = @target_id::uuid
+    WHERE user_id = @source_id::uuid
+    RETURNING subject
+),
+moved_messages AS (
+    UPDATE reservation_messages SET author_id = @target_id::uuid
+    WHERE author_id = @source_id::uuid
+    RETURNING id
+),
+-- A transfer between the two accounts would hand a reservation to its own owner once they
+-- are one, so it is dropped; the others name the target in place of the source.
+dropped_transfers AS (
+    DELETE FROM reservation_transfers
+    WHERE (from_user_id = @source_id::uuid AND to_user_id = @target_id::uuid)
+       OR (from_user_id = @target_id::uuid AND to_user_id = @source_id::uuid)
+    RETURNING id
+),
+moved_transfers AS (
+    UPDATE reservation_transfers SET
+        from_user_id = CASE WHEN from_user_id = @source_id::uuid THEN @target_id::uuid ELSE from_user_id END,
+        to_user_id = CASE WHEN to_user_id = @source_id::uuid THEN @target_id::uuid ELSE to_user_id END,
+        initiated_by = CASE WHEN initiated_by = @source_id::uuid THEN @target_id::uuid ELSE initiated_by END
+    WHERE @source_id::uuid IN (from_user_id, to_user_id, initiated_by)
+      AND NOT (from_user_id IN (@source_id::uuid, @target_id::uuid) AND to_user_id IN (@source_id::uuid, @target_id::uuid))
+    RETURNING id
+),
+deactivated AS (
+    UPDATE users SET is_active = false, updated_at = @now::timestamptz
+    WHERE id = @source_id::uuid
+)
+SELECT
+    (SELECT count(*) FROM moved_reservations) AS reservations,
+    (SELECT count(*) FROM moved_reviews) AS reviews,
+    (SELECT count(*) FROM moved_credits) AS referral_credits,
+    (SELECT count(*) FROM moved_points) AS loyalty_entries,
+    (SELECT count(*) FROM moved_activity) AS activity_entries,
+    (SELECT count(*) FROM moved_identities) AS identities,
+    (SELECT count(*) FROM moved_messages) AS messages,
+    (SELECT count(*) FROM dropped_transfers) + (SELECT count(*) FROM moved_transfers) AS transfers,
+    (SELECT count(*) FROM user_sessions
+     WHERE user_id = @source_id::uuid AND revoked_at IS NULL AND expires_at > @now::timestamptz) AS sessions;
@@ -0,0 +1,55 @@
+-- name: UpsertPendingUserMFA :exec
+-- Starts or restarts an enrollment; an enabled one is left alone.
+INSERT INTO user_mfa (user_id, secret_ciphertext, created_at)
+VALUES (@user_id, @secret_ciphertext, @created_at)
+ON CONFLICT (user_id) DO UPDATE
+SET secret_ciphertext = EXCLUDED.secret_ciphertext,
+    last_used_step = 0,
+    failed_attempts = 0,
+    locked_until = NULL,
+    created_at = EXCLUDED.created_at
+WHERE user_mfa.enabled_at IS NULL;
+
+-- name: GetUserMFAForUpdate :one
+SELECT user_id, secret_ciphertext, enabled_at, last_used_step, failed_attempts, locked_until
+FROM user_mfa
+WHERE user_id = @user_id
+FOR UPDATE;
+
+-- name: IsUserMFAEnabled :one
+SELECT EXISTS (
+    SELECT 1 FROM user_mfa
+    WHERE user_id = @user_id AND enabled_at IS NOT NULL
+)::boolean AS enabled;
+
+-- name: EnableUserMFA :exec
+UPDATE user_mfa
+SET enabled_at = @enabled_at,
+    last_used_step = @last_used_step
+WHERE user_id = @user_id;
+
+-- name: UpdateUserMFAAttempts :exec
+UPDATE user_mfa
+SET last_used_step = @last_used_step,
+    failed_attempts = @failed_attempts,
+    locked_until = @locked_until
+WHERE user_id = @user_id;
+
+-- name: DeleteUserMFA :exec
+DELETE FROM user_mfa
+WHERE user_id = @user_id;
+
+-- name: DeleteUserMFARecoveryCodes :exec
+DELETE FROM user_mfa_recovery_codes
+WHERE user_id = @user_id;
+
+-- name: CreateUserMFARecoveryCode :exec
+INSERT INTO user_mfa_recovery_codes (user_id, code_hash)
+VALUES (@user_id, @code_hash);
+
+-- name: UseUserMFARecoveryCode :one
+-- Spends the code: it matches once.
+UPDATE user_mfa_recovery_codes
+SET used_at = @used_at
+WHERE user_id = @user_id AND code_hash = @code_hash AND used_at IS NULL
+RETURNING user_id;
@@ -0,0 +1,31 @@
+-- name: UpsertUserSession :exec
+-- The first token of a session creates its row; later ones move it to the latest client.
+INSERT INTO user_sessions (id, user_id, device_fingerprint, ip_address, user_agent, created_at, last_seen_at, expires_at)
+VALUES (@id, @user_id, @device_fingerprint, @ip_address, @user_agent, @seen_at, @seen_at, @expires_at)
+ON CONFLICT (id) DO UPDATE SET
+    device_fingerprint = EXCLUDED.device_fingerprint,
+    ip_address = EXCLUDED.ip_address,
+    user_agent = EXCLUDED.user_agent,
+    last_seen_at = EXCLUDED.last_seen_at,
+    expires_at = EXCLUDED.expires_at;
+
+-- name: RevokeUserSession :execrows
+UPDATE user_sessions
+SET revoked_at = @revoked_at
+WHERE id = @id AND user_id = @user_id AND revoked_at IS NULL AND expires_at > @revoked_at;
+
+-- name: MarkUserSessionRevoked :exec
+UPDATE user_sessions
+SET revoked_at = @revoked_at
+WHERE id = @id AND revoked_at IS NULL;
+
+-- name: MarkUserSessionsRevoked :exec
+UPDATE user_sessions
+SET revoked_at = @revoked_at
+WHERE user_id = @user_id AND revoked_at IS NULL;
+
+-- name: ListUserSessions :many
+SELECT id, device_fingerprint, ip_address, user_agent, created_at, last_seen_at, expires_at
+FROM user_sessions
+WHERE user_id = @user_id AND revoked_at IS NULL AND expires_at > @now
+ORDER BY last_seen_at DESC, id;
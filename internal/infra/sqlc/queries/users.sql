@@ -13,17 +13,62 @@ FROM users
 WHERE email = $1;
 
 -- name: FindUserByID :one
-SELECT id, email, role, company_id, last_login, is_active, created_at, updated_at
+SELECT id, email, role, company_id, last_login, is_active, created_at, updated_at, phone_ciphertext
 FROM users 
 WHERE id = $1;
 
+-- name: TouchUserDevice :one
+-- Reports whether the fingerprint is new for the user; xmax is 0 only on a fresh insert.
+INSERT INTO user_devices (user_id, fingerprint, first_seen_at, last_seen_at)
+VALUES (@user_id, @fingerprint, @seen_at::timestamptz, @seen_at::timestamptz)
+ON CONFLICT (user_id, fingerprint) DO UPDATE SET last_seen_at = EXCLUDED.last_seen_at
+RETURNING (xmax = 0)::boolean AS inserted;
+
 -- name: UpdateUserLastLogin :exec
 UPDATE users 
 SET last_login = NOW(), updated_at = NOW()
 WHERE id = $1;
 
 -- name: CreateUser :one
-INSERT INTO users (email, password_hash, role, company_id, is_active)
-VALUES ($1, $2, $3, $4, true)
+INSERT INTO users (email, password_hash, role, company_id, referral_code, is_active)
+VALUES ($1, $2, $3, $4, $5, true)
+ON CONFLICT (referral_code) DO NOTHING
+RETURNING id;
+
+-- name: CreateInactiveUser :one
+-- Like CreateUser, for accounts that must verify their email before signing in.
+INSERT INTO users (email, password_hash, role, company_id, referral_code, is_active)
+VALUES ($1, $2, $3, $4, $5, false)
+ON CONFLICT (referral_code) DO NOTHING
 RETURNING id;
 
+-- name: ActivateUser :exec
+UPDATE users
+SET is_active = true, updated_at = NOW()
+WHERE id = $1;
+
+-- name: GetUserPasswordHashForUpdate :one
+-- Locks the row so concurrent password changes are checked one after the other.
+SELECT password_hash FROM users
+WHERE id = $1
+FOR UPDATE;
+
+-- name: SetUserPassword :exec
+UPDATE users
+SET password_hash = $2, updated_at = NOW()
+WHERE id = $1;
+
+-- name: SetUserPhone :exec
+UPDATE users
+SET phone_ciphertext = $2, updated_at = NOW()
+WHERE id = $1;
+
+-- name: SetUserExternalID :exec
+UPDATE users
+SET external_id = $2, updated_at = NOW()
+WHERE id = $1;
+
+-- name: SetUserRole :exec
+UPDATE users
+SET role = $2, updated_at = NOW()
+WHERE id = $1;
@@ -0,0 +1,91 @@
+//go:build unit
+
+package sso_test
+
+import (
+	"encoding/base64"
+	"net/url"
+	"strings"
+	"testing"
+
+	"gin-clean-starter/internal/infra/sso"
+	"gin-clean-starter/tests/common/samltest"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSAMLProvider_ParseIDPMetadata(t *testing.T) {
+	idp := samltest.NewIdP(t)
+	provider := sso.NewSAMLProvider("https://app.example.com/", false)
+
+	entityID, err := provider.ParseIDPMetadata(idp.Metadata(t))
+	require.NoError(t, err)
+	assert.Equal(t, idp.EntityID, entityID)
+
+	spMetadata, err := provider.Metadata(uuid.New())
+	require.NoError(t, err)
+	_, err = provider.ParseIDPMetadata(spMetadata)
+	assert.Error(t, err, "SP metadata is not an IdP")
+
+	_, err = provider.ParseIDPMetadata([]byte("<not-metadata"))
+	assert.Error(t, err)
+}
+
+func TestSAMLProvider_ParseResponse(t *testing.T) {
+	idp := samltest.NewIdP(t)
+	companyID := uuid.New()
+	provider := sso.NewSAMLProvider("https://app.example.com", false)
+	spMetadata, err := provider.Metadata(companyID)
+	require.NoError(t, err)
+	user := samltest.User{
+		NameID:     "jane@acme.example",
+		Attributes: map[string][]string{"groups": {"staff", "admins"}},
+	}
+
+	t.Run("success: a response to our request is accepted", func(t *testing.T) {
+		redirect, requestID, err := provider.AuthnRequestURL(companyID, idp.Metadata(t))
+		require.NoError(t, err)
+		u, err := url.Parse(redirect)
+		require.NoError(t, err)
+		assert.Equal(t, "idp.example.com", u.Host)
+		assert.Equal(t, requestID, samltest.RequestID(t, redirect))
+
+		assertion, err := provider.ParseResponse(companyID, idp.Metadata(t), idp.Response(t, spMetadata, requestID, user), []string{requestID})
+		require.NoError(t, err)
+		assert.Equal(t, "jane@acme.example", assertion.NameID)
+		assert.Equal(t, []string{"staff", "admins"}, assertion.Attributes["groups"])
+	})
+
+	t.Run("error: IdP-initiated responses need AllowIDPInitiated", func(t *testing.T) {
+		response := idp.Response(t, spMetadata, "", user)
+		_, err := provider.ParseResponse(companyID, idp.Metadata(t), response, nil)
+		assert.Error(t, err)
+
+		lenient := sso.NewSAMLProvider("https://app.example.com", true)
+		_, err = lenient.ParseResponse(companyID, idp.Metadata(t), response, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("error: a response for another company is rejected", func(t *testing.T) {
+		other, err := provider.Metadata(uuid.New())
+		require.NoError(t, err)
+		_, err = provider.ParseResponse(companyID, idp.Metadata(t), idp.Response(t, other, "id-1", user), []string{"id-1"})
+		assert.Error(t, err)
+	})
+
+	t.Run("error: a response signed by another IdP is rejected", func(t *testing.T) {
+		impostor := samltest.NewIdP(t)
+		_, err := provider.ParseResponse(companyID, idp.Metadata(t), impostor.Response(t, spMetadata, "id-1", user), []string{"id-1"})
+		assert.Error(t, err)
+	})
+
+	t.Run("error: a tampered response is rejected", func(t *testing.T) {
+		raw, err := base64.StdEncoding.DecodeString(idp.Response(t, spMetadata, "id-1", user))
+		require.NoError(t, err)
+		forged := strings.ReplaceAll(string(raw), "jane@acme.example", "mallory@acme.example")
+		_, err = provider.ParseResponse(companyID, idp.Metadata(t), base64.StdEncoding.EncodeToString([]byte(forged)), []string{"id-1"})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,90 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/pkg/errs"
+)
+
+var errInvalidKey = errs.New("storage key escapes the storage directory")
+
+// LocalFileStorage keeps files under a directory on the local disk. Multi-instance
+// deployments need a shared volume or another shared.FileStorage implementation.
+type LocalFileStorage struct {
+	dir string
+}
+
+func NewLocalFileStorage(dir string) (*LocalFileStorage, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, errs.Wrap(err, "failed to create storage directory")
+	}
+	return &LocalFileStorage{dir: dir}, nil
+}
+
+// Put writes to a temporary file first, so readers never see a partial file.
+func (s *LocalFileStorage) Put(_ context.Context, key string, content io.Reader) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return infra.WrapRepoErr("failed to create storage directory", err, infra.KindDBFailure)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return infra.WrapRepoErr("failed to create file", err, infra.KindDBFailure)
+	}
+	defer os.Remove(tmp.Name()) // no-op once renamed
+
+	if _, err = io.Copy(tmp, content); err != nil {
+		tmp.Close()
+		return infra.WrapRepoErr("failed to write file", err, infra.KindDBFailure)
+	}
+	if err = tmp.Close(); err != nil {
+		return infra.WrapRepoErr("failed to write file", err, infra.KindDBFailure)
+	}
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return infra.WrapRepoErr("failed to store file", err, infra.KindDBFailure)
+	}
+	return nil
+}
+
+func (s *LocalFileStorage) Open(_ context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, infra.WrapRepoErr("file not found", err, infra.KindNotFound)
+		}
+		return nil, infra.WrapRepoErr("failed to open file", err, infra.KindDBFailure)
+	}
+	return f, nil
+}
+
+func (s *LocalFileStorage) Delete(_ context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err = os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return infra.WrapRepoErr("failed to delete file", err, infra.KindDBFailure)
+	}
+	return nil
+}
+
+func (s *LocalFileStorage) path(key string) (string, error) {
+	if !filepath.IsLocal(key) {
+		return "", infra.WrapRepoErr("invalid storage key", errInvalidKey, infra.KindDBFailure)
+	}
+	return filepath.Join(s.dir, key), nil
+}
@@ -0,0 +1,49 @@
+//go:build unit
+
+package storage_test
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/infra/storage"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalFileStorage(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success: stored content round-trips and delete removes it", func(t *testing.T) {
+		s, err := storage.NewLocalFileStorage(t.TempDir())
+		require.NoError(t, err)
+
+		require.NoError(t, s.Put(ctx, "reservations/a/b", strings.NewReader("signed form")))
+		f, err := s.Open(ctx, "reservations/a/b")
+		require.NoError(t, err)
+		content, err := io.ReadAll(f)
+		require.NoError(t, f.Close())
+		require.NoError(t, err)
+		assert.Equal(t, "signed form", string(content))
+
+		require.NoError(t, s.Delete(ctx, "reservations/a/b"))
+		_, err = s.Open(ctx, "reservations/a/b")
+		assert.True(t, infra.IsKind(err, infra.KindNotFound), "expected not found, got %v", err)
+		assert.NoError(t, s.Delete(ctx, "reservations/a/b"), "deleting a missing file is a no-op")
+	})
+
+	t.Run("error: keys outside the storage directory are rejected", func(t *testing.T) {
+		s, err := storage.NewLocalFileStorage(t.TempDir())
+		require.NoError(t, err)
+
+		for _, key := range []string{"../escape", "/etc/passwd", ""} {
+			assert.Error(t, s.Put(ctx, key, strings.NewReader("x")), key)
+			_, err := s.Open(ctx, key)
+			assert.Error(t, err, key)
+		}
+	})
+}
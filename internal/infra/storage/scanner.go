@@ -0,0 +1,15 @@
+package storage
+
+import "context"
+
+// NoopFileScanner accepts every file. Replace it with a shared.FileScanner that calls
+// an antivirus service (e.g. clamd) where uploads come from untrusted users.
+type NoopFileScanner struct{}
+
+func NewNoopFileScanner() *NoopFileScanner {
+	return &NoopFileScanner{}
+}
+
+func (NoopFileScanner) Scan(context.Context, string, []byte) (bool, error) {
+	return true, nil
+}
@@ -0,0 +1,91 @@
+package summary
+
+import (
+	"context"
+	"strings"
+	"text/template"
+	"unicode/utf8"
+
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+)
+
+const (
+	maxQuotes      = 3
+	maxQuoteRunes  = 80
+	positiveRating = 4
+	negativeRating = 2
+)
+
+var summaryTemplate = template.Must(template.New("summary").Funcs(template.FuncMap{
+	"quotes": func(q []string) string { return `"` + strings.Join(q, `"; "`) + `"` },
+}).Parse(`{{.Positive}} of {{.Total}} recent reviews are positive.` +
+	`{{if .Pros}} Pros: {{quotes .Pros}}.{{end}}` +
+	`{{if .Cons}} Cons: {{quotes .Cons}}.{{end}}`))
+
+type summaryData struct {
+	Total    int
+	Positive int
+	Pros     []string
+	Cons     []string
+}
+
+// TemplateSummarizer fills a fixed template with the share of positive reviews and the
+// opening sentence of a few of the newest positive (pros) and negative (cons) ones.
+type TemplateSummarizer struct{}
+
+func NewTemplateSummarizer() *TemplateSummarizer {
+	return &TemplateSummarizer{}
+}
+
+func (TemplateSummarizer) Summarize(_ context.Context, reviews []shared.ReviewExcerpt) (string, error) {
+	if len(reviews) == 0 {
+		return "", nil
+	}
+
+	data := summaryData{Total: len(reviews)}
+	for _, r := range reviews {
+		switch {
+		case r.Rating >= positiveRating:
+			data.Positive++
+			data.Pros = appendQuote(data.Pros, r.Comment)
+		case r.Rating <= negativeRating:
+			data.Cons = appendQuote(data.Cons, r.Comment)
+		}
+	}
+
+	var b strings.Builder
+	if err := summaryTemplate.Execute(&b, data); err != nil {
+		return "", errs.Wrap(err, "failed to render review summary")
+	}
+	return b.String(), nil
+}
+
+// appendQuote adds the comment's first sentence unless the list is full or already has it.
+func appendQuote(quotes []string, comment string) []string {
+	if len(quotes) >= maxQuotes {
+		return quotes
+	}
+	quote := firstSentence(comment)
+	if quote == "" {
+		return quotes
+	}
+	for _, q := range quotes {
+		if strings.EqualFold(q, quote) {
+			return quotes
+		}
+	}
+	return append(quotes, quote)
+}
+
+func firstSentence(comment string) string {
+	s := strings.Join(strings.Fields(comment), " ")
+	if i := strings.IndexAny(s, ".!?"); i >= 0 {
+		s = s[:i]
+	}
+	s = strings.TrimSpace(strings.ReplaceAll(s, `"`, "'"))
+	if utf8.RuneCountInString(s) > maxQuoteRunes {
+		s = strings.TrimSpace(string([]rune(s)[:maxQuoteRunes-1])) + "…"
+	}
+	return s
+}
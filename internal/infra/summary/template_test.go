@@ -0,0 +1,68 @@
+//go:build unit
+
+package summary_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"gin-clean-starter/internal/infra/summary"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplateSummarizer_Summarize(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		name     string
+		reviews  []shared.ReviewExcerpt
+		expected string
+	}{
+		{
+			name:     "success: no reviews gives no summary",
+			reviews:  nil,
+			expected: "",
+		},
+		{
+			name: "success: pros and cons quote the first sentence of each side",
+			reviews: []shared.ReviewExcerpt{
+				{Rating: 5, Comment: "Quiet room. Would book again!"},
+				{Rating: 2, Comment: "The Wi-Fi dropped twice\nduring our call."},
+				{Rating: 3, Comment: "Fine overall."},
+				{Rating: 4, Comment: "quiet room"},
+				{Rating: 4, Comment: `Great "4K" projector!`},
+			},
+			expected: `3 of 5 recent reviews are positive. Pros: "Quiet room"; "Great '4K' projector". Cons: "The Wi-Fi dropped twice during our call".`,
+		},
+		{
+			name: "success: only negative reviews leave out the pros",
+			reviews: []shared.ReviewExcerpt{
+				{Rating: 1, Comment: "Heating was broken."},
+			},
+			expected: `0 of 1 recent reviews are positive. Cons: "Heating was broken".`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := summary.NewTemplateSummarizer().Summarize(ctx, tc.reviews)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+
+	t.Run("success: long quotes are truncated and at most three are kept", func(t *testing.T) {
+		reviews := make([]shared.ReviewExcerpt, 0, 5)
+		for _, c := range []string{strings.Repeat("a", 200), "b", "c", "d"} {
+			reviews = append(reviews, shared.ReviewExcerpt{Rating: 5, Comment: c})
+		}
+
+		got, err := summary.NewTemplateSummarizer().Summarize(ctx, reviews)
+		require.NoError(t, err)
+		assert.Equal(t, `4 of 4 recent reviews are positive. Pros: "`+strings.Repeat("a", 79)+`…"; "b"; "c".`, got)
+	})
+}
@@ -10,6 +10,7 @@ import (
 
 	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
 	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/pkg/reqctx"
 	"gin-clean-starter/internal/usecase/shared"
 
 	"github.com/jackc/pgx/v5"
@@ -28,9 +29,26 @@ var (
 	errMaxRetriesExceeded = errs.New("transaction failed after max retries")
 )
 
+// Options configures PostgresUoW behavior that comes from config.
+type Options struct {
+	// TagTransactions runs SetTransactionTags at the start of every transaction so
+	// pg_stat_activity and slow-query logs (log_line_prefix %a) show the request.
+	TagTransactions bool
+	// ApplicationName is the connection-level name; tagged transactions report
+	// "<ApplicationName>/<request id>" until they end.
+	ApplicationName string
+}
+
+// ReadRouter picks the endpoint for lag-tolerant reads and names it.
+type ReadRouter interface {
+	Pick() (string, sqlc.DBTX)
+}
+
 type PostgresUoW struct {
-	pool *pgxpool.Pool
-	q    *sqlc.Queries
+	pool  *pgxpool.Pool
+	q     *sqlc.Queries
+	opts  Options
+	reads ReadRouter
 
 	// write repositories provided via DI
 	reservationRepo  shared.ReservationRepository
@@ -39,27 +57,88 @@ type PostgresUoW struct {
 	idempotencyRepo  shared.IdempotencyRepository
 	notificationRepo shared.NotificationRepository
 	userRepo         shared.UserRepository
+	roleRepo         shared.RoleRepository
+	operatorRepo     shared.ResourceOperatorRepository
+	inviteRepo       shared.InviteRepository
+	companyRepo      shared.CompanyRepository
+	resourceRepo     shared.ResourceRepository
+	auditRepo        shared.AuditRepository
+	referralRepo     shared.ReferralRepository
+	loyaltyRepo      shared.LoyaltyRepository
+	tosRepo          shared.TOSRepository
+	blockRepo        shared.ResourceBlockRepository
+	messageRepo      shared.ReservationMessageRepository
+	attachmentRepo   shared.ReservationAttachmentRepository
+	transferRepo     shared.ReservationTransferRepository
+	billingRepo      shared.BillingRepository
+	provisioningRepo shared.ProvisioningRepository
+	samlRepo         shared.SAMLConnectionRepository
+	approvalRepo     shared.ReservationApprovalRepository
+	customFieldRepo  shared.CustomFieldRepository
+	activityRepo     shared.ActivityRepository
 }
 
 func NewPostgresUoW(
 	pool *pgxpool.Pool,
 	q *sqlc.Queries,
+	opts Options,
+	reads ReadRouter,
 	reservationRepo shared.ReservationRepository,
 	reviewRepo shared.ReviewRepository,
 	ratingStatsRepo shared.RatingStatsRepository,
 	idempotencyRepo shared.IdempotencyRepository,
 	notificationRepo shared.NotificationRepository,
 	userRepo shared.UserRepository,
+	roleRepo shared.RoleRepository,
+	operatorRepo shared.ResourceOperatorRepository,
+	inviteRepo shared.InviteRepository,
+	companyRepo shared.CompanyRepository,
+	resourceRepo shared.ResourceRepository,
+	auditRepo shared.AuditRepository,
+	referralRepo shared.ReferralRepository,
+	loyaltyRepo shared.LoyaltyRepository,
+	tosRepo shared.TOSRepository,
+	blockRepo shared.ResourceBlockRepository,
+	messageRepo shared.ReservationMessageRepository,
+	attachmentRepo shared.ReservationAttachmentRepository,
+	transferRepo shared.ReservationTransferRepository,
+	billingRepo shared.BillingRepository,
+	provisioningRepo shared.ProvisioningRepository,
+	samlRepo shared.SAMLConnectionRepository,
+	approvalRepo shared.ReservationApprovalRepository,
+	customFieldRepo shared.CustomFieldRepository,
+	activityRepo shared.ActivityRepository,
 ) shared.UnitOfWork {
 	return &PostgresUoW{
 		pool:             pool,
 		q:                q,
+		opts:             opts,
+		reads:            reads,
 		reservationRepo:  reservationRepo,
 		reviewRepo:       reviewRepo,
 		ratingStatsRepo:  ratingStatsRepo,
 		idempotencyRepo:  idempotencyRepo,
 		notificationRepo: notificationRepo,
 		userRepo:         userRepo,
+		roleRepo:         roleRepo,
+		operatorRepo:     operatorRepo,
+		inviteRepo:       inviteRepo,
+		companyRepo:      companyRepo,
+		resourceRepo:     resourceRepo,
+		auditRepo:        auditRepo,
+		referralRepo:     referralRepo,
+		loyaltyRepo:      loyaltyRepo,
+		tosRepo:          tosRepo,
+		blockRepo:        blockRepo,
+		messageRepo:      messageRepo,
+		attachmentRepo:   attachmentRepo,
+		transferRepo:     transferRepo,
+		billingRepo:      billingRepo,
+		provisioningRepo: provisioningRepo,
+		samlRepo:         samlRepo,
+		approvalRepo:     approvalRepo,
+		customFieldRepo:  customFieldRepo,
+		activityRepo:     activityRepo,
 	}
 }
 
@@ -71,6 +150,13 @@ func (u *PostgresUoW) Within(ctx context.Context, fn func(ctx context.Context, t
 // Read-only transaction for consistent multi-table snapshots
 func (u *PostgresUoW) DB(_ context.Context) sqlc.DBTX { return u.pool }
 
+// ReadDB leaves the choice to the read router and reports it for X-Served-By
+func (u *PostgresUoW) ReadDB(ctx context.Context) sqlc.DBTX {
+	endpoint, db := u.reads.Pick()
+	reqctx.ReportServedBy(ctx, endpoint)
+	return db
+}
+
 // Avoids defer accumulation in retry loops to prevent connection leaks
 func (u *PostgresUoW) runInTxWithOptions(ctx context.Context, options pgx.TxOptions, fn func(ctx context.Context, tx shared.Tx) error) error {
 	const maxRetries = 3
@@ -81,6 +167,10 @@ func (u *PostgresUoW) runInTxWithOptions(ctx context.Context, options pgx.TxOpti
 		if err != nil {
 			return errs.Mark(err, errTransactionBegin)
 		}
+		if err := u.tagTransaction(ctx, pgxTx); err != nil {
+			_ = pgxTx.Rollback(context.WithoutCancel(ctx))
+			return errs.Mark(err, errTransactionBegin)
+		}
 
 		tx := &pgTx{
 			dbtx: pgxTx,
@@ -95,7 +185,9 @@ func (u *PostgresUoW) runInTxWithOptions(ctx context.Context, options pgx.TxOpti
 			err = errs.Mark(err, errTransactionCommit)
 		}
 
-		if rollbackErr := pgxTx.Rollback(ctx); rollbackErr != nil {
+		// A canceled request still rolls back on its connection, which then goes back to
+		// the pool instead of being dropped.
+		if rollbackErr := pgxTx.Rollback(context.WithoutCancel(ctx)); rollbackErr != nil {
 			if !errors.Is(rollbackErr, pgx.ErrTxClosed) {
 				slog.Warn("rollback failed", "attempt", attempt+1, "error", rollbackErr.Error())
 			}
@@ -128,6 +220,35 @@ func (u *PostgresUoW) runInTxWithOptions(ctx context.Context, options pgx.TxOpti
 	return errMaxRetriesExceeded
 }
 
+// tagTransaction sets transaction-local settings (SET LOCAL semantics), so they
+// reset on commit/rollback and never leak to the next user of the pooled connection.
+// Work outside an HTTP request (scheduler jobs) carries no metadata and is left untagged.
+func (u *PostgresUoW) tagTransaction(ctx context.Context, tx pgx.Tx) error {
+	if !u.opts.TagTransactions {
+		return nil
+	}
+
+	requestID := reqctx.RequestID(ctx)
+	userID := ""
+	if id, ok := reqctx.UserID(ctx); ok {
+		userID = id.String()
+	}
+	if requestID == "" && userID == "" {
+		return nil
+	}
+
+	appName := u.opts.ApplicationName
+	if requestID != "" {
+		appName += "/" + requestID
+	}
+
+	return u.q.SetTransactionTags(ctx, tx, sqlc.SetTransactionTagsParams{
+		ApplicationName: appName,
+		RequestID:       requestID,
+		UserID:          userID,
+	})
+}
+
 func shouldRetry(err error, attempt, maxRetries int) bool {
 	return isRetryableError(err) && attempt < maxRetries
 }
@@ -200,3 +321,79 @@ func (t *pgTx) Notifications() shared.NotificationRepository {
 func (t *pgTx) Users() shared.UserRepository {
 	return t.uow.userRepo
 }
+
+func (t *pgTx) Roles() shared.RoleRepository {
+	return t.uow.roleRepo
+}
+
+func (t *pgTx) ResourceOperators() shared.ResourceOperatorRepository {
+	return t.uow.operatorRepo
+}
+
+func (t *pgTx) Invites() shared.InviteRepository {
+	return t.uow.inviteRepo
+}
+
+func (t *pgTx) Companies() shared.CompanyRepository {
+	return t.uow.companyRepo
+}
+
+func (t *pgTx) Resources() shared.ResourceRepository {
+	return t.uow.resourceRepo
+}
+
+func (t *pgTx) Audit() shared.AuditRepository {
+	return t.uow.auditRepo
+}
+
+func (t *pgTx) Referrals() shared.ReferralRepository {
+	return t.uow.referralRepo
+}
+
+func (t *pgTx) Loyalty() shared.LoyaltyRepository {
+	return t.uow.loyaltyRepo
+}
+
+func (t *pgTx) TOS() shared.TOSRepository {
+	return t.uow.tosRepo
+}
+
+func (t *pgTx) ResourceBlocks() shared.ResourceBlockRepository {
+	return t.uow.blockRepo
+}
+
+func (t *pgTx) ReservationMessages() shared.ReservationMessageRepository {
+	return t.uow.messageRepo
+}
+
+func (t *pgTx) ReservationAttachments() shared.ReservationAttachmentRepository {
+	return t.uow.attachmentRepo
+}
+
+func (t *pgTx) ReservationTransfers() shared.ReservationTransferRepository {
+	return t.uow.transferRepo
+}
+
+func (t *pgTx) Billing() shared.BillingRepository {
+	return t.uow.billingRepo
+}
+
+func (t *pgTx) Provisioning() shared.ProvisioningRepository {
+	return t.uow.provisioningRepo
+}
+
+func (t *pgTx) SAMLConnections() shared.SAMLConnectionRepository {
+	return t.uow.samlRepo
+}
+
+func (t *pgTx) ReservationApprovals() shared.ReservationApprovalRepository {
+	return t.uow.approvalRepo
+}
+
+func (t *pgTx) CustomFields() shared.CustomFieldRepository {
+	return t.uow.customFieldRepo
+}
+
+func (t *pgTx) Activity() shared.ActivityRepository {
+	return t.uow.activityRepo
+}
@@ -0,0 +1,63 @@
+// Package buildinfo holds what was deployed. Release builds set the variables with
+//
+//	go build -ldflags "-X gin-clean-starter/internal/pkg/buildinfo.Version=v1.4.0 \
+//	  -X gin-clean-starter/internal/pkg/buildinfo.Commit=$(git rev-parse HEAD) \
+//	  -X gin-clean-starter/internal/pkg/buildinfo.BuildTime=$(date -u +%Y-%m-%dT%H:%M:%SZ)"
+//
+// Builds without ldflags fall back to the VCS stamp the go tool embeds.
+package buildinfo
+
+import (
+	"runtime"
+	"runtime/debug"
+	"sync"
+)
+
+var (
+	Version   = "dev"
+	Commit    = ""
+	BuildTime = ""
+)
+
+type Info struct {
+	Version   string
+	Commit    string
+	BuildTime string
+	GoVersion string
+	// Modified reports a build from a working tree with uncommitted changes; only known
+	// from the VCS stamp.
+	Modified bool
+}
+
+var current = sync.OnceValue(func() Info {
+	info := Info{
+		Version:   Version,
+		Commit:    Commit,
+		BuildTime: BuildTime,
+		GoVersion: runtime.Version(),
+	}
+	bi, ok := debug.ReadBuildInfo()
+	if !ok {
+		return info
+	}
+	for _, s := range bi.Settings {
+		switch s.Key {
+		case "vcs.revision":
+			if info.Commit == "" {
+				info.Commit = s.Value
+			}
+		case "vcs.time":
+			if info.BuildTime == "" {
+				info.BuildTime = s.Value
+			}
+		case "vcs.modified":
+			info.Modified = s.Value == "true"
+		}
+	}
+	return info
+})
+
+// Get returns the build's info; it is resolved once per process.
+func Get() Info {
+	return current()
+}
@@ -1,6 +1,9 @@
 package clock
 
-import "time"
+import (
+	"sync/atomic"
+	"time"
+)
 
 type Clock interface {
 	Now() time.Time
@@ -16,6 +19,29 @@ func (c *RealClock) Now() time.Time {
 	return time.Now()
 }
 
+// OffsetClock is the real clock shifted by an adjustable offset. E2E builds inject it so
+// tests can move time forward without sleeping; it is safe for concurrent use.
+type OffsetClock struct {
+	offset atomic.Int64
+}
+
+func NewOffsetClock() *OffsetClock {
+	return &OffsetClock{}
+}
+
+func (c *OffsetClock) Now() time.Time {
+	return time.Now().Add(c.Offset())
+}
+
+func (c *OffsetClock) Offset() time.Duration {
+	return time.Duration(c.offset.Load())
+}
+
+// SetOffset replaces the offset; zero restores real time.
+func (c *OffsetClock) SetOffset(d time.Duration) {
+	c.offset.Store(int64(d))
+}
+
 type MockClock struct {
 	currentTime time.Time
 }
@@ -1,7 +1,12 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/kelseyhightower/envconfig"
@@ -14,16 +19,62 @@ import (
 // -----------------------------------------------------------------------------
 
 type Config struct {
-	Server ServerConfig
-	DB     DBConfig
-	CORS   CORSConfig
-	Log    LogConfig
-	JWT    JWTConfig
-	Cookie CookieConfig
+	Server      ServerConfig
+	DB          DBConfig
+	Replicas    ReplicaConfig
+	CORS        CORSConfig
+	Log         LogConfig
+	JWT         JWTConfig
+	Cookie      CookieConfig
+	Pricing     PricingConfig
+	Scheduling  SchedulingConfig
+	Retention   RetentionConfig
+	Crypto      CryptoConfig
+	Authz       AuthzConfig
+	Invite      InviteConfig
+	Transfer    TransferConfig
+	Signup      SignupConfig
+	MFA         MFAConfig
+	Lockout     LoginLockoutConfig
+	RateLimit   RateLimitConfig
+	Company     CompanyConfig
+	Support     SupportConfig
+	Referral    ReferralConfig
+	Loyalty     LoyaltyConfig
+	Attachment  AttachmentConfig
+	Summary     SummaryConfig
+	Moderation  ModerationConfig
+	Eligibility EligibilityConfig
+	Usage       UsageConfig
+	Billing     BillingConfig
+	Telemetry   TelemetryConfig
+	Deprecation DeprecationConfig
+	AdminIP     AdminIPConfig
+	Features    FeaturesConfig
+	Maintenance MaintenanceConfig
+	SAML        SAMLConfig
+	OIDC        OIDCConfig
+	Approval    ApprovalConfig
+	PublicSite  PublicSiteConfig
+	Recording   RequestRecordingConfig
+	Export      CompanyExportConfig
+	Deletion    CompanyDeletionConfig
+	Digest      NotificationDigestConfig
 }
 
 type ServerConfig struct {
 	Port string `envconfig:"PORT" required:"true"`
+	// Body of 201 replies: representation | minimal ({"id"}); clients override it with a Prefer: return=... header
+	CreatedResponseBody string `envconfig:"CREATED_RESPONSE_BODY" default:"representation"`
+	// Shape of JSON bodies: compat (also writes legacy snake_case keys such as next_cursor) |
+	// plain | envelope ({"data","meta"} / {"errors","meta"}); clients override it with an
+	// X-Response-Format header
+	ResponseFormat string `envconfig:"RESPONSE_FORMAT" default:"compat"`
+	// Peers (IPs or CIDRs) allowed to set the client IP headers and traceparent; empty trusts
+	// none, so the client IP is the connection's address.
+	TrustedProxies []string `envconfig:"TRUSTED_PROXIES"`
+	// Read in order on requests from a trusted proxy.
+	ClientIPHeaders []string `envconfig:"CLIENT_IP_HEADERS" default:"X-Forwarded-For,X-Real-IP"`
 }
 
 type DBConfig struct {
@@ -34,13 +85,39 @@ type DBConfig struct {
 	DBName   string `envconfig:"DB_NAME" required:"true"`
 	SSLMode  string `envconfig:"DB_SSL_MODE" default:"disable"`
 	TimeZone string `envconfig:"DB_TIMEZONE" default:"Asia/Tokyo"`
+	// pgx exec mode: cache_statement | cache_describe | describe_exec | exec | simple_protocol.
+	// Behind transaction-pooling proxies (PgBouncer) use exec or simple_protocol: they cannot keep prepared statements.
+	QueryExecMode string `envconfig:"DB_QUERY_EXEC_MODE" default:"cache_statement"`
+	// Per-connection LRU size for the statement (or description) cache; 0 keeps the pgx default.
+	StatementCacheSize int `envconfig:"DB_STATEMENT_CACHE_SIZE" default:"512"`
+	// Prepares the hottest queries on every new connection; only applies in cache_statement mode.
+	PrepareHotStatements bool `envconfig:"DB_PREPARE_HOT_STATEMENTS" default:"true"`
+	// Reported in pg_stat_activity and log_line_prefix %a.
+	ApplicationName string `envconfig:"DB_APPLICATION_NAME" default:"gin-clean-starter"`
+	// Tags each UoW transaction with the request ID and user ID (SET LOCAL application_name, app.request_id, app.user_id).
+	TagTransactions bool `envconfig:"DB_TAG_TRANSACTIONS" default:"true"`
+}
+
+// Lag-tolerant reads (public resource pages, the sitemap, review feeds and admin reports)
+// may be served by read replicas, given as "<region>=<host>:<port>" and reached with the
+// DB_* credentials. Each replica is probed every ProbeInterval for latency and replication
+// lag, and skipped while a probe fails or it lags more than MaxLag. Routing "nearest"
+// picks the lowest-latency replica, "region" prefers replicas in Region, and "primary"
+// ignores replicas. ServedByHeader reports the endpoint in X-Served-By for debugging.
+type ReplicaConfig struct {
+	Endpoints      []string      `envconfig:"DB_READ_REPLICAS"`
+	Routing        string        `envconfig:"DB_READ_ROUTING" default:"primary"`
+	Region         string        `envconfig:"DB_READ_REGION"`
+	ProbeInterval  time.Duration `envconfig:"DB_READ_PROBE_INTERVAL" default:"10s"`
+	MaxLag         time.Duration `envconfig:"DB_READ_MAX_LAG" default:"5s"`
+	ServedByHeader bool          `envconfig:"DB_SERVED_BY_HEADER" default:"false"`
 }
 
 type CORSConfig struct {
 	AllowOrigins     []string      `envconfig:"CORS_ALLOW_ORIGINS" required:"true"`
 	AllowMethods     []string      `envconfig:"CORS_ALLOW_METHODS" default:"GET,POST,PUT,PATCH,DELETE,OPTIONS"`
-	AllowHeaders     []string      `envconfig:"CORS_ALLOW_HEADERS" default:"Origin,Content-Type,Accept,Authorization"`
-	ExposeHeaders    []string      `envconfig:"CORS_EXPOSE_HEADERS" default:"Content-Length"`
+	AllowHeaders     []string      `envconfig:"CORS_ALLOW_HEADERS" default:"Origin,Content-Type,Accept,Authorization,X-Device-Key,X-CSRF-Token,Prefer,X-Response-Format,traceparent,tracestate"`
+	ExposeHeaders    []string      `envconfig:"CORS_EXPOSE_HEADERS" default:"Content-Length,Location,Preference-Applied,X-App-Version,X-RateLimit-Limit,X-RateLimit-Remaining,X-RateLimit-Reset,Deprecation,Sunset,Link"`
 	AllowCredentials bool          `envconfig:"CORS_ALLOW_CREDENTIALS" default:"true"`
 	MaxAge           time.Duration `envconfig:"CORS_MAX_AGE" default:"12h"`
 }
@@ -56,13 +133,359 @@ type JWTConfig struct {
 	Secret               string `envconfig:"JWT_SECRET" required:"true"`
 	AccessTokenDuration  string `envconfig:"JWT_ACCESS_TOKEN_DURATION" default:"15m"`
 	RefreshTokenDuration string `envconfig:"JWT_REFRESH_TOKEN_DURATION" default:"168h"`
+	// Enables /auth/token endpoints that return tokens in the JSON body for non-browser clients
+	BodyTokensEnabled bool `envconfig:"JWT_BODY_TOKENS_ENABLED" default:"false"`
+	// Refresh token device binding: off | optional | required (see commands.DeviceBindingMode)
+	DeviceBinding string `envconfig:"JWT_DEVICE_BINDING" default:"optional"`
+	// How long a login sent with an Idempotency-Key is replayed to resubmissions; 0 disables
+	LoginReplayTTL time.Duration `envconfig:"LOGIN_REPLAY_TTL" default:"10s"`
+	// Signing key rotation: JWT_SECRET signs under KeyID, and the retired secrets in
+	// PreviousKeys (kid:secret,kid2:...) keep verifying tokens until RotationWindow after
+	// RotatedAt (RFC 3339), long enough for the refresh tokens they signed to expire.
+	KeyID          string        `envconfig:"JWT_KEY_ID" default:"default"`
+	PreviousKeys   string        `envconfig:"JWT_PREVIOUS_KEYS" default:""`
+	RotatedAt      time.Time     `envconfig:"JWT_ROTATED_AT"`
+	RotationWindow time.Duration `envconfig:"JWT_ROTATION_WINDOW" default:"168h"`
 }
 
+// Auth token cookies. Profile presets SameSite and Secure for how browsers reach the API
+// (see the CookieProfile constants) and overrides COOKIE_SAME_SITE and COOKIE_SECURE;
+// without one those apply as set. PublicURL is the origin browsers use, checked against
+// Secure and Domain at startup. With CSRF on, state-changing requests that carry the
+// cookies must echo a token from GET /auth/csrf in an X-CSRF-Token header.
 type CookieConfig struct {
-	Secure    bool   `envconfig:"COOKIE_SECURE" default:"false"`
-	SameSite  string `envconfig:"COOKIE_SAME_SITE" default:"Lax"`
-	Domain    string `envconfig:"COOKIE_DOMAIN" default:""`
-	HTTPSOnly bool   `envconfig:"COOKIE_HTTPS_ONLY" default:"true"`
+	Profile      string        `envconfig:"COOKIE_PROFILE"`
+	Secure       bool          `envconfig:"COOKIE_SECURE" default:"false"`
+	SameSite     string        `envconfig:"COOKIE_SAME_SITE" default:"Lax"`
+	Domain       string        `envconfig:"COOKIE_DOMAIN" default:""`
+	Path         string        `envconfig:"COOKIE_PATH" default:"/"`
+	PublicURL    string        `envconfig:"COOKIE_PUBLIC_URL"`
+	HTTPSOnly    bool          `envconfig:"COOKIE_HTTPS_ONLY" default:"true"`
+	CSRF         bool          `envconfig:"COOKIE_CSRF" default:"false"`
+	CSRFTokenTTL time.Duration `envconfig:"COOKIE_CSRF_TOKEN_TTL" default:"12h"`
+}
+
+const (
+	// Plain-HTTP development: Lax, not Secure.
+	CookieProfileLocal = "local"
+	// SPA served from the API's site: Lax, Secure.
+	CookieProfileSameSite = "same-site"
+	// SPA on another site: None, Secure and CSRF tokens, since every site's requests carry
+	// the cookies.
+	CookieProfileCrossSite = "cross-site"
+)
+
+// Coupon stacking: exclusive (one coupon) | stack_up_to_cap | best_of (see reservation.StackingRule)
+type PricingConfig struct {
+	TaxRateBasisPoints        int64         `envconfig:"PRICING_TAX_RATE_BPS" default:"0"` // 1000 = 10%
+	QuoteTTL                  time.Duration `envconfig:"PRICING_QUOTE_TTL" default:"15m"`
+	CouponStacking            string        `envconfig:"PRICING_COUPON_STACKING" default:"exclusive"`
+	CouponStackCapBasisPoints int64         `envconfig:"PRICING_COUPON_STACK_CAP_BPS" default:"5000"` // combined discount cap for stack_up_to_cap
+}
+
+// Lead-time and review eligibility checks tolerate client clocks up to ClockSkew off ours
+// (at most 5m): a slot may start that much early and a reservation be reviewed that much
+// before it ends.
+type SchedulingConfig struct {
+	ClockSkew time.Duration `envconfig:"SCHEDULING_CLOCK_SKEW" default:"30s"`
+}
+
+type RetentionConfig struct {
+	Enabled             bool          `envconfig:"RETENTION_ENABLED" default:"true"`
+	Interval            time.Duration `envconfig:"RETENTION_INTERVAL" default:"1h"`
+	BatchSize           int32         `envconfig:"RETENTION_BATCH_SIZE" default:"1000"`
+	NotificationsMaxAge time.Duration `envconfig:"RETENTION_NOTIFICATIONS_MAX_AGE" default:"2160h"` // 90d
+	IdempotencyMaxAge   time.Duration `envconfig:"RETENTION_IDEMPOTENCY_MAX_AGE" default:"720h"`    // 30d
+	AuditLogsMaxAge     time.Duration `envconfig:"RETENTION_AUDIT_LOGS_MAX_AGE" default:"8760h"`    // 365d
+	// RefreshTokensMaxAge counts from a token's expiry, not its issue.
+	RefreshTokensMaxAge time.Duration `envconfig:"RETENTION_REFRESH_TOKENS_MAX_AGE" default:"24h"`
+	// Self-registered accounts are deleted this long after their verification link expired unused.
+	UnverifiedUsersMaxAge time.Duration `envconfig:"RETENTION_UNVERIFIED_USERS_MAX_AGE" default:"168h"`
+}
+
+// Keys are injected by the secrets provider; retired keys stay listed until all rows are rotated.
+type CryptoConfig struct {
+	Keys        string `envconfig:"CRYPTO_KEYS" default:""` // id:base64(32 bytes),id2:...
+	ActiveKeyID string `envconfig:"CRYPTO_ACTIVE_KEY_ID" default:""`
+}
+
+// Role grants are cached per instance; edits made on another instance apply after the TTL.
+type AuthzConfig struct {
+	PermissionCacheTTL time.Duration `envconfig:"AUTHZ_PERMISSION_CACHE_TTL" default:"1m"`
+	// TOSCacheTTL bounds how long a newly published terms-of-service version goes unenforced.
+	TOSCacheTTL time.Duration `envconfig:"AUTHZ_TOS_CACHE_TTL" default:"1m"`
+	// SessionCacheTTL bounds how long access tokens of a revoked session are still accepted.
+	SessionCacheTTL time.Duration `envconfig:"AUTHZ_SESSION_CACHE_TTL" default:"30s"`
+}
+
+// AcceptURL is the frontend page that receives the signed token as ?token=...
+type InviteConfig struct {
+	TTL       time.Duration `envconfig:"INVITE_TTL" default:"72h"`
+	AcceptURL string        `envconfig:"INVITE_ACCEPT_URL" default:"http://localhost:3000/accept-invite"`
+}
+
+// Reservation transfer offers; the signed token is appended to AcceptURL as "token".
+type TransferConfig struct {
+	TTL       time.Duration `envconfig:"RESERVATION_TRANSFER_TTL" default:"72h"`
+	AcceptURL string        `envconfig:"RESERVATION_TRANSFER_ACCEPT_URL" default:"http://localhost:3000/accept-transfer"`
+}
+
+// Self-registration (POST /api/auth/register) is opt-in. Accounts start inactive until the
+// link mailed to the address is followed: VerifyURL with the signed token appended as "token".
+type SignupConfig struct {
+	Enabled         bool          `envconfig:"SIGNUP_ENABLED" default:"false"`
+	VerificationTTL time.Duration `envconfig:"SIGNUP_VERIFICATION_TTL" default:"24h"`
+	VerifyURL       string        `envconfig:"SIGNUP_VERIFY_URL" default:"http://localhost:3000/verify-email"`
+}
+
+// TOTP two-factor authentication. A sign-in of an enrolled user answers with an
+// mfa_pending token that must be redeemed with a code within ChallengeTTL; MaxAttempts
+// wrong codes in a row lock the user's second factor for Lockout.
+type MFAConfig struct {
+	Issuer        string        `envconfig:"MFA_ISSUER" default:"gin-clean-starter"`
+	ChallengeTTL  time.Duration `envconfig:"MFA_CHALLENGE_TTL" default:"5m"`
+	RecoveryCodes int           `envconfig:"MFA_RECOVERY_CODES" default:"10"`
+	MaxAttempts   int           `envconfig:"MFA_MAX_ATTEMPTS" default:"5"`
+	Lockout       time.Duration `envconfig:"MFA_LOCKOUT" default:"15m"`
+}
+
+// Password sign-in lockout. Failures are counted per email and client IP, so a flood from
+// one address does not lock the owner out elsewhere; MaxAttempts failures with no more
+// than Cooldown between them lock that pair for Cooldown. 0 turns the lockout off.
+type LoginLockoutConfig struct {
+	MaxAttempts int           `envconfig:"LOGIN_LOCKOUT_MAX_ATTEMPTS" default:"10"`
+	Cooldown    time.Duration `envconfig:"LOGIN_LOCKOUT_COOLDOWN" default:"15m"`
+}
+
+// Rate limit of the sign-in endpoints (/api/auth/login, /refresh and their /token
+// counterparts): at most PerIP requests per client IP and PerEmail per submitted email
+// within any Window. Counts are kept per instance. 0 turns a limit off.
+type RateLimitConfig struct {
+	Window   time.Duration `envconfig:"RATE_LIMIT_WINDOW" default:"1m"`
+	PerIP    int           `envconfig:"RATE_LIMIT_PER_IP" default:"30"`
+	PerEmail int           `envconfig:"RATE_LIMIT_PER_EMAIL" default:"10"`
+}
+
+// Self-service workspace registration (POST /api/companies) is opt-in; sample resources are created per company.
+type CompanyConfig struct {
+	RegistrationEnabled bool     `envconfig:"COMPANY_REGISTRATION_ENABLED" default:"false"`
+	DefaultTimezone     string   `envconfig:"COMPANY_DEFAULT_TIMEZONE" default:"Asia/Tokyo"`
+	DefaultLeadTimeMin  int32    `envconfig:"COMPANY_DEFAULT_LEAD_TIME_MIN" default:"0"`
+	SampleResources     []string `envconfig:"COMPANY_SAMPLE_RESOURCES" default:"Meeting Room A,Meeting Room B"`
+}
+
+// Support tokens cannot be refreshed; staff start a new session once this elapses.
+type SupportConfig struct {
+	SessionTTL time.Duration `envconfig:"SUPPORT_SESSION_TTL" default:"30m"`
+}
+
+// Rewards are issued by a background job once the referred user's first reservation has ended.
+type ReferralConfig struct {
+	RewardsEnabled      bool          `envconfig:"REFERRAL_REWARDS_ENABLED" default:"true"`
+	RewardInterval      time.Duration `envconfig:"REFERRAL_REWARD_INTERVAL" default:"5m"`
+	BatchSize           int32         `envconfig:"REFERRAL_REWARD_BATCH_SIZE" default:"100"`
+	ReferrerRewardCents int32         `envconfig:"REFERRAL_REFERRER_REWARD_CENTS" default:"1000"`
+	ReferredRewardCents int32         `envconfig:"REFERRAL_REFERRED_REWARD_CENTS" default:"1000"`
+}
+
+// Points accrue from a background job once a reservation's slot has ended; an expiry job
+// forfeits lots older than PointsTTL. Redemption is priced in the quote/reservation flow.
+type LoyaltyConfig struct {
+	JobsEnabled              bool          `envconfig:"LOYALTY_JOBS_ENABLED" default:"true"`
+	AccrualInterval          time.Duration `envconfig:"LOYALTY_ACCRUAL_INTERVAL" default:"5m"`
+	ExpiryInterval           time.Duration `envconfig:"LOYALTY_EXPIRY_INTERVAL" default:"1h"`
+	BatchSize                int32         `envconfig:"LOYALTY_BATCH_SIZE" default:"500"`
+	AccrualLookback          time.Duration `envconfig:"LOYALTY_ACCRUAL_LOOKBACK" default:"720h"` // 30d
+	PointsPerDollar          int64         `envconfig:"LOYALTY_POINTS_PER_DOLLAR" default:"1"`
+	PointsTTL                time.Duration `envconfig:"LOYALTY_POINTS_TTL" default:"8760h"` // 365d
+	CentsPerPoint            int64         `envconfig:"LOYALTY_CENTS_PER_POINT" default:"1"`
+	MaxRedemptionBasisPoints int64         `envconfig:"LOYALTY_MAX_REDEMPTION_BPS" default:"5000"` // share of the post-coupon amount points may cover
+}
+
+// Attachment types are checked against the sniffed content, not the client's Content-Type.
+type AttachmentConfig struct {
+	StorageDir   string   `envconfig:"ATTACHMENT_STORAGE_DIR" default:"./data/attachments"`
+	MaxBytes     int64    `envconfig:"ATTACHMENT_MAX_BYTES" default:"10485760"` // 10 MiB
+	AllowedTypes []string `envconfig:"ATTACHMENT_ALLOWED_TYPES" default:"application/pdf,image/png,image/jpeg"`
+}
+
+// Review summaries are regenerated by a background job for resources whose review count
+// changed; each summary looks at the RecentReviews newest reviews.
+type SummaryConfig struct {
+	JobEnabled    bool          `envconfig:"REVIEW_SUMMARY_JOB_ENABLED" default:"true"`
+	Interval      time.Duration `envconfig:"REVIEW_SUMMARY_INTERVAL" default:"10m"`
+	BatchSize     int32         `envconfig:"REVIEW_SUMMARY_BATCH_SIZE" default:"100"`
+	RecentReviews int32         `envconfig:"REVIEW_SUMMARY_RECENT_REVIEWS" default:"20"`
+}
+
+// A new review is held for moderation when its comment has a trigram similarity of at
+// least DuplicateThreshold (0..1, 0 disables) with one of the author's last DuplicateLookback reviews.
+type ModerationConfig struct {
+	DuplicateThreshold float32 `envconfig:"REVIEW_DUPLICATE_THRESHOLD" default:"0.8"`
+	DuplicateLookback  int32   `envconfig:"REVIEW_DUPLICATE_LOOKBACK" default:"20"`
+}
+
+// A reservation can be reviewed from OpensAfter past its end until WindowDays after it; zero
+// WindowDays never closes. Companies can override both in company_settings.
+type EligibilityConfig struct {
+	OpensAfter time.Duration `envconfig:"REVIEW_WINDOW_OPENS_AFTER" default:"0s"`
+	WindowDays int           `envconfig:"REVIEW_WINDOW_DAYS" default:"0"`
+}
+
+// API usage is counted per authenticated request and written to the company's monthly
+// totals every FlushInterval; quota checks reuse a company's totals for QuotaCacheTTL.
+type UsageConfig struct {
+	MeteringEnabled bool          `envconfig:"USAGE_METERING_ENABLED" default:"true"`
+	FlushInterval   time.Duration `envconfig:"USAGE_FLUSH_INTERVAL" default:"1m"`
+	QuotaCacheTTL   time.Duration `envconfig:"USAGE_QUOTA_CACHE_TTL" default:"1m"`
+}
+
+// New companies subscribe to DefaultPlan; the billing provider's webhooks, signed with
+// WebhookSecret, keep each subscription in sync afterwards.
+type BillingConfig struct {
+	DefaultPlan   string `envconfig:"BILLING_DEFAULT_PLAN" default:"free"`
+	WebhookSecret string `envconfig:"BILLING_WEBHOOK_SECRET"`
+}
+
+// Feature-usage counters are kept in memory and emitted to the analytics sink every
+// FlushInterval. Company IDs are replaced with an HMAC keyed by Salt; keep it secret and
+// stable, or the same company shows up under a new key.
+type TelemetryConfig struct {
+	Enabled       bool          `envconfig:"TELEMETRY_ENABLED" default:"true"`
+	FlushInterval time.Duration `envconfig:"TELEMETRY_FLUSH_INTERVAL" default:"5m"`
+	Salt          string        `envconfig:"TELEMETRY_SALT"`
+}
+
+// Calls to deprecated routes are counted per consumer and written to the daily totals of
+// the deprecated-route report every FlushInterval.
+type DeprecationConfig struct {
+	TrackingEnabled bool          `envconfig:"DEPRECATION_TRACKING_ENABLED" default:"true"`
+	FlushInterval   time.Duration `envconfig:"DEPRECATION_FLUSH_INTERVAL" default:"1m"`
+}
+
+// Restricts /api/admin/* and the debug routes by client IP. Deny entries win; with allow
+// entries, only matching clients pass. A rules file replaces both lists and is re-read
+// every RulesReloadInterval, so edits apply without a restart.
+type AdminIPConfig struct {
+	Allow               []string      `envconfig:"ADMIN_IP_ALLOW"`
+	Deny                []string      `envconfig:"ADMIN_IP_DENY"`
+	RulesFile           string        `envconfig:"ADMIN_IP_RULES_FILE"`
+	RulesReloadInterval time.Duration `envconfig:"ADMIN_IP_RULES_RELOAD_INTERVAL" default:"30s"`
+}
+
+// Per-company feature toggles. Companies without their own setting for a feature get
+// it when it is listed in Defaults; settings are cached for CacheTTL, so a change made on
+// another instance applies within it.
+type FeaturesConfig struct {
+	Defaults []string      `envconfig:"FEATURES_DEFAULT_ON" default:"review_moderation"`
+	CacheTTL time.Duration `envconfig:"FEATURES_CACHE_TTL" default:"1m"`
+}
+
+// Stored cursors (webhook watermarks, message read markers, outbox run times) further than
+// CursorClockSkew ahead of the clock are invalid. The background check only reports them
+// unless CursorAutoRepair is set; admins can repair them on demand either way.
+type MaintenanceConfig struct {
+	CursorCheckEnabled  bool          `envconfig:"MAINTENANCE_CURSOR_CHECK_ENABLED" default:"true"`
+	CursorCheckInterval time.Duration `envconfig:"MAINTENANCE_CURSOR_CHECK_INTERVAL" default:"1h"`
+	CursorAutoRepair    bool          `envconfig:"MAINTENANCE_CURSOR_AUTO_REPAIR" default:"false"`
+	CursorClockSkew     time.Duration `envconfig:"MAINTENANCE_CURSOR_CLOCK_SKEW" default:"5m"`
+}
+
+// SAML single sign-on for companies that upload their IdP metadata. Each company's service
+// provider lives under BaseURL, the public origin of this API. After a sign-in the browser
+// is sent to RedirectURL. IdP-initiated sign-ins answer no request of ours and can be
+// replayed into another browser, so they are refused unless AllowIDPInitiated is set.
+type SAMLConfig struct {
+	Enabled           bool   `envconfig:"SAML_ENABLED" default:"false"`
+	BaseURL           string `envconfig:"SAML_BASE_URL" default:"http://localhost:8080"`
+	RedirectURL       string `envconfig:"SAML_REDIRECT_URL" default:"http://localhost:3000/"`
+	AllowIDPInitiated bool   `envconfig:"SAML_ALLOW_IDP_INITIATED" default:"false"`
+}
+
+// OpenID Connect social login. Google is offered when GoogleClientID is set; any other
+// OpenID provider (Okta, Auth0, Keycloak, ...) is offered under ProviderName when
+// ProviderIssuer is set. Providers send the browser back to BaseURL, the public origin of
+// this API, at /api/auth/oidc/<provider>/callback; after a sign-in it is sent on to
+// RedirectURL.
+type OIDCConfig struct {
+	BaseURL              string `envconfig:"OIDC_BASE_URL" default:"http://localhost:8080"`
+	RedirectURL          string `envconfig:"OIDC_REDIRECT_URL" default:"http://localhost:3000/"`
+	GoogleClientID       string `envconfig:"OIDC_GOOGLE_CLIENT_ID"`
+	GoogleClientSecret   string `envconfig:"OIDC_GOOGLE_CLIENT_SECRET"`
+	ProviderName         string `envconfig:"OIDC_PROVIDER_NAME" default:"oidc"`
+	ProviderIssuer       string `envconfig:"OIDC_PROVIDER_ISSUER"`
+	ProviderClientID     string `envconfig:"OIDC_PROVIDER_CLIENT_ID"`
+	ProviderClientSecret string `envconfig:"OIDC_PROVIDER_CLIENT_SECRET"`
+}
+
+// Reservations on resources with designated approvers wait in pending_approval for TTL (or
+// until the slot starts, if sooner). A sweep job notifies the company's admins of requests
+// still pending after EscalateAfter and cancels the ones that expired.
+type ApprovalConfig struct {
+	TTL           time.Duration `envconfig:"APPROVAL_TTL" default:"48h"`
+	EscalateAfter time.Duration `envconfig:"APPROVAL_ESCALATE_AFTER" default:"24h"`
+	JobEnabled    bool          `envconfig:"APPROVAL_JOB_ENABLED" default:"true"`
+	JobInterval   time.Duration `envconfig:"APPROVAL_JOB_INTERVAL" default:"5m"`
+	BatchSize     int32         `envconfig:"APPROVAL_BATCH_SIZE" default:"200"`
+}
+
+// Public resource pages live at SiteURL + "/resources/<slug>" on the SEO-facing frontend; the
+// sitemap lists them there. Their data and the sitemap may be cached for CacheMaxAge, published
+// reviews and rating stats for ReviewsCacheMaxAge. Shared caches may serve any anonymous read
+// for StaleWhileRevalidate past its max age while they refetch it. The platform stats they
+// show are recomputed every StatsInterval.
+type PublicSiteConfig struct {
+	SiteURL              string        `envconfig:"PUBLIC_SITE_URL" default:"http://localhost:3000"`
+	CacheMaxAge          time.Duration `envconfig:"PUBLIC_CACHE_MAX_AGE" default:"5m"`
+	ReviewsCacheMaxAge   time.Duration `envconfig:"PUBLIC_REVIEWS_CACHE_MAX_AGE" default:"1m"`
+	StaleWhileRevalidate time.Duration `envconfig:"PUBLIC_CACHE_STALE_WHILE_REVALIDATE" default:"1m"`
+	StatsJobEnabled      bool          `envconfig:"PUBLIC_STATS_JOB_ENABLED" default:"true"`
+	StatsInterval        time.Duration `envconfig:"PUBLIC_STATS_INTERVAL" default:"15m"`
+}
+
+// Support can record a consenting user's next requests (at most MaxRequests) for TTL.
+// Bodies longer than MaxBodyBytes are not captured. Which users are being recorded is
+// cached for CacheTTL on each instance; a purge job deletes expired recordings.
+type RequestRecordingConfig struct {
+	TTL          time.Duration `envconfig:"REQUEST_RECORDING_TTL" default:"72h"`
+	MaxRequests  int32         `envconfig:"REQUEST_RECORDING_MAX_REQUESTS" default:"100"`
+	MaxBodyBytes int64         `envconfig:"REQUEST_RECORDING_MAX_BODY_BYTES" default:"65536"`
+	CacheTTL     time.Duration `envconfig:"REQUEST_RECORDING_CACHE_TTL" default:"30s"`
+	JobEnabled   bool          `envconfig:"REQUEST_RECORDING_JOB_ENABLED" default:"true"`
+	JobInterval  time.Duration `envconfig:"REQUEST_RECORDING_JOB_INTERVAL" default:"15m"`
+	BatchSize    int32         `envconfig:"REQUEST_RECORDING_BATCH_SIZE" default:"100"`
+}
+
+// Offboarding archives of a company's data are built by a job polling every JobInterval,
+// BatchSize rows per query. Archives are kept for TTL; download links last LinkTTL and
+// are re-issued on every status poll. A running export not finished after StaleAfter
+// (e.g. its instance died) is picked up again.
+type CompanyExportConfig struct {
+	TTL         time.Duration `envconfig:"COMPANY_EXPORT_TTL" default:"168h"`
+	LinkTTL     time.Duration `envconfig:"COMPANY_EXPORT_LINK_TTL" default:"15m"`
+	JobEnabled  bool          `envconfig:"COMPANY_EXPORT_JOB_ENABLED" default:"true"`
+	JobInterval time.Duration `envconfig:"COMPANY_EXPORT_JOB_INTERVAL" default:"30s"`
+	BatchSize   int32         `envconfig:"COMPANY_EXPORT_BATCH_SIZE" default:"500"`
+	StaleAfter  time.Duration `envconfig:"COMPANY_EXPORT_STALE_AFTER" default:"30m"`
+}
+
+// Company hard-deletes are purged by a job polling every JobInterval, BatchSize rows per
+// transaction. A running deletion without a checkpoint for StaleAfter (e.g. its instance
+// died) is taken over by the next run.
+type CompanyDeletionConfig struct {
+	JobEnabled  bool          `envconfig:"COMPANY_DELETION_JOB_ENABLED" default:"true"`
+	JobInterval time.Duration `envconfig:"COMPANY_DELETION_JOB_INTERVAL" default:"1m"`
+	BatchSize   int32         `envconfig:"COMPANY_DELETION_BATCH_SIZE" default:"200"`
+	StaleAfter  time.Duration `envconfig:"COMPANY_DELETION_STALE_AFTER" default:"10m"`
+}
+
+// Users who prefer a digest get non-urgent emails as one daily or weekly summary. A job
+// polling every JobInterval queues the digests of up to BatchSize users whose oldest held
+// notification has waited out their period.
+type NotificationDigestConfig struct {
+	JobEnabled  bool          `envconfig:"NOTIFICATION_DIGEST_JOB_ENABLED" default:"true"`
+	JobInterval time.Duration `envconfig:"NOTIFICATION_DIGEST_JOB_INTERVAL" default:"15m"`
+	BatchSize   int32         `envconfig:"NOTIFICATION_DIGEST_BATCH_SIZE" default:"100"`
 }
 
 func (c *DBConfig) BuildDSN() string {
@@ -78,22 +501,100 @@ func LoadConfig() (Config, error) {
 	if err != nil {
 		return Config{}, fmt.Errorf("failed to process env config: %w", err)
 	}
+	if cfg.Cookie, err = cfg.Cookie.Resolve(); err != nil {
+		return Config{}, err
+	}
 	return cfg, nil
 }
 
+// Resolve applies the profile and rejects settings browsers would silently refuse, so a
+// misconfigured deployment fails at startup rather than with users who cannot stay signed in.
+func (c CookieConfig) Resolve() (CookieConfig, error) {
+	switch c.Profile {
+	case "":
+	case CookieProfileLocal:
+		c.SameSite, c.Secure = "Lax", false
+	case CookieProfileSameSite:
+		c.SameSite, c.Secure = "Lax", true
+	case CookieProfileCrossSite:
+		c.SameSite, c.Secure, c.CSRF = "None", true, true
+	default:
+		return CookieConfig{}, fmt.Errorf("invalid COOKIE_PROFILE %q: want %q, %q or %q", c.Profile, CookieProfileLocal, CookieProfileSameSite, CookieProfileCrossSite)
+	}
+
+	switch c.SameSite {
+	case "Strict", "Lax":
+	case "None":
+		if !c.Secure {
+			return CookieConfig{}, errors.New("COOKIE_SAME_SITE=None requires COOKIE_SECURE=true")
+		}
+		if !c.CSRF {
+			return CookieConfig{}, errors.New("COOKIE_SAME_SITE=None requires COOKIE_CSRF=true")
+		}
+	default:
+		return CookieConfig{}, fmt.Errorf("invalid COOKIE_SAME_SITE %q: want Strict, Lax or None", c.SameSite)
+	}
+	if !strings.HasPrefix(c.Path, "/") {
+		return CookieConfig{}, fmt.Errorf("invalid COOKIE_PATH %q: must start with /", c.Path)
+	}
+	if c.CSRF && c.CSRFTokenTTL <= 0 {
+		return CookieConfig{}, errors.New("COOKIE_CSRF_TOKEN_TTL must be positive")
+	}
+
+	if c.PublicURL == "" {
+		return c, nil
+	}
+	u, err := url.Parse(c.PublicURL)
+	if err != nil || u.Host == "" {
+		return CookieConfig{}, fmt.Errorf("invalid COOKIE_PUBLIC_URL %q", c.PublicURL)
+	}
+	host := u.Hostname()
+	switch u.Scheme {
+	case "https":
+		if !c.Secure {
+			return CookieConfig{}, errors.New("COOKIE_SECURE must be true when COOKIE_PUBLIC_URL is https")
+		}
+	case "http":
+		// Browsers only accept Secure cookies over plain HTTP from localhost.
+		if c.Secure && host != "localhost" && host != "127.0.0.1" && host != "::1" {
+			return CookieConfig{}, errors.New("COOKIE_SECURE cannot be true when COOKIE_PUBLIC_URL is http")
+		}
+	default:
+		return CookieConfig{}, fmt.Errorf("invalid COOKIE_PUBLIC_URL %q: want an http or https origin", c.PublicURL)
+	}
+	if domain := strings.TrimPrefix(c.Domain, "."); domain != "" && host != domain && !strings.HasSuffix(host, "."+domain) {
+		return CookieConfig{}, fmt.Errorf("COOKIE_DOMAIN %q does not cover COOKIE_PUBLIC_URL host %q", c.Domain, host)
+	}
+	return c, nil
+}
+
 func NewTestConfig() Config {
 	return Config{
 		Server: ServerConfig{
-			Port: "8889", // Test port
+			Port:                "8889", // Test port
+			CreatedResponseBody: "representation",
+			ResponseFormat:      "compat",
+			ClientIPHeaders:     []string{"X-Forwarded-For", "X-Real-IP"},
 		},
 		DB: DBConfig{
-			Host:     "localhost",
-			Port:     "15433", // Test DB port
-			User:     "test",
-			Password: "test",
-			DBName:   "test_db",
-			SSLMode:  "disable",
-			TimeZone: "Asia/Tokyo",
+			Host:                 "localhost",
+			Port:                 "15433", // Test DB port
+			User:                 "test",
+			Password:             "test",
+			DBName:               "test_db",
+			SSLMode:              "disable",
+			TimeZone:             "Asia/Tokyo",
+			QueryExecMode:        "cache_statement",
+			StatementCacheSize:   512,
+			PrepareHotStatements: true,
+			ApplicationName:      "gin-clean-starter-test",
+			TagTransactions:      true,
+		},
+		Replicas: ReplicaConfig{
+			Routing:        "primary", // Tests run against a single database
+			ProbeInterval:  10 * time.Second,
+			MaxLag:         5 * time.Second,
+			ServedByHeader: true,
 		},
 		Log: LogConfig{
 			Level:          "error", // Error level only for tests
@@ -105,15 +606,208 @@ func NewTestConfig() Config {
 			Secret:               "test-jwt-secret-key",
 			AccessTokenDuration:  "15m",
 			RefreshTokenDuration: "168h",
+			BodyTokensEnabled:    true,
+			DeviceBinding:        "optional",
+			LoginReplayTTL:       10 * time.Second,
+			KeyID:                "default",
+			RotationWindow:       168 * time.Hour,
 		},
 		CORS: CORSConfig{
 			AllowOrigins: []string{"http://localhost:3000", "http://localhost:8080"},
 		},
 		Cookie: CookieConfig{
-			Secure:    false,
-			SameSite:  "Lax",
-			Domain:    "",
-			HTTPSOnly: false,
+			Secure:       false,
+			SameSite:     "Lax",
+			Domain:       "",
+			Path:         "/",
+			HTTPSOnly:    false,
+			CSRFTokenTTL: 12 * time.Hour,
+		},
+		Pricing: PricingConfig{
+			TaxRateBasisPoints:        1000,
+			QuoteTTL:                  15 * time.Minute,
+			CouponStacking:            "exclusive",
+			CouponStackCapBasisPoints: 5000,
+		},
+		Scheduling: SchedulingConfig{
+			ClockSkew: 30 * time.Second,
+		},
+		Retention: RetentionConfig{
+			Enabled:               false, // Purges are triggered explicitly in tests
+			Interval:              time.Hour,
+			BatchSize:             1000,
+			NotificationsMaxAge:   90 * 24 * time.Hour,
+			IdempotencyMaxAge:     30 * 24 * time.Hour,
+			AuditLogsMaxAge:       365 * 24 * time.Hour,
+			RefreshTokensMaxAge:   24 * time.Hour,
+			UnverifiedUsersMaxAge: 7 * 24 * time.Hour,
+		},
+		Crypto: CryptoConfig{
+			Keys:        "test:dGVzdC1jb2x1bW4tZW5jcnlwdGlvbi1rZXktMzJieXQ=", // "test-column-encryption-key-32byt"
+			ActiveKeyID: "test",
+		},
+		Authz: AuthzConfig{
+			PermissionCacheTTL: time.Minute,
+			TOSCacheTTL:        0, // Versions published by a test apply to its next request
+			SessionCacheTTL:    0, // Revocations apply to the next request
+		},
+		Invite: InviteConfig{
+			TTL:       72 * time.Hour,
+			AcceptURL: "http://localhost:3000/accept-invite",
+		},
+		Transfer: TransferConfig{
+			TTL:       72 * time.Hour,
+			AcceptURL: "http://localhost:3000/accept-transfer",
+		},
+		Signup: SignupConfig{
+			Enabled:         true,
+			VerificationTTL: 24 * time.Hour,
+			VerifyURL:       "http://localhost:3000/verify-email",
+		},
+		MFA: MFAConfig{
+			Issuer:        "gin-clean-starter",
+			ChallengeTTL:  5 * time.Minute,
+			RecoveryCodes: 10,
+			MaxAttempts:   5,
+			Lockout:       15 * time.Minute,
+		},
+		Lockout: LoginLockoutConfig{
+			MaxAttempts: 5,
+			Cooldown:    15 * time.Minute,
+		},
+		RateLimit: RateLimitConfig{
+			Window:   time.Minute,
+			PerIP:    0, // Off: every test request comes from one address
+			PerEmail: 0, // Off: the fixture users sign in throughout the run
+		},
+		Company: CompanyConfig{
+			RegistrationEnabled: true,
+			DefaultTimezone:     "Asia/Tokyo",
+			DefaultLeadTimeMin:  0,
+			SampleResources:     []string{"Meeting Room A", "Meeting Room B"},
+		},
+		Support: SupportConfig{
+			SessionTTL: 30 * time.Minute,
+		},
+		Referral: ReferralConfig{
+			RewardsEnabled:      false, // Rewards are issued explicitly in tests
+			RewardInterval:      5 * time.Minute,
+			BatchSize:           100,
+			ReferrerRewardCents: 1000,
+			ReferredRewardCents: 1000,
+		},
+		Loyalty: LoyaltyConfig{
+			JobsEnabled:              false, // Accrual and expiry are run explicitly in tests
+			AccrualInterval:          5 * time.Minute,
+			ExpiryInterval:           time.Hour,
+			BatchSize:                500,
+			AccrualLookback:          30 * 24 * time.Hour,
+			PointsPerDollar:          1,
+			PointsTTL:                365 * 24 * time.Hour,
+			CentsPerPoint:            1,
+			MaxRedemptionBasisPoints: 5000,
+		},
+		Attachment: AttachmentConfig{
+			StorageDir:   filepath.Join(os.TempDir(), "gin-clean-starter-attachments"),
+			MaxBytes:     1 << 20,
+			AllowedTypes: []string{"application/pdf", "image/png", "image/jpeg"},
+		},
+		Summary: SummaryConfig{
+			JobEnabled:    false, // Summaries are refreshed explicitly in tests
+			Interval:      10 * time.Minute,
+			BatchSize:     100,
+			RecentReviews: 20,
+		},
+		Moderation: ModerationConfig{
+			DuplicateThreshold: 0.8,
+			DuplicateLookback:  20,
+		},
+		Eligibility: EligibilityConfig{
+			OpensAfter: 0,
+			WindowDays: 0,
+		},
+		Usage: UsageConfig{
+			MeteringEnabled: true,
+			FlushInterval:   time.Minute,
+			QuotaCacheTTL:   0, // Usage written by a test applies to its next request
+		},
+		Billing: BillingConfig{
+			DefaultPlan:   "free",
+			WebhookSecret: "test-billing-secret",
+		},
+		Telemetry: TelemetryConfig{
+			Enabled:       true,
+			FlushInterval: 5 * time.Minute,
+			Salt:          "test-telemetry-salt",
+		},
+		Deprecation: DeprecationConfig{
+			TrackingEnabled: true,
+			FlushInterval:   time.Minute,
+		},
+		AdminIP: AdminIPConfig{
+			RulesReloadInterval: 30 * time.Second,
+		},
+		Features: FeaturesConfig{
+			Defaults: []string{"review_moderation"},
+			CacheTTL: 0, // Toggles set by a test apply to its next request
+		},
+		Maintenance: MaintenanceConfig{
+			CursorCheckEnabled:  false, // Checks are triggered explicitly in tests
+			CursorCheckInterval: time.Hour,
+			CursorClockSkew:     5 * time.Minute,
+		},
+		SAML: SAMLConfig{
+			Enabled:     true,
+			BaseURL:     "http://localhost:8080",
+			RedirectURL: "http://localhost:3000/",
+		},
+		OIDC: OIDCConfig{
+			BaseURL:      "http://localhost:8080",
+			RedirectURL:  "http://localhost:3000/",
+			ProviderName: "oidc", // Tests point ProviderIssuer at an in-process provider
+		},
+		Approval: ApprovalConfig{
+			TTL:           48 * time.Hour,
+			EscalateAfter: 24 * time.Hour,
+			JobEnabled:    false, // Sweeps are triggered explicitly in tests
+			JobInterval:   5 * time.Minute,
+			BatchSize:     200,
+		},
+		PublicSite: PublicSiteConfig{
+			SiteURL:              "https://example.com",
+			CacheMaxAge:          5 * time.Minute,
+			ReviewsCacheMaxAge:   time.Minute,
+			StaleWhileRevalidate: time.Minute,
+			StatsJobEnabled:      false, // Stats are refreshed explicitly in tests
+			StatsInterval:        15 * time.Minute,
+		},
+		Recording: RequestRecordingConfig{
+			TTL:          72 * time.Hour,
+			MaxRequests:  100,
+			MaxBodyBytes: 64 << 10,
+			CacheTTL:     0,     // Recordings enabled by a test capture its next request
+			JobEnabled:   false, // Purges are triggered explicitly in tests
+			JobInterval:  15 * time.Minute,
+			BatchSize:    100,
+		},
+		Export: CompanyExportConfig{
+			TTL:         168 * time.Hour,
+			LinkTTL:     15 * time.Minute,
+			JobEnabled:  false, // Requested exports stay pending in tests
+			JobInterval: 30 * time.Second,
+			BatchSize:   500,
+			StaleAfter:  30 * time.Minute,
+		},
+		Deletion: CompanyDeletionConfig{
+			JobEnabled:  false, // Requested deletions stay pending in tests
+			JobInterval: time.Minute,
+			BatchSize:   200,
+			StaleAfter:  10 * time.Minute,
+		},
+		Digest: NotificationDigestConfig{
+			JobEnabled:  false, // Held notifications stay held in tests
+			JobInterval: 15 * time.Minute,
+			BatchSize:   100,
 		},
 	}
 }
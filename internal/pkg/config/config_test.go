@@ -0,0 +1,124 @@
+//go:build unit
+
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/pkg/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCookieConfigResolve(t *testing.T) {
+	base := config.CookieConfig{SameSite: "Lax", Path: "/", CSRFTokenTTL: 12 * time.Hour}
+	with := func(change func(*config.CookieConfig)) config.CookieConfig {
+		c := base
+		change(&c)
+		return c
+	}
+
+	testCases := []struct {
+		name        string
+		cfg         config.CookieConfig
+		expected    config.CookieConfig
+		expectedErr string
+	}{
+		{
+			name:     "success: no profile keeps the settings",
+			cfg:      with(func(c *config.CookieConfig) { c.SameSite = "Strict" }),
+			expected: with(func(c *config.CookieConfig) { c.SameSite = "Strict" }),
+		},
+		{
+			name: "success: cross-site profile turns on Secure and CSRF",
+			cfg: with(func(c *config.CookieConfig) {
+				c.Profile = config.CookieProfileCrossSite
+				c.PublicURL = "https://api.example.com"
+				c.Domain = ".example.com"
+			}),
+			expected: with(func(c *config.CookieConfig) {
+				c.Profile = config.CookieProfileCrossSite
+				c.PublicURL = "https://api.example.com"
+				c.Domain = ".example.com"
+				c.SameSite, c.Secure, c.CSRF = "None", true, true
+			}),
+		},
+		{
+			name: "success: profile overrides COOKIE_SECURE",
+			cfg: with(func(c *config.CookieConfig) {
+				c.Profile = config.CookieProfileLocal
+				c.Secure = true
+				c.PublicURL = "http://app.internal:8080"
+			}),
+			expected: with(func(c *config.CookieConfig) {
+				c.Profile = config.CookieProfileLocal
+				c.PublicURL = "http://app.internal:8080"
+			}),
+		},
+		{
+			name:     "success: Secure cookies over http on localhost",
+			cfg:      with(func(c *config.CookieConfig) { c.Secure, c.PublicURL = true, "http://localhost:8080" }),
+			expected: with(func(c *config.CookieConfig) { c.Secure, c.PublicURL = true, "http://localhost:8080" }),
+		},
+		{
+			name:        "error: unknown profile",
+			cfg:         with(func(c *config.CookieConfig) { c.Profile = "prod" }),
+			expectedErr: "invalid COOKIE_PROFILE",
+		},
+		{
+			name:        "error: https origin without Secure",
+			cfg:         with(func(c *config.CookieConfig) { c.PublicURL = "https://api.example.com" }),
+			expectedErr: "COOKIE_SECURE must be true",
+		},
+		{
+			name:        "error: Secure cookies over http",
+			cfg:         with(func(c *config.CookieConfig) { c.Secure, c.PublicURL = true, "http://api.example.com" }),
+			expectedErr: "COOKIE_SECURE cannot be true",
+		},
+		{
+			name:        "error: SameSite None without Secure",
+			cfg:         with(func(c *config.CookieConfig) { c.SameSite, c.CSRF = "None", true }),
+			expectedErr: "requires COOKIE_SECURE=true",
+		},
+		{
+			name:        "error: SameSite None without CSRF tokens",
+			cfg:         with(func(c *config.CookieConfig) { c.SameSite, c.Secure = "None", true }),
+			expectedErr: "requires COOKIE_CSRF=true",
+		},
+		{
+			name:        "error: unknown SameSite",
+			cfg:         with(func(c *config.CookieConfig) { c.SameSite = "lax" }),
+			expectedErr: "invalid COOKIE_SAME_SITE",
+		},
+		{
+			name:        "error: relative path",
+			cfg:         with(func(c *config.CookieConfig) { c.Path = "api" }),
+			expectedErr: "invalid COOKIE_PATH",
+		},
+		{
+			name: "error: domain does not cover the origin",
+			cfg: with(func(c *config.CookieConfig) {
+				c.Profile = config.CookieProfileSameSite
+				c.PublicURL = "https://api.example.com"
+				c.Domain = "example.org"
+			}),
+			expectedErr: "does not cover",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resolved, err := tc.cfg.Resolve()
+
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, resolved)
+		})
+	}
+}
@@ -1,10 +1,14 @@
 package cookie
 
 import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
 	"net/http"
 	"time"
 
 	"gin-clean-starter/internal/pkg/config"
+	"gin-clean-starter/internal/pkg/signedtoken"
 
 	"github.com/gin-gonic/gin"
 )
@@ -12,6 +16,11 @@ import (
 const (
 	AccessTokenCookieName  = "access_token"
 	RefreshTokenCookieName = "refresh_token"
+	CSRFTokenCookieName    = "csrf_token"
+	// CSRFTokenHeader carries the csrf_token cookie's value back on state-changing requests.
+	CSRFTokenHeader = "X-CSRF-Token"
+
+	csrfPurpose = "csrf"
 )
 
 func SetTokenCookies(c *gin.Context, cfg config.CookieConfig, accessToken, refreshToken string, accessExpiry, refreshExpiry time.Duration) {
@@ -21,7 +30,7 @@ func SetTokenCookies(c *gin.Context, cfg config.CookieConfig, accessToken, refre
 		AccessTokenCookieName,
 		accessToken,
 		int(accessExpiry.Seconds()),
-		"/",
+		cfg.Path,
 		cfg.Domain,
 		cfg.Secure,
 		true, // HttpOnly
@@ -31,7 +40,7 @@ func SetTokenCookies(c *gin.Context, cfg config.CookieConfig, accessToken, refre
 		RefreshTokenCookieName,
 		refreshToken,
 		int(refreshExpiry.Seconds()),
-		"/",
+		cfg.Path,
 		cfg.Domain,
 		cfg.Secure,
 		true, // HttpOnly
@@ -45,7 +54,7 @@ func ClearTokenCookies(c *gin.Context, cfg config.CookieConfig) {
 		AccessTokenCookieName,
 		"",
 		-1,
-		"/",
+		cfg.Path,
 		cfg.Domain,
 		cfg.Secure,
 		true,
@@ -55,13 +64,61 @@ func ClearTokenCookies(c *gin.Context, cfg config.CookieConfig) {
 		RefreshTokenCookieName,
 		"",
 		-1,
-		"/",
+		cfg.Path,
 		cfg.Domain,
 		cfg.Secure,
 		true,
 	)
 }
 
+// IssueCSRFToken returns the request's CSRF token while it has at least half its lifetime
+// left, or sets a new one. Tokens are signed rather than stored, so any instance verifies
+// them. The cookie is readable by scripts; a cross-site SPA reads the returned value instead.
+func IssueCSRFToken(c *gin.Context, cfg config.CookieConfig, signer *signedtoken.Signer, now time.Time) (string, time.Time, error) {
+	if token, err := c.Cookie(CSRFTokenCookieName); err == nil {
+		var nonce string
+		if expiresAt, verr := signer.Verify(csrfPurpose, token, now, &nonce); verr == nil && expiresAt.Sub(now) >= cfg.CSRFTokenTTL/2 {
+			return token, expiresAt, nil
+		}
+	}
+
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return "", time.Time{}, err
+	}
+	expiresAt := now.Add(cfg.CSRFTokenTTL)
+	token, err := signer.Sign(csrfPurpose, base64.RawURLEncoding.EncodeToString(nonce), expiresAt)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	c.SetSameSite(getSameSite(cfg.SameSite))
+	c.SetCookie(
+		CSRFTokenCookieName,
+		token,
+		int(cfg.CSRFTokenTTL.Seconds()),
+		cfg.Path,
+		cfg.Domain,
+		cfg.Secure,
+		false, // read back by the SPA
+	)
+	return token, expiresAt, nil
+}
+
+// ValidCSRFToken reports whether the X-CSRF-Token header repeats the csrf_token cookie
+// and the token is an unexpired one of ours. Another site can make the browser send the
+// cookie but cannot read it to set the header.
+func ValidCSRFToken(c *gin.Context, signer *signedtoken.Signer, now time.Time) bool {
+	header := c.GetHeader(CSRFTokenHeader)
+	token, err := c.Cookie(CSRFTokenCookieName)
+	if header == "" || err != nil || subtle.ConstantTimeCompare([]byte(header), []byte(token)) != 1 {
+		return false
+	}
+	var nonce string
+	_, err = signer.Verify(csrfPurpose, token, now, &nonce)
+	return err == nil
+}
+
 func GetAccessToken(c *gin.Context) string {
 	token, _ := c.Cookie(AccessTokenCookieName)
 	return token
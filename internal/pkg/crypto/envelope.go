@@ -0,0 +1,220 @@
+package crypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrNoActiveKey         = errors.New("no active encryption key")
+	ErrUnknownKey          = errors.New("unknown encryption key")
+	ErrInvalidKey          = errors.New("invalid encryption key")
+	ErrMalformedCiphertext = errors.New("malformed ciphertext")
+	ErrDecryptionFailed    = errors.New("decryption failed")
+)
+
+const (
+	formatVersion = "v1"
+	keySize       = 32 // AES-256
+)
+
+var encoding = base64.RawURLEncoding
+
+// Envelope encrypts column values with a fresh data key per value; the data key is
+// wrapped by a master key identified by ID so old rows stay readable after rotation.
+//
+// Ciphertext format: v1.<keyID>.<wrapped data key>.<sealed value> (base64url parts).
+type Envelope struct {
+	keys     map[string]cipher.AEAD
+	activeID string
+}
+
+// NewEnvelope builds an envelope from 32-byte master keys. New values are encrypted
+// with activeID; every key in keys can still decrypt.
+func NewEnvelope(keys map[string][]byte, activeID string) (*Envelope, error) {
+	aeads := make(map[string]cipher.AEAD, len(keys))
+	for id, key := range keys {
+		if id == "" || strings.Contains(id, ".") {
+			return nil, fmt.Errorf("%w: key id %q", ErrInvalidKey, id)
+		}
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, fmt.Errorf("%w: key id %q", err, id)
+		}
+		aeads[id] = aead
+	}
+
+	if activeID != "" {
+		if _, ok := aeads[activeID]; !ok {
+			return nil, fmt.Errorf("%w: active key id %q", ErrUnknownKey, activeID)
+		}
+	}
+
+	return &Envelope{keys: aeads, activeID: activeID}, nil
+}
+
+// ParseKeys parses "id:base64key,id2:base64key" as supplied by the secrets provider.
+func ParseKeys(spec string) (map[string][]byte, error) {
+	keys := make(map[string][]byte)
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		id, encoded, ok := strings.Cut(part, ":")
+		if !ok {
+			return nil, fmt.Errorf("%w: expected id:base64", ErrInvalidKey)
+		}
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("%w: key id %q is not base64", ErrInvalidKey, id)
+		}
+		keys[id] = key
+	}
+	return keys, nil
+}
+
+// Encrypt seals plaintext; aad binds the ciphertext to its context (e.g. "users.phone:<id>")
+// so a value copied into another row fails to decrypt.
+func (e *Envelope) Encrypt(plaintext, aad []byte) (string, error) {
+	master, ok := e.keys[e.activeID]
+	if !ok {
+		return "", ErrNoActiveKey
+	}
+
+	dataKey := make([]byte, keySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", err
+	}
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+
+	wrapped, err := seal(master, dataKey, []byte(e.activeID))
+	if err != nil {
+		return "", err
+	}
+	sealed, err := seal(dataAEAD, plaintext, aad)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Join([]string{
+		formatVersion,
+		e.activeID,
+		encoding.EncodeToString(wrapped),
+		encoding.EncodeToString(sealed),
+	}, "."), nil
+}
+
+func (e *Envelope) Decrypt(ciphertext string, aad []byte) ([]byte, error) {
+	keyID, wrapped, sealed, err := parse(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+
+	master, ok := e.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownKey, keyID)
+	}
+
+	dataKey, err := open(master, wrapped, []byte(keyID))
+	if err != nil {
+		return nil, err
+	}
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, ErrDecryptionFailed
+	}
+
+	return open(dataAEAD, sealed, aad)
+}
+
+// CanEncrypt reports whether an active key is configured; without one, callers that
+// support plaintext storage keep writing plaintext and existing ciphertext stays readable.
+func (e *Envelope) CanEncrypt() bool {
+	_, ok := e.keys[e.activeID]
+	return ok
+}
+
+func (e *Envelope) EncryptString(plaintext, aad string) (string, error) {
+	return e.Encrypt([]byte(plaintext), []byte(aad))
+}
+
+func (e *Envelope) DecryptString(ciphertext, aad string) (string, error) {
+	plaintext, err := e.Decrypt(ciphertext, []byte(aad))
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+// NeedsRotation reports whether ciphertext was sealed with a key other than the active one.
+func (e *Envelope) NeedsRotation(ciphertext string) bool {
+	keyID, _, _, err := parse(ciphertext)
+	return err == nil && keyID != e.activeID
+}
+
+// Rotate re-encrypts ciphertext under the active key; it is a no-op when already current.
+func (e *Envelope) Rotate(ciphertext string, aad []byte) (string, error) {
+	plaintext, err := e.Decrypt(ciphertext, aad)
+	if err != nil {
+		return "", err
+	}
+	if !e.NeedsRotation(ciphertext) {
+		return ciphertext, nil
+	}
+	return e.Encrypt(plaintext, aad)
+}
+
+func parse(ciphertext string) (keyID string, wrapped, sealed []byte, err error) {
+	parts := strings.Split(ciphertext, ".")
+	if len(parts) != 4 || parts[0] != formatVersion || parts[1] == "" {
+		return "", nil, nil, ErrMalformedCiphertext
+	}
+	if wrapped, err = encoding.DecodeString(parts[2]); err != nil {
+		return "", nil, nil, ErrMalformedCiphertext
+	}
+	if sealed, err = encoding.DecodeString(parts[3]); err != nil {
+		return "", nil, nil, ErrMalformedCiphertext
+	}
+	return parts[1], wrapped, sealed, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != keySize {
+		return nil, ErrInvalidKey
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, ErrInvalidKey
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal prefixes the random nonce to the GCM output.
+func seal(aead cipher.AEAD, plaintext, aad []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, aad), nil
+}
+
+func open(aead cipher.AEAD, data, aad []byte) ([]byte, error) {
+	if len(data) < aead.NonceSize() {
+		return nil, ErrMalformedCiphertext
+	}
+	nonce, ct := data[:aead.NonceSize()], data[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ct, aad)
+	if err != nil {
+		return nil, ErrDecryptionFailed
+	}
+	return plaintext, nil
+}
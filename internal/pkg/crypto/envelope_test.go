@@ -0,0 +1,131 @@
+//go:build unit
+
+package crypto_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"gin-clean-starter/internal/pkg/crypto"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	oldKey = bytes.Repeat([]byte{1}, 32)
+	newKey = bytes.Repeat([]byte{2}, 32)
+)
+
+func TestEnvelope(t *testing.T) {
+	env, err := crypto.NewEnvelope(map[string][]byte{"k1": oldKey}, "k1")
+	require.NoError(t, err)
+
+	t.Run("round trips with matching aad", func(t *testing.T) {
+		ct, err := env.EncryptString("+81-90-0000-0000", "users.phone:1")
+		require.NoError(t, err)
+		assert.NotContains(t, ct, "0000")
+
+		pt, err := env.DecryptString(ct, "users.phone:1")
+		require.NoError(t, err)
+		assert.Equal(t, "+81-90-0000-0000", pt)
+	})
+
+	t.Run("same plaintext encrypts differently", func(t *testing.T) {
+		a, err := env.EncryptString("secret", "")
+		require.NoError(t, err)
+		b, err := env.EncryptString("secret", "")
+		require.NoError(t, err)
+		assert.NotEqual(t, a, b)
+	})
+
+	t.Run("mismatched aad is rejected", func(t *testing.T) {
+		ct, err := env.EncryptString("secret", "users.phone:1")
+		require.NoError(t, err)
+
+		_, err = env.DecryptString(ct, "users.phone:2")
+		assert.ErrorIs(t, err, crypto.ErrDecryptionFailed)
+	})
+
+	t.Run("tampered ciphertext is rejected", func(t *testing.T) {
+		ct, err := env.EncryptString("secret", "")
+		require.NoError(t, err)
+
+		parts := strings.Split(ct, ".")
+		sealed, err := base64.RawURLEncoding.DecodeString(parts[3])
+		require.NoError(t, err)
+		sealed[len(sealed)-1] ^= 0xff
+		parts[3] = base64.RawURLEncoding.EncodeToString(sealed)
+
+		_, err = env.DecryptString(strings.Join(parts, "."), "")
+		assert.ErrorIs(t, err, crypto.ErrDecryptionFailed)
+	})
+
+	t.Run("malformed ciphertext is rejected", func(t *testing.T) {
+		_, err := env.DecryptString("not-a-ciphertext", "")
+		assert.ErrorIs(t, err, crypto.ErrMalformedCiphertext)
+	})
+
+	t.Run("encrypt without active key fails", func(t *testing.T) {
+		readOnly, err := crypto.NewEnvelope(map[string][]byte{"k1": oldKey}, "")
+		require.NoError(t, err)
+
+		_, err = readOnly.EncryptString("secret", "")
+		assert.ErrorIs(t, err, crypto.ErrNoActiveKey)
+	})
+}
+
+func TestEnvelope_Rotation(t *testing.T) {
+	before, err := crypto.NewEnvelope(map[string][]byte{"k1": oldKey}, "k1")
+	require.NoError(t, err)
+	ct, err := before.EncryptString("api-key-material", "api_keys:1")
+	require.NoError(t, err)
+
+	after, err := crypto.NewEnvelope(map[string][]byte{"k1": oldKey, "k2": newKey}, "k2")
+	require.NoError(t, err)
+
+	pt, err := after.DecryptString(ct, "api_keys:1")
+	require.NoError(t, err)
+	assert.Equal(t, "api-key-material", pt)
+	assert.True(t, after.NeedsRotation(ct))
+
+	rotated, err := after.Rotate(ct, []byte("api_keys:1"))
+	require.NoError(t, err)
+	assert.False(t, after.NeedsRotation(rotated))
+
+	unchanged, err := after.Rotate(rotated, []byte("api_keys:1"))
+	require.NoError(t, err)
+	assert.Equal(t, rotated, unchanged)
+
+	retired, err := crypto.NewEnvelope(map[string][]byte{"k2": newKey}, "k2")
+	require.NoError(t, err)
+	_, err = retired.DecryptString(ct, "api_keys:1")
+	assert.ErrorIs(t, err, crypto.ErrUnknownKey)
+
+	pt, err = retired.DecryptString(rotated, "api_keys:1")
+	require.NoError(t, err)
+	assert.Equal(t, "api-key-material", pt)
+}
+
+func TestParseKeys(t *testing.T) {
+	encoded := base64.StdEncoding.EncodeToString(oldKey)
+
+	keys, err := crypto.ParseKeys("k1:" + encoded + ", k2:" + encoded)
+	require.NoError(t, err)
+	assert.Len(t, keys, 2)
+
+	keys, err = crypto.ParseKeys("")
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	_, err = crypto.ParseKeys("k1")
+	assert.ErrorIs(t, err, crypto.ErrInvalidKey)
+
+	_, err = crypto.NewEnvelope(map[string][]byte{"k1": []byte("short")}, "k1")
+	assert.ErrorIs(t, err, crypto.ErrInvalidKey)
+
+	_, err = crypto.NewEnvelope(map[string][]byte{"k1": oldKey}, "missing")
+	assert.ErrorIs(t, err, crypto.ErrUnknownKey)
+}
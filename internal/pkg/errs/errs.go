@@ -1,6 +1,7 @@
 package errs
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -18,11 +19,82 @@ func New(msg string) error {
 	return cr.New(msg)
 }
 
+// Mark attaches marker so that both errors.Is (stdlib) and cockroach's Is match it.
+// cockroach marks alone are invisible to the stdlib errors.Is used by handlers.
 func Mark(err, marker error) error {
 	if err == nil {
 		return nil
 	}
-	return cr.Mark(err, marker)
+	return &markedError{cause: cr.Mark(err, marker), marker: marker}
+}
+
+type markedError struct {
+	cause  error
+	marker error
+}
+
+func (e *markedError) Error() string { return e.cause.Error() }
+
+func (e *markedError) Unwrap() error { return e.cause }
+
+func (e *markedError) Is(target error) bool { return target == e.marker }
+
+func (e *markedError) ErrorCode() Code { return CodeOf(e.marker) }
+
+// Keeps %+v stack traces from the cockroach chain.
+func (e *markedError) Format(s fmt.State, verb rune) {
+	if f, ok := e.cause.(fmt.Formatter); ok {
+		f.Format(s, verb)
+		return
+	}
+	fmt.Fprint(s, e.cause.Error())
+}
+
+// Code is a stable, machine-readable error identifier exposed to API clients.
+// Every code is listed in registry_gen.go (`go generate ./internal/pkg/errs`).
+type Code string
+
+// NewCoded declares a sentinel carrying code. Wrapping or marking with it keeps the code
+// reachable through CodeOf.
+func NewCoded(code Code, msg string) error {
+	return &codedError{cause: cr.New(msg), code: code}
+}
+
+type codedError struct {
+	cause error
+	code  Code
+}
+
+func (e *codedError) Error() string { return e.cause.Error() }
+
+func (e *codedError) Unwrap() error { return e.cause }
+
+func (e *codedError) ErrorCode() Code { return e.code }
+
+func (e *codedError) Format(s fmt.State, verb rune) {
+	if f, ok := e.cause.(fmt.Formatter); ok {
+		f.Format(s, verb)
+		return
+	}
+	fmt.Fprint(s, e.cause.Error())
+}
+
+type coder interface {
+	ErrorCode() Code
+}
+
+// CodeOf returns the outermost code in err's chain, including codes of Mark markers,
+// or "" when none is attached.
+func CodeOf(err error) Code {
+	for err != nil {
+		if c, ok := err.(coder); ok {
+			if code := c.ErrorCode(); code != "" {
+				return code
+			}
+		}
+		err = errors.Unwrap(err)
+	}
+	return ""
 }
 
 func ExtractStackLines(err error, maxLines int) []string {
@@ -0,0 +1,137 @@
+//go:build unit
+
+package errs_test
+
+import (
+	"errors"
+	"testing"
+
+	"gin-clean-starter/internal/pkg/errs"
+
+	cr "github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errMarker = errs.New("marker")
+
+func TestNilPassthrough(t *testing.T) {
+	assert.NoError(t, errs.Wrap(nil, "ctx"))
+	assert.NoError(t, errs.Mark(nil, errMarker))
+	assert.Nil(t, errs.ExtractStackLines(nil, 5))
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = errs.Wrap(nil, "ctx")
+		_ = errs.Mark(nil, errMarker)
+	})
+	assert.Zero(t, allocs, "nil errors must not allocate")
+}
+
+func TestMarkAndWrapPreserveIdentity(t *testing.T) {
+	base := errors.New("boom")
+
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{"mark", errs.Mark(base, errMarker)},
+		{"wrap of mark", errs.Wrap(errs.Mark(base, errMarker), "ctx")},
+		{"mark of wrap", errs.Mark(errs.Wrap(base, "ctx"), errMarker)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.err, errMarker)
+			assert.ErrorIs(t, tt.err, base)
+			assert.True(t, cr.Is(tt.err, errMarker))
+			assert.NotErrorIs(t, tt.err, errs.New("marker"), "markers match by identity only")
+		})
+	}
+
+	err := errs.Wrap(errs.Mark(errs.New("boom"), errMarker), "ctx")
+	assert.Equal(t, "ctx: boom", err.Error())
+	assert.Greater(t, len(errs.ExtractStackLines(err, 0)), 1, "stack trace survives marking")
+
+	// Handlers walk error rule tables with errors.Is on every failed request
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = errors.Is(err, errMarker)
+	})
+	assert.Zero(t, allocs, "errors.Is on a marked error must not allocate")
+}
+
+func TestCodeOf(t *testing.T) {
+	coded := errs.NewCoded("THING_MISSING", "thing missing")
+	other := errs.NewCoded("OTHER", "other")
+
+	tests := []struct {
+		name string
+		err  error
+		want errs.Code
+	}{
+		{"nil", nil, ""},
+		{"uncoded", errs.New("boom"), ""},
+		{"sentinel", coded, "THING_MISSING"},
+		{"wrapped sentinel", errs.Wrap(coded, "ctx"), "THING_MISSING"},
+		{"marked with coded sentinel", errs.Mark(errors.New("boom"), coded), "THING_MISSING"},
+		{"uncoded marker keeps inner code", errs.Mark(coded, errMarker), "THING_MISSING"},
+		{"outermost code wins", errs.Mark(errs.Wrap(coded, "ctx"), other), "OTHER"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, errs.CodeOf(tt.err))
+		})
+	}
+
+	assert.ErrorIs(t, errs.Wrap(coded, "ctx"), coded)
+	assert.Equal(t, "thing missing", coded.Error())
+}
+
+func TestRegistryIsSortedAndUnique(t *testing.T) {
+	require.NotEmpty(t, errs.Registry)
+	for i, info := range errs.Registry {
+		assert.Regexp(t, `^[A-Z][A-Z0-9_]*$`, string(info.Code))
+		assert.NotEmpty(t, info.Sources, info.Code)
+		if i > 0 {
+			assert.Less(t, string(errs.Registry[i-1].Code), string(info.Code), "registry must be sorted and unique")
+		}
+	}
+
+	info, ok := errs.Lookup("RESERVATION_CONFLICT")
+	assert.True(t, ok)
+	assert.Contains(t, info.Sources, "commands.ErrReservationConflict")
+}
+
+func BenchmarkNew(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = errs.New("boom")
+	}
+}
+
+func BenchmarkWrap(b *testing.B) {
+	base := errors.New("boom")
+
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = errs.Wrap(base, "ctx")
+	}
+}
+
+func BenchmarkMark(b *testing.B) {
+	base := errors.New("boom")
+
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = errs.Mark(base, errMarker)
+	}
+}
+
+func BenchmarkIs(b *testing.B) {
+	err := errs.Wrap(errs.Mark(errors.New("boom"), errMarker), "ctx")
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if !errors.Is(err, errMarker) {
+			b.Fatal("marker lost")
+		}
+	}
+}
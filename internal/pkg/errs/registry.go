@@ -0,0 +1,24 @@
+package errs
+
+//go:generate go run ../../../cmd/errcodes
+
+// CodeInfo describes one entry of Registry.
+type CodeInfo struct {
+	Code        Code
+	Description string
+	// Statuses are the HTTP statuses the code is reported with, as read off the handlers'
+	// error mappings; empty when none names the code directly.
+	Statuses []int
+	// Sources are the declarations carrying the code, as package.Name.
+	Sources []string
+}
+
+// Lookup returns the registry entry for code.
+func Lookup(code Code) (CodeInfo, bool) {
+	for _, info := range Registry {
+		if info.Code == code {
+			return info, true
+		}
+	}
+	return CodeInfo{}, false
+}
@@ -0,0 +1,126 @@
+// Package feed encodes Atom 1.0 (RFC 4287) documents. It covers the subset the API
+// publishes: a feed with a self link, a feed-level author and plain-text entries.
+package feed
+
+import (
+	"encoding/xml"
+	"io"
+	"time"
+)
+
+// ContentType is the media type Atom documents are served with.
+const ContentType = "application/atom+xml; charset=utf-8"
+
+const atomNS = "http://www.w3.org/2005/Atom"
+
+// Feed is an Atom feed. IDs must be permanent IRIs (e.g. urn:uuid:...); Updated should be
+// the latest Updated of its entries, or the time of the request for an empty feed.
+type Feed struct {
+	ID       string
+	Title    string
+	Subtitle string
+	Author   string
+	SelfLink string
+	Updated  time.Time
+	Entries  []Entry
+}
+
+// Entry is one item of a feed. Entries without an Author inherit the feed's. Language is
+// an optional ISO 639-1 code of the content, emitted as xml:lang.
+type Entry struct {
+	ID        string
+	Title     string
+	Content   string
+	Author    string
+	Language  string
+	Published time.Time
+	Updated   time.Time
+}
+
+type xmlFeed struct {
+	XMLName  xml.Name   `xml:"feed"`
+	NS       string     `xml:"xmlns,attr"`
+	ID       string     `xml:"id"`
+	Title    string     `xml:"title"`
+	Subtitle string     `xml:"subtitle,omitempty"`
+	Updated  string     `xml:"updated"`
+	Link     *xmlLink   `xml:"link,omitempty"`
+	Author   *xmlPerson `xml:"author,omitempty"`
+	Entries  []xmlEntry `xml:"entry"`
+}
+
+type xmlLink struct {
+	Rel  string `xml:"rel,attr"`
+	Href string `xml:"href,attr"`
+}
+
+type xmlPerson struct {
+	Name string `xml:"name"`
+}
+
+type xmlEntry struct {
+	Lang      string     `xml:"xml:lang,attr,omitempty"`
+	ID        string     `xml:"id"`
+	Title     string     `xml:"title"`
+	Published string     `xml:"published,omitempty"`
+	Updated   string     `xml:"updated"`
+	Author    *xmlPerson `xml:"author,omitempty"`
+	Content   xmlContent `xml:"content"`
+}
+
+type xmlContent struct {
+	Type string `xml:"type,attr"`
+	Body string `xml:",chardata"`
+}
+
+// Write encodes f as an indented Atom document, XML declaration included.
+func Write(w io.Writer, f Feed) error {
+	doc := xmlFeed{
+		NS:       atomNS,
+		ID:       f.ID,
+		Title:    f.Title,
+		Subtitle: f.Subtitle,
+		Updated:  formatTime(f.Updated),
+		Author:   person(f.Author),
+		Entries:  make([]xmlEntry, 0, len(f.Entries)),
+	}
+	if f.SelfLink != "" {
+		doc.Link = &xmlLink{Rel: "self", Href: f.SelfLink}
+	}
+	for _, e := range f.Entries {
+		entry := xmlEntry{
+			Lang:    e.Language,
+			ID:      e.ID,
+			Title:   e.Title,
+			Updated: formatTime(e.Updated),
+			Author:  person(e.Author),
+			Content: xmlContent{Type: "text", Body: e.Content},
+		}
+		if !e.Published.IsZero() {
+			entry.Published = formatTime(e.Published)
+		}
+		doc.Entries = append(doc.Entries, entry)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+func person(name string) *xmlPerson {
+	if name == "" {
+		return nil
+	}
+	return &xmlPerson{Name: name}
+}
+
+// formatTime renders RFC 3339 in UTC at second precision, as Atom date constructs expect.
+func formatTime(t time.Time) string {
+	return t.UTC().Truncate(time.Second).Format(time.RFC3339)
+}
@@ -0,0 +1,93 @@
+//go:build unit
+
+package feed_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/pkg/feed"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type parsedFeed struct {
+	XMLName xml.Name `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string   `xml:"id"`
+	Title   string   `xml:"title"`
+	Updated string   `xml:"updated"`
+	Link    struct {
+		Rel  string `xml:"rel,attr"`
+		Href string `xml:"href,attr"`
+	} `xml:"link"`
+	Author  string `xml:"author>name"`
+	Entries []struct {
+		Lang      string `xml:"http://www.w3.org/XML/1998/namespace lang,attr"`
+		ID        string `xml:"id"`
+		Published string `xml:"published"`
+		Updated   string `xml:"updated"`
+		Author    string `xml:"author>name"`
+		Content   struct {
+			Type string `xml:"type,attr"`
+			Body string `xml:",chardata"`
+		} `xml:"content"`
+	} `xml:"entry"`
+}
+
+func TestWrite(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	created := time.Date(2025, 3, 4, 14, 0, 0, 500, jst)
+
+	var buf bytes.Buffer
+	err := feed.Write(&buf, feed.Feed{
+		ID:       "urn:uuid:feed",
+		Title:    "Room A reviews",
+		Author:   "Room A",
+		SelfLink: "/api/resources/1/reviews.atom",
+		Updated:  created.Add(time.Hour),
+		Entries: []feed.Entry{
+			{ID: "urn:uuid:1", Title: "5/5", Content: "Great <room> & view", Language: "en", Published: created, Updated: created.Add(time.Hour)},
+			{ID: "urn:uuid:2", Title: "3/5", Content: "ok", Author: "Guest", Updated: created},
+		},
+	})
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(buf.Bytes(), []byte(xml.Header)))
+
+	var got parsedFeed
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &got))
+	assert.Equal(t, "urn:uuid:feed", got.ID)
+	assert.Equal(t, "Room A reviews", got.Title)
+	assert.Equal(t, "2025-03-04T06:00:00Z", got.Updated)
+	assert.Equal(t, "self", got.Link.Rel)
+	assert.Equal(t, "/api/resources/1/reviews.atom", got.Link.Href)
+	assert.Equal(t, "Room A", got.Author)
+
+	require.Len(t, got.Entries, 2)
+	first := got.Entries[0]
+	assert.Equal(t, "en", first.Lang)
+	assert.Equal(t, "2025-03-04T05:00:00Z", first.Published)
+	assert.Equal(t, "2025-03-04T06:00:00Z", first.Updated)
+	assert.Equal(t, "text", first.Content.Type)
+	assert.Equal(t, "Great <room> & view", first.Content.Body)
+	assert.Empty(t, first.Author)
+
+	second := got.Entries[1]
+	assert.Empty(t, second.Lang)
+	assert.Empty(t, second.Published)
+	assert.Equal(t, "Guest", second.Author)
+}
+
+func TestWriteEmptyFeed(t *testing.T) {
+	var buf bytes.Buffer
+	err := feed.Write(&buf, feed.Feed{ID: "urn:uuid:feed", Title: "empty", Updated: time.Unix(0, 0)})
+	require.NoError(t, err)
+
+	var got parsedFeed
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &got))
+	assert.Empty(t, got.Entries)
+	assert.NotContains(t, buf.String(), "<link")
+	assert.NotContains(t, buf.String(), "<author")
+}
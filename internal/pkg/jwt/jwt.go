@@ -1,7 +1,11 @@
 package jwt
 
 import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
 	"errors"
+	"strings"
 	"time"
 
 	"gin-clean-starter/internal/domain/user"
@@ -20,41 +24,138 @@ type TokenType string
 const (
 	TokenTypeAccess  TokenType = "access"
 	TokenTypeRefresh TokenType = "refresh"
+	// TokenTypeSupport is a read-only access token scoped to CompanyID, issued to staff for troubleshooting.
+	TokenTypeSupport TokenType = "support"
 )
 
 type Claims struct {
 	UserID    uuid.UUID `json:"user_id"`
 	Role      string    `json:"role"`
 	TokenType TokenType `json:"token_type"`
+	// DeviceBinding is the thumbprint of the client-held device key (refresh tokens only).
+	// Empty for unbound tokens, including those issued before binding existed.
+	DeviceBinding string `json:"cnf,omitempty"`
+	// CompanyID is the company a support token is scoped to (support tokens only).
+	CompanyID *uuid.UUID `json:"company_id,omitempty"`
+	// SessionID is the refresh token chain an access or refresh token was issued in, so
+	// revoking the session also rejects its access tokens. Nil for tokens issued before
+	// rotation was tracked.
+	SessionID *uuid.UUID `json:"sid,omitempty"`
 	jwt.RegisteredClaims
 }
 
+func (c *Claims) IsDeviceBound() bool {
+	return c.DeviceBinding != ""
+}
+
+// MatchesDevice reports whether deviceKey is the key this token was bound to.
+func (c *Claims) MatchesDevice(deviceKey string) bool {
+	if !c.IsDeviceBound() || deviceKey == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(c.DeviceBinding), []byte(DeviceThumbprint(deviceKey))) == 1
+}
+
+// DeviceThumbprint hashes the device key so the token never carries the key itself.
+func DeviceThumbprint(deviceKey string) string {
+	sum := sha256.Sum256([]byte(deviceKey))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// DefaultKeyID is the kid of a service built with NewService.
+const DefaultKeyID = "default"
+
+// Key is an HMAC secret named by the kid header of the tokens it signs. Until is set on
+// retired keys: they verify the tokens they signed until then, so rotating the secret
+// does not sign everyone out.
+type Key struct {
+	ID     string
+	Secret []byte
+	Until  time.Time
+}
+
+// ParseKeys reads retired keys given as kid:secret,kid2:... and retires them at until.
+func ParseKeys(spec string, until time.Time) ([]Key, error) {
+	var keys []Key
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		id, secret, ok := strings.Cut(part, ":")
+		if !ok || id == "" || secret == "" {
+			return nil, errors.New("expected kid:secret")
+		}
+		keys = append(keys, Key{ID: id, Secret: []byte(secret), Until: until})
+	}
+	return keys, nil
+}
+
 type Service struct {
-	secretKey            []byte
+	signingKey           Key
+	keys                 map[string]Key
 	accessTokenDuration  time.Duration
 	refreshTokenDuration time.Duration
 	issuer               string
 	audience             string
-	keyID                string
 }
 
 func NewService(secretKey string, accessTokenDuration, refreshTokenDuration time.Duration) *Service {
+	return NewRotatingService(Key{ID: DefaultKeyID, Secret: []byte(secretKey)}, nil, accessTokenDuration, refreshTokenDuration)
+}
+
+// NewRotatingService signs with signingKey and also verifies tokens signed with the
+// previous keys until each one's Until.
+func NewRotatingService(signingKey Key, previous []Key, accessTokenDuration, refreshTokenDuration time.Duration) *Service {
+	keys := make(map[string]Key, len(previous)+1)
+	for _, key := range previous {
+		keys[key.ID] = key
+	}
+	signingKey.Until = time.Time{}
+	keys[signingKey.ID] = signingKey
 	return &Service{
-		secretKey:            []byte(secretKey),
+		signingKey:           signingKey,
+		keys:                 keys,
 		accessTokenDuration:  accessTokenDuration,
 		refreshTokenDuration: refreshTokenDuration,
 		issuer:               "gin-clean-starter",
 		audience:             "gin-clean-starter-api",
-		keyID:                "default",
 	}
 }
 
-func (s *Service) GenerateAccessToken(userID uuid.UUID, role user.Role) (string, error) {
-	return s.generateToken(userID, role, TokenTypeAccess, s.accessTokenDuration)
+// GenerateAccessToken ties the token to sessionID unless it is uuid.Nil.
+func (s *Service) GenerateAccessToken(userID uuid.UUID, role user.Role, sessionID uuid.UUID) (string, error) {
+	return s.generateToken(userID, role, TokenTypeAccess, s.accessTokenDuration, "", uuid.New(), sessionID)
 }
 
-func (s *Service) GenerateRefreshToken(userID uuid.UUID, role user.Role) (string, error) {
-	return s.generateToken(userID, role, TokenTypeRefresh, s.refreshTokenDuration)
+// GenerateRefreshToken binds the token to deviceKey when it is non-empty. tokenID becomes
+// the jti, which is how the server-side record of the token is found on rotation.
+func (s *Service) GenerateRefreshToken(userID uuid.UUID, role user.Role, deviceKey string, tokenID, sessionID uuid.UUID) (string, error) {
+	var binding string
+	if deviceKey != "" {
+		binding = DeviceThumbprint(deviceKey)
+	}
+	return s.generateToken(userID, role, TokenTypeRefresh, s.refreshTokenDuration, binding, tokenID, sessionID)
+}
+
+// GenerateSupportToken issues a non-refreshable support token; sessionID becomes the jti
+// so audit entries can be correlated with the token.
+func (s *Service) GenerateSupportToken(userID uuid.UUID, role user.Role, companyID, sessionID uuid.UUID, ttl time.Duration) (string, error) {
+	now := time.Now()
+	claims := Claims{
+		UserID:    userID,
+		Role:      role.String(),
+		TokenType: TokenTypeSupport,
+		CompanyID: &companyID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    s.issuer,
+			Audience:  []string{s.audience},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+			ID:        sessionID.String(),
+		},
+	}
+	return s.sign(claims)
 }
 
 func (s *Service) GetAccessTokenDuration() time.Duration {
@@ -65,24 +166,49 @@ func (s *Service) GetRefreshTokenDuration() time.Duration {
 	return s.refreshTokenDuration
 }
 
-func (s *Service) generateToken(userID uuid.UUID, role user.Role, tokenType TokenType, duration time.Duration) (string, error) {
+func (s *Service) generateToken(userID uuid.UUID, role user.Role, tokenType TokenType, duration time.Duration, deviceBinding string, tokenID, sessionID uuid.UUID) (string, error) {
 	now := time.Now()
 	claims := Claims{
-		UserID:    userID,
-		Role:      role.String(),
-		TokenType: tokenType,
+		UserID:        userID,
+		Role:          role.String(),
+		TokenType:     tokenType,
+		DeviceBinding: deviceBinding,
 		RegisteredClaims: jwt.RegisteredClaims{
 			Issuer:    s.issuer,
 			Audience:  []string{s.audience},
 			IssuedAt:  jwt.NewNumericDate(now),
 			ExpiresAt: jwt.NewNumericDate(now.Add(duration)),
-			ID:        uuid.NewString(),
+			ID:        tokenID.String(),
 		},
 	}
+	if sessionID != uuid.Nil {
+		claims.SessionID = &sessionID
+	}
+
+	return s.sign(claims)
+}
 
+func (s *Service) sign(claims Claims) (string, error) {
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	token.Header["kid"] = s.keyID
-	return token.SignedString(s.secretKey)
+	token.Header["kid"] = s.signingKey.ID
+	return token.SignedString(s.signingKey.Secret)
+}
+
+// verificationKey picks the secret a token names in its kid header. Tokens without one
+// predate kid and were signed with the current key.
+func (s *Service) verificationKey(token *jwt.Token) ([]byte, error) {
+	kid, ok := token.Header["kid"].(string)
+	if !ok {
+		if _, present := token.Header["kid"]; present {
+			return nil, ErrInvalidToken
+		}
+		return s.signingKey.Secret, nil
+	}
+	key, ok := s.keys[kid]
+	if !ok || (!key.Until.IsZero() && !time.Now().Before(key.Until)) {
+		return nil, ErrInvalidToken
+	}
+	return key.Secret, nil
 }
 
 func (s *Service) ValidateToken(tokenString string) (*Claims, error) {
@@ -90,7 +216,7 @@ func (s *Service) ValidateToken(tokenString string) (*Claims, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, ErrInvalidToken
 		}
-		return s.secretKey, nil
+		return s.verificationKey(token)
 	})
 
 	if err != nil {
@@ -0,0 +1,155 @@
+//go:build unit
+
+package jwt_test
+
+import (
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/pkg/jwt"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRefreshTokenDeviceBinding(t *testing.T) {
+	service := jwt.NewService("test-secret", 15*time.Minute, time.Hour)
+	userID := uuid.New()
+
+	t.Run("bound token matches only its device key", func(t *testing.T) {
+		token, err := service.GenerateRefreshToken(userID, user.RoleViewer, "device-a", uuid.New(), uuid.New())
+		require.NoError(t, err)
+
+		claims, err := service.ValidateToken(token)
+		require.NoError(t, err)
+
+		assert.True(t, claims.IsDeviceBound())
+		assert.NotContains(t, claims.DeviceBinding, "device-a")
+		assert.True(t, claims.MatchesDevice("device-a"))
+		assert.False(t, claims.MatchesDevice("device-b"))
+		assert.False(t, claims.MatchesDevice(""))
+	})
+
+	t.Run("token without device key is unbound", func(t *testing.T) {
+		token, err := service.GenerateRefreshToken(userID, user.RoleViewer, "", uuid.New(), uuid.Nil)
+		require.NoError(t, err)
+
+		claims, err := service.ValidateToken(token)
+		require.NoError(t, err)
+
+		assert.False(t, claims.IsDeviceBound())
+		assert.False(t, claims.MatchesDevice("device-a"))
+	})
+}
+
+func TestSupportToken(t *testing.T) {
+	service := jwt.NewService("test-secret", 15*time.Minute, time.Hour)
+	userID := uuid.New()
+	companyID := uuid.New()
+	sessionID := uuid.New()
+
+	token, err := service.GenerateSupportToken(userID, user.RoleAdmin, companyID, sessionID, 10*time.Minute)
+	require.NoError(t, err)
+
+	claims, err := service.ValidateToken(token)
+	require.NoError(t, err)
+
+	assert.Equal(t, jwt.TokenTypeSupport, claims.TokenType)
+	assert.Equal(t, userID, claims.UserID)
+	require.NotNil(t, claims.CompanyID)
+	assert.Equal(t, companyID, *claims.CompanyID)
+	assert.Equal(t, sessionID.String(), claims.ID)
+	assert.False(t, claims.IsDeviceBound())
+	assert.WithinDuration(t, time.Now().Add(10*time.Minute), claims.ExpiresAt.Time, 5*time.Second)
+}
+
+func TestRefreshTokenSession(t *testing.T) {
+	service := jwt.NewService("test-secret", 15*time.Minute, time.Hour)
+	userID := uuid.New()
+	tokenID := uuid.New()
+	sessionID := uuid.New()
+
+	t.Run("refresh token carries its id and session", func(t *testing.T) {
+		token, err := service.GenerateRefreshToken(userID, user.RoleViewer, "", tokenID, sessionID)
+		require.NoError(t, err)
+
+		claims, err := service.ValidateToken(token)
+		require.NoError(t, err)
+
+		assert.Equal(t, tokenID.String(), claims.ID)
+		require.NotNil(t, claims.SessionID)
+		assert.Equal(t, sessionID, *claims.SessionID)
+	})
+
+	t.Run("access token without a session has no sid", func(t *testing.T) {
+		token, err := service.GenerateAccessToken(userID, user.RoleViewer, uuid.Nil)
+		require.NoError(t, err)
+
+		claims, err := service.ValidateToken(token)
+		require.NoError(t, err)
+
+		assert.Nil(t, claims.SessionID)
+	})
+}
+
+func TestKeyRotation(t *testing.T) {
+	userID := uuid.New()
+	old := jwt.NewRotatingService(jwt.Key{ID: "2025", Secret: []byte("old-secret")}, nil, 15*time.Minute, time.Hour)
+	oldToken, err := old.GenerateAccessToken(userID, user.RoleViewer, uuid.Nil)
+	require.NoError(t, err)
+	legacyToken, err := jwt.NewService("old-secret", 15*time.Minute, time.Hour).GenerateAccessToken(userID, user.RoleViewer, uuid.Nil)
+	require.NoError(t, err)
+
+	t.Run("new key signs, retired key still verifies inside the window", func(t *testing.T) {
+		previous := []jwt.Key{{ID: "2025", Secret: []byte("old-secret"), Until: time.Now().Add(time.Hour)}}
+		service := jwt.NewRotatingService(jwt.Key{ID: "2026", Secret: []byte("new-secret")}, previous, 15*time.Minute, time.Hour)
+
+		claims, err := service.ValidateToken(oldToken)
+		require.NoError(t, err)
+		assert.Equal(t, userID, claims.UserID)
+
+		token, err := service.GenerateAccessToken(userID, user.RoleViewer, uuid.Nil)
+		require.NoError(t, err)
+		_, err = old.ValidateToken(token)
+		require.ErrorIs(t, err, jwt.ErrInvalidToken)
+		_, err = service.ValidateToken(token)
+		require.NoError(t, err)
+	})
+
+	t.Run("retired key stops verifying after the window", func(t *testing.T) {
+		previous := []jwt.Key{{ID: "2025", Secret: []byte("old-secret"), Until: time.Now().Add(-time.Second)}}
+		service := jwt.NewRotatingService(jwt.Key{ID: "2026", Secret: []byte("new-secret")}, previous, 15*time.Minute, time.Hour)
+
+		_, err := service.ValidateToken(oldToken)
+		require.ErrorIs(t, err, jwt.ErrInvalidToken)
+	})
+
+	t.Run("unknown kid is rejected, even with a matching secret", func(t *testing.T) {
+		service := jwt.NewRotatingService(jwt.Key{ID: "2026", Secret: []byte("old-secret")}, nil, 15*time.Minute, time.Hour)
+
+		_, err := service.ValidateToken(legacyToken)
+		require.ErrorIs(t, err, jwt.ErrInvalidToken)
+	})
+}
+
+func TestParseKeys(t *testing.T) {
+	until := time.Date(2026, 10, 23, 0, 0, 0, 0, time.UTC)
+
+	keys, err := jwt.ParseKeys(" 2024:first , 2025:sec:ond,", until)
+	require.NoError(t, err)
+	assert.Equal(t, []jwt.Key{
+		{ID: "2024", Secret: []byte("first"), Until: until},
+		{ID: "2025", Secret: []byte("sec:ond"), Until: until},
+	}, keys)
+
+	keys, err = jwt.ParseKeys("", until)
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	_, err = jwt.ParseKeys("2024", until)
+	require.Error(t, err)
+	_, err = jwt.ParseKeys(":secret", until)
+	require.Error(t, err)
+}
@@ -0,0 +1,141 @@
+// Package keyset pages lists by (created_at, id) rather than OFFSET: with a composite index
+// on (filter column, created_at DESC, id DESC) every page is a bounded index range scan, and
+// rows inserted between requests neither shift nor duplicate items across pages. New list
+// queries should follow the FirstPage/Keyset query pair pattern with a matching index and
+// read their pages through Page.
+package keyset
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/pkg/pgconv"
+
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultLimit = 20
+	MaxLimit     = 200
+	VersionV1    = "v1"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Position is the (created_at, id) of the last row of a page; the next page starts after it.
+type Position struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+// Encode uses microsecond precision to align with PostgreSQL timestamp precision.
+func Encode(p Position) string {
+	cursorData := fmt.Sprintf("%s:%d-%s", VersionV1, p.CreatedAt.UnixMicro(), p.ID.String())
+	return base64.URLEncoding.EncodeToString([]byte(cursorData))
+}
+
+// Decode supports the legacy format for backward compatibility.
+func Decode(cursor string) (Position, error) {
+	if cursor == "" {
+		return Position{}, fmt.Errorf("cursor cannot be empty")
+	}
+
+	// Try to decode as base64url first (v1 format)
+	if decoded, err := base64.URLEncoding.DecodeString(cursor); err == nil {
+		decodedStr := string(decoded)
+		if strings.HasPrefix(decodedStr, VersionV1+":") {
+			return parseVersioned(decodedStr)
+		}
+	}
+
+	// Fall back to legacy format for backward compatibility
+	return parseLegacy(cursor)
+}
+
+func parseVersioned(cursorData string) (Position, error) {
+	payload := strings.TrimPrefix(cursorData, VersionV1+":")
+
+	parts := strings.SplitN(payload, "-", 2)
+	if len(parts) != 2 {
+		return Position{}, fmt.Errorf("invalid cursor format: expected '<micros>-<uuid>'")
+	}
+
+	timestamp, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return Position{}, fmt.Errorf("invalid timestamp: %w", err)
+	}
+
+	id, err := uuid.Parse(parts[1])
+	if err != nil {
+		return Position{}, fmt.Errorf("invalid UUID: %w", err)
+	}
+
+	return Position{CreatedAt: time.UnixMicro(timestamp), ID: id}, nil
+}
+
+func parseLegacy(cursor string) (Position, error) {
+	parts := strings.SplitN(cursor, "-", 2)
+	if len(parts) != 2 {
+		return Position{}, fmt.Errorf("invalid cursor format")
+	}
+
+	timestamp, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return Position{}, fmt.Errorf("invalid timestamp: %w", err)
+	}
+
+	id, err := uuid.Parse(parts[1])
+	if err != nil {
+		return Position{}, fmt.Errorf("invalid UUID: %w", err)
+	}
+
+	return Position{CreatedAt: time.Unix(0, timestamp), ID: id}, nil
+}
+
+// Limit normalizes a page size (default/max) for consistent reads.
+func Limit(limit int) int {
+	if limit <= 0 {
+		return DefaultLimit
+	}
+	if limit > MaxLimit {
+		return MaxLimit
+	}
+	return limit
+}
+
+// FetchLimit is one more than the page size, so a full fetch shows a next page exists.
+func FetchLimit(limit int) int32 {
+	return pgconv.IntToInt32(Limit(limit) + 1)
+}
+
+// Page reads one page of at most limit rows after the cursor after ("" for the first page)
+// and returns the cursor of the page that follows ("" at the end). fetch loads up to n rows
+// after a position, or from the start when it is nil; position gives a row's key. A cursor
+// that does not decode fails with ErrInvalidCursor; fetch errors are returned as they are.
+func Page[T any](after string, limit int, position func(T) Position, fetch func(after *Position, n int32) ([]T, error)) ([]T, string, error) {
+	limit = Limit(limit)
+
+	var start *Position
+	if after != "" {
+		p, err := Decode(after)
+		if err != nil {
+			return nil, "", errs.Mark(err, ErrInvalidCursor)
+		}
+		start = &p
+	}
+
+	rows, err := fetch(start, FetchLimit(limit))
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(rows) <= limit {
+		return rows, "", nil
+	}
+	return rows[:limit], Encode(position(rows[limit-1])), nil
+}
@@ -0,0 +1,147 @@
+//go:build unit
+
+package keyset_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/pkg/keyset"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	cursorTime = time.Date(2025, 1, 2, 3, 4, 5, 123456000, time.UTC)
+	cursorID   = uuid.MustParse("0b9f3c2e-6a4d-4c1e-9d7a-2f5b8e1c3a40")
+	cursorPos  = keyset.Position{CreatedAt: cursorTime, ID: cursorID}
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	cursor := keyset.Encode(cursorPos)
+
+	got, err := keyset.Decode(cursor)
+	require.NoError(t, err)
+	assert.True(t, cursorTime.Equal(got.CreatedAt))
+	assert.Equal(t, cursorID, got.ID)
+}
+
+// Cursors are encoded and decoded on every list request; upper bounds are the
+// current counts, so a raise here should be deliberate.
+func TestCursorAllocs(t *testing.T) {
+	cursor := keyset.Encode(cursorPos)
+
+	encode := testing.AllocsPerRun(100, func() {
+		_ = keyset.Encode(cursorPos)
+	})
+	decode := testing.AllocsPerRun(100, func() {
+		_, _ = keyset.Decode(cursor)
+	})
+
+	assert.LessOrEqual(t, encode, 6.0, "Encode allocs")
+	assert.LessOrEqual(t, decode, 3.0, "Decode allocs")
+}
+
+func TestLimit(t *testing.T) {
+	assert.Equal(t, keyset.DefaultLimit, keyset.Limit(0))
+	assert.Equal(t, keyset.DefaultLimit, keyset.Limit(-5))
+	assert.Equal(t, 7, keyset.Limit(7))
+	assert.Equal(t, keyset.MaxLimit, keyset.Limit(keyset.MaxLimit+1))
+	assert.Equal(t, int32(8), keyset.FetchLimit(7))
+}
+
+type row struct {
+	n  int
+	at time.Time
+	id uuid.UUID
+}
+
+func rowPosition(r row) keyset.Position { return keyset.Position{CreatedAt: r.at, ID: r.id} }
+
+// table is a newest-first list served the way a FirstPage/Keyset query pair serves it.
+func table(n int) func(after *keyset.Position, limit int32) ([]row, error) {
+	rows := make([]row, n)
+	for i := range rows {
+		rows[i] = row{n: i, at: cursorTime.Add(-time.Duration(i) * time.Minute), id: uuid.New()}
+	}
+	return func(after *keyset.Position, limit int32) ([]row, error) {
+		start := 0
+		if after != nil {
+			for start < len(rows) && !rows[start].at.Before(after.CreatedAt) {
+				start++
+			}
+		}
+		end := min(start+int(limit), len(rows))
+		return rows[start:end], nil
+	}
+}
+
+func TestPage(t *testing.T) {
+	t.Run("walks every row once and ends without a cursor", func(t *testing.T) {
+		fetch := table(5)
+
+		var seen []int
+		after := ""
+		for pages := 0; pages < 10; pages++ {
+			rows, next, err := keyset.Page(after, 2, rowPosition, fetch)
+			require.NoError(t, err)
+			assert.LessOrEqual(t, len(rows), 2)
+			for _, r := range rows {
+				seen = append(seen, r.n)
+			}
+			if next == "" {
+				break
+			}
+			after = next
+		}
+		assert.Equal(t, []int{0, 1, 2, 3, 4}, seen)
+	})
+
+	t.Run("a full last page has no next cursor", func(t *testing.T) {
+		rows, next, err := keyset.Page("", 4, rowPosition, table(4))
+
+		require.NoError(t, err)
+		assert.Len(t, rows, 4)
+		assert.Empty(t, next)
+	})
+
+	t.Run("a malformed cursor fails before fetching", func(t *testing.T) {
+		_, _, err := keyset.Page("not-a-cursor", 2, rowPosition, func(*keyset.Position, int32) ([]row, error) {
+			t.Fatal("fetch must not run")
+			return nil, nil
+		})
+
+		assert.ErrorIs(t, err, keyset.ErrInvalidCursor)
+	})
+
+	t.Run("fetch errors are returned unmarked", func(t *testing.T) {
+		failure := errors.New("db down")
+		_, _, err := keyset.Page("", 2, rowPosition, func(*keyset.Position, int32) ([]row, error) {
+			return nil, failure
+		})
+
+		assert.ErrorIs(t, err, failure)
+		assert.NotErrorIs(t, err, keyset.ErrInvalidCursor)
+	})
+}
+
+func BenchmarkEncode(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = keyset.Encode(cursorPos)
+	}
+}
+
+func BenchmarkDecode(b *testing.B) {
+	cursor := keyset.Encode(cursorPos)
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := keyset.Decode(cursor); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,281 @@
+// Package oauth signs users in through OpenID Connect providers with the authorization
+// code flow and PKCE. Endpoints come from the issuer's discovery document and ID tokens
+// are verified against its published keys.
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	ErrDiscovery      = errors.New("OpenID provider discovery failed")
+	ErrExchange       = errors.New("authorization code exchange failed")
+	ErrInvalidIDToken = errors.New("invalid ID token")
+)
+
+const (
+	// keysRefreshInterval bounds how often an unknown key ID refetches the provider's
+	// keys, so tokens naming made-up keys cannot make us hammer it.
+	keysRefreshInterval = time.Minute
+	// maxResponseBytes caps what we read from the provider.
+	maxResponseBytes = 1 << 20
+	// clockLeeway tolerates drift between our clock and the provider's.
+	clockLeeway = time.Minute
+)
+
+// Config is a client registered with an OpenID provider. RedirectURL is the callback the
+// provider sends the browser back to; it must be registered with the provider.
+type Config struct {
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+}
+
+// Identity is who the provider says signed in. Subject is stable per provider; the email
+// may change and only counts when EmailVerified.
+type Identity struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+type discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+type idTokenClaims struct {
+	jwt.RegisteredClaims
+	Nonce         string `json:"nonce"`
+	Email         string `json:"email"`
+	EmailVerified any    `json:"email_verified"`
+	Name          string `json:"name"`
+}
+
+// Provider is safe for concurrent use. Discovery and keys are fetched on first use and
+// cached; keys are refetched when a token names one we do not know, as after a rotation.
+type Provider struct {
+	cfg    Config
+	client *http.Client
+
+	mu          sync.Mutex
+	discovery   *discovery
+	keys        map[string]*rsa.PublicKey
+	keysFetched time.Time
+}
+
+func NewProvider(cfg Config, client *http.Client) *Provider {
+	if len(cfg.Scopes) == 0 {
+		cfg.Scopes = []string{"openid", "email", "profile"}
+	}
+	return &Provider{cfg: cfg, client: client}
+}
+
+// GenerateToken returns a random URL-safe string for a state, nonce or PKCE verifier.
+func GenerateToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// AuthCodeURL is where to send the browser to sign in. state comes back with the
+// callback; nonce comes back in the ID token; the verifier is sent with the code.
+func (p *Provider) AuthCodeURL(ctx context.Context, state, nonce, verifier string) (string, error) {
+	d, err := p.loadDiscovery(ctx)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	q := url.Values{}
+	q.Set("response_type", "code")
+	q.Set("client_id", p.cfg.ClientID)
+	q.Set("redirect_uri", p.cfg.RedirectURL)
+	q.Set("scope", strings.Join(p.cfg.Scopes, " "))
+	q.Set("state", state)
+	q.Set("nonce", nonce)
+	q.Set("code_challenge", base64.RawURLEncoding.EncodeToString(sum[:]))
+	q.Set("code_challenge_method", "S256")
+
+	sep := "?"
+	if strings.Contains(d.AuthorizationEndpoint, "?") {
+		sep = "&"
+	}
+	return d.AuthorizationEndpoint + sep + q.Encode(), nil
+}
+
+// Exchange redeems the code the callback received and verifies the ID token that comes
+// with it: signature, issuer, audience, expiry and nonce.
+func (p *Provider) Exchange(ctx context.Context, code, verifier, nonce string, now time.Time) (*Identity, error) {
+	d, err := p.loadDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.cfg.RedirectURL)
+	form.Set("code_verifier", verifier)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrExchange, err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(p.cfg.ClientID), url.QueryEscape(p.cfg.ClientSecret))
+
+	var token struct {
+		IDToken string `json:"id_token"`
+	}
+	if err := p.do(req, &token); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrExchange, err)
+	}
+	if token.IDToken == "" {
+		return nil, fmt.Errorf("%w: no id_token in token response", ErrExchange)
+	}
+	return p.verifyIDToken(ctx, d, token.IDToken, nonce, now)
+}
+
+func (p *Provider) verifyIDToken(ctx context.Context, d *discovery, raw, nonce string, now time.Time) (*Identity, error) {
+	var claims idTokenClaims
+	_, err := jwt.ParseWithClaims(raw, &claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		return p.key(ctx, d, kid)
+	},
+		jwt.WithValidMethods([]string{"RS256"}),
+		jwt.WithIssuer(d.Issuer),
+		jwt.WithAudience(p.cfg.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithTimeFunc(func() time.Time { return now }),
+		jwt.WithLeeway(clockLeeway),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidIDToken, err)
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%w: no subject", ErrInvalidIDToken)
+	}
+	if claims.Nonce != nonce {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrInvalidIDToken)
+	}
+	return &Identity{
+		Subject: claims.Subject,
+		Email:   claims.Email,
+		// Some providers send the flag as a string.
+		EmailVerified: claims.EmailVerified == true || claims.EmailVerified == "true",
+		Name:          claims.Name,
+	}, nil
+}
+
+func (p *Provider) loadDiscovery(ctx context.Context) (*discovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(p.cfg.Issuer, "/")+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDiscovery, err)
+	}
+	var d discovery
+	if err := p.do(req, &d); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDiscovery, err)
+	}
+	// The document must be the issuer's own, or tokens it vouches for could be passed off
+	// as this provider's.
+	if d.Issuer != p.cfg.Issuer {
+		return nil, fmt.Errorf("%w: issuer %q does not match %q", ErrDiscovery, d.Issuer, p.cfg.Issuer)
+	}
+	if d.AuthorizationEndpoint == "" || d.TokenEndpoint == "" || d.JWKSURI == "" {
+		return nil, fmt.Errorf("%w: missing endpoints", ErrDiscovery)
+	}
+	p.discovery = &d
+	return p.discovery, nil
+}
+
+func (p *Provider) key(ctx context.Context, d *discovery, kid string) (*rsa.PublicKey, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if key, ok := p.keys[kid]; ok {
+		return key, nil
+	}
+	if p.keys != nil && time.Since(p.keysFetched) < keysRefreshInterval {
+		return nil, fmt.Errorf("unknown key %q", kid)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, d.JWKSURI, nil)
+	if err != nil {
+		return nil, err
+	}
+	var set struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			Use string `json:"use"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}
+	if err := p.do(req, &set); err != nil {
+		return nil, fmt.Errorf("fetch keys: %w", err)
+	}
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" || (k.Use != "" && k.Use != "sig") {
+			continue
+		}
+		n, nerr := base64.RawURLEncoding.DecodeString(k.N)
+		e, eerr := base64.RawURLEncoding.DecodeString(k.E)
+		if nerr != nil || eerr != nil || len(e) == 0 || len(e) > 4 {
+			continue
+		}
+		keys[k.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+	p.keys, p.keysFetched = keys, time.Now()
+	if key, ok := keys[kid]; ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown key %q", kid)
+}
+
+func (p *Provider) do(req *http.Request, out any) error {
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s %s: status %d", req.Method, req.URL.Redacted(), resp.StatusCode)
+	}
+	return json.Unmarshal(body, out)
+}
@@ -0,0 +1,120 @@
+//go:build unit
+
+package oauth_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/pkg/oauth"
+	"gin-clean-starter/tests/common/oidctest"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const redirectURL = "https://api.example.com/api/auth/oidc/test/callback"
+
+func newClient(issuer string) *oauth.Provider {
+	return oauth.NewProvider(oauth.Config{
+		Issuer:       issuer,
+		ClientID:     oidctest.ClientID,
+		ClientSecret: oidctest.ClientSecret,
+		RedirectURL:  redirectURL,
+	}, http.DefaultClient)
+}
+
+// signIn runs the authorization code flow for u and returns the code and verifier.
+func signIn(t *testing.T, idp *oidctest.Provider, client *oauth.Provider, nonce string, u oidctest.User) (string, string) {
+	t.Helper()
+	verifier, err := oauth.GenerateToken()
+	require.NoError(t, err)
+	authURL, err := client.AuthCodeURL(context.Background(), "state-1", nonce, verifier)
+	require.NoError(t, err)
+
+	callback, err := url.Parse(idp.Authorize(t, authURL, u))
+	require.NoError(t, err)
+	assert.Equal(t, "state-1", callback.Query().Get("state"))
+	return callback.Query().Get("code"), verifier
+}
+
+func TestProvider_Exchange(t *testing.T) {
+	ctx := context.Background()
+	idp := oidctest.NewProvider(t)
+	client := newClient(idp.URL)
+	u := oidctest.User{Subject: "subject-1", Email: "user@example.com", EmailVerified: true, Name: "User One"}
+
+	code, verifier := signIn(t, idp, client, "nonce-1", u)
+	identity, err := client.Exchange(ctx, code, verifier, "nonce-1", time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, &oauth.Identity{Subject: "subject-1", Email: "user@example.com", EmailVerified: true, Name: "User One"}, identity)
+
+	t.Run("error: a code is redeemed once", func(t *testing.T) {
+		_, err := client.Exchange(ctx, code, verifier, "nonce-1", time.Now())
+		assert.ErrorIs(t, err, oauth.ErrExchange)
+	})
+
+	t.Run("error: wrong PKCE verifier", func(t *testing.T) {
+		code, _ := signIn(t, idp, client, "nonce-1", u)
+		other, err := oauth.GenerateToken()
+		require.NoError(t, err)
+
+		_, err = client.Exchange(ctx, code, other, "nonce-1", time.Now())
+		assert.ErrorIs(t, err, oauth.ErrExchange)
+	})
+
+	t.Run("error: nonce of another sign-in", func(t *testing.T) {
+		code, verifier := signIn(t, idp, client, "nonce-2", u)
+
+		_, err := client.Exchange(ctx, code, verifier, "nonce-1", time.Now())
+		assert.ErrorIs(t, err, oauth.ErrInvalidIDToken)
+	})
+
+	t.Run("error: expired token", func(t *testing.T) {
+		code, verifier := signIn(t, idp, client, "nonce-1", u)
+
+		_, err := client.Exchange(ctx, code, verifier, "nonce-1", time.Now().Add(time.Hour))
+		assert.ErrorIs(t, err, oauth.ErrInvalidIDToken)
+	})
+
+	t.Run("error: token for another client", func(t *testing.T) {
+		idp.Claims = func(claims jwt.MapClaims) { claims["aud"] = "another-client" }
+		defer func() { idp.Claims = nil }()
+		code, verifier := signIn(t, idp, client, "nonce-1", u)
+
+		_, err := client.Exchange(ctx, code, verifier, "nonce-1", time.Now())
+		assert.ErrorIs(t, err, oauth.ErrInvalidIDToken)
+	})
+
+	t.Run("error: token signed with an unpublished key", func(t *testing.T) {
+		idp.WrongKey = true
+		defer func() { idp.WrongKey = false }()
+		code, verifier := signIn(t, idp, client, "nonce-1", u)
+
+		_, err := client.Exchange(ctx, code, verifier, "nonce-1", time.Now())
+		assert.ErrorIs(t, err, oauth.ErrInvalidIDToken)
+	})
+}
+
+func TestProvider_AuthCodeURL(t *testing.T) {
+	idp := oidctest.NewProvider(t)
+
+	authURL, err := newClient(idp.URL).AuthCodeURL(context.Background(), "s", "n", "verifier")
+	require.NoError(t, err)
+	u, err := url.Parse(authURL)
+	require.NoError(t, err)
+	q := u.Query()
+	assert.Equal(t, "openid email profile", q.Get("scope"))
+	assert.Equal(t, redirectURL, q.Get("redirect_uri"))
+	// The S256 challenge is the unpadded base64url SHA-256 of the verifier.
+	assert.Equal(t, "iMnq5o6zALKXGivsnlom_0F5_WYda32GHkxlV7mq7hQ", q.Get("code_challenge"))
+
+	t.Run("error: discovery document of another issuer", func(t *testing.T) {
+		_, err := newClient(idp.URL+"/").AuthCodeURL(context.Background(), "s", "n", "verifier")
+		assert.ErrorIs(t, err, oauth.ErrDiscovery)
+	})
+}
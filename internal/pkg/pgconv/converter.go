@@ -68,6 +68,13 @@ func Int32PtrFromPgtype(pi pgtype.Int4) *int32 {
 	return &pi.Int32
 }
 
+func Int64PtrFromPgtype(pi pgtype.Int8) *int64 {
+	if !pi.Valid {
+		return nil
+	}
+	return &pi.Int64
+}
+
 func UUIDToPgtype(id uuid.UUID) pgtype.UUID {
 	return pgtype.UUID{Bytes: id, Valid: true}
 }
@@ -90,10 +97,29 @@ func StringPtrToPgtype(s *string) pgtype.Text {
 	return pgtype.Text{String: *s, Valid: true}
 }
 
+func Int32PtrToPgtype(v *int32) pgtype.Int4 {
+	if v == nil {
+		return pgtype.Int4{Valid: false}
+	}
+	return pgtype.Int4{Int32: *v, Valid: true}
+}
+
 func TimeToPgtype(t time.Time) pgtype.Timestamptz {
 	return pgtype.Timestamptz{Time: t, Valid: true}
 }
 
+func TimePtrToPgtype(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{Valid: false}
+	}
+	return pgtype.Timestamptz{Time: *t, Valid: true}
+}
+
+// DateToPgtype keeps only the calendar date of t in its own location.
+func DateToPgtype(t time.Time) pgtype.Date {
+	return pgtype.Date{Time: time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC), Valid: true}
+}
+
 func SafeIntToInt32(v int) (int32, error) {
 	if v > math.MaxInt32 || v < math.MinInt32 {
 		return 0, ErrIntOverflow
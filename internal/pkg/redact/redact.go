@@ -0,0 +1,96 @@
+// Package redact masks credentials and secrets in captured HTTP traffic before it is
+// stored, so a capture can be shared for debugging without leaking them.
+package redact
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Mask replaces every redacted value.
+const Mask = "[REDACTED]"
+
+// Headers whose values are always credentials.
+var sensitiveHeaders = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+	"Set-Cookie":          true,
+	"X-Api-Key":           true,
+	"X-Csrf-Token":        true,
+}
+
+// Key fragments that mark a JSON field or query parameter as secret, matched
+// case-insensitively anywhere in the name ("refreshToken", "new_password").
+var sensitiveKeyParts = []string{"password", "token", "secret", "apikey", "api_key", "authorization", "cookie", "otp", "ssn", "card"}
+
+// Headers copies h with the values of credential headers masked.
+func Headers(h http.Header) map[string][]string {
+	out := make(map[string][]string, len(h))
+	for name, values := range h {
+		if sensitiveHeaders[http.CanonicalHeaderKey(name)] {
+			out[name] = []string{Mask}
+			continue
+		}
+		out[name] = append([]string(nil), values...)
+	}
+	return out
+}
+
+// Query re-encodes a raw query string with the values of secret parameters masked.
+func Query(rawQuery string) string {
+	if rawQuery == "" {
+		return ""
+	}
+	values, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return Mask
+	}
+	for key := range values {
+		if SensitiveKey(key) {
+			values[key] = []string{Mask}
+		}
+	}
+	return values.Encode()
+}
+
+// JSON decodes body and masks the values of secret fields at any depth. It reports false
+// when body is not valid JSON.
+func JSON(body []byte) (any, bool) {
+	var v any
+	if err := json.Unmarshal(body, &v); err != nil {
+		return nil, false
+	}
+	return maskJSON(v), true
+}
+
+func maskJSON(v any) any {
+	switch t := v.(type) {
+	case map[string]any:
+		for key, value := range t {
+			if SensitiveKey(key) {
+				t[key] = Mask
+				continue
+			}
+			t[key] = maskJSON(value)
+		}
+	case []any:
+		for i, value := range t {
+			t[i] = maskJSON(value)
+		}
+	}
+	return v
+}
+
+// SensitiveKey reports whether a field or parameter name looks like it holds a secret.
+func SensitiveKey(name string) bool {
+	name = strings.ToLower(name)
+	for _, part := range sensitiveKeyParts {
+		if strings.Contains(name, part) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,76 @@
+//go:build unit
+
+package redact_test
+
+import (
+	"net/http"
+	"testing"
+
+	"gin-clean-starter/internal/pkg/redact"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeaders(t *testing.T) {
+	h := http.Header{}
+	h.Set("Authorization", "Bearer abc")
+	h.Set("Cookie", "access_token=abc")
+	h.Set("Content-Type", "application/json")
+	h.Add("Accept", "application/json")
+	h.Add("Accept", "text/plain")
+
+	got := redact.Headers(h)
+
+	assert.Equal(t, []string{redact.Mask}, got["Authorization"])
+	assert.Equal(t, []string{redact.Mask}, got["Cookie"])
+	assert.Equal(t, []string{"application/json"}, got["Content-Type"])
+	assert.Equal(t, []string{"application/json", "text/plain"}, got["Accept"])
+	assert.Equal(t, "Bearer abc", h.Get("Authorization"), "the original header is left alone")
+}
+
+func TestQuery(t *testing.T) {
+	testCases := []struct {
+		name     string
+		raw      string
+		expected string
+	}{
+		{name: "empty", raw: "", expected: ""},
+		{name: "plain parameters kept", raw: "limit=10&cursor=abc", expected: "cursor=abc&limit=10"},
+		{name: "secret parameters masked", raw: "token=abc&page=2", expected: "page=2&token=%5BREDACTED%5D"},
+		{name: "malformed query masked whole", raw: "a=%zz", expected: redact.Mask},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, redact.Query(tc.raw))
+		})
+	}
+}
+
+func TestJSON(t *testing.T) {
+	t.Run("secret fields masked at any depth", func(t *testing.T) {
+		got, ok := redact.JSON([]byte(`{"email":"a@example.com","password":"hunter22","nested":{"refreshToken":"r","items":[{"clientSecret":"s","name":"x"}]}}`))
+		require.True(t, ok)
+
+		assert.Equal(t, map[string]any{
+			"email":    "a@example.com",
+			"password": redact.Mask,
+			"nested": map[string]any{
+				"refreshToken": redact.Mask,
+				"items":        []any{map[string]any{"clientSecret": redact.Mask, "name": "x"}},
+			},
+		}, got)
+	})
+
+	t.Run("non-object values pass through", func(t *testing.T) {
+		got, ok := redact.JSON([]byte(`[1,"two"]`))
+		require.True(t, ok)
+		assert.Equal(t, []any{float64(1), "two"}, got)
+	})
+
+	t.Run("invalid JSON reported", func(t *testing.T) {
+		_, ok := redact.JSON([]byte(`{"password":`))
+		assert.False(t, ok)
+	})
+}
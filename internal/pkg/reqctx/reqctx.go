@@ -0,0 +1,80 @@
+// Package reqctx carries request metadata on context.Context so layers below the
+// handlers (e.g. the UoW tagging database sessions) can use it without gin.
+package reqctx
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type key int
+
+const (
+	requestIDKey key = iota
+	userIDKey
+	clientKey
+	traceKey
+	servedByKey
+	scopesKey
+)
+
+// Client describes the caller's connection as seen by the HTTP layer.
+type Client struct {
+	IP        string
+	UserAgent string
+}
+
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey, requestID)
+}
+
+// RequestID returns "" outside of an HTTP request (e.g. scheduler jobs).
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey).(string)
+	return id
+}
+
+func WithUserID(ctx context.Context, userID uuid.UUID) context.Context {
+	return context.WithValue(ctx, userIDKey, userID)
+}
+
+func UserID(ctx context.Context) (uuid.UUID, bool) {
+	id, ok := ctx.Value(userIDKey).(uuid.UUID)
+	return id, ok
+}
+
+// WithScopes limits the request to the permissions in scopes, e.g. those of the API key
+// it authenticated with.
+func WithScopes(ctx context.Context, scopes []string) context.Context {
+	return context.WithValue(ctx, scopesKey, scopes)
+}
+
+// Scopes reports false when the request is not limited beyond its role's grants.
+func Scopes(ctx context.Context) ([]string, bool) {
+	scopes, ok := ctx.Value(scopesKey).([]string)
+	return scopes, ok
+}
+
+func WithClient(ctx context.Context, client Client) context.Context {
+	return context.WithValue(ctx, clientKey, client)
+}
+
+// ClientInfo returns the zero Client outside of an HTTP request.
+func ClientInfo(ctx context.Context) Client {
+	client, _ := ctx.Value(clientKey).(Client)
+	return client
+}
+
+// WithServedBy registers report to be told which database endpoint served the request's
+// replica-routed reads.
+func WithServedBy(ctx context.Context, report func(endpoint string)) context.Context {
+	return context.WithValue(ctx, servedByKey, report)
+}
+
+// ReportServedBy passes endpoint to the function registered by WithServedBy, if any.
+func ReportServedBy(ctx context.Context, endpoint string) {
+	if report, ok := ctx.Value(servedByKey).(func(string)); ok {
+		report(endpoint)
+	}
+}
@@ -0,0 +1,51 @@
+//go:build unit
+
+package reqctx_test
+
+import (
+	"context"
+	"testing"
+
+	"gin-clean-starter/internal/pkg/reqctx"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestMetadata(t *testing.T) {
+	ctx := context.Background()
+
+	assert.Empty(t, reqctx.RequestID(ctx))
+	_, ok := reqctx.UserID(ctx)
+	assert.False(t, ok)
+	assert.Equal(t, reqctx.Client{}, reqctx.ClientInfo(ctx))
+
+	userID := uuid.New()
+	ctx = reqctx.WithUserID(reqctx.WithRequestID(ctx, "20250101000000-abcd1234"), userID)
+
+	assert.Equal(t, "20250101000000-abcd1234", reqctx.RequestID(ctx))
+	got, ok := reqctx.UserID(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, userID, got)
+
+	ctx = reqctx.WithClient(ctx, reqctx.Client{IP: "203.0.113.7", UserAgent: "curl/8.0"})
+	assert.Equal(t, reqctx.Client{IP: "203.0.113.7", UserAgent: "curl/8.0"}, reqctx.ClientInfo(ctx))
+
+	// Survives the detached contexts used for post-response audit writes
+	detached := context.WithoutCancel(ctx)
+	assert.Equal(t, "20250101000000-abcd1234", reqctx.RequestID(detached))
+}
+
+func TestServedBy(t *testing.T) {
+	// Nothing registered outside of an HTTP request
+	reqctx.ReportServedBy(context.Background(), "primary")
+
+	var reported []string
+	ctx := reqctx.WithServedBy(context.Background(), func(endpoint string) {
+		reported = append(reported, endpoint)
+	})
+	reqctx.ReportServedBy(ctx, "eu-west-1/replica-a:5432")
+	reqctx.ReportServedBy(ctx, "primary")
+
+	assert.Equal(t, []string{"eu-west-1/replica-a:5432", "primary"}, reported)
+}
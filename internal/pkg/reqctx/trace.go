@@ -0,0 +1,97 @@
+package reqctx
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+)
+
+// Trace is the W3C trace context (https://www.w3.org/TR/trace-context/) of a request.
+// SpanID identifies this service's part of the trace; calls made while serving the
+// request pass TraceParent() on so the next service becomes its child.
+type Trace struct {
+	TraceID  [16]byte
+	SpanID   [8]byte
+	ParentID [8]byte // zero when the trace started here
+	Sampled  bool
+	State    string // tracestate from upstream, passed on unchanged
+}
+
+// NewTrace starts a trace at this service.
+func NewTrace() Trace {
+	var t Trace
+	_, _ = rand.Read(t.TraceID[:])
+	_, _ = rand.Read(t.SpanID[:])
+	return t
+}
+
+// ContinueTrace joins the trace named by a traceparent header, or starts a new one when
+// the header is missing or malformed.
+func ContinueTrace(traceparent, tracestate string) Trace {
+	parent, ok := parseTraceParent(traceparent)
+	if !ok {
+		return NewTrace()
+	}
+	t := Trace{TraceID: parent.TraceID, ParentID: parent.SpanID, Sampled: parent.Sampled, State: tracestate}
+	_, _ = rand.Read(t.SpanID[:])
+	return t
+}
+
+func (t Trace) TraceIDString() string {
+	return hex.EncodeToString(t.TraceID[:])
+}
+
+// TraceParent is the header value for calls made on behalf of this request.
+func (t Trace) TraceParent() string {
+	flags := "00"
+	if t.Sampled {
+		flags = "01"
+	}
+	return "00-" + hex.EncodeToString(t.TraceID[:]) + "-" + hex.EncodeToString(t.SpanID[:]) + "-" + flags
+}
+
+// parseTraceParent accepts "version-traceid-parentid-flags". Versions after 00 may append
+// fields, which are ignored; version ff and all-zero IDs are invalid.
+func parseTraceParent(s string) (Trace, bool) {
+	var t Trace
+	if len(s) < 55 || (len(s) > 55 && s[55] != '-') {
+		return t, false
+	}
+	version, traceID, spanID, flags := s[0:2], s[3:35], s[36:52], s[53:55]
+	if s[2] != '-' || s[35] != '-' || s[52] != '-' || !isLowerHex(version+traceID+spanID+flags) {
+		return t, false
+	}
+	if version == "ff" || (version == "00" && len(s) != 55) {
+		return t, false
+	}
+	if traceID == strings.Repeat("0", 32) || spanID == strings.Repeat("0", 16) {
+		return t, false
+	}
+	_, _ = hex.Decode(t.TraceID[:], []byte(traceID))
+	_, _ = hex.Decode(t.SpanID[:], []byte(spanID))
+	var f [1]byte
+	_, _ = hex.Decode(f[:], []byte(flags))
+	t.Sampled = f[0]&0x01 != 0
+	return t, true
+}
+
+func isLowerHex(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+func WithTrace(ctx context.Context, trace Trace) context.Context {
+	return context.WithValue(ctx, traceKey, trace)
+}
+
+// TraceInfo returns false outside of an HTTP request.
+func TraceInfo(ctx context.Context) (Trace, bool) {
+	t, ok := ctx.Value(traceKey).(Trace)
+	return t, ok
+}
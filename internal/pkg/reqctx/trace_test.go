@@ -0,0 +1,59 @@
+//go:build unit
+
+package reqctx_test
+
+import (
+	"context"
+	"testing"
+
+	"gin-clean-starter/internal/pkg/reqctx"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContinueTrace(t *testing.T) {
+	testCases := []struct {
+		name        string
+		traceparent string
+		continued   bool
+	}{
+		{name: "success: version 00", traceparent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", continued: true},
+		{name: "success: future version with extra fields", traceparent: "cc-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-what", continued: true},
+		{name: "error: empty", traceparent: ""},
+		{name: "error: uppercase hex", traceparent: "00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01"},
+		{name: "error: version ff", traceparent: "ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"},
+		{name: "error: version 00 with extra fields", traceparent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-what"},
+		{name: "error: zero parent id", traceparent: "00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01"},
+		{name: "error: misplaced separator", traceparent: "00-4bf92f3577b34da6a3ce929d0e0e473-600f067aa0ba902b7-01"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			trace := reqctx.ContinueTrace(tc.traceparent, "vendor=1")
+
+			assert.NotEqual(t, [8]byte{}, trace.SpanID)
+			if !tc.continued {
+				assert.NotEqual(t, "4bf92f3577b34da6a3ce929d0e0e4736", trace.TraceIDString())
+				assert.Equal(t, [8]byte{}, trace.ParentID)
+				assert.Empty(t, trace.State)
+				return
+			}
+			assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", trace.TraceIDString())
+			assert.Equal(t, [8]byte{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7}, trace.ParentID)
+			assert.True(t, trace.Sampled)
+			assert.Equal(t, "vendor=1", trace.State)
+			assert.Regexp(t, `^00-4bf92f3577b34da6a3ce929d0e0e4736-[0-9a-f]{16}-01$`, trace.TraceParent())
+			assert.NotContains(t, trace.TraceParent(), "00f067aa0ba902b7")
+		})
+	}
+}
+
+func TestTraceInfo(t *testing.T) {
+	_, ok := reqctx.TraceInfo(context.Background())
+	assert.False(t, ok)
+
+	trace := reqctx.NewTrace()
+	got, ok := reqctx.TraceInfo(reqctx.WithTrace(context.Background(), trace))
+	assert.True(t, ok)
+	assert.Equal(t, trace, got)
+}
@@ -0,0 +1,111 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+type Job func(ctx context.Context) error
+
+type entry struct {
+	name     string
+	interval time.Duration
+	job      Job
+}
+
+// Scheduler runs registered jobs on a fixed interval in their own goroutines.
+// A job never overlaps with itself; a slow run simply delays the next tick.
+type Scheduler struct {
+	mu      sync.Mutex
+	entries []entry
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+func New() *Scheduler {
+	return &Scheduler{}
+}
+
+// Every registers a job; it must be called before Start.
+func (s *Scheduler) Every(name string, interval time.Duration, job Job) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries = append(s.entries, entry{name: name, interval: interval, job: job})
+}
+
+func (s *Scheduler) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cancel != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	for _, e := range s.entries {
+		s.wg.Add(1)
+		go s.loop(ctx, e)
+	}
+}
+
+// Stop cancels running jobs and waits for them to return or for ctx to expire.
+func (s *Scheduler) Stop(ctx context.Context) error {
+	s.mu.Lock()
+	cancel := s.cancel
+	s.cancel = nil
+	s.mu.Unlock()
+
+	if cancel == nil {
+		return nil
+	}
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *Scheduler) loop(ctx context.Context, e entry) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.run(ctx, e)
+		}
+	}
+}
+
+func (s *Scheduler) run(ctx context.Context, e entry) {
+	defer func() {
+		if r := recover(); r != nil {
+			slog.Error("Scheduled job panicked", "job", e.name, "panic", r)
+		}
+	}()
+
+	started := time.Now()
+	if err := e.job(ctx); err != nil {
+		slog.Error("Scheduled job failed", "job", e.name, "error", err.Error(), "duration", time.Since(started))
+		return
+	}
+	slog.Debug("Scheduled job finished", "job", e.name, "duration", time.Since(started))
+}
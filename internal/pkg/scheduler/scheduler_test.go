@@ -0,0 +1,67 @@
+//go:build unit
+
+package scheduler_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/pkg/scheduler"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduler(t *testing.T) {
+	t.Run("runs registered jobs until stopped", func(t *testing.T) {
+		var runs atomic.Int32
+		s := scheduler.New()
+		s.Every("count", 5*time.Millisecond, func(context.Context) error {
+			runs.Add(1)
+			return nil
+		})
+
+		s.Start()
+		assert.Eventually(t, func() bool { return runs.Load() >= 2 }, time.Second, time.Millisecond)
+		require.NoError(t, s.Stop(context.Background()))
+
+		stopped := runs.Load()
+		time.Sleep(20 * time.Millisecond)
+		assert.Equal(t, stopped, runs.Load())
+	})
+
+	t.Run("job context is canceled on stop", func(t *testing.T) {
+		started := make(chan struct{})
+		s := scheduler.New()
+		s.Every("block", time.Millisecond, func(ctx context.Context) error {
+			select {
+			case started <- struct{}{}:
+			default:
+			}
+			<-ctx.Done()
+			return ctx.Err()
+		})
+
+		s.Start()
+		<-started
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		assert.NoError(t, s.Stop(ctx))
+	})
+
+	t.Run("panicking job does not stop the scheduler", func(t *testing.T) {
+		var runs atomic.Int32
+		s := scheduler.New()
+		s.Every("panic", 5*time.Millisecond, func(context.Context) error {
+			runs.Add(1)
+			panic("boom")
+		})
+
+		s.Start()
+		assert.Eventually(t, func() bool { return runs.Load() >= 2 }, time.Second, time.Millisecond)
+		require.NoError(t, s.Stop(context.Background()))
+	})
+}
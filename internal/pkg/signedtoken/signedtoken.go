@@ -0,0 +1,95 @@
+package signedtoken
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidToken = errors.New("invalid signed token")
+	ErrExpiredToken = errors.New("signed token expired")
+)
+
+// Signer issues compact HMAC-signed tokens (`<payload>.<signature>`, base64url).
+// The purpose is part of the signed payload so a token minted for one flow
+// cannot be replayed against another that shares the same secret.
+type Signer struct {
+	secret []byte
+}
+
+type envelope struct {
+	Purpose   string          `json:"p"`
+	ExpiresAt int64           `json:"exp"`
+	Data      json.RawMessage `json:"d"`
+}
+
+func NewSigner(secret []byte) *Signer {
+	return &Signer{secret: secret}
+}
+
+func (s *Signer) Sign(purpose string, data any, expiresAt time.Time) (string, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+
+	payload, err := json.Marshal(envelope{
+		Purpose:   purpose,
+		ExpiresAt: expiresAt.Unix(),
+		Data:      raw,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(s.mac(encoded)), nil
+}
+
+// Verify checks signature, purpose and expiry, then decodes the payload into out.
+// An expired token is still decoded so callers can act on its contents (e.g. re-issue).
+func (s *Signer) Verify(purpose, token string, now time.Time, out any) (time.Time, error) {
+	encoded, sig, ok := strings.Cut(token, ".")
+	if !ok || encoded == "" || sig == "" {
+		return time.Time{}, ErrInvalidToken
+	}
+
+	gotMAC, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil || !hmac.Equal(gotMAC, s.mac(encoded)) {
+		return time.Time{}, ErrInvalidToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return time.Time{}, ErrInvalidToken
+	}
+
+	var env envelope
+	if err := json.Unmarshal(payload, &env); err != nil {
+		return time.Time{}, ErrInvalidToken
+	}
+	if env.Purpose != purpose {
+		return time.Time{}, ErrInvalidToken
+	}
+	if err := json.Unmarshal(env.Data, out); err != nil {
+		return time.Time{}, ErrInvalidToken
+	}
+
+	expiresAt := time.Unix(env.ExpiresAt, 0)
+	if !now.Before(expiresAt) {
+		return expiresAt, ErrExpiredToken
+	}
+
+	return expiresAt, nil
+}
+
+func (s *Signer) mac(encoded string) []byte {
+	h := hmac.New(sha256.New, s.secret)
+	h.Write([]byte(encoded))
+	return h.Sum(nil)
+}
@@ -0,0 +1,54 @@
+// Package sitemap encodes sitemaps in the sitemaps.org 0.9 protocol.
+package sitemap
+
+import (
+	"encoding/xml"
+	"io"
+	"time"
+)
+
+// ContentType is the media type sitemaps are served with.
+const ContentType = "application/xml; charset=utf-8"
+
+// MaxURLs is the most URLs one sitemap may list; larger sites split theirs behind an index.
+const MaxURLs = 50000
+
+const sitemapNS = "http://www.sitemaps.org/schemas/sitemap/0.9"
+
+// URL is one page of a sitemap. Loc must be absolute; a zero LastMod is left out.
+type URL struct {
+	Loc     string
+	LastMod time.Time
+}
+
+type xmlURLSet struct {
+	XMLName xml.Name `xml:"urlset"`
+	NS      string   `xml:"xmlns,attr"`
+	URLs    []xmlURL `xml:"url"`
+}
+
+type xmlURL struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+// Write encodes urls as an indented sitemap, XML declaration included.
+func Write(w io.Writer, urls []URL) error {
+	doc := xmlURLSet{NS: sitemapNS, URLs: make([]xmlURL, len(urls))}
+	for i, u := range urls {
+		doc.URLs[i] = xmlURL{Loc: u.Loc}
+		if !u.LastMod.IsZero() {
+			doc.URLs[i].LastMod = u.LastMod.UTC().Format(time.RFC3339)
+		}
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	return enc.Close()
+}
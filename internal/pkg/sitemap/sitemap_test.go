@@ -0,0 +1,48 @@
+//go:build unit
+
+package sitemap_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/pkg/sitemap"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrite(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+
+	var buf bytes.Buffer
+	err := sitemap.Write(&buf, []sitemap.URL{
+		{Loc: "https://example.com/resources/room-a?x=1&y=2", LastMod: time.Date(2025, 3, 4, 9, 0, 0, 0, jst)},
+		{Loc: "https://example.com/resources/room-b"},
+	})
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(buf.Bytes(), []byte(xml.Header)))
+
+	var got struct {
+		XMLName xml.Name `xml:"http://www.sitemaps.org/schemas/sitemap/0.9 urlset"`
+		URLs    []struct {
+			Loc     string `xml:"loc"`
+			LastMod string `xml:"lastmod"`
+		} `xml:"url"`
+	}
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &got))
+	require.Len(t, got.URLs, 2)
+	assert.Equal(t, "https://example.com/resources/room-a?x=1&y=2", got.URLs[0].Loc)
+	assert.Equal(t, "2025-03-04T00:00:00Z", got.URLs[0].LastMod)
+	assert.Equal(t, "https://example.com/resources/room-b", got.URLs[1].Loc)
+	assert.NotContains(t, buf.String(), "<lastmod></lastmod>")
+	assert.Contains(t, buf.String(), "room-a?x=1&amp;y=2")
+}
+
+func TestWriteEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, sitemap.Write(&buf, nil))
+	assert.Contains(t, buf.String(), `<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9"></urlset>`)
+}
@@ -0,0 +1,75 @@
+//go:build unit
+
+package totp_test
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/pkg/totp"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rfcSecret is the RFC 6238 SHA1 test key "12345678901234567890" in base32.
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCode_RFC6238Vectors(t *testing.T) {
+	// The RFC lists 8-digit codes; 6-digit codes are their last six digits.
+	vectors := map[int64]string{
+		59:          "287082",
+		1111111109:  "081804",
+		1111111111:  "050471",
+		1234567890:  "005924",
+		2000000000:  "279037",
+		20000000000: "353130",
+	}
+	for unix, expected := range vectors {
+		code, err := totp.Code(rfcSecret, totp.Step(time.Unix(unix, 0)))
+		require.NoError(t, err)
+		assert.Equal(t, expected, code, "time %d", unix)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	previous, err := totp.Code(rfcSecret, totp.Step(now)-1)
+	require.NoError(t, err)
+	stale, err := totp.Code(rfcSecret, totp.Step(now)-2)
+	require.NoError(t, err)
+
+	step, err := totp.Validate(rfcSecret, "050471", now, 1)
+	require.NoError(t, err)
+	assert.Equal(t, totp.Step(now), step)
+
+	step, err = totp.Validate(rfcSecret, previous, now, 1)
+	require.NoError(t, err)
+	assert.Equal(t, totp.Step(now)-1, step, "one step of drift is tolerated")
+
+	_, err = totp.Validate(rfcSecret, stale, now, 1)
+	assert.ErrorIs(t, err, totp.ErrInvalidCode)
+	_, err = totp.Validate(rfcSecret, "50471", now, 1)
+	assert.ErrorIs(t, err, totp.ErrInvalidCode)
+	_, err = totp.Validate("not base32!", "050471", now, 1)
+	assert.ErrorIs(t, err, totp.ErrInvalidSecret)
+}
+
+func TestGenerateSecretAndProvisioningURI(t *testing.T) {
+	secret, err := totp.GenerateSecret()
+	require.NoError(t, err)
+	assert.Len(t, secret, 32)
+	_, err = totp.Code(secret, 1)
+	require.NoError(t, err)
+
+	u, err := url.Parse(totp.ProvisioningURI("Gin Starter", "user@example.com", secret))
+	require.NoError(t, err)
+	assert.Equal(t, "otpauth", u.Scheme)
+	assert.Equal(t, "totp", u.Host)
+	assert.Equal(t, "/Gin Starter:user@example.com", u.Path)
+	assert.Equal(t, secret, u.Query().Get("secret"))
+	assert.Equal(t, "Gin Starter", u.Query().Get("issuer"))
+	assert.Equal(t, "6", u.Query().Get("digits"))
+	assert.Equal(t, "30", u.Query().Get("period"))
+}
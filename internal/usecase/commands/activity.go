@@ -0,0 +1,24 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+// recordNotification puts a notification about the reservation on the user's timeline.
+// Only notifications caused by someone else's action go there; the user's own bookings
+// and cancellations are already on it.
+func recordNotification(ctx context.Context, tx shared.Tx, userID, reservationID uuid.UUID, resourceID *uuid.UUID, topic string, now time.Time) error {
+	return tx.Activity().Record(ctx, tx.DB(), shared.ActivityEntry{
+		UserID:     userID,
+		Kind:       shared.ActivityNotification,
+		SubjectID:  reservationID,
+		ResourceID: resourceID,
+		Data:       map[string]any{"topic": topic},
+		OccurredAt: now,
+	})
+}
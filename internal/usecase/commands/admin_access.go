@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"context"
+
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+)
+
+const (
+	AuditActionAdminIPDenied = "admin.ip_denied"
+
+	auditTargetRoute = "route"
+)
+
+var ErrAdminAccessAuditFailed = errs.New("admin access audit failed")
+
+// AdminAccessDenial describes a request to an admin route refused by the IP rules. It is
+// refused before authentication, so only the client's address is known.
+type AdminAccessDenial struct {
+	ClientIP string
+	Method   string
+	Path     string
+	Reason   string // "denied": matched a deny entry; "not_allowed": matched no allow entry
+}
+
+type AdminAccessCommands interface {
+	RecordDenied(ctx context.Context, denial AdminAccessDenial) error
+}
+
+type adminAccessCommandsImpl struct {
+	uow shared.UnitOfWork
+}
+
+func NewAdminAccessCommands(uow shared.UnitOfWork) AdminAccessCommands {
+	return &adminAccessCommandsImpl{
+		uow: uow,
+	}
+}
+
+func (c *adminAccessCommandsImpl) RecordDenied(ctx context.Context, denial AdminAccessDenial) error {
+	err := c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		return tx.Audit().Record(ctx, tx.DB(), shared.AuditEntry{
+			Action:     AuditActionAdminIPDenied,
+			TargetType: auditTargetRoute,
+			TargetID:   denial.Path,
+			Metadata: map[string]any{
+				"client_ip": denial.ClientIP,
+				"method":    denial.Method,
+				"reason":    denial.Reason,
+			},
+		})
+	})
+	if err != nil {
+		return errs.Mark(err, ErrAdminAccessAuditFailed)
+	}
+	return nil
+}
@@ -2,32 +2,165 @@ package commands
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"log/slog"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 
 	"gin-clean-starter/internal/domain/user"
 	reqdto "gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/clock"
 	"gin-clean-starter/internal/pkg/errs"
 	"gin-clean-starter/internal/pkg/jwt"
 	"gin-clean-starter/internal/pkg/password"
+	"gin-clean-starter/internal/pkg/reqctx"
+	"gin-clean-starter/internal/pkg/signedtoken"
+	"gin-clean-starter/internal/pkg/totp"
 	"gin-clean-starter/internal/usecase/queries"
 	"gin-clean-starter/internal/usecase/shared"
 )
 
 var (
-	ErrUserNotFound         = errs.New("user not found")
-	ErrInvalidCredentials   = errs.New("invalid credentials")
-	ErrUserInactive         = errs.New("user inactive")
-	ErrAuthenticationFailed = errs.New("authentication failed")
-	ErrTokenGeneration      = errs.New("token generation failed")
-	ErrTokenValidation      = errs.New("token validation failed")
+	ErrUserNotFound            = errs.NewCoded("USER_NOT_FOUND", "user not found")
+	ErrInvalidCredentials      = errs.NewCoded("INVALID_CREDENTIALS", "invalid credentials")
+	ErrUserInactive            = errs.NewCoded("USER_INACTIVE", "user inactive")
+	ErrAuthenticationFailed    = errs.New("authentication failed")
+	ErrTokenGeneration         = errs.New("token generation failed")
+	ErrTokenValidation         = errs.NewCoded("INVALID_TOKEN", "token validation failed")
+	ErrDeviceKeyRequired       = errs.NewCoded("DEVICE_KEY_REQUIRED", "device key required")
+	ErrDeviceMismatch          = errs.NewCoded("DEVICE_MISMATCH", "refresh token bound to another device")
+	ErrRefreshTokenReused      = errs.NewCoded("REFRESH_TOKEN_REUSED", "refresh token already used; session revoked")
+	ErrSessionRevoked          = errs.NewCoded("SESSION_REVOKED", "session revoked")
+	ErrLogoutFailed            = errs.New("logout failed")
+	ErrSessionRevocationFailed = errs.New("session revocation failed")
+	ErrSessionNotFound         = errs.NewCoded("SESSION_NOT_FOUND", "session not found or already ended")
+	ErrRegistrationDisabled    = errs.NewCoded("REGISTRATION_DISABLED", "self-registration disabled")
+	ErrRegisterInvalidEmail    = errs.NewCoded("INVALID_EMAIL", "invalid email")
+	ErrWeakPassword            = errs.NewCoded("WEAK_PASSWORD", "password too weak")
+	ErrRegisterEmailTaken      = errs.NewCoded("EMAIL_ALREADY_REGISTERED", "email already registered")
+	ErrRegistrationFailed      = errs.New("registration failed")
+	ErrInvalidVerification     = errs.NewCoded("EMAIL_VERIFICATION_INVALID", "invalid email verification token")
+	ErrVerificationExpired     = errs.NewCoded("EMAIL_VERIFICATION_EXPIRED", "email verification expired")
+	ErrVerificationFailed      = errs.New("email verification failed")
+	ErrCurrentPasswordWrong    = errs.NewCoded("CURRENT_PASSWORD_INCORRECT", "current password incorrect")
+	ErrPasswordUnchanged       = errs.NewCoded("PASSWORD_UNCHANGED", "new password equals the current one")
+	ErrPasswordChangeFailed    = errs.New("password change failed")
+	ErrMFAChallengeInvalid     = errs.NewCoded("MFA_TOKEN_INVALID", "invalid MFA token")
+	ErrMFAChallengeExpired     = errs.NewCoded("MFA_TOKEN_EXPIRED", "MFA token expired")
+	ErrMFACodeInvalid          = errs.NewCoded("MFA_CODE_INVALID", "invalid two-factor code")
+	ErrMFALocked               = errs.NewCoded("MFA_LOCKED", "too many invalid two-factor codes")
+	ErrMFAAlreadyEnabled       = errs.NewCoded("MFA_ALREADY_ENABLED", "two-factor authentication already enabled")
+	ErrMFANotEnrolled          = errs.NewCoded("MFA_NOT_ENROLLED", "two-factor authentication not enrolled")
+	ErrMFANotEnabled           = errs.NewCoded("MFA_NOT_ENABLED", "two-factor authentication not enabled")
+	ErrMFAFailed               = errs.New("two-factor authentication failed")
+	ErrAccountLocked           = errs.NewCoded("ACCOUNT_LOCKED", "too many failed sign-ins")
+	ErrLoginUnlockFailed       = errs.New("login unlock failed")
 )
 
+const (
+	EmailVerificationTokenPurpose = "email_verification"
+
+	NotificationTopicEmailVerification = "email_verification"
+
+	// MFAPendingTokenPurpose signs the token a login answers with when the user has to
+	// send a second factor.
+	MFAPendingTokenPurpose = "mfa_pending"
+
+	// mfaSkew is how many time steps a TOTP code may be off, for clock drift on the phone.
+	mfaSkew = 1
+	// recoveryCodeLength is the number of base32 characters of a recovery code, shown
+	// split in two halves.
+	recoveryCodeLength = 10
+)
+
+// MFAPolicy controls TOTP two-factor authentication; Issuer names the account in
+// authenticator apps.
+type MFAPolicy struct {
+	Issuer        string
+	ChallengeTTL  time.Duration
+	RecoveryCodes int
+	MaxAttempts   int
+	Lockout       time.Duration
+}
+
+// LoginLockoutPolicy locks an email out of password sign-in from one client IP after
+// MaxAttempts failures with no more than Cooldown between them, for Cooldown. A zero
+// MaxAttempts turns the lockout off.
+type LoginLockoutPolicy struct {
+	MaxAttempts int
+	Cooldown    time.Duration
+}
+
+// AccountLockedError is a sign-in refused until Until because of earlier failures. It
+// matches ErrAccountLocked.
+type AccountLockedError struct {
+	Until time.Time
+}
+
+func (e *AccountLockedError) Error() string {
+	return fmt.Sprintf("sign-in locked until %s", e.Until.Format(time.RFC3339))
+}
+
+func (e *AccountLockedError) Is(target error) bool {
+	return target == ErrAccountLocked
+}
+
+func (e *AccountLockedError) ErrorCode() errs.Code {
+	return errs.CodeOf(ErrAccountLocked)
+}
+
+// RegistrationPolicy controls self-registration; the signed verification token is
+// appended to VerifyURL as the "token" query parameter.
+type RegistrationPolicy struct {
+	Enabled   bool
+	TTL       time.Duration
+	VerifyURL string
+}
+
+// DeviceBindingMode controls how refresh tokens are tied to a client-held device key.
+//   - off: tokens are never bound and device keys are ignored
+//   - optional: tokens are bound when a key is sent; unbound tokens still refresh and
+//     are upgraded to bound ones (migration path for tokens issued before binding)
+//   - required: every login and refresh must present the key; unbound tokens are rejected
+type DeviceBindingMode string
+
+const (
+	DeviceBindingOff      DeviceBindingMode = "off"
+	DeviceBindingOptional DeviceBindingMode = "optional"
+	DeviceBindingRequired DeviceBindingMode = "required"
+)
+
+// LoginResult carries either TokenPair or, for a user with two-factor authentication,
+// MFAChallenge.
 type LoginResult struct {
-	UserID     uuid.UUID
-	TokenPair  *TokenPair
-	IsReplayed bool
+	UserID       uuid.UUID
+	TokenPair    *TokenPair
+	MFAChallenge *MFAChallenge
+	IsReplayed   bool
+}
+
+// MFAChallenge is redeemed for a token pair with the user's code through VerifyMFA
+// before ExpiresAt.
+type MFAChallenge struct {
+	Token     string
+	ExpiresAt time.Time
+}
+
+// MFAEnrollment is a pending enrollment's secret, as text and as the otpauth:// URI to
+// show as a QR code.
+type MFAEnrollment struct {
+	Secret          string
+	ProvisioningURI string
 }
 
 type TokenPair struct {
@@ -35,77 +168,306 @@ type TokenPair struct {
 	RefreshToken string
 }
 
+type RegisterResult struct {
+	UserID    uuid.UUID
+	Email     string
+	ExpiresAt time.Time
+}
+
+// verificationClaims is the signed payload of a verification link. The nonce must match
+// the pending verification, which redeeming deletes, so a link works once.
+type verificationClaims struct {
+	UserID uuid.UUID `json:"uid"`
+	Nonce  uuid.UUID `json:"n"`
+}
+
+// mfaClaims is the signed payload of an mfa_pending token.
+type mfaClaims struct {
+	UserID uuid.UUID `json:"uid"`
+}
+
 type AuthCommands interface {
-	Login(ctx context.Context, req reqdto.LoginRequest) (*LoginResult, error)
-	RefreshToken(ctx context.Context, refreshToken string) (*TokenPair, error)
+	// Login authenticates the user and issues a token pair. A resubmission carrying the
+	// same nonce and credentials within the replay window returns the first pair instead.
+	// Too many failures for the email from the client's IP fail with AccountLockedError
+	// until the lockout ends, even with the right password.
+	Login(ctx context.Context, req reqdto.LoginRequest, deviceKey string, nonce string) (*LoginResult, error)
+	// RefreshToken rotates the refresh token: the presented token is spent and a new pair
+	// of the same session is issued. Presenting a spent token again revokes the session.
+	RefreshToken(ctx context.Context, refreshToken string, deviceKey string) (*TokenPair, error)
+	// Logout revokes the access token's session, so neither its refresh token nor its
+	// access tokens are accepted any more. Tokens without a session are denylisted, the
+	// refresh token too when the client sent it.
+	Logout(ctx context.Context, access shared.IssuedToken, refreshToken string) error
+	// RevokeAllSessions signs the user out everywhere on behalf of actorID.
+	RevokeAllSessions(ctx context.Context, userID, actorID uuid.UUID) error
+	// RevokeSession signs the user out of one of their live sessions; its refresh token is
+	// refused from now on and its access tokens like those of a logout.
+	RevokeSession(ctx context.Context, userID, sessionID uuid.UUID) error
+	// UnlockLogin lifts the user's sign-in lockouts from every IP on behalf of actorID.
+	UnlockLogin(ctx context.Context, userID, actorID uuid.UUID) error
+	// Register creates an inactive viewer account and mails it a verification link.
+	Register(ctx context.Context, req reqdto.RegisterRequest) (*RegisterResult, error)
+	// VerifyEmail redeems a verification link and activates its account.
+	VerifyEmail(ctx context.Context, token string) error
+	// ChangePassword replaces the password once the current one is confirmed and signs the
+	// user out of every session; the returned pair belongs to a new session for the caller.
+	ChangePassword(ctx context.Context, userID uuid.UUID, req reqdto.ChangePasswordRequest, deviceKey string) (*TokenPair, error)
+	// VerifyMFA redeems the mfa_pending token of a login with a TOTP or recovery code and
+	// issues the token pair the login held back.
+	VerifyMFA(ctx context.Context, req reqdto.VerifyMFARequest, deviceKey string) (*LoginResult, error)
+	// EnrollMFA starts two-factor enrollment with a new secret, replacing one that was
+	// never confirmed.
+	EnrollMFA(ctx context.Context, userID uuid.UUID) (*MFAEnrollment, error)
+	// EnableMFA confirms the enrollment with a code of its secret and returns the
+	// recovery codes, which are not shown again.
+	EnableMFA(ctx context.Context, userID uuid.UUID, code string) ([]string, error)
+	// DisableMFA turns two-factor authentication off once a TOTP or recovery code is given.
+	DisableMFA(ctx context.Context, userID uuid.UUID, code string) error
 }
 
 type authCommandsImpl struct {
-	uow        shared.UnitOfWork
-	readStore  queries.UserReadStore
-	jwtService *jwt.Service
+	uow           shared.UnitOfWork
+	readStore     queries.UserReadStore
+	jwtService    *jwt.Service
+	bindingMode   DeviceBindingMode
+	clock         clock.Clock
+	replays       shared.LoginReplayCache
+	tokens        shared.RefreshTokenRepository
+	revocations   shared.RefreshTokenReadStore
+	verifications shared.EmailVerificationRepository
+	signer        *signedtoken.Signer
+	registration  RegistrationPolicy
+	mfa           shared.MFARepository
+	mfaPolicy     MFAPolicy
+	failures      shared.LoginFailureRepository
+	lockout       LoginLockoutPolicy
 }
 
-func NewAuthCommands(uow shared.UnitOfWork, readStore queries.UserReadStore, jwtService *jwt.Service) AuthCommands {
+func NewAuthCommands(
+	uow shared.UnitOfWork,
+	readStore queries.UserReadStore,
+	jwtService *jwt.Service,
+	bindingMode DeviceBindingMode,
+	clock clock.Clock,
+	replays shared.LoginReplayCache,
+	tokens shared.RefreshTokenRepository,
+	revocations shared.RefreshTokenReadStore,
+	verifications shared.EmailVerificationRepository,
+	signer *signedtoken.Signer,
+	registration RegistrationPolicy,
+	mfa shared.MFARepository,
+	mfaPolicy MFAPolicy,
+	failures shared.LoginFailureRepository,
+	lockout LoginLockoutPolicy,
+) AuthCommands {
 	return &authCommandsImpl{
-		uow:        uow,
-		readStore:  readStore,
-		jwtService: jwtService,
+		uow:           uow,
+		readStore:     readStore,
+		jwtService:    jwtService,
+		bindingMode:   bindingMode,
+		clock:         clock,
+		replays:       replays,
+		tokens:        tokens,
+		revocations:   revocations,
+		verifications: verifications,
+		signer:        signer,
+		registration:  registration,
+		mfa:           mfa,
+		mfaPolicy:     mfaPolicy,
+		failures:      failures,
+		lockout:       lockout,
+	}
+}
+
+func (a *authCommandsImpl) Login(ctx context.Context, req reqdto.LoginRequest, deviceKey string, nonce string) (*LoginResult, error) {
+	deviceKey, err := a.resolveDeviceKey(deviceKey)
+	if err != nil {
+		return nil, err
+	}
+
+	attempt := shared.LoginAttempt{Nonce: nonce, Email: req.Email, Password: req.Password, DeviceKey: deviceKey}
+	replay, replayed, err := a.replays.Do(attempt, func() (*shared.LoginReplay, error) {
+		return a.login(ctx, req, deviceKey)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if replayed {
+		slog.Info("Duplicate login submission answered from the replay cache", "user_id", replay.UserID)
+	}
+
+	result := &LoginResult{UserID: replay.UserID, IsReplayed: replayed}
+	if replay.MFAToken != "" {
+		result.MFAChallenge = &MFAChallenge{Token: replay.MFAToken, ExpiresAt: replay.MFAExpiresAt}
+	} else {
+		result.TokenPair = &TokenPair{
+			AccessToken:  replay.AccessToken,
+			RefreshToken: replay.RefreshToken,
+		}
 	}
+	return result, nil
 }
 
-func (a *authCommandsImpl) Login(ctx context.Context, req reqdto.LoginRequest) (*LoginResult, error) {
+func (a *authCommandsImpl) login(ctx context.Context, req reqdto.LoginRequest, deviceKey string) (*shared.LoginReplay, error) {
 	credentials, err := req.ToDomain()
 	if err != nil {
 		return nil, errs.Mark(err, ErrAuthenticationFailed)
 	}
 
+	email, ip := credentials.Email().Value(), reqctx.ClientInfo(ctx).IP
+	if err := a.checkLockout(ctx, email, ip); err != nil {
+		return nil, err
+	}
+
 	userReadModel, err := a.validateUser(ctx, credentials)
 	if err != nil {
+		// Unknown emails count too, so a lockout says nothing about which accounts exist.
+		if errors.Is(err, ErrInvalidCredentials) || errors.Is(err, ErrUserNotFound) {
+			if lockErr := a.recordLoginFailure(ctx, email, ip); lockErr != nil {
+				return nil, lockErr
+			}
+		}
 		return nil, err
 	}
+	a.clearLoginFailures(ctx, email, ip)
 
 	role, err := user.NewRole(userReadModel.Role)
 	if err != nil {
 		return nil, errs.Mark(err, ErrAuthenticationFailed)
 	}
 
-	accessToken, err := a.jwtService.GenerateAccessToken(userReadModel.ID, role)
+	mfaEnabled, err := a.mfa.IsEnabled(ctx, a.uow.DB(ctx), userReadModel.ID)
 	if err != nil {
-		return nil, errs.Mark(err, ErrTokenGeneration)
+		return nil, errs.Mark(err, ErrAuthenticationFailed)
+	}
+	if mfaEnabled {
+		challenge, err := newMFAChallenge(a.signer, a.mfaPolicy, userReadModel.ID, a.clock.Now())
+		if err != nil {
+			return nil, errs.Mark(err, ErrTokenGeneration)
+		}
+		return &shared.LoginReplay{UserID: userReadModel.ID, MFAToken: challenge.Token, MFAExpiresAt: challenge.ExpiresAt}, nil
 	}
 
-	refreshToken, err := a.jwtService.GenerateRefreshToken(userReadModel.ID, role)
+	var pair *TokenPair
+	err = a.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		var issueErr error
+		pair, issueErr = issueTokens(ctx, tx.DB(), a.tokens, a.jwtService, userReadModel.ID, role, deviceKey, uuid.New(), a.clock.Now())
+		return issueErr
+	})
 	if err != nil {
 		return nil, errs.Mark(err, ErrTokenGeneration)
 	}
+	a.recordLogin(ctx, userReadModel.ID, deviceKey)
 
-	err = a.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
-		updateErr := tx.Users().UpdateLastLogin(ctx, tx.DB(), userReadModel.ID)
-		if updateErr != nil {
-			slog.Warn("failed to update last login", "user_id", userReadModel.ID, "error", updateErr.Error())
-			// Continue without failing - this is not critical
-		}
+	return &shared.LoginReplay{
+		UserID:       userReadModel.ID,
+		AccessToken:  pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
+	}, nil
+}
+
+// checkLockout refuses a sign-in of email from ip while it is locked out.
+func (a *authCommandsImpl) checkLockout(ctx context.Context, email, ip string) error {
+	if a.lockout.MaxAttempts == 0 {
+		return nil
+	}
+	until, err := a.failures.LockedUntil(ctx, a.uow.DB(ctx), email, ip, a.clock.Now())
+	if err != nil {
+		return errs.Mark(err, ErrAuthenticationFailed)
+	}
+	if until != nil {
+		return &AccountLockedError{Until: *until}
+	}
+	return nil
+}
+
+// recordLoginFailure counts a failed sign-in of email from ip. The failure that reaches
+// the limit locks the pair and is itself refused with AccountLockedError; a user with
+// that email gets an auth.account_locked security event.
+func (a *authCommandsImpl) recordLoginFailure(ctx context.Context, email, ip string) error {
+	if a.lockout.MaxAttempts == 0 {
 		return nil
+	}
+	now := a.clock.Now()
+	var locked *AccountLockedError
+	err := a.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		attempts, recordErr := a.failures.RecordFailure(ctx, tx.DB(), email, ip, now, now.Add(a.lockout.Cooldown))
+		if recordErr != nil {
+			return recordErr
+		}
+		if attempts < a.lockout.MaxAttempts {
+			return nil
+		}
+		until := now.Add(a.lockout.Cooldown)
+		if lockErr := a.failures.Lock(ctx, tx.DB(), email, ip, until); lockErr != nil {
+			return lockErr
+		}
+		locked = &AccountLockedError{Until: until}
+
+		account, _, findErr := a.readStore.FindByEmail(ctx, tx.DB(), email)
+		if findErr != nil {
+			if infra.IsKind(findErr, infra.KindNotFound) {
+				return nil
+			}
+			return findErr
+		}
+		client := reqctx.ClientInfo(ctx)
+		return tx.Audit().Record(ctx, tx.DB(), shared.AuditEntry{
+			Action:     shared.AuditActionAccountLocked,
+			TargetType: shared.AuditTargetUser,
+			TargetID:   account.ID.String(),
+			Metadata: map[string]any{
+				"failed_attempts": attempts,
+				"locked_until":    until,
+				"ip":              client.IP,
+				"user_agent":      client.UserAgent,
+			},
+		})
 	})
 	if err != nil {
-		slog.Warn("transaction failed during login", "user_id", userReadModel.ID, "error", err.Error())
-		// Continue without failing - login was successful, only last_login update failed
+		return errs.Mark(err, ErrAuthenticationFailed)
+	}
+	if locked != nil {
+		slog.Warn("Sign-in locked after repeated failures", "email", email, "ip", ip, "locked_until", locked.Until)
+		return locked
 	}
+	return nil
+}
 
-	tokenPair := &TokenPair{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
+// clearLoginFailures forgets the failures of email from ip once it signs in.
+func (a *authCommandsImpl) clearLoginFailures(ctx context.Context, email, ip string) {
+	if a.lockout.MaxAttempts == 0 {
+		return
 	}
+	if err := a.failures.Clear(ctx, a.uow.DB(ctx), email, ip); err != nil {
+		slog.Warn("failed to clear login failures", "email", email, "error", err.Error())
+		// Continue without failing - the count lapses after the cool-down anyway
+	}
+}
 
-	return &LoginResult{
-		UserID:     userReadModel.ID,
-		TokenPair:  tokenPair,
-		IsReplayed: false,
-	}, nil
+// recordLogin updates the last login and the known devices of a user who just signed in.
+func (a *authCommandsImpl) recordLogin(ctx context.Context, userID uuid.UUID, deviceKey string) {
+	err := a.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		updateErr := tx.Users().UpdateLastLogin(ctx, tx.DB(), userID)
+		if updateErr != nil {
+			slog.Warn("failed to update last login", "user_id", userID, "error", updateErr.Error())
+			// Continue without failing - this is not critical
+		}
+		return recordDevice(ctx, tx, userID, deviceKey, a.clock.Now())
+	})
+	if err != nil {
+		slog.Warn("transaction failed during login", "user_id", userID, "error", err.Error())
+		// Continue without failing - login was successful, only the bookkeeping failed
+	}
 }
 
-func (a *authCommandsImpl) RefreshToken(ctx context.Context, refreshToken string) (*TokenPair, error) {
+func (a *authCommandsImpl) RefreshToken(ctx context.Context, refreshToken string, deviceKey string) (*TokenPair, error) {
+	deviceKey, err := a.resolveDeviceKey(deviceKey)
+	if err != nil {
+		return nil, err
+	}
+
 	claims, err := a.jwtService.ValidateToken(refreshToken)
 	if err != nil {
 		return nil, errs.Mark(err, ErrTokenValidation)
@@ -115,6 +477,10 @@ func (a *authCommandsImpl) RefreshToken(ctx context.Context, refreshToken string
 		return nil, ErrTokenValidation
 	}
 
+	if err := a.checkDeviceBinding(claims, deviceKey); err != nil {
+		return nil, err
+	}
+
 	role, err := user.NewRole(claims.Role)
 	if err != nil {
 		return nil, errs.Mark(err, ErrTokenValidation)
@@ -132,22 +498,786 @@ func (a *authCommandsImpl) RefreshToken(ctx context.Context, refreshToken string
 		return nil, ErrUserInactive
 	}
 
-	accessToken, err := a.jwtService.GenerateAccessToken(claims.UserID, role)
+	issued, err := shared.IssuedTokenFromClaims(claims)
 	if err != nil {
-		return nil, errs.Mark(err, ErrTokenGeneration)
+		return nil, errs.Mark(err, ErrTokenValidation)
 	}
+	tokenID := issued.ID
+
+	var pair *TokenPair
+	var reusedSession *uuid.UUID
+	err = a.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		now := a.clock.Now()
+		stored, findErr := a.tokens.FindForUpdate(ctx, tx.DB(), tokenID)
+		switch {
+		case infra.IsKind(findErr, infra.KindNotFound):
+			if claims.SessionID != nil {
+				return ErrTokenValidation
+			}
+			revoked, revokedErr := a.revocations.IsTokenRevoked(ctx, tx.DB(), issued)
+			if revokedErr != nil {
+				return revokedErr
+			}
+			if revoked {
+				return ErrSessionRevoked
+			}
+			// Issued before rotation was tracked: honoured once by recording it as spent,
+			// so presenting it again counts as reuse.
+			sessionID := uuid.New()
+			if createErr := a.tokens.Create(ctx, tx.DB(), shared.RefreshToken{
+				ID:        tokenID,
+				SessionID: sessionID,
+				UserID:    claims.UserID,
+				ExpiresAt: claims.ExpiresAt.Time,
+				RotatedAt: &now,
+				CreatedAt: now,
+			}); createErr != nil {
+				return createErr
+			}
+			stored = &shared.RefreshToken{ID: tokenID, SessionID: sessionID, UserID: claims.UserID}
+		case findErr != nil:
+			return findErr
+		case stored.UserID != claims.UserID:
+			return ErrTokenValidation
+		case stored.RevokedAt != nil:
+			return ErrSessionRevoked
+		case stored.RotatedAt != nil:
+			if revokeErr := a.tokens.RevokeSession(ctx, tx.DB(), stored.SessionID, now); revokeErr != nil {
+				return revokeErr
+			}
+			client := reqctx.ClientInfo(ctx)
+			if auditErr := tx.Audit().Record(ctx, tx.DB(), shared.AuditEntry{
+				Action:     shared.AuditActionRefreshTokenReused,
+				TargetType: shared.AuditTargetUser,
+				TargetID:   stored.UserID.String(),
+				Metadata: map[string]any{
+					"session_id": stored.SessionID.String(),
+					"ip":         client.IP,
+					"user_agent": client.UserAgent,
+				},
+			}); auditErr != nil {
+				return auditErr
+			}
+			// Committed so the revocation sticks; the caller is refused below.
+			reusedSession = &stored.SessionID
+			return nil
+		default:
+			if markErr := a.tokens.MarkRotated(ctx, tx.DB(), stored.ID, now); markErr != nil {
+				return markErr
+			}
+		}
 
-	newRefreshToken, err := a.jwtService.GenerateRefreshToken(claims.UserID, role)
+		var issueErr error
+		pair, issueErr = issueTokens(ctx, tx.DB(), a.tokens, a.jwtService, claims.UserID, role, deviceKey, stored.SessionID, now)
+		return issueErr
+	})
 	if err != nil {
+		if errors.Is(err, ErrTokenValidation) || errors.Is(err, ErrSessionRevoked) {
+			return nil, err
+		}
 		return nil, errs.Mark(err, ErrTokenGeneration)
 	}
+	if reusedSession != nil {
+		slog.Warn("Spent refresh token presented again; session revoked", "user_id", claims.UserID, "session_id", *reusedSession)
+		return nil, ErrRefreshTokenReused
+	}
+
+	return pair, nil
+}
+
+func (a *authCommandsImpl) Logout(ctx context.Context, access shared.IssuedToken, refreshToken string) error {
+	revoke := []shared.IssuedToken{access}
+	// A refresh token that does not validate or belongs to someone else is not ours to revoke.
+	if claims, err := a.jwtService.ValidateToken(refreshToken); err == nil && claims.TokenType == jwt.TokenTypeRefresh && claims.UserID == access.UserID {
+		if refresh, rerr := shared.IssuedTokenFromClaims(claims); rerr == nil {
+			revoke = append(revoke, refresh)
+		}
+	}
+
+	err := a.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		now := a.clock.Now()
+		for _, token := range revoke {
+			var rerr error
+			if token.SessionID != nil {
+				rerr = a.tokens.RevokeSession(ctx, tx.DB(), *token.SessionID, now)
+			} else {
+				rerr = a.tokens.RevokeToken(ctx, tx.DB(), token, now)
+			}
+			if rerr != nil {
+				return rerr
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errs.Mark(err, ErrLogoutFailed)
+	}
+	return nil
+}
+
+func (a *authCommandsImpl) RevokeAllSessions(ctx context.Context, userID, actorID uuid.UUID) error {
+	err := a.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		if err := a.tokens.RevokeUser(ctx, tx.DB(), userID, a.clock.Now()); err != nil {
+			if infra.IsKind(err, infra.KindForeignKeyViolated) {
+				return errs.Mark(err, ErrUserNotFound)
+			}
+			return err
+		}
+		return tx.Audit().Record(ctx, tx.DB(), shared.AuditEntry{
+			ActorID:    &actorID,
+			Action:     shared.AuditActionSessionsRevoked,
+			TargetType: shared.AuditTargetUser,
+			TargetID:   userID.String(),
+		})
+	})
+	if err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			return err
+		}
+		return errs.Mark(err, ErrSessionRevocationFailed)
+	}
+	return nil
+}
+
+func (a *authCommandsImpl) RevokeSession(ctx context.Context, userID, sessionID uuid.UUID) error {
+	err := a.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		if err := a.tokens.RevokeUserSession(ctx, tx.DB(), userID, sessionID, a.clock.Now()); err != nil {
+			if infra.IsKind(err, infra.KindNotFound) {
+				return errs.Mark(err, ErrSessionNotFound)
+			}
+			return err
+		}
+		client := reqctx.ClientInfo(ctx)
+		return tx.Audit().Record(ctx, tx.DB(), shared.AuditEntry{
+			ActorID:    &userID,
+			Action:     shared.AuditActionSessionRevoked,
+			TargetType: shared.AuditTargetUser,
+			TargetID:   userID.String(),
+			Metadata: map[string]any{
+				"session_id": sessionID.String(),
+				"ip":         client.IP,
+				"user_agent": client.UserAgent,
+			},
+		})
+	})
+	if err != nil {
+		if errors.Is(err, ErrSessionNotFound) {
+			return err
+		}
+		return errs.Mark(err, ErrSessionRevocationFailed)
+	}
+	return nil
+}
+
+func (a *authCommandsImpl) UnlockLogin(ctx context.Context, userID, actorID uuid.UUID) error {
+	account, err := a.readStore.FindByID(ctx, a.uow.DB(ctx), userID)
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return errs.Mark(err, ErrUserNotFound)
+		}
+		return errs.Mark(err, ErrLoginUnlockFailed)
+	}
+
+	err = a.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		unlocked, unlockErr := a.failures.Unlock(ctx, tx.DB(), account.Email, a.clock.Now())
+		if unlockErr != nil {
+			return unlockErr
+		}
+		return tx.Audit().Record(ctx, tx.DB(), shared.AuditEntry{
+			ActorID:    &actorID,
+			Action:     shared.AuditActionAccountUnlocked,
+			TargetType: shared.AuditTargetUser,
+			TargetID:   userID.String(),
+			Metadata:   map[string]any{"lockouts": unlocked},
+		})
+	})
+	if err != nil {
+		return errs.Mark(err, ErrLoginUnlockFailed)
+	}
+	return nil
+}
+
+func (a *authCommandsImpl) Register(ctx context.Context, req reqdto.RegisterRequest) (*RegisterResult, error) {
+	if !a.registration.Enabled {
+		return nil, ErrRegistrationDisabled
+	}
+	email, err := user.NewEmail(req.Email)
+	if err != nil {
+		return nil, errs.Mark(err, ErrRegisterInvalidEmail)
+	}
+	pw, err := user.NewPassword(req.Password)
+	if err != nil {
+		return nil, errs.Mark(err, ErrWeakPassword)
+	}
+	passwordHash, err := password.HashPassword(pw.Value())
+	if err != nil {
+		return nil, errs.Mark(err, ErrRegistrationFailed)
+	}
+
+	now := a.clock.Now()
+	expiresAt := now.Add(a.registration.TTL)
+	var userID uuid.UUID
+	err = a.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		var cerr error
+		userID, cerr = tx.Users().CreateInactive(ctx, tx.DB(), sqlc.CreateUserParams{
+			Email:        email.Value(),
+			PasswordHash: passwordHash,
+			Role:         user.RoleViewer.String(),
+		})
+		if cerr != nil {
+			if infra.IsKind(cerr, infra.KindDuplicateKey) {
+				return errs.Mark(cerr, ErrRegisterEmailTaken)
+			}
+			return cerr
+		}
+
+		nonce := uuid.New()
+		if verr := a.verifications.Create(ctx, tx.DB(), userID, nonce, expiresAt, now); verr != nil {
+			return verr
+		}
+		return a.enqueueVerificationEmail(ctx, tx, userID, email.Value(), nonce, expiresAt)
+	})
+	if err != nil {
+		if errors.Is(err, ErrRegisterEmailTaken) {
+			return nil, err
+		}
+		return nil, errs.Mark(err, ErrRegistrationFailed)
+	}
+
+	return &RegisterResult{UserID: userID, Email: email.Value(), ExpiresAt: expiresAt}, nil
+}
+
+func (a *authCommandsImpl) VerifyEmail(ctx context.Context, token string) error {
+	now := a.clock.Now()
+	var claims verificationClaims
+	if _, err := a.signer.Verify(EmailVerificationTokenPurpose, token, now, &claims); err != nil {
+		if errors.Is(err, signedtoken.ErrExpiredToken) {
+			return errs.Mark(err, ErrVerificationExpired)
+		}
+		return errs.Mark(err, ErrInvalidVerification)
+	}
+
+	err := a.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		expiresAt, cerr := a.verifications.Consume(ctx, tx.DB(), claims.UserID, claims.Nonce)
+		if cerr != nil {
+			if infra.IsKind(cerr, infra.KindNotFound) {
+				return errs.Mark(cerr, ErrInvalidVerification)
+			}
+			return cerr
+		}
+		if !now.Before(expiresAt) {
+			return ErrVerificationExpired
+		}
+		return tx.Users().Activate(ctx, tx.DB(), claims.UserID)
+	})
+	if err != nil {
+		if errors.Is(err, ErrInvalidVerification) || errors.Is(err, ErrVerificationExpired) {
+			return err
+		}
+		return errs.Mark(err, ErrVerificationFailed)
+	}
+	return nil
+}
+
+func (a *authCommandsImpl) ChangePassword(ctx context.Context, userID uuid.UUID, req reqdto.ChangePasswordRequest, deviceKey string) (*TokenPair, error) {
+	deviceKey, err := a.resolveDeviceKey(deviceKey)
+	if err != nil {
+		return nil, err
+	}
+	pw, err := user.NewPassword(req.NewPassword)
+	if err != nil {
+		return nil, errs.Mark(err, ErrWeakPassword)
+	}
+	if req.NewPassword == req.CurrentPassword {
+		return nil, ErrPasswordUnchanged
+	}
+
+	account, err := a.readStore.FindByID(ctx, a.uow.DB(ctx), userID)
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return nil, errs.Mark(err, ErrUserNotFound)
+		}
+		return nil, errs.Mark(err, ErrPasswordChangeFailed)
+	}
+	if !account.IsActive {
+		return nil, ErrUserInactive
+	}
+	role, err := user.NewRole(account.Role)
+	if err != nil {
+		return nil, errs.Mark(err, ErrPasswordChangeFailed)
+	}
+	passwordHash, err := password.HashPassword(pw.Value())
+	if err != nil {
+		return nil, errs.Mark(err, ErrPasswordChangeFailed)
+	}
+
+	var pair *TokenPair
+	err = a.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		current, herr := tx.Users().PasswordHashForUpdate(ctx, tx.DB(), userID)
+		if herr != nil {
+			if infra.IsKind(herr, infra.KindNotFound) {
+				return errs.Mark(herr, ErrUserNotFound)
+			}
+			return herr
+		}
+		if cerr := password.ComparePassword(current, req.CurrentPassword); cerr != nil {
+			return errs.Mark(cerr, ErrCurrentPasswordWrong)
+		}
+		if serr := tx.Users().SetPassword(ctx, tx.DB(), userID, passwordHash); serr != nil {
+			return serr
+		}
+
+		now := a.clock.Now()
+		if rerr := a.tokens.RevokeUser(ctx, tx.DB(), userID, now); rerr != nil {
+			return rerr
+		}
+		if aerr := tx.Audit().Record(ctx, tx.DB(), shared.AuditEntry{
+			ActorID:    &userID,
+			Action:     shared.AuditActionPasswordChanged,
+			TargetType: shared.AuditTargetUser,
+			TargetID:   userID.String(),
+		}); aerr != nil {
+			return aerr
+		}
+
+		var ierr error
+		pair, ierr = issueTokens(ctx, tx.DB(), a.tokens, a.jwtService, userID, role, deviceKey, uuid.New(), now)
+		return ierr
+	})
+	if err != nil {
+		if errors.Is(err, ErrCurrentPasswordWrong) || errors.Is(err, ErrUserNotFound) {
+			return nil, err
+		}
+		return nil, errs.Mark(err, ErrPasswordChangeFailed)
+	}
+	return pair, nil
+}
+
+func (a *authCommandsImpl) VerifyMFA(ctx context.Context, req reqdto.VerifyMFARequest, deviceKey string) (*LoginResult, error) {
+	deviceKey, err := a.resolveDeviceKey(deviceKey)
+	if err != nil {
+		return nil, err
+	}
+	now := a.clock.Now()
+	var claims mfaClaims
+	if _, err := a.signer.Verify(MFAPendingTokenPurpose, req.MFAToken, now, &claims); err != nil {
+		if errors.Is(err, signedtoken.ErrExpiredToken) {
+			return nil, errs.Mark(err, ErrMFAChallengeExpired)
+		}
+		return nil, errs.Mark(err, ErrMFAChallengeInvalid)
+	}
+
+	account, err := a.readStore.FindByID(ctx, a.uow.DB(ctx), claims.UserID)
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return nil, errs.Mark(err, ErrUserNotFound)
+		}
+		return nil, errs.Mark(err, ErrMFAFailed)
+	}
+	if !account.IsActive {
+		return nil, ErrUserInactive
+	}
+	role, err := user.NewRole(account.Role)
+	if err != nil {
+		return nil, errs.Mark(err, ErrMFAFailed)
+	}
+
+	var pair *TokenPair
+	var rejected error
+	err = a.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		m, ferr := a.mfa.FindForUpdate(ctx, tx.DB(), claims.UserID)
+		if ferr != nil {
+			if infra.IsKind(ferr, infra.KindNotFound) {
+				return errs.Mark(ferr, ErrMFAChallengeInvalid)
+			}
+			return ferr
+		}
+		if !m.Enabled() {
+			// Two-factor authentication was turned off since the login; sign in again.
+			return ErrMFAChallengeInvalid
+		}
+		var cerr error
+		if rejected, cerr = a.checkMFACode(ctx, tx, m, req.Code, now); cerr != nil || rejected != nil {
+			// A rejected code is committed so it counts towards the lockout.
+			return cerr
+		}
+
+		var ierr error
+		pair, ierr = issueTokens(ctx, tx.DB(), a.tokens, a.jwtService, claims.UserID, role, deviceKey, uuid.New(), now)
+		return ierr
+	})
+	if err != nil {
+		if errors.Is(err, ErrMFAChallengeInvalid) {
+			return nil, err
+		}
+		return nil, errs.Mark(err, ErrMFAFailed)
+	}
+	if rejected != nil {
+		return nil, rejected
+	}
+	a.recordLogin(ctx, claims.UserID, deviceKey)
+
+	return &LoginResult{UserID: claims.UserID, TokenPair: pair}, nil
+}
+
+func (a *authCommandsImpl) EnrollMFA(ctx context.Context, userID uuid.UUID) (*MFAEnrollment, error) {
+	account, err := a.readStore.FindByID(ctx, a.uow.DB(ctx), userID)
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return nil, errs.Mark(err, ErrUserNotFound)
+		}
+		return nil, errs.Mark(err, ErrMFAFailed)
+	}
+	if !account.IsActive {
+		return nil, ErrUserInactive
+	}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, errs.Mark(err, ErrMFAFailed)
+	}
+
+	err = a.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		m, ferr := a.mfa.FindForUpdate(ctx, tx.DB(), userID)
+		switch {
+		case infra.IsKind(ferr, infra.KindNotFound):
+		case ferr != nil:
+			return ferr
+		case m.Enabled():
+			return ErrMFAAlreadyEnabled
+		}
+		return a.mfa.SavePending(ctx, tx.DB(), userID, secret, a.clock.Now())
+	})
+	if err != nil {
+		if errors.Is(err, ErrMFAAlreadyEnabled) {
+			return nil, err
+		}
+		return nil, errs.Mark(err, ErrMFAFailed)
+	}
+
+	return &MFAEnrollment{
+		Secret:          secret,
+		ProvisioningURI: totp.ProvisioningURI(a.mfaPolicy.Issuer, account.Email, secret),
+	}, nil
+}
+
+func (a *authCommandsImpl) EnableMFA(ctx context.Context, userID uuid.UUID, code string) ([]string, error) {
+	codes, hashes, err := generateRecoveryCodes(a.mfaPolicy.RecoveryCodes)
+	if err != nil {
+		return nil, errs.Mark(err, ErrMFAFailed)
+	}
+
+	err = a.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		m, ferr := a.mfa.FindForUpdate(ctx, tx.DB(), userID)
+		if ferr != nil {
+			if infra.IsKind(ferr, infra.KindNotFound) {
+				return errs.Mark(ferr, ErrMFANotEnrolled)
+			}
+			return ferr
+		}
+		if m.Enabled() {
+			return ErrMFAAlreadyEnabled
+		}
+		now := a.clock.Now()
+		step, verr := totp.Validate(m.Secret, normalizeMFACode(code), now, mfaSkew)
+		if verr != nil {
+			return errs.Mark(verr, ErrMFACodeInvalid)
+		}
+		if eerr := a.mfa.Enable(ctx, tx.DB(), userID, step, hashes, now); eerr != nil {
+			return eerr
+		}
+		return tx.Audit().Record(ctx, tx.DB(), shared.AuditEntry{
+			ActorID:    &userID,
+			Action:     shared.AuditActionTwoFactorEnabled,
+			TargetType: shared.AuditTargetUser,
+			TargetID:   userID.String(),
+		})
+	})
+	if err != nil {
+		if errors.Is(err, ErrMFANotEnrolled) || errors.Is(err, ErrMFAAlreadyEnabled) || errors.Is(err, ErrMFACodeInvalid) {
+			return nil, err
+		}
+		return nil, errs.Mark(err, ErrMFAFailed)
+	}
+	return codes, nil
+}
+
+func (a *authCommandsImpl) DisableMFA(ctx context.Context, userID uuid.UUID, code string) error {
+	var rejected error
+	err := a.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		m, ferr := a.mfa.FindForUpdate(ctx, tx.DB(), userID)
+		if ferr != nil {
+			if infra.IsKind(ferr, infra.KindNotFound) {
+				return errs.Mark(ferr, ErrMFANotEnabled)
+			}
+			return ferr
+		}
+		if !m.Enabled() {
+			return ErrMFANotEnabled
+		}
+		var cerr error
+		if rejected, cerr = a.checkMFACode(ctx, tx, m, code, a.clock.Now()); cerr != nil || rejected != nil {
+			return cerr
+		}
+
+		if derr := a.mfa.Delete(ctx, tx.DB(), userID); derr != nil {
+			return derr
+		}
+		return tx.Audit().Record(ctx, tx.DB(), shared.AuditEntry{
+			ActorID:    &userID,
+			Action:     shared.AuditActionTwoFactorDisabled,
+			TargetType: shared.AuditTargetUser,
+			TargetID:   userID.String(),
+		})
+	})
+	if err != nil {
+		if errors.Is(err, ErrMFANotEnabled) {
+			return err
+		}
+		return errs.Mark(err, ErrMFAFailed)
+	}
+	return rejected
+}
+
+// checkMFACode accepts a TOTP code of a step newer than the last one used, or an unused
+// recovery code, which it spends. A wrong code counts towards the lockout and is returned
+// as rejected; the caller commits the count before refusing.
+func (a *authCommandsImpl) checkMFACode(ctx context.Context, tx shared.Tx, m *shared.UserMFA, code string, now time.Time) (rejected error, err error) {
+	if m.Locked(now) {
+		return ErrMFALocked, nil
+	}
+
+	code = normalizeMFACode(code)
+	accepted := false
+	if len(code) == totp.Digits {
+		if step, verr := totp.Validate(m.Secret, code, now, mfaSkew); verr == nil && step > m.LastUsedStep {
+			m.LastUsedStep = step
+			accepted = true
+		}
+	} else if len(code) == recoveryCodeLength {
+		uerr := a.mfa.UseRecoveryCode(ctx, tx.DB(), m.UserID, hashRecoveryCode(code), now)
+		switch {
+		case uerr == nil:
+			if aerr := tx.Audit().Record(ctx, tx.DB(), shared.AuditEntry{
+				ActorID:    &m.UserID,
+				Action:     shared.AuditActionRecoveryCodeUsed,
+				TargetType: shared.AuditTargetUser,
+				TargetID:   m.UserID.String(),
+			}); aerr != nil {
+				return nil, aerr
+			}
+			accepted = true
+		case !infra.IsKind(uerr, infra.KindNotFound):
+			return nil, uerr
+		}
+	}
+
+	if accepted {
+		m.FailedAttempts, m.LockedUntil = 0, nil
+	} else {
+		m.FailedAttempts++
+		if m.FailedAttempts >= a.mfaPolicy.MaxAttempts {
+			until := now.Add(a.mfaPolicy.Lockout)
+			m.FailedAttempts, m.LockedUntil = 0, &until
+			slog.Warn("Two-factor authentication locked after repeated invalid codes", "user_id", m.UserID, "until", until)
+		}
+		rejected = ErrMFACodeInvalid
+	}
+	if serr := a.mfa.SaveAttempts(ctx, tx.DB(), *m); serr != nil {
+		return nil, serr
+	}
+	return rejected, nil
+}
+
+// newMFAChallenge signs the mfa_pending token a sign-in answers with for a user with
+// two-factor authentication.
+func newMFAChallenge(signer *signedtoken.Signer, policy MFAPolicy, userID uuid.UUID, now time.Time) (*MFAChallenge, error) {
+	expiresAt := now.Add(policy.ChallengeTTL)
+	token, err := signer.Sign(MFAPendingTokenPurpose, mfaClaims{UserID: userID}, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+	return &MFAChallenge{Token: token, ExpiresAt: expiresAt}, nil
+}
+
+// normalizeMFACode drops the separators people type or paste along with a code.
+func normalizeMFACode(code string) string {
+	return strings.ToLower(strings.NewReplacer(" ", "", "-", "").Replace(code))
+}
+
+// generateRecoveryCodes returns n codes formatted for display, e.g. "k3xq7-mzt2a", and
+// the hashes they are stored as.
+func generateRecoveryCodes(n int) (codes, hashes []string, err error) {
+	const alphabet = "abcdefghijklmnopqrstuvwxyz234567"
+	buf := make([]byte, recoveryCodeLength)
+	for range n {
+		if _, err := rand.Read(buf); err != nil {
+			return nil, nil, err
+		}
+		for i, b := range buf {
+			buf[i] = alphabet[int(b)%len(alphabet)]
+		}
+		code := string(buf)
+		codes = append(codes, code[:recoveryCodeLength/2]+"-"+code[recoveryCodeLength/2:])
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+	return codes, hashes, nil
+}
+
+// hashRecoveryCode hashes a normalized recovery code. Codes carry 50 random bits, so an
+// unsalted SHA-256 is enough to keep a database dump from revealing them.
+func hashRecoveryCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+func (a *authCommandsImpl) enqueueVerificationEmail(ctx context.Context, tx shared.Tx, userID uuid.UUID, email string, nonce uuid.UUID, expiresAt time.Time) error {
+	token, err := a.signer.Sign(EmailVerificationTokenPurpose, verificationClaims{UserID: userID, Nonce: nonce}, expiresAt)
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(map[string]any{
+		"user_id":    userID,
+		"email":      email,
+		"link":       a.verifyLink(token),
+		"expires_at": expiresAt,
+		"type":       NotificationTopicEmailVerification,
+	})
+	if err != nil {
+		return err
+	}
+
+	return tx.Notifications().CreateJob(ctx, tx.DB(), NotificationKindEmail, NotificationTopicEmailVerification, payload, a.clock.Now())
+}
+
+func (a *authCommandsImpl) verifyLink(token string) string {
+	u, err := url.Parse(a.registration.VerifyURL)
+	if err != nil {
+		return a.registration.VerifyURL + "?token=" + url.QueryEscape(token)
+	}
+	q := u.Query()
+	q.Set("token", token)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// issueTokens stores a new refresh token of sessionID, records the client it goes to on
+// the session and signs it together with an access token of the same session.
+func issueTokens(ctx context.Context, db sqlc.DBTX, tokens shared.RefreshTokenRepository, jwtService *jwt.Service, userID uuid.UUID, role user.Role, deviceKey string, sessionID uuid.UUID, now time.Time) (*TokenPair, error) {
+	tokenID := uuid.New()
+	expiresAt := now.Add(jwtService.GetRefreshTokenDuration())
+	err := tokens.Create(ctx, db, shared.RefreshToken{
+		ID:        tokenID,
+		SessionID: sessionID,
+		UserID:    userID,
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	client := reqctx.ClientInfo(ctx)
+	err = tokens.TouchSession(ctx, db, shared.Session{
+		ID:                sessionID,
+		UserID:            userID,
+		DeviceFingerprint: deviceFingerprint(deviceKey, client.UserAgent),
+		IPAddress:         client.IP,
+		UserAgent:         client.UserAgent,
+		SeenAt:            now,
+		ExpiresAt:         expiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, err := jwtService.GenerateAccessToken(userID, role, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	refreshToken, err := jwtService.GenerateRefreshToken(userID, role, deviceKey, tokenID, sessionID)
+	if err != nil {
+		return nil, err
+	}
 
 	return &TokenPair{
 		AccessToken:  accessToken,
-		RefreshToken: newRefreshToken,
+		RefreshToken: refreshToken,
 	}, nil
 }
 
+// recordDevice remembers the device the user logged in from and adds a security event
+// the first time it is seen. Clients are told apart by their device key when they send
+// one, by their user agent otherwise; with neither there is nothing to compare.
+func recordDevice(ctx context.Context, tx shared.Tx, userID uuid.UUID, deviceKey string, at time.Time) error {
+	client := reqctx.ClientInfo(ctx)
+	fingerprint := deviceFingerprint(deviceKey, client.UserAgent)
+	if fingerprint == "" {
+		return nil
+	}
+
+	isNew, err := tx.Users().TouchDevice(ctx, tx.DB(), userID, fingerprint, at)
+	if err != nil || !isNew {
+		return err
+	}
+	return tx.Audit().Record(ctx, tx.DB(), shared.AuditEntry{
+		ActorID:    &userID,
+		Action:     shared.AuditActionNewDeviceLogin,
+		TargetType: shared.AuditTargetUser,
+		TargetID:   userID.String(),
+		Metadata: map[string]any{
+			"ip":         client.IP,
+			"user_agent": client.UserAgent,
+		},
+	})
+}
+
+func deviceFingerprint(deviceKey, userAgent string) string {
+	var source string
+	switch {
+	case deviceKey != "":
+		source = "key:" + deviceKey
+	case userAgent != "":
+		source = "ua:" + userAgent
+	default:
+		return ""
+	}
+	sum := sha256.Sum256([]byte(source))
+	return hex.EncodeToString(sum[:])
+}
+
+// resolveDeviceKey drops the key when binding is off and enforces it when required.
+func (a *authCommandsImpl) resolveDeviceKey(deviceKey string) (string, error) {
+	switch a.bindingMode {
+	case DeviceBindingOff:
+		return "", nil
+	case DeviceBindingRequired:
+		if deviceKey == "" {
+			return "", ErrDeviceKeyRequired
+		}
+	}
+	return deviceKey, nil
+}
+
+func (a *authCommandsImpl) checkDeviceBinding(claims *jwt.Claims, deviceKey string) error {
+	if a.bindingMode == DeviceBindingOff {
+		return nil
+	}
+
+	if !claims.IsDeviceBound() {
+		if a.bindingMode == DeviceBindingRequired {
+			return errs.Mark(ErrDeviceMismatch, ErrTokenValidation)
+		}
+		// Legacy unbound token: accepted during migration, re-issued bound if a key was sent
+		return nil
+	}
+
+	if !claims.MatchesDevice(deviceKey) {
+		slog.Warn("refresh token presented from another device", "user_id", claims.UserID)
+		return errs.Mark(ErrDeviceMismatch, ErrTokenValidation)
+	}
+	return nil
+}
+
 func (a *authCommandsImpl) validateUser(ctx context.Context, credentials user.Credentials) (*queries.AuthorizedUserView, error) {
 	var userReadModel *queries.AuthorizedUserView
 	var hashedPassword string
@@ -0,0 +1,75 @@
+package commands
+
+import (
+	"context"
+	"net/http"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+)
+
+var (
+	ErrBillingSignatureInvalid = errs.NewCoded("BILLING_SIGNATURE_INVALID", "billing webhook signature invalid")
+	ErrBillingPayloadInvalid   = errs.NewCoded("BILLING_PAYLOAD_INVALID", "billing webhook payload invalid")
+	ErrBillingUnknownReference = errs.NewCoded("BILLING_UNKNOWN_REFERENCE", "billing webhook names an unknown company or plan")
+	ErrBillingWebhookFailed    = errs.New("billing webhook handling failed")
+)
+
+type BillingCommands interface {
+	// HandleWebhook applies one provider delivery. Each event is applied once: a retried
+	// delivery succeeds without changing anything, and a delivery older than the stored
+	// subscription leaves it as is.
+	HandleWebhook(ctx context.Context, payload []byte, header http.Header) error
+}
+
+type billingCommandsImpl struct {
+	uow      shared.UnitOfWork
+	provider shared.BillingProvider
+}
+
+func NewBillingCommands(uow shared.UnitOfWork, provider shared.BillingProvider) BillingCommands {
+	return &billingCommandsImpl{
+		uow:      uow,
+		provider: provider,
+	}
+}
+
+func (c *billingCommandsImpl) HandleWebhook(ctx context.Context, payload []byte, header http.Header) error {
+	if !c.provider.VerifyWebhook(payload, header) {
+		return ErrBillingSignatureInvalid
+	}
+	event, err := c.provider.ParseWebhook(payload)
+	if err != nil {
+		return errs.Mark(err, ErrBillingPayloadInvalid)
+	}
+	if sub := event.Subscription; sub != nil && !validSubscriptionStatus(sub.Status) {
+		return ErrBillingPayloadInvalid
+	}
+
+	err = c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		recorded, rerr := tx.Billing().RecordEvent(ctx, tx.DB(), event.ID, event.Type)
+		if rerr != nil || !recorded || event.Subscription == nil {
+			return rerr
+		}
+		if serr := tx.Billing().SyncSubscription(ctx, tx.DB(), *event.Subscription); serr != nil {
+			if infra.IsKind(serr, infra.KindForeignKeyViolated) {
+				return errs.Mark(serr, ErrBillingUnknownReference)
+			}
+			return serr
+		}
+		return nil
+	})
+	if err != nil {
+		return errs.Mark(err, ErrBillingWebhookFailed)
+	}
+	return nil
+}
+
+func validSubscriptionStatus(status string) bool {
+	switch status {
+	case shared.SubscriptionTrialing, shared.SubscriptionActive, shared.SubscriptionPastDue, shared.SubscriptionCanceled:
+		return true
+	}
+	return false
+}
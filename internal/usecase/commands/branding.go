@@ -0,0 +1,80 @@
+package commands
+
+import (
+	"context"
+	"strings"
+
+	reqdto "gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+const AuditActionBrandingSet = "company.branding_set"
+
+var (
+	ErrBrandingCompanyNotFound = errs.NewCoded("COMPANY_NOT_FOUND", "company not found")
+	ErrInvalidLogoURL          = errs.NewCoded("INVALID_LOGO_URL", "logo URL must use https")
+	ErrBrandingUpdateFailed    = errs.New("branding update failed")
+)
+
+// BrandingCommands sets how a company's transactional emails look.
+type BrandingCommands interface {
+	// Set replaces the company's branding; omitted fields fall back to the product defaults.
+	Set(ctx context.Context, companyID uuid.UUID, req reqdto.SetBrandingRequest, actorID uuid.UUID) error
+}
+
+type brandingCommandsImpl struct {
+	uow   shared.UnitOfWork
+	clock clock.Clock
+}
+
+func NewBrandingCommands(uow shared.UnitOfWork, clock clock.Clock) BrandingCommands {
+	return &brandingCommandsImpl{
+		uow:   uow,
+		clock: clock,
+	}
+}
+
+func (c *brandingCommandsImpl) Set(ctx context.Context, companyID uuid.UUID, req reqdto.SetBrandingRequest, actorID uuid.UUID) error {
+	branding := shared.CompanyBranding{
+		LogoURL:      strings.TrimSpace(req.LogoURL),
+		PrimaryColor: strings.ToLower(req.PrimaryColor),
+		AccentColor:  strings.ToLower(req.AccentColor),
+		ReplyTo:      strings.TrimSpace(req.ReplyTo),
+		Footer:       strings.TrimSpace(req.Footer),
+	}
+	// Mail clients block images served over plain http.
+	if branding.LogoURL != "" && !strings.HasPrefix(branding.LogoURL, "https://") {
+		return ErrInvalidLogoURL
+	}
+
+	err := c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		if err := tx.Companies().SetBranding(ctx, tx.DB(), companyID, branding, c.clock.Now()); err != nil {
+			if infra.IsKind(err, infra.KindForeignKeyViolated) {
+				return errs.Mark(err, ErrBrandingCompanyNotFound)
+			}
+			return err
+		}
+		return tx.Audit().Record(ctx, tx.DB(), shared.AuditEntry{
+			ActorID:    &actorID,
+			CompanyID:  &companyID,
+			Action:     AuditActionBrandingSet,
+			TargetType: auditTargetCompany,
+			TargetID:   companyID.String(),
+			Metadata: map[string]any{
+				"logoUrl":      branding.LogoURL,
+				"primaryColor": branding.PrimaryColor,
+				"accentColor":  branding.AccentColor,
+				"replyTo":      branding.ReplyTo,
+			},
+		})
+	})
+	if err != nil {
+		return errs.Mark(err, ErrBrandingUpdateFailed)
+	}
+	return nil
+}
@@ -0,0 +1,244 @@
+package commands
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"gin-clean-starter/internal/domain/resource"
+	"gin-clean-starter/internal/domain/user"
+	reqdto "gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/pkg/password"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/queries"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrCompanyRegistrationDisabled = errs.NewCoded("COMPANY_REGISTRATION_DISABLED", "company registration disabled")
+	ErrInvalidCompanyName          = errs.NewCoded("INVALID_COMPANY_NAME", "invalid company name")
+	ErrCompanyInvalidEmail         = errs.NewCoded("INVALID_EMAIL", "invalid admin email")
+	ErrCompanyWeakPassword         = errs.NewCoded("WEAK_PASSWORD", "admin password too weak")
+	ErrInvalidTimezone             = errs.NewCoded("INVALID_TIMEZONE", "invalid timezone")
+	ErrCompanyAlreadyExists        = errs.NewCoded("COMPANY_ALREADY_EXISTS", "company already exists")
+	ErrCompanyEmailTaken           = errs.NewCoded("EMAIL_ALREADY_REGISTERED", "email already registered")
+	ErrCompanyRegistrationFailed   = errs.New("company registration failed")
+	ErrCompanyNotFound             = errs.NewCoded("COMPANY_NOT_FOUND", "company not found")
+	ErrInvalidReviewWindow         = errs.NewCoded("INVALID_REVIEW_WINDOW", "review window closes before it opens")
+	ErrCompanySettingsUpdateFailed = errs.New("company settings update failed")
+)
+
+const AuditActionReviewWindowSet = "company.review_window_set"
+
+// CompanyPolicy holds the defaults applied to every newly registered workspace.
+type CompanyPolicy struct {
+	RegistrationEnabled bool
+	DefaultTimezone     string
+	DefaultLeadTimeMin  int32
+	SampleResources     []string
+	// DefaultPlan is the plan new companies subscribe to; empty leaves them unlimited.
+	DefaultPlan string
+}
+
+type RegisterCompanyResult struct {
+	CompanyID   uuid.UUID
+	CompanyName string
+	Timezone    string
+	AdminUserID uuid.UUID
+	AdminEmail  string
+	ResourceIDs []uuid.UUID
+}
+
+type CompanyCommands interface {
+	Register(ctx context.Context, req reqdto.RegisterCompanyRequest) (*RegisterCompanyResult, error)
+	// SetReviewWindow replaces the company's review window; nil fields use the defaults.
+	SetReviewWindow(ctx context.Context, companyID uuid.UUID, req reqdto.SetReviewWindowRequest, actorID uuid.UUID) error
+}
+
+type companyCommandsImpl struct {
+	uow       shared.UnitOfWork
+	clock     clock.Clock
+	users     queries.UserReadStore
+	referrals shared.ReferralReadStore
+	plans     PlanGuard
+	policy    CompanyPolicy
+}
+
+func NewCompanyCommands(uow shared.UnitOfWork, clock clock.Clock, users queries.UserReadStore, referrals shared.ReferralReadStore, plans PlanGuard, policy CompanyPolicy) CompanyCommands {
+	return &companyCommandsImpl{
+		uow:       uow,
+		clock:     clock,
+		users:     users,
+		referrals: referrals,
+		plans:     plans,
+		policy:    policy,
+	}
+}
+
+// Register creates the company, its settings, its subscription to the default plan, the
+// owner and the sample resources in one transaction, so a failure leaves no partial
+// workspace behind. The owner operates
+// the sample resources instead of holding a global role that would reach other companies.
+func (c *companyCommandsImpl) Register(ctx context.Context, req reqdto.RegisterCompanyRequest) (*RegisterCompanyResult, error) {
+	if !c.policy.RegistrationEnabled {
+		return nil, ErrCompanyRegistrationDisabled
+	}
+
+	name := strings.TrimSpace(req.CompanyName)
+	if name == "" {
+		return nil, ErrInvalidCompanyName
+	}
+
+	email, err := user.NewEmail(req.AdminEmail)
+	if err != nil {
+		return nil, errs.Mark(err, ErrCompanyInvalidEmail)
+	}
+	pw, err := user.NewPassword(req.AdminPassword)
+	if err != nil {
+		return nil, errs.Mark(err, ErrCompanyWeakPassword)
+	}
+
+	timezone := req.Timezone
+	if timezone == "" {
+		timezone = c.policy.DefaultTimezone
+	}
+	if _, lerr := time.LoadLocation(timezone); lerr != nil {
+		return nil, errs.Mark(lerr, ErrInvalidTimezone)
+	}
+	referralCode, err := parseReferralCode(req.ReferralCode)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, _, ferr := c.users.FindByEmail(ctx, c.uow.DB(ctx), email.Value()); ferr == nil {
+		return nil, ErrCompanyEmailTaken
+	} else if !infra.IsKind(ferr, infra.KindNotFound) {
+		return nil, errs.Mark(ferr, ErrCompanyRegistrationFailed)
+	}
+
+	passwordHash, err := password.HashPassword(pw.Value())
+	if err != nil {
+		return nil, errs.Mark(err, ErrCompanyRegistrationFailed)
+	}
+
+	var result *RegisterCompanyResult
+	err = c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		companyID, cerr := tx.Companies().Create(ctx, tx.DB(), name)
+		if cerr != nil {
+			if infra.IsKind(cerr, infra.KindDuplicateKey) {
+				return errs.Mark(cerr, ErrCompanyAlreadyExists)
+			}
+			return cerr
+		}
+
+		if serr := tx.Companies().CreateSettings(ctx, tx.DB(), sqlc.CreateCompanySettingsParams{
+			CompanyID:          companyID,
+			Timezone:           timezone,
+			DefaultLeadTimeMin: c.policy.DefaultLeadTimeMin,
+		}); serr != nil {
+			return serr
+		}
+		if c.policy.DefaultPlan != "" {
+			if berr := tx.Billing().CreateSubscription(ctx, tx.DB(), companyID, c.policy.DefaultPlan, shared.SubscriptionActive); berr != nil {
+				return berr
+			}
+		}
+
+		adminID, uerr := tx.Users().Create(ctx, tx.DB(), sqlc.CreateUserParams{
+			Email:        email.Value(),
+			PasswordHash: passwordHash,
+			Role:         user.RoleOwner.String(),
+			CompanyID:    pgconv.UUIDToPgtype(companyID),
+		})
+		if uerr != nil {
+			if infra.IsKind(uerr, infra.KindDuplicateKey) {
+				return errs.Mark(uerr, ErrCompanyEmailTaken)
+			}
+			return uerr
+		}
+		if rerr := attributeReferral(ctx, tx, c.referrals, referralCode, adminID); rerr != nil {
+			return rerr
+		}
+
+		resources := make([]sqlc.CreateResourcesParams, 0, len(c.policy.SampleResources))
+		resourceIDs := make([]uuid.UUID, 0, len(c.policy.SampleResources))
+		for _, resourceName := range c.policy.SampleResources {
+			resourceName = strings.TrimSpace(resourceName)
+			if resourceName == "" {
+				continue
+			}
+			id := uuid.New()
+			resources = append(resources, sqlc.CreateResourcesParams{
+				ID:          id,
+				Name:        resourceName,
+				LeadTimeMin: c.policy.DefaultLeadTimeMin,
+				CompanyID:   pgconv.UUIDToPgtype(companyID),
+				Slug:        pgconv.StringToPgtype(resource.SlugFor(resourceName, id).String()),
+			})
+			resourceIDs = append(resourceIDs, id)
+		}
+		if perr := c.plans.CheckResources(ctx, tx.DB(), companyID, len(resources)); perr != nil {
+			return perr
+		}
+		if err := tx.Resources().CreateMany(ctx, tx.DB(), resources); err != nil {
+			return err
+		}
+		for _, id := range resourceIDs {
+			if err := tx.ResourceOperators().Assign(ctx, tx.DB(), id, adminID); err != nil {
+				return err
+			}
+		}
+
+		result = &RegisterCompanyResult{
+			CompanyID:   companyID,
+			CompanyName: name,
+			Timezone:    timezone,
+			AdminUserID: adminID,
+			AdminEmail:  email.Value(),
+			ResourceIDs: resourceIDs,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errs.Mark(err, ErrCompanyRegistrationFailed)
+	}
+	return result, nil
+}
+
+// SetReviewWindow only checks a window set in full: one that mixes a company value with a
+// default can still close before it opens, and then admits no review.
+func (c *companyCommandsImpl) SetReviewWindow(ctx context.Context, companyID uuid.UUID, req reqdto.SetReviewWindowRequest, actorID uuid.UUID) error {
+	if req.OpensAfterMinutes != nil && req.WindowDays != nil && int64(*req.WindowDays)*24*60 <= int64(*req.OpensAfterMinutes) {
+		return ErrInvalidReviewWindow
+	}
+	setting := shared.ReviewWindowSetting{OpensAfterMin: req.OpensAfterMinutes, WindowDays: req.WindowDays}
+	err := c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		if err := tx.Companies().SetReviewWindow(ctx, tx.DB(), companyID, setting, c.clock.Now()); err != nil {
+			if infra.IsKind(err, infra.KindForeignKeyViolated) {
+				return errs.Mark(err, ErrCompanyNotFound)
+			}
+			return err
+		}
+		return tx.Audit().Record(ctx, tx.DB(), shared.AuditEntry{
+			ActorID:    &actorID,
+			CompanyID:  &companyID,
+			Action:     AuditActionReviewWindowSet,
+			TargetType: auditTargetCompany,
+			TargetID:   companyID.String(),
+			Metadata: map[string]any{
+				"opensAfterMinutes": req.OpensAfterMinutes,
+				"windowDays":        req.WindowDays,
+			},
+		})
+	})
+	if err != nil {
+		return errs.Mark(err, ErrCompanySettingsUpdateFailed)
+	}
+	return nil
+}
@@ -0,0 +1,377 @@
+package commands
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+const (
+	AuditActionCompanyDeletionRequested = "company.deletion_requested"
+	AuditActionCompanyDeleted           = "company.deleted"
+
+	// CompanyDeletionCertificateVersion is bumped whenever the certificate changes shape.
+	CompanyDeletionCertificateVersion = 1
+
+	companyDeletionReason = "company_deleted"
+)
+
+var (
+	ErrCompanyDeletionInProgress  = errs.NewCoded("COMPANY_DELETION_IN_PROGRESS", "a deletion of this company is already in progress")
+	ErrCompanyDeletionUnconfirmed = errs.NewCoded("COMPANY_DELETION_UNCONFIRMED", "confirmation does not match the company name")
+	ErrCompanyDeletionOwnCompany  = errs.NewCoded("COMPANY_DELETION_OWN_COMPANY", "cannot delete the company you belong to")
+	ErrCompanyDeletionFailed      = errs.New("company deletion failed")
+)
+
+// CompanyDeletionPolicy: rows are purged BatchSize at a time, each batch in its own
+// transaction; a running deletion without a checkpoint for StaleAfter is taken over by
+// the next run.
+type CompanyDeletionPolicy struct {
+	StaleAfter time.Duration
+	BatchSize  int32
+}
+
+type CompanyDeletionCommands interface {
+	// Request locks the company out at once and queues the purge of everything it holds.
+	// confirm must repeat the company's name; staff cannot delete their own company.
+	Request(ctx context.Context, companyID, actorID uuid.UUID, confirm string) (*shared.CompanyDeletion, error)
+	// Run purges queued deletions until none is left and returns how many it finished. A
+	// deletion that fails stops the run and is retried from its last checkpoint next time.
+	Run(ctx context.Context) (int, error)
+}
+
+type companyDeletionCommandsImpl struct {
+	uow     shared.UnitOfWork
+	repo    shared.CompanyDeletionRepository
+	storage shared.FileStorage
+	clock   clock.Clock
+	policy  CompanyDeletionPolicy
+}
+
+func NewCompanyDeletionCommands(
+	uow shared.UnitOfWork,
+	repo shared.CompanyDeletionRepository,
+	storage shared.FileStorage,
+	clock clock.Clock,
+	policy CompanyDeletionPolicy,
+) CompanyDeletionCommands {
+	return &companyDeletionCommandsImpl{
+		uow:     uow,
+		repo:    repo,
+		storage: storage,
+		clock:   clock,
+		policy:  policy,
+	}
+}
+
+func (c *companyDeletionCommandsImpl) Request(ctx context.Context, companyID, actorID uuid.UUID, confirm string) (*shared.CompanyDeletion, error) {
+	deletion := shared.CompanyDeletion{
+		ID:          uuid.New(),
+		CompanyID:   companyID,
+		RequestedBy: &actorID,
+		Stage:       shared.CompanyDeletionStages[0],
+		CreatedAt:   c.clock.Now(),
+	}
+
+	err := c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		target, err := c.repo.FindTarget(ctx, tx.DB(), companyID, actorID)
+		if err != nil {
+			if infra.IsKind(err, infra.KindNotFound) {
+				return errs.Mark(err, ErrCompanyNotFound)
+			}
+			return err
+		}
+		if target.ActorIsMember {
+			return ErrCompanyDeletionOwnCompany
+		}
+		if strings.TrimSpace(confirm) != target.Name {
+			return ErrCompanyDeletionUnconfirmed
+		}
+		deletion.CompanyName = target.Name
+
+		deactivated, err := c.repo.Lock(ctx, tx.DB(), companyID)
+		if err != nil {
+			return err
+		}
+		deletion.Counts = map[string]int64{"users_deactivated": deactivated}
+		if err := c.repo.Create(ctx, tx.DB(), deletion); err != nil {
+			if infra.IsKind(err, infra.KindDuplicateKey) {
+				return errs.Mark(err, ErrCompanyDeletionInProgress)
+			}
+			return err
+		}
+		return tx.Audit().Record(ctx, tx.DB(), shared.AuditEntry{
+			ActorID:    &actorID,
+			Action:     AuditActionCompanyDeletionRequested,
+			TargetType: auditTargetCompany,
+			TargetID:   companyID.String(),
+			Metadata: map[string]any{
+				"deletion_id":       deletion.ID,
+				"company_name":      deletion.CompanyName,
+				"users_deactivated": deactivated,
+			},
+		})
+	})
+	if err != nil {
+		return nil, errs.Mark(err, ErrCompanyDeletionFailed)
+	}
+	return &deletion, nil
+}
+
+func (c *companyDeletionCommandsImpl) Run(ctx context.Context) (int, error) {
+	finished := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return finished, err
+		}
+
+		now := c.clock.Now()
+		deletion, err := c.repo.Claim(ctx, c.uow.DB(ctx), now, now.Add(-c.policy.StaleAfter))
+		if err != nil {
+			if infra.IsKind(err, infra.KindNotFound) {
+				return finished, nil
+			}
+			return finished, errs.Mark(err, ErrCompanyDeletionFailed)
+		}
+
+		if err := c.purge(ctx, deletion); err != nil {
+			// A cancelled run leaves the deletion running; it is taken over once stale
+			if ctx.Err() != nil {
+				return finished, ctx.Err()
+			}
+			slog.Error("Company deletion stopped", "deletion_id", deletion.ID, "company_id", deletion.CompanyID,
+				"stage", deletion.Stage, "error", err.Error())
+			reason := "purge stopped at stage " + string(deletion.Stage)
+			if rerr := c.repo.Release(ctx, c.uow.DB(ctx), deletion.ID, reason, c.clock.Now()); rerr != nil {
+				slog.Error("Failed to release company deletion", "deletion_id", deletion.ID, "error", rerr.Error())
+			}
+			return finished, errs.Mark(err, ErrCompanyDeletionFailed)
+		}
+		finished++
+	}
+}
+
+// purge runs the stages from the one the deletion reached, then certifies it.
+func (c *companyDeletionCommandsImpl) purge(ctx context.Context, deletion *shared.CompanyDeletion) error {
+	start := slices.Index(shared.CompanyDeletionStages, deletion.Stage)
+	if start < 0 {
+		start = len(shared.CompanyDeletionStages)
+	}
+	for i := start; i < len(shared.CompanyDeletionStages); i++ {
+		deletion.Stage = shared.CompanyDeletionStages[i]
+		next := shared.CompanyDeletionDone
+		if i+1 < len(shared.CompanyDeletionStages) {
+			next = shared.CompanyDeletionStages[i+1]
+		}
+		if err := c.runStage(ctx, deletion, next); err != nil {
+			return err
+		}
+	}
+	return c.certify(ctx, deletion)
+}
+
+// runStage repeats batches of the deletion's stage until one comes back short, and
+// checkpoints after every batch in the batch's transaction: the stage while batches
+// remain, next once the stage is through.
+func (c *companyDeletionCommandsImpl) runStage(ctx context.Context, deletion *shared.CompanyDeletion, next shared.CompanyDeletionStage) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var counts map[string]int64
+		var more bool
+		err := c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+			counts = maps.Clone(deletion.Counts)
+			var err error
+			more, err = c.batch(ctx, tx, deletion, counts)
+			if err != nil {
+				return err
+			}
+			reached := next
+			if more {
+				reached = deletion.Stage
+			}
+			return c.repo.Checkpoint(ctx, tx.DB(), deletion.ID, reached, counts, c.clock.Now())
+		})
+		if err != nil {
+			return err
+		}
+		deletion.Counts = counts
+		if !more {
+			return nil
+		}
+	}
+}
+
+// batch purges one batch of the deletion's stage, adding what it did to counts, and
+// reports whether the stage has more to do.
+func (c *companyDeletionCommandsImpl) batch(ctx context.Context, tx shared.Tx, deletion *shared.CompanyDeletion, counts map[string]int64) (bool, error) {
+	limit := c.policy.BatchSize
+	switch deletion.Stage {
+	case shared.CompanyDeletionCancelReservations:
+		n, err := c.cancelReservations(ctx, tx, deletion.CompanyID, counts)
+		return n == int(limit), err
+
+	case shared.CompanyDeletionAnonymizeReviews:
+		n, err := c.repo.AnonymizeReviews(ctx, tx.DB(), deletion.CompanyID, limit)
+		counts["reviews_anonymized"] += n
+		return n == int64(limit), err
+
+	case shared.CompanyDeletionPurgeStorage:
+		n, err := c.purgeFiles(ctx, tx, deletion.CompanyID, counts)
+		return n == int(limit), err
+
+	case shared.CompanyDeletionDeleteUsers:
+		n, err := c.repo.DeleteUsers(ctx, tx.DB(), deletion.CompanyID, limit)
+		counts["users_deleted"] += n
+		return n == int64(limit), err
+
+	case shared.CompanyDeletionDeleteCompany:
+		// Reservations booked and files attached since their stages ran go first
+		if n, err := c.cancelReservations(ctx, tx, deletion.CompanyID, counts); n > 0 || err != nil {
+			return true, err
+		}
+		if n, err := c.purgeFiles(ctx, tx, deletion.CompanyID, counts); n > 0 || err != nil {
+			return true, err
+		}
+		n, err := c.repo.DeleteResources(ctx, tx.DB(), deletion.CompanyID, limit)
+		counts["resources_deleted"] += n
+		if n > 0 || err != nil {
+			return true, err
+		}
+		return false, c.repo.DeleteCompany(ctx, tx.DB(), deletion.CompanyID)
+	}
+	return false, nil
+}
+
+// cancelReservations cancels a batch of upcoming reservations and emails the users
+// outside the company about theirs, putting the cancellation on their timelines.
+func (c *companyDeletionCommandsImpl) cancelReservations(ctx context.Context, tx shared.Tx, companyID uuid.UUID, counts map[string]int64) (int, error) {
+	now := c.clock.Now()
+	canceled, err := c.repo.CancelReservations(ctx, tx.DB(), companyID, now, c.policy.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	for _, r := range canceled {
+		if r.Member || r.UserID == shared.DeletedUserID {
+			continue
+		}
+		body, err := json.Marshal(map[string]any{
+			"type":           NotificationTopicReservationCanceled,
+			"user_id":        r.UserID,
+			"resource_id":    r.ResourceID,
+			"reservation_id": r.ID,
+			"reason":         companyDeletionReason,
+		})
+		if err != nil {
+			return 0, err
+		}
+		if err := tx.Notifications().CreateJob(ctx, tx.DB(), NotificationKindEmail, NotificationTopicReservationCanceled, body, now); err != nil {
+			return 0, err
+		}
+		err = tx.Activity().Record(ctx, tx.DB(), shared.ActivityEntry{
+			UserID:     r.UserID,
+			Kind:       shared.ActivityReservationCanceled,
+			SubjectID:  r.ID,
+			ResourceID: &r.ResourceID,
+			OccurredAt: now,
+		})
+		if err != nil {
+			return 0, err
+		}
+		counts["cancellation_emails"]++
+	}
+	counts["reservations_canceled"] += int64(len(canceled))
+	return len(canceled), nil
+}
+
+// purgeFiles deletes a batch of the company's files from storage, then the rows that
+// refer to them. Deleting a missing file succeeds, so a batch whose transaction fails is
+// simply listed again.
+func (c *companyDeletionCommandsImpl) purgeFiles(ctx context.Context, tx shared.Tx, companyID uuid.UUID, counts map[string]int64) (int, error) {
+	keys, err := c.repo.ListObjects(ctx, tx.DB(), companyID, c.policy.BatchSize)
+	if err != nil || len(keys) == 0 {
+		return 0, err
+	}
+	for _, key := range keys {
+		if err := c.storage.Delete(ctx, key); err != nil {
+			return 0, err
+		}
+	}
+	if err := c.repo.DeleteObjects(ctx, tx.DB(), keys); err != nil {
+		return 0, err
+	}
+	counts["files_deleted"] += int64(len(keys))
+	return len(keys), nil
+}
+
+type companyDeletionCertificate struct {
+	FormatVersion int              `json:"format_version"`
+	DeletionID    uuid.UUID        `json:"deletion_id"`
+	CompanyID     uuid.UUID        `json:"company_id"`
+	CompanyName   string           `json:"company_name"`
+	RequestedBy   *uuid.UUID       `json:"requested_by"`
+	RequestedAt   time.Time        `json:"requested_at"`
+	StartedAt     time.Time        `json:"started_at"`
+	CompletedAt   time.Time        `json:"completed_at"`
+	Counts        map[string]int64 `json:"counts"`
+	SHA256        string           `json:"sha256,omitempty"`
+}
+
+// certify completes the deletion with its certificate and records the certificate in
+// the audit trail. SHA256 is the digest of the certificate encoded without it, so a copy
+// kept outside the system can be checked against the audit entry.
+func (c *companyDeletionCommandsImpl) certify(ctx context.Context, deletion *shared.CompanyDeletion) error {
+	cert := companyDeletionCertificate{
+		FormatVersion: CompanyDeletionCertificateVersion,
+		DeletionID:    deletion.ID,
+		CompanyID:     deletion.CompanyID,
+		CompanyName:   deletion.CompanyName,
+		RequestedBy:   deletion.RequestedBy,
+		RequestedAt:   deletion.CreatedAt.UTC(),
+		StartedAt:     deletion.StartedAt.UTC(),
+		CompletedAt:   c.clock.Now().UTC(),
+		Counts:        deletion.Counts,
+	}
+	unsigned, err := json.Marshal(cert)
+	if err != nil {
+		return err
+	}
+	sum := sha256.Sum256(unsigned)
+	cert.SHA256 = hex.EncodeToString(sum[:])
+	raw, err := json.Marshal(cert)
+	if err != nil {
+		return err
+	}
+	var metadata map[string]any
+	if err := json.Unmarshal(raw, &metadata); err != nil {
+		return err
+	}
+
+	return c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		if err := c.repo.Complete(ctx, tx.DB(), deletion.ID, raw, cert.CompletedAt); err != nil {
+			return err
+		}
+		return tx.Audit().Record(ctx, tx.DB(), shared.AuditEntry{
+			ActorID:    deletion.RequestedBy,
+			Action:     AuditActionCompanyDeleted,
+			TargetType: auditTargetCompany,
+			TargetID:   deletion.CompanyID.String(),
+			Metadata:   metadata,
+		})
+	})
+}
@@ -0,0 +1,338 @@
+package commands
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+const (
+	AuditActionCompanyExportRequested = "company.export_requested"
+
+	// CompanyExportFormatVersion is bumped whenever a file of the archive changes shape.
+	CompanyExportFormatVersion = 1
+
+	companyExportFailure = "archive could not be built"
+)
+
+var (
+	ErrCompanyExportInProgress = errs.NewCoded("COMPANY_EXPORT_IN_PROGRESS", "an export of this company is already in progress")
+	ErrCompanyExportFailed     = errs.New("company export failed")
+)
+
+// CompanyExportPolicy: archives and failed exports are kept for TTL after they finish; a
+// running export not finished StaleAfter after it started is started over. Rows are
+// read, and expired exports purged, BatchSize at a time.
+type CompanyExportPolicy struct {
+	TTL        time.Duration
+	StaleAfter time.Duration
+	BatchSize  int32
+}
+
+type CompanyExportCommands interface {
+	// Request queues an export of everything the company owns and records it in the audit
+	// trail; a company has at most one export pending or running.
+	Request(ctx context.Context, companyID, actorID uuid.UUID) (*shared.CompanyExport, error)
+	// Run builds archives for queued exports until none is left and returns how many it
+	// finished, failed ones included.
+	Run(ctx context.Context) (int, error)
+	// Purge deletes expired exports with their archives and returns how many it deleted.
+	Purge(ctx context.Context) (int, error)
+}
+
+type companyExportCommandsImpl struct {
+	uow       shared.UnitOfWork
+	readStore shared.CompanyExportReadStore
+	repo      shared.CompanyExportRepository
+	storage   shared.FileStorage
+	clock     clock.Clock
+	policy    CompanyExportPolicy
+}
+
+func NewCompanyExportCommands(
+	uow shared.UnitOfWork,
+	readStore shared.CompanyExportReadStore,
+	repo shared.CompanyExportRepository,
+	storage shared.FileStorage,
+	clock clock.Clock,
+	policy CompanyExportPolicy,
+) CompanyExportCommands {
+	return &companyExportCommandsImpl{
+		uow:       uow,
+		readStore: readStore,
+		repo:      repo,
+		storage:   storage,
+		clock:     clock,
+		policy:    policy,
+	}
+}
+
+func (c *companyExportCommandsImpl) Request(ctx context.Context, companyID, actorID uuid.UUID) (*shared.CompanyExport, error) {
+	export := shared.CompanyExport{
+		ID:          uuid.New(),
+		CompanyID:   companyID,
+		RequestedBy: actorID,
+		CreatedAt:   c.clock.Now(),
+	}
+
+	err := c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		if err := c.repo.Create(ctx, tx.DB(), export); err != nil {
+			if infra.IsKind(err, infra.KindDuplicateKey) {
+				return errs.Mark(err, ErrCompanyExportInProgress)
+			}
+			if infra.IsKind(err, infra.KindForeignKeyViolated) {
+				return errs.Mark(err, ErrCompanyNotFound)
+			}
+			return err
+		}
+		return tx.Audit().Record(ctx, tx.DB(), shared.AuditEntry{
+			ActorID:    &actorID,
+			Action:     AuditActionCompanyExportRequested,
+			TargetType: auditTargetCompany,
+			TargetID:   companyID.String(),
+			Metadata: map[string]any{
+				"export_id": export.ID,
+			},
+		})
+	})
+	if err != nil {
+		return nil, errs.Mark(err, ErrCompanyExportFailed)
+	}
+	return &export, nil
+}
+
+// Run handles one export at a time; an archive that cannot be built marks its export
+// failed and the run moves on to the next.
+func (c *companyExportCommandsImpl) Run(ctx context.Context) (int, error) {
+	finished := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return finished, err
+		}
+
+		now := c.clock.Now()
+		export, err := c.repo.Claim(ctx, c.uow.DB(ctx), now, now.Add(-c.policy.StaleAfter))
+		if err != nil {
+			if infra.IsKind(err, infra.KindNotFound) {
+				return finished, nil
+			}
+			return finished, errs.Mark(err, ErrCompanyExportFailed)
+		}
+
+		if err := c.export(ctx, export); err != nil {
+			return finished, errs.Mark(err, ErrCompanyExportFailed)
+		}
+		finished++
+	}
+}
+
+func (c *companyExportCommandsImpl) export(ctx context.Context, export *shared.CompanyExport) error {
+	key := "company-exports/" + export.ID.String() + ".zip"
+	size, err := c.putArchive(ctx, key, export)
+	if err != nil {
+		// A cancelled run leaves the export running; it is picked up again once stale
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		slog.Error("Failed to build company export", "export_id", export.ID, "company_id", export.CompanyID, "error", err.Error())
+		if derr := c.storage.Delete(ctx, key); derr != nil {
+			slog.Error("Failed to remove partial company export", "storage_key", key, "error", derr.Error())
+		}
+		now := c.clock.Now()
+		return c.repo.Fail(ctx, c.uow.DB(ctx), export.ID, companyExportFailure, now, now.Add(c.policy.TTL))
+	}
+
+	now := c.clock.Now()
+	return c.repo.Complete(ctx, c.uow.DB(ctx), export.ID, key, size, now, now.Add(c.policy.TTL))
+}
+
+// putArchive streams the zip into storage as it is written and returns its size.
+func (c *companyExportCommandsImpl) putArchive(ctx context.Context, key string, export *shared.CompanyExport) (int64, error) {
+	pr, pw := io.Pipe()
+	out := &countingWriter{w: pw}
+	go func() {
+		pw.CloseWithError(c.writeArchive(ctx, out, export))
+	}()
+
+	if err := c.storage.Put(ctx, key, pr); err != nil {
+		pr.CloseWithError(err)
+		return 0, err
+	}
+	return out.n, nil
+}
+
+type companyExportManifest struct {
+	FormatVersion int                              `json:"format_version"`
+	ExportID      uuid.UUID                        `json:"export_id"`
+	CompanyID     uuid.UUID                        `json:"company_id"`
+	GeneratedAt   time.Time                        `json:"generated_at"`
+	Files         map[string]companyExportFileInfo `json:"files"`
+}
+
+type companyExportFileInfo struct {
+	Rows int64 `json:"rows"`
+}
+
+// writeArchive writes company.json, one JSON Lines file per section and manifest.json
+// last, listing the rows actually written. Progress is saved after every batch and
+// stays below 100 until the export completes.
+func (c *companyExportCommandsImpl) writeArchive(ctx context.Context, w io.Writer, export *shared.CompanyExport) error {
+	db := c.uow.DB(ctx)
+	counts, err := c.readStore.CountRows(ctx, db, export.CompanyID)
+	if err != nil {
+		return err
+	}
+	var total int64
+	for _, n := range counts {
+		total += n
+	}
+
+	zw := zip.NewWriter(w)
+	manifest := companyExportManifest{
+		FormatVersion: CompanyExportFormatVersion,
+		ExportID:      export.ID,
+		CompanyID:     export.CompanyID,
+		GeneratedAt:   c.clock.Now().UTC(),
+		Files:         map[string]companyExportFileInfo{},
+	}
+
+	profile, err := c.readStore.FindProfile(ctx, db, export.CompanyID)
+	if err != nil {
+		return err
+	}
+	if err := writeZipFile(zw, "company.json", profile); err != nil {
+		return err
+	}
+	manifest.Files["company.json"] = companyExportFileInfo{Rows: 1}
+
+	var written int64
+	progress := int32(0)
+	for _, section := range shared.CompanyExportSections {
+		name := string(section) + ".jsonl"
+		f, err := zw.Create(name)
+		if err != nil {
+			return err
+		}
+		var rows int64
+		afterID := uuid.Nil
+		for {
+			records, err := c.readStore.ListSection(ctx, db, section, export.CompanyID, afterID, c.policy.BatchSize)
+			if err != nil {
+				return err
+			}
+			for _, rec := range records {
+				if _, err := f.Write(rec.Doc); err != nil {
+					return err
+				}
+				if _, err := io.WriteString(f, "\n"); err != nil {
+					return err
+				}
+			}
+			rows += int64(len(records))
+			written += int64(len(records))
+
+			if p := exportProgress(written, total); p != progress {
+				progress = p
+				if err := c.repo.SetProgress(ctx, db, export.ID, progress); err != nil {
+					return err
+				}
+			}
+			if int32(len(records)) < c.policy.BatchSize {
+				break
+			}
+			afterID = records[len(records)-1].ID
+		}
+		manifest.Files[name] = companyExportFileInfo{Rows: rows}
+	}
+
+	raw, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := writeZipFile(zw, "manifest.json", raw); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+// exportProgress is written/total as a percentage capped at 99; rows added while the
+// export runs can push written past the initial count.
+func exportProgress(written, total int64) int32 {
+	if total <= 0 {
+		return 0
+	}
+	return int32(min(written*100/total, 99))
+}
+
+func writeZipFile(zw *zip.Writer, name string, content []byte) error {
+	f, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(content)
+	return err
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.n += int64(n)
+	return n, err
+}
+
+// Purge works in batches of expired exports, each deleted in its own transaction.
+func (c *companyExportCommandsImpl) Purge(ctx context.Context) (int, error) {
+	deleted := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return deleted, err
+		}
+
+		ids, err := c.readStore.ListExpired(ctx, c.uow.DB(ctx), c.clock.Now(), c.policy.BatchSize)
+		if err != nil {
+			return deleted, errs.Mark(err, ErrCompanyExportFailed)
+		}
+
+		for _, id := range ids {
+			var key string
+			err = c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+				var derr error
+				key, derr = c.repo.Delete(ctx, tx.DB(), id)
+				return derr
+			})
+			if err != nil && !infra.IsKind(err, infra.KindNotFound) {
+				return deleted, errs.Mark(err, ErrCompanyExportFailed)
+			}
+			if err != nil {
+				continue
+			}
+			deleted++
+			if key == "" {
+				continue
+			}
+			// Removed after commit: a failed removal leaves an unreferenced file, never a
+			// download link without content
+			if err := c.storage.Delete(context.WithoutCancel(ctx), key); err != nil {
+				slog.Error("Failed to remove company export", "storage_key", key, "error", err.Error())
+			}
+		}
+
+		if int32(len(ids)) < c.policy.BatchSize {
+			return deleted, nil
+		}
+	}
+}
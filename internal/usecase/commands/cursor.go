@@ -0,0 +1,114 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+const (
+	AuditActionCursorsRepaired = "maintenance.cursors_repaired"
+	auditTargetCursor          = "cursor"
+)
+
+var ErrCursorCheckFailed = errs.New("cursor check failed")
+
+// CursorCheckPolicy sets how far ahead of the clock a stored position may be before it
+// counts as invalid; the slack covers clock skew between instances.
+type CursorCheckPolicy struct {
+	ClockSkew time.Duration
+}
+
+type CursorCheckResult struct {
+	Cursor   string
+	Invalid  int64
+	Repaired int64
+}
+
+// CursorCommands validates the positions event processing resumes from (shared.Cursors),
+// typically after a schema or data migration.
+type CursorCommands interface {
+	// Check counts invalid positions per cursor; with repair it also fixes them, one
+	// transaction and audit entry per cursor. actorID is nil for the background job.
+	Check(ctx context.Context, repair bool, actorID *uuid.UUID) ([]CursorCheckResult, error)
+}
+
+type cursorCommandsImpl struct {
+	uow    shared.UnitOfWork
+	repo   shared.CursorRepository
+	policy CursorCheckPolicy
+	clock  clock.Clock
+}
+
+func NewCursorCommands(uow shared.UnitOfWork, repo shared.CursorRepository, policy CursorCheckPolicy, clock clock.Clock) CursorCommands {
+	return &cursorCommandsImpl{
+		uow:    uow,
+		repo:   repo,
+		policy: policy,
+		clock:  clock,
+	}
+}
+
+func (c *cursorCommandsImpl) Check(ctx context.Context, repair bool, actorID *uuid.UUID) ([]CursorCheckResult, error) {
+	now := c.clock.Now()
+	limit := now.Add(c.policy.ClockSkew)
+	results := make([]CursorCheckResult, 0, len(shared.Cursors))
+
+	for _, cursor := range shared.Cursors {
+		result, err := c.checkCursor(ctx, cursor, repair, actorID, limit, now)
+		results = append(results, result)
+		if err != nil {
+			return results, errs.Mark(err, ErrCursorCheckFailed)
+		}
+	}
+
+	return results, nil
+}
+
+func (c *cursorCommandsImpl) checkCursor(ctx context.Context, cursor string, repair bool, actorID *uuid.UUID, limit, now time.Time) (CursorCheckResult, error) {
+	result := CursorCheckResult{Cursor: cursor}
+
+	invalid, err := c.repo.CountInvalid(ctx, c.uow.DB(ctx), cursor, limit)
+	if err != nil {
+		return result, err
+	}
+	result.Invalid = invalid
+	if invalid == 0 {
+		return result, nil
+	}
+	if !repair {
+		slog.Warn("Stored cursors ahead of the clock", "cursor", cursor, "invalid", invalid, "limit", limit)
+		return result, nil
+	}
+
+	err = c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		repaired, err := c.repo.Repair(ctx, tx.DB(), cursor, limit, now)
+		if err != nil {
+			return err
+		}
+		result.Repaired = repaired
+		return tx.Audit().Record(ctx, tx.DB(), shared.AuditEntry{
+			ActorID:    actorID,
+			Action:     AuditActionCursorsRepaired,
+			TargetType: auditTargetCursor,
+			TargetID:   cursor,
+			Metadata: map[string]any{
+				"repaired": repaired,
+				"limit":    limit,
+			},
+		})
+	})
+	if err != nil {
+		result.Repaired = 0
+		return result, err
+	}
+	slog.Info("Stored cursors repaired", "cursor", cursor, "repaired", result.Repaired)
+
+	return result, nil
+}
@@ -0,0 +1,162 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gin-clean-starter/internal/domain/reservation"
+	reqdto "gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+const (
+	AuditActionCustomFieldCreated = "custom_field.created"
+	AuditActionCustomFieldDeleted = "custom_field.deleted"
+
+	auditTargetCustomField = "custom_field"
+)
+
+var (
+	ErrCustomFieldInvalid          = errs.NewCoded("CUSTOM_FIELD_INVALID", "invalid custom field definition")
+	ErrCustomFieldKeyTaken         = errs.NewCoded("CUSTOM_FIELD_KEY_TAKEN", "company already has a custom field with this key")
+	ErrCustomFieldResourceNotFound = errs.NewCoded("RESOURCE_NOT_FOUND", "resource not found in company")
+	ErrCustomFieldNotFound         = errs.NewCoded("CUSTOM_FIELD_NOT_FOUND", "custom field not found")
+	ErrCustomFieldFailed           = errs.New("custom field operation failed")
+
+	ErrInvalidCustomFields = errs.NewCoded("INVALID_CUSTOM_FIELDS", "invalid custom field values")
+)
+
+// CustomFieldsError names the custom field a reservation was rejected for. Item is the
+// index of the group item it belongs to. It matches ErrInvalidCustomFields.
+type CustomFieldsError struct {
+	Item   *int
+	Field  string
+	Reason string
+}
+
+func (e *CustomFieldsError) Error() string {
+	return fmt.Sprintf("custom field %q: %s", e.Field, e.Reason)
+}
+
+func (e *CustomFieldsError) Is(target error) bool {
+	return target == ErrInvalidCustomFields
+}
+
+func (e *CustomFieldsError) ErrorCode() errs.Code {
+	return errs.CodeOf(ErrInvalidCustomFields)
+}
+
+type CustomFieldCommands interface {
+	Create(ctx context.Context, companyID uuid.UUID, req reqdto.CreateCustomFieldRequest, actorID uuid.UUID) (*shared.CustomFieldDefinition, error)
+	// Delete removes the definition. Values already stored on reservations are kept.
+	Delete(ctx context.Context, companyID, fieldID, actorID uuid.UUID) error
+}
+
+type customFieldCommandsImpl struct {
+	uow   shared.UnitOfWork
+	clock clock.Clock
+}
+
+func NewCustomFieldCommands(uow shared.UnitOfWork, clock clock.Clock) CustomFieldCommands {
+	return &customFieldCommandsImpl{
+		uow:   uow,
+		clock: clock,
+	}
+}
+
+func (c *customFieldCommandsImpl) Create(ctx context.Context, companyID uuid.UUID, req reqdto.CreateCustomFieldRequest, actorID uuid.UUID) (*shared.CustomFieldDefinition, error) {
+	def, err := reservation.NewFieldDefinition(req.Key, req.Label, reservation.FieldType(req.Type), req.Required, req.Options)
+	if err != nil {
+		return nil, errs.Mark(err, ErrCustomFieldInvalid)
+	}
+
+	field := &shared.CustomFieldDefinition{
+		CompanyID:       companyID,
+		ResourceID:      req.ResourceID,
+		FieldDefinition: def,
+		CreatedAt:       c.clock.Now(),
+	}
+	err = c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		id, cerr := tx.CustomFields().Create(ctx, tx.DB(), *field)
+		if cerr != nil {
+			switch {
+			case infra.IsKind(cerr, infra.KindDuplicateKey):
+				return errs.Mark(cerr, ErrCustomFieldKeyTaken)
+			case infra.IsKind(cerr, infra.KindForeignKeyViolated):
+				return errs.Mark(cerr, ErrCompanyNotFound)
+			case infra.IsKind(cerr, infra.KindNotFound):
+				return errs.Mark(cerr, ErrCustomFieldResourceNotFound)
+			}
+			return cerr
+		}
+		field.ID = id
+
+		metadata := map[string]any{
+			"key":      def.Key,
+			"type":     string(def.Type),
+			"required": def.Required,
+		}
+		if req.ResourceID != nil {
+			metadata["resourceId"] = req.ResourceID.String()
+		}
+		return tx.Audit().Record(ctx, tx.DB(), shared.AuditEntry{
+			ActorID:    &actorID,
+			CompanyID:  &companyID,
+			Action:     AuditActionCustomFieldCreated,
+			TargetType: auditTargetCustomField,
+			TargetID:   id.String(),
+			Metadata:   metadata,
+		})
+	})
+	if err != nil {
+		return nil, errs.Mark(err, ErrCustomFieldFailed)
+	}
+	return field, nil
+}
+
+func (c *customFieldCommandsImpl) Delete(ctx context.Context, companyID, fieldID, actorID uuid.UUID) error {
+	err := c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		if derr := tx.CustomFields().Delete(ctx, tx.DB(), companyID, fieldID); derr != nil {
+			if infra.IsKind(derr, infra.KindNotFound) {
+				return errs.Mark(derr, ErrCustomFieldNotFound)
+			}
+			return derr
+		}
+		return tx.Audit().Record(ctx, tx.DB(), shared.AuditEntry{
+			ActorID:    &actorID,
+			CompanyID:  &companyID,
+			Action:     AuditActionCustomFieldDeleted,
+			TargetType: auditTargetCustomField,
+			TargetID:   fieldID.String(),
+		})
+	})
+	if err != nil {
+		return errs.Mark(err, ErrCustomFieldFailed)
+	}
+	return nil
+}
+
+// checkCustomFields validates values against the fields asked on the resource's
+// reservations.
+func checkCustomFields(ctx context.Context, db sqlc.DBTX, store shared.CustomFieldReadStore, resourceID uuid.UUID, values map[string]any) (reservation.CustomFields, error) {
+	defs, err := store.FieldDefinitionsForResource(ctx, db, resourceID)
+	if err != nil {
+		return nil, errs.Mark(err, errDatabaseOperationFailed)
+	}
+	fields, err := reservation.NewCustomFields(defs, values)
+	if err != nil {
+		var fieldErr *reservation.CustomFieldError
+		if errors.As(err, &fieldErr) {
+			return nil, &CustomFieldsError{Field: fieldErr.Key, Reason: fieldErr.Reason}
+		}
+		return nil, err
+	}
+	return fields, nil
+}
@@ -0,0 +1,108 @@
+package commands
+
+import (
+	"context"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+const (
+	AuditActionFeatureSet   = "company.feature_set"
+	AuditActionFeatureReset = "company.feature_reset"
+)
+
+var (
+	ErrUnknownFeature         = errs.NewCoded("UNKNOWN_FEATURE", "unknown feature")
+	ErrFeatureCompanyNotFound = errs.NewCoded("COMPANY_NOT_FOUND", "company not found")
+	ErrFeatureUpdateFailed    = errs.New("feature update failed")
+)
+
+// FeatureCommands rolls features out per company. Changes apply on this instance at once
+// and on the others within FEATURES_CACHE_TTL.
+type FeatureCommands interface {
+	// Set turns feature on or off for companyID regardless of the default.
+	Set(ctx context.Context, companyID uuid.UUID, feature string, enabled bool, actorID uuid.UUID) error
+	// Reset drops companyID's own setting, so the default applies again.
+	Reset(ctx context.Context, companyID uuid.UUID, feature string, actorID uuid.UUID) error
+}
+
+type featureCommandsImpl struct {
+	uow   shared.UnitOfWork
+	clock clock.Clock
+	store shared.FeatureReadStore
+	flags shared.FeatureFlags
+}
+
+func NewFeatureCommands(uow shared.UnitOfWork, clock clock.Clock, store shared.FeatureReadStore, flags shared.FeatureFlags) FeatureCommands {
+	return &featureCommandsImpl{
+		uow:   uow,
+		clock: clock,
+		store: store,
+		flags: flags,
+	}
+}
+
+func (c *featureCommandsImpl) Set(ctx context.Context, companyID uuid.UUID, feature string, enabled bool, actorID uuid.UUID) error {
+	if !shared.IsFeature(feature) {
+		return ErrUnknownFeature
+	}
+	err := c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		if err := tx.Companies().SetFeature(ctx, tx.DB(), companyID, feature, enabled, c.clock.Now()); err != nil {
+			if infra.IsKind(err, infra.KindForeignKeyViolated) {
+				return errs.Mark(err, ErrFeatureCompanyNotFound)
+			}
+			return err
+		}
+		return c.audit(ctx, tx, AuditActionFeatureSet, companyID, actorID, map[string]any{
+			"feature": feature,
+			"enabled": enabled,
+		})
+	})
+	if err != nil {
+		return errs.Mark(err, ErrFeatureUpdateFailed)
+	}
+	c.flags.Invalidate(companyID)
+	return nil
+}
+
+func (c *featureCommandsImpl) Reset(ctx context.Context, companyID uuid.UUID, feature string, actorID uuid.UUID) error {
+	if !shared.IsFeature(feature) {
+		return ErrUnknownFeature
+	}
+	err := c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		exists, err := c.store.CompanyExists(ctx, tx.DB(), companyID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return ErrFeatureCompanyNotFound
+		}
+		if err := tx.Companies().ClearFeature(ctx, tx.DB(), companyID, feature); err != nil {
+			return err
+		}
+		return c.audit(ctx, tx, AuditActionFeatureReset, companyID, actorID, map[string]any{
+			"feature": feature,
+		})
+	})
+	if err != nil {
+		return errs.Mark(err, ErrFeatureUpdateFailed)
+	}
+	c.flags.Invalidate(companyID)
+	return nil
+}
+
+func (c *featureCommandsImpl) audit(ctx context.Context, tx shared.Tx, action string, companyID, actorID uuid.UUID, metadata map[string]any) error {
+	return tx.Audit().Record(ctx, tx.DB(), shared.AuditEntry{
+		ActorID:    &actorID,
+		CompanyID:  &companyID,
+		Action:     action,
+		TargetType: auditTargetCompany,
+		TargetID:   companyID.String(),
+		Metadata:   metadata,
+	})
+}
@@ -0,0 +1,396 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"time"
+
+	"gin-clean-starter/internal/domain/user"
+	reqdto "gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/pkg/password"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/pkg/signedtoken"
+	"gin-clean-starter/internal/usecase/queries"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+const (
+	InviteTokenPurpose  = "company_invite"
+	InviteStatusPending = "pending"
+
+	NotificationTopicCompanyInvite = "company_invite"
+)
+
+var (
+	ErrInviteInvalidEmail   = errs.NewCoded("INVITE_INVALID_EMAIL", "invalid invite email")
+	ErrInviteUnknownRole    = errs.NewCoded("INVITE_UNKNOWN_ROLE", "unknown invite role")
+	ErrInviteRoleForbidden  = errs.NewCoded("INVITE_ROLE_FORBIDDEN", "inviter may not grant this role")
+	ErrInviteCompanyMissing = errs.NewCoded("COMPANY_MEMBERSHIP_REQUIRED", "inviter does not belong to a company")
+	ErrInviteEmailTaken     = errs.NewCoded("EMAIL_ALREADY_REGISTERED", "email already registered")
+	ErrInviteAlreadyPending = errs.NewCoded("INVITE_ALREADY_PENDING", "invite already pending for email")
+	ErrInviteNotFound       = errs.NewCoded("INVITE_NOT_FOUND", "invite not found")
+	ErrInviteNotPending     = errs.NewCoded("INVITE_NOT_PENDING", "invite is no longer pending")
+	ErrInvalidInvite        = errs.NewCoded("INVITE_INVALID_TOKEN", "invalid invite token")
+	ErrInviteExpired        = errs.NewCoded("INVITE_EXPIRED", "invite expired")
+	ErrInviteWeakPassword   = errs.NewCoded("WEAK_PASSWORD", "invite password too weak")
+	ErrInviteFailed         = errs.New("invite operation failed")
+)
+
+// InvitePolicy controls invite lifetime and the link mailed to invitees;
+// the signed token is appended to AcceptURL as the "token" query parameter.
+type InvitePolicy struct {
+	TTL       time.Duration
+	AcceptURL string
+}
+
+type InviteResult struct {
+	InviteID  uuid.UUID
+	Email     string
+	Role      string
+	ExpiresAt time.Time
+}
+
+type AcceptInviteResult struct {
+	UserID    uuid.UUID
+	Email     string
+	Role      string
+	CompanyID uuid.UUID
+}
+
+type InviteCommands interface {
+	Create(ctx context.Context, req reqdto.CreateInviteRequest, inviterID uuid.UUID, inviterRole string) (*InviteResult, error)
+	Resend(ctx context.Context, inviteID uuid.UUID, actorID uuid.UUID) (*InviteResult, error)
+	Revoke(ctx context.Context, inviteID uuid.UUID, actorID uuid.UUID) error
+	Accept(ctx context.Context, req reqdto.AcceptInviteRequest) (*AcceptInviteResult, error)
+}
+
+// inviteClaims is the signed payload of an invite link. The nonce must match the
+// stored invite, so resending (which rotates it) invalidates earlier links.
+type inviteClaims struct {
+	InviteID uuid.UUID `json:"iid"`
+	Nonce    uuid.UUID `json:"n"`
+}
+
+type inviteCommandsImpl struct {
+	uow         shared.UnitOfWork
+	clock       clock.Clock
+	users       queries.UserReadStore
+	invites     shared.InviteReadStore
+	referrals   shared.ReferralReadStore
+	permissions shared.PermissionResolver
+	signer      *signedtoken.Signer
+	policy      InvitePolicy
+}
+
+func NewInviteCommands(
+	uow shared.UnitOfWork,
+	clock clock.Clock,
+	users queries.UserReadStore,
+	invites shared.InviteReadStore,
+	referrals shared.ReferralReadStore,
+	permissions shared.PermissionResolver,
+	signer *signedtoken.Signer,
+	policy InvitePolicy,
+) InviteCommands {
+	return &inviteCommandsImpl{
+		uow:         uow,
+		clock:       clock,
+		users:       users,
+		invites:     invites,
+		referrals:   referrals,
+		permissions: permissions,
+		signer:      signer,
+		policy:      policy,
+	}
+}
+
+func (c *inviteCommandsImpl) Create(ctx context.Context, req reqdto.CreateInviteRequest, inviterID uuid.UUID, inviterRole string) (*InviteResult, error) {
+	email, err := user.NewEmail(req.Email)
+	if err != nil {
+		return nil, errs.Mark(err, ErrInviteInvalidEmail)
+	}
+
+	roleName := req.Role
+	if roleName == "" {
+		roleName = user.RoleViewer.String()
+	}
+	role, err := user.NewRole(roleName)
+	if err != nil {
+		return nil, errs.Mark(err, ErrInviteUnknownRole)
+	}
+
+	companyID, err := c.inviterCompany(ctx, inviterID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Inviting into another role is a role grant; keep it with whoever manages roles.
+	if role.String() != inviterRole {
+		ok, perr := c.permissions.HasPermission(ctx, inviterRole, shared.PermissionRolesManage)
+		if perr != nil {
+			return nil, errs.Mark(perr, ErrInviteFailed)
+		}
+		if !ok {
+			return nil, ErrInviteRoleForbidden
+		}
+	}
+
+	if _, _, ferr := c.users.FindByEmail(ctx, c.uow.DB(ctx), email.Value()); ferr == nil {
+		return nil, ErrInviteEmailTaken
+	} else if !infra.IsKind(ferr, infra.KindNotFound) {
+		return nil, errs.Mark(ferr, ErrInviteFailed)
+	}
+
+	nonce := uuid.New()
+	expiresAt := c.clock.Now().Add(c.policy.TTL)
+
+	var inviteID uuid.UUID
+	err = c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		id, cerr := tx.Invites().Create(ctx, tx.DB(), sqlc.CreateInviteParams{
+			Email:     email.Value(),
+			CompanyID: companyID,
+			Role:      role.String(),
+			InvitedBy: inviterID,
+			Nonce:     nonce,
+			ExpiresAt: pgconv.TimeToPgtype(expiresAt),
+		})
+		if cerr != nil {
+			switch {
+			case infra.IsKind(cerr, infra.KindDuplicateKey):
+				return errs.Mark(cerr, ErrInviteAlreadyPending)
+			case infra.IsKind(cerr, infra.KindForeignKeyViolated):
+				return errs.Mark(cerr, ErrInviteUnknownRole)
+			}
+			return cerr
+		}
+		inviteID = id
+		return c.enqueueInviteEmail(ctx, tx, inviteID, email.Value(), nonce, expiresAt)
+	})
+	if err != nil {
+		return nil, errs.Mark(err, ErrInviteFailed)
+	}
+
+	return &InviteResult{
+		InviteID:  inviteID,
+		Email:     email.Value(),
+		Role:      role.String(),
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// Resend issues a fresh link with a new expiry; links sent earlier stop working.
+func (c *inviteCommandsImpl) Resend(ctx context.Context, inviteID uuid.UUID, actorID uuid.UUID) (*InviteResult, error) {
+	companyID, err := c.inviterCompany(ctx, actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := uuid.New()
+	expiresAt := c.clock.Now().Add(c.policy.TTL)
+
+	var result *InviteResult
+	err = c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		snap, ferr := c.findCompanyInvite(ctx, tx, inviteID, companyID)
+		if ferr != nil {
+			return ferr
+		}
+		if rerr := tx.Invites().Reissue(ctx, tx.DB(), inviteID, nonce, expiresAt); rerr != nil {
+			if infra.IsKind(rerr, infra.KindConflict) {
+				return errs.Mark(rerr, ErrInviteNotPending)
+			}
+			return rerr
+		}
+		result = &InviteResult{
+			InviteID:  snap.ID,
+			Email:     snap.Email,
+			Role:      snap.Role,
+			ExpiresAt: expiresAt,
+		}
+		return c.enqueueInviteEmail(ctx, tx, snap.ID, snap.Email, nonce, expiresAt)
+	})
+	if err != nil {
+		return nil, errs.Mark(err, ErrInviteFailed)
+	}
+	return result, nil
+}
+
+func (c *inviteCommandsImpl) Revoke(ctx context.Context, inviteID uuid.UUID, actorID uuid.UUID) error {
+	companyID, err := c.inviterCompany(ctx, actorID)
+	if err != nil {
+		return err
+	}
+
+	err = c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		if _, ferr := c.findCompanyInvite(ctx, tx, inviteID, companyID); ferr != nil {
+			return ferr
+		}
+		if rerr := tx.Invites().Revoke(ctx, tx.DB(), inviteID); rerr != nil {
+			if infra.IsKind(rerr, infra.KindConflict) {
+				return errs.Mark(rerr, ErrInviteNotPending)
+			}
+			return rerr
+		}
+		return nil
+	})
+	if err != nil {
+		return errs.Mark(err, ErrInviteFailed)
+	}
+	return nil
+}
+
+// Accept creates the invited user in the inviter's company with the invited role.
+func (c *inviteCommandsImpl) Accept(ctx context.Context, req reqdto.AcceptInviteRequest) (*AcceptInviteResult, error) {
+	pw, err := user.NewPassword(req.Password)
+	if err != nil {
+		return nil, errs.Mark(err, ErrInviteWeakPassword)
+	}
+	referralCode, err := parseReferralCode(req.ReferralCode)
+	if err != nil {
+		return nil, err
+	}
+
+	now := c.clock.Now()
+	var claims inviteClaims
+	if _, verr := c.signer.Verify(InviteTokenPurpose, req.Token, now, &claims); verr != nil {
+		if errors.Is(verr, signedtoken.ErrExpiredToken) {
+			return nil, errs.Mark(verr, ErrInviteExpired)
+		}
+		return nil, errs.Mark(verr, ErrInvalidInvite)
+	}
+
+	passwordHash, err := password.HashPassword(pw.Value())
+	if err != nil {
+		return nil, errs.Mark(err, ErrInviteFailed)
+	}
+
+	var result *AcceptInviteResult
+	err = c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		snap, ferr := c.invites.FindSnapshotByID(ctx, tx.DB(), claims.InviteID)
+		if ferr != nil {
+			if infra.IsKind(ferr, infra.KindNotFound) {
+				return errs.Mark(ferr, ErrInvalidInvite)
+			}
+			return ferr
+		}
+		if snap.Nonce != claims.Nonce {
+			return ErrInvalidInvite
+		}
+		if snap.Status != InviteStatusPending {
+			return ErrInviteNotPending
+		}
+		if !now.Before(snap.ExpiresAt) {
+			return ErrInviteExpired
+		}
+
+		userID, cerr := tx.Users().Create(ctx, tx.DB(), sqlc.CreateUserParams{
+			Email:        snap.Email,
+			PasswordHash: passwordHash,
+			Role:         snap.Role,
+			CompanyID:    pgconv.UUIDToPgtype(snap.CompanyID),
+		})
+		if cerr != nil {
+			if infra.IsKind(cerr, infra.KindDuplicateKey) {
+				return errs.Mark(cerr, ErrInviteEmailTaken)
+			}
+			return cerr
+		}
+		if rerr := attributeReferral(ctx, tx, c.referrals, referralCode, userID); rerr != nil {
+			return rerr
+		}
+		if req.Phone != nil {
+			if perr := tx.Users().SetPhone(ctx, tx.DB(), userID, *req.Phone); perr != nil {
+				return perr
+			}
+		}
+
+		if aerr := tx.Invites().MarkAccepted(ctx, tx.DB(), snap.ID, snap.Nonce, userID); aerr != nil {
+			if infra.IsKind(aerr, infra.KindConflict) {
+				return errs.Mark(aerr, ErrInviteNotPending)
+			}
+			return aerr
+		}
+
+		result = &AcceptInviteResult{
+			UserID:    userID,
+			Email:     snap.Email,
+			Role:      snap.Role,
+			CompanyID: snap.CompanyID,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errs.Mark(err, ErrInviteFailed)
+	}
+	return result, nil
+}
+
+func (c *inviteCommandsImpl) inviterCompany(ctx context.Context, actorID uuid.UUID) (uuid.UUID, error) {
+	actor, err := c.users.FindByID(ctx, c.uow.DB(ctx), actorID)
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return uuid.Nil, errs.Mark(err, ErrInviteCompanyMissing)
+		}
+		return uuid.Nil, errs.Mark(err, ErrInviteFailed)
+	}
+	if actor.CompanyID == nil {
+		return uuid.Nil, ErrInviteCompanyMissing
+	}
+	return *actor.CompanyID, nil
+}
+
+// Invites of other companies are reported as missing rather than forbidden.
+func (c *inviteCommandsImpl) findCompanyInvite(ctx context.Context, tx shared.Tx, inviteID, companyID uuid.UUID) (*shared.InviteSnapshot, error) {
+	snap, err := c.invites.FindSnapshotByID(ctx, tx.DB(), inviteID)
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return nil, errs.Mark(err, ErrInviteNotFound)
+		}
+		return nil, err
+	}
+	if snap.CompanyID != companyID {
+		return nil, ErrInviteNotFound
+	}
+	if snap.Status != InviteStatusPending {
+		return nil, ErrInviteNotPending
+	}
+	return snap, nil
+}
+
+func (c *inviteCommandsImpl) enqueueInviteEmail(ctx context.Context, tx shared.Tx, inviteID uuid.UUID, email string, nonce uuid.UUID, expiresAt time.Time) error {
+	token, err := c.signer.Sign(InviteTokenPurpose, inviteClaims{InviteID: inviteID, Nonce: nonce}, expiresAt)
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(map[string]any{
+		"invite_id":  inviteID,
+		"email":      email,
+		"link":       c.acceptLink(token),
+		"expires_at": expiresAt,
+		"type":       NotificationTopicCompanyInvite,
+	})
+	if err != nil {
+		return err
+	}
+
+	return tx.Notifications().CreateJob(ctx, tx.DB(), NotificationKindEmail, NotificationTopicCompanyInvite, payload, c.clock.Now())
+}
+
+func (c *inviteCommandsImpl) acceptLink(token string) string {
+	u, err := url.Parse(c.policy.AcceptURL)
+	if err != nil {
+		return c.policy.AcceptURL + "?token=" + url.QueryEscape(token)
+	}
+	q := u.Query()
+	q.Set("token", token)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
@@ -0,0 +1,181 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"gin-clean-starter/internal/domain/loyalty"
+	"gin-clean-starter/internal/domain/reservation"
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInsufficientPoints = errs.NewCoded("INSUFFICIENT_POINTS", "insufficient loyalty points")
+
+	ErrPointsAccrualFailed = errs.New("loyalty points accrual failed")
+	ErrPointsExpiryFailed  = errs.New("loyalty points expiry failed")
+)
+
+// LoyaltyPolicy is how points are earned and how much one run of each job processes.
+// Only reservations that ended within AccrualLookback accrue, which bounds the scan and
+// keeps history from before the program started out of the ledger.
+type LoyaltyPolicy struct {
+	Earn            loyalty.EarnPolicy
+	BatchSize       int32
+	AccrualLookback time.Duration
+}
+
+type LoyaltyCommands interface {
+	// AccruePoints credits a lot of points for every completed reservation that has not
+	// earned yet, and returns how many reservations accrued.
+	AccruePoints(ctx context.Context) (int, error)
+	// ExpirePoints forfeits the unspent points of expired lots and returns how many lots expired.
+	ExpirePoints(ctx context.Context) (int64, error)
+}
+
+type loyaltyCommandsImpl struct {
+	uow       shared.UnitOfWork
+	readStore shared.LoyaltyReadStore
+	policy    LoyaltyPolicy
+	clock     clock.Clock
+}
+
+func NewLoyaltyCommands(uow shared.UnitOfWork, readStore shared.LoyaltyReadStore, policy LoyaltyPolicy, clock clock.Clock) LoyaltyCommands {
+	return &loyaltyCommandsImpl{
+		uow:       uow,
+		readStore: readStore,
+		policy:    policy,
+		clock:     clock,
+	}
+}
+
+// A reservation completes by its slot ending rather than by a write, so completion is
+// detected here on a schedule, like referral rewards.
+func (l *loyaltyCommandsImpl) AccruePoints(ctx context.Context) (int, error) {
+	now := l.clock.Now()
+	completed, err := l.readStore.ListAccruable(ctx, l.uow.DB(ctx), now.Add(-l.policy.AccrualLookback), now, l.policy.Earn.MinPriceCents(), l.policy.BatchSize)
+	if err != nil {
+		return 0, errs.Mark(err, ErrPointsAccrualFailed)
+	}
+
+	accrued := 0
+	for _, res := range completed {
+		if cerr := ctx.Err(); cerr != nil {
+			return accrued, cerr
+		}
+
+		accrual := shared.PointsAccrual{
+			UserID:        res.UserID,
+			ReservationID: res.ID,
+			Points:        l.policy.Earn.Points(res.PriceCents),
+			ExpiresAt:     l.policy.Earn.ExpiresAt(res.CompletedAt),
+		}
+		aerr := l.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+			if err := tx.Loyalty().Accrue(ctx, tx.DB(), accrual, now); err != nil {
+				return err
+			}
+			return tx.Activity().Record(ctx, tx.DB(), shared.ActivityEntry{
+				UserID:     res.UserID,
+				Kind:       shared.ActivityPointsEarned,
+				SubjectID:  res.ID,
+				Data:       map[string]any{"points": accrual.Points, "expiresAt": accrual.ExpiresAt},
+				OccurredAt: now,
+			})
+		})
+		if aerr != nil {
+			if infra.IsKind(aerr, infra.KindConflict) {
+				continue
+			}
+			return accrued, errs.Mark(aerr, ErrPointsAccrualFailed)
+		}
+		accrued++
+	}
+	return accrued, nil
+}
+
+// ExpirePoints works in bounded batches, each in its own transaction, until a batch
+// comes back short.
+func (l *loyaltyCommandsImpl) ExpirePoints(ctx context.Context) (int64, error) {
+	now := l.clock.Now()
+	var total int64
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return total, err
+		}
+
+		var expired int64
+		err := l.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+			var xerr error
+			expired, xerr = tx.Loyalty().ExpireLots(ctx, tx.DB(), now, l.policy.BatchSize)
+			return xerr
+		})
+		if err != nil {
+			return total, errs.Mark(err, ErrPointsExpiryFailed)
+		}
+
+		total += expired
+		if expired > 0 {
+			slog.Info("Loyalty lots expired", "expired", expired, "total_expired", total)
+		}
+		if expired < int64(l.policy.BatchSize) {
+			return total, nil
+		}
+	}
+}
+
+// checkPointsBalance rejects a quote that asks for more points than the user holds.
+// Reservation creation re-checks under lock in redeemPoints.
+func checkPointsBalance(ctx context.Context, db sqlc.DBTX, store shared.LoyaltyReadStore, userID uuid.UUID, requested int64, now time.Time) error {
+	if requested <= 0 {
+		return nil
+	}
+	balance, err := store.Balance(ctx, db, userID, now)
+	if err != nil {
+		return errs.Mark(err, errDatabaseOperationFailed)
+	}
+	if balance < requested {
+		return ErrInsufficientPoints
+	}
+	return nil
+}
+
+// redeemPoints draws the points a reservation was priced with from the user's lots and
+// records the redemption, all inside the reservation's transaction.
+func redeemPoints(ctx context.Context, tx shared.Tx, userID, reservationID uuid.UUID, points reservation.RedeemedPoints, now time.Time) error {
+	if points.Points <= 0 {
+		return nil
+	}
+
+	lots, err := tx.Loyalty().LockOpenLots(ctx, tx.DB(), userID, now)
+	if err != nil {
+		return errs.Mark(err, errDatabaseOperationFailed)
+	}
+	draws, err := loyalty.Allocate(lots, points.Points)
+	if err != nil {
+		return errs.Mark(err, ErrInsufficientPoints)
+	}
+	for _, draw := range draws {
+		if err = tx.Loyalty().Draw(ctx, tx.DB(), draw); err != nil {
+			return errs.Mark(err, errDatabaseOperationFailed)
+		}
+	}
+
+	err = tx.Loyalty().RecordRedemption(ctx, tx.DB(), shared.PointsRedemption{
+		UserID:        userID,
+		ReservationID: reservationID,
+		Points:        points.Points,
+		DiscountCents: int64(points.Amount.Cents()),
+	}, now)
+	if err != nil {
+		return errs.Mark(err, errDatabaseOperationFailed)
+	}
+	return nil
+}
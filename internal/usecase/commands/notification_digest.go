@@ -0,0 +1,168 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+const (
+	NotificationTopicDigest = "notification_digest"
+
+	NotificationDigestOff    = "off"
+	NotificationDigestDaily  = "daily"
+	NotificationDigestWeekly = "weekly"
+)
+
+var (
+	ErrInvalidNotificationDigest = errs.NewCoded("INVALID_NOTIFICATION_DIGEST", "notification digest must be off, daily or weekly")
+	ErrNotificationDigestFailed  = errs.New("notification digest failed")
+)
+
+// digestTopics are the notifications a user may take in a digest. The rest are urgent or
+// wait for an answer (cancellations and rejections, approval requests, transfer offers,
+// invites, verification links) and always go out at once.
+var digestTopics = map[string]bool{
+	NotificationTopicReservationCreated:  true,
+	NotificationTopicReservationApproved: true,
+	NotificationTopicReservationMessage:  true,
+	NotificationTopicReferralRewarded:    true,
+}
+
+// NotificationDigestPolicy: a run queues the digests of up to BatchSize users.
+type NotificationDigestPolicy struct {
+	BatchSize int32
+}
+
+type NotificationDigestCommands interface {
+	// SetMode chooses how the user gets non-urgent notification emails: off (one by one),
+	// daily or weekly. Notifications held when the digest is turned off go out in one last
+	// digest on the next run.
+	SetMode(ctx context.Context, userID uuid.UUID, mode string) error
+	// Run queues a digest email for every user whose oldest held notification has waited
+	// out their period, and returns how many it queued.
+	Run(ctx context.Context) (int, error)
+}
+
+type notificationDigestCommandsImpl struct {
+	uow    shared.UnitOfWork
+	repo   shared.NotificationDigestRepository
+	clock  clock.Clock
+	policy NotificationDigestPolicy
+}
+
+func NewNotificationDigestCommands(
+	uow shared.UnitOfWork,
+	repo shared.NotificationDigestRepository,
+	clock clock.Clock,
+	policy NotificationDigestPolicy,
+) NotificationDigestCommands {
+	return &notificationDigestCommandsImpl{
+		uow:    uow,
+		repo:   repo,
+		clock:  clock,
+		policy: policy,
+	}
+}
+
+func (c *notificationDigestCommandsImpl) SetMode(ctx context.Context, userID uuid.UUID, mode string) error {
+	switch mode {
+	case NotificationDigestOff, NotificationDigestDaily, NotificationDigestWeekly:
+	default:
+		return ErrInvalidNotificationDigest
+	}
+
+	if err := c.repo.SetMode(ctx, c.uow.DB(ctx), userID, mode); err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return errs.Mark(err, ErrUserNotFound)
+		}
+		return errs.Mark(err, ErrNotificationDigestFailed)
+	}
+	return nil
+}
+
+func (c *notificationDigestCommandsImpl) Run(ctx context.Context) (int, error) {
+	now := c.clock.Now()
+	due, err := c.repo.ListDue(ctx, c.uow.DB(ctx), now, c.policy.BatchSize)
+	if err != nil {
+		return 0, errs.Mark(err, ErrNotificationDigestFailed)
+	}
+
+	queued := 0
+	for _, digest := range due {
+		if cerr := ctx.Err(); cerr != nil {
+			return queued, cerr
+		}
+
+		var sent bool
+		err = c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+			held, terr := c.repo.Take(ctx, tx.DB(), digest.UserID, now)
+			if terr != nil || len(held) == 0 {
+				// Another instance took them first.
+				return terr
+			}
+			payload, merr := digestPayload(digest, held)
+			if merr != nil {
+				return merr
+			}
+			sent = true
+			return tx.Notifications().CreateUserJob(ctx, tx.DB(), digest.UserID, NotificationKindEmail, NotificationTopicDigest, payload, now, false)
+		})
+		if err != nil {
+			return queued, errs.Mark(err, ErrNotificationDigestFailed)
+		}
+		if sent {
+			queued++
+		}
+	}
+	return queued, nil
+}
+
+type digestEvent struct {
+	CreatedAt time.Time       `json:"created_at"`
+	Payload   json.RawMessage `json:"payload"`
+}
+
+type digestGroup struct {
+	Topic  string        `json:"topic"`
+	Count  int           `json:"count"`
+	Events []digestEvent `json:"events"`
+}
+
+// digestPayload groups the held notifications by topic, in the order each topic first
+// came up, for the worker to render as one summary email.
+func digestPayload(digest shared.DueNotificationDigest, held []shared.HeldNotification) ([]byte, error) {
+	var groups []*digestGroup
+	byTopic := map[string]*digestGroup{}
+	for _, n := range held {
+		group, ok := byTopic[n.Topic]
+		if !ok {
+			group = &digestGroup{Topic: n.Topic}
+			byTopic[n.Topic] = group
+			groups = append(groups, group)
+		}
+		group.Count++
+		group.Events = append(group.Events, digestEvent{CreatedAt: n.CreatedAt, Payload: n.Payload})
+	}
+	return json.Marshal(map[string]any{
+		"type":    NotificationTopicDigest,
+		"user_id": digest.UserID,
+		"period":  digest.Mode,
+		"since":   held[0].CreatedAt,
+		"count":   len(held),
+		"groups":  groups,
+	})
+}
+
+// queueUserNotification emails userID about topic, or holds the email for their digest
+// when they take one and the topic is not urgent.
+func queueUserNotification(ctx context.Context, tx shared.Tx, userID uuid.UUID, topic string, payload []byte, now time.Time) error {
+	return tx.Notifications().CreateUserJob(ctx, tx.DB(), userID, NotificationKindEmail, topic, payload, now, digestTopics[topic])
+}
@@ -0,0 +1,311 @@
+package commands
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"log/slog"
+	"time"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/pkg/jwt"
+	"gin-clean-starter/internal/pkg/oauth"
+	"gin-clean-starter/internal/pkg/password"
+	"gin-clean-starter/internal/pkg/reqctx"
+	"gin-clean-starter/internal/pkg/signedtoken"
+	"gin-clean-starter/internal/usecase/queries"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+const (
+	AuditActionOIDCLogin = "auth.oidc_login"
+
+	// OIDCStatePurpose signs the state a browser carries from the sign-in redirect to the
+	// provider's callback.
+	OIDCStatePurpose = "oidc_login"
+	// oidcStateTTL bounds how long the user may take at the provider.
+	oidcStateTTL = 10 * time.Minute
+)
+
+var (
+	ErrOIDCProviderNotFound = errs.NewCoded("OIDC_PROVIDER_NOT_FOUND", "unknown OpenID Connect provider")
+	ErrOIDCStateInvalid     = errs.NewCoded("OIDC_STATE_INVALID", "sign-in state missing, expired or mismatched")
+	ErrOIDCIdentityInvalid  = errs.NewCoded("OIDC_IDENTITY_INVALID", "identity provider response rejected")
+	ErrOIDCEmailUnverified  = errs.NewCoded("OIDC_EMAIL_UNVERIFIED", "identity provider reports no verified email")
+	ErrOIDCEmailTaken       = errs.NewCoded("OIDC_EMAIL_TAKEN", "email belongs to an account not linked to this identity")
+	ErrOIDCFailed           = errs.New("OpenID Connect sign-in failed")
+)
+
+// OIDCLoginStart sends the browser to the provider. State goes back to the callback with
+// the browser; it ties the callback to this browser and carries the PKCE verifier.
+type OIDCLoginStart struct {
+	RedirectURL string
+	State       string
+	ExpiresAt   time.Time
+}
+
+type oidcState struct {
+	Provider string `json:"p"`
+	State    string `json:"s"`
+	Nonce    string `json:"n"`
+	Verifier string `json:"v"`
+}
+
+// OIDCCommands signs users in through OpenID Connect providers such as Google.
+type OIDCCommands interface {
+	StartLogin(ctx context.Context, provider string) (*OIDCLoginStart, error)
+	// Login redeems the code and state the provider's callback got, given the signed state
+	// StartLogin issued to the browser. An identity seen for the first time gets a new
+	// viewer account, unless its email belongs to an existing one; users with two-factor
+	// authentication get an MFAChallenge instead of a token pair.
+	Login(ctx context.Context, provider, code, state, signedState string) (*LoginResult, error)
+}
+
+type oidcCommandsImpl struct {
+	uow         shared.UnitOfWork
+	clock       clock.Clock
+	providers   shared.OIDCProviders
+	identities  shared.UserIdentityRepository
+	users       queries.UserReadStore
+	mfa         shared.MFARepository
+	mfaPolicy   MFAPolicy
+	signer      *signedtoken.Signer
+	jwtService  *jwt.Service
+	bindingMode DeviceBindingMode
+	tokens      shared.RefreshTokenRepository
+}
+
+func NewOIDCCommands(
+	uow shared.UnitOfWork,
+	clock clock.Clock,
+	providers shared.OIDCProviders,
+	identities shared.UserIdentityRepository,
+	users queries.UserReadStore,
+	mfa shared.MFARepository,
+	mfaPolicy MFAPolicy,
+	signer *signedtoken.Signer,
+	jwtService *jwt.Service,
+	bindingMode DeviceBindingMode,
+	tokens shared.RefreshTokenRepository,
+) OIDCCommands {
+	return &oidcCommandsImpl{
+		uow:         uow,
+		clock:       clock,
+		providers:   providers,
+		identities:  identities,
+		users:       users,
+		mfa:         mfa,
+		mfaPolicy:   mfaPolicy,
+		signer:      signer,
+		jwtService:  jwtService,
+		bindingMode: bindingMode,
+		tokens:      tokens,
+	}
+}
+
+func (c *oidcCommandsImpl) StartLogin(ctx context.Context, provider string) (*OIDCLoginStart, error) {
+	p, ok := c.providers[provider]
+	if !ok {
+		return nil, ErrOIDCProviderNotFound
+	}
+	claims := oidcState{Provider: provider}
+	for _, v := range []*string{&claims.State, &claims.Nonce, &claims.Verifier} {
+		token, err := oauth.GenerateToken()
+		if err != nil {
+			return nil, errs.Mark(err, ErrOIDCFailed)
+		}
+		*v = token
+	}
+	redirectURL, err := p.AuthCodeURL(ctx, claims.State, claims.Nonce, claims.Verifier)
+	if err != nil {
+		return nil, errs.Mark(err, ErrOIDCFailed)
+	}
+	expiresAt := c.clock.Now().Add(oidcStateTTL)
+	state, err := c.signer.Sign(OIDCStatePurpose, claims, expiresAt)
+	if err != nil {
+		return nil, errs.Mark(err, ErrOIDCFailed)
+	}
+	return &OIDCLoginStart{RedirectURL: redirectURL, State: state, ExpiresAt: expiresAt}, nil
+}
+
+func (c *oidcCommandsImpl) Login(ctx context.Context, provider, code, state, signedState string) (*LoginResult, error) {
+	// The provider redirects the browser here, so no client sends a device key.
+	if c.bindingMode == DeviceBindingRequired {
+		return nil, ErrDeviceKeyRequired
+	}
+	p, ok := c.providers[provider]
+	if !ok {
+		return nil, ErrOIDCProviderNotFound
+	}
+
+	now := c.clock.Now()
+	var claims oidcState
+	if _, err := c.signer.Verify(OIDCStatePurpose, signedState, now, &claims); err != nil {
+		return nil, errs.Mark(err, ErrOIDCStateInvalid)
+	}
+	// A state from another browser's sign-in would log this browser into that account.
+	if claims.Provider != provider || subtle.ConstantTimeCompare([]byte(claims.State), []byte(state)) != 1 {
+		return nil, ErrOIDCStateInvalid
+	}
+
+	identity, err := p.Exchange(ctx, code, claims.Verifier, claims.Nonce, now)
+	if err != nil {
+		if errors.Is(err, oauth.ErrExchange) || errors.Is(err, oauth.ErrInvalidIDToken) {
+			return nil, errs.Mark(err, ErrOIDCIdentityInvalid)
+		}
+		return nil, errs.Mark(err, ErrOIDCFailed)
+	}
+
+	account, err := c.linkedUser(ctx, provider, identity.Subject)
+	if err != nil {
+		return nil, err
+	}
+	var email user.Email
+	if account == nil {
+		if email, err = c.newUserEmail(ctx, identity); err != nil {
+			return nil, err
+		}
+	} else {
+		if !account.IsActive {
+			return nil, ErrUserInactive
+		}
+		mfaEnabled, merr := c.mfa.IsEnabled(ctx, c.uow.DB(ctx), account.ID)
+		if merr != nil {
+			return nil, errs.Mark(merr, ErrOIDCFailed)
+		}
+		if mfaEnabled {
+			challenge, cerr := newMFAChallenge(c.signer, c.mfaPolicy, account.ID, now)
+			if cerr != nil {
+				return nil, errs.Mark(cerr, ErrTokenGeneration)
+			}
+			return &LoginResult{UserID: account.ID, MFAChallenge: challenge}, nil
+		}
+	}
+
+	var userID uuid.UUID
+	role := user.RoleViewer
+	err = c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		provisioned := account == nil
+		if provisioned {
+			id, cerr := c.createUser(ctx, tx, email.Value())
+			if cerr != nil {
+				if infra.IsKind(cerr, infra.KindDuplicateKey) {
+					return errs.Mark(cerr, ErrOIDCEmailTaken)
+				}
+				return cerr
+			}
+			userID = id
+			if lerr := c.identities.Create(ctx, tx.DB(), provider, identity.Subject, userID, identity.Email, now); lerr != nil {
+				return lerr
+			}
+		} else {
+			userID = account.ID
+			var rerr error
+			if role, rerr = user.NewRole(account.Role); rerr != nil {
+				return rerr
+			}
+			if terr := c.identities.Touch(ctx, tx.DB(), provider, identity.Subject, identity.Email, now); terr != nil {
+				return terr
+			}
+			if uerr := tx.Users().UpdateLastLogin(ctx, tx.DB(), userID); uerr != nil {
+				slog.Warn("failed to update last login", "user_id", userID, "error", uerr.Error())
+			}
+		}
+		if derr := recordDevice(ctx, tx, userID, "", now); derr != nil {
+			return derr
+		}
+		client := reqctx.ClientInfo(ctx)
+		return tx.Audit().Record(ctx, tx.DB(), shared.AuditEntry{
+			ActorID:    &userID,
+			Action:     AuditActionOIDCLogin,
+			TargetType: shared.AuditTargetUser,
+			TargetID:   userID.String(),
+			Metadata: map[string]any{
+				"provider":    provider,
+				"provisioned": provisioned,
+				"ip":          client.IP,
+				"user_agent":  client.UserAgent,
+			},
+		})
+	})
+	if err != nil {
+		if errors.Is(err, ErrOIDCEmailTaken) {
+			return nil, err
+		}
+		return nil, errs.Mark(err, ErrOIDCFailed)
+	}
+
+	var pair *TokenPair
+	err = c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		var issueErr error
+		pair, issueErr = issueTokens(ctx, tx.DB(), c.tokens, c.jwtService, userID, role, "", uuid.New(), now)
+		return issueErr
+	})
+	if err != nil {
+		return nil, errs.Mark(err, ErrTokenGeneration)
+	}
+	return &LoginResult{
+		UserID:    userID,
+		TokenPair: pair,
+	}, nil
+}
+
+// linkedUser is the user the identity signs in as, nil when it is not linked yet.
+func (c *oidcCommandsImpl) linkedUser(ctx context.Context, provider, subject string) (*queries.AuthorizedUserView, error) {
+	userID, err := c.identities.FindUserID(ctx, c.uow.DB(ctx), provider, subject)
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return nil, nil
+		}
+		return nil, errs.Mark(err, ErrOIDCFailed)
+	}
+	account, err := c.users.FindByID(ctx, c.uow.DB(ctx), userID)
+	if err != nil {
+		return nil, errs.Mark(err, ErrOIDCFailed)
+	}
+	return account, nil
+}
+
+// newUserEmail is the email to create the identity's account with. Only an address the
+// provider verified will do, and one that already has an account is refused rather than
+// linked: the provider's word is no proof the person also owns that account.
+func (c *oidcCommandsImpl) newUserEmail(ctx context.Context, identity *oauth.Identity) (user.Email, error) {
+	if !identity.EmailVerified {
+		return user.Email{}, ErrOIDCEmailUnverified
+	}
+	email, err := user.NewEmail(identity.Email)
+	if err != nil {
+		return user.Email{}, errs.Mark(err, ErrOIDCEmailUnverified)
+	}
+	existing, _, err := c.users.FindByEmail(ctx, c.uow.DB(ctx), email.Value())
+	if err != nil && !infra.IsKind(err, infra.KindNotFound) {
+		return user.Email{}, errs.Mark(err, ErrOIDCFailed)
+	}
+	if existing != nil {
+		return user.Email{}, ErrOIDCEmailTaken
+	}
+	return email, nil
+}
+
+// createUser adds a viewer with a password nobody knows; they sign in through the provider.
+func (c *oidcCommandsImpl) createUser(ctx context.Context, tx shared.Tx, email string) (uuid.UUID, error) {
+	secret, err := unusablePassword()
+	if err != nil {
+		return uuid.Nil, err
+	}
+	passwordHash, err := password.HashPassword(secret)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return tx.Users().Create(ctx, tx.DB(), sqlc.CreateUserParams{
+		Email:        email,
+		PasswordHash: passwordHash,
+		Role:         user.RoleViewer.String(),
+	})
+}
@@ -0,0 +1,86 @@
+package commands
+
+import (
+	"context"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrPlanResourceLimit = errs.NewCoded("PLAN_RESOURCE_LIMIT", "plan resource limit reached")
+	ErrPlanOperatorLimit = errs.NewCoded("PLAN_OPERATOR_LIMIT", "plan operator limit reached")
+)
+
+// PlanGuard enforces the limits of a company's plan on the commands that grow it. Checks
+// take the caller's transaction, so they count what it has written so far; companies
+// without a subscription are not limited.
+type PlanGuard interface {
+	// CheckResources reports ErrPlanResourceLimit when adding resources would take the
+	// company past its plan.
+	CheckResources(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID, adding int) error
+	// CheckOperator reports ErrPlanOperatorLimit when assigning userID to the resource
+	// would add an operator past the plan of the resource's company. Users who already
+	// operate another of the company's resources are not counted again.
+	CheckOperator(ctx context.Context, db sqlc.DBTX, resourceID, userID uuid.UUID) error
+}
+
+type planGuardImpl struct {
+	plans shared.PlanReadStore
+}
+
+func NewPlanGuard(plans shared.PlanReadStore) PlanGuard {
+	return &planGuardImpl{plans: plans}
+}
+
+func (g *planGuardImpl) CheckResources(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID, adding int) error {
+	plan, err := g.findPlan(ctx, db, companyID)
+	if err != nil || plan == nil || plan.MaxResources == nil {
+		return err
+	}
+	count, err := g.plans.CountResources(ctx, db, companyID)
+	if err != nil {
+		return err
+	}
+	if count+int64(adding) > int64(*plan.MaxResources) {
+		return ErrPlanResourceLimit
+	}
+	return nil
+}
+
+func (g *planGuardImpl) CheckOperator(ctx context.Context, db sqlc.DBTX, resourceID, userID uuid.UUID) error {
+	operators, err := g.plans.CountOperators(ctx, db, resourceID, userID)
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return errs.Mark(err, ErrOperatorTargetNotFound)
+		}
+		return err
+	}
+	if operators.CompanyID == nil || operators.IsOperator {
+		return nil
+	}
+	plan, err := g.findPlan(ctx, db, *operators.CompanyID)
+	if err != nil || plan == nil || plan.MaxOperators == nil {
+		return err
+	}
+	if operators.Count+1 > int64(*plan.MaxOperators) {
+		return ErrPlanOperatorLimit
+	}
+	return nil
+}
+
+// findPlan returns nil for companies without a subscription.
+func (g *planGuardImpl) findPlan(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID) (*shared.CompanyPlan, error) {
+	plan, err := g.plans.FindCompanyPlan(ctx, db, companyID)
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return plan, nil
+}
@@ -0,0 +1,37 @@
+package commands
+
+import (
+	"context"
+
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+)
+
+var ErrPlatformStatsFailed = errs.New("platform stats refresh failed")
+
+type PlatformStatsCommands interface {
+	// Refresh recomputes the platform-wide totals served to public pages.
+	Refresh(ctx context.Context) error
+}
+
+type platformStatsCommandsImpl struct {
+	uow   shared.UnitOfWork
+	clock clock.Clock
+	stats shared.PlatformStatsRepository
+}
+
+func NewPlatformStatsCommands(uow shared.UnitOfWork, clock clock.Clock, stats shared.PlatformStatsRepository) PlatformStatsCommands {
+	return &platformStatsCommandsImpl{
+		uow:   uow,
+		clock: clock,
+		stats: stats,
+	}
+}
+
+func (c *platformStatsCommandsImpl) Refresh(ctx context.Context) error {
+	if err := c.stats.Refresh(ctx, c.uow.DB(ctx), c.clock.Now()); err != nil {
+		return errs.Mark(err, ErrPlatformStatsFailed)
+	}
+	return nil
+}
@@ -0,0 +1,221 @@
+package commands
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+
+	"gin-clean-starter/internal/domain/user"
+	scimdto "gin-clean-starter/internal/handler/dto/scim"
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/pkg/password"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+const (
+	AuditActionProvisioningTokenIssued  = "provisioning.token_issued"
+	AuditActionProvisioningTokenRevoked = "provisioning.token_revoked"
+	AuditActionUserProvisioned          = "provisioning.user_created"
+	AuditActionUserActiveSet            = "provisioning.user_active_set"
+)
+
+var (
+	ErrProvisioningTokenNotFound = errs.NewCoded("PROVISIONING_TOKEN_NOT_FOUND", "provisioning token not found")
+	ErrProvisioningInvalidEmail  = errs.NewCoded("PROVISIONING_INVALID_EMAIL", "invalid provisioned user email")
+	ErrProvisioningWeakPassword  = errs.NewCoded("WEAK_PASSWORD", "provisioned user password too weak")
+	ErrProvisionedUserExists     = errs.NewCoded("USER_ALREADY_EXISTS", "a user with this email or external ID already exists")
+	ErrProvisioningFailed        = errs.New("provisioning operation failed")
+)
+
+// IssuedProvisioningToken carries the plaintext token; only its hash is stored, so it
+// cannot be shown again.
+type IssuedProvisioningToken struct {
+	ID    uuid.UUID
+	Token string
+}
+
+// ProvisioningCommands lets a company's identity provider manage its staff accounts.
+type ProvisioningCommands interface {
+	IssueToken(ctx context.Context, companyID, actorID uuid.UUID) (*IssuedProvisioningToken, error)
+	RevokeToken(ctx context.Context, companyID, tokenID, actorID uuid.UUID) error
+	// CreateUser adds a viewer to the company. Without a password the account has a random
+	// one nobody knows and cannot sign in until a password is set.
+	CreateUser(ctx context.Context, companyID uuid.UUID, req scimdto.CreateUserRequest) (uuid.UUID, error)
+	// SetActive enables or disables sign-in for a member of the company; disabled members
+	// can no longer log in or refresh their session.
+	SetActive(ctx context.Context, companyID, userID uuid.UUID, active bool) error
+}
+
+type provisioningCommandsImpl struct {
+	uow   shared.UnitOfWork
+	clock clock.Clock
+}
+
+func NewProvisioningCommands(uow shared.UnitOfWork, clock clock.Clock) ProvisioningCommands {
+	return &provisioningCommandsImpl{
+		uow:   uow,
+		clock: clock,
+	}
+}
+
+func (c *provisioningCommandsImpl) IssueToken(ctx context.Context, companyID, actorID uuid.UUID) (*IssuedProvisioningToken, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, errs.Mark(err, ErrProvisioningFailed)
+	}
+	token := shared.ProvisioningTokenPrefix + base64.RawURLEncoding.EncodeToString(secret)
+
+	var tokenID uuid.UUID
+	err := c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		id, cerr := tx.Provisioning().CreateToken(ctx, tx.DB(), companyID, shared.HashProvisioningToken(token), actorID, c.clock.Now())
+		if cerr != nil {
+			if infra.IsKind(cerr, infra.KindForeignKeyViolated) {
+				return errs.Mark(cerr, ErrCompanyNotFound)
+			}
+			return cerr
+		}
+		tokenID = id
+		return tx.Audit().Record(ctx, tx.DB(), shared.AuditEntry{
+			ActorID:    &actorID,
+			CompanyID:  &companyID,
+			Action:     AuditActionProvisioningTokenIssued,
+			TargetType: auditTargetCompany,
+			TargetID:   companyID.String(),
+			Metadata:   map[string]any{"tokenId": id},
+		})
+	})
+	if err != nil {
+		return nil, errs.Mark(err, ErrProvisioningFailed)
+	}
+	return &IssuedProvisioningToken{ID: tokenID, Token: token}, nil
+}
+
+func (c *provisioningCommandsImpl) RevokeToken(ctx context.Context, companyID, tokenID, actorID uuid.UUID) error {
+	err := c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		if rerr := tx.Provisioning().RevokeToken(ctx, tx.DB(), companyID, tokenID, c.clock.Now()); rerr != nil {
+			if infra.IsKind(rerr, infra.KindNotFound) {
+				return errs.Mark(rerr, ErrProvisioningTokenNotFound)
+			}
+			return rerr
+		}
+		return tx.Audit().Record(ctx, tx.DB(), shared.AuditEntry{
+			ActorID:    &actorID,
+			CompanyID:  &companyID,
+			Action:     AuditActionProvisioningTokenRevoked,
+			TargetType: auditTargetCompany,
+			TargetID:   companyID.String(),
+			Metadata:   map[string]any{"tokenId": tokenID},
+		})
+	})
+	if err != nil {
+		return errs.Mark(err, ErrProvisioningFailed)
+	}
+	return nil
+}
+
+func (c *provisioningCommandsImpl) CreateUser(ctx context.Context, companyID uuid.UUID, req scimdto.CreateUserRequest) (uuid.UUID, error) {
+	email, err := user.NewEmail(req.UserName)
+	if err != nil {
+		return uuid.Nil, errs.Mark(err, ErrProvisioningInvalidEmail)
+	}
+	secret := req.Password
+	if secret == "" {
+		secret, err = unusablePassword()
+		if err != nil {
+			return uuid.Nil, errs.Mark(err, ErrProvisioningFailed)
+		}
+	} else if _, perr := user.NewPassword(secret); perr != nil {
+		return uuid.Nil, errs.Mark(perr, ErrProvisioningWeakPassword)
+	}
+	passwordHash, err := password.HashPassword(secret)
+	if err != nil {
+		return uuid.Nil, errs.Mark(err, ErrProvisioningFailed)
+	}
+	externalID := strings.TrimSpace(req.ExternalID)
+	active := req.Active == nil || *req.Active
+
+	var userID uuid.UUID
+	err = c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		id, cerr := tx.Users().Create(ctx, tx.DB(), sqlc.CreateUserParams{
+			Email:        email.Value(),
+			PasswordHash: passwordHash,
+			Role:         user.RoleViewer.String(),
+			CompanyID:    pgconv.UUIDToPgtype(companyID),
+		})
+		if cerr != nil {
+			return markProvisionedUserExists(cerr)
+		}
+		userID = id
+		if externalID != "" {
+			if serr := tx.Users().SetExternalID(ctx, tx.DB(), id, externalID); serr != nil {
+				return markProvisionedUserExists(serr)
+			}
+		}
+		if !active {
+			if serr := tx.Provisioning().SetUserActive(ctx, tx.DB(), companyID, id, false, c.clock.Now()); serr != nil {
+				return serr
+			}
+		}
+		return tx.Audit().Record(ctx, tx.DB(), shared.AuditEntry{
+			CompanyID:  &companyID,
+			Action:     AuditActionUserProvisioned,
+			TargetType: shared.AuditTargetUser,
+			TargetID:   id.String(),
+			Metadata: map[string]any{
+				"externalId": externalID,
+				"active":     active,
+			},
+		})
+	})
+	if err != nil {
+		return uuid.Nil, errs.Mark(err, ErrProvisioningFailed)
+	}
+	return userID, nil
+}
+
+func (c *provisioningCommandsImpl) SetActive(ctx context.Context, companyID, userID uuid.UUID, active bool) error {
+	err := c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		if serr := tx.Provisioning().SetUserActive(ctx, tx.DB(), companyID, userID, active, c.clock.Now()); serr != nil {
+			if infra.IsKind(serr, infra.KindNotFound) {
+				return errs.Mark(serr, ErrUserNotFound)
+			}
+			// Reactivating collides with an active account that took the email meanwhile.
+			return markProvisionedUserExists(serr)
+		}
+		return tx.Audit().Record(ctx, tx.DB(), shared.AuditEntry{
+			CompanyID:  &companyID,
+			Action:     AuditActionUserActiveSet,
+			TargetType: shared.AuditTargetUser,
+			TargetID:   userID.String(),
+			Metadata:   map[string]any{"active": active},
+		})
+	})
+	if err != nil {
+		return errs.Mark(err, ErrProvisioningFailed)
+	}
+	return nil
+}
+
+func markProvisionedUserExists(err error) error {
+	if infra.IsKind(err, infra.KindDuplicateKey) {
+		return errs.Mark(err, ErrProvisionedUserExists)
+	}
+	return err
+}
+
+// unusablePassword is a random secret that is hashed and discarded.
+func unusablePassword() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,357 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"gin-clean-starter/internal/domain/reservation"
+	reqdto "gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/pkg/signedtoken"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+const QuoteTokenPurpose = "reservation_quote"
+
+var (
+	ErrInvalidQuote = errs.NewCoded("QUOTE_MISMATCH", "invalid quote")
+	ErrQuoteExpired = errs.NewCoded("QUOTE_EXPIRED", "quote expired")
+)
+
+type QuotePolicy struct {
+	TTL time.Duration
+}
+
+type QuoteResult struct {
+	QuoteID             string
+	ResourceID          uuid.UUID
+	StartTime           time.Time
+	EndTime             time.Time
+	CouponCodes         []string
+	Discounts           []DiscountLine
+	PointsRedeemed      int64 // may be less than requested when the redemption cap applies
+	PointsDiscountCents int64
+	BaseCents           int64
+	DiscountCents       int64
+	SubtotalCents       int64
+	TaxCents            int64
+	TotalCents          int64
+	ExpiresAt           time.Time
+}
+
+// DiscountLine is one applied coupon of a quote, in application order.
+type DiscountLine struct {
+	CouponID    uuid.UUID
+	CouponCode  string
+	AmountCents int64
+}
+
+type QuoteCommands interface {
+	CreateQuote(ctx context.Context, req reqdto.CreateQuoteRequest, userID uuid.UUID) (*QuoteResult, error)
+	// Requote prices a reservation request afresh, leaving out the coupons that are no
+	// longer valid or no longer exist. It returns the normalized codes it left out.
+	Requote(ctx context.Context, req reqdto.CreateReservationRequest, userID uuid.UUID) (*QuoteResult, []string, error)
+}
+
+// QuoteExpiredError carries a fresh quote for a reservation request whose quote expired,
+// so the client can confirm the new price and retry with it in one round trip. Coupons
+// in DroppedCoupons are not part of the fresh quote. It matches ErrQuoteExpired.
+type QuoteExpiredError struct {
+	Quote          *QuoteResult
+	DroppedCoupons []string
+}
+
+func (e *QuoteExpiredError) Error() string {
+	return fmt.Sprintf("quote expired; requoted at %d cents", e.Quote.TotalCents)
+}
+
+func (e *QuoteExpiredError) Is(target error) bool {
+	return target == ErrQuoteExpired
+}
+
+func (e *QuoteExpiredError) ErrorCode() errs.Code {
+	return errs.CodeOf(ErrQuoteExpired)
+}
+
+// quoteClaims is the signed payload behind a quote ID; the quote is stateless
+// and only valid for the user, resource, slot and coupons it was issued for.
+type quoteClaims struct {
+	UserID        uuid.UUID        `json:"uid"`
+	ResourceID    uuid.UUID        `json:"rid"`
+	StartTime     time.Time        `json:"st"`
+	EndTime       time.Time        `json:"et"`
+	CouponCodes   []string         `json:"ccs,omitempty"` // normalized, see normalizeCouponCodes
+	Discounts     []quotedDiscount `json:"dis,omitempty"`
+	RedeemPoints  int64            `json:"rp,omitempty"` // as requested
+	Points        *quotedPoints    `json:"pts,omitempty"`
+	SubtotalCents int64            `json:"sub"`
+	TotalCents    int64            `json:"tot"`
+}
+
+type quotedDiscount struct {
+	CouponID    uuid.UUID `json:"cid"`
+	CouponCode  string    `json:"cc"`
+	AmountCents int64     `json:"amt"`
+}
+
+type quotedPoints struct {
+	Points      int64 `json:"n"`
+	AmountCents int64 `json:"amt"`
+}
+
+func (c *quoteClaims) redeemedPoints() reservation.RedeemedPoints {
+	if c.Points == nil {
+		return reservation.RedeemedPoints{}
+	}
+	return reservation.RedeemedPoints{Points: c.Points.Points, Amount: reservation.NewMoney(c.Points.AmountCents)}
+}
+
+func (c *quoteClaims) appliedDiscounts() []reservation.AppliedDiscount {
+	if len(c.Discounts) == 0 {
+		return nil
+	}
+	lines := make([]reservation.AppliedDiscount, len(c.Discounts))
+	for i, d := range c.Discounts {
+		lines[i] = reservation.AppliedDiscount{CouponID: d.CouponID, Code: d.CouponCode, Amount: reservation.NewMoney(d.AmountCents)}
+	}
+	return lines
+}
+
+type quoteCommandsImpl struct {
+	uow       shared.UnitOfWork
+	services  *reservation.Services
+	clock     clock.Clock
+	resources shared.ResourceReadStore
+	coupons   shared.CouponReadStore
+	loyalty   shared.LoyaltyReadStore
+	signer    *signedtoken.Signer
+	policy    QuotePolicy
+}
+
+func NewQuoteCommands(
+	uow shared.UnitOfWork,
+	services *reservation.Services,
+	clock clock.Clock,
+	resources shared.ResourceReadStore,
+	coupons shared.CouponReadStore,
+	loyalty shared.LoyaltyReadStore,
+	signer *signedtoken.Signer,
+	policy QuotePolicy,
+) QuoteCommands {
+	return &quoteCommandsImpl{
+		uow:       uow,
+		services:  services,
+		clock:     clock,
+		resources: resources,
+		coupons:   coupons,
+		loyalty:   loyalty,
+		signer:    signer,
+		policy:    policy,
+	}
+}
+
+func (q *quoteCommandsImpl) CreateQuote(ctx context.Context, req reqdto.CreateQuoteRequest, userID uuid.UUID) (*QuoteResult, error) {
+	slot, err := req.ToDomain()
+	if err != nil {
+		return nil, errs.Mark(err, ErrInvalidTimeSlot)
+	}
+
+	codes := req.GetCouponCodes()
+	snapshots, err := loadPricingSnapshots(ctx, q.uow.DB(ctx), q.resources, q.coupons, req.ResourceID, codes)
+	if err != nil {
+		return nil, err
+	}
+	return q.issue(ctx, userID, snapshots, slot, normalizeCouponCodes(codes), req.GetRedeemPoints())
+}
+
+func (q *quoteCommandsImpl) Requote(ctx context.Context, req reqdto.CreateReservationRequest, userID uuid.UUID) (*QuoteResult, []string, error) {
+	slot, err := reservation.NewTimeSlot(req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, nil, errs.Mark(err, ErrInvalidTimeSlot)
+	}
+
+	snapshots, err := loadPricingSnapshots(ctx, q.uow.DB(ctx), q.resources, q.coupons, req.ResourceID, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	now := q.clock.Now()
+	var kept, dropped []string
+	for _, code := range normalizeCouponCodes(req.GetCouponCodes()) {
+		cs, ferr := q.coupons.FindByCode(ctx, q.uow.DB(ctx), code)
+		if ferr != nil && !infra.IsKind(ferr, infra.KindNotFound) {
+			return nil, nil, errs.Mark(ferr, errDatabaseOperationFailed)
+		}
+		if ferr != nil || !couponSpec(*cs).ValidAt(now) {
+			dropped = append(dropped, code)
+			continue
+		}
+		kept = append(kept, code)
+		snapshots.Coupons = append(snapshots.Coupons, *cs)
+	}
+
+	quote, err := q.issue(ctx, userID, snapshots, slot, kept, req.GetRedeemPoints())
+	if err != nil {
+		return nil, nil, err
+	}
+	return quote, dropped, nil
+}
+
+// issue prices slot with the loaded snapshots and signs the result into a quote ID.
+// codes are the normalized codes of snapshots.Coupons.
+func (q *quoteCommandsImpl) issue(
+	ctx context.Context,
+	userID uuid.UUID,
+	snapshots Snapshots,
+	slot reservation.TimeSlot,
+	codes []string,
+	points int64,
+) (*QuoteResult, error) {
+	if err := checkPointsBalance(ctx, q.uow.DB(ctx), q.loyalty, userID, points, q.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	quote, err := reservation.NewQuote(q.services, snapshots.resourceSpec(), slot, snapshots.couponSpecs(), points)
+	if err != nil {
+		return nil, mapPricingError(err)
+	}
+
+	claims := quoteClaims{
+		UserID:        userID,
+		ResourceID:    snapshots.Resource.ID,
+		StartTime:     slot.Start().UTC(),
+		EndTime:       slot.End().UTC(),
+		CouponCodes:   codes,
+		RedeemPoints:  points,
+		SubtotalCents: int64(quote.Subtotal().Cents()),
+		TotalCents:    int64(quote.Total().Cents()),
+	}
+	if redeemed := quote.Points(); redeemed.Points > 0 {
+		claims.Points = &quotedPoints{Points: redeemed.Points, AmountCents: int64(redeemed.Amount.Cents())}
+	}
+	lines := make([]DiscountLine, len(quote.Discounts()))
+	for i, d := range quote.Discounts() {
+		lines[i] = DiscountLine{CouponID: d.CouponID, CouponCode: d.Code, AmountCents: int64(d.Amount.Cents())}
+		claims.Discounts = append(claims.Discounts, quotedDiscount{CouponID: d.CouponID, CouponCode: d.Code, AmountCents: lines[i].AmountCents})
+	}
+
+	expiresAt := q.clock.Now().Add(q.policy.TTL)
+	token, err := q.signer.Sign(QuoteTokenPurpose, claims, expiresAt)
+	if err != nil {
+		return nil, errs.Mark(err, ErrTokenGeneration)
+	}
+
+	return &QuoteResult{
+		QuoteID:             token,
+		ResourceID:          claims.ResourceID,
+		StartTime:           claims.StartTime,
+		EndTime:             claims.EndTime,
+		CouponCodes:         claims.CouponCodes,
+		Discounts:           lines,
+		PointsRedeemed:      quote.Points().Points,
+		PointsDiscountCents: int64(quote.Points().Amount.Cents()),
+		BaseCents:           int64(quote.Base().Cents()),
+		DiscountCents:       int64(quote.Discount().Cents()),
+		SubtotalCents:       claims.SubtotalCents,
+		TaxCents:            int64(quote.Tax().Cents()),
+		TotalCents:          claims.TotalCents,
+		ExpiresAt:           expiresAt,
+	}, nil
+}
+
+// verifyQuote accepts a quote only when it matches the reservation being created.
+func verifyQuote(
+	signer *signedtoken.Signer,
+	token string,
+	now time.Time,
+	userID uuid.UUID,
+	req reqdto.CreateReservationRequest,
+) (*quoteClaims, error) {
+	var claims quoteClaims
+	if _, err := signer.Verify(QuoteTokenPurpose, token, now, &claims); err != nil {
+		if errors.Is(err, signedtoken.ErrExpiredToken) {
+			return nil, errs.Mark(err, ErrQuoteExpired)
+		}
+		return nil, errs.Mark(err, ErrInvalidQuote)
+	}
+
+	if claims.UserID != userID ||
+		claims.ResourceID != req.ResourceID ||
+		!claims.StartTime.Equal(req.StartTime) ||
+		!claims.EndTime.Equal(req.EndTime) ||
+		!slices.Equal(claims.CouponCodes, normalizeCouponCodes(req.GetCouponCodes())) ||
+		claims.RedeemPoints != req.GetRedeemPoints() {
+		return nil, ErrInvalidQuote
+	}
+
+	return &claims, nil
+}
+
+// normalizeCouponCodes lowercases and sorts codes in place: coupon codes are
+// case-insensitive and the stacking policy, not the client, decides the order.
+func normalizeCouponCodes(codes []string) []string {
+	for i, code := range codes {
+		codes[i] = strings.ToLower(code)
+	}
+	slices.Sort(codes)
+	return codes
+}
+
+// loadPricingSnapshots loads resource and coupon data as snapshots without validation.
+// Domain validation is performed by the Quote / Reservation constructors.
+func loadPricingSnapshots(
+	ctx context.Context,
+	db sqlc.DBTX,
+	resources shared.ResourceReadStore,
+	coupons shared.CouponReadStore,
+	resourceID uuid.UUID,
+	couponCodes []string,
+) (Snapshots, error) {
+	var snapshots Snapshots
+
+	rs, err := resources.FindByID(ctx, db, resourceID)
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return snapshots, ErrResourceNotFound
+		}
+		return snapshots, errs.Mark(err, errDatabaseOperationFailed)
+	}
+	snapshots.Resource = *rs
+
+	for _, code := range couponCodes {
+		cs, err := coupons.FindByCode(ctx, db, strings.ToLower(code))
+		if err != nil {
+			if infra.IsKind(err, infra.KindNotFound) {
+				return snapshots, ErrCouponNotFound
+			}
+			return snapshots, errs.Mark(err, errDatabaseOperationFailed)
+		}
+		snapshots.Coupons = append(snapshots.Coupons, *cs)
+	}
+
+	return snapshots, nil
+}
+
+func mapPricingError(err error) error {
+	if errors.Is(err, reservation.ErrLeadTimeNotMet) {
+		return ErrInsufficientLeadTime
+	}
+	if errors.Is(err, reservation.ErrInvalidCoupon) {
+		return ErrInvalidCoupon
+	}
+	if errors.Is(err, reservation.ErrCouponStackingNotAllowed) {
+		return ErrCouponNotStackable
+	}
+	if errors.Is(err, reservation.ErrDuplicateCoupon) {
+		return ErrDuplicateCoupon
+	}
+	return errs.Mark(err, ErrDomainValidation)
+}
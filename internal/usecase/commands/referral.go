@@ -0,0 +1,155 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"gin-clean-starter/internal/domain/referral"
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+const NotificationTopicReferralRewarded = "referral_rewarded"
+
+var (
+	ErrInvalidReferralCode  = errs.NewCoded("INVALID_REFERRAL_CODE", "unknown referral code")
+	ErrReferralRewardFailed = errs.New("referral reward run failed")
+)
+
+// ReferralPolicy holds the reward amounts and how many referrals one run settles.
+type ReferralPolicy struct {
+	Reward    referral.RewardPolicy
+	BatchSize int32
+}
+
+type ReferralCommands interface {
+	// IssueRewards credits both parties of every pending referral whose referred user
+	// has completed a reservation, and returns how many referrals were rewarded.
+	IssueRewards(ctx context.Context) (int, error)
+}
+
+type referralCommandsImpl struct {
+	uow       shared.UnitOfWork
+	readStore shared.ReferralReadStore
+	policy    ReferralPolicy
+	clock     clock.Clock
+}
+
+func NewReferralCommands(uow shared.UnitOfWork, readStore shared.ReferralReadStore, policy ReferralPolicy, clock clock.Clock) ReferralCommands {
+	return &referralCommandsImpl{
+		uow:       uow,
+		readStore: readStore,
+		policy:    policy,
+		clock:     clock,
+	}
+}
+
+// A reservation completes by its slot ending rather than by a write, so completion is
+// detected here on a schedule; each rewarded referral emits a notification per credited party.
+func (r *referralCommandsImpl) IssueRewards(ctx context.Context) (int, error) {
+	now := r.clock.Now()
+	pending, err := r.readStore.ListRewardable(ctx, r.uow.DB(ctx), now, r.policy.BatchSize)
+	if err != nil {
+		return 0, errs.Mark(err, ErrReferralRewardFailed)
+	}
+
+	rewarded := 0
+	for _, ref := range pending {
+		if cerr := ctx.Err(); cerr != nil {
+			return rewarded, cerr
+		}
+
+		issued, rerr := r.reward(ctx, ref, now)
+		if rerr != nil {
+			return rewarded, errs.Mark(rerr, ErrReferralRewardFailed)
+		}
+		if issued {
+			rewarded++
+		}
+	}
+	return rewarded, nil
+}
+
+// reward reports false when another run settled the referral first.
+func (r *referralCommandsImpl) reward(ctx context.Context, ref shared.RewardableReferral, now time.Time) (bool, error) {
+	issued := false
+	err := r.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		if err := tx.Referrals().MarkRewarded(ctx, tx.DB(), ref.ID, ref.ReservationID, now); err != nil {
+			if infra.IsKind(err, infra.KindConflict) {
+				return nil
+			}
+			return err
+		}
+
+		for _, credit := range r.policy.Reward.Credits(ref.ReferrerID, ref.ReferredID) {
+			if err := tx.Referrals().CreateCredit(ctx, tx.DB(), ref.ID, credit); err != nil {
+				return err
+			}
+			err := tx.Activity().Record(ctx, tx.DB(), shared.ActivityEntry{
+				UserID:     credit.UserID,
+				Kind:       shared.ActivityCreditEarned,
+				SubjectID:  ref.ID,
+				Data:       map[string]any{"party": credit.Party, "amountCents": credit.AmountCents},
+				OccurredAt: now,
+			})
+			if err != nil {
+				return err
+			}
+
+			payload, err := json.Marshal(map[string]any{
+				"referral_id":  ref.ID,
+				"user_id":      credit.UserID,
+				"party":        credit.Party,
+				"amount_cents": credit.AmountCents,
+				"type":         NotificationTopicReferralRewarded,
+			})
+			if err != nil {
+				return err
+			}
+			if err = queueUserNotification(ctx, tx, credit.UserID, NotificationTopicReferralRewarded, payload, now); err != nil {
+				return err
+			}
+		}
+
+		issued = true
+		return nil
+	})
+	return issued, err
+}
+
+// parseReferralCode validates an optional registration referral code up front, before
+// any password hashing or transaction work.
+func parseReferralCode(code *string) (*referral.Code, error) {
+	if code == nil {
+		return nil, nil
+	}
+	parsed, err := referral.NewCode(*code)
+	if err != nil {
+		return nil, errs.Mark(err, ErrInvalidReferralCode)
+	}
+	return &parsed, nil
+}
+
+// attributeReferral links a newly registered user to the owner of code inside the
+// registration transaction; a nil code is a registration without a referral.
+func attributeReferral(ctx context.Context, tx shared.Tx, referrals shared.ReferralReadStore, code *referral.Code, referredID uuid.UUID) error {
+	if code == nil {
+		return nil
+	}
+
+	referrerID, err := referrals.FindReferrerByCode(ctx, tx.DB(), code.String())
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return errs.Mark(err, ErrInvalidReferralCode)
+		}
+		return err
+	}
+
+	_, err = tx.Referrals().Create(ctx, tx.DB(), referrerID, referredID)
+	return err
+}
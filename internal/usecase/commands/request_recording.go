@@ -0,0 +1,198 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	reqdto "gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+const (
+	AuditActionRequestRecordingEnabled = "request_recording.enabled"
+	AuditActionRequestRecordingDeleted = "request_recording.deleted"
+
+	auditTargetUser             = "user"
+	auditTargetRequestRecording = "request_recording"
+)
+
+var (
+	ErrRequestRecordingLimitInvalid = errs.NewCoded("INVALID_REQUEST_RECORDING_LIMIT", "request recording limit is invalid")
+	ErrRequestRecordingNotFound     = errs.NewCoded("REQUEST_RECORDING_NOT_FOUND", "request recording not found")
+	ErrRequestRecordingFailed       = errs.New("request recording failed")
+)
+
+// RequestRecordingPolicy bounds recordings: each expires TTL after it is enabled and
+// captures at most MaxRequests requests. Purge deletes expired ones BatchSize at a time.
+type RequestRecordingPolicy struct {
+	TTL         time.Duration
+	MaxRequests int32
+	BatchSize   int32
+}
+
+type RequestRecordingCommands interface {
+	// Enable starts recording the user's next requests and records the consent in the
+	// audit trail.
+	Enable(ctx context.Context, userID uuid.UUID, req reqdto.EnableRequestRecordingRequest, actorID uuid.UUID) (*shared.RequestRecording, error)
+	// Delete removes a recording and its captures before it expires.
+	Delete(ctx context.Context, id, actorID uuid.UUID) error
+	// Purge deletes expired recordings with their captures and returns how many it deleted.
+	Purge(ctx context.Context) (int, error)
+}
+
+type requestRecordingCommandsImpl struct {
+	uow       shared.UnitOfWork
+	readStore shared.RequestRecordingReadStore
+	repo      shared.RequestRecordingRepository
+	recorder  shared.RequestRecorder
+	storage   shared.FileStorage
+	clock     clock.Clock
+	policy    RequestRecordingPolicy
+}
+
+func NewRequestRecordingCommands(
+	uow shared.UnitOfWork,
+	readStore shared.RequestRecordingReadStore,
+	repo shared.RequestRecordingRepository,
+	recorder shared.RequestRecorder,
+	storage shared.FileStorage,
+	clock clock.Clock,
+	policy RequestRecordingPolicy,
+) RequestRecordingCommands {
+	return &requestRecordingCommandsImpl{
+		uow:       uow,
+		readStore: readStore,
+		repo:      repo,
+		recorder:  recorder,
+		storage:   storage,
+		clock:     clock,
+		policy:    policy,
+	}
+}
+
+func (c *requestRecordingCommandsImpl) Enable(ctx context.Context, userID uuid.UUID, req reqdto.EnableRequestRecordingRequest, actorID uuid.UUID) (*shared.RequestRecording, error) {
+	if req.MaxRequests < 1 || req.MaxRequests > c.policy.MaxRequests {
+		return nil, ErrRequestRecordingLimitInvalid
+	}
+
+	now := c.clock.Now()
+	rec := shared.RequestRecording{
+		ID:               uuid.New(),
+		UserID:           userID,
+		EnabledBy:        actorID,
+		Reason:           req.Reason,
+		ConsentReference: req.ConsentReference,
+		MaxRequests:      req.MaxRequests,
+		ExpiresAt:        now.Add(c.policy.TTL),
+		CreatedAt:        now,
+	}
+
+	err := c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		if err := c.repo.Create(ctx, tx.DB(), rec); err != nil {
+			if infra.IsKind(err, infra.KindForeignKeyViolated) {
+				return errs.Mark(err, ErrUserNotFound)
+			}
+			return err
+		}
+		return tx.Audit().Record(ctx, tx.DB(), shared.AuditEntry{
+			ActorID:    &actorID,
+			Action:     AuditActionRequestRecordingEnabled,
+			TargetType: auditTargetUser,
+			TargetID:   userID.String(),
+			Metadata: map[string]any{
+				"recording_id":      rec.ID,
+				"reason":            rec.Reason,
+				"consent_reference": rec.ConsentReference,
+				"max_requests":      rec.MaxRequests,
+				"expires_at":        rec.ExpiresAt,
+			},
+		})
+	})
+	if err != nil {
+		return nil, errs.Mark(err, ErrRequestRecordingFailed)
+	}
+
+	c.recorder.Invalidate()
+	return &rec, nil
+}
+
+func (c *requestRecordingCommandsImpl) Delete(ctx context.Context, id, actorID uuid.UUID) error {
+	var keys []string
+	err := c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		var err error
+		keys, err = c.repo.Delete(ctx, tx.DB(), id)
+		if err != nil {
+			if infra.IsKind(err, infra.KindNotFound) {
+				return errs.Mark(err, ErrRequestRecordingNotFound)
+			}
+			return err
+		}
+		return tx.Audit().Record(ctx, tx.DB(), shared.AuditEntry{
+			ActorID:    &actorID,
+			Action:     AuditActionRequestRecordingDeleted,
+			TargetType: auditTargetRequestRecording,
+			TargetID:   id.String(),
+			Metadata: map[string]any{
+				"captures": len(keys),
+			},
+		})
+	})
+	if err != nil {
+		return errs.Mark(err, ErrRequestRecordingFailed)
+	}
+
+	c.recorder.Invalidate()
+	c.removeCaptures(ctx, keys)
+	return nil
+}
+
+// Purge works in batches of expired recordings, each deleted in its own transaction.
+func (c *requestRecordingCommandsImpl) Purge(ctx context.Context) (int, error) {
+	deleted := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return deleted, err
+		}
+
+		ids, err := c.readStore.ListExpired(ctx, c.uow.DB(ctx), c.clock.Now(), c.policy.BatchSize)
+		if err != nil {
+			return deleted, errs.Mark(err, ErrRequestRecordingFailed)
+		}
+
+		for _, id := range ids {
+			var keys []string
+			err = c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+				var derr error
+				keys, derr = c.repo.Delete(ctx, tx.DB(), id)
+				return derr
+			})
+			if err != nil && !infra.IsKind(err, infra.KindNotFound) {
+				return deleted, errs.Mark(err, ErrRequestRecordingFailed)
+			}
+			if err == nil {
+				deleted++
+				c.removeCaptures(ctx, keys)
+			}
+		}
+
+		if int32(len(ids)) < c.policy.BatchSize {
+			return deleted, nil
+		}
+	}
+}
+
+// removeCaptures runs after commit: a failed removal leaves unreferenced files, never
+// rows without content.
+func (c *requestRecordingCommandsImpl) removeCaptures(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		if err := c.storage.Delete(context.WithoutCancel(ctx), key); err != nil {
+			slog.Error("Failed to remove recorded request", "storage_key", key, "error", err.Error())
+		}
+	}
+}
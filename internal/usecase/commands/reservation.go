@@ -14,6 +14,7 @@ import (
 	"gin-clean-starter/internal/infra"
 	"gin-clean-starter/internal/pkg/clock"
 	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/pkg/signedtoken"
 	"gin-clean-starter/internal/usecase/shared"
 
 	"github.com/google/uuid"
@@ -24,22 +25,32 @@ const (
 	EndpointCreateReservation = "POST /reservations"
 	IdemStatusProcessing      = "processing"
 	IdemStatusCompleted       = "completed"
+	idempotencyKeyTTL         = 24 * time.Hour
 
-	NotificationKindEmail               = "email"
-	NotificationTopicReservationCreated = "reservation_created"
+	NotificationKindEmail                = "email"
+	NotificationTopicReservationCreated  = "reservation_created"
+	NotificationTopicReservationCanceled = "reservation_canceled"
 )
 
 // Public errors - used by handlers
 var (
-	ErrResourceNotFound      = errs.New("resource not found")
-	ErrCouponNotFound        = errs.New("coupon not found")
-	ErrInvalidTimeSlot       = errs.New("invalid time slot")
-	ErrInsufficientLeadTime  = errs.New("insufficient lead time")
-	ErrDuplicateReservation  = errs.New("duplicate reservation")
-	ErrReservationConflict   = errs.New("reservation conflict")
-	ErrInvalidCoupon         = errs.New("invalid coupon")
-	ErrIdempotencyInProgress = errs.New("idempotency in progress")
-	ErrDomainValidation      = errs.New("domain validation error")
+	ErrResourceNotFound      = errs.NewCoded("RESOURCE_NOT_FOUND", "resource not found")
+	ErrCouponNotFound        = errs.NewCoded("COUPON_NOT_FOUND", "coupon not found")
+	ErrInvalidTimeSlot       = errs.NewCoded("INVALID_TIME_SLOT", "invalid time slot")
+	ErrInsufficientLeadTime  = errs.NewCoded("INSUFFICIENT_LEAD_TIME", "insufficient lead time")
+	ErrDuplicateReservation  = errs.NewCoded("RESERVATION_CONFLICT", "duplicate reservation")
+	ErrReservationConflict   = errs.NewCoded("RESERVATION_CONFLICT", "reservation conflict")
+	ErrResourceBlocked       = errs.NewCoded("RESOURCE_BLOCKED", "time slot blocked on the resource")
+	ErrInvalidCoupon         = errs.NewCoded("INVALID_COUPON", "invalid coupon")
+	ErrCouponNotStackable    = errs.NewCoded("COUPON_STACKING_NOT_ALLOWED", "coupons cannot be combined")
+	ErrDuplicateCoupon       = errs.NewCoded("DUPLICATE_COUPON", "coupon applied more than once")
+	ErrIdempotencyInProgress = errs.NewCoded("IDEMPOTENCY_IN_PROGRESS", "idempotency in progress")
+	ErrDomainValidation      = errs.NewCoded("VALIDATION_FAILED", "domain validation error")
+
+	ErrReservationNotFoundWrite = errs.NewCoded("RESERVATION_NOT_FOUND", "reservation not found")
+	ErrReservationNotOwned      = errs.NewCoded("RESERVATION_NOT_OWNED", "reservation not owned by user")
+	ErrReservationNotCancelable = errs.NewCoded("RESERVATION_NOT_CANCELABLE", "reservation cannot be canceled")
+	ErrReservationCancelFailed  = errs.New("reservation cancel failed")
 )
 
 // Private errors - internal use only
@@ -56,20 +67,59 @@ type CreateReservationResult struct {
 
 type Snapshots struct {
 	Resource shared.ResourceSnapshot
-	Coupon   *shared.CouponSnapshot
+	Coupons  []shared.CouponSnapshot
+}
+
+func (s Snapshots) resourceSpec() reservation.ResourceSpec {
+	return reservation.ResourceSpec{
+		ID:          s.Resource.ID,
+		LeadTimeMin: s.Resource.LeadTimeMin,
+	}
+}
+
+func (s Snapshots) couponSpecs() []reservation.CouponSpec {
+	if len(s.Coupons) == 0 {
+		return nil
+	}
+	specs := make([]reservation.CouponSpec, len(s.Coupons))
+	for i, c := range s.Coupons {
+		specs[i] = couponSpec(c)
+	}
+	return specs
+}
+
+func couponSpec(c shared.CouponSnapshot) reservation.CouponSpec {
+	return reservation.CouponSpec{
+		ID:             c.ID,
+		Code:           c.Code,
+		Priority:       c.Priority,
+		AmountOffCents: c.AmountOffCents,
+		PercentOff:     c.PercentOff,
+		ValidFrom:      c.ValidFrom,
+		ValidTo:        c.ValidTo,
+	}
 }
 
 type ReservationCommands interface {
 	CreateReservation(ctx context.Context, req reqdto.CreateReservationRequest, userID uuid.UUID, idempotencyKey uuid.UUID) (*CreateReservationResult, error)
+	CreateGroup(ctx context.Context, req reqdto.CreateReservationGroupRequest, userID uuid.UUID, idempotencyKey uuid.UUID) (*CreateReservationGroupResult, error)
+	Cancel(ctx context.Context, reservationID uuid.UUID, actorID uuid.UUID, actorRole string) error
 }
 
 type reservationUseCaseImpl struct {
-	uow       shared.UnitOfWork
-	services  *reservation.Services
-	clock     clock.Clock
-	resources shared.ResourceReadStore
-	coupons   shared.CouponReadStore
-	idemReads shared.IdempotencyReadStore
+	uow          shared.UnitOfWork
+	services     *reservation.Services
+	clock        clock.Clock
+	resources    shared.ResourceReadStore
+	coupons      shared.CouponReadStore
+	idemReads    shared.IdempotencyReadStore
+	reservations shared.ReservationSnapshotReadStore
+	authorizer   shared.ResourceAuthorizer
+	signer       *signedtoken.Signer
+	approvals    shared.ReservationApprovalReadStore
+	approval     ApprovalPolicy
+	customFields shared.CustomFieldReadStore
+	quotes       QuoteCommands
 }
 
 func NewReservationCommands(
@@ -79,14 +129,28 @@ func NewReservationCommands(
 	resources shared.ResourceReadStore,
 	coupons shared.CouponReadStore,
 	idemReads shared.IdempotencyReadStore,
+	reservations shared.ReservationSnapshotReadStore,
+	authorizer shared.ResourceAuthorizer,
+	signer *signedtoken.Signer,
+	approvals shared.ReservationApprovalReadStore,
+	approval ApprovalPolicy,
+	customFields shared.CustomFieldReadStore,
+	quotes QuoteCommands,
 ) ReservationCommands {
 	return &reservationUseCaseImpl{
-		uow:       uow,
-		services:  services,
-		clock:     clock,
-		resources: resources,
-		coupons:   coupons,
-		idemReads: idemReads,
+		uow:          uow,
+		services:     services,
+		clock:        clock,
+		resources:    resources,
+		coupons:      coupons,
+		idemReads:    idemReads,
+		reservations: reservations,
+		authorizer:   authorizer,
+		signer:       signer,
+		approvals:    approvals,
+		approval:     approval,
+		customFields: customFields,
+		quotes:       quotes,
 	}
 }
 
@@ -101,19 +165,37 @@ func (r *reservationUseCaseImpl) CreateReservation(
 		return nil, errs.Mark(err, ErrInvalidTimeSlot)
 	}
 
+	var quoted *quoteClaims
+	if quoteID := req.GetQuoteID(); quoteID != nil {
+		quoted, err = verifyQuote(r.signer, *quoteID, r.clock.Now(), userID, req)
+		if errors.Is(err, ErrQuoteExpired) {
+			return nil, r.requote(ctx, req, userID)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	snapshots, err := r.loadSnapshots(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 
+	fields, err := checkCustomFields(ctx, r.uow.DB(ctx), r.customFields, req.ResourceID, req.CustomFields)
+	if err != nil {
+		return nil, err
+	}
+	// Hash the checked values, so a retry that differs only in whitespace still matches.
+	req.CustomFields = fields
+
 	requestHash := r.calculateNormalizedHash(req)
-	expiresAt := r.clock.Now().Add(24 * time.Hour)
+	expiresAt := r.clock.Now().Add(idempotencyKeyTTL)
 
 	var result *CreateReservationResult
 
 	err = r.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
 		var existingReservationID *uuid.UUID
-		existingReservationID, err = r.handleIdempotencyInTx(ctx, tx, idempotencyKey, userID, requestHash, expiresAt)
+		existingReservationID, err = r.handleIdempotencyInTx(ctx, tx, EndpointCreateReservation, idempotencyKey, userID, requestHash, expiresAt)
 		if err != nil {
 			return err
 		}
@@ -126,7 +208,7 @@ func (r *reservationUseCaseImpl) CreateReservation(
 		}
 
 		var reservationID *uuid.UUID
-		reservationID, err = r.createReservation(ctx, tx, snapshots, domainData.TimeSlot, domainData.Note, userID, idempotencyKey)
+		reservationID, err = r.createReservation(ctx, tx, snapshots, quoted, req.GetRedeemPoints(), domainData.TimeSlot, domainData.Note, fields, userID, idempotencyKey)
 		if err != nil {
 			return err
 		}
@@ -143,15 +225,91 @@ func (r *reservationUseCaseImpl) CreateReservation(
 	return result, nil
 }
 
+// requote answers a request whose quote expired with a fresh quote. Errors that keep the
+// slot from being priced at all, such as a lead time no longer met, are returned as is.
+func (r *reservationUseCaseImpl) requote(ctx context.Context, req reqdto.CreateReservationRequest, userID uuid.UUID) error {
+	quote, dropped, err := r.quotes.Requote(ctx, req, userID)
+	if err != nil {
+		return err
+	}
+	return &QuoteExpiredError{Quote: quote, DroppedCoupons: dropped}
+}
+
+// Cancel is allowed for the reservation owner, or for operators holding a cancel grant that
+// covers the reservation's resource. Only confirmed or pending reservations that have not
+// ended qualify; canceling a pending one withdraws its approval request.
+func (r *reservationUseCaseImpl) Cancel(ctx context.Context, reservationID uuid.UUID, actorID uuid.UUID, actorRole string) error {
+	err := r.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		snap, err := r.reservations.FindSnapshotByID(ctx, tx.DB(), reservationID)
+		if err != nil {
+			if infra.IsKind(err, infra.KindNotFound) {
+				return errs.Mark(err, ErrReservationNotFoundWrite)
+			}
+			return err
+		}
+
+		if snap.UserID != actorID {
+			allowed, aerr := r.authorizer.CanActOnResource(ctx, actorID, actorRole, shared.PermissionReservationsCancelAny, shared.PermissionReservationsCancelAssigned, snap.ResourceID)
+			if aerr != nil {
+				return aerr
+			}
+			if !allowed {
+				return ErrReservationNotOwned
+			}
+		}
+
+		pending := snap.Status == string(reservation.StatusPendingApproval)
+		if (snap.Status != string(reservation.StatusConfirmed) && !pending) || !snap.EndTime.After(r.clock.Now()) {
+			return ErrReservationNotCancelable
+		}
+
+		if err = tx.Reservations().Cancel(ctx, tx.DB(), reservationID); err != nil {
+			if infra.IsKind(err, infra.KindConflict) {
+				return errs.Mark(err, ErrReservationNotCancelable)
+			}
+			return err
+		}
+		if pending {
+			err = tx.ReservationApprovals().Decide(ctx, tx.DB(), shared.ApprovalDecision{
+				ReservationID: reservationID,
+				Status:        shared.ApprovalStatusWithdrawn,
+				DecidedBy:     actorID,
+				DecidedAt:     r.clock.Now(),
+			})
+			if err != nil && !infra.IsKind(err, infra.KindConflict) {
+				return err
+			}
+		}
+
+		err = tx.Activity().Record(ctx, tx.DB(), shared.ActivityEntry{
+			UserID:     snap.UserID,
+			Kind:       shared.ActivityReservationCanceled,
+			SubjectID:  reservationID,
+			ResourceID: &snap.ResourceID,
+			OccurredAt: r.clock.Now(),
+		})
+		if err != nil {
+			return err
+		}
+
+		return r.createNotificationJob(ctx, tx, reservationID, snap.UserID, NotificationTopicReservationCanceled)
+	})
+	if err != nil {
+		return errs.Mark(err, ErrReservationCancelFailed)
+	}
+	return nil
+}
+
 func (r *reservationUseCaseImpl) handleIdempotencyInTx(
 	ctx context.Context,
 	tx shared.Tx,
+	endpoint string,
 	idempotencyKey, userID uuid.UUID,
 	requestHash string,
 	expiresAt time.Time,
 ) (*uuid.UUID, error) {
 	inserted := true
-	if err := tx.Idempotency().TryInsert(ctx, tx.DB(), idempotencyKey, userID, EndpointCreateReservation, requestHash, expiresAt); err != nil {
+	if err := tx.Idempotency().TryInsert(ctx, tx.DB(), idempotencyKey, userID, endpoint, requestHash, expiresAt); err != nil {
 		if !infra.IsKind(err, infra.KindConflict) {
 			return nil, errs.Mark(err, errors.New("failed to insert idempotency key"))
 		}
@@ -204,34 +362,36 @@ func (r *reservationUseCaseImpl) createReservation(
 	ctx context.Context,
 	tx shared.Tx,
 	snapshots Snapshots,
+	quoted *quoteClaims,
+	points int64,
 	slot reservation.TimeSlot,
 	note reservation.Note,
+	fields reservation.CustomFields,
 	userID, idempotencyKey uuid.UUID,
 ) (*uuid.UUID, error) {
-	resSpec := reservation.ResourceSpec{
-		ID:          snapshots.Resource.ID,
-		LeadTimeMin: snapshots.Resource.LeadTimeMin,
-	}
-	var coupSpec *reservation.CouponSpec
-	if snapshots.Coupon != nil {
-		coupSpec = &reservation.CouponSpec{
-			ID:             snapshots.Coupon.ID,
-			AmountOffCents: snapshots.Coupon.AmountOffCents,
-			PercentOff:     snapshots.Coupon.PercentOff,
-			ValidFrom:      snapshots.Coupon.ValidFrom,
-			ValidTo:        snapshots.Coupon.ValidTo,
-		}
+	var reservationEntity *reservation.Reservation
+	var err error
+	if quoted != nil {
+		reservationEntity, err = reservation.NewQuotedReservation(r.services, snapshots.resourceSpec(), userID, slot, quoted.appliedDiscounts(), quoted.redeemedPoints(), note, quoted.SubtotalCents)
+	} else {
+		reservationEntity, err = reservation.NewReservation(r.services, snapshots.resourceSpec(), userID, slot, snapshots.couponSpecs(), points, note)
+	}
+	if err != nil {
+		return nil, mapPricingError(err)
 	}
+	reservationEntity.SetCustomFields(fields)
 
-	reservationEntity, err := reservation.NewReservation(r.services, resSpec, userID, slot, coupSpec, note)
+	blocked, err := tx.ResourceBlocks().Overlaps(ctx, tx.DB(), snapshots.Resource.ID, slot.Start(), slot.End())
 	if err != nil {
-		if errors.Is(err, reservation.ErrLeadTimeNotMet) {
-			return nil, ErrInsufficientLeadTime
-		}
-		if errors.Is(err, reservation.ErrInvalidCoupon) {
-			return nil, ErrInvalidCoupon
-		}
-		return nil, errs.Mark(err, ErrDomainValidation)
+		return nil, errs.Mark(err, errDatabaseOperationFailed)
+	}
+	if blocked {
+		return nil, ErrResourceBlocked
+	}
+
+	approvers, err := r.holdForApproval(ctx, tx, reservationEntity)
+	if err != nil {
+		return nil, err
 	}
 
 	reservationID, err := tx.Reservations().Create(ctx, tx.DB(), reservationEntity)
@@ -242,7 +402,25 @@ func (r *reservationUseCaseImpl) createReservation(
 		return nil, errs.Mark(err, errDatabaseOperationFailed)
 	}
 
-	if notificationErr := r.createNotificationJobByID(ctx, tx, reservationID); notificationErr != nil {
+	if err = redeemPoints(ctx, tx, userID, reservationID, reservationEntity.Points(), r.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	// The note opens the reservation's message thread.
+	if !note.IsEmpty() {
+		_, err = tx.ReservationMessages().Create(ctx, tx.DB(), shared.ReservationMessage{
+			ReservationID: reservationID,
+			AuthorID:      userID,
+			AuthorSide:    shared.MessageSideUser,
+			Body:          note.String(),
+			CreatedAt:     r.clock.Now(),
+		})
+		if err != nil {
+			return nil, errs.Mark(err, errDatabaseOperationFailed)
+		}
+	}
+
+	if notificationErr := r.notifyCreated(ctx, tx, reservationID, reservationEntity, approvers); notificationErr != nil {
 		return nil, errs.Mark(notificationErr, errDatabaseOperationFailed)
 	}
 
@@ -255,56 +433,67 @@ func (r *reservationUseCaseImpl) createReservation(
 	return &reservationID, nil
 }
 
-// loadSnapshots loads resource and coupon data as snapshots without validation.
-// Domain validation is performed within the Reservation aggregate.
 func (r *reservationUseCaseImpl) loadSnapshots(
 	ctx context.Context,
 	req reqdto.CreateReservationRequest,
 ) (Snapshots, error) {
-	var snapshots Snapshots
+	return loadPricingSnapshots(ctx, r.uow.DB(ctx), r.resources, r.coupons, req.ResourceID, req.GetCouponCodes())
+}
 
-	var rs *shared.ResourceSnapshot
-	db := r.uow.DB(ctx)
-	var err error
-	rs, err = r.resources.FindByID(ctx, db, req.ResourceID)
+// holdForApproval puts the reservation on hold when its resource has designated approvers,
+// and returns them.
+func (r *reservationUseCaseImpl) holdForApproval(ctx context.Context, tx shared.Tx, entity *reservation.Reservation) ([]uuid.UUID, error) {
+	approvers, err := r.approvals.ApproverIDs(ctx, tx.DB(), entity.ResourceID())
 	if err != nil {
-		if infra.IsKind(err, infra.KindNotFound) {
-			return snapshots, ErrResourceNotFound
-		}
-		return snapshots, errs.Mark(err, errDatabaseOperationFailed)
+		return nil, errs.Mark(err, errDatabaseOperationFailed)
 	}
-	snapshots.Resource = *rs
+	if len(approvers) > 0 {
+		entity.RequireApproval()
+	}
+	return approvers, nil
+}
 
-	if code := req.GetCouponCode(); code != nil {
-		normalizedCode := strings.ToLower(*code)
-		var cs *shared.CouponSnapshot
-		cs, err = r.coupons.FindByCode(ctx, db, normalizedCode)
-		if err != nil {
-			if infra.IsKind(err, infra.KindNotFound) {
-				return snapshots, ErrCouponNotFound
-			}
-			return snapshots, errs.Mark(err, errDatabaseOperationFailed)
-		}
-		snapshots.Coupon = cs
+// notifyCreated puts the reservation on its user's timeline and announces it when
+// confirmed, or opens the approval request of one on hold and notifies its approvers instead.
+func (r *reservationUseCaseImpl) notifyCreated(ctx context.Context, tx shared.Tx, reservationID uuid.UUID, entity *reservation.Reservation, approvers []uuid.UUID) error {
+	resourceID := entity.ResourceID()
+	err := tx.Activity().Record(ctx, tx.DB(), shared.ActivityEntry{
+		UserID:     entity.UserID(),
+		Kind:       shared.ActivityReservationCreated,
+		SubjectID:  reservationID,
+		ResourceID: &resourceID,
+		Data: map[string]any{
+			"status":    entity.Status(),
+			"startTime": entity.TimeSlot().Start(),
+			"endTime":   entity.TimeSlot().End(),
+		},
+		OccurredAt: r.clock.Now(),
+	})
+	if err != nil {
+		return err
 	}
 
-	return snapshots, nil
+	if !entity.IsPendingApproval() {
+		return r.createNotificationJob(ctx, tx, reservationID, entity.UserID(), NotificationTopicReservationCreated)
+	}
+	return openApproval(ctx, tx, r.approval, reservationID, entity.ResourceID(), entity.TimeSlot().Start(), approvers, r.clock.Now())
 }
 
-func (r *reservationUseCaseImpl) createNotificationJobByID(
+func (r *reservationUseCaseImpl) createNotificationJob(
 	ctx context.Context,
 	tx shared.Tx,
-	reservationID uuid.UUID,
+	reservationID, userID uuid.UUID,
+	topic string,
 ) error {
 	notificationPayload, err := json.Marshal(map[string]any{
 		"reservation_id": reservationID,
-		"type":           NotificationTopicReservationCreated,
+		"type":           topic,
 	})
 	if err != nil {
 		return err
 	}
 
-	return tx.Notifications().CreateJob(ctx, tx.DB(), NotificationKindEmail, NotificationTopicReservationCreated, notificationPayload, r.clock.Now())
+	return queueUserNotification(ctx, tx, userID, topic, notificationPayload, r.clock.Now())
 }
 
 func (r *reservationUseCaseImpl) calculateIDHash(id uuid.UUID) string {
@@ -313,18 +502,15 @@ func (r *reservationUseCaseImpl) calculateIDHash(id uuid.UUID) string {
 }
 
 func (r *reservationUseCaseImpl) calculateNormalizedHash(req reqdto.CreateReservationRequest) string {
-	normalizedCouponCode := req.GetCouponCode()
-	if normalizedCouponCode != nil {
-		lowered := strings.ToLower(*normalizedCouponCode)
-		normalizedCouponCode = &lowered
-	}
-
 	normalized := reqdto.CreateReservationRequest{
-		ResourceID: req.ResourceID,
-		StartTime:  req.StartTime.UTC(),
-		EndTime:    req.EndTime.UTC(),
-		CouponCode: normalizedCouponCode,
-		Note:       normalizeNote(req.Note),
+		ResourceID:   req.ResourceID,
+		StartTime:    req.StartTime.UTC(),
+		EndTime:      req.EndTime.UTC(),
+		CouponCodes:  normalizeCouponCodes(req.GetCouponCodes()),
+		Note:         normalizeNote(req.Note),
+		QuoteID:      req.GetQuoteID(),
+		RedeemPoints: req.RedeemPoints,
+		CustomFields: req.CustomFields,
 	}
 	data, _ := json.Marshal(normalized)
 	hash := sha256.Sum256(data)
@@ -0,0 +1,387 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"gin-clean-starter/internal/domain/reservation"
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+const (
+	NotificationTopicApprovalRequested   = "reservation_approval_requested"
+	NotificationTopicApprovalEscalated   = "reservation_approval_escalated"
+	NotificationTopicApprovalExpired     = "reservation_approval_expired"
+	NotificationTopicReservationApproved = "reservation_approved"
+	NotificationTopicReservationRejected = "reservation_rejected"
+
+	AuditActionReservationApproved = "reservation.approved"
+	AuditActionReservationRejected = "reservation.rejected"
+)
+
+var (
+	ErrReservationNotPendingApproval = errs.NewCoded("RESERVATION_NOT_PENDING_APPROVAL", "reservation is not pending approval")
+	ErrApprovalExpired               = errs.NewCoded("RESERVATION_APPROVAL_EXPIRED", "approval request expired")
+	ErrNotReservationApprover        = errs.NewCoded("RESERVATION_APPROVER_REQUIRED", "not an approver of the reservation's resource")
+	ErrApprovalOwnReservation        = errs.NewCoded("RESERVATION_APPROVAL_OWN", "approvers cannot decide on their own reservations")
+	ErrApproverTargetNotFound        = errs.NewCoded("APPROVER_TARGET_NOT_FOUND", "resource or user not found")
+	ErrApproverNotFound              = errs.NewCoded("APPROVER_NOT_FOUND", "resource approver not found")
+	ErrReservationApprovalFailed     = errs.New("reservation approval failed")
+	ErrApprovalSweepFailed           = errs.New("reservation approval sweep failed")
+)
+
+// ApprovalPolicy bounds how long a reservation waits for approval. A request expires after
+// TTL or when the reservation's slot starts, whichever comes first; one still undecided
+// after EscalateAfter is escalated once (0 turns escalation off). BatchSize bounds one run
+// of each step of the sweep.
+type ApprovalPolicy struct {
+	TTL           time.Duration
+	EscalateAfter time.Duration
+	BatchSize     int32
+}
+
+func (p ApprovalPolicy) expiresAt(now, start time.Time) time.Time {
+	expiresAt := now.Add(p.TTL)
+	if start.Before(expiresAt) {
+		return start
+	}
+	return expiresAt
+}
+
+// ApprovalSweep counts what one run of Sweep did.
+type ApprovalSweep struct {
+	Escalated int
+	Expired   int
+}
+
+type ReservationApprovalCommands interface {
+	// Approve confirms a reservation pending approval. Designated approvers of its resource
+	// and holders of reservations:approve:any may decide, except on their own reservations.
+	Approve(ctx context.Context, reservationID, actorID uuid.UUID, actorRole string) error
+	// Reject cancels a reservation pending approval; reason is passed on to its user.
+	Reject(ctx context.Context, reservationID uuid.UUID, reason string, actorID uuid.UUID, actorRole string) error
+	AddApprover(ctx context.Context, resourceID, userID uuid.UUID) error
+	RemoveApprover(ctx context.Context, resourceID, userID uuid.UUID) error
+	// Sweep escalates requests that have waited EscalateAfter and expires those past due,
+	// canceling their reservations.
+	Sweep(ctx context.Context) (ApprovalSweep, error)
+}
+
+type reservationApprovalCommandsImpl struct {
+	uow          shared.UnitOfWork
+	clock        clock.Clock
+	reservations shared.ReservationSnapshotReadStore
+	approvals    shared.ReservationApprovalReadStore
+	permissions  shared.PermissionResolver
+	policy       ApprovalPolicy
+}
+
+func NewReservationApprovalCommands(
+	uow shared.UnitOfWork,
+	clock clock.Clock,
+	reservations shared.ReservationSnapshotReadStore,
+	approvals shared.ReservationApprovalReadStore,
+	permissions shared.PermissionResolver,
+	policy ApprovalPolicy,
+) ReservationApprovalCommands {
+	return &reservationApprovalCommandsImpl{
+		uow:          uow,
+		clock:        clock,
+		reservations: reservations,
+		approvals:    approvals,
+		permissions:  permissions,
+		policy:       policy,
+	}
+}
+
+func (c *reservationApprovalCommandsImpl) Approve(ctx context.Context, reservationID, actorID uuid.UUID, actorRole string) error {
+	return c.decide(ctx, reservationID, shared.ApprovalStatusApproved, "", actorID, actorRole)
+}
+
+func (c *reservationApprovalCommandsImpl) Reject(ctx context.Context, reservationID uuid.UUID, reason string, actorID uuid.UUID, actorRole string) error {
+	return c.decide(ctx, reservationID, shared.ApprovalStatusRejected, reason, actorID, actorRole)
+}
+
+func (c *reservationApprovalCommandsImpl) decide(ctx context.Context, reservationID uuid.UUID, status, reason string, actorID uuid.UUID, actorRole string) error {
+	now := c.clock.Now()
+	err := c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		snap, err := c.reservations.FindSnapshotByID(ctx, tx.DB(), reservationID)
+		if err != nil {
+			if infra.IsKind(err, infra.KindNotFound) {
+				return errs.Mark(err, ErrReservationNotFoundWrite)
+			}
+			return err
+		}
+		if err = c.authorize(ctx, tx, snap, actorID, actorRole); err != nil {
+			return err
+		}
+
+		approval, err := c.approvals.FindApproval(ctx, tx.DB(), reservationID)
+		if err != nil {
+			if infra.IsKind(err, infra.KindNotFound) {
+				return errs.Mark(err, ErrReservationNotPendingApproval)
+			}
+			return err
+		}
+		if approval.Status != shared.ApprovalStatusPending || snap.Status != string(reservation.StatusPendingApproval) {
+			return ErrReservationNotPendingApproval
+		}
+		if !now.Before(approval.ExpiresAt) {
+			return ErrApprovalExpired
+		}
+
+		err = tx.ReservationApprovals().Decide(ctx, tx.DB(), shared.ApprovalDecision{
+			ReservationID: reservationID,
+			Status:        status,
+			DecidedBy:     actorID,
+			Reason:        reason,
+			DecidedAt:     now,
+		})
+		if err != nil {
+			if infra.IsKind(err, infra.KindConflict) {
+				return errs.Mark(err, ErrReservationNotPendingApproval)
+			}
+			return err
+		}
+
+		resolved, topic, action := reservation.StatusConfirmed, NotificationTopicReservationApproved, AuditActionReservationApproved
+		if status == shared.ApprovalStatusRejected {
+			resolved, topic, action = reservation.StatusCanceled, NotificationTopicReservationRejected, AuditActionReservationRejected
+		}
+		if err = tx.Reservations().ResolvePending(ctx, tx.DB(), reservationID, resolved); err != nil {
+			if infra.IsKind(err, infra.KindConflict) {
+				return errs.Mark(err, ErrReservationNotPendingApproval)
+			}
+			return err
+		}
+
+		payload, err := json.Marshal(map[string]any{
+			"reservation_id": reservationID,
+			"user_id":        snap.UserID,
+			"reason":         reason,
+			"type":           topic,
+		})
+		if err != nil {
+			return err
+		}
+		if err = queueUserNotification(ctx, tx, snap.UserID, topic, payload, now); err != nil {
+			return err
+		}
+		if err = recordNotification(ctx, tx, snap.UserID, reservationID, &snap.ResourceID, topic, now); err != nil {
+			return err
+		}
+
+		metadata := map[string]any{"resource_id": snap.ResourceID}
+		if reason != "" {
+			metadata["reason"] = reason
+		}
+		return tx.Audit().Record(ctx, tx.DB(), shared.AuditEntry{
+			ActorID:    &actorID,
+			Action:     action,
+			TargetType: auditTargetReservation,
+			TargetID:   reservationID.String(),
+			Metadata:   metadata,
+		})
+	})
+	if err != nil {
+		return errs.Mark(err, ErrReservationApprovalFailed)
+	}
+	return nil
+}
+
+// authorize lets designated approvers of the resource and holders of
+// reservations:approve:any decide, but never on their own reservation.
+func (c *reservationApprovalCommandsImpl) authorize(ctx context.Context, tx shared.Tx, snap *shared.ReservationSnapshot, actorID uuid.UUID, actorRole string) error {
+	if snap.UserID == actorID {
+		return ErrApprovalOwnReservation
+	}
+	allowed, err := c.permissions.HasPermission(ctx, actorRole, shared.PermissionReservationsApproveAny)
+	if err != nil || allowed {
+		return err
+	}
+	allowed, err = c.approvals.IsApprover(ctx, tx.DB(), snap.ResourceID, actorID)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return ErrNotReservationApprover
+	}
+	return nil
+}
+
+func (c *reservationApprovalCommandsImpl) AddApprover(ctx context.Context, resourceID, userID uuid.UUID) error {
+	err := c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		if err := tx.ReservationApprovals().AddApprover(ctx, tx.DB(), resourceID, userID); err != nil {
+			if infra.IsKind(err, infra.KindForeignKeyViolated) {
+				return errs.Mark(err, ErrApproverTargetNotFound)
+			}
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return errs.Mark(err, ErrReservationApprovalFailed)
+	}
+	return nil
+}
+
+// RemoveApprover leaves pending requests on the resource open; once the last approver is
+// gone, only holders of reservations:approve:any can still decide them.
+func (c *reservationApprovalCommandsImpl) RemoveApprover(ctx context.Context, resourceID, userID uuid.UUID) error {
+	err := c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		if err := tx.ReservationApprovals().RemoveApprover(ctx, tx.DB(), resourceID, userID); err != nil {
+			if infra.IsKind(err, infra.KindNotFound) {
+				return errs.Mark(err, ErrApproverNotFound)
+			}
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return errs.Mark(err, ErrReservationApprovalFailed)
+	}
+	return nil
+}
+
+func (c *reservationApprovalCommandsImpl) Sweep(ctx context.Context) (ApprovalSweep, error) {
+	var sweep ApprovalSweep
+	escalated, err := c.escalate(ctx)
+	sweep.Escalated = escalated
+	if err != nil {
+		return sweep, errs.Mark(err, ErrApprovalSweepFailed)
+	}
+	expired, err := c.expire(ctx)
+	sweep.Expired = expired
+	if err != nil {
+		return sweep, errs.Mark(err, ErrApprovalSweepFailed)
+	}
+	return sweep, nil
+}
+
+// escalate notifies the company's admins, once per request, about requests nobody has
+// decided on within EscalateAfter.
+func (c *reservationApprovalCommandsImpl) escalate(ctx context.Context) (int, error) {
+	if c.policy.EscalateAfter <= 0 {
+		return 0, nil
+	}
+
+	now := c.clock.Now()
+	due, err := c.approvals.ListToEscalate(ctx, c.uow.DB(ctx), now.Add(-c.policy.EscalateAfter), now, c.policy.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	escalated := 0
+	for _, req := range due {
+		if cerr := ctx.Err(); cerr != nil {
+			return escalated, cerr
+		}
+
+		var marked bool
+		err = c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+			var merr error
+			marked, merr = tx.ReservationApprovals().MarkEscalated(ctx, tx.DB(), req.ReservationID, now)
+			if merr != nil || !marked {
+				return merr
+			}
+			payload, merr := json.Marshal(map[string]any{
+				"reservation_id": req.ReservationID,
+				"resource_id":    req.ResourceID,
+				"company_id":     req.CompanyID,
+				"user_id":        req.UserID,
+				"expires_at":     req.ExpiresAt,
+				"type":           NotificationTopicApprovalEscalated,
+			})
+			if merr != nil {
+				return merr
+			}
+			return tx.Notifications().CreateJob(ctx, tx.DB(), NotificationKindEmail, NotificationTopicApprovalEscalated, payload, now)
+		})
+		if err != nil {
+			return escalated, err
+		}
+		if marked {
+			escalated++
+		}
+	}
+	if escalated > 0 {
+		slog.Info("Reservation approvals escalated", "escalated", escalated)
+	}
+	return escalated, nil
+}
+
+// expire works in bounded batches, each in its own transaction, until a batch comes back short.
+func (c *reservationApprovalCommandsImpl) expire(ctx context.Context) (int, error) {
+	now := c.clock.Now()
+	total := 0
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return total, err
+		}
+
+		var expired []shared.ExpiredApproval
+		err := c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+			var xerr error
+			expired, xerr = tx.ReservationApprovals().ExpireDue(ctx, tx.DB(), now, c.policy.BatchSize)
+			if xerr != nil {
+				return xerr
+			}
+			for _, exp := range expired {
+				payload, perr := json.Marshal(map[string]any{
+					"reservation_id": exp.ReservationID,
+					"user_id":        exp.UserID,
+					"type":           NotificationTopicApprovalExpired,
+				})
+				if perr != nil {
+					return perr
+				}
+				if perr = tx.Notifications().CreateJob(ctx, tx.DB(), NotificationKindEmail, NotificationTopicApprovalExpired, payload, now); perr != nil {
+					return perr
+				}
+				if perr = recordNotification(ctx, tx, exp.UserID, exp.ReservationID, nil, NotificationTopicApprovalExpired, now); perr != nil {
+					return perr
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return total, err
+		}
+
+		total += len(expired)
+		if len(expired) > 0 {
+			slog.Info("Reservation approvals expired", "expired", len(expired), "total_expired", total)
+		}
+		if len(expired) < int(c.policy.BatchSize) {
+			return total, nil
+		}
+	}
+}
+
+// openApproval records the request of a reservation put on hold and notifies its approvers.
+func openApproval(ctx context.Context, tx shared.Tx, policy ApprovalPolicy, reservationID, resourceID uuid.UUID, start time.Time, approvers []uuid.UUID, now time.Time) error {
+	expiresAt := policy.expiresAt(now, start)
+	if err := tx.ReservationApprovals().Open(ctx, tx.DB(), reservationID, expiresAt); err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(map[string]any{
+		"reservation_id": reservationID,
+		"resource_id":    resourceID,
+		"approver_ids":   approvers,
+		"expires_at":     expiresAt,
+		"type":           NotificationTopicApprovalRequested,
+	})
+	if err != nil {
+		return err
+	}
+	return tx.Notifications().CreateJob(ctx, tx.DB(), NotificationKindEmail, NotificationTopicApprovalRequested, payload, now)
+}
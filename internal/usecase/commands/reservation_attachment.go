@@ -0,0 +1,237 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+const maxAttachmentFilenameLength = 255
+
+var (
+	ErrInvalidAttachment              = errs.NewCoded("INVALID_ATTACHMENT", "invalid attachment")
+	ErrAttachmentTooLarge             = errs.NewCoded("ATTACHMENT_TOO_LARGE", "attachment too large")
+	ErrAttachmentTypeNotAllowed       = errs.NewCoded("ATTACHMENT_TYPE_NOT_ALLOWED", "attachment type not allowed")
+	ErrAttachmentRejected             = errs.NewCoded("ATTACHMENT_REJECTED", "attachment rejected by the file scan")
+	ErrReservationAttachmentForbidden = errs.NewCoded("PERMISSION_DENIED", "permission denied")
+	ErrReservationAttachmentNotFound  = errs.NewCoded("RESERVATION_ATTACHMENT_NOT_FOUND", "reservation attachment not found")
+	ErrReservationAttachmentFailed    = errs.New("reservation attachment update failed")
+)
+
+// AttachmentPolicy limits uploads; AllowedTypes are matched against the sniffed content type.
+type AttachmentPolicy struct {
+	MaxBytes     int64
+	AllowedTypes []string
+}
+
+type UploadAttachmentInput struct {
+	ReservationID uuid.UUID
+	ActorID       uuid.UUID
+	ActorRole     string
+	Side          string
+	Filename      string
+	Content       io.Reader
+	// OperatorOnly hides the file from the reservation's user; only the operator side may set it.
+	OperatorOnly bool
+}
+
+type ReservationAttachmentCommands interface {
+	// Upload validates, scans and stores a file on the reservation as side: the user side
+	// must own the reservation, the operator side needs reservation_attachments:write on its resource.
+	Upload(ctx context.Context, in UploadAttachmentInput) (*shared.ReservationAttachment, error)
+	// Delete removes an attachment; the user side may only delete files it uploaded.
+	Delete(ctx context.Context, reservationID, attachmentID, actorID uuid.UUID, actorRole, side string) error
+}
+
+type reservationAttachmentCommandsImpl struct {
+	uow          shared.UnitOfWork
+	reservations shared.ReservationSnapshotReadStore
+	attachments  shared.ReservationAttachmentReadStore
+	authorizer   shared.ResourceAuthorizer
+	storage      shared.FileStorage
+	scanner      shared.FileScanner
+	clock        clock.Clock
+	policy       AttachmentPolicy
+}
+
+func NewReservationAttachmentCommands(
+	uow shared.UnitOfWork,
+	reservations shared.ReservationSnapshotReadStore,
+	attachments shared.ReservationAttachmentReadStore,
+	authorizer shared.ResourceAuthorizer,
+	storage shared.FileStorage,
+	scanner shared.FileScanner,
+	clock clock.Clock,
+	policy AttachmentPolicy,
+) ReservationAttachmentCommands {
+	return &reservationAttachmentCommandsImpl{
+		uow:          uow,
+		reservations: reservations,
+		attachments:  attachments,
+		authorizer:   authorizer,
+		storage:      storage,
+		scanner:      scanner,
+		clock:        clock,
+		policy:       policy,
+	}
+}
+
+func (c *reservationAttachmentCommandsImpl) Upload(ctx context.Context, in UploadAttachmentInput) (*shared.ReservationAttachment, error) {
+	filename, ok := cleanAttachmentFilename(in.Filename)
+	if !ok || (in.OperatorOnly && in.Side != shared.MessageSideOperator) {
+		return nil, ErrInvalidAttachment
+	}
+	content, err := io.ReadAll(io.LimitReader(in.Content, c.policy.MaxBytes+1))
+	if err != nil {
+		return nil, errs.Mark(err, ErrInvalidAttachment)
+	}
+	if len(content) == 0 {
+		return nil, ErrInvalidAttachment
+	}
+	if int64(len(content)) > c.policy.MaxBytes {
+		return nil, ErrAttachmentTooLarge
+	}
+	contentType, _, err := mime.ParseMediaType(http.DetectContentType(content))
+	if err != nil || !slices.Contains(c.policy.AllowedTypes, contentType) {
+		return nil, ErrAttachmentTypeNotAllowed
+	}
+
+	if _, err = c.authorize(ctx, c.uow.DB(ctx), in.ReservationID, in.ActorID, in.ActorRole, in.Side); err != nil {
+		return nil, errs.Mark(err, ErrReservationAttachmentFailed)
+	}
+	clean, err := c.scanner.Scan(ctx, filename, content)
+	if err != nil {
+		return nil, errs.Mark(err, ErrReservationAttachmentFailed)
+	}
+	if !clean {
+		return nil, ErrAttachmentRejected
+	}
+
+	id := uuid.New()
+	attachment := &shared.ReservationAttachment{
+		ID:            id,
+		ReservationID: in.ReservationID,
+		UploadedBy:    in.ActorID,
+		UploaderSide:  in.Side,
+		Filename:      filename,
+		ContentType:   contentType,
+		SizeBytes:     int64(len(content)),
+		StorageKey:    path.Join("reservations", in.ReservationID.String(), id.String()),
+		OperatorOnly:  in.OperatorOnly,
+		CreatedAt:     c.clock.Now(),
+	}
+	// The file is stored first so a committed row always has content; a failed insert
+	// removes it again.
+	if err = c.storage.Put(ctx, attachment.StorageKey, bytes.NewReader(content)); err != nil {
+		return nil, errs.Mark(err, ErrReservationAttachmentFailed)
+	}
+	err = c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		return tx.ReservationAttachments().Create(ctx, tx.DB(), *attachment)
+	})
+	if err != nil {
+		c.removeContent(ctx, attachment.StorageKey)
+		return nil, errs.Mark(err, ErrReservationAttachmentFailed)
+	}
+	return attachment, nil
+}
+
+func (c *reservationAttachmentCommandsImpl) Delete(ctx context.Context, reservationID, attachmentID, actorID uuid.UUID, actorRole, side string) error {
+	var storageKey string
+	err := c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		if _, err := c.authorize(ctx, tx.DB(), reservationID, actorID, actorRole, side); err != nil {
+			return err
+		}
+
+		attachment, err := c.attachments.FindByID(ctx, tx.DB(), reservationID, attachmentID)
+		if err != nil {
+			if infra.IsKind(err, infra.KindNotFound) {
+				return errs.Mark(err, ErrReservationAttachmentNotFound)
+			}
+			return err
+		}
+		if side != shared.MessageSideOperator {
+			if attachment.OperatorOnly {
+				return ErrReservationAttachmentNotFound
+			}
+			if attachment.UploadedBy != actorID {
+				return ErrReservationAttachmentForbidden
+			}
+		}
+
+		if err = tx.ReservationAttachments().Delete(ctx, tx.DB(), reservationID, attachmentID); err != nil {
+			if infra.IsKind(err, infra.KindNotFound) {
+				return errs.Mark(err, ErrReservationAttachmentNotFound)
+			}
+			return err
+		}
+		storageKey = attachment.StorageKey
+		return nil
+	})
+	if err != nil {
+		return errs.Mark(err, ErrReservationAttachmentFailed)
+	}
+
+	// After commit: a failed removal leaves an unreferenced file, never a row without content.
+	c.removeContent(ctx, storageKey)
+	return nil
+}
+
+// authorize checks the actor may act on the reservation's attachments as side.
+func (c *reservationAttachmentCommandsImpl) authorize(ctx context.Context, db sqlc.DBTX, reservationID, actorID uuid.UUID, actorRole, side string) (*shared.ReservationSnapshot, error) {
+	snap, err := c.reservations.FindSnapshotByID(ctx, db, reservationID)
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return nil, errs.Mark(err, ErrReservationNotFoundWrite)
+		}
+		return nil, err
+	}
+
+	switch side {
+	case shared.MessageSideUser:
+		if snap.UserID != actorID {
+			return nil, ErrReservationNotOwned
+		}
+	case shared.MessageSideOperator:
+		allowed, aerr := c.authorizer.CanActOnResource(ctx, actorID, actorRole,
+			shared.PermissionReservationAttachmentsWriteAny, shared.PermissionReservationAttachmentsWriteAssigned, snap.ResourceID)
+		if aerr != nil {
+			return nil, aerr
+		}
+		if !allowed {
+			return nil, ErrReservationAttachmentForbidden
+		}
+	default:
+		return nil, ErrInvalidAttachment
+	}
+	return snap, nil
+}
+
+func (c *reservationAttachmentCommandsImpl) removeContent(ctx context.Context, key string) {
+	if err := c.storage.Delete(context.WithoutCancel(ctx), key); err != nil {
+		slog.Error("Failed to remove attachment content", "storage_key", key, "error", err.Error())
+	}
+}
+
+// cleanAttachmentFilename keeps the last path element of a client-supplied name.
+func cleanAttachmentFilename(name string) (string, bool) {
+	name = strings.TrimSpace(path.Base(strings.ReplaceAll(name, "\\", "/")))
+	if name == "" || name == "." || name == ".." || name == "/" || !utf8.ValidString(name) || len(name) > maxAttachmentFilenameLength {
+		return "", false
+	}
+	return name, true
+}
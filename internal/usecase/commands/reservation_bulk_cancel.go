@@ -0,0 +1,204 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	reqdto "gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+const (
+	AuditActionReservationsRangeCanceled = "resource.reservations_canceled"
+	// NotificationTopicReservationsBulkCanceled is one email listing all of a user's
+	// reservations canceled by the same range; users with a single one get the usual
+	// reservation_canceled email instead.
+	NotificationTopicReservationsBulkCanceled = "reservations_bulk_canceled"
+
+	maxCancelRangeWindow = 92 * 24 * time.Hour
+)
+
+var (
+	ErrInvalidCancelRange     = errs.NewCoded("INVALID_CANCEL_RANGE", "invalid cancel range")
+	ErrCancelRangeForbidden   = errs.NewCoded("PERMISSION_DENIED", "permission denied")
+	ErrReservationRangeFailed = errs.New("reservation range cancel failed")
+)
+
+// CancelRangeResult lists the affected reservations, earliest first. On a dry run nothing
+// was canceled and no one was notified.
+type CancelRangeResult struct {
+	DryRun       bool
+	Reservations []shared.ReservationSlot
+	// Emails is the number of notification emails, one per affected user.
+	Emails int
+}
+
+type ReservationBulkCancelCommands interface {
+	// CancelRange cancels the resource's confirmed reservations overlapping the range that
+	// have not ended yet, e.g. for a facility closure. Each affected user gets one email.
+	CancelRange(ctx context.Context, req reqdto.CancelReservationRangeRequest, resourceID, actorID uuid.UUID, actorRole string) (*CancelRangeResult, error)
+}
+
+type reservationBulkCancelCommandsImpl struct {
+	uow          shared.UnitOfWork
+	resources    shared.ResourceReadStore
+	reservations shared.ReservationSnapshotReadStore
+	authorizer   shared.ResourceAuthorizer
+	clock        clock.Clock
+}
+
+func NewReservationBulkCancelCommands(
+	uow shared.UnitOfWork,
+	resources shared.ResourceReadStore,
+	reservations shared.ReservationSnapshotReadStore,
+	authorizer shared.ResourceAuthorizer,
+	clock clock.Clock,
+) ReservationBulkCancelCommands {
+	return &reservationBulkCancelCommandsImpl{
+		uow:          uow,
+		resources:    resources,
+		reservations: reservations,
+		authorizer:   authorizer,
+		clock:        clock,
+	}
+}
+
+func (c *reservationBulkCancelCommandsImpl) CancelRange(ctx context.Context, req reqdto.CancelReservationRangeRequest, resourceID, actorID uuid.UUID, actorRole string) (*CancelRangeResult, error) {
+	reason := strings.TrimSpace(req.Reason)
+	window := shared.TimeRange{Start: req.StartTime, End: req.EndTime}
+	if reason == "" || !window.End.After(window.Start) || window.End.Sub(window.Start) > maxCancelRangeWindow {
+		return nil, ErrInvalidCancelRange
+	}
+	if err := c.authorize(ctx, resourceID, actorID, actorRole); err != nil {
+		return nil, err
+	}
+
+	now := c.clock.Now()
+	if req.DryRun {
+		affected, err := c.reservations.ListCancelableInRange(ctx, c.uow.DB(ctx), resourceID, window, now)
+		if err != nil {
+			return nil, errs.Mark(err, ErrReservationRangeFailed)
+		}
+		return &CancelRangeResult{DryRun: true, Reservations: affected, Emails: len(groupByUser(affected))}, nil
+	}
+
+	var result *CancelRangeResult
+	err := c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		canceled, cerr := tx.Reservations().CancelInRange(ctx, tx.DB(), resourceID, window, now)
+		if cerr != nil {
+			return cerr
+		}
+
+		for _, slot := range canceled {
+			aerr := tx.Activity().Record(ctx, tx.DB(), shared.ActivityEntry{
+				UserID:     slot.UserID,
+				Kind:       shared.ActivityReservationCanceled,
+				SubjectID:  slot.ID,
+				ResourceID: &resourceID,
+				OccurredAt: now,
+			})
+			if aerr != nil {
+				return aerr
+			}
+		}
+
+		byUser := groupByUser(canceled)
+		for _, slots := range byUser {
+			if nerr := c.notify(ctx, tx, resourceID, reason, slots, now); nerr != nil {
+				return nerr
+			}
+		}
+
+		if aerr := tx.Audit().Record(ctx, tx.DB(), shared.AuditEntry{
+			ActorID:    &actorID,
+			Action:     AuditActionReservationsRangeCanceled,
+			TargetType: auditTargetResource,
+			TargetID:   resourceID.String(),
+			Metadata: map[string]any{
+				"reason":     reason,
+				"start_time": window.Start,
+				"end_time":   window.End,
+				"canceled":   len(canceled),
+			},
+		}); aerr != nil {
+			return aerr
+		}
+
+		result = &CancelRangeResult{Reservations: canceled, Emails: len(byUser)}
+		return nil
+	})
+	if err != nil {
+		return nil, errs.Mark(err, ErrReservationRangeFailed)
+	}
+	return result, nil
+}
+
+// notify queues one email for a user's canceled reservations.
+func (c *reservationBulkCancelCommandsImpl) notify(ctx context.Context, tx shared.Tx, resourceID uuid.UUID, reason string, slots []shared.ReservationSlot, now time.Time) error {
+	payload := map[string]any{
+		"user_id":     slots[0].UserID,
+		"resource_id": resourceID,
+		"reason":      reason,
+	}
+	topic := NotificationTopicReservationCanceled
+	if len(slots) == 1 {
+		payload["reservation_id"] = slots[0].ID
+	} else {
+		topic = NotificationTopicReservationsBulkCanceled
+		ids := make([]uuid.UUID, len(slots))
+		for i, s := range slots {
+			ids[i] = s.ID
+		}
+		payload["reservation_ids"] = ids
+	}
+	payload["type"] = topic
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return tx.Notifications().CreateJob(ctx, tx.DB(), NotificationKindEmail, topic, body, now)
+}
+
+// authorize reports a missing resource before a missing grant, like the block endpoints.
+func (c *reservationBulkCancelCommandsImpl) authorize(ctx context.Context, resourceID, actorID uuid.UUID, actorRole string) error {
+	if _, err := c.resources.FindByID(ctx, c.uow.DB(ctx), resourceID); err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return errs.Mark(err, ErrResourceNotFound)
+		}
+		return errs.Mark(err, ErrReservationRangeFailed)
+	}
+
+	allowed, err := c.authorizer.CanActOnResource(ctx, actorID, actorRole, shared.PermissionReservationsCancelAny, shared.PermissionReservationsCancelAssigned, resourceID)
+	if err != nil {
+		return errs.Mark(err, ErrReservationRangeFailed)
+	}
+	if !allowed {
+		return ErrCancelRangeForbidden
+	}
+	return nil
+}
+
+// groupByUser keeps each user's slots in the order given, and users in order of their
+// first slot.
+func groupByUser(slots []shared.ReservationSlot) [][]shared.ReservationSlot {
+	index := make(map[uuid.UUID]int)
+	var groups [][]shared.ReservationSlot
+	for _, s := range slots {
+		i, ok := index[s.UserID]
+		if !ok {
+			i = len(groups)
+			index[s.UserID] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], s)
+	}
+	return groups
+}
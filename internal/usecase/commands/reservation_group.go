@@ -0,0 +1,271 @@
+package commands
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"gin-clean-starter/internal/domain/reservation"
+	reqdto "gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+const EndpointCreateReservationGroup = "POST /reservation-groups"
+
+var ErrReservationGroupConflict = errs.NewCoded("RESERVATION_GROUP_CONFLICT", "some items of the reservation group cannot be booked")
+
+type CreateReservationGroupResult struct {
+	GroupID    uuid.UUID
+	IsReplayed bool
+}
+
+// ReservationGroupIssue is one item of a group booking that cannot be reserved.
+type ReservationGroupIssue struct {
+	Index      int
+	ResourceID uuid.UUID
+	// Code is RESERVATION_CONFLICT or RESOURCE_BLOCKED.
+	Code errs.Code
+	// OverlapsItem is set when the conflict is with an earlier item of the same request.
+	OverlapsItem *int
+}
+
+// ReservationGroupConflictError lists every conflicting item at once, so a client can
+// fix the whole request in one round trip. It matches ErrReservationGroupConflict.
+type ReservationGroupConflictError struct {
+	Issues []ReservationGroupIssue
+}
+
+func (e *ReservationGroupConflictError) Error() string {
+	return fmt.Sprintf("%d reservation group item(s) conflict", len(e.Issues))
+}
+
+func (e *ReservationGroupConflictError) Is(target error) bool {
+	return target == ErrReservationGroupConflict
+}
+
+func (e *ReservationGroupConflictError) ErrorCode() errs.Code {
+	return errs.CodeOf(ErrReservationGroupConflict)
+}
+
+// CreateGroup reserves every item of req in one transaction, or none of them. All items
+// are checked for conflicts before the first insert, so a rejection reports each
+// conflicting item rather than just the first. The idempotency key covers the whole group.
+func (r *reservationUseCaseImpl) CreateGroup(
+	ctx context.Context,
+	req reqdto.CreateReservationGroupRequest,
+	userID uuid.UUID,
+	idempotencyKey uuid.UUID,
+) (*CreateReservationGroupResult, error) {
+	slots, err := req.ToDomain()
+	if err != nil {
+		return nil, errs.Mark(err, ErrInvalidTimeSlot)
+	}
+
+	specs, err := r.loadGroupResources(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := r.checkGroupCustomFields(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	requestHash := r.calculateGroupHash(req, fields)
+	expiresAt := r.clock.Now().Add(idempotencyKeyTTL)
+
+	var result *CreateReservationGroupResult
+
+	err = r.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		existingReservationID, err := r.handleIdempotencyInTx(ctx, tx, EndpointCreateReservationGroup, idempotencyKey, userID, requestHash, expiresAt)
+		if err != nil {
+			return err
+		}
+		if existingReservationID != nil {
+			groupID, err := r.reservations.FindGroupID(ctx, tx.DB(), *existingReservationID)
+			if err != nil {
+				return errs.Mark(err, errDatabaseOperationFailed)
+			}
+			if groupID == nil {
+				// The key was used for a single reservation.
+				return ErrDuplicateReservation
+			}
+			result = &CreateReservationGroupResult{GroupID: *groupID, IsReplayed: true}
+			return nil
+		}
+
+		groupID, err := r.createGroup(ctx, tx, req.Items, slots, specs, fields, userID, idempotencyKey)
+		if err != nil {
+			return err
+		}
+		result = &CreateReservationGroupResult{GroupID: groupID}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (r *reservationUseCaseImpl) createGroup(
+	ctx context.Context,
+	tx shared.Tx,
+	items []reqdto.ReservationGroupItemRequest,
+	slots []reservation.TimeSlot,
+	specs map[uuid.UUID]reservation.ResourceSpec,
+	fields []reservation.CustomFields,
+	userID, idempotencyKey uuid.UUID,
+) (uuid.UUID, error) {
+	entities := make([]*reservation.Reservation, len(items))
+	for i, item := range items {
+		entity, err := reservation.NewReservation(r.services, specs[item.ResourceID], userID, slots[i], nil, 0, reservation.Note{})
+		if err != nil {
+			return uuid.Nil, mapPricingError(err)
+		}
+		entity.SetCustomFields(fields[i])
+		entities[i] = entity
+	}
+
+	issues, err := r.groupConflicts(ctx, tx, items, slots)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if len(issues) > 0 {
+		return uuid.Nil, &ReservationGroupConflictError{Issues: issues}
+	}
+
+	reservationIDs := make([]uuid.UUID, len(entities))
+	for i, entity := range entities {
+		approvers, err := r.holdForApproval(ctx, tx, entity)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		reservationID, err := tx.Reservations().Create(ctx, tx.DB(), entity)
+		if err != nil {
+			// Lost a race with a booking made after the conflict checks.
+			if infra.IsKind(err, infra.KindConflict) {
+				return uuid.Nil, ErrReservationConflict
+			}
+			return uuid.Nil, errs.Mark(err, errDatabaseOperationFailed)
+		}
+		if err = r.notifyCreated(ctx, tx, reservationID, entity, approvers); err != nil {
+			return uuid.Nil, errs.Mark(err, errDatabaseOperationFailed)
+		}
+		reservationIDs[i] = reservationID
+	}
+
+	groupID := uuid.New()
+	if err = tx.Reservations().AssignGroup(ctx, tx.DB(), groupID, reservationIDs); err != nil {
+		return uuid.Nil, errs.Mark(err, errDatabaseOperationFailed)
+	}
+
+	err = tx.Idempotency().UpdateStatusCompleted(ctx, tx.DB(), idempotencyKey, userID, r.calculateIDHash(groupID), reservationIDs[0])
+	if err != nil {
+		return uuid.Nil, errs.Mark(err, errDatabaseOperationFailed)
+	}
+
+	return groupID, nil
+}
+
+// groupConflicts checks each item against the earlier items, resource blocks and
+// existing reservations, in that order, and reports the first hit per item.
+func (r *reservationUseCaseImpl) groupConflicts(
+	ctx context.Context,
+	tx shared.Tx,
+	items []reqdto.ReservationGroupItemRequest,
+	slots []reservation.TimeSlot,
+) ([]ReservationGroupIssue, error) {
+	var issues []ReservationGroupIssue
+	for i, item := range items {
+		issue := ReservationGroupIssue{Index: i, ResourceID: item.ResourceID}
+
+		for j := range i {
+			if items[j].ResourceID == item.ResourceID && slots[j].Overlaps(slots[i]) {
+				issue.Code = errs.CodeOf(ErrReservationConflict)
+				issue.OverlapsItem = &j
+				break
+			}
+		}
+
+		if issue.Code == "" {
+			blocked, err := tx.ResourceBlocks().Overlaps(ctx, tx.DB(), item.ResourceID, slots[i].Start(), slots[i].End())
+			if err != nil {
+				return nil, errs.Mark(err, errDatabaseOperationFailed)
+			}
+			if blocked {
+				issue.Code = errs.CodeOf(ErrResourceBlocked)
+			}
+		}
+
+		if issue.Code == "" {
+			taken, err := tx.Reservations().SlotTaken(ctx, tx.DB(), item.ResourceID, slots[i].Start(), slots[i].End())
+			if err != nil {
+				return nil, errs.Mark(err, errDatabaseOperationFailed)
+			}
+			if taken {
+				issue.Code = errs.CodeOf(ErrReservationConflict)
+			}
+		}
+
+		if issue.Code != "" {
+			issues = append(issues, issue)
+		}
+	}
+	return issues, nil
+}
+
+func (r *reservationUseCaseImpl) loadGroupResources(ctx context.Context, req reqdto.CreateReservationGroupRequest) (map[uuid.UUID]reservation.ResourceSpec, error) {
+	specs := make(map[uuid.UUID]reservation.ResourceSpec, len(req.Items))
+	for _, item := range req.Items {
+		if _, ok := specs[item.ResourceID]; ok {
+			continue
+		}
+		snapshots, err := loadPricingSnapshots(ctx, r.uow.DB(ctx), r.resources, r.coupons, item.ResourceID, nil)
+		if err != nil {
+			return nil, err
+		}
+		specs[item.ResourceID] = snapshots.resourceSpec()
+	}
+	return specs, nil
+}
+
+// checkGroupCustomFields checks each item's values against its resource's fields, in
+// request order, and reports the item of the first rejected value.
+func (r *reservationUseCaseImpl) checkGroupCustomFields(ctx context.Context, req reqdto.CreateReservationGroupRequest) ([]reservation.CustomFields, error) {
+	fields := make([]reservation.CustomFields, len(req.Items))
+	for i, item := range req.Items {
+		checked, err := checkCustomFields(ctx, r.uow.DB(ctx), r.customFields, item.ResourceID, item.CustomFields)
+		if err != nil {
+			var fieldsErr *CustomFieldsError
+			if errors.As(err, &fieldsErr) {
+				fieldsErr.Item = &i
+			}
+			return nil, err
+		}
+		fields[i] = checked
+	}
+	return fields, nil
+}
+
+// calculateGroupHash takes the checked custom field values rather than the raw ones.
+func (r *reservationUseCaseImpl) calculateGroupHash(req reqdto.CreateReservationGroupRequest, fields []reservation.CustomFields) string {
+	normalized := make([]reqdto.ReservationGroupItemRequest, len(req.Items))
+	for i, item := range req.Items {
+		normalized[i] = reqdto.ReservationGroupItemRequest{
+			ResourceID:   item.ResourceID,
+			StartTime:    item.StartTime.UTC(),
+			EndTime:      item.EndTime.UTC(),
+			CustomFields: fields[i],
+		}
+	}
+	data, _ := json.Marshal(normalized)
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,179 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"gin-clean-starter/internal/domain/reservation"
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+const NotificationTopicReservationMessage = "reservation_message"
+
+var (
+	ErrInvalidReservationMessage   = errs.NewCoded("INVALID_RESERVATION_MESSAGE", "invalid reservation message")
+	ErrReservationMessageForbidden = errs.NewCoded("PERMISSION_DENIED", "permission denied")
+	ErrReservationMessageFailed    = errs.New("reservation message update failed")
+)
+
+type PostReservationMessageResult struct {
+	ID            uuid.UUID
+	ReservationID uuid.UUID
+	AuthorID      uuid.UUID
+	AuthorSide    string
+	Body          string
+	CreatedAt     time.Time
+}
+
+type ReservationMessageCommands interface {
+	// Post adds a message to the reservation's thread as side: the user side must own the
+	// reservation, the operator side needs reservation_messages:write on its resource.
+	// The other side is notified, and the thread counts as read for the author's side.
+	Post(ctx context.Context, reservationID, actorID uuid.UUID, actorRole, side, body string) (*PostReservationMessageResult, error)
+	// MarkRead clears side's unread indicator; the operator side needs reservations:read
+	// on the reservation's resource.
+	MarkRead(ctx context.Context, reservationID, actorID uuid.UUID, actorRole, side string) error
+}
+
+type reservationMessageCommandsImpl struct {
+	uow          shared.UnitOfWork
+	reservations shared.ReservationSnapshotReadStore
+	authorizer   shared.ResourceAuthorizer
+	clock        clock.Clock
+}
+
+func NewReservationMessageCommands(uow shared.UnitOfWork, reservations shared.ReservationSnapshotReadStore, authorizer shared.ResourceAuthorizer, clock clock.Clock) ReservationMessageCommands {
+	return &reservationMessageCommandsImpl{
+		uow:          uow,
+		reservations: reservations,
+		authorizer:   authorizer,
+		clock:        clock,
+	}
+}
+
+func (c *reservationMessageCommandsImpl) Post(ctx context.Context, reservationID, actorID uuid.UUID, actorRole, side, body string) (*PostReservationMessageResult, error) {
+	text, err := reservation.NewNote(body)
+	if err != nil || text.IsEmpty() {
+		return nil, ErrInvalidReservationMessage
+	}
+
+	var result *PostReservationMessageResult
+	err = c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		snap, serr := c.authorize(ctx, tx, reservationID, actorID, actorRole, side,
+			shared.PermissionReservationMessagesWriteAny, shared.PermissionReservationMessagesWriteAssigned)
+		if serr != nil {
+			return serr
+		}
+
+		msg := shared.ReservationMessage{
+			ReservationID: reservationID,
+			AuthorID:      actorID,
+			AuthorSide:    side,
+			Body:          text.String(),
+			CreatedAt:     c.clock.Now(),
+		}
+		id, cerr := tx.ReservationMessages().Create(ctx, tx.DB(), msg)
+		if cerr != nil {
+			return cerr
+		}
+		if rerr := tx.ReservationMessages().MarkRead(ctx, tx.DB(), reservationID, side, msg.CreatedAt); rerr != nil {
+			return rerr
+		}
+		if nerr := c.notify(ctx, tx, snap, id, side); nerr != nil {
+			return nerr
+		}
+
+		result = &PostReservationMessageResult{
+			ID:            id,
+			ReservationID: reservationID,
+			AuthorID:      actorID,
+			AuthorSide:    side,
+			Body:          msg.Body,
+			CreatedAt:     msg.CreatedAt,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errs.Mark(err, ErrReservationMessageFailed)
+	}
+	return result, nil
+}
+
+func (c *reservationMessageCommandsImpl) MarkRead(ctx context.Context, reservationID, actorID uuid.UUID, actorRole, side string) error {
+	err := c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		if _, err := c.authorize(ctx, tx, reservationID, actorID, actorRole, side,
+			shared.PermissionReservationsReadAny, shared.PermissionReservationsReadAssigned); err != nil {
+			return err
+		}
+		return tx.ReservationMessages().MarkRead(ctx, tx.DB(), reservationID, side, c.clock.Now())
+	})
+	if err != nil {
+		return errs.Mark(err, ErrReservationMessageFailed)
+	}
+	return nil
+}
+
+// authorize checks the actor may act on the thread as side; operator-side access is
+// decided by the given grants on the reservation's resource.
+func (c *reservationMessageCommandsImpl) authorize(ctx context.Context, tx shared.Tx, reservationID, actorID uuid.UUID, actorRole, side, anyPermission, assignedPermission string) (*shared.ReservationSnapshot, error) {
+	snap, err := c.reservations.FindSnapshotByID(ctx, tx.DB(), reservationID)
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return nil, errs.Mark(err, ErrReservationNotFoundWrite)
+		}
+		return nil, err
+	}
+
+	switch side {
+	case shared.MessageSideUser:
+		if snap.UserID != actorID {
+			return nil, ErrReservationNotOwned
+		}
+	case shared.MessageSideOperator:
+		allowed, aerr := c.authorizer.CanActOnResource(ctx, actorID, actorRole, anyPermission, assignedPermission, snap.ResourceID)
+		if aerr != nil {
+			return nil, aerr
+		}
+		if !allowed {
+			return nil, ErrReservationMessageForbidden
+		}
+	default:
+		return nil, ErrInvalidReservationMessage
+	}
+	return snap, nil
+}
+
+// notify queues a notification for the other side: the reservation's user, on whose
+// timeline it also goes, or the operators of its resource, whom the worker resolves from
+// the reservation.
+func (c *reservationMessageCommandsImpl) notify(ctx context.Context, tx shared.Tx, snap *shared.ReservationSnapshot, messageID uuid.UUID, authorSide string) error {
+	payload := map[string]any{
+		"reservation_id": snap.ID,
+		"message_id":     messageID,
+		"type":           NotificationTopicReservationMessage,
+	}
+	if authorSide == shared.MessageSideOperator {
+		payload["recipient_side"] = shared.MessageSideUser
+		payload["recipient_user_id"] = snap.UserID
+	} else {
+		payload["recipient_side"] = shared.MessageSideOperator
+		payload["resource_id"] = snap.ResourceID
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	if authorSide != shared.MessageSideOperator {
+		return tx.Notifications().CreateJob(ctx, tx.DB(), NotificationKindEmail, NotificationTopicReservationMessage, data, c.clock.Now())
+	}
+	if err = queueUserNotification(ctx, tx, snap.UserID, NotificationTopicReservationMessage, data, c.clock.Now()); err != nil {
+		return err
+	}
+	return recordNotification(ctx, tx, snap.UserID, snap.ID, &snap.ResourceID, NotificationTopicReservationMessage, c.clock.Now())
+}
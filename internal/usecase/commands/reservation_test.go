@@ -0,0 +1,77 @@
+//go:build unit
+
+package commands
+
+import (
+	"testing"
+	"time"
+
+	reqdto "gin-clean-starter/internal/handler/dto/request"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func hashBenchRequest() reqdto.CreateReservationRequest {
+	coupon := "  SPRING10 "
+	note := "  window seat please  "
+	start := time.Date(2025, 4, 1, 19, 0, 0, 0, time.FixedZone("JST", 9*60*60))
+	return reqdto.CreateReservationRequest{
+		ResourceID: uuid.MustParse("0b9f3c2e-6a4d-4c1e-9d7a-2f5b8e1c3a40"),
+		StartTime:  start,
+		EndTime:    start.Add(time.Hour),
+		CouponCode: &coupon,
+		Note:       &note,
+	}
+}
+
+func TestCalculateNormalizedHash(t *testing.T) {
+	uc := &reservationUseCaseImpl{}
+	req := hashBenchRequest()
+
+	equivalent := req
+	coupon := "spring10"
+	note := "window seat please"
+	equivalent.CouponCode = &coupon
+	equivalent.Note = &note
+	equivalent.StartTime = req.StartTime.UTC()
+	equivalent.EndTime = req.EndTime.UTC()
+
+	assert.Equal(t, uc.calculateNormalizedHash(req), uc.calculateNormalizedHash(equivalent))
+
+	listed := req
+	listed.CouponCode = nil
+	listed.CouponCodes = []string{"Spring10"}
+	assert.Equal(t, uc.calculateNormalizedHash(req), uc.calculateNormalizedHash(listed))
+
+	stacked := listed
+	stacked.CouponCodes = []string{"spring10", "WELCOME"}
+	reordered := listed
+	reordered.CouponCodes = []string{"welcome", "SPRING10"}
+	assert.Equal(t, uc.calculateNormalizedHash(stacked), uc.calculateNormalizedHash(reordered))
+	assert.NotEqual(t, uc.calculateNormalizedHash(req), uc.calculateNormalizedHash(stacked))
+
+	points := int32(500)
+	redeeming := req
+	redeeming.RedeemPoints = &points
+	assert.NotEqual(t, uc.calculateNormalizedHash(req), uc.calculateNormalizedHash(redeeming))
+
+	moved := req
+	moved.EndTime = req.EndTime.Add(time.Minute)
+	assert.NotEqual(t, uc.calculateNormalizedHash(req), uc.calculateNormalizedHash(moved))
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = uc.calculateNormalizedHash(req)
+	})
+	assert.LessOrEqual(t, allocs, 10.0, "calculateNormalizedHash allocs")
+}
+
+func BenchmarkCalculateNormalizedHash(b *testing.B) {
+	uc := &reservationUseCaseImpl{}
+	req := hashBenchRequest()
+
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = uc.calculateNormalizedHash(req)
+	}
+}
@@ -0,0 +1,344 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"time"
+
+	"gin-clean-starter/internal/domain/reservation"
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/pkg/signedtoken"
+	"gin-clean-starter/internal/usecase/queries"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ReservationTransferTokenPurpose = "reservation_transfer"
+	TransferStatusPending           = "pending"
+	TransferStatusAccepted          = "accepted"
+
+	NotificationTopicTransferOffered     = "reservation_transfer_offered"
+	NotificationTopicReservationTransfer = "reservation_transferred"
+
+	AuditActionReservationTransferOverride = "reservation.transfer_override"
+
+	auditTargetReservation = "reservation"
+)
+
+var (
+	ErrTransferInvalidRecipient   = errs.NewCoded("RESERVATION_TRANSFER_INVALID_RECIPIENT", "transfer recipient must be another active user")
+	ErrReservationNotTransferable = errs.NewCoded("RESERVATION_NOT_TRANSFERABLE", "reservation cannot be transferred")
+	ErrInvalidTransferToken       = errs.NewCoded("RESERVATION_TRANSFER_INVALID_TOKEN", "invalid transfer token")
+	ErrTransferExpired            = errs.NewCoded("RESERVATION_TRANSFER_EXPIRED", "transfer offer expired")
+	ErrTransferNotPending         = errs.NewCoded("RESERVATION_TRANSFER_NOT_PENDING", "transfer offer is no longer pending")
+	ErrTransferWrongRecipient     = errs.NewCoded("RESERVATION_TRANSFER_WRONG_RECIPIENT", "transfer offer is addressed to another user")
+	ErrReservationTransferFailed  = errs.New("reservation transfer failed")
+)
+
+// ReservationTransferPolicy controls how long an offer stays open and the link mailed to
+// the recipient; the signed token is appended to AcceptURL as the "token" query parameter.
+type ReservationTransferPolicy struct {
+	TTL       time.Duration
+	AcceptURL string
+}
+
+type ReservationTransferResult struct {
+	TransferID    uuid.UUID
+	ReservationID uuid.UUID
+	ToUserID      uuid.UUID
+	ToEmail       string
+	Status        string
+	ExpiresAt     time.Time
+}
+
+type ReservationTransferCommands interface {
+	// Initiate offers the reservation to the user registered under email. The owner's offer
+	// waits for the recipient to accept it and supersedes any earlier pending offer; an
+	// operator holding reservations:transfer on the resource moves it right away.
+	Initiate(ctx context.Context, reservationID uuid.UUID, email string, actorID uuid.UUID, actorRole string) (*ReservationTransferResult, error)
+	// Accept completes the offer in token; only its recipient may accept it.
+	Accept(ctx context.Context, token string, actorID uuid.UUID) (*ReservationTransferResult, error)
+}
+
+// transferClaims is the signed payload of a transfer link. The nonce must match the
+// stored offer, so a newer offer for the same reservation invalidates earlier links.
+type transferClaims struct {
+	TransferID uuid.UUID `json:"tid"`
+	Nonce      uuid.UUID `json:"n"`
+}
+
+type reservationTransferCommandsImpl struct {
+	uow          shared.UnitOfWork
+	clock        clock.Clock
+	users        queries.UserReadStore
+	reservations shared.ReservationSnapshotReadStore
+	transfers    shared.ReservationTransferReadStore
+	authorizer   shared.ResourceAuthorizer
+	signer       *signedtoken.Signer
+	policy       ReservationTransferPolicy
+}
+
+func NewReservationTransferCommands(
+	uow shared.UnitOfWork,
+	clock clock.Clock,
+	users queries.UserReadStore,
+	reservations shared.ReservationSnapshotReadStore,
+	transfers shared.ReservationTransferReadStore,
+	authorizer shared.ResourceAuthorizer,
+	signer *signedtoken.Signer,
+	policy ReservationTransferPolicy,
+) ReservationTransferCommands {
+	return &reservationTransferCommandsImpl{
+		uow:          uow,
+		clock:        clock,
+		users:        users,
+		reservations: reservations,
+		transfers:    transfers,
+		authorizer:   authorizer,
+		signer:       signer,
+		policy:       policy,
+	}
+}
+
+func (c *reservationTransferCommandsImpl) Initiate(ctx context.Context, reservationID uuid.UUID, email string, actorID uuid.UUID, actorRole string) (*ReservationTransferResult, error) {
+	recipient, err := c.findRecipient(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+
+	now := c.clock.Now()
+	var result *ReservationTransferResult
+	err = c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		snap, serr := c.reservations.FindSnapshotByID(ctx, tx.DB(), reservationID)
+		if serr != nil {
+			if infra.IsKind(serr, infra.KindNotFound) {
+				return errs.Mark(serr, ErrReservationNotFoundWrite)
+			}
+			return serr
+		}
+
+		override := snap.UserID != actorID
+		if override {
+			allowed, aerr := c.authorizer.CanActOnResource(ctx, actorID, actorRole, shared.PermissionReservationsTransferAny, shared.PermissionReservationsTransferAssigned, snap.ResourceID)
+			if aerr != nil {
+				return aerr
+			}
+			if !allowed {
+				return ErrReservationNotOwned
+			}
+		}
+
+		if snap.Status != string(reservation.StatusConfirmed) || !snap.EndTime.After(now) {
+			return ErrReservationNotTransferable
+		}
+		if recipient.ID == snap.UserID {
+			return ErrTransferInvalidRecipient
+		}
+
+		if perr := tx.ReservationTransfers().SupersedePending(ctx, tx.DB(), reservationID); perr != nil {
+			return perr
+		}
+
+		params := sqlc.CreateReservationTransferParams{
+			ReservationID: reservationID,
+			FromUserID:    snap.UserID,
+			ToUserID:      recipient.ID,
+			InitiatedBy:   actorID,
+			Nonce:         uuid.New(),
+			Status:        TransferStatusPending,
+			ExpiresAt:     pgconv.TimeToPgtype(now.Add(c.policy.TTL)),
+		}
+		if override {
+			params.Status = TransferStatusAccepted
+			params.ExpiresAt = pgconv.TimeToPgtype(now)
+			params.AcceptedAt = pgconv.TimeToPgtype(now)
+		}
+		transferID, cerr := tx.ReservationTransfers().Create(ctx, tx.DB(), params)
+		if cerr != nil {
+			if infra.IsKind(cerr, infra.KindDuplicateKey) {
+				return errs.Mark(cerr, ErrReservationNotTransferable)
+			}
+			return cerr
+		}
+
+		result = &ReservationTransferResult{
+			TransferID:    transferID,
+			ReservationID: reservationID,
+			ToUserID:      recipient.ID,
+			ToEmail:       recipient.Email,
+			Status:        params.Status,
+			ExpiresAt:     pgconv.TimeFromPgtype(params.ExpiresAt),
+		}
+
+		if !override {
+			return c.enqueueOfferEmail(ctx, tx, result, params.Nonce)
+		}
+		if terr := c.complete(ctx, tx, transferID, reservationID, snap.UserID, recipient.ID, now); terr != nil {
+			return terr
+		}
+		return tx.Audit().Record(ctx, tx.DB(), shared.AuditEntry{
+			ActorID:    &actorID,
+			Action:     AuditActionReservationTransferOverride,
+			TargetType: auditTargetReservation,
+			TargetID:   reservationID.String(),
+			Metadata: map[string]any{
+				"transfer_id":  transferID,
+				"from_user_id": snap.UserID,
+				"to_user_id":   recipient.ID,
+			},
+		})
+	})
+	if err != nil {
+		return nil, errs.Mark(err, ErrReservationTransferFailed)
+	}
+	return result, nil
+}
+
+func (c *reservationTransferCommandsImpl) Accept(ctx context.Context, token string, actorID uuid.UUID) (*ReservationTransferResult, error) {
+	now := c.clock.Now()
+	var claims transferClaims
+	if _, err := c.signer.Verify(ReservationTransferTokenPurpose, token, now, &claims); err != nil {
+		if errors.Is(err, signedtoken.ErrExpiredToken) {
+			return nil, errs.Mark(err, ErrTransferExpired)
+		}
+		return nil, errs.Mark(err, ErrInvalidTransferToken)
+	}
+
+	var result *ReservationTransferResult
+	err := c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		snap, ferr := c.transfers.FindSnapshotByID(ctx, tx.DB(), claims.TransferID)
+		if ferr != nil {
+			if infra.IsKind(ferr, infra.KindNotFound) {
+				return errs.Mark(ferr, ErrInvalidTransferToken)
+			}
+			return ferr
+		}
+		if snap.Nonce != claims.Nonce {
+			return ErrInvalidTransferToken
+		}
+		if snap.ToUserID != actorID {
+			return ErrTransferWrongRecipient
+		}
+		if snap.Status != TransferStatusPending {
+			return ErrTransferNotPending
+		}
+		if !now.Before(snap.ExpiresAt) {
+			return ErrTransferExpired
+		}
+
+		if aerr := tx.ReservationTransfers().MarkAccepted(ctx, tx.DB(), snap.ID, snap.Nonce, now); aerr != nil {
+			if infra.IsKind(aerr, infra.KindConflict) {
+				return errs.Mark(aerr, ErrTransferNotPending)
+			}
+			return aerr
+		}
+		if terr := c.complete(ctx, tx, snap.ID, snap.ReservationID, snap.FromUserID, snap.ToUserID, now); terr != nil {
+			return terr
+		}
+
+		result = &ReservationTransferResult{
+			TransferID:    snap.ID,
+			ReservationID: snap.ReservationID,
+			ToUserID:      snap.ToUserID,
+			Status:        TransferStatusAccepted,
+			ExpiresAt:     snap.ExpiresAt,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errs.Mark(err, ErrReservationTransferFailed)
+	}
+	return result, nil
+}
+
+// complete moves ownership and notifies both parties, on their timelines too. Billing
+// follows the owner: the reservation keeps its price and discounts, and the loyalty points
+// it earns once its slot has ended accrue to the recipient.
+func (c *reservationTransferCommandsImpl) complete(ctx context.Context, tx shared.Tx, transferID, reservationID, fromUserID, toUserID uuid.UUID, now time.Time) error {
+	if err := tx.Reservations().Transfer(ctx, tx.DB(), reservationID, fromUserID, toUserID, now); err != nil {
+		if infra.IsKind(err, infra.KindConflict) {
+			return errs.Mark(err, ErrReservationNotTransferable)
+		}
+		return err
+	}
+
+	payload, err := json.Marshal(map[string]any{
+		"reservation_id": reservationID,
+		"transfer_id":    transferID,
+		"from_user_id":   fromUserID,
+		"to_user_id":     toUserID,
+		"type":           NotificationTopicReservationTransfer,
+	})
+	if err != nil {
+		return err
+	}
+	if err = tx.Notifications().CreateJob(ctx, tx.DB(), NotificationKindEmail, NotificationTopicReservationTransfer, payload, now); err != nil {
+		return err
+	}
+	for _, userID := range []uuid.UUID{fromUserID, toUserID} {
+		if err = recordNotification(ctx, tx, userID, reservationID, nil, NotificationTopicReservationTransfer, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// findRecipient resolves an active user by email; unknown and inactive users are reported alike.
+func (c *reservationTransferCommandsImpl) findRecipient(ctx context.Context, email string) (*queries.AuthorizedUserView, error) {
+	addr, err := user.NewEmail(email)
+	if err != nil {
+		return nil, errs.Mark(err, ErrTransferInvalidRecipient)
+	}
+	recipient, _, err := c.users.FindByEmail(ctx, c.uow.DB(ctx), addr.Value())
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return nil, errs.Mark(err, ErrTransferInvalidRecipient)
+		}
+		return nil, errs.Mark(err, ErrReservationTransferFailed)
+	}
+	if !recipient.IsActive {
+		return nil, ErrTransferInvalidRecipient
+	}
+	return recipient, nil
+}
+
+func (c *reservationTransferCommandsImpl) enqueueOfferEmail(ctx context.Context, tx shared.Tx, offer *ReservationTransferResult, nonce uuid.UUID) error {
+	token, err := c.signer.Sign(ReservationTransferTokenPurpose, transferClaims{TransferID: offer.TransferID, Nonce: nonce}, offer.ExpiresAt)
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(map[string]any{
+		"reservation_id": offer.ReservationID,
+		"transfer_id":    offer.TransferID,
+		"email":          offer.ToEmail,
+		"link":           c.acceptLink(token),
+		"expires_at":     offer.ExpiresAt,
+		"type":           NotificationTopicTransferOffered,
+	})
+	if err != nil {
+		return err
+	}
+
+	return tx.Notifications().CreateJob(ctx, tx.DB(), NotificationKindEmail, NotificationTopicTransferOffered, payload, c.clock.Now())
+}
+
+func (c *reservationTransferCommandsImpl) acceptLink(token string) string {
+	u, err := url.Parse(c.policy.AcceptURL)
+	if err != nil {
+		return c.policy.AcceptURL + "?token=" + url.QueryEscape(token)
+	}
+	q := u.Query()
+	q.Set("token", token)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
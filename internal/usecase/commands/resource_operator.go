@@ -0,0 +1,66 @@
+package commands
+
+import (
+	"context"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrOperatorTargetNotFound     = errs.NewCoded("OPERATOR_TARGET_NOT_FOUND", "resource or user not found")
+	ErrOperatorAssignmentNotFound = errs.NewCoded("OPERATOR_ASSIGNMENT_NOT_FOUND", "resource operator assignment not found")
+	ErrOperatorUpdateFailed       = errs.New("resource operator update failed")
+)
+
+type ResourceOperatorCommands interface {
+	Assign(ctx context.Context, resourceID, userID uuid.UUID) error
+	Unassign(ctx context.Context, resourceID, userID uuid.UUID) error
+}
+
+type resourceOperatorCommandsImpl struct {
+	uow   shared.UnitOfWork
+	plans PlanGuard
+}
+
+func NewResourceOperatorCommands(uow shared.UnitOfWork, plans PlanGuard) ResourceOperatorCommands {
+	return &resourceOperatorCommandsImpl{uow: uow, plans: plans}
+}
+
+func (c *resourceOperatorCommandsImpl) Assign(ctx context.Context, resourceID, userID uuid.UUID) error {
+	err := c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		if perr := c.plans.CheckOperator(ctx, tx.DB(), resourceID, userID); perr != nil {
+			return perr
+		}
+		if aerr := tx.ResourceOperators().Assign(ctx, tx.DB(), resourceID, userID); aerr != nil {
+			if infra.IsKind(aerr, infra.KindForeignKeyViolated) {
+				return errs.Mark(aerr, ErrOperatorTargetNotFound)
+			}
+			return aerr
+		}
+		return nil
+	})
+	if err != nil {
+		return errs.Mark(err, ErrOperatorUpdateFailed)
+	}
+	return nil
+}
+
+func (c *resourceOperatorCommandsImpl) Unassign(ctx context.Context, resourceID, userID uuid.UUID) error {
+	err := c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		if uerr := tx.ResourceOperators().Unassign(ctx, tx.DB(), resourceID, userID); uerr != nil {
+			if infra.IsKind(uerr, infra.KindNotFound) {
+				return errs.Mark(uerr, ErrOperatorAssignmentNotFound)
+			}
+			return uerr
+		}
+		return nil
+	})
+	if err != nil {
+		return errs.Mark(err, ErrOperatorUpdateFailed)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+)
+
+var ErrRetentionPurgeFailed = errs.New("retention purge failed")
+
+type RetentionPurgeResult struct {
+	Table   string
+	Cutoff  time.Time
+	Deleted int64
+	Batches int
+}
+
+type RetentionCommands interface {
+	Purge(ctx context.Context) ([]RetentionPurgeResult, error)
+}
+
+type retentionCommandsImpl struct {
+	uow      shared.UnitOfWork
+	repo     shared.RetentionRepository
+	policies shared.RetentionPolicies
+	metrics  *shared.RetentionMetrics
+	clock    clock.Clock
+}
+
+func NewRetentionCommands(
+	uow shared.UnitOfWork,
+	repo shared.RetentionRepository,
+	policies shared.RetentionPolicies,
+	metrics *shared.RetentionMetrics,
+	clock clock.Clock,
+) RetentionCommands {
+	return &retentionCommandsImpl{
+		uow:      uow,
+		repo:     repo,
+		policies: policies,
+		metrics:  metrics,
+		clock:    clock,
+	}
+}
+
+// Purge deletes expired rows table by table in bounded batches, each batch in its own
+// statement so long purges never hold locks for more than one batch.
+func (r *retentionCommandsImpl) Purge(ctx context.Context) ([]RetentionPurgeResult, error) {
+	now := r.clock.Now()
+	results := make([]RetentionPurgeResult, 0, len(r.policies.Policies))
+
+	for _, policy := range r.policies.Policies {
+		result, err := r.purgeTable(ctx, policy, now)
+		r.metrics.RecordRun(policy.Table, now, result.Deleted, result.Batches)
+		results = append(results, result)
+		if err != nil {
+			return results, errs.Mark(err, ErrRetentionPurgeFailed)
+		}
+	}
+
+	return results, nil
+}
+
+func (r *retentionCommandsImpl) purgeTable(ctx context.Context, policy shared.RetentionPolicy, now time.Time) (RetentionPurgeResult, error) {
+	result := RetentionPurgeResult{
+		Table:  policy.Table,
+		Cutoff: now.Add(-policy.MaxAge),
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		deleted, err := r.repo.DeleteExpiredBatch(ctx, r.uow.DB(ctx), policy.Table, result.Cutoff, r.policies.BatchSize)
+		if err != nil {
+			return result, err
+		}
+		if deleted == 0 {
+			break
+		}
+
+		result.Deleted += deleted
+		result.Batches++
+		slog.Info("Retention batch purged",
+			"table", policy.Table,
+			"batch", result.Batches,
+			"deleted", deleted,
+			"total_deleted", result.Deleted)
+
+		if deleted < int64(r.policies.BatchSize) {
+			break
+		}
+	}
+
+	return result, nil
+}
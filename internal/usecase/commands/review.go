@@ -2,37 +2,67 @@ package commands
 
 import (
 	"context"
+	"errors"
+	"log/slog"
+	"time"
 
 	domreview "gin-clean-starter/internal/domain/review"
 	reqdto "gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
 	"gin-clean-starter/internal/pkg/clock"
 	"gin-clean-starter/internal/pkg/errs"
-	"gin-clean-starter/internal/usecase/queries"
 	"gin-clean-starter/internal/usecase/shared"
 
 	"github.com/google/uuid"
 )
 
 var (
-	ErrReviewNotOwned          = errs.New("review not owned by user")
-	ErrReviewNotFoundWrite     = errs.New("review not found")
+	ErrReviewNotOwned          = errs.NewCoded("REVIEW_NOT_OWNED", "review not owned by user")
+	ErrReviewNotFoundWrite     = errs.NewCoded("REVIEW_NOT_FOUND", "review not found")
+	ErrReviewAlreadyExists     = errs.NewCoded("REVIEW_ALREADY_EXISTS", "review already exists for this reservation")
+	ErrReviewNotEligible       = errs.NewCoded("REVIEW_NOT_ELIGIBLE", "reservation is not eligible for review")
+	ErrReviewTooEarly          = errs.NewCoded("REVIEW_TOO_EARLY", "reservation cannot be reviewed yet")
+	ErrReviewWindowExpired     = errs.NewCoded("REVIEW_WINDOW_EXPIRED", "review window has expired")
+	ErrReviewNotFlagged        = errs.NewCoded("REVIEW_NOT_FLAGGED", "review is not held for moderation")
+	ErrReviewModerationDenied  = errs.NewCoded("PERMISSION_DENIED", "permission denied")
+	ErrReviewApprovalFailed    = errs.New("review approval failed")
+	ErrDuplicateCheckFailed    = errs.New("duplicate review check failed")
+	ErrFeatureCheckFailed      = errs.New("feature check failed")
 	ErrReviewCreationFailed    = errs.New("review creation failed")
 	ErrReviewUpdateFailed      = errs.New("review update failed")
 	ErrReviewDeletionFailed    = errs.New("review deletion failed")
-	ErrDomainValidationFailed  = errs.New("domain validation failed")
+	ErrDomainValidationFailed  = errs.NewCoded("VALIDATION_FAILED", "domain validation failed")
 	ErrRatingStatsRecalcFailed = errs.New("rating stats recalculation failed")
 	ErrReservationCheckFailed  = errs.New("reservation check failed")
 	ErrTransactionFailed       = errs.New("transaction failed")
 )
 
+// ReviewPolicy configures duplicate detection: a new review whose comment has a trigram
+// similarity of at least DuplicateThreshold with one of the author's last DuplicateLookback
+// reviews is held for moderation. A zero threshold turns the check off. Window is when a
+// reservation can be reviewed unless its company sets its own, and ClockSkew widens it for
+// clients whose clock is slightly off ours.
+type ReviewPolicy struct {
+	DuplicateThreshold float32
+	DuplicateLookback  int32
+	ClockSkew          time.Duration
+	Window             domreview.Window
+}
+
 type CreateReviewResult struct {
 	ReviewID uuid.UUID
+	// Flagged reports that the review was held for moderation instead of published.
+	Flagged bool
 }
 
 type ReviewCommands interface {
 	Create(ctx context.Context, req reqdto.CreateReviewRequest, userID uuid.UUID) (*CreateReviewResult, error)
 	Update(ctx context.Context, reviewID uuid.UUID, req reqdto.UpdateReviewRequest, actorID uuid.UUID) error
 	Delete(ctx context.Context, reviewID uuid.UUID, actorID uuid.UUID, actorRole string) error
+	// Approve publishes a flagged review and counts it in the resource's rating stats.
+	Approve(ctx context.Context, reviewID uuid.UUID, actorID uuid.UUID, actorRole string) error
+	Import(ctx context.Context, req reqdto.ImportReviewsRequest, actorID uuid.UUID) (*ReviewImportReport, error)
 }
 
 type reviewCommandsImpl struct {
@@ -40,15 +70,19 @@ type reviewCommandsImpl struct {
 	clock        clock.Clock
 	reviews      shared.ReviewReadStore
 	reservations shared.ReservationSnapshotReadStore
+	authorizer   shared.ResourceAuthorizer
+	languages    shared.LanguageDetector
+	features     shared.FeatureFlags
+	policy       ReviewPolicy
 }
 
-func NewReviewCommands(uow shared.UnitOfWork, clk clock.Clock, reviews shared.ReviewReadStore, reservations shared.ReservationSnapshotReadStore) ReviewCommands {
-	return &reviewCommandsImpl{uow: uow, clock: clk, reviews: reviews, reservations: reservations}
+func NewReviewCommands(uow shared.UnitOfWork, clk clock.Clock, reviews shared.ReviewReadStore, reservations shared.ReservationSnapshotReadStore, authorizer shared.ResourceAuthorizer, languages shared.LanguageDetector, features shared.FeatureFlags, policy ReviewPolicy) ReviewCommands {
+	return &reviewCommandsImpl{uow: uow, clock: clk, reviews: reviews, reservations: reservations, authorizer: authorizer, languages: languages, features: features, policy: policy}
 }
 
 func (uc *reviewCommandsImpl) Create(ctx context.Context, req reqdto.CreateReviewRequest, userID uuid.UUID) (*CreateReviewResult, error) {
 	if err := uc.canPostReview(ctx, userID, req.ResourceID, req.ReservationID); err != nil {
-		return nil, errs.Mark(err, ErrDomainValidationFailed)
+		return nil, err
 	}
 
 	now := uc.clock.Now()
@@ -56,14 +90,43 @@ func (uc *reviewCommandsImpl) Create(ctx context.Context, req reqdto.CreateRevie
 	if err != nil {
 		return nil, errs.Mark(err, ErrDomainValidationFailed)
 	}
+	uc.tagLanguage(ctx, rev)
+
+	// Companies without review moderation publish every review, duplicates included.
+	moderated, err := uc.features.EnabledForResource(ctx, req.ResourceID, shared.FeatureReviewModeration)
+	if err != nil {
+		return nil, errs.Mark(err, ErrFeatureCheckFailed)
+	}
 
 	var createdID uuid.UUID
 	err = uc.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		if moderated {
+			if derr := uc.flagIfDuplicate(ctx, tx, rev); derr != nil {
+				return derr
+			}
+		}
 		id, derr := tx.Reviews().Create(ctx, tx.DB(), rev)
 		if derr != nil {
+			if infra.IsKind(derr, infra.KindDuplicateKey) {
+				return errs.Mark(derr, ErrReviewAlreadyExists)
+			}
 			return errs.Mark(derr, ErrReviewCreationFailed)
 		}
 		createdID = id
+		derr = tx.Activity().Record(ctx, tx.DB(), shared.ActivityEntry{
+			UserID:     userID,
+			Kind:       shared.ActivityReviewPosted,
+			SubjectID:  id,
+			ResourceID: &req.ResourceID,
+			Data:       map[string]any{"rating": req.Rating},
+			OccurredAt: now,
+		})
+		if derr != nil {
+			return errs.Mark(derr, ErrReviewCreationFailed)
+		}
+		if rev.Status() == domreview.StatusFlagged {
+			return nil
+		}
 		if derr := tx.RatingStats().ApplyOnCreate(ctx, tx.DB(), req.ResourceID, req.Rating); derr != nil {
 			return errs.Mark(derr, ErrRatingStatsRecalcFailed)
 		}
@@ -72,7 +135,35 @@ func (uc *reviewCommandsImpl) Create(ctx context.Context, req reqdto.CreateRevie
 	if err != nil {
 		return nil, errs.Mark(err, ErrTransactionFailed)
 	}
-	return &CreateReviewResult{ReviewID: createdID}, nil
+	return &CreateReviewResult{ReviewID: createdID, Flagged: rev.Status() == domreview.StatusFlagged}, nil
+}
+
+// tagLanguage records the comment's language. Detection is best effort: a failing
+// detector leaves the language unknown rather than rejecting the review.
+func (uc *reviewCommandsImpl) tagLanguage(ctx context.Context, rev *domreview.Review) {
+	code, err := uc.languages.Detect(ctx, rev.Comment().String())
+	if err != nil {
+		slog.Warn("Review language detection failed", "review_id", rev.ID(), "error", err.Error())
+		return
+	}
+	rev.TagLanguage(code)
+}
+
+// flagIfDuplicate compares the comment with the author's recent reviews inside the
+// creating transaction, so two copies posted at once cannot both miss each other.
+func (uc *reviewCommandsImpl) flagIfDuplicate(ctx context.Context, tx shared.Tx, rev *domreview.Review) error {
+	if uc.policy.DuplicateThreshold <= 0 {
+		return nil
+	}
+	original, err := uc.reviews.FindSimilarRecent(ctx, tx.DB(), rev.UserID(), rev.Comment().String(), uc.policy.DuplicateLookback, uc.policy.DuplicateThreshold)
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return nil
+		}
+		return errs.Mark(err, ErrDuplicateCheckFailed)
+	}
+	rev.FlagAsDuplicateOf(original)
+	return nil
 }
 
 func (uc *reviewCommandsImpl) Update(ctx context.Context, reviewID uuid.UUID, req reqdto.UpdateReviewRequest, actorID uuid.UUID) error {
@@ -91,11 +182,12 @@ func (uc *reviewCommandsImpl) Update(ctx context.Context, reviewID uuid.UUID, re
 		if err != nil {
 			return errs.Mark(err, ErrDomainValidationFailed)
 		}
+		uc.tagLanguage(ctx, updatedReview)
 
 		if derr := tx.Reviews().Update(ctx, tx.DB(), reviewID, updatedReview); derr != nil {
 			return errs.Mark(derr, ErrReviewUpdateFailed)
 		}
-		if existing.Rating != updatedReview.Rating().Value() {
+		if existing.Status == string(domreview.StatusPublished) && existing.Rating != updatedReview.Rating().Value() {
 			if derr := tx.RatingStats().ApplyOnUpdate(ctx, tx.DB(), existing.ResourceID, existing.Rating, updatedReview.Rating().Value()); derr != nil {
 				return errs.Mark(derr, ErrRatingStatsRecalcFailed)
 			}
@@ -114,12 +206,24 @@ func (uc *reviewCommandsImpl) Delete(ctx context.Context, reviewID uuid.UUID, ac
 		if derr != nil {
 			return errs.Mark(derr, ErrReviewNotFoundWrite)
 		}
-		if actorRole != queries.RoleAdmin && snap.UserID != actorID {
-			return ErrReviewNotOwned
+		if snap.UserID != actorID {
+			allowed, aerr := uc.authorizer.CanActOnResource(ctx, actorID, actorRole, shared.PermissionReviewsDeleteAny, shared.PermissionReviewsDeleteAssigned, snap.ResourceID)
+			if aerr != nil {
+				return errs.Mark(aerr, ErrReviewDeletionFailed)
+			}
+			if !allowed {
+				return ErrReviewNotOwned
+			}
 		}
 		if derr = tx.Reviews().Delete(ctx, tx.DB(), reviewID); derr != nil {
 			return errs.Mark(derr, ErrReviewDeletionFailed)
 		}
+		if derr = tx.Activity().Remove(ctx, tx.DB(), shared.ActivityReviewPosted, reviewID); derr != nil {
+			return errs.Mark(derr, ErrReviewDeletionFailed)
+		}
+		if snap.Status != string(domreview.StatusPublished) {
+			return nil
+		}
 		if derr = tx.RatingStats().ApplyOnDelete(ctx, tx.DB(), snap.ResourceID, snap.Rating); derr != nil {
 			return errs.Mark(derr, ErrRatingStatsRecalcFailed)
 		}
@@ -131,21 +235,79 @@ func (uc *reviewCommandsImpl) Delete(ctx context.Context, reviewID uuid.UUID, ac
 	return nil
 }
 
+func (uc *reviewCommandsImpl) Approve(ctx context.Context, reviewID uuid.UUID, actorID uuid.UUID, actorRole string) error {
+	err := uc.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		snap, derr := uc.reviews.FindSnapshotByID(ctx, tx.DB(), reviewID)
+		if derr != nil {
+			return errs.Mark(derr, ErrReviewNotFoundWrite)
+		}
+		allowed, aerr := uc.authorizer.CanActOnResource(ctx, actorID, actorRole, shared.PermissionReviewsDeleteAny, shared.PermissionReviewsDeleteAssigned, snap.ResourceID)
+		if aerr != nil {
+			return errs.Mark(aerr, ErrReviewApprovalFailed)
+		}
+		if !allowed {
+			return ErrReviewModerationDenied
+		}
+		if derr = tx.Reviews().Publish(ctx, tx.DB(), reviewID); derr != nil {
+			if infra.IsKind(derr, infra.KindNotFound) {
+				return errs.Mark(derr, ErrReviewNotFlagged)
+			}
+			return errs.Mark(derr, ErrReviewApprovalFailed)
+		}
+		if derr = tx.RatingStats().ApplyOnCreate(ctx, tx.DB(), snap.ResourceID, snap.Rating); derr != nil {
+			return errs.Mark(derr, ErrRatingStatsRecalcFailed)
+		}
+		return nil
+	})
+	if err != nil {
+		return errs.Mark(err, ErrTransactionFailed)
+	}
+	return nil
+}
+
 func (uc *reviewCommandsImpl) canPostReview(ctx context.Context, userID, resourceID, reservationID uuid.UUID) error {
 	db := uc.uow.DB(ctx)
 	resSnap, err := uc.reservations.FindSnapshotByID(ctx, db, reservationID)
 	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return errs.Mark(err, ErrReservationNotFoundWrite)
+		}
 		return errs.Mark(err, ErrReservationCheckFailed)
 	}
 	if resSnap.UserID != userID || resSnap.ResourceID != resourceID {
-		return domreview.ErrReservationNotEligible
+		return errs.Mark(domreview.ErrReservationNotEligible, ErrReviewNotEligible)
 	}
 	if resSnap.Status != "confirmed" {
-		return domreview.ErrReservationNotEligible
+		return errs.Mark(domreview.ErrReservationNotEligible, ErrReviewNotEligible)
 	}
-	now := uc.clock.Now()
-	if !resSnap.EndTime.Before(now) {
-		return domreview.ErrReservationNotEligible
+	window, err := uc.reviewWindow(ctx, db, resourceID)
+	if err != nil {
+		return err
+	}
+	switch err := window.Check(resSnap.EndTime, uc.clock.Now(), uc.policy.ClockSkew); {
+	case errors.Is(err, domreview.ErrReviewWindowNotOpen):
+		return errs.Mark(err, ErrReviewTooEarly)
+	case errors.Is(err, domreview.ErrReviewWindowClosed):
+		return errs.Mark(err, ErrReviewWindowExpired)
 	}
 	return nil
 }
+
+// reviewWindow is the policy's window with the resource's company settings laid over it.
+func (uc *reviewCommandsImpl) reviewWindow(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID) (domreview.Window, error) {
+	window := uc.policy.Window
+	setting, err := uc.reviews.FindReviewWindow(ctx, db, resourceID)
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return window, errs.Mark(err, ErrReviewNotEligible)
+		}
+		return window, errs.Mark(err, ErrReservationCheckFailed)
+	}
+	if setting.OpensAfterMin != nil {
+		window.OpensAfter = time.Duration(*setting.OpensAfterMin) * time.Minute
+	}
+	if setting.WindowDays != nil {
+		window.ClosesAfter = time.Duration(*setting.WindowDays) * 24 * time.Hour
+	}
+	return window, nil
+}
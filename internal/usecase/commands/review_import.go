@@ -0,0 +1,196 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	domreview "gin-clean-starter/internal/domain/review"
+	"gin-clean-starter/internal/domain/user"
+	reqdto "gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+const (
+	AuditActionReviewsImported = "resource.reviews_imported"
+
+	// maxImportExternalIDLength bounds the ID a review had in the system it came from.
+	maxImportExternalIDLength = 255
+)
+
+var (
+	ErrReviewImportResourceNotFound = errs.NewCoded("RESOURCE_NOT_FOUND", "resource not found")
+	ErrReviewImportAuthorNotFound   = errs.NewCoded("REVIEW_AUTHOR_NOT_FOUND", "no user matches the imported review's author")
+	ErrReviewImportFailed           = errs.New("review import failed")
+
+	// reviewImportRejections leave a single review out of an import; other errors fail it.
+	reviewImportRejections = []error{ErrDomainValidationFailed, ErrReviewImportResourceNotFound, ErrReviewImportAuthorNotFound}
+)
+
+// ReviewImportStatus is what became of one review of an import.
+type ReviewImportStatus string
+
+const (
+	ReviewImportImported  ReviewImportStatus = "imported"
+	ReviewImportDuplicate ReviewImportStatus = "duplicate"
+	ReviewImportRejected  ReviewImportStatus = "rejected"
+)
+
+// ReviewImportItem reports one review of an import; Index is its position in the request.
+type ReviewImportItem struct {
+	Index      int
+	ExternalID string
+	Status     ReviewImportStatus
+	// ReviewID is set for imported reviews.
+	ReviewID *uuid.UUID
+	// Code says why a rejected review was left out: VALIDATION_FAILED,
+	// RESOURCE_NOT_FOUND or REVIEW_AUTHOR_NOT_FOUND.
+	Code errs.Code
+}
+
+// ReviewImportReport lists every review of an import in request order, with totals.
+type ReviewImportReport struct {
+	Imported   int
+	Duplicates int
+	Rejected   int
+	Items      []ReviewImportItem
+}
+
+// pendingImport is a checked review waiting for the import's transaction.
+type pendingImport struct {
+	index      int
+	externalID string
+	review     *domreview.Review
+}
+
+// Import carries over historical reviews from another system. Each review is checked on
+// its own, so a bad one is reported and the rest still go in; the ones source imported
+// before are skipped. Rating stats of the resources reviewed are recalculated once, after
+// the last insert, rather than review by review.
+func (uc *reviewCommandsImpl) Import(ctx context.Context, req reqdto.ImportReviewsRequest, actorID uuid.UUID) (*ReviewImportReport, error) {
+	now := uc.clock.Now()
+	report := &ReviewImportReport{Items: make([]ReviewImportItem, len(req.Reviews))}
+	known := map[uuid.UUID]bool{}
+	pending := make([]pendingImport, 0, len(req.Reviews))
+	for i, item := range req.Reviews {
+		report.Items[i] = ReviewImportItem{Index: i, ExternalID: item.ExternalID}
+		rev, err := uc.checkImport(ctx, item, known, now)
+		if err != nil {
+			rejection := rejectionOf(err)
+			if rejection == nil {
+				return nil, errs.Mark(err, ErrReviewImportFailed)
+			}
+			report.Items[i].Status = ReviewImportRejected
+			report.Items[i].Code = errs.CodeOf(rejection)
+			report.Rejected++
+			continue
+		}
+		uc.tagLanguage(ctx, rev)
+		pending = append(pending, pendingImport{index: i, externalID: strings.TrimSpace(item.ExternalID), review: rev})
+	}
+
+	err := uc.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		var resources []uuid.UUID
+		imported := map[uuid.UUID]int{}
+		for _, p := range pending {
+			id, ierr := tx.Reviews().Import(ctx, tx.DB(), p.review, req.Source, p.externalID)
+			if ierr != nil {
+				if infra.IsKind(ierr, infra.KindDuplicateKey) {
+					report.Items[p.index].Status = ReviewImportDuplicate
+					report.Duplicates++
+					continue
+				}
+				return ierr
+			}
+			report.Items[p.index].Status = ReviewImportImported
+			report.Items[p.index].ReviewID = &id
+			report.Imported++
+			resourceID := p.review.ResourceID()
+			if imported[resourceID] == 0 {
+				resources = append(resources, resourceID)
+			}
+			imported[resourceID]++
+		}
+
+		for _, resourceID := range resources {
+			if rerr := tx.RatingStats().Recalculate(ctx, tx.DB(), resourceID); rerr != nil {
+				return errs.Mark(rerr, ErrRatingStatsRecalcFailed)
+			}
+			if aerr := tx.Audit().Record(ctx, tx.DB(), shared.AuditEntry{
+				ActorID:    &actorID,
+				Action:     AuditActionReviewsImported,
+				TargetType: auditTargetResource,
+				TargetID:   resourceID.String(),
+				Metadata: map[string]any{
+					"source":   req.Source,
+					"imported": imported[resourceID],
+				},
+			}); aerr != nil {
+				return aerr
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errs.Mark(err, ErrReviewImportFailed)
+	}
+	return report, nil
+}
+
+// checkImport turns one review of an import into a domain review. known caches which
+// resources exist, as an export usually holds many reviews of each.
+func (uc *reviewCommandsImpl) checkImport(ctx context.Context, item reqdto.ImportReviewItemRequest, known map[uuid.UUID]bool, now time.Time) (*domreview.Review, error) {
+	externalID := strings.TrimSpace(item.ExternalID)
+	if externalID == "" || len(externalID) > maxImportExternalIDLength {
+		return nil, ErrDomainValidationFailed
+	}
+
+	db := uc.uow.DB(ctx)
+	exists, ok := known[item.ResourceID]
+	if !ok {
+		_, err := uc.reviews.FindReviewWindow(ctx, db, item.ResourceID)
+		if err != nil && !infra.IsKind(err, infra.KindNotFound) {
+			return nil, err
+		}
+		exists = err == nil
+		known[item.ResourceID] = exists
+	}
+	if !exists {
+		return nil, ErrReviewImportResourceNotFound
+	}
+
+	var email string
+	if item.AuthorEmail != "" {
+		// A malformed email matches nobody; the external ID may still.
+		if e, err := user.NewEmail(item.AuthorEmail); err == nil {
+			email = e.Value()
+		}
+	}
+	userID, err := uc.reviews.FindImportAuthor(ctx, db, item.ResourceID, strings.TrimSpace(item.AuthorExternalID), email)
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return nil, errs.Mark(err, ErrReviewImportAuthorNotFound)
+		}
+		return nil, err
+	}
+
+	rev, err := item.ToDomain(userID, now)
+	if err != nil {
+		return nil, errs.Mark(err, ErrDomainValidationFailed)
+	}
+	return rev, nil
+}
+
+func rejectionOf(err error) error {
+	for _, rejection := range reviewImportRejections {
+		if errors.Is(err, rejection) {
+			return rejection
+		}
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+)
+
+var ErrReviewSummaryFailed = errs.New("review summary refresh failed")
+
+// ReviewSummaryPolicy bounds one refresh run: BatchSize resources, each summarized from
+// its RecentReviews newest reviews.
+type ReviewSummaryPolicy struct {
+	BatchSize     int32
+	RecentReviews int32
+}
+
+type ReviewSummaryCommands interface {
+	// RefreshSummaries regenerates the summary of every resource whose review count changed
+	// since its last summary, and returns how many summaries were saved.
+	RefreshSummaries(ctx context.Context) (int, error)
+}
+
+type reviewSummaryCommandsImpl struct {
+	uow        shared.UnitOfWork
+	reviews    shared.ReviewReadStore
+	summarizer shared.Summarizer
+	policy     ReviewSummaryPolicy
+}
+
+func NewReviewSummaryCommands(uow shared.UnitOfWork, reviews shared.ReviewReadStore, summarizer shared.Summarizer, policy ReviewSummaryPolicy) ReviewSummaryCommands {
+	return &reviewSummaryCommandsImpl{
+		uow:        uow,
+		reviews:    reviews,
+		summarizer: summarizer,
+		policy:     policy,
+	}
+}
+
+// The summarizer may call out to a slow service, so it runs outside any transaction; the
+// save is skipped when a review lands meanwhile and the resource is picked up next run.
+func (c *reviewSummaryCommandsImpl) RefreshSummaries(ctx context.Context) (int, error) {
+	stale, err := c.reviews.ListStaleSummaries(ctx, c.uow.DB(ctx), c.policy.BatchSize)
+	if err != nil {
+		return 0, errs.Mark(err, ErrReviewSummaryFailed)
+	}
+
+	saved := 0
+	for _, s := range stale {
+		if cerr := ctx.Err(); cerr != nil {
+			return saved, cerr
+		}
+
+		excerpts, lerr := c.reviews.ListRecentForSummary(ctx, c.uow.DB(ctx), s.ResourceID, c.policy.RecentReviews)
+		if lerr != nil {
+			return saved, errs.Mark(lerr, ErrReviewSummaryFailed)
+		}
+		summary, serr := c.summarizer.Summarize(ctx, excerpts)
+		if serr != nil {
+			// One resource failing to summarize must not hold back the rest of the batch.
+			slog.Error("Failed to summarize reviews", "resource_id", s.ResourceID, "error", serr.Error())
+			continue
+		}
+
+		var current bool
+		werr := c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+			var xerr error
+			current, xerr = tx.RatingStats().SaveSummary(ctx, tx.DB(), s.ResourceID, summary, s.TotalReviews)
+			return xerr
+		})
+		if werr != nil {
+			return saved, errs.Mark(werr, ErrReviewSummaryFailed)
+		}
+		if current {
+			saved++
+		}
+	}
+	if saved > 0 {
+		slog.Info("Review summaries refreshed", "saved", saved)
+	}
+	return saved, nil
+}
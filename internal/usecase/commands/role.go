@@ -0,0 +1,211 @@
+package commands
+
+import (
+	"context"
+	"errors"
+
+	"gin-clean-starter/internal/domain/user"
+	reqdto "gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/queries"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidRoleName     = errs.NewCoded("INVALID_ROLE_NAME", "invalid role name")
+	ErrRoleNotFound        = errs.NewCoded("ROLE_NOT_FOUND", "role not found")
+	ErrRoleAlreadyExists   = errs.NewCoded("ROLE_ALREADY_EXISTS", "role already exists")
+	ErrRoleInUse           = errs.NewCoded("ROLE_IN_USE", "role still assigned to users")
+	ErrSystemRoleImmutable = errs.NewCoded("SYSTEM_ROLE_IMMUTABLE", "system roles cannot be modified")
+	ErrUnknownPermission   = errs.NewCoded("UNKNOWN_PERMISSION", "unknown permission")
+	ErrOwnRoleChange       = errs.NewCoded("OWN_ROLE_CHANGE", "users cannot change their own role")
+	ErrRoleUpdateFailed    = errs.New("role update failed")
+)
+
+type RoleCommands interface {
+	Create(ctx context.Context, req reqdto.CreateRoleRequest) error
+	UpdatePermissions(ctx context.Context, name string, req reqdto.UpdateRolePermissionsRequest) error
+	Delete(ctx context.Context, name string) error
+	// ChangeUserRole assigns the user the requested role on behalf of actorID. Tokens
+	// carry the role they were issued with, so the user is signed out everywhere.
+	ChangeUserRole(ctx context.Context, userID uuid.UUID, req reqdto.ChangeUserRoleRequest, actorID uuid.UUID) error
+}
+
+type roleCommandsImpl struct {
+	uow         shared.UnitOfWork
+	clock       clock.Clock
+	readStore   queries.RoleReadStore
+	users       queries.UserReadStore
+	tokens      shared.RefreshTokenRepository
+	permissions shared.PermissionResolver
+}
+
+func NewRoleCommands(
+	uow shared.UnitOfWork,
+	clock clock.Clock,
+	readStore queries.RoleReadStore,
+	users queries.UserReadStore,
+	tokens shared.RefreshTokenRepository,
+	permissions shared.PermissionResolver,
+) RoleCommands {
+	return &roleCommandsImpl{
+		uow:         uow,
+		clock:       clock,
+		readStore:   readStore,
+		users:       users,
+		tokens:      tokens,
+		permissions: permissions,
+	}
+}
+
+func (r *roleCommandsImpl) Create(ctx context.Context, req reqdto.CreateRoleRequest) error {
+	role, err := user.NewRole(req.Name)
+	if err != nil {
+		return errs.Mark(err, ErrInvalidRoleName)
+	}
+
+	err = r.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		if cerr := tx.Roles().Create(ctx, tx.DB(), role.String(), req.Description); cerr != nil {
+			if infra.IsKind(cerr, infra.KindDuplicateKey) {
+				return errs.Mark(cerr, ErrRoleAlreadyExists)
+			}
+			return cerr
+		}
+		return r.replacePermissions(ctx, tx, role.String(), req.Permissions)
+	})
+	if err != nil {
+		return errs.Mark(err, ErrRoleUpdateFailed)
+	}
+
+	r.permissions.Invalidate()
+	return nil
+}
+
+func (r *roleCommandsImpl) UpdatePermissions(ctx context.Context, name string, req reqdto.UpdateRolePermissionsRequest) error {
+	err := r.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		if cerr := r.ensureCustomRole(ctx, tx, name); cerr != nil {
+			return cerr
+		}
+		return r.replacePermissions(ctx, tx, name, req.Permissions)
+	})
+	if err != nil {
+		return errs.Mark(err, ErrRoleUpdateFailed)
+	}
+
+	r.permissions.Invalidate()
+	return nil
+}
+
+func (r *roleCommandsImpl) Delete(ctx context.Context, name string) error {
+	err := r.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		if cerr := r.ensureCustomRole(ctx, tx, name); cerr != nil {
+			return cerr
+		}
+		if cerr := tx.Roles().Delete(ctx, tx.DB(), name); cerr != nil {
+			switch {
+			case infra.IsKind(cerr, infra.KindNotFound):
+				return errs.Mark(cerr, ErrRoleNotFound)
+			case infra.IsKind(cerr, infra.KindForeignKeyViolated):
+				return errs.Mark(cerr, ErrRoleInUse)
+			}
+			return cerr
+		}
+		return nil
+	})
+	if err != nil {
+		return errs.Mark(err, ErrRoleUpdateFailed)
+	}
+
+	r.permissions.Invalidate()
+	return nil
+}
+
+func (r *roleCommandsImpl) ChangeUserRole(ctx context.Context, userID uuid.UUID, req reqdto.ChangeUserRoleRequest, actorID uuid.UUID) error {
+	account, err := r.users.FindByID(ctx, r.uow.DB(ctx), userID)
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return errs.Mark(err, ErrUserNotFound)
+		}
+		return errs.Mark(err, ErrRoleUpdateFailed)
+	}
+	current := user.Role(account.Role)
+	role, err := user.ChangeRole(actorID, userID, current, req.Role)
+	if err != nil {
+		if errors.Is(err, user.ErrOwnRoleChange) {
+			return errs.Mark(err, ErrOwnRoleChange)
+		}
+		return errs.Mark(err, ErrInvalidRoleName)
+	}
+	if role == current {
+		return nil
+	}
+
+	err = r.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		if serr := tx.Users().SetRole(ctx, tx.DB(), userID, role.String()); serr != nil {
+			if infra.IsKind(serr, infra.KindForeignKeyViolated) {
+				return errs.Mark(serr, ErrRoleNotFound)
+			}
+			return serr
+		}
+		if rerr := r.tokens.RevokeUser(ctx, tx.DB(), userID, r.clock.Now()); rerr != nil {
+			return rerr
+		}
+		return tx.Audit().Record(ctx, tx.DB(), shared.AuditEntry{
+			ActorID:    &actorID,
+			Action:     shared.AuditActionRoleChanged,
+			TargetType: shared.AuditTargetUser,
+			TargetID:   userID.String(),
+			Metadata: map[string]any{
+				"from": current.String(),
+				"to":   role.String(),
+			},
+		})
+	})
+	if err != nil {
+		return errs.Mark(err, ErrRoleUpdateFailed)
+	}
+	return nil
+}
+
+// System roles carry the built-in hierarchy and stay fixed; only custom roles are editable.
+func (r *roleCommandsImpl) ensureCustomRole(ctx context.Context, tx shared.Tx, name string) error {
+	role, err := r.readStore.FindByName(ctx, tx.DB(), name)
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return errs.Mark(err, ErrRoleNotFound)
+		}
+		return err
+	}
+	if role.IsSystem {
+		return ErrSystemRoleImmutable
+	}
+	return nil
+}
+
+func (r *roleCommandsImpl) replacePermissions(ctx context.Context, tx shared.Tx, name string, permissions []string) error {
+	err := tx.Roles().ReplacePermissions(ctx, tx.DB(), name, dedupePermissions(permissions))
+	if err != nil {
+		if infra.IsKind(err, infra.KindForeignKeyViolated) {
+			return errs.Mark(err, ErrUnknownPermission)
+		}
+		return err
+	}
+	return nil
+}
+
+func dedupePermissions(values []string) []string {
+	seen := make(map[string]struct{}, len(values))
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		out = append(out, v)
+	}
+	return out
+}
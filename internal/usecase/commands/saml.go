@@ -0,0 +1,393 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"gin-clean-starter/internal/domain/user"
+	reqdto "gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/pkg/jwt"
+	"gin-clean-starter/internal/pkg/password"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/pkg/reqctx"
+	"gin-clean-starter/internal/pkg/signedtoken"
+	"gin-clean-starter/internal/usecase/queries"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+const (
+	AuditActionSSOConnectionSet     = "sso.connection_set"
+	AuditActionSSOConnectionRemoved = "sso.connection_removed"
+	AuditActionSSOLogin             = "auth.sso_login"
+
+	// SAMLStatePurpose signs the state a browser carries from the login redirect to the ACS.
+	SAMLStatePurpose = "saml_login"
+	// samlStateTTL bounds how long the user may take at the IdP.
+	samlStateTTL = 10 * time.Minute
+)
+
+var (
+	ErrSAMLInvalidMetadata    = errs.NewCoded("SAML_INVALID_METADATA", "invalid identity provider metadata")
+	ErrSAMLUnknownRole        = errs.NewCoded("SAML_UNKNOWN_ROLE", "unknown SAML role")
+	ErrSAMLRoleForbidden      = errs.NewCoded("SAML_ROLE_FORBIDDEN", "actor may not grant this role through SSO")
+	ErrSAMLConnectionNotFound = errs.NewCoded("SAML_CONNECTION_NOT_FOUND", "company has no SAML connection")
+	ErrSAMLAssertionInvalid   = errs.NewCoded("SAML_ASSERTION_INVALID", "SAML response rejected")
+	ErrSAMLEmailInvalid       = errs.NewCoded("SAML_EMAIL_INVALID", "SAML assertion carries no valid email")
+	ErrSSOUserOtherCompany    = errs.NewCoded("SSO_USER_OTHER_COMPANY", "user belongs to another company")
+	ErrSAMLFailed             = errs.New("SAML operation failed")
+)
+
+// SAMLLoginStart sends the browser to the IdP. State goes back to the ACS with the
+// browser; it ties the response to this request.
+type SAMLLoginStart struct {
+	RedirectURL string
+	State       string
+	ExpiresAt   time.Time
+}
+
+type samlState struct {
+	CompanyID uuid.UUID `json:"c"`
+	RequestID string    `json:"r"`
+}
+
+// SAMLCommands signs company users in through their SAML identity provider.
+type SAMLCommands interface {
+	// SetConnection trusts the IdP described by the metadata. Mapping users into a role
+	// other than the actor's own needs roles:manage, as inviting into one does.
+	SetConnection(ctx context.Context, companyID uuid.UUID, req reqdto.SetSAMLConnectionRequest, actorID uuid.UUID, actorRole string) error
+	DeleteConnection(ctx context.Context, companyID, actorID uuid.UUID) error
+	StartLogin(ctx context.Context, companyID uuid.UUID) (*SAMLLoginStart, error)
+	// Login verifies a SAMLResponse posted to the company's ACS and issues a token pair.
+	// Unknown users join the company just in time; known ones get the role the assertion
+	// maps to. state is empty for IdP-initiated responses.
+	Login(ctx context.Context, companyID uuid.UUID, samlResponse, state string) (*LoginResult, error)
+}
+
+type samlCommandsImpl struct {
+	uow         shared.UnitOfWork
+	clock       clock.Clock
+	connections shared.SAMLConnectionReadStore
+	users       queries.UserReadStore
+	roles       queries.RoleReadStore
+	permissions shared.PermissionResolver
+	provider    shared.SAMLServiceProvider
+	signer      *signedtoken.Signer
+	jwtService  *jwt.Service
+	bindingMode DeviceBindingMode
+	tokens      shared.RefreshTokenRepository
+}
+
+func NewSAMLCommands(
+	uow shared.UnitOfWork,
+	clock clock.Clock,
+	connections shared.SAMLConnectionReadStore,
+	users queries.UserReadStore,
+	roles queries.RoleReadStore,
+	permissions shared.PermissionResolver,
+	provider shared.SAMLServiceProvider,
+	signer *signedtoken.Signer,
+	jwtService *jwt.Service,
+	bindingMode DeviceBindingMode,
+	tokens shared.RefreshTokenRepository,
+) SAMLCommands {
+	return &samlCommandsImpl{
+		uow:         uow,
+		clock:       clock,
+		connections: connections,
+		users:       users,
+		roles:       roles,
+		permissions: permissions,
+		provider:    provider,
+		signer:      signer,
+		jwtService:  jwtService,
+		bindingMode: bindingMode,
+		tokens:      tokens,
+	}
+}
+
+func (c *samlCommandsImpl) SetConnection(ctx context.Context, companyID uuid.UUID, req reqdto.SetSAMLConnectionRequest, actorID uuid.UUID, actorRole string) error {
+	metadata := []byte(strings.TrimSpace(req.MetadataXML))
+	entityID, err := c.provider.ParseIDPMetadata(metadata)
+	if err != nil {
+		return errs.Mark(err, ErrSAMLInvalidMetadata)
+	}
+
+	conn := shared.SAMLConnection{
+		CompanyID:      companyID,
+		IDPEntityID:    entityID,
+		IDPMetadata:    metadata,
+		EmailAttribute: strings.TrimSpace(req.EmailAttribute),
+		RoleAttribute:  strings.TrimSpace(req.RoleAttribute),
+		RoleMapping:    make(map[string]string, len(req.RoleMapping)),
+		DefaultRole:    req.DefaultRole,
+		UpdatedAt:      c.clock.Now(),
+	}
+	if conn.DefaultRole == "" {
+		conn.DefaultRole = user.RoleViewer.String()
+	}
+	for value, role := range req.RoleMapping {
+		conn.RoleMapping[strings.TrimSpace(value)] = role
+	}
+	if err := c.checkGrantableRoles(ctx, conn, actorRole); err != nil {
+		return err
+	}
+
+	err = c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		if uerr := tx.SAMLConnections().Upsert(ctx, tx.DB(), conn, actorID); uerr != nil {
+			if infra.IsKind(uerr, infra.KindForeignKeyViolated) {
+				return errs.Mark(uerr, ErrCompanyNotFound)
+			}
+			return uerr
+		}
+		return tx.Audit().Record(ctx, tx.DB(), shared.AuditEntry{
+			ActorID:    &actorID,
+			CompanyID:  &companyID,
+			Action:     AuditActionSSOConnectionSet,
+			TargetType: auditTargetCompany,
+			TargetID:   companyID.String(),
+			Metadata: map[string]any{
+				"idpEntityId": entityID,
+				"roleMapping": conn.RoleMapping,
+				"defaultRole": conn.DefaultRole,
+			},
+		})
+	})
+	if err != nil {
+		return errs.Mark(err, ErrSAMLFailed)
+	}
+	return nil
+}
+
+// checkGrantableRoles makes sure every role the connection hands out exists and that the
+// actor may grant it.
+func (c *samlCommandsImpl) checkGrantableRoles(ctx context.Context, conn shared.SAMLConnection, actorRole string) error {
+	granted := map[string]bool{conn.DefaultRole: true}
+	for _, role := range conn.RoleMapping {
+		granted[role] = true
+	}
+	mayManageRoles := false
+	for name := range granted {
+		if _, err := user.NewRole(name); err != nil {
+			return errs.Mark(err, ErrSAMLUnknownRole)
+		}
+		if _, err := c.roles.FindByName(ctx, c.uow.DB(ctx), name); err != nil {
+			if infra.IsKind(err, infra.KindNotFound) {
+				return errs.Mark(err, ErrSAMLUnknownRole)
+			}
+			return errs.Mark(err, ErrSAMLFailed)
+		}
+		if name == actorRole || mayManageRoles {
+			continue
+		}
+		ok, err := c.permissions.HasPermission(ctx, actorRole, shared.PermissionRolesManage)
+		if err != nil {
+			return errs.Mark(err, ErrSAMLFailed)
+		}
+		if !ok {
+			return ErrSAMLRoleForbidden
+		}
+		mayManageRoles = true
+	}
+	return nil
+}
+
+func (c *samlCommandsImpl) DeleteConnection(ctx context.Context, companyID, actorID uuid.UUID) error {
+	err := c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		if derr := tx.SAMLConnections().Delete(ctx, tx.DB(), companyID); derr != nil {
+			if infra.IsKind(derr, infra.KindNotFound) {
+				return errs.Mark(derr, ErrSAMLConnectionNotFound)
+			}
+			return derr
+		}
+		return tx.Audit().Record(ctx, tx.DB(), shared.AuditEntry{
+			ActorID:    &actorID,
+			CompanyID:  &companyID,
+			Action:     AuditActionSSOConnectionRemoved,
+			TargetType: auditTargetCompany,
+			TargetID:   companyID.String(),
+		})
+	})
+	if err != nil {
+		return errs.Mark(err, ErrSAMLFailed)
+	}
+	return nil
+}
+
+func (c *samlCommandsImpl) StartLogin(ctx context.Context, companyID uuid.UUID) (*SAMLLoginStart, error) {
+	conn, err := c.connection(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	redirectURL, requestID, err := c.provider.AuthnRequestURL(companyID, conn.IDPMetadata)
+	if err != nil {
+		return nil, errs.Mark(err, ErrSAMLFailed)
+	}
+	expiresAt := c.clock.Now().Add(samlStateTTL)
+	state, err := c.signer.Sign(SAMLStatePurpose, samlState{CompanyID: companyID, RequestID: requestID}, expiresAt)
+	if err != nil {
+		return nil, errs.Mark(err, ErrSAMLFailed)
+	}
+	return &SAMLLoginStart{RedirectURL: redirectURL, State: state, ExpiresAt: expiresAt}, nil
+}
+
+func (c *samlCommandsImpl) Login(ctx context.Context, companyID uuid.UUID, samlResponse, state string) (*LoginResult, error) {
+	// The IdP posts the browser here, so no client sends a device key.
+	if c.bindingMode == DeviceBindingRequired {
+		return nil, ErrDeviceKeyRequired
+	}
+
+	var requestIDs []string
+	if state != "" {
+		var claims samlState
+		if _, err := c.signer.Verify(SAMLStatePurpose, state, c.clock.Now(), &claims); err == nil && claims.CompanyID == companyID {
+			requestIDs = []string{claims.RequestID}
+		}
+	}
+
+	conn, err := c.connection(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	assertion, err := c.provider.ParseResponse(companyID, conn.IDPMetadata, samlResponse, requestIDs)
+	if err != nil {
+		return nil, errs.Mark(err, ErrSAMLAssertionInvalid)
+	}
+
+	email, err := user.NewEmail(assertionEmail(conn, assertion))
+	if err != nil {
+		return nil, errs.Mark(err, ErrSAMLEmailInvalid)
+	}
+	roleName := assertionRole(conn, assertion)
+	role, err := user.NewRole(roleName)
+	if err != nil {
+		return nil, errs.Mark(err, ErrSAMLFailed)
+	}
+
+	existing, _, err := c.users.FindByEmail(ctx, c.uow.DB(ctx), email.Value())
+	if err != nil && !infra.IsKind(err, infra.KindNotFound) {
+		return nil, errs.Mark(err, ErrSAMLFailed)
+	}
+	if existing != nil {
+		if existing.CompanyID == nil || *existing.CompanyID != companyID {
+			return nil, ErrSSOUserOtherCompany
+		}
+		if !existing.IsActive {
+			return nil, ErrUserInactive
+		}
+	}
+
+	var userID uuid.UUID
+	err = c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		provisioned := existing == nil
+		if provisioned {
+			id, cerr := c.createUser(ctx, tx, companyID, email.Value(), roleName)
+			if cerr != nil {
+				return cerr
+			}
+			userID = id
+		} else {
+			userID = existing.ID
+			if existing.Role != roleName {
+				if serr := tx.Users().SetRole(ctx, tx.DB(), userID, roleName); serr != nil {
+					return serr
+				}
+			}
+			if uerr := tx.Users().UpdateLastLogin(ctx, tx.DB(), userID); uerr != nil {
+				slog.Warn("failed to update last login", "user_id", userID, "error", uerr.Error())
+			}
+		}
+		if derr := recordDevice(ctx, tx, userID, "", c.clock.Now()); derr != nil {
+			return derr
+		}
+		client := reqctx.ClientInfo(ctx)
+		return tx.Audit().Record(ctx, tx.DB(), shared.AuditEntry{
+			ActorID:    &userID,
+			CompanyID:  &companyID,
+			Action:     AuditActionSSOLogin,
+			TargetType: shared.AuditTargetUser,
+			TargetID:   userID.String(),
+			Metadata: map[string]any{
+				"idpEntityId": conn.IDPEntityID,
+				"role":        roleName,
+				"provisioned": provisioned,
+				"ip":          client.IP,
+				"user_agent":  client.UserAgent,
+			},
+		})
+	})
+	if err != nil {
+		return nil, errs.Mark(err, ErrSAMLFailed)
+	}
+
+	var pair *TokenPair
+	err = c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		var issueErr error
+		pair, issueErr = issueTokens(ctx, tx.DB(), c.tokens, c.jwtService, userID, role, "", uuid.New(), c.clock.Now())
+		return issueErr
+	})
+	if err != nil {
+		return nil, errs.Mark(err, ErrTokenGeneration)
+	}
+	return &LoginResult{
+		UserID:    userID,
+		TokenPair: pair,
+	}, nil
+}
+
+func (c *samlCommandsImpl) connection(ctx context.Context, companyID uuid.UUID) (*shared.SAMLConnection, error) {
+	conn, err := c.connections.FindSAMLConnection(ctx, c.uow.DB(ctx), companyID)
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return nil, errs.Mark(err, ErrSAMLConnectionNotFound)
+		}
+		return nil, errs.Mark(err, ErrSAMLFailed)
+	}
+	return conn, nil
+}
+
+// createUser adds an SSO user with a password nobody knows; they sign in through the IdP.
+func (c *samlCommandsImpl) createUser(ctx context.Context, tx shared.Tx, companyID uuid.UUID, email, role string) (uuid.UUID, error) {
+	secret, err := unusablePassword()
+	if err != nil {
+		return uuid.Nil, err
+	}
+	passwordHash, err := password.HashPassword(secret)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return tx.Users().Create(ctx, tx.DB(), sqlc.CreateUserParams{
+		Email:        email,
+		PasswordHash: passwordHash,
+		Role:         role,
+		CompanyID:    pgconv.UUIDToPgtype(companyID),
+	})
+}
+
+func assertionEmail(conn *shared.SAMLConnection, assertion *shared.SAMLAssertion) string {
+	if conn.EmailAttribute == "" {
+		return assertion.NameID
+	}
+	if values := assertion.Attributes[conn.EmailAttribute]; len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func assertionRole(conn *shared.SAMLConnection, assertion *shared.SAMLAssertion) string {
+	if conn.RoleAttribute != "" {
+		for _, value := range assertion.Attributes[conn.RoleAttribute] {
+			if role, ok := conn.RoleMapping[value]; ok {
+				return role
+			}
+		}
+	}
+	return conn.DefaultRole
+}
@@ -0,0 +1,146 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/domain/user"
+	reqdto "gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/pkg/jwt"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+const (
+	AuditActionSupportSessionStarted = "support.session_started"
+	AuditActionSupportRequest        = "support.request"
+	AuditActionSupportWriteRejected  = "support.write_rejected"
+
+	auditTargetCompany = "company"
+)
+
+var (
+	ErrInvalidSupportCompanyID = errs.NewCoded("INVALID_COMPANY_ID", "invalid support company ID")
+	ErrSupportCompanyNotFound  = errs.NewCoded("COMPANY_NOT_FOUND", "support company not found")
+	ErrSupportSessionFailed    = errs.New("support session failed")
+)
+
+// SupportPolicy bounds how long a support token stays valid; it cannot be refreshed.
+type SupportPolicy struct {
+	SessionTTL time.Duration
+}
+
+type SupportSessionResult struct {
+	SessionID   uuid.UUID
+	CompanyID   uuid.UUID
+	AccessToken string
+	ExpiresAt   time.Time
+}
+
+// SupportRequestRecord describes one request made with a support token.
+type SupportRequestRecord struct {
+	SessionID uuid.UUID
+	ActorID   uuid.UUID
+	CompanyID uuid.UUID
+	Method    string
+	Path      string
+	Status    int
+	Rejected  bool
+}
+
+type SupportCommands interface {
+	Start(ctx context.Context, req reqdto.StartSupportSessionRequest, actorID uuid.UUID, actorRole user.Role) (*SupportSessionResult, error)
+	RecordRequest(ctx context.Context, rec SupportRequestRecord) error
+}
+
+type supportCommandsImpl struct {
+	uow        shared.UnitOfWork
+	clock      clock.Clock
+	jwtService *jwt.Service
+	policy     SupportPolicy
+}
+
+func NewSupportCommands(uow shared.UnitOfWork, clock clock.Clock, jwtService *jwt.Service, policy SupportPolicy) SupportCommands {
+	return &supportCommandsImpl{
+		uow:        uow,
+		clock:      clock,
+		jwtService: jwtService,
+		policy:     policy,
+	}
+}
+
+// Start issues a read-only token scoped to the company and records who opened it and why.
+func (c *supportCommandsImpl) Start(ctx context.Context, req reqdto.StartSupportSessionRequest, actorID uuid.UUID, actorRole user.Role) (*SupportSessionResult, error) {
+	companyID, err := uuid.Parse(req.CompanyID)
+	if err != nil {
+		return nil, errs.Mark(err, ErrInvalidSupportCompanyID)
+	}
+
+	sessionID := uuid.New()
+	expiresAt := c.clock.Now().Add(c.policy.SessionTTL)
+
+	token, err := c.jwtService.GenerateSupportToken(actorID, actorRole, companyID, sessionID, c.policy.SessionTTL)
+	if err != nil {
+		return nil, errs.Mark(err, ErrTokenGeneration)
+	}
+
+	err = c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		rerr := tx.Audit().Record(ctx, tx.DB(), shared.AuditEntry{
+			ActorID:    &actorID,
+			CompanyID:  &companyID,
+			Action:     AuditActionSupportSessionStarted,
+			TargetType: auditTargetCompany,
+			TargetID:   companyID.String(),
+			Metadata: map[string]any{
+				"session_id": sessionID,
+				"reason":     req.Reason,
+				"expires_at": expiresAt,
+			},
+		})
+		if rerr != nil && infra.IsKind(rerr, infra.KindForeignKeyViolated) {
+			return errs.Mark(rerr, ErrSupportCompanyNotFound)
+		}
+		return rerr
+	})
+	if err != nil {
+		return nil, errs.Mark(err, ErrSupportSessionFailed)
+	}
+
+	return &SupportSessionResult{
+		SessionID:   sessionID,
+		CompanyID:   companyID,
+		AccessToken: token,
+		ExpiresAt:   expiresAt,
+	}, nil
+}
+
+func (c *supportCommandsImpl) RecordRequest(ctx context.Context, rec SupportRequestRecord) error {
+	action := AuditActionSupportRequest
+	if rec.Rejected {
+		action = AuditActionSupportWriteRejected
+	}
+
+	err := c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		return tx.Audit().Record(ctx, tx.DB(), shared.AuditEntry{
+			ActorID:    &rec.ActorID,
+			CompanyID:  &rec.CompanyID,
+			Action:     action,
+			TargetType: auditTargetCompany,
+			TargetID:   rec.CompanyID.String(),
+			Metadata: map[string]any{
+				"session_id": rec.SessionID,
+				"method":     rec.Method,
+				"path":       rec.Path,
+				"status":     rec.Status,
+			},
+		})
+	})
+	if err != nil {
+		return errs.Mark(err, ErrSupportSessionFailed)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	reqdto "gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTOSVersionNotFound = errs.NewCoded("TOS_VERSION_NOT_FOUND", "no terms of service version published")
+	ErrTOSVersionOutdated = errs.NewCoded("TOS_VERSION_OUTDATED", "accepted terms of service version is not the current one")
+	ErrTOSAcceptFailed    = errs.New("terms of service acceptance failed")
+)
+
+type TOSAcceptResult struct {
+	Version    string
+	AcceptedAt time.Time
+}
+
+type TOSCommands interface {
+	// Accept records that userID accepted the current version from ip. The request names
+	// the version the user was shown, so a version published meanwhile is not accepted blindly.
+	Accept(ctx context.Context, req reqdto.AcceptTOSRequest, userID uuid.UUID, ip string) (*TOSAcceptResult, error)
+}
+
+type tosCommandsImpl struct {
+	uow       shared.UnitOfWork
+	readStore shared.TOSReadStore
+	clock     clock.Clock
+}
+
+func NewTOSCommands(uow shared.UnitOfWork, readStore shared.TOSReadStore, clock clock.Clock) TOSCommands {
+	return &tosCommandsImpl{
+		uow:       uow,
+		readStore: readStore,
+		clock:     clock,
+	}
+}
+
+func (c *tosCommandsImpl) Accept(ctx context.Context, req reqdto.AcceptTOSRequest, userID uuid.UUID, ip string) (*TOSAcceptResult, error) {
+	now := c.clock.Now()
+	current, err := c.readStore.FindCurrent(ctx, c.uow.DB(ctx), now)
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return nil, errs.Mark(err, ErrTOSVersionNotFound)
+		}
+		return nil, errs.Mark(err, ErrTOSAcceptFailed)
+	}
+	if req.Version != current.Version {
+		return nil, ErrTOSVersionOutdated
+	}
+
+	err = c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		return tx.TOS().Accept(ctx, tx.DB(), shared.TOSAcceptance{
+			UserID:     userID,
+			VersionID:  current.ID,
+			AcceptedAt: now,
+			IPAddress:  ip,
+		})
+	})
+	if err != nil {
+		return nil, errs.Mark(err, ErrTOSAcceptFailed)
+	}
+	return &TOSAcceptResult{Version: current.Version, AcceptedAt: now}, nil
+}
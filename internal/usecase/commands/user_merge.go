@@ -0,0 +1,149 @@
+package commands
+
+import (
+	"context"
+
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+const AuditActionUserMerged = "user.merged"
+
+var (
+	ErrUserMergeSameAccount     = errs.NewCoded("USER_MERGE_SAME_ACCOUNT", "cannot merge an account into itself")
+	ErrUserMergeOwnAccount      = errs.NewCoded("USER_MERGE_OWN_ACCOUNT", "cannot merge away your own account")
+	ErrUserMergeCompanyMismatch = errs.NewCoded("USER_MERGE_COMPANY_MISMATCH", "accounts belong to different companies")
+	ErrUserMergeTargetInactive  = errs.NewCoded("USER_MERGE_TARGET_INACTIVE", "cannot merge into an inactive account")
+	ErrUserMergeFailed          = errs.New("user merge failed")
+)
+
+// UserMergeResult is what a merge moved, or on a dry run what it would move.
+type UserMergeResult struct {
+	DryRun   bool
+	SourceID uuid.UUID
+	TargetID uuid.UUID
+	Counts   shared.UserMergeCounts
+}
+
+type UserMergeCommands interface {
+	// Merge folds the duplicate account sourceID into targetID: its reservations, reviews,
+	// referral credits, loyalty points, activity timeline, OIDC and SAML identities, messages
+	// and transfers move over, its sessions are signed out and it is deactivated. Both must belong to the same company, and the target must be active.
+	Merge(ctx context.Context, sourceID, targetID, actorID uuid.UUID, dryRun bool) (*UserMergeResult, error)
+}
+
+type userMergeCommandsImpl struct {
+	uow    shared.UnitOfWork
+	repo   shared.UserMergeRepository
+	tokens shared.RefreshTokenRepository
+	clock  clock.Clock
+}
+
+func NewUserMergeCommands(
+	uow shared.UnitOfWork,
+	repo shared.UserMergeRepository,
+	tokens shared.RefreshTokenRepository,
+	clock clock.Clock,
+) UserMergeCommands {
+	return &userMergeCommandsImpl{
+		uow:    uow,
+		repo:   repo,
+		tokens: tokens,
+		clock:  clock,
+	}
+}
+
+func (c *userMergeCommandsImpl) Merge(ctx context.Context, sourceID, targetID, actorID uuid.UUID, dryRun bool) (*UserMergeResult, error) {
+	if sourceID == targetID {
+		return nil, ErrUserMergeSameAccount
+	}
+	if sourceID == actorID {
+		return nil, ErrUserMergeOwnAccount
+	}
+
+	now := c.clock.Now()
+	result := &UserMergeResult{DryRun: dryRun, SourceID: sourceID, TargetID: targetID}
+	err := c.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		if cerr := c.check(ctx, tx, sourceID, targetID); cerr != nil {
+			return cerr
+		}
+
+		if dryRun {
+			counts, cerr := c.repo.Count(ctx, tx.DB(), sourceID, now)
+			if cerr != nil {
+				return cerr
+			}
+			result.Counts = *counts
+			return nil
+		}
+
+		counts, merr := c.repo.Merge(ctx, tx.DB(), sourceID, targetID, now)
+		if merr != nil {
+			return merr
+		}
+		result.Counts = *counts
+		// Tokens name the account they were issued to, so sessions cannot move with the rest.
+		if rerr := c.tokens.RevokeUser(ctx, tx.DB(), sourceID, now); rerr != nil {
+			return rerr
+		}
+		return tx.Audit().Record(ctx, tx.DB(), shared.AuditEntry{
+			ActorID:    &actorID,
+			Action:     AuditActionUserMerged,
+			TargetType: shared.AuditTargetUser,
+			TargetID:   sourceID.String(),
+			Metadata: map[string]any{
+				"into":             targetID.String(),
+				"reservations":     counts.Reservations,
+				"reviews":          counts.Reviews,
+				"referral_credits": counts.ReferralCredits,
+				"loyalty_entries":  counts.LoyaltyEntries,
+				"activity_entries": counts.ActivityEntries,
+				"identities":       counts.Identities,
+				"messages":         counts.Messages,
+				"transfers":        counts.Transfers,
+				"sessions":         counts.Sessions,
+			},
+		})
+	})
+	if err != nil {
+		return nil, errs.Mark(err, ErrUserMergeFailed)
+	}
+	return result, nil
+}
+
+// check locks both accounts so nothing is added to the source while it is merged.
+func (c *userMergeCommandsImpl) check(ctx context.Context, tx shared.Tx, sourceID, targetID uuid.UUID) error {
+	accounts, err := c.repo.Lock(ctx, tx.DB(), sourceID, targetID)
+	if err != nil {
+		return err
+	}
+	var source, target *shared.UserMergeAccount
+	for i := range accounts {
+		switch accounts[i].ID {
+		case sourceID:
+			source = &accounts[i]
+		case targetID:
+			target = &accounts[i]
+		}
+	}
+	if source == nil || target == nil {
+		return ErrUserNotFound
+	}
+	if !sameCompany(source.CompanyID, target.CompanyID) {
+		return ErrUserMergeCompanyMismatch
+	}
+	if !target.IsActive {
+		return ErrUserMergeTargetInactive
+	}
+	return nil
+}
+
+func sameCompany(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
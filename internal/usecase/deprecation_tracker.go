@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+type deprecationKey struct {
+	day    time.Time
+	route  string
+	userID uuid.UUID // uuid.Nil for anonymous callers
+}
+
+// deprecationTrackerImpl keeps per-consumer counters between flushes, like the usage meter,
+// so a call to a deprecated route adds no database work to the request.
+type deprecationTrackerImpl struct {
+	uow   shared.UnitOfWork
+	repo  shared.DeprecationRepository
+	clock clock.Clock
+
+	mu      sync.Mutex
+	pending map[deprecationKey]*shared.DeprecatedRouteCalls
+}
+
+func NewDeprecationTracker(uow shared.UnitOfWork, repo shared.DeprecationRepository, clock clock.Clock) shared.DeprecationTracker {
+	return &deprecationTrackerImpl{
+		uow:     uow,
+		repo:    repo,
+		clock:   clock,
+		pending: make(map[deprecationKey]*shared.DeprecatedRouteCalls),
+	}
+}
+
+func (t *deprecationTrackerImpl) Record(route string, userID *uuid.UUID) {
+	now := t.clock.Now()
+	key := deprecationKey{day: shared.TelemetryDay(now), route: route}
+	if userID != nil {
+		key.userID = *userID
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	calls, ok := t.pending[key]
+	if !ok {
+		calls = &shared.DeprecatedRouteCalls{Day: key.day, Route: route, UserID: userID}
+		t.pending[key] = calls
+	}
+	calls.Calls++
+	calls.LastCalledAt = now
+}
+
+func (t *deprecationTrackerImpl) Flush(ctx context.Context) (int, error) {
+	t.mu.Lock()
+	batch := t.pending
+	t.pending = make(map[deprecationKey]*shared.DeprecatedRouteCalls)
+	t.mu.Unlock()
+
+	if len(batch) == 0 {
+		return 0, nil
+	}
+
+	err := t.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		for _, calls := range batch {
+			if err := t.repo.Add(ctx, tx.DB(), *calls); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.restore(batch)
+		return 0, err
+	}
+	return len(batch), nil
+}
+
+// restore merges a batch that failed to write back into the counters.
+func (t *deprecationTrackerImpl) restore(batch map[deprecationKey]*shared.DeprecatedRouteCalls) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for key, calls := range batch {
+		if cur, ok := t.pending[key]; ok {
+			cur.Calls += calls.Calls
+			if calls.LastCalledAt.After(cur.LastCalledAt) {
+				cur.LastCalledAt = calls.LastCalledAt
+			}
+			continue
+		}
+		t.pending[key] = calls
+	}
+}
@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+// Expired companies are swept, and resource owners forgotten, once a cache holds this many entries.
+const featureCacheSweepSize = 10000
+
+type cachedFeatures struct {
+	settings  map[string]bool
+	expiresAt time.Time
+}
+
+// featureFlagsImpl caches each company's settings for ttl; Invalidate applies a change
+// made on this instance at once. A resource's company never changes, so it is cached
+// until the cache is swept.
+type featureFlagsImpl struct {
+	uow      shared.UnitOfWork
+	store    shared.FeatureReadStore
+	clock    clock.Clock
+	defaults map[string]bool
+	ttl      time.Duration
+
+	mu        sync.RWMutex
+	companies map[uuid.UUID]cachedFeatures
+	owners    map[uuid.UUID]uuid.UUID
+}
+
+func NewFeatureFlags(uow shared.UnitOfWork, store shared.FeatureReadStore, clock clock.Clock, defaults []string, ttl time.Duration) shared.FeatureFlags {
+	on := make(map[string]bool, len(defaults))
+	for _, f := range defaults {
+		on[f] = true
+	}
+	return &featureFlagsImpl{
+		uow:       uow,
+		store:     store,
+		clock:     clock,
+		defaults:  on,
+		ttl:       ttl,
+		companies: make(map[uuid.UUID]cachedFeatures),
+		owners:    make(map[uuid.UUID]uuid.UUID),
+	}
+}
+
+func (f *featureFlagsImpl) Enabled(ctx context.Context, companyID uuid.UUID, feature string) (bool, error) {
+	settings, err := f.settings(ctx, companyID)
+	if err != nil {
+		return false, err
+	}
+	if enabled, ok := settings[feature]; ok {
+		return enabled, nil
+	}
+	return f.defaults[feature], nil
+}
+
+func (f *featureFlagsImpl) EnabledForResource(ctx context.Context, resourceID uuid.UUID, feature string) (bool, error) {
+	f.mu.RLock()
+	companyID, ok := f.owners[resourceID]
+	f.mu.RUnlock()
+	if !ok {
+		var err error
+		companyID, err = f.store.FindResourceCompany(ctx, f.uow.DB(ctx), resourceID)
+		if err != nil {
+			if infra.IsKind(err, infra.KindNotFound) {
+				return f.defaults[feature], nil
+			}
+			return false, err
+		}
+		f.mu.Lock()
+		if len(f.owners) >= featureCacheSweepSize {
+			f.owners = make(map[uuid.UUID]uuid.UUID)
+		}
+		f.owners[resourceID] = companyID
+		f.mu.Unlock()
+	}
+	return f.Enabled(ctx, companyID, feature)
+}
+
+func (f *featureFlagsImpl) States(ctx context.Context, companyID uuid.UUID) ([]shared.FeatureState, error) {
+	settings, err := f.settings(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	states := make([]shared.FeatureState, len(shared.Features))
+	for i, feature := range shared.Features {
+		enabled, overridden := settings[feature]
+		if !overridden {
+			enabled = f.defaults[feature]
+		}
+		states[i] = shared.FeatureState{Feature: feature, Enabled: enabled, Overridden: overridden}
+	}
+	return states, nil
+}
+
+func (f *featureFlagsImpl) Invalidate(companyID uuid.UUID) {
+	f.mu.Lock()
+	delete(f.companies, companyID)
+	f.mu.Unlock()
+}
+
+// settings returns nil for uuid.Nil, so every feature takes its default.
+func (f *featureFlagsImpl) settings(ctx context.Context, companyID uuid.UUID) (map[string]bool, error) {
+	if companyID == uuid.Nil {
+		return nil, nil
+	}
+	now := f.clock.Now()
+
+	f.mu.RLock()
+	entry, ok := f.companies[companyID]
+	f.mu.RUnlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.settings, nil
+	}
+
+	settings, err := f.store.ListCompanyFeatures(ctx, f.uow.DB(ctx), companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	if len(f.companies) >= featureCacheSweepSize {
+		for id, e := range f.companies {
+			if !now.Before(e.expiresAt) {
+				delete(f.companies, id)
+			}
+		}
+	}
+	f.companies[companyID] = cachedFeatures{settings: settings, expiresAt: now.Add(f.ttl)}
+	f.mu.Unlock()
+
+	return settings, nil
+}
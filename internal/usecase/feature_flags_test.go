@@ -0,0 +1,137 @@
+//go:build unit
+
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/usecase"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// readOnlyUoW serves the non-transactional handle the flags read with.
+type readOnlyUoW struct{}
+
+func (readOnlyUoW) Within(context.Context, func(context.Context, shared.Tx) error) error {
+	return errors.New("no transactions in this test")
+}
+
+func (readOnlyUoW) DB(context.Context) sqlc.DBTX {
+	return nil
+}
+
+func (readOnlyUoW) ReadDB(context.Context) sqlc.DBTX {
+	return nil
+}
+
+type featureStoreStub struct {
+	settings map[uuid.UUID]map[string]bool
+	owners   map[uuid.UUID]uuid.UUID
+	lists    int
+	lookups  int
+}
+
+func (s *featureStoreStub) ListCompanyFeatures(_ context.Context, _ sqlc.DBTX, companyID uuid.UUID) (map[string]bool, error) {
+	s.lists++
+	return s.settings[companyID], nil
+}
+
+func (s *featureStoreStub) FindResourceCompany(_ context.Context, _ sqlc.DBTX, resourceID uuid.UUID) (uuid.UUID, error) {
+	s.lookups++
+	companyID, ok := s.owners[resourceID]
+	if !ok {
+		return uuid.Nil, infra.WrapRepoErr("resource not found", errors.New("no rows"), infra.KindNotFound)
+	}
+	return companyID, nil
+}
+
+func (s *featureStoreStub) CompanyExists(_ context.Context, _ sqlc.DBTX, companyID uuid.UUID) (bool, error) {
+	_, ok := s.settings[companyID]
+	return ok, nil
+}
+
+func TestFeatureFlags(t *testing.T) {
+	ctx := context.Background()
+	optedOut, untouched := uuid.New(), uuid.New()
+	optedOutRoom, unownedRoom := uuid.New(), uuid.New()
+	newStore := func() *featureStoreStub {
+		return &featureStoreStub{
+			settings: map[uuid.UUID]map[string]bool{
+				optedOut:  {shared.FeatureReviewModeration: false},
+				untouched: {},
+			},
+			owners: map[uuid.UUID]uuid.UUID{
+				optedOutRoom: optedOut,
+				unownedRoom:  uuid.Nil,
+			},
+		}
+	}
+
+	t.Run("success: company setting wins over the default", func(t *testing.T) {
+		flags := usecase.NewFeatureFlags(readOnlyUoW{}, newStore(), clock.NewMockClock(time.Now()), []string{shared.FeatureReviewModeration}, time.Minute)
+
+		enabled, err := flags.Enabled(ctx, optedOut, shared.FeatureReviewModeration)
+		require.NoError(t, err)
+		assert.False(t, enabled)
+
+		enabled, err = flags.Enabled(ctx, untouched, shared.FeatureReviewModeration)
+		require.NoError(t, err)
+		assert.True(t, enabled)
+	})
+
+	t.Run("success: resources resolve to their company, unowned and unknown ones to the default", func(t *testing.T) {
+		store := newStore()
+		flags := usecase.NewFeatureFlags(readOnlyUoW{}, store, clock.NewMockClock(time.Now()), []string{shared.FeatureReviewModeration}, time.Minute)
+
+		for resourceID, want := range map[uuid.UUID]bool{optedOutRoom: false, unownedRoom: true, uuid.New(): true} {
+			enabled, err := flags.EnabledForResource(ctx, resourceID, shared.FeatureReviewModeration)
+			require.NoError(t, err)
+			assert.Equal(t, want, enabled)
+		}
+
+		_, err := flags.EnabledForResource(ctx, optedOutRoom, shared.FeatureReviewModeration)
+		require.NoError(t, err)
+		assert.Equal(t, 3, store.lookups, "a resource's company is looked up once")
+	})
+
+	t.Run("success: settings are cached for the ttl unless invalidated", func(t *testing.T) {
+		store := newStore()
+		clk := clock.NewMockClock(time.Now())
+		flags := usecase.NewFeatureFlags(readOnlyUoW{}, store, clk, nil, time.Minute)
+
+		_, _ = flags.Enabled(ctx, optedOut, shared.FeatureReviewModeration)
+		clk.Add(59 * time.Second)
+		_, _ = flags.Enabled(ctx, optedOut, shared.FeatureReviewModeration)
+		assert.Equal(t, 1, store.lists)
+
+		clk.Add(time.Second)
+		_, _ = flags.Enabled(ctx, optedOut, shared.FeatureReviewModeration)
+		assert.Equal(t, 2, store.lists)
+
+		flags.Invalidate(optedOut)
+		_, _ = flags.Enabled(ctx, optedOut, shared.FeatureReviewModeration)
+		assert.Equal(t, 3, store.lists)
+	})
+
+	t.Run("success: states report where each value comes from", func(t *testing.T) {
+		flags := usecase.NewFeatureFlags(readOnlyUoW{}, newStore(), clock.NewMockClock(time.Now()), []string{shared.FeatureReviewModeration}, time.Minute)
+
+		states, err := flags.States(ctx, optedOut)
+		require.NoError(t, err)
+		assert.Contains(t, states, shared.FeatureState{Feature: shared.FeatureReviewModeration, Enabled: false, Overridden: true})
+
+		states, err = flags.States(ctx, untouched)
+		require.NoError(t, err)
+		assert.Contains(t, states, shared.FeatureState{Feature: shared.FeatureReviewModeration, Enabled: true, Overridden: false})
+	})
+}
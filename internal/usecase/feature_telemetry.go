@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+type featureKey struct {
+	userID  uuid.UUID
+	day     time.Time
+	feature string
+}
+
+// featureTelemetryImpl keeps per-user counters between flushes, like the usage meter, so
+// a request never needs the company lookup. The flush resolves companies in one query,
+// drops opted-out ones and replaces each company ID with a salted hash.
+type featureTelemetryImpl struct {
+	uow   shared.UnitOfWork
+	store shared.TelemetryReadStore
+	sink  shared.AnalyticsSink
+	clock clock.Clock
+	salt  []byte
+
+	mu      sync.Mutex
+	pending map[featureKey]int64
+}
+
+func NewFeatureTelemetry(uow shared.UnitOfWork, store shared.TelemetryReadStore, sink shared.AnalyticsSink, clock clock.Clock, salt string) shared.FeatureTelemetry {
+	return &featureTelemetryImpl{
+		uow:     uow,
+		store:   store,
+		sink:    sink,
+		clock:   clock,
+		salt:    []byte(salt),
+		pending: make(map[featureKey]int64),
+	}
+}
+
+func (t *featureTelemetryImpl) Record(userID uuid.UUID, feature string) {
+	key := featureKey{userID: userID, day: shared.TelemetryDay(t.clock.Now()), feature: feature}
+
+	t.mu.Lock()
+	t.pending[key]++
+	t.mu.Unlock()
+}
+
+func (t *featureTelemetryImpl) Flush(ctx context.Context) (int, error) {
+	t.mu.Lock()
+	batch := t.pending
+	t.pending = make(map[featureKey]int64)
+	t.mu.Unlock()
+
+	if len(batch) == 0 {
+		return 0, nil
+	}
+
+	seen := make(map[uuid.UUID]struct{})
+	userIDs := make([]uuid.UUID, 0, len(batch))
+	for key := range batch {
+		if _, ok := seen[key.userID]; !ok {
+			seen[key.userID] = struct{}{}
+			userIDs = append(userIDs, key.userID)
+		}
+	}
+	companies, err := t.store.ListCompanies(ctx, t.uow.DB(ctx), userIDs)
+	if err != nil {
+		t.restore(batch)
+		return 0, err
+	}
+
+	type countKey struct {
+		day         time.Time
+		feature     string
+		companyHash string
+	}
+	merged := make(map[countKey]int64)
+	for key, n := range batch {
+		company, ok := companies[key.userID]
+		if ok && company.OptedOut {
+			continue
+		}
+		ck := countKey{day: key.day, feature: key.feature}
+		if ok {
+			ck.companyHash = t.anonymize(company.CompanyID)
+		}
+		merged[ck] += n
+	}
+	if len(merged) == 0 {
+		return 0, nil
+	}
+
+	counts := make([]shared.FeatureCount, 0, len(merged))
+	for ck, n := range merged {
+		counts = append(counts, shared.FeatureCount{Day: ck.day, Feature: ck.feature, CompanyHash: ck.companyHash, Requests: n})
+	}
+	if err := t.sink.Emit(ctx, counts); err != nil {
+		t.restore(batch)
+		return 0, err
+	}
+	return len(counts), nil
+}
+
+// anonymize returns a stable pseudonym for the company that the analytics side cannot
+// map back without the salt.
+func (t *featureTelemetryImpl) anonymize(companyID uuid.UUID) string {
+	mac := hmac.New(sha256.New, t.salt)
+	mac.Write(companyID[:])
+	return hex.EncodeToString(mac.Sum(nil))[:16]
+}
+
+// restore merges a batch that failed to emit back into the counters.
+func (t *featureTelemetryImpl) restore(batch map[featureKey]int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for key, n := range batch {
+		t.pending[key] += n
+	}
+}
@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"time"
+
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/usecase/shared"
+)
+
+type loginReplayEntry struct {
+	done      chan struct{} // closed once result and err are set
+	result    *shared.LoginReplay
+	err       error
+	expiresAt time.Time
+}
+
+// loginReplayCacheImpl keeps entries in memory under an HMAC of the attempt, keyed with a
+// per-process secret, so neither nonces nor passwords are held in the clear. Concurrent
+// duplicates wait for the first submission instead of racing it.
+type loginReplayCacheImpl struct {
+	clock   clock.Clock
+	ttl     time.Duration
+	metrics *shared.LoginReplayMetrics
+	secret  []byte
+
+	mu        sync.Mutex
+	entries   map[string]*loginReplayEntry
+	lastSweep time.Time
+}
+
+// NewLoginReplayCache returns a cache that keeps results for ttl; zero disables it.
+func NewLoginReplayCache(clock clock.Clock, ttl time.Duration, metrics *shared.LoginReplayMetrics) shared.LoginReplayCache {
+	secret := make([]byte, 32)
+	_, _ = rand.Read(secret)
+	return &loginReplayCacheImpl{
+		clock:   clock,
+		ttl:     ttl,
+		metrics: metrics,
+		secret:  secret,
+		entries: make(map[string]*loginReplayEntry),
+	}
+}
+
+func (c *loginReplayCacheImpl) Do(attempt shared.LoginAttempt, login func() (*shared.LoginReplay, error)) (*shared.LoginReplay, bool, error) {
+	if c.ttl <= 0 || attempt.Nonce == "" {
+		result, err := login()
+		return result, false, err
+	}
+	key := c.key(attempt)
+
+	c.mu.Lock()
+	now := c.clock.Now()
+	c.sweep(now)
+	if e, ok := c.entries[key]; ok {
+		select {
+		case <-e.done:
+			if now.Before(e.expiresAt) {
+				c.mu.Unlock()
+				c.metrics.RecordDuplicate(false)
+				return e.result, true, nil
+			}
+		default:
+			c.mu.Unlock()
+			return c.await(e, login)
+		}
+	}
+	e := &loginReplayEntry{done: make(chan struct{})}
+	c.entries[key] = e
+	c.mu.Unlock()
+
+	result, err := login()
+
+	c.mu.Lock()
+	e.result, e.err = result, err
+	if err != nil {
+		delete(c.entries, key)
+	} else {
+		e.expiresAt = c.clock.Now().Add(c.ttl)
+	}
+	close(e.done)
+	c.mu.Unlock()
+
+	if err != nil {
+		return nil, false, err
+	}
+	c.metrics.RecordStored()
+	return result, false, nil
+}
+
+// await answers a duplicate with the result of the submission in progress. When that one
+// fails the duplicate runs on its own, since failures are never replayed.
+func (c *loginReplayCacheImpl) await(e *loginReplayEntry, login func() (*shared.LoginReplay, error)) (*shared.LoginReplay, bool, error) {
+	<-e.done
+	if e.err != nil {
+		result, err := login()
+		return result, false, err
+	}
+	c.metrics.RecordDuplicate(true)
+	return e.result, true, nil
+}
+
+// sweep drops expired entries at most once per ttl; callers hold mu.
+func (c *loginReplayCacheImpl) sweep(now time.Time) {
+	if now.Sub(c.lastSweep) < c.ttl {
+		return
+	}
+	c.lastSweep = now
+	for key, e := range c.entries {
+		select {
+		case <-e.done:
+			if !now.Before(e.expiresAt) {
+				delete(c.entries, key)
+			}
+		default:
+		}
+	}
+}
+
+func (c *loginReplayCacheImpl) key(attempt shared.LoginAttempt) string {
+	mac := hmac.New(sha256.New, c.secret)
+	for _, part := range []string{attempt.Nonce, strings.ToLower(strings.TrimSpace(attempt.Email)), attempt.Password, attempt.DeviceKey} {
+		mac.Write([]byte(part))
+		mac.Write([]byte{0})
+	}
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,149 @@
+//go:build unit
+
+package usecase_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/usecase"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func countingLogin(calls *int) func() (*shared.LoginReplay, error) {
+	return func() (*shared.LoginReplay, error) {
+		*calls++
+		return &shared.LoginReplay{UserID: uuid.New(), AccessToken: "access", RefreshToken: "refresh"}, nil
+	}
+}
+
+func TestLoginReplayCache_Do(t *testing.T) {
+	attempt := shared.LoginAttempt{Nonce: uuid.NewString(), Email: "alice@example.com", Password: "password123"}
+
+	t.Run("success: resubmission within the ttl is replayed", func(t *testing.T) {
+		clk := clock.NewMockClock(time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC))
+		metrics := shared.NewLoginReplayMetrics()
+		cache := usecase.NewLoginReplayCache(clk, 10*time.Second, metrics)
+		calls := 0
+
+		first, replayed, err := cache.Do(attempt, countingLogin(&calls))
+		require.NoError(t, err)
+		assert.False(t, replayed)
+
+		clk.Add(9 * time.Second)
+		resubmitted := attempt
+		resubmitted.Email = " Alice@Example.com"
+		second, replayed, err := cache.Do(resubmitted, countingLogin(&calls))
+		require.NoError(t, err)
+		assert.True(t, replayed)
+		assert.Same(t, first, second)
+		assert.Equal(t, 1, calls)
+
+		stats := metrics.Stats()
+		assert.Equal(t, int64(1), stats.Stored)
+		assert.Equal(t, int64(1), stats.Replayed)
+	})
+
+	t.Run("success: entries expire after the ttl", func(t *testing.T) {
+		clk := clock.NewMockClock(time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC))
+		cache := usecase.NewLoginReplayCache(clk, 10*time.Second, shared.NewLoginReplayMetrics())
+		calls := 0
+
+		_, _, err := cache.Do(attempt, countingLogin(&calls))
+		require.NoError(t, err)
+		clk.Add(10 * time.Second)
+		_, replayed, err := cache.Do(attempt, countingLogin(&calls))
+		require.NoError(t, err)
+		assert.False(t, replayed)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("success: other credentials, device or a missing nonce never hit", func(t *testing.T) {
+		clk := clock.NewMockClock(time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC))
+		cache := usecase.NewLoginReplayCache(clk, 10*time.Second, shared.NewLoginReplayMetrics())
+		calls := 0
+
+		_, _, err := cache.Do(attempt, countingLogin(&calls))
+		require.NoError(t, err)
+
+		wrongPassword, otherDevice, noNonce := attempt, attempt, attempt
+		wrongPassword.Password = "password124"
+		otherDevice.DeviceKey = "device-2"
+		noNonce.Nonce = ""
+		for _, a := range []shared.LoginAttempt{wrongPassword, otherDevice, noNonce, noNonce} {
+			_, replayed, err := cache.Do(a, countingLogin(&calls))
+			require.NoError(t, err)
+			assert.False(t, replayed)
+		}
+		assert.Equal(t, 5, calls)
+	})
+
+	t.Run("success: failures are not cached", func(t *testing.T) {
+		clk := clock.NewMockClock(time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC))
+		cache := usecase.NewLoginReplayCache(clk, 10*time.Second, shared.NewLoginReplayMetrics())
+		calls := 0
+
+		_, _, err := cache.Do(attempt, func() (*shared.LoginReplay, error) {
+			calls++
+			return nil, errors.New("invalid credentials")
+		})
+		require.Error(t, err)
+		_, replayed, err := cache.Do(attempt, countingLogin(&calls))
+		require.NoError(t, err)
+		assert.False(t, replayed)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("success: disabled with a zero ttl", func(t *testing.T) {
+		cache := usecase.NewLoginReplayCache(clock.NewMockClock(time.Now()), 0, shared.NewLoginReplayMetrics())
+		calls := 0
+
+		for range 2 {
+			_, replayed, err := cache.Do(attempt, countingLogin(&calls))
+			require.NoError(t, err)
+			assert.False(t, replayed)
+		}
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("success: concurrent duplicates wait for the first login", func(t *testing.T) {
+		metrics := shared.NewLoginReplayMetrics()
+		cache := usecase.NewLoginReplayCache(clock.NewMockClock(time.Now()), 10*time.Second, metrics)
+		release := make(chan struct{})
+		var mu sync.Mutex
+		calls := 0
+
+		var wg sync.WaitGroup
+		results := make([]*shared.LoginReplay, 5)
+		for i := range results {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				results[i], _, _ = cache.Do(attempt, func() (*shared.LoginReplay, error) {
+					mu.Lock()
+					calls++
+					mu.Unlock()
+					<-release
+					return &shared.LoginReplay{UserID: uuid.New()}, nil
+				})
+			}()
+		}
+		time.Sleep(20 * time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		assert.Equal(t, 1, calls)
+		for _, r := range results {
+			assert.Same(t, results[0], r)
+		}
+		stats := metrics.Stats()
+		assert.Equal(t, int64(4), stats.Coalesced+stats.Replayed)
+	})
+}
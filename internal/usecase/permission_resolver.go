@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/reqctx"
+	"gin-clean-starter/internal/usecase/shared"
+)
+
+type cachedGrants struct {
+	permissions []string
+	expiresAt   time.Time
+}
+
+// permissionResolverImpl looks up a role's grants per request and caches them for ttl,
+// so permission changes apply within ttl on other instances and immediately on this one.
+type permissionResolverImpl struct {
+	uow   shared.UnitOfWork
+	store shared.RolePermissionReadStore
+	clock clock.Clock
+	ttl   time.Duration
+
+	mu    sync.RWMutex
+	cache map[string]cachedGrants
+}
+
+func NewPermissionResolver(uow shared.UnitOfWork, store shared.RolePermissionReadStore, clock clock.Clock, ttl time.Duration) shared.PermissionResolver {
+	return &permissionResolverImpl{
+		uow:   uow,
+		store: store,
+		clock: clock,
+		ttl:   ttl,
+		cache: make(map[string]cachedGrants),
+	}
+}
+
+func (r *permissionResolverImpl) HasPermission(ctx context.Context, role string, permission string) (bool, error) {
+	granted, err := r.grants(ctx, role)
+	if err != nil {
+		return false, err
+	}
+	// A scoped request, e.g. one made with an API key, needs the permission in its scopes too.
+	if scopes, ok := reqctx.Scopes(ctx); ok && !shared.PermissionGranted(scopes, permission) {
+		return false, nil
+	}
+	return shared.PermissionGranted(granted, permission), nil
+}
+
+func (r *permissionResolverImpl) Invalidate() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cache = make(map[string]cachedGrants)
+}
+
+func (r *permissionResolverImpl) grants(ctx context.Context, role string) ([]string, error) {
+	now := r.clock.Now()
+
+	r.mu.RLock()
+	entry, ok := r.cache[role]
+	r.mu.RUnlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.permissions, nil
+	}
+
+	permissions, err := r.store.PermissionsByRole(ctx, r.uow.DB(ctx), role)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	r.cache[role] = cachedGrants{permissions: permissions, expiresAt: now.Add(r.ttl)}
+	r.mu.Unlock()
+
+	return permissions, nil
+}
@@ -0,0 +1,61 @@
+package queries
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/pkg/keyset"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+var ErrActivityQueryFailed = errs.New("activity query failed")
+
+// ActivityItem is an entry on the user's timeline (see shared.ActivityKinds).
+type ActivityItem struct {
+	ID         uuid.UUID
+	Kind       string
+	SubjectID  uuid.UUID
+	ResourceID *uuid.UUID
+	Data       json.RawMessage
+	OccurredAt time.Time
+}
+
+type ActivityReadStore interface {
+	FindFirstPage(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, kind *string, limit int32) ([]*ActivityItem, error)
+	FindKeyset(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, kind *string, lastOccurredAt time.Time, lastID uuid.UUID, limit int32) ([]*ActivityItem, error)
+}
+
+type ActivityQueries interface {
+	// ListMine lists the user's activity newest first, optionally only one kind.
+	ListMine(ctx context.Context, userID uuid.UUID, kind *string, after *Cursor, limit int) ([]*ActivityItem, *Cursor, error)
+}
+
+type activityQueriesImpl struct {
+	uow       shared.UnitOfWork
+	readStore ActivityReadStore
+}
+
+func NewActivityQueries(uow shared.UnitOfWork, readStore ActivityReadStore) ActivityQueries {
+	return &activityQueriesImpl{
+		uow:       uow,
+		readStore: readStore,
+	}
+}
+
+func (q *activityQueriesImpl) ListMine(ctx context.Context, userID uuid.UUID, kind *string, after *Cursor, limit int) ([]*ActivityItem, *Cursor, error) {
+	db := q.uow.DB(ctx)
+
+	return listPage(after, limit, ErrInvalidCursor, ErrActivityQueryFailed,
+		func(e *ActivityItem) keyset.Position { return keyset.Position{CreatedAt: e.OccurredAt, ID: e.ID} },
+		func(last *keyset.Position, n int32) ([]*ActivityItem, error) {
+			if last == nil {
+				return q.readStore.FindFirstPage(ctx, db, userID, kind, n)
+			}
+			return q.readStore.FindKeyset(ctx, db, userID, kind, last.CreatedAt, last.ID, n)
+		})
+}
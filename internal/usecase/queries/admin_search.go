@@ -0,0 +1,141 @@
+package queries
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrSearchQueryTooShort = errs.NewCoded("SEARCH_QUERY_TOO_SHORT", "search query is too short")
+	ErrAdminSearchFailed   = errs.New("admin search failed")
+)
+
+// Search terms shorter than the trigrams behind the indexes would scan whole tables.
+const minSearchQueryLength = 3
+
+// Results per type: the default and the most a request may ask for.
+const (
+	DefaultSearchLimit = 5
+	MaxSearchLimit     = 20
+)
+
+type SearchUserHit struct {
+	ID        uuid.UUID
+	Email     string
+	Role      string
+	CompanyID *uuid.UUID
+	IsActive  bool
+}
+
+type SearchReservationHit struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	ResourceID uuid.UUID
+	Status     string
+	StartTime  time.Time
+	EndTime    time.Time
+}
+
+type SearchResourceHit struct {
+	ID        uuid.UUID
+	Name      string
+	CompanyID *uuid.UUID
+}
+
+type SearchReviewHit struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	ResourceID uuid.UUID
+	Rating     int
+	Comment    string
+	Status     string
+}
+
+// SearchResults groups the hits by type, each closest match first.
+type SearchResults struct {
+	Users        []*SearchUserHit
+	Reservations []*SearchReservationHit
+	Resources    []*SearchResourceHit
+	Reviews      []*SearchReviewHit
+}
+
+// AdminSearchReadStore matches term as a case-insensitive substring.
+type AdminSearchReadStore interface {
+	FindUsers(ctx context.Context, db sqlc.DBTX, term string, limit int32) ([]*SearchUserHit, error)
+	// FindReservationByID returns nil when no reservation has the ID.
+	FindReservationByID(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (*SearchReservationHit, error)
+	FindReservationsByNote(ctx context.Context, db sqlc.DBTX, term string, limit int32) ([]*SearchReservationHit, error)
+	FindResources(ctx context.Context, db sqlc.DBTX, term string, limit int32) ([]*SearchResourceHit, error)
+	FindReviews(ctx context.Context, db sqlc.DBTX, term string, limit int32) ([]*SearchReviewHit, error)
+}
+
+type AdminSearchQueries interface {
+	// Search looks query up across every company: users by email, reservations by ID or
+	// note, resources by name and reviews by comment. limit caps each type separately.
+	// Encrypted notes cannot be searched.
+	Search(ctx context.Context, query string, limit int) (*SearchResults, error)
+}
+
+type adminSearchQueriesImpl struct {
+	uow       shared.UnitOfWork
+	readStore AdminSearchReadStore
+}
+
+func NewAdminSearchQueries(uow shared.UnitOfWork, readStore AdminSearchReadStore) AdminSearchQueries {
+	return &adminSearchQueriesImpl{
+		uow:       uow,
+		readStore: readStore,
+	}
+}
+
+func (q *adminSearchQueriesImpl) Search(ctx context.Context, query string, limit int) (*SearchResults, error) {
+	term := strings.TrimSpace(query)
+	if utf8.RuneCountInString(term) < minSearchQueryLength {
+		return nil, ErrSearchQueryTooShort
+	}
+	if limit <= 0 {
+		limit = DefaultSearchLimit
+	}
+	n := int32(min(limit, MaxSearchLimit))
+	db := q.uow.ReadDB(ctx)
+
+	var (
+		results SearchResults
+		err     error
+	)
+	if results.Users, err = q.readStore.FindUsers(ctx, db, term, n); err != nil {
+		return nil, errs.Mark(err, ErrAdminSearchFailed)
+	}
+	if results.Reservations, err = q.findReservations(ctx, db, term, n); err != nil {
+		return nil, errs.Mark(err, ErrAdminSearchFailed)
+	}
+	if results.Resources, err = q.readStore.FindResources(ctx, db, term, n); err != nil {
+		return nil, errs.Mark(err, ErrAdminSearchFailed)
+	}
+	if results.Reviews, err = q.readStore.FindReviews(ctx, db, term, n); err != nil {
+		return nil, errs.Mark(err, ErrAdminSearchFailed)
+	}
+	return &results, nil
+}
+
+// findReservations looks a term that is a UUID up as a reservation ID and anything else in
+// the notes.
+func (q *adminSearchQueriesImpl) findReservations(ctx context.Context, db sqlc.DBTX, term string, limit int32) ([]*SearchReservationHit, error) {
+	id, err := uuid.Parse(term)
+	if err != nil {
+		return q.readStore.FindReservationsByNote(ctx, db, term, limit)
+	}
+	hit, err := q.readStore.FindReservationByID(ctx, db, id)
+	if err != nil || hit == nil {
+		return []*SearchReservationHit{}, err
+	}
+	return []*SearchReservationHit{hit}, nil
+}
@@ -0,0 +1,90 @@
+package queries
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidAPIKey     = errs.NewCoded("INVALID_API_KEY", "api key is unknown, expired or revoked")
+	ErrAPIKeyQueryFailed = errs.New("api key query failed")
+)
+
+// APIKeyIdentity is who a request authenticated by an API key acts as: the key's user
+// with their current role, limited to the key's scopes.
+type APIKeyIdentity struct {
+	KeyID  uuid.UUID
+	UserID uuid.UUID
+	Role   string
+	Scopes []string
+}
+
+// APIKeyView is an issued key as admins list it; the key itself is never shown again.
+type APIKeyView struct {
+	ID        uuid.UUID
+	Name      string
+	Scopes    []string
+	CreatedAt time.Time
+	ExpiresAt *time.Time
+	RevokedAt *time.Time
+}
+
+type APIKeyReadStore interface {
+	// FindIdentity reports KindNotFound for unknown, expired and revoked keys and for keys
+	// of inactive users.
+	FindIdentity(ctx context.Context, db sqlc.DBTX, keyHash []byte, now time.Time) (*APIKeyIdentity, error)
+	ListByUser(ctx context.Context, db sqlc.DBTX, userID uuid.UUID) ([]*APIKeyView, error)
+}
+
+type APIKeyQueries interface {
+	// Authenticate returns the identity a live API key acts as.
+	Authenticate(ctx context.Context, key string) (*APIKeyIdentity, error)
+	// ListByUser returns the user's keys, revoked and expired ones included, newest first.
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]*APIKeyView, error)
+}
+
+type apiKeyQueriesImpl struct {
+	uow       shared.UnitOfWork
+	clock     clock.Clock
+	readStore APIKeyReadStore
+}
+
+func NewAPIKeyQueries(uow shared.UnitOfWork, clock clock.Clock, readStore APIKeyReadStore) APIKeyQueries {
+	return &apiKeyQueriesImpl{
+		uow:       uow,
+		clock:     clock,
+		readStore: readStore,
+	}
+}
+
+// Authenticate reads the primary so a revoked key stops working at once.
+func (q *apiKeyQueriesImpl) Authenticate(ctx context.Context, key string) (*APIKeyIdentity, error) {
+	if !strings.HasPrefix(key, shared.APIKeyPrefix) {
+		return nil, ErrInvalidAPIKey
+	}
+	identity, err := q.readStore.FindIdentity(ctx, q.uow.DB(ctx), shared.HashAPIKey(key), q.clock.Now())
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return nil, errs.Mark(err, ErrInvalidAPIKey)
+		}
+		return nil, errs.Mark(err, ErrAPIKeyQueryFailed)
+	}
+	return identity, nil
+}
+
+func (q *apiKeyQueriesImpl) ListByUser(ctx context.Context, userID uuid.UUID) ([]*APIKeyView, error) {
+	keys, err := q.readStore.ListByUser(ctx, q.uow.DB(ctx), userID)
+	if err != nil {
+		return nil, errs.Mark(err, ErrAPIKeyQueryFailed)
+	}
+	return keys, nil
+}
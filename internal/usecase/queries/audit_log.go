@@ -0,0 +1,69 @@
+package queries
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/pkg/keyset"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+var ErrAuditLogQueryFailed = errs.New("audit log query failed")
+
+type AuditLogEntry struct {
+	ID         uuid.UUID
+	ActorID    *uuid.UUID // nil for system actions and deleted actors
+	CompanyID  *uuid.UUID
+	Action     string
+	TargetType string
+	TargetID   string
+	Metadata   json.RawMessage
+	CreatedAt  time.Time
+}
+
+// AuditLogFilter narrows the audit log; nil fields match every entry.
+type AuditLogFilter struct {
+	Action  *string
+	ActorID *uuid.UUID
+}
+
+type AuditLogReadStore interface {
+	FindFirstPage(ctx context.Context, db sqlc.DBTX, filter AuditLogFilter, limit int32) ([]*AuditLogEntry, error)
+	FindKeyset(ctx context.Context, db sqlc.DBTX, filter AuditLogFilter, lastCreatedAt time.Time, lastID uuid.UUID, limit int32) ([]*AuditLogEntry, error)
+}
+
+type AuditLogQueries interface {
+	// List lists audit entries newest first. Entries age out with the audit log retention
+	// policy.
+	List(ctx context.Context, filter AuditLogFilter, after *Cursor, limit int) ([]*AuditLogEntry, *Cursor, error)
+}
+
+type auditLogQueriesImpl struct {
+	uow       shared.UnitOfWork
+	readStore AuditLogReadStore
+}
+
+func NewAuditLogQueries(uow shared.UnitOfWork, readStore AuditLogReadStore) AuditLogQueries {
+	return &auditLogQueriesImpl{
+		uow:       uow,
+		readStore: readStore,
+	}
+}
+
+func (q *auditLogQueriesImpl) List(ctx context.Context, filter AuditLogFilter, after *Cursor, limit int) ([]*AuditLogEntry, *Cursor, error) {
+	db := q.uow.DB(ctx)
+
+	return listPage(after, limit, ErrInvalidCursor, ErrAuditLogQueryFailed,
+		func(e *AuditLogEntry) keyset.Position { return keyset.Position{CreatedAt: e.CreatedAt, ID: e.ID} },
+		func(last *keyset.Position, n int32) ([]*AuditLogEntry, error) {
+			if last == nil {
+				return q.readStore.FindFirstPage(ctx, db, filter, n)
+			}
+			return q.readStore.FindKeyset(ctx, db, filter, last.CreatedAt, last.ID, n)
+		})
+}
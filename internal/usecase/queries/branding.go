@@ -0,0 +1,72 @@
+package queries
+
+import (
+	"context"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrBrandingCompanyNotFound = errs.NewCoded("COMPANY_NOT_FOUND", "company not found")
+	ErrBrandingQueryFailed     = errs.New("branding query failed")
+)
+
+// previewEmail is the sample message admins see their branding on.
+var previewEmail = shared.Email{
+	Subject: "Your reservation is confirmed",
+	Heading: "Your reservation is confirmed",
+	Paragraphs: []string{
+		"Meeting Room A, Monday 10:00-11:00.",
+		"You can change or cancel the reservation up to its start.",
+	},
+	ActionLabel: "View reservation",
+	ActionURL:   "https://example.com/reservations/preview",
+}
+
+type BrandingQueries interface {
+	// Get returns the company's own branding; empty fields use the product defaults.
+	Get(ctx context.Context, companyID uuid.UUID) (shared.CompanyBranding, error)
+	// Preview renders a sample reservation email in the company's branding.
+	Preview(ctx context.Context, companyID uuid.UUID) (shared.RenderedEmail, error)
+}
+
+type brandingQueriesImpl struct {
+	uow      shared.UnitOfWork
+	store    shared.BrandingReadStore
+	renderer shared.EmailRenderer
+}
+
+func NewBrandingQueries(uow shared.UnitOfWork, store shared.BrandingReadStore, renderer shared.EmailRenderer) BrandingQueries {
+	return &brandingQueriesImpl{
+		uow:      uow,
+		store:    store,
+		renderer: renderer,
+	}
+}
+
+func (q *brandingQueriesImpl) Get(ctx context.Context, companyID uuid.UUID) (shared.CompanyBranding, error) {
+	branding, err := q.store.FindBranding(ctx, q.uow.DB(ctx), companyID)
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return shared.CompanyBranding{}, errs.Mark(err, ErrBrandingCompanyNotFound)
+		}
+		return shared.CompanyBranding{}, errs.Mark(err, ErrBrandingQueryFailed)
+	}
+	return branding, nil
+}
+
+func (q *brandingQueriesImpl) Preview(ctx context.Context, companyID uuid.UUID) (shared.RenderedEmail, error) {
+	branding, err := q.Get(ctx, companyID)
+	if err != nil {
+		return shared.RenderedEmail{}, err
+	}
+	rendered, err := q.renderer.Render(branding, previewEmail)
+	if err != nil {
+		return shared.RenderedEmail{}, errs.Mark(err, ErrBrandingQueryFailed)
+	}
+	return rendered, nil
+}
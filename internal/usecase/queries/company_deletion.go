@@ -0,0 +1,69 @@
+package queries
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrCompanyDeletionNotFound    = errs.NewCoded("COMPANY_DELETION_NOT_FOUND", "company deletion not found")
+	ErrCompanyDeletionQueryFailed = errs.New("company deletion query failed")
+)
+
+// CompanyDeletionDetail is a deletion's progress. Counts totals what each stage did so
+// far; Certificate is set once the company is gone, and Error while a failed run waits
+// to be retried.
+type CompanyDeletionDetail struct {
+	ID          uuid.UUID
+	CompanyID   uuid.UUID
+	CompanyName string
+	RequestedBy *uuid.UUID
+	Status      string
+	Stage       string
+	Counts      map[string]int64
+	Attempts    int32
+	Error       *string
+	Certificate json.RawMessage
+	CreatedAt   time.Time
+	StartedAt   *time.Time
+	CompletedAt *time.Time
+}
+
+type CompanyDeletionReadStore interface {
+	FindByID(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (*CompanyDeletionDetail, error)
+}
+
+type CompanyDeletionQueries interface {
+	Get(ctx context.Context, id uuid.UUID) (*CompanyDeletionDetail, error)
+}
+
+type companyDeletionQueriesImpl struct {
+	uow       shared.UnitOfWork
+	readStore CompanyDeletionReadStore
+}
+
+func NewCompanyDeletionQueries(uow shared.UnitOfWork, readStore CompanyDeletionReadStore) CompanyDeletionQueries {
+	return &companyDeletionQueriesImpl{
+		uow:       uow,
+		readStore: readStore,
+	}
+}
+
+func (q *companyDeletionQueriesImpl) Get(ctx context.Context, id uuid.UUID) (*CompanyDeletionDetail, error) {
+	detail, err := q.readStore.FindByID(ctx, q.uow.DB(ctx), id)
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return nil, errs.Mark(err, ErrCompanyDeletionNotFound)
+		}
+		return nil, errs.Mark(err, ErrCompanyDeletionQueryFailed)
+	}
+	return detail, nil
+}
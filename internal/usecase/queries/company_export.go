@@ -0,0 +1,155 @@
+package queries
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/pkg/signedtoken"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+const (
+	CompanyExportDownloadPurpose = "company_export_download"
+
+	CompanyExportStatusDone = "done"
+)
+
+var (
+	ErrCompanyExportNotFound    = errs.NewCoded("COMPANY_EXPORT_NOT_FOUND", "company export not found")
+	ErrInvalidExportLink        = errs.NewCoded("COMPANY_EXPORT_INVALID_LINK", "invalid export download link")
+	ErrExportLinkExpired        = errs.NewCoded("COMPANY_EXPORT_LINK_EXPIRED", "export download link expired")
+	ErrCompanyExportQueryFailed = errs.New("company export query failed")
+)
+
+// CompanyExportLinkPolicy sets how long a signed download link stays valid; a link never
+// outlives the archive it points at.
+type CompanyExportLinkPolicy struct {
+	TTL time.Duration
+}
+
+// CompanyExportDetail is an export's progress. DownloadToken is set only while a finished
+// archive is kept; RequestedBy is nil once the staff member has been deleted.
+type CompanyExportDetail struct {
+	ID                uuid.UUID
+	CompanyID         uuid.UUID
+	RequestedBy       *uuid.UUID
+	Status            string
+	Progress          int32
+	StorageKey        string
+	SizeBytes         *int64
+	Error             *string
+	CreatedAt         time.Time
+	StartedAt         *time.Time
+	CompletedAt       *time.Time
+	ExpiresAt         *time.Time
+	DownloadToken     string
+	DownloadExpiresAt *time.Time
+}
+
+type CompanyExportReadStore interface {
+	FindByID(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (*CompanyExportDetail, error)
+}
+
+type CompanyExportQueries interface {
+	// Get returns the export with a fresh download link once its archive is ready.
+	Get(ctx context.Context, id uuid.UUID) (*CompanyExportDetail, error)
+	// OpenDownload resolves a signed link to the export and its zip archive; the caller
+	// closes the reader.
+	OpenDownload(ctx context.Context, token string) (*CompanyExportDetail, io.ReadCloser, error)
+}
+
+type companyExportClaims struct {
+	ExportID uuid.UUID `json:"e"`
+}
+
+type companyExportQueriesImpl struct {
+	uow       shared.UnitOfWork
+	readStore CompanyExportReadStore
+	storage   shared.FileStorage
+	signer    *signedtoken.Signer
+	clock     clock.Clock
+	policy    CompanyExportLinkPolicy
+}
+
+func NewCompanyExportQueries(
+	uow shared.UnitOfWork,
+	readStore CompanyExportReadStore,
+	storage shared.FileStorage,
+	signer *signedtoken.Signer,
+	clock clock.Clock,
+	policy CompanyExportLinkPolicy,
+) CompanyExportQueries {
+	return &companyExportQueriesImpl{
+		uow:       uow,
+		readStore: readStore,
+		storage:   storage,
+		signer:    signer,
+		clock:     clock,
+		policy:    policy,
+	}
+}
+
+func (q *companyExportQueriesImpl) Get(ctx context.Context, id uuid.UUID) (*CompanyExportDetail, error) {
+	detail, err := q.readStore.FindByID(ctx, q.uow.DB(ctx), id)
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return nil, errs.Mark(err, ErrCompanyExportNotFound)
+		}
+		return nil, errs.Mark(err, ErrCompanyExportQueryFailed)
+	}
+
+	now := q.clock.Now()
+	if detail.Status != CompanyExportStatusDone || detail.ExpiresAt == nil || !now.Before(*detail.ExpiresAt) {
+		return detail, nil
+	}
+	expiresAt := now.Add(q.policy.TTL)
+	if detail.ExpiresAt.Before(expiresAt) {
+		expiresAt = *detail.ExpiresAt
+	}
+	token, err := q.signer.Sign(CompanyExportDownloadPurpose, companyExportClaims{ExportID: id}, expiresAt)
+	if err != nil {
+		return nil, errs.Mark(err, ErrCompanyExportQueryFailed)
+	}
+	detail.DownloadToken = token
+	detail.DownloadExpiresAt = &expiresAt
+	return detail, nil
+}
+
+func (q *companyExportQueriesImpl) OpenDownload(ctx context.Context, token string) (*CompanyExportDetail, io.ReadCloser, error) {
+	var claims companyExportClaims
+	if _, err := q.signer.Verify(CompanyExportDownloadPurpose, token, q.clock.Now(), &claims); err != nil {
+		if errors.Is(err, signedtoken.ErrExpiredToken) {
+			return nil, nil, errs.Mark(err, ErrExportLinkExpired)
+		}
+		return nil, nil, errs.Mark(err, ErrInvalidExportLink)
+	}
+
+	// Purged archives read as expired links: the signature was good, the content is gone
+	detail, err := q.readStore.FindByID(ctx, q.uow.DB(ctx), claims.ExportID)
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return nil, nil, errs.Mark(err, ErrExportLinkExpired)
+		}
+		return nil, nil, errs.Mark(err, ErrCompanyExportQueryFailed)
+	}
+	if detail.Status != CompanyExportStatusDone {
+		return nil, nil, ErrInvalidExportLink
+	}
+
+	content, err := q.storage.Open(ctx, detail.StorageKey)
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return nil, nil, errs.Mark(err, ErrExportLinkExpired)
+		}
+		return nil, nil, errs.Mark(err, ErrCompanyExportQueryFailed)
+	}
+	return detail, content, nil
+}
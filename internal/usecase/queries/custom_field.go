@@ -0,0 +1,68 @@
+package queries
+
+import (
+	"context"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrCustomFieldResourceNotFound = errs.NewCoded("RESOURCE_NOT_FOUND", "resource not found")
+	ErrCustomFieldQueryFailed      = errs.New("custom field query failed")
+)
+
+type CustomFieldReadStore interface {
+	ListByCompany(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID) ([]*shared.CustomFieldDefinition, error)
+	ListForResource(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID) ([]*shared.CustomFieldDefinition, error)
+}
+
+type CustomFieldQueries interface {
+	// ListByCompany returns every field the company defined, company-wide and per resource,
+	// oldest first.
+	ListByCompany(ctx context.Context, companyID uuid.UUID) ([]*shared.CustomFieldDefinition, error)
+	// ListForResource returns the fields a reservation of the resource is asked for.
+	ListForResource(ctx context.Context, resourceID uuid.UUID) ([]*shared.CustomFieldDefinition, error)
+}
+
+type customFieldQueriesImpl struct {
+	uow       shared.UnitOfWork
+	resources shared.ResourceReadStore
+	readStore CustomFieldReadStore
+}
+
+func NewCustomFieldQueries(uow shared.UnitOfWork, resources shared.ResourceReadStore, readStore CustomFieldReadStore) CustomFieldQueries {
+	return &customFieldQueriesImpl{
+		uow:       uow,
+		resources: resources,
+		readStore: readStore,
+	}
+}
+
+func (q *customFieldQueriesImpl) ListByCompany(ctx context.Context, companyID uuid.UUID) ([]*shared.CustomFieldDefinition, error) {
+	defs, err := q.readStore.ListByCompany(ctx, q.uow.DB(ctx), companyID)
+	if err != nil {
+		return nil, errs.Mark(err, ErrCustomFieldQueryFailed)
+	}
+	return defs, nil
+}
+
+func (q *customFieldQueriesImpl) ListForResource(ctx context.Context, resourceID uuid.UUID) ([]*shared.CustomFieldDefinition, error) {
+	db := q.uow.DB(ctx)
+	if _, err := q.resources.FindByID(ctx, db, resourceID); err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return nil, errs.Mark(err, ErrCustomFieldResourceNotFound)
+		}
+		return nil, errs.Mark(err, ErrCustomFieldQueryFailed)
+	}
+
+	defs, err := q.readStore.ListForResource(ctx, db, resourceID)
+	if err != nil {
+		return nil, errs.Mark(err, ErrCustomFieldQueryFailed)
+	}
+	return defs, nil
+}
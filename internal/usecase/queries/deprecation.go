@@ -0,0 +1,85 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrDeprecationRangeInvalid = errs.NewCoded("INVALID_DEPRECATION_REPORT_RANGE", "deprecated route report range is invalid")
+	ErrDeprecationQueryFailed  = errs.New("deprecated route query failed")
+)
+
+// Longest range one deprecated route report may cover.
+const maxDeprecationRange = 366 * 24 * time.Hour
+
+// DeprecatedRouteConsumer is how often one consumer called a deprecated route. UserID and
+// the user's details are nil for anonymous calls; company details are nil for users
+// without a company.
+type DeprecatedRouteConsumer struct {
+	Route        string
+	UserID       *uuid.UUID
+	Email        *string
+	CompanyID    *uuid.UUID
+	CompanyName  *string
+	Calls        int64
+	LastCalledAt time.Time
+}
+
+type DeprecationReport struct {
+	From      time.Time
+	To        time.Time // inclusive
+	Consumers []*DeprecatedRouteConsumer
+}
+
+type DeprecationReadStore interface {
+	ListCalls(ctx context.Context, db sqlc.DBTX, from, to time.Time, route *string) ([]*DeprecatedRouteConsumer, error)
+}
+
+type DeprecationQueries interface {
+	// Report lists who called deprecated routes between from and to (both days inclusive,
+	// UTC), grouped by route with the busiest consumer first, optionally narrowed to one
+	// route. It defaults to the 30 days up to today.
+	Report(ctx context.Context, from, to *time.Time, route *string) (*DeprecationReport, error)
+}
+
+type deprecationQueriesImpl struct {
+	uow       shared.UnitOfWork
+	readStore DeprecationReadStore
+	clock     clock.Clock
+}
+
+func NewDeprecationQueries(uow shared.UnitOfWork, readStore DeprecationReadStore, clock clock.Clock) DeprecationQueries {
+	return &deprecationQueriesImpl{
+		uow:       uow,
+		readStore: readStore,
+		clock:     clock,
+	}
+}
+
+func (q *deprecationQueriesImpl) Report(ctx context.Context, from, to *time.Time, route *string) (*DeprecationReport, error) {
+	end := shared.TelemetryDay(q.clock.Now())
+	if to != nil {
+		end = shared.TelemetryDay(*to)
+	}
+	start := end.AddDate(0, 0, -29)
+	if from != nil {
+		start = shared.TelemetryDay(*from)
+	}
+	if start.After(end) || end.Sub(start) > maxDeprecationRange {
+		return nil, ErrDeprecationRangeInvalid
+	}
+
+	consumers, err := q.readStore.ListCalls(ctx, q.uow.ReadDB(ctx), start, end, route)
+	if err != nil {
+		return nil, errs.Mark(err, ErrDeprecationQueryFailed)
+	}
+	return &DeprecationReport{From: start, To: end, Consumers: consumers}, nil
+}
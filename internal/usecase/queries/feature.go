@@ -0,0 +1,49 @@
+package queries
+
+import (
+	"context"
+
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrFeatureCompanyNotFound = errs.NewCoded("COMPANY_NOT_FOUND", "company not found")
+	ErrFeatureQueryFailed     = errs.New("feature query failed")
+)
+
+type FeatureQueries interface {
+	// List reports every feature for companyID and whether the company overrides its default.
+	List(ctx context.Context, companyID uuid.UUID) ([]shared.FeatureState, error)
+}
+
+type featureQueriesImpl struct {
+	uow   shared.UnitOfWork
+	store shared.FeatureReadStore
+	flags shared.FeatureFlags
+}
+
+func NewFeatureQueries(uow shared.UnitOfWork, store shared.FeatureReadStore, flags shared.FeatureFlags) FeatureQueries {
+	return &featureQueriesImpl{
+		uow:   uow,
+		store: store,
+		flags: flags,
+	}
+}
+
+func (q *featureQueriesImpl) List(ctx context.Context, companyID uuid.UUID) ([]shared.FeatureState, error) {
+	exists, err := q.store.CompanyExists(ctx, q.uow.DB(ctx), companyID)
+	if err != nil {
+		return nil, errs.Mark(err, ErrFeatureQueryFailed)
+	}
+	if !exists {
+		return nil, ErrFeatureCompanyNotFound
+	}
+	states, err := q.flags.States(ctx, companyID)
+	if err != nil {
+		return nil, errs.Mark(err, ErrFeatureQueryFailed)
+	}
+	return states, nil
+}
@@ -0,0 +1,77 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInviteCompanyMissing = errs.NewCoded("COMPANY_MEMBERSHIP_REQUIRED", "caller does not belong to a company")
+	ErrInviteQueryFailed    = errs.New("invite query failed")
+)
+
+type InviteView struct {
+	ID             uuid.UUID
+	Email          string
+	Role           string
+	Status         string
+	InvitedByEmail string
+	ExpiresAt      time.Time
+	CreatedAt      time.Time
+}
+
+type InviteReadStore interface {
+	ListByCompany(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID) ([]*InviteView, error)
+}
+
+type InviteQueries interface {
+	// List returns the invites of the actor's company, newest first.
+	List(ctx context.Context, actorID uuid.UUID) ([]*InviteView, error)
+	// ListByCompany serves callers whose company comes from their token (support sessions).
+	ListByCompany(ctx context.Context, companyID uuid.UUID) ([]*InviteView, error)
+}
+
+type inviteQueriesImpl struct {
+	uow       shared.UnitOfWork
+	users     UserReadStore
+	readStore InviteReadStore
+}
+
+func NewInviteQueries(uow shared.UnitOfWork, users UserReadStore, readStore InviteReadStore) InviteQueries {
+	return &inviteQueriesImpl{
+		uow:       uow,
+		users:     users,
+		readStore: readStore,
+	}
+}
+
+func (q *inviteQueriesImpl) List(ctx context.Context, actorID uuid.UUID) ([]*InviteView, error) {
+	db := q.uow.DB(ctx)
+	actor, err := q.users.FindByID(ctx, db, actorID)
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return nil, errs.Mark(err, ErrInviteCompanyMissing)
+		}
+		return nil, errs.Mark(err, ErrInviteQueryFailed)
+	}
+	if actor.CompanyID == nil {
+		return nil, ErrInviteCompanyMissing
+	}
+
+	return q.ListByCompany(ctx, *actor.CompanyID)
+}
+
+func (q *inviteQueriesImpl) ListByCompany(ctx context.Context, companyID uuid.UUID) ([]*InviteView, error) {
+	invites, err := q.readStore.ListByCompany(ctx, q.uow.DB(ctx), companyID)
+	if err != nil {
+		return nil, errs.Mark(err, ErrInviteQueryFailed)
+	}
+	return invites, nil
+}
@@ -0,0 +1,83 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/pkg/keyset"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+var ErrLoyaltyQueryFailed = errs.New("loyalty query failed")
+
+// PointsHistory is a user's spendable balance and a page of their points ledger.
+type PointsHistory struct {
+	Balance int64
+	Entries []*PointsEntry
+}
+
+// PointsEntry is one ledger line. Points are positive for accruals and negative for
+// redemptions and expiries.
+type PointsEntry struct {
+	ID            uuid.UUID
+	Kind          string
+	Points        int32
+	ReservationID *uuid.UUID
+	DiscountCents *int32     // redemptions only
+	ExpiresAt     *time.Time // accruals only
+	CreatedAt     time.Time
+}
+
+type LoyaltyReadStore interface {
+	Balance(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, now time.Time) (int64, error)
+	FindEntriesFirstPage(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, limit int32) ([]*PointsEntry, error)
+	FindEntriesKeyset(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, lastCreatedAt time.Time, lastID uuid.UUID, limit int32) ([]*PointsEntry, error)
+}
+
+type LoyaltyQueries interface {
+	// GetHistory lists ledger entries newest first; the balance excludes expired lots
+	// even before the expiry job has recorded them.
+	GetHistory(ctx context.Context, userID uuid.UUID, after *Cursor, limit int) (*PointsHistory, *Cursor, error)
+}
+
+type loyaltyQueriesImpl struct {
+	uow       shared.UnitOfWork
+	readStore LoyaltyReadStore
+	clock     clock.Clock
+}
+
+func NewLoyaltyQueries(uow shared.UnitOfWork, readStore LoyaltyReadStore, clock clock.Clock) LoyaltyQueries {
+	return &loyaltyQueriesImpl{
+		uow:       uow,
+		readStore: readStore,
+		clock:     clock,
+	}
+}
+
+func (q *loyaltyQueriesImpl) GetHistory(ctx context.Context, userID uuid.UUID, after *Cursor, limit int) (*PointsHistory, *Cursor, error) {
+	db := q.uow.DB(ctx)
+
+	entries, nextCursor, err := listPage(after, limit, ErrInvalidCursor, ErrLoyaltyQueryFailed,
+		func(e *PointsEntry) keyset.Position { return keyset.Position{CreatedAt: e.CreatedAt, ID: e.ID} },
+		func(last *keyset.Position, n int32) ([]*PointsEntry, error) {
+			if last == nil {
+				return q.readStore.FindEntriesFirstPage(ctx, db, userID, n)
+			}
+			return q.readStore.FindEntriesKeyset(ctx, db, userID, last.CreatedAt, last.ID, n)
+		})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	balance, err := q.readStore.Balance(ctx, db, userID, q.clock.Now())
+	if err != nil {
+		return nil, nil, errs.Mark(err, ErrLoyaltyQueryFailed)
+	}
+
+	return &PointsHistory{Balance: balance, Entries: entries}, nextCursor, nil
+}
@@ -0,0 +1,69 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/pkg/keyset"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+var ErrNotificationJobQueryFailed = errs.New("notification job query failed")
+
+// NotificationJob is a queued or processed notification. Payloads are left out: they carry
+// recipients' addresses and signed links.
+type NotificationJob struct {
+	ID        uuid.UUID
+	Kind      string
+	Topic     string
+	Status    string
+	Attempts  int32
+	LastError string
+	RunAt     time.Time
+	CreatedAt time.Time
+}
+
+// NotificationJobFilter narrows the job list; nil fields match every job.
+type NotificationJobFilter struct {
+	Status *string
+	Topic  *string
+}
+
+type NotificationJobReadStore interface {
+	FindFirstPage(ctx context.Context, db sqlc.DBTX, filter NotificationJobFilter, limit int32) ([]*NotificationJob, error)
+	FindKeyset(ctx context.Context, db sqlc.DBTX, filter NotificationJobFilter, lastCreatedAt time.Time, lastID uuid.UUID, limit int32) ([]*NotificationJob, error)
+}
+
+type NotificationJobQueries interface {
+	// List lists notification jobs newest first.
+	List(ctx context.Context, filter NotificationJobFilter, after *Cursor, limit int) ([]*NotificationJob, *Cursor, error)
+}
+
+type notificationJobQueriesImpl struct {
+	uow       shared.UnitOfWork
+	readStore NotificationJobReadStore
+}
+
+func NewNotificationJobQueries(uow shared.UnitOfWork, readStore NotificationJobReadStore) NotificationJobQueries {
+	return &notificationJobQueriesImpl{
+		uow:       uow,
+		readStore: readStore,
+	}
+}
+
+func (q *notificationJobQueriesImpl) List(ctx context.Context, filter NotificationJobFilter, after *Cursor, limit int) ([]*NotificationJob, *Cursor, error) {
+	db := q.uow.DB(ctx)
+
+	return listPage(after, limit, ErrInvalidCursor, ErrNotificationJobQueryFailed,
+		func(j *NotificationJob) keyset.Position { return keyset.Position{CreatedAt: j.CreatedAt, ID: j.ID} },
+		func(last *keyset.Position, n int32) ([]*NotificationJob, error) {
+			if last == nil {
+				return q.readStore.FindFirstPage(ctx, db, filter, n)
+			}
+			return q.readStore.FindKeyset(ctx, db, filter, last.CreatedAt, last.ID, n)
+		})
+}
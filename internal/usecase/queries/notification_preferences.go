@@ -0,0 +1,54 @@
+package queries
+
+import (
+	"context"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+var ErrNotificationPreferencesQueryFailed = errs.New("notification preferences query failed")
+
+// NotificationPreferencesView is how a user receives notification emails. Digest is off,
+// daily or weekly; Held counts the notifications waiting for the next digest.
+type NotificationPreferencesView struct {
+	Digest string
+	Held   int64
+}
+
+type NotificationPreferencesReadStore interface {
+	// Get returns KindNotFound for unknown users.
+	Get(ctx context.Context, db sqlc.DBTX, userID uuid.UUID) (*NotificationPreferencesView, error)
+}
+
+type NotificationPreferencesQueries interface {
+	Get(ctx context.Context, userID uuid.UUID) (*NotificationPreferencesView, error)
+}
+
+type notificationPreferencesQueriesImpl struct {
+	uow       shared.UnitOfWork
+	readStore NotificationPreferencesReadStore
+}
+
+func NewNotificationPreferencesQueries(uow shared.UnitOfWork, readStore NotificationPreferencesReadStore) NotificationPreferencesQueries {
+	return &notificationPreferencesQueriesImpl{
+		uow:       uow,
+		readStore: readStore,
+	}
+}
+
+// Get reads the primary so a preference changed a moment ago is not shown stale.
+func (q *notificationPreferencesQueriesImpl) Get(ctx context.Context, userID uuid.UUID) (*NotificationPreferencesView, error) {
+	prefs, err := q.readStore.Get(ctx, q.uow.DB(ctx), userID)
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, errs.Mark(err, ErrNotificationPreferencesQueryFailed)
+	}
+	return prefs, nil
+}
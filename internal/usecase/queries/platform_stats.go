@@ -0,0 +1,55 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+)
+
+var ErrPlatformStatsQueryFailed = errs.New("platform stats query failed")
+
+// PlatformStats are the platform-wide totals as of ComputedAt, the last run of the
+// platform_stats job; nil before it first ran. AverageRating is 0 until the first review.
+type PlatformStats struct {
+	TotalResources int64
+	TotalReviews   int64
+	AverageRating  float64
+	ComputedAt     *time.Time
+}
+
+type PlatformStatsReadStore interface {
+	// Get returns the stored totals; KindNotFound before they are first computed.
+	Get(ctx context.Context, db sqlc.DBTX) (*PlatformStats, error)
+}
+
+type PlatformStatsQueries interface {
+	// Get returns the totals the job last computed, never aggregating live data.
+	Get(ctx context.Context) (*PlatformStats, error)
+}
+
+type platformStatsQueriesImpl struct {
+	uow       shared.UnitOfWork
+	readStore PlatformStatsReadStore
+}
+
+func NewPlatformStatsQueries(uow shared.UnitOfWork, readStore PlatformStatsReadStore) PlatformStatsQueries {
+	return &platformStatsQueriesImpl{
+		uow:       uow,
+		readStore: readStore,
+	}
+}
+
+func (q *platformStatsQueriesImpl) Get(ctx context.Context) (*PlatformStats, error) {
+	stats, err := q.readStore.Get(ctx, q.uow.ReadDB(ctx))
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return &PlatformStats{}, nil
+		}
+		return nil, errs.Mark(err, ErrPlatformStatsQueryFailed)
+	}
+	return stats, nil
+}
@@ -0,0 +1,108 @@
+package queries
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/pkg/keyset"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidProvisioningToken = errs.NewCoded("INVALID_PROVISIONING_TOKEN", "provisioning token is unknown or revoked")
+	ErrProvisioningQueryFailed  = errs.New("provisioning query failed")
+)
+
+// ProvisionedUser is a company member as an identity provider sees it. ExternalID is empty
+// for members that were not provisioned.
+type ProvisionedUser struct {
+	ID         uuid.UUID
+	Email      string
+	ExternalID string
+	Active     bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// ProvisionedUserFilter narrows the member list; nil fields match every member.
+type ProvisionedUserFilter struct {
+	Email      *string
+	ExternalID *string
+}
+
+type ProvisioningReadStore interface {
+	// FindTokenCompany reports KindNotFound for unknown and revoked tokens.
+	FindTokenCompany(ctx context.Context, db sqlc.DBTX, tokenHash []byte) (uuid.UUID, error)
+	FindUser(ctx context.Context, db sqlc.DBTX, companyID, userID uuid.UUID) (*ProvisionedUser, error)
+	ListUsers(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID, filter ProvisionedUserFilter, offset, limit int32) ([]*ProvisionedUser, error)
+	CountUsers(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID, filter ProvisionedUserFilter) (int64, error)
+}
+
+type ProvisioningQueries interface {
+	// Authenticate returns the company a provisioning token was issued for.
+	Authenticate(ctx context.Context, token string) (uuid.UUID, error)
+	GetUser(ctx context.Context, companyID, userID uuid.UUID) (*ProvisionedUser, error)
+	// ListUsers returns a page of the company's members, oldest first, and how many match
+	// the filter in total. limit is capped at keyset.MaxLimit; 0 only counts.
+	ListUsers(ctx context.Context, companyID uuid.UUID, filter ProvisionedUserFilter, offset, limit int) ([]*ProvisionedUser, int64, error)
+}
+
+type provisioningQueriesImpl struct {
+	uow       shared.UnitOfWork
+	readStore ProvisioningReadStore
+}
+
+func NewProvisioningQueries(uow shared.UnitOfWork, readStore ProvisioningReadStore) ProvisioningQueries {
+	return &provisioningQueriesImpl{
+		uow:       uow,
+		readStore: readStore,
+	}
+}
+
+func (q *provisioningQueriesImpl) Authenticate(ctx context.Context, token string) (uuid.UUID, error) {
+	if !strings.HasPrefix(token, shared.ProvisioningTokenPrefix) {
+		return uuid.Nil, ErrInvalidProvisioningToken
+	}
+	companyID, err := q.readStore.FindTokenCompany(ctx, q.uow.DB(ctx), shared.HashProvisioningToken(token))
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return uuid.Nil, errs.Mark(err, ErrInvalidProvisioningToken)
+		}
+		return uuid.Nil, errs.Mark(err, ErrProvisioningQueryFailed)
+	}
+	return companyID, nil
+}
+
+func (q *provisioningQueriesImpl) GetUser(ctx context.Context, companyID, userID uuid.UUID) (*ProvisionedUser, error) {
+	user, err := q.readStore.FindUser(ctx, q.uow.DB(ctx), companyID, userID)
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return nil, errs.Mark(err, ErrUserNotFound)
+		}
+		return nil, errs.Mark(err, ErrProvisioningQueryFailed)
+	}
+	return user, nil
+}
+
+func (q *provisioningQueriesImpl) ListUsers(ctx context.Context, companyID uuid.UUID, filter ProvisionedUserFilter, offset, limit int) ([]*ProvisionedUser, int64, error) {
+	db := q.uow.DB(ctx)
+	users := []*ProvisionedUser{}
+	if limit = min(max(limit, 0), keyset.MaxLimit); limit > 0 {
+		var err error
+		users, err = q.readStore.ListUsers(ctx, db, companyID, filter, int32(max(offset, 0)), int32(limit))
+		if err != nil {
+			return nil, 0, errs.Mark(err, ErrProvisioningQueryFailed)
+		}
+	}
+	total, err := q.readStore.CountUsers(ctx, db, companyID, filter)
+	if err != nil {
+		return nil, 0, errs.Mark(err, ErrProvisioningQueryFailed)
+	}
+	return users, total, nil
+}
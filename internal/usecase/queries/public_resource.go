@@ -0,0 +1,91 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gin-clean-starter/internal/domain/resource"
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+)
+
+var (
+	ErrPublicResourceNotFound    = errs.NewCoded("RESOURCE_NOT_FOUND", "resource not found")
+	ErrPublicResourceQueryFailed = errs.New("public resource query failed")
+)
+
+// maxSitemapResources is the sitemap protocol's limit on URLs per sitemap.
+const maxSitemapResources = 50000
+
+// PublicResourcePage is what anonymous visitors see of a resource. It is named by its slug
+// and carries no ids. LastModified is the latest change to any of its fields.
+type PublicResourcePage struct {
+	Slug          string
+	Name          string
+	CompanyName   *string
+	LeadTimeMin   int
+	TotalReviews  int32
+	AverageRating float64
+	LastModified  time.Time
+}
+
+// PublicResourceLink is a public resource page as listed in the sitemap.
+type PublicResourceLink struct {
+	Slug         string
+	LastModified time.Time
+}
+
+type PublicResourceReadStore interface {
+	FindPublicBySlug(ctx context.Context, db sqlc.DBTX, slug string) (*PublicResourcePage, error)
+	ListPublic(ctx context.Context, db sqlc.DBTX, limit int32) ([]*PublicResourceLink, error)
+}
+
+type PublicResourceQueries interface {
+	// GetBySlug returns the resource's public page; malformed slugs are not found.
+	GetBySlug(ctx context.Context, slug string) (*PublicResourcePage, error)
+	// ListForSitemap returns every public resource page by slug, up to the sitemap limit.
+	ListForSitemap(ctx context.Context) ([]*PublicResourceLink, error)
+	GenerateETag(page *PublicResourcePage) string
+}
+
+type publicResourceQueriesImpl struct {
+	uow       shared.UnitOfWork
+	readStore PublicResourceReadStore
+}
+
+func NewPublicResourceQueries(uow shared.UnitOfWork, readStore PublicResourceReadStore) PublicResourceQueries {
+	return &publicResourceQueriesImpl{
+		uow:       uow,
+		readStore: readStore,
+	}
+}
+
+func (q *publicResourceQueriesImpl) GetBySlug(ctx context.Context, slug string) (*PublicResourcePage, error) {
+	valid, err := resource.NewSlug(slug)
+	if err != nil {
+		return nil, errs.Mark(err, ErrPublicResourceNotFound)
+	}
+	page, err := q.readStore.FindPublicBySlug(ctx, q.uow.ReadDB(ctx), valid.String())
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return nil, errs.Mark(err, ErrPublicResourceNotFound)
+		}
+		return nil, errs.Mark(err, ErrPublicResourceQueryFailed)
+	}
+	return page, nil
+}
+
+func (q *publicResourceQueriesImpl) ListForSitemap(ctx context.Context) ([]*PublicResourceLink, error) {
+	links, err := q.readStore.ListPublic(ctx, q.uow.ReadDB(ctx), maxSitemapResources)
+	if err != nil {
+		return nil, errs.Mark(err, ErrPublicResourceQueryFailed)
+	}
+	return links, nil
+}
+
+func (q *publicResourceQueriesImpl) GenerateETag(page *PublicResourcePage) string {
+	return fmt.Sprintf("W/\"%s-%d\"", page.Slug, page.LastModified.UnixMicro())
+}
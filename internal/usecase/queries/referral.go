@@ -0,0 +1,64 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+var ErrReferralQueryFailed = errs.New("referral query failed")
+
+// ReferralOverview is a user's own referral code, earned credit and the users they referred.
+type ReferralOverview struct {
+	Code        string
+	CreditCents int64
+	Referrals   []*ReferralView
+}
+
+type ReferralView struct {
+	ID             uuid.UUID
+	ReferredUserID uuid.UUID
+	ReferredEmail  string
+	Status         string
+	RewardCents    *int32 // the referrer's credit, once rewarded
+	CreatedAt      time.Time
+	RewardedAt     *time.Time
+}
+
+type ReferralReadStore interface {
+	FindOverview(ctx context.Context, db sqlc.DBTX, userID uuid.UUID) (*ReferralOverview, error)
+}
+
+type ReferralQueries interface {
+	// GetOverview lists the user's referrals newest first.
+	GetOverview(ctx context.Context, userID uuid.UUID) (*ReferralOverview, error)
+}
+
+type referralQueriesImpl struct {
+	uow       shared.UnitOfWork
+	readStore ReferralReadStore
+}
+
+func NewReferralQueries(uow shared.UnitOfWork, readStore ReferralReadStore) ReferralQueries {
+	return &referralQueriesImpl{
+		uow:       uow,
+		readStore: readStore,
+	}
+}
+
+func (q *referralQueriesImpl) GetOverview(ctx context.Context, userID uuid.UUID) (*ReferralOverview, error) {
+	overview, err := q.readStore.FindOverview(ctx, q.uow.DB(ctx), userID)
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return nil, errs.Mark(err, ErrUserNotFound)
+		}
+		return nil, errs.Mark(err, ErrReferralQueryFailed)
+	}
+	return overview, nil
+}
@@ -0,0 +1,100 @@
+package queries
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrRequestRecordingNotFound    = errs.NewCoded("REQUEST_RECORDING_NOT_FOUND", "request recording not found")
+	ErrRecordedRequestNotFound     = errs.NewCoded("RECORDED_REQUEST_NOT_FOUND", "recorded request not found")
+	ErrRequestRecordingQueryFailed = errs.New("request recording query failed")
+)
+
+// RequestRecordingDetail is a recording with its captures, oldest first. EnabledBy is nil
+// once the staff member who enabled it has been deleted.
+type RequestRecordingDetail struct {
+	ID               uuid.UUID
+	UserID           uuid.UUID
+	UserEmail        string
+	EnabledBy        *uuid.UUID
+	Reason           string
+	ConsentReference string
+	MaxRequests      int32
+	RecordedCount    int32
+	ExpiresAt        time.Time
+	CreatedAt        time.Time
+	Captures         []*shared.RecordedRequest
+}
+
+type RequestRecordingReadStore interface {
+	FindByID(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (*RequestRecordingDetail, error)
+	ListCaptures(ctx context.Context, db sqlc.DBTX, id uuid.UUID) ([]*shared.RecordedRequest, error)
+	FindCapture(ctx context.Context, db sqlc.DBTX, id uuid.UUID, seq int32) (*shared.RecordedRequest, error)
+}
+
+type RequestRecordingQueries interface {
+	Get(ctx context.Context, id uuid.UUID) (*RequestRecordingDetail, error)
+	// OpenCapture returns the capture and its sanitized JSON document; the caller closes
+	// the reader.
+	OpenCapture(ctx context.Context, id uuid.UUID, seq int32) (*shared.RecordedRequest, io.ReadCloser, error)
+}
+
+type requestRecordingQueriesImpl struct {
+	uow       shared.UnitOfWork
+	readStore RequestRecordingReadStore
+	storage   shared.FileStorage
+}
+
+func NewRequestRecordingQueries(uow shared.UnitOfWork, readStore RequestRecordingReadStore, storage shared.FileStorage) RequestRecordingQueries {
+	return &requestRecordingQueriesImpl{
+		uow:       uow,
+		readStore: readStore,
+		storage:   storage,
+	}
+}
+
+func (q *requestRecordingQueriesImpl) Get(ctx context.Context, id uuid.UUID) (*RequestRecordingDetail, error) {
+	db := q.uow.DB(ctx)
+	detail, err := q.readStore.FindByID(ctx, db, id)
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return nil, errs.Mark(err, ErrRequestRecordingNotFound)
+		}
+		return nil, errs.Mark(err, ErrRequestRecordingQueryFailed)
+	}
+
+	captures, err := q.readStore.ListCaptures(ctx, db, id)
+	if err != nil {
+		return nil, errs.Mark(err, ErrRequestRecordingQueryFailed)
+	}
+	detail.Captures = captures
+	return detail, nil
+}
+
+func (q *requestRecordingQueriesImpl) OpenCapture(ctx context.Context, id uuid.UUID, seq int32) (*shared.RecordedRequest, io.ReadCloser, error) {
+	capture, err := q.readStore.FindCapture(ctx, q.uow.DB(ctx), id, seq)
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return nil, nil, errs.Mark(err, ErrRecordedRequestNotFound)
+		}
+		return nil, nil, errs.Mark(err, ErrRequestRecordingQueryFailed)
+	}
+
+	content, err := q.storage.Open(ctx, capture.StorageKey)
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return nil, nil, errs.Mark(err, ErrRecordedRequestNotFound)
+		}
+		return nil, nil, errs.Mark(err, ErrRequestRecordingQueryFailed)
+	}
+	return capture, content, nil
+}
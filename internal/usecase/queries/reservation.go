@@ -8,27 +8,32 @@ import (
 	"gin-clean-starter/internal/infra"
 	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
 	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/pkg/keyset"
 	"gin-clean-starter/internal/usecase/shared"
 
 	"github.com/google/uuid"
 )
 
 var (
-	ErrReservationNotFound = errs.New("reservation not found")
-	ErrReservationAccess   = errs.New("reservation access failed")
-	ErrInvalidCursor       = errs.New("invalid cursor")
-)
+	ErrReservationNotFound  = errs.NewCoded("RESERVATION_NOT_FOUND", "reservation not found")
+	ErrReservationAccess    = errs.New("reservation access failed")
+	ErrInvalidCursor        = errs.NewCoded("INVALID_CURSOR", "invalid cursor")
+	ErrReservationForbidden = errs.NewCoded("RESERVATION_LISTING_FORBIDDEN", "reservation listing forbidden")
 
-const (
-	RoleViewer   = "viewer"
-	RoleOperator = "operator"
-	RoleAdmin    = "admin"
+	ErrReservationGroupNotFound = errs.NewCoded("RESERVATION_GROUP_NOT_FOUND", "reservation group not found")
 )
 
 type ReservationQueries interface {
 	GetByID(ctx context.Context, actor uuid.UUID, id uuid.UUID) (*ReservationView, error)
-	GetByIDWithRole(ctx context.Context, actorID uuid.UUID, actorRole string, id uuid.UUID) (*ReservationView, error)
+	// GetGroup returns the actor's reservations booked together as groupID, earliest slot first.
+	GetGroup(ctx context.Context, actor uuid.UUID, groupID uuid.UUID) ([]*ReservationView, error)
+	// companyID, when set (support sessions), hides reservations on other companies' resources.
+	GetByIDWithRole(ctx context.Context, actorID uuid.UUID, actorRole string, companyID *uuid.UUID, id uuid.UUID) (*ReservationView, error)
 	ListByUser(ctx context.Context, userID uuid.UUID, after *Cursor, limit int) ([]*ReservationListItem, *Cursor, error)
+	// fields filters by custom field values, as AdminReservationFilter.CustomFields.
+	ListForAdmin(ctx context.Context, actorID uuid.UUID, actorRole string, companyID, resourceID *uuid.UUID, fields map[string]string, after *Cursor, limit int) ([]*AdminReservationListItem, *Cursor, error)
+	// ExportForAdmin passes every reservation ListForAdmin would list, newest first, to emit.
+	ExportForAdmin(ctx context.Context, actorID uuid.UUID, actorRole string, companyID, resourceID *uuid.UUID, fields map[string]string, emit func(*AdminReservationListItem) error) error
 	GenerateETag(reservation *ReservationView) string
 }
 
@@ -36,22 +41,86 @@ type ReservationReadStore interface {
 	FindByID(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (*ReservationView, error)
 	FindByUserIDFirstPage(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, limit int32) ([]*ReservationListItem, error)
 	FindByUserIDKeyset(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, lastCreatedAt time.Time, lastID uuid.UUID, limit int32) ([]*ReservationListItem, error)
+	FindForAdminFirstPage(ctx context.Context, db sqlc.DBTX, filter AdminReservationFilter, limit int32) ([]*AdminReservationListItem, error)
+	FindForAdminKeyset(ctx context.Context, db sqlc.DBTX, filter AdminReservationFilter, lastCreatedAt time.Time, lastID uuid.UUID, limit int32) ([]*AdminReservationListItem, error)
+	FindIDsByGroup(ctx context.Context, db sqlc.DBTX, groupID, userID uuid.UUID) ([]uuid.UUID, error)
+}
+
+// AdminReservationFilter narrows the admin listing; nil fields are not applied.
+// OperatorID keeps only reservations on resources assigned to that operator;
+// CompanyID keeps only reservations on that company's resources; CustomFields keeps
+// only reservations whose custom fields hold each of the values, compared as text.
+type AdminReservationFilter struct {
+	ResourceID   *uuid.UUID
+	OperatorID   *uuid.UUID
+	CompanyID    *uuid.UUID
+	CustomFields map[string]string
 }
 
 type reservationQueriesImpl struct {
-	uow shared.UnitOfWork
-	rs  ReservationReadStore
+	uow         shared.UnitOfWork
+	rs          ReservationReadStore
+	permissions shared.PermissionResolver
+	authorizer  shared.ResourceAuthorizer
 }
 
-func NewReservationQueries(uow shared.UnitOfWork, repo ReservationReadStore) ReservationQueries {
-	return &reservationQueriesImpl{uow: uow, rs: repo}
+func NewReservationQueries(uow shared.UnitOfWork, repo ReservationReadStore, permissions shared.PermissionResolver, authorizer shared.ResourceAuthorizer) ReservationQueries {
+	return &reservationQueriesImpl{uow: uow, rs: repo, permissions: permissions, authorizer: authorizer}
 }
 
+// GetByID returns only the actor's own reservations.
 func (q *reservationQueriesImpl) GetByID(ctx context.Context, actor uuid.UUID, id uuid.UUID) (*ReservationView, error) {
-	return q.GetByIDWithRole(ctx, actor, RoleViewer, id)
+	reservation, err := q.find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if reservation.UserID != actor {
+		return nil, ErrReservationNotFound
+	}
+
+	return reservation, nil
 }
 
-func (q *reservationQueriesImpl) GetByIDWithRole(ctx context.Context, actorID uuid.UUID, actorRole string, id uuid.UUID) (*ReservationView, error) {
+func (q *reservationQueriesImpl) GetGroup(ctx context.Context, actor uuid.UUID, groupID uuid.UUID) ([]*ReservationView, error) {
+	ids, err := q.rs.FindIDsByGroup(ctx, q.uow.DB(ctx), groupID, actor)
+	if err != nil {
+		return nil, errs.Mark(err, ErrReservationAccess)
+	}
+	if len(ids) == 0 {
+		return nil, ErrReservationGroupNotFound
+	}
+
+	views := make([]*ReservationView, len(ids))
+	for i, id := range ids {
+		if views[i], err = q.find(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+	return views, nil
+}
+
+func (q *reservationQueriesImpl) GetByIDWithRole(ctx context.Context, actorID uuid.UUID, actorRole string, companyID *uuid.UUID, id uuid.UUID) (*ReservationView, error) {
+	reservation, err := q.find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if companyID != nil && (reservation.ResourceCompanyID == nil || *reservation.ResourceCompanyID != *companyID) {
+		return nil, ErrReservationNotFound
+	}
+
+	ok, err := q.canAccessReservation(ctx, actorID, actorRole, reservation)
+	if err != nil {
+		return nil, errs.Mark(err, ErrReservationAccess)
+	}
+	if !ok {
+		return nil, ErrReservationNotFound
+	}
+
+	return reservation, nil
+}
+
+func (q *reservationQueriesImpl) find(ctx context.Context, id uuid.UUID) (*ReservationView, error) {
 	db := q.uow.DB(ctx)
 	reservation, err := q.rs.FindByID(ctx, db, id)
 	if err != nil {
@@ -60,76 +129,137 @@ func (q *reservationQueriesImpl) GetByIDWithRole(ctx context.Context, actorID uu
 		}
 		return nil, errs.Mark(err, ErrReservationAccess)
 	}
-
-	if !canAccessReservation(actorID, actorRole, reservation) {
-		return nil, ErrReservationNotFound
-	}
-
 	return reservation, nil
 }
 
 func (q *reservationQueriesImpl) ListByUser(ctx context.Context, userID uuid.UUID, after *Cursor, limit int) ([]*ReservationListItem, *Cursor, error) {
-	limit = ValidateLimit(limit)
+	db := q.uow.DB(ctx)
+
+	return listPage(after, limit, ErrInvalidCursor, ErrReservationAccess,
+		func(r *ReservationListItem) keyset.Position { return keyset.Position{CreatedAt: r.CreatedAt, ID: r.ID} },
+		func(last *keyset.Position, n int32) ([]*ReservationListItem, error) {
+			if last == nil {
+				return q.rs.FindByUserIDFirstPage(ctx, db, userID, n)
+			}
+			return q.rs.FindByUserIDKeyset(ctx, db, userID, last.CreatedAt, last.ID, n)
+		})
+}
+
+// ListForAdmin lists every reservation for reservations:read:any, or only those on the
+// actor's assigned resources for reservations:read:assigned. A non-nil companyID narrows
+// either listing to that company's resources.
+func (q *reservationQueriesImpl) ListForAdmin(ctx context.Context, actorID uuid.UUID, actorRole string, companyID, resourceID *uuid.UUID, fields map[string]string, after *Cursor, limit int) ([]*AdminReservationListItem, *Cursor, error) {
+	filter, err := q.adminFilter(ctx, actorID, actorRole, resourceID)
+	if err != nil {
+		return nil, nil, err
+	}
+	filter.CompanyID = companyID
+	filter.CustomFields = fields
 
-	var rows []*ReservationListItem
-	var err error
 	db := q.uow.DB(ctx)
 
-	if after == nil || after.After == "" {
-		rows, err = q.rs.FindByUserIDFirstPage(ctx, db, userID, ToPgFetchLimit(limit))
-	} else {
-		lastCreatedAt, lastID, decodeErr := DecodeAfterCursor(after.After)
-		if decodeErr != nil {
-			return nil, nil, errs.Mark(decodeErr, ErrInvalidCursor)
+	return listPage(after, limit, ErrInvalidCursor, ErrReservationAccess,
+		func(r *AdminReservationListItem) keyset.Position {
+			return keyset.Position{CreatedAt: r.CreatedAt, ID: r.ID}
+		},
+		func(last *keyset.Position, n int32) ([]*AdminReservationListItem, error) {
+			if last == nil {
+				return q.rs.FindForAdminFirstPage(ctx, db, filter, n)
+			}
+			return q.rs.FindForAdminKeyset(ctx, db, filter, last.CreatedAt, last.ID, n)
+		})
+}
+
+func (q *reservationQueriesImpl) ExportForAdmin(ctx context.Context, actorID uuid.UUID, actorRole string, companyID, resourceID *uuid.UUID, fields map[string]string, emit func(*AdminReservationListItem) error) error {
+	filter, err := q.adminFilter(ctx, actorID, actorRole, resourceID)
+	if err != nil {
+		return err
+	}
+	filter.CompanyID = companyID
+	filter.CustomFields = fields
+
+	var last *AdminReservationListItem
+	for {
+		var batch []*AdminReservationListItem
+		if last == nil {
+			batch, err = q.rs.FindForAdminFirstPage(ctx, q.uow.DB(ctx), filter, exportBatchSize)
+		} else {
+			batch, err = q.rs.FindForAdminKeyset(ctx, q.uow.DB(ctx), filter, last.CreatedAt, last.ID, exportBatchSize)
+		}
+		if err != nil {
+			return errs.Mark(err, ErrReservationAccess)
+		}
+		for _, item := range batch {
+			if err := emit(item); err != nil {
+				return err
+			}
 		}
-		rows, err = q.rs.FindByUserIDKeyset(ctx, db, userID, lastCreatedAt, lastID, ToPgFetchLimit(limit))
+		if len(batch) < exportBatchSize {
+			return nil
+		}
+		last = batch[len(batch)-1]
 	}
+}
 
+func (q *reservationQueriesImpl) adminFilter(ctx context.Context, actorID uuid.UUID, actorRole string, resourceID *uuid.UUID) (AdminReservationFilter, error) {
+	filter := AdminReservationFilter{ResourceID: resourceID}
+
+	readAny, err := q.permissions.HasPermission(ctx, actorRole, shared.PermissionReservationsReadAny)
 	if err != nil {
-		return nil, nil, errs.Mark(err, ErrReservationAccess)
+		return filter, errs.Mark(err, ErrReservationAccess)
+	}
+	if readAny {
+		return filter, nil
 	}
 
-	var nextCursor *Cursor
-	if len(rows) > limit {
-		lastItem := rows[limit-1]
-		nextCursor = &Cursor{
-			After: EncodeAfterCursor(lastItem.CreatedAt, lastItem.ID),
-		}
-		rows = rows[:limit]
+	readAssigned, err := q.permissions.HasPermission(ctx, actorRole, shared.PermissionReservationsReadAssigned)
+	if err != nil {
+		return filter, errs.Mark(err, ErrReservationAccess)
+	}
+	if !readAssigned {
+		return filter, ErrReservationForbidden
 	}
 
-	return rows, nextCursor, nil
+	filter.OperatorID = &actorID
+	return filter, nil
 }
 
 func (q *reservationQueriesImpl) GenerateETag(reservation *ReservationView) string {
 	return fmt.Sprintf("W/\"%s-%d\"", reservation.ID.String(), reservation.UpdatedAt.UnixMicro())
 }
 
-func canAccessReservation(actorID uuid.UUID, actorRole string, reservation *ReservationView) bool {
-	switch actorRole {
-	case RoleAdmin, RoleOperator:
-		return true
-	case RoleViewer:
-		return reservation.UserID == actorID
-	default:
-		return false
+func (q *reservationQueriesImpl) canAccessReservation(ctx context.Context, actorID uuid.UUID, actorRole string, reservation *ReservationView) (bool, error) {
+	if reservation.UserID == actorID {
+		return true, nil
 	}
+	return q.authorizer.CanActOnResource(ctx, actorID, actorRole, shared.PermissionReservationsReadAny, shared.PermissionReservationsReadAssigned, reservation.ResourceID)
 }
 
 type ReservationView struct {
-	ID           uuid.UUID  `json:"id"`
-	ResourceID   uuid.UUID  `json:"resource_id"`
-	ResourceName string     `json:"resource_name"`
-	UserID       uuid.UUID  `json:"user_id"`
-	UserEmail    string     `json:"user_email"`
-	Slot         string     `json:"slot"`
-	Status       string     `json:"status"`
-	PriceCents   int32      `json:"price_cents"`
-	CouponID     *uuid.UUID `json:"coupon_id,omitempty"`
-	CouponCode   *string    `json:"coupon_code,omitempty"`
-	Note         *string    `json:"note,omitempty"`
-	CreatedAt    time.Time  `json:"created_at"`
-	UpdatedAt    time.Time  `json:"updated_at"`
+	ID           uuid.UUID                 `json:"id"`
+	ResourceID   uuid.UUID                 `json:"resource_id"`
+	ResourceName string                    `json:"resource_name"`
+	UserID       uuid.UUID                 `json:"user_id"`
+	UserEmail    string                    `json:"user_email"`
+	Slot         string                    `json:"slot"`
+	Status       string                    `json:"status"`
+	PriceCents   int32                     `json:"price_cents"`
+	CouponID     *uuid.UUID                `json:"coupon_id,omitempty"`
+	CouponCode   *string                   `json:"coupon_code,omitempty"`
+	Discounts    []ReservationDiscountView `json:"discounts"`
+	CreatedAt    time.Time                 `json:"created_at"`
+	UpdatedAt    time.Time                 `json:"updated_at"`
+	// ResourceCompanyID is nil for shared resources that belong to no company.
+	ResourceCompanyID *uuid.UUID `json:"resource_company_id,omitempty"`
+	// CustomFields holds the values given for the company's custom fields, by key.
+	CustomFields map[string]any `json:"custom_fields"`
+}
+
+// ReservationDiscountView is one applied coupon, in application order.
+type ReservationDiscountView struct {
+	CouponID    uuid.UUID `json:"coupon_id"`
+	CouponCode  string    `json:"coupon_code"`
+	AmountCents int32     `json:"amount_cents"`
 }
 
 type ReservationListItem struct {
@@ -141,3 +271,16 @@ type ReservationListItem struct {
 	PriceCents   int32     `json:"price_cents"`
 	CreatedAt    time.Time `json:"created_at"`
 }
+
+type AdminReservationListItem struct {
+	ID           uuid.UUID      `json:"id"`
+	ResourceID   uuid.UUID      `json:"resource_id"`
+	ResourceName string         `json:"resource_name"`
+	UserID       uuid.UUID      `json:"user_id"`
+	UserEmail    string         `json:"user_email"`
+	Slot         string         `json:"slot"`
+	Status       string         `json:"status"`
+	PriceCents   int32          `json:"price_cents"`
+	CreatedAt    time.Time      `json:"created_at"`
+	CustomFields map[string]any `json:"custom_fields"`
+}
@@ -0,0 +1,101 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+// pendingApprovalsLimit caps the approval queue; the soonest to expire come first.
+const pendingApprovalsLimit = 100
+
+var (
+	ErrApproverResourceNotFound = errs.NewCoded("RESOURCE_NOT_FOUND", "resource not found")
+	ErrApprovalQueryFailed      = errs.New("reservation approval query failed")
+)
+
+type ResourceApproverView struct {
+	UserID     uuid.UUID
+	Email      string
+	Role       string
+	AssignedAt time.Time
+}
+
+// PendingApprovalView is a reservation waiting for an approver's decision.
+type PendingApprovalView struct {
+	ReservationID uuid.UUID
+	ResourceID    uuid.UUID
+	ResourceName  string
+	UserID        uuid.UUID
+	UserEmail     string
+	StartTime     time.Time
+	EndTime       time.Time
+	ExpiresAt     time.Time
+	EscalatedAt   *time.Time
+	RequestedAt   time.Time
+}
+
+type ReservationApprovalReadStore interface {
+	ListApprovers(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID) ([]*ResourceApproverView, error)
+	// ListPending returns pending requests on the resources approverID approves, or on
+	// every resource with allResources.
+	ListPending(ctx context.Context, db sqlc.DBTX, approverID uuid.UUID, allResources bool, limit int32) ([]*PendingApprovalView, error)
+}
+
+type ReservationApprovalQueries interface {
+	ListApprovers(ctx context.Context, resourceID uuid.UUID) ([]*ResourceApproverView, error)
+	// ListPending is the caller's approval queue: requests on the resources they approve,
+	// or on every resource with reservations:approve:any.
+	ListPending(ctx context.Context, actorID uuid.UUID, actorRole string) ([]*PendingApprovalView, error)
+}
+
+type reservationApprovalQueriesImpl struct {
+	uow         shared.UnitOfWork
+	resources   shared.ResourceReadStore
+	readStore   ReservationApprovalReadStore
+	permissions shared.PermissionResolver
+}
+
+func NewReservationApprovalQueries(uow shared.UnitOfWork, resources shared.ResourceReadStore, readStore ReservationApprovalReadStore, permissions shared.PermissionResolver) ReservationApprovalQueries {
+	return &reservationApprovalQueriesImpl{
+		uow:         uow,
+		resources:   resources,
+		readStore:   readStore,
+		permissions: permissions,
+	}
+}
+
+func (q *reservationApprovalQueriesImpl) ListApprovers(ctx context.Context, resourceID uuid.UUID) ([]*ResourceApproverView, error) {
+	db := q.uow.DB(ctx)
+	if _, err := q.resources.FindByID(ctx, db, resourceID); err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return nil, errs.Mark(err, ErrApproverResourceNotFound)
+		}
+		return nil, errs.Mark(err, ErrApprovalQueryFailed)
+	}
+
+	approvers, err := q.readStore.ListApprovers(ctx, db, resourceID)
+	if err != nil {
+		return nil, errs.Mark(err, ErrApprovalQueryFailed)
+	}
+	return approvers, nil
+}
+
+func (q *reservationApprovalQueriesImpl) ListPending(ctx context.Context, actorID uuid.UUID, actorRole string) ([]*PendingApprovalView, error) {
+	allResources, err := q.permissions.HasPermission(ctx, actorRole, shared.PermissionReservationsApproveAny)
+	if err != nil {
+		return nil, errs.Mark(err, ErrApprovalQueryFailed)
+	}
+
+	pending, err := q.readStore.ListPending(ctx, q.uow.DB(ctx), actorID, allResources, pendingApprovalsLimit)
+	if err != nil {
+		return nil, errs.Mark(err, ErrApprovalQueryFailed)
+	}
+	return pending, nil
+}
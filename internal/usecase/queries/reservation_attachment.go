@@ -0,0 +1,122 @@
+package queries
+
+import (
+	"context"
+	"io"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrReservationAttachmentNotFound    = errs.NewCoded("RESERVATION_ATTACHMENT_NOT_FOUND", "reservation attachment not found")
+	ErrReservationAttachmentQueryFailed = errs.New("reservation attachment query failed")
+)
+
+// AttachmentAccess identifies who reads a reservation's attachments and from which side.
+// The user side must own the reservation; the operator side needs reservations:read on its
+// resource, even when the actor also owns the reservation. CompanyID, when set (support
+// sessions), hides reservations on other companies' resources.
+type AttachmentAccess struct {
+	ActorID   uuid.UUID
+	ActorRole string
+	Side      string
+	CompanyID *uuid.UUID
+}
+
+type ReservationAttachmentQueries interface {
+	// List returns the reservation's attachments, oldest first; operator-only files are
+	// hidden from the user side.
+	List(ctx context.Context, reservationID uuid.UUID, access AttachmentAccess) ([]*shared.ReservationAttachment, error)
+	// Open returns the attachment and its content; the caller closes the reader.
+	Open(ctx context.Context, reservationID, id uuid.UUID, access AttachmentAccess) (*shared.ReservationAttachment, io.ReadCloser, error)
+}
+
+type reservationAttachmentQueriesImpl struct {
+	uow          shared.UnitOfWork
+	reservations ReservationReadStore
+	readStore    shared.ReservationAttachmentReadStore
+	authorizer   shared.ResourceAuthorizer
+	storage      shared.FileStorage
+}
+
+func NewReservationAttachmentQueries(uow shared.UnitOfWork, reservations ReservationReadStore, readStore shared.ReservationAttachmentReadStore, authorizer shared.ResourceAuthorizer, storage shared.FileStorage) ReservationAttachmentQueries {
+	return &reservationAttachmentQueriesImpl{
+		uow:          uow,
+		reservations: reservations,
+		readStore:    readStore,
+		authorizer:   authorizer,
+		storage:      storage,
+	}
+}
+
+func (q *reservationAttachmentQueriesImpl) List(ctx context.Context, reservationID uuid.UUID, access AttachmentAccess) ([]*shared.ReservationAttachment, error) {
+	if err := q.checkAccess(ctx, reservationID, access); err != nil {
+		return nil, err
+	}
+
+	attachments, err := q.readStore.FindByReservation(ctx, q.uow.DB(ctx), reservationID, access.Side == shared.MessageSideOperator)
+	if err != nil {
+		return nil, errs.Mark(err, ErrReservationAttachmentQueryFailed)
+	}
+	return attachments, nil
+}
+
+func (q *reservationAttachmentQueriesImpl) Open(ctx context.Context, reservationID, id uuid.UUID, access AttachmentAccess) (*shared.ReservationAttachment, io.ReadCloser, error) {
+	if err := q.checkAccess(ctx, reservationID, access); err != nil {
+		return nil, nil, err
+	}
+
+	attachment, err := q.readStore.FindByID(ctx, q.uow.DB(ctx), reservationID, id)
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return nil, nil, errs.Mark(err, ErrReservationAttachmentNotFound)
+		}
+		return nil, nil, errs.Mark(err, ErrReservationAttachmentQueryFailed)
+	}
+	if attachment.OperatorOnly && access.Side != shared.MessageSideOperator {
+		return nil, nil, ErrReservationAttachmentNotFound
+	}
+
+	content, err := q.storage.Open(ctx, attachment.StorageKey)
+	if err != nil {
+		return nil, nil, errs.Mark(err, ErrReservationAttachmentQueryFailed)
+	}
+	return attachment, content, nil
+}
+
+// checkAccess reports reservations the actor may not read from its side as not found.
+func (q *reservationAttachmentQueriesImpl) checkAccess(ctx context.Context, reservationID uuid.UUID, access AttachmentAccess) error {
+	reservation, err := q.reservations.FindByID(ctx, q.uow.DB(ctx), reservationID)
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return errs.Mark(err, ErrReservationNotFound)
+		}
+		return errs.Mark(err, ErrReservationAttachmentQueryFailed)
+	}
+	if access.CompanyID != nil && (reservation.ResourceCompanyID == nil || *reservation.ResourceCompanyID != *access.CompanyID) {
+		return ErrReservationNotFound
+	}
+
+	switch access.Side {
+	case shared.MessageSideUser:
+		if reservation.UserID != access.ActorID {
+			return ErrReservationNotFound
+		}
+	case shared.MessageSideOperator:
+		ok, err := q.authorizer.CanActOnResource(ctx, access.ActorID, access.ActorRole,
+			shared.PermissionReservationsReadAny, shared.PermissionReservationsReadAssigned, reservation.ResourceID)
+		if err != nil {
+			return errs.Mark(err, ErrReservationAccess)
+		}
+		if !ok {
+			return ErrReservationNotFound
+		}
+	default:
+		return ErrReservationNotFound
+	}
+	return nil
+}
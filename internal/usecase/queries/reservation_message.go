@@ -0,0 +1,80 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/pkg/keyset"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+var ErrReservationMessageQueryFailed = errs.New("reservation message query failed")
+
+type ReservationMessageView struct {
+	ID         uuid.UUID
+	AuthorID   uuid.UUID
+	AuthorSide string // shared.MessageSideUser or shared.MessageSideOperator
+	Body       string
+	CreatedAt  time.Time
+}
+
+// ReservationMessageThread is one page of a thread, oldest first, as seen by one side.
+type ReservationMessageThread struct {
+	Messages []*ReservationMessageView
+	Next     *Cursor
+	// Unread counts the other side's messages this side has not marked read, across the thread.
+	Unread int64
+}
+
+type ReservationMessageReadStore interface {
+	FindFirstPage(ctx context.Context, db sqlc.DBTX, reservationID uuid.UUID, limit int32) ([]*ReservationMessageView, error)
+	FindKeyset(ctx context.Context, db sqlc.DBTX, reservationID uuid.UUID, lastCreatedAt time.Time, lastID uuid.UUID, limit int32) ([]*ReservationMessageView, error)
+	CountUnread(ctx context.Context, db sqlc.DBTX, reservationID uuid.UUID, side string) (int64, error)
+}
+
+type ReservationMessageQueries interface {
+	// Thread pages the reservation's messages for side. It does not check access:
+	// the view must come from ReservationQueries, which already has.
+	Thread(ctx context.Context, reservation *ReservationView, side string, after *Cursor, limit int) (*ReservationMessageThread, error)
+}
+
+type reservationMessageQueriesImpl struct {
+	uow       shared.UnitOfWork
+	readStore ReservationMessageReadStore
+}
+
+func NewReservationMessageQueries(uow shared.UnitOfWork, readStore ReservationMessageReadStore) ReservationMessageQueries {
+	return &reservationMessageQueriesImpl{
+		uow:       uow,
+		readStore: readStore,
+	}
+}
+
+func (q *reservationMessageQueriesImpl) Thread(ctx context.Context, reservation *ReservationView, side string, after *Cursor, limit int) (*ReservationMessageThread, error) {
+	db := q.uow.DB(ctx)
+
+	messages, next, err := listPage(after, limit, ErrInvalidCursor, ErrReservationMessageQueryFailed,
+		func(m *ReservationMessageView) keyset.Position {
+			return keyset.Position{CreatedAt: m.CreatedAt, ID: m.ID}
+		},
+		func(last *keyset.Position, n int32) ([]*ReservationMessageView, error) {
+			if last == nil {
+				return q.readStore.FindFirstPage(ctx, db, reservation.ID, n)
+			}
+			return q.readStore.FindKeyset(ctx, db, reservation.ID, last.CreatedAt, last.ID, n)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	unread, err := q.readStore.CountUnread(ctx, db, reservation.ID, side)
+	if err != nil {
+		return nil, errs.Mark(err, ErrReservationMessageQueryFailed)
+	}
+
+	return &ReservationMessageThread{Messages: messages, Unread: unread, Next: next}, nil
+}
@@ -0,0 +1,141 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+// MaxScheduleWindow bounds the time range a block listing or availability request may span.
+const MaxScheduleWindow = 92 * 24 * time.Hour
+
+// maxListedBlocks caps a block listing; a window holding more is cut off at the latest starts.
+const maxListedBlocks = 500
+
+var (
+	ErrScheduleResourceNotFound = errs.NewCoded("RESOURCE_NOT_FOUND", "resource not found")
+	ErrInvalidScheduleWindow    = errs.NewCoded("INVALID_TIME_WINDOW", "invalid time window")
+	ErrResourceBlocksForbidden  = errs.NewCoded("PERMISSION_DENIED", "permission denied")
+	ErrScheduleQueryFailed      = errs.New("resource schedule query failed")
+)
+
+type ResourceBlockView struct {
+	ID        uuid.UUID
+	SeriesID  uuid.UUID
+	StartTime time.Time
+	EndTime   time.Time
+	Reason    string
+	CreatedBy uuid.UUID
+	CreatedAt time.Time
+}
+
+// BusySlot is a time range in which a resource cannot be booked.
+type BusySlot struct {
+	StartTime time.Time
+	EndTime   time.Time
+	Kind      string // "reservation" or "block"
+}
+
+type ResourceBlockReadStore interface {
+	List(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID, from, to time.Time, limit int32) ([]*ResourceBlockView, error)
+	BusySlots(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID, from, to time.Time) ([]*BusySlot, error)
+}
+
+type ResourceBlockQueries interface {
+	// List returns the blocks overlapping [from, to) to actors allowed to manage them.
+	// A zero from means now and a zero to means from plus 30 days.
+	List(ctx context.Context, resourceID uuid.UUID, from, to time.Time, actorID uuid.UUID, actorRole string) ([]*ResourceBlockView, error)
+	// Availability lists the busy time ranges overlapping [from, to) without saying who
+	// booked them or why they are blocked; the window defaults as for List.
+	Availability(ctx context.Context, resourceID uuid.UUID, from, to time.Time) ([]*BusySlot, error)
+}
+
+type resourceBlockQueriesImpl struct {
+	uow        shared.UnitOfWork
+	resources  shared.ResourceReadStore
+	readStore  ResourceBlockReadStore
+	authorizer shared.ResourceAuthorizer
+	clock      clock.Clock
+}
+
+func NewResourceBlockQueries(uow shared.UnitOfWork, resources shared.ResourceReadStore, readStore ResourceBlockReadStore, authorizer shared.ResourceAuthorizer, clock clock.Clock) ResourceBlockQueries {
+	return &resourceBlockQueriesImpl{
+		uow:        uow,
+		resources:  resources,
+		readStore:  readStore,
+		authorizer: authorizer,
+		clock:      clock,
+	}
+}
+
+func (q *resourceBlockQueriesImpl) List(ctx context.Context, resourceID uuid.UUID, from, to time.Time, actorID uuid.UUID, actorRole string) ([]*ResourceBlockView, error) {
+	from, to, err := q.window(from, to)
+	if err != nil {
+		return nil, err
+	}
+	db := q.uow.DB(ctx)
+	if err := q.ensureResource(ctx, db, resourceID); err != nil {
+		return nil, err
+	}
+
+	allowed, err := q.authorizer.CanActOnResource(ctx, actorID, actorRole, shared.PermissionResourceBlocksManageAny, shared.PermissionResourceBlocksManageAssigned, resourceID)
+	if err != nil {
+		return nil, errs.Mark(err, ErrScheduleQueryFailed)
+	}
+	if !allowed {
+		return nil, ErrResourceBlocksForbidden
+	}
+
+	blocks, err := q.readStore.List(ctx, db, resourceID, from, to, maxListedBlocks)
+	if err != nil {
+		return nil, errs.Mark(err, ErrScheduleQueryFailed)
+	}
+	return blocks, nil
+}
+
+func (q *resourceBlockQueriesImpl) Availability(ctx context.Context, resourceID uuid.UUID, from, to time.Time) ([]*BusySlot, error) {
+	from, to, err := q.window(from, to)
+	if err != nil {
+		return nil, err
+	}
+	db := q.uow.DB(ctx)
+	if err := q.ensureResource(ctx, db, resourceID); err != nil {
+		return nil, err
+	}
+
+	slots, err := q.readStore.BusySlots(ctx, db, resourceID, from, to)
+	if err != nil {
+		return nil, errs.Mark(err, ErrScheduleQueryFailed)
+	}
+	return slots, nil
+}
+
+func (q *resourceBlockQueriesImpl) window(from, to time.Time) (time.Time, time.Time, error) {
+	if from.IsZero() {
+		from = q.clock.Now()
+	}
+	if to.IsZero() {
+		to = from.Add(30 * 24 * time.Hour)
+	}
+	if !to.After(from) || to.Sub(from) > MaxScheduleWindow {
+		return time.Time{}, time.Time{}, ErrInvalidScheduleWindow
+	}
+	return from, to, nil
+}
+
+func (q *resourceBlockQueriesImpl) ensureResource(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID) error {
+	if _, err := q.resources.FindByID(ctx, db, resourceID); err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return errs.Mark(err, ErrScheduleResourceNotFound)
+		}
+		return errs.Mark(err, ErrScheduleQueryFailed)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrOperatorResourceNotFound = errs.NewCoded("RESOURCE_NOT_FOUND", "resource not found")
+	ErrOperatorQueryFailed      = errs.New("resource operator query failed")
+)
+
+type ResourceOperatorView struct {
+	UserID     uuid.UUID
+	Email      string
+	Role       string
+	AssignedAt time.Time
+}
+
+type ResourceOperatorReadStore interface {
+	ListByResource(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID) ([]*ResourceOperatorView, error)
+}
+
+type ResourceOperatorQueries interface {
+	List(ctx context.Context, resourceID uuid.UUID) ([]*ResourceOperatorView, error)
+}
+
+type resourceOperatorQueriesImpl struct {
+	uow       shared.UnitOfWork
+	resources shared.ResourceReadStore
+	readStore ResourceOperatorReadStore
+}
+
+func NewResourceOperatorQueries(uow shared.UnitOfWork, resources shared.ResourceReadStore, readStore ResourceOperatorReadStore) ResourceOperatorQueries {
+	return &resourceOperatorQueriesImpl{
+		uow:       uow,
+		resources: resources,
+		readStore: readStore,
+	}
+}
+
+func (q *resourceOperatorQueriesImpl) List(ctx context.Context, resourceID uuid.UUID) ([]*ResourceOperatorView, error) {
+	db := q.uow.DB(ctx)
+	if _, err := q.resources.FindByID(ctx, db, resourceID); err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return nil, errs.Mark(err, ErrOperatorResourceNotFound)
+		}
+		return nil, errs.Mark(err, ErrOperatorQueryFailed)
+	}
+
+	operators, err := q.readStore.ListByResource(ctx, db, resourceID)
+	if err != nil {
+		return nil, errs.Mark(err, ErrOperatorQueryFailed)
+	}
+	return operators, nil
+}
@@ -0,0 +1,87 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+)
+
+var ErrRetentionQueryFailed = errs.New("retention query failed")
+
+type RetentionTableReport struct {
+	Table          string
+	MaxAge         time.Duration
+	Cutoff         time.Time
+	EligibleRows   int64
+	LastRunAt      *time.Time
+	LastRunDeleted int64
+	TotalDeleted   int64
+}
+
+type RetentionReport struct {
+	GeneratedAt time.Time
+	BatchSize   int32
+	Tables      []RetentionTableReport
+}
+
+type RetentionQueries interface {
+	DryRun(ctx context.Context) (*RetentionReport, error)
+}
+
+type retentionQueriesImpl struct {
+	uow      shared.UnitOfWork
+	repo     shared.RetentionRepository
+	policies shared.RetentionPolicies
+	metrics  *shared.RetentionMetrics
+	clock    clock.Clock
+}
+
+func NewRetentionQueries(
+	uow shared.UnitOfWork,
+	repo shared.RetentionRepository,
+	policies shared.RetentionPolicies,
+	metrics *shared.RetentionMetrics,
+	clock clock.Clock,
+) RetentionQueries {
+	return &retentionQueriesImpl{
+		uow:      uow,
+		repo:     repo,
+		policies: policies,
+		metrics:  metrics,
+		clock:    clock,
+	}
+}
+
+// DryRun reports what the next purge would delete without deleting anything.
+func (q *retentionQueriesImpl) DryRun(ctx context.Context) (*RetentionReport, error) {
+	now := q.clock.Now()
+	report := &RetentionReport{
+		GeneratedAt: now,
+		BatchSize:   q.policies.BatchSize,
+		Tables:      make([]RetentionTableReport, 0, len(q.policies.Policies)),
+	}
+
+	for _, policy := range q.policies.Policies {
+		cutoff := now.Add(-policy.MaxAge)
+		count, err := q.repo.CountExpired(ctx, q.uow.DB(ctx), policy.Table, cutoff)
+		if err != nil {
+			return nil, errs.Mark(err, ErrRetentionQueryFailed)
+		}
+
+		stats := q.metrics.Stats(policy.Table)
+		report.Tables = append(report.Tables, RetentionTableReport{
+			Table:          policy.Table,
+			MaxAge:         policy.MaxAge,
+			Cutoff:         cutoff,
+			EligibleRows:   count,
+			LastRunAt:      stats.LastRunAt,
+			LastRunDeleted: stats.LastRunDeleted,
+			TotalDeleted:   stats.TotalDeleted,
+		})
+	}
+
+	return report, nil
+}
@@ -2,34 +2,51 @@ package queries
 
 import (
 	"context"
+	"crypto/sha256"
+	"fmt"
 	"time"
 
+	domreview "gin-clean-starter/internal/domain/review"
 	"gin-clean-starter/internal/infra"
 	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
 	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/pkg/keyset"
 	"gin-clean-starter/internal/usecase/shared"
 
 	"github.com/google/uuid"
 )
 
 var (
-	ErrReviewNotFound     = errs.New("review not found")
-	ErrReviewAccess       = errs.New("review access denied")
+	ErrReviewNotFound     = errs.NewCoded("REVIEW_NOT_FOUND", "review not found")
+	ErrReviewAccess       = errs.NewCoded("REVIEW_ACCESS_DENIED", "review access denied")
 	ErrReviewQueryFailed  = errs.New("review query failed")
-	ErrInvalidCursorQuery = errs.New("invalid cursor for review query")
+	ErrInvalidCursorQuery = errs.NewCoded("INVALID_CURSOR", "invalid cursor for review query")
+	ErrReviewModeration   = errs.NewCoded("PERMISSION_DENIED", "permission denied")
+	ErrReviewFeedResource = errs.NewCoded("RESOURCE_NOT_FOUND", "resource not found")
 )
 
+// maxFlaggedReviews caps one page of a resource's moderation queue.
+const maxFlaggedReviews = 100
+
+// reviewFeedSize is how many of the newest published reviews a resource feed carries.
+const reviewFeedSize = 50
+
+// exportBatchSize is how many rows an export reads per query.
+const exportBatchSize = 500
+
 type ReviewView struct {
-	ID            uuid.UUID `json:"id"`
-	UserID        uuid.UUID `json:"userId"`
-	UserEmail     string    `json:"userEmail"`
-	ResourceID    uuid.UUID `json:"resourceId"`
-	ResourceName  string    `json:"resourceName"`
-	ReservationID uuid.UUID `json:"reservationId"`
-	Rating        int32     `json:"rating"`
-	Comment       string    `json:"comment"`
-	CreatedAt     time.Time `json:"createdAt"`
-	UpdatedAt     time.Time `json:"updatedAt"`
+	ID            uuid.UUID  `json:"id"`
+	UserID        uuid.UUID  `json:"userId"`
+	UserEmail     string     `json:"userEmail"`
+	ResourceID    uuid.UUID  `json:"resourceId"`
+	ResourceName  string     `json:"resourceName"`
+	ReservationID *uuid.UUID `json:"reservationId"` // nil for imported reviews
+	Rating        int32      `json:"rating"`
+	Comment       string     `json:"comment"`
+	Status        string     `json:"status"`
+	Language      *string    `json:"language"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	UpdatedAt     time.Time  `json:"updatedAt"`
 }
 
 type ReviewListItem struct {
@@ -37,7 +54,53 @@ type ReviewListItem struct {
 	UserEmail string    `json:"userEmail"`
 	Rating    int32     `json:"rating"`
 	Comment   string    `json:"comment"`
+	Language  *string   `json:"language"`
 	CreatedAt time.Time `json:"createdAt"`
+	// Status is only set in per-user listings; resource listings hold published reviews only.
+	Status string `json:"status,omitempty"`
+}
+
+// FlaggedReview is a review held for moderation as a near copy of DuplicateOf.
+type FlaggedReview struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	UserEmail   string
+	Rating      int32
+	Comment     string
+	DuplicateOf *uuid.UUID
+	CreatedAt   time.Time
+}
+
+// ReviewExportItem is one of a user's reviews as written to their data export.
+type ReviewExportItem struct {
+	ID            uuid.UUID
+	ResourceID    uuid.UUID
+	ResourceName  string
+	ReservationID *uuid.UUID
+	Rating        int32
+	Comment       string
+	Status        string
+	Language      *string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// ReviewFeed is a resource's newest published reviews, newest first. Updated is the latest
+// UpdatedAt among them and zero when the resource has none.
+type ReviewFeed struct {
+	ResourceID   uuid.UUID
+	ResourceName string
+	Updated      time.Time
+	Entries      []*ReviewFeedEntry
+}
+
+type ReviewFeedEntry struct {
+	ID        uuid.UUID
+	Rating    int32
+	Comment   string
+	Language  *string
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
 type ResourceRatingStats struct {
@@ -50,36 +113,59 @@ type ResourceRatingStats struct {
 	Rating4Count  int32     `json:"rating4Count"`
 	Rating5Count  int32     `json:"rating5Count"`
 	UpdatedAt     time.Time `json:"updatedAt"`
+	Summary       *string   `json:"summary"`
+	// LanguageCounts counts published reviews per detected language.
+	LanguageCounts map[string]int32 `json:"languageCounts"`
 }
 
 type ReviewFilters struct {
 	MinRating *int
 	MaxRating *int
+	Language  *string
 }
 
 type ReviewReadStore interface {
 	FindByID(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (*ReviewView, error)
-	FindByResourceFirstPage(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID, limit int32, minRating, maxRating *int) ([]*ReviewListItem, error)
-	FindByResourceKeyset(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID, lastCreatedAt time.Time, lastID uuid.UUID, limit int32, minRating, maxRating *int) ([]*ReviewListItem, error)
+	FindByResourceFirstPage(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID, limit int32, filters ReviewFilters) ([]*ReviewListItem, error)
+	FindByResourceKeyset(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID, lastCreatedAt time.Time, lastID uuid.UUID, limit int32, filters ReviewFilters) ([]*ReviewListItem, error)
 	FindByUserFirstPage(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, limit int32) ([]*ReviewListItem, error)
 	FindByUserKeyset(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, lastCreatedAt time.Time, lastID uuid.UUID, limit int32) ([]*ReviewListItem, error)
 	GetResourceRatingStats(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID) (*ResourceRatingStats, error)
+	FindFlaggedByResource(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID, limit int32) ([]*FlaggedReview, error)
+	FindForExport(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, afterCreatedAt time.Time, afterID uuid.UUID, limit int32) ([]*ReviewExportItem, error)
+	FindRecentPublished(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID, limit int32) ([]*ReviewFeedEntry, error)
 }
 
 type ReviewQueries interface {
+	// GetByID returns the review whatever its status; GetPublishedByID hides flagged ones.
 	GetByID(ctx context.Context, id uuid.UUID) (*ReviewView, error)
+	GetPublishedByID(ctx context.Context, id uuid.UUID) (*ReviewView, error)
 	ListByResource(ctx context.Context, resourceID uuid.UUID, filters ReviewFilters, cursor *Cursor, limit int) ([]*ReviewListItem, *Cursor, error)
 	ListByUser(ctx context.Context, userID uuid.UUID, actorID uuid.UUID, actorRole string, cursor *Cursor, limit int) ([]*ReviewListItem, *Cursor, error)
 	GetResourceRatingStats(ctx context.Context, resourceID uuid.UUID) (*ResourceRatingStats, error)
+	// ListFlagged returns the resource's moderation queue, oldest first, to actors allowed
+	// to delete its reviews.
+	ListFlagged(ctx context.Context, resourceID uuid.UUID, actorID uuid.UUID, actorRole string) ([]*FlaggedReview, error)
+	// ExportByUser passes every review of the user, flagged ones included, to emit oldest
+	// first, reading them in batches. An error from emit stops the export and is returned.
+	ExportByUser(ctx context.Context, userID uuid.UUID, emit func(*ReviewExportItem) error) error
+	// Feed returns the resource's newest published reviews for its public Atom feed.
+	Feed(ctx context.Context, resourceID uuid.UUID) (*ReviewFeed, error)
+	// GenerateFeedETag changes whenever a feed entry is added, edited or dropped, or the
+	// resource is renamed.
+	GenerateFeedETag(feed *ReviewFeed) string
 }
 
 type reviewQueriesImpl struct {
-	uow  shared.UnitOfWork
-	repo ReviewReadStore
+	uow         shared.UnitOfWork
+	repo        ReviewReadStore
+	permissions shared.PermissionResolver
+	authorizer  shared.ResourceAuthorizer
+	resources   shared.ResourceReadStore
 }
 
-func NewReviewQueries(uow shared.UnitOfWork, rs ReviewReadStore) ReviewQueries {
-	return &reviewQueriesImpl{uow: uow, repo: rs}
+func NewReviewQueries(uow shared.UnitOfWork, rs ReviewReadStore, permissions shared.PermissionResolver, authorizer shared.ResourceAuthorizer, resources shared.ResourceReadStore) ReviewQueries {
+	return &reviewQueriesImpl{uow: uow, repo: rs, permissions: permissions, authorizer: authorizer, resources: resources}
 }
 
 func (q *reviewQueriesImpl) GetByID(ctx context.Context, id uuid.UUID) (*ReviewView, error) {
@@ -94,66 +180,66 @@ func (q *reviewQueriesImpl) GetByID(ctx context.Context, id uuid.UUID) (*ReviewV
 	return rv, nil
 }
 
-func (q *reviewQueriesImpl) ListByResource(ctx context.Context, resourceID uuid.UUID, filters ReviewFilters, cursor *Cursor, limit int) ([]*ReviewListItem, *Cursor, error) {
-	limit = ValidateLimit(limit)
-	var rows []*ReviewListItem
-	var err error
-	db := q.uow.DB(ctx)
-	if cursor == nil || cursor.After == "" {
-		rows, err = q.repo.FindByResourceFirstPage(ctx, db, resourceID, ToPgFetchLimit(limit), filters.MinRating, filters.MaxRating)
-	} else {
-		lastCreatedAt, lastID, derr := DecodeAfterCursor(cursor.After)
-		if derr != nil {
-			return nil, nil, errs.Mark(derr, ErrInvalidCursorQuery)
-		}
-		rows, err = q.repo.FindByResourceKeyset(ctx, db, resourceID, lastCreatedAt, lastID, ToPgFetchLimit(limit), filters.MinRating, filters.MaxRating)
+func (q *reviewQueriesImpl) GetPublishedByID(ctx context.Context, id uuid.UUID) (*ReviewView, error) {
+	rv, err := q.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
 	}
+	if rv.Status != string(domreview.StatusPublished) {
+		return nil, ErrReviewNotFound
+	}
+	return rv, nil
+}
+
+func (q *reviewQueriesImpl) ListFlagged(ctx context.Context, resourceID uuid.UUID, actorID uuid.UUID, actorRole string) ([]*FlaggedReview, error) {
+	allowed, err := q.authorizer.CanActOnResource(ctx, actorID, actorRole, shared.PermissionReviewsDeleteAny, shared.PermissionReviewsDeleteAssigned, resourceID)
 	if err != nil {
-		return nil, nil, errs.Mark(err, ErrReviewQueryFailed)
+		return nil, errs.Mark(err, ErrReviewQueryFailed)
 	}
-	var next *Cursor
-	if len(rows) > limit {
-		last := rows[limit-1]
-		next = &Cursor{After: EncodeAfterCursor(last.CreatedAt, last.ID)}
-		rows = rows[:limit]
+	if !allowed {
+		return nil, ErrReviewModeration
 	}
-	return rows, next, nil
+	flagged, err := q.repo.FindFlaggedByResource(ctx, q.uow.DB(ctx), resourceID, maxFlaggedReviews)
+	if err != nil {
+		return nil, errs.Mark(err, ErrReviewQueryFailed)
+	}
+	return flagged, nil
+}
+
+func (q *reviewQueriesImpl) ListByResource(ctx context.Context, resourceID uuid.UUID, filters ReviewFilters, cursor *Cursor, limit int) ([]*ReviewListItem, *Cursor, error) {
+	db := q.uow.DB(ctx)
+	return listPage(cursor, limit, ErrInvalidCursorQuery, ErrReviewQueryFailed, reviewPosition,
+		func(last *keyset.Position, n int32) ([]*ReviewListItem, error) {
+			if last == nil {
+				return q.repo.FindByResourceFirstPage(ctx, db, resourceID, n, filters)
+			}
+			return q.repo.FindByResourceKeyset(ctx, db, resourceID, last.CreatedAt, last.ID, n, filters)
+		})
 }
 
 func (q *reviewQueriesImpl) ListByUser(ctx context.Context, userID uuid.UUID, actorID uuid.UUID, actorRole string, cursor *Cursor, limit int) ([]*ReviewListItem, *Cursor, error) {
-	switch actorRole {
-	case RoleAdmin, RoleOperator:
-	case RoleViewer:
-		if userID != actorID {
+	if userID != actorID {
+		ok, err := q.permissions.HasPermission(ctx, actorRole, shared.PermissionReviewsReadAny)
+		if err != nil {
+			return nil, nil, errs.Mark(err, ErrReviewQueryFailed)
+		}
+		if !ok {
 			return nil, nil, ErrReviewAccess
 		}
-	default:
-		return nil, nil, ErrReviewAccess
 	}
 
-	limit = ValidateLimit(limit)
-	var rows []*ReviewListItem
-	var err error
 	db := q.uow.DB(ctx)
-	if cursor == nil || cursor.After == "" {
-		rows, err = q.repo.FindByUserFirstPage(ctx, db, userID, ToPgFetchLimit(limit))
-	} else {
-		lastCreatedAt, lastID, derr := DecodeAfterCursor(cursor.After)
-		if derr != nil {
-			return nil, nil, errs.Mark(derr, ErrInvalidCursorQuery)
-		}
-		rows, err = q.repo.FindByUserKeyset(ctx, db, userID, lastCreatedAt, lastID, ToPgFetchLimit(limit))
-	}
-	if err != nil {
-		return nil, nil, errs.Mark(err, ErrReviewQueryFailed)
-	}
-	var next *Cursor
-	if len(rows) > limit {
-		last := rows[limit-1]
-		next = &Cursor{After: EncodeAfterCursor(last.CreatedAt, last.ID)}
-		rows = rows[:limit]
-	}
-	return rows, next, nil
+	return listPage(cursor, limit, ErrInvalidCursorQuery, ErrReviewQueryFailed, reviewPosition,
+		func(last *keyset.Position, n int32) ([]*ReviewListItem, error) {
+			if last == nil {
+				return q.repo.FindByUserFirstPage(ctx, db, userID, n)
+			}
+			return q.repo.FindByUserKeyset(ctx, db, userID, last.CreatedAt, last.ID, n)
+		})
+}
+
+func reviewPosition(r *ReviewListItem) keyset.Position {
+	return keyset.Position{CreatedAt: r.CreatedAt, ID: r.ID}
 }
 
 func (q *reviewQueriesImpl) GetResourceRatingStats(ctx context.Context, resourceID uuid.UUID) (*ResourceRatingStats, error) {
@@ -164,3 +250,58 @@ func (q *reviewQueriesImpl) GetResourceRatingStats(ctx context.Context, resource
 	}
 	return stats, nil
 }
+
+func (q *reviewQueriesImpl) ExportByUser(ctx context.Context, userID uuid.UUID, emit func(*ReviewExportItem) error) error {
+	var afterCreatedAt time.Time
+	var afterID uuid.UUID
+	for {
+		batch, err := q.repo.FindForExport(ctx, q.uow.DB(ctx), userID, afterCreatedAt, afterID, exportBatchSize)
+		if err != nil {
+			return errs.Mark(err, ErrReviewQueryFailed)
+		}
+		for _, item := range batch {
+			if err := emit(item); err != nil {
+				return err
+			}
+		}
+		if len(batch) < exportBatchSize {
+			return nil
+		}
+		last := batch[len(batch)-1]
+		afterCreatedAt, afterID = last.CreatedAt, last.ID
+	}
+}
+
+func (q *reviewQueriesImpl) Feed(ctx context.Context, resourceID uuid.UUID) (*ReviewFeed, error) {
+	db := q.uow.ReadDB(ctx)
+	resource, err := q.resources.FindByID(ctx, db, resourceID)
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return nil, errs.Mark(err, ErrReviewFeedResource)
+		}
+		return nil, errs.Mark(err, ErrReviewQueryFailed)
+	}
+
+	entries, err := q.repo.FindRecentPublished(ctx, db, resourceID, reviewFeedSize)
+	if err != nil {
+		return nil, errs.Mark(err, ErrReviewQueryFailed)
+	}
+	feed := &ReviewFeed{ResourceID: resourceID, ResourceName: resource.Name, Entries: entries}
+	for _, e := range entries {
+		if e.UpdatedAt.After(feed.Updated) {
+			feed.Updated = e.UpdatedAt
+		}
+	}
+	return feed, nil
+}
+
+// GenerateFeedETag hashes every entry's id and updated_at: a deleted or re-flagged review
+// need not move the newest timestamp, so Updated alone would miss it.
+func (q *reviewQueriesImpl) GenerateFeedETag(feed *ReviewFeed) string {
+	h := sha256.New()
+	h.Write([]byte(feed.ResourceName))
+	for _, e := range feed.Entries {
+		fmt.Fprintf(h, "|%s-%d", e.ID, e.UpdatedAt.UnixMicro())
+	}
+	return fmt.Sprintf("W/\"%s-%x\"", feed.ResourceID, h.Sum(nil)[:8])
+}
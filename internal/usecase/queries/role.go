@@ -0,0 +1,81 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+)
+
+var (
+	ErrRoleNotFound    = errs.NewCoded("ROLE_NOT_FOUND", "role not found")
+	ErrRoleQueryFailed = errs.New("role query failed")
+)
+
+type RoleView struct {
+	Name        string
+	Description string
+	IsSystem    bool
+	Permissions []string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type PermissionView struct {
+	Name        string
+	Description string
+}
+
+type RoleReadStore interface {
+	FindByName(ctx context.Context, db sqlc.DBTX, name string) (*RoleView, error)
+	List(ctx context.Context, db sqlc.DBTX) ([]*RoleView, error)
+	ListPermissions(ctx context.Context, db sqlc.DBTX) ([]*PermissionView, error)
+}
+
+type RoleQueries interface {
+	Get(ctx context.Context, name string) (*RoleView, error)
+	List(ctx context.Context) ([]*RoleView, error)
+	ListPermissions(ctx context.Context) ([]*PermissionView, error)
+}
+
+type roleQueriesImpl struct {
+	uow       shared.UnitOfWork
+	readStore RoleReadStore
+}
+
+func NewRoleQueries(uow shared.UnitOfWork, readStore RoleReadStore) RoleQueries {
+	return &roleQueriesImpl{
+		uow:       uow,
+		readStore: readStore,
+	}
+}
+
+func (q *roleQueriesImpl) Get(ctx context.Context, name string) (*RoleView, error) {
+	role, err := q.readStore.FindByName(ctx, q.uow.DB(ctx), name)
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return nil, ErrRoleNotFound
+		}
+		return nil, errs.Mark(err, ErrRoleQueryFailed)
+	}
+	return role, nil
+}
+
+func (q *roleQueriesImpl) List(ctx context.Context) ([]*RoleView, error) {
+	roles, err := q.readStore.List(ctx, q.uow.DB(ctx))
+	if err != nil {
+		return nil, errs.Mark(err, ErrRoleQueryFailed)
+	}
+	return roles, nil
+}
+
+func (q *roleQueriesImpl) ListPermissions(ctx context.Context) ([]*PermissionView, error) {
+	permissions, err := q.readStore.ListPermissions(ctx, q.uow.DB(ctx))
+	if err != nil {
+		return nil, errs.Mark(err, ErrRoleQueryFailed)
+	}
+	return permissions, nil
+}
@@ -0,0 +1,66 @@
+package queries
+
+import (
+	"context"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrSAMLConnectionNotFound = errs.NewCoded("SAML_CONNECTION_NOT_FOUND", "company has no SAML connection")
+	ErrSAMLQueryFailed        = errs.New("SAML query failed")
+)
+
+// SAMLConnectionView is a company's SAML connection with the service provider URLs its IdP
+// needs.
+type SAMLConnectionView struct {
+	Connection shared.SAMLConnection
+	Endpoints  shared.SAMLEndpoints
+}
+
+type SAMLQueries interface {
+	GetConnection(ctx context.Context, companyID uuid.UUID) (*SAMLConnectionView, error)
+	// Metadata is the company's SP metadata XML; it needs no connection, so an IdP can be
+	// set up before its metadata is uploaded.
+	Metadata(ctx context.Context, companyID uuid.UUID) ([]byte, error)
+}
+
+type samlQueriesImpl struct {
+	uow      shared.UnitOfWork
+	store    shared.SAMLConnectionReadStore
+	provider shared.SAMLServiceProvider
+}
+
+func NewSAMLQueries(uow shared.UnitOfWork, store shared.SAMLConnectionReadStore, provider shared.SAMLServiceProvider) SAMLQueries {
+	return &samlQueriesImpl{
+		uow:      uow,
+		store:    store,
+		provider: provider,
+	}
+}
+
+func (q *samlQueriesImpl) GetConnection(ctx context.Context, companyID uuid.UUID) (*SAMLConnectionView, error) {
+	conn, err := q.store.FindSAMLConnection(ctx, q.uow.DB(ctx), companyID)
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return nil, errs.Mark(err, ErrSAMLConnectionNotFound)
+		}
+		return nil, errs.Mark(err, ErrSAMLQueryFailed)
+	}
+	return &SAMLConnectionView{
+		Connection: *conn,
+		Endpoints:  q.provider.Endpoints(companyID),
+	}, nil
+}
+
+func (q *samlQueriesImpl) Metadata(_ context.Context, companyID uuid.UUID) ([]byte, error) {
+	metadata, err := q.provider.Metadata(companyID)
+	if err != nil {
+		return nil, errs.Mark(err, ErrSAMLQueryFailed)
+	}
+	return metadata, nil
+}
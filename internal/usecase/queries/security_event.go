@@ -0,0 +1,60 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/pkg/keyset"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+var ErrSecurityEventQueryFailed = errs.New("security event query failed")
+
+// SecurityEvent is an audit entry about the user's own account (see shared.SecurityEventActions).
+type SecurityEvent struct {
+	ID        uuid.UUID
+	Action    string
+	IPAddress string // empty when the event did not come from a request
+	UserAgent string
+	CreatedAt time.Time
+}
+
+type SecurityEventReadStore interface {
+	FindFirstPage(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, limit int32) ([]*SecurityEvent, error)
+	FindKeyset(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, lastCreatedAt time.Time, lastID uuid.UUID, limit int32) ([]*SecurityEvent, error)
+}
+
+type SecurityEventQueries interface {
+	// ListMine lists the user's security events newest first. Events are audit entries,
+	// so they age out with the audit log retention policy.
+	ListMine(ctx context.Context, userID uuid.UUID, after *Cursor, limit int) ([]*SecurityEvent, *Cursor, error)
+}
+
+type securityEventQueriesImpl struct {
+	uow       shared.UnitOfWork
+	readStore SecurityEventReadStore
+}
+
+func NewSecurityEventQueries(uow shared.UnitOfWork, readStore SecurityEventReadStore) SecurityEventQueries {
+	return &securityEventQueriesImpl{
+		uow:       uow,
+		readStore: readStore,
+	}
+}
+
+func (q *securityEventQueriesImpl) ListMine(ctx context.Context, userID uuid.UUID, after *Cursor, limit int) ([]*SecurityEvent, *Cursor, error) {
+	db := q.uow.DB(ctx)
+
+	return listPage(after, limit, ErrInvalidCursor, ErrSecurityEventQueryFailed,
+		func(e *SecurityEvent) keyset.Position { return keyset.Position{CreatedAt: e.CreatedAt, ID: e.ID} },
+		func(last *keyset.Position, n int32) ([]*SecurityEvent, error) {
+			if last == nil {
+				return q.readStore.FindFirstPage(ctx, db, userID, n)
+			}
+			return q.readStore.FindKeyset(ctx, db, userID, last.CreatedAt, last.ID, n)
+		})
+}
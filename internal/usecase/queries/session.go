@@ -0,0 +1,61 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+var ErrSessionQueryFailed = errs.New("session query failed")
+
+// SessionView is a live login session as its user lists it: the client its latest token
+// was issued to, and when.
+type SessionView struct {
+	ID                uuid.UUID
+	DeviceFingerprint string
+	IPAddress         string
+	UserAgent         string
+	CreatedAt         time.Time
+	LastSeenAt        time.Time
+	ExpiresAt         time.Time
+}
+
+type SessionReadStore interface {
+	// ListLive returns the user's sessions that are neither revoked nor expired at now,
+	// most recently seen first.
+	ListLive(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, now time.Time) ([]*SessionView, error)
+}
+
+type SessionQueries interface {
+	// ListByUser returns the user's live sessions, most recently seen first.
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]*SessionView, error)
+}
+
+type sessionQueriesImpl struct {
+	uow       shared.UnitOfWork
+	clock     clock.Clock
+	readStore SessionReadStore
+}
+
+func NewSessionQueries(uow shared.UnitOfWork, clock clock.Clock, readStore SessionReadStore) SessionQueries {
+	return &sessionQueriesImpl{
+		uow:       uow,
+		clock:     clock,
+		readStore: readStore,
+	}
+}
+
+// ListByUser reads the primary so a session signed out a moment ago is not listed again.
+func (q *sessionQueriesImpl) ListByUser(ctx context.Context, userID uuid.UUID) ([]*SessionView, error) {
+	sessions, err := q.readStore.ListLive(ctx, q.uow.DB(ctx), userID, q.clock.Now())
+	if err != nil {
+		return nil, errs.Mark(err, ErrSessionQueryFailed)
+	}
+	return sessions, nil
+}
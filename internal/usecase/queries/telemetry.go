@@ -0,0 +1,92 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+)
+
+var (
+	ErrAdoptionRangeInvalid = errs.NewCoded("INVALID_ADOPTION_RANGE", "adoption report range is invalid")
+	ErrAdoptionQueryFailed  = errs.New("adoption query failed")
+)
+
+// Longest range one adoption report may cover.
+const maxAdoptionRange = 366 * 24 * time.Hour
+
+// FeatureAdoption is how many requests reached a feature and from how many companies.
+type FeatureAdoption struct {
+	Feature   string
+	Requests  int64
+	Companies int64
+}
+
+type AdoptionReport struct {
+	From     time.Time
+	To       time.Time // inclusive
+	Features []*FeatureAdoption
+}
+
+type TelemetryReadStore interface {
+	ListAdoption(ctx context.Context, db sqlc.DBTX, from, to time.Time) ([]*FeatureAdoption, error)
+}
+
+type TelemetryQueries interface {
+	// Adoption reports feature usage between from and to (both days inclusive, UTC),
+	// most widely adopted first. It defaults to the 30 days up to today.
+	Adoption(ctx context.Context, from, to *time.Time) (*AdoptionReport, error)
+	// LoginReplays counts duplicate login submissions since the process started.
+	LoginReplays() shared.LoginReplayStats
+	// CanceledRequests counts requests abandoned by their client since the process started.
+	CanceledRequests() shared.CanceledRequestStats
+}
+
+type telemetryQueriesImpl struct {
+	uow       shared.UnitOfWork
+	readStore TelemetryReadStore
+	clock     clock.Clock
+	replays   *shared.LoginReplayMetrics
+	canceled  *shared.CanceledRequestMetrics
+}
+
+func NewTelemetryQueries(uow shared.UnitOfWork, readStore TelemetryReadStore, clock clock.Clock, replays *shared.LoginReplayMetrics, canceled *shared.CanceledRequestMetrics) TelemetryQueries {
+	return &telemetryQueriesImpl{
+		uow:       uow,
+		readStore: readStore,
+		clock:     clock,
+		replays:   replays,
+		canceled:  canceled,
+	}
+}
+
+func (q *telemetryQueriesImpl) Adoption(ctx context.Context, from, to *time.Time) (*AdoptionReport, error) {
+	end := shared.TelemetryDay(q.clock.Now())
+	if to != nil {
+		end = shared.TelemetryDay(*to)
+	}
+	start := end.AddDate(0, 0, -29)
+	if from != nil {
+		start = shared.TelemetryDay(*from)
+	}
+	if start.After(end) || end.Sub(start) > maxAdoptionRange {
+		return nil, ErrAdoptionRangeInvalid
+	}
+
+	features, err := q.readStore.ListAdoption(ctx, q.uow.ReadDB(ctx), start, end)
+	if err != nil {
+		return nil, errs.Mark(err, ErrAdoptionQueryFailed)
+	}
+	return &AdoptionReport{From: start, To: end, Features: features}, nil
+}
+
+func (q *telemetryQueriesImpl) LoginReplays() shared.LoginReplayStats {
+	return q.replays.Stats()
+}
+
+func (q *telemetryQueriesImpl) CanceledRequests() shared.CanceledRequestStats {
+	return q.canceled.Stats()
+}
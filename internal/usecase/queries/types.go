@@ -13,6 +13,7 @@ type AuthorizedUserView struct {
 	Role      string     `json:"role"`
 	CompanyID *uuid.UUID `json:"company_id,omitempty"`
 	IsActive  bool       `json:"is_active"`
+	Phone     *string    `json:"phone,omitempty"`
 }
 
 // IdempotencyKeyView represents read-optimized idempotency key data
@@ -62,4 +63,6 @@ type NotificationJobView struct {
 	LastError *string   `json:"last_error,omitempty"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+	// UserID is the recipient of jobs addressed to a single user.
+	UserID *uuid.UUID `json:"user_id,omitempty"`
 }
@@ -0,0 +1,69 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+var ErrUsageQueryFailed = errs.New("usage query failed")
+
+// CompanyUsage is one company's metered API usage for a month; nil quotas are unlimited.
+type CompanyUsage struct {
+	CompanyID   uuid.UUID
+	CompanyName string
+	Requests    int64
+	BytesIn     int64
+	BytesOut    int64
+	SoftQuota   *int64
+	HardQuota   *int64
+	UpdatedAt   time.Time
+}
+
+type UsageReport struct {
+	Month     time.Time // first day of the month, UTC
+	Companies []*CompanyUsage
+}
+
+type UsageReadStore interface {
+	ListByMonth(ctx context.Context, db sqlc.DBTX, month time.Time, companyID *uuid.UUID) ([]*CompanyUsage, error)
+}
+
+type UsageQueries interface {
+	// Report lists usage for month (the current month when nil), busiest company first,
+	// optionally narrowed to one company. Companies without usage that month are omitted.
+	Report(ctx context.Context, month *time.Time, companyID *uuid.UUID) (*UsageReport, error)
+}
+
+type usageQueriesImpl struct {
+	uow       shared.UnitOfWork
+	readStore UsageReadStore
+	clock     clock.Clock
+}
+
+func NewUsageQueries(uow shared.UnitOfWork, readStore UsageReadStore, clock clock.Clock) UsageQueries {
+	return &usageQueriesImpl{
+		uow:       uow,
+		readStore: readStore,
+		clock:     clock,
+	}
+}
+
+func (q *usageQueriesImpl) Report(ctx context.Context, month *time.Time, companyID *uuid.UUID) (*UsageReport, error) {
+	m := shared.UsageMonth(q.clock.Now())
+	if month != nil {
+		m = shared.UsageMonth(*month)
+	}
+
+	companies, err := q.readStore.ListByMonth(ctx, q.uow.ReadDB(ctx), m, companyID)
+	if err != nil {
+		return nil, errs.Mark(err, ErrUsageQueryFailed)
+	}
+	return &UsageReport{Month: m, Companies: companies}, nil
+}
@@ -12,9 +12,9 @@ import (
 )
 
 var (
-	ErrUserNotFound = errs.New("user not found")
-	ErrUserInactive = errs.New("user inactive")
-	ErrUserAccess   = errs.New("user access denied")
+	ErrUserNotFound = errs.NewCoded("USER_NOT_FOUND", "user not found")
+	ErrUserInactive = errs.NewCoded("USER_INACTIVE", "user inactive")
+	ErrUserAccess   = errs.NewCoded("USER_ACCESS_DENIED", "user access denied")
 )
 
 type UserQueries interface {
@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+// requestRecorderImpl caches the set of users being recorded for ttl, so a recording
+// starts or ends within ttl on other instances and immediately on this one. Only requests
+// of users in the set reach the database to claim a capture.
+type requestRecorderImpl struct {
+	uow     shared.UnitOfWork
+	store   shared.RequestRecordingReadStore
+	repo    shared.RequestRecordingRepository
+	storage shared.FileStorage
+	clock   clock.Clock
+	ttl     time.Duration
+
+	mu        sync.RWMutex
+	users     map[uuid.UUID]struct{}
+	expiresAt time.Time
+}
+
+func NewRequestRecorder(uow shared.UnitOfWork, store shared.RequestRecordingReadStore, repo shared.RequestRecordingRepository, storage shared.FileStorage, clock clock.Clock, ttl time.Duration) shared.RequestRecorder {
+	return &requestRecorderImpl{
+		uow:     uow,
+		store:   store,
+		repo:    repo,
+		storage: storage,
+		clock:   clock,
+		ttl:     ttl,
+	}
+}
+
+func (r *requestRecorderImpl) Claim(ctx context.Context, userID uuid.UUID) (*shared.RecordingSlot, error) {
+	recorded, err := r.recorded(ctx, userID)
+	if err != nil || !recorded {
+		return nil, err
+	}
+
+	slot, err := r.repo.ClaimSlot(ctx, r.uow.DB(ctx), userID, r.clock.Now())
+	if err != nil {
+		if infra.IsKind(err, infra.KindNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &slot, nil
+}
+
+// Save writes the document before its row, so a listed capture can always be opened.
+func (r *requestRecorderImpl) Save(ctx context.Context, slot shared.RecordingSlot, capture shared.RequestCapture) error {
+	key := recordingCaptureKey(slot)
+	if err := r.storage.Put(ctx, key, bytes.NewReader(capture.Document)); err != nil {
+		return err
+	}
+	return r.repo.AddCapture(ctx, r.uow.DB(ctx), shared.RecordedRequest{
+		RecordingID: slot.RecordingID,
+		Seq:         slot.Seq,
+		Method:      capture.Method,
+		Path:        capture.Path,
+		Status:      int32(capture.Status),
+		DurationMs:  int32(min(capture.Duration.Milliseconds(), int64(1<<31-1))),
+		StorageKey:  key,
+		RecordedAt:  capture.RecordedAt,
+	})
+}
+
+func (r *requestRecorderImpl) Invalidate() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.users = nil
+}
+
+func (r *requestRecorderImpl) recorded(ctx context.Context, userID uuid.UUID) (bool, error) {
+	now := r.clock.Now()
+
+	r.mu.RLock()
+	users, expiresAt := r.users, r.expiresAt
+	r.mu.RUnlock()
+	if users != nil && now.Before(expiresAt) {
+		_, ok := users[userID]
+		return ok, nil
+	}
+
+	ids, err := r.store.ListActiveUsers(ctx, r.uow.DB(ctx), now)
+	if err != nil {
+		return false, err
+	}
+	users = make(map[uuid.UUID]struct{}, len(ids))
+	for _, id := range ids {
+		users[id] = struct{}{}
+	}
+
+	r.mu.Lock()
+	r.users, r.expiresAt = users, now.Add(r.ttl)
+	r.mu.Unlock()
+
+	_, ok := users[userID]
+	return ok, nil
+}
+
+// recordingCaptureKey is where the document of a capture is kept in file storage.
+func recordingCaptureKey(slot shared.RecordingSlot) string {
+	return path.Join("request-recordings", slot.RecordingID.String(), strconv.Itoa(int(slot.Seq))+".json")
+}
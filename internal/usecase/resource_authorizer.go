@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+type resourceAuthorizerImpl struct {
+	uow         shared.UnitOfWork
+	permissions shared.PermissionResolver
+	assignments shared.ResourceAssignmentReadStore
+}
+
+func NewResourceAuthorizer(uow shared.UnitOfWork, permissions shared.PermissionResolver, assignments shared.ResourceAssignmentReadStore) shared.ResourceAuthorizer {
+	return &resourceAuthorizerImpl{
+		uow:         uow,
+		permissions: permissions,
+		assignments: assignments,
+	}
+}
+
+func (a *resourceAuthorizerImpl) CanActOnResource(ctx context.Context, actorID uuid.UUID, role string, anyPermission, assignedPermission string, resourceID uuid.UUID) (bool, error) {
+	ok, err := a.permissions.HasPermission(ctx, role, anyPermission)
+	if err != nil || ok {
+		return ok, err
+	}
+
+	ok, err = a.permissions.HasPermission(ctx, role, assignedPermission)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	// Assignments are read fresh: revoking one takes effect immediately.
+	return a.assignments.IsAssigned(ctx, a.uow.DB(ctx), resourceID, actorID)
+}
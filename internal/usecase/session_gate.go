@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+type cachedSession struct {
+	revoked   bool
+	expiresAt time.Time
+}
+
+// sessionGateImpl caches revocations for ttl, by session or, for tokens without one, by
+// jti, so revoked access tokens are refused within ttl without a lookup on every request.
+type sessionGateImpl struct {
+	uow   shared.UnitOfWork
+	store shared.RefreshTokenReadStore
+	clock clock.Clock
+	ttl   time.Duration
+
+	mu        sync.RWMutex
+	cache     map[uuid.UUID]cachedSession
+	lastSweep time.Time
+}
+
+func NewSessionGate(uow shared.UnitOfWork, store shared.RefreshTokenReadStore, clock clock.Clock, ttl time.Duration) shared.SessionGate {
+	return &sessionGateImpl{
+		uow:   uow,
+		store: store,
+		clock: clock,
+		ttl:   ttl,
+		cache: make(map[uuid.UUID]cachedSession),
+	}
+}
+
+func (g *sessionGateImpl) IsRevoked(ctx context.Context, token shared.IssuedToken) (bool, error) {
+	now := g.clock.Now()
+	key := token.ID
+	if token.SessionID != nil {
+		key = *token.SessionID
+	}
+
+	g.mu.RLock()
+	entry, ok := g.cache[key]
+	g.mu.RUnlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.revoked, nil
+	}
+
+	var (
+		revoked bool
+		err     error
+	)
+	if token.SessionID != nil {
+		revoked, err = g.store.IsSessionRevoked(ctx, g.uow.DB(ctx), *token.SessionID)
+	} else {
+		revoked, err = g.store.IsTokenRevoked(ctx, g.uow.DB(ctx), token)
+	}
+	if err != nil {
+		return false, err
+	}
+	if g.ttl <= 0 {
+		return revoked, nil
+	}
+
+	g.mu.Lock()
+	g.sweep(now)
+	g.cache[key] = cachedSession{revoked: revoked, expiresAt: now.Add(g.ttl)}
+	g.mu.Unlock()
+
+	return revoked, nil
+}
+
+// sweep drops expired entries at most once per ttl; callers hold mu.
+func (g *sessionGateImpl) sweep(now time.Time) {
+	if now.Sub(g.lastSweep) < g.ttl {
+		return
+	}
+	g.lastSweep = now
+	for id, entry := range g.cache {
+		if !now.Before(entry.expiresAt) {
+			delete(g.cache, id)
+		}
+	}
+}
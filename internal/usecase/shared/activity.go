@@ -0,0 +1,43 @@
+package shared
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ActivityKind is what an entry on a user's activity timeline records.
+type ActivityKind string
+
+const (
+	ActivityReservationCreated  ActivityKind = "reservation_created"
+	ActivityReservationCanceled ActivityKind = "reservation_canceled"
+	ActivityReviewPosted        ActivityKind = "review_posted"
+	ActivityCreditEarned        ActivityKind = "credit_earned"
+	ActivityPointsEarned        ActivityKind = "points_earned"
+	// ActivityNotification is a notification someone else's action sent the user, e.g. an
+	// approval decision; emails about the user's own actions are not repeated.
+	ActivityNotification ActivityKind = "notification"
+)
+
+// ActivityKinds lists every kind, in the order the API documents them.
+var ActivityKinds = []ActivityKind{
+	ActivityReservationCreated,
+	ActivityReservationCanceled,
+	ActivityReviewPosted,
+	ActivityCreditEarned,
+	ActivityPointsEarned,
+	ActivityNotification,
+}
+
+// ActivityEntry is written in the transaction of the change it describes. SubjectID is
+// the reservation, review or referral it is about; Data holds the few details the
+// timeline shows without a lookup, e.g. a rating or the notification topic.
+type ActivityEntry struct {
+	UserID     uuid.UUID
+	Kind       ActivityKind
+	SubjectID  uuid.UUID
+	ResourceID *uuid.UUID
+	Data       map[string]any
+	OccurredAt time.Time
+}
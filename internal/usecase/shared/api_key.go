@@ -0,0 +1,13 @@
+package shared
+
+import "crypto/sha256"
+
+// APIKeyPrefix marks API keys so secret scanners can recognise them.
+const APIKeyPrefix = "gcsk_"
+
+// HashAPIKey is the form an API key is stored and looked up in; the plaintext is only
+// shown when the key is issued.
+func HashAPIKey(key string) []byte {
+	sum := sha256.Sum256([]byte(key))
+	return sum[:]
+}
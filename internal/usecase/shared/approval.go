@@ -0,0 +1,50 @@
+package shared
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Statuses of a reservation approval request. Only pending requests can be decided.
+const (
+	ApprovalStatusPending   = "pending"
+	ApprovalStatusApproved  = "approved"
+	ApprovalStatusRejected  = "rejected"
+	ApprovalStatusExpired   = "expired"
+	ApprovalStatusWithdrawn = "withdrawn"
+)
+
+// ReservationApproval is the approval request of a reservation held in pending_approval.
+type ReservationApproval struct {
+	ReservationID uuid.UUID
+	Status        string
+	ExpiresAt     time.Time
+	EscalatedAt   *time.Time
+}
+
+// ApprovalDecision closes a pending request. DecidedBy is the approver, or the user who
+// withdrew their own request.
+type ApprovalDecision struct {
+	ReservationID uuid.UUID
+	Status        string
+	DecidedBy     uuid.UUID
+	Reason        string
+	DecidedAt     time.Time
+}
+
+// ApprovalEscalation is a request that has waited long enough to be escalated to the
+// admins of the resource's company. CompanyID is nil for resources without a company.
+type ApprovalEscalation struct {
+	ReservationID uuid.UUID
+	ResourceID    uuid.UUID
+	UserID        uuid.UUID
+	CompanyID     *uuid.UUID
+	ExpiresAt     time.Time
+}
+
+// ExpiredApproval is a request that expired undecided; its reservation is canceled.
+type ExpiredApproval struct {
+	ReservationID uuid.UUID
+	UserID        uuid.UUID
+}
@@ -0,0 +1,61 @@
+package shared
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Subscription statuses, as mirrored from the billing provider.
+const (
+	SubscriptionTrialing = "trialing"
+	SubscriptionActive   = "active"
+	SubscriptionPastDue  = "past_due"
+	SubscriptionCanceled = "canceled"
+)
+
+// BillingProvider verifies and decodes the billing provider's webhook deliveries. The
+// generic HMAC provider is wired by default; an adapter for a hosted provider implements
+// the same two methods.
+type BillingProvider interface {
+	// VerifyWebhook reports whether header carries a valid signature of payload.
+	VerifyWebhook(payload []byte, header http.Header) bool
+	// ParseWebhook decodes a verified delivery; an error means the payload is malformed.
+	ParseWebhook(payload []byte) (*BillingEvent, error)
+}
+
+// BillingEvent is one webhook delivery. Subscription is nil for event types that do not
+// change a subscription; those are still recorded so a retry is recognised.
+type BillingEvent struct {
+	ID           string
+	Type         string
+	Subscription *SubscriptionState
+}
+
+// SubscriptionState is a company's subscription as the provider saw it at UpdatedAt.
+type SubscriptionState struct {
+	CompanyID        uuid.UUID
+	PlanID           string
+	Status           string
+	CustomerID       string
+	SubscriptionID   string
+	CurrentPeriodEnd *time.Time
+	UpdatedAt        time.Time
+}
+
+// CompanyPlan holds the limits in force for a company; nil limits are unlimited.
+type CompanyPlan struct {
+	PlanID              string
+	Status              string
+	MaxResources        *int32
+	MaxOperators        *int32
+	MonthlyRequestQuota *int64
+}
+
+// CompanyOperators counts the distinct operators across a resource's company.
+type CompanyOperators struct {
+	CompanyID  *uuid.UUID // nil for resources shared by every company
+	Count      int64
+	IsOperator bool // whether the user already operates one of the company's resources
+}
@@ -0,0 +1,37 @@
+package shared
+
+// CompanyBranding is how a company's transactional emails look. Empty fields use the
+// product defaults.
+type CompanyBranding struct {
+	CompanyName  string
+	LogoURL      string
+	PrimaryColor string
+	AccentColor  string
+	ReplyTo      string
+	Footer       string
+}
+
+// Email is a transactional message before branding: a heading, body paragraphs and an
+// optional call to action.
+type Email struct {
+	Subject     string
+	Heading     string
+	Paragraphs  []string
+	ActionLabel string
+	ActionURL   string
+}
+
+// RenderedEmail is ready to hand to a mail transport. ReplyTo is empty when the company
+// sets none.
+type RenderedEmail struct {
+	Subject string
+	ReplyTo string
+	HTML    string
+	Text    string
+}
+
+// EmailRenderer lays a message out in the company's branding. The template implementation
+// runs in-process; a hosted template service can replace it.
+type EmailRenderer interface {
+	Render(branding CompanyBranding, email Email) (RenderedEmail, error)
+}
@@ -0,0 +1,47 @@
+package shared
+
+import (
+	"maps"
+	"sync"
+	"time"
+)
+
+type CanceledRequestStats struct {
+	Total int64
+	// Routes counts by route template, e.g. "GET /api/reservations/:id".
+	Routes map[string]int64
+	Since  time.Time
+}
+
+// CanceledRequestMetrics counts requests whose client went away before the reply since
+// the process started, the 499s of an nginx log.
+type CanceledRequestMetrics struct {
+	mu     sync.Mutex
+	total  int64
+	routes map[string]int64
+	since  time.Time
+}
+
+func NewCanceledRequestMetrics() *CanceledRequestMetrics {
+	return &CanceledRequestMetrics{
+		routes: make(map[string]int64),
+		since:  time.Now(),
+	}
+}
+
+// Record counts a canceled request; an empty route (no route matched) only counts in Total.
+func (m *CanceledRequestMetrics) Record(route string) {
+	m.mu.Lock()
+	m.total++
+	if route != "" {
+		m.routes[route]++
+	}
+	m.mu.Unlock()
+}
+
+func (m *CanceledRequestMetrics) Stats() CanceledRequestStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return CanceledRequestStats{Total: m.total, Routes: maps.Clone(m.routes), Since: m.since}
+}
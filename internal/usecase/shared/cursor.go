@@ -0,0 +1,12 @@
+package shared
+
+// Stored positions that event processing resumes from; each needs a matching query pair
+// in CursorRepository. A position ahead of the clock, e.g. after a migration reinterpreted
+// timestamps, makes later events look handled and they are skipped without an error.
+const (
+	CursorBillingWebhooks    = "billing_webhooks"    // subscriptions.provider_updated_at
+	CursorMessageReads       = "message_reads"       // reservation_message_reads.last_read_at
+	CursorNotificationOutbox = "notification_outbox" // queued notification_jobs.run_at
+)
+
+var Cursors = []string{CursorBillingWebhooks, CursorMessageReads, CursorNotificationOutbox}
@@ -0,0 +1,19 @@
+package shared
+
+import (
+	"time"
+
+	"gin-clean-starter/internal/domain/reservation"
+
+	"github.com/google/uuid"
+)
+
+// CustomFieldDefinition is a field a company collects on reservations. ResourceID is nil
+// for a field asked on every resource of the company.
+type CustomFieldDefinition struct {
+	ID         uuid.UUID
+	CompanyID  uuid.UUID
+	ResourceID *uuid.UUID
+	reservation.FieldDefinition
+	CreatedAt time.Time
+}
@@ -0,0 +1,18 @@
+package shared
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// DeprecationTracker counts calls to deprecated routes per consumer in memory; Flush writes
+// them to the daily totals behind the deprecated-route report.
+type DeprecationTracker interface {
+	// Record counts one call to route ("<METHOD> <route template>"); userID is nil for
+	// anonymous callers.
+	Record(route string, userID *uuid.UUID)
+	// Flush writes what was recorded since the last flush and returns how many counts it
+	// wrote. Counts that fail to write are kept for the next flush.
+	Flush(ctx context.Context) (int, error)
+}
@@ -0,0 +1,46 @@
+package shared
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// Features that can be rolled out per company. Each is checked where it takes effect, so
+// a company without it behaves as if the feature had never shipped.
+const (
+	// FeatureReviewModeration holds near-duplicate reviews for approval and serves the
+	// moderation queue.
+	FeatureReviewModeration = "review_moderation"
+)
+
+// Features lists every toggle in the order the admin API reports them.
+var Features = []string{
+	FeatureReviewModeration,
+}
+
+func IsFeature(name string) bool {
+	return slices.Contains(Features, name)
+}
+
+// FeatureFlags resolves per-company feature toggles: a company's own setting when it has
+// one, otherwise the deployment default.
+type FeatureFlags interface {
+	// Enabled reports whether feature is on for companyID; uuid.Nil gets the default.
+	Enabled(ctx context.Context, companyID uuid.UUID, feature string) (bool, error)
+	// EnabledForResource checks the company owning resourceID. Resources without a
+	// company, and unknown ones, get the default.
+	EnabledForResource(ctx context.Context, resourceID uuid.UUID, feature string) (bool, error)
+	// States reports every feature for companyID.
+	States(ctx context.Context, companyID uuid.UUID) ([]FeatureState, error)
+	// Invalidate drops companyID's cached settings after they change.
+	Invalidate(companyID uuid.UUID)
+}
+
+type FeatureState struct {
+	Feature string
+	Enabled bool
+	// Overridden reports that the company has its own setting instead of the default.
+	Overridden bool
+}
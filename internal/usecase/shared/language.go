@@ -0,0 +1,10 @@
+package shared
+
+import "context"
+
+// LanguageDetector guesses the language of a review comment. The heuristic implementation
+// runs in-process; a detection service or a fuller model can replace it.
+type LanguageDetector interface {
+	// Detect returns an ISO 639-1 code, or "" when the text is too short or ambiguous.
+	Detect(ctx context.Context, text string) (string, error)
+}
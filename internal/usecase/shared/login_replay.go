@@ -0,0 +1,80 @@
+package shared
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LoginAttempt identifies a login submission for the replay cache. Every field is part
+// of the key, so a cached result only answers a resubmission of the same credentials:
+// guessing a nonce does not reveal tokens, and a wrong password never hits the cache.
+type LoginAttempt struct {
+	Nonce     string
+	Email     string
+	Password  string
+	DeviceKey string
+}
+
+// LoginReplay is what the replay cache keeps of a successful login.
+// MFAToken is set instead of the tokens when the user still has to send a second factor.
+type LoginReplay struct {
+	UserID       uuid.UUID
+	AccessToken  string
+	RefreshToken string
+	MFAToken     string
+	MFAExpiresAt time.Time
+}
+
+// LoginReplayCache briefly remembers successful logins by client nonce so a double-submitted
+// form gets the first submission's tokens instead of a second pair and a second set of
+// last-login writes.
+type LoginReplayCache interface {
+	// Do runs login unless an earlier submission of the same attempt succeeded recently or
+	// is still running, in which case it returns that result with replayed set. Attempts
+	// without a nonce always run. Failed logins are not cached.
+	Do(attempt LoginAttempt, login func() (*LoginReplay, error)) (result *LoginReplay, replayed bool, err error)
+}
+
+type LoginReplayStats struct {
+	Stored    int64 // successful logins kept for replay
+	Replayed  int64 // duplicates answered from a finished login
+	Coalesced int64 // duplicates that waited for a login still in progress
+	Since     time.Time
+}
+
+// LoginReplayMetrics counts duplicate login submissions since the process started.
+type LoginReplayMetrics struct {
+	mu    sync.Mutex
+	stats LoginReplayStats
+}
+
+func NewLoginReplayMetrics() *LoginReplayMetrics {
+	return &LoginReplayMetrics{
+		stats: LoginReplayStats{Since: time.Now()},
+	}
+}
+
+func (m *LoginReplayMetrics) RecordStored() {
+	m.mu.Lock()
+	m.stats.Stored++
+	m.mu.Unlock()
+}
+
+func (m *LoginReplayMetrics) RecordDuplicate(coalesced bool) {
+	m.mu.Lock()
+	if coalesced {
+		m.stats.Coalesced++
+	} else {
+		m.stats.Replayed++
+	}
+	m.mu.Unlock()
+}
+
+func (m *LoginReplayMetrics) Stats() LoginReplayStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.stats
+}
@@ -0,0 +1,29 @@
+package shared
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserMFA is a user's TOTP enrollment with its secret decrypted. Sign-in asks for a code
+// only once EnabledAt is set; before that the enrollment waits for its first code.
+type UserMFA struct {
+	UserID    uuid.UUID
+	Secret    string
+	EnabledAt *time.Time
+	// LastUsedStep is the newest time step a code was accepted for; codes of it or an
+	// earlier step are refused, so an observed code cannot be replayed.
+	LastUsedStep   int64
+	FailedAttempts int
+	LockedUntil    *time.Time
+}
+
+func (m *UserMFA) Enabled() bool {
+	return m.EnabledAt != nil
+}
+
+// Locked reports whether too many wrong codes were sent and the lockout has not ended.
+func (m *UserMFA) Locked(now time.Time) bool {
+	return m.LockedUntil != nil && now.Before(*m.LockedUntil)
+}
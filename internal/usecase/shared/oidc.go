@@ -0,0 +1,21 @@
+package shared
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/pkg/oauth"
+)
+
+// OIDCProvider is an OpenID Connect provider users sign in through with the authorization
+// code flow; *oauth.Provider implements it.
+type OIDCProvider interface {
+	// AuthCodeURL is where to send the browser; state comes back with the callback, nonce
+	// in the ID token, and verifier has to be sent with the code.
+	AuthCodeURL(ctx context.Context, state, nonce, verifier string) (string, error)
+	// Exchange redeems the callback's code and verifies the ID token it yields.
+	Exchange(ctx context.Context, code, verifier, nonce string, now time.Time) (*oauth.Identity, error)
+}
+
+// OIDCProviders are the configured providers by the name in their sign-in URLs.
+type OIDCProviders map[string]OIDCProvider
@@ -0,0 +1,101 @@
+package shared
+
+import (
+	"context"
+	"strings"
+
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+
+	"github.com/google/uuid"
+)
+
+// Permissions checked in code; they must also exist in the permissions table.
+// ":any" grants act on every resource; ":assigned" grants only on resources the actor operates.
+const (
+	PermissionReservationsReadAny                 = "reservations:read:any"
+	PermissionReservationsReadAssigned            = "reservations:read:assigned"
+	PermissionReservationsCancelAny               = "reservations:cancel:any"
+	PermissionReservationsCancelAssigned          = "reservations:cancel:assigned"
+	PermissionReservationsTransferAny             = "reservations:transfer:any"
+	PermissionReservationsTransferAssigned        = "reservations:transfer:assigned"
+	PermissionReservationsApproveAny              = "reservations:approve:any"
+	PermissionResourceApproversManage             = "resource_approvers:manage"
+	PermissionReviewsReadAny                      = "reviews:read:any"
+	PermissionReviewsDeleteAny                    = "reviews:delete:any"
+	PermissionReviewsDeleteAssigned               = "reviews:delete:assigned"
+	PermissionRetentionRead                       = "retention:read"
+	PermissionRolesManage                         = "roles:manage"
+	PermissionResourceOperatorsManage             = "resource_operators:manage"
+	PermissionInvitesManage                       = "invites:manage"
+	PermissionSupportAccess                       = "support:access"
+	PermissionResourceBlocksManageAny             = "resource_blocks:manage:any"
+	PermissionResourceBlocksManageAssigned        = "resource_blocks:manage:assigned"
+	PermissionReservationMessagesWriteAny         = "reservation_messages:write:any"
+	PermissionReservationMessagesWriteAssigned    = "reservation_messages:write:assigned"
+	PermissionReservationAttachmentsWriteAny      = "reservation_attachments:write:any"
+	PermissionReservationAttachmentsWriteAssigned = "reservation_attachments:write:assigned"
+	PermissionUsageRead                           = "usage:read"
+	PermissionTelemetryRead                       = "telemetry:read"
+	PermissionFeaturesManage                      = "features:manage"
+	PermissionMaintenanceRun                      = "maintenance:run"
+	PermissionAuditLogsRead                       = "audit_logs:read"
+	PermissionNotificationJobsRead                = "notification_jobs:read"
+	PermissionCompanySettingsManage               = "company_settings:manage"
+	PermissionProvisioningManage                  = "provisioning:manage"
+	PermissionSSOManage                           = "sso:manage"
+	PermissionCustomFieldsManage                  = "custom_fields:manage"
+	PermissionRequestRecordingsManage             = "request_recordings:manage"
+	PermissionCompanyExportsManage                = "company_exports:manage"
+	PermissionCompaniesDelete                     = "companies:delete"
+	PermissionSearchRead                          = "search:read"
+	PermissionSessionsRevoke                      = "sessions:revoke"
+	PermissionAPIKeysManage                       = "api_keys:manage"
+	PermissionLoginsUnlock                        = "logins:unlock"
+	PermissionReviewsImport                       = "reviews:import"
+	PermissionUsersMerge                          = "users:merge"
+)
+
+type PermissionResolver interface {
+	HasPermission(ctx context.Context, role string, permission string) (bool, error)
+	// Invalidate drops cached grants after roles or their permissions change.
+	Invalidate()
+}
+
+// ResourceAuthorizer combines role grants with resource_operators assignments.
+type ResourceAuthorizer interface {
+	// CanActOnResource is true with the any-scoped grant, or with the assigned-scoped grant
+	// when actorID operates resourceID.
+	CanActOnResource(ctx context.Context, actorID uuid.UUID, role string, anyPermission, assignedPermission string, resourceID uuid.UUID) (bool, error)
+}
+
+type ResourceAssignmentReadStore interface {
+	IsAssigned(ctx context.Context, db sqlc.DBTX, resourceID, userID uuid.UUID) (bool, error)
+}
+
+type RolePermissionReadStore interface {
+	PermissionsByRole(ctx context.Context, db sqlc.DBTX, role string) ([]string, error)
+}
+
+// PermissionGranted reports whether any granted name covers required; a "*" segment
+// in a granted name matches the rest ("reviews:*" covers "reviews:delete:any").
+func PermissionGranted(granted []string, required string) bool {
+	requiredParts := strings.Split(required, ":")
+	for _, g := range granted {
+		if permissionCovers(strings.Split(g, ":"), requiredParts) {
+			return true
+		}
+	}
+	return false
+}
+
+func permissionCovers(granted, required []string) bool {
+	for i, part := range granted {
+		if part == "*" {
+			return true
+		}
+		if i >= len(required) || part != required[i] {
+			return false
+		}
+	}
+	return len(granted) == len(required)
+}
@@ -0,0 +1,13 @@
+package shared
+
+import "crypto/sha256"
+
+// ProvisioningTokenPrefix marks provisioning tokens so secret scanners can recognise them.
+const ProvisioningTokenPrefix = "scim_"
+
+// HashProvisioningToken is the form a provisioning token is stored and looked up in; the
+// plaintext is only shown when the token is issued.
+func HashProvisioningToken(token string) []byte {
+	sum := sha256.Sum256([]byte(token))
+	return sum[:]
+}
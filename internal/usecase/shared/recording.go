@@ -0,0 +1,37 @@
+package shared
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RequestRecorder captures the requests of users support is recording. Which users are
+// being recorded is cached, so requests of everyone else add no database work.
+type RequestRecorder interface {
+	// Claim reserves the next capture for userID; nil when the user is not being recorded
+	// or the recording is full.
+	Claim(ctx context.Context, userID uuid.UUID) (*RecordingSlot, error)
+	// Save stores a capture taken in slot.
+	Save(ctx context.Context, slot RecordingSlot, capture RequestCapture) error
+	// Invalidate drops the cached set of recorded users after a recording starts or ends.
+	Invalidate()
+}
+
+// RecordingSlot is a claimed capture: the Seq-th request of the recording, from 1.
+type RecordingSlot struct {
+	RecordingID uuid.UUID
+	Seq         int32
+}
+
+// RequestCapture is one sanitized request/response pair; Document is the JSON kept in
+// file storage.
+type RequestCapture struct {
+	Method     string
+	Path       string
+	Status     int
+	Duration   time.Duration
+	RecordedAt time.Time
+	Document   []byte
+}
@@ -0,0 +1,67 @@
+package shared
+
+import (
+	"context"
+	"time"
+
+	"gin-clean-starter/internal/pkg/jwt"
+
+	"github.com/google/uuid"
+)
+
+// RefreshToken is the server-side record of an issued refresh token, keyed by its jti.
+// Every token issued by rotating another one shares its SessionID, so presenting a token
+// that was already rotated revokes the whole chain.
+type RefreshToken struct {
+	ID        uuid.UUID
+	SessionID uuid.UUID
+	UserID    uuid.UUID
+	ExpiresAt time.Time
+	RotatedAt *time.Time
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
+
+// Session is the client a login session last issued a token to. SeenAt is when that
+// token was issued and ExpiresAt when it expires; the session lives as long as it does.
+type Session struct {
+	ID                uuid.UUID
+	UserID            uuid.UUID
+	DeviceFingerprint string
+	IPAddress         string
+	UserAgent         string
+	SeenAt            time.Time
+	ExpiresAt         time.Time
+}
+
+// IssuedToken is what revocation needs to know of a signed access or refresh token.
+// SessionID is nil for tokens issued before sessions were tracked; those are revoked
+// one by one through the denylist.
+type IssuedToken struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	SessionID *uuid.UUID
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// IssuedTokenFromClaims reports jwt.ErrInvalidToken when the jti is not a UUID.
+func IssuedTokenFromClaims(claims *jwt.Claims) (IssuedToken, error) {
+	id, err := uuid.Parse(claims.ID)
+	if err != nil || claims.IssuedAt == nil || claims.ExpiresAt == nil {
+		return IssuedToken{}, jwt.ErrInvalidToken
+	}
+	return IssuedToken{
+		ID:        id,
+		UserID:    claims.UserID,
+		SessionID: claims.SessionID,
+		IssuedAt:  claims.IssuedAt.Time,
+		ExpiresAt: claims.ExpiresAt.Time,
+	}, nil
+}
+
+// SessionGate decides whether an access token was revoked, with its session or, for
+// tokens without one, by logout or an admin signing the user out everywhere.
+type SessionGate interface {
+	IsRevoked(ctx context.Context, token IssuedToken) (bool, error)
+}
@@ -0,0 +1,73 @@
+package shared
+
+import (
+	"sync"
+	"time"
+)
+
+// Tables with a retention policy; each needs a matching query pair in RetentionRepository.
+// Reviews have no policy: they are hard-deleted, so no soft-deleted rows accumulate.
+const (
+	RetentionTableNotificationJobs = "notification_jobs"
+	RetentionTableIdempotencyKeys  = "idempotency_keys"
+	RetentionTableAuditLogs        = "audit_logs"
+	// Refresh tokens are kept past expiry so a replayed token is still recognised as spent.
+	RetentionTableRefreshTokens = "refresh_tokens"
+	// A denylisted token is dropped once it has expired; it is rejected on expiry alone from then on.
+	RetentionTableRevokedTokens = "revoked_tokens"
+	// Purging an expired verification deletes the self-registered account that never verified.
+	RetentionTableEmailVerifications = "email_verifications"
+	// Failed sign-in counts expire on their own; only lapsed rows are purged.
+	RetentionTableLoginFailures = "login_failures"
+	// Sessions are dropped once their last token has expired; spent tokens stay in refresh_tokens.
+	RetentionTableUserSessions = "user_sessions"
+)
+
+type RetentionPolicy struct {
+	Table  string
+	MaxAge time.Duration
+}
+
+type RetentionPolicies struct {
+	Policies  []RetentionPolicy
+	BatchSize int32
+}
+
+type RetentionTableStats struct {
+	LastRunAt      *time.Time
+	LastRunDeleted int64
+	LastRunBatches int
+	TotalDeleted   int64
+}
+
+// RetentionMetrics records purge progress so the report endpoint can show it
+// alongside the dry-run numbers.
+type RetentionMetrics struct {
+	mu     sync.RWMutex
+	tables map[string]RetentionTableStats
+}
+
+func NewRetentionMetrics() *RetentionMetrics {
+	return &RetentionMetrics{
+		tables: make(map[string]RetentionTableStats),
+	}
+}
+
+func (m *RetentionMetrics) RecordRun(table string, at time.Time, deleted int64, batches int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stats := m.tables[table]
+	stats.LastRunAt = &at
+	stats.LastRunDeleted = deleted
+	stats.LastRunBatches = batches
+	stats.TotalDeleted += deleted
+	m.tables[table] = stats
+}
+
+func (m *RetentionMetrics) Stats(table string) RetentionTableStats {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.tables[table]
+}
@@ -0,0 +1,55 @@
+package shared
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SAMLConnection is a company's trust in its SAML identity provider. Users get the role
+// mapped from the first value of RoleAttribute that RoleMapping lists, DefaultRole
+// otherwise.
+type SAMLConnection struct {
+	CompanyID   uuid.UUID
+	IDPEntityID string
+	IDPMetadata []byte
+	// EmailAttribute names the attribute holding the sign-in email; empty uses the NameID.
+	EmailAttribute string
+	// RoleAttribute is empty when every user gets DefaultRole.
+	RoleAttribute string
+	RoleMapping   map[string]string
+	DefaultRole   string
+	UpdatedAt     time.Time
+}
+
+// SAMLEndpoints are a company's service provider URLs, entered into the IdP's
+// application settings.
+type SAMLEndpoints struct {
+	EntityID string
+	ACSURL   string
+	LoginURL string
+}
+
+// SAMLAssertion is what a verified SAML response says about the user.
+type SAMLAssertion struct {
+	NameID     string
+	Attributes map[string][]string
+}
+
+// SAMLServiceProvider speaks SAML 2.0 as the service provider. Each company is its own SP
+// entity, so an IdP trusted by one company cannot sign users into another.
+type SAMLServiceProvider interface {
+	// ParseIDPMetadata checks that metadata describes an IdP with a signing certificate and
+	// returns its entity ID.
+	ParseIDPMetadata(metadata []byte) (string, error)
+	Endpoints(companyID uuid.UUID) SAMLEndpoints
+	// Metadata is the SP metadata XML of the company.
+	Metadata(companyID uuid.UUID) ([]byte, error)
+	// AuthnRequestURL builds an HTTP-Redirect AuthnRequest to the IdP and returns it with
+	// the request ID the response must answer.
+	AuthnRequestURL(companyID uuid.UUID, idpMetadata []byte) (string, string, error)
+	// ParseResponse verifies a base64 SAMLResponse posted to the company's ACS: signature,
+	// issuer, audience, recipient and validity window. requestIDs are the AuthnRequests it
+	// may answer.
+	ParseResponse(companyID uuid.UUID, idpMetadata []byte, samlResponse string, requestIDs []string) (*SAMLAssertion, error)
+}
@@ -0,0 +1,52 @@
+package shared
+
+// Security events are audit entries about a user's own account. They target the user
+// (TargetType AuditTargetUser, TargetID the user ID), which is what the self-audit
+// listing filters on; ActorID is whoever made the change.
+const (
+	AuditTargetUser = "user"
+
+	AuditActionPasswordChanged   = "auth.password_changed"
+	AuditActionEmailChanged      = "auth.email_changed"
+	AuditActionNewDeviceLogin    = "auth.new_device_login"
+	AuditActionTwoFactorEnabled  = "auth.2fa_enabled"
+	AuditActionTwoFactorDisabled = "auth.2fa_disabled"
+	AuditActionRecoveryCodeUsed  = "auth.2fa_recovery_code_used"
+	// AuditActionRefreshTokenReused is recorded without an actor: a refresh token that
+	// had already been rotated was presented again and its session was revoked.
+	AuditActionRefreshTokenReused = "auth.refresh_token_reused"
+	// AuditActionSessionsRevoked is an admin signing the user out of every session.
+	AuditActionSessionsRevoked = "auth.sessions_revoked"
+	// AuditActionSessionRevoked is the user signing one of their own sessions out.
+	AuditActionSessionRevoked = "auth.session_revoked"
+	// AuditActionAPIKeyIssued and AuditActionAPIKeyRevoked are an admin issuing or revoking
+	// a key that acts as the user.
+	AuditActionAPIKeyIssued  = "auth.api_key_issued"
+	AuditActionAPIKeyRevoked = "auth.api_key_revoked"
+	// AuditActionAccountLocked is recorded without an actor: too many failed sign-ins from
+	// one IP locked the user out from there. AuditActionAccountUnlocked is an admin lifting
+	// the lockouts before they end.
+	AuditActionAccountLocked   = "auth.account_locked"
+	AuditActionAccountUnlocked = "auth.account_unlocked"
+	// AuditActionRoleChanged is an admin assigning the user another role, which signs
+	// them out of every session.
+	AuditActionRoleChanged = "auth.role_changed"
+)
+
+// SecurityEventActions are the audit actions shown to users as security events.
+var SecurityEventActions = []string{
+	AuditActionPasswordChanged,
+	AuditActionEmailChanged,
+	AuditActionNewDeviceLogin,
+	AuditActionTwoFactorEnabled,
+	AuditActionTwoFactorDisabled,
+	AuditActionRecoveryCodeUsed,
+	AuditActionRefreshTokenReused,
+	AuditActionSessionsRevoked,
+	AuditActionSessionRevoked,
+	AuditActionAPIKeyIssued,
+	AuditActionAPIKeyRevoked,
+	AuditActionAccountLocked,
+	AuditActionAccountUnlocked,
+	AuditActionRoleChanged,
+}
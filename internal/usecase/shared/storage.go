@@ -0,0 +1,22 @@
+package shared
+
+import (
+	"context"
+	"io"
+)
+
+// FileStorage keeps file contents outside the database; rows store only the key.
+type FileStorage interface {
+	// Put writes content under key, replacing what was there.
+	Put(ctx context.Context, key string, content io.Reader) error
+	// Open returns the content under key; a missing key is KindNotFound.
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes key; deleting a missing key is not an error.
+	Delete(ctx context.Context, key string) error
+}
+
+// FileScanner inspects uploads before they are stored, e.g. with an antivirus daemon.
+type FileScanner interface {
+	// Scan returns false when the file must be rejected; err reports that the scan itself failed.
+	Scan(ctx context.Context, filename string, content []byte) (clean bool, err error)
+}
@@ -0,0 +1,26 @@
+package shared
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// ReviewExcerpt is the part of a review a Summarizer sees.
+type ReviewExcerpt struct {
+	Rating  int32
+	Comment string
+}
+
+// StaleReviewSummary is a resource whose review count changed since its summary was generated.
+type StaleReviewSummary struct {
+	ResourceID   uuid.UUID
+	TotalReviews int32
+}
+
+// Summarizer turns a resource's recent reviews, newest first, into a short pros/cons
+// summary. The template implementation runs in-process; an LLM-backed one can replace it.
+type Summarizer interface {
+	// Summarize returns "" when there is nothing worth summarizing.
+	Summarize(ctx context.Context, reviews []ReviewExcerpt) (string, error)
+}
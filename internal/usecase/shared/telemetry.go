@@ -0,0 +1,44 @@
+package shared
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FeatureTelemetry counts which features users reach, in memory; Flush drops opted-out
+// companies, anonymizes the rest and hands the counts to the AnalyticsSink.
+type FeatureTelemetry interface {
+	Record(userID uuid.UUID, feature string)
+	// Flush emits what was recorded since the last flush and returns how many counts it
+	// emitted. Counts the sink fails to take are kept for the next flush.
+	Flush(ctx context.Context) (int, error)
+}
+
+// AnalyticsSink is the analytics pipeline feature-usage counts are emitted to. The default
+// sink stores them for the adoption report; an exporter to an external pipeline
+// implements the same method.
+type AnalyticsSink interface {
+	Emit(ctx context.Context, counts []FeatureCount) error
+}
+
+// FeatureCount is how often one anonymized company used a feature on a day (UTC).
+type FeatureCount struct {
+	Day         time.Time
+	Feature     string
+	CompanyHash string // "" for users without a company
+	Requests    int64
+}
+
+// TelemetryCompany is the company a user belongs to and whether it opted out.
+type TelemetryCompany struct {
+	CompanyID uuid.UUID
+	OptedOut  bool
+}
+
+// TelemetryDay returns the first instant of t's day in UTC, the key counts are kept under.
+func TelemetryDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
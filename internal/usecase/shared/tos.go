@@ -0,0 +1,14 @@
+package shared
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// TOSGate decides whether a user has to accept the current terms of service first.
+type TOSGate interface {
+	// PendingVersion returns the current version when userID has not accepted it, and
+	// nil when it has or when no version is published yet.
+	PendingVersion(ctx context.Context, userID uuid.UUID) (*TOSVersion, error)
+}
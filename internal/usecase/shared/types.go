@@ -1,6 +1,7 @@
 package shared
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
@@ -15,6 +16,7 @@ type ResourceSnapshot struct {
 type CouponSnapshot struct {
 	ID             uuid.UUID
 	Code           string
+	Priority       int
 	AmountOffCents *int32
 	PercentOff     *float64
 	ValidFrom      *time.Time
@@ -37,4 +39,316 @@ type ReviewSnapshot struct {
 	ReservationID uuid.UUID
 	Rating        int
 	Comment       string
+	Status        string
+}
+
+// ReviewWindowSetting is a company's own review window (company_settings); nil fields fall
+// back to the configured defaults.
+type ReviewWindowSetting struct {
+	OpensAfterMin *int32
+	WindowDays    *int32
+}
+
+type InviteSnapshot struct {
+	ID        uuid.UUID
+	Email     string
+	CompanyID uuid.UUID
+	Role      string
+	Nonce     uuid.UUID
+	Status    string
+	ExpiresAt time.Time
+}
+
+type ReservationTransferSnapshot struct {
+	ID            uuid.UUID
+	ReservationID uuid.UUID
+	FromUserID    uuid.UUID
+	ToUserID      uuid.UUID
+	Nonce         uuid.UUID
+	Status        string
+	ExpiresAt     time.Time
+}
+
+// Sides of a reservation message thread: the reservation's user, and the operators
+// (or admins) of its resource.
+const (
+	MessageSideUser     = "user"
+	MessageSideOperator = "operator"
+)
+
+// ReservationMessage is a new message on a reservation's thread.
+type ReservationMessage struct {
+	ReservationID uuid.UUID
+	AuthorID      uuid.UUID
+	AuthorSide    string
+	Body          string
+	CreatedAt     time.Time
+}
+
+// ReservationAttachment is a file's metadata; its contents are stored under StorageKey.
+type ReservationAttachment struct {
+	ID            uuid.UUID
+	ReservationID uuid.UUID
+	UploadedBy    uuid.UUID
+	UploaderSide  string // MessageSideUser or MessageSideOperator
+	Filename      string
+	ContentType   string
+	SizeBytes     int64
+	StorageKey    string
+	OperatorOnly  bool
+	CreatedAt     time.Time
+}
+
+// ResourceBlockSeries is a block and its recurrences, one time range per occurrence.
+type ResourceBlockSeries struct {
+	ID          uuid.UUID
+	ResourceID  uuid.UUID
+	Reason      string
+	CreatedBy   uuid.UUID
+	Occurrences []TimeRange
+}
+
+type TimeRange struct {
+	Start time.Time
+	End   time.Time
+}
+
+// AuditEntry is one row of the audit trail; Metadata is stored as JSON.
+type AuditEntry struct {
+	ActorID    *uuid.UUID
+	CompanyID  *uuid.UUID
+	Action     string
+	TargetType string
+	TargetID   string
+	Metadata   map[string]any
+}
+
+// RewardableReferral is a pending referral whose referred user has completed a reservation.
+type RewardableReferral struct {
+	ID            uuid.UUID
+	ReferrerID    uuid.UUID
+	ReferredID    uuid.UUID
+	ReservationID uuid.UUID
+}
+
+// AccruableReservation is a completed reservation that has not earned loyalty points yet.
+type AccruableReservation struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	PriceCents  int64
+	CompletedAt time.Time
+}
+
+// PointsAccrual is the expiring lot of points a completed reservation earns.
+type PointsAccrual struct {
+	UserID        uuid.UUID
+	ReservationID uuid.UUID
+	Points        int64
+	ExpiresAt     time.Time
+}
+
+// PointsRedemption records points spent as a discount on a reservation.
+type PointsRedemption struct {
+	UserID        uuid.UUID
+	ReservationID uuid.UUID
+	Points        int64
+	DiscountCents int64
+}
+
+// TOSVersion is a published terms-of-service version.
+type TOSVersion struct {
+	ID          uuid.UUID
+	Version     string
+	URL         string
+	PublishedAt time.Time
+}
+
+// TOSAcceptance is the compliance record of a user accepting a version.
+type TOSAcceptance struct {
+	UserID     uuid.UUID
+	VersionID  uuid.UUID
+	AcceptedAt time.Time
+	IPAddress  string
+}
+
+// UsageDelta is the usage one user added to their company's month since the last flush.
+type UsageDelta struct {
+	UserID   uuid.UUID
+	Month    time.Time // first day of the month, UTC
+	Requests int64
+	BytesIn  int64
+	BytesOut int64
+}
+
+// DeprecatedRouteCalls is how often one consumer called a deprecated route on a day (UTC)
+// since the last flush; UserID is nil for anonymous calls.
+type DeprecatedRouteCalls struct {
+	Day          time.Time
+	Route        string
+	UserID       *uuid.UUID
+	Calls        int64
+	LastCalledAt time.Time
+}
+
+// RequestRecording records a consenting user's next MaxRequests requests until ExpiresAt.
+// ConsentReference points at where the user agreed, e.g. a support ticket.
+type RequestRecording struct {
+	ID               uuid.UUID
+	UserID           uuid.UUID
+	EnabledBy        uuid.UUID
+	Reason           string
+	ConsentReference string
+	MaxRequests      int32
+	ExpiresAt        time.Time
+	CreatedAt        time.Time
+}
+
+// RecordedRequest is one capture of a recording; the sanitized pair is in file storage
+// under StorageKey.
+type RecordedRequest struct {
+	RecordingID uuid.UUID
+	Seq         int32
+	Method      string
+	Path        string
+	Status      int32
+	DurationMs  int32
+	StorageKey  string
+	RecordedAt  time.Time
+}
+
+// CompanyExport is a queued offboarding archive of a company; RequestedBy is the staff
+// member who asked for it.
+type CompanyExport struct {
+	ID          uuid.UUID
+	CompanyID   uuid.UUID
+	RequestedBy uuid.UUID
+	CreatedAt   time.Time
+}
+
+// CompanyExportSection names one JSON Lines file of a company archive.
+type CompanyExportSection string
+
+const (
+	CompanyExportUsers        CompanyExportSection = "users"
+	CompanyExportResources    CompanyExportSection = "resources"
+	CompanyExportReservations CompanyExportSection = "reservations"
+	CompanyExportReviews      CompanyExportSection = "reviews"
+)
+
+// CompanyExportSections are the archive's row sections in the order they are written.
+var CompanyExportSections = []CompanyExportSection{
+	CompanyExportUsers,
+	CompanyExportResources,
+	CompanyExportReservations,
+	CompanyExportReviews,
+}
+
+// CompanyExportRecord is one row of a section as a JSON document.
+type CompanyExportRecord struct {
+	ID  uuid.UUID
+	Doc json.RawMessage
+}
+
+// DeletedUserID is the inactive placeholder user that takes over reservations, reviews
+// and blocks of members deleted with their company.
+var DeletedUserID = uuid.MustParse("00000000-0000-0000-0000-00000000dead")
+
+// CompanyDeletionStage names a step of a company hard-delete; a deletion records the
+// stage it has reached so a retried run resumes there.
+type CompanyDeletionStage string
+
+const (
+	CompanyDeletionCancelReservations CompanyDeletionStage = "cancel_reservations"
+	CompanyDeletionAnonymizeReviews   CompanyDeletionStage = "anonymize_reviews"
+	CompanyDeletionPurgeStorage       CompanyDeletionStage = "purge_storage"
+	CompanyDeletionDeleteUsers        CompanyDeletionStage = "delete_users"
+	CompanyDeletionDeleteCompany      CompanyDeletionStage = "delete_company"
+	CompanyDeletionDone               CompanyDeletionStage = "done"
+)
+
+// CompanyDeletionStages are the purge stages in the order they run: nothing is deleted
+// while rows elsewhere still point at it.
+var CompanyDeletionStages = []CompanyDeletionStage{
+	CompanyDeletionCancelReservations,
+	CompanyDeletionAnonymizeReviews,
+	CompanyDeletionPurgeStorage,
+	CompanyDeletionDeleteUsers,
+	CompanyDeletionDeleteCompany,
+}
+
+// CompanyDeletion is a queued or running hard-delete of a company. Counts totals the rows
+// each stage touched so far, keyed by what was done (e.g. "users_deleted"); RequestedBy
+// is nil once the staff member has been deleted.
+type CompanyDeletion struct {
+	ID          uuid.UUID
+	CompanyID   uuid.UUID
+	CompanyName string
+	RequestedBy *uuid.UUID
+	Stage       CompanyDeletionStage
+	Counts      map[string]int64
+	CreatedAt   time.Time
+	StartedAt   time.Time
+}
+
+// CompanyDeletionTarget is the company a deletion is requested for; ActorIsMember is true
+// when the requester belongs to it.
+type CompanyDeletionTarget struct {
+	Name          string
+	ActorIsMember bool
+}
+
+// PurgedReservation is a reservation canceled by a company deletion; Member is false when
+// it belongs to a user outside the company.
+type PurgedReservation struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	ResourceID uuid.UUID
+	StartTime  time.Time
+	EndTime    time.Time
+	Member     bool
+}
+
+// UserUsageQuota is the month's usage of a user's company against its quotas; nil
+// limits are unlimited.
+type UserUsageQuota struct {
+	CompanyID uuid.UUID
+	Used      int64
+	SoftLimit *int64
+	HardLimit *int64
+}
+
+// UserMergeAccount is one of the two accounts of a merge.
+type UserMergeAccount struct {
+	ID        uuid.UUID
+	CompanyID *uuid.UUID
+	IsActive  bool
+}
+
+// UserMergeCounts is what a merge moves to the target account. Transfers include those
+// between the two accounts, which are dropped; Sessions are the source's live sessions,
+// which are signed out rather than moved.
+type UserMergeCounts struct {
+	Reservations    int64
+	Reviews         int64
+	ReferralCredits int64
+	LoyaltyEntries  int64
+	ActivityEntries int64
+	Identities      int64
+	Messages        int64
+	Transfers       int64
+	Sessions        int64
+}
+
+// DueNotificationDigest is a user whose held notifications are due to go out in a digest.
+type DueNotificationDigest struct {
+	UserID uuid.UUID
+	Mode   string
+}
+
+// HeldNotification is a notification job held for its user's digest.
+type HeldNotification struct {
+	ID        uuid.UUID
+	Topic     string
+	Payload   []byte
+	CreatedAt time.Time
 }
@@ -2,8 +2,11 @@ package shared
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
+	"gin-clean-starter/internal/domain/loyalty"
+	"gin-clean-starter/internal/domain/referral"
 	"gin-clean-starter/internal/domain/reservation"
 	"gin-clean-starter/internal/domain/review"
 	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
@@ -16,6 +19,8 @@ type UnitOfWork interface {
 	Within(ctx context.Context, fn func(ctx context.Context, tx Tx) error) error
 	// DB: Direct access to non-transactional DB handle for single-query reads
 	DB(ctx context.Context) sqlc.DBTX
+	// ReadDB: Handle for reads that tolerate replication lag; may be a read replica
+	ReadDB(ctx context.Context) sqlc.DBTX
 }
 
 type Tx interface {
@@ -25,6 +30,25 @@ type Tx interface {
 	Idempotency() IdempotencyRepository
 	Notifications() NotificationRepository
 	Users() UserRepository
+	Roles() RoleRepository
+	ResourceOperators() ResourceOperatorRepository
+	Invites() InviteRepository
+	Companies() CompanyRepository
+	Resources() ResourceRepository
+	Audit() AuditRepository
+	Referrals() ReferralRepository
+	Loyalty() LoyaltyRepository
+	TOS() TOSRepository
+	ResourceBlocks() ResourceBlockRepository
+	ReservationMessages() ReservationMessageRepository
+	ReservationAttachments() ReservationAttachmentRepository
+	ReservationTransfers() ReservationTransferRepository
+	Billing() BillingRepository
+	Provisioning() ProvisioningRepository
+	SAMLConnections() SAMLConnectionRepository
+	ReservationApprovals() ReservationApprovalRepository
+	CustomFields() CustomFieldRepository
+	Activity() ActivityRepository
 	DB() sqlc.DBTX
 }
 
@@ -37,6 +61,14 @@ type ReservationSnapshot struct {
 	EndTime    time.Time
 }
 
+// ReservationSlot is a reservation's owner and time range.
+type ReservationSlot struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	StartTime time.Time
+	EndTime   time.Time
+}
+
 // Read store interfaces for commands (snapshots)
 type ResourceReadStore interface {
 	FindByID(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (*ResourceSnapshot, error)
@@ -52,26 +84,175 @@ type IdempotencyReadStore interface {
 
 type ReviewReadStore interface {
 	FindSnapshotByID(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (*ReviewSnapshot, error)
+	ListStaleSummaries(ctx context.Context, db sqlc.DBTX, limit int32) ([]StaleReviewSummary, error)
+	// ListRecentForSummary returns the resource's newest reviews first.
+	ListRecentForSummary(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID, limit int32) ([]ReviewExcerpt, error)
+	// FindSimilarRecent returns the closest of the user's last lookback reviews whose comment
+	// has a trigram similarity of at least threshold, or KindNotFound.
+	FindSimilarRecent(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, comment string, lookback int32, threshold float32) (uuid.UUID, error)
+	// FindReviewWindow returns the review window of the resource's company, or KindNotFound
+	// when the resource does not exist.
+	FindReviewWindow(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID) (ReviewWindowSetting, error)
+	// FindImportAuthor returns the member of the resource's company with the SCIM external
+	// ID, else the user with the email, or KindNotFound. Empty values match nobody.
+	FindImportAuthor(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID, externalID, email string) (uuid.UUID, error)
+}
+
+type InviteReadStore interface {
+	FindSnapshotByID(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (*InviteSnapshot, error)
+}
+
+type ReservationTransferReadStore interface {
+	FindSnapshotByID(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (*ReservationTransferSnapshot, error)
+}
+
+type ReferralReadStore interface {
+	FindReferrerByCode(ctx context.Context, db sqlc.DBTX, code string) (uuid.UUID, error)
+	ListRewardable(ctx context.Context, db sqlc.DBTX, now time.Time, limit int32) ([]RewardableReferral, error)
+}
+
+type LoyaltyReadStore interface {
+	// Balance counts unspent points in lots that have not expired at now.
+	Balance(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, now time.Time) (int64, error)
+	ListAccruable(ctx context.Context, db sqlc.DBTX, since, now time.Time, minPriceCents int64, limit int32) ([]AccruableReservation, error)
+}
+
+type TOSReadStore interface {
+	// FindCurrent returns the latest version published at or before now.
+	FindCurrent(ctx context.Context, db sqlc.DBTX, now time.Time) (*TOSVersion, error)
+	HasAccepted(ctx context.Context, db sqlc.DBTX, userID, versionID uuid.UUID) (bool, error)
+}
+
+type RefreshTokenReadStore interface {
+	IsSessionRevoked(ctx context.Context, db sqlc.DBTX, sessionID uuid.UUID) (bool, error)
+	// IsTokenRevoked checks a token without a session: denylisted by its jti, or issued
+	// before the user was signed out everywhere.
+	IsTokenRevoked(ctx context.Context, db sqlc.DBTX, token IssuedToken) (bool, error)
+}
+
+type CompanyExportReadStore interface {
+	// CountRows returns how many rows each section of the company's archive will hold.
+	CountRows(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID) (map[CompanyExportSection]int64, error)
+	// FindProfile returns the company with its settings as one JSON document; KindNotFound
+	// when the company does not exist.
+	FindProfile(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID) (json.RawMessage, error)
+	// ListSection pages through a section by ascending ID, starting after afterID.
+	ListSection(ctx context.Context, db sqlc.DBTX, section CompanyExportSection, companyID, afterID uuid.UUID, limit int32) ([]CompanyExportRecord, error)
+	ListExpired(ctx context.Context, db sqlc.DBTX, now time.Time, limit int32) ([]uuid.UUID, error)
+}
+
+type RequestRecordingReadStore interface {
+	// ListActiveUsers returns the users with a recording that still captures at now.
+	ListActiveUsers(ctx context.Context, db sqlc.DBTX, now time.Time) ([]uuid.UUID, error)
+	ListExpired(ctx context.Context, db sqlc.DBTX, now time.Time, limit int32) ([]uuid.UUID, error)
+}
+
+type UsageReadStore interface {
+	// FindQuota reports KindNotFound when the user has no company.
+	FindQuota(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, month time.Time) (*UserUsageQuota, error)
+}
+
+type PlanReadStore interface {
+	// FindCompanyPlan reports KindNotFound when the company has no subscription.
+	FindCompanyPlan(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID) (*CompanyPlan, error)
+	CountResources(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID) (int64, error)
+	// CountOperators reports KindNotFound when the resource does not exist.
+	CountOperators(ctx context.Context, db sqlc.DBTX, resourceID, userID uuid.UUID) (*CompanyOperators, error)
+}
+
+type TelemetryReadStore interface {
+	// ListCompanies maps each of userIDs to its company; users without one are absent.
+	ListCompanies(ctx context.Context, db sqlc.DBTX, userIDs []uuid.UUID) (map[uuid.UUID]TelemetryCompany, error)
+}
+
+type FeatureReadStore interface {
+	// ListCompanyFeatures returns the company's own settings by feature.
+	ListCompanyFeatures(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID) (map[string]bool, error)
+	// FindResourceCompany returns uuid.Nil for resources without a company and reports
+	// KindNotFound for unknown resources.
+	FindResourceCompany(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID) (uuid.UUID, error)
+	CompanyExists(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID) (bool, error)
+}
+
+type BrandingReadStore interface {
+	// FindBranding reports KindNotFound for unknown companies.
+	FindBranding(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID) (CompanyBranding, error)
+}
+
+type SAMLConnectionReadStore interface {
+	// FindSAMLConnection reports KindNotFound when the company has no connection.
+	FindSAMLConnection(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID) (*SAMLConnection, error)
+}
+
+type ReservationApprovalReadStore interface {
+	// ApproverIDs lists the resource's designated approvers. Reservations on a resource
+	// without any need no approval.
+	ApproverIDs(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID) ([]uuid.UUID, error)
+	IsApprover(ctx context.Context, db sqlc.DBTX, resourceID, userID uuid.UUID) (bool, error)
+	// FindApproval reports KindNotFound when the reservation never needed approval.
+	FindApproval(ctx context.Context, db sqlc.DBTX, reservationID uuid.UUID) (*ReservationApproval, error)
+	// ListToEscalate returns up to limit pending, not yet escalated requests created at or
+	// before waitedSince that have not expired at now, oldest first.
+	ListToEscalate(ctx context.Context, db sqlc.DBTX, waitedSince, now time.Time, limit int32) ([]ApprovalEscalation, error)
+}
+
+type CustomFieldReadStore interface {
+	// FieldDefinitionsForResource returns the fields asked on reservations of the resource:
+	// its company's company-wide fields and its own, oldest first.
+	FieldDefinitionsForResource(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID) ([]reservation.FieldDefinition, error)
 }
 
 type ReservationSnapshotReadStore interface {
 	FindSnapshotByID(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (*ReservationSnapshot, error)
+	// FindGroupID returns the group of a reservation booked as part of one, or nil.
+	FindGroupID(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (*uuid.UUID, error)
+	// ListCancelableInRange returns the resource's confirmed reservations overlapping the
+	// window that have not ended at now, earliest first.
+	ListCancelableInRange(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID, window TimeRange, now time.Time) ([]ReservationSlot, error)
+}
+
+type ReservationAttachmentReadStore interface {
+	FindByID(ctx context.Context, db sqlc.DBTX, reservationID, id uuid.UUID) (*ReservationAttachment, error)
+	FindByReservation(ctx context.Context, db sqlc.DBTX, reservationID uuid.UUID, includeOperatorOnly bool) ([]*ReservationAttachment, error)
 }
 
 type ReservationRepository interface {
 	Create(ctx context.Context, tx sqlc.DBTX, res *reservation.Reservation) (uuid.UUID, error)
+	Cancel(ctx context.Context, tx sqlc.DBTX, reservationID uuid.UUID) error
+	// SlotTaken reports whether an insert for the slot would hit reservations_no_overlap.
+	SlotTaken(ctx context.Context, tx sqlc.DBTX, resourceID uuid.UUID, start, end time.Time) (bool, error)
+	AssignGroup(ctx context.Context, tx sqlc.DBTX, groupID uuid.UUID, reservationIDs []uuid.UUID) error
+	// Transfer hands the reservation from fromUserID to toUserID; it reports KindConflict
+	// unless the reservation is still fromUserID's, confirmed and not ended at now.
+	Transfer(ctx context.Context, tx sqlc.DBTX, reservationID, fromUserID, toUserID uuid.UUID, now time.Time) error
+	// CancelInRange cancels the reservations ListCancelableInRange would return, earliest first.
+	CancelInRange(ctx context.Context, tx sqlc.DBTX, resourceID uuid.UUID, window TimeRange, now time.Time) ([]ReservationSlot, error)
+	// ResolvePending moves a reservation pending approval to status; it reports
+	// KindConflict when the reservation is no longer pending.
+	ResolvePending(ctx context.Context, tx sqlc.DBTX, reservationID uuid.UUID, status reservation.Status) error
 }
 
 type ReviewRepository interface {
 	Create(ctx context.Context, tx sqlc.DBTX, rev *review.Review) (uuid.UUID, error)
 	Update(ctx context.Context, tx sqlc.DBTX, reviewID uuid.UUID, rev *review.Review) error
 	Delete(ctx context.Context, tx sqlc.DBTX, reviewID uuid.UUID) error
+	// Publish releases a flagged review; KindNotFound when it is not flagged.
+	Publish(ctx context.Context, tx sqlc.DBTX, reviewID uuid.UUID) error
+	// Import inserts a review carried over from another system, keyed by source and
+	// externalID; KindDuplicateKey when that review was imported before.
+	Import(ctx context.Context, tx sqlc.DBTX, rev *review.Review, source, externalID string) (uuid.UUID, error)
 }
 
 type RatingStatsRepository interface {
 	ApplyOnCreate(ctx context.Context, tx sqlc.DBTX, resourceID uuid.UUID, rating int) error
 	ApplyOnUpdate(ctx context.Context, tx sqlc.DBTX, resourceID uuid.UUID, oldRating, newRating int) error
 	ApplyOnDelete(ctx context.Context, tx sqlc.DBTX, resourceID uuid.UUID, oldRating int) error
+	// Recalculate rebuilds the resource's stats from its published reviews, in place of
+	// applying a bulk change review by review.
+	Recalculate(ctx context.Context, tx sqlc.DBTX, resourceID uuid.UUID) error
+	// SaveSummary stores the summary generated from reviewCount reviews ("" clears it) and
+	// reports false when the resource's review count has changed since.
+	SaveSummary(ctx context.Context, tx sqlc.DBTX, resourceID uuid.UUID, summary string, reviewCount int32) (bool, error)
 }
 
 type IdempotencyRepository interface {
@@ -82,9 +263,362 @@ type IdempotencyRepository interface {
 
 type NotificationRepository interface {
 	CreateJob(ctx context.Context, tx sqlc.DBTX, kind, topic string, payload []byte, runAt time.Time) error
+	// CreateUserJob addresses the job to userID. A digestible one is held for the user's
+	// digest when they take one.
+	CreateUserJob(ctx context.Context, tx sqlc.DBTX, userID uuid.UUID, kind, topic string, payload []byte, runAt time.Time, digestible bool) error
 }
 
 type UserRepository interface {
 	UpdateLastLogin(ctx context.Context, tx sqlc.DBTX, userID uuid.UUID) error
 	Create(ctx context.Context, tx sqlc.DBTX, params sqlc.CreateUserParams) (uuid.UUID, error)
+	// CreateInactive creates an account that cannot sign in until Activate.
+	CreateInactive(ctx context.Context, tx sqlc.DBTX, params sqlc.CreateUserParams) (uuid.UUID, error)
+	Activate(ctx context.Context, tx sqlc.DBTX, userID uuid.UUID) error
+	// PasswordHashForUpdate locks the user's row until the transaction ends; KindNotFound
+	// when there is no such user.
+	PasswordHashForUpdate(ctx context.Context, tx sqlc.DBTX, userID uuid.UUID) (string, error)
+	SetPassword(ctx context.Context, tx sqlc.DBTX, userID uuid.UUID, passwordHash string) error
+	SetPhone(ctx context.Context, tx sqlc.DBTX, userID uuid.UUID, phone string) error
+	// TouchDevice records that userID was seen on the device and reports whether it is new.
+	TouchDevice(ctx context.Context, tx sqlc.DBTX, userID uuid.UUID, fingerprint string, seenAt time.Time) (bool, error)
+	// SetExternalID stores the identity provider's ID for the user; "" clears it.
+	SetExternalID(ctx context.Context, tx sqlc.DBTX, userID uuid.UUID, externalID string) error
+	// SetRole reports KindForeignKeyViolated when the role does not exist.
+	SetRole(ctx context.Context, tx sqlc.DBTX, userID uuid.UUID, role string) error
+}
+
+type RoleRepository interface {
+	Create(ctx context.Context, tx sqlc.DBTX, name, description string) error
+	ReplacePermissions(ctx context.Context, tx sqlc.DBTX, name string, permissions []string) error
+	Delete(ctx context.Context, tx sqlc.DBTX, name string) error
+}
+
+type ResourceOperatorRepository interface {
+	Assign(ctx context.Context, tx sqlc.DBTX, resourceID, userID uuid.UUID) error
+	Unassign(ctx context.Context, tx sqlc.DBTX, resourceID, userID uuid.UUID) error
+}
+
+type ReservationTransferRepository interface {
+	Create(ctx context.Context, tx sqlc.DBTX, params sqlc.CreateReservationTransferParams) (uuid.UUID, error)
+	// SupersedePending retires the reservation's pending offer, if any.
+	SupersedePending(ctx context.Context, tx sqlc.DBTX, reservationID uuid.UUID) error
+	// MarkAccepted reports KindConflict unless the offer is pending with the given nonce.
+	MarkAccepted(ctx context.Context, tx sqlc.DBTX, transferID, nonce uuid.UUID, acceptedAt time.Time) error
+}
+
+type InviteRepository interface {
+	Create(ctx context.Context, tx sqlc.DBTX, params sqlc.CreateInviteParams) (uuid.UUID, error)
+	Reissue(ctx context.Context, tx sqlc.DBTX, inviteID, nonce uuid.UUID, expiresAt time.Time) error
+	Revoke(ctx context.Context, tx sqlc.DBTX, inviteID uuid.UUID) error
+	MarkAccepted(ctx context.Context, tx sqlc.DBTX, inviteID, nonce, userID uuid.UUID) error
+}
+
+type CompanyRepository interface {
+	Create(ctx context.Context, tx sqlc.DBTX, name string) (uuid.UUID, error)
+	CreateSettings(ctx context.Context, tx sqlc.DBTX, params sqlc.CreateCompanySettingsParams) error
+	// SetFeature reports KindForeignKeyViolated when the company does not exist.
+	SetFeature(ctx context.Context, tx sqlc.DBTX, companyID uuid.UUID, feature string, enabled bool, at time.Time) error
+	ClearFeature(ctx context.Context, tx sqlc.DBTX, companyID uuid.UUID, feature string) error
+	// SetReviewWindow reports KindForeignKeyViolated when the company does not exist.
+	SetReviewWindow(ctx context.Context, tx sqlc.DBTX, companyID uuid.UUID, setting ReviewWindowSetting, at time.Time) error
+	// SetBranding stores empty fields as NULL and reports KindForeignKeyViolated when the
+	// company does not exist. CompanyName is not stored.
+	SetBranding(ctx context.Context, tx sqlc.DBTX, companyID uuid.UUID, branding CompanyBranding, at time.Time) error
+}
+
+type ResourceRepository interface {
+	// CreateMany inserts all rows with a single COPY; callers assign the IDs.
+	CreateMany(ctx context.Context, tx sqlc.DBTX, params []sqlc.CreateResourcesParams) error
+}
+
+type ResourceBlockRepository interface {
+	// CreateSeries locks the resource against concurrent reservations, reports
+	// KindConflict when an occurrence overlaps a confirmed reservation and KindNotFound
+	// when the resource does not exist, and returns the IDs in occurrence order.
+	CreateSeries(ctx context.Context, tx sqlc.DBTX, series ResourceBlockSeries) ([]uuid.UUID, error)
+	// Delete removes one block, or it and the later occurrences of its series; it
+	// reports KindNotFound when blockID is not a block of resourceID.
+	Delete(ctx context.Context, tx sqlc.DBTX, resourceID, blockID uuid.UUID, laterOccurrences bool) (int64, error)
+	// Overlaps share-locks the resource (see CreateSeries) and reports whether a block
+	// overlaps [start, end).
+	Overlaps(ctx context.Context, tx sqlc.DBTX, resourceID uuid.UUID, start, end time.Time) (bool, error)
+}
+
+type AuditRepository interface {
+	Record(ctx context.Context, tx sqlc.DBTX, entry AuditEntry) error
+}
+
+type ActivityRepository interface {
+	Record(ctx context.Context, tx sqlc.DBTX, entry ActivityEntry) error
+	// Remove drops the entries of kind about subjectID, e.g. when a review is deleted.
+	Remove(ctx context.Context, tx sqlc.DBTX, kind ActivityKind, subjectID uuid.UUID) error
+}
+
+type ReferralRepository interface {
+	Create(ctx context.Context, tx sqlc.DBTX, referrerID, referredID uuid.UUID) (uuid.UUID, error)
+	MarkRewarded(ctx context.Context, tx sqlc.DBTX, referralID, reservationID uuid.UUID, rewardedAt time.Time) error
+	CreateCredit(ctx context.Context, tx sqlc.DBTX, referralID uuid.UUID, credit referral.Credit) error
+}
+
+type LoyaltyRepository interface {
+	// Accrue reports KindConflict when the reservation has already earned its points.
+	Accrue(ctx context.Context, tx sqlc.DBTX, accrual PointsAccrual, at time.Time) error
+	// LockOpenLots locks the user's unspent, unexpired lots until the transaction ends.
+	LockOpenLots(ctx context.Context, tx sqlc.DBTX, userID uuid.UUID, now time.Time) ([]loyalty.Lot, error)
+	Draw(ctx context.Context, tx sqlc.DBTX, draw loyalty.Draw) error
+	RecordRedemption(ctx context.Context, tx sqlc.DBTX, redemption PointsRedemption, at time.Time) error
+	ExpireLots(ctx context.Context, tx sqlc.DBTX, now time.Time, batchSize int32) (int64, error)
+}
+
+type TOSRepository interface {
+	// Accept keeps the first acceptance when the user accepts the same version again.
+	Accept(ctx context.Context, tx sqlc.DBTX, acceptance TOSAcceptance) error
+}
+
+type BillingRepository interface {
+	CreateSubscription(ctx context.Context, tx sqlc.DBTX, companyID uuid.UUID, planID, status string) error
+	// SyncSubscription keeps the stored state when it is newer than state.
+	SyncSubscription(ctx context.Context, tx sqlc.DBTX, state SubscriptionState) error
+	// RecordEvent returns false when the event was already recorded.
+	RecordEvent(ctx context.Context, tx sqlc.DBTX, eventID, eventType string) (bool, error)
+}
+
+type ProvisioningRepository interface {
+	// CreateToken reports KindForeignKeyViolated when the company does not exist.
+	CreateToken(ctx context.Context, tx sqlc.DBTX, companyID uuid.UUID, tokenHash []byte, createdBy uuid.UUID, at time.Time) (uuid.UUID, error)
+	// RevokeToken reports KindNotFound unless tokenID is an unrevoked token of the company.
+	RevokeToken(ctx context.Context, tx sqlc.DBTX, companyID, tokenID uuid.UUID, at time.Time) error
+	// SetUserActive reports KindNotFound when the user is not a member of the company.
+	SetUserActive(ctx context.Context, tx sqlc.DBTX, companyID, userID uuid.UUID, active bool, at time.Time) error
+}
+
+type SAMLConnectionRepository interface {
+	// Upsert reports KindForeignKeyViolated when the company or the default role does not exist.
+	Upsert(ctx context.Context, tx sqlc.DBTX, conn SAMLConnection, actorID uuid.UUID) error
+	// Delete reports KindNotFound when the company has no connection.
+	Delete(ctx context.Context, tx sqlc.DBTX, companyID uuid.UUID) error
+}
+
+type ReservationApprovalRepository interface {
+	Open(ctx context.Context, tx sqlc.DBTX, reservationID uuid.UUID, expiresAt time.Time) error
+	// Decide reports KindConflict when the request is no longer pending.
+	Decide(ctx context.Context, tx sqlc.DBTX, decision ApprovalDecision) error
+	// MarkEscalated returns false when the request was decided or escalated meanwhile.
+	MarkEscalated(ctx context.Context, tx sqlc.DBTX, reservationID uuid.UUID, at time.Time) (bool, error)
+	// ExpireDue expires up to batchSize requests due at now and cancels their reservations.
+	ExpireDue(ctx context.Context, tx sqlc.DBTX, now time.Time, batchSize int32) ([]ExpiredApproval, error)
+	// AddApprover is idempotent; an unknown resource or user surfaces as KindForeignKeyViolated.
+	AddApprover(ctx context.Context, tx sqlc.DBTX, resourceID, userID uuid.UUID) error
+	// RemoveApprover reports KindNotFound when userID does not approve the resource.
+	RemoveApprover(ctx context.Context, tx sqlc.DBTX, resourceID, userID uuid.UUID) error
+}
+
+type CustomFieldRepository interface {
+	// Create reports KindDuplicateKey when the company already has the key, KindNotFound when
+	// ResourceID is not a resource of the company and KindForeignKeyViolated when the
+	// company does not exist.
+	Create(ctx context.Context, tx sqlc.DBTX, def CustomFieldDefinition) (uuid.UUID, error)
+	// Delete reports KindNotFound when the company has no such field.
+	Delete(ctx context.Context, tx sqlc.DBTX, companyID, id uuid.UUID) error
+}
+
+type UsageRepository interface {
+	// Add accumulates delta into the user's company; users without a company are skipped.
+	Add(ctx context.Context, tx sqlc.DBTX, delta UsageDelta, at time.Time) error
+}
+
+type DeprecationRepository interface {
+	// Add accumulates calls into the consumer's counts for the day.
+	Add(ctx context.Context, tx sqlc.DBTX, calls DeprecatedRouteCalls) error
+}
+
+type CompanyExportRepository interface {
+	// Create reports KindDuplicateKey while the company has an export pending or running,
+	// and KindForeignKeyViolated when the company does not exist.
+	Create(ctx context.Context, tx sqlc.DBTX, export CompanyExport) error
+	// Claim starts the oldest pending export, or one running since before staleBefore;
+	// KindNotFound when there is none.
+	Claim(ctx context.Context, db sqlc.DBTX, now, staleBefore time.Time) (*CompanyExport, error)
+	SetProgress(ctx context.Context, db sqlc.DBTX, id uuid.UUID, progress int32) error
+	Complete(ctx context.Context, db sqlc.DBTX, id uuid.UUID, storageKey string, sizeBytes int64, completedAt, expiresAt time.Time) error
+	Fail(ctx context.Context, db sqlc.DBTX, id uuid.UUID, reason string, completedAt, expiresAt time.Time) error
+	// Delete removes the export and returns its archive's storage key, empty when none was
+	// written; KindNotFound when there is no such export.
+	Delete(ctx context.Context, tx sqlc.DBTX, id uuid.UUID) (string, error)
+}
+
+// UserMergeRepository moves what a duplicate account holds to the account it is merged into.
+type UserMergeRepository interface {
+	// Lock locks both accounts and returns those that exist.
+	Lock(ctx context.Context, tx sqlc.DBTX, sourceID, targetID uuid.UUID) ([]UserMergeAccount, error)
+	// Count reports what Merge would move.
+	Count(ctx context.Context, tx sqlc.DBTX, sourceID uuid.UUID, now time.Time) (*UserMergeCounts, error)
+	// Merge moves the source's reservations, reviews, referral credits, loyalty points,
+	// activity, sign-in identities, messages and transfers to the target and deactivates
+	// the source.
+	Merge(ctx context.Context, tx sqlc.DBTX, sourceID, targetID uuid.UUID, now time.Time) (*UserMergeCounts, error)
+}
+
+// CompanyDeletionRepository drives company hard-deletes. The purge methods touch at most
+// limit rows and report how many they did; a stage is finished once a call reports fewer.
+// NotificationDigestRepository sets users' digest mode and gathers the notifications held
+// for their digests.
+type NotificationDigestRepository interface {
+	// SetMode returns KindNotFound for unknown users.
+	SetMode(ctx context.Context, tx sqlc.DBTX, userID uuid.UUID, mode string) error
+	ListDue(ctx context.Context, tx sqlc.DBTX, now time.Time, limit int32) ([]DueNotificationDigest, error)
+	// Take marks the user's held notifications digested and returns them oldest first.
+	Take(ctx context.Context, tx sqlc.DBTX, userID uuid.UUID, now time.Time) ([]HeldNotification, error)
+}
+
+type CompanyDeletionRepository interface {
+	// FindTarget locks the company for the request; KindNotFound when it does not exist.
+	FindTarget(ctx context.Context, tx sqlc.DBTX, companyID, actorID uuid.UUID) (*CompanyDeletionTarget, error)
+	// Create reports KindDuplicateKey while the company already has a deletion under way.
+	Create(ctx context.Context, tx sqlc.DBTX, deletion CompanyDeletion) error
+	// Lock deactivates the company's members and revokes SSO, SCIM, invites and unfinished
+	// exports; it returns how many members were deactivated.
+	Lock(ctx context.Context, tx sqlc.DBTX, companyID uuid.UUID) (int64, error)
+	// Claim starts the least recently touched pending deletion, or one running without a
+	// checkpoint since staleBefore; KindNotFound when there is none.
+	Claim(ctx context.Context, db sqlc.DBTX, now, staleBefore time.Time) (*CompanyDeletion, error)
+	// Checkpoint saves the stage reached and the running counts.
+	Checkpoint(ctx context.Context, tx sqlc.DBTX, id uuid.UUID, stage CompanyDeletionStage, counts map[string]int64, now time.Time) error
+	// Release queues a deletion whose run failed again, recording why.
+	Release(ctx context.Context, db sqlc.DBTX, id uuid.UUID, reason string, now time.Time) error
+	Complete(ctx context.Context, tx sqlc.DBTX, id uuid.UUID, certificate json.RawMessage, completedAt time.Time) error
+
+	CancelReservations(ctx context.Context, tx sqlc.DBTX, companyID uuid.UUID, now time.Time, limit int32) ([]PurgedReservation, error)
+	AnonymizeReviews(ctx context.Context, tx sqlc.DBTX, companyID uuid.UUID, limit int32) (int64, error)
+	// ListObjects returns storage keys of files the company's data refers to.
+	ListObjects(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID, limit int32) ([]string, error)
+	// DeleteObjects removes the rows referring to files already deleted from storage.
+	DeleteObjects(ctx context.Context, tx sqlc.DBTX, keys []string) error
+	DeleteUsers(ctx context.Context, tx sqlc.DBTX, companyID uuid.UUID, limit int32) (int64, error)
+	DeleteResources(ctx context.Context, tx sqlc.DBTX, companyID uuid.UUID, limit int32) (int64, error)
+	// DeleteCompany removes the company row once nothing refers to it any more.
+	DeleteCompany(ctx context.Context, tx sqlc.DBTX, companyID uuid.UUID) error
+}
+
+type RequestRecordingRepository interface {
+	// Create reports KindForeignKeyViolated when the user does not exist.
+	Create(ctx context.Context, tx sqlc.DBTX, rec RequestRecording) error
+	// ClaimSlot takes the next capture of the user's oldest active recording, or reports
+	// KindNotFound when the user has none with room left.
+	ClaimSlot(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, now time.Time) (RecordingSlot, error)
+	AddCapture(ctx context.Context, db sqlc.DBTX, capture RecordedRequest) error
+	// Delete removes the recording with its captures and returns the captures' storage
+	// keys; KindNotFound when there is no such recording.
+	Delete(ctx context.Context, tx sqlc.DBTX, id uuid.UUID) ([]string, error)
+}
+
+type RefreshTokenRepository interface {
+	Create(ctx context.Context, tx sqlc.DBTX, token RefreshToken) error
+	// FindForUpdate locks the token for rotation; KindNotFound when it was never stored.
+	FindForUpdate(ctx context.Context, tx sqlc.DBTX, id uuid.UUID) (*RefreshToken, error)
+	MarkRotated(ctx context.Context, tx sqlc.DBTX, id uuid.UUID, at time.Time) error
+	// TouchSession records the client a token of the session was issued to, creating the
+	// session on its first token.
+	TouchSession(ctx context.Context, tx sqlc.DBTX, session Session) error
+	// RevokeSession revokes every token of the session that is not revoked yet.
+	RevokeSession(ctx context.Context, tx sqlc.DBTX, sessionID uuid.UUID, at time.Time) error
+	// RevokeUserSession revokes the user's live session with its tokens; KindNotFound when
+	// the user has no such session, or it has already ended.
+	RevokeUserSession(ctx context.Context, tx sqlc.DBTX, userID, sessionID uuid.UUID, at time.Time) error
+	// RevokeToken denylists a token without a session until it expires.
+	RevokeToken(ctx context.Context, tx sqlc.DBTX, token IssuedToken, at time.Time) error
+	// RevokeUser revokes all of the user's sessions and every token without one issued
+	// before at; KindForeignKeyViolated when the user does not exist.
+	RevokeUser(ctx context.Context, tx sqlc.DBTX, userID uuid.UUID, at time.Time) error
+}
+
+type EmailVerificationRepository interface {
+	Create(ctx context.Context, tx sqlc.DBTX, userID, nonce uuid.UUID, expiresAt, createdAt time.Time) error
+	// Consume deletes the user's pending verification if nonce matches and returns its
+	// expiry; KindNotFound otherwise.
+	Consume(ctx context.Context, tx sqlc.DBTX, userID, nonce uuid.UUID) (time.Time, error)
+}
+
+type RetentionRepository interface {
+	CountExpired(ctx context.Context, db sqlc.DBTX, table string, cutoff time.Time) (int64, error)
+	DeleteExpiredBatch(ctx context.Context, db sqlc.DBTX, table string, cutoff time.Time, batchSize int32) (int64, error)
+}
+
+// CursorRepository finds and repairs stored positions later than limit. Repair moves them
+// back to now, or clears them where the next event re-establishes the position.
+type CursorRepository interface {
+	CountInvalid(ctx context.Context, db sqlc.DBTX, cursor string, limit time.Time) (int64, error)
+	Repair(ctx context.Context, db sqlc.DBTX, cursor string, limit, now time.Time) (int64, error)
+}
+
+type ReservationMessageRepository interface {
+	// Create stores the message (encrypted when a key is configured) and bumps the
+	// reservation's updated_at, which its ETag derives from.
+	Create(ctx context.Context, tx sqlc.DBTX, msg ReservationMessage) (uuid.UUID, error)
+	// MarkRead marks the thread read for side up to readAt; the marker never moves back.
+	MarkRead(ctx context.Context, tx sqlc.DBTX, reservationID uuid.UUID, side string, readAt time.Time) error
+}
+
+type ReservationAttachmentRepository interface {
+	Create(ctx context.Context, tx sqlc.DBTX, attachment ReservationAttachment) error
+	// Delete removes the attachment's row; a missing row is KindNotFound.
+	Delete(ctx context.Context, tx sqlc.DBTX, reservationID, id uuid.UUID) error
+}
+
+type MFARepository interface {
+	// SavePending stores secret encrypted as the user's enrollment, replacing one that is
+	// not enabled yet; an enabled enrollment is kept.
+	SavePending(ctx context.Context, tx sqlc.DBTX, userID uuid.UUID, secret string, now time.Time) error
+	// FindForUpdate locks the user's enrollment; KindNotFound when there is none.
+	FindForUpdate(ctx context.Context, tx sqlc.DBTX, userID uuid.UUID) (*UserMFA, error)
+	IsEnabled(ctx context.Context, db sqlc.DBTX, userID uuid.UUID) (bool, error)
+	// Enable turns the enrollment on, with step as its last used step, and replaces its
+	// recovery codes with codeHashes.
+	Enable(ctx context.Context, tx sqlc.DBTX, userID uuid.UUID, step int64, codeHashes []string, at time.Time) error
+	// SaveAttempts stores LastUsedStep, FailedAttempts and LockedUntil of m.
+	SaveAttempts(ctx context.Context, tx sqlc.DBTX, m UserMFA) error
+	// UseRecoveryCode spends the unused recovery code with codeHash; KindNotFound when
+	// there is none.
+	UseRecoveryCode(ctx context.Context, tx sqlc.DBTX, userID uuid.UUID, codeHash string, at time.Time) error
+	// Delete removes the enrollment with its recovery codes.
+	Delete(ctx context.Context, tx sqlc.DBTX, userID uuid.UUID) error
+}
+
+// UserIdentityRepository links accounts at OpenID Connect providers to users.
+type UserIdentityRepository interface {
+	// FindUserID is the user the provider's subject signs in as; KindNotFound when it is
+	// not linked.
+	FindUserID(ctx context.Context, db sqlc.DBTX, provider, subject string) (uuid.UUID, error)
+	// Create links the subject to userID; KindDuplicateKey when it is linked already.
+	Create(ctx context.Context, tx sqlc.DBTX, provider, subject string, userID uuid.UUID, email string, at time.Time) error
+	// Touch records a sign-in with the email the provider reported.
+	Touch(ctx context.Context, tx sqlc.DBTX, provider, subject, email string, at time.Time) error
+}
+
+// PlatformStatsRepository keeps the platform-wide totals public pages show.
+type PlatformStatsRepository interface {
+	// Refresh recomputes the totals from the resources and their rating stats.
+	Refresh(ctx context.Context, db sqlc.DBTX, at time.Time) error
+}
+
+// APIKeyRepository stores the keys machine clients authenticate with.
+type APIKeyRepository interface {
+	// Create stores a key of userID by its hash; KindForeignKeyViolated when the user does
+	// not exist. A nil expiresAt never expires.
+	Create(ctx context.Context, tx sqlc.DBTX, userID uuid.UUID, name string, keyHash []byte, scopes []string, createdBy uuid.UUID, at time.Time, expiresAt *time.Time) (uuid.UUID, error)
+	// Revoke revokes a live key of userID; KindNotFound when there is none.
+	Revoke(ctx context.Context, tx sqlc.DBTX, userID, keyID uuid.UUID, at time.Time) error
+}
+
+// LoginFailureRepository counts failed sign-ins per email and client IP. A count lapses
+// when its row expires, and a lockout ends at its locked-until time.
+type LoginFailureRepository interface {
+	// LockedUntil is when the live lockout of email from ip ends, nil when there is none.
+	LockedUntil(ctx context.Context, db sqlc.DBTX, email, ip string, now time.Time) (*time.Time, error)
+	// RecordFailure counts a failed sign-in, keeps the count until expiresAt and returns it.
+	RecordFailure(ctx context.Context, db sqlc.DBTX, email, ip string, now, expiresAt time.Time) (int, error)
+	Lock(ctx context.Context, db sqlc.DBTX, email, ip string, until time.Time) error
+	Clear(ctx context.Context, db sqlc.DBTX, email, ip string) error
+	// Unlock lifts the live lockouts of email from every IP and returns how many there were.
+	Unlock(ctx context.Context, db sqlc.DBTX, email string, now time.Time) (int64, error)
 }
@@ -0,0 +1,44 @@
+package shared
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UsageMeter counts API requests per user in memory; Flush adds the counts to each
+// company's monthly usage, so metering adds no database work to a request.
+type UsageMeter interface {
+	Record(userID uuid.UUID, bytesIn, bytesOut int64)
+	// Flush writes what was recorded since the last flush and returns how many
+	// user-months it wrote. Counts that fail to write are kept for the next flush.
+	Flush(ctx context.Context) (int, error)
+}
+
+// UsageQuotaGate reports a user's company usage against its monthly request quotas.
+type UsageQuotaGate interface {
+	// Check returns nil when the user's company has no quota.
+	Check(ctx context.Context, userID uuid.UUID) (*UsageQuotaStatus, error)
+}
+
+type UsageQuotaStatus struct {
+	Used      int64
+	SoftLimit *int64
+	HardLimit *int64
+	ResetsAt  time.Time // start of the next month, UTC
+}
+
+func (s *UsageQuotaStatus) SoftExceeded() bool {
+	return s.SoftLimit != nil && s.Used >= *s.SoftLimit
+}
+
+func (s *UsageQuotaStatus) HardExceeded() bool {
+	return s.HardLimit != nil && s.Used >= *s.HardLimit
+}
+
+// UsageMonth returns the first instant of t's month in UTC, the key usage is stored under.
+func UsageMonth(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
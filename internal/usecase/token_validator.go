@@ -3,13 +3,30 @@ package usecase
 import (
 	"gin-clean-starter/internal/domain/user"
 	"gin-clean-starter/internal/pkg/jwt"
+	"gin-clean-starter/internal/usecase/shared"
 
 	"github.com/google/uuid"
 )
 
+// AccessIdentity is the caller resolved from an access or support token.
+type AccessIdentity struct {
+	UserID uuid.UUID
+	Role   user.Role
+	// Token identifies an access token for revocation checks; nil for support tokens,
+	// which end with their support session.
+	Token *shared.IssuedToken
+	// Support is set only for read-only support tokens.
+	Support *SupportScope
+}
+
+type SupportScope struct {
+	SessionID uuid.UUID
+	CompanyID uuid.UUID
+}
+
 // TokenValidator provides token validation for middleware
 type TokenValidator interface {
-	ValidateToken(tokenString string) (uuid.UUID, user.Role, error)
+	ValidateToken(tokenString string) (*AccessIdentity, error)
 }
 
 type tokenValidatorImpl struct {
@@ -22,16 +39,37 @@ func NewTokenValidator(jwtService *jwt.Service) TokenValidator {
 	}
 }
 
-func (t *tokenValidatorImpl) ValidateToken(tokenString string) (uuid.UUID, user.Role, error) {
+func (t *tokenValidatorImpl) ValidateToken(tokenString string) (*AccessIdentity, error) {
 	claims, err := t.jwtService.ValidateToken(tokenString)
 	if err != nil {
-		return uuid.Nil, "", err
+		return nil, err
+	}
+	// Refresh tokens outlive access tokens and are only device-checked on refresh,
+	// so they must never authenticate a request.
+	if claims.TokenType != jwt.TokenTypeAccess && claims.TokenType != jwt.TokenTypeSupport {
+		return nil, jwt.ErrInvalidToken
 	}
 
 	role, err := user.NewRole(claims.Role)
 	if err != nil {
-		return uuid.Nil, "", err
+		return nil, err
+	}
+
+	identity := &AccessIdentity{UserID: claims.UserID, Role: role}
+	if claims.TokenType == jwt.TokenTypeSupport {
+		sessionID, perr := uuid.Parse(claims.ID)
+		if perr != nil || claims.CompanyID == nil {
+			return nil, jwt.ErrInvalidToken
+		}
+		identity.Support = &SupportScope{SessionID: sessionID, CompanyID: *claims.CompanyID}
+		return identity, nil
+	}
+
+	token, err := shared.IssuedTokenFromClaims(claims)
+	if err != nil {
+		return nil, err
 	}
+	identity.Token = &token
 
-	return claims.UserID, role, nil
+	return identity, nil
 }
@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+// tosGateImpl caches the current version for ttl, so a newly published version applies
+// within ttl. Acceptances of the cached version are remembered, since they are never withdrawn.
+type tosGateImpl struct {
+	uow   shared.UnitOfWork
+	store shared.TOSReadStore
+	clock clock.Clock
+	ttl   time.Duration
+
+	mu        sync.RWMutex
+	current   *shared.TOSVersion // nil while no version is published
+	expiresAt time.Time
+	accepted  map[uuid.UUID]struct{}
+}
+
+func NewTOSGate(uow shared.UnitOfWork, store shared.TOSReadStore, clock clock.Clock, ttl time.Duration) shared.TOSGate {
+	return &tosGateImpl{
+		uow:      uow,
+		store:    store,
+		clock:    clock,
+		ttl:      ttl,
+		accepted: make(map[uuid.UUID]struct{}),
+	}
+}
+
+func (g *tosGateImpl) PendingVersion(ctx context.Context, userID uuid.UUID) (*shared.TOSVersion, error) {
+	current, err := g.currentVersion(ctx)
+	if err != nil || current == nil {
+		return nil, err
+	}
+
+	g.mu.RLock()
+	_, ok := g.accepted[userID]
+	g.mu.RUnlock()
+	if ok {
+		return nil, nil
+	}
+
+	accepted, err := g.store.HasAccepted(ctx, g.uow.DB(ctx), userID, current.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !accepted {
+		return current, nil
+	}
+
+	g.mu.Lock()
+	if g.current != nil && g.current.ID == current.ID {
+		g.accepted[userID] = struct{}{}
+	}
+	g.mu.Unlock()
+	return nil, nil
+}
+
+func (g *tosGateImpl) currentVersion(ctx context.Context) (*shared.TOSVersion, error) {
+	now := g.clock.Now()
+
+	g.mu.RLock()
+	current, expiresAt := g.current, g.expiresAt
+	g.mu.RUnlock()
+	if now.Before(expiresAt) {
+		return current, nil
+	}
+
+	current, err := g.store.FindCurrent(ctx, g.uow.DB(ctx), now)
+	if err != nil && !infra.IsKind(err, infra.KindNotFound) {
+		return nil, err
+	}
+
+	g.mu.Lock()
+	if current == nil || g.current == nil || g.current.ID != current.ID {
+		g.accepted = make(map[uuid.UUID]struct{})
+	}
+	g.current, g.expiresAt = current, now.Add(g.ttl)
+	g.mu.Unlock()
+
+	return current, nil
+}
@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+type usageKey struct {
+	userID uuid.UUID
+	month  time.Time
+}
+
+// usageMeterImpl keeps per-user counters between flushes. Counters are per user rather
+// than per company so a request never needs the company lookup; the flush query resolves it.
+type usageMeterImpl struct {
+	uow   shared.UnitOfWork
+	repo  shared.UsageRepository
+	clock clock.Clock
+
+	mu      sync.Mutex
+	pending map[usageKey]*shared.UsageDelta
+}
+
+func NewUsageMeter(uow shared.UnitOfWork, repo shared.UsageRepository, clock clock.Clock) shared.UsageMeter {
+	return &usageMeterImpl{
+		uow:     uow,
+		repo:    repo,
+		clock:   clock,
+		pending: make(map[usageKey]*shared.UsageDelta),
+	}
+}
+
+func (m *usageMeterImpl) Record(userID uuid.UUID, bytesIn, bytesOut int64) {
+	key := usageKey{userID: userID, month: shared.UsageMonth(m.clock.Now())}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	d, ok := m.pending[key]
+	if !ok {
+		d = &shared.UsageDelta{UserID: userID, Month: key.month}
+		m.pending[key] = d
+	}
+	d.Requests++
+	d.BytesIn += max(bytesIn, 0)
+	d.BytesOut += max(bytesOut, 0)
+}
+
+func (m *usageMeterImpl) Flush(ctx context.Context) (int, error) {
+	m.mu.Lock()
+	batch := m.pending
+	m.pending = make(map[usageKey]*shared.UsageDelta)
+	m.mu.Unlock()
+
+	if len(batch) == 0 {
+		return 0, nil
+	}
+
+	now := m.clock.Now()
+	err := m.uow.Within(ctx, func(ctx context.Context, tx shared.Tx) error {
+		for _, d := range batch {
+			if err := m.repo.Add(ctx, tx.DB(), *d, now); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		m.restore(batch)
+		return 0, err
+	}
+	return len(batch), nil
+}
+
+// restore merges a batch that failed to write back into the counters.
+func (m *usageMeterImpl) restore(batch map[usageKey]*shared.UsageDelta) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for key, d := range batch {
+		if cur, ok := m.pending[key]; ok {
+			cur.Requests += d.Requests
+			cur.BytesIn += d.BytesIn
+			cur.BytesOut += d.BytesOut
+			continue
+		}
+		m.pending[key] = d
+	}
+}
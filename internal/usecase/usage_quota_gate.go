@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"github.com/google/uuid"
+)
+
+// Expired entries are swept once the cache holds this many users.
+const quotaCacheSweepSize = 10000
+
+type cachedQuota struct {
+	status    *shared.UsageQuotaStatus // nil when the company has no quota
+	expiresAt time.Time
+}
+
+// usageQuotaGateImpl caches each user's quota status for ttl. Usage itself reaches the
+// database on the meter's flush, so a hard quota is enforced within one flush interval
+// plus ttl of being reached.
+type usageQuotaGateImpl struct {
+	uow   shared.UnitOfWork
+	store shared.UsageReadStore
+	clock clock.Clock
+	ttl   time.Duration
+
+	mu    sync.RWMutex
+	cache map[uuid.UUID]cachedQuota
+}
+
+func NewUsageQuotaGate(uow shared.UnitOfWork, store shared.UsageReadStore, clock clock.Clock, ttl time.Duration) shared.UsageQuotaGate {
+	return &usageQuotaGateImpl{
+		uow:   uow,
+		store: store,
+		clock: clock,
+		ttl:   ttl,
+		cache: make(map[uuid.UUID]cachedQuota),
+	}
+}
+
+func (g *usageQuotaGateImpl) Check(ctx context.Context, userID uuid.UUID) (*shared.UsageQuotaStatus, error) {
+	now := g.clock.Now()
+
+	g.mu.RLock()
+	entry, ok := g.cache[userID]
+	g.mu.RUnlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.status, nil
+	}
+
+	month := shared.UsageMonth(now)
+	quota, err := g.store.FindQuota(ctx, g.uow.DB(ctx), userID, month)
+	if err != nil && !infra.IsKind(err, infra.KindNotFound) {
+		return nil, err
+	}
+
+	var status *shared.UsageQuotaStatus
+	if quota != nil && (quota.SoftLimit != nil || quota.HardLimit != nil) {
+		status = &shared.UsageQuotaStatus{
+			Used:      quota.Used,
+			SoftLimit: quota.SoftLimit,
+			HardLimit: quota.HardLimit,
+			ResetsAt:  month.AddDate(0, 1, 0),
+		}
+	}
+
+	g.mu.Lock()
+	if len(g.cache) >= quotaCacheSweepSize {
+		for id, e := range g.cache {
+			if !now.Before(e.expiresAt) {
+				delete(g.cache, id)
+			}
+		}
+	}
+	g.cache[userID] = cachedQuota{status: status, expiresAt: now.Add(g.ttl)}
+	g.mu.Unlock()
+
+	return status, nil
+}
@@ -0,0 +1,47 @@
+-- Roles are data rather than a CHECK constraint so admins can add custom roles.
+CREATE TABLE roles (
+    name TEXT PRIMARY KEY CHECK (name ~ '^[a-z][a-z0-9_]{1,49}$'),
+    description TEXT NOT NULL DEFAULT '',
+    is_system BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- Permission names follow <resource>:<action>[:<scope>]; "*" in a granted name matches any remaining segments.
+CREATE TABLE permissions (
+    name TEXT PRIMARY KEY,
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE role_permissions (
+    role_name TEXT NOT NULL REFERENCES roles(name) ON DELETE CASCADE,
+    permission_name TEXT NOT NULL REFERENCES permissions(name) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (role_name, permission_name)
+);
+
+CREATE INDEX idx_role_permissions_permission ON role_permissions (permission_name);
+
+INSERT INTO roles (name, description, is_system) VALUES
+    ('viewer', 'Makes and manages own reservations and reviews', true),
+    ('operator', 'Viewer plus read access to everyone''s reservations and reviews', true),
+    ('admin', 'Full access', true);
+
+INSERT INTO permissions (name, description) VALUES
+    ('*', 'Every permission'),
+    ('reservations:read:any', 'Read reservations of any user'),
+    ('reviews:read:any', 'Read reviews of any user'),
+    ('reviews:delete:any', 'Delete reviews of any user'),
+    ('retention:read', 'View data retention reports'),
+    ('roles:manage', 'Manage custom roles and their permissions');
+
+-- The hierarchy viewer < operator < admin is expressed as cumulative grants.
+INSERT INTO role_permissions (role_name, permission_name) VALUES
+    ('operator', 'reservations:read:any'),
+    ('operator', 'reviews:read:any'),
+    ('admin', '*');
+
+ALTER TABLE users DROP CONSTRAINT users_role_check;
+ALTER TABLE users
+ADD CONSTRAINT users_role_fkey FOREIGN KEY (role) REFERENCES roles(name);
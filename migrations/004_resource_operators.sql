@@ -0,0 +1,32 @@
+-- Operators act only on the resources they are assigned to.
+CREATE TABLE resource_operators (
+    resource_id UUID NOT NULL REFERENCES resources(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (resource_id, user_id)
+);
+
+CREATE INDEX idx_resource_operators_user_id ON resource_operators (user_id);
+
+CREATE INDEX idx_reservations_created_desc ON reservations (created_at DESC, id DESC);
+
+INSERT INTO permissions (name, description) VALUES
+    ('reservations:read:assigned', 'Read reservations on assigned resources'),
+    ('reservations:cancel:any', 'Cancel reservations of any user'),
+    ('reservations:cancel:assigned', 'Cancel reservations on assigned resources'),
+    ('reviews:delete:assigned', 'Delete reviews on assigned resources'),
+    ('resource_operators:manage', 'Assign operators to resources');
+
+-- Operators lose global reservation access in favour of assignment-scoped grants.
+DELETE FROM role_permissions
+WHERE role_name = 'operator' AND permission_name = 'reservations:read:any';
+
+INSERT INTO role_permissions (role_name, permission_name) VALUES
+    ('operator', 'reservations:read:assigned'),
+    ('operator', 'reservations:cancel:assigned'),
+    ('operator', 'reviews:delete:assigned');
+
+UPDATE roles
+SET description = 'Viewer plus read access to reviews and moderation of assigned resources',
+    updated_at = now()
+WHERE name = 'operator';
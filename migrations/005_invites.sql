@@ -0,0 +1,22 @@
+-- Pending invites for new company members; the nonce is embedded in the signed
+-- invite link and rotated on resend so earlier links stop working.
+CREATE TABLE invites (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    email CITEXT NOT NULL,
+    company_id UUID NOT NULL REFERENCES companies(id) ON DELETE CASCADE,
+    role TEXT NOT NULL REFERENCES roles(name),
+    invited_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    nonce UUID NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'accepted', 'revoked')),
+    expires_at TIMESTAMPTZ NOT NULL,
+    accepted_user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX idx_invites_company_email_pending ON invites (company_id, email) WHERE status = 'pending';
+
+CREATE INDEX idx_invites_company_created ON invites (company_id, created_at DESC, id DESC);
+
+INSERT INTO permissions (name, description) VALUES
+    ('invites:manage', 'Invite new members to the caller''s company');
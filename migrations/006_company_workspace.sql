@@ -0,0 +1,13 @@
+-- Per-company defaults written when a workspace is registered.
+CREATE TABLE company_settings (
+    company_id UUID PRIMARY KEY REFERENCES companies(id) ON DELETE CASCADE,
+    timezone TEXT NOT NULL DEFAULT 'UTC',
+    default_lead_time_min INTEGER NOT NULL DEFAULT 0 CHECK (default_lead_time_min >= 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- Resources created for a workspace belong to its company; existing resources stay shared (NULL).
+ALTER TABLE resources ADD COLUMN company_id UUID REFERENCES companies(id) ON DELETE CASCADE;
+
+CREATE INDEX idx_resources_company_id ON resources (company_id);
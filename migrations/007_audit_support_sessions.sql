@@ -0,0 +1,19 @@
+-- Append-only trail of security-relevant actions; actor and company survive deletion as NULL.
+CREATE TABLE audit_logs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    actor_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    company_id UUID REFERENCES companies(id) ON DELETE SET NULL,
+    action TEXT NOT NULL,
+    target_type TEXT NOT NULL DEFAULT '',
+    target_id TEXT NOT NULL DEFAULT '',
+    metadata JSONB NOT NULL DEFAULT '{}'::jsonb,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_audit_logs_created_at ON audit_logs (created_at);
+CREATE INDEX idx_audit_logs_actor_created ON audit_logs (actor_id, created_at DESC);
+CREATE INDEX idx_audit_logs_company_created ON audit_logs (company_id, created_at DESC);
+
+INSERT INTO permissions (name, description) VALUES
+    ('support:access', 'Open read-only support sessions scoped to a company')
+ON CONFLICT (name) DO NOTHING;
@@ -0,0 +1,10 @@
+-- Every reservation list is keyset-paginated on (created_at DESC, id DESC) behind an equality filter.
+-- Indexes matching filter + sort order let each page be an index range scan that stops after LIMIT rows,
+-- so page N costs the same as page 1 (OFFSET would scan and discard all earlier rows).
+
+-- Admin listing filtered by resource (GET /api/admin/reservations?resource_id=...).
+CREATE INDEX idx_reservations_resource_created_desc ON reservations (resource_id, created_at DESC, id DESC);
+
+-- Plain single-column indexes are now prefixes of the composite ones above and in 001.
+DROP INDEX idx_reservations_resource_id;
+DROP INDEX idx_reservations_user_id;
@@ -0,0 +1,15 @@
+-- Several coupons may apply to one reservation (see PRICING_COUPON_STACKING).
+-- Higher priority coupons apply first when they stack and win ties under best_of.
+ALTER TABLE coupons ADD COLUMN priority INTEGER NOT NULL DEFAULT 0;
+
+-- Applied-discount line items, in application order. reservations.coupon_id keeps the first one.
+CREATE TABLE reservation_discounts (
+    reservation_id UUID NOT NULL REFERENCES reservations (id) ON DELETE CASCADE,
+    position SMALLINT NOT NULL,
+    coupon_id UUID NOT NULL REFERENCES coupons (id),
+    amount_cents INTEGER NOT NULL CHECK (amount_cents >= 0),
+    PRIMARY KEY (reservation_id, position),
+    UNIQUE (reservation_id, coupon_id)
+);
+
+CREATE INDEX idx_reservation_discounts_coupon_id ON reservation_discounts (coupon_id);
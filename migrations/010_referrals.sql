@@ -0,0 +1,35 @@
+-- Every user gets a shareable referral code; existing rows are backfilled by the default.
+ALTER TABLE users
+    ADD COLUMN referral_code CITEXT NOT NULL
+        DEFAULT upper(substr(replace(gen_random_uuid()::text, '-', ''), 1, 10));
+ALTER TABLE users ADD CONSTRAINT users_referral_code_key UNIQUE (referral_code);
+
+-- A user is referred at most once, at registration. The referral is rewarded once the
+-- referred user completes (a confirmed slot has ended) a first reservation.
+CREATE TABLE referrals (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    referrer_id UUID NOT NULL REFERENCES users (id),
+    referred_id UUID NOT NULL UNIQUE REFERENCES users (id),
+    status TEXT NOT NULL CHECK (status IN ('pending', 'rewarded')) DEFAULT 'pending',
+    reservation_id UUID REFERENCES reservations (id),
+    rewarded_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CHECK (referrer_id <> referred_id),
+    CHECK ((status = 'rewarded') = (rewarded_at IS NOT NULL))
+);
+
+CREATE INDEX idx_referrals_referrer_created ON referrals (referrer_id, created_at DESC, id DESC);
+CREATE INDEX idx_referrals_pending ON referrals (created_at, id) WHERE status = 'pending';
+
+-- Reward credits; one per party of a rewarded referral.
+CREATE TABLE referral_credits (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    referral_id UUID NOT NULL REFERENCES referrals (id),
+    user_id UUID NOT NULL REFERENCES users (id),
+    party TEXT NOT NULL CHECK (party IN ('referrer', 'referred')),
+    amount_cents INTEGER NOT NULL CHECK (amount_cents > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (referral_id, party)
+);
+
+CREATE INDEX idx_referral_credits_user_id ON referral_credits (user_id);
@@ -0,0 +1,33 @@
+-- Loyalty points ledger; a user's entries sum to their lifetime balance.
+-- Accruals are lots that expire: remaining_points tracks how much of a lot is unspent,
+-- redemptions draw lots down soonest-expiring first and expiry zeroes what is left.
+CREATE TABLE loyalty_ledger (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users (id),
+    kind TEXT NOT NULL CHECK (kind IN ('accrual', 'redemption', 'expiry')),
+    points INTEGER NOT NULL,
+    remaining_points INTEGER CHECK (remaining_points >= 0),
+    expires_at TIMESTAMPTZ,
+    reservation_id UUID REFERENCES reservations (id),
+    lot_id UUID REFERENCES loyalty_ledger (id),
+    discount_cents INTEGER CHECK (discount_cents >= 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CHECK ((kind = 'accrual') = (points > 0)),
+    CHECK ((kind = 'accrual') = (remaining_points IS NOT NULL AND expires_at IS NOT NULL)),
+    CHECK (remaining_points <= points),
+    CHECK ((kind = 'expiry') = (lot_id IS NOT NULL)),
+    CHECK ((kind = 'expiry') = (reservation_id IS NULL)),
+    CHECK ((kind = 'redemption') = (discount_cents IS NOT NULL))
+);
+
+-- One accrual and at most one redemption per reservation; one expiry per lot.
+CREATE UNIQUE INDEX uq_loyalty_ledger_reservation_kind ON loyalty_ledger (reservation_id, kind)
+WHERE reservation_id IS NOT NULL;
+CREATE UNIQUE INDEX uq_loyalty_ledger_lot_id ON loyalty_ledger (lot_id) WHERE lot_id IS NOT NULL;
+
+CREATE INDEX idx_loyalty_ledger_user_created ON loyalty_ledger (user_id, created_at DESC, id DESC);
+CREATE INDEX idx_loyalty_ledger_open_lots ON loyalty_ledger (user_id, expires_at, id) WHERE remaining_points > 0;
+CREATE INDEX idx_loyalty_ledger_expiring_lots ON loyalty_ledger (expires_at, id) WHERE remaining_points > 0;
+
+-- Accrual scans confirmed reservations by the end of their slot.
+CREATE INDEX idx_reservations_confirmed_ended ON reservations (upper(slot), id) WHERE status = 'confirmed';
@@ -0,0 +1,24 @@
+-- Self-registered workspace owners get a company-scoped role instead of the global
+-- admin: they manage invites and operate only their own company's resources.
+INSERT INTO roles (name, description, is_system) VALUES
+    ('owner', 'Runs a self-registered company: invites members and operates its resources', true);
+
+INSERT INTO role_permissions (role_name, permission_name) VALUES
+    ('owner', 'reservations:read:assigned'),
+    ('owner', 'reservations:cancel:assigned'),
+    ('owner', 'reviews:delete:assigned'),
+    ('owner', 'invites:manage');
+
+-- Earlier registrations made the owner a global admin; only registered companies have settings.
+INSERT INTO resource_operators (resource_id, user_id)
+SELECT r.id, u.id
+FROM users u
+JOIN company_settings cs ON cs.company_id = u.company_id
+JOIN resources r ON r.company_id = u.company_id
+WHERE u.role = 'admin'
+ON CONFLICT DO NOTHING;
+
+UPDATE users u
+SET role = 'owner', updated_at = now()
+FROM company_settings cs
+WHERE cs.company_id = u.company_id AND u.role = 'admin';
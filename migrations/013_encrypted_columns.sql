@@ -0,0 +1,5 @@
+-- Envelope ciphertext (internal/pkg/crypto) for sensitive free-text columns. The
+-- plaintext note column keeps rows written before encryption, or without keys.
+ALTER TABLE reservations ADD COLUMN note_ciphertext TEXT;
+
+ALTER TABLE users ADD COLUMN phone_ciphertext TEXT;
@@ -0,0 +1,3 @@
+-- Referral codes are generated by the application, which retries on a collision; a
+-- column default would turn a collision into a failed registration.
+ALTER TABLE users ALTER COLUMN referral_code DROP DEFAULT;
@@ -0,0 +1,19 @@
+-- Terms-of-service versions; the current one is the latest already published.
+CREATE TABLE tos_versions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    version TEXT NOT NULL UNIQUE,
+    url TEXT NOT NULL,
+    published_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_tos_versions_published_at ON tos_versions (published_at DESC);
+
+-- One row per user and accepted version, kept as compliance evidence.
+CREATE TABLE tos_acceptances (
+    user_id UUID NOT NULL REFERENCES users (id),
+    tos_version_id UUID NOT NULL REFERENCES tos_versions (id),
+    accepted_at TIMESTAMPTZ NOT NULL,
+    ip_address TEXT,
+    PRIMARY KEY (user_id, tos_version_id)
+);
@@ -0,0 +1,12 @@
+-- Devices each user has logged in from, so a login from an unseen one can be flagged.
+CREATE TABLE user_devices (
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    fingerprint TEXT NOT NULL,
+    first_seen_at TIMESTAMPTZ NOT NULL,
+    last_seen_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (user_id, fingerprint)
+);
+
+-- Serves GET /users/me/security-events: audit entries that target a user, newest first.
+CREATE INDEX idx_audit_logs_user_target ON audit_logs (target_id, created_at DESC, id DESC)
+WHERE target_type = 'user';
@@ -0,0 +1,23 @@
+-- Time ranges an operator has taken a resource out of service (maintenance, private
+-- events); reservations must not overlap them. A recurring request inserts one row per
+-- occurrence, all sharing series_id.
+CREATE TABLE resource_blocks (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    resource_id UUID NOT NULL REFERENCES resources(id) ON DELETE CASCADE,
+    series_id UUID NOT NULL,
+    slot TSTZRANGE NOT NULL,
+    reason TEXT NOT NULL,
+    created_by UUID NOT NULL REFERENCES users(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_resource_blocks_resource_slot ON resource_blocks USING gist (resource_id, slot);
+CREATE INDEX idx_resource_blocks_series ON resource_blocks (series_id);
+
+INSERT INTO permissions (name, description) VALUES
+    ('resource_blocks:manage:any', 'Block time ranges on any resource'),
+    ('resource_blocks:manage:assigned', 'Block time ranges on assigned resources');
+
+INSERT INTO role_permissions (role_name, permission_name) VALUES
+    ('operator', 'resource_blocks:manage:assigned'),
+    ('owner', 'resource_blocks:manage:assigned');
@@ -0,0 +1,39 @@
+-- Messages between the reservation's user and the resource's operators. They replace the
+-- single reservations.note; existing notes become the first message of their thread.
+CREATE TABLE reservation_messages (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    reservation_id UUID NOT NULL REFERENCES reservations (id) ON DELETE CASCADE,
+    author_id UUID NOT NULL REFERENCES users (id),
+    author_side TEXT NOT NULL CHECK (author_side IN ('user', 'operator')),
+    body TEXT,
+    body_ciphertext TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CHECK (body IS NOT NULL OR body_ciphertext IS NOT NULL)
+);
+
+-- Threads page oldest first by keyset (created_at, id).
+CREATE INDEX idx_reservation_messages_thread ON reservation_messages (reservation_id, created_at, id);
+
+-- How far each side has read a thread; messages from the other side after last_read_at are unread.
+CREATE TABLE reservation_message_reads (
+    reservation_id UUID NOT NULL REFERENCES reservations (id) ON DELETE CASCADE,
+    side TEXT NOT NULL CHECK (side IN ('user', 'operator')),
+    last_read_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (reservation_id, side)
+);
+
+-- Note ciphertext moves as is: messages use the note's associated data (author's user ID).
+INSERT INTO reservation_messages (reservation_id, author_id, author_side, body, body_ciphertext, created_at)
+SELECT id, user_id, 'user', note, note_ciphertext, created_at
+FROM reservations
+WHERE note IS NOT NULL OR note_ciphertext IS NOT NULL;
+
+ALTER TABLE reservations DROP COLUMN note, DROP COLUMN note_ciphertext;
+
+INSERT INTO permissions (name, description) VALUES
+    ('reservation_messages:write:any', 'Message users about any reservation'),
+    ('reservation_messages:write:assigned', 'Message users about reservations on assigned resources');
+
+INSERT INTO role_permissions (role_name, permission_name) VALUES
+    ('operator', 'reservation_messages:write:assigned'),
+    ('owner', 'reservation_messages:write:assigned');
@@ -0,0 +1,24 @@
+-- Files attached to a reservation. Contents live in file storage under storage_key;
+-- operator_only files are hidden from the reservation's user.
+CREATE TABLE reservation_attachments (
+    id UUID PRIMARY KEY,
+    reservation_id UUID NOT NULL REFERENCES reservations (id) ON DELETE CASCADE,
+    uploaded_by UUID NOT NULL REFERENCES users (id),
+    uploader_side TEXT NOT NULL CHECK (uploader_side IN ('user', 'operator')),
+    filename TEXT NOT NULL CHECK (filename <> ''),
+    content_type TEXT NOT NULL,
+    size_bytes BIGINT NOT NULL CHECK (size_bytes > 0),
+    storage_key TEXT NOT NULL UNIQUE,
+    operator_only BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_reservation_attachments_reservation ON reservation_attachments (reservation_id, created_at, id);
+
+INSERT INTO permissions (name, description) VALUES
+    ('reservation_attachments:write:any', 'Attach and delete files on any reservation'),
+    ('reservation_attachments:write:assigned', 'Attach and delete files on reservations on assigned resources');
+
+INSERT INTO role_permissions (role_name, permission_name) VALUES
+    ('operator', 'reservation_attachments:write:assigned'),
+    ('owner', 'reservation_attachments:write:assigned');
@@ -0,0 +1,10 @@
+-- Short pros/cons summary of each resource's recent reviews, written by the summary job.
+-- summary_review_count is total_reviews when the summary was generated; the job refreshes
+-- rows where the two differ.
+ALTER TABLE resource_rating_stats
+    ADD COLUMN summary TEXT,
+    ADD COLUMN summary_review_count INTEGER,
+    ADD COLUMN summary_updated_at TIMESTAMPTZ;
+
+CREATE INDEX idx_resource_rating_stats_stale_summary ON resource_rating_stats (updated_at)
+WHERE summary_review_count IS DISTINCT FROM total_reviews;
@@ -0,0 +1,10 @@
+-- Reviews whose comment is a near copy of one of the author's recent reviews are held
+-- for moderation: they stay out of listings, rating stats and summaries until approved.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE reviews
+    ADD COLUMN status TEXT NOT NULL DEFAULT 'published' CHECK (status IN ('published', 'flagged')),
+    ADD COLUMN duplicate_of UUID REFERENCES reviews (id) ON DELETE SET NULL;
+
+-- Per-resource moderation queue, oldest first.
+CREATE INDEX idx_reviews_flagged ON reviews (resource_id, created_at, id) WHERE status = 'flagged';
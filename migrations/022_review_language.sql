@@ -0,0 +1,5 @@
+-- Language of the review comment as an ISO 639-1 code, NULL when the detector could not
+-- tell. Drives the lang= listing filter and the per-language counts in rating stats.
+ALTER TABLE reviews ADD COLUMN language TEXT CHECK (language ~ '^[a-z]{2}$');
+
+CREATE INDEX idx_reviews_resource_language ON reviews (resource_id, language, created_at DESC, id DESC) WHERE status = 'published';
@@ -0,0 +1,23 @@
+-- Monthly API usage per company. Requests are counted in memory and added here in
+-- batches, so rows lag live traffic by up to one flush interval.
+CREATE TABLE api_usage (
+    company_id UUID NOT NULL REFERENCES companies (id) ON DELETE CASCADE,
+    month DATE NOT NULL CHECK (extract(DAY FROM month) = 1),
+    request_count BIGINT NOT NULL DEFAULT 0 CHECK (request_count >= 0),
+    bytes_in BIGINT NOT NULL DEFAULT 0 CHECK (bytes_in >= 0),
+    bytes_out BIGINT NOT NULL DEFAULT 0 CHECK (bytes_out >= 0),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (company_id, month)
+);
+
+-- Serves GET /admin/usage for one month across companies, busiest first.
+CREATE INDEX idx_api_usage_month ON api_usage (month, request_count DESC);
+
+-- Monthly request quotas, NULL for unlimited. Past the soft quota responses carry a
+-- warning header; past the hard quota requests are refused with 429.
+ALTER TABLE company_settings
+    ADD COLUMN monthly_request_soft_quota BIGINT CHECK (monthly_request_soft_quota > 0),
+    ADD COLUMN monthly_request_hard_quota BIGINT CHECK (monthly_request_hard_quota > 0);
+
+INSERT INTO permissions (name, description) VALUES
+    ('usage:read', 'View API usage and quotas of every company');
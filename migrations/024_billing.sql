@@ -0,0 +1,48 @@
+-- SaaS plans; NULL limits are unlimited.
+CREATE TABLE plans (
+    id TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    max_resources INTEGER CHECK (max_resources >= 0),
+    max_operators INTEGER CHECK (max_operators >= 0),
+    monthly_request_quota BIGINT CHECK (monthly_request_quota > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+INSERT INTO plans (id, name, max_resources, max_operators, monthly_request_quota) VALUES
+    ('free', 'Free', 3, 2, 10000),
+    ('pro', 'Pro', 50, 25, 1000000),
+    ('enterprise', 'Enterprise', NULL, NULL, NULL);
+
+-- One subscription per company, kept in sync by the billing provider's webhooks.
+-- provider_updated_at orders deliveries, so a late retry never rolls a subscription back.
+CREATE TABLE subscriptions (
+    company_id UUID PRIMARY KEY REFERENCES companies (id) ON DELETE CASCADE,
+    plan_id TEXT NOT NULL REFERENCES plans (id),
+    status TEXT NOT NULL CHECK (status IN ('trialing', 'active', 'past_due', 'canceled')),
+    provider_customer_id TEXT,
+    provider_subscription_id TEXT UNIQUE,
+    current_period_end TIMESTAMPTZ,
+    provider_updated_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- The limits in force per company. Companies without a subscription (created before
+-- billing, or the shared default company) are not limited; canceled ones fall back to free.
+CREATE VIEW company_plans AS
+SELECT
+    s.company_id,
+    p.id AS plan_id,
+    s.status,
+    p.max_resources,
+    p.max_operators,
+    p.monthly_request_quota
+FROM subscriptions s
+JOIN plans p ON p.id = CASE WHEN s.status = 'canceled' THEN 'free' ELSE s.plan_id END;
+
+-- Webhook deliveries already applied; providers retry, so each event is applied once.
+CREATE TABLE billing_events (
+    provider_event_id TEXT PRIMARY KEY,
+    event_type TEXT NOT NULL,
+    received_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
@@ -0,0 +1,16 @@
+-- Daily feature-usage counters as emitted by the default analytics sink. Companies are
+-- identified only by a salted hash; rows never carry user or company IDs.
+CREATE TABLE feature_usage (
+    day DATE NOT NULL,
+    feature TEXT NOT NULL,
+    company_hash TEXT NOT NULL, -- '' for users without a company
+    request_count BIGINT NOT NULL CHECK (request_count > 0),
+    PRIMARY KEY (day, feature, company_hash)
+);
+
+-- Companies that opted out are left out of feature-usage telemetry entirely.
+ALTER TABLE company_settings
+    ADD COLUMN telemetry_opt_out BOOLEAN NOT NULL DEFAULT false;
+
+INSERT INTO permissions (name, description) VALUES
+    ('telemetry:read', 'View the feature adoption report');
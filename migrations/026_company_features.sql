@@ -0,0 +1,12 @@
+-- Per-company feature toggles. A company without a row for a feature gets the
+-- deployment default (FEATURES_DEFAULT_ON).
+CREATE TABLE company_features (
+    company_id UUID NOT NULL REFERENCES companies (id) ON DELETE CASCADE,
+    feature TEXT NOT NULL,
+    enabled BOOLEAN NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (company_id, feature)
+);
+
+INSERT INTO permissions (name, description) VALUES
+    ('features:manage', 'Turn features on or off per company');
@@ -0,0 +1,3 @@
+-- Admin maintenance tasks, such as checking stored cursors after a data migration.
+INSERT INTO permissions (name, description) VALUES
+    ('maintenance:run', 'Run maintenance checks and repairs');
@@ -0,0 +1,6 @@
+-- Reservations booked together in one all-or-nothing request (e.g. room + projector)
+-- share a group_id. The idempotency key of the request points at the group's first
+-- reservation, from which a replay finds the group.
+ALTER TABLE reservations ADD COLUMN group_id UUID;
+
+CREATE INDEX idx_reservations_group ON reservations (group_id) WHERE group_id IS NOT NULL;
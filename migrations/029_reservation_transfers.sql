@@ -0,0 +1,28 @@
+-- Offers to hand a reservation over to another user. The recipient accepts through a
+-- signed link carrying the nonce; a newer offer for the same reservation supersedes the
+-- pending one. Operator overrides are stored already accepted.
+CREATE TABLE reservation_transfers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    reservation_id UUID NOT NULL REFERENCES reservations (id) ON DELETE CASCADE,
+    from_user_id UUID NOT NULL REFERENCES users (id),
+    to_user_id UUID NOT NULL REFERENCES users (id),
+    initiated_by UUID NOT NULL REFERENCES users (id),
+    nonce UUID NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'accepted', 'superseded')),
+    expires_at TIMESTAMPTZ NOT NULL,
+    accepted_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CHECK (from_user_id <> to_user_id),
+    CHECK ((status = 'accepted') = (accepted_at IS NOT NULL))
+);
+
+CREATE UNIQUE INDEX idx_reservation_transfers_pending ON reservation_transfers (reservation_id) WHERE status = 'pending';
+
+INSERT INTO permissions (name, description) VALUES
+    ('reservations:transfer:any', 'Transfer reservations of any user without the recipient''s acceptance'),
+    ('reservations:transfer:assigned', 'Transfer reservations on assigned resources without the recipient''s acceptance');
+
+INSERT INTO role_permissions (role_name, permission_name) VALUES
+    ('operator', 'reservations:transfer:assigned'),
+    ('owner', 'reservations:transfer:assigned');
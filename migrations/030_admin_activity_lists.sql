@@ -0,0 +1,8 @@
+-- Serve GET /admin/audit-logs and GET /admin/notification-jobs: newest first, paged by
+-- keyset (created_at, id).
+CREATE INDEX idx_audit_logs_created_id ON audit_logs (created_at DESC, id DESC);
+CREATE INDEX idx_notification_jobs_created_id ON notification_jobs (created_at DESC, id DESC);
+
+INSERT INTO permissions (name, description) VALUES
+    ('audit_logs:read', 'Read the audit log'),
+    ('notification_jobs:read', 'Read queued and sent notification jobs');
@@ -0,0 +1,9 @@
+-- Per-company review window: a reservation can be reviewed from review_opens_after_min
+-- minutes after it ends until review_window_days days after it ends. NULL falls back to the
+-- REVIEW_WINDOW_* defaults.
+ALTER TABLE company_settings
+    ADD COLUMN review_opens_after_min INTEGER CHECK (review_opens_after_min >= 0),
+    ADD COLUMN review_window_days INTEGER CHECK (review_window_days > 0);
+
+INSERT INTO permissions (name, description) VALUES
+    ('company_settings:manage', 'Change per-company settings such as the review window');
@@ -0,0 +1,7 @@
+-- Per-company branding for transactional emails. NULL uses the product defaults.
+ALTER TABLE company_settings
+    ADD COLUMN brand_logo_url TEXT,
+    ADD COLUMN brand_primary_color TEXT CHECK (brand_primary_color ~ '^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$'),
+    ADD COLUMN brand_accent_color TEXT CHECK (brand_accent_color ~ '^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$'),
+    ADD COLUMN brand_reply_to TEXT,
+    ADD COLUMN brand_footer TEXT;
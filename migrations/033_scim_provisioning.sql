@@ -0,0 +1,20 @@
+-- Provisioning tokens let a company's identity provider sync users over /scim/v2. Only the
+-- SHA-256 of a token is stored; it is shown once when issued.
+CREATE TABLE provisioning_tokens (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    company_id UUID NOT NULL REFERENCES companies(id) ON DELETE CASCADE,
+    token_hash BYTEA NOT NULL UNIQUE,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    revoked_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_provisioning_tokens_company_id ON provisioning_tokens (company_id);
+
+-- The identity provider's own id for a provisioned user, unique within the company.
+ALTER TABLE users ADD COLUMN external_id TEXT;
+
+CREATE UNIQUE INDEX idx_users_company_external_id ON users (company_id, external_id) WHERE external_id IS NOT NULL;
+
+INSERT INTO permissions (name, description) VALUES
+    ('provisioning:manage', 'Issue and revoke SCIM provisioning tokens');
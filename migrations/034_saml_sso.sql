@@ -0,0 +1,17 @@
+-- SAML single sign-on: one identity provider per company. role_mapping maps values of
+-- role_attribute to role names; users without a mapped value get default_role.
+CREATE TABLE company_saml_connections (
+    company_id UUID PRIMARY KEY REFERENCES companies(id) ON DELETE CASCADE,
+    idp_entity_id TEXT NOT NULL,
+    idp_metadata TEXT NOT NULL,
+    email_attribute TEXT,
+    role_attribute TEXT,
+    role_mapping JSONB NOT NULL DEFAULT '{}'::jsonb,
+    default_role TEXT NOT NULL REFERENCES roles(name),
+    updated_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+INSERT INTO permissions (name, description) VALUES
+    ('sso:manage', 'Configure a company''s SAML single sign-on');
@@ -0,0 +1,36 @@
+-- Reservations on a resource with designated approvers are held in pending_approval until
+-- one of them decides. The pending reservation keeps its slot; a rejected, expired or
+-- withdrawn request cancels it.
+ALTER TABLE reservations DROP CONSTRAINT reservations_status_check;
+ALTER TABLE reservations ADD CONSTRAINT reservations_status_check
+    CHECK (status IN ('confirmed', 'canceled', 'pending_approval'));
+
+CREATE TABLE resource_approvers (
+    resource_id UUID NOT NULL REFERENCES resources(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (resource_id, user_id)
+);
+
+CREATE INDEX idx_resource_approvers_user_id ON resource_approvers (user_id);
+
+-- One request per reservation. escalated_at is set once the request has waited long
+-- enough to be escalated to the company's admins.
+CREATE TABLE reservation_approvals (
+    reservation_id UUID PRIMARY KEY REFERENCES reservations(id) ON DELETE CASCADE,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected', 'expired', 'withdrawn')),
+    expires_at TIMESTAMPTZ NOT NULL,
+    escalated_at TIMESTAMPTZ,
+    decided_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    decided_at TIMESTAMPTZ,
+    reason TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CHECK ((status = 'pending') = (decided_at IS NULL))
+);
+
+CREATE INDEX idx_reservation_approvals_pending ON reservation_approvals (expires_at) WHERE status = 'pending';
+
+INSERT INTO permissions (name, description) VALUES
+    ('reservations:approve:any', 'Approve or reject pending reservations on any resource'),
+    ('resource_approvers:manage', 'Designate who approves reservations on a resource');
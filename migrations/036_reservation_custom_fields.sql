@@ -0,0 +1,24 @@
+-- Custom fields a company collects on reservations. A definition without resource_id
+-- applies to every resource of the company. Keys are unique per company so a value means
+-- the same thing on every resource; options lists the choices of a select field.
+CREATE TABLE custom_field_definitions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    company_id UUID NOT NULL REFERENCES companies(id) ON DELETE CASCADE,
+    resource_id UUID REFERENCES resources(id) ON DELETE CASCADE,
+    key TEXT NOT NULL,
+    label TEXT NOT NULL,
+    field_type TEXT NOT NULL CHECK (field_type IN ('text', 'number', 'select')),
+    required BOOLEAN NOT NULL DEFAULT false,
+    options TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (company_id, key),
+    CHECK ((field_type = 'select') = (cardinality(options) > 0))
+);
+
+CREATE INDEX idx_custom_field_definitions_resource_id ON custom_field_definitions (resource_id);
+
+-- Values keep their key after the definition is deleted, so past reservations still export them.
+ALTER TABLE reservations ADD COLUMN custom_fields JSONB NOT NULL DEFAULT '{}'::jsonb;
+
+INSERT INTO permissions (name, description) VALUES
+    ('custom_fields:manage', 'Define the custom fields collected on a company''s reservations');
@@ -0,0 +1,13 @@
+-- Slugs name resources in public URLs (public resource pages, the sitemap) so internal ids
+-- stay out of them. Existing resources get the slug resource.SlugFor would derive: the name's
+-- ASCII words, at most 60 characters, then the first 8 characters of the id. A resource
+-- without a slug has no public page.
+ALTER TABLE resources ADD COLUMN slug TEXT
+    CHECK (char_length(slug) <= 80 AND slug ~ '^[a-z0-9]+(-[a-z0-9]+)*$');
+
+UPDATE resources
+SET slug = concat_ws('-',
+    NULLIF(trim(both '-' from left(trim(both '-' from regexp_replace(lower(name), '[^a-z0-9]+', '-', 'g')), 60)), ''),
+    left(id::text, 8));
+
+CREATE UNIQUE INDEX idx_resources_slug ON resources (slug);
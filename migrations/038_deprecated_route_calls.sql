@@ -0,0 +1,10 @@
+-- Daily calls to routes flagged as deprecated, per consumer, so the callers still on an
+-- old route can be found before its sunset. Anonymous calls are counted under a NULL user.
+CREATE TABLE deprecated_route_calls (
+    day DATE NOT NULL,
+    route TEXT NOT NULL, -- "<METHOD> <route template>", e.g. "GET /api/reservations/:id"
+    user_id UUID REFERENCES users(id) ON DELETE CASCADE,
+    call_count BIGINT NOT NULL CHECK (call_count > 0),
+    last_called_at TIMESTAMPTZ NOT NULL,
+    UNIQUE NULLS NOT DISTINCT (day, route, user_id)
+);
@@ -0,0 +1,33 @@
+-- Support staff can record a user's next requests for debugging once the user consented.
+-- Captures are sanitized request/response pairs kept in file storage under storage_key;
+-- a recording stops capturing after max_requests or at expires_at, and the purge job
+-- deletes it with its captures once expired.
+CREATE TABLE request_recordings (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    enabled_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    reason TEXT NOT NULL,
+    consent_reference TEXT NOT NULL, -- where the user agreed, e.g. a support ticket
+    max_requests INTEGER NOT NULL CHECK (max_requests > 0),
+    recorded_count INTEGER NOT NULL DEFAULT 0 CHECK (recorded_count <= max_requests),
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_request_recordings_user_id ON request_recordings (user_id, expires_at);
+CREATE INDEX idx_request_recordings_expires_at ON request_recordings (expires_at);
+
+CREATE TABLE recorded_requests (
+    recording_id UUID NOT NULL REFERENCES request_recordings(id) ON DELETE CASCADE,
+    seq INTEGER NOT NULL,
+    method TEXT NOT NULL,
+    path TEXT NOT NULL,
+    status INTEGER NOT NULL,
+    duration_ms INTEGER NOT NULL,
+    storage_key TEXT NOT NULL,
+    recorded_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (recording_id, seq)
+);
+
+INSERT INTO permissions (name, description) VALUES
+    ('request_recordings:manage', 'Record a consenting user''s requests for support debugging');
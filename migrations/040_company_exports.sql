@@ -0,0 +1,26 @@
+-- Full-company archives for offboarding. The export job claims pending rows, writes a zip
+-- of the company's data to file storage under storage_key and reports progress as it
+-- goes; the archive and its row are purged at expires_at. At most one export per company
+-- is queued or running at a time.
+CREATE TABLE company_exports (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    company_id UUID NOT NULL REFERENCES companies(id) ON DELETE CASCADE,
+    requested_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'running', 'done', 'failed')),
+    progress INTEGER NOT NULL DEFAULT 0 CHECK (progress BETWEEN 0 AND 100),
+    storage_key TEXT,
+    size_bytes BIGINT,
+    error TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    started_at TIMESTAMPTZ,
+    completed_at TIMESTAMPTZ,
+    expires_at TIMESTAMPTZ,
+    CHECK ((status = 'done') = (storage_key IS NOT NULL))
+);
+
+CREATE UNIQUE INDEX idx_company_exports_active ON company_exports (company_id) WHERE status IN ('pending', 'running');
+CREATE INDEX idx_company_exports_queue ON company_exports (created_at) WHERE status IN ('pending', 'running');
+CREATE INDEX idx_company_exports_expires_at ON company_exports (expires_at);
+
+INSERT INTO permissions (name, description) VALUES
+    ('company_exports:manage', 'Export all of a company''s data for offboarding');
@@ -0,0 +1,29 @@
+-- Hard-deletes of whole companies. The request locks the company out at once; the
+-- deletion job then purges its data stage by stage, a batch per transaction, saving the
+-- stage reached and running totals with every batch so a retried run resumes where the
+-- last one stopped. company_id has no foreign key: the row outlives the company and keeps
+-- the certificate recorded once the purge is done.
+CREATE TABLE company_deletions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    company_id UUID NOT NULL,
+    company_name TEXT NOT NULL,
+    requested_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'running', 'done')),
+    stage TEXT NOT NULL CHECK (stage IN ('cancel_reservations', 'anonymize_reviews', 'purge_storage',
+        'delete_users', 'delete_company', 'done')),
+    counts JSONB NOT NULL DEFAULT '{}'::jsonb,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    error TEXT,
+    certificate JSONB,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    started_at TIMESTAMPTZ,
+    completed_at TIMESTAMPTZ,
+    CHECK ((status = 'done') = (certificate IS NOT NULL))
+);
+
+CREATE UNIQUE INDEX idx_company_deletions_active ON company_deletions (company_id) WHERE status <> 'done';
+CREATE INDEX idx_company_deletions_queue ON company_deletions (updated_at) WHERE status <> 'done';
+
+INSERT INTO permissions (name, description) VALUES
+    ('companies:delete', 'Permanently delete a company and everything it holds');
@@ -0,0 +1,62 @@
+-- Each user's activity timeline: reservations made and canceled, reviews posted, referral
+-- credits and loyalty points earned, and notifications others' actions sent them. Rows are
+-- written in the same transaction as the change they describe, so the timeline is read
+-- from one index instead of four tables. subject_id and resource_id have no foreign keys:
+-- an entry outlives a reservation or resource deleted with its company.
+CREATE TABLE user_activity (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    kind TEXT NOT NULL CHECK (kind IN ('reservation_created', 'reservation_canceled', 'review_posted',
+        'credit_earned', 'points_earned', 'notification')),
+    subject_id UUID NOT NULL,
+    resource_id UUID,
+    data JSONB NOT NULL DEFAULT '{}'::jsonb,
+    occurred_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_user_activity_user_occurred ON user_activity (user_id, occurred_at DESC, id DESC);
+CREATE INDEX idx_user_activity_subject ON user_activity (subject_id);
+
+-- Backfill from what is already stored. Notifications are taken from the jobs the
+-- notification retention policy has not purged yet.
+INSERT INTO user_activity (user_id, kind, subject_id, resource_id, data, occurred_at)
+SELECT r.user_id, 'reservation_created', r.id, r.resource_id,
+    jsonb_build_object('status', CASE WHEN r.status = 'pending_approval' THEN 'pending_approval' ELSE 'confirmed' END,
+        'startTime', lower(r.slot), 'endTime', upper(r.slot)),
+    r.created_at
+FROM reservations r;
+
+INSERT INTO user_activity (user_id, kind, subject_id, resource_id, occurred_at)
+SELECT r.user_id, 'reservation_canceled', r.id, r.resource_id, r.updated_at
+FROM reservations r
+WHERE r.status = 'canceled';
+
+INSERT INTO user_activity (user_id, kind, subject_id, resource_id, data, occurred_at)
+SELECT rv.user_id, 'review_posted', rv.id, rv.resource_id, jsonb_build_object('rating', rv.rating), rv.created_at
+FROM reviews rv;
+
+INSERT INTO user_activity (user_id, kind, subject_id, data, occurred_at)
+SELECT rc.user_id, 'credit_earned', rc.referral_id,
+    jsonb_build_object('party', rc.party, 'amountCents', rc.amount_cents), rc.created_at
+FROM referral_credits rc;
+
+INSERT INTO user_activity (user_id, kind, subject_id, data, occurred_at)
+SELECT l.user_id, 'points_earned', l.reservation_id,
+    jsonb_build_object('points', l.points, 'expiresAt', l.expires_at), l.created_at
+FROM loyalty_ledger l
+WHERE l.kind = 'accrual';
+
+INSERT INTO user_activity (user_id, kind, subject_id, resource_id, data, occurred_at)
+SELECT u.id, 'notification', r.id,
+    CASE WHEN n.topic IN ('reservation_approved', 'reservation_rejected', 'reservation_message') THEN r.resource_id END,
+    jsonb_build_object('topic', n.topic), n.created_at
+FROM notification_jobs n
+CROSS JOIN LATERAL (VALUES
+    (CASE WHEN n.topic = 'reservation_message' THEN n.payload->>'recipient_user_id' ELSE n.payload->>'user_id' END),
+    (CASE WHEN n.topic = 'reservation_transferred' THEN n.payload->>'to_user_id' END),
+    (CASE WHEN n.topic = 'reservation_transferred' THEN n.payload->>'from_user_id' END)
+) AS recipient (user_id)
+JOIN users u ON u.id::text = recipient.user_id
+JOIN reservations r ON r.id::text = n.payload->>'reservation_id'
+WHERE n.topic IN ('reservation_approved', 'reservation_rejected', 'reservation_approval_expired',
+    'reservation_message', 'reservation_transferred');
@@ -0,0 +1,12 @@
+-- Trigram indexes behind the admin search. Each serves ILIKE '%term%' on its column once
+-- the term has three or more characters; shorter terms are rejected by the API.
+CREATE INDEX idx_users_email_trgm ON users USING gin ((email::text) gin_trgm_ops);
+CREATE INDEX idx_resources_name_trgm ON resources USING gin (name gin_trgm_ops);
+CREATE INDEX idx_reviews_comment_trgm ON reviews USING gin (comment gin_trgm_ops);
+-- Reservation notes are the opening message of their thread. Encrypted bodies cannot be
+-- searched, so only plaintext ones are indexed.
+CREATE INDEX idx_reservation_messages_body_trgm ON reservation_messages USING gin (body gin_trgm_ops)
+    WHERE body IS NOT NULL;
+
+INSERT INTO permissions (name, description) VALUES
+    ('search:read', 'Search users, reservations, resources and reviews of every company');
@@ -0,0 +1,16 @@
+-- One row per refresh token issued (id is its jti), chained by the login session the
+-- token was rotated within. A token is exchanged once: presenting it again revokes its
+-- whole session, and access tokens carry the session so the revocation reaches them too.
+CREATE TABLE refresh_tokens (
+    id UUID PRIMARY KEY,
+    session_id UUID NOT NULL,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    rotated_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_refresh_tokens_session ON refresh_tokens (session_id);
+-- Retention purges tokens some time after they expire.
+CREATE INDEX idx_refresh_tokens_expires ON refresh_tokens (expires_at);
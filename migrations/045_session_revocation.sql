@@ -0,0 +1,22 @@
+-- Logged-out access and refresh tokens that belong to no session, i.e. issued before
+-- sessions were tracked, keyed by jti until they expire. Tokens of a session are revoked
+-- with it in refresh_tokens instead.
+CREATE TABLE revoked_tokens (
+    jti UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    revoked_at TIMESTAMPTZ NOT NULL
+);
+
+-- Retention purges denylisted tokens once they have expired.
+CREATE INDEX idx_revoked_tokens_expires ON revoked_tokens (expires_at);
+
+-- Set when an admin signs a user out everywhere: tokens without a session issued before
+-- revoked_before are refused, since they cannot be listed to denylist them one by one.
+CREATE TABLE user_session_cutoffs (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    revoked_before TIMESTAMPTZ NOT NULL
+);
+
+INSERT INTO permissions (name, description) VALUES
+    ('sessions:revoke', 'Sign a user out of every session');
@@ -0,0 +1,11 @@
+-- Self-registered accounts start inactive until the address is verified. The row holds the
+-- nonce of the link last mailed to it and is deleted when the link is redeemed, so a link
+-- works once and an account deactivated by other means cannot be reactivated with one.
+CREATE TABLE email_verifications (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    nonce UUID NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_email_verifications_expires_at ON email_verifications (expires_at);
@@ -0,0 +1,22 @@
+-- TOTP two-factor authentication. The secret is encrypted with the column key
+-- (CRYPTO_KEYS); the row exists from enrollment on, but sign-in only asks for a code once
+-- enabled_at is set by confirming one. last_used_step is the newest time step accepted,
+-- so an observed code cannot be replayed; wrong codes count towards a lockout.
+CREATE TABLE user_mfa (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    secret_ciphertext TEXT NOT NULL,
+    enabled_at TIMESTAMPTZ,
+    last_used_step BIGINT NOT NULL DEFAULT 0,
+    failed_attempts INTEGER NOT NULL DEFAULT 0,
+    locked_until TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Single-use recovery codes, stored as SHA-256 hashes; replaced whenever 2FA is enabled
+-- and dropped with the enrollment.
+CREATE TABLE user_mfa_recovery_codes (
+    user_id UUID NOT NULL REFERENCES user_mfa(user_id) ON DELETE CASCADE,
+    code_hash TEXT NOT NULL,
+    used_at TIMESTAMPTZ,
+    PRIMARY KEY (user_id, code_hash)
+);
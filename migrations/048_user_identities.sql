@@ -0,0 +1,13 @@
+-- Accounts at OpenID Connect providers that sign in as a local user. subject is the
+-- provider's stable ID for the account; email is what it last reported and only informs.
+CREATE TABLE user_identities (
+    provider TEXT NOT NULL,
+    subject TEXT NOT NULL,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    email TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_login_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (provider, subject)
+);
+
+CREATE INDEX idx_user_identities_user_id ON user_identities (user_id);
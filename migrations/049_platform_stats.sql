@@ -0,0 +1,21 @@
+-- Platform-wide totals for public marketing pages, recomputed by the platform_stats job so
+-- anonymous traffic never aggregates live tables. It holds at most one row.
+CREATE TABLE platform_stats (
+    id BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+    total_resources BIGINT NOT NULL,
+    total_reviews BIGINT NOT NULL,
+    average_rating NUMERIC(3,2) NOT NULL,
+    computed_at TIMESTAMPTZ NOT NULL
+);
+
+-- Seed the row so the stats are served before the job first runs.
+INSERT INTO platform_stats (total_resources, total_reviews, average_rating, computed_at)
+SELECT
+    (SELECT count(*) FROM resources),
+    COALESCE(SUM(s.rating_1_count + s.rating_2_count + s.rating_3_count + s.rating_4_count + s.rating_5_count), 0),
+    COALESCE(ROUND(
+        SUM(s.rating_1_count + 2 * s.rating_2_count + 3 * s.rating_3_count + 4 * s.rating_4_count + 5 * s.rating_5_count)::numeric
+        / NULLIF(SUM(s.rating_1_count + s.rating_2_count + s.rating_3_count + s.rating_4_count + s.rating_5_count), 0),
+        2), 0),
+    NOW()
+FROM resource_rating_stats s;
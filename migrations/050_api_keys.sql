@@ -0,0 +1,19 @@
+-- API keys let machine clients call the API as a user, sending X-API-Key instead of a JWT.
+-- Only the SHA-256 of a key is stored; it is shown once when issued. scopes are the
+-- permissions the key may use, on top of the user's role; expires_at NULL never expires.
+CREATE TABLE api_keys (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    key_hash BYTEA NOT NULL UNIQUE,
+    scopes TEXT[] NOT NULL,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    expires_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_api_keys_user_id ON api_keys (user_id);
+
+INSERT INTO permissions (name, description) VALUES
+    ('api_keys:manage', 'Issue and revoke API keys for machine clients');
@@ -0,0 +1,17 @@
+-- Failed password sign-ins per email and client IP. Enough of them lock that email for
+-- that IP until locked_until; expires_at is when the row stops counting (the cool-down
+-- after the last failure or the end of the lock), after which the count starts again and
+-- retention drops the row.
+CREATE TABLE login_failures (
+    email CITEXT NOT NULL,
+    ip_address TEXT NOT NULL,
+    failed_attempts INTEGER NOT NULL,
+    locked_until TIMESTAMPTZ,
+    expires_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (email, ip_address)
+);
+
+CREATE INDEX idx_login_failures_expires_at ON login_failures (expires_at);
+
+INSERT INTO permissions (name, description) VALUES
+    ('logins:unlock', 'Lift a login lockout before its cool-down ends');
@@ -0,0 +1,20 @@
+-- One row per login session, keyed by the session_id its refresh tokens share, so users
+-- can see where they are signed in and sign a single device out. Every token issued in
+-- the session moves last_seen_at and records the client it went to; revoking the
+-- session's tokens sets revoked_at here as well.
+CREATE TABLE user_sessions (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    -- user_devices.fingerprint of the client; empty when it sent neither device key nor user agent
+    device_fingerprint TEXT NOT NULL,
+    ip_address TEXT NOT NULL,
+    user_agent TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    last_seen_at TIMESTAMPTZ NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    revoked_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_user_sessions_user ON user_sessions (user_id, last_seen_at DESC);
+-- Retention purges sessions some time after their last token expires.
+CREATE INDEX idx_user_sessions_expires ON user_sessions (expires_at);
@@ -0,0 +1,16 @@
+-- Reviews imported from a legacy system have no reservation here and keep their original
+-- timestamps. import_source and external_id name a review in the system it came from, so
+-- importing the same export again skips the reviews already in.
+ALTER TABLE reviews
+    ALTER COLUMN reservation_id DROP NOT NULL,
+    ADD COLUMN import_source TEXT,
+    ADD COLUMN external_id TEXT,
+    ADD CONSTRAINT reviews_reserved_or_imported CHECK (
+        (reservation_id IS NULL) = (import_source IS NOT NULL)
+        AND (import_source IS NULL) = (external_id IS NULL)
+    );
+
+CREATE UNIQUE INDEX idx_reviews_import_external_id ON reviews (import_source, external_id) WHERE import_source IS NOT NULL;
+
+INSERT INTO permissions (name, description) VALUES
+    ('reviews:import', 'Import historical reviews from a legacy system');
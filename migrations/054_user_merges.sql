@@ -0,0 +1,2 @@
+INSERT INTO permissions (name, description) VALUES
+    ('users:merge', 'Merge a duplicate account into another');
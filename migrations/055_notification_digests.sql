@@ -0,0 +1,16 @@
+-- Users who prefer a digest get non-urgent emails folded into one daily or weekly
+-- summary. Their notification jobs wait as 'held' until the digest job takes them into
+-- a 'notification_digest' job and marks them 'digested'; user_id names the recipient of
+-- jobs addressed to a single user, which the worker previously read from the payload.
+ALTER TABLE users
+ADD COLUMN notification_digest TEXT NOT NULL DEFAULT 'off'
+CHECK (notification_digest IN ('off', 'daily', 'weekly'));
+
+ALTER TABLE notification_jobs ADD COLUMN user_id UUID REFERENCES users(id) ON DELETE CASCADE;
+
+ALTER TABLE notification_jobs DROP CONSTRAINT notification_jobs_status_check;
+ALTER TABLE notification_jobs
+ADD CONSTRAINT notification_jobs_status_check
+CHECK (status IN ('queued', 'held', 'digested', 'done', 'error'));
+
+CREATE INDEX idx_notification_jobs_held ON notification_jobs (user_id, created_at) WHERE status = 'held';
@@ -1,7 +1,8 @@
 #!/bin/bash
 
 # Auto-generate gomock files to avoid manual mock maintenance
-# Usage: ./scripts/generate_mocks.sh [filename...]
+# Usage: ./scripts/generate_mocks.sh [manifest]
+# The manifest (default: scripts/mocks.manifest) lists which sources are mocked where.
 
 set -e
 
@@ -10,14 +11,15 @@ GREEN='\033[0;32m'
 YELLOW='\033[1;33m'
 NC='\033[0m'
 
+cd "$(dirname "$0")/.."
+
+manifest="${1:-scripts/mocks.manifest}"
 generated_count=0
 
-# Create mock directories
-mkdir -p tests/mock/usecase
-mkdir -p tests/mock/commands
-mkdir -p tests/mock/queries
-mkdir -p tests/mock/repository
-mkdir -p tests/mock/readstore
+if [ ! -f "$manifest" ]; then
+    echo -e "${RED}Manifest not found: ${manifest}${NC}"
+    exit 1
+fi
 
 # Function to generate mock for a specific file
 generate_mock() {
@@ -25,13 +27,14 @@ generate_mock() {
     local mock_dir="$2"
     local mock_package="$3"
     
-    [ -f "$source_file" ] || return
+    [ -f "$source_file" ] || return 0
     
     filename=$(basename "$source_file" .go)
     
     # Check if file contains interfaces
     if grep -q "type.*interface" "$source_file"; then
         echo -e "${GREEN}Generating mock: ${source_file} -> ${mock_dir}${NC}"
+        mkdir -p "$mock_dir"
         mockgen -source="$source_file" \
                 -destination="${mock_dir}/${filename}_mock.go" \
                 -package="$mock_package"
@@ -39,32 +42,27 @@ generate_mock() {
     fi
 }
 
-# Generate mocks for different directories
-echo "Generating mocks for usecase layer..."
-
-# TokenValidator (usecase root)
-generate_mock "internal/usecase/token_validator.go" "tests/mock/usecase" "usecasemock"
+while read -r pattern mock_dir mock_package _; do
+    # Skip blank lines and comments
+    [ -z "$pattern" ] || [ "${pattern:0:1}" = "#" ] && continue
 
-# Commands
-for file in internal/usecase/commands/*.go; do
-    generate_mock "$file" "tests/mock/commands" "commandsmock"
-done
-
-# Queries  
-for file in internal/usecase/queries/*.go; do
-    generate_mock "$file" "tests/mock/queries" "queriesmock"
-done
-
-# Repository (infrastructure layer)
-echo "Generating mocks for repository layer..."
-for file in internal/infra/repository/*.go; do
-    generate_mock "$file" "tests/mock/repository" "repositorymock"
-done
+    if [ -z "$mock_package" ]; then
+        echo -e "${RED}Malformed manifest entry: ${pattern} ${mock_dir}${NC}"
+        exit 1
+    fi
 
-# ReadStore (infrastructure layer)
-for file in internal/infra/readstore/*.go; do
-    generate_mock "$file" "tests/mock/readstore" "readstoremock"
-done
+    matched=0
+    for file in $pattern; do
+        case "$file" in
+            *_test.go) continue ;;
+        esac
+        [ -f "$file" ] && matched=1
+        generate_mock "$file" "$mock_dir" "$mock_package"
+    done
+    if [ $matched -eq 0 ]; then
+        echo -e "${YELLOW}No source matches ${pattern}${NC}"
+    fi
+done < "$manifest"
 
 if [ $generated_count -eq 0 ]; then
     echo -e "${RED}No interfaces found${NC}"
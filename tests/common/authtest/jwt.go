@@ -29,7 +29,7 @@ func (h *JWTHelper) GenerateToken(t *testing.T, userID uuid.UUID, role user.Role
 	refreshDuration, err := time.ParseDuration(h.cfg.RefreshTokenDuration)
 	require.NoError(t, err)
 	service := jwt.NewService(h.cfg.Secret, duration, refreshDuration)
-	token, err := service.GenerateAccessToken(userID, role)
+	token, err := service.GenerateAccessToken(userID, role, uuid.Nil)
 	require.NoError(t, err)
 	return token
 }
@@ -39,7 +39,7 @@ func (h *JWTHelper) CreateExpiredToken(t *testing.T, userID uuid.UUID, role user
 	refreshDuration, err := time.ParseDuration(h.cfg.RefreshTokenDuration)
 	require.NoError(t, err)
 	service := jwt.NewService(h.cfg.Secret, 1*time.Millisecond, refreshDuration)
-	token, err := service.GenerateAccessToken(userID, role)
+	token, err := service.GenerateAccessToken(userID, role, uuid.Nil)
 	require.NoError(t, err)
 	time.Sleep(10 * time.Millisecond)
 	return token
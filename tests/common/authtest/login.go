@@ -32,7 +32,13 @@ func LoginUser(t *testing.T, router *gin.Engine, email, password string) string
 func CreateAndLogin(t *testing.T, db dbtest.DBLike, router *gin.Engine, email, role string) string {
 	t.Helper()
 	dbtest.CreateTestUser(t, db, email, role)
-	return LoginUser(t, router, email, "password123")
+	return LoginUser(t, router, email, dbtest.DefaultPassword)
+}
+
+// LoginAs logs in a user created by dbtest.Scenario.
+func LoginAs(t *testing.T, router *gin.Engine, u dbtest.ScenarioUser) string {
+	t.Helper()
+	return LoginUser(t, router, u.Email, dbtest.DefaultPassword)
 }
 
 func LogoutUser(t *testing.T, router *gin.Engine, cookies []*http.Cookie) {
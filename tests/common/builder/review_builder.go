@@ -58,11 +58,12 @@ func (r *ReviewBuilder) BuildInfra() sqlc.Reviews {
 		ID:            id,
 		UserID:        r.UserID,
 		ResourceID:    r.ResourceID,
-		ReservationID: r.ReservationID,
+		ReservationID: pgtype.UUID{Bytes: r.ReservationID, Valid: true},
 		Rating:        int32(r.Rating),
 		Comment:       r.Comment,
 		CreatedAt:     pgtype.Timestamptz{Time: r.CreatedAt, Valid: true},
 		UpdatedAt:     pgtype.Timestamptz{Time: r.UpdatedAt, Valid: true},
+		Status:        string(domreview.StatusPublished),
 	}
 }
 
@@ -92,9 +93,10 @@ func (r *ReviewBuilder) BuildViewQuery() *queries.ReviewView {
 		UserEmail:     r.UserEmail,
 		ResourceID:    r.ResourceID,
 		ResourceName:  r.ResourceName,
-		ReservationID: r.ReservationID,
+		ReservationID: &r.ReservationID,
 		Rating:        int32(r.Rating),
 		Comment:       r.Comment,
+		Status:        string(domreview.StatusPublished),
 		CreatedAt:     r.CreatedAt,
 		UpdatedAt:     r.UpdatedAt,
 	}
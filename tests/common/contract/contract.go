@@ -0,0 +1,378 @@
+//go:build unit || e2e
+
+// Package contract checks real HTTP responses against the generated OpenAPI document
+// (docs/swagger.json), so handlers cannot drift from what the docs promise clients.
+package contract
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/spec"
+	"github.com/stretchr/testify/require"
+)
+
+// apiPrefix is where the router mounts the API group; the docs list those paths without it.
+const apiPrefix = "/api"
+
+const specFile = "docs/swagger.json"
+
+type Spec struct {
+	doc        *spec.Swagger
+	operations []operation
+}
+
+type operation struct {
+	method   string
+	template string
+	segments []string
+	op       *spec.Operation
+}
+
+// Load reads docs/swagger.json, resolving the path from the package dirs `go test` runs in.
+func Load() (*Spec, error) {
+	var (
+		raw     []byte
+		readErr error
+	)
+	for _, cand := range []string{
+		specFile,
+		filepath.Join("..", specFile),
+		filepath.Join("..", "..", specFile),
+		filepath.Join("..", "..", "..", specFile),
+	} {
+		raw, readErr = os.ReadFile(cand)
+		if readErr == nil {
+			break
+		}
+	}
+	if readErr != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", specFile, readErr)
+	}
+	return Parse(raw)
+}
+
+func MustLoad(t *testing.T) *Spec {
+	t.Helper()
+	s, err := Load()
+	require.NoError(t, err, "Failed to load OpenAPI document")
+	return s
+}
+
+func Parse(raw []byte) (*Spec, error) {
+	var doc spec.Swagger
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse OpenAPI document: %w", err)
+	}
+
+	s := &Spec{doc: &doc}
+	if doc.Paths == nil {
+		return s, nil
+	}
+	for template, item := range doc.Paths.Paths {
+		for method, op := range map[string]*spec.Operation{
+			http.MethodGet:    item.Get,
+			http.MethodPost:   item.Post,
+			http.MethodPut:    item.Put,
+			http.MethodPatch:  item.Patch,
+			http.MethodDelete: item.Delete,
+		} {
+			if op == nil {
+				continue
+			}
+			s.operations = append(s.operations, operation{
+				method:   method,
+				template: template,
+				segments: splitPath(template),
+				op:       op,
+			})
+		}
+	}
+	sort.Slice(s.operations, func(i, j int) bool {
+		if s.operations[i].template != s.operations[j].template {
+			return s.operations[i].template < s.operations[j].template
+		}
+		return s.operations[i].method < s.operations[j].method
+	})
+	return s, nil
+}
+
+// Operations lists every documented operation as "METHOD /template".
+func (s *Spec) Operations() []string {
+	out := make([]string, len(s.operations))
+	for i, o := range s.operations {
+		out[i] = o.method + " " + o.template
+	}
+	return out
+}
+
+// RouteKey converts a gin route (e.g. "/api/reviews/:id") into the form Operations returns.
+func RouteKey(method, ginPath string) string {
+	segments := splitPath(strings.TrimPrefix(ginPath, apiPrefix))
+	for i, seg := range segments {
+		if strings.HasPrefix(seg, ":") || strings.HasPrefix(seg, "*") {
+			segments[i] = "{" + seg[1:] + "}"
+		}
+	}
+	return method + " /" + strings.Join(segments, "/")
+}
+
+// Validate reports every way the response differs from the documented one for method and
+// the concrete request path (e.g. "/api/reviews/4f1c...").
+func (s *Spec) Validate(method, requestPath string, status int, header http.Header, body []byte) error {
+	op, ok := s.find(method, requestPath)
+	if !ok {
+		return fmt.Errorf("%s %s is not documented", method, requestPath)
+	}
+
+	resp, ok := op.op.Responses.StatusCodeResponses[status]
+	if !ok {
+		if op.op.Responses.Default == nil {
+			return fmt.Errorf("%s %s: status %d is not documented", method, op.template, status)
+		}
+		resp = *op.op.Responses.Default
+	}
+
+	if resp.Schema == nil {
+		if len(bytes.TrimSpace(body)) > 0 {
+			return fmt.Errorf("%s %s: status %d documents no body but got %q", method, op.template, status, body)
+		}
+		return nil
+	}
+
+	if mediaType, _, err := mime.ParseMediaType(header.Get("Content-Type")); err != nil || mediaType != "application/json" {
+		return fmt.Errorf("%s %s: status %d: expected application/json, got Content-Type %q", method, op.template, status, header.Get("Content-Type"))
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var value any
+	if err := dec.Decode(&value); err != nil {
+		return fmt.Errorf("%s %s: status %d: invalid JSON body: %w", method, op.template, status, err)
+	}
+
+	var v validator
+	v.doc = s.doc
+	v.validate("$", resp.Schema, value)
+	if len(v.problems) > 0 {
+		return fmt.Errorf("%s %s: status %d violates the documented schema:\n  %s\nbody: %s",
+			method, op.template, status, strings.Join(v.problems, "\n  "), body)
+	}
+	return nil
+}
+
+// AssertResponse fails the test when w does not match the documented response.
+func (s *Spec) AssertResponse(t *testing.T, method, requestPath string, w *httptest.ResponseRecorder) {
+	t.Helper()
+	if err := s.Validate(method, requestPath, w.Code, w.Header(), w.Body.Bytes()); err != nil {
+		t.Error(err)
+	}
+}
+
+func (s *Spec) find(method, requestPath string) (operation, bool) {
+	if i := strings.IndexByte(requestPath, '?'); i >= 0 {
+		requestPath = requestPath[:i]
+	}
+	segments := splitPath(strings.TrimPrefix(requestPath, apiPrefix))
+
+	// Prefer the most literal template, so /reservations/mine would beat /reservations/{id}.
+	best, bestLiterals := operation{}, -1
+	for _, o := range s.operations {
+		if o.method != method || len(o.segments) != len(segments) {
+			continue
+		}
+		literals, matched := 0, true
+		for i, seg := range o.segments {
+			if strings.HasPrefix(seg, "{") {
+				continue
+			}
+			if seg != segments[i] {
+				matched = false
+				break
+			}
+			literals++
+		}
+		if matched && literals > bestLiterals {
+			best, bestLiterals = o, literals
+		}
+	}
+	return best, bestLiterals >= 0
+}
+
+func splitPath(p string) []string {
+	p = strings.Trim(p, "/")
+	if p == "" {
+		return []string{}
+	}
+	return strings.Split(p, "/")
+}
+
+type validator struct {
+	doc      *spec.Swagger
+	problems []string
+}
+
+func (v *validator) fail(path, format string, args ...any) {
+	v.problems = append(v.problems, path+": "+fmt.Sprintf(format, args...))
+}
+
+func (v *validator) resolve(path string, s *spec.Schema) (*spec.Schema, bool) {
+	for s.Ref.String() != "" {
+		name := strings.TrimPrefix(s.Ref.String(), "#/definitions/")
+		def, ok := v.doc.Definitions[name]
+		if !ok {
+			v.fail(path, "unresolvable $ref %q", s.Ref.String())
+			return nil, false
+		}
+		s = &def
+	}
+	return s, true
+}
+
+func (v *validator) validate(path string, s *spec.Schema, value any) {
+	s, ok := v.resolve(path, s)
+	if !ok {
+		return
+	}
+	for i := range s.AllOf {
+		v.validate(path, &s.AllOf[i], value)
+	}
+
+	if value == nil {
+		if !s.Nullable && len(s.Type) > 0 {
+			v.fail(path, "null where %s is documented", s.Type[0])
+		}
+		return
+	}
+	if len(s.Type) == 0 {
+		if len(s.Properties) > 0 {
+			v.validateObject(path, s, value)
+		}
+		return
+	}
+
+	switch s.Type[0] {
+	case "object":
+		v.validateObject(path, s, value)
+	case "array":
+		items, ok := value.([]any)
+		if !ok {
+			v.fail(path, "expected array, got %s", jsonKind(value))
+			return
+		}
+		if s.Items != nil && s.Items.Schema != nil {
+			for i, item := range items {
+				v.validate(path+"["+strconv.Itoa(i)+"]", s.Items.Schema, item)
+			}
+		}
+	case "string":
+		str, ok := value.(string)
+		if !ok {
+			v.fail(path, "expected string, got %s", jsonKind(value))
+			return
+		}
+		if s.Format == "date-time" {
+			if _, err := time.Parse(time.RFC3339Nano, str); err != nil {
+				v.fail(path, "expected RFC 3339 date-time, got %q", str)
+			}
+		}
+	case "integer":
+		n, ok := value.(json.Number)
+		if !ok {
+			v.fail(path, "expected integer, got %s", jsonKind(value))
+			return
+		}
+		if _, err := n.Int64(); err != nil {
+			v.fail(path, "expected integer, got %s", n)
+		}
+	case "number":
+		if _, ok := value.(json.Number); !ok {
+			v.fail(path, "expected number, got %s", jsonKind(value))
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			v.fail(path, "expected boolean, got %s", jsonKind(value))
+		}
+	}
+
+	if len(s.Enum) > 0 {
+		for _, allowed := range s.Enum {
+			if fmt.Sprint(allowed) == fmt.Sprint(value) {
+				return
+			}
+		}
+		v.fail(path, "%v is not one of the documented values %v", value, s.Enum)
+	}
+}
+
+// validateObject enforces required fields (present and non-null) and rejects fields the
+// docs do not mention, unless the schema declares additionalProperties.
+func (v *validator) validateObject(path string, s *spec.Schema, value any) {
+	obj, ok := value.(map[string]any)
+	if !ok {
+		v.fail(path, "expected object, got %s", jsonKind(value))
+		return
+	}
+
+	for _, name := range s.Required {
+		if field, present := obj[name]; !present || field == nil {
+			v.fail(path+"."+name, "required field is missing")
+		}
+	}
+
+	names := make([]string, 0, len(obj))
+	for name := range obj {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		fieldPath := path + "." + name
+		if prop, documented := s.Properties[name]; documented {
+			// swag cannot express nullability; optional fields may be null.
+			if obj[name] != nil {
+				v.validate(fieldPath, &prop, obj[name])
+			}
+			continue
+		}
+		switch {
+		case s.AdditionalProperties == nil:
+			// An object without properties is free-form (e.g. map[string]any).
+			if len(s.Properties) > 0 {
+				v.fail(fieldPath, "field is not documented")
+			}
+		case s.AdditionalProperties.Schema != nil:
+			v.validate(fieldPath, s.AdditionalProperties.Schema, obj[name])
+		case !s.AdditionalProperties.Allows:
+			v.fail(fieldPath, "field is not documented")
+		}
+	}
+}
+
+func jsonKind(value any) string {
+	switch value.(type) {
+	case map[string]any:
+		return "object"
+	case []any:
+		return "array"
+	case string:
+		return "string"
+	case json.Number:
+		return "number"
+	case bool:
+		return "boolean"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
@@ -0,0 +1,198 @@
+//go:build unit
+
+package contract_test
+
+import (
+	"net/http"
+	"testing"
+
+	"gin-clean-starter/tests/common/contract"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSpec = `{
+  "swagger": "2.0",
+  "paths": {
+    "/health": {
+      "get": {"responses": {"200": {"schema": {"type": "object", "additionalProperties": {"type": "string"}}}}}
+    },
+    "/reviews/{id}": {
+      "get": {
+        "responses": {
+          "200": {"schema": {"$ref": "#/definitions/Review"}},
+          "404": {"schema": {"$ref": "#/definitions/Error"}}
+        }
+      },
+      "delete": {"responses": {"204": {"description": "No Content"}}}
+    }
+  },
+  "definitions": {
+    "Review": {
+      "type": "object",
+      "required": ["id", "rating"],
+      "properties": {
+        "id": {"type": "string"},
+        "rating": {"type": "integer"},
+        "comment": {"type": "string"},
+        "createdAt": {"type": "string", "format": "date-time"},
+        "tags": {"type": "array", "items": {"type": "string"}}
+      }
+    },
+    "Error": {
+      "type": "object",
+      "required": ["error"],
+      "properties": {
+        "detail": {},
+        "error": {"type": "object", "required": ["message"], "properties": {"message": {"type": "string"}}}
+      }
+    }
+  }
+}`
+
+func TestSpec_Validate(t *testing.T) {
+	s, err := contract.Parse([]byte(testSpec))
+	require.NoError(t, err)
+
+	jsonHeader := http.Header{"Content-Type": []string{"application/json; charset=utf-8"}}
+
+	testCases := []struct {
+		name      string
+		method    string
+		path      string
+		status    int
+		header    http.Header
+		body      string
+		wantError string
+	}{
+		{
+			name:   "success: documented body matches",
+			method: http.MethodGet, path: "/api/reviews/42", status: http.StatusOK, header: jsonHeader,
+			body: `{"id":"42","rating":5,"comment":"ok","createdAt":"2025-01-02T03:04:05Z","tags":["a"]}`,
+		},
+		{
+			name:   "success: optional field may be null",
+			method: http.MethodGet, path: "/api/reviews/42?x=1", status: http.StatusOK, header: jsonHeader,
+			body: `{"id":"42","rating":5,"comment":null}`,
+		},
+		{
+			name:   "success: error detail accepts any value",
+			method: http.MethodGet, path: "/api/reviews/42", status: http.StatusNotFound, header: jsonHeader,
+			body: `{"error":{"message":"not found"},"detail":{"field":"id"}}`,
+		},
+		{
+			name:   "success: routes outside the API group match as-is",
+			method: http.MethodGet, path: "/health", status: http.StatusOK, header: jsonHeader,
+			body: `{"status":"ok"}`,
+		},
+		{
+			name:   "success: no-content response",
+			method: http.MethodDelete, path: "/api/reviews/42", status: http.StatusNoContent,
+		},
+		{
+			name:   "error: required field missing",
+			method: http.MethodGet, path: "/api/reviews/42", status: http.StatusOK, header: jsonHeader,
+			body:      `{"id":"42"}`,
+			wantError: "$.rating: required field is missing",
+		},
+		{
+			name:   "error: required field null",
+			method: http.MethodGet, path: "/api/reviews/42", status: http.StatusOK, header: jsonHeader,
+			body:      `{"id":"42","rating":null}`,
+			wantError: "$.rating: required field is missing",
+		},
+		{
+			name:   "error: wrong type",
+			method: http.MethodGet, path: "/api/reviews/42", status: http.StatusOK, header: jsonHeader,
+			body:      `{"id":42,"rating":5}`,
+			wantError: "$.id: expected string, got number",
+		},
+		{
+			name:   "error: fractional integer",
+			method: http.MethodGet, path: "/api/reviews/42", status: http.StatusOK, header: jsonHeader,
+			body:      `{"id":"42","rating":4.5}`,
+			wantError: "$.rating: expected integer",
+		},
+		{
+			name:   "error: malformed date-time",
+			method: http.MethodGet, path: "/api/reviews/42", status: http.StatusOK, header: jsonHeader,
+			body:      `{"id":"42","rating":5,"createdAt":"yesterday"}`,
+			wantError: "$.createdAt: expected RFC 3339 date-time",
+		},
+		{
+			name:   "error: array item type",
+			method: http.MethodGet, path: "/api/reviews/42", status: http.StatusOK, header: jsonHeader,
+			body:      `{"id":"42","rating":5,"tags":["a",1]}`,
+			wantError: "$.tags[1]: expected string",
+		},
+		{
+			name:   "error: undocumented field",
+			method: http.MethodGet, path: "/api/reviews/42", status: http.StatusOK, header: jsonHeader,
+			body:      `{"id":"42","rating":5,"secret":"x"}`,
+			wantError: "$.secret: field is not documented",
+		},
+		{
+			name:   "error: error payload in the wrong shape",
+			method: http.MethodGet, path: "/api/reviews/42", status: http.StatusNotFound, header: jsonHeader,
+			body:      `{"error":"not found"}`,
+			wantError: "$.error: expected object, got string",
+		},
+		{
+			name:   "error: undocumented status",
+			method: http.MethodGet, path: "/api/reviews/42", status: http.StatusInternalServerError, header: jsonHeader,
+			body:      `{"error":{"message":"boom"}}`,
+			wantError: "status 500 is not documented",
+		},
+		{
+			name:   "error: undocumented route",
+			method: http.MethodPost, path: "/api/reviews/42", status: http.StatusOK, header: jsonHeader,
+			body:      `{}`,
+			wantError: "POST /api/reviews/42 is not documented",
+		},
+		{
+			name:   "error: body on a no-content response",
+			method: http.MethodDelete, path: "/api/reviews/42", status: http.StatusNoContent,
+			body:      `{"id":"42"}`,
+			wantError: "documents no body",
+		},
+		{
+			name:   "error: non-JSON content type",
+			method: http.MethodGet, path: "/api/reviews/42", status: http.StatusOK,
+			header:    http.Header{"Content-Type": []string{"text/plain"}},
+			body:      `{"id":"42","rating":5}`,
+			wantError: "expected application/json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			header := tc.header
+			if header == nil {
+				header = http.Header{}
+			}
+			err := s.Validate(tc.method, tc.path, tc.status, header, []byte(tc.body))
+
+			if tc.wantError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSpec_OperationsAndRouteKey(t *testing.T) {
+	s, err := contract.Parse([]byte(testSpec))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"GET /health", "DELETE /reviews/{id}", "GET /reviews/{id}"}, s.Operations())
+	assert.Equal(t, "GET /reviews/{id}", contract.RouteKey(http.MethodGet, "/api/reviews/:id"))
+	assert.Equal(t, "GET /health", contract.RouteKey(http.MethodGet, "/health"))
+}
+
+func TestLoad_GeneratedDocument(t *testing.T) {
+	s := contract.MustLoad(t)
+	assert.Contains(t, s.Operations(), "GET /reviews/{id}")
+}
@@ -1,4 +1,4 @@
-//go:build unit || e2e
+//go:build unit || e2e || dbtest || integration
 
 package dbtest
 
@@ -18,24 +18,7 @@ import (
 
 func CreateTestUser(t *testing.T, db DBLike, email, role string) uuid.UUID {
 	t.Helper()
-
-	userID := uuid.New()
-	var companyID uuid.UUID
-
-	ctx := context.Background()
-	err := db.QueryRow(ctx, "SELECT id FROM companies WHERE name = 'Default Company' LIMIT 1").Scan(&companyID)
-	require.NoError(t, err)
-
-	passwordHash := "$2a$12$uhAjVE9f92IGYv3E25pJNetg.27lVt0p7jmLWjqjmhOg92ldPS0A."
-	tag, err := db.Exec(ctx, "INSERT INTO users (id, email, password_hash, role, company_id, is_active) VALUES ($1, $2, $3, $4, $5, true) ON CONFLICT (email) WHERE is_active = true DO NOTHING",
-		userID, email, passwordHash, role, companyID)
-	require.NoError(t, err)
-
-	if tag.RowsAffected() == 0 {
-		_ = db.QueryRow(ctx, "SELECT id FROM users WHERE email = $1 AND is_active = true", email).Scan(&userID)
-	}
-
-	return userID
+	return createUser(t, db, email, role, defaultCompanyID(t, db))
 }
 
 func CreateTestCompany(t *testing.T, db DBLike, name string) uuid.UUID {
@@ -69,6 +52,96 @@ func SeedReferenceData(pool *pgxpool.Pool) error {
 		return err
 	}
 
+	// System roles and permissions (mirrors 003_permission_schema.sql and later grants)
+	_, err = pool.Exec(ctx, `
+		INSERT INTO roles (name, description, is_system) VALUES
+		    ('viewer', 'Makes and manages own reservations and reviews', true),
+		    ('operator', 'Viewer plus read access to reviews and moderation of assigned resources', true),
+		    ('admin', 'Full access', true),
+		    ('owner', 'Runs a self-registered company: invites members and operates its resources', true)
+		ON CONFLICT (name) DO NOTHING;
+
+		INSERT INTO permissions (name, description) VALUES
+		    ('*', 'Every permission'),
+		    ('reservations:read:any', 'Read reservations of any user'),
+		    ('reviews:read:any', 'Read reviews of any user'),
+		    ('reviews:delete:any', 'Delete reviews of any user'),
+		    ('retention:read', 'View data retention reports'),
+		    ('roles:manage', 'Manage custom roles and their permissions'),
+		    ('reservations:read:assigned', 'Read reservations on assigned resources'),
+		    ('reservations:cancel:any', 'Cancel reservations of any user'),
+		    ('reservations:cancel:assigned', 'Cancel reservations on assigned resources'),
+		    ('reviews:delete:assigned', 'Delete reviews on assigned resources'),
+		    ('resource_operators:manage', 'Assign operators to resources'),
+		    ('invites:manage', 'Invite new members to the caller''s company'),
+		    ('support:access', 'Open read-only support sessions scoped to a company'),
+		    ('resource_blocks:manage:any', 'Block time ranges on any resource'),
+		    ('resource_blocks:manage:assigned', 'Block time ranges on assigned resources'),
+		    ('reservation_messages:write:any', 'Message users about any reservation'),
+		    ('reservation_messages:write:assigned', 'Message users about reservations on assigned resources'),
+		    ('reservation_attachments:write:any', 'Attach and delete files on any reservation'),
+		    ('reservation_attachments:write:assigned', 'Attach and delete files on reservations on assigned resources'),
+		    ('usage:read', 'View API usage and quotas of every company'),
+		    ('telemetry:read', 'View the feature adoption report'),
+		    ('features:manage', 'Turn features on or off per company'),
+		    ('maintenance:run', 'Run maintenance checks and repairs'),
+		    ('reservations:transfer:any', 'Transfer reservations of any user without the recipient''s acceptance'),
+		    ('reservations:transfer:assigned', 'Transfer reservations on assigned resources without the recipient''s acceptance'),
+		    ('audit_logs:read', 'Read the audit log'),
+		    ('notification_jobs:read', 'Read queued and sent notification jobs'),
+		    ('company_settings:manage', 'Change per-company settings such as the review window'),
+		    ('provisioning:manage', 'Issue and revoke SCIM provisioning tokens'),
+		    ('sso:manage', 'Configure a company''s SAML single sign-on'),
+		    ('reservations:approve:any', 'Approve or reject pending reservations on any resource'),
+		    ('resource_approvers:manage', 'Designate who approves reservations on a resource'),
+		    ('custom_fields:manage', 'Define the custom fields collected on a company''s reservations'),
+		    ('request_recordings:manage', 'Record a consenting user''s requests for support debugging'),
+		    ('company_exports:manage', 'Export all of a company''s data for offboarding'),
+		    ('companies:delete', 'Permanently delete a company and everything it holds'),
+		    ('search:read', 'Search users, reservations, resources and reviews of every company'),
+		    ('sessions:revoke', 'Sign a user out of every session'),
+		    ('api_keys:manage', 'Issue and revoke API keys for machine clients'),
+		    ('logins:unlock', 'Lift a login lockout before its cool-down ends'),
+		    ('reviews:import', 'Import historical reviews from a legacy system'),
+		    ('users:merge', 'Merge a duplicate account into another')
+		ON CONFLICT (name) DO NOTHING;
+
+		INSERT INTO role_permissions (role_name, permission_name) VALUES
+		    ('operator', 'reviews:read:any'),
+		    ('operator', 'reservations:read:assigned'),
+		    ('operator', 'reservations:cancel:assigned'),
+		    ('operator', 'reviews:delete:assigned'),
+		    ('operator', 'resource_blocks:manage:assigned'),
+		    ('operator', 'reservation_messages:write:assigned'),
+		    ('operator', 'reservation_attachments:write:assigned'),
+		    ('operator', 'reservations:transfer:assigned'),
+		    ('admin', '*'),
+		    ('owner', 'reservations:read:assigned'),
+		    ('owner', 'reservations:cancel:assigned'),
+		    ('owner', 'reviews:delete:assigned'),
+		    ('owner', 'invites:manage'),
+		    ('owner', 'resource_blocks:manage:assigned'),
+		    ('owner', 'reservation_messages:write:assigned'),
+		    ('owner', 'reservation_attachments:write:assigned'),
+		    ('owner', 'reservations:transfer:assigned')
+		ON CONFLICT DO NOTHING;
+	`)
+	if err != nil {
+		return err
+	}
+
+	// Billing plans (mirrors 024_billing.sql)
+	_, err = pool.Exec(ctx, `
+		INSERT INTO plans (id, name, max_resources, max_operators, monthly_request_quota) VALUES
+		    ('free', 'Free', 3, 2, 10000),
+		    ('pro', 'Pro', 50, 25, 1000000),
+		    ('enterprise', 'Enterprise', NULL, NULL, NULL)
+		ON CONFLICT (id) DO NOTHING;
+	`)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -125,15 +198,7 @@ func ResetDB(pool *pgxpool.Pool) error {
 
 func CreateTestResource(t *testing.T, db DBLike, name string, leadTimeMin int) uuid.UUID {
 	t.Helper()
-
-	resourceID := uuid.New()
-	ctx := context.Background()
-
-	_, err := db.Exec(ctx, "INSERT INTO resources (id, name, lead_time_min) VALUES ($1, $2, $3)",
-		resourceID, name, leadTimeMin)
-	require.NoError(t, err)
-
-	return resourceID
+	return createResource(t, db, name, leadTimeMin, nil)
 }
 
 func CreateTestReservation(t *testing.T, db DBLike, resourceID, userID uuid.UUID, startTime, endTime time.Time, status string) uuid.UUID {
@@ -1,4 +1,4 @@
-//go:build unit || e2e
+//go:build unit || e2e || dbtest || integration
 
 package dbtest
 
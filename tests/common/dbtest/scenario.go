@@ -0,0 +1,190 @@
+//go:build unit || e2e || dbtest || integration
+
+package dbtest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/domain/referral"
+	"gin-clean-starter/internal/domain/resource"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+// DefaultPassword is the plain-text password of every user the fixtures create.
+const DefaultPassword = "password123"
+
+// bcrypt hash of DefaultPassword
+const defaultPasswordHash = "$2a$12$uhAjVE9f92IGYv3E25pJNetg.27lVt0p7jmLWjqjmhOg92ldPS0A."
+
+type ScenarioUser struct {
+	ID    uuid.UUID
+	Email string
+	Role  string
+}
+
+// ScenarioFixtures holds every row a scenario created, in creation order. The singular
+// fields point at the most recently created row of each kind.
+type ScenarioFixtures struct {
+	CompanyID     uuid.UUID
+	User          ScenarioUser
+	ResourceID    uuid.UUID
+	ReservationID uuid.UUID
+
+	Users          []ScenarioUser
+	ResourceIDs    []uuid.UUID
+	ReservationIDs []uuid.UUID
+}
+
+// ScenarioBuilder composes e2e fixtures. Each With* call inserts immediately and attaches
+// to the latest company, user and resource, e.g.
+//
+//	sc := dbtest.Scenario(t, db).WithUser("viewer").WithResource().WithCompletedReservation().Build()
+type ScenarioBuilder struct {
+	t          *testing.T
+	db         DBLike
+	now        time.Time
+	fx         ScenarioFixtures
+	ownCompany bool // WithCompany was called; resources join the company too
+	seq        int
+	pastSlots  int
+	futureSlot int
+}
+
+// Scenario starts a builder; users join 'Default Company' until WithCompany is called.
+func Scenario(t *testing.T, db DBLike) *ScenarioBuilder {
+	t.Helper()
+	return &ScenarioBuilder{t: t, db: db, now: time.Now()}
+}
+
+// WithCompany creates a company that later users and resources belong to.
+func (b *ScenarioBuilder) WithCompany() *ScenarioBuilder {
+	b.t.Helper()
+	b.fx.CompanyID = CreateTestCompany(b.t, b.db, fmt.Sprintf("Scenario Company %d %s", b.next(), shortID()))
+	b.ownCompany = true
+	return b
+}
+
+// WithUser creates a user with a generated email; log in with DefaultPassword.
+func (b *ScenarioBuilder) WithUser(role string) *ScenarioBuilder {
+	b.t.Helper()
+	return b.WithUserEmail(fmt.Sprintf("%s-%d-%s@example.com", role, b.next(), shortID()), role)
+}
+
+func (b *ScenarioBuilder) WithUserEmail(email, role string) *ScenarioBuilder {
+	b.t.Helper()
+	if b.fx.CompanyID == uuid.Nil {
+		b.fx.CompanyID = defaultCompanyID(b.t, b.db)
+	}
+	u := ScenarioUser{ID: createUser(b.t, b.db, email, role, b.fx.CompanyID), Email: email, Role: role}
+	b.fx.User = u
+	b.fx.Users = append(b.fx.Users, u)
+	return b
+}
+
+// WithResource creates a resource with a generated name and a 60-minute lead time.
+func (b *ScenarioBuilder) WithResource() *ScenarioBuilder {
+	b.t.Helper()
+	return b.WithResourceNamed(fmt.Sprintf("Resource %d", b.next()), 60)
+}
+
+// WithResourceNamed creates a resource in the scenario company, or a shared (company-less)
+// resource when WithCompany was never called.
+func (b *ScenarioBuilder) WithResourceNamed(name string, leadTimeMin int) *ScenarioBuilder {
+	b.t.Helper()
+	var companyID *uuid.UUID
+	if b.ownCompany {
+		companyID = &b.fx.CompanyID
+	}
+	id := createResource(b.t, b.db, name, leadTimeMin, companyID)
+	b.fx.ResourceID = id
+	b.fx.ResourceIDs = append(b.fx.ResourceIDs, id)
+	return b
+}
+
+// WithCompletedReservation books the latest user on the latest resource in a confirmed,
+// already-ended slot, which makes it eligible for a review. Each call takes an earlier
+// one-hour slot so reservations never overlap.
+func (b *ScenarioBuilder) WithCompletedReservation() *ScenarioBuilder {
+	b.t.Helper()
+	b.pastSlots++
+	end := b.now.Add(-time.Duration(b.pastSlots) * time.Hour)
+	return b.WithReservation(end.Add(-time.Hour), end, "confirmed")
+}
+
+// WithUpcomingReservation books a confirmed one-hour slot starting a day from now; each
+// call takes the following hour.
+func (b *ScenarioBuilder) WithUpcomingReservation() *ScenarioBuilder {
+	b.t.Helper()
+	start := b.now.Add(24*time.Hour + time.Duration(b.futureSlot)*time.Hour)
+	b.futureSlot++
+	return b.WithReservation(start, start.Add(time.Hour), "confirmed")
+}
+
+func (b *ScenarioBuilder) WithReservation(start, end time.Time, status string) *ScenarioBuilder {
+	b.t.Helper()
+	require.NotEqual(b.t, uuid.Nil, b.fx.User.ID, "Scenario: add a user before a reservation")
+	require.NotEqual(b.t, uuid.Nil, b.fx.ResourceID, "Scenario: add a resource before a reservation")
+
+	id := CreateTestReservation(b.t, b.db, b.fx.ResourceID, b.fx.User.ID, start, end, status)
+	b.fx.ReservationID = id
+	b.fx.ReservationIDs = append(b.fx.ReservationIDs, id)
+	return b
+}
+
+func (b *ScenarioBuilder) Build() *ScenarioFixtures {
+	fx := b.fx
+	return &fx
+}
+
+func (b *ScenarioBuilder) next() int {
+	b.seq++
+	return b.seq
+}
+
+func shortID() string {
+	return uuid.NewString()[:8]
+}
+
+func defaultCompanyID(t *testing.T, db DBLike) uuid.UUID {
+	t.Helper()
+
+	var companyID uuid.UUID
+	err := db.QueryRow(context.Background(), "SELECT id FROM companies WHERE name = 'Default Company' LIMIT 1").Scan(&companyID)
+	require.NoError(t, err)
+	return companyID
+}
+
+func createUser(t *testing.T, db DBLike, email, role string, companyID uuid.UUID) uuid.UUID {
+	t.Helper()
+
+	userID := uuid.New()
+	ctx := context.Background()
+	code, err := referral.GenerateCode()
+	require.NoError(t, err)
+
+	tag, err := db.Exec(ctx, "INSERT INTO users (id, email, password_hash, role, company_id, referral_code, is_active) VALUES ($1, $2, $3, $4, $5, $6, true) ON CONFLICT (email) WHERE is_active = true DO NOTHING",
+		userID, email, defaultPasswordHash, role, companyID, code.String())
+	require.NoError(t, err)
+
+	if tag.RowsAffected() == 0 {
+		_ = db.QueryRow(ctx, "SELECT id FROM users WHERE email = $1 AND is_active = true", email).Scan(&userID)
+	}
+
+	return userID
+}
+
+func createResource(t *testing.T, db DBLike, name string, leadTimeMin int, companyID *uuid.UUID) uuid.UUID {
+	t.Helper()
+
+	resourceID := uuid.New()
+	_, err := db.Exec(context.Background(), "INSERT INTO resources (id, name, lead_time_min, company_id, slug) VALUES ($1, $2, $3, $4, $5)",
+		resourceID, name, leadTimeMin, companyID, resource.SlugFor(name, resourceID).String())
+	require.NoError(t, err)
+
+	return resourceID
+}
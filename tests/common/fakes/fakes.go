@@ -0,0 +1,193 @@
+//go:build unit || e2e || integration
+
+// Package fakes holds in-memory stand-ins for the subsystem ports in internal/usecase/shared.
+// Where a mock asserts calls, a fake behaves like the real adapter and keeps what it was given
+// for the test to inspect. Module provides all of them in place of the bootstrap modules.
+package fakes
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"slices"
+	"sync"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/usecase/shared"
+
+	"go.uber.org/fx"
+)
+
+// Module replaces bootstrap.StorageModule, SummaryModule, LanguageModule and AnalyticsModule.
+// The concrete fakes are provided as well so tests can fx.Populate them.
+var Module = fx.Module("fakes",
+	fx.Provide(
+		fx.Annotate(
+			NewFileStorage,
+			fx.As(fx.Self()),
+			fx.As(new(shared.FileStorage)),
+		),
+		fx.Annotate(
+			NewFileScanner,
+			fx.As(fx.Self()),
+			fx.As(new(shared.FileScanner)),
+		),
+		fx.Annotate(
+			NewSummarizer,
+			fx.As(fx.Self()),
+			fx.As(new(shared.Summarizer)),
+		),
+		fx.Annotate(
+			NewLanguageDetector,
+			fx.As(fx.Self()),
+			fx.As(new(shared.LanguageDetector)),
+		),
+		fx.Annotate(
+			NewAnalyticsSink,
+			fx.As(fx.Self()),
+			fx.As(new(shared.AnalyticsSink)),
+		),
+	),
+)
+
+// FileStorage keeps files in a map.
+type FileStorage struct {
+	mu    sync.Mutex
+	files map[string][]byte
+}
+
+func NewFileStorage() *FileStorage {
+	return &FileStorage{files: map[string][]byte{}}
+}
+
+func (s *FileStorage) Put(_ context.Context, key string, content io.Reader) error {
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.files[key] = data
+	return nil
+}
+
+func (s *FileStorage) Open(_ context.Context, key string) (io.ReadCloser, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, ok := s.files[key]
+	if !ok {
+		return nil, infra.WrapRepoErr("file not found", errors.New(key), infra.KindNotFound)
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (s *FileStorage) Delete(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.files, key)
+	return nil
+}
+
+// Keys lists the stored keys in order.
+func (s *FileStorage) Keys() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	keys := make([]string, 0, len(s.files))
+	for k := range s.files {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// FileScanner passes every file except those named in Reject.
+type FileScanner struct {
+	mu      sync.Mutex
+	rejects map[string]bool
+}
+
+func NewFileScanner() *FileScanner {
+	return &FileScanner{rejects: map[string]bool{}}
+}
+
+// Reject makes later scans of filename report it as infected.
+func (s *FileScanner) Reject(filename string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rejects[filename] = true
+}
+
+func (s *FileScanner) Scan(_ context.Context, filename string, _ []byte) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return !s.rejects[filename], nil
+}
+
+// Summarizer returns Summary for every non-empty batch and records the batches.
+type Summarizer struct {
+	mu      sync.Mutex
+	Summary string
+	calls   [][]shared.ReviewExcerpt
+}
+
+func NewSummarizer() *Summarizer {
+	return &Summarizer{Summary: "fake summary"}
+}
+
+func (s *Summarizer) Summarize(_ context.Context, reviews []shared.ReviewExcerpt) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls = append(s.calls, slices.Clone(reviews))
+	if len(reviews) == 0 {
+		return "", nil
+	}
+	return s.Summary, nil
+}
+
+// Calls returns the review batches summarized so far.
+func (s *Summarizer) Calls() [][]shared.ReviewExcerpt {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.calls)
+}
+
+// LanguageDetector reports Language for any text, or "" for empty text.
+type LanguageDetector struct {
+	Language string
+}
+
+func NewLanguageDetector() *LanguageDetector {
+	return &LanguageDetector{Language: "en"}
+}
+
+func (d *LanguageDetector) Detect(_ context.Context, text string) (string, error) {
+	if text == "" {
+		return "", nil
+	}
+	return d.Language, nil
+}
+
+// AnalyticsSink records the emitted counts.
+type AnalyticsSink struct {
+	mu     sync.Mutex
+	counts []shared.FeatureCount
+}
+
+func NewAnalyticsSink() *AnalyticsSink {
+	return &AnalyticsSink{}
+}
+
+func (s *AnalyticsSink) Emit(_ context.Context, counts []shared.FeatureCount) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.counts = append(s.counts, counts...)
+	return nil
+}
+
+// Counts returns every count emitted so far.
+func (s *AnalyticsSink) Counts() []shared.FeatureCount {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.counts)
+}
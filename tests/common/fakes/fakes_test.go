@@ -0,0 +1,107 @@
+//go:build unit
+
+package fakes_test
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/usecase/shared"
+	"gin-clean-starter/tests/common/fakes"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxtest"
+)
+
+func TestModule(t *testing.T) {
+	var (
+		storage     shared.FileStorage
+		scanner     shared.FileScanner
+		summarizer  shared.Summarizer
+		detector    shared.LanguageDetector
+		sink        shared.AnalyticsSink
+		fakeStorage *fakes.FileStorage
+		fakeSink    *fakes.AnalyticsSink
+	)
+	app := fxtest.New(t,
+		fakes.Module,
+		fx.Populate(&storage, &scanner, &summarizer, &detector, &sink, &fakeStorage, &fakeSink),
+	)
+	app.RequireStart()
+	defer app.RequireStop()
+
+	ctx := context.Background()
+	require.NoError(t, storage.Put(ctx, "a/b.txt", strings.NewReader("hello")))
+	assert.Equal(t, []string{"a/b.txt"}, fakeStorage.Keys(), "the interface and the fake are one instance")
+
+	require.NoError(t, sink.Emit(ctx, []shared.FeatureCount{{Feature: "reservations", Requests: 2}}))
+	assert.Len(t, fakeSink.Counts(), 1)
+}
+
+func TestFileStorage(t *testing.T) {
+	ctx := context.Background()
+	s := fakes.NewFileStorage()
+
+	t.Run("success: stored content round-trips and delete removes it", func(t *testing.T) {
+		require.NoError(t, s.Put(ctx, "key", strings.NewReader("content")))
+
+		rc, err := s.Open(ctx, "key")
+		require.NoError(t, err)
+		data, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		assert.Equal(t, "content", string(data))
+
+		require.NoError(t, s.Delete(ctx, "key"))
+		assert.Empty(t, s.Keys())
+	})
+
+	t.Run("error: missing keys are not found like the local storage", func(t *testing.T) {
+		_, err := s.Open(ctx, "missing")
+
+		assert.True(t, infra.IsKind(err, infra.KindNotFound))
+	})
+}
+
+func TestFileScanner(t *testing.T) {
+	s := fakes.NewFileScanner()
+	s.Reject("eicar.txt")
+
+	clean, err := s.Scan(context.Background(), "report.pdf", nil)
+	require.NoError(t, err)
+	assert.True(t, clean)
+
+	clean, err = s.Scan(context.Background(), "eicar.txt", nil)
+	require.NoError(t, err)
+	assert.False(t, clean)
+}
+
+func TestSummarizer(t *testing.T) {
+	s := fakes.NewSummarizer()
+	s.Summary = "Mostly positive"
+
+	got, err := s.Summarize(context.Background(), []shared.ReviewExcerpt{{Rating: 5, Comment: "Great"}})
+	require.NoError(t, err)
+	assert.Equal(t, "Mostly positive", got)
+
+	got, err = s.Summarize(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Empty(t, got)
+	assert.Len(t, s.Calls(), 2)
+}
+
+func TestLanguageDetector(t *testing.T) {
+	d := fakes.NewLanguageDetector()
+
+	got, err := d.Detect(context.Background(), "Bonjour")
+	require.NoError(t, err)
+	assert.Equal(t, "en", got)
+
+	got, err = d.Detect(context.Background(), "")
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
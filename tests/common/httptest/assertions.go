@@ -44,3 +44,19 @@ func AssertErrorResponse(t *testing.T, w *httptest.ResponseRecorder, expectedSta
 			"Response error message doesn't contain expected text")
 	}
 }
+
+func AssertErrorCode(t *testing.T, w *httptest.ResponseRecorder, expectedStatus int, expectedCode string) {
+	t.Helper()
+
+	assert.Equal(t, expectedStatus, w.Code,
+		fmt.Sprintf("Expected status %d, got %d", expectedStatus, w.Code))
+
+	var errorResponse struct {
+		Error struct {
+			Code string `json:"code"`
+		} `json:"error"`
+	}
+	err := json.Unmarshal(w.Body.Bytes(), &errorResponse)
+	assert.NoError(t, err, fmt.Sprintf("Failed to decode error response JSON: %s", w.Body.String()))
+	assert.Equal(t, expectedCode, errorResponse.Error.Code, "Response error code mismatch")
+}
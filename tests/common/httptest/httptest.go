@@ -5,6 +5,7 @@ package httptest
 import (
 	"bytes"
 	"encoding/json"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -72,6 +73,64 @@ func PerformRequestWithCookies(t *testing.T, router *gin.Engine, method, path st
 	return w
 }
 
+// performs HTTP request with extra headers (e.g. Idempotency-Key)
+func PerformRequestWithHeaders(t *testing.T, router *gin.Engine, method, path string, body any, authToken string, headers map[string]string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	var reqBody *bytes.Buffer
+	if body != nil {
+		jsonBody, err := json.Marshal(body)
+		require.NoError(t, err, "Failed to encode request body to JSON")
+		reqBody = bytes.NewBuffer(jsonBody)
+	} else {
+		reqBody = bytes.NewBuffer(nil)
+	}
+
+	req := httptest.NewRequest(method, path, reqBody)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if authToken != "" {
+		req.Header.Set("Authorization", "Bearer "+authToken)
+	}
+
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+// uploads content as the multipart "file" field, with any extra form fields
+func PerformMultipartRequest(t *testing.T, router *gin.Engine, method, path, filename string, content []byte, fields map[string]string, authToken string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	var reqBody bytes.Buffer
+	mw := multipart.NewWriter(&reqBody)
+	part, err := mw.CreateFormFile("file", filename)
+	require.NoError(t, err, "Failed to create multipart file field")
+	_, err = part.Write(content)
+	require.NoError(t, err, "Failed to write multipart file field")
+	for k, v := range fields {
+		require.NoError(t, mw.WriteField(k, v), "Failed to write multipart field")
+	}
+	require.NoError(t, mw.Close(), "Failed to close multipart body")
+
+	req := httptest.NewRequest(method, path, &reqBody)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+
+	if authToken != "" {
+		req.Header.Set("Authorization", "Bearer "+authToken)
+	}
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
 // extracts all cookies from response
 func ExtractCookies(w *httptest.ResponseRecorder) []*http.Cookie {
 	return w.Result().Cookies()
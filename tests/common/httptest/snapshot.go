@@ -0,0 +1,272 @@
+//go:build unit || e2e
+
+package httptest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+// UpdateSnapshotsEnv rewrites the golden files instead of comparing against them when set
+// to a non-empty value: UPDATE_SNAPSHOTS=1 go test -tags unit ./...
+const UpdateSnapshotsEnv = "UPDATE_SNAPSHOTS"
+
+const snapshotDir = "testdata/snapshots"
+
+// Differences listed per failed comparison before the rest are summarized.
+const maxSnapshotDiffs = 20
+
+var unsafeNameChars = regexp.MustCompile(`[^A-Za-z0-9.-]+`)
+
+// SnapshotRule masks values that change between runs. It gets the JSON path of a value
+// (list indexes as "[i]") and the decoded value, and returns the placeholder stored in its
+// place, or false to keep the value.
+type SnapshotRule func(path []string, value any) (string, bool)
+
+// IgnoreFields masks the values at the given dotted paths, e.g. "createdAt" or
+// "reviews.id". Lists are transparent and "*" matches any key.
+func IgnoreFields(paths ...string) SnapshotRule {
+	patterns := make([][]string, len(paths))
+	for i, p := range paths {
+		patterns[i] = strings.Split(p, ".")
+	}
+	return func(path []string, _ any) (string, bool) {
+		keys := slices.DeleteFunc(slices.Clone(path), func(seg string) bool {
+			return strings.HasPrefix(seg, "[")
+		})
+		for _, pattern := range patterns {
+			if matchPath(pattern, keys) {
+				return "<ignored>", true
+			}
+		}
+		return "", false
+	}
+}
+
+// IgnoreIDs masks every string value that is a UUID.
+func IgnoreIDs() SnapshotRule {
+	return func(_ []string, value any) (string, bool) {
+		s, ok := value.(string)
+		if !ok || len(s) != 36 {
+			return "", false
+		}
+		if _, err := uuid.Parse(s); err != nil {
+			return "", false
+		}
+		return "<uuid>", true
+	}
+}
+
+// IgnoreTimestamps masks every string value that is an RFC 3339 timestamp.
+func IgnoreTimestamps() SnapshotRule {
+	return func(_ []string, value any) (string, bool) {
+		s, ok := value.(string)
+		if !ok {
+			return "", false
+		}
+		if _, err := time.Parse(time.RFC3339Nano, s); err != nil {
+			return "", false
+		}
+		return "<timestamp>", true
+	}
+}
+
+// AssertSnapshot compares the status and JSON body of w with the golden file of the running
+// test, testdata/snapshots/<test name>.json in the test's package. Values matched by rules are
+// replaced by placeholders before comparing, and a mismatch lists every differing field.
+// A missing golden file fails the test; run with UPDATE_SNAPSHOTS=1 to record it, then
+// review and commit the file.
+func AssertSnapshot(t *testing.T, w *httptest.ResponseRecorder, rules ...SnapshotRule) {
+	t.Helper()
+
+	got := canonicalSnapshot(t, w.Code, w.Body.Bytes(), rules)
+	path := snapshotPath(t.Name())
+
+	if os.Getenv(UpdateSnapshotsEnv) != "" {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, got, 0o644))
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		t.Fatalf("snapshot %s does not exist; run with %s=1 to record it", path, UpdateSnapshotsEnv)
+	}
+	require.NoError(t, err)
+	if bytes.Equal(want, got) {
+		return
+	}
+
+	var wantDoc, gotDoc any
+	require.NoError(t, decodeJSON(want, &wantDoc), "snapshot %s is not valid JSON", path)
+	require.NoError(t, decodeJSON(got, &gotDoc))
+	diffs := diffJSON(nil, wantDoc, gotDoc, nil)
+	if len(diffs) == 0 {
+		return // same document, formatted differently
+	}
+	if len(diffs) > maxSnapshotDiffs {
+		diffs = append(diffs[:maxSnapshotDiffs], fmt.Sprintf("... and %d more", len(diffs)-maxSnapshotDiffs))
+	}
+	t.Errorf("response does not match snapshot %s (run with %s=1 to accept):\n  %s",
+		path, UpdateSnapshotsEnv, strings.Join(diffs, "\n  "))
+}
+
+// canonicalSnapshot renders the masked response as indented JSON with sorted keys. Bodies
+// that are not JSON are kept as a string.
+func canonicalSnapshot(t *testing.T, status int, body []byte, rules []SnapshotRule) []byte {
+	t.Helper()
+
+	var doc any
+	if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 {
+		if err := decodeJSON(trimmed, &doc); err != nil {
+			doc = string(body)
+		} else {
+			doc = mask(nil, doc, rules)
+		}
+	}
+	var out bytes.Buffer
+	enc := json.NewEncoder(&out)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	require.NoError(t, enc.Encode(map[string]any{"status": status, "body": doc}))
+	return out.Bytes()
+}
+
+func decodeJSON(data []byte, v any) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return dec.Decode(v)
+}
+
+func mask(path []string, v any, rules []SnapshotRule) any {
+	for _, rule := range rules {
+		if placeholder, ok := rule(path, v); ok {
+			return placeholder
+		}
+	}
+	switch x := v.(type) {
+	case map[string]any:
+		for k, val := range x {
+			x[k] = mask(append(slices.Clip(path), k), val, rules)
+		}
+	case []any:
+		for i := range x {
+			x[i] = mask(append(slices.Clip(path), fmt.Sprintf("[%d]", i)), x[i], rules)
+		}
+	}
+	return v
+}
+
+// diffJSON appends one line per field that differs between want and got.
+func diffJSON(path []string, want, got any, diffs []string) []string {
+	wantMap, wantIsMap := want.(map[string]any)
+	gotMap, gotIsMap := got.(map[string]any)
+	if wantIsMap && gotIsMap {
+		keys := make([]string, 0, len(wantMap)+len(gotMap))
+		for k := range wantMap {
+			keys = append(keys, k)
+		}
+		for k := range gotMap {
+			if _, ok := wantMap[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			child := append(slices.Clip(path), k)
+			w, inWant := wantMap[k]
+			g, inGot := gotMap[k]
+			switch {
+			case !inGot:
+				diffs = append(diffs, fmt.Sprintf("%s: removed (was %s)", formatPath(child), formatValue(w)))
+			case !inWant:
+				diffs = append(diffs, fmt.Sprintf("%s: added %s", formatPath(child), formatValue(g)))
+			default:
+				diffs = diffJSON(child, w, g, diffs)
+			}
+		}
+		return diffs
+	}
+
+	wantList, wantIsList := want.([]any)
+	gotList, gotIsList := got.([]any)
+	if wantIsList && gotIsList {
+		for i := 0; i < max(len(wantList), len(gotList)); i++ {
+			child := append(slices.Clip(path), fmt.Sprintf("[%d]", i))
+			switch {
+			case i >= len(gotList):
+				diffs = append(diffs, fmt.Sprintf("%s: removed (was %s)", formatPath(child), formatValue(wantList[i])))
+			case i >= len(wantList):
+				diffs = append(diffs, fmt.Sprintf("%s: added %s", formatPath(child), formatValue(gotList[i])))
+			default:
+				diffs = diffJSON(child, wantList[i], gotList[i], diffs)
+			}
+		}
+		return diffs
+	}
+
+	if formatValue(want) != formatValue(got) {
+		diffs = append(diffs, fmt.Sprintf("%s: want %s, got %s", formatPath(path), formatValue(want), formatValue(got)))
+	}
+	return diffs
+}
+
+func formatPath(path []string) string {
+	if len(path) == 0 {
+		return "(root)"
+	}
+	var b strings.Builder
+	for i, seg := range path {
+		if i > 0 && !strings.HasPrefix(seg, "[") {
+			b.WriteByte('.')
+		}
+		b.WriteString(seg)
+	}
+	return b.String()
+}
+
+func formatValue(v any) string {
+	out, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(out)
+}
+
+func matchPath(pattern, keys []string) bool {
+	if len(pattern) != len(keys) {
+		return false
+	}
+	for i, p := range pattern {
+		if p != "*" && p != keys[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// snapshotPath maps a test name such as "TestSuite/TestGet/success: returns 200" to
+// testdata/snapshots/TestSuite/TestGet/success_returns_200.json.
+func snapshotPath(testName string) string {
+	segments := strings.Split(testName, "/")
+	for i, seg := range segments {
+		seg = strings.Trim(unsafeNameChars.ReplaceAllString(seg, "_"), "_.")
+		if seg == "" {
+			seg = "_"
+		}
+		segments[i] = seg
+	}
+	return filepath.Join(snapshotDir, filepath.Join(segments...)) + ".json"
+}
@@ -0,0 +1,92 @@
+//go:build unit
+
+package httptest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func recorder(status int, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	w.Code = status
+	w.Body.WriteString(body)
+	return w
+}
+
+func TestAssertSnapshot(t *testing.T) {
+	t.Chdir(t.TempDir())
+	rules := []SnapshotRule{IgnoreIDs(), IgnoreTimestamps(), IgnoreFields("reviews.etag")}
+
+	t.Setenv(UpdateSnapshotsEnv, "1")
+	AssertSnapshot(t, recorder(http.StatusOK,
+		`{"reviews":[{"id":"7f0c6b1e-4f7a-4c59-9d8e-2a3b4c5d6e7f","rating":5,"etag":"a1","createdAt":"2026-01-02T03:04:05Z"}],"nextCursor":""}`,
+	), rules...)
+
+	golden, err := os.ReadFile(snapshotPath(t.Name()))
+	require.NoError(t, err)
+	assert.Equal(t, `{
+  "body": {
+    "nextCursor": "",
+    "reviews": [
+      {
+        "createdAt": "<timestamp>",
+        "etag": "<ignored>",
+        "id": "<uuid>",
+        "rating": 5
+      }
+    ]
+  },
+  "status": 200
+}
+`, string(golden))
+
+	t.Setenv(UpdateSnapshotsEnv, "")
+	// Ignored values may change between runs.
+	AssertSnapshot(t, recorder(http.StatusOK,
+		`{"nextCursor":"","reviews":[{"id":"0b5f2d8c-1111-4c59-9d8e-2a3b4c5d6e7f","rating":5,"etag":"b2","createdAt":"2026-05-06T07:08:09.123Z"}]}`,
+	), rules...)
+}
+
+func TestDiffJSON(t *testing.T) {
+	var want, got any
+	require.NoError(t, decodeJSON([]byte(`{"status":200,"body":{"items":[{"id":1,"tags":["a"]}],"old":true}}`), &want))
+	require.NoError(t, decodeJSON([]byte(`{"status":201,"body":{"items":[{"id":2,"tags":["a","b"]}],"new":1}}`), &got))
+
+	assert.Equal(t, []string{
+		`body.items[0].id: want 1, got 2`,
+		`body.items[0].tags[1]: added "b"`,
+		`body.new: added 1`,
+		`body.old: removed (was true)`,
+		`status: want 200, got 201`,
+	}, diffJSON(nil, want, got, nil))
+}
+
+func TestIgnoreFields(t *testing.T) {
+	rule := IgnoreFields("reviews.createdAt", "*.token")
+
+	testCases := []struct {
+		path []string
+		want bool
+	}{
+		{path: []string{"reviews", "[3]", "createdAt"}, want: true},
+		{path: []string{"createdAt"}, want: false},
+		{path: []string{"session", "token"}, want: true},
+		{path: []string{"token"}, want: false},
+	}
+	for _, tc := range testCases {
+		_, ok := rule(tc.path, "x")
+		assert.Equal(t, tc.want, ok, "%v", tc.path)
+	}
+}
+
+func TestSnapshotPath(t *testing.T) {
+	assert.Equal(t,
+		"testdata/snapshots/TestReviewHandlerSuite/TestGet/success_returns_200_OK.json",
+		snapshotPath("TestReviewHandlerSuite/TestGet/success:_returns_200_OK"))
+}
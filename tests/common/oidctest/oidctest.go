@@ -0,0 +1,208 @@
+//go:build unit || e2e
+
+// Package oidctest is an in-process OpenID provider that signs ID tokens for the client
+// under test.
+package oidctest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	ClientID     = "oidctest-client"
+	ClientSecret = "oidctest-secret"
+
+	keyID = "oidctest-key"
+)
+
+// User is who signs in at the provider.
+type User struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+// Provider accepts the client ClientID/ClientSecret. Its issuer is URL. Claims, when set,
+// edits each ID token's claims before signing; WrongKey signs with a key it does not publish.
+type Provider struct {
+	URL      string
+	Claims   func(claims jwt.MapClaims)
+	WrongKey bool
+
+	server *httptest.Server
+	key    *rsa.PrivateKey
+	mu     sync.Mutex
+	codes  map[string]grant
+}
+
+type grant struct {
+	user        User
+	redirectURI string
+	challenge   string
+	nonce       string
+}
+
+var (
+	sharedOnce     sync.Once
+	sharedProvider *Provider
+)
+
+// Shared is a provider that lives as long as the test binary, for apps built once per run.
+func Shared() *Provider {
+	sharedOnce.Do(func() {
+		sharedProvider = start()
+	})
+	return sharedProvider
+}
+
+// NewProvider is a provider closed when the test ends.
+func NewProvider(t *testing.T) *Provider {
+	t.Helper()
+	p := start()
+	t.Cleanup(p.server.Close)
+	return p
+}
+
+func start() *Provider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+	p := &Provider{key: key, codes: map[string]grant{}}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", p.discovery)
+	mux.HandleFunc("GET /keys", p.keys)
+	mux.HandleFunc("POST /token", p.token)
+	p.server = httptest.NewServer(mux)
+	p.URL = p.server.URL
+	return p
+}
+
+// Authorize plays the browser signing u in at the authorization URL the client sent it
+// to, and returns the callback URL the provider redirects back to.
+func (p *Provider) Authorize(t *testing.T, authURL string, u User) string {
+	t.Helper()
+	parsed, err := url.Parse(authURL)
+	require.NoError(t, err)
+	q := parsed.Query()
+	require.Equal(t, p.URL+"/authorize", parsed.Scheme+"://"+parsed.Host+parsed.Path)
+	require.Equal(t, ClientID, q.Get("client_id"))
+	require.Equal(t, "code", q.Get("response_type"))
+	require.Equal(t, "S256", q.Get("code_challenge_method"))
+
+	code := rand.Text()
+	p.mu.Lock()
+	p.codes[code] = grant{
+		user:        u,
+		redirectURI: q.Get("redirect_uri"),
+		challenge:   q.Get("code_challenge"),
+		nonce:       q.Get("nonce"),
+	}
+	p.mu.Unlock()
+
+	callback, err := url.Parse(q.Get("redirect_uri"))
+	require.NoError(t, err)
+	cq := callback.Query()
+	cq.Set("code", code)
+	cq.Set("state", q.Get("state"))
+	callback.RawQuery = cq.Encode()
+	return callback.String()
+}
+
+func (p *Provider) discovery(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{
+		"issuer":                 p.URL,
+		"authorization_endpoint": p.URL + "/authorize",
+		"token_endpoint":         p.URL + "/token",
+		"jwks_uri":               p.URL + "/keys",
+	})
+}
+
+func (p *Provider) keys(w http.ResponseWriter, _ *http.Request) {
+	pub := p.key.PublicKey
+	writeJSON(w, http.StatusOK, map[string]any{"keys": []map[string]string{{
+		"kty": "RSA",
+		"kid": keyID,
+		"use": "sig",
+		"alg": "RS256",
+		"n":   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+	}}})
+}
+
+func (p *Provider) token(w http.ResponseWriter, r *http.Request) {
+	id, secret, ok := r.BasicAuth()
+	if !ok || id != ClientID || secret != ClientSecret {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+	p.mu.Lock()
+	g, found := p.codes[r.PostFormValue("code")]
+	delete(p.codes, r.PostFormValue("code"))
+	p.mu.Unlock()
+	sum := sha256.Sum256([]byte(r.PostFormValue("code_verifier")))
+	if !found || r.PostFormValue("grant_type") != "authorization_code" ||
+		r.PostFormValue("redirect_uri") != g.redirectURI ||
+		base64.RawURLEncoding.EncodeToString(sum[:]) != g.challenge {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"iss":            p.URL,
+		"aud":            ClientID,
+		"sub":            g.user.Subject,
+		"nonce":          g.nonce,
+		"email":          g.user.Email,
+		"email_verified": g.user.EmailVerified,
+		"name":           g.user.Name,
+		"iat":            now.Unix(),
+		"exp":            now.Add(5 * time.Minute).Unix(),
+	}
+	if p.Claims != nil {
+		p.Claims(claims)
+	}
+	key := p.key
+	if p.WrongKey {
+		var err error
+		if key, err = rsa.GenerateKey(rand.Reader, 2048); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = keyID
+	signed, err := token.SignedString(key)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": "oidctest-access-token",
+		"token_type":   "Bearer",
+		"expires_in":   300,
+		"id_token":     signed,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,126 @@
+//go:build unit || e2e
+
+// Package samltest is an in-process SAML identity provider that signs responses for the
+// service provider under test.
+package samltest
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/xml"
+	"io"
+	"math/big"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/crewjam/saml"
+	"github.com/stretchr/testify/require"
+)
+
+// IdP signs with a throwaway key; its entity ID is EntityID.
+type IdP struct {
+	EntityID string
+	idp      *saml.IdentityProvider
+}
+
+// User is the subject of a response. Attributes are sent as basic string attributes.
+type User struct {
+	NameID     string
+	Attributes map[string][]string
+}
+
+func NewIdP(t *testing.T) *IdP {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "samltest"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	metadataURL, _ := url.Parse("https://idp.example.com/metadata")
+	ssoURL, _ := url.Parse("https://idp.example.com/sso")
+	return &IdP{
+		EntityID: metadataURL.String(),
+		idp: &saml.IdentityProvider{
+			Key:         key,
+			Certificate: cert,
+			MetadataURL: *metadataURL,
+			SSOURL:      *ssoURL,
+		},
+	}
+}
+
+// Metadata is the IdP metadata XML a company uploads.
+func (p *IdP) Metadata(t *testing.T) []byte {
+	t.Helper()
+	out, err := xml.Marshal(p.idp.Metadata())
+	require.NoError(t, err)
+	return out
+}
+
+// Response is a signed, base64 SAMLResponse for the SP described by spMetadata, answering
+// requestID ("" for an IdP-initiated login).
+func (p *IdP) Response(t *testing.T, spMetadata []byte, requestID string, u User) string {
+	t.Helper()
+	var sp saml.EntityDescriptor
+	require.NoError(t, xml.Unmarshal(spMetadata, &sp))
+	require.NotEmpty(t, sp.SPSSODescriptors)
+
+	attrs := make([]saml.Attribute, 0, len(u.Attributes))
+	for name, values := range u.Attributes {
+		attr := saml.Attribute{Name: name, NameFormat: "urn:oasis:names:tc:SAML:2.0:attrname-format:basic"}
+		for _, v := range values {
+			attr.Values = append(attr.Values, saml.AttributeValue{Type: "xs:string", Value: v})
+		}
+		attrs = append(attrs, attr)
+	}
+
+	req := &saml.IdpAuthnRequest{
+		IDP:                     p.idp,
+		HTTPRequest:             httptest.NewRequest("POST", p.idp.SSOURL.String(), nil),
+		Request:                 saml.AuthnRequest{ID: requestID},
+		ServiceProviderMetadata: &sp,
+		SPSSODescriptor:         &sp.SPSSODescriptors[0],
+		ACSEndpoint:             &sp.SPSSODescriptors[0].AssertionConsumerServices[0],
+		Now:                     saml.TimeNow(),
+	}
+	require.NoError(t, saml.DefaultAssertionMaker{}.MakeAssertion(req, &saml.Session{
+		ID:               "session-" + u.NameID,
+		CreateTime:       req.Now,
+		ExpireTime:       req.Now.Add(time.Hour),
+		NameID:           u.NameID,
+		NameIDFormat:     string(saml.EmailAddressNameIDFormat),
+		CustomAttributes: attrs,
+	}))
+	form, err := req.PostBinding()
+	require.NoError(t, err)
+	return form.SAMLResponse
+}
+
+// RequestID reads the AuthnRequest ID out of an HTTP-Redirect binding URL.
+func RequestID(t *testing.T, redirectURL string) string {
+	t.Helper()
+	u, err := url.Parse(redirectURL)
+	require.NoError(t, err)
+	raw, err := base64.StdEncoding.DecodeString(u.Query().Get("SAMLRequest"))
+	require.NoError(t, err)
+	inflated, err := io.ReadAll(flate.NewReader(bytes.NewReader(raw)))
+	require.NoError(t, err)
+	var req saml.AuthnRequest
+	require.NoError(t, xml.Unmarshal(inflated, &req))
+	return req.ID
+}
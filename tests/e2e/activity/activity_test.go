@@ -0,0 +1,152 @@
+//go:build e2e
+
+package activity_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/tests/common/authtest"
+	"gin-clean-starter/tests/common/dbtest"
+	"gin-clean-starter/tests/common/httptest"
+	"gin-clean-starter/tests/e2e"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	auditLogsURL        = "/api/admin/audit-logs"
+	notificationJobsURL = "/api/admin/notification-jobs"
+)
+
+type ActivitySuite struct {
+	e2e.SharedSuite
+}
+
+func (s *ActivitySuite) SetupSubTest() {
+	s.SharedSuite.SetupSubTest()
+}
+
+func TestActivitySuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ActivitySuite))
+}
+
+// walk follows nextCursor from the first page to the last and returns every page.
+func walk[P any](s *ActivitySuite, t *testing.T, token, path string, query url.Values, next func(P) string) []P {
+	t.Helper()
+
+	var pages []P
+	for len(pages) < 10 {
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, path+"?"+query.Encode(), nil, token)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var page P
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &page))
+		pages = append(pages, page)
+		if next(page) == "" {
+			return pages
+		}
+		query.Set("after", next(page))
+	}
+	t.Fatal("pagination did not end")
+	return nil
+}
+
+func (s *ActivitySuite) TestAuditLogs() {
+	s.Run("Normal case: pages through one actor's entries newest first", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+		base := time.Now().Add(-time.Hour)
+		for i := range 3 {
+			_, err := s.DB.Exec(context.Background(),
+				`INSERT INTO audit_logs (actor_id, action, target_type, target_id, metadata, created_at) VALUES ($1, $2, 'user', $3, '{"n": 1}', $4)`,
+				sc.User.ID, fmt.Sprintf("test.action_%d", i), sc.User.ID.String(), base.Add(time.Duration(i)*time.Minute))
+			require.NoError(t, err)
+		}
+
+		pages := walk(s, t, authtest.LoginAs(t, s.Router, sc.User), auditLogsURL,
+			url.Values{"actor_id": {sc.User.ID.String()}, "limit": {"2"}},
+			func(p response.AuditLogListResponse) string { return p.NextCursor })
+
+		require.Len(t, pages, 2)
+		var actions []string
+		for _, p := range pages {
+			for _, e := range p.Entries {
+				assert.Equal(t, sc.User.ID, *e.ActorID)
+				actions = append(actions, e.Action)
+			}
+		}
+		assert.Equal(t, []string{"test.action_2", "test.action_1", "test.action_0"}, actions)
+		assert.JSONEq(t, `{"n": 1}`, string(pages[0].Entries[0].Metadata))
+	})
+
+	s.Run("Error case: missing permission, bad filters and bad cursors are rejected", func() {
+		t := s.T()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+		viewer := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+		token := authtest.LoginAs(t, s.Router, admin.User)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, auditLogsURL, nil, authtest.LoginAs(t, s.Router, viewer.User))
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "PERMISSION_DENIED")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, auditLogsURL+"?actor_id=nope", nil, token)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, auditLogsURL+"?after=garbage", nil, token)
+		httptest.AssertErrorCode(t, w, http.StatusBadRequest, "INVALID_CURSOR")
+	})
+}
+
+func (s *ActivitySuite) TestNotificationJobs() {
+	s.Run("Normal case: pages through one topic's jobs without payloads", func() {
+		t := s.T()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+		topic := "test_topic_" + uuid.NewString()
+		base := time.Now().Add(-time.Hour)
+		for i, status := range []string{"done", "error", "queued"} {
+			_, err := s.DB.Exec(context.Background(),
+				`INSERT INTO notification_jobs (kind, topic, payload, status, created_at) VALUES ('email', $1, '{"email": "x@example.com"}', $2, $3)`,
+				topic, status, base.Add(time.Duration(i)*time.Minute))
+			require.NoError(t, err)
+		}
+		token := authtest.LoginAs(t, s.Router, admin.User)
+
+		pages := walk(s, t, token, notificationJobsURL,
+			url.Values{"topic": {topic}, "limit": {"1"}},
+			func(p response.NotificationJobListResponse) string { return p.NextCursor })
+
+		require.Len(t, pages, 3)
+		var statuses []string
+		for _, p := range pages {
+			require.Len(t, p.Jobs, 1)
+			statuses = append(statuses, p.Jobs[0].Status)
+		}
+		assert.Equal(t, []string{"queued", "error", "done"}, statuses)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, notificationJobsURL+"?"+url.Values{"topic": {topic}, "status": {"error"}}.Encode(), nil, token)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		assert.NotContains(t, w.Body.String(), "x@example.com")
+		var page response.NotificationJobListResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &page))
+		require.Len(t, page.Jobs, 1)
+		assert.Equal(t, "error", page.Jobs[0].Status)
+		assert.Empty(t, page.NextCursor)
+	})
+
+	s.Run("Error case: callers without notification_jobs:read are rejected", func() {
+		t := s.T()
+		viewer := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, notificationJobsURL, nil, authtest.LoginAs(t, s.Router, viewer.User))
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "PERMISSION_DENIED")
+	})
+}
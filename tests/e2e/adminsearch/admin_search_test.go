@@ -0,0 +1,145 @@
+//go:build e2e
+
+package adminsearch_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/tests/common/authtest"
+	"gin-clean-starter/tests/common/dbtest"
+	"gin-clean-starter/tests/common/httptest"
+	"gin-clean-starter/tests/e2e"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const searchURL = "/api/admin/search"
+
+type AdminSearchSuite struct {
+	e2e.SharedSuite
+}
+
+func (s *AdminSearchSuite) SetupSubTest() {
+	s.SharedSuite.SetupSubTest()
+}
+
+func TestAdminSearchSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(AdminSearchSuite))
+}
+
+// token is unique to one subtest, so hits from parallel suites never match it.
+func token() string {
+	return "tok" + strings.ReplaceAll(uuid.NewString(), "-", "")[:10]
+}
+
+func (s *AdminSearchSuite) search(t *testing.T, adminToken string, query url.Values) response.AdminSearchResponse {
+	t.Helper()
+
+	w := httptest.PerformRequest(t, s.Router, http.MethodGet, searchURL+"?"+query.Encode(), nil, adminToken)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var results response.AdminSearchResponse
+	require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &results))
+	return results
+}
+
+func (s *AdminSearchSuite) TestSearch() {
+	s.Run("Normal case: hits are grouped by type", func() {
+		t := s.T()
+		tok := token()
+		sc := dbtest.Scenario(t, s.DB).
+			WithCompany().
+			WithUserEmail(tok+"@example.com", string(user.RoleViewer)).
+			WithResourceNamed("Room "+strings.ToUpper(tok), 0).
+			WithCompletedReservation().
+			Build()
+		_, err := s.DB.Exec(context.Background(), `
+			INSERT INTO reservation_messages (reservation_id, author_id, author_side, body)
+			VALUES ($1, $2, 'user', $3)`, sc.ReservationID, sc.User.ID, "Please set up the "+tok+" projector")
+		require.NoError(t, err)
+		reviewID := uuid.New()
+		_, err = s.DB.Exec(context.Background(), `
+			INSERT INTO reviews (id, user_id, resource_id, reservation_id, rating, comment)
+			VALUES ($1, $2, $3, $4, 4, $5)`, reviewID, sc.User.ID, sc.ResourceID, sc.ReservationID, "The "+tok+" projector worked")
+		require.NoError(t, err)
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+
+		results := s.search(t, authtest.LoginAs(t, s.Router, admin.User), url.Values{"q": {tok}})
+
+		require.Len(t, results.Users, 1)
+		assert.Equal(t, sc.User.ID, results.Users[0].ID)
+		assert.Equal(t, &sc.CompanyID, results.Users[0].CompanyID)
+		require.Len(t, results.Reservations, 1)
+		assert.Equal(t, sc.ReservationID, results.Reservations[0].ID)
+		assert.Equal(t, sc.ResourceID, results.Reservations[0].ResourceID)
+		require.Len(t, results.Resources, 1, "name matches case-insensitively")
+		assert.Equal(t, sc.ResourceID, results.Resources[0].ID)
+		require.Len(t, results.Reviews, 1)
+		assert.Equal(t, reviewID, results.Reviews[0].ID)
+		assert.Equal(t, 4, results.Reviews[0].Rating)
+	})
+
+	s.Run("Normal case: limit caps each type", func() {
+		t := s.T()
+		tok := token()
+		dbtest.Scenario(t, s.DB).
+			WithResourceNamed("North "+tok, 0).
+			WithResourceNamed("South "+tok, 0).
+			Build()
+		dbtest.Scenario(t, s.DB).WithUserEmail(tok+"@example.com", string(user.RoleViewer)).Build()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+
+		results := s.search(t, authtest.LoginAs(t, s.Router, admin.User), url.Values{"q": {tok}, "limit": {"1"}})
+
+		assert.Len(t, results.Resources, 1)
+		assert.Len(t, results.Users, 1)
+	})
+
+	s.Run("Normal case: a UUID finds the reservation with that ID", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).WithResource().WithUpcomingReservation().Build()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+
+		results := s.search(t, authtest.LoginAs(t, s.Router, admin.User), url.Values{"q": {sc.ReservationID.String()}})
+
+		require.Len(t, results.Reservations, 1)
+		assert.Equal(t, sc.ReservationID, results.Reservations[0].ID)
+		assert.Equal(t, sc.User.ID, results.Reservations[0].UserID)
+	})
+
+	s.Run("Normal case: wildcards in the term match literally", func() {
+		t := s.T()
+		dbtest.Scenario(t, s.DB).WithResource().Build()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+
+		results := s.search(t, authtest.LoginAs(t, s.Router, admin.User), url.Values{"q": {"%_%"}})
+
+		assert.Empty(t, results.Users)
+		assert.Empty(t, results.Resources)
+	})
+
+	s.Run("Error case: term shorter than three characters", func() {
+		t := s.T()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, searchURL+"?q=%20ab%20", nil, authtest.LoginAs(t, s.Router, admin.User))
+		httptest.AssertErrorCode(t, w, http.StatusBadRequest, "SEARCH_QUERY_TOO_SHORT")
+	})
+
+	s.Run("Error case: caller without search:read", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleOperator)).Build()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, searchURL+"?q=room", nil, authtest.LoginAs(t, s.Router, sc.User))
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
@@ -0,0 +1,180 @@
+//go:build e2e
+
+package apikey_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	nethttptest "net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/middleware"
+	"gin-clean-starter/internal/usecase/shared"
+	"gin-clean-starter/tests/common/authtest"
+	"gin-clean-starter/tests/common/dbtest"
+	"gin-clean-starter/tests/common/httptest"
+	"gin-clean-starter/tests/e2e"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	keysURL         = "/api/admin/users/%s/api-keys"
+	meURL           = "/api/auth/me"
+	rolesURL        = "/api/admin/roles"
+	permissionsURL  = "/api/admin/permissions"
+	passwordURL     = "/api/auth/password"
+	reservationsURL = "/api/reservations"
+)
+
+type APIKeySuite struct {
+	e2e.SharedSuite
+}
+
+func (s *APIKeySuite) SetupSubTest() {
+	s.SharedSuite.SetupSubTest()
+}
+
+func TestAPIKeySuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(APIKeySuite))
+}
+
+// withKey performs a request authenticated by an API key only.
+func (s *APIKeySuite) withKey(t *testing.T, method, path string, body any, key string) *nethttptest.ResponseRecorder {
+	t.Helper()
+	return httptest.PerformRequestWithHeaders(t, s.Router, method, path, body, "", map[string]string{middleware.APIKeyHeader: key})
+}
+
+func (s *APIKeySuite) TestAPIKeys() {
+	s.Run("Normal case: a key acts as its user within its scopes until revoked", func() {
+		t := s.T()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+		owner := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+		adminToken := authtest.LoginAs(t, s.Router, admin.User)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, fmt.Sprintf(keysURL, owner.User.ID), request.IssueAPIKeyRequest{
+			Name:   "reporting",
+			Scopes: []string{shared.PermissionRolesManage},
+		}, adminToken)
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+		var issued response.IssuedAPIKeyResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &issued))
+		assert.True(t, strings.HasPrefix(issued.Key, shared.APIKeyPrefix))
+
+		w = s.withKey(t, http.MethodGet, rolesURL, nil, issued.Key)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		// The owner's admin role grants the permission, but the key's scopes do not.
+		w = s.withKey(t, http.MethodGet, fmt.Sprintf(keysURL, owner.User.ID), nil, issued.Key)
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "PERMISSION_DENIED")
+
+		w = s.withKey(t, http.MethodPut, passwordURL, request.ChangePasswordRequest{CurrentPassword: dbtest.DefaultPassword, NewPassword: "password456"}, issued.Key)
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "API_KEY_NOT_ALLOWED")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodDelete, fmt.Sprintf(keysURL, owner.User.ID)+"/"+issued.ID.String(), nil, adminToken)
+		require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+
+		w = s.withKey(t, http.MethodGet, rolesURL, nil, issued.Key)
+		httptest.AssertErrorCode(t, w, http.StatusUnauthorized, "INVALID_API_KEY")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, fmt.Sprintf(keysURL, owner.User.ID), nil, adminToken)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var keys []response.APIKeyResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &keys))
+		require.Len(t, keys, 1)
+		assert.Equal(t, issued.ID, keys[0].ID)
+		assert.Equal(t, []string{shared.PermissionRolesManage}, keys[0].Scopes)
+		assert.NotNil(t, keys[0].RevokedAt)
+
+		var events int
+		require.NoError(t, s.DB.QueryRow(context.Background(),
+			`SELECT count(*) FROM audit_logs WHERE action IN ('auth.api_key_issued', 'auth.api_key_revoked') AND target_id = $1`,
+			owner.User.ID.String()).Scan(&events))
+		assert.Equal(t, 2, events)
+	})
+
+	s.Run("Error case: a key never exceeds its user's role", func() {
+		t := s.T()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+		viewer := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, fmt.Sprintf(keysURL, viewer.User.ID), request.IssueAPIKeyRequest{
+			Name:   "overreaching",
+			Scopes: []string{shared.PermissionRolesManage},
+		}, authtest.LoginAs(t, s.Router, admin.User))
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+		var issued response.IssuedAPIKeyResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &issued))
+
+		w = s.withKey(t, http.MethodGet, permissionsURL, nil, issued.Key)
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "PERMISSION_DENIED")
+	})
+
+	s.Run("Error case: routes that check no permission refuse keys of any scope", func() {
+		t := s.T()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).WithResource().Build()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, fmt.Sprintf(keysURL, sc.User.ID), request.IssueAPIKeyRequest{
+			Name:   "reviews",
+			Scopes: []string{shared.PermissionReviewsReadAny},
+		}, authtest.LoginAs(t, s.Router, admin.User))
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+		var issued response.IssuedAPIKeyResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &issued))
+
+		start := time.Now().UTC().Truncate(time.Hour).Add(72 * time.Hour)
+		w = s.withKey(t, http.MethodPost, reservationsURL, request.CreateReservationRequest{
+			ResourceID: sc.ResourceID,
+			StartTime:  start,
+			EndTime:    start.Add(time.Hour),
+		}, issued.Key)
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "API_KEY_NOT_ALLOWED")
+
+		w = s.withKey(t, http.MethodGet, meURL, nil, issued.Key)
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "API_KEY_NOT_ALLOWED")
+
+		var reservations int
+		require.NoError(t, s.DB.QueryRow(context.Background(), `SELECT count(*) FROM reservations WHERE user_id = $1`, sc.User.ID).Scan(&reservations))
+		assert.Zero(t, reservations)
+	})
+
+	s.Run("Error case: unknown scopes, past expiries and keys are refused", func() {
+		t := s.T()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+		adminToken := authtest.LoginAs(t, s.Router, admin.User)
+		url := fmt.Sprintf(keysURL, admin.User.ID)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, url, request.IssueAPIKeyRequest{
+			Name: "typo", Scopes: []string{"roles:mange"},
+		}, adminToken)
+		httptest.AssertErrorCode(t, w, http.StatusBadRequest, "UNKNOWN_PERMISSION")
+
+		past := time.Now().Add(-time.Hour)
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, url, request.IssueAPIKeyRequest{
+			Name: "expired", Scopes: []string{shared.PermissionRolesManage}, ExpiresAt: &past,
+		}, adminToken)
+		httptest.AssertErrorCode(t, w, http.StatusBadRequest, "INVALID_API_KEY_EXPIRY")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, fmt.Sprintf(keysURL, uuid.New()), request.IssueAPIKeyRequest{
+			Name: "nobody", Scopes: []string{shared.PermissionRolesManage},
+		}, adminToken)
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "USER_NOT_FOUND")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodDelete, url+"/"+uuid.NewString(), nil, adminToken)
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "API_KEY_NOT_FOUND")
+
+		w = s.withKey(t, http.MethodGet, meURL, nil, shared.APIKeyPrefix+"unknown")
+		httptest.AssertErrorCode(t, w, http.StatusUnauthorized, "INVALID_API_KEY")
+	})
+}
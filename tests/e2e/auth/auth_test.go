@@ -5,10 +5,15 @@ package auth_test
 import (
 	"context"
 	"net/http"
+	nethttptest "net/http/httptest"
+	"net/url"
 	"testing"
+	"time"
 
 	"gin-clean-starter/internal/domain/user"
 	"gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/pkg/totp"
 	"gin-clean-starter/tests/common/authtest"
 	"gin-clean-starter/tests/common/dbtest"
 	"gin-clean-starter/tests/common/httptest"
@@ -25,6 +30,10 @@ const (
 	logoutURL  = "/api/auth/logout"
 	refreshURL = "/api/auth/refresh"
 	meURL      = "/api/auth/me"
+	tokenURL   = "/api/auth/token"
+
+	tokenRefreshURL = "/api/auth/token/refresh"
+	sessionsURL     = "/api/auth/sessions"
 )
 
 type authSuite struct {
@@ -344,7 +353,7 @@ func (s *authSuite) TestMe() {
 				userID := uuid.New()
 				passwordHash := "$2a$12$uhAjVE9f92IGYv3E25pJNetg.27lVt0p7jmLWjqjmhOg92ldPS0A."
 				ctx := context.Background()
-				_, err := s.DB.Exec(ctx, "INSERT INTO users (id, email, password_hash, role, company_id, is_active) VALUES ($1, $2, $3, $4, $5, true)",
+				_, err := s.DB.Exec(ctx, "INSERT INTO users (id, email, password_hash, role, company_id, referral_code, is_active) VALUES ($1, $2, $3, $4, $5, 'CUSTOMCORP1', true)",
 					userID, email, passwordHash, string(user.RoleAdmin), companyID)
 				require.NoError(s.T(), err)
 				token := authtest.LoginUser(s.T(), s.Router, email, "password123")
@@ -412,6 +421,143 @@ func (s *authSuite) TestTokenExpiry() {
 	})
 }
 
+func (s *authSuite) TestBodyTokens() {
+	s.Run("Refresh token from the token endpoint is not a bearer credential", func() {
+		s.SetupSubTest()
+		t := s.T()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, tokenURL, request.LoginRequest{Email: "viewer@example.com", Password: "password123"}, "")
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var tokens response.TokenResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &tokens))
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, meURL, nil, tokens.AccessToken)
+		require.Equal(t, http.StatusOK, w.Code, "access token should authenticate")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, meURL, nil, tokens.RefreshToken)
+		require.Equal(t, http.StatusUnauthorized, w.Code, "refresh token must not authenticate")
+	})
+}
+
+func (s *authSuite) TestRefreshRotation() {
+	login := func(t *testing.T) response.TokenResponse {
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, tokenURL, request.LoginRequest{Email: "viewer@example.com", Password: "password123"}, "")
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var tokens response.TokenResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &tokens))
+		return tokens
+	}
+	refresh := func(t *testing.T, refreshToken string) *nethttptest.ResponseRecorder {
+		return httptest.PerformRequest(t, s.Router, http.MethodPost, tokenRefreshURL, nil, refreshToken)
+	}
+
+	s.Run("A refresh token is exchanged once", func() {
+		s.SetupSubTest()
+		t := s.T()
+		first := login(t)
+
+		w := refresh(t, first.RefreshToken)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var second response.TokenResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &second))
+		require.NotEqual(t, first.RefreshToken, second.RefreshToken)
+
+		w = refresh(t, second.RefreshToken)
+		require.Equal(t, http.StatusOK, w.Code, "the rotated token should refresh")
+	})
+
+	s.Run("Replaying a spent refresh token revokes the session", func() {
+		s.SetupSubTest()
+		t := s.T()
+		first := login(t)
+
+		w := refresh(t, first.RefreshToken)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var second response.TokenResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &second))
+
+		w = refresh(t, first.RefreshToken)
+		httptest.AssertErrorCode(t, w, http.StatusUnauthorized, "REFRESH_TOKEN_REUSED")
+
+		w = refresh(t, second.RefreshToken)
+		httptest.AssertErrorCode(t, w, http.StatusUnauthorized, "SESSION_REVOKED")
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, meURL, nil, second.AccessToken)
+		httptest.AssertErrorCode(t, w, http.StatusUnauthorized, "SESSION_REVOKED")
+
+		var events int
+		err := s.DB.QueryRow(t.Context(), "SELECT count(*) FROM audit_logs WHERE action = 'auth.refresh_token_reused'").Scan(&events)
+		require.NoError(t, err)
+		require.Equal(t, 1, events)
+	})
+
+	s.Run("Logout revokes the session", func() {
+		s.SetupSubTest()
+		t := s.T()
+		tokens := login(t)
+		other := login(t)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, logoutURL, nil, tokens.AccessToken)
+		require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, meURL, nil, tokens.AccessToken)
+		httptest.AssertErrorCode(t, w, http.StatusUnauthorized, "SESSION_REVOKED")
+		w = refresh(t, tokens.RefreshToken)
+		httptest.AssertErrorCode(t, w, http.StatusUnauthorized, "SESSION_REVOKED")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, meURL, nil, other.AccessToken)
+		require.Equal(t, http.StatusOK, w.Code, "other sessions stay signed in")
+	})
+
+	s.Run("Logout denylists a token issued before sessions were tracked", func() {
+		s.SetupSubTest()
+		t := s.T()
+		var viewerID uuid.UUID
+		require.NoError(t, s.DB.QueryRow(t.Context(), "SELECT id FROM users WHERE email = 'viewer@example.com'").Scan(&viewerID))
+		legacy := s.jwtHelper.GenerateToken(t, viewerID, user.RoleViewer)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, logoutURL, nil, legacy)
+		require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, meURL, nil, legacy)
+		httptest.AssertErrorCode(t, w, http.StatusUnauthorized, "SESSION_REVOKED")
+	})
+
+	s.Run("An admin signs a user out of every session", func() {
+		s.SetupSubTest()
+		t := s.T()
+		var viewerID uuid.UUID
+		require.NoError(t, s.DB.QueryRow(t.Context(), "SELECT id FROM users WHERE email = 'viewer@example.com'").Scan(&viewerID))
+		tokens := login(t)
+		other := login(t)
+		legacy := s.jwtHelper.GenerateToken(t, viewerID, user.RoleViewer)
+		adminToken := authtest.LoginUser(t, s.Router, "test@example.com", "password123")
+
+		url := "/api/admin/users/" + viewerID.String() + "/sessions"
+		w := httptest.PerformRequest(t, s.Router, http.MethodDelete, url, nil, tokens.AccessToken)
+		require.Equal(t, http.StatusForbidden, w.Code, "viewers cannot revoke sessions")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodDelete, url, nil, adminToken)
+		require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+
+		for _, token := range []string{tokens.AccessToken, other.AccessToken, legacy} {
+			w = httptest.PerformRequest(t, s.Router, http.MethodGet, meURL, nil, token)
+			httptest.AssertErrorCode(t, w, http.StatusUnauthorized, "SESSION_REVOKED")
+		}
+		w = refresh(t, other.RefreshToken)
+		httptest.AssertErrorCode(t, w, http.StatusUnauthorized, "SESSION_REVOKED")
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, meURL, nil, adminToken)
+		require.Equal(t, http.StatusOK, w.Code, "the admin stays signed in")
+
+		var events int
+		err := s.DB.QueryRow(t.Context(), "SELECT count(*) FROM audit_logs WHERE action = 'auth.sessions_revoked'").Scan(&events)
+		require.NoError(t, err)
+		require.Equal(t, 1, events)
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodDelete, "/api/admin/users/"+uuid.NewString()+"/sessions", nil, adminToken)
+		require.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
 func (s *authSuite) TestAuthenticationRequired() {
 	s.Run("Authentication required endpoints", func() {
 		s.SetupSubTest()
@@ -453,3 +599,413 @@ func (s *authSuite) TestConcurrentLogin() {
 		require.Equal(t, http.StatusOK, w2.Code, "Second token is invalid")
 	})
 }
+
+func (s *authSuite) TestLoginReplay() {
+	s.Run("Double-submitted login with the same Idempotency-Key returns the first tokens", func() {
+		s.SetupSubTest()
+		t := s.T()
+		headers := map[string]string{"Idempotency-Key": uuid.NewString()}
+		creds := request.LoginRequest{Email: "viewer@example.com", Password: "password123"}
+
+		w := httptest.PerformRequestWithHeaders(t, s.Router, http.MethodPost, tokenURL, creds, "", headers)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		require.Empty(t, w.Header().Get("Idempotent-Replayed"))
+		var first response.TokenResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &first))
+
+		w = httptest.PerformRequestWithHeaders(t, s.Router, http.MethodPost, tokenURL, creds, "", headers)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		require.Equal(t, "true", w.Header().Get("Idempotent-Replayed"))
+		var second response.TokenResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &second))
+		require.Equal(t, first.AccessToken, second.AccessToken)
+		require.Equal(t, first.RefreshToken, second.RefreshToken)
+	})
+
+	s.Run("A wrong password with a used Idempotency-Key is still rejected", func() {
+		s.SetupSubTest()
+		t := s.T()
+		headers := map[string]string{"Idempotency-Key": uuid.NewString()}
+
+		w := httptest.PerformRequestWithHeaders(t, s.Router, http.MethodPost, tokenURL, request.LoginRequest{Email: "viewer@example.com", Password: "password123"}, "", headers)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		w = httptest.PerformRequestWithHeaders(t, s.Router, http.MethodPost, tokenURL, request.LoginRequest{Email: "viewer@example.com", Password: "password124"}, "", headers)
+		httptest.AssertErrorCode(t, w, http.StatusUnauthorized, "INVALID_CREDENTIALS")
+	})
+}
+
+func (s *authSuite) TestRegistration() {
+	s.Run("A registered account signs in once its email is verified", func() {
+		s.SetupSubTest()
+		t := s.T()
+		creds := request.LoginRequest{Email: "new@example.com", Password: "password123"}
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, "/api/auth/register", request.RegisterRequest(creds), "")
+		require.Equal(t, http.StatusAccepted, w.Code, w.Body.String())
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, tokenURL, creds, "")
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "USER_INACTIVE")
+
+		var link string
+		err := s.DB.QueryRow(t.Context(),
+			"SELECT payload->>'link' FROM notification_jobs WHERE topic = 'email_verification' AND payload->>'email' = $1",
+			creds.Email).Scan(&link)
+		require.NoError(t, err)
+		u, err := url.Parse(link)
+		require.NoError(t, err)
+		verify := request.VerifyEmailRequest{Token: u.Query().Get("token")}
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, "/api/auth/verify-email", verify, "")
+		require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, tokenURL, creds, "")
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, "/api/auth/verify-email", verify, "")
+		httptest.AssertErrorCode(t, w, http.StatusBadRequest, "EMAIL_VERIFICATION_INVALID")
+	})
+
+	s.Run("A taken email is a conflict", func() {
+		s.SetupSubTest()
+		t := s.T()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, "/api/auth/register",
+			request.RegisterRequest{Email: "viewer@example.com", Password: "password123"}, "")
+		httptest.AssertErrorCode(t, w, http.StatusConflict, "EMAIL_ALREADY_REGISTERED")
+	})
+}
+
+func (s *authSuite) TestChangePassword() {
+	const passwordURL = "/api/auth/password"
+	login := func(t *testing.T, password string) *nethttptest.ResponseRecorder {
+		return httptest.PerformRequest(t, s.Router, http.MethodPost, tokenURL, request.LoginRequest{Email: "viewer@example.com", Password: password}, "")
+	}
+
+	s.Run("Changing the password signs out every other session", func() {
+		s.SetupSubTest()
+		t := s.T()
+		w := login(t, "password123")
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var old response.TokenResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &old))
+		other := login(t, "password123")
+		require.Equal(t, http.StatusOK, other.Code)
+
+		change := request.ChangePasswordRequest{CurrentPassword: "password123", NewPassword: "password456"}
+		w = httptest.PerformRequest(t, s.Router, http.MethodPut, passwordURL, change, old.AccessToken)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var renewed response.TokenResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &renewed))
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, meURL, nil, old.AccessToken)
+		httptest.AssertErrorCode(t, w, http.StatusUnauthorized, "SESSION_REVOKED")
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, tokenRefreshURL, nil, old.RefreshToken)
+		httptest.AssertErrorCode(t, w, http.StatusUnauthorized, "SESSION_REVOKED")
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, meURL, nil, renewed.AccessToken)
+		require.Equal(t, http.StatusOK, w.Code, "the new session stays signed in")
+
+		httptest.AssertErrorCode(t, login(t, "password123"), http.StatusUnauthorized, "INVALID_CREDENTIALS")
+		require.Equal(t, http.StatusOK, login(t, "password456").Code)
+
+		var events int
+		err := s.DB.QueryRow(t.Context(), "SELECT count(*) FROM audit_logs WHERE action = 'auth.password_changed'").Scan(&events)
+		require.NoError(t, err)
+		require.Equal(t, 1, events)
+	})
+
+	s.Run("A wrong current password changes nothing", func() {
+		s.SetupSubTest()
+		t := s.T()
+		token := authtest.LoginUser(t, s.Router, "viewer@example.com", "password123")
+
+		change := request.ChangePasswordRequest{CurrentPassword: "password124", NewPassword: "password456"}
+		w := httptest.PerformRequest(t, s.Router, http.MethodPut, passwordURL, change, token)
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "CURRENT_PASSWORD_INCORRECT")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, meURL, nil, token)
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, http.StatusOK, login(t, "password123").Code)
+	})
+}
+
+func (s *authSuite) TestTwoFactor() {
+	const (
+		enrollURL    = "/api/auth/mfa/enroll"
+		enableURL    = "/api/auth/mfa/enable"
+		disableURL   = "/api/auth/mfa/disable"
+		verifyMFAURL = "/api/auth/token/mfa"
+	)
+	code := func(t *testing.T, secret string, steps int64) string {
+		c, err := totp.Code(secret, totp.Step(time.Now())+steps)
+		require.NoError(t, err)
+		return c
+	}
+	challenge := func(t *testing.T) string {
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, tokenURL, request.LoginRequest{Email: "viewer@example.com", Password: "password123"}, "")
+		require.Equal(t, http.StatusAccepted, w.Code, w.Body.String())
+		var body response.MFAChallengeResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &body))
+		require.Equal(t, "mfa_pending", body.Status)
+		return body.MFAToken
+	}
+	verify := func(t *testing.T, mfaToken, code string) *nethttptest.ResponseRecorder {
+		return httptest.PerformRequest(t, s.Router, http.MethodPost, verifyMFAURL, request.VerifyMFARequest{MFAToken: mfaToken, Code: code}, "")
+	}
+
+	s.Run("Enrolled users finish logins with a TOTP or recovery code", func() {
+		s.SetupSubTest()
+		t := s.T()
+		token := authtest.LoginUser(t, s.Router, "viewer@example.com", "password123")
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, enrollURL, nil, token)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		require.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+		var enrollment response.MFAEnrollmentResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &enrollment))
+		require.Contains(t, enrollment.ProvisioningURI, "otpauth://totp/")
+
+		var stored string
+		err := s.DB.QueryRow(t.Context(), "SELECT secret_ciphertext FROM user_mfa").Scan(&stored)
+		require.NoError(t, err)
+		require.NotContains(t, stored, enrollment.Secret, "the secret is stored encrypted")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, enableURL, request.MFACodeRequest{Code: code(t, enrollment.Secret, 0)}, token)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var recovery response.MFARecoveryCodesResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &recovery))
+		require.Len(t, recovery.RecoveryCodes, s.Config.MFA.RecoveryCodes)
+
+		mfaToken := challenge(t)
+		httptest.AssertErrorCode(t, verify(t, mfaToken, code(t, enrollment.Secret, 0)), http.StatusUnauthorized, "MFA_CODE_INVALID")
+		w = verify(t, mfaToken, code(t, enrollment.Secret, 1))
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var tokens response.TokenResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &tokens))
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, meURL, nil, tokens.AccessToken)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		mfaToken = challenge(t)
+		require.Equal(t, http.StatusOK, verify(t, mfaToken, recovery.RecoveryCodes[0]).Code)
+		httptest.AssertErrorCode(t, verify(t, mfaToken, recovery.RecoveryCodes[0]), http.StatusUnauthorized, "MFA_CODE_INVALID")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, disableURL, request.MFACodeRequest{Code: recovery.RecoveryCodes[1]}, token)
+		require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, tokenURL, request.LoginRequest{Email: "viewer@example.com", Password: "password123"}, "")
+		require.Equal(t, http.StatusOK, w.Code, "logins no longer ask for a code")
+
+		var events int
+		err = s.DB.QueryRow(t.Context(), "SELECT count(*) FROM audit_logs WHERE action IN ('auth.2fa_enabled', 'auth.2fa_recovery_code_used', 'auth.2fa_disabled')").Scan(&events)
+		require.NoError(t, err)
+		require.Equal(t, 4, events)
+	})
+
+	s.Run("Repeated wrong codes lock the second factor", func() {
+		s.SetupSubTest()
+		t := s.T()
+		token := authtest.LoginUser(t, s.Router, "viewer@example.com", "password123")
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, enrollURL, nil, token)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var enrollment response.MFAEnrollmentResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &enrollment))
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, enableURL, request.MFACodeRequest{Code: code(t, enrollment.Secret, 0)}, token)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		mfaToken := challenge(t)
+		for range s.Config.MFA.MaxAttempts {
+			httptest.AssertErrorCode(t, verify(t, mfaToken, "000000"), http.StatusUnauthorized, "MFA_CODE_INVALID")
+		}
+		httptest.AssertErrorCode(t, verify(t, mfaToken, code(t, enrollment.Secret, 1)), http.StatusTooManyRequests, "MFA_LOCKED")
+	})
+}
+
+func (s *authSuite) TestLoginLockout() {
+	wrong := request.LoginRequest{Email: "viewer@example.com", Password: "wrong-password"}
+	right := request.LoginRequest{Email: "viewer@example.com", Password: "password123"}
+
+	s.Run("Repeated failures lock the email out until an admin unlocks it", func() {
+		s.SetupSubTest()
+		t := s.T()
+		for range s.Config.Lockout.MaxAttempts - 1 {
+			w := httptest.PerformRequest(t, s.Router, http.MethodPost, loginURL, wrong, "")
+			httptest.AssertErrorCode(t, w, http.StatusUnauthorized, "INVALID_CREDENTIALS")
+		}
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, loginURL, wrong, "")
+		httptest.AssertErrorCode(t, w, http.StatusLocked, "ACCOUNT_LOCKED")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, loginURL, right, "")
+		httptest.AssertErrorCode(t, w, http.StatusLocked, "ACCOUNT_LOCKED")
+		require.NotEmpty(t, w.Header().Get("Retry-After"))
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, tokenURL, right, "")
+		httptest.AssertErrorCode(t, w, http.StatusLocked, "ACCOUNT_LOCKED")
+
+		var viewerID uuid.UUID
+		require.NoError(t, s.DB.QueryRow(t.Context(), "SELECT id FROM users WHERE email = 'viewer@example.com'").Scan(&viewerID))
+		adminToken := authtest.LoginUser(t, s.Router, "test@example.com", "password123")
+		url := "/api/admin/users/" + viewerID.String() + "/login-lockout"
+		w = httptest.PerformRequest(t, s.Router, http.MethodDelete, url, nil, adminToken)
+		require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, loginURL, right, "")
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		var locked, unlocked int
+		require.NoError(t, s.DB.QueryRow(t.Context(),
+			`SELECT count(*) FILTER (WHERE action = 'auth.account_locked'), count(*) FILTER (WHERE action = 'auth.account_unlocked')
+			 FROM audit_logs WHERE target_id = $1`, viewerID.String()).Scan(&locked, &unlocked))
+		require.Equal(t, 1, locked)
+		require.Equal(t, 1, unlocked)
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodDelete, "/api/admin/users/"+uuid.NewString()+"/login-lockout", nil, adminToken)
+		require.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	s.Run("A successful login resets the count", func() {
+		s.SetupSubTest()
+		t := s.T()
+		for range s.Config.Lockout.MaxAttempts - 1 {
+			w := httptest.PerformRequest(t, s.Router, http.MethodPost, loginURL, wrong, "")
+			httptest.AssertErrorCode(t, w, http.StatusUnauthorized, "INVALID_CREDENTIALS")
+		}
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, loginURL, right, "")
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, loginURL, wrong, "")
+		httptest.AssertErrorCode(t, w, http.StatusUnauthorized, "INVALID_CREDENTIALS")
+	})
+
+	s.Run("Unknown emails lock out like existing ones", func() {
+		s.SetupSubTest()
+		t := s.T()
+		unknown := request.LoginRequest{Email: "nobody@example.com", Password: "wrong-password"}
+		for range s.Config.Lockout.MaxAttempts - 1 {
+			w := httptest.PerformRequest(t, s.Router, http.MethodPost, loginURL, unknown, "")
+			require.Equal(t, http.StatusUnauthorized, w.Code, w.Body.String())
+		}
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, loginURL, unknown, "")
+		httptest.AssertErrorCode(t, w, http.StatusLocked, "ACCOUNT_LOCKED")
+	})
+}
+
+func (s *authSuite) TestSessions() {
+	s.Run("A user lists their sessions and signs another device out", func() {
+		s.SetupSubTest()
+		t := s.T()
+		login := request.LoginRequest{Email: "viewer@example.com", Password: "password123"}
+		var laptop, phone response.TokenResponse
+		w := httptest.PerformRequestWithHeaders(t, s.Router, http.MethodPost, tokenURL, login, "", map[string]string{"User-Agent": "laptop"})
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &laptop))
+		w = httptest.PerformRequestWithHeaders(t, s.Router, http.MethodPost, tokenURL, login, "", map[string]string{"User-Agent": "phone"})
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &phone))
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, sessionsURL, nil, laptop.AccessToken)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var sessions []response.SessionResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &sessions))
+		require.Len(t, sessions, 2)
+		require.Equal(t, "phone", sessions[0].UserAgent, "most recently seen first")
+		require.False(t, sessions[0].Current)
+		require.Equal(t, "laptop", sessions[1].UserAgent)
+		require.True(t, sessions[1].Current)
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodDelete, sessionsURL+"/"+sessions[0].ID.String(), nil, laptop.AccessToken)
+		require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, tokenRefreshURL, nil, phone.RefreshToken)
+		httptest.AssertErrorCode(t, w, http.StatusUnauthorized, "SESSION_REVOKED")
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, sessionsURL, nil, laptop.AccessToken)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &sessions))
+		require.Len(t, sessions, 1)
+		require.True(t, sessions[0].Current)
+
+		var events int
+		err := s.DB.QueryRow(t.Context(), "SELECT count(*) FROM audit_logs WHERE action = 'auth.session_revoked'").Scan(&events)
+		require.NoError(t, err)
+		require.Equal(t, 1, events)
+	})
+
+	s.Run("Error case: sessions of other users and ended sessions are not found", func() {
+		s.SetupSubTest()
+		t := s.T()
+		viewer := authtest.LoginUser(t, s.Router, "viewer@example.com", "password123")
+		admin := authtest.LoginUser(t, s.Router, "test@example.com", "password123")
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, sessionsURL, nil, admin)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var sessions []response.SessionResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &sessions))
+		require.Len(t, sessions, 1)
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodDelete, sessionsURL+"/"+sessions[0].ID.String(), nil, viewer)
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "SESSION_NOT_FOUND")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodDelete, sessionsURL+"/"+sessions[0].ID.String(), nil, admin)
+		require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+		w = httptest.PerformRequest(t, s.Router, http.MethodDelete, sessionsURL+"/not-a-uuid", nil, viewer)
+		httptest.AssertErrorCode(t, w, http.StatusBadRequest, "INVALID_ID_FORMAT")
+	})
+}
+
+func (s *authSuite) TestChangeUserRole() {
+	roleURL := func(id uuid.UUID) string { return "/api/admin/users/" + id.String() + "/role" }
+	userID := func(t *testing.T, email string) uuid.UUID {
+		t.Helper()
+		var id uuid.UUID
+		require.NoError(t, s.DB.QueryRow(t.Context(), "SELECT id FROM users WHERE email = $1", email).Scan(&id))
+		return id
+	}
+
+	s.Run("Normal case: the user is signed out and signs back in with the new role", func() {
+		s.SetupSubTest()
+		t := s.T()
+		viewerID := userID(t, "viewer@example.com")
+		viewer := authtest.LoginUser(t, s.Router, "viewer@example.com", "password123")
+		admin := authtest.LoginUser(t, s.Router, "test@example.com", "password123")
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPut, roleURL(viewerID), request.ChangeUserRoleRequest{Role: string(user.RoleOperator)}, admin)
+		require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, meURL, nil, viewer)
+		require.Equal(t, http.StatusUnauthorized, w.Code, w.Body.String())
+		viewer = authtest.LoginUser(t, s.Router, "viewer@example.com", "password123")
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, meURL, nil, viewer)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		var role, actor, from, to string
+		require.NoError(t, s.DB.QueryRow(t.Context(), "SELECT role FROM users WHERE id = $1", viewerID).Scan(&role))
+		require.Equal(t, string(user.RoleOperator), role)
+		require.NoError(t, s.DB.QueryRow(t.Context(),
+			`SELECT actor_id::text, metadata->>'from', metadata->>'to' FROM audit_logs WHERE action = 'auth.role_changed' AND target_id = $1`,
+			viewerID.String()).Scan(&actor, &from, &to))
+		require.Equal(t, userID(t, "test@example.com").String(), actor)
+		require.Equal(t, string(user.RoleViewer), from)
+		require.Equal(t, string(user.RoleOperator), to)
+	})
+
+	s.Run("Error case: own role, unknown roles and users, and non-admins are refused", func() {
+		s.SetupSubTest()
+		t := s.T()
+		adminID := userID(t, "test@example.com")
+		viewerID := userID(t, "viewer@example.com")
+		admin := authtest.LoginUser(t, s.Router, "test@example.com", "password123")
+		operator := authtest.LoginUser(t, s.Router, "operator@example.com", "password123")
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPut, roleURL(adminID), request.ChangeUserRoleRequest{Role: string(user.RoleViewer)}, admin)
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "OWN_ROLE_CHANGE")
+		w = httptest.PerformRequest(t, s.Router, http.MethodPut, roleURL(viewerID), request.ChangeUserRoleRequest{Role: "no_such_role"}, admin)
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "ROLE_NOT_FOUND")
+		w = httptest.PerformRequest(t, s.Router, http.MethodPut, roleURL(viewerID), request.ChangeUserRoleRequest{Role: "Not A Role"}, admin)
+		httptest.AssertErrorCode(t, w, http.StatusBadRequest, "INVALID_ROLE_NAME")
+		w = httptest.PerformRequest(t, s.Router, http.MethodPut, roleURL(uuid.New()), request.ChangeUserRoleRequest{Role: string(user.RoleViewer)}, admin)
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "USER_NOT_FOUND")
+		w = httptest.PerformRequest(t, s.Router, http.MethodPut, roleURL(viewerID), request.ChangeUserRoleRequest{Role: string(user.RoleAdmin)}, operator)
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "PERMISSION_DENIED")
+
+		var role string
+		require.NoError(t, s.DB.QueryRow(t.Context(), "SELECT role FROM users WHERE id = $1", viewerID).Scan(&role))
+		require.Equal(t, string(user.RoleViewer), role)
+	})
+}
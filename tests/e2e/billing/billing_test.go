@@ -0,0 +1,165 @@
+//go:build e2e
+
+package billing_test
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	nethttptest "net/http/httptest"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/infra/billing"
+	"gin-clean-starter/tests/common/authtest"
+	"gin-clean-starter/tests/common/dbtest"
+	"gin-clean-starter/tests/common/httptest"
+	"gin-clean-starter/tests/e2e"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	webhookURL   = "/api/billing/webhook"
+	companiesURL = "/api/companies"
+)
+
+type BillingSuite struct {
+	e2e.SharedSuite
+}
+
+func (s *BillingSuite) SetupSubTest() {
+	s.SharedSuite.SetupSubTest()
+}
+
+func TestBillingSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(BillingSuite))
+}
+
+func (s *BillingSuite) register(t *testing.T, name, email string) *response.RegisterCompanyResponse {
+	t.Helper()
+
+	w := httptest.PerformRequest(t, s.Router, http.MethodPost, companiesURL, request.RegisterCompanyRequest{
+		CompanyName:   name,
+		AdminEmail:    email,
+		AdminPassword: dbtest.DefaultPassword,
+	}, "")
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var registered response.RegisterCompanyResponse
+	require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &registered))
+	return &registered
+}
+
+// deliver posts a subscription event signed with the test config's webhook secret.
+func (s *BillingSuite) deliver(t *testing.T, eventID string, companyID uuid.UUID, plan string, at time.Time) *nethttptest.ResponseRecorder {
+	t.Helper()
+
+	payload, err := json.Marshal(map[string]any{
+		"id":         eventID,
+		"type":       "subscription.updated",
+		"created_at": at.UTC().Format(time.RFC3339),
+		"subscription": map[string]any{
+			"id":          "sub_" + companyID.String(),
+			"customer_id": "cus_" + companyID.String(),
+			"company_id":  companyID,
+			"plan":        plan,
+			"status":      "active",
+		},
+	})
+	require.NoError(t, err)
+	return s.post(t, payload, hex.EncodeToString(billing.Sign([]byte(s.Config.Billing.WebhookSecret), payload)))
+}
+
+func (s *BillingSuite) post(t *testing.T, payload []byte, signature string) *nethttptest.ResponseRecorder {
+	t.Helper()
+	return httptest.PerformRequestWithHeaders(t, s.Router, http.MethodPost, webhookURL, json.RawMessage(payload), "", map[string]string{
+		billing.SignatureHeader: "sha256=" + signature,
+	})
+}
+
+func (s *BillingSuite) planOf(t *testing.T, companyID uuid.UUID) string {
+	t.Helper()
+	var plan string
+	require.NoError(t, s.DB.QueryRow(context.Background(), `SELECT plan_id FROM subscriptions WHERE company_id = $1`, companyID).Scan(&plan))
+	return plan
+}
+
+func (s *BillingSuite) assign(t *testing.T, token string, resourceID, userID uuid.UUID) *nethttptest.ResponseRecorder {
+	t.Helper()
+	return httptest.PerformRequest(t, s.Router, http.MethodPut, fmt.Sprintf("/api/admin/resources/%s/operators/%s", resourceID, userID), nil, token)
+}
+
+func (s *BillingSuite) TestPlanLimits() {
+	s.Run("Normal case: registered companies start on the free plan", func() {
+		t := s.T()
+		registered := s.register(t, "Billing Free", "owner@billing-free.example.com")
+		assert.Equal(t, "free", s.planOf(t, registered.CompanyID))
+	})
+
+	s.Run("Error case: operators past the plan limit are refused until the company upgrades", func() {
+		t := s.T()
+		registered := s.register(t, "Billing Limits", "owner@billing-limits.example.com")
+		others := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).WithUser(string(user.RoleViewer)).WithUser(string(user.RoleAdmin)).Build()
+		token := authtest.LoginAs(t, s.Router, others.User)
+		resourceID := registered.ResourceIDs[0]
+
+		// The owner operates the sample resources, so the free plan has room for one more.
+		w := s.assign(t, token, resourceID, others.Users[0].ID)
+		require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+		w = s.assign(t, token, registered.ResourceIDs[1], others.Users[0].ID)
+		require.Equal(t, http.StatusNoContent, w.Code, "an existing operator is not counted twice: %s", w.Body.String())
+		w = s.assign(t, token, resourceID, others.Users[1].ID)
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "PLAN_OPERATOR_LIMIT")
+
+		w = s.deliver(t, "evt_upgrade_"+registered.CompanyID.String(), registered.CompanyID, "pro", time.Now())
+		require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+
+		w = s.assign(t, token, resourceID, others.Users[1].ID)
+		assert.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+	})
+}
+
+func (s *BillingSuite) TestWebhook() {
+	s.Run("Normal case: retried and stale deliveries leave the subscription as is", func() {
+		t := s.T()
+		registered := s.register(t, "Billing Sync", "owner@billing-sync.example.com")
+		now := time.Now()
+		upgrade := "evt_sync_" + registered.CompanyID.String()
+
+		w := s.deliver(t, upgrade, registered.CompanyID, "pro", now)
+		require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+		w = s.deliver(t, upgrade, registered.CompanyID, "enterprise", now.Add(time.Minute))
+		require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+		assert.Equal(t, "pro", s.planOf(t, registered.CompanyID), "a retried event was applied twice")
+
+		w = s.deliver(t, upgrade+"_stale", registered.CompanyID, "free", now.Add(-time.Hour))
+		require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+		assert.Equal(t, "pro", s.planOf(t, registered.CompanyID), "an older delivery rolled the subscription back")
+	})
+
+	s.Run("Error case: bad signatures, malformed payloads and unknown plans are refused", func() {
+		t := s.T()
+		registered := s.register(t, "Billing Errors", "owner@billing-errors.example.com")
+
+		payload := []byte(`{"id":"evt_forged","type":"subscription.updated","created_at":"2025-01-01T00:00:00Z"}`)
+		w := s.post(t, payload, hex.EncodeToString(billing.Sign([]byte("not-the-secret"), payload)))
+		httptest.AssertErrorCode(t, w, http.StatusUnauthorized, "BILLING_SIGNATURE_INVALID")
+
+		payload = []byte(`{"type":"subscription.updated"}`)
+		w = s.post(t, payload, hex.EncodeToString(billing.Sign([]byte(s.Config.Billing.WebhookSecret), payload)))
+		httptest.AssertErrorCode(t, w, http.StatusBadRequest, "BILLING_PAYLOAD_INVALID")
+
+		w = s.deliver(t, "evt_gold_"+registered.CompanyID.String(), registered.CompanyID, "gold", time.Now())
+		httptest.AssertErrorCode(t, w, http.StatusUnprocessableEntity, "BILLING_UNKNOWN_REFERENCE")
+		assert.Equal(t, "free", s.planOf(t, registered.CompanyID))
+	})
+}
@@ -0,0 +1,96 @@
+//go:build e2e
+
+package branding_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/tests/common/authtest"
+	"gin-clean-starter/tests/common/dbtest"
+	"gin-clean-starter/tests/common/httptest"
+	"gin-clean-starter/tests/e2e"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const brandingURL = "/api/admin/companies/%s/branding"
+
+type BrandingSuite struct {
+	e2e.SharedSuite
+}
+
+func (s *BrandingSuite) SetupSubTest() {
+	s.SharedSuite.SetupSubTest()
+}
+
+func TestBrandingSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(BrandingSuite))
+}
+
+func (s *BrandingSuite) TestCompanyBranding() {
+	s.Run("Normal case: branding is stored and shows in the preview", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithCompany().Build()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+		token := authtest.LoginAs(t, s.Router, admin.User)
+		url := fmt.Sprintf(brandingURL, sc.CompanyID)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, url+"/preview", nil, token)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var preview response.BrandingPreviewResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &preview))
+		assert.Empty(t, preview.ReplyTo)
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPut, url, request.SetBrandingRequest{
+			LogoURL:      "https://cdn.example.com/acme.png",
+			PrimaryColor: "#AA3300",
+			ReplyTo:      "help@acme.example",
+			Footer:       "Acme Inc., 1 Main St",
+		}, token)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var branding response.BrandingResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &branding))
+		assert.Equal(t, "#aa3300", branding.PrimaryColor)
+		assert.Empty(t, branding.AccentColor)
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, url+"/preview", nil, token)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &preview))
+		assert.Equal(t, "help@acme.example", preview.ReplyTo)
+		assert.Contains(t, preview.HTML, "https://cdn.example.com/acme.png")
+		assert.Contains(t, preview.HTML, "#aa3300")
+		assert.Contains(t, preview.Text, "Acme Inc., 1 Main St")
+	})
+
+	s.Run("Error case: invalid branding, unknown companies and missing permission are rejected", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithCompany().WithUser(string(user.RoleViewer)).Build()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+		token := authtest.LoginAs(t, s.Router, admin.User)
+		url := fmt.Sprintf(brandingURL, sc.CompanyID)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPut, url, request.SetBrandingRequest{LogoURL: "http://cdn.example.com/acme.png"}, token)
+		httptest.AssertErrorCode(t, w, http.StatusBadRequest, "INVALID_LOGO_URL")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPut, url, request.SetBrandingRequest{PrimaryColor: "#aa330080"}, token)
+		require.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPut, fmt.Sprintf(brandingURL, uuid.New()), request.SetBrandingRequest{}, token)
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "COMPANY_NOT_FOUND")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, fmt.Sprintf(brandingURL, uuid.New())+"/preview", nil, token)
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "COMPANY_NOT_FOUND")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, url, nil, authtest.LoginAs(t, s.Router, sc.User))
+		require.Equal(t, http.StatusForbidden, w.Code, w.Body.String())
+	})
+}
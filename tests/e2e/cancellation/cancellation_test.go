@@ -0,0 +1,124 @@
+//go:build e2e
+
+package cancellation_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	nethttptest "net/http/httptest"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/tests/common/authtest"
+	"gin-clean-starter/tests/common/dbtest"
+	"gin-clean-starter/tests/common/httptest"
+	"gin-clean-starter/tests/e2e"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	slowURL     = "/api/_test/slow"
+	canceledURL = "/api/admin/canceled-requests"
+	// sleepSeconds is far longer than any test waits, so a finished query means a canceled one.
+	sleepSeconds = "30"
+)
+
+type CancellationSuite struct {
+	e2e.SharedSuite
+}
+
+func (s *CancellationSuite) SetupSubTest() {
+	s.SharedSuite.SetupSubTest()
+}
+
+func TestCancellationSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(CancellationSuite))
+}
+
+// abandon sends a slow request and disconnects once its query is running.
+func (s *CancellationSuite) abandon(t *testing.T, via, tag string) *nethttptest.ResponseRecorder {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	req := nethttptest.NewRequestWithContext(ctx, http.MethodGet, slowURL+"?seconds="+sleepSeconds+"&via="+via+"&tag="+tag, nil)
+	w := nethttptest.NewRecorder()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.Router.ServeHTTP(w, req)
+	}()
+
+	require.Eventually(t, func() bool { return s.running(t, tag) == 1 }, 10*time.Second, 20*time.Millisecond)
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("request still running after its client disconnected")
+	}
+	return w
+}
+
+// running counts the backends still executing the tagged query.
+func (s *CancellationSuite) running(t *testing.T, tag string) int {
+	t.Helper()
+	var count int
+	require.NoError(t, s.DB.QueryRow(context.Background(),
+		`SELECT count(*) FROM pg_stat_activity WHERE state = 'active' AND query LIKE '%pg_sleep%' AND query LIKE '%' || $1`,
+		tag).Scan(&count))
+	return count
+}
+
+func (s *CancellationSuite) canceledRequests(t *testing.T, token string) response.CanceledRequestStatsResponse {
+	t.Helper()
+	w := httptest.PerformRequest(t, s.Router, http.MethodGet, canceledURL, nil, token)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var stats response.CanceledRequestStatsResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &stats))
+	return stats
+}
+
+func (s *CancellationSuite) TestClientDisconnect() {
+	for _, via := range []string{"read", "tx"} {
+		s.Run("Normal case: the query stops with its client, via "+via, func() {
+			t := s.T()
+			admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+			token := authtest.LoginAs(t, s.Router, admin.User)
+			before := s.canceledRequests(t, token)
+			tag := uuid.NewString()
+
+			w := s.abandon(t, via, tag)
+			httptest.AssertErrorCode(t, w, httperr.StatusClientClosedRequest, "CLIENT_CLOSED_REQUEST")
+			// pgx asks the server to cancel the statement, so the backend does not sleep on.
+			require.Eventually(t, func() bool { return s.running(t, tag) == 0 }, 5*time.Second, 20*time.Millisecond)
+
+			after := s.canceledRequests(t, token)
+			assert.Greater(t, after.Total, before.Total)
+			assert.Greater(t, after.Routes["GET "+slowURL], before.Routes["GET "+slowURL])
+		})
+	}
+
+	s.Run("Normal case: the pool serves other requests after a cancellation", func() {
+		t := s.T()
+		s.abandon(t, "tx", uuid.NewString())
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, slowURL+"?seconds=0.01&tag="+uuid.NewString(), nil, "")
+		assert.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+	})
+
+	s.Run("Error case: viewers cannot read the counters", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithCompany().WithUser(string(user.RoleViewer)).Build()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, canceledURL, nil, authtest.LoginAs(t, s.Router, sc.User))
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "PERMISSION_DENIED")
+	})
+}
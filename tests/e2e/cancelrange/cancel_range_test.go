@@ -0,0 +1,155 @@
+//go:build e2e
+
+package cancelrange_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	nethttptest "net/http/httptest"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/tests/common/authtest"
+	"gin-clean-starter/tests/common/dbtest"
+	"gin-clean-starter/tests/common/httptest"
+	"gin-clean-starter/tests/e2e"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type CancelRangeSuite struct {
+	e2e.SharedSuite
+}
+
+func (s *CancelRangeSuite) SetupSubTest() {
+	s.SharedSuite.SetupSubTest()
+}
+
+func TestCancelRangeSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(CancelRangeSuite))
+}
+
+func (s *CancelRangeSuite) cancelRange(t *testing.T, token string, resourceID uuid.UUID, req request.CancelReservationRangeRequest) *nethttptest.ResponseRecorder {
+	t.Helper()
+
+	return httptest.PerformRequest(t, s.Router, http.MethodPost, fmt.Sprintf("/api/admin/resources/%s/cancel-range", resourceID), req, token)
+}
+
+// closure books two reservations for one user and one for another inside the next two
+// days, plus one for the second user well after them.
+func (s *CancelRangeSuite) closure(t *testing.T) (sc *dbtest.ScenarioFixtures, req request.CancelReservationRangeRequest) {
+	t.Helper()
+
+	later := time.Now().Add(10 * 24 * time.Hour)
+	sc = dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).WithResource().
+		WithUpcomingReservation().WithUpcomingReservation().
+		WithUser(string(user.RoleViewer)).WithUpcomingReservation().
+		WithReservation(later, later.Add(time.Hour), "confirmed").Build()
+	return sc, request.CancelReservationRangeRequest{
+		StartTime: time.Now(),
+		EndTime:   time.Now().Add(48 * time.Hour),
+		Reason:    "Facility closed for repairs",
+	}
+}
+
+func (s *CancelRangeSuite) status(t *testing.T, reservationID uuid.UUID) string {
+	t.Helper()
+
+	var status string
+	require.NoError(t, s.DB.QueryRow(context.Background(), "SELECT status FROM reservations WHERE id = $1", reservationID).Scan(&status))
+	return status
+}
+
+func (s *CancelRangeSuite) jobs(t *testing.T, userID uuid.UUID) map[string]int {
+	t.Helper()
+
+	rows, err := s.DB.Query(context.Background(),
+		"SELECT topic, jsonb_array_length(COALESCE(payload->'reservation_ids', '[null]'::jsonb)) FROM notification_jobs WHERE payload->>'user_id' = $1",
+		userID.String())
+	require.NoError(t, err)
+	defer rows.Close()
+	jobs := make(map[string]int)
+	for rows.Next() {
+		var topic string
+		var n int
+		require.NoError(t, rows.Scan(&topic, &n))
+		jobs[topic] += n
+	}
+	require.NoError(t, rows.Err())
+	return jobs
+}
+
+func (s *CancelRangeSuite) TestCancelRange() {
+	s.Run("Normal case: dry run lists the affected reservations and changes nothing", func() {
+		t := s.T()
+		sc, req := s.closure(t)
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+		req.DryRun = true
+
+		w := s.cancelRange(t, authtest.LoginAs(t, s.Router, admin.User), sc.ResourceID, req)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var result response.CancelRangeResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &result))
+		assert.True(t, result.DryRun)
+		assert.Equal(t, 2, result.Emails)
+		require.Len(t, result.Reservations, 3)
+		for i, r := range result.Reservations {
+			assert.Equal(t, sc.ReservationIDs[i], r.ID)
+			assert.Equal(t, "confirmed", s.status(t, r.ID))
+		}
+		assert.Empty(t, s.jobs(t, sc.Users[0].ID))
+	})
+
+	s.Run("Normal case: cancels the range and sends one email per user", func() {
+		t := s.T()
+		sc, req := s.closure(t)
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+
+		w := s.cancelRange(t, authtest.LoginAs(t, s.Router, admin.User), sc.ResourceID, req)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var result response.CancelRangeResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &result))
+		assert.False(t, result.DryRun)
+		assert.Equal(t, 2, result.Emails)
+		assert.Len(t, result.Reservations, 3)
+
+		for _, id := range sc.ReservationIDs[:3] {
+			assert.Equal(t, "canceled", s.status(t, id))
+		}
+		assert.Equal(t, "confirmed", s.status(t, sc.ReservationIDs[3]))
+		assert.Equal(t, map[string]int{"reservations_bulk_canceled": 2}, s.jobs(t, sc.Users[0].ID))
+		assert.Equal(t, map[string]int{"reservation_canceled": 1}, s.jobs(t, sc.Users[1].ID))
+
+		var audited int
+		require.NoError(t, s.DB.QueryRow(context.Background(),
+			"SELECT count(*) FROM audit_logs WHERE action = 'resource.reservations_canceled' AND target_id = $1",
+			sc.ResourceID.String()).Scan(&audited))
+		assert.Equal(t, 1, audited)
+	})
+
+	s.Run("Error case: rejects callers without a cancel grant and invalid ranges", func() {
+		t := s.T()
+		sc, req := s.closure(t)
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+
+		w := s.cancelRange(t, authtest.LoginAs(t, s.Router, sc.User), sc.ResourceID, req)
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "PERMISSION_DENIED")
+
+		adminToken := authtest.LoginAs(t, s.Router, admin.User)
+		w = s.cancelRange(t, adminToken, uuid.New(), req)
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "RESOURCE_NOT_FOUND")
+
+		req.StartTime, req.EndTime = req.EndTime, req.StartTime
+		w = s.cancelRange(t, adminToken, sc.ResourceID, req)
+		httptest.AssertErrorCode(t, w, http.StatusBadRequest, "INVALID_CANCEL_RANGE")
+		assert.Equal(t, "confirmed", s.status(t, sc.ReservationIDs[0]))
+	})
+}
@@ -0,0 +1,292 @@
+//go:build e2e
+
+package clock_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	nethttptest "net/http/httptest"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/tests/common/authtest"
+	"gin-clean-starter/tests/common/builder"
+	"gin-clean-starter/tests/common/dbtest"
+	"gin-clean-starter/tests/common/httptest"
+	"gin-clean-starter/tests/e2e"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	testClockURL    = "/api/_test/clock"
+	quotesURL       = "/api/quotes"
+	reservationsURL = "/api/reservations"
+	reviewsURL      = "/api/reviews"
+	reviewWindowURL = "/api/admin/companies/%s/review-window"
+)
+
+type clockSuite struct {
+	e2e.SharedSuite
+}
+
+// The clock offset is process-wide, so time-shifting tests stay in this one suite.
+func TestClockSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(clockSuite))
+}
+
+// =============================================================================
+// TestSetClock - the control endpoint itself
+// =============================================================================
+
+func (s *clockSuite) TestSetClock() {
+	s.Run("Normal case: offset shifts the reported time", func() {
+		t := s.T()
+		t.Cleanup(func() {
+			httptest.PerformRequest(t, s.Router, http.MethodPost, testClockURL, request.SetTestClockRequest{Offset: "0s"}, "")
+		})
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, testClockURL, request.SetTestClockRequest{Offset: "72h"}, "")
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		var res response.TestClockResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		assert.Equal(t, "72h0m0s", res.Offset)
+		assert.WithinDuration(t, time.Now().Add(72*time.Hour), res.Now, time.Minute)
+	})
+
+	s.Run("Error case: malformed offset is rejected", func() {
+		t := s.T()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, testClockURL, request.SetTestClockRequest{Offset: "three days"}, "")
+		httptest.AssertErrorResponse(t, w, http.StatusBadRequest, "Invalid offset")
+	})
+}
+
+// =============================================================================
+// TestReviewEligibilityWindow - reviews open once the reservation has ended, or within the
+// company's own window
+// =============================================================================
+
+func (s *clockSuite) TestReviewEligibilityWindow() {
+	s.Run("Normal case: review is accepted only after the slot ends", func() {
+		t := s.T()
+
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).WithResourceNamed("Clock Room", 0).Build()
+		resourceID := sc.ResourceID
+		token := authtest.LoginAs(t, s.Router, sc.User)
+
+		start := time.Now().Add(2 * time.Hour).Truncate(time.Minute)
+		reservationID := s.createReservation(t, token, request.CreateReservationRequest{
+			ResourceID: resourceID,
+			StartTime:  start,
+			EndTime:    start.Add(time.Hour),
+		})
+
+		reqBody := builder.NewReviewBuilder().
+			WithResourceID(resourceID).
+			WithReservationID(reservationID).
+			WithRating(5).
+			WithComment("Worth the wait").
+			BuildCreateRequestDTO()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, reviewsURL, reqBody, token)
+		httptest.AssertErrorCode(t, w, http.StatusUnprocessableEntity, "REVIEW_TOO_EARLY")
+
+		s.SetClockOffset(4 * time.Hour)
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, reviewsURL, reqBody, token)
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	})
+
+	s.Run("Normal case: a company window opens late and expires", func() {
+		t := s.T()
+
+		sc := dbtest.Scenario(t, s.DB).
+			WithCompany().
+			WithUser(string(user.RoleViewer)).
+			WithResource().WithCompletedReservation().WithCompletedReservation().
+			Build()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+		token := authtest.LoginAs(t, s.Router, sc.User)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPut, fmt.Sprintf(reviewWindowURL, sc.CompanyID),
+			request.SetReviewWindowRequest{OpensAfterMinutes: ptr(int32(120)), WindowDays: ptr(int32(2))},
+			authtest.LoginAs(t, s.Router, admin.User))
+		require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+
+		review := func(reservationID uuid.UUID) request.CreateReviewRequest {
+			return builder.NewReviewBuilder().
+				WithResourceID(sc.ResourceID).
+				WithReservationID(reservationID).
+				WithRating(4).
+				WithComment("Opened right on time").
+				BuildCreateRequestDTO()
+		}
+
+		// The reservations ended one and two hours ago; the window opens two hours after the end.
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, reviewsURL, review(sc.ReservationIDs[0]), token)
+		httptest.AssertErrorCode(t, w, http.StatusUnprocessableEntity, "REVIEW_TOO_EARLY")
+
+		s.SetClockOffset(2 * time.Hour)
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, reviewsURL, review(sc.ReservationIDs[0]), token)
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+		s.SetClockOffset(47 * time.Hour)
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, reviewsURL, review(sc.ReservationIDs[1]), token)
+		httptest.AssertErrorCode(t, w, http.StatusUnprocessableEntity, "REVIEW_WINDOW_EXPIRED")
+	})
+
+	s.Run("Error case: invalid windows, unknown companies and missing permission are rejected", func() {
+		t := s.T()
+
+		sc := dbtest.Scenario(t, s.DB).WithCompany().WithUser(string(user.RoleViewer)).Build()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+		token := authtest.LoginAs(t, s.Router, admin.User)
+		url := fmt.Sprintf(reviewWindowURL, sc.CompanyID)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPut, url,
+			request.SetReviewWindowRequest{OpensAfterMinutes: ptr(int32(2 * 24 * 60)), WindowDays: ptr(int32(1))}, token)
+		httptest.AssertErrorCode(t, w, http.StatusBadRequest, "INVALID_REVIEW_WINDOW")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPut, url, map[string]any{"windowDays": 0}, token)
+		require.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPut, fmt.Sprintf(reviewWindowURL, uuid.New()), request.SetReviewWindowRequest{}, token)
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "COMPANY_NOT_FOUND")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPut, url, request.SetReviewWindowRequest{}, authtest.LoginAs(t, s.Router, sc.User))
+		require.Equal(t, http.StatusForbidden, w.Code, w.Body.String())
+	})
+}
+
+// =============================================================================
+// TestQuoteExpiry - a quote cannot be redeemed after its TTL; the client gets a fresh one
+// =============================================================================
+
+func (s *clockSuite) TestQuoteExpiry() {
+	s.Run("Normal case: an expired quote is rejected with a fresh quote to retry with", func() {
+		t := s.T()
+
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).WithResourceNamed("Clock Desk", 0).Build()
+		resourceID := sc.ResourceID
+		token := authtest.LoginAs(t, s.Router, sc.User)
+
+		start := time.Now().Add(48 * time.Hour).Truncate(time.Hour)
+		end := start.Add(time.Hour)
+
+		quote := s.createQuote(t, token, request.CreateQuoteRequest{ResourceID: resourceID, StartTime: start, EndTime: end})
+
+		s.SetClockOffset(s.Config.Pricing.QuoteTTL + time.Minute)
+
+		req := request.CreateReservationRequest{
+			ResourceID: resourceID,
+			StartTime:  start,
+			EndTime:    end,
+			QuoteID:    &quote.QuoteID,
+		}
+		w := s.performReservation(t, token, req)
+		httptest.AssertErrorResponse(t, w, http.StatusConflict, "Quote expired")
+		httptest.AssertErrorCode(t, w, http.StatusConflict, "QUOTE_EXPIRED")
+
+		requote := decodeRequote(t, w)
+		assert.NotEqual(t, quote.QuoteID, requote.Quote.QuoteID)
+		assert.Equal(t, quote.TotalCents, requote.Quote.TotalCents)
+		assert.Empty(t, requote.DroppedCoupons)
+
+		req.QuoteID = &requote.Quote.QuoteID
+		s.createReservation(t, token, req)
+	})
+
+	s.Run("Normal case: a coupon that expired with the quote is dropped from the fresh quote", func() {
+		t := s.T()
+
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).WithResourceNamed("Clock Lounge", 0).Build()
+		token := authtest.LoginAs(t, s.Router, sc.User)
+		code := "clock-" + uuid.NewString()[:8]
+		_, err := s.DB.Exec(context.Background(),
+			`INSERT INTO coupons (code, amount_off_cents, valid_to) VALUES ($1, 500, $2)`,
+			code, time.Now().Add(s.Config.Pricing.QuoteTTL))
+		require.NoError(t, err)
+
+		start := time.Now().Add(48 * time.Hour).Truncate(time.Hour)
+		end := start.Add(time.Hour)
+
+		quote := s.createQuote(t, token, request.CreateQuoteRequest{ResourceID: sc.ResourceID, StartTime: start, EndTime: end, CouponCode: &code})
+		require.Equal(t, int64(500), quote.DiscountCents)
+
+		s.SetClockOffset(s.Config.Pricing.QuoteTTL + time.Minute)
+
+		w := s.performReservation(t, token, request.CreateReservationRequest{
+			ResourceID: sc.ResourceID,
+			StartTime:  start,
+			EndTime:    end,
+			CouponCode: &code,
+			QuoteID:    &quote.QuoteID,
+		})
+		httptest.AssertErrorCode(t, w, http.StatusConflict, "QUOTE_EXPIRED")
+
+		requote := decodeRequote(t, w)
+		assert.Equal(t, []string{code}, requote.DroppedCoupons)
+		assert.Empty(t, requote.Quote.CouponCodes)
+		assert.Zero(t, requote.Quote.DiscountCents)
+		assert.Equal(t, quote.SubtotalCents+500, requote.Quote.SubtotalCents)
+
+		s.createReservation(t, token, request.CreateReservationRequest{
+			ResourceID: sc.ResourceID,
+			StartTime:  start,
+			EndTime:    end,
+			QuoteID:    &requote.Quote.QuoteID,
+		})
+	})
+}
+
+func (s *clockSuite) createQuote(t *testing.T, token string, req request.CreateQuoteRequest) response.QuoteResponse {
+	t.Helper()
+
+	w := httptest.PerformRequest(t, s.Router, http.MethodPost, quotesURL, req, token)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var quote response.QuoteResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &quote))
+	return quote
+}
+
+func decodeRequote(t *testing.T, w *nethttptest.ResponseRecorder) response.RequoteResponse {
+	t.Helper()
+
+	var body struct {
+		Detail response.RequoteResponse `json:"detail"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.NotNil(t, body.Detail.Quote, w.Body.String())
+	return body.Detail
+}
+
+func (s *clockSuite) createReservation(t *testing.T, token string, req request.CreateReservationRequest) uuid.UUID {
+	t.Helper()
+
+	w := s.performReservation(t, token, req)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	var res response.ReservationResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+	return res.ID
+}
+
+func (s *clockSuite) performReservation(t *testing.T, token string, req request.CreateReservationRequest) *nethttptest.ResponseRecorder {
+	t.Helper()
+
+	return httptest.PerformRequestWithHeaders(t, s.Router, http.MethodPost, reservationsURL, req, token,
+		map[string]string{"Idempotency-Key": uuid.NewString()})
+}
+
+func ptr[T any](v T) *T { return &v }
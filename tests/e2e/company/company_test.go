@@ -0,0 +1,104 @@
+//go:build e2e
+
+package company_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/tests/common/authtest"
+	"gin-clean-starter/tests/common/dbtest"
+	"gin-clean-starter/tests/common/httptest"
+	"gin-clean-starter/tests/e2e"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	companiesURL         = "/api/companies"
+	adminReservationsURL = "/api/admin/reservations"
+)
+
+type CompanySuite struct {
+	e2e.SharedSuite
+}
+
+func (s *CompanySuite) SetupSubTest() {
+	s.SharedSuite.SetupSubTest()
+}
+
+func TestCompanySuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(CompanySuite))
+}
+
+// register creates a workspace through the public endpoint and logs its owner in.
+func (s *CompanySuite) register(t *testing.T, name, email string) (*response.RegisterCompanyResponse, string) {
+	t.Helper()
+
+	w := httptest.PerformRequest(t, s.Router, http.MethodPost, companiesURL, request.RegisterCompanyRequest{
+		CompanyName:   name,
+		AdminEmail:    email,
+		AdminPassword: dbtest.DefaultPassword,
+	}, "")
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var registered response.RegisterCompanyResponse
+	require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &registered))
+	require.NotEmpty(t, registered.ResourceIDs)
+
+	return &registered, authtest.LoginUser(t, s.Router, email, dbtest.DefaultPassword)
+}
+
+func (s *CompanySuite) TestOwnerIsolation() {
+	s.Run("Normal case: owner sees only reservations on its own company's resources", func() {
+		t := s.T()
+		mine, myToken := s.register(t, "Isolation A", "owner@isolation-a.example.com")
+		theirs, _ := s.register(t, "Isolation B", "owner@isolation-b.example.com")
+
+		start := time.Now().Add(48 * time.Hour)
+		myReservation := dbtest.CreateTestReservation(t, s.DB, mine.ResourceIDs[0], mine.AdminUserID, start, start.Add(time.Hour), "confirmed")
+		theirReservation := dbtest.CreateTestReservation(t, s.DB, theirs.ResourceIDs[0], theirs.AdminUserID, start, start.Add(time.Hour), "confirmed")
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, adminReservationsURL+"?limit=100", nil, myToken)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var page response.AdminReservationListPageResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &page))
+
+		ids := make([]uuid.UUID, 0, len(page.Reservations))
+		for _, r := range page.Reservations {
+			assert.Contains(t, mine.ResourceIDs, r.ResourceID, "listed a reservation outside the owner's company")
+			ids = append(ids, r.ID)
+		}
+		assert.Contains(t, ids, myReservation)
+		assert.NotContains(t, ids, theirReservation)
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, fmt.Sprintf("%s/%s", adminReservationsURL, myReservation), nil, myToken)
+		assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	})
+
+	s.Run("Error case: owner cannot read another company's reservation", func() {
+		t := s.T()
+		_, myToken := s.register(t, "Isolation C", "owner@isolation-c.example.com")
+		theirs, _ := s.register(t, "Isolation D", "owner@isolation-d.example.com")
+
+		start := time.Now().Add(48 * time.Hour)
+		theirReservation := dbtest.CreateTestReservation(t, s.DB, theirs.ResourceIDs[0], theirs.AdminUserID, start, start.Add(time.Hour), "confirmed")
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, fmt.Sprintf("%s/%s", adminReservationsURL, theirReservation), nil, myToken)
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "RESERVATION_NOT_FOUND")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet,
+			fmt.Sprintf("%s?resource_id=%s", adminReservationsURL, theirs.ResourceIDs[0]), nil, myToken)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var page response.AdminReservationListPageResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &page))
+		assert.Empty(t, page.Reservations)
+	})
+}
@@ -0,0 +1,142 @@
+//go:build e2e
+
+package companydeletion_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/tests/common/authtest"
+	"gin-clean-starter/tests/common/dbtest"
+	"gin-clean-starter/tests/common/httptest"
+	"gin-clean-starter/tests/e2e"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type CompanyDeletionSuite struct {
+	e2e.SharedSuite
+}
+
+func (s *CompanyDeletionSuite) SetupSubTest() {
+	s.SharedSuite.SetupSubTest()
+}
+
+func TestCompanyDeletionSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(CompanyDeletionSuite))
+}
+
+func requestURL(companyID uuid.UUID, confirm string) string {
+	return "/api/admin/companies/" + companyID.String() + "?" + url.Values{"confirm": {confirm}}.Encode()
+}
+
+func deletionURL(id uuid.UUID) string {
+	return "/api/admin/company-deletions/" + id.String()
+}
+
+func (s *CompanyDeletionSuite) companyName(t *testing.T, id uuid.UUID) string {
+	t.Helper()
+
+	var name string
+	require.NoError(t, s.DB.QueryRow(context.Background(), `SELECT name FROM companies WHERE id = $1`, id).Scan(&name))
+	return name
+}
+
+func (s *CompanyDeletionSuite) TestCompanyDeletion() {
+	s.Run("Normal case: a deletion locks the company out and is queued", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithCompany().WithUser(string(user.RoleViewer)).WithResource().Build()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+		adminToken := authtest.LoginAs(t, s.Router, admin.User)
+		name := s.companyName(t, sc.CompanyID)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodDelete, requestURL(sc.CompanyID, name), nil, adminToken)
+		require.Equal(t, http.StatusAccepted, w.Code, w.Body.String())
+		var queued response.CompanyDeletionResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &queued))
+		assert.Equal(t, sc.CompanyID, queued.CompanyID)
+		assert.Equal(t, name, queued.CompanyName)
+		assert.Equal(t, &admin.User.ID, queued.RequestedBy)
+		assert.Equal(t, "pending", queued.Status)
+		assert.Equal(t, "cancel_reservations", queued.Stage)
+		assert.Equal(t, int64(1), queued.Counts["users_deactivated"])
+
+		var active bool
+		require.NoError(t, s.DB.QueryRow(context.Background(), `SELECT is_active FROM users WHERE id = $1`, sc.User.ID).Scan(&active))
+		assert.False(t, active, "members are locked out at once")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, deletionURL(queued.ID), nil, adminToken)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var status response.CompanyDeletionResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &status))
+		assert.Equal(t, "pending", status.Status)
+		assert.Empty(t, status.Certificate)
+
+		var action string
+		err := s.DB.QueryRow(context.Background(),
+			`SELECT action FROM audit_logs WHERE target_type = 'company' AND target_id = $1`, sc.CompanyID.String()).Scan(&action)
+		require.NoError(t, err)
+		assert.Equal(t, "company.deletion_requested", action)
+	})
+
+	s.Run("Error case: confirmation must repeat the company name", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithCompany().Build()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodDelete, requestURL(sc.CompanyID, "not the name"), nil, authtest.LoginAs(t, s.Router, admin.User))
+		httptest.AssertErrorCode(t, w, http.StatusBadRequest, "COMPANY_DELETION_UNCONFIRMED")
+	})
+
+	s.Run("Error case: staff cannot delete their own company", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithCompany().WithUser(string(user.RoleAdmin)).Build()
+		name := s.companyName(t, sc.CompanyID)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodDelete, requestURL(sc.CompanyID, name), nil, authtest.LoginAs(t, s.Router, sc.User))
+		httptest.AssertErrorCode(t, w, http.StatusConflict, "COMPANY_DELETION_OWN_COMPANY")
+	})
+
+	s.Run("Error case: one deletion in progress per company", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithCompany().Build()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+		adminToken := authtest.LoginAs(t, s.Router, admin.User)
+		name := s.companyName(t, sc.CompanyID)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodDelete, requestURL(sc.CompanyID, name), nil, adminToken)
+		require.Equal(t, http.StatusAccepted, w.Code, w.Body.String())
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodDelete, requestURL(sc.CompanyID, name), nil, adminToken)
+		httptest.AssertErrorCode(t, w, http.StatusConflict, "COMPANY_DELETION_IN_PROGRESS")
+	})
+
+	s.Run("Error case: unknown company or deletion", func() {
+		t := s.T()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+		adminToken := authtest.LoginAs(t, s.Router, admin.User)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodDelete, requestURL(uuid.New(), "Acme"), nil, adminToken)
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "COMPANY_NOT_FOUND")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, deletionURL(uuid.New()), nil, adminToken)
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "COMPANY_DELETION_NOT_FOUND")
+	})
+
+	s.Run("Error case: permission required", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithCompany().WithUser(string(user.RoleViewer)).Build()
+		name := s.companyName(t, sc.CompanyID)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodDelete, requestURL(sc.CompanyID, name), nil, authtest.LoginAs(t, s.Router, sc.User))
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "PERMISSION_DENIED")
+	})
+}
@@ -0,0 +1,165 @@
+//go:build e2e
+
+package companyexport_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/tests/common/authtest"
+	"gin-clean-starter/tests/common/dbtest"
+	"gin-clean-starter/tests/common/httptest"
+	"gin-clean-starter/tests/e2e"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type CompanyExportSuite struct {
+	e2e.SharedSuite
+}
+
+func (s *CompanyExportSuite) SetupSubTest() {
+	s.SharedSuite.SetupSubTest()
+}
+
+func TestCompanyExportSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(CompanyExportSuite))
+}
+
+func requestURL(companyID uuid.UUID) string {
+	return "/api/admin/companies/" + companyID.String() + "/exports"
+}
+
+func exportURL(id uuid.UUID) string {
+	return "/api/admin/company-exports/" + id.String()
+}
+
+// finish marks an export done as the job would, pointing at storageKey.
+func (s *CompanyExportSuite) finish(t *testing.T, id uuid.UUID, storageKey string, expiresAt time.Time) {
+	t.Helper()
+
+	_, err := s.DB.Exec(context.Background(), `
+		UPDATE company_exports
+		SET status = 'done', progress = 100, storage_key = $2, size_bytes = 22, started_at = now(), completed_at = now(), expires_at = $3
+		WHERE id = $1`, id, storageKey, expiresAt)
+	require.NoError(t, err)
+}
+
+func (s *CompanyExportSuite) TestCompanyExport() {
+	s.Run("Normal case: an export is queued and reports its progress", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithCompany().WithUser(string(user.RoleViewer)).WithResource().Build()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+		adminToken := authtest.LoginAs(t, s.Router, admin.User)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, requestURL(sc.CompanyID), nil, adminToken)
+		require.Equal(t, http.StatusAccepted, w.Code, w.Body.String())
+		var queued response.CompanyExportResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &queued))
+		assert.Equal(t, sc.CompanyID, queued.CompanyID)
+		assert.Equal(t, &admin.User.ID, queued.RequestedBy)
+		assert.Equal(t, "pending", queued.Status)
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, exportURL(queued.ID), nil, adminToken)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var status response.CompanyExportResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &status))
+		assert.Equal(t, "pending", status.Status)
+		assert.Equal(t, int32(0), status.Progress)
+		assert.Empty(t, status.DownloadURL)
+
+		var action string
+		err := s.DB.QueryRow(context.Background(),
+			`SELECT action FROM audit_logs WHERE target_type = 'company' AND target_id = $1`, sc.CompanyID.String()).Scan(&action)
+		require.NoError(t, err)
+		assert.Equal(t, "company.export_requested", action)
+	})
+
+	s.Run("Normal case: a finished export carries a signed download link", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithCompany().Build()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+		adminToken := authtest.LoginAs(t, s.Router, admin.User)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, requestURL(sc.CompanyID), nil, adminToken)
+		require.Equal(t, http.StatusAccepted, w.Code, w.Body.String())
+		var queued response.CompanyExportResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &queued))
+		expiresAt := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+		s.finish(t, queued.ID, "company-exports/"+queued.ID.String()+".zip", expiresAt)
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, exportURL(queued.ID), nil, adminToken)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var status response.CompanyExportResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &status))
+		assert.Equal(t, "done", status.Status)
+		assert.Equal(t, int32(100), status.Progress)
+		require.NotEmpty(t, status.DownloadURL)
+		require.NotNil(t, status.DownloadExpiresAt)
+		assert.True(t, status.DownloadExpiresAt.Before(expiresAt), "links are shorter-lived than the archive")
+
+		// The archive was never written here, so the link resolves to a gone file
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, status.DownloadURL, nil, "")
+		httptest.AssertErrorCode(t, w, http.StatusGone, "COMPANY_EXPORT_LINK_EXPIRED")
+
+		// Once finished, the company may ask for a new export
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, requestURL(sc.CompanyID), nil, adminToken)
+		assert.Equal(t, http.StatusAccepted, w.Code, w.Body.String())
+	})
+
+	s.Run("Error case: one export in progress per company", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithCompany().Build()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+		adminToken := authtest.LoginAs(t, s.Router, admin.User)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, requestURL(sc.CompanyID), nil, adminToken)
+		require.Equal(t, http.StatusAccepted, w.Code, w.Body.String())
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, requestURL(sc.CompanyID), nil, adminToken)
+		httptest.AssertErrorCode(t, w, http.StatusConflict, "COMPANY_EXPORT_IN_PROGRESS")
+	})
+
+	s.Run("Error case: unknown company", func() {
+		t := s.T()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, requestURL(uuid.New()), nil, authtest.LoginAs(t, s.Router, admin.User))
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "COMPANY_NOT_FOUND")
+	})
+
+	s.Run("Error case: unknown export", func() {
+		t := s.T()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, exportURL(uuid.New()), nil, authtest.LoginAs(t, s.Router, admin.User))
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "COMPANY_EXPORT_NOT_FOUND")
+	})
+
+	s.Run("Error case: tampered or missing download token", func() {
+		t := s.T()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, "/api/company-exports/download?"+url.Values{"token": {"abc.def"}}.Encode(), nil, "")
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "COMPANY_EXPORT_INVALID_LINK")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, "/api/company-exports/download", nil, "")
+		httptest.AssertErrorCode(t, w, http.StatusBadRequest, "COMPANY_EXPORT_INVALID_LINK")
+	})
+
+	s.Run("Error case: permission required", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithCompany().WithUser(string(user.RoleViewer)).Build()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, requestURL(sc.CompanyID), nil, authtest.LoginAs(t, s.Router, sc.User))
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "PERMISSION_DENIED")
+	})
+}
@@ -0,0 +1,302 @@
+//go:build e2e
+
+package contract_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	nethttptest "net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/tests/common/authtest"
+	"gin-clean-starter/tests/common/builder"
+	"gin-clean-starter/tests/common/contract"
+	"gin-clean-starter/tests/common/dbtest"
+	"gin-clean-starter/tests/common/httptest"
+	"gin-clean-starter/tests/e2e"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+// contractSuite replays representative success and error responses through the real
+// router and checks each one against docs/swagger.json.
+type contractSuite struct {
+	e2e.SharedSuite
+	spec *contract.Spec
+}
+
+func TestContractSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(contractSuite))
+}
+
+func (s *contractSuite) SetupSuite() {
+	s.SharedSuite.SetupSuite()
+	s.spec = contract.MustLoad(s.T())
+}
+
+// call performs the request and asserts the response against the documented contract.
+func (s *contractSuite) call(t *testing.T, method, path string, body any, token string, headers map[string]string) *nethttptest.ResponseRecorder {
+	t.Helper()
+
+	w := httptest.PerformRequestWithHeaders(t, s.Router, method, path, body, token, headers)
+	s.spec.AssertResponse(t, method, path, w)
+	return w
+}
+
+// =============================================================================
+// TestRoutesMatchDocumentation - every route is documented and vice versa
+// =============================================================================
+
+func (s *contractSuite) TestRoutesMatchDocumentation() {
+	t := s.T()
+
+	registered := map[string]bool{}
+	for _, r := range s.Router.Routes() {
+		// e2e-only control endpoints are deliberately undocumented.
+		if strings.HasPrefix(r.Path, "/api/_test/") {
+			continue
+		}
+		registered[contract.RouteKey(r.Method, r.Path)] = true
+	}
+	documented := map[string]bool{}
+	for _, op := range s.spec.Operations() {
+		documented[op] = true
+	}
+
+	var undocumented, stale []string
+	for key := range registered {
+		if !documented[key] {
+			undocumented = append(undocumented, key)
+		}
+	}
+	for key := range documented {
+		if !registered[key] {
+			stale = append(stale, key)
+		}
+	}
+	sort.Strings(undocumented)
+	sort.Strings(stale)
+
+	assert.Empty(t, undocumented, "Routes missing from docs/swagger.json (add swag annotations)")
+	assert.Empty(t, stale, "Documented operations with no registered route")
+}
+
+// =============================================================================
+// TestAuthContract
+// =============================================================================
+
+func (s *contractSuite) TestAuthContract() {
+	s.SetupSubTest()
+	t := s.T()
+
+	dbtest.CreateTestUser(t, s.DB, "contract-auth@example.com", string(user.RoleViewer))
+
+	s.call(t, http.MethodGet, "/health", nil, "", nil)
+
+	w := s.call(t, http.MethodPost, "/api/auth/login", request.LoginRequest{Email: "contract-auth@example.com", Password: "password123"}, "", nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	cookies := httptest.ExtractCookies(w)
+
+	w = s.call(t, http.MethodPost, "/api/auth/login", request.LoginRequest{Email: "contract-auth@example.com", Password: "wrong-password"}, "", nil)
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+
+	w = s.call(t, http.MethodPost, "/api/auth/login", map[string]string{"email": "not-an-email"}, "", nil)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = s.call(t, http.MethodPost, "/api/auth/token", request.LoginRequest{Email: "contract-auth@example.com", Password: "password123"}, "", nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var tokens struct {
+		AccessToken  string `json:"accessToken"`
+		RefreshToken string `json:"refreshToken"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &tokens))
+
+	w = s.call(t, http.MethodPost, "/api/auth/token/refresh", request.RefreshRequest{RefreshToken: tokens.RefreshToken}, "", nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	w = s.call(t, http.MethodGet, "/api/auth/me", nil, tokens.AccessToken, nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	w = s.call(t, http.MethodGet, "/api/auth/me", nil, "", nil)
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+
+	w = s.call(t, http.MethodGet, "/api/auth/me", nil, "not-a-jwt", nil)
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+
+	w = httptest.PerformRequestWithCookies(t, s.Router, http.MethodPost, "/api/auth/logout", nil, cookies, "")
+	s.spec.AssertResponse(t, http.MethodPost, "/api/auth/logout", w)
+	require.Equal(t, http.StatusNoContent, w.Code)
+}
+
+// =============================================================================
+// TestReviewContract
+// =============================================================================
+
+func (s *contractSuite) TestReviewContract() {
+	s.SetupSubTest()
+	t := s.T()
+
+	userID := dbtest.CreateTestUser(t, s.DB, "contract-review@example.com", string(user.RoleViewer))
+	resourceID := dbtest.CreateTestResource(t, s.DB, "Contract Room", 0)
+	now := time.Now()
+	reservationID := dbtest.CreateTestReservation(t, s.DB, resourceID, userID,
+		now.Add(-2*time.Hour), now.Add(-1*time.Hour), "confirmed")
+	token := authtest.LoginUser(t, s.Router, "contract-review@example.com", "password123")
+
+	createReq := builder.NewReviewBuilder().
+		WithResourceID(resourceID).
+		WithReservationID(reservationID).
+		WithRating(4).
+		WithComment("Quiet and bright").
+		BuildCreateRequestDTO()
+
+	w := s.call(t, http.MethodPost, "/api/reviews", createReq, "", nil)
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+
+	w = s.call(t, http.MethodPost, "/api/reviews", createReq, token, nil)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var created response.CreatedResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+	reviewURL := "/api/reviews/" + created.ID
+
+	w = s.call(t, http.MethodPost, "/api/reviews", map[string]any{"rating": 9}, token, nil)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = s.call(t, http.MethodGet, reviewURL, nil, "", nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	w = s.call(t, http.MethodGet, "/api/reviews/"+uuid.NewString(), nil, "", nil)
+	require.Equal(t, http.StatusNotFound, w.Code)
+
+	w = s.call(t, http.MethodGet, "/api/reviews/not-a-uuid", nil, "", nil)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = s.call(t, http.MethodGet, fmt.Sprintf("/api/resources/%s/reviews?limit=1", resourceID), nil, "", nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	w = s.call(t, http.MethodGet, fmt.Sprintf("/api/resources/%s/rating-stats", resourceID), nil, "", nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	w = s.call(t, http.MethodGet, fmt.Sprintf("/api/users/%s/reviews", userID), nil, token, nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	rating := 5
+	w = s.call(t, http.MethodPut, reviewURL, request.UpdateReviewRequest{Rating: &rating}, token, nil)
+	require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+
+	w = s.call(t, http.MethodDelete, reviewURL, nil, token, nil)
+	require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+}
+
+// =============================================================================
+// TestReservationContract
+// =============================================================================
+
+func (s *contractSuite) TestReservationContract() {
+	s.SetupSubTest()
+	t := s.T()
+
+	dbtest.CreateTestUser(t, s.DB, "contract-reserve@example.com", string(user.RoleViewer))
+	resourceID := dbtest.CreateTestResource(t, s.DB, "Contract Desk", 0)
+	token := authtest.LoginUser(t, s.Router, "contract-reserve@example.com", "password123")
+
+	start := time.Now().Add(48 * time.Hour).Truncate(time.Hour)
+	end := start.Add(time.Hour)
+
+	w := s.call(t, http.MethodPost, "/api/quotes", request.CreateQuoteRequest{ResourceID: resourceID, StartTime: start, EndTime: end}, token, nil)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	w = s.call(t, http.MethodPost, "/api/quotes", request.CreateQuoteRequest{ResourceID: uuid.New(), StartTime: start, EndTime: end}, token, nil)
+	require.Equal(t, http.StatusNotFound, w.Code)
+
+	reserve := request.CreateReservationRequest{ResourceID: resourceID, StartTime: start, EndTime: end}
+	key := map[string]string{"Idempotency-Key": uuid.NewString()}
+
+	w = s.call(t, http.MethodPost, "/api/reservations", reserve, token, nil)
+	require.Equal(t, http.StatusBadRequest, w.Code, "Missing Idempotency-Key")
+
+	w = s.call(t, http.MethodPost, "/api/reservations", reserve, token, key)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var created struct {
+		ID string `json:"id"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+	reservationURL := "/api/reservations/" + created.ID
+	require.Equal(t, reservationURL, w.Header().Get("Location"))
+
+	w = s.call(t, http.MethodPost, "/api/reservations", reserve, token, key)
+	require.Equal(t, http.StatusOK, w.Code, "Replay with the same Idempotency-Key")
+
+	w = s.call(t, http.MethodPost, "/api/reservations", reserve, token, map[string]string{"Idempotency-Key": uuid.NewString()})
+	require.Equal(t, http.StatusConflict, w.Code, "Overlapping slot")
+
+	w = s.call(t, http.MethodGet, "/api/reservations?limit=10", nil, token, nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	w = s.call(t, http.MethodGet, reservationURL, nil, token, nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	w = s.call(t, http.MethodGet, "/api/reservations/"+uuid.NewString(), nil, token, nil)
+	require.Equal(t, http.StatusNotFound, w.Code)
+
+	w = s.call(t, http.MethodPost, reservationURL+"/cancel", nil, token, nil)
+	require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+
+	w = s.call(t, http.MethodPost, reservationURL+"/cancel", nil, token, nil)
+	require.Equal(t, http.StatusConflict, w.Code, "Already canceled")
+}
+
+// =============================================================================
+// TestAdminContract
+// =============================================================================
+
+func (s *contractSuite) TestAdminContract() {
+	s.SetupSubTest()
+	t := s.T()
+
+	dbtest.CreateTestUser(t, s.DB, "contract-admin@example.com", string(user.RoleAdmin))
+	dbtest.CreateTestUser(t, s.DB, "contract-viewer@example.com", string(user.RoleViewer))
+	adminToken := authtest.LoginUser(t, s.Router, "contract-admin@example.com", "password123")
+	viewerToken := authtest.LoginUser(t, s.Router, "contract-viewer@example.com", "password123")
+
+	for _, path := range []string{
+		"/api/admin/reservations",
+		"/api/admin/permissions",
+		"/api/admin/roles",
+		"/api/admin/roles/admin",
+		"/api/admin/retention/report",
+		"/api/admin/invites",
+	} {
+		w := s.call(t, http.MethodGet, path, nil, adminToken, nil)
+		require.Equal(t, http.StatusOK, w.Code, "%s: %s", path, w.Body.String())
+	}
+
+	w := s.call(t, http.MethodGet, "/api/admin/roles", nil, viewerToken, nil)
+	require.Equal(t, http.StatusForbidden, w.Code)
+
+	w = s.call(t, http.MethodGet, "/api/admin/roles/no-such-role", nil, adminToken, nil)
+	require.Equal(t, http.StatusNotFound, w.Code)
+
+	w = s.call(t, http.MethodPost, "/api/admin/roles", request.CreateRoleRequest{Name: "contract-role", Description: "Contract test"}, adminToken, nil)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	w = s.call(t, http.MethodPut, "/api/admin/roles/contract-role/permissions", request.UpdateRolePermissionsRequest{Permissions: []string{}}, adminToken, nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	w = s.call(t, http.MethodDelete, "/api/admin/roles/contract-role", nil, adminToken, nil)
+	require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+
+	w = s.call(t, http.MethodPost, "/api/admin/invites", request.CreateInviteRequest{Email: "contract-invitee@example.com"}, adminToken, nil)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+}
@@ -0,0 +1,170 @@
+//go:build e2e
+
+package customfield_test
+
+import (
+	"fmt"
+	"net/http"
+	nethttptest "net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/tests/common/authtest"
+	"gin-clean-starter/tests/common/dbtest"
+	"gin-clean-starter/tests/common/httptest"
+	"gin-clean-starter/tests/e2e"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	reservationsURL      = "/api/reservations"
+	adminReservationsURL = "/api/admin/reservations"
+)
+
+type CustomFieldSuite struct {
+	e2e.SharedSuite
+}
+
+func (s *CustomFieldSuite) SetupSubTest() {
+	s.SharedSuite.SetupSubTest()
+}
+
+func TestCustomFieldSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(CustomFieldSuite))
+}
+
+func customFieldsURL(companyID uuid.UUID) string {
+	return fmt.Sprintf("/api/admin/companies/%s/custom-fields", companyID)
+}
+
+// define creates a custom field as an admin and returns it.
+func (s *CustomFieldSuite) define(t *testing.T, adminToken string, companyID uuid.UUID, req request.CreateCustomFieldRequest) response.CustomFieldResponse {
+	t.Helper()
+
+	w := httptest.PerformRequest(t, s.Router, http.MethodPost, customFieldsURL(companyID), req, adminToken)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var field response.CustomFieldResponse
+	require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &field))
+	return field
+}
+
+func (s *CustomFieldSuite) reserve(t *testing.T, token string, resourceID uuid.UUID, dayOffset int, fields map[string]any) *nethttptest.ResponseRecorder {
+	t.Helper()
+
+	start := time.Now().UTC().Truncate(time.Hour).Add(time.Duration(72+24*dayOffset) * time.Hour)
+	return httptest.PerformRequestWithHeaders(t, s.Router, http.MethodPost, reservationsURL, request.CreateReservationRequest{
+		ResourceID:   resourceID,
+		StartTime:    start,
+		EndTime:      start.Add(time.Hour),
+		CustomFields: fields,
+	}, token, map[string]string{"Idempotency-Key": uuid.NewString()})
+}
+
+func (s *CustomFieldSuite) TestCustomFields() {
+	s.Run("Normal case: values are validated, stored, filtered on and exported", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithCompany().WithUser(string(user.RoleAdmin)).WithResource().Build()
+		adminToken := authtest.LoginAs(t, s.Router, sc.User)
+
+		s.define(t, adminToken, sc.CompanyID, request.CreateCustomFieldRequest{
+			Key: "purpose", Label: "Purpose", Type: "text", Required: true,
+		})
+		s.define(t, adminToken, sc.CompanyID, request.CreateCustomFieldRequest{
+			Key: "room_layout", Label: "Room layout", Type: "select", Options: []string{"theater", "boardroom"}, ResourceID: &sc.ResourceID,
+		})
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, fmt.Sprintf("/api/resources/%s/custom-fields", sc.ResourceID), nil, adminToken)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var asked []response.CustomFieldResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &asked))
+		require.Len(t, asked, 2)
+		assert.Equal(t, "purpose", asked[0].Key)
+		assert.Equal(t, []string{"theater", "boardroom"}, asked[1].Options)
+
+		w = s.reserve(t, adminToken, sc.ResourceID, 0, map[string]any{"purpose": " Board meeting ", "room_layout": "boardroom"})
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+		var created response.ReservationResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &created))
+		assert.Equal(t, map[string]any{"purpose": "Board meeting", "room_layout": "boardroom"}, created.CustomFields)
+
+		w = s.reserve(t, adminToken, sc.ResourceID, 1, map[string]any{"purpose": "Interview"})
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet,
+			fmt.Sprintf("%s?resource_id=%s&field[room_layout]=boardroom", adminReservationsURL, sc.ResourceID), nil, adminToken)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var page response.AdminReservationListPageResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &page))
+		require.Len(t, page.Reservations, 1)
+		assert.Equal(t, created.ID, page.Reservations[0].ID)
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet,
+			fmt.Sprintf("%s/export?format=csv&resource_id=%s", adminReservationsURL, sc.ResourceID), nil, adminToken)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		lines := strings.Split(strings.TrimSpace(w.Body.String()), "\n")
+		require.Len(t, lines, 3)
+		assert.True(t, strings.HasSuffix(lines[0], ",custom_fields"))
+		assert.Contains(t, w.Body.String(), `"{""purpose"":""Board meeting"",""room_layout"":""boardroom""}"`)
+	})
+
+	s.Run("Error case: invalid values name the rejected field", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithCompany().WithUser(string(user.RoleAdmin)).WithResource().Build()
+		adminToken := authtest.LoginAs(t, s.Router, sc.User)
+		s.define(t, adminToken, sc.CompanyID, request.CreateCustomFieldRequest{
+			Key: "attendees", Label: "Attendees", Type: "number", Required: true,
+		})
+
+		testCases := []struct {
+			name   string
+			fields map[string]any
+			field  string
+		}{
+			{"required field missing", nil, "attendees"},
+			{"number given as text", map[string]any{"attendees": "twelve"}, "attendees"},
+			{"unknown field", map[string]any{"attendees": 12, "catering": "yes"}, "catering"},
+		}
+		for _, tc := range testCases {
+			w := s.reserve(t, adminToken, sc.ResourceID, 0, tc.fields)
+			httptest.AssertErrorCode(t, w, http.StatusBadRequest, "INVALID_CUSTOM_FIELDS")
+			assert.Contains(t, w.Body.String(), fmt.Sprintf(`"field":"%s"`, tc.field), tc.name)
+		}
+	})
+
+	s.Run("Error case: definitions are checked", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithCompany().WithUser(string(user.RoleAdmin)).Build()
+		other := dbtest.Scenario(t, s.DB).WithCompany().WithUser(string(user.RoleViewer)).WithResource().Build()
+		adminToken := authtest.LoginAs(t, s.Router, sc.User)
+		field := s.define(t, adminToken, sc.CompanyID, request.CreateCustomFieldRequest{Key: "purpose", Label: "Purpose", Type: "text"})
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, customFieldsURL(sc.CompanyID),
+			request.CreateCustomFieldRequest{Key: "purpose", Label: "Again", Type: "text"}, adminToken)
+		httptest.AssertErrorCode(t, w, http.StatusConflict, "CUSTOM_FIELD_KEY_TAKEN")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, customFieldsURL(sc.CompanyID),
+			request.CreateCustomFieldRequest{Key: "layout", Label: "Layout", Type: "select"}, adminToken)
+		httptest.AssertErrorCode(t, w, http.StatusBadRequest, "CUSTOM_FIELD_INVALID")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, customFieldsURL(sc.CompanyID),
+			request.CreateCustomFieldRequest{Key: "floor", Label: "Floor", Type: "number", ResourceID: &other.ResourceID}, adminToken)
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "RESOURCE_NOT_FOUND")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, customFieldsURL(sc.CompanyID), nil, authtest.LoginAs(t, s.Router, other.User))
+		assert.Equal(t, http.StatusForbidden, w.Code, w.Body.String())
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodDelete, fmt.Sprintf("%s/%s", customFieldsURL(sc.CompanyID), field.ID), nil, adminToken)
+		require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+		w = httptest.PerformRequest(t, s.Router, http.MethodDelete, fmt.Sprintf("%s/%s", customFieldsURL(sc.CompanyID), field.ID), nil, adminToken)
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "CUSTOM_FIELD_NOT_FOUND")
+	})
+}
@@ -0,0 +1,132 @@
+//go:build e2e
+
+package deprecation_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/tests/common/authtest"
+	"gin-clean-starter/tests/common/dbtest"
+	"gin-clean-starter/tests/common/httptest"
+	"gin-clean-starter/tests/e2e"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const reportURL = "/api/admin/usage/deprecated-routes"
+
+type DeprecationSuite struct {
+	e2e.SharedSuite
+}
+
+func (s *DeprecationSuite) SetupSubTest() {
+	s.SharedSuite.SetupSubTest()
+}
+
+func TestDeprecationSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(DeprecationSuite))
+}
+
+// seedCalls writes rows as the scheduled flush would; the in-memory counters are not
+// reachable from the suite.
+func (s *DeprecationSuite) seedCalls(t *testing.T, busy, quiet uuid.UUID) {
+	t.Helper()
+
+	_, err := s.DB.Exec(context.Background(), `
+		INSERT INTO deprecated_route_calls (day, route, user_id, call_count, last_called_at) VALUES
+		    ('2026-03-01', 'GET /api/reservations', $1, 10, '2026-03-01T10:00:00Z'),
+		    ('2026-03-02', 'GET /api/reservations', $1, 5, '2026-03-02T08:00:00Z'),
+		    ('2026-03-02', 'GET /api/reservations', $2, 1, '2026-03-02T09:00:00Z'),
+		    ('2026-03-02', 'GET /api/reviews/:id', NULL, 7, '2026-03-02T12:00:00Z'),
+		    ('2026-04-15', 'GET /api/reservations', $2, 99, '2026-04-15T12:00:00Z')`, busy, quiet)
+	require.NoError(t, err)
+}
+
+func (s *DeprecationSuite) TestReport() {
+	s.Run("Normal case: consumers grouped by route, busiest first", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithCompany().WithUser(string(user.RoleViewer)).WithUser(string(user.RoleViewer)).Build()
+		busy, quiet := sc.Users[0], sc.Users[1]
+		s.seedCalls(t, busy.ID, quiet.ID)
+		var companyName string
+		require.NoError(t, s.DB.QueryRow(context.Background(), `SELECT name FROM companies WHERE id = $1`, sc.CompanyID).Scan(&companyName))
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, reportURL+"?from=2026-03-01&to=2026-03-31", nil, authtest.LoginAs(t, s.Router, admin.User))
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var report response.DeprecationReportResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &report))
+
+		assert.Equal(t, "2026-03-01", report.From)
+		assert.Equal(t, "2026-03-31", report.To)
+		require.Len(t, report.Consumers, 3)
+
+		first := report.Consumers[0]
+		assert.Equal(t, "GET /api/reservations", first.Route)
+		assert.Equal(t, &busy.ID, first.UserID)
+		assert.Equal(t, &busy.Email, first.Email)
+		assert.Equal(t, &sc.CompanyID, first.CompanyID)
+		assert.Equal(t, &companyName, first.CompanyName)
+		assert.Equal(t, int64(15), first.Calls)
+		assert.Equal(t, "2026-03-02T08:00:00Z", first.LastCalledAt.UTC().Format("2006-01-02T15:04:05Z07:00"))
+
+		assert.Equal(t, &quiet.ID, report.Consumers[1].UserID)
+		assert.Equal(t, int64(1), report.Consumers[1].Calls)
+
+		anonymous := report.Consumers[2]
+		assert.Equal(t, "GET /api/reviews/:id", anonymous.Route)
+		assert.Nil(t, anonymous.UserID)
+		assert.Nil(t, anonymous.CompanyID)
+		assert.Equal(t, int64(7), anonymous.Calls)
+	})
+
+	s.Run("Normal case: report narrowed to one route", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithCompany().WithUser(string(user.RoleViewer)).WithUser(string(user.RoleViewer)).Build()
+		s.seedCalls(t, sc.Users[0].ID, sc.Users[1].ID)
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, reportURL+"?from=2026-03-01&to=2026-04-30&route="+url.QueryEscape("GET /api/reservations"), nil, authtest.LoginAs(t, s.Router, admin.User))
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var report response.DeprecationReportResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &report))
+
+		require.Len(t, report.Consumers, 2)
+		assert.Equal(t, &sc.Users[1].ID, report.Consumers[0].UserID)
+		assert.Equal(t, int64(100), report.Consumers[0].Calls)
+		assert.Equal(t, int64(15), report.Consumers[1].Calls)
+	})
+
+	s.Run("Error case: reversed range is rejected", func() {
+		t := s.T()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, reportURL+"?from=2026-04-01&to=2026-03-01", nil, authtest.LoginAs(t, s.Router, admin.User))
+		httptest.AssertErrorCode(t, w, http.StatusBadRequest, "INVALID_DEPRECATION_REPORT_RANGE")
+	})
+
+	s.Run("Error case: malformed date is rejected", func() {
+		t := s.T()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, reportURL+"?to=03/01/2026", nil, authtest.LoginAs(t, s.Router, admin.User))
+		httptest.AssertErrorCode(t, w, http.StatusBadRequest, "INVALID_DEPRECATION_REPORT_DATE")
+	})
+
+	s.Run("Error case: viewers cannot read the report", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithCompany().WithUser(string(user.RoleViewer)).Build()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, reportURL, nil, authtest.LoginAs(t, s.Router, sc.User))
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "PERMISSION_DENIED")
+	})
+}
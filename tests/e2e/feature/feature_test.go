@@ -0,0 +1,139 @@
+//go:build e2e
+
+package feature_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/tests/common/authtest"
+	"gin-clean-starter/tests/common/builder"
+	"gin-clean-starter/tests/common/dbtest"
+	"gin-clean-starter/tests/common/httptest"
+	"gin-clean-starter/tests/e2e"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	featuresURL = "/api/admin/companies/%s/features"
+	moderation  = "review_moderation"
+)
+
+type FeatureSuite struct {
+	e2e.SharedSuite
+}
+
+func (s *FeatureSuite) SetupSubTest() {
+	s.SharedSuite.SetupSubTest()
+}
+
+func TestFeatureSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(FeatureSuite))
+}
+
+func (s *FeatureSuite) listFeatures(t *testing.T, token string, companyID uuid.UUID) map[string]*response.FeatureStateResponse {
+	t.Helper()
+
+	w := httptest.PerformRequest(t, s.Router, http.MethodGet, fmt.Sprintf(featuresURL, companyID), nil, token)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var states []*response.FeatureStateResponse
+	require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &states))
+	byName := make(map[string]*response.FeatureStateResponse, len(states))
+	for _, st := range states {
+		byName[st.Feature] = st
+	}
+	return byName
+}
+
+func (s *FeatureSuite) TestCompanyFeatures() {
+	s.Run("Normal case: a company setting overrides the default until reset", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithCompany().Build()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+		token := authtest.LoginAs(t, s.Router, admin.User)
+
+		states := s.listFeatures(t, token, sc.CompanyID)
+		require.Equal(t, &response.FeatureStateResponse{Feature: moderation, Enabled: true, Source: "default"}, states[moderation])
+
+		url := fmt.Sprintf(featuresURL, sc.CompanyID) + "/" + moderation
+		w := httptest.PerformRequest(t, s.Router, http.MethodPut, url, map[string]any{"enabled": false}, token)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		states = s.listFeatures(t, token, sc.CompanyID)
+		require.Equal(t, &response.FeatureStateResponse{Feature: moderation, Enabled: false, Source: "company"}, states[moderation])
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodDelete, url, nil, token)
+		require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+		states = s.listFeatures(t, token, sc.CompanyID)
+		require.Equal(t, "default", states[moderation].Source)
+		require.True(t, states[moderation].Enabled)
+	})
+
+	s.Run("Error case: unknown features, unknown companies and missing permission are rejected", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithCompany().WithUser(string(user.RoleViewer)).Build()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+		token := authtest.LoginAs(t, s.Router, admin.User)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPut, fmt.Sprintf(featuresURL, sc.CompanyID)+"/review_teleport", map[string]any{"enabled": true}, token)
+		httptest.AssertErrorCode(t, w, http.StatusBadRequest, "UNKNOWN_FEATURE")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPut, fmt.Sprintf(featuresURL, uuid.New())+"/"+moderation, map[string]any{"enabled": true}, token)
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "COMPANY_NOT_FOUND")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, fmt.Sprintf(featuresURL, uuid.New()), nil, token)
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "COMPANY_NOT_FOUND")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, fmt.Sprintf(featuresURL, sc.CompanyID), nil, authtest.LoginAs(t, s.Router, sc.User))
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "PERMISSION_DENIED")
+	})
+}
+
+func (s *FeatureSuite) TestReviewModerationRollout() {
+	s.Run("Normal case: without review moderation duplicates are published and the queue is closed", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).
+			WithCompany().
+			WithUser(string(user.RoleViewer)).
+			WithResource().WithCompletedReservation().WithCompletedReservation().
+			Build()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+		adminToken := authtest.LoginAs(t, s.Router, admin.User)
+		token := authtest.LoginAs(t, s.Router, sc.User)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPut, fmt.Sprintf(featuresURL, sc.CompanyID)+"/"+moderation, map[string]any{"enabled": false}, adminToken)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		for _, reservationID := range sc.ReservationIDs {
+			req := builder.NewReviewBuilder().
+				WithResourceID(sc.ResourceID).
+				WithReservationID(reservationID).
+				WithRating(4).
+				WithComment("Quiet room with a big whiteboard and plenty of light").
+				BuildCreateRequestDTO()
+			w = httptest.PerformRequest(t, s.Router, http.MethodPost, "/api/reviews", req, token)
+			require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+		}
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, fmt.Sprintf("/api/resources/%s/rating-stats", sc.ResourceID), nil, "")
+		var stats response.ResourceRatingStatsResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &stats))
+		require.Equal(t, int32(2), stats.TotalReviews, "the copy is published, not held")
+
+		flaggedURL := fmt.Sprintf("/api/admin/resources/%s/reviews/flagged", sc.ResourceID)
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, flaggedURL, nil, adminToken)
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "FEATURE_NOT_ENABLED")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodDelete, fmt.Sprintf(featuresURL, sc.CompanyID)+"/"+moderation, nil, adminToken)
+		require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, flaggedURL, nil, adminToken)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	})
+}
@@ -0,0 +1,118 @@
+//go:build e2e
+
+package maintenance_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/tests/common/authtest"
+	"gin-clean-starter/tests/common/dbtest"
+	"gin-clean-starter/tests/common/httptest"
+	"gin-clean-starter/tests/e2e"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const cursorsURL = "/api/admin/maintenance/cursors"
+
+type MaintenanceSuite struct {
+	e2e.SharedSuite
+}
+
+func (s *MaintenanceSuite) SetupSubTest() {
+	s.SharedSuite.SetupSubTest()
+}
+
+func TestMaintenanceSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(MaintenanceSuite))
+}
+
+// seedFutureCursors leaves one cursor of each kind a day ahead of the clock, as a
+// migration that shifted timestamps would.
+func (s *MaintenanceSuite) seedFutureCursors(t *testing.T, sc *dbtest.ScenarioFixtures) {
+	t.Helper()
+
+	ctx := context.Background()
+	_, err := s.DB.Exec(ctx,
+		`INSERT INTO subscriptions (company_id, plan_id, status, provider_updated_at) VALUES ($1, 'pro', 'active', now() + interval '1 day')`,
+		sc.CompanyID)
+	require.NoError(t, err)
+	_, err = s.DB.Exec(ctx,
+		`INSERT INTO reservation_message_reads (reservation_id, side, last_read_at) VALUES ($1, 'user', now() + interval '1 day')`,
+		sc.ReservationID)
+	require.NoError(t, err)
+	_, err = s.DB.Exec(ctx,
+		`INSERT INTO notification_jobs (kind, topic, payload, run_at, status) VALUES ('email', 'test', '{}', now() + interval '1 day', 'queued')`)
+	require.NoError(t, err)
+}
+
+func (s *MaintenanceSuite) checkCursors(t *testing.T, token, query string) map[string]response.CursorCheckItemResponse {
+	t.Helper()
+
+	w := httptest.PerformRequest(t, s.Router, http.MethodPost, cursorsURL+query, nil, token)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var res response.CursorCheckResponse
+	require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &res))
+	byCursor := make(map[string]response.CursorCheckItemResponse, len(res.Cursors))
+	for _, c := range res.Cursors {
+		byCursor[c.Cursor] = c
+	}
+	return byCursor
+}
+
+func (s *MaintenanceSuite) TestCursors() {
+	s.Run("Normal case: a dry run reports future cursors and a repair fixes them", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithCompany().WithUser(string(user.RoleViewer)).WithResource().WithUpcomingReservation().Build()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+		token := authtest.LoginAs(t, s.Router, admin.User)
+		s.seedFutureCursors(t, sc)
+
+		checks := s.checkCursors(t, token, "")
+		require.Len(t, checks, 3)
+		for name, c := range checks {
+			assert.Equal(t, int64(1), c.Invalid, name)
+			assert.Zero(t, c.Repaired, name)
+		}
+
+		checks = s.checkCursors(t, token, "?repair=true")
+		for name, c := range checks {
+			assert.Equal(t, int64(1), c.Repaired, name)
+		}
+
+		ctx := context.Background()
+		var watermark *time.Time
+		require.NoError(t, s.DB.QueryRow(ctx, `SELECT provider_updated_at FROM subscriptions WHERE company_id = $1`, sc.CompanyID).Scan(&watermark))
+		assert.Nil(t, watermark, "webhook watermark should be cleared")
+		var lastReadAt time.Time
+		require.NoError(t, s.DB.QueryRow(ctx, `SELECT last_read_at FROM reservation_message_reads WHERE reservation_id = $1`, sc.ReservationID).Scan(&lastReadAt))
+		assert.WithinDuration(t, time.Now(), lastReadAt, time.Minute)
+		var audited int
+		require.NoError(t, s.DB.QueryRow(ctx, `SELECT COUNT(*) FROM audit_logs WHERE action = 'maintenance.cursors_repaired' AND actor_id = $1`, admin.User.ID).Scan(&audited))
+		assert.Equal(t, 3, audited)
+
+		for name, c := range s.checkCursors(t, token, "") {
+			assert.Zero(t, c.Invalid, name)
+		}
+	})
+
+	s.Run("Error case: invalid repair flag and missing permission are rejected", func() {
+		t := s.T()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+		viewer := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, cursorsURL+"?repair=maybe", nil, authtest.LoginAs(t, s.Router, admin.User))
+		httptest.AssertErrorCode(t, w, http.StatusBadRequest, "INVALID_REPAIR_FLAG")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, cursorsURL, nil, authtest.LoginAs(t, s.Router, viewer.User))
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "PERMISSION_DENIED")
+	})
+}
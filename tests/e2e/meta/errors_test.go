@@ -0,0 +1,62 @@
+//go:build e2e
+
+package meta_test
+
+import (
+	"net/http"
+	"testing"
+
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/httperr"
+	"gin-clean-starter/internal/pkg/errs"
+	"gin-clean-starter/tests/common/httptest"
+	"gin-clean-starter/tests/e2e"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const errorsURL = "/api/meta/errors"
+
+type MetaSuite struct {
+	e2e.SharedSuite
+}
+
+func TestMetaSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(MetaSuite))
+}
+
+func (s *MetaSuite) TestErrorCatalog() {
+	s.Run("Normal case: every registered code with its statuses, without signing in", func() {
+		t := s.T()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, errorsURL, nil, "")
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		assert.Equal(t, "public, max-age=3600", w.Header().Get("Cache-Control"))
+		var catalog response.ErrorCatalogResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &catalog))
+
+		require.Len(t, catalog.Errors, len(errs.Registry))
+		byCode := map[string]response.ErrorCodeResponse{}
+		for _, e := range catalog.Errors {
+			byCode[e.Code] = e
+		}
+		assert.Equal(t, response.ErrorCodeResponse{Code: "RESERVATION_NOT_FOUND", Description: "reservation not found", Statuses: []int{404}}, byCode["RESERVATION_NOT_FOUND"])
+		assert.Equal(t, []int{500}, byCode["INTERNAL_ERROR"].Statuses)
+		assert.Equal(t, []int{429}, byCode["RATE_LIMITED"].Statuses)
+	})
+
+	s.Run("Normal case: a reported code is in the catalog with the reply's status", func() {
+		t := s.T()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, "/api/auth/me", nil, "")
+		require.Equal(t, http.StatusUnauthorized, w.Code)
+		var reply httperr.Response
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &reply))
+		info, ok := errs.Lookup(reply.Error.Code)
+		require.True(t, ok, reply.Error.Code)
+		assert.Contains(t, info.Statuses, http.StatusUnauthorized)
+	})
+}
@@ -0,0 +1,119 @@
+//go:build e2e
+
+package notificationprefs_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/tests/common/authtest"
+	"gin-clean-starter/tests/common/dbtest"
+	"gin-clean-starter/tests/common/httptest"
+	"gin-clean-starter/tests/e2e"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	preferencesURL  = "/api/users/me/notification-preferences"
+	reservationsURL = "/api/reservations"
+)
+
+type NotificationPreferencesSuite struct {
+	e2e.SharedSuite
+}
+
+func (s *NotificationPreferencesSuite) SetupSubTest() {
+	s.SharedSuite.SetupSubTest()
+}
+
+func TestNotificationPreferencesSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(NotificationPreferencesSuite))
+}
+
+func (s *NotificationPreferencesSuite) preferences(t *testing.T, token string) response.NotificationPreferencesResponse {
+	t.Helper()
+
+	w := httptest.PerformRequest(t, s.Router, http.MethodGet, preferencesURL, nil, token)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var prefs response.NotificationPreferencesResponse
+	require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &prefs))
+	return prefs
+}
+
+func (s *NotificationPreferencesSuite) TestPreferences() {
+	s.Run("Normal case: the digest is off until the user picks a period", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+		token := authtest.LoginAs(t, s.Router, sc.User)
+
+		assert.Equal(t, response.NotificationPreferencesResponse{Digest: "off"}, s.preferences(t, token))
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPut, preferencesURL, request.UpdateNotificationPreferencesRequest{Digest: "weekly"}, token)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var updated response.NotificationPreferencesResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &updated))
+		assert.Equal(t, "weekly", updated.Digest)
+		assert.Equal(t, "weekly", s.preferences(t, token).Digest)
+	})
+
+	s.Run("Error case: unknown periods are rejected", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+		token := authtest.LoginAs(t, s.Router, sc.User)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPut, preferencesURL, request.UpdateNotificationPreferencesRequest{Digest: "hourly"}, token)
+		httptest.AssertErrorCode(t, w, http.StatusBadRequest, "INVALID_NOTIFICATION_DIGEST")
+		assert.Equal(t, "off", s.preferences(t, token).Digest)
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, preferencesURL, nil, "")
+		assert.Equal(t, http.StatusUnauthorized, w.Code, w.Body.String())
+	})
+}
+
+func (s *NotificationPreferencesSuite) TestHeldNotifications() {
+	s.Run("Normal case: a digest holds confirmations but not cancellations", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).WithResource().Build()
+		token := authtest.LoginAs(t, s.Router, sc.User)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPut, preferencesURL, request.UpdateNotificationPreferencesRequest{Digest: "daily"}, token)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		start := time.Now().UTC().Truncate(time.Hour).Add(72 * time.Hour)
+		w = httptest.PerformRequestWithHeaders(t, s.Router, http.MethodPost, reservationsURL, request.CreateReservationRequest{
+			ResourceID: sc.ResourceID,
+			StartTime:  start,
+			EndTime:    start.Add(time.Hour),
+		}, token, map[string]string{"Idempotency-Key": uuid.NewString()})
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+		var created response.ReservationResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &created))
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, fmt.Sprintf("%s/%s/cancel", reservationsURL, created.ID), nil, token)
+		require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+
+		statuses := map[string]string{}
+		rows, err := s.DB.Query(context.Background(), `SELECT topic, status FROM notification_jobs WHERE user_id = $1`, sc.User.ID)
+		require.NoError(t, err)
+		defer rows.Close()
+		for rows.Next() {
+			var topic, status string
+			require.NoError(t, rows.Scan(&topic, &status))
+			statuses[topic] = status
+		}
+		require.NoError(t, rows.Err())
+		assert.Equal(t, map[string]string{"reservation_created": "held", "reservation_canceled": "queued"}, statuses)
+		assert.Equal(t, int64(1), s.preferences(t, token).HeldNotifications)
+	})
+}
@@ -0,0 +1,166 @@
+//go:build e2e
+
+package oidc_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	nethttptest "net/http/httptest"
+	"net/url"
+	"testing"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/api"
+	"gin-clean-starter/tests/common/dbtest"
+	"gin-clean-starter/tests/common/httptest"
+	"gin-clean-starter/tests/common/oidctest"
+	"gin-clean-starter/tests/e2e"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	// provider is the test config's OIDC_PROVIDER_NAME, served by oidctest.Shared.
+	provider = "oidc"
+	oidcURL  = "/api/auth/oidc/%s"
+	meURL    = "/api/auth/me"
+)
+
+type OIDCSuite struct {
+	e2e.SharedSuite
+}
+
+func (s *OIDCSuite) SetupSubTest() {
+	s.SharedSuite.SetupSubTest()
+}
+
+func TestOIDCSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(OIDCSuite))
+}
+
+// start follows the sign-in redirect and returns the provider URL and the state cookie.
+func (s *OIDCSuite) start(t *testing.T) (string, *http.Cookie) {
+	t.Helper()
+	w := httptest.PerformRequest(t, s.Router, http.MethodGet, fmt.Sprintf(oidcURL, provider)+"/start", nil, "")
+	require.Equal(t, http.StatusFound, w.Code, w.Body.String())
+	state := httptest.ExtractCookie(w, api.OIDCStateCookieName)
+	require.NotNil(t, state)
+	assert.Equal(t, "/api/auth/oidc/"+provider+"/callback", state.Path)
+	return w.Header().Get("Location"), state
+}
+
+func (s *OIDCSuite) callback(t *testing.T, callbackURL string, state *http.Cookie) *nethttptest.ResponseRecorder {
+	t.Helper()
+	u, err := url.Parse(callbackURL)
+	require.NoError(t, err)
+	req := nethttptest.NewRequest(http.MethodGet, u.RequestURI(), nil)
+	if state != nil {
+		req.AddCookie(state)
+	}
+	w := nethttptest.NewRecorder()
+	s.Router.ServeHTTP(w, req)
+	return w
+}
+
+// signIn runs a whole sign-in of u in one browser.
+func (s *OIDCSuite) signIn(t *testing.T, u oidctest.User) *nethttptest.ResponseRecorder {
+	t.Helper()
+	authURL, state := s.start(t)
+	return s.callback(t, oidctest.Shared().Authorize(t, authURL, u), state)
+}
+
+func newUser() oidctest.User {
+	id := uuid.NewString()[:8]
+	return oidctest.User{Subject: "sub-" + id, Email: fmt.Sprintf("oidc-%s@example.com", id), EmailVerified: true}
+}
+
+func (s *OIDCSuite) TestLogin() {
+	s.Run("Normal case: a new identity gets a viewer account and signs into it again", func() {
+		t := s.T()
+		u := newUser()
+
+		w := s.signIn(t, u)
+		require.Equal(t, http.StatusSeeOther, w.Code, w.Body.String())
+		assert.Equal(t, "http://localhost:3000/", w.Header().Get("Location"))
+		access := httptest.ExtractCookie(w, "access_token")
+		require.NotNil(t, access)
+		require.NotNil(t, httptest.ExtractCookie(w, "refresh_token"))
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, meURL, nil, access.Value)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		assert.Contains(t, w.Body.String(), u.Email)
+
+		w = s.signIn(t, u)
+		require.Equal(t, http.StatusSeeOther, w.Code, w.Body.String())
+
+		ctx := context.Background()
+		var role string
+		var accounts int
+		require.NoError(t, s.DB.QueryRow(ctx, `SELECT role, count(*) OVER () FROM users WHERE email = $1`, u.Email).Scan(&role, &accounts))
+		assert.Equal(t, string(user.RoleViewer), role)
+		assert.Equal(t, 1, accounts)
+
+		var provisioned, returning int
+		require.NoError(t, s.DB.QueryRow(ctx,
+			`SELECT count(*) FILTER (WHERE metadata->>'provisioned' = 'true'), count(*) FILTER (WHERE metadata->>'provisioned' = 'false')
+			 FROM audit_logs WHERE action = 'auth.oidc_login' AND target_id = (SELECT id::text FROM users WHERE email = $1)`,
+			u.Email).Scan(&provisioned, &returning))
+		assert.Equal(t, 1, provisioned)
+		assert.Equal(t, 1, returning)
+	})
+
+	s.Run("Error case: a callback without this browser's state is rejected", func() {
+		t := s.T()
+		u := newUser()
+		authURL, _ := s.start(t)
+		_, otherState := s.start(t)
+
+		w := s.callback(t, oidctest.Shared().Authorize(t, authURL, u), nil)
+		httptest.AssertErrorCode(t, w, http.StatusBadRequest, "OIDC_STATE_INVALID")
+
+		authURL, _ = s.start(t)
+		w = s.callback(t, oidctest.Shared().Authorize(t, authURL, u), otherState)
+		httptest.AssertErrorCode(t, w, http.StatusBadRequest, "OIDC_STATE_INVALID")
+
+		var count int
+		require.NoError(t, s.DB.QueryRow(context.Background(), `SELECT count(*) FROM users WHERE email = $1`, u.Email).Scan(&count))
+		assert.Zero(t, count)
+	})
+
+	s.Run("Error case: unverified and existing emails get no account", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+
+		unverified := newUser()
+		unverified.EmailVerified = false
+		w := s.signIn(t, unverified)
+		httptest.AssertErrorCode(t, w, http.StatusUnauthorized, "OIDC_EMAIL_UNVERIFIED")
+
+		taken := newUser()
+		taken.Email = sc.User.Email
+		w = s.signIn(t, taken)
+		httptest.AssertErrorCode(t, w, http.StatusConflict, "OIDC_EMAIL_TAKEN")
+
+		var linked int
+		require.NoError(t, s.DB.QueryRow(context.Background(), `SELECT count(*) FROM user_identities WHERE user_id = $1`, sc.User.ID).Scan(&linked))
+		assert.Zero(t, linked)
+	})
+
+	s.Run("Error case: the provider reports the sign-in was denied", func() {
+		t := s.T()
+		_, state := s.start(t)
+		w := s.callback(t, fmt.Sprintf(oidcURL, provider)+"/callback?error=access_denied", state)
+		httptest.AssertErrorCode(t, w, http.StatusUnauthorized, "OIDC_AUTHORIZATION_DENIED")
+	})
+
+	s.Run("Error case: unknown providers are not found", func() {
+		t := s.T()
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, fmt.Sprintf(oidcURL, "google")+"/start", nil, "")
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "OIDC_PROVIDER_NOT_FOUND")
+	})
+}
@@ -0,0 +1,193 @@
+//go:build e2e
+
+package provisioning_test
+
+import (
+	"fmt"
+	"net/http"
+	nethttptest "net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/internal/handler/dto/scim"
+	"gin-clean-starter/tests/common/authtest"
+	"gin-clean-starter/tests/common/dbtest"
+	"gin-clean-starter/tests/common/httptest"
+	"gin-clean-starter/tests/e2e"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	tokensURL = "/api/admin/companies/%s/provisioning-tokens"
+	usersURL  = "/api/scim/v2/Users"
+	loginURL  = "/api/auth/login"
+)
+
+type ProvisioningSuite struct {
+	e2e.SharedSuite
+}
+
+func (s *ProvisioningSuite) SetupSubTest() {
+	s.SharedSuite.SetupSubTest()
+}
+
+func TestProvisioningSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ProvisioningSuite))
+}
+
+// issueToken issues a provisioning token for companyID as a fresh admin.
+func (s *ProvisioningSuite) issueToken(t *testing.T, companyID uuid.UUID) response.ProvisioningTokenResponse {
+	t.Helper()
+	admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+	w := httptest.PerformRequest(t, s.Router, http.MethodPost, fmt.Sprintf(tokensURL, companyID), nil, authtest.LoginAs(t, s.Router, admin.User))
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var issued response.ProvisioningTokenResponse
+	require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &issued))
+	return issued
+}
+
+// assertSCIMError checks a SCIM error body; scimType is empty for errors that carry none.
+func assertSCIMError(t *testing.T, w *nethttptest.ResponseRecorder, status int, scimType string) {
+	t.Helper()
+	require.Equal(t, status, w.Code, w.Body.String())
+	assert.Equal(t, scim.ContentType, w.Header().Get("Content-Type"))
+	var body scim.ErrorResponse
+	require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &body))
+	assert.Equal(t, []string{scim.SchemaError}, body.Schemas)
+	assert.Equal(t, strconv.Itoa(status), body.Status)
+	assert.Equal(t, scimType, body.ScimType)
+}
+
+func (s *ProvisioningSuite) TestUserLifecycle() {
+	s.Run("Normal case: a provisioned user is listed, deactivated and reactivated", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithCompany().Build()
+		token := s.issueToken(t, sc.CompanyID).Token
+		assert.True(t, strings.HasPrefix(token, "scim_"))
+		email := fmt.Sprintf("scim-%s@example.com", uuid.NewString()[:8])
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, usersURL, scim.CreateUserRequest{
+			Schemas:    []string{scim.SchemaUser},
+			UserName:   email,
+			ExternalID: "00u-" + email,
+			Password:   "password123",
+		}, token)
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+		assert.Equal(t, scim.ContentType, w.Header().Get("Content-Type"))
+		var created scim.User
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &created))
+		assert.Equal(t, email, created.UserName)
+		assert.Equal(t, "00u-"+email, created.ExternalID)
+		assert.True(t, created.Active)
+
+		filter := url.QueryEscape(fmt.Sprintf("externalId eq %q", "00u-"+email))
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, usersURL+"?filter="+filter, nil, token)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var list scim.ListResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &list))
+		require.EqualValues(t, 1, list.TotalResults)
+		assert.Equal(t, created.ID, list.Resources[0].ID)
+
+		login := request.LoginRequest{Email: email, Password: "password123"}
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, loginURL, login, "")
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPatch, usersURL+"/"+created.ID, scim.PatchUserRequest{
+			Schemas:    []string{scim.SchemaPatchOp},
+			Operations: []scim.PatchOperation{{Op: "Replace", Value: map[string]any{"active": "False"}}},
+		}, token)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var patched scim.User
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &patched))
+		assert.False(t, patched.Active)
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, loginURL, login, "")
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "USER_INACTIVE")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPatch, usersURL+"/"+created.ID, scim.PatchUserRequest{
+			Operations: []scim.PatchOperation{{Op: "replace", Path: "active", Value: true}},
+		}, token)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodDelete, usersURL+"/"+created.ID, nil, token)
+		require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, usersURL+"/"+created.ID, nil, token)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &patched))
+		assert.False(t, patched.Active)
+	})
+
+	s.Run("Error case: duplicates, other companies' users and unsupported requests are rejected", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithCompany().Build()
+		other := dbtest.Scenario(t, s.DB).WithCompany().WithUser(string(user.RoleViewer)).Build()
+		token := s.issueToken(t, sc.CompanyID).Token
+		email := fmt.Sprintf("scim-%s@example.com", uuid.NewString()[:8])
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, usersURL, scim.CreateUserRequest{UserName: email, ExternalID: "dup"}, token)
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, usersURL, scim.CreateUserRequest{UserName: "x" + email, ExternalID: "dup"}, token)
+		assertSCIMError(t, w, http.StatusConflict, scim.ErrorTypeUniqueness)
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, usersURL, scim.CreateUserRequest{UserName: other.User.Email}, token)
+		assertSCIMError(t, w, http.StatusConflict, scim.ErrorTypeUniqueness)
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, usersURL, scim.CreateUserRequest{UserName: "not-an-email"}, token)
+		assertSCIMError(t, w, http.StatusBadRequest, scim.ErrorTypeInvalidValue)
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, usersURL+"/"+other.User.ID.String(), nil, token)
+		assertSCIMError(t, w, http.StatusNotFound, "")
+		w = httptest.PerformRequest(t, s.Router, http.MethodDelete, usersURL+"/"+other.User.ID.String(), nil, token)
+		assertSCIMError(t, w, http.StatusNotFound, "")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, usersURL+"?filter="+url.QueryEscape(`name.givenName co "a"`), nil, token)
+		assertSCIMError(t, w, http.StatusBadRequest, scim.ErrorTypeInvalidFilter)
+		w = httptest.PerformRequest(t, s.Router, http.MethodPatch, usersURL+"/"+uuid.NewString(), scim.PatchUserRequest{
+			Operations: []scim.PatchOperation{{Op: "replace", Path: "userName", Value: "a@example.com"}},
+		}, token)
+		assertSCIMError(t, w, http.StatusBadRequest, scim.ErrorTypeInvalidPath)
+	})
+}
+
+func (s *ProvisioningSuite) TestTokens() {
+	s.Run("Normal case: a revoked token is rejected", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithCompany().Build()
+		issued := s.issueToken(t, sc.CompanyID)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, usersURL, nil, issued.Token)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+		revokeURL := fmt.Sprintf(tokensURL, sc.CompanyID) + "/" + issued.ID.String()
+		w = httptest.PerformRequest(t, s.Router, http.MethodDelete, revokeURL, nil, authtest.LoginAs(t, s.Router, admin.User))
+		require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, usersURL, nil, issued.Token)
+		assertSCIMError(t, w, http.StatusUnauthorized, "")
+		w = httptest.PerformRequest(t, s.Router, http.MethodDelete, revokeURL, nil, authtest.LoginAs(t, s.Router, admin.User))
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "PROVISIONING_TOKEN_NOT_FOUND")
+	})
+
+	s.Run("Error case: missing tokens, user tokens and missing permission are rejected", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithCompany().WithUser(string(user.RoleViewer)).Build()
+		userToken := authtest.LoginAs(t, s.Router, sc.User)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, usersURL, nil, "")
+		assertSCIMError(t, w, http.StatusUnauthorized, "")
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, usersURL, nil, userToken)
+		assertSCIMError(t, w, http.StatusUnauthorized, "")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, fmt.Sprintf(tokensURL, sc.CompanyID), nil, userToken)
+		require.Equal(t, http.StatusForbidden, w.Code, w.Body.String())
+	})
+}
@@ -0,0 +1,94 @@
+//go:build e2e
+
+package publicresource_test
+
+import (
+	"net/http"
+	"testing"
+
+	"gin-clean-starter/internal/domain/resource"
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/tests/common/dbtest"
+	"gin-clean-starter/tests/common/httptest"
+	"gin-clean-starter/tests/e2e"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	publicResourcesURL = "/api/public/resources/"
+	sitemapURL         = "/sitemap.xml"
+	siteURL            = "https://example.com"
+)
+
+type PublicResourceSuite struct {
+	e2e.SharedSuite
+}
+
+func (s *PublicResourceSuite) SetupSubTest() {
+	s.SharedSuite.SetupSubTest()
+}
+
+func TestPublicResourceSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(PublicResourceSuite))
+}
+
+func (s *PublicResourceSuite) TestGetBySlug() {
+	s.Run("Normal case: page data is found by slug without ids, and revalidates with the ETag", func() {
+		t := s.T()
+
+		sc := dbtest.Scenario(t, s.DB).WithCompany().WithResourceNamed("Harbour View Room", 30).Build()
+		slug := resource.SlugFor("Harbour View Room", sc.ResourceID).String()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, publicResourcesURL+slug, nil, "")
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		assert.Equal(t, "public, max-age=300, stale-while-revalidate=60", w.Header().Get("Cache-Control"))
+		assert.NotEmpty(t, w.Header().Get("Last-Modified"))
+		assert.NotContains(t, w.Body.String(), sc.ResourceID.String())
+
+		var page response.PublicResourceResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &page))
+		assert.Equal(t, slug, page.Slug)
+		assert.Equal(t, siteURL+"/resources/"+slug, page.URL)
+		assert.Equal(t, "Harbour View Room", page.Name)
+		assert.Equal(t, 30, page.LeadTimeMin)
+		assert.Zero(t, page.TotalReviews)
+
+		etag := w.Header().Get("ETag")
+		require.NotEmpty(t, etag)
+		w = httptest.PerformRequestWithHeaders(t, s.Router, http.MethodGet, publicResourcesURL+slug, nil, "", map[string]string{"If-None-Match": etag})
+		assert.Equal(t, http.StatusNotModified, w.Code)
+		assert.Equal(t, "public, max-age=300, stale-while-revalidate=60", w.Header().Get("Cache-Control"))
+	})
+
+	s.Run("Error case: unknown and malformed slugs are not found", func() {
+		t := s.T()
+
+		for _, slug := range []string{"no-such-room-00000000", "Not_A_Slug"} {
+			w := httptest.PerformRequest(t, s.Router, http.MethodGet, publicResourcesURL+slug, nil, "")
+			httptest.AssertErrorCode(t, w, http.StatusNotFound, "RESOURCE_NOT_FOUND")
+			assert.Equal(t, "no-store", w.Header().Get("Cache-Control"), "errors are not cached")
+		}
+	})
+}
+
+func (s *PublicResourceSuite) TestSitemap() {
+	s.Run("Normal case: sitemap lists public resource pages on the public site", func() {
+		t := s.T()
+
+		sc := dbtest.Scenario(t, s.DB).WithCompany().WithResourceNamed("Sitemap Studio", 0).Build()
+		slug := resource.SlugFor("Sitemap Studio", sc.ResourceID).String()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, sitemapURL, nil, "")
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		assert.Equal(t, "application/xml; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Equal(t, "public, max-age=300, stale-while-revalidate=60", w.Header().Get("Cache-Control"))
+		// No replicas configured, so the lag-tolerant read stays on the primary
+		assert.Equal(t, "primary", w.Header().Get("X-Served-By"))
+		assert.Contains(t, w.Body.String(), "<loc>"+siteURL+"/resources/"+slug+"</loc>")
+		assert.NotContains(t, w.Body.String(), sc.ResourceID.String())
+	})
+}
@@ -0,0 +1,54 @@
+//go:build e2e
+
+package publicresource_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/tests/common/httptest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const publicStatsURL = "/api/public/stats"
+
+func (s *PublicResourceSuite) TestPlatformStats() {
+	s.Run("Normal case: stored stats are served cacheable, as of their computation", func() {
+		t := s.T()
+		computedAt := time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+		_, err := s.DB.Exec(context.Background(),
+			`INSERT INTO platform_stats (total_resources, total_reviews, average_rating, computed_at) VALUES (12, 345, 4.27, $1)`, computedAt)
+		require.NoError(t, err)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, publicStatsURL, nil, "")
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		assert.Equal(t, "public, max-age=300, stale-while-revalidate=60", w.Header().Get("Cache-Control"))
+		assert.Equal(t, computedAt.Format(http.TimeFormat), w.Header().Get("Last-Modified"))
+
+		var stats response.PlatformStatsResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &stats))
+		assert.Equal(t, int64(12), stats.TotalResources)
+		assert.Equal(t, int64(345), stats.TotalReviews)
+		assert.InDelta(t, 4.27, stats.AverageRating, 0.001)
+		require.NotNil(t, stats.ComputedAt)
+		assert.True(t, computedAt.Equal(*stats.ComputedAt))
+	})
+
+	s.Run("Normal case: stats not computed yet are zero", func() {
+		t := s.T()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, publicStatsURL, nil, "")
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		assert.Empty(t, w.Header().Get("Last-Modified"))
+
+		var stats response.PlatformStatsResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &stats))
+		assert.Zero(t, stats.TotalResources)
+		assert.Zero(t, stats.TotalReviews)
+		assert.Nil(t, stats.ComputedAt)
+	})
+}
@@ -0,0 +1,112 @@
+//go:build e2e
+
+package referral_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/tests/common/authtest"
+	"gin-clean-starter/tests/common/dbtest"
+	"gin-clean-starter/tests/common/httptest"
+	"gin-clean-starter/tests/e2e"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	myReferralsURL = "/api/users/me/referrals"
+	companiesURL   = "/api/companies"
+)
+
+type ReferralSuite struct {
+	e2e.SharedSuite
+}
+
+func (s *ReferralSuite) SetupSubTest() {
+	s.SharedSuite.SetupSubTest()
+}
+
+func TestReferralSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ReferralSuite))
+}
+
+func (s *ReferralSuite) getReferrals(t *testing.T, token string) response.ReferralOverviewResponse {
+	t.Helper()
+
+	w := httptest.PerformRequest(t, s.Router, http.MethodGet, myReferralsURL, nil, token)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var overview response.ReferralOverviewResponse
+	require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &overview))
+	return overview
+}
+
+func (s *ReferralSuite) TestGetMyReferrals() {
+	s.Run("Normal case: new user has a code and no referrals yet", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+
+		overview := s.getReferrals(t, authtest.LoginAs(t, s.Router, sc.User))
+
+		assert.NotEmpty(t, overview.Code)
+		assert.Zero(t, overview.CreditCents)
+		assert.NotNil(t, overview.Referrals)
+		assert.Empty(t, overview.Referrals)
+	})
+
+	s.Run("Error case: requires authentication", func() {
+		t := s.T()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, myReferralsURL, nil, "")
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func (s *ReferralSuite) TestAttribution() {
+	s.Run("Normal case: registering with a referral code creates a pending referral", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+		token := authtest.LoginAs(t, s.Router, sc.User)
+		code := s.getReferrals(t, token).Code
+
+		lowered := strings.ToLower(code)
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, companiesURL, request.RegisterCompanyRequest{
+			CompanyName:   "Referred Co",
+			AdminEmail:    "founder@referred.example.com",
+			AdminPassword: dbtest.DefaultPassword,
+			ReferralCode:  &lowered,
+		}, "")
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+		overview := s.getReferrals(t, token)
+		require.Len(t, overview.Referrals, 1)
+		assert.Equal(t, "pending", overview.Referrals[0].Status)
+		assert.Equal(t, "f***@referred.example.com", overview.Referrals[0].ReferredEmail)
+		assert.Nil(t, overview.Referrals[0].RewardCents)
+		assert.Nil(t, overview.Referrals[0].RewardedAt)
+	})
+
+	s.Run("Error case: unknown referral code rejects the registration", func() {
+		t := s.T()
+
+		unknown := "NOSUCHCODE1"
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, companiesURL, request.RegisterCompanyRequest{
+			CompanyName:   "Unreferred Co",
+			AdminEmail:    "founder@unreferred.example.com",
+			AdminPassword: dbtest.DefaultPassword,
+			ReferralCode:  &unknown,
+		}, "")
+		httptest.AssertErrorCode(t, w, http.StatusBadRequest, "INVALID_REFERRAL_CODE")
+
+		login := httptest.PerformRequest(t, s.Router, http.MethodPost, "/api/auth/login",
+			request.LoginRequest{Email: "founder@unreferred.example.com", Password: dbtest.DefaultPassword}, "")
+		assert.Equal(t, http.StatusUnauthorized, login.Code, "registration must roll back on an invalid code")
+	})
+}
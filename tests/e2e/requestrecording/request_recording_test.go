@@ -0,0 +1,208 @@
+//go:build e2e
+
+package requestrecording_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/tests/common/authtest"
+	"gin-clean-starter/tests/common/dbtest"
+	"gin-clean-starter/tests/common/httptest"
+	"gin-clean-starter/tests/e2e"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type RequestRecordingSuite struct {
+	e2e.SharedSuite
+}
+
+func (s *RequestRecordingSuite) SetupSubTest() {
+	s.SharedSuite.SetupSubTest()
+}
+
+func TestRequestRecordingSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(RequestRecordingSuite))
+}
+
+func enableURL(userID uuid.UUID) string {
+	return "/api/admin/users/" + userID.String() + "/request-recordings"
+}
+
+func recordingURL(id uuid.UUID) string {
+	return "/api/admin/request-recordings/" + id.String()
+}
+
+func (s *RequestRecordingSuite) enable(t *testing.T, adminToken string, userID uuid.UUID, maxRequests int32) response.RequestRecordingResponse {
+	t.Helper()
+
+	w := httptest.PerformRequest(t, s.Router, http.MethodPost, enableURL(userID), request.EnableRequestRecordingRequest{
+		MaxRequests:      maxRequests,
+		Reason:           "customer reports an empty profile",
+		ConsentReference: "TICKET-4711",
+	}, adminToken)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var rec response.RequestRecordingResponse
+	require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &rec))
+	return rec
+}
+
+func (s *RequestRecordingSuite) TestRecording() {
+	s.Run("Normal case: the user's next requests are captured with secrets masked", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+		adminToken := authtest.LoginAs(t, s.Router, admin.User)
+		userToken := authtest.LoginAs(t, s.Router, sc.User)
+
+		rec := s.enable(t, adminToken, sc.User.ID, 5)
+		assert.Equal(t, sc.User.ID, rec.UserID)
+		assert.Equal(t, admin.User.ID, rec.EnabledBy)
+		assert.Equal(t, int32(5), rec.MaxRequests)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, "/api/auth/me?token=abc&lang=en", nil, userToken)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, recordingURL(rec.ID), nil, adminToken)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var detail response.RequestRecordingDetailResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &detail))
+		assert.Equal(t, sc.User.Email, detail.UserEmail)
+		assert.Equal(t, "TICKET-4711", detail.ConsentReference)
+		assert.Equal(t, int32(1), detail.RecordedCount)
+		require.Len(t, detail.Requests, 1)
+		assert.Equal(t, int32(1), detail.Requests[0].Seq)
+		assert.Equal(t, http.MethodGet, detail.Requests[0].Method)
+		assert.Equal(t, "/api/auth/me", detail.Requests[0].Path)
+		assert.Equal(t, int32(http.StatusOK), detail.Requests[0].Status)
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, recordingURL(rec.ID)+"/requests/1", nil, adminToken)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var doc struct {
+			Query   string `json:"query"`
+			Status  int    `json:"status"`
+			Request struct {
+				Headers map[string][]string `json:"headers"`
+			} `json:"request"`
+			Response struct {
+				Body map[string]any `json:"body"`
+			} `json:"response"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &doc))
+		assert.Equal(t, "lang=en&token=%5BREDACTED%5D", doc.Query)
+		assert.Equal(t, http.StatusOK, doc.Status)
+		assert.Equal(t, []string{"[REDACTED]"}, doc.Request.Headers["Authorization"])
+		assert.Contains(t, fmt.Sprint(doc.Response.Body), sc.User.Email)
+
+		var consent string
+		require.NoError(t, s.DB.QueryRow(context.Background(),
+			`SELECT metadata->>'consent_reference' FROM audit_logs WHERE action = 'request_recording.enabled' AND actor_id = $1 AND target_id = $2`,
+			admin.User.ID, sc.User.ID.String()).Scan(&consent))
+		assert.Equal(t, "TICKET-4711", consent)
+	})
+
+	s.Run("Normal case: recording stops after maxRequests", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+		adminToken := authtest.LoginAs(t, s.Router, admin.User)
+		userToken := authtest.LoginAs(t, s.Router, sc.User)
+
+		rec := s.enable(t, adminToken, sc.User.ID, 1)
+		for range 3 {
+			w := httptest.PerformRequest(t, s.Router, http.MethodGet, "/api/auth/me", nil, userToken)
+			require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		}
+
+		var captures int
+		require.NoError(t, s.DB.QueryRow(context.Background(), `SELECT COUNT(*) FROM recorded_requests WHERE recording_id = $1`, rec.ID).Scan(&captures))
+		assert.Equal(t, 1, captures)
+	})
+
+	s.Run("Normal case: expired recordings capture nothing", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+		adminToken := authtest.LoginAs(t, s.Router, admin.User)
+		userToken := authtest.LoginAs(t, s.Router, sc.User)
+
+		rec := s.enable(t, adminToken, sc.User.ID, 5)
+		_, err := s.DB.Exec(context.Background(), `UPDATE request_recordings SET expires_at = now() - interval '1 minute' WHERE id = $1`, rec.ID)
+		require.NoError(t, err)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, "/api/auth/me", nil, userToken)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		var captures int
+		require.NoError(t, s.DB.QueryRow(context.Background(), `SELECT COUNT(*) FROM recorded_requests WHERE recording_id = $1`, rec.ID).Scan(&captures))
+		assert.Zero(t, captures)
+	})
+
+	s.Run("Normal case: deleted recordings are gone with their captures", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+		adminToken := authtest.LoginAs(t, s.Router, admin.User)
+		userToken := authtest.LoginAs(t, s.Router, sc.User)
+
+		rec := s.enable(t, adminToken, sc.User.ID, 5)
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, "/api/auth/me", nil, userToken)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodDelete, recordingURL(rec.ID), nil, adminToken)
+		require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, recordingURL(rec.ID), nil, adminToken)
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "REQUEST_RECORDING_NOT_FOUND")
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, recordingURL(rec.ID)+"/requests/1", nil, adminToken)
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "RECORDED_REQUEST_NOT_FOUND")
+	})
+
+	s.Run("Error case: maxRequests above the configured maximum", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, enableURL(sc.User.ID), request.EnableRequestRecordingRequest{
+			MaxRequests:      101,
+			Reason:           "too many",
+			ConsentReference: "TICKET-1",
+		}, authtest.LoginAs(t, s.Router, admin.User))
+		httptest.AssertErrorCode(t, w, http.StatusBadRequest, "INVALID_REQUEST_RECORDING_LIMIT")
+	})
+
+	s.Run("Error case: unknown user", func() {
+		t := s.T()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, enableURL(uuid.New()), request.EnableRequestRecordingRequest{
+			MaxRequests:      1,
+			Reason:           "missing user",
+			ConsentReference: "TICKET-1",
+		}, authtest.LoginAs(t, s.Router, admin.User))
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "USER_NOT_FOUND")
+	})
+
+	s.Run("Error case: permission required", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, enableURL(sc.User.ID), request.EnableRequestRecordingRequest{
+			MaxRequests:      1,
+			Reason:           "self",
+			ConsentReference: "TICKET-1",
+		}, authtest.LoginAs(t, s.Router, sc.User))
+		assert.Equal(t, http.StatusForbidden, w.Code, w.Body.String())
+	})
+}
@@ -0,0 +1,208 @@
+//go:build e2e
+
+package reservationapproval_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/tests/common/authtest"
+	"gin-clean-starter/tests/common/dbtest"
+	"gin-clean-starter/tests/common/httptest"
+	"gin-clean-starter/tests/e2e"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	reservationsURL = "/api/reservations"
+	approvalsURL    = "/api/reservation-approvals"
+)
+
+type ReservationApprovalSuite struct {
+	e2e.SharedSuite
+}
+
+func (s *ReservationApprovalSuite) SetupSubTest() {
+	s.SharedSuite.SetupSubTest()
+}
+
+func TestReservationApprovalSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ReservationApprovalSuite))
+}
+
+func approverURL(resourceID, userID uuid.UUID) string {
+	return fmt.Sprintf("/api/admin/resources/%s/approvers/%s", resourceID, userID)
+}
+
+// designate makes approver an approver of the resource, as an admin.
+func (s *ReservationApprovalSuite) designate(t *testing.T, resourceID, approverID uuid.UUID) {
+	t.Helper()
+
+	admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+	w := httptest.PerformRequest(t, s.Router, http.MethodPut, approverURL(resourceID, approverID), nil, authtest.LoginAs(t, s.Router, admin.User))
+	require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+}
+
+func (s *ReservationApprovalSuite) reserve(t *testing.T, token string, resourceID uuid.UUID) response.ReservationResponse {
+	t.Helper()
+
+	start := time.Now().UTC().Truncate(time.Hour).Add(72 * time.Hour)
+	w := httptest.PerformRequestWithHeaders(t, s.Router, http.MethodPost, reservationsURL, request.CreateReservationRequest{
+		ResourceID: resourceID,
+		StartTime:  start,
+		EndTime:    start.Add(time.Hour),
+	}, token, map[string]string{"Idempotency-Key": uuid.NewString()})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var created response.ReservationResponse
+	require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &created))
+	return created
+}
+
+func (s *ReservationApprovalSuite) statusOf(t *testing.T, reservationID uuid.UUID) string {
+	t.Helper()
+
+	var status string
+	require.NoError(t, s.DB.QueryRow(context.Background(), "SELECT status FROM reservations WHERE id = $1", reservationID).Scan(&status))
+	return status
+}
+
+func (s *ReservationApprovalSuite) notified(t *testing.T, topic string, reservationID uuid.UUID) int {
+	t.Helper()
+
+	var n int
+	require.NoError(t, s.DB.QueryRow(context.Background(),
+		`SELECT count(*) FROM notification_jobs WHERE topic = $1 AND payload->>'reservation_id' = $2`,
+		topic, reservationID.String()).Scan(&n))
+	return n
+}
+
+func (s *ReservationApprovalSuite) TestApproval() {
+	s.Run("Normal case: a reservation on a resource with approvers waits for an approver to confirm it", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).WithResource().Build()
+		approver := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+		s.designate(t, sc.ResourceID, approver.User.ID)
+
+		created := s.reserve(t, authtest.LoginAs(t, s.Router, sc.User), sc.ResourceID)
+		assert.Equal(t, "pending_approval", created.Status)
+		assert.Equal(t, 1, s.notified(t, "reservation_approval_requested", created.ID))
+
+		approverToken := authtest.LoginAs(t, s.Router, approver.User)
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, approvalsURL, nil, approverToken)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var queue []response.PendingApprovalResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &queue))
+		require.Len(t, queue, 1)
+		assert.Equal(t, created.ID, queue[0].ReservationID)
+		assert.Equal(t, sc.User.Email, queue[0].UserEmail)
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, fmt.Sprintf("%s/%s/approve", reservationsURL, created.ID), nil, approverToken)
+		require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+		assert.Equal(t, "confirmed", s.statusOf(t, created.ID))
+		assert.Equal(t, 1, s.notified(t, "reservation_approved", created.ID))
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, fmt.Sprintf("%s/%s/approve", reservationsURL, created.ID), nil, approverToken)
+		httptest.AssertErrorCode(t, w, http.StatusConflict, "RESERVATION_NOT_PENDING_APPROVAL")
+	})
+
+	s.Run("Normal case: a rejected reservation is canceled and frees its slot", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).WithResource().Build()
+		approver := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+		s.designate(t, sc.ResourceID, approver.User.ID)
+		created := s.reserve(t, authtest.LoginAs(t, s.Router, sc.User), sc.ResourceID)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, fmt.Sprintf("%s/%s/reject", reservationsURL, created.ID),
+			request.RejectReservationRequest{Reason: "Room is being renovated"}, authtest.LoginAs(t, s.Router, approver.User))
+		require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+		assert.Equal(t, "canceled", s.statusOf(t, created.ID))
+
+		var reason string
+		require.NoError(t, s.DB.QueryRow(context.Background(),
+			"SELECT reason FROM reservation_approvals WHERE reservation_id = $1 AND status = 'rejected'", created.ID).Scan(&reason))
+		assert.Equal(t, "Room is being renovated", reason)
+		assert.Equal(t, 1, s.notified(t, "reservation_rejected", created.ID))
+	})
+
+	s.Run("Error case: the user and other users cannot decide", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).WithResource().Build()
+		approver := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+		other := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+		s.designate(t, sc.ResourceID, approver.User.ID)
+		s.designate(t, sc.ResourceID, sc.User.ID)
+		userToken := authtest.LoginAs(t, s.Router, sc.User)
+		created := s.reserve(t, userToken, sc.ResourceID)
+		approveURL := fmt.Sprintf("%s/%s/approve", reservationsURL, created.ID)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, approveURL, nil, userToken)
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "RESERVATION_APPROVAL_OWN")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, approveURL, nil, authtest.LoginAs(t, s.Router, other.User))
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "RESERVATION_APPROVER_REQUIRED")
+		assert.Equal(t, "pending_approval", s.statusOf(t, created.ID))
+	})
+
+	s.Run("Normal case: the user withdraws a pending reservation by canceling it", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).WithResource().Build()
+		approver := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+		s.designate(t, sc.ResourceID, approver.User.ID)
+		userToken := authtest.LoginAs(t, s.Router, sc.User)
+		created := s.reserve(t, userToken, sc.ResourceID)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, fmt.Sprintf("%s/%s/cancel", reservationsURL, created.ID), nil, userToken)
+		require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+		assert.Equal(t, "canceled", s.statusOf(t, created.ID))
+
+		var status string
+		require.NoError(t, s.DB.QueryRow(context.Background(),
+			"SELECT status FROM reservation_approvals WHERE reservation_id = $1", created.ID).Scan(&status))
+		assert.Equal(t, "withdrawn", status)
+	})
+}
+
+func (s *ReservationApprovalSuite) TestApprovers() {
+	s.Run("Normal case: removing the last approver confirms new reservations right away", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).WithResource().Build()
+		approver := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+		adminToken := authtest.LoginAs(t, s.Router, admin.User)
+		s.designate(t, sc.ResourceID, approver.User.ID)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, fmt.Sprintf("/api/admin/resources/%s/approvers", sc.ResourceID), nil, adminToken)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var approvers []response.ResourceApproverResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &approvers))
+		require.Len(t, approvers, 1)
+		assert.Equal(t, approver.User.ID, approvers[0].UserID)
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodDelete, approverURL(sc.ResourceID, approver.User.ID), nil, adminToken)
+		require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+		w = httptest.PerformRequest(t, s.Router, http.MethodDelete, approverURL(sc.ResourceID, approver.User.ID), nil, adminToken)
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "APPROVER_NOT_FOUND")
+
+		created := s.reserve(t, authtest.LoginAs(t, s.Router, sc.User), sc.ResourceID)
+		assert.Equal(t, "confirmed", created.Status)
+	})
+
+	s.Run("Error case: viewers cannot designate approvers", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).WithResource().Build()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPut, approverURL(sc.ResourceID, sc.User.ID), nil, authtest.LoginAs(t, s.Router, sc.User))
+		assert.Equal(t, http.StatusForbidden, w.Code, w.Body.String())
+	})
+}
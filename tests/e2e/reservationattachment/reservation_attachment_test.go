@@ -0,0 +1,216 @@
+//go:build e2e
+
+package reservationattachment_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/tests/common/authtest"
+	"gin-clean-starter/tests/common/dbtest"
+	"gin-clean-starter/tests/common/httptest"
+	"gin-clean-starter/tests/e2e"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	reservationsURL      = "/api/reservations"
+	adminReservationsURL = "/api/admin/reservations"
+)
+
+var (
+	pdfContent = []byte("%PDF-1.4\n1 0 obj\n<<>>\nendobj\ntrailer\n<<>>\n%%EOF\n")
+	pngContent = append([]byte("\x89PNG\r\n\x1a\n"), bytes.Repeat([]byte{0}, 32)...)
+)
+
+type ReservationAttachmentSuite struct {
+	e2e.SharedSuite
+}
+
+func (s *ReservationAttachmentSuite) SetupSubTest() {
+	s.SharedSuite.SetupSubTest()
+}
+
+func TestReservationAttachmentSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ReservationAttachmentSuite))
+}
+
+func (s *ReservationAttachmentSuite) upload(t *testing.T, url, token, filename string, content []byte, fields map[string]string) response.ReservationAttachmentResponse {
+	t.Helper()
+
+	w := httptest.PerformMultipartRequest(t, s.Router, http.MethodPost, url, filename, content, fields, token)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var attachment response.ReservationAttachmentResponse
+	require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &attachment))
+	return attachment
+}
+
+func (s *ReservationAttachmentSuite) list(t *testing.T, url, token string) []uuid.UUID {
+	t.Helper()
+
+	w := httptest.PerformRequest(t, s.Router, http.MethodGet, url, nil, token)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var attachments []response.ReservationAttachmentResponse
+	require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &attachments))
+	ids := make([]uuid.UUID, 0, len(attachments))
+	for _, a := range attachments {
+		ids = append(ids, a.ID)
+	}
+	return ids
+}
+
+func (s *ReservationAttachmentSuite) TestUploadAndDownload() {
+	s.Run("Normal case: owner uploads, lists and downloads a file with a sniffed type", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).WithResource().WithUpcomingReservation().Build()
+		token := authtest.LoginAs(t, s.Router, sc.User)
+		url := fmt.Sprintf("%s/%s/attachments", reservationsURL, sc.ReservationID)
+
+		attachment := s.upload(t, url, token, "../../signed form.pdf", pdfContent, nil)
+		assert.Equal(t, "signed form.pdf", attachment.Filename)
+		assert.Equal(t, "application/pdf", attachment.ContentType)
+		assert.Equal(t, int64(len(pdfContent)), attachment.SizeBytes)
+		assert.Equal(t, sc.User.ID, attachment.UploadedBy)
+		assert.Equal(t, "user", attachment.UploaderSide)
+		assert.False(t, attachment.OperatorOnly)
+
+		assert.Equal(t, []uuid.UUID{attachment.ID}, s.list(t, url, token))
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, fmt.Sprintf("%s/%s", url, attachment.ID), nil, token)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		assert.Equal(t, pdfContent, w.Body.Bytes())
+		assert.Equal(t, "application/pdf", w.Header().Get("Content-Type"))
+		assert.Equal(t, `attachment; filename="signed form.pdf"`, w.Header().Get("Content-Disposition"))
+		assert.Equal(t, "nosniff", w.Header().Get("X-Content-Type-Options"))
+	})
+
+	s.Run("Error case: disallowed and oversized files are rejected", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).WithResource().WithUpcomingReservation().Build()
+		token := authtest.LoginAs(t, s.Router, sc.User)
+		url := fmt.Sprintf("%s/%s/attachments", reservationsURL, sc.ReservationID)
+
+		w := httptest.PerformMultipartRequest(t, s.Router, http.MethodPost, url, "notes.pdf", []byte("plain text pretending to be a pdf"), nil, token)
+		httptest.AssertErrorCode(t, w, http.StatusUnsupportedMediaType, "ATTACHMENT_TYPE_NOT_ALLOWED")
+
+		large := append(append([]byte{}, pdfContent...), bytes.Repeat([]byte{' '}, 1<<20)...)
+		w = httptest.PerformMultipartRequest(t, s.Router, http.MethodPost, url, "large.pdf", large, nil, token)
+		httptest.AssertErrorCode(t, w, http.StatusRequestEntityTooLarge, "ATTACHMENT_TOO_LARGE")
+
+		w = httptest.PerformMultipartRequest(t, s.Router, http.MethodPost, url, "empty.pdf", nil, nil, token)
+		httptest.AssertErrorCode(t, w, http.StatusBadRequest, "INVALID_ATTACHMENT")
+
+		assert.Empty(t, s.list(t, url, token))
+	})
+
+	s.Run("Error case: another user cannot upload to or read the reservation's files", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).WithResource().WithUpcomingReservation().Build()
+		other := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+		ownerToken := authtest.LoginAs(t, s.Router, sc.User)
+		otherToken := authtest.LoginAs(t, s.Router, other.User)
+		url := fmt.Sprintf("%s/%s/attachments", reservationsURL, sc.ReservationID)
+		attachment := s.upload(t, url, ownerToken, "plan.png", pngContent, nil)
+
+		w := httptest.PerformMultipartRequest(t, s.Router, http.MethodPost, url, "plan.png", pngContent, nil, otherToken)
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "RESERVATION_NOT_OWNED")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, url, nil, otherToken)
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "RESERVATION_NOT_FOUND")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, fmt.Sprintf("%s/%s", url, attachment.ID), nil, otherToken)
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "RESERVATION_NOT_FOUND")
+	})
+}
+
+func (s *ReservationAttachmentSuite) TestOperatorOnly() {
+	s.Run("Normal case: operator-only files are hidden from the reservation's user", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).WithResource().WithUpcomingReservation().Build()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+		userToken := authtest.LoginAs(t, s.Router, sc.User)
+		adminToken := authtest.LoginAs(t, s.Router, admin.User)
+		userURL := fmt.Sprintf("%s/%s/attachments", reservationsURL, sc.ReservationID)
+		adminURL := fmt.Sprintf("%s/%s/attachments", adminReservationsURL, sc.ReservationID)
+
+		shared := s.upload(t, adminURL, adminToken, "layout.png", pngContent, nil)
+		internal := s.upload(t, adminURL, adminToken, "internal.pdf", pdfContent, map[string]string{"operator_only": "true"})
+		assert.Equal(t, "operator", internal.UploaderSide)
+		assert.True(t, internal.OperatorOnly)
+
+		assert.Equal(t, []uuid.UUID{shared.ID}, s.list(t, userURL, userToken))
+		assert.Equal(t, []uuid.UUID{shared.ID, internal.ID}, s.list(t, adminURL, adminToken))
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, fmt.Sprintf("%s/%s", userURL, internal.ID), nil, userToken)
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "RESERVATION_ATTACHMENT_NOT_FOUND")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, fmt.Sprintf("%s/%s", adminURL, internal.ID), nil, adminToken)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		assert.Equal(t, pdfContent, w.Body.Bytes())
+	})
+
+	s.Run("Error case: users cannot mark their uploads operator-only or use the admin routes", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).WithResource().WithUpcomingReservation().Build()
+		token := authtest.LoginAs(t, s.Router, sc.User)
+		url := fmt.Sprintf("%s/%s/attachments", reservationsURL, sc.ReservationID)
+		adminURL := fmt.Sprintf("%s/%s/attachments", adminReservationsURL, sc.ReservationID)
+
+		w := httptest.PerformMultipartRequest(t, s.Router, http.MethodPost, url, "form.pdf", pdfContent, map[string]string{"operator_only": "true"}, token)
+		httptest.AssertErrorCode(t, w, http.StatusBadRequest, "INVALID_ATTACHMENT")
+
+		w = httptest.PerformMultipartRequest(t, s.Router, http.MethodPost, adminURL, "form.pdf", pdfContent, nil, token)
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "PERMISSION_DENIED")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, adminURL, nil, token)
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "RESERVATION_NOT_FOUND")
+	})
+}
+
+func (s *ReservationAttachmentSuite) TestDelete() {
+	s.Run("Normal case: owner deletes their upload and the content is gone", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).WithResource().WithUpcomingReservation().Build()
+		token := authtest.LoginAs(t, s.Router, sc.User)
+		url := fmt.Sprintf("%s/%s/attachments", reservationsURL, sc.ReservationID)
+		attachment := s.upload(t, url, token, "form.pdf", pdfContent, nil)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodDelete, fmt.Sprintf("%s/%s", url, attachment.ID), nil, token)
+		require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+		assert.Empty(t, s.list(t, url, token))
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, fmt.Sprintf("%s/%s", url, attachment.ID), nil, token)
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "RESERVATION_ATTACHMENT_NOT_FOUND")
+	})
+
+	s.Run("Error case: user cannot delete the operator's files", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).WithResource().WithUpcomingReservation().Build()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+		userToken := authtest.LoginAs(t, s.Router, sc.User)
+		adminToken := authtest.LoginAs(t, s.Router, admin.User)
+		userURL := fmt.Sprintf("%s/%s/attachments", reservationsURL, sc.ReservationID)
+		adminURL := fmt.Sprintf("%s/%s/attachments", adminReservationsURL, sc.ReservationID)
+
+		layout := s.upload(t, adminURL, adminToken, "layout.png", pngContent, nil)
+		internal := s.upload(t, adminURL, adminToken, "internal.pdf", pdfContent, map[string]string{"operator_only": "true"})
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodDelete, fmt.Sprintf("%s/%s", userURL, layout.ID), nil, userToken)
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "PERMISSION_DENIED")
+		w = httptest.PerformRequest(t, s.Router, http.MethodDelete, fmt.Sprintf("%s/%s", userURL, internal.ID), nil, userToken)
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "RESERVATION_ATTACHMENT_NOT_FOUND")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodDelete, fmt.Sprintf("%s/%s", adminURL, internal.ID), nil, adminToken)
+		require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+		assert.Equal(t, []uuid.UUID{layout.ID}, s.list(t, adminURL, adminToken))
+	})
+}
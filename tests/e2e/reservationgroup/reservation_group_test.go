@@ -0,0 +1,148 @@
+//go:build e2e
+
+package reservationgroup_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	nethttptest "net/http/httptest"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/tests/common/authtest"
+	"gin-clean-starter/tests/common/dbtest"
+	"gin-clean-starter/tests/common/httptest"
+	"gin-clean-starter/tests/e2e"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const reservationGroupsURL = "/api/reservation-groups"
+
+type ReservationGroupSuite struct {
+	e2e.SharedSuite
+}
+
+func (s *ReservationGroupSuite) SetupSubTest() {
+	s.SharedSuite.SetupSubTest()
+}
+
+func TestReservationGroupSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ReservationGroupSuite))
+}
+
+// slot returns a one-hour slot on a whole hour, days from now.
+func slot(days int) (time.Time, time.Time) {
+	start := time.Now().UTC().Truncate(time.Hour).AddDate(0, 0, days)
+	return start, start.Add(time.Hour)
+}
+
+func (s *ReservationGroupSuite) book(t *testing.T, token, key string, items ...request.ReservationGroupItemRequest) *nethttptest.ResponseRecorder {
+	t.Helper()
+
+	return httptest.PerformRequestWithHeaders(t, s.Router, http.MethodPost, reservationGroupsURL,
+		request.CreateReservationGroupRequest{Items: items}, token, map[string]string{"Idempotency-Key": key})
+}
+
+func (s *ReservationGroupSuite) countReservations(t *testing.T, userID uuid.UUID) int {
+	t.Helper()
+
+	var n int
+	require.NoError(t, s.DB.QueryRow(context.Background(), "SELECT count(*) FROM reservations WHERE user_id = $1", userID).Scan(&n))
+	return n
+}
+
+func (s *ReservationGroupSuite) TestCreateGroup() {
+	s.Run("Normal case: books every item under one group and replays the same key", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).WithResource().WithResource().Build()
+		token := authtest.LoginAs(t, s.Router, sc.User)
+		start, end := slot(3)
+		key := uuid.NewString()
+		items := []request.ReservationGroupItemRequest{
+			{ResourceID: sc.ResourceIDs[0], StartTime: start, EndTime: end},
+			{ResourceID: sc.ResourceIDs[1], StartTime: start, EndTime: end},
+		}
+
+		w := s.book(t, token, key, items...)
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+		var group response.ReservationGroupResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &group))
+		require.Len(t, group.Reservations, 2)
+		assert.ElementsMatch(t, sc.ResourceIDs, []uuid.UUID{group.Reservations[0].ResourceID, group.Reservations[1].ResourceID})
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, fmt.Sprintf("%s/%s", reservationGroupsURL, group.ID), nil, token)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var fetched response.ReservationGroupResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &fetched))
+		assert.Equal(t, group.ID, fetched.ID)
+		assert.Len(t, fetched.Reservations, 2)
+
+		w = s.book(t, token, key, items...)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		assert.Equal(t, "true", w.Header().Get("Idempotent-Replayed"))
+		var replayed response.ReservationGroupResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &replayed))
+		assert.Equal(t, group.ID, replayed.ID)
+		assert.Equal(t, 2, s.countReservations(t, sc.User.ID))
+	})
+
+	s.Run("Error case: conflicts are reported per item and nothing is booked", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).WithResource().WithUpcomingReservation().WithResource().Build()
+		token := authtest.LoginAs(t, s.Router, sc.User)
+		var takenStart, takenEnd time.Time
+		require.NoError(t, s.DB.QueryRow(context.Background(),
+			"SELECT lower(slot), upper(slot) FROM reservations WHERE id = $1", sc.ReservationID).Scan(&takenStart, &takenEnd))
+		start, end := slot(3)
+
+		w := s.book(t, token, uuid.NewString(),
+			request.ReservationGroupItemRequest{ResourceID: sc.ResourceIDs[0], StartTime: takenStart, EndTime: takenEnd},
+			request.ReservationGroupItemRequest{ResourceID: sc.ResourceIDs[1], StartTime: start, EndTime: end},
+			request.ReservationGroupItemRequest{ResourceID: sc.ResourceIDs[1], StartTime: start.Add(30 * time.Minute), EndTime: end.Add(30 * time.Minute)},
+		)
+		httptest.AssertErrorCode(t, w, http.StatusConflict, "RESERVATION_GROUP_CONFLICT")
+
+		var body struct {
+			Detail struct {
+				Items []response.ReservationGroupIssueResponse `json:"items"`
+			} `json:"detail"`
+		}
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &body))
+		require.Len(t, body.Detail.Items, 2)
+		assert.Equal(t, 0, body.Detail.Items[0].Index)
+		assert.Equal(t, "RESERVATION_CONFLICT", body.Detail.Items[0].Code)
+		assert.Nil(t, body.Detail.Items[0].OverlapsItem)
+		assert.Equal(t, 2, body.Detail.Items[1].Index)
+		require.NotNil(t, body.Detail.Items[1].OverlapsItem)
+		assert.Equal(t, 1, *body.Detail.Items[1].OverlapsItem)
+		assert.Equal(t, 1, s.countReservations(t, sc.User.ID))
+	})
+
+	s.Run("Error case: another user's group is not found", func() {
+		t := s.T()
+		owner := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).WithResource().WithResource().Build()
+		other := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+		start, end := slot(4)
+
+		w := s.book(t, authtest.LoginAs(t, s.Router, owner.User), uuid.NewString(),
+			request.ReservationGroupItemRequest{ResourceID: owner.ResourceIDs[0], StartTime: start, EndTime: end},
+			request.ReservationGroupItemRequest{ResourceID: owner.ResourceIDs[1], StartTime: start, EndTime: end},
+		)
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+		var group response.ReservationGroupResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &group))
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, fmt.Sprintf("%s/%s", reservationGroupsURL, group.ID), nil,
+			authtest.LoginAs(t, s.Router, other.User))
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "RESERVATION_GROUP_NOT_FOUND")
+	})
+}
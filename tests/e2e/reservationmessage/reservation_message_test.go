@@ -0,0 +1,189 @@
+//go:build e2e
+
+package reservationmessage_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/tests/common/authtest"
+	"gin-clean-starter/tests/common/dbtest"
+	"gin-clean-starter/tests/common/httptest"
+	"gin-clean-starter/tests/e2e"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	reservationsURL      = "/api/reservations"
+	adminReservationsURL = "/api/admin/reservations"
+)
+
+type ReservationMessageSuite struct {
+	e2e.SharedSuite
+}
+
+func (s *ReservationMessageSuite) SetupSubTest() {
+	s.SharedSuite.SetupSubTest()
+}
+
+func TestReservationMessageSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ReservationMessageSuite))
+}
+
+func (s *ReservationMessageSuite) detail(t *testing.T, url, token string) response.ReservationResponse {
+	t.Helper()
+
+	w := httptest.PerformRequest(t, s.Router, http.MethodGet, url, nil, token)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var resp response.ReservationResponse
+	require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &resp))
+	require.NotNil(t, resp.Messages)
+	return resp
+}
+
+func (s *ReservationMessageSuite) post(t *testing.T, url, token, body string) response.ReservationMessageResponse {
+	t.Helper()
+
+	w := httptest.PerformRequest(t, s.Router, http.MethodPost, url, request.PostReservationMessageRequest{Body: body}, token)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var msg response.ReservationMessageResponse
+	require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &msg))
+	return msg
+}
+
+func (s *ReservationMessageSuite) TestThread() {
+	s.Run("Normal case: the note opens the thread and each side sees the other's unread messages", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).WithResource().Build()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+		userToken := authtest.LoginAs(t, s.Router, sc.User)
+		adminToken := authtest.LoginAs(t, s.Router, admin.User)
+
+		start := time.Now().UTC().Truncate(time.Hour).Add(72 * time.Hour)
+		note := "window seat please"
+		w := httptest.PerformRequestWithHeaders(t, s.Router, http.MethodPost, reservationsURL, request.CreateReservationRequest{
+			ResourceID: sc.ResourceID,
+			StartTime:  start,
+			EndTime:    start.Add(time.Hour),
+			Note:       &note,
+		}, userToken, map[string]string{"Idempotency-Key": uuid.NewString()})
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+		var created response.ReservationResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &created))
+		require.NotNil(t, created.Messages)
+		require.Len(t, created.Messages.Messages, 1)
+		assert.Equal(t, note, created.Messages.Messages[0].Body)
+		assert.Equal(t, "user", created.Messages.Messages[0].AuthorSide)
+
+		userURL := fmt.Sprintf("%s/%s", reservationsURL, created.ID)
+		adminURL := fmt.Sprintf("%s/%s", adminReservationsURL, created.ID)
+
+		assert.Equal(t, int64(1), s.detail(t, adminURL, adminToken).Messages.Unread)
+
+		reply := s.post(t, adminURL+"/messages", adminToken, "Noted, seat reserved.")
+		assert.Equal(t, "operator", reply.AuthorSide)
+		assert.Equal(t, admin.User.ID, reply.AuthorID)
+		assert.Equal(t, int64(0), s.detail(t, adminURL, adminToken).Messages.Unread)
+
+		thread := s.detail(t, userURL, userToken).Messages
+		require.Len(t, thread.Messages, 2)
+		assert.Equal(t, "Noted, seat reserved.", thread.Messages[1].Body)
+		assert.Equal(t, int64(1), thread.Unread)
+
+		var queued int
+		require.NoError(t, s.DB.QueryRow(context.Background(),
+			`SELECT count(*) FROM notification_jobs WHERE topic = 'reservation_message' AND payload->>'message_id' = $1`,
+			reply.ID.String()).Scan(&queued))
+		assert.Equal(t, 1, queued)
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, userURL+"/messages/read", nil, userToken)
+		require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+		assert.Equal(t, int64(0), s.detail(t, userURL, userToken).Messages.Unread)
+	})
+
+	s.Run("Normal case: messages page with a cursor and the ETag tracks new messages", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).WithResource().WithUpcomingReservation().Build()
+		token := authtest.LoginAs(t, s.Router, sc.User)
+		url := fmt.Sprintf("%s/%s", reservationsURL, sc.ReservationID)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, url, nil, token)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		etag := w.Header().Get("ETag")
+		require.NotEmpty(t, etag)
+
+		for i := range 3 {
+			s.post(t, url+"/messages", token, fmt.Sprintf("message %d", i))
+		}
+
+		w = httptest.PerformRequestWithHeaders(t, s.Router, http.MethodGet, url, nil, token, map[string]string{"If-None-Match": etag})
+		assert.Equal(t, http.StatusOK, w.Code, "a new message must change the ETag")
+
+		first := s.detail(t, url+"?messages_limit=2", token).Messages
+		require.Len(t, first.Messages, 2)
+		require.NotEmpty(t, first.NextCursor)
+		second := s.detail(t, url+"?messages_limit=2&messages_after="+first.NextCursor, token).Messages
+		require.Len(t, second.Messages, 1)
+		assert.Equal(t, "message 2", second.Messages[0].Body)
+		assert.Empty(t, second.NextCursor)
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, url+"?messages_after=garbage", nil, token)
+		assert.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+	})
+
+	s.Run("Normal case: fields prunes the detail and gets its own ETag", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).WithResource().WithUpcomingReservation().Build()
+		token := authtest.LoginAs(t, s.Router, sc.User)
+		url := fmt.Sprintf("%s/%s", reservationsURL, sc.ReservationID)
+		s.post(t, url+"/messages", token, "hello")
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, url, nil, token)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		fullETag := w.Header().Get("ETag")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, url+"?fields=id,status,messages.messages.body", nil, token)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		assert.JSONEq(t, fmt.Sprintf(`{"id":%q,"status":"confirmed","messages":{"messages":[{"body":"hello"}]}}`, sc.ReservationID), w.Body.String())
+		prunedETag := w.Header().Get("ETag")
+		assert.NotEqual(t, fullETag, prunedETag)
+
+		w = httptest.PerformRequestWithHeaders(t, s.Router, http.MethodGet, url+"?fields=status,messages.messages.body,id", nil, token,
+			map[string]string{"If-None-Match": prunedETag})
+		assert.Equal(t, http.StatusNotModified, w.Code)
+		w = httptest.PerformRequestWithHeaders(t, s.Router, http.MethodGet, url+"?fields=id", nil, token,
+			map[string]string{"If-None-Match": prunedETag})
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	s.Run("Error case: only the owner and operators may post", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).WithUser(string(user.RoleViewer)).WithResource().Build()
+		reservationID := dbtest.CreateTestReservation(t, s.DB, sc.ResourceID, sc.Users[0].ID,
+			time.Now().Add(48*time.Hour), time.Now().Add(49*time.Hour), "confirmed")
+		otherToken := authtest.LoginAs(t, s.Router, sc.Users[1])
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, fmt.Sprintf("%s/%s/messages", reservationsURL, reservationID),
+			request.PostReservationMessageRequest{Body: "hello"}, otherToken)
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "RESERVATION_NOT_OWNED")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, fmt.Sprintf("%s/%s/messages", adminReservationsURL, reservationID),
+			request.PostReservationMessageRequest{Body: "hello"}, otherToken)
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "PERMISSION_DENIED")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, fmt.Sprintf("%s/%s/messages", reservationsURL, uuid.New()),
+			request.PostReservationMessageRequest{Body: "hello"}, otherToken)
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "RESERVATION_NOT_FOUND")
+	})
+}
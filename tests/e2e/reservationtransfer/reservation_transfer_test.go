@@ -0,0 +1,166 @@
+//go:build e2e
+
+package reservationtransfer_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	nethttptest "net/http/httptest"
+	"net/url"
+	"testing"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/tests/common/authtest"
+	"gin-clean-starter/tests/common/dbtest"
+	"gin-clean-starter/tests/common/httptest"
+	"gin-clean-starter/tests/e2e"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const acceptURL = "/api/reservation-transfers/accept"
+
+type ReservationTransferSuite struct {
+	e2e.SharedSuite
+}
+
+func (s *ReservationTransferSuite) SetupSubTest() {
+	s.SharedSuite.SetupSubTest()
+}
+
+func TestReservationTransferSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ReservationTransferSuite))
+}
+
+func transferURL(reservationID uuid.UUID) string {
+	return fmt.Sprintf("/api/reservations/%s/transfer", reservationID)
+}
+
+func (s *ReservationTransferSuite) offer(t *testing.T, token string, reservationID uuid.UUID, email string) *nethttptest.ResponseRecorder {
+	t.Helper()
+
+	return httptest.PerformRequest(t, s.Router, http.MethodPost, transferURL(reservationID),
+		request.TransferReservationRequest{Email: email}, token)
+}
+
+// offerToken returns the token of the link mailed for the transfer.
+func (s *ReservationTransferSuite) offerToken(t *testing.T, transferID uuid.UUID) string {
+	t.Helper()
+
+	var link string
+	require.NoError(t, s.DB.QueryRow(context.Background(),
+		`SELECT payload->>'link' FROM notification_jobs WHERE topic = 'reservation_transfer_offered' AND payload->>'transfer_id' = $1`,
+		transferID.String()).Scan(&link))
+	u, err := url.Parse(link)
+	require.NoError(t, err)
+	return u.Query().Get("token")
+}
+
+func (s *ReservationTransferSuite) ownerOf(t *testing.T, reservationID uuid.UUID) uuid.UUID {
+	t.Helper()
+
+	var userID uuid.UUID
+	require.NoError(t, s.DB.QueryRow(context.Background(), "SELECT user_id FROM reservations WHERE id = $1", reservationID).Scan(&userID))
+	return userID
+}
+
+func (s *ReservationTransferSuite) TestTransfer() {
+	s.Run("Normal case: the recipient accepts the offer and becomes the owner", func() {
+		t := s.T()
+		owner := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).WithResource().WithUpcomingReservation().Build()
+		recipient := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+
+		w := s.offer(t, authtest.LoginAs(t, s.Router, owner.User), owner.ReservationID, recipient.User.Email)
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+		var offer response.ReservationTransferResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &offer))
+		assert.Equal(t, "pending", offer.Status)
+		assert.Equal(t, recipient.User.ID, offer.ToUserID)
+		assert.Equal(t, owner.User.ID, s.ownerOf(t, owner.ReservationID))
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, acceptURL,
+			request.AcceptReservationTransferRequest{Token: s.offerToken(t, offer.ID)}, authtest.LoginAs(t, s.Router, recipient.User))
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var accepted response.ReservationTransferResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &accepted))
+		assert.Equal(t, "accepted", accepted.Status)
+		assert.Equal(t, recipient.User.ID, s.ownerOf(t, owner.ReservationID))
+
+		var notified int
+		require.NoError(t, s.DB.QueryRow(context.Background(),
+			`SELECT count(*) FROM notification_jobs WHERE topic = 'reservation_transferred' AND payload->>'transfer_id' = $1`,
+			offer.ID.String()).Scan(&notified))
+		assert.Equal(t, 1, notified)
+	})
+
+	s.Run("Error case: only the recipient of the latest offer can accept", func() {
+		t := s.T()
+		owner := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).WithResource().WithUpcomingReservation().Build()
+		first := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+		second := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+		ownerToken := authtest.LoginAs(t, s.Router, owner.User)
+
+		w := s.offer(t, ownerToken, owner.ReservationID, first.User.Email)
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+		var stale response.ReservationTransferResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &stale))
+		staleToken := s.offerToken(t, stale.ID)
+
+		w = s.offer(t, ownerToken, owner.ReservationID, second.User.Email)
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+		var latest response.ReservationTransferResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &latest))
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, acceptURL,
+			request.AcceptReservationTransferRequest{Token: staleToken}, authtest.LoginAs(t, s.Router, first.User))
+		httptest.AssertErrorCode(t, w, http.StatusConflict, "RESERVATION_TRANSFER_NOT_PENDING")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, acceptURL,
+			request.AcceptReservationTransferRequest{Token: s.offerToken(t, latest.ID)}, authtest.LoginAs(t, s.Router, first.User))
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "RESERVATION_TRANSFER_WRONG_RECIPIENT")
+		assert.Equal(t, owner.User.ID, s.ownerOf(t, owner.ReservationID))
+	})
+
+	s.Run("Error case: other users cannot offer the reservation and recipients must exist", func() {
+		t := s.T()
+		owner := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).WithResource().WithUpcomingReservation().Build()
+		other := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+
+		w := s.offer(t, authtest.LoginAs(t, s.Router, other.User), owner.ReservationID, other.User.Email)
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "RESERVATION_NOT_OWNED")
+
+		ownerToken := authtest.LoginAs(t, s.Router, owner.User)
+		w = s.offer(t, ownerToken, owner.ReservationID, "nobody-"+uuid.NewString()[:8]+"@example.com")
+		httptest.AssertErrorCode(t, w, http.StatusUnprocessableEntity, "RESERVATION_TRANSFER_INVALID_RECIPIENT")
+
+		w = s.offer(t, ownerToken, owner.ReservationID, owner.User.Email)
+		httptest.AssertErrorCode(t, w, http.StatusUnprocessableEntity, "RESERVATION_TRANSFER_INVALID_RECIPIENT")
+	})
+
+	s.Run("Normal case: an operator override transfers right away and is audited", func() {
+		t := s.T()
+		owner := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).WithResource().WithUpcomingReservation().Build()
+		recipient := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+
+		w := s.offer(t, authtest.LoginAs(t, s.Router, admin.User), owner.ReservationID, recipient.User.Email)
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+		var transfer response.ReservationTransferResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &transfer))
+		assert.Equal(t, "accepted", transfer.Status)
+		assert.Equal(t, recipient.User.ID, s.ownerOf(t, owner.ReservationID))
+
+		var audited int
+		require.NoError(t, s.DB.QueryRow(context.Background(),
+			`SELECT count(*) FROM audit_logs WHERE action = 'reservation.transfer_override' AND target_id = $1`,
+			owner.ReservationID.String()).Scan(&audited))
+		assert.Equal(t, 1, audited)
+	})
+}
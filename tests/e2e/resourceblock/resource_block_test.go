@@ -0,0 +1,185 @@
+//go:build e2e
+
+package resourceblock_test
+
+import (
+	"fmt"
+	"net/http"
+	nethttptest "net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/tests/common/authtest"
+	"gin-clean-starter/tests/common/dbtest"
+	"gin-clean-starter/tests/common/httptest"
+	"gin-clean-starter/tests/e2e"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const reservationsURL = "/api/reservations"
+
+type ResourceBlockSuite struct {
+	e2e.SharedSuite
+}
+
+func (s *ResourceBlockSuite) SetupSubTest() {
+	s.SharedSuite.SetupSubTest()
+}
+
+func TestResourceBlockSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ResourceBlockSuite))
+}
+
+func blocksURL(resourceID uuid.UUID) string {
+	return fmt.Sprintf("/api/admin/resources/%s/blocks", resourceID)
+}
+
+// slot returns a one-hour slot on a whole hour, days from now.
+func slot(days int) (time.Time, time.Time) {
+	start := time.Now().UTC().Truncate(time.Hour).AddDate(0, 0, days)
+	return start, start.Add(time.Hour)
+}
+
+func (s *ResourceBlockSuite) block(t *testing.T, token string, resourceID uuid.UUID, req request.CreateResourceBlockRequest) response.ResourceBlockSeriesResponse {
+	t.Helper()
+
+	w := httptest.PerformRequest(t, s.Router, http.MethodPost, blocksURL(resourceID), req, token)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var series response.ResourceBlockSeriesResponse
+	require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &series))
+	return series
+}
+
+func (s *ResourceBlockSuite) reserve(t *testing.T, token string, resourceID uuid.UUID, start, end time.Time) *nethttptest.ResponseRecorder {
+	t.Helper()
+
+	return httptest.PerformRequestWithHeaders(t, s.Router, http.MethodPost, reservationsURL, request.CreateReservationRequest{
+		ResourceID: resourceID,
+		StartTime:  start,
+		EndTime:    end,
+	}, token, map[string]string{"Idempotency-Key": uuid.NewString()})
+}
+
+func (s *ResourceBlockSuite) TestBlockedSlots() {
+	s.Run("Normal case: reservations cannot overlap a block and availability reports it", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).WithResource().Build()
+		token := authtest.LoginAs(t, s.Router, sc.User)
+		start, end := slot(3)
+
+		series := s.block(t, token, sc.ResourceID, request.CreateResourceBlockRequest{
+			StartTime: start,
+			EndTime:   end,
+			Reason:    "Maintenance",
+		})
+		require.Len(t, series.Blocks, 1)
+
+		w := s.reserve(t, token, sc.ResourceID, start.Add(30*time.Minute), end.Add(30*time.Minute))
+		httptest.AssertErrorCode(t, w, http.StatusConflict, "RESOURCE_BLOCKED")
+
+		w = s.reserve(t, token, sc.ResourceID, end, end.Add(time.Hour))
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+		query := url.Values{"from": {start.Format(time.RFC3339)}, "to": {end.Add(2 * time.Hour).Format(time.RFC3339)}}
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet,
+			fmt.Sprintf("/api/resources/%s/availability?%s", sc.ResourceID, query.Encode()), nil, token)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var availability response.AvailabilityResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &availability))
+		require.Len(t, availability.Busy, 2)
+		assert.Equal(t, "block", availability.Busy[0].Kind)
+		assert.True(t, availability.Busy[0].StartTime.Equal(start))
+		assert.Equal(t, "reservation", availability.Busy[1].Kind)
+		assert.NotContains(t, w.Body.String(), "Maintenance")
+	})
+
+	s.Run("Normal case: weekly recurrence creates one block per occurrence", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).WithResource().Build()
+		token := authtest.LoginAs(t, s.Router, sc.User)
+		start, end := slot(1)
+
+		series := s.block(t, token, sc.ResourceID, request.CreateResourceBlockRequest{
+			StartTime:  start,
+			EndTime:    end,
+			Reason:     "Weekly cleaning",
+			Recurrence: &request.BlockRecurrenceRequest{Frequency: "weekly", Count: 4},
+		})
+		require.Len(t, series.Blocks, 4)
+		for i, b := range series.Blocks {
+			assert.Equal(t, series.SeriesID, b.SeriesID)
+			assert.True(t, b.StartTime.Equal(start.AddDate(0, 0, 7*i)), "occurrence %d", i)
+		}
+
+		w := s.reserve(t, token, sc.ResourceID, start.AddDate(0, 0, 14), end.AddDate(0, 0, 14))
+		httptest.AssertErrorCode(t, w, http.StatusConflict, "RESOURCE_BLOCKED")
+	})
+
+	s.Run("Normal case: deleting with following removes the rest of the series", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).WithResource().Build()
+		token := authtest.LoginAs(t, s.Router, sc.User)
+		start, end := slot(1)
+
+		series := s.block(t, token, sc.ResourceID, request.CreateResourceBlockRequest{
+			StartTime:  start,
+			EndTime:    end,
+			Reason:     "Daily standup",
+			Recurrence: &request.BlockRecurrenceRequest{Frequency: "daily", Count: 5},
+		})
+		require.Len(t, series.Blocks, 5)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodDelete,
+			fmt.Sprintf("%s/%s?following=true", blocksURL(sc.ResourceID), series.Blocks[2].ID), nil, token)
+		require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+
+		query := url.Values{"from": {start.Format(time.RFC3339)}}
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, blocksURL(sc.ResourceID)+"?"+query.Encode(), nil, token)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var blocks []response.ResourceBlockResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &blocks))
+		require.Len(t, blocks, 2)
+		assert.Equal(t, series.Blocks[0].ID, blocks[0].ID)
+		assert.Equal(t, series.Blocks[1].ID, blocks[1].ID)
+	})
+
+	s.Run("Error case: block overlapping a confirmed reservation is rejected", func() {
+		t := s.T()
+		start, end := slot(2)
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).WithResource().WithReservation(start, end, "confirmed").Build()
+		token := authtest.LoginAs(t, s.Router, sc.User)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, blocksURL(sc.ResourceID), request.CreateResourceBlockRequest{
+			StartTime: start.Add(-30 * time.Minute),
+			EndTime:   start.Add(30 * time.Minute),
+			Reason:    "Private event",
+		}, token)
+		httptest.AssertErrorCode(t, w, http.StatusConflict, "RESOURCE_BLOCK_CONFLICT")
+	})
+
+	s.Run("Error case: viewer cannot manage blocks", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).WithResource().Build()
+		token := authtest.LoginAs(t, s.Router, sc.User)
+		start, end := slot(1)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, blocksURL(sc.ResourceID), request.CreateResourceBlockRequest{
+			StartTime: start,
+			EndTime:   end,
+			Reason:    "Maintenance",
+		}, token)
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "PERMISSION_DENIED")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, blocksURL(sc.ResourceID), nil, token)
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "PERMISSION_DENIED")
+	})
+}
@@ -3,8 +3,10 @@
 package review_test
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -20,6 +22,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
@@ -29,6 +32,8 @@ const (
 	resourceReviewsURL = "/api/resources/%s/reviews"
 	userReviewsURL     = "/api/users/%s/reviews"
 	ratingStatsURL     = "/api/resources/%s/rating-stats"
+	reviewExportURL    = "/api/users/me/reviews/export"
+	reviewFeedURL      = "/api/resources/%s/reviews.atom"
 )
 
 type ReviewSuite struct {
@@ -54,14 +59,13 @@ func (s *ReviewSuite) TestCreateReview() {
 	s.Run("Normal case: User can create review successfully", func() {
 		t := s.T()
 
-		userID := dbtest.CreateTestUser(t, s.DB, "reviewer@example.com", string(user.RoleAdmin))
-		resourceID := dbtest.CreateTestResource(t, s.DB, "Test Resource", 60)
-
-		now := time.Now()
-		reservationID := dbtest.CreateTestReservation(t, s.DB, resourceID, userID,
-			now.Add(-2*time.Hour), now.Add(-1*time.Hour), "confirmed")
-
-		token := authtest.LoginUser(t, s.Router, "reviewer@example.com", "password123")
+		sc := dbtest.Scenario(t, s.DB).
+			WithUserEmail("reviewer@example.com", string(user.RoleAdmin)).
+			WithResourceNamed("Test Resource", 60).
+			WithCompletedReservation().
+			Build()
+		resourceID, reservationID := sc.ResourceID, sc.ReservationID
+		token := authtest.LoginAs(t, s.Router, sc.User)
 
 		reqBody := builder.NewReviewBuilder().
 			WithResourceID(resourceID).
@@ -73,12 +77,12 @@ func (s *ReviewSuite) TestCreateReview() {
 		w := httptest.PerformRequest(t, s.Router, http.MethodPost, url, reqBody, token)
 		require.Equal(t, http.StatusCreated, w.Code, "Should create review successfully")
 
-		var created map[string]string
+		var created response.CreatedResponse
 		err := httptest.DecodeResponseBody(t, w.Body, &created)
 		require.NoError(t, err)
-		id := created["id"]
+		id := created.ID
 		require.NotEmpty(t, id, "Review ID should not be empty")
-		// Location header format may vary by router prefix; only assert body id
+		require.Equal(t, reviewsURL+"/"+id, w.Header().Get("Location"))
 
 		// Fetch detail and assert
 		detailURL := reviewsURL + "/" + id
@@ -94,6 +98,7 @@ func (s *ReviewSuite) TestCreateReview() {
 			ResourceName: "Test Resource",
 			Rating:       int32(5),
 			Comment:      "Excellent service!",
+			Status:       "published",
 		}
 
 		opts := []cmp.Option{
@@ -108,14 +113,13 @@ func (s *ReviewSuite) TestCreateReview() {
 	s.Run("Error case: Duplicate review for same reservation fails", func() {
 		t := s.T()
 
-		userID := dbtest.CreateTestUser(t, s.DB, "reviewer2@example.com", string(user.RoleAdmin))
-		resourceID := dbtest.CreateTestResource(t, s.DB, "Test Resource 2", 60)
-
-		now := time.Now()
-		reservationID := dbtest.CreateTestReservation(t, s.DB, resourceID, userID,
-			now.Add(-2*time.Hour), now.Add(-1*time.Hour), "confirmed")
-
-		token := authtest.LoginUser(t, s.Router, "reviewer2@example.com", "password123")
+		sc := dbtest.Scenario(t, s.DB).
+			WithUserEmail("reviewer2@example.com", string(user.RoleAdmin)).
+			WithResourceNamed("Test Resource 2", 60).
+			WithCompletedReservation().
+			Build()
+		resourceID, reservationID := sc.ResourceID, sc.ReservationID
+		token := authtest.LoginAs(t, s.Router, sc.User)
 
 		reqBody := builder.NewReviewBuilder().
 			WithResourceID(resourceID).
@@ -132,17 +136,49 @@ func (s *ReviewSuite) TestCreateReview() {
 		reqBody.Comment = "Second review attempt"
 		w2 := httptest.PerformRequest(t, s.Router, http.MethodPost, url, reqBody, token)
 		require.Equal(t, http.StatusConflict, w2.Code, "Should prevent duplicate reviews for same reservation")
+		httptest.AssertErrorCode(t, w2, http.StatusConflict, "REVIEW_ALREADY_EXISTS")
 	})
 
-	s.Run("Auth test - Unauthorized when not logged in", func() {
+	s.Run("Error case: Unknown reservation returns 404", func() {
+		t := s.T()
+
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).WithResource().Build()
+		token := authtest.LoginAs(t, s.Router, sc.User)
+
+		reqBody := builder.NewReviewBuilder().
+			WithResourceID(sc.ResourceID).
+			WithReservationID(uuid.New()).
+			BuildCreateRequestDTO()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, url, reqBody, token)
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "RESERVATION_NOT_FOUND")
+	})
+
+	s.Run("Error case: Another user's reservation is not eligible", func() {
 		t := s.T()
 
-		userID := dbtest.CreateTestUser(t, s.DB, "reviewer4@example.com", string(user.RoleAdmin))
-		resourceID := dbtest.CreateTestResource(t, s.DB, "Test Resource 4", 60)
+		owner := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).WithResource().WithCompletedReservation().Build()
+		other := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+		token := authtest.LoginAs(t, s.Router, other.User)
+
+		reqBody := builder.NewReviewBuilder().
+			WithResourceID(owner.ResourceID).
+			WithReservationID(owner.ReservationID).
+			BuildCreateRequestDTO()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, url, reqBody, token)
+		httptest.AssertErrorCode(t, w, http.StatusUnprocessableEntity, "REVIEW_NOT_ELIGIBLE")
+	})
+
+	s.Run("Auth test - Unauthorized when not logged in", func() {
+		t := s.T()
 
-		now := time.Now()
-		reservationID := dbtest.CreateTestReservation(t, s.DB, resourceID, userID,
-			now.Add(-2*time.Hour), now.Add(-1*time.Hour), "confirmed")
+		sc := dbtest.Scenario(t, s.DB).
+			WithUserEmail("reviewer4@example.com", string(user.RoleAdmin)).
+			WithResourceNamed("Test Resource 4", 60).
+			WithCompletedReservation().
+			Build()
+		resourceID, reservationID := sc.ResourceID, sc.ReservationID
 
 		reqBody := builder.NewReviewBuilder().
 			WithResourceID(resourceID).
@@ -164,14 +200,13 @@ func (s *ReviewSuite) TestGetReview() {
 	s.Run("Normal case: Review retrieved successfully by ID", func() {
 		t := s.T()
 
-		userID := dbtest.CreateTestUser(t, s.DB, "reviewer@example.com", string(user.RoleAdmin))
-		resourceID := dbtest.CreateTestResource(t, s.DB, "Test Resource", 60)
-
-		now := time.Now()
-		reservationID := dbtest.CreateTestReservation(t, s.DB, resourceID, userID,
-			now.Add(-2*time.Hour), now.Add(-1*time.Hour), "confirmed")
-
-		token := authtest.LoginUser(t, s.Router, "reviewer@example.com", "password123")
+		sc := dbtest.Scenario(t, s.DB).
+			WithUserEmail("reviewer@example.com", string(user.RoleAdmin)).
+			WithResourceNamed("Test Resource", 60).
+			WithCompletedReservation().
+			Build()
+		resourceID, reservationID := sc.ResourceID, sc.ReservationID
+		token := authtest.LoginAs(t, s.Router, sc.User)
 
 		// Create a review first
 		createReq := builder.NewReviewBuilder().
@@ -184,10 +219,10 @@ func (s *ReviewSuite) TestGetReview() {
 		createResp := httptest.PerformRequest(t, s.Router, http.MethodPost, reviewsURL, createReq, token)
 		require.Equal(t, http.StatusCreated, createResp.Code)
 
-		var created map[string]string
+		var created response.CreatedResponse
 		err := httptest.DecodeResponseBody(t, createResp.Body, &created)
 		require.NoError(t, err)
-		id := created["id"]
+		id := created.ID
 		require.NotEmpty(t, id)
 
 		// Get the review
@@ -234,14 +269,13 @@ func (s *ReviewSuite) TestUpdateReview() {
 	s.Run("Normal case: User can update their own review", func() {
 		t := s.T()
 
-		userID := dbtest.CreateTestUser(t, s.DB, "reviewer@example.com", string(user.RoleAdmin))
-		resourceID := dbtest.CreateTestResource(t, s.DB, "Test Resource", 60)
-
-		now := time.Now()
-		reservationID := dbtest.CreateTestReservation(t, s.DB, resourceID, userID,
-			now.Add(-2*time.Hour), now.Add(-1*time.Hour), "confirmed")
-
-		token := authtest.LoginUser(t, s.Router, "reviewer@example.com", "password123")
+		sc := dbtest.Scenario(t, s.DB).
+			WithUserEmail("reviewer@example.com", string(user.RoleAdmin)).
+			WithResourceNamed("Test Resource", 60).
+			WithCompletedReservation().
+			Build()
+		resourceID, reservationID := sc.ResourceID, sc.ReservationID
+		token := authtest.LoginAs(t, s.Router, sc.User)
 
 		// Create a review first
 		createReq := builder.NewReviewBuilder().
@@ -254,10 +288,10 @@ func (s *ReviewSuite) TestUpdateReview() {
 		createResp := httptest.PerformRequest(t, s.Router, http.MethodPost, reviewsURL, createReq, token)
 		require.Equal(t, http.StatusCreated, createResp.Code)
 
-		var created map[string]string
+		var created response.CreatedResponse
 		err := httptest.DecodeResponseBody(t, createResp.Body, &created)
 		require.NoError(t, err)
-		id := created["id"]
+		id := created.ID
 		require.NotEmpty(t, id)
 
 		// Update the review
@@ -286,14 +320,13 @@ func (s *ReviewSuite) TestUpdateReview() {
 	s.Run("Normal case: Partial update (rating only)", func() {
 		t := s.T()
 
-		userID := dbtest.CreateTestUser(t, s.DB, "reviewer2@example.com", string(user.RoleAdmin))
-		resourceID := dbtest.CreateTestResource(t, s.DB, "Test Resource 2", 60)
-
-		now := time.Now()
-		reservationID := dbtest.CreateTestReservation(t, s.DB, resourceID, userID,
-			now.Add(-2*time.Hour), now.Add(-1*time.Hour), "confirmed")
-
-		token := authtest.LoginUser(t, s.Router, "reviewer2@example.com", "password123")
+		sc := dbtest.Scenario(t, s.DB).
+			WithUserEmail("reviewer2@example.com", string(user.RoleAdmin)).
+			WithResourceNamed("Test Resource 2", 60).
+			WithCompletedReservation().
+			Build()
+		resourceID, reservationID := sc.ResourceID, sc.ReservationID
+		token := authtest.LoginAs(t, s.Router, sc.User)
 
 		// Create a review first
 		createReq := builder.NewReviewBuilder().
@@ -306,10 +339,10 @@ func (s *ReviewSuite) TestUpdateReview() {
 		createResp := httptest.PerformRequest(t, s.Router, http.MethodPost, reviewsURL, createReq, token)
 		require.Equal(t, http.StatusCreated, createResp.Code)
 
-		var created map[string]string
+		var created response.CreatedResponse
 		err := httptest.DecodeResponseBody(t, createResp.Body, &created)
 		require.NoError(t, err)
-		id := created["id"]
+		id := created.ID
 		require.NotEmpty(t, id)
 
 		// Update only rating
@@ -336,14 +369,13 @@ func (s *ReviewSuite) TestUpdateReview() {
 	s.Run("Auth test - Unauthorized when not logged in", func() {
 		t := s.T()
 
-		userID := dbtest.CreateTestUser(t, s.DB, "reviewer3@example.com", string(user.RoleAdmin))
-		resourceID := dbtest.CreateTestResource(t, s.DB, "Test Resource 3", 60)
-
-		now := time.Now()
-		reservationID := dbtest.CreateTestReservation(t, s.DB, resourceID, userID,
-			now.Add(-2*time.Hour), now.Add(-1*time.Hour), "confirmed")
-
-		token := authtest.LoginUser(t, s.Router, "reviewer3@example.com", "password123")
+		sc := dbtest.Scenario(t, s.DB).
+			WithUserEmail("reviewer3@example.com", string(user.RoleAdmin)).
+			WithResourceNamed("Test Resource 3", 60).
+			WithCompletedReservation().
+			Build()
+		resourceID, reservationID := sc.ResourceID, sc.ReservationID
+		token := authtest.LoginAs(t, s.Router, sc.User)
 
 		// Create a review first
 		createReq := builder.NewReviewBuilder().
@@ -356,10 +388,10 @@ func (s *ReviewSuite) TestUpdateReview() {
 		createResp := httptest.PerformRequest(t, s.Router, http.MethodPost, reviewsURL, createReq, token)
 		require.Equal(t, http.StatusCreated, createResp.Code)
 
-		var created map[string]string
+		var created response.CreatedResponse
 		err := httptest.DecodeResponseBody(t, createResp.Body, &created)
 		require.NoError(t, err)
-		id := created["id"]
+		id := created.ID
 		require.NotEmpty(t, id)
 
 		// Try to update without authentication
@@ -382,14 +414,13 @@ func (s *ReviewSuite) TestDeleteReview() {
 	s.Run("Normal case: User can delete their own review", func() {
 		t := s.T()
 
-		userID := dbtest.CreateTestUser(t, s.DB, "reviewer@example.com", string(user.RoleAdmin))
-		resourceID := dbtest.CreateTestResource(t, s.DB, "Test Resource", 60)
-
-		now := time.Now()
-		reservationID := dbtest.CreateTestReservation(t, s.DB, resourceID, userID,
-			now.Add(-2*time.Hour), now.Add(-1*time.Hour), "confirmed")
-
-		token := authtest.LoginUser(t, s.Router, "reviewer@example.com", "password123")
+		sc := dbtest.Scenario(t, s.DB).
+			WithUserEmail("reviewer@example.com", string(user.RoleAdmin)).
+			WithResourceNamed("Test Resource", 60).
+			WithCompletedReservation().
+			Build()
+		resourceID, reservationID := sc.ResourceID, sc.ReservationID
+		token := authtest.LoginAs(t, s.Router, sc.User)
 
 		// Create a review first
 		createReq := builder.NewReviewBuilder().
@@ -402,10 +433,10 @@ func (s *ReviewSuite) TestDeleteReview() {
 		createResp := httptest.PerformRequest(t, s.Router, http.MethodPost, reviewsURL, createReq, token)
 		require.Equal(t, http.StatusCreated, createResp.Code)
 
-		var created map[string]string
+		var created response.CreatedResponse
 		err := httptest.DecodeResponseBody(t, createResp.Body, &created)
 		require.NoError(t, err)
-		id := created["id"]
+		id := created.ID
 		require.NotEmpty(t, id)
 
 		// Delete the review
@@ -422,14 +453,13 @@ func (s *ReviewSuite) TestDeleteReview() {
 		t := s.T()
 
 		// Create regular user and their review
-		regularUserID := dbtest.CreateTestUser(t, s.DB, "regular@example.com", string(user.RoleViewer))
-		resourceID := dbtest.CreateTestResource(t, s.DB, "Test Resource", 60)
-
-		now := time.Now()
-		reservationID := dbtest.CreateTestReservation(t, s.DB, resourceID, regularUserID,
-			now.Add(-2*time.Hour), now.Add(-1*time.Hour), "confirmed")
-
-		regularToken := authtest.LoginUser(t, s.Router, "regular@example.com", "password123")
+		sc := dbtest.Scenario(t, s.DB).
+			WithUserEmail("regular@example.com", string(user.RoleViewer)).
+			WithResourceNamed("Test Resource", 60).
+			WithCompletedReservation().
+			Build()
+		resourceID, reservationID := sc.ResourceID, sc.ReservationID
+		regularToken := authtest.LoginAs(t, s.Router, sc.User)
 
 		createReq := builder.NewReviewBuilder().
 			WithResourceID(resourceID).
@@ -441,15 +471,15 @@ func (s *ReviewSuite) TestDeleteReview() {
 		createResp := httptest.PerformRequest(t, s.Router, http.MethodPost, reviewsURL, createReq, regularToken)
 		require.Equal(t, http.StatusCreated, createResp.Code)
 
-		var created map[string]string
+		var created response.CreatedResponse
 		err := httptest.DecodeResponseBody(t, createResp.Body, &created)
 		require.NoError(t, err)
-		id := created["id"]
+		id := created.ID
 		require.NotEmpty(t, id)
 
 		// Admin tries to delete the regular user's review
-		dbtest.CreateTestUser(t, s.DB, "admin@example.com", string(user.RoleAdmin))
-		adminToken := authtest.LoginUser(t, s.Router, "admin@example.com", "password123")
+		admin := dbtest.Scenario(t, s.DB).WithUserEmail("admin@example.com", string(user.RoleAdmin)).Build()
+		adminToken := authtest.LoginAs(t, s.Router, admin.User)
 
 		url := reviewsURL + "/" + id
 		w := httptest.PerformRequest(t, s.Router, http.MethodDelete, url, nil, adminToken)
@@ -459,14 +489,13 @@ func (s *ReviewSuite) TestDeleteReview() {
 	s.Run("Auth test - Unauthorized when not logged in", func() {
 		t := s.T()
 
-		userID := dbtest.CreateTestUser(t, s.DB, "reviewer2@example.com", string(user.RoleAdmin))
-		resourceID := dbtest.CreateTestResource(t, s.DB, "Test Resource 2", 60)
-
-		now := time.Now()
-		reservationID := dbtest.CreateTestReservation(t, s.DB, resourceID, userID,
-			now.Add(-2*time.Hour), now.Add(-1*time.Hour), "confirmed")
-
-		token := authtest.LoginUser(t, s.Router, "reviewer2@example.com", "password123")
+		sc := dbtest.Scenario(t, s.DB).
+			WithUserEmail("reviewer2@example.com", string(user.RoleAdmin)).
+			WithResourceNamed("Test Resource 2", 60).
+			WithCompletedReservation().
+			Build()
+		resourceID, reservationID := sc.ResourceID, sc.ReservationID
+		token := authtest.LoginAs(t, s.Router, sc.User)
 
 		// Create a review first
 		createReq := builder.NewReviewBuilder().
@@ -479,10 +508,10 @@ func (s *ReviewSuite) TestDeleteReview() {
 		createResp := httptest.PerformRequest(t, s.Router, http.MethodPost, reviewsURL, createReq, token)
 		require.Equal(t, http.StatusCreated, createResp.Code)
 
-		var created map[string]string
+		var created response.CreatedResponse
 		err := httptest.DecodeResponseBody(t, createResp.Body, &created)
 		require.NoError(t, err)
-		id := created["id"]
+		id := created.ID
 		require.NotEmpty(t, id)
 
 		// Try to delete without authentication
@@ -500,18 +529,16 @@ func (s *ReviewSuite) TestListResourceReviews() {
 	s.Run("Normal case: Reviews list retrieved with default parameters", func() {
 		t := s.T()
 
-		resourceID := dbtest.CreateTestResource(t, s.DB, "Test Resource", 60)
-		user1ID := dbtest.CreateTestUser(t, s.DB, "user1@example.com", string(user.RoleAdmin))
-		user2ID := dbtest.CreateTestUser(t, s.DB, "user2@example.com", string(user.RoleAdmin))
-
-		now := time.Now()
-		reservation1ID := dbtest.CreateTestReservation(t, s.DB, resourceID, user1ID,
-			now.Add(-3*time.Hour), now.Add(-2*time.Hour), "confirmed")
-		reservation2ID := dbtest.CreateTestReservation(t, s.DB, resourceID, user2ID,
-			now.Add(-2*time.Hour), now.Add(-1*time.Hour), "confirmed")
+		sc := dbtest.Scenario(t, s.DB).
+			WithResourceNamed("Test Resource", 60).
+			WithUserEmail("user1@example.com", string(user.RoleAdmin)).WithCompletedReservation().
+			WithUserEmail("user2@example.com", string(user.RoleAdmin)).WithCompletedReservation().
+			Build()
+		resourceID := sc.ResourceID
+		reservation1ID, reservation2ID := sc.ReservationIDs[0], sc.ReservationIDs[1]
 
-		token1 := authtest.LoginUser(t, s.Router, "user1@example.com", "password123")
-		token2 := authtest.LoginUser(t, s.Router, "user2@example.com", "password123")
+		token1 := authtest.LoginAs(t, s.Router, sc.Users[0])
+		token2 := authtest.LoginAs(t, s.Router, sc.Users[1])
 
 		// Create 2 reviews
 		review1Req := builder.NewReviewBuilder().
@@ -584,22 +611,18 @@ func (s *ReviewSuite) TestListResourceReviews() {
 		for _, tc := range testCases {
 			s.Run(tc.name, func() {
 				// fresh seed per case (DB reset runs between subtests)
-				resourceID := dbtest.CreateTestResource(t, s.DB, "Filter Test Resource", 60)
-				user1ID := dbtest.CreateTestUser(t, s.DB, "filter1@example.com", string(user.RoleAdmin))
-				user2ID := dbtest.CreateTestUser(t, s.DB, "filter2@example.com", string(user.RoleAdmin))
-				user3ID := dbtest.CreateTestUser(t, s.DB, "filter3@example.com", string(user.RoleAdmin))
-
-				now := time.Now()
-				reservation1ID := dbtest.CreateTestReservation(t, s.DB, resourceID, user1ID,
-					now.Add(-4*time.Hour), now.Add(-3*time.Hour), "confirmed")
-				reservation2ID := dbtest.CreateTestReservation(t, s.DB, resourceID, user2ID,
-					now.Add(-3*time.Hour), now.Add(-2*time.Hour), "confirmed")
-				reservation3ID := dbtest.CreateTestReservation(t, s.DB, resourceID, user3ID,
-					now.Add(-2*time.Hour), now.Add(-1*time.Hour), "confirmed")
-
-				token1 := authtest.LoginUser(t, s.Router, "filter1@example.com", "password123")
-				token2 := authtest.LoginUser(t, s.Router, "filter2@example.com", "password123")
-				token3 := authtest.LoginUser(t, s.Router, "filter3@example.com", "password123")
+				sc := dbtest.Scenario(t, s.DB).
+					WithResourceNamed("Filter Test Resource", 60).
+					WithUserEmail("filter1@example.com", string(user.RoleAdmin)).WithCompletedReservation().
+					WithUserEmail("filter2@example.com", string(user.RoleAdmin)).WithCompletedReservation().
+					WithUserEmail("filter3@example.com", string(user.RoleAdmin)).WithCompletedReservation().
+					Build()
+				resourceID := sc.ResourceID
+				reservation1ID, reservation2ID, reservation3ID := sc.ReservationIDs[0], sc.ReservationIDs[1], sc.ReservationIDs[2]
+
+				token1 := authtest.LoginAs(t, s.Router, sc.Users[0])
+				token2 := authtest.LoginAs(t, s.Router, sc.Users[1])
+				token3 := authtest.LoginAs(t, s.Router, sc.Users[2])
 
 				for _, rv := range []struct {
 					token   string
@@ -636,6 +659,261 @@ func (s *ReviewSuite) TestListResourceReviews() {
 			})
 		}
 	})
+
+	s.Run("Normal case: fields keeps only the listed review fields", func() {
+		t := s.T()
+
+		sc := dbtest.Scenario(t, s.DB).WithResource().WithUser(string(user.RoleViewer)).WithCompletedReservation().Build()
+		req := builder.NewReviewBuilder().WithResourceID(sc.ResourceID).WithReservationID(sc.ReservationID).WithRating(4).BuildCreateRequestDTO()
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, reviewsURL, req, authtest.LoginAs(t, s.Router, sc.User))
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+		url := fmt.Sprintf(resourceReviewsURL, sc.ResourceID.String())
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, url+"?fields=id,rating", nil, "")
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var page struct {
+			Reviews []map[string]any `json:"reviews"`
+		}
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &page))
+		require.Len(t, page.Reviews, 1)
+		require.Len(t, page.Reviews[0], 2)
+		require.Equal(t, float64(4), page.Reviews[0]["rating"])
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, url+"?fields=id,nope", nil, "")
+		httptest.AssertErrorCode(t, w, http.StatusBadRequest, "INVALID_FIELDS")
+	})
+}
+
+// =============================================================================
+// TestDuplicateReviews - near-copy moderation API tests
+// =============================================================================
+
+func (s *ReviewSuite) TestDuplicateReviews() {
+	s.Run("Normal case: a copied comment is held for moderation until approved", func() {
+		t := s.T()
+
+		sc := dbtest.Scenario(t, s.DB).
+			WithUserEmail("copycat@example.com", string(user.RoleViewer)).
+			WithResourceNamed("Moderated Room", 60).WithCompletedReservation().WithCompletedReservation().
+			Build()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+		token := authtest.LoginAs(t, s.Router, sc.User)
+		adminToken := authtest.LoginAs(t, s.Router, admin.User)
+
+		comment := "Spotless room, friendly staff and a great view of the bay"
+		var ids []string
+		for _, reservationID := range sc.ReservationIDs {
+			req := builder.NewReviewBuilder().
+				WithResourceID(sc.ResourceID).
+				WithReservationID(reservationID).
+				WithRating(5).
+				WithComment(comment).
+				BuildCreateRequestDTO()
+			w := httptest.PerformRequest(t, s.Router, http.MethodPost, reviewsURL, req, token)
+			require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+			var created response.CreatedResponse
+			require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &created))
+			ids = append(ids, created.ID)
+		}
+		copyURL := reviewsURL + "/" + ids[1]
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, copyURL, nil, "")
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "REVIEW_NOT_FOUND")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, fmt.Sprintf(userReviewsURL, sc.User.ID), nil, token)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var mine response.ReviewListPageResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &mine))
+		require.Len(t, mine.Reviews, 2)
+		require.Equal(t, ids[1], mine.Reviews[0].ID.String())
+		require.Equal(t, "flagged", mine.Reviews[0].Status)
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, fmt.Sprintf(ratingStatsURL, sc.ResourceID), nil, "")
+		var stats response.ResourceRatingStatsResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &stats))
+		require.Equal(t, int32(1), stats.TotalReviews, "flagged reviews are not counted")
+
+		flaggedURL := fmt.Sprintf("/api/admin/resources/%s/reviews/flagged", sc.ResourceID)
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, flaggedURL, nil, token)
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "PERMISSION_DENIED")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, flaggedURL, nil, adminToken)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var queue response.FlaggedReviewListResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &queue))
+		require.Len(t, queue.Reviews, 1)
+		require.Equal(t, ids[1], queue.Reviews[0].ID.String())
+		require.NotNil(t, queue.Reviews[0].DuplicateOf)
+		require.Equal(t, ids[0], queue.Reviews[0].DuplicateOf.String())
+
+		approveURL := "/api/admin/reviews/" + ids[1] + "/approve"
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, approveURL, nil, adminToken)
+		require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, approveURL, nil, adminToken)
+		httptest.AssertErrorCode(t, w, http.StatusConflict, "REVIEW_NOT_FLAGGED")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, copyURL, nil, "")
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, fmt.Sprintf(ratingStatsURL, sc.ResourceID), nil, "")
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &stats))
+		require.Equal(t, int32(2), stats.TotalReviews)
+	})
+}
+
+func (s *ReviewSuite) TestReviewLanguages() {
+	s.Run("Normal case: comments are tagged with their language, filterable and counted", func() {
+		t := s.T()
+
+		sc := dbtest.Scenario(t, s.DB).
+			WithResourceNamed("Polyglot Room", 60).
+			WithUserEmail("lang1@example.com", string(user.RoleViewer)).WithCompletedReservation().
+			WithUserEmail("lang2@example.com", string(user.RoleViewer)).WithCompletedReservation().
+			WithUserEmail("lang3@example.com", string(user.RoleViewer)).WithCompletedReservation().
+			Build()
+
+		comments := []string{
+			"The room was quiet and the projector worked very well",
+			"Der Raum war sehr ruhig und das Licht war gut",
+			"Great!",
+		}
+		ids := make([]string, len(comments))
+		for i, comment := range comments {
+			req := builder.NewReviewBuilder().
+				WithResourceID(sc.ResourceID).
+				WithReservationID(sc.ReservationIDs[i]).
+				WithRating(4).
+				WithComment(comment).
+				BuildCreateRequestDTO()
+			w := httptest.PerformRequest(t, s.Router, http.MethodPost, reviewsURL, req, authtest.LoginAs(t, s.Router, sc.Users[i]))
+			require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+			var created response.CreatedResponse
+			require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &created))
+			ids[i] = created.ID
+		}
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, reviewsURL+"/"+ids[1], nil, "")
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var detail response.ReviewResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &detail))
+		require.NotNil(t, detail.Language)
+		require.Equal(t, "de", *detail.Language)
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, fmt.Sprintf(resourceReviewsURL, sc.ResourceID)+"?lang=de", nil, "")
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var page response.ReviewListPageResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &page))
+		require.Len(t, page.Reviews, 1)
+		require.Equal(t, ids[1], page.Reviews[0].ID.String())
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, fmt.Sprintf(ratingStatsURL, sc.ResourceID), nil, "")
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var stats response.ResourceRatingStatsResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &stats))
+		require.Equal(t, int32(3), stats.TotalReviews)
+		require.Equal(t, map[string]int32{"en": 1, "de": 1}, stats.LanguageCounts)
+	})
+
+	s.Run("Error case: lang must be an ISO 639-1 code", func() {
+		t := s.T()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, fmt.Sprintf(resourceReviewsURL, uuid.New())+"?lang=deutsch", nil, "")
+		httptest.AssertErrorCode(t, w, http.StatusBadRequest, "INVALID_LANGUAGE")
+	})
+}
+
+// =============================================================================
+// TestExportReviews - Review export API tests
+// =============================================================================
+
+func (s *ReviewSuite) TestExportReviews() {
+	s.Run("Normal case: CSV export lists the user's reviews with resource names", func() {
+		t := s.T()
+
+		sc := dbtest.Scenario(t, s.DB).
+			WithResourceNamed("Export Room", 60).
+			WithUserEmail("export@example.com", string(user.RoleViewer)).WithCompletedReservation().
+			Build()
+		token := authtest.LoginAs(t, s.Router, sc.User)
+
+		req := builder.NewReviewBuilder().
+			WithResourceID(sc.ResourceID).
+			WithReservationID(sc.ReservationID).
+			WithRating(5).
+			WithComment("=SUM(A1) the room was fine").
+			BuildCreateRequestDTO()
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, reviewsURL, req, token)
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, reviewExportURL+"?format=csv", nil, token)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		require.Equal(t, "text/csv; charset=utf-8", w.Header().Get("Content-Type"))
+
+		lines := strings.Split(strings.TrimSpace(w.Body.String()), "\n")
+		require.Len(t, lines, 2)
+		require.Equal(t, strings.Join(response.ReviewExportCSVHeader, ","), lines[0])
+		require.Contains(t, lines[1], "Export Room")
+		require.Contains(t, lines[1], "'=SUM(A1) the room was fine")
+	})
+
+	s.Run("Error case: unknown format is rejected", func() {
+		t := s.T()
+
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+		token := authtest.LoginAs(t, s.Router, sc.User)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, reviewExportURL+"?format=xml", nil, token)
+		httptest.AssertErrorCode(t, w, http.StatusBadRequest, "INVALID_EXPORT_FORMAT")
+	})
+}
+
+// =============================================================================
+// TestReviewFeed - Public Atom feed of a resource's reviews
+// =============================================================================
+
+func (s *ReviewSuite) TestReviewFeed() {
+	s.Run("Normal case: feed lists published reviews and honours If-None-Match", func() {
+		t := s.T()
+
+		sc := dbtest.Scenario(t, s.DB).
+			WithResourceNamed("Feed Room", 60).
+			WithUserEmail("feed@example.com", string(user.RoleViewer)).WithCompletedReservation().
+			Build()
+		token := authtest.LoginAs(t, s.Router, sc.User)
+
+		req := builder.NewReviewBuilder().
+			WithResourceID(sc.ResourceID).
+			WithReservationID(sc.ReservationID).
+			WithRating(4).
+			WithComment("Bright room & friendly staff").
+			BuildCreateRequestDTO()
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, reviewsURL, req, token)
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+		url := fmt.Sprintf(reviewFeedURL, sc.ResourceID.String())
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, url, nil, "")
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		require.Equal(t, "application/atom+xml; charset=utf-8", w.Header().Get("Content-Type"))
+		require.Equal(t, "public, max-age=300, stale-while-revalidate=60", w.Header().Get("Cache-Control"))
+		require.NotEmpty(t, w.Header().Get("Last-Modified"))
+		body := w.Body.String()
+		require.Contains(t, body, "<title>Feed Room reviews</title>")
+		require.Contains(t, body, "Bright room &amp; friendly staff")
+		require.NotContains(t, body, "feed@example.com")
+
+		etag := w.Header().Get("ETag")
+		require.NotEmpty(t, etag)
+		w = httptest.PerformRequestWithHeaders(t, s.Router, http.MethodGet, url, nil, "", map[string]string{"If-None-Match": etag})
+		require.Equal(t, http.StatusNotModified, w.Code)
+	})
+
+	s.Run("Error case: unknown resource returns 404", func() {
+		t := s.T()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, fmt.Sprintf(reviewFeedURL, uuid.New().String()), nil, "")
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "RESOURCE_NOT_FOUND")
+	})
 }
 
 // =============================================================================
@@ -646,17 +924,16 @@ func (s *ReviewSuite) TestListUserReviews() {
 	s.Run("Normal case: User reviews list retrieved successfully", func() {
 		t := s.T()
 
-		userID := dbtest.CreateTestUser(t, s.DB, "listuser@example.com", string(user.RoleAdmin))
-		resource1ID := dbtest.CreateTestResource(t, s.DB, "Test Resource 1", 60)
-		resource2ID := dbtest.CreateTestResource(t, s.DB, "Test Resource 2", 60)
-
-		now := time.Now()
-		reservation1ID := dbtest.CreateTestReservation(t, s.DB, resource1ID, userID,
-			now.Add(-4*time.Hour), now.Add(-3*time.Hour), "confirmed")
-		reservation2ID := dbtest.CreateTestReservation(t, s.DB, resource2ID, userID,
-			now.Add(-3*time.Hour), now.Add(-2*time.Hour), "confirmed")
+		sc := dbtest.Scenario(t, s.DB).
+			WithUserEmail("listuser@example.com", string(user.RoleAdmin)).
+			WithResourceNamed("Test Resource 1", 60).WithCompletedReservation().
+			WithResourceNamed("Test Resource 2", 60).WithCompletedReservation().
+			Build()
+		userID := sc.User.ID
+		resource1ID, resource2ID := sc.ResourceIDs[0], sc.ResourceIDs[1]
+		reservation1ID, reservation2ID := sc.ReservationIDs[0], sc.ReservationIDs[1]
 
-		token := authtest.LoginUser(t, s.Router, "listuser@example.com", "password123")
+		token := authtest.LoginAs(t, s.Router, sc.User)
 
 		// Create 2 reviews by the same user
 		review1Req := builder.NewReviewBuilder().
@@ -692,19 +969,19 @@ func (s *ReviewSuite) TestListUserReviews() {
 	s.Run("Normal case: Integration test (pagination)", func() {
 		t := s.T()
 
-		userID := dbtest.CreateTestUser(t, s.DB, "paginguser@example.com", string(user.RoleAdmin))
-		token := authtest.LoginUser(t, s.Router, "paginguser@example.com", "password123")
+		sc := dbtest.Scenario(t, s.DB).WithUserEmail("paginguser@example.com", string(user.RoleAdmin))
+		for i := range 5 {
+			sc.WithResourceNamed(fmt.Sprintf("Resource %d", i), 60).WithCompletedReservation()
+		}
+		fx := sc.Build()
+		userID := fx.User.ID
+		token := authtest.LoginAs(t, s.Router, fx.User)
 
 		// Create multiple reviews for pagination test
-		now := time.Now()
 		for i := range 5 {
-			resourceID := dbtest.CreateTestResource(t, s.DB, fmt.Sprintf("Resource %d", i), 60)
-			reservationID := dbtest.CreateTestReservation(t, s.DB, resourceID, userID,
-				now.Add(time.Duration(-6+i)*time.Hour), now.Add(time.Duration(-5+i)*time.Hour), "confirmed")
-
 			reviewReq := builder.NewReviewBuilder().
-				WithResourceID(resourceID).
-				WithReservationID(reservationID).
+				WithResourceID(fx.ResourceIDs[i]).
+				WithReservationID(fx.ReservationIDs[i]).
 				WithRating(4).
 				WithComment(fmt.Sprintf("Review %d", i)).
 				BuildCreateRequestDTO()
@@ -729,7 +1006,7 @@ func (s *ReviewSuite) TestListUserReviews() {
 	s.Run("Auth test - Unauthorized when not logged in", func() {
 		t := s.T()
 
-		userID := dbtest.CreateTestUser(t, s.DB, "authuser@example.com", string(user.RoleAdmin))
+		userID := dbtest.Scenario(t, s.DB).WithUserEmail("authuser@example.com", string(user.RoleAdmin)).Build().User.ID
 		url := fmt.Sprintf(userReviewsURL, userID.String())
 		w := httptest.PerformRequest(t, s.Router, http.MethodGet, url, nil, "")
 		require.Equal(t, http.StatusUnauthorized, w.Code, "Should reject unauthorized access")
@@ -744,22 +1021,18 @@ func (s *ReviewSuite) TestResourceRatingStats() {
 	s.Run("Normal case: Rating statistics retrieved successfully", func() {
 		t := s.T()
 
-		resourceID := dbtest.CreateTestResource(t, s.DB, "Stats Test Resource", 60)
-		user1ID := dbtest.CreateTestUser(t, s.DB, "stats1@example.com", string(user.RoleAdmin))
-		user2ID := dbtest.CreateTestUser(t, s.DB, "stats2@example.com", string(user.RoleAdmin))
-		user3ID := dbtest.CreateTestUser(t, s.DB, "stats3@example.com", string(user.RoleAdmin))
-
-		now := time.Now()
-		reservation1ID := dbtest.CreateTestReservation(t, s.DB, resourceID, user1ID,
-			now.Add(-4*time.Hour), now.Add(-3*time.Hour), "confirmed")
-		reservation2ID := dbtest.CreateTestReservation(t, s.DB, resourceID, user2ID,
-			now.Add(-3*time.Hour), now.Add(-2*time.Hour), "confirmed")
-		reservation3ID := dbtest.CreateTestReservation(t, s.DB, resourceID, user3ID,
-			now.Add(-2*time.Hour), now.Add(-1*time.Hour), "confirmed")
+		sc := dbtest.Scenario(t, s.DB).
+			WithResourceNamed("Stats Test Resource", 60).
+			WithUserEmail("stats1@example.com", string(user.RoleAdmin)).WithCompletedReservation().
+			WithUserEmail("stats2@example.com", string(user.RoleAdmin)).WithCompletedReservation().
+			WithUserEmail("stats3@example.com", string(user.RoleAdmin)).WithCompletedReservation().
+			Build()
+		resourceID := sc.ResourceID
+		reservation1ID, reservation2ID, reservation3ID := sc.ReservationIDs[0], sc.ReservationIDs[1], sc.ReservationIDs[2]
 
-		token1 := authtest.LoginUser(t, s.Router, "stats1@example.com", "password123")
-		token2 := authtest.LoginUser(t, s.Router, "stats2@example.com", "password123")
-		token3 := authtest.LoginUser(t, s.Router, "stats3@example.com", "password123")
+		token1 := authtest.LoginAs(t, s.Router, sc.Users[0])
+		token2 := authtest.LoginAs(t, s.Router, sc.Users[1])
+		token3 := authtest.LoginAs(t, s.Router, sc.Users[2])
 
 		// Create reviews with ratings: 5, 4, 3
 		reviews := []struct {
@@ -806,6 +1079,8 @@ func (s *ReviewSuite) TestResourceRatingStats() {
 			Rating3Count: int32(1),
 			Rating4Count: int32(1),
 			Rating5Count: int32(1),
+			// none of the comments has enough common words to tell its language
+			LanguageCounts: map[string]int32{},
 		}
 
 		opts := []cmp.Option{
@@ -830,9 +1105,10 @@ func (s *ReviewSuite) TestResourceRatingStats() {
 		require.NoError(t, err)
 
 		expected := &response.ResourceRatingStatsResponse{
-			ResourceID:    nonExistentResourceID,
-			TotalReviews:  int32(0),
-			AverageRating: 0.0,
+			ResourceID:     nonExistentResourceID,
+			TotalReviews:   int32(0),
+			AverageRating:  0.0,
+			LanguageCounts: map[string]int32{},
 		}
 
 		opts := []cmp.Option{
@@ -844,3 +1120,93 @@ func (s *ReviewSuite) TestResourceRatingStats() {
 		}
 	})
 }
+
+// =============================================================================
+// TestImportReviews - Review import API tests
+// =============================================================================
+
+func (s *ReviewSuite) TestImportReviews() {
+	url := "/api/admin/reviews/import"
+	ctx := context.Background()
+
+	s.Run("Normal case: historical reviews are imported once and counted in the stats", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).
+			WithCompany().
+			WithUser(string(user.RoleAdmin)).
+			WithUser(string(user.RoleViewer)).
+			WithUser(string(user.RoleViewer)).
+			WithResource().
+			Build()
+		admin, scimMember, emailAuthor := sc.Users[0], sc.Users[1], sc.Users[2]
+		_, err := s.DB.Exec(ctx, `UPDATE users SET external_id = 'legacy-42' WHERE id = $1`, scimMember.ID)
+		require.NoError(t, err)
+		token := authtest.LoginAs(t, s.Router, admin)
+
+		created := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+		item := func(externalID string, rating int) request.ImportReviewItemRequest {
+			return request.ImportReviewItemRequest{ExternalID: externalID, ResourceID: sc.ResourceID, AuthorEmail: emailAuthor.Email, Rating: rating, Comment: "Quiet and clean", CreatedAt: created}
+		}
+		bySCIM := item("r-1", 5)
+		bySCIM.AuthorEmail, bySCIM.AuthorExternalID = "", "legacy-42"
+		stranger := item("r-3", 4)
+		stranger.AuthorEmail = "nobody-" + uuid.NewString()[:8] + "@example.com"
+		body := request.ImportReviewsRequest{Source: "legacy-crm-" + uuid.NewString()[:8], Reviews: []request.ImportReviewItemRequest{
+			bySCIM, item("r-2", 3), stranger, item("r-4", 9),
+		}}
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, url, body, token)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var report response.ReviewImportResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &report))
+		assert.Equal(t, 2, report.Imported)
+		assert.Equal(t, 2, report.Rejected)
+		statuses := make([]string, len(report.Items))
+		for i, it := range report.Items {
+			statuses[i] = it.Status + " " + it.Code
+		}
+		assert.Equal(t, []string{"imported ", "imported ", "rejected REVIEW_AUTHOR_NOT_FOUND", "rejected VALIDATION_FAILED"}, statuses)
+
+		require.NotNil(t, report.Items[0].ReviewID)
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, reviewsURL+"/"+report.Items[0].ReviewID.String(), nil, token)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var got response.ReviewResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &got))
+		assert.Equal(t, scimMember.ID.String(), got.UserID)
+		assert.Nil(t, got.ReservationID)
+		assert.Equal(t, created.Unix(), got.CreatedAt)
+
+		// Importing the same export again skips what is already in.
+		body.Reviews = []request.ImportReviewItemRequest{bySCIM, item("r-5", 4)}
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, url, body, token)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &report))
+		assert.Equal(t, 1, report.Imported)
+		assert.Equal(t, 1, report.Duplicates)
+		assert.Equal(t, "duplicate", report.Items[0].Status)
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, fmt.Sprintf(ratingStatsURL, sc.ResourceID), nil, "")
+		require.Equal(t, http.StatusOK, w.Code)
+		var stats response.ResourceRatingStatsResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &stats))
+		assert.Equal(t, int32(3), stats.TotalReviews)
+		assert.InDelta(t, 4.0, stats.AverageRating, 0.01)
+
+		var audits int
+		require.NoError(t, s.DB.QueryRow(ctx,
+			`SELECT count(*) FROM audit_logs WHERE action = 'resource.reviews_imported' AND target_id = $1`,
+			sc.ResourceID.String()).Scan(&audits))
+		assert.Equal(t, 2, audits)
+	})
+
+	s.Run("Error case: viewers cannot import", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).WithResource().Build()
+		body := request.ImportReviewsRequest{Source: "legacy-crm", Reviews: []request.ImportReviewItemRequest{
+			{ExternalID: "r-1", ResourceID: sc.ResourceID, AuthorEmail: sc.User.Email, Rating: 5, Comment: "Great", CreatedAt: time.Now().Add(-time.Hour)},
+		}}
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, url, body, authtest.LoginAs(t, s.Router, sc.User))
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "PERMISSION_DENIED")
+	})
+}
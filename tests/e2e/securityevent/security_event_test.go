@@ -0,0 +1,117 @@
+//go:build e2e
+
+package securityevent_test
+
+import (
+	"net/http"
+	"testing"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/tests/common/dbtest"
+	"gin-clean-starter/tests/common/httptest"
+	"gin-clean-starter/tests/e2e"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	loginURL          = "/api/auth/login"
+	securityEventsURL = "/api/users/me/security-events"
+)
+
+type SecurityEventSuite struct {
+	e2e.SharedSuite
+}
+
+func (s *SecurityEventSuite) SetupSubTest() {
+	s.SharedSuite.SetupSubTest()
+}
+
+func TestSecurityEventSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(SecurityEventSuite))
+}
+
+// loginFrom logs the user in with the given User-Agent and returns the access token.
+func (s *SecurityEventSuite) loginFrom(t *testing.T, u dbtest.ScenarioUser, userAgent string) string {
+	t.Helper()
+
+	w := httptest.PerformRequestWithHeaders(t, s.Router, http.MethodPost, loginURL,
+		request.LoginRequest{Email: u.Email, Password: dbtest.DefaultPassword}, "",
+		map[string]string{"User-Agent": userAgent})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	cookie := httptest.ExtractCookie(w, "access_token")
+	require.NotNil(t, cookie)
+	return cookie.Value
+}
+
+func (s *SecurityEventSuite) list(t *testing.T, token, query string) response.SecurityEventListResponse {
+	t.Helper()
+
+	w := httptest.PerformRequest(t, s.Router, http.MethodGet, securityEventsURL+query, nil, token)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var page response.SecurityEventListResponse
+	require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &page))
+	return page
+}
+
+func (s *SecurityEventSuite) TestNewDeviceLogins() {
+	s.Run("Normal case: only logins from unseen devices are listed, newest first", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+
+		s.loginFrom(t, sc.User, "Laptop/1.0")
+		s.loginFrom(t, sc.User, "Laptop/1.0")
+		token := s.loginFrom(t, sc.User, "Phone/2.0")
+
+		page := s.list(t, token, "")
+		require.Len(t, page.Events, 2)
+		assert.Equal(t, "auth.new_device_login", page.Events[0].Action)
+		assert.Equal(t, "Phone/2.0", page.Events[0].UserAgent)
+		assert.NotEmpty(t, page.Events[0].IPAddress)
+		assert.Equal(t, "Laptop/1.0", page.Events[1].UserAgent)
+		assert.Empty(t, page.NextCursor)
+	})
+
+	s.Run("Normal case: pages with a cursor", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+
+		s.loginFrom(t, sc.User, "Laptop/1.0")
+		token := s.loginFrom(t, sc.User, "Phone/2.0")
+
+		first := s.list(t, token, "?limit=1")
+		require.Len(t, first.Events, 1)
+		require.NotEmpty(t, first.NextCursor)
+
+		second := s.list(t, token, "?limit=1&after="+first.NextCursor)
+		require.Len(t, second.Events, 1)
+		assert.NotEqual(t, first.Events[0].ID, second.Events[0].ID)
+	})
+
+	s.Run("Normal case: another user's events are not listed", func() {
+		t := s.T()
+		mine := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+		theirs := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+
+		s.loginFrom(t, theirs.User, "Laptop/1.0")
+		token := s.loginFrom(t, mine.User, "Phone/2.0")
+
+		page := s.list(t, token, "")
+		require.Len(t, page.Events, 1)
+		assert.Equal(t, "Phone/2.0", page.Events[0].UserAgent)
+	})
+
+	s.Run("Error case: invalid cursor", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+		token := s.loginFrom(t, sc.User, "Laptop/1.0")
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, securityEventsURL+"?after=not-a-cursor", nil, token)
+		assert.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+	})
+}
@@ -6,8 +6,10 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"net/http"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"testing"
@@ -15,9 +17,12 @@ import (
 
 	"gin-clean-starter/cmd/bootstrap"
 	"gin-clean-starter/cmd/bootstrap/components"
+	"gin-clean-starter/internal/handler/dto/request"
 	"gin-clean-starter/internal/infra/db"
 	"gin-clean-starter/internal/pkg/config"
 	"gin-clean-starter/tests/common/dbtest"
+	"gin-clean-starter/tests/common/httptest"
+	"gin-clean-starter/tests/common/oidctest"
 
 	"github.com/docker/go-connections/nat"
 	"github.com/gin-gonic/gin"
@@ -86,18 +91,21 @@ func startContainers(t *testing.T) ContainerInfo {
 
 // ------------------------------------------------------------
 // Database Preparation
+// Every suite gets its own database cloned from a template that is migrated and seeded
+// once per process, so parallel suites never share rows and only pay for a file copy.
 // ------------------------------------------------------------
 func prepareDatabase(t *testing.T, postgresInfo ContainerInfo) (*pgxpool.Pool, config.DBConfig) {
-	// Generate unique schema name per process
+	templateName := prepareTemplateDatabaseOnce(t, postgresInfo)
+
+	// Generate unique database name per suite
 	dbName := "testdb_" + strings.ReplaceAll(uuid.New().String(), "-", "")
 
-	adminDSN := fmt.Sprintf("postgres://%s:%s@%s:%s/postgres?sslmode=disable",
-		testUser, testPassword, postgresInfo.Host, postgresInfo.Port.Port())
+	dsn := adminDSN(postgresInfo)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
 	defer cancel()
 
-	adminPool, err := pgxpool.New(ctx, adminDSN)
+	adminPool, err := pgxpool.New(ctx, dsn)
 	require.NoError(t, err, "Failed to connect as admin")
 	defer adminPool.Close()
 
@@ -111,7 +119,7 @@ func prepareDatabase(t *testing.T, postgresInfo ContainerInfo) (*pgxpool.Pool, c
 			waitTime = min(waitTime, 3*time.Second)
 			time.Sleep(waitTime)
 		}
-		_, createErr = adminPool.Exec(ctx, "CREATE DATABASE "+dbName)
+		_, createErr = adminPool.Exec(ctx, "CREATE DATABASE "+dbName+" TEMPLATE "+templateName)
 		if createErr == nil {
 			break
 		}
@@ -128,7 +136,7 @@ func prepareDatabase(t *testing.T, postgresInfo ContainerInfo) (*pgxpool.Pool, c
 		cleanupCtx, cleanupCancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cleanupCancel()
 
-		cleanupPool, err := pgxpool.New(cleanupCtx, adminDSN)
+		cleanupPool, err := pgxpool.New(cleanupCtx, dsn)
 		if err != nil {
 			slog.Warn("Failed to connect for cleanup", "database", dbName, "error", err.Error())
 			return
@@ -141,33 +149,92 @@ func prepareDatabase(t *testing.T, postgresInfo ContainerInfo) (*pgxpool.Pool, c
 		}
 	})
 
-	dbConfig := config.DBConfig{
-		Host:     postgresInfo.Host,
-		Port:     postgresInfo.Port.Port(),
-		User:     testUser,
-		Password: testPassword,
-		DBName:   dbName,
-		SSLMode:  "disable",
-		TimeZone: "Asia/Tokyo",
-	}
+	dbConfig := testDBConfig(postgresInfo, dbName)
 
 	pool, _, err := db.Connect(dbConfig)
 	require.NoError(t, err, "Failed to connect to database")
 	require.NotNil(t, pool, "Database connection is nil")
 
-	err = applyMigrations(t, dbConfig)
-	require.NoError(t, err, "Failed to apply database migrations")
+	if gin.Mode() != gin.TestMode {
+		slog.Info("Database setup complete", "postgres_database", dbName, "template", templateName)
+	}
+	return pool, dbConfig
+}
 
-	if err := dbtest.SeedReferenceData(pool); err != nil {
-		require.NoError(t, err, "Failed to seed reference data")
+var (
+	templateOnce sync.Once
+	templateName string
+	templateErr  error
+)
+
+// prepareTemplateDatabaseOnce migrates and seeds the process-wide template database. It is
+// left in place when the suites finish; the container is thrown away with it.
+func prepareTemplateDatabaseOnce(t *testing.T, postgresInfo ContainerInfo) string {
+	templateOnce.Do(func() {
+		templateName, templateErr = prepareTemplateDatabase(t, postgresInfo)
+	})
+	require.NoError(t, templateErr, "Failed to prepare template database")
+	return templateName
+}
+
+func prepareTemplateDatabase(t *testing.T, postgresInfo ContainerInfo) (string, error) {
+	name := "testtmpl_" + strings.ReplaceAll(uuid.New().String(), "-", "")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	adminPool, err := pgxpool.New(ctx, adminDSN(postgresInfo))
+	if err != nil {
+		return "", fmt.Errorf("failed to connect as admin: %w", err)
 	}
+	defer adminPool.Close()
 
-	if gin.Mode() != gin.TestMode {
-		slog.Info("Database setup complete", "postgres_schema", dbName)
+	if _, err := adminPool.Exec(ctx, "CREATE DATABASE "+name); err != nil {
+		return "", fmt.Errorf("failed to create template database: %w", err)
+	}
+
+	dbConfig := testDBConfig(postgresInfo, name)
+	if err := applyMigrations(t, dbConfig); err != nil {
+		return "", err
+	}
+
+	pool, _, err := db.Connect(dbConfig)
+	if err != nil {
+		return "", fmt.Errorf("failed to connect to template database: %w", err)
+	}
+	err = dbtest.SeedReferenceData(pool)
+	// CREATE DATABASE ... TEMPLATE fails while anyone is connected to the source.
+	pool.Close()
+	if err != nil {
+		return "", fmt.Errorf("failed to seed template database: %w", err)
+	}
+
+	if _, err := adminPool.Exec(ctx, "ALTER DATABASE "+name+" WITH IS_TEMPLATE true ALLOW_CONNECTIONS false"); err != nil {
+		return "", fmt.Errorf("failed to mark template database: %w", err)
+	}
+
+	slog.Info("Template database ready", "template", name)
+	return name, nil
+}
+
+func adminDSN(postgresInfo ContainerInfo) string {
+	return fmt.Sprintf("postgres://%s:%s@%s:%s/postgres?sslmode=disable",
+		testUser, testPassword, postgresInfo.Host, postgresInfo.Port.Port())
+}
+
+func testDBConfig(postgresInfo ContainerInfo, dbName string) config.DBConfig {
+	return config.DBConfig{
+		Host:     postgresInfo.Host,
+		Port:     postgresInfo.Port.Port(),
+		User:     testUser,
+		Password: testPassword,
+		DBName:   dbName,
+		SSLMode:  "disable",
+		TimeZone: "Asia/Tokyo",
 	}
-	return pool, dbConfig
 }
 
+// applyMigrations runs migrations/*.sql in file name order, so new migrations are picked up without edits here.
 func applyMigrations(t *testing.T, dbConfig config.DBConfig) error {
 	t.Helper()
 
@@ -179,32 +246,20 @@ func applyMigrations(t *testing.T, dbConfig config.DBConfig) error {
 	}
 	defer pool.Close()
 
-	migrationFiles := []string{
-		"migrations/001_initial_schema.sql",
-		"migrations/002_review_schema.sql",
+	dir, err := findMigrationsDir()
+	if err != nil {
+		return err
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "*.sql"))
+	if err != nil {
+		return fmt.Errorf("failed to list migrations: %w", err)
 	}
+	sort.Strings(files)
 
-	for _, file := range migrationFiles {
-		// Resolve migration file path relative to possible working dirs (package dirs during `go test`).
-		var (
-			sqlContent []byte
-			readErr    error
-		)
-		candidates := []string{
-			file, // repo root
-			filepath.Join("..", file),
-			filepath.Join("..", "..", file),
-			filepath.Join("..", "..", "..", file),
-		}
-		for _, cand := range candidates {
-			sqlContent, readErr = os.ReadFile(cand)
-			if readErr == nil {
-				file = cand
-				break
-			}
-		}
-		if readErr != nil {
-			return fmt.Errorf("failed to read migration file %s: %w", file, readErr)
+	for _, file := range files {
+		sqlContent, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("failed to read migration file %s: %w", file, err)
 		}
 
 		_, err = pool.Exec(ctx, string(sqlContent))
@@ -218,6 +273,22 @@ func applyMigrations(t *testing.T, dbConfig config.DBConfig) error {
 	return nil
 }
 
+// Resolve the migrations directory relative to possible working dirs (package dirs during `go test`).
+func findMigrationsDir() (string, error) {
+	candidates := []string{
+		"migrations", // repo root
+		filepath.Join("..", "migrations"),
+		filepath.Join("..", "..", "migrations"),
+		filepath.Join("..", "..", "..", "migrations"),
+	}
+	for _, cand := range candidates {
+		if info, err := os.Stat(cand); err == nil && info.IsDir() {
+			return cand, nil
+		}
+	}
+	return "", fmt.Errorf("migrations directory not found")
+}
+
 // ------------------------------------------------------------
 // E2E Application Builder
 // Returns router, config, and fx.App for proper lifecycle management
@@ -242,9 +313,21 @@ func buildE2EApp(pool *pgxpool.Pool, dbConfig config.DBConfig) (*gin.Engine, con
 		fx.Provide(func() *gin.Engine { return gin.New() }),
 		bootstrap.LoggerModule,
 		bootstrap.JWTModule,
+		bootstrap.SignerModule,
+		bootstrap.CryptoModule,
+		bootstrap.StorageModule,
+		bootstrap.SummaryModule,
+		bootstrap.LanguageModule,
+		bootstrap.EmailModule,
+		bootstrap.BillingModule,
+		bootstrap.SAMLModule,
+		bootstrap.OIDCModule,
+		bootstrap.AnalyticsModule,
+		bootstrap.SchedulerModule,
 		components.PersistenceModule,
 		components.UseCaseModule,
 		components.HandlerModule,
+		components.JobsModule,
 
 		fx.Populate(&router, &cfg),
 
@@ -269,6 +352,10 @@ func buildE2EApp(pool *pgxpool.Pool, dbConfig config.DBConfig) (*gin.Engine, con
 func createTestConfig(dbConfig config.DBConfig) config.Config {
 	testConfig := config.NewTestConfig()
 	testConfig.DB = dbConfig
+	idp := oidctest.Shared()
+	testConfig.OIDC.ProviderIssuer = idp.URL
+	testConfig.OIDC.ProviderClientID = oidctest.ClientID
+	testConfig.OIDC.ProviderClientSecret = oidctest.ClientSecret
 	return testConfig
 }
 
@@ -396,3 +483,22 @@ func (s *SharedSuite) SetupSubTest() {
 	err := dbtest.ResetDB(s.DB)
 	require.NoError(s.T(), err, "Failed to reset database state")
 }
+
+// SetClockOffset shifts the application clock through POST /api/_test/clock and restores
+// real time when the calling test ends.
+func (s *SharedSuite) SetClockOffset(offset time.Duration) {
+	t := s.T()
+	t.Helper()
+
+	w := httptest.PerformRequest(t, s.Router, http.MethodPost, "/api/_test/clock",
+		request.SetTestClockRequest{Offset: offset.String()}, "")
+	require.Equal(t, http.StatusOK, w.Code, "Failed to set test clock: %s", w.Body.String())
+
+	t.Cleanup(func() {
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, "/api/_test/clock",
+			request.SetTestClockRequest{Offset: "0s"}, "")
+		if w.Code != http.StatusOK {
+			slog.Warn("Failed to reset test clock", "status", w.Code)
+		}
+	})
+}
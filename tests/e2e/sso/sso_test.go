@@ -0,0 +1,245 @@
+//go:build e2e
+
+package sso_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	nethttptest "net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/api"
+	"gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/tests/common/authtest"
+	"gin-clean-starter/tests/common/dbtest"
+	"gin-clean-starter/tests/common/httptest"
+	"gin-clean-starter/tests/common/samltest"
+	"gin-clean-starter/tests/e2e"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	connectionURL = "/api/admin/companies/%s/saml"
+	samlURL       = "/api/auth/saml/%s"
+	meURL         = "/api/auth/me"
+)
+
+type SSOSuite struct {
+	e2e.SharedSuite
+}
+
+func (s *SSOSuite) SetupSubTest() {
+	s.SharedSuite.SetupSubTest()
+}
+
+func TestSSOSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(SSOSuite))
+}
+
+func (s *SSOSuite) adminToken(t *testing.T) string {
+	t.Helper()
+	admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+	return authtest.LoginAs(t, s.Router, admin.User)
+}
+
+// connect trusts idp for companyID, mapping the "groups" value "ops" to operators.
+func (s *SSOSuite) connect(t *testing.T, token string, companyID uuid.UUID, idp *samltest.IdP) response.SAMLConnectionResponse {
+	t.Helper()
+	w := httptest.PerformRequest(t, s.Router, http.MethodPut, fmt.Sprintf(connectionURL, companyID), request.SetSAMLConnectionRequest{
+		MetadataXML:   string(idp.Metadata(t)),
+		RoleAttribute: "groups",
+		RoleMapping:   map[string]string{"ops": string(user.RoleOperator)},
+	}, token)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var conn response.SAMLConnectionResponse
+	require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &conn))
+	return conn
+}
+
+func (s *SSOSuite) spMetadata(t *testing.T, companyID uuid.UUID) []byte {
+	t.Helper()
+	w := httptest.PerformRequest(t, s.Router, http.MethodGet, fmt.Sprintf(samlURL, companyID)+"/metadata", nil, "")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Equal(t, "application/samlmetadata+xml", w.Header().Get("Content-Type"))
+	return w.Body.Bytes()
+}
+
+// startLogin follows the login redirect and returns the AuthnRequest ID and the state cookie.
+func (s *SSOSuite) startLogin(t *testing.T, companyID uuid.UUID) (string, *http.Cookie) {
+	t.Helper()
+	w := httptest.PerformRequest(t, s.Router, http.MethodGet, fmt.Sprintf(samlURL, companyID)+"/login", nil, "")
+	require.Equal(t, http.StatusFound, w.Code, w.Body.String())
+	state := httptest.ExtractCookie(w, api.SAMLStateCookieName)
+	require.NotNil(t, state)
+	return samltest.RequestID(t, w.Header().Get("Location")), state
+}
+
+func (s *SSOSuite) postACS(t *testing.T, companyID uuid.UUID, samlResponse string, state *http.Cookie) *nethttptest.ResponseRecorder {
+	t.Helper()
+	form := url.Values{"SAMLResponse": {samlResponse}, "RelayState": {"https://evil.example/"}}
+	req := nethttptest.NewRequest(http.MethodPost, fmt.Sprintf(samlURL, companyID)+"/acs", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if state != nil {
+		req.AddCookie(state)
+	}
+	w := nethttptest.NewRecorder()
+	s.Router.ServeHTTP(w, req)
+	return w
+}
+
+func (s *SSOSuite) TestLogin() {
+	s.Run("Normal case: a new user signs in, joins the company with the mapped role and is audited", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithCompany().Build()
+		idp := samltest.NewIdP(t)
+		conn := s.connect(t, s.adminToken(t), sc.CompanyID, idp)
+		assert.Equal(t, idp.EntityID, conn.IDPEntityID)
+		assert.Equal(t, string(user.RoleViewer), conn.DefaultRole)
+		assert.True(t, strings.HasSuffix(conn.ACSURL, "/api/auth/saml/"+sc.CompanyID.String()+"/acs"))
+
+		sp := s.spMetadata(t, sc.CompanyID)
+		requestID, state := s.startLogin(t, sc.CompanyID)
+		email := fmt.Sprintf("sso-%s@example.com", uuid.NewString()[:8])
+		w := s.postACS(t, sc.CompanyID, idp.Response(t, sp, requestID, samltest.User{
+			NameID:     email,
+			Attributes: map[string][]string{"groups": {"staff", "ops"}},
+		}), state)
+		require.Equal(t, http.StatusSeeOther, w.Code, w.Body.String())
+		assert.Equal(t, "http://localhost:3000/", w.Header().Get("Location"), "RelayState is not followed")
+		access := httptest.ExtractCookie(w, "access_token")
+		require.NotNil(t, access)
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, meURL, nil, access.Value)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		assert.Contains(t, w.Body.String(), email)
+
+		ctx := context.Background()
+		var role string
+		var companyID uuid.UUID
+		require.NoError(t, s.DB.QueryRow(ctx, `SELECT role, company_id FROM users WHERE email = $1`, email).Scan(&role, &companyID))
+		assert.Equal(t, string(user.RoleOperator), role)
+		assert.Equal(t, sc.CompanyID, companyID)
+
+		var audited int
+		require.NoError(t, s.DB.QueryRow(ctx,
+			`SELECT count(*) FROM audit_logs WHERE action = 'auth.sso_login' AND company_id = $1 AND metadata->>'provisioned' = 'true'`,
+			sc.CompanyID).Scan(&audited))
+		assert.Equal(t, 1, audited)
+	})
+
+	s.Run("Normal case: an existing member gets the role the assertion maps to", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithCompany().WithUser(string(user.RoleOperator)).Build()
+		idp := samltest.NewIdP(t)
+		s.connect(t, s.adminToken(t), sc.CompanyID, idp)
+
+		requestID, state := s.startLogin(t, sc.CompanyID)
+		w := s.postACS(t, sc.CompanyID, idp.Response(t, s.spMetadata(t, sc.CompanyID), requestID, samltest.User{NameID: sc.User.Email}), state)
+		require.Equal(t, http.StatusSeeOther, w.Code, w.Body.String())
+
+		var role string
+		require.NoError(t, s.DB.QueryRow(context.Background(), `SELECT role FROM users WHERE id = $1`, sc.User.ID).Scan(&role))
+		assert.Equal(t, string(user.RoleViewer), role)
+	})
+
+	s.Run("Error case: unsolicited, foreign and misdirected responses are rejected", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithCompany().Build()
+		other := dbtest.Scenario(t, s.DB).WithCompany().WithUser(string(user.RoleViewer)).Build()
+		idp := samltest.NewIdP(t)
+		token := s.adminToken(t)
+		s.connect(t, token, sc.CompanyID, idp)
+		sp := s.spMetadata(t, sc.CompanyID)
+		newUser := samltest.User{NameID: fmt.Sprintf("sso-%s@example.com", uuid.NewString()[:8])}
+
+		w := s.postACS(t, sc.CompanyID, idp.Response(t, sp, "", newUser), nil)
+		httptest.AssertErrorCode(t, w, http.StatusUnauthorized, "SAML_ASSERTION_INVALID")
+
+		requestID, state := s.startLogin(t, sc.CompanyID)
+		w = s.postACS(t, sc.CompanyID, samltest.NewIdP(t).Response(t, sp, requestID, newUser), state)
+		httptest.AssertErrorCode(t, w, http.StatusUnauthorized, "SAML_ASSERTION_INVALID")
+
+		requestID, state = s.startLogin(t, sc.CompanyID)
+		w = s.postACS(t, sc.CompanyID, idp.Response(t, sp, requestID, samltest.User{NameID: other.User.Email}), state)
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "SSO_USER_OTHER_COMPANY")
+
+		// Both companies trust the IdP, but a response addressed to one is not accepted by the other.
+		s.connect(t, token, other.CompanyID, idp)
+		requestID, state = s.startLogin(t, sc.CompanyID)
+		w = s.postACS(t, other.CompanyID, idp.Response(t, sp, requestID, newUser), state)
+		httptest.AssertErrorCode(t, w, http.StatusUnauthorized, "SAML_ASSERTION_INVALID")
+
+		var count int
+		require.NoError(t, s.DB.QueryRow(context.Background(), `SELECT count(*) FROM users WHERE email = $1`, newUser.NameID).Scan(&count))
+		assert.Zero(t, count)
+	})
+
+	s.Run("Error case: companies without a connection cannot start a sign-in", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithCompany().Build()
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, fmt.Sprintf(samlURL, sc.CompanyID)+"/login", nil, "")
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "SAML_CONNECTION_NOT_FOUND")
+	})
+}
+
+func (s *SSOSuite) TestConnection() {
+	s.Run("Normal case: a connection is read back and removed", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithCompany().Build()
+		token := s.adminToken(t)
+		s.connect(t, token, sc.CompanyID, samltest.NewIdP(t))
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, fmt.Sprintf(connectionURL, sc.CompanyID), nil, token)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var conn response.SAMLConnectionResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &conn))
+		assert.Equal(t, map[string]string{"ops": string(user.RoleOperator)}, conn.RoleMapping)
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodDelete, fmt.Sprintf(connectionURL, sc.CompanyID), nil, token)
+		require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, fmt.Sprintf(connectionURL, sc.CompanyID), nil, token)
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "SAML_CONNECTION_NOT_FOUND")
+
+		rows, err := s.DB.Query(context.Background(), `SELECT action FROM audit_logs WHERE company_id = $1 ORDER BY created_at`, sc.CompanyID)
+		require.NoError(t, err)
+		actions, err := pgx.CollectRows(rows, pgx.RowTo[string])
+		require.NoError(t, err)
+		assert.Equal(t, []string{"sso.connection_set", "sso.connection_removed"}, actions)
+	})
+
+	s.Run("Error case: bad metadata, unknown roles and callers without sso:manage are rejected", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithCompany().WithUser(string(user.RoleViewer)).Build()
+		token := s.adminToken(t)
+		path := fmt.Sprintf(connectionURL, sc.CompanyID)
+		idp := samltest.NewIdP(t)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPut, path, request.SetSAMLConnectionRequest{MetadataXML: "<EntityDescriptor/>"}, token)
+		httptest.AssertErrorCode(t, w, http.StatusBadRequest, "SAML_INVALID_METADATA")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPut, path, request.SetSAMLConnectionRequest{
+			MetadataXML: string(idp.Metadata(t)),
+			DefaultRole: "no_such_role",
+		}, token)
+		httptest.AssertErrorCode(t, w, http.StatusBadRequest, "SAML_UNKNOWN_ROLE")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPut, path, request.SetSAMLConnectionRequest{
+			MetadataXML: string(idp.Metadata(t)),
+		}, authtest.LoginAs(t, s.Router, sc.User))
+		require.Equal(t, http.StatusForbidden, w.Code, w.Body.String())
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodDelete, path, nil, token)
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "SAML_CONNECTION_NOT_FOUND")
+	})
+}
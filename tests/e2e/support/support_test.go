@@ -0,0 +1,120 @@
+//go:build e2e
+
+package support_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/tests/common/authtest"
+	"gin-clean-starter/tests/common/dbtest"
+	"gin-clean-starter/tests/common/httptest"
+	"gin-clean-starter/tests/e2e"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	supportSessionsURL   = "/api/admin/support-sessions"
+	adminReservationsURL = "/api/admin/reservations"
+)
+
+type SupportSuite struct {
+	e2e.SharedSuite
+}
+
+func (s *SupportSuite) SetupSubTest() {
+	s.SharedSuite.SetupSubTest()
+}
+
+func TestSupportSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(SupportSuite))
+}
+
+// twoCompanies creates a staff admin and one upcoming reservation in each of two companies.
+func (s *SupportSuite) twoCompanies(t *testing.T) (staff string, mine, theirs *dbtest.ScenarioFixtures) {
+	t.Helper()
+
+	mine = dbtest.Scenario(t, s.DB).WithCompany().WithUser(string(user.RoleViewer)).WithResource().WithUpcomingReservation().Build()
+	theirs = dbtest.Scenario(t, s.DB).WithCompany().WithUser(string(user.RoleViewer)).WithResource().WithUpcomingReservation().Build()
+	admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+	return authtest.LoginAs(t, s.Router, admin.User), mine, theirs
+}
+
+func (s *SupportSuite) startSession(t *testing.T, staffToken string, companyID uuid.UUID) string {
+	t.Helper()
+
+	w := httptest.PerformRequest(t, s.Router, http.MethodPost, supportSessionsURL, request.StartSupportSessionRequest{
+		CompanyID: companyID.String(),
+		Reason:    "TICKET-42",
+	}, staffToken)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var session response.SupportSessionResponse
+	require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &session))
+	return session.AccessToken
+}
+
+func (s *SupportSuite) TestCompanyScope() {
+	s.Run("Normal case: support session lists only the target company's reservations", func() {
+		t := s.T()
+		staff, mine, theirs := s.twoCompanies(t)
+		token := s.startSession(t, staff, mine.CompanyID)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, adminReservationsURL+"?limit=100", nil, token)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var page response.AdminReservationListPageResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &page))
+
+		ids := make([]uuid.UUID, 0, len(page.Reservations))
+		for _, r := range page.Reservations {
+			assert.Equal(t, mine.ResourceID, r.ResourceID, "listed a reservation outside the session's company")
+			ids = append(ids, r.ID)
+		}
+		assert.Contains(t, ids, mine.ReservationID)
+		assert.NotContains(t, ids, theirs.ReservationID)
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, fmt.Sprintf("%s/%s", adminReservationsURL, mine.ReservationID), nil, token)
+		assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	})
+
+	s.Run("Error case: support session cannot read another company's reservation", func() {
+		t := s.T()
+		staff, mine, theirs := s.twoCompanies(t)
+		token := s.startSession(t, staff, mine.CompanyID)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, fmt.Sprintf("%s/%s", adminReservationsURL, theirs.ReservationID), nil, token)
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "RESERVATION_NOT_FOUND")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, fmt.Sprintf("/api/reservations/%s", theirs.ReservationID), nil, token)
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "SUPPORT_SESSION_OUT_OF_SCOPE")
+	})
+
+	s.Run("Error case: routes that are not company-scoped reject support sessions", func() {
+		t := s.T()
+		staff, mine, _ := s.twoCompanies(t)
+		token := s.startSession(t, staff, mine.CompanyID)
+
+		for _, path := range []string{
+			"/api/admin/roles",
+			"/api/admin/permissions",
+			"/api/admin/retention/report",
+			fmt.Sprintf("/api/admin/resources/%s/operators", mine.ResourceID),
+			"/api/reservations",
+			"/api/users/me/points",
+		} {
+			w := httptest.PerformRequest(t, s.Router, http.MethodGet, path, nil, token)
+			httptest.AssertErrorCode(t, w, http.StatusForbidden, "SUPPORT_SESSION_OUT_OF_SCOPE")
+		}
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, "/api/admin/invites", nil, token)
+		assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	})
+}
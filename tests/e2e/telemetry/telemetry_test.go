@@ -0,0 +1,94 @@
+//go:build e2e
+
+package telemetry_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/tests/common/authtest"
+	"gin-clean-starter/tests/common/dbtest"
+	"gin-clean-starter/tests/common/httptest"
+	"gin-clean-starter/tests/e2e"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const adoptionURL = "/api/admin/adoption"
+
+type TelemetrySuite struct {
+	e2e.SharedSuite
+}
+
+func (s *TelemetrySuite) SetupSubTest() {
+	s.SharedSuite.SetupSubTest()
+}
+
+func TestTelemetrySuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(TelemetrySuite))
+}
+
+// seedFeatureUsage writes rows as the scheduled flush would; the in-memory counters are
+// not reachable from the suite.
+func (s *TelemetrySuite) seedFeatureUsage(t *testing.T) {
+	t.Helper()
+
+	_, err := s.DB.Exec(context.Background(), `
+		INSERT INTO feature_usage (day, feature, company_hash, request_count) VALUES
+		    ('2026-03-01', 'GET /api/reservations', 'aaaaaaaaaaaaaaaa', 10),
+		    ('2026-03-02', 'GET /api/reservations', 'aaaaaaaaaaaaaaaa', 5),
+		    ('2026-03-02', 'GET /api/reservations', 'bbbbbbbbbbbbbbbb', 1),
+		    ('2026-03-02', 'POST /api/reviews', 'aaaaaaaaaaaaaaaa', 40),
+		    ('2026-04-15', 'POST /api/reviews', 'bbbbbbbbbbbbbbbb', 99)`)
+	require.NoError(t, err)
+}
+
+func (s *TelemetrySuite) TestAdoption() {
+	s.Run("Normal case: features ordered by the number of companies using them", func() {
+		t := s.T()
+		s.seedFeatureUsage(t)
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, adoptionURL+"?from=2026-03-01&to=2026-03-31", nil, authtest.LoginAs(t, s.Router, admin.User))
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var report response.AdoptionReportResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &report))
+
+		assert.Equal(t, "2026-03-01", report.From)
+		assert.Equal(t, "2026-03-31", report.To)
+		assert.Equal(t, []response.FeatureAdoptionResponse{
+			{Feature: "GET /api/reservations", Requests: 16, Companies: 2},
+			{Feature: "POST /api/reviews", Requests: 40, Companies: 1},
+		}, report.Features)
+	})
+
+	s.Run("Error case: reversed range is rejected", func() {
+		t := s.T()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, adoptionURL+"?from=2026-04-01&to=2026-03-01", nil, authtest.LoginAs(t, s.Router, admin.User))
+		httptest.AssertErrorCode(t, w, http.StatusBadRequest, "INVALID_ADOPTION_RANGE")
+	})
+
+	s.Run("Error case: malformed date is rejected", func() {
+		t := s.T()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, adoptionURL+"?from=03/01/2026", nil, authtest.LoginAs(t, s.Router, admin.User))
+		httptest.AssertErrorCode(t, w, http.StatusBadRequest, "INVALID_ADOPTION_DATE")
+	})
+
+	s.Run("Error case: viewers cannot read the report", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithCompany().WithUser(string(user.RoleViewer)).Build()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, adoptionURL, nil, authtest.LoginAs(t, s.Router, sc.User))
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "PERMISSION_DENIED")
+	})
+}
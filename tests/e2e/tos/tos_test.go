@@ -0,0 +1,120 @@
+//go:build e2e
+
+package tos_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/tests/common/authtest"
+	"gin-clean-starter/tests/common/dbtest"
+	"gin-clean-starter/tests/common/httptest"
+	"gin-clean-starter/tests/e2e"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	acceptTOSURL    = "/api/users/me/accept-tos"
+	reservationsURL = "/api/reservations"
+)
+
+type TOSSuite struct {
+	e2e.SharedSuite
+}
+
+func (s *TOSSuite) SetupSubTest() {
+	s.SharedSuite.SetupSubTest()
+}
+
+func TestTOSSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(TOSSuite))
+}
+
+func (s *TOSSuite) publish(t *testing.T, version string, publishedAt time.Time) {
+	t.Helper()
+
+	_, err := s.DB.Exec(context.Background(),
+		"INSERT INTO tos_versions (version, url, published_at) VALUES ($1, $2, $3)",
+		version, "https://example.com/tos/"+version, publishedAt)
+	require.NoError(t, err)
+}
+
+func (s *TOSSuite) TestAcceptance() {
+	s.Run("Normal case: nothing is required until a version is published", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+		s.publish(t, "2099-01", time.Now().Add(24*time.Hour))
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, reservationsURL, nil, authtest.LoginAs(t, s.Router, sc.User))
+		assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	})
+
+	s.Run("Normal case: accepting the current version unblocks the user", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+		s.publish(t, "2026-01", time.Now().Add(-30*24*time.Hour))
+		s.publish(t, "2026-09", time.Now().Add(-time.Hour))
+		token := authtest.LoginAs(t, s.Router, sc.User)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, reservationsURL, nil, token)
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "TOS_ACCEPTANCE_REQUIRED")
+		assert.Contains(t, w.Body.String(), `"version":"2026-09"`)
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodPost, acceptTOSURL, request.AcceptTOSRequest{Version: "2026-09"}, token)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var accepted response.TOSAcceptanceResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &accepted))
+		assert.Equal(t, "2026-09", accepted.Version)
+
+		var ip *string
+		err := s.DB.QueryRow(context.Background(),
+			"SELECT a.ip_address FROM tos_acceptances a JOIN tos_versions v ON v.id = a.tos_version_id WHERE a.user_id = $1 AND v.version = '2026-09'",
+			sc.User.ID).Scan(&ip)
+		require.NoError(t, err)
+		require.NotNil(t, ip)
+		assert.NotEmpty(t, *ip)
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, reservationsURL, nil, token)
+		assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	})
+
+	s.Run("Normal case: logout is allowed while acceptance is pending", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+		s.publish(t, "2026-09", time.Now().Add(-time.Hour))
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, "/api/auth/logout", nil, authtest.LoginAs(t, s.Router, sc.User))
+		assert.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+	})
+
+	s.Run("Error case: accepting a version that is not the current one", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+		s.publish(t, "2026-01", time.Now().Add(-30*24*time.Hour))
+		s.publish(t, "2026-09", time.Now().Add(-time.Hour))
+		token := authtest.LoginAs(t, s.Router, sc.User)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, acceptTOSURL, request.AcceptTOSRequest{Version: "2026-01"}, token)
+		httptest.AssertErrorCode(t, w, http.StatusConflict, "TOS_VERSION_OUTDATED")
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, reservationsURL, nil, token)
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "TOS_ACCEPTANCE_REQUIRED")
+	})
+
+	s.Run("Error case: nothing to accept before a version is published", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, acceptTOSURL, request.AcceptTOSRequest{Version: "2026-09"}, authtest.LoginAs(t, s.Router, sc.User))
+		httptest.AssertErrorCode(t, w, http.StatusNotFound, "TOS_VERSION_NOT_FOUND")
+	})
+}
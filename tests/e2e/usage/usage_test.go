@@ -0,0 +1,135 @@
+//go:build e2e
+
+package usage_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/tests/common/authtest"
+	"gin-clean-starter/tests/common/dbtest"
+	"gin-clean-starter/tests/common/httptest"
+	"gin-clean-starter/tests/e2e"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	usageURL        = "/api/admin/usage"
+	reservationsURL = "/api/reservations"
+)
+
+type UsageSuite struct {
+	e2e.SharedSuite
+}
+
+func (s *UsageSuite) SetupSubTest() {
+	s.SharedSuite.SetupSubTest()
+}
+
+func TestUsageSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UsageSuite))
+}
+
+// seedUsage gives the company quotas and this month's usage; the meter itself only
+// writes on its scheduled flush.
+func (s *UsageSuite) seedUsage(t *testing.T, companyID uuid.UUID, used int64, soft, hard *int64) {
+	t.Helper()
+
+	ctx := context.Background()
+	_, err := s.DB.Exec(ctx,
+		`INSERT INTO company_settings (company_id, monthly_request_soft_quota, monthly_request_hard_quota) VALUES ($1, $2, $3)`,
+		companyID, soft, hard)
+	require.NoError(t, err)
+	_, err = s.DB.Exec(ctx,
+		`INSERT INTO api_usage (company_id, month, request_count, bytes_in, bytes_out)
+		 VALUES ($1, date_trunc('month', now() AT TIME ZONE 'UTC')::date, $2, 1024, 4096)`,
+		companyID, used)
+	require.NoError(t, err)
+}
+
+func ptr(v int64) *int64 { return &v }
+
+func (s *UsageSuite) TestQuotas() {
+	s.Run("Normal case: past the soft quota responses carry a warning", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithCompany().WithUser(string(user.RoleViewer)).Build()
+		s.seedUsage(t, sc.CompanyID, 150, ptr(100), ptr(1000))
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, reservationsURL, nil, authtest.LoginAs(t, s.Router, sc.User))
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		assert.NotEmpty(t, w.Header().Get("X-Usage-Quota-Warning"))
+		assert.Equal(t, "1000", w.Header().Get("X-RateLimit-Limit"))
+		assert.Equal(t, "849", w.Header().Get("X-RateLimit-Remaining"))
+		assert.NotEmpty(t, w.Header().Get("X-RateLimit-Reset"))
+	})
+
+	s.Run("Error case: past the hard quota requests are refused until the month ends", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithCompany().WithUser(string(user.RoleViewer)).Build()
+		s.seedUsage(t, sc.CompanyID, 1000, nil, ptr(1000))
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, reservationsURL, nil, authtest.LoginAs(t, s.Router, sc.User))
+		httptest.AssertErrorCode(t, w, http.StatusTooManyRequests, "USAGE_QUOTA_EXCEEDED")
+		assert.NotEmpty(t, w.Header().Get("Retry-After"))
+		assert.Equal(t, "0", w.Header().Get("X-RateLimit-Remaining"))
+	})
+
+	s.Run("Normal case: companies without quotas are not limited", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithCompany().WithUser(string(user.RoleViewer)).Build()
+		s.seedUsage(t, sc.CompanyID, 1_000_000, nil, nil)
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, reservationsURL, nil, authtest.LoginAs(t, s.Router, sc.User))
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		assert.Empty(t, w.Header().Get("X-Usage-Quota-Warning"))
+		assert.Empty(t, w.Header().Get("X-RateLimit-Limit"))
+	})
+}
+
+func (s *UsageSuite) TestReport() {
+	s.Run("Normal case: admin reads one company's usage for the month", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithCompany().WithUser(string(user.RoleViewer)).Build()
+		s.seedUsage(t, sc.CompanyID, 42, ptr(100), nil)
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, fmt.Sprintf("%s?company=%s", usageURL, sc.CompanyID), nil, authtest.LoginAs(t, s.Router, admin.User))
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var report response.UsageReportResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &report))
+
+		require.Len(t, report.Companies, 1)
+		got := report.Companies[0]
+		assert.Equal(t, sc.CompanyID, got.CompanyID)
+		assert.Equal(t, int64(42), got.Requests)
+		assert.Equal(t, int64(1024), got.BytesIn)
+		assert.Equal(t, int64(4096), got.BytesOut)
+		assert.Equal(t, ptr(100), got.SoftQuota)
+		assert.Nil(t, got.HardQuota)
+	})
+
+	s.Run("Error case: month must be YYYY-MM", func() {
+		t := s.T()
+		admin := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleAdmin)).Build()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, usageURL+"?month=2026-13", nil, authtest.LoginAs(t, s.Router, admin.User))
+		httptest.AssertErrorCode(t, w, http.StatusBadRequest, "INVALID_USAGE_MONTH")
+	})
+
+	s.Run("Error case: viewers cannot read usage", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, usageURL, nil, authtest.LoginAs(t, s.Router, sc.User))
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "PERMISSION_DENIED")
+	})
+}
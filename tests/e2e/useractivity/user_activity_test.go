@@ -0,0 +1,132 @@
+//go:build e2e
+
+package useractivity_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/tests/common/authtest"
+	"gin-clean-starter/tests/common/dbtest"
+	"gin-clean-starter/tests/common/httptest"
+	"gin-clean-starter/tests/e2e"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	activityURL     = "/api/users/me/activity"
+	reservationsURL = "/api/reservations"
+)
+
+type UserActivitySuite struct {
+	e2e.SharedSuite
+}
+
+func (s *UserActivitySuite) SetupSubTest() {
+	s.SharedSuite.SetupSubTest()
+}
+
+func TestUserActivitySuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UserActivitySuite))
+}
+
+func (s *UserActivitySuite) reserve(t *testing.T, token string, resourceID uuid.UUID, start time.Time) uuid.UUID {
+	t.Helper()
+
+	w := httptest.PerformRequestWithHeaders(t, s.Router, http.MethodPost, reservationsURL, request.CreateReservationRequest{
+		ResourceID: resourceID,
+		StartTime:  start,
+		EndTime:    start.Add(time.Hour),
+	}, token, map[string]string{"Idempotency-Key": uuid.NewString()})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var created response.ReservationResponse
+	require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &created))
+	return created.ID
+}
+
+func (s *UserActivitySuite) list(t *testing.T, token string, query url.Values) response.ActivityListResponse {
+	t.Helper()
+
+	w := httptest.PerformRequest(t, s.Router, http.MethodGet, activityURL+"?"+query.Encode(), nil, token)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var page response.ActivityListResponse
+	require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &page))
+	return page
+}
+
+func (s *UserActivitySuite) TestActivity() {
+	s.Run("Normal case: bookings and cancellations show up newest first", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).WithResource().Build()
+		token := authtest.LoginAs(t, s.Router, sc.User)
+
+		start := time.Now().UTC().Truncate(time.Hour).Add(72 * time.Hour)
+		first := s.reserve(t, token, sc.ResourceID, start)
+		second := s.reserve(t, token, sc.ResourceID, start.Add(2*time.Hour))
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, fmt.Sprintf("%s/%s/cancel", reservationsURL, first), nil, token)
+		require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+
+		page := s.list(t, token, url.Values{})
+		require.Len(t, page.Items, 3)
+		assert.Equal(t, "reservation_canceled", page.Items[0].Kind)
+		assert.Equal(t, first, page.Items[0].SubjectID)
+		assert.Equal(t, "reservation_created", page.Items[1].Kind)
+		assert.Equal(t, second, page.Items[1].SubjectID)
+		assert.Equal(t, "reservation_created", page.Items[2].Kind)
+		assert.Equal(t, first, page.Items[2].SubjectID)
+		require.NotNil(t, page.Items[2].ResourceID)
+		assert.Equal(t, sc.ResourceID, *page.Items[2].ResourceID)
+		assert.JSONEq(t, fmt.Sprintf(`{"status":"confirmed","startTime":%q,"endTime":%q}`,
+			start.Format(time.RFC3339), start.Add(time.Hour).Format(time.RFC3339)), string(page.Items[2].Data))
+		assert.Empty(t, page.NextCursor)
+
+		created := s.list(t, token, url.Values{"kind": {"reservation_created"}})
+		assert.Len(t, created.Items, 2)
+	})
+
+	s.Run("Normal case: pages follow the cursor and other users' activity stays out", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).WithResource().Build()
+		other := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+		token := authtest.LoginAs(t, s.Router, sc.User)
+
+		start := time.Now().UTC().Truncate(time.Hour).Add(72 * time.Hour)
+		for i := range 3 {
+			s.reserve(t, token, sc.ResourceID, start.Add(time.Duration(2*i)*time.Hour))
+		}
+		s.reserve(t, authtest.LoginAs(t, s.Router, other.User), sc.ResourceID, start.Add(10*time.Hour))
+
+		first := s.list(t, token, url.Values{"limit": {"2"}})
+		require.Len(t, first.Items, 2)
+		require.NotEmpty(t, first.NextCursor)
+		rest := s.list(t, token, url.Values{"limit": {"2"}, "after": {first.NextCursor}})
+		require.Len(t, rest.Items, 1)
+		assert.Empty(t, rest.NextCursor)
+	})
+
+	s.Run("Error case: unknown kind", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, activityURL+"?kind=logins", nil, authtest.LoginAs(t, s.Router, sc.User))
+		httptest.AssertErrorCode(t, w, http.StatusBadRequest, "INVALID_ACTIVITY_KIND")
+	})
+
+	s.Run("Error case: login required", func() {
+		t := s.T()
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, activityURL, nil, "")
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
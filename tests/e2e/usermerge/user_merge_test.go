@@ -0,0 +1,237 @@
+//go:build e2e
+
+package usermerge_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	nethttptest "net/http/httptest"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/domain/user"
+	"gin-clean-starter/internal/handler/dto/request"
+	"gin-clean-starter/internal/handler/dto/response"
+	"gin-clean-starter/tests/common/authtest"
+	"gin-clean-starter/tests/common/dbtest"
+	"gin-clean-starter/tests/common/httptest"
+	"gin-clean-starter/tests/e2e"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	mergeURL = "/api/admin/users/%s/merge?into=%s"
+	meURL    = "/api/auth/me"
+	// reservationsURL is the user side of reservations, where the owner reads its thread.
+	reservationsURL = "/api/reservations"
+)
+
+type UserMergeSuite struct {
+	e2e.SharedSuite
+}
+
+func (s *UserMergeSuite) SetupSubTest() {
+	s.SharedSuite.SetupSubTest()
+}
+
+func TestUserMergeSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UserMergeSuite))
+}
+
+func (s *UserMergeSuite) merge(t *testing.T, sourceID, targetID uuid.UUID, query, token string) *response.UserMergeResponse {
+	t.Helper()
+	w := httptest.PerformRequest(t, s.Router, http.MethodPost, fmt.Sprintf(mergeURL, sourceID, targetID)+query, nil, token)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var result response.UserMergeResponse
+	require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &result))
+	return &result
+}
+
+// accounts builds an admin and two viewers of one company; the source, the last of them,
+// holds a completed and an upcoming reservation.
+func (s *UserMergeSuite) accounts(t *testing.T) (admin, target, source dbtest.ScenarioUser, sc *dbtest.ScenarioFixtures) {
+	t.Helper()
+	sc = dbtest.Scenario(t, s.DB).
+		WithCompany().
+		WithUser(string(user.RoleAdmin)).
+		WithUser(string(user.RoleViewer)).
+		WithUser(string(user.RoleViewer)).
+		WithResource().
+		WithCompletedReservation().
+		WithUpcomingReservation().
+		Build()
+	return sc.Users[0], sc.Users[1], sc.Users[2], sc
+}
+
+func (s *UserMergeSuite) count(t *testing.T, query string, args ...any) int {
+	t.Helper()
+	var n int
+	require.NoError(t, s.DB.QueryRow(context.Background(), query, args...).Scan(&n))
+	return n
+}
+
+func (s *UserMergeSuite) TestMerge() {
+	ctx := context.Background()
+
+	s.Run("Normal case: a dry run previews the merge, which then moves everything over", func() {
+		t := s.T()
+		admin, target, source, sc := s.accounts(t)
+		_, err := s.DB.Exec(ctx, `
+			INSERT INTO reviews (id, user_id, resource_id, reservation_id, rating, comment)
+			VALUES ($1, $2, $3, $4, 5, 'Great room')`, uuid.New(), source.ID, sc.ResourceID, sc.ReservationIDs[0])
+		require.NoError(t, err)
+		_, err = s.DB.Exec(ctx, `
+			INSERT INTO loyalty_ledger (user_id, kind, points, remaining_points, expires_at, reservation_id)
+			VALUES ($1, 'accrual', 10, 10, $2, $3)`, source.ID, time.Now().AddDate(1, 0, 0), sc.ReservationIDs[0])
+		require.NoError(t, err)
+		sourceToken := authtest.LoginAs(t, s.Router, source)
+		token := authtest.LoginAs(t, s.Router, admin)
+
+		preview := s.merge(t, source.ID, target.ID, "&dryRun=true", token)
+		assert.True(t, preview.DryRun)
+		assert.Equal(t, int64(2), preview.Reservations)
+		assert.Equal(t, int64(1), preview.Reviews)
+		assert.Equal(t, int64(1), preview.LoyaltyEntries)
+		assert.Equal(t, int64(1), preview.Sessions)
+		w := httptest.PerformRequest(t, s.Router, http.MethodGet, meURL, nil, sourceToken)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		result := s.merge(t, source.ID, target.ID, "", token)
+		assert.False(t, result.DryRun)
+		preview.DryRun = false
+		assert.Equal(t, preview, result)
+
+		var reservations, reviews, points int
+		var active bool
+		require.NoError(t, s.DB.QueryRow(ctx, `
+			SELECT (SELECT count(*) FROM reservations WHERE user_id = $1),
+			       (SELECT count(*) FROM reviews WHERE user_id = $1),
+			       (SELECT count(*) FROM loyalty_ledger WHERE user_id = $1),
+			       (SELECT is_active FROM users WHERE id = $2)`, target.ID, source.ID).Scan(&reservations, &reviews, &points, &active))
+		assert.Equal(t, 2, reservations)
+		assert.Equal(t, 1, reviews)
+		assert.Equal(t, 1, points)
+		assert.False(t, active)
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, meURL, nil, sourceToken)
+		httptest.AssertErrorCode(t, w, http.StatusUnauthorized, "SESSION_REVOKED")
+
+		var into string
+		require.NoError(t, s.DB.QueryRow(ctx,
+			`SELECT metadata->>'into' FROM audit_logs WHERE action = 'user.merged' AND target_id = $1 AND actor_id = $2`,
+			source.ID.String(), admin.ID).Scan(&into))
+		assert.Equal(t, target.ID.String(), into)
+	})
+
+	s.Run("Normal case: the activity timeline moves with the account", func() {
+		t := s.T()
+		admin, target, source, _ := s.accounts(t)
+		_, err := s.DB.Exec(ctx, `
+			INSERT INTO user_activity (user_id, kind, subject_id, occurred_at) VALUES ($1, 'points_earned', $2, now())`,
+			source.ID, uuid.New())
+		require.NoError(t, err)
+
+		result := s.merge(t, source.ID, target.ID, "", authtest.LoginAs(t, s.Router, admin))
+		assert.Equal(t, int64(1), result.ActivityEntries)
+		assert.Equal(t, 0, s.count(t, `SELECT count(*) FROM user_activity WHERE user_id = $1 AND kind = 'points_earned'`, source.ID))
+		assert.Equal(t, 1, s.count(t, `SELECT count(*) FROM user_activity WHERE user_id = $1 AND kind = 'points_earned'`, target.ID))
+	})
+
+	s.Run("Normal case: linked sign-in identities sign in to the target", func() {
+		t := s.T()
+		admin, target, source, _ := s.accounts(t)
+		_, err := s.DB.Exec(ctx, `
+			INSERT INTO user_identities (provider, subject, user_id, email) VALUES ('oidc', $1, $2, $3)`,
+			"sub-"+uuid.NewString(), source.ID, source.Email)
+		require.NoError(t, err)
+
+		result := s.merge(t, source.ID, target.ID, "", authtest.LoginAs(t, s.Router, admin))
+		assert.Equal(t, int64(1), result.Identities)
+		assert.Equal(t, 0, s.count(t, `SELECT count(*) FROM user_identities WHERE user_id = $1`, source.ID))
+		assert.Equal(t, 1, s.count(t, `SELECT count(*) FROM user_identities WHERE user_id = $1`, target.ID))
+	})
+
+	s.Run("Normal case: messages are the target's and still read back", func() {
+		t := s.T()
+		admin, target, source, sc := s.accounts(t)
+		url := fmt.Sprintf("%s/%s", reservationsURL, sc.ReservationIDs[1])
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, url+"/messages",
+			request.PostReservationMessageRequest{Body: "door code 4711"}, authtest.LoginAs(t, s.Router, source))
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+		result := s.merge(t, source.ID, target.ID, "", authtest.LoginAs(t, s.Router, admin))
+		assert.Equal(t, int64(1), result.Messages)
+
+		w = httptest.PerformRequest(t, s.Router, http.MethodGet, url, nil, authtest.LoginAs(t, s.Router, target))
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var detail response.ReservationResponse
+		require.NoError(t, httptest.DecodeResponseBody(t, w.Body, &detail))
+		require.NotNil(t, detail.Messages)
+		require.Len(t, detail.Messages.Messages, 1)
+		assert.Equal(t, target.ID, detail.Messages.Messages[0].AuthorID)
+		assert.Equal(t, "door code 4711", detail.Messages.Messages[0].Body)
+	})
+
+	s.Run("Normal case: transfers name the target, and those between the two accounts are dropped", func() {
+		t := s.T()
+		admin, target, source, sc := s.accounts(t)
+		other := dbtest.Scenario(t, s.DB).WithUser(string(user.RoleViewer)).Build().User
+		offered, between := uuid.New(), uuid.New()
+		_, err := s.DB.Exec(ctx, `
+			INSERT INTO reservation_transfers (id, reservation_id, from_user_id, to_user_id, initiated_by, nonce, status, expires_at)
+			VALUES ($1, $3, $4, $5, $4, gen_random_uuid(), 'pending', now() + interval '1 day'),
+			       ($2, $3, $4, $6, $6, gen_random_uuid(), 'superseded', now() - interval '1 day')`,
+			offered, between, sc.ReservationIDs[1], source.ID, other.ID, target.ID)
+		require.NoError(t, err)
+
+		result := s.merge(t, source.ID, target.ID, "&dryRun=true", authtest.LoginAs(t, s.Router, admin))
+		assert.Equal(t, int64(2), result.Transfers)
+		result = s.merge(t, source.ID, target.ID, "", authtest.LoginAs(t, s.Router, admin))
+		assert.Equal(t, int64(2), result.Transfers)
+
+		var from, initiatedBy uuid.UUID
+		require.NoError(t, s.DB.QueryRow(ctx, `SELECT from_user_id, initiated_by FROM reservation_transfers WHERE id = $1`, offered).Scan(&from, &initiatedBy))
+		assert.Equal(t, target.ID, from)
+		assert.Equal(t, target.ID, initiatedBy)
+		assert.Equal(t, 0, s.count(t, `SELECT count(*) FROM reservation_transfers WHERE id = $1`, between))
+	})
+
+	s.Run("Error case: merges that would lose or mix up accounts are refused", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).
+			WithCompany().
+			WithUser(string(user.RoleAdmin)).
+			WithUser(string(user.RoleViewer)).
+			WithUser(string(user.RoleViewer)).
+			Build()
+		admin, target, source := sc.Users[0], sc.Users[1], sc.Users[2]
+		stranger := dbtest.Scenario(t, s.DB).WithCompany().WithUser(string(user.RoleViewer)).Build().User
+		token := authtest.LoginAs(t, s.Router, admin)
+		post := func(sourceID, targetID string) *nethttptest.ResponseRecorder {
+			return httptest.PerformRequest(t, s.Router, http.MethodPost, fmt.Sprintf(mergeURL, sourceID, targetID), nil, token)
+		}
+
+		httptest.AssertErrorCode(t, post(source.ID.String(), "not-a-uuid"), http.StatusBadRequest, "INVALID_ID_FORMAT")
+		httptest.AssertErrorCode(t, post(source.ID.String(), source.ID.String()), http.StatusBadRequest, "USER_MERGE_SAME_ACCOUNT")
+		httptest.AssertErrorCode(t, post(admin.ID.String(), target.ID.String()), http.StatusForbidden, "USER_MERGE_OWN_ACCOUNT")
+		httptest.AssertErrorCode(t, post(uuid.NewString(), target.ID.String()), http.StatusNotFound, "USER_NOT_FOUND")
+		httptest.AssertErrorCode(t, post(source.ID.String(), stranger.ID.String()), http.StatusConflict, "USER_MERGE_COMPANY_MISMATCH")
+
+		w := httptest.PerformRequest(t, s.Router, http.MethodPost, fmt.Sprintf(mergeURL, target.ID, source.ID), nil, authtest.LoginAs(t, s.Router, source))
+		httptest.AssertErrorCode(t, w, http.StatusForbidden, "PERMISSION_DENIED")
+
+		_, err := s.DB.Exec(ctx, `UPDATE users SET is_active = false WHERE id = $1`, target.ID)
+		require.NoError(t, err)
+		httptest.AssertErrorCode(t, post(source.ID.String(), target.ID.String()), http.StatusConflict, "USER_MERGE_TARGET_INACTIVE")
+
+		var active bool
+		require.NoError(t, s.DB.QueryRow(ctx, `SELECT is_active FROM users WHERE id = $1`, source.ID).Scan(&active))
+		assert.True(t, active)
+	})
+}
@@ -0,0 +1,162 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/infra/readstore"
+	"gin-clean-starter/internal/infra/repository"
+	"gin-clean-starter/internal/pkg/config"
+	"gin-clean-starter/internal/pkg/crypto"
+	"gin-clean-starter/internal/usecase/shared"
+	"gin-clean-starter/tests/common/dbtest"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+// testEnvelope uses the key from config.NewTestConfig.
+func testEnvelope(t *testing.T) *crypto.Envelope {
+	t.Helper()
+
+	cfg := config.NewTestConfig().Crypto
+	keys, err := crypto.ParseKeys(cfg.Keys)
+	require.NoError(t, err)
+	envelope, err := crypto.NewEnvelope(keys, cfg.ActiveKeyID)
+	require.NoError(t, err)
+	return envelope
+}
+
+type encryptionSuite struct {
+	dbSuite
+	envelope *crypto.Envelope
+}
+
+func TestEncryptionSuite(t *testing.T) {
+	suite.Run(t, new(encryptionSuite))
+}
+
+func (s *encryptionSuite) SetupSuite() {
+	s.dbSuite.SetupSuite()
+	s.envelope = testEnvelope(s.T())
+}
+
+func (s *encryptionSuite) storedMessage(id uuid.UUID) (plaintext, ciphertext pgtype.Text) {
+	t := s.T()
+	t.Helper()
+
+	err := s.DB.QueryRow(context.Background(),
+		`SELECT body, body_ciphertext FROM reservation_messages WHERE id = $1`, id).Scan(&plaintext, &ciphertext)
+	require.NoError(t, err)
+	return plaintext, ciphertext
+}
+
+func (s *encryptionSuite) TestReservationMessage() {
+	ctx := context.Background()
+
+	message := func(sc *dbtest.ScenarioFixtures, authorID uuid.UUID) shared.ReservationMessage {
+		return shared.ReservationMessage{
+			ReservationID: sc.ReservationID,
+			AuthorID:      authorID,
+			AuthorSide:    shared.MessageSideUser,
+			Body:          "door code 4711",
+			CreatedAt:     time.Now(),
+		}
+	}
+
+	s.Run("Normal case: message is stored as ciphertext and read back in plaintext", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser("viewer").WithResource().WithUpcomingReservation().Build()
+		repo := repository.NewReservationMessageRepository(s.Queries, s.envelope)
+		store := readstore.NewReservationMessageReadStore(s.Queries, s.envelope)
+
+		id, err := repo.Create(ctx, s.DB, message(sc, sc.User.ID))
+		require.NoError(t, err)
+
+		plaintext, ciphertext := s.storedMessage(id)
+		assert.False(t, plaintext.Valid, "plaintext column must stay empty")
+		require.True(t, ciphertext.Valid)
+		assert.NotContains(t, ciphertext.String, "4711")
+
+		views, err := store.FindFirstPage(ctx, s.DB, sc.ReservationID, 10)
+		require.NoError(t, err)
+		require.Len(t, views, 1)
+		assert.Equal(t, "door code 4711", views[0].Body)
+	})
+
+	s.Run("Normal case: without an active key the message is stored in plaintext", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser("viewer").WithResource().WithUpcomingReservation().Build()
+		noKeys, err := crypto.NewEnvelope(nil, "")
+		require.NoError(t, err)
+		repo := repository.NewReservationMessageRepository(s.Queries, noKeys)
+		store := readstore.NewReservationMessageReadStore(s.Queries, s.envelope)
+
+		id, err := repo.Create(ctx, s.DB, message(sc, sc.User.ID))
+		require.NoError(t, err)
+
+		plaintext, ciphertext := s.storedMessage(id)
+		assert.False(t, ciphertext.Valid)
+		assert.Equal(t, "door code 4711", plaintext.String)
+
+		views, err := store.FindFirstPage(ctx, s.DB, sc.ReservationID, 10)
+		require.NoError(t, err)
+		require.Len(t, views, 1)
+		assert.Equal(t, "door code 4711", views[0].Body)
+	})
+
+	s.Run("Error case: a message copied onto another author's message does not decrypt", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser("viewer").WithUser("viewer").WithResource().WithUpcomingReservation().Build()
+		repo := repository.NewReservationMessageRepository(s.Queries, s.envelope)
+		store := readstore.NewReservationMessageReadStore(s.Queries, s.envelope)
+
+		id, err := repo.Create(ctx, s.DB, message(sc, sc.Users[0].ID))
+		require.NoError(t, err)
+		_, ciphertext := s.storedMessage(id)
+		require.True(t, ciphertext.Valid)
+		_, err = s.DB.Exec(ctx, `UPDATE reservation_messages SET author_id = $2 WHERE id = $1`, id, sc.Users[1].ID)
+		require.NoError(t, err)
+
+		_, err = store.FindFirstPage(ctx, s.DB, sc.ReservationID, 10)
+		assert.ErrorIs(t, err, crypto.ErrDecryptionFailed)
+	})
+}
+
+func (s *encryptionSuite) TestUserPhone() {
+	ctx := context.Background()
+
+	s.Run("Normal case: phone is stored as ciphertext and read back in plaintext", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser("viewer").Build()
+		repo := repository.NewUserRepository(s.Queries, s.envelope)
+		store := readstore.NewUserReadStore(s.Queries, s.envelope)
+
+		require.NoError(t, repo.SetPhone(ctx, s.DB, sc.User.ID, "+81312345678"))
+
+		var stored string
+		require.NoError(t, s.DB.QueryRow(ctx, `SELECT phone_ciphertext FROM users WHERE id = $1`, sc.User.ID).Scan(&stored))
+		assert.NotContains(t, stored, "312345678")
+
+		view, err := store.FindByID(ctx, s.DB, sc.User.ID)
+		require.NoError(t, err)
+		require.NotNil(t, view.Phone)
+		assert.Equal(t, "+81312345678", *view.Phone)
+	})
+
+	s.Run("Normal case: a user without a phone reads back none", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser("viewer").Build()
+		store := readstore.NewUserReadStore(s.Queries, s.envelope)
+
+		view, err := store.FindByID(ctx, s.DB, sc.User.ID)
+		require.NoError(t, err)
+		assert.Nil(t, view.Phone)
+	})
+}
@@ -0,0 +1,188 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/domain/loyalty"
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/infra/readstore"
+	"gin-clean-starter/internal/infra/repository"
+	"gin-clean-starter/internal/usecase/shared"
+	"gin-clean-starter/tests/common/dbtest"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type loyaltySuite struct {
+	dbSuite
+	repo  *repository.LoyaltyRepository
+	store *readstore.LoyaltyReadStore
+}
+
+func TestLoyaltySuite(t *testing.T) {
+	suite.Run(t, new(loyaltySuite))
+}
+
+func (s *loyaltySuite) SetupSuite() {
+	s.dbSuite.SetupSuite()
+	s.repo = repository.NewLoyaltyRepository(s.Queries)
+	s.store = readstore.NewLoyaltyReadStore(s.Queries)
+}
+
+func (s *loyaltySuite) accrue(userID, reservationID uuid.UUID, points int64, expiresAt time.Time) {
+	t := s.T()
+	t.Helper()
+
+	err := s.repo.Accrue(context.Background(), s.DB, shared.PointsAccrual{
+		UserID:        userID,
+		ReservationID: reservationID,
+		Points:        points,
+		ExpiresAt:     expiresAt,
+	}, time.Now())
+	require.NoError(t, err)
+}
+
+func (s *loyaltySuite) TestAccrual() {
+	ctx := context.Background()
+
+	s.Run("Normal case: only ended, unaccrued reservations inside the lookback are listed", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).
+			WithUser("viewer").WithResource().
+			WithCompletedReservation().WithCompletedReservation().WithUpcomingReservation().
+			Build()
+		now := time.Now()
+
+		accruable, err := s.store.ListAccruable(ctx, s.DB, now.Add(-24*time.Hour), now, 100, 10)
+		require.NoError(t, err)
+		require.Len(t, accruable, 2)
+		assert.Equal(t, sc.ReservationIDs[1], accruable[0].ID, "oldest completion first")
+		assert.Equal(t, int64(10000), accruable[0].PriceCents)
+
+		s.accrue(sc.User.ID, sc.ReservationIDs[1], 100, now.Add(time.Hour))
+
+		accruable, err = s.store.ListAccruable(ctx, s.DB, now.Add(-24*time.Hour), now, 100, 10)
+		require.NoError(t, err)
+		require.Len(t, accruable, 1)
+		assert.Equal(t, sc.ReservationIDs[0], accruable[0].ID)
+
+		accruable, err = s.store.ListAccruable(ctx, s.DB, now.Add(-24*time.Hour), now, 10001, 10)
+		require.NoError(t, err)
+		assert.Empty(t, accruable, "reservations below the minimum price earn nothing")
+	})
+
+	s.Run("Error case: a reservation accrues once", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser("viewer").WithResource().WithCompletedReservation().Build()
+		s.accrue(sc.User.ID, sc.ReservationID, 100, time.Now().Add(time.Hour))
+
+		err := s.repo.Accrue(ctx, s.DB, shared.PointsAccrual{
+			UserID:        sc.User.ID,
+			ReservationID: sc.ReservationID,
+			Points:        100,
+			ExpiresAt:     time.Now().Add(time.Hour),
+		}, time.Now())
+		require.Error(t, err)
+		assert.True(t, infra.IsKind(err, infra.KindConflict), "got %v", err)
+	})
+}
+
+func (s *loyaltySuite) TestRedemption() {
+	ctx := context.Background()
+
+	s.Run("Normal case: redemption draws the soonest-expiring lots and lowers the balance", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).
+			WithUser("viewer").WithResource().
+			WithCompletedReservation().WithCompletedReservation().WithUpcomingReservation().
+			Build()
+		now := time.Now()
+		s.accrue(sc.User.ID, sc.ReservationIDs[0], 100, now.Add(48*time.Hour))
+		s.accrue(sc.User.ID, sc.ReservationIDs[1], 100, now.Add(24*time.Hour))
+
+		tx, err := s.DB.Begin(ctx)
+		require.NoError(t, err)
+		defer func() { _ = tx.Rollback(ctx) }()
+
+		lots, err := s.repo.LockOpenLots(ctx, tx, sc.User.ID, now)
+		require.NoError(t, err)
+		require.Len(t, lots, 2)
+		draws, err := loyalty.Allocate(lots, 150)
+		require.NoError(t, err)
+		for _, draw := range draws {
+			require.NoError(t, s.repo.Draw(ctx, tx, draw))
+		}
+		require.NoError(t, s.repo.RecordRedemption(ctx, tx, shared.PointsRedemption{
+			UserID:        sc.User.ID,
+			ReservationID: sc.ReservationIDs[2],
+			Points:        150,
+			DiscountCents: 150,
+		}, time.Now()))
+		require.NoError(t, tx.Commit(ctx))
+
+		balance, err := s.store.Balance(ctx, s.DB, sc.User.ID, now)
+		require.NoError(t, err)
+		assert.Equal(t, int64(50), balance)
+
+		entries, err := s.store.FindEntriesFirstPage(ctx, s.DB, sc.User.ID, 10)
+		require.NoError(t, err)
+		require.Len(t, entries, 3)
+		assert.Equal(t, loyalty.EntryRedemption.String(), entries[0].Kind)
+		assert.Equal(t, int32(-150), entries[0].Points)
+		require.NotNil(t, entries[0].DiscountCents)
+		assert.Equal(t, int32(150), *entries[0].DiscountCents)
+	})
+
+	s.Run("Error case: drawing more than a lot holds is a conflict", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser("viewer").WithResource().WithCompletedReservation().Build()
+		now := time.Now()
+		s.accrue(sc.User.ID, sc.ReservationID, 100, now.Add(time.Hour))
+
+		lots, err := s.repo.LockOpenLots(ctx, s.DB, sc.User.ID, now)
+		require.NoError(t, err)
+		require.Len(t, lots, 1)
+
+		err = s.repo.Draw(ctx, s.DB, loyalty.Draw{LotID: lots[0].ID, Points: 101})
+		require.Error(t, err)
+		assert.True(t, infra.IsKind(err, infra.KindConflict), "got %v", err)
+	})
+}
+
+func (s *loyaltySuite) TestExpiry() {
+	ctx := context.Background()
+
+	s.Run("Normal case: expired lots are zeroed once and recorded in the ledger", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser("viewer").WithResource().WithCompletedReservation().WithCompletedReservation().Build()
+		now := time.Now()
+		s.accrue(sc.User.ID, sc.ReservationIDs[0], 100, now.Add(-time.Minute))
+		s.accrue(sc.User.ID, sc.ReservationIDs[1], 70, now.Add(time.Hour))
+
+		balance, err := s.store.Balance(ctx, s.DB, sc.User.ID, now)
+		require.NoError(t, err)
+		assert.Equal(t, int64(70), balance, "expired lots stop counting before the job runs")
+
+		later := time.Now()
+		expired, err := s.repo.ExpireLots(ctx, s.DB, later, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), expired)
+
+		expired, err = s.repo.ExpireLots(ctx, s.DB, later, 10)
+		require.NoError(t, err)
+		assert.Zero(t, expired)
+
+		entries, err := s.store.FindEntriesFirstPage(ctx, s.DB, sc.User.ID, 10)
+		require.NoError(t, err)
+		require.Len(t, entries, 3)
+		assert.Equal(t, loyalty.EntryExpiry.String(), entries[0].Kind)
+		assert.Equal(t, int32(-100), entries[0].Points)
+	})
+}
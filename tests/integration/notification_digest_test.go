@@ -0,0 +1,153 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/infra/readstore"
+	"gin-clean-starter/internal/infra/repository"
+	"gin-clean-starter/internal/usecase/shared"
+	"gin-clean-starter/tests/common/dbtest"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type notificationDigestSuite struct {
+	dbSuite
+	notifications *repository.NotificationRepository
+	repo          *repository.NotificationDigestRepository
+	store         *readstore.NotificationPreferencesReadStore
+}
+
+func TestNotificationDigestSuite(t *testing.T) {
+	suite.Run(t, new(notificationDigestSuite))
+}
+
+func (s *notificationDigestSuite) SetupSuite() {
+	s.dbSuite.SetupSuite()
+	s.notifications = repository.NewNotificationRepository(s.Queries, s.DB)
+	s.repo = repository.NewNotificationDigestRepository(s.Queries)
+	s.store = readstore.NewNotificationPreferencesReadStore(s.Queries)
+}
+
+func (s *notificationDigestSuite) notify(userID uuid.UUID, topic string, digestible bool) {
+	t := s.T()
+	t.Helper()
+
+	err := s.notifications.CreateUserJob(context.Background(), s.DB, userID, "email", topic, []byte(`{"type":"`+topic+`"}`), time.Now(), digestible)
+	require.NoError(t, err)
+}
+
+func (s *notificationDigestSuite) statuses(userID uuid.UUID) map[string]string {
+	t := s.T()
+	t.Helper()
+
+	rows, err := s.DB.Query(context.Background(), `SELECT topic, status FROM notification_jobs WHERE user_id = $1`, userID)
+	require.NoError(t, err)
+	defer rows.Close()
+	statuses := map[string]string{}
+	for rows.Next() {
+		var topic, status string
+		require.NoError(t, rows.Scan(&topic, &status))
+		statuses[topic] = status
+	}
+	require.NoError(t, rows.Err())
+	return statuses
+}
+
+func (s *notificationDigestSuite) TestHold() {
+	ctx := context.Background()
+
+	s.Run("Normal case: only digestible jobs of users taking a digest are held", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser("viewer").WithUser("viewer").Build()
+		digester, plain := sc.Users[0].ID, sc.Users[1].ID
+		require.NoError(t, s.repo.SetMode(ctx, s.DB, digester, "daily"))
+
+		s.notify(digester, "reservation_created", true)
+		s.notify(digester, "reservation_canceled", false)
+		s.notify(plain, "reservation_created", true)
+
+		assert.Equal(t, map[string]string{"reservation_created": "held", "reservation_canceled": "queued"}, s.statuses(digester))
+		assert.Equal(t, map[string]string{"reservation_created": "queued"}, s.statuses(plain))
+
+		prefs, err := s.store.Get(ctx, s.DB, digester)
+		require.NoError(t, err)
+		assert.Equal(t, "daily", prefs.Digest)
+		assert.Equal(t, int64(1), prefs.Held)
+	})
+
+	s.Run("Error case: unknown users", func() {
+		t := s.T()
+		err := s.repo.SetMode(ctx, s.DB, uuid.New(), "daily")
+		assert.True(t, infra.IsKind(err, infra.KindNotFound), "got %v", err)
+
+		_, err = s.store.Get(ctx, s.DB, uuid.New())
+		assert.True(t, infra.IsKind(err, infra.KindNotFound), "got %v", err)
+
+		err = s.repo.SetMode(ctx, s.DB, dbtest.Scenario(t, s.DB).WithUser("viewer").Build().User.ID, "hourly")
+		assert.True(t, infra.IsKind(err, infra.KindCheckViolated), "got %v", err)
+	})
+}
+
+func (s *notificationDigestSuite) TestDue() {
+	ctx := context.Background()
+
+	s.Run("Normal case: digests fall due once the oldest held job has waited out the period", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser("viewer").WithUser("viewer").WithUser("viewer").Build()
+		daily, weekly, stopped := sc.Users[0].ID, sc.Users[1].ID, sc.Users[2].ID
+		for _, u := range []struct {
+			id   uuid.UUID
+			mode string
+		}{{daily, "daily"}, {weekly, "weekly"}, {stopped, "weekly"}} {
+			require.NoError(t, s.repo.SetMode(ctx, s.DB, u.id, u.mode))
+			s.notify(u.id, "reservation_created", true)
+			s.notify(u.id, "referral_rewarded", true)
+		}
+		require.NoError(t, s.repo.SetMode(ctx, s.DB, stopped, "off"))
+
+		now := time.Now()
+		due, err := s.repo.ListDue(ctx, s.DB, now, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []shared.DueNotificationDigest{{UserID: stopped, Mode: "off"}}, due)
+
+		due, err = s.repo.ListDue(ctx, s.DB, now.Add(25*time.Hour), 10)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []shared.DueNotificationDigest{{UserID: daily, Mode: "daily"}, {UserID: stopped, Mode: "off"}}, due)
+
+		due, err = s.repo.ListDue(ctx, s.DB, now.Add(8*24*time.Hour), 10)
+		require.NoError(t, err)
+		assert.Len(t, due, 3)
+	})
+
+	s.Run("Normal case: taking the held jobs marks them digested, once", func() {
+		t := s.T()
+		userID := dbtest.Scenario(t, s.DB).WithUser("viewer").Build().User.ID
+		require.NoError(t, s.repo.SetMode(ctx, s.DB, userID, "daily"))
+		s.notify(userID, "reservation_created", true)
+		s.notify(userID, "reservation_message", true)
+
+		held, err := s.repo.Take(ctx, s.DB, userID, time.Now())
+		require.NoError(t, err)
+		require.Len(t, held, 2)
+		assert.Equal(t, "reservation_created", held[0].Topic)
+		assert.JSONEq(t, `{"type":"reservation_created"}`, string(held[0].Payload))
+		assert.False(t, held[1].CreatedAt.Before(held[0].CreatedAt))
+		assert.Equal(t, map[string]string{"reservation_created": "digested", "reservation_message": "digested"}, s.statuses(userID))
+
+		held, err = s.repo.Take(ctx, s.DB, userID, time.Now())
+		require.NoError(t, err)
+		assert.Empty(t, held)
+		due, err := s.repo.ListDue(ctx, s.DB, time.Now().Add(48*time.Hour), 10)
+		require.NoError(t, err)
+		assert.Empty(t, due)
+	})
+}
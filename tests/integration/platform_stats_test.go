@@ -0,0 +1,95 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/infra/readstore"
+	"gin-clean-starter/internal/infra/repository"
+	"gin-clean-starter/tests/common/dbtest"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type platformStatsSuite struct {
+	dbSuite
+	repo  *repository.PlatformStatsRepository
+	store *readstore.PlatformStatsReadStore
+}
+
+func TestPlatformStatsSuite(t *testing.T) {
+	suite.Run(t, new(platformStatsSuite))
+}
+
+func (s *platformStatsSuite) SetupSuite() {
+	s.dbSuite.SetupSuite()
+	s.repo = repository.NewPlatformStatsRepository(s.Queries)
+	s.store = readstore.NewPlatformStatsReadStore(s.Queries)
+}
+
+// rate stores a resource's rating stats as the review commands would; counts[k] is the
+// number of k+1 star reviews.
+func (s *platformStatsSuite) rate(resourceID uuid.UUID, counts [5]int) {
+	t := s.T()
+	t.Helper()
+	_, err := s.DB.Exec(context.Background(), `
+		INSERT INTO resource_rating_stats (resource_id, total_reviews, rating_1_count, rating_2_count, rating_3_count, rating_4_count, rating_5_count)
+		VALUES ($1, $2 + $3 + $4 + $5 + $6, $2, $3, $4, $5, $6)`,
+		resourceID, counts[0], counts[1], counts[2], counts[3], counts[4])
+	require.NoError(t, err)
+}
+
+func (s *platformStatsSuite) TestRefresh() {
+	ctx := context.Background()
+
+	s.Run("Normal case: totals count every resource and weigh the average by reviews", func() {
+		t := s.T()
+		popular := dbtest.CreateTestResource(t, s.DB, "Popular Room", 0)
+		panned := dbtest.CreateTestResource(t, s.DB, "Panned Room", 0)
+		dbtest.CreateTestResource(t, s.DB, "Unreviewed Room", 0)
+		s.rate(popular, [5]int{0, 0, 0, 1, 3})
+		s.rate(panned, [5]int{1, 0, 0, 0, 0})
+
+		at := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+		require.NoError(t, s.repo.Refresh(ctx, s.DB, at))
+
+		stats, err := s.store.Get(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), stats.TotalResources)
+		assert.Equal(t, int64(5), stats.TotalReviews)
+		assert.InDelta(t, 4.0, stats.AverageRating, 0.001, "20 stars over 5 reviews, not the mean of 4.75 and 1")
+		require.NotNil(t, stats.ComputedAt)
+		assert.True(t, at.Equal(*stats.ComputedAt))
+	})
+
+	s.Run("Normal case: a later refresh replaces the single row", func() {
+		t := s.T()
+		require.NoError(t, s.repo.Refresh(ctx, s.DB, time.Now()))
+		dbtest.CreateTestResource(t, s.DB, "New Room", 0)
+		require.NoError(t, s.repo.Refresh(ctx, s.DB, time.Now()))
+
+		stats, err := s.store.Get(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), stats.TotalResources)
+		assert.Zero(t, stats.TotalReviews)
+		assert.Zero(t, stats.AverageRating)
+
+		var rows int
+		require.NoError(t, s.DB.QueryRow(ctx, `SELECT count(*) FROM platform_stats`).Scan(&rows))
+		assert.Equal(t, 1, rows)
+	})
+
+	s.Run("Error case: stats never computed map to KindNotFound", func() {
+		t := s.T()
+		_, err := s.store.Get(ctx, s.DB)
+		require.Error(t, err)
+		assert.True(t, infra.IsKind(err, infra.KindNotFound))
+	})
+}
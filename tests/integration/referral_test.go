@@ -0,0 +1,177 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/domain/referral"
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/infra/readstore"
+	"gin-clean-starter/internal/infra/repository"
+	"gin-clean-starter/tests/common/dbtest"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type referralSuite struct {
+	dbSuite
+	repo  *repository.ReferralRepository
+	store *readstore.ReferralReadStore
+}
+
+func TestReferralSuite(t *testing.T) {
+	suite.Run(t, new(referralSuite))
+}
+
+func (s *referralSuite) SetupSuite() {
+	s.dbSuite.SetupSuite()
+	s.repo = repository.NewReferralRepository(s.Queries)
+	s.store = readstore.NewReferralReadStore(s.Queries)
+}
+
+func (s *referralSuite) referralCode(userID uuid.UUID) string {
+	t := s.T()
+	t.Helper()
+
+	overview, err := s.store.FindOverview(context.Background(), s.DB, userID)
+	require.NoError(t, err)
+	return overview.Code
+}
+
+func (s *referralSuite) TestCodes() {
+	ctx := context.Background()
+
+	s.Run("Normal case: every user gets a distinct code that resolves case-insensitively", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser("viewer").WithUser("viewer").Build()
+
+		first, second := s.referralCode(sc.Users[0].ID), s.referralCode(sc.Users[1].ID)
+		_, err := referral.NewCode(first)
+		require.NoError(t, err, "generated code %q must pass validation", first)
+		assert.NotEqual(t, first, second)
+
+		id, err := s.store.FindReferrerByCode(ctx, s.DB, strings.ToLower(first))
+		require.NoError(t, err)
+		assert.Equal(t, sc.Users[0].ID, id)
+	})
+
+	s.Run("Error case: inactive users' codes do not resolve", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser("viewer").Build()
+		code := s.referralCode(sc.User.ID)
+		_, err := s.DB.Exec(ctx, `UPDATE users SET is_active = false WHERE id = $1`, sc.User.ID)
+		require.NoError(t, err)
+
+		_, err = s.store.FindReferrerByCode(ctx, s.DB, code)
+		require.Error(t, err)
+		assert.True(t, infra.IsKind(err, infra.KindNotFound), "got %v", err)
+	})
+}
+
+func (s *referralSuite) TestCreate() {
+	ctx := context.Background()
+
+	s.Run("Error case: a user is referred at most once", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser("viewer").WithUser("viewer").WithUser("viewer").Build()
+
+		_, err := s.repo.Create(ctx, s.DB, sc.Users[0].ID, sc.Users[2].ID)
+		require.NoError(t, err)
+		_, err = s.repo.Create(ctx, s.DB, sc.Users[1].ID, sc.Users[2].ID)
+		require.Error(t, err)
+		assert.True(t, infra.IsKind(err, infra.KindDuplicateKey), "got %v", err)
+	})
+
+	s.Run("Error case: self-referral violates the check constraint", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser("viewer").Build()
+
+		_, err := s.repo.Create(ctx, s.DB, sc.User.ID, sc.User.ID)
+		require.Error(t, err)
+		assert.True(t, infra.IsKind(err, infra.KindCheckViolated), "got %v", err)
+	})
+}
+
+func (s *referralSuite) TestRewards() {
+	ctx := context.Background()
+
+	s.Run("Normal case: only referrals with a completed reservation are rewardable", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).
+			WithUser("viewer").
+			WithUser("viewer").WithResource().WithUpcomingReservation().
+			WithUser("viewer").WithResource().WithCompletedReservation().WithCompletedReservation().
+			Build()
+		referrer, upcoming, completed := sc.Users[0].ID, sc.Users[1].ID, sc.Users[2].ID
+
+		_, err := s.repo.Create(ctx, s.DB, referrer, upcoming)
+		require.NoError(t, err)
+		completedReferral, err := s.repo.Create(ctx, s.DB, referrer, completed)
+		require.NoError(t, err)
+
+		rewardable, err := s.store.ListRewardable(ctx, s.DB, time.Now(), 10)
+		require.NoError(t, err)
+		require.Len(t, rewardable, 1)
+		assert.Equal(t, completedReferral, rewardable[0].ID)
+		assert.Equal(t, referrer, rewardable[0].ReferrerID)
+		assert.Equal(t, completed, rewardable[0].ReferredID)
+		assert.Equal(t, sc.ReservationIDs[2], rewardable[0].ReservationID, "the earliest completed reservation is attributed")
+	})
+
+	s.Run("Normal case: rewarded referral credits both parties once", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser("viewer").WithUser("viewer").WithResource().WithCompletedReservation().Build()
+		referrer, referred := sc.Users[0].ID, sc.Users[1].ID
+
+		referralID, err := s.repo.Create(ctx, s.DB, referrer, referred)
+		require.NoError(t, err)
+		policy, err := referral.NewRewardPolicy(1000, 500)
+		require.NoError(t, err)
+
+		require.NoError(t, s.repo.MarkRewarded(ctx, s.DB, referralID, sc.ReservationID, time.Now()))
+		for _, credit := range policy.Credits(referrer, referred) {
+			require.NoError(t, s.repo.CreateCredit(ctx, s.DB, referralID, credit))
+		}
+
+		err = s.repo.MarkRewarded(ctx, s.DB, referralID, sc.ReservationID, time.Now())
+		require.Error(t, err)
+		assert.True(t, infra.IsKind(err, infra.KindConflict), "got %v", err)
+
+		err = s.repo.CreateCredit(ctx, s.DB, referralID, policy.Credits(referrer, referred)[0])
+		require.Error(t, err)
+		assert.True(t, infra.IsKind(err, infra.KindDuplicateKey), "got %v", err)
+
+		rewardable, err := s.store.ListRewardable(ctx, s.DB, time.Now(), 10)
+		require.NoError(t, err)
+		assert.Empty(t, rewardable)
+
+		overview, err := s.store.FindOverview(ctx, s.DB, referrer)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1000), overview.CreditCents)
+		require.Len(t, overview.Referrals, 1)
+		assert.Equal(t, referral.StatusRewarded.String(), overview.Referrals[0].Status)
+		require.NotNil(t, overview.Referrals[0].RewardCents)
+		assert.Equal(t, int32(1000), *overview.Referrals[0].RewardCents)
+		assert.NotNil(t, overview.Referrals[0].RewardedAt)
+
+		referredOverview, err := s.store.FindOverview(ctx, s.DB, referred)
+		require.NoError(t, err)
+		assert.Equal(t, int64(500), referredOverview.CreditCents)
+		assert.Empty(t, referredOverview.Referrals)
+	})
+
+	s.Run("Error case: unknown user has no overview", func() {
+		t := s.T()
+
+		_, err := s.store.FindOverview(ctx, s.DB, uuid.New())
+		require.Error(t, err)
+		assert.True(t, infra.IsKind(err, infra.KindNotFound), "got %v", err)
+	})
+}
@@ -0,0 +1,229 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/domain/reservation"
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/infra/readstore"
+	"gin-clean-starter/internal/infra/repository"
+	"gin-clean-starter/internal/pkg/clock"
+	"gin-clean-starter/tests/common/dbtest"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type reservationSuite struct {
+	dbSuite
+	repo  *repository.ReservationRepository
+	store *readstore.ReservationReadStore
+}
+
+func TestReservationSuite(t *testing.T) {
+	suite.Run(t, new(reservationSuite))
+}
+
+func (s *reservationSuite) SetupSuite() {
+	s.dbSuite.SetupSuite()
+	s.repo = repository.NewReservationRepository(s.Queries, s.DB)
+	s.store = readstore.NewReservationReadStore(s.Queries)
+}
+
+func (s *reservationSuite) newReservation(resourceID, userID uuid.UUID, start time.Time) *reservation.Reservation {
+	t := s.T()
+	t.Helper()
+
+	slot, err := reservation.NewTimeSlot(start, start.Add(time.Hour))
+	require.NoError(t, err)
+	note, err := reservation.NewNote("window seat")
+	require.NoError(t, err)
+	return reservation.ReconstructReservation(uuid.New(), resourceID, userID, slot,
+		reservation.StatusConfirmed, reservation.NewMoney(1500), nil, note, time.Time{}, time.Time{})
+}
+
+func (s *reservationSuite) insertCoupon(code string, priority int) uuid.UUID {
+	t := s.T()
+	t.Helper()
+
+	var id uuid.UUID
+	err := s.DB.QueryRow(context.Background(),
+		`INSERT INTO coupons (code, amount_off_cents, priority) VALUES ($1, 500, $2) RETURNING id`,
+		code, priority).Scan(&id)
+	require.NoError(t, err)
+	return id
+}
+
+func (s *reservationSuite) TestCreate() {
+	ctx := context.Background()
+	start := time.Now().Add(48 * time.Hour).Truncate(time.Hour)
+
+	s.Run("Normal case: created reservation is readable through the readstore", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUserEmail("booker@example.com", "viewer").WithResourceNamed("Room A", 0).Build()
+
+		id, err := s.repo.Create(ctx, s.DB, s.newReservation(sc.ResourceID, sc.User.ID, start))
+		require.NoError(t, err)
+
+		view, err := s.store.FindByID(ctx, s.DB, id)
+		require.NoError(t, err)
+		assert.Equal(t, sc.ResourceID, view.ResourceID)
+		assert.Equal(t, "Room A", view.ResourceName)
+		assert.Equal(t, "booker@example.com", view.UserEmail)
+		assert.Equal(t, "confirmed", view.Status)
+		assert.Equal(t, int32(1500), view.PriceCents)
+		assert.False(t, view.CreatedAt.IsZero())
+	})
+
+	s.Run("Normal case: discount line items round-trip in application order", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser("viewer").WithResource().Build()
+		flat, pct := s.insertCoupon("FLAT500", 10), s.insertCoupon("TENPCT", 0)
+
+		slot, err := reservation.NewTimeSlot(start, start.Add(time.Hour))
+		require.NoError(t, err)
+		services := &reservation.Services{Clock: clock.NewMockClock(start.Add(-24 * time.Hour))}
+		discounts := []reservation.AppliedDiscount{
+			{CouponID: flat, Code: "FLAT500", Amount: reservation.NewMoney(500)},
+			{CouponID: pct, Code: "TENPCT", Amount: reservation.NewMoney(100)},
+		}
+		res, err := reservation.NewQuotedReservation(services, reservation.ResourceSpec{ID: sc.ResourceID},
+			sc.User.ID, slot, discounts, reservation.RedeemedPoints{}, reservation.Note{}, 900)
+		require.NoError(t, err)
+
+		id, err := s.repo.Create(ctx, s.DB, res)
+		require.NoError(t, err)
+
+		view, err := s.store.FindByID(ctx, s.DB, id)
+		require.NoError(t, err)
+		require.Len(t, view.Discounts, 2)
+		assert.Equal(t, flat, view.Discounts[0].CouponID)
+		assert.Equal(t, "FLAT500", view.Discounts[0].CouponCode)
+		assert.Equal(t, int32(500), view.Discounts[0].AmountCents)
+		assert.Equal(t, pct, view.Discounts[1].CouponID)
+		assert.Equal(t, int32(100), view.Discounts[1].AmountCents)
+	})
+
+	s.Run("Normal case: reservation without coupons has no discount lines", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser("viewer").WithResource().Build()
+
+		id, err := s.repo.Create(ctx, s.DB, s.newReservation(sc.ResourceID, sc.User.ID, start))
+		require.NoError(t, err)
+
+		view, err := s.store.FindByID(ctx, s.DB, id)
+		require.NoError(t, err)
+		assert.NotNil(t, view.Discounts)
+		assert.Empty(t, view.Discounts)
+	})
+
+	s.Run("Normal case: back-to-back slots do not overlap", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser("viewer").WithResource().Build()
+
+		_, err := s.repo.Create(ctx, s.DB, s.newReservation(sc.ResourceID, sc.User.ID, start))
+		require.NoError(t, err)
+		_, err = s.repo.Create(ctx, s.DB, s.newReservation(sc.ResourceID, sc.User.ID, start.Add(time.Hour)))
+		require.NoError(t, err, "[start, end) ranges that only touch must be accepted")
+	})
+
+	s.Run("Error case: overlapping slot maps the exclusion violation to KindConflict", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser("viewer").WithResource().Build()
+
+		_, err := s.repo.Create(ctx, s.DB, s.newReservation(sc.ResourceID, sc.User.ID, start))
+		require.NoError(t, err)
+
+		_, err = s.repo.Create(ctx, s.DB, s.newReservation(sc.ResourceID, sc.User.ID, start.Add(30*time.Minute)))
+		require.Error(t, err)
+		assert.True(t, infra.IsKind(err, infra.KindConflict), "got %v", err)
+	})
+
+	s.Run("Normal case: same slot on another resource is accepted", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser("viewer").WithResource().WithResource().Build()
+
+		_, err := s.repo.Create(ctx, s.DB, s.newReservation(sc.ResourceIDs[0], sc.User.ID, start))
+		require.NoError(t, err)
+		_, err = s.repo.Create(ctx, s.DB, s.newReservation(sc.ResourceIDs[1], sc.User.ID, start))
+		require.NoError(t, err)
+	})
+
+	s.Run("Error case: unknown resource maps to KindForeignKeyViolated", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser("viewer").Build()
+
+		_, err := s.repo.Create(ctx, s.DB, s.newReservation(uuid.New(), sc.User.ID, start))
+		require.Error(t, err)
+		assert.True(t, infra.IsKind(err, infra.KindForeignKeyViolated), "got %v", err)
+	})
+}
+
+func (s *reservationSuite) TestCancel() {
+	ctx := context.Background()
+
+	s.Run("Normal case: confirmed reservation is canceled once", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser("viewer").WithResource().WithUpcomingReservation().Build()
+
+		require.NoError(t, s.repo.Cancel(ctx, s.DB, sc.ReservationID))
+
+		view, err := s.store.FindByID(ctx, s.DB, sc.ReservationID)
+		require.NoError(t, err)
+		assert.Equal(t, "canceled", view.Status)
+
+		err = s.repo.Cancel(ctx, s.DB, sc.ReservationID)
+		require.Error(t, err)
+		assert.True(t, infra.IsKind(err, infra.KindConflict), "got %v", err)
+	})
+
+	s.Run("Error case: unknown reservation reports KindConflict", func() {
+		t := s.T()
+
+		err := s.repo.Cancel(ctx, s.DB, uuid.New())
+		require.Error(t, err)
+		assert.True(t, infra.IsKind(err, infra.KindConflict), "got %v", err)
+	})
+}
+
+func (s *reservationSuite) TestReadStore() {
+	ctx := context.Background()
+
+	s.Run("Error case: missing reservation maps to KindNotFound", func() {
+		t := s.T()
+
+		_, err := s.store.FindByID(ctx, s.DB, uuid.New())
+		require.Error(t, err)
+		assert.True(t, infra.IsKind(err, infra.KindNotFound), "got %v", err)
+	})
+
+	s.Run("Normal case: user listing pages with the keyset cursor", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser("viewer").WithResource().
+			WithUpcomingReservation().WithUpcomingReservation().WithUpcomingReservation().
+			Build()
+		dbtest.Scenario(t, s.DB).WithUser("viewer").WithResource().WithUpcomingReservation().Build()
+
+		first, err := s.store.FindByUserIDFirstPage(ctx, s.DB, sc.User.ID, 2)
+		require.NoError(t, err)
+		require.Len(t, first, 2)
+
+		last := first[len(first)-1]
+		rest, err := s.store.FindByUserIDKeyset(ctx, s.DB, sc.User.ID, last.CreatedAt, last.ID, 2)
+		require.NoError(t, err)
+		require.Len(t, rest, 1)
+
+		seen := map[uuid.UUID]bool{}
+		for _, item := range append(first, rest...) {
+			assert.Equal(t, sc.ResourceID, item.ResourceID, "another user's reservation leaked into the listing")
+			seen[item.ID] = true
+		}
+		assert.Len(t, seen, 3)
+	})
+}
@@ -0,0 +1,319 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gin-clean-starter/internal/domain/review"
+	"gin-clean-starter/internal/infra"
+	"gin-clean-starter/internal/infra/readstore"
+	"gin-clean-starter/internal/infra/repository"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+	"gin-clean-starter/internal/usecase/queries"
+	"gin-clean-starter/internal/usecase/shared"
+	"gin-clean-starter/tests/common/dbtest"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type reviewSuite struct {
+	dbSuite
+	repo  *repository.ReviewRepository
+	stats *repository.RatingStatsRepository
+	store *readstore.ReviewReadStore
+}
+
+func TestReviewSuite(t *testing.T) {
+	suite.Run(t, new(reviewSuite))
+}
+
+func (s *reviewSuite) SetupSuite() {
+	s.dbSuite.SetupSuite()
+	s.repo = repository.NewReviewRepository(s.Queries, s.DB)
+	s.stats = repository.NewRatingStatsRepository(s.Queries, s.DB)
+	s.store = readstore.NewReviewReadStore(s.Queries)
+}
+
+func (s *reviewSuite) newReview(sc *dbtest.ScenarioFixtures, reservationID uuid.UUID, rating int, comment string) *review.Review {
+	t := s.T()
+	t.Helper()
+
+	rev, err := review.NewReview(uuid.New(), sc.User.ID, sc.ResourceID, reservationID, rating, comment, time.Now())
+	require.NoError(t, err)
+	return rev
+}
+
+func (s *reviewSuite) TestCreate() {
+	ctx := context.Background()
+
+	s.Run("Normal case: created review is readable through the readstore", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUserEmail("critic@example.com", "viewer").
+			WithResourceNamed("Quiet Room", 0).WithCompletedReservation().Build()
+
+		id, err := s.repo.Create(ctx, s.DB, s.newReview(sc, sc.ReservationID, 4, "Comfortable"))
+		require.NoError(t, err)
+
+		view, err := s.store.FindByID(ctx, s.DB, id)
+		require.NoError(t, err)
+		assert.Equal(t, "critic@example.com", view.UserEmail)
+		assert.Equal(t, "Quiet Room", view.ResourceName)
+		assert.Equal(t, &sc.ReservationID, view.ReservationID)
+		assert.Equal(t, int32(4), view.Rating)
+		assert.Equal(t, "Comfortable", view.Comment)
+	})
+
+	s.Run("Error case: second review of a reservation maps to KindDuplicateKey", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser("viewer").WithResource().WithCompletedReservation().Build()
+
+		_, err := s.repo.Create(ctx, s.DB, s.newReview(sc, sc.ReservationID, 5, "First"))
+		require.NoError(t, err)
+
+		_, err = s.repo.Create(ctx, s.DB, s.newReview(sc, sc.ReservationID, 1, "Second"))
+		require.Error(t, err)
+		assert.True(t, infra.IsKind(err, infra.KindDuplicateKey), "got %v", err)
+	})
+
+	s.Run("Error case: unknown reservation maps to KindForeignKeyViolated", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser("viewer").WithResource().Build()
+
+		_, err := s.repo.Create(ctx, s.DB, s.newReview(sc, uuid.New(), 3, "Ghost booking"))
+		require.Error(t, err)
+		assert.True(t, infra.IsKind(err, infra.KindForeignKeyViolated), "got %v", err)
+	})
+}
+
+func (s *reviewSuite) TestCheckConstraint() {
+	s.Run("Error case: rating outside the CHECK range maps to KindCheckViolated", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser("viewer").WithResource().WithCompletedReservation().Build()
+
+		// The domain rejects such ratings first, so go through the generated query directly.
+		_, err := s.Queries.CreateReview(context.Background(), s.DB, sqlc.CreateReviewParams{
+			ID:            uuid.New(),
+			UserID:        sc.User.ID,
+			ResourceID:    sc.ResourceID,
+			ReservationID: pgconv.UUIDToPgtype(sc.ReservationID),
+			Rating:        9,
+			Comment:       "Off the scale",
+			Status:        "published",
+		})
+		require.Error(t, err)
+
+		err = infra.WrapRepoErr("failed to create review", err)
+		assert.True(t, infra.IsKind(err, infra.KindCheckViolated), "got %v", err)
+	})
+}
+
+func (s *reviewSuite) TestUpdateAndDelete() {
+	ctx := context.Background()
+
+	s.Run("Normal case: update then delete", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser("viewer").WithResource().WithCompletedReservation().Build()
+
+		id, err := s.repo.Create(ctx, s.DB, s.newReview(sc, sc.ReservationID, 2, "Noisy"))
+		require.NoError(t, err)
+
+		require.NoError(t, s.repo.Update(ctx, s.DB, id, s.newReview(sc, sc.ReservationID, 4, "Better on a weekday")))
+		view, err := s.store.FindByID(ctx, s.DB, id)
+		require.NoError(t, err)
+		assert.Equal(t, int32(4), view.Rating)
+		assert.Equal(t, "Better on a weekday", view.Comment)
+
+		require.NoError(t, s.repo.Delete(ctx, s.DB, id))
+		_, err = s.store.FindByID(ctx, s.DB, id)
+		assert.True(t, infra.IsKind(err, infra.KindNotFound), "got %v", err)
+	})
+
+	s.Run("Error case: missing review maps to KindNotFound", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser("viewer").WithResource().Build()
+
+		err := s.repo.Update(ctx, s.DB, uuid.New(), s.newReview(sc, uuid.New(), 3, "Nothing here"))
+		assert.True(t, infra.IsKind(err, infra.KindNotFound), "got %v", err)
+
+		err = s.repo.Delete(ctx, s.DB, uuid.New())
+		assert.True(t, infra.IsKind(err, infra.KindNotFound), "got %v", err)
+	})
+}
+
+func (s *reviewSuite) TestReadStore() {
+	ctx := context.Background()
+
+	s.Run("Normal case: resource listing honors the rating filter", func() {
+		t := s.T()
+		b := dbtest.Scenario(t, s.DB).WithResource()
+		for range 3 {
+			b.WithUser("viewer").WithCompletedReservation()
+		}
+		sc := b.Build()
+
+		for i, rating := range []int{1, 3, 5} {
+			user := sc.Users[i]
+			rev, err := review.NewReview(uuid.New(), user.ID, sc.ResourceID, sc.ReservationIDs[i], rating, "Rated", time.Now())
+			require.NoError(t, err)
+			_, err = s.repo.Create(ctx, s.DB, rev)
+			require.NoError(t, err)
+		}
+
+		all, err := s.store.FindByResourceFirstPage(ctx, s.DB, sc.ResourceID, 10, queries.ReviewFilters{})
+		require.NoError(t, err)
+		assert.Len(t, all, 3)
+
+		minRating, maxRating := 2, 4
+		filtered, err := s.store.FindByResourceFirstPage(ctx, s.DB, sc.ResourceID, 10, queries.ReviewFilters{MinRating: &minRating, MaxRating: &maxRating})
+		require.NoError(t, err)
+		require.Len(t, filtered, 1)
+		assert.Equal(t, int32(3), filtered[0].Rating)
+	})
+
+	s.Run("Normal case: rating stats follow create, update and delete", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser("viewer").WithResource().Build()
+
+		stats, err := s.store.GetResourceRatingStats(ctx, s.DB, sc.ResourceID)
+		require.NoError(t, err)
+		assert.Zero(t, stats.TotalReviews, "uninitialized stats read as zero")
+
+		require.NoError(t, s.stats.ApplyOnCreate(ctx, s.DB, sc.ResourceID, 5))
+		require.NoError(t, s.stats.ApplyOnCreate(ctx, s.DB, sc.ResourceID, 3))
+		require.NoError(t, s.stats.ApplyOnUpdate(ctx, s.DB, sc.ResourceID, 3, 1))
+
+		stats, err = s.store.GetResourceRatingStats(ctx, s.DB, sc.ResourceID)
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), stats.TotalReviews)
+		assert.InDelta(t, 3.0, stats.AverageRating, 0.01)
+		assert.Equal(t, int32(1), stats.Rating1Count)
+		assert.Equal(t, int32(0), stats.Rating3Count)
+		assert.Equal(t, int32(1), stats.Rating5Count)
+
+		require.NoError(t, s.stats.ApplyOnDelete(ctx, s.DB, sc.ResourceID, 5))
+		stats, err = s.store.GetResourceRatingStats(ctx, s.DB, sc.ResourceID)
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), stats.TotalReviews)
+		assert.InDelta(t, 1.0, stats.AverageRating, 0.01)
+	})
+}
+
+func (s *reviewSuite) TestSummaries() {
+	ctx := context.Background()
+
+	s.Run("Normal case: summaries go stale when the review count changes", func() {
+		t := s.T()
+		b := dbtest.Scenario(t, s.DB).WithResource()
+		for range 2 {
+			b.WithUser("viewer").WithCompletedReservation()
+		}
+		sc := b.Build()
+
+		for i, comment := range []string{"Older", "Newer"} {
+			rev, err := review.NewReview(uuid.New(), sc.Users[i].ID, sc.ResourceID, sc.ReservationIDs[i], 4+i, comment, time.Now())
+			require.NoError(t, err)
+			_, err = s.repo.Create(ctx, s.DB, rev)
+			require.NoError(t, err)
+			require.NoError(t, s.stats.ApplyOnCreate(ctx, s.DB, sc.ResourceID, 4+i))
+		}
+
+		assert.Contains(t, s.staleSummaries(), shared.StaleReviewSummary{ResourceID: sc.ResourceID, TotalReviews: 2})
+		excerpts, err := s.store.ListRecentForSummary(ctx, s.DB, sc.ResourceID, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []shared.ReviewExcerpt{{Rating: 5, Comment: "Newer"}, {Rating: 4, Comment: "Older"}}, excerpts)
+
+		saved, err := s.stats.SaveSummary(ctx, s.DB, sc.ResourceID, "2 of 2 recent reviews are positive.", 2)
+		require.NoError(t, err)
+		assert.True(t, saved)
+		assert.NotContains(t, s.staleSummaries(), shared.StaleReviewSummary{ResourceID: sc.ResourceID, TotalReviews: 2})
+
+		stats, err := s.store.GetResourceRatingStats(ctx, s.DB, sc.ResourceID)
+		require.NoError(t, err)
+		require.NotNil(t, stats.Summary)
+		assert.Equal(t, "2 of 2 recent reviews are positive.", *stats.Summary)
+
+		require.NoError(t, s.stats.ApplyOnDelete(ctx, s.DB, sc.ResourceID, 5))
+		assert.Contains(t, s.staleSummaries(), shared.StaleReviewSummary{ResourceID: sc.ResourceID, TotalReviews: 1})
+	})
+
+	s.Run("Error case: a summary built from an outdated count is not saved", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser("viewer").WithResource().Build()
+		require.NoError(t, s.stats.ApplyOnCreate(ctx, s.DB, sc.ResourceID, 5))
+
+		saved, err := s.stats.SaveSummary(ctx, s.DB, sc.ResourceID, "outdated", 0)
+		require.NoError(t, err)
+		assert.False(t, saved)
+
+		stats, err := s.store.GetResourceRatingStats(ctx, s.DB, sc.ResourceID)
+		require.NoError(t, err)
+		assert.Nil(t, stats.Summary)
+	})
+}
+
+func (s *reviewSuite) TestModeration() {
+	ctx := context.Background()
+
+	s.Run("Normal case: a near copy is found, held out of listings and published on approval", func() {
+		t := s.T()
+		sc := dbtest.Scenario(t, s.DB).WithUser("viewer").WithResource().
+			WithCompletedReservation().WithCompletedReservation().Build()
+
+		originalID, err := s.repo.Create(ctx, s.DB, s.newReview(sc, sc.ReservationIDs[0], 5, "Spotless room, friendly staff and a great view of the bay"))
+		require.NoError(t, err)
+
+		_, err = s.store.FindSimilarRecent(ctx, s.DB, sc.User.ID, "Noisy heating and the projector never worked", 20, 0.8)
+		assert.True(t, infra.IsKind(err, infra.KindNotFound), "got %v", err)
+
+		comment := "Spotless room, friendly staff and a great view of the bay!"
+		matchID, err := s.store.FindSimilarRecent(ctx, s.DB, sc.User.ID, comment, 20, 0.8)
+		require.NoError(t, err)
+		assert.Equal(t, originalID, matchID)
+
+		copied := s.newReview(sc, sc.ReservationIDs[1], 5, comment)
+		copied.FlagAsDuplicateOf(matchID)
+		copyID, err := s.repo.Create(ctx, s.DB, copied)
+		require.NoError(t, err)
+
+		listed, err := s.store.FindByResourceFirstPage(ctx, s.DB, sc.ResourceID, 10, queries.ReviewFilters{})
+		require.NoError(t, err)
+		require.Len(t, listed, 1)
+		assert.Equal(t, originalID, listed[0].ID)
+
+		mine, err := s.store.FindByUserFirstPage(ctx, s.DB, sc.User.ID, 10)
+		require.NoError(t, err)
+		require.Len(t, mine, 2)
+		assert.Equal(t, "flagged", mine[0].Status)
+
+		queue, err := s.store.FindFlaggedByResource(ctx, s.DB, sc.ResourceID, 10)
+		require.NoError(t, err)
+		require.Len(t, queue, 1)
+		assert.Equal(t, copyID, queue[0].ID)
+		require.NotNil(t, queue[0].DuplicateOf)
+		assert.Equal(t, originalID, *queue[0].DuplicateOf)
+
+		require.NoError(t, s.repo.Publish(ctx, s.DB, copyID))
+		view, err := s.store.FindByID(ctx, s.DB, copyID)
+		require.NoError(t, err)
+		assert.Equal(t, "published", view.Status)
+
+		err = s.repo.Publish(ctx, s.DB, copyID)
+		assert.True(t, infra.IsKind(err, infra.KindNotFound), "publishing twice: got %v", err)
+	})
+}
+
+func (s *reviewSuite) staleSummaries() []shared.StaleReviewSummary {
+	t := s.T()
+	t.Helper()
+
+	stale, err := s.store.ListStaleSummaries(context.Background(), s.DB, 1000)
+	require.NoError(t, err)
+	return stale
+}
@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/commands/api_key.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/commands/api_key.go -destination=tests/mock/commands/api_key_mock.go -package=commandsmock
+//
+
+// Package commandsmock is a generated GoMock package.
+package commandsmock
+
+import (
+	context "context"
+	request "gin-clean-starter/internal/handler/dto/request"
+	commands "gin-clean-starter/internal/usecase/commands"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAPIKeyCommands is a mock of APIKeyCommands interface.
+type MockAPIKeyCommands struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIKeyCommandsMockRecorder
+	isgomock struct{}
+}
+
+// MockAPIKeyCommandsMockRecorder is the mock recorder for MockAPIKeyCommands.
+type MockAPIKeyCommandsMockRecorder struct {
+	mock *MockAPIKeyCommands
+}
+
+// NewMockAPIKeyCommands creates a new mock instance.
+func NewMockAPIKeyCommands(ctrl *gomock.Controller) *MockAPIKeyCommands {
+	mock := &MockAPIKeyCommands{ctrl: ctrl}
+	mock.recorder = &MockAPIKeyCommandsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPIKeyCommands) EXPECT() *MockAPIKeyCommandsMockRecorder {
+	return m.recorder
+}
+
+// Issue mocks base method.
+func (m *MockAPIKeyCommands) Issue(ctx context.Context, userID, actorID uuid.UUID, req request.IssueAPIKeyRequest) (*commands.IssuedAPIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Issue", ctx, userID, actorID, req)
+	ret0, _ := ret[0].(*commands.IssuedAPIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Issue indicates an expected call of Issue.
+func (mr *MockAPIKeyCommandsMockRecorder) Issue(ctx, userID, actorID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Issue", reflect.TypeOf((*MockAPIKeyCommands)(nil).Issue), ctx, userID, actorID, req)
+}
+
+// Revoke mocks base method.
+func (m *MockAPIKeyCommands) Revoke(ctx context.Context, userID, keyID, actorID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, userID, keyID, actorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockAPIKeyCommandsMockRecorder) Revoke(ctx, userID, keyID, actorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockAPIKeyCommands)(nil).Revoke), ctx, userID, keyID, actorID)
+}
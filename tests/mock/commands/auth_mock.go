@@ -13,8 +13,10 @@ import (
 	context "context"
 	request "gin-clean-starter/internal/handler/dto/request"
 	commands "gin-clean-starter/internal/usecase/commands"
+	shared "gin-clean-starter/internal/usecase/shared"
 	reflect "reflect"
 
+	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -42,32 +44,191 @@ func (m *MockAuthCommands) EXPECT() *MockAuthCommandsMockRecorder {
 	return m.recorder
 }
 
+// ChangePassword mocks base method.
+func (m *MockAuthCommands) ChangePassword(ctx context.Context, userID uuid.UUID, req request.ChangePasswordRequest, deviceKey string) (*commands.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", ctx, userID, req, deviceKey)
+	ret0, _ := ret[0].(*commands.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockAuthCommandsMockRecorder) ChangePassword(ctx, userID, req, deviceKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockAuthCommands)(nil).ChangePassword), ctx, userID, req, deviceKey)
+}
+
+// DisableMFA mocks base method.
+func (m *MockAuthCommands) DisableMFA(ctx context.Context, userID uuid.UUID, code string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableMFA", ctx, userID, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableMFA indicates an expected call of DisableMFA.
+func (mr *MockAuthCommandsMockRecorder) DisableMFA(ctx, userID, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableMFA", reflect.TypeOf((*MockAuthCommands)(nil).DisableMFA), ctx, userID, code)
+}
+
+// EnableMFA mocks base method.
+func (m *MockAuthCommands) EnableMFA(ctx context.Context, userID uuid.UUID, code string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableMFA", ctx, userID, code)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableMFA indicates an expected call of EnableMFA.
+func (mr *MockAuthCommandsMockRecorder) EnableMFA(ctx, userID, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableMFA", reflect.TypeOf((*MockAuthCommands)(nil).EnableMFA), ctx, userID, code)
+}
+
+// EnrollMFA mocks base method.
+func (m *MockAuthCommands) EnrollMFA(ctx context.Context, userID uuid.UUID) (*commands.MFAEnrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnrollMFA", ctx, userID)
+	ret0, _ := ret[0].(*commands.MFAEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnrollMFA indicates an expected call of EnrollMFA.
+func (mr *MockAuthCommandsMockRecorder) EnrollMFA(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnrollMFA", reflect.TypeOf((*MockAuthCommands)(nil).EnrollMFA), ctx, userID)
+}
+
 // Login mocks base method.
-func (m *MockAuthCommands) Login(ctx context.Context, req request.LoginRequest) (*commands.LoginResult, error) {
+func (m *MockAuthCommands) Login(ctx context.Context, req request.LoginRequest, deviceKey, nonce string) (*commands.LoginResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Login", ctx, req)
+	ret := m.ctrl.Call(m, "Login", ctx, req, deviceKey, nonce)
 	ret0, _ := ret[0].(*commands.LoginResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Login indicates an expected call of Login.
-func (mr *MockAuthCommandsMockRecorder) Login(ctx, req any) *gomock.Call {
+func (mr *MockAuthCommandsMockRecorder) Login(ctx, req, deviceKey, nonce any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockAuthCommands)(nil).Login), ctx, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockAuthCommands)(nil).Login), ctx, req, deviceKey, nonce)
+}
+
+// Logout mocks base method.
+func (m *MockAuthCommands) Logout(ctx context.Context, access shared.IssuedToken, refreshToken string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", ctx, access, refreshToken)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockAuthCommandsMockRecorder) Logout(ctx, access, refreshToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuthCommands)(nil).Logout), ctx, access, refreshToken)
 }
 
 // RefreshToken mocks base method.
-func (m *MockAuthCommands) RefreshToken(ctx context.Context, refreshToken string) (*commands.TokenPair, error) {
+func (m *MockAuthCommands) RefreshToken(ctx context.Context, refreshToken, deviceKey string) (*commands.TokenPair, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RefreshToken", ctx, refreshToken)
+	ret := m.ctrl.Call(m, "RefreshToken", ctx, refreshToken, deviceKey)
 	ret0, _ := ret[0].(*commands.TokenPair)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RefreshToken indicates an expected call of RefreshToken.
-func (mr *MockAuthCommandsMockRecorder) RefreshToken(ctx, refreshToken any) *gomock.Call {
+func (mr *MockAuthCommandsMockRecorder) RefreshToken(ctx, refreshToken, deviceKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshToken", reflect.TypeOf((*MockAuthCommands)(nil).RefreshToken), ctx, refreshToken, deviceKey)
+}
+
+// Register mocks base method.
+func (m *MockAuthCommands) Register(ctx context.Context, req request.RegisterRequest) (*commands.RegisterResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", ctx, req)
+	ret0, _ := ret[0].(*commands.RegisterResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockAuthCommandsMockRecorder) Register(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockAuthCommands)(nil).Register), ctx, req)
+}
+
+// RevokeAllSessions mocks base method.
+func (m *MockAuthCommands) RevokeAllSessions(ctx context.Context, userID, actorID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAllSessions", ctx, userID, actorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAllSessions indicates an expected call of RevokeAllSessions.
+func (mr *MockAuthCommandsMockRecorder) RevokeAllSessions(ctx, userID, actorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAllSessions", reflect.TypeOf((*MockAuthCommands)(nil).RevokeAllSessions), ctx, userID, actorID)
+}
+
+// RevokeSession mocks base method.
+func (m *MockAuthCommands) RevokeSession(ctx context.Context, userID, sessionID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", ctx, userID, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockAuthCommandsMockRecorder) RevokeSession(ctx, userID, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockAuthCommands)(nil).RevokeSession), ctx, userID, sessionID)
+}
+
+// UnlockLogin mocks base method.
+func (m *MockAuthCommands) UnlockLogin(ctx context.Context, userID, actorID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockLogin", ctx, userID, actorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlockLogin indicates an expected call of UnlockLogin.
+func (mr *MockAuthCommandsMockRecorder) UnlockLogin(ctx, userID, actorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockLogin", reflect.TypeOf((*MockAuthCommands)(nil).UnlockLogin), ctx, userID, actorID)
+}
+
+// VerifyEmail mocks base method.
+func (m *MockAuthCommands) VerifyEmail(ctx context.Context, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEmail", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockAuthCommandsMockRecorder) VerifyEmail(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockAuthCommands)(nil).VerifyEmail), ctx, token)
+}
+
+// VerifyMFA mocks base method.
+func (m *MockAuthCommands) VerifyMFA(ctx context.Context, req request.VerifyMFARequest, deviceKey string) (*commands.LoginResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyMFA", ctx, req, deviceKey)
+	ret0, _ := ret[0].(*commands.LoginResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyMFA indicates an expected call of VerifyMFA.
+func (mr *MockAuthCommandsMockRecorder) VerifyMFA(ctx, req, deviceKey any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshToken", reflect.TypeOf((*MockAuthCommands)(nil).RefreshToken), ctx, refreshToken)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyMFA", reflect.TypeOf((*MockAuthCommands)(nil).VerifyMFA), ctx, req, deviceKey)
 }
@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/commands/company_deletion.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/commands/company_deletion.go -destination=tests/mock/commands/company_deletion_mock.go -package=commandsmock
+//
+
+// Package commandsmock is a generated GoMock package.
+package commandsmock
+
+import (
+	context "context"
+	shared "gin-clean-starter/internal/usecase/shared"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCompanyDeletionCommands is a mock of CompanyDeletionCommands interface.
+type MockCompanyDeletionCommands struct {
+	ctrl     *gomock.Controller
+	recorder *MockCompanyDeletionCommandsMockRecorder
+	isgomock struct{}
+}
+
+// MockCompanyDeletionCommandsMockRecorder is the mock recorder for MockCompanyDeletionCommands.
+type MockCompanyDeletionCommandsMockRecorder struct {
+	mock *MockCompanyDeletionCommands
+}
+
+// NewMockCompanyDeletionCommands creates a new mock instance.
+func NewMockCompanyDeletionCommands(ctrl *gomock.Controller) *MockCompanyDeletionCommands {
+	mock := &MockCompanyDeletionCommands{ctrl: ctrl}
+	mock.recorder = &MockCompanyDeletionCommandsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCompanyDeletionCommands) EXPECT() *MockCompanyDeletionCommandsMockRecorder {
+	return m.recorder
+}
+
+// Request mocks base method.
+func (m *MockCompanyDeletionCommands) Request(ctx context.Context, companyID, actorID uuid.UUID, confirm string) (*shared.CompanyDeletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Request", ctx, companyID, actorID, confirm)
+	ret0, _ := ret[0].(*shared.CompanyDeletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Request indicates an expected call of Request.
+func (mr *MockCompanyDeletionCommandsMockRecorder) Request(ctx, companyID, actorID, confirm any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Request", reflect.TypeOf((*MockCompanyDeletionCommands)(nil).Request), ctx, companyID, actorID, confirm)
+}
+
+// Run mocks base method.
+func (m *MockCompanyDeletionCommands) Run(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockCompanyDeletionCommandsMockRecorder) Run(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockCompanyDeletionCommands)(nil).Run), ctx)
+}
@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/commands/company_export.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/commands/company_export.go -destination=tests/mock/commands/company_export_mock.go -package=commandsmock
+//
+
+// Package commandsmock is a generated GoMock package.
+package commandsmock
+
+import (
+	context "context"
+	shared "gin-clean-starter/internal/usecase/shared"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCompanyExportCommands is a mock of CompanyExportCommands interface.
+type MockCompanyExportCommands struct {
+	ctrl     *gomock.Controller
+	recorder *MockCompanyExportCommandsMockRecorder
+	isgomock struct{}
+}
+
+// MockCompanyExportCommandsMockRecorder is the mock recorder for MockCompanyExportCommands.
+type MockCompanyExportCommandsMockRecorder struct {
+	mock *MockCompanyExportCommands
+}
+
+// NewMockCompanyExportCommands creates a new mock instance.
+func NewMockCompanyExportCommands(ctrl *gomock.Controller) *MockCompanyExportCommands {
+	mock := &MockCompanyExportCommands{ctrl: ctrl}
+	mock.recorder = &MockCompanyExportCommandsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCompanyExportCommands) EXPECT() *MockCompanyExportCommandsMockRecorder {
+	return m.recorder
+}
+
+// Purge mocks base method.
+func (m *MockCompanyExportCommands) Purge(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockCompanyExportCommandsMockRecorder) Purge(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockCompanyExportCommands)(nil).Purge), ctx)
+}
+
+// Request mocks base method.
+func (m *MockCompanyExportCommands) Request(ctx context.Context, companyID, actorID uuid.UUID) (*shared.CompanyExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Request", ctx, companyID, actorID)
+	ret0, _ := ret[0].(*shared.CompanyExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Request indicates an expected call of Request.
+func (mr *MockCompanyExportCommandsMockRecorder) Request(ctx, companyID, actorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Request", reflect.TypeOf((*MockCompanyExportCommands)(nil).Request), ctx, companyID, actorID)
+}
+
+// Run mocks base method.
+func (m *MockCompanyExportCommands) Run(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockCompanyExportCommandsMockRecorder) Run(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockCompanyExportCommands)(nil).Run), ctx)
+}
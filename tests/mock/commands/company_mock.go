@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/commands/company.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/commands/company.go -destination=tests/mock/commands/company_mock.go -package=commandsmock
+//
+
+// Package commandsmock is a generated GoMock package.
+package commandsmock
+
+import (
+	context "context"
+	request "gin-clean-starter/internal/handler/dto/request"
+	commands "gin-clean-starter/internal/usecase/commands"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCompanyCommands is a mock of CompanyCommands interface.
+type MockCompanyCommands struct {
+	ctrl     *gomock.Controller
+	recorder *MockCompanyCommandsMockRecorder
+	isgomock struct{}
+}
+
+// MockCompanyCommandsMockRecorder is the mock recorder for MockCompanyCommands.
+type MockCompanyCommandsMockRecorder struct {
+	mock *MockCompanyCommands
+}
+
+// NewMockCompanyCommands creates a new mock instance.
+func NewMockCompanyCommands(ctrl *gomock.Controller) *MockCompanyCommands {
+	mock := &MockCompanyCommands{ctrl: ctrl}
+	mock.recorder = &MockCompanyCommandsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCompanyCommands) EXPECT() *MockCompanyCommandsMockRecorder {
+	return m.recorder
+}
+
+// Register mocks base method.
+func (m *MockCompanyCommands) Register(ctx context.Context, req request.RegisterCompanyRequest) (*commands.RegisterCompanyResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", ctx, req)
+	ret0, _ := ret[0].(*commands.RegisterCompanyResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockCompanyCommandsMockRecorder) Register(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockCompanyCommands)(nil).Register), ctx, req)
+}
+
+// SetReviewWindow mocks base method.
+func (m *MockCompanyCommands) SetReviewWindow(ctx context.Context, companyID uuid.UUID, req request.SetReviewWindowRequest, actorID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReviewWindow", ctx, companyID, req, actorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetReviewWindow indicates an expected call of SetReviewWindow.
+func (mr *MockCompanyCommandsMockRecorder) SetReviewWindow(ctx, companyID, req, actorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReviewWindow", reflect.TypeOf((*MockCompanyCommands)(nil).SetReviewWindow), ctx, companyID, req, actorID)
+}
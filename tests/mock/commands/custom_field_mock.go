@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/commands/custom_field.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/commands/custom_field.go -destination=tests/mock/commands/custom_field_mock.go -package=commandsmock
+//
+
+// Package commandsmock is a generated GoMock package.
+package commandsmock
+
+import (
+	context "context"
+	request "gin-clean-starter/internal/handler/dto/request"
+	shared "gin-clean-starter/internal/usecase/shared"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCustomFieldCommands is a mock of CustomFieldCommands interface.
+type MockCustomFieldCommands struct {
+	ctrl     *gomock.Controller
+	recorder *MockCustomFieldCommandsMockRecorder
+	isgomock struct{}
+}
+
+// MockCustomFieldCommandsMockRecorder is the mock recorder for MockCustomFieldCommands.
+type MockCustomFieldCommandsMockRecorder struct {
+	mock *MockCustomFieldCommands
+}
+
+// NewMockCustomFieldCommands creates a new mock instance.
+func NewMockCustomFieldCommands(ctrl *gomock.Controller) *MockCustomFieldCommands {
+	mock := &MockCustomFieldCommands{ctrl: ctrl}
+	mock.recorder = &MockCustomFieldCommandsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCustomFieldCommands) EXPECT() *MockCustomFieldCommandsMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCustomFieldCommands) Create(ctx context.Context, companyID uuid.UUID, req request.CreateCustomFieldRequest, actorID uuid.UUID) (*shared.CustomFieldDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, companyID, req, actorID)
+	ret0, _ := ret[0].(*shared.CustomFieldDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCustomFieldCommandsMockRecorder) Create(ctx, companyID, req, actorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCustomFieldCommands)(nil).Create), ctx, companyID, req, actorID)
+}
+
+// Delete mocks base method.
+func (m *MockCustomFieldCommands) Delete(ctx context.Context, companyID, fieldID, actorID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, companyID, fieldID, actorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCustomFieldCommandsMockRecorder) Delete(ctx, companyID, fieldID, actorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCustomFieldCommands)(nil).Delete), ctx, companyID, fieldID, actorID)
+}
@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/commands/invite.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/commands/invite.go -destination=tests/mock/commands/invite_mock.go -package=commandsmock
+//
+
+// Package commandsmock is a generated GoMock package.
+package commandsmock
+
+import (
+	context "context"
+	request "gin-clean-starter/internal/handler/dto/request"
+	commands "gin-clean-starter/internal/usecase/commands"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockInviteCommands is a mock of InviteCommands interface.
+type MockInviteCommands struct {
+	ctrl     *gomock.Controller
+	recorder *MockInviteCommandsMockRecorder
+	isgomock struct{}
+}
+
+// MockInviteCommandsMockRecorder is the mock recorder for MockInviteCommands.
+type MockInviteCommandsMockRecorder struct {
+	mock *MockInviteCommands
+}
+
+// NewMockInviteCommands creates a new mock instance.
+func NewMockInviteCommands(ctrl *gomock.Controller) *MockInviteCommands {
+	mock := &MockInviteCommands{ctrl: ctrl}
+	mock.recorder = &MockInviteCommandsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInviteCommands) EXPECT() *MockInviteCommandsMockRecorder {
+	return m.recorder
+}
+
+// Accept mocks base method.
+func (m *MockInviteCommands) Accept(ctx context.Context, req request.AcceptInviteRequest) (*commands.AcceptInviteResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Accept", ctx, req)
+	ret0, _ := ret[0].(*commands.AcceptInviteResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Accept indicates an expected call of Accept.
+func (mr *MockInviteCommandsMockRecorder) Accept(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Accept", reflect.TypeOf((*MockInviteCommands)(nil).Accept), ctx, req)
+}
+
+// Create mocks base method.
+func (m *MockInviteCommands) Create(ctx context.Context, req request.CreateInviteRequest, inviterID uuid.UUID, inviterRole string) (*commands.InviteResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, req, inviterID, inviterRole)
+	ret0, _ := ret[0].(*commands.InviteResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockInviteCommandsMockRecorder) Create(ctx, req, inviterID, inviterRole any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockInviteCommands)(nil).Create), ctx, req, inviterID, inviterRole)
+}
+
+// Resend mocks base method.
+func (m *MockInviteCommands) Resend(ctx context.Context, inviteID, actorID uuid.UUID) (*commands.InviteResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resend", ctx, inviteID, actorID)
+	ret0, _ := ret[0].(*commands.InviteResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resend indicates an expected call of Resend.
+func (mr *MockInviteCommandsMockRecorder) Resend(ctx, inviteID, actorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resend", reflect.TypeOf((*MockInviteCommands)(nil).Resend), ctx, inviteID, actorID)
+}
+
+// Revoke mocks base method.
+func (m *MockInviteCommands) Revoke(ctx context.Context, inviteID, actorID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, inviteID, actorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockInviteCommandsMockRecorder) Revoke(ctx, inviteID, actorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockInviteCommands)(nil).Revoke), ctx, inviteID, actorID)
+}
@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/commands/loyalty.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/commands/loyalty.go -destination=tests/mock/commands/loyalty_mock.go -package=commandsmock
+//
+
+// Package commandsmock is a generated GoMock package.
+package commandsmock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLoyaltyCommands is a mock of LoyaltyCommands interface.
+type MockLoyaltyCommands struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoyaltyCommandsMockRecorder
+	isgomock struct{}
+}
+
+// MockLoyaltyCommandsMockRecorder is the mock recorder for MockLoyaltyCommands.
+type MockLoyaltyCommandsMockRecorder struct {
+	mock *MockLoyaltyCommands
+}
+
+// NewMockLoyaltyCommands creates a new mock instance.
+func NewMockLoyaltyCommands(ctrl *gomock.Controller) *MockLoyaltyCommands {
+	mock := &MockLoyaltyCommands{ctrl: ctrl}
+	mock.recorder = &MockLoyaltyCommandsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoyaltyCommands) EXPECT() *MockLoyaltyCommandsMockRecorder {
+	return m.recorder
+}
+
+// AccruePoints mocks base method.
+func (m *MockLoyaltyCommands) AccruePoints(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AccruePoints", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AccruePoints indicates an expected call of AccruePoints.
+func (mr *MockLoyaltyCommandsMockRecorder) AccruePoints(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccruePoints", reflect.TypeOf((*MockLoyaltyCommands)(nil).AccruePoints), ctx)
+}
+
+// ExpirePoints mocks base method.
+func (m *MockLoyaltyCommands) ExpirePoints(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpirePoints", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpirePoints indicates an expected call of ExpirePoints.
+func (mr *MockLoyaltyCommandsMockRecorder) ExpirePoints(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpirePoints", reflect.TypeOf((*MockLoyaltyCommands)(nil).ExpirePoints), ctx)
+}
@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/commands/notification_digest.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/commands/notification_digest.go -destination=tests/mock/commands/notification_digest_mock.go -package=commandsmock
+//
+
+// Package commandsmock is a generated GoMock package.
+package commandsmock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNotificationDigestCommands is a mock of NotificationDigestCommands interface.
+type MockNotificationDigestCommands struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationDigestCommandsMockRecorder
+	isgomock struct{}
+}
+
+// MockNotificationDigestCommandsMockRecorder is the mock recorder for MockNotificationDigestCommands.
+type MockNotificationDigestCommandsMockRecorder struct {
+	mock *MockNotificationDigestCommands
+}
+
+// NewMockNotificationDigestCommands creates a new mock instance.
+func NewMockNotificationDigestCommands(ctrl *gomock.Controller) *MockNotificationDigestCommands {
+	mock := &MockNotificationDigestCommands{ctrl: ctrl}
+	mock.recorder = &MockNotificationDigestCommandsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationDigestCommands) EXPECT() *MockNotificationDigestCommandsMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockNotificationDigestCommands) Run(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockNotificationDigestCommandsMockRecorder) Run(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockNotificationDigestCommands)(nil).Run), ctx)
+}
+
+// SetMode mocks base method.
+func (m *MockNotificationDigestCommands) SetMode(ctx context.Context, userID uuid.UUID, mode string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMode", ctx, userID, mode)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMode indicates an expected call of SetMode.
+func (mr *MockNotificationDigestCommandsMockRecorder) SetMode(ctx, userID, mode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMode", reflect.TypeOf((*MockNotificationDigestCommands)(nil).SetMode), ctx, userID, mode)
+}
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/commands/oidc.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/commands/oidc.go -destination=tests/mock/commands/oidc_mock.go -package=commandsmock
+//
+
+// Package commandsmock is a generated GoMock package.
+package commandsmock
+
+import (
+	context "context"
+	commands "gin-clean-starter/internal/usecase/commands"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOIDCCommands is a mock of OIDCCommands interface.
+type MockOIDCCommands struct {
+	ctrl     *gomock.Controller
+	recorder *MockOIDCCommandsMockRecorder
+	isgomock struct{}
+}
+
+// MockOIDCCommandsMockRecorder is the mock recorder for MockOIDCCommands.
+type MockOIDCCommandsMockRecorder struct {
+	mock *MockOIDCCommands
+}
+
+// NewMockOIDCCommands creates a new mock instance.
+func NewMockOIDCCommands(ctrl *gomock.Controller) *MockOIDCCommands {
+	mock := &MockOIDCCommands{ctrl: ctrl}
+	mock.recorder = &MockOIDCCommandsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOIDCCommands) EXPECT() *MockOIDCCommandsMockRecorder {
+	return m.recorder
+}
+
+// Login mocks base method.
+func (m *MockOIDCCommands) Login(ctx context.Context, provider, code, state, signedState string) (*commands.LoginResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Login", ctx, provider, code, state, signedState)
+	ret0, _ := ret[0].(*commands.LoginResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Login indicates an expected call of Login.
+func (mr *MockOIDCCommandsMockRecorder) Login(ctx, provider, code, state, signedState any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockOIDCCommands)(nil).Login), ctx, provider, code, state, signedState)
+}
+
+// StartLogin mocks base method.
+func (m *MockOIDCCommands) StartLogin(ctx context.Context, provider string) (*commands.OIDCLoginStart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartLogin", ctx, provider)
+	ret0, _ := ret[0].(*commands.OIDCLoginStart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartLogin indicates an expected call of StartLogin.
+func (mr *MockOIDCCommandsMockRecorder) StartLogin(ctx, provider any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartLogin", reflect.TypeOf((*MockOIDCCommands)(nil).StartLogin), ctx, provider)
+}
@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/commands/platform_stats.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/commands/platform_stats.go -destination=tests/mock/commands/platform_stats_mock.go -package=commandsmock
+//
+
+// Package commandsmock is a generated GoMock package.
+package commandsmock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPlatformStatsCommands is a mock of PlatformStatsCommands interface.
+type MockPlatformStatsCommands struct {
+	ctrl     *gomock.Controller
+	recorder *MockPlatformStatsCommandsMockRecorder
+	isgomock struct{}
+}
+
+// MockPlatformStatsCommandsMockRecorder is the mock recorder for MockPlatformStatsCommands.
+type MockPlatformStatsCommandsMockRecorder struct {
+	mock *MockPlatformStatsCommands
+}
+
+// NewMockPlatformStatsCommands creates a new mock instance.
+func NewMockPlatformStatsCommands(ctrl *gomock.Controller) *MockPlatformStatsCommands {
+	mock := &MockPlatformStatsCommands{ctrl: ctrl}
+	mock.recorder = &MockPlatformStatsCommandsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPlatformStatsCommands) EXPECT() *MockPlatformStatsCommandsMockRecorder {
+	return m.recorder
+}
+
+// Refresh mocks base method.
+func (m *MockPlatformStatsCommands) Refresh(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockPlatformStatsCommandsMockRecorder) Refresh(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockPlatformStatsCommands)(nil).Refresh), ctx)
+}
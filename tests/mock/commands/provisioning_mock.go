@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/commands/provisioning.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/commands/provisioning.go -destination=tests/mock/commands/provisioning_mock.go -package=commandsmock
+//
+
+// Package commandsmock is a generated GoMock package.
+package commandsmock
+
+import (
+	context "context"
+	scim "gin-clean-starter/internal/handler/dto/scim"
+	commands "gin-clean-starter/internal/usecase/commands"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProvisioningCommands is a mock of ProvisioningCommands interface.
+type MockProvisioningCommands struct {
+	ctrl     *gomock.Controller
+	recorder *MockProvisioningCommandsMockRecorder
+	isgomock struct{}
+}
+
+// MockProvisioningCommandsMockRecorder is the mock recorder for MockProvisioningCommands.
+type MockProvisioningCommandsMockRecorder struct {
+	mock *MockProvisioningCommands
+}
+
+// NewMockProvisioningCommands creates a new mock instance.
+func NewMockProvisioningCommands(ctrl *gomock.Controller) *MockProvisioningCommands {
+	mock := &MockProvisioningCommands{ctrl: ctrl}
+	mock.recorder = &MockProvisioningCommandsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProvisioningCommands) EXPECT() *MockProvisioningCommandsMockRecorder {
+	return m.recorder
+}
+
+// CreateUser mocks base method.
+func (m *MockProvisioningCommands) CreateUser(ctx context.Context, companyID uuid.UUID, req scim.CreateUserRequest) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", ctx, companyID, req)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockProvisioningCommandsMockRecorder) CreateUser(ctx, companyID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockProvisioningCommands)(nil).CreateUser), ctx, companyID, req)
+}
+
+// IssueToken mocks base method.
+func (m *MockProvisioningCommands) IssueToken(ctx context.Context, companyID, actorID uuid.UUID) (*commands.IssuedProvisioningToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueToken", ctx, companyID, actorID)
+	ret0, _ := ret[0].(*commands.IssuedProvisioningToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueToken indicates an expected call of IssueToken.
+func (mr *MockProvisioningCommandsMockRecorder) IssueToken(ctx, companyID, actorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueToken", reflect.TypeOf((*MockProvisioningCommands)(nil).IssueToken), ctx, companyID, actorID)
+}
+
+// RevokeToken mocks base method.
+func (m *MockProvisioningCommands) RevokeToken(ctx context.Context, companyID, tokenID, actorID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeToken", ctx, companyID, tokenID, actorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeToken indicates an expected call of RevokeToken.
+func (mr *MockProvisioningCommandsMockRecorder) RevokeToken(ctx, companyID, tokenID, actorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeToken", reflect.TypeOf((*MockProvisioningCommands)(nil).RevokeToken), ctx, companyID, tokenID, actorID)
+}
+
+// SetActive mocks base method.
+func (m *MockProvisioningCommands) SetActive(ctx context.Context, companyID, userID uuid.UUID, active bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetActive", ctx, companyID, userID, active)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetActive indicates an expected call of SetActive.
+func (mr *MockProvisioningCommandsMockRecorder) SetActive(ctx, companyID, userID, active any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetActive", reflect.TypeOf((*MockProvisioningCommands)(nil).SetActive), ctx, companyID, userID, active)
+}
@@ -0,0 +1,75 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/commands/quote.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/commands/quote.go -destination=tests/mock/commands/quote_mock.go -package=commandsmock
+//
+
+// Package commandsmock is a generated GoMock package.
+package commandsmock
+
+import (
+	context "context"
+	request "gin-clean-starter/internal/handler/dto/request"
+	commands "gin-clean-starter/internal/usecase/commands"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockQuoteCommands is a mock of QuoteCommands interface.
+type MockQuoteCommands struct {
+	ctrl     *gomock.Controller
+	recorder *MockQuoteCommandsMockRecorder
+	isgomock struct{}
+}
+
+// MockQuoteCommandsMockRecorder is the mock recorder for MockQuoteCommands.
+type MockQuoteCommandsMockRecorder struct {
+	mock *MockQuoteCommands
+}
+
+// NewMockQuoteCommands creates a new mock instance.
+func NewMockQuoteCommands(ctrl *gomock.Controller) *MockQuoteCommands {
+	mock := &MockQuoteCommands{ctrl: ctrl}
+	mock.recorder = &MockQuoteCommandsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQuoteCommands) EXPECT() *MockQuoteCommandsMockRecorder {
+	return m.recorder
+}
+
+// CreateQuote mocks base method.
+func (m *MockQuoteCommands) CreateQuote(ctx context.Context, req request.CreateQuoteRequest, userID uuid.UUID) (*commands.QuoteResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateQuote", ctx, req, userID)
+	ret0, _ := ret[0].(*commands.QuoteResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateQuote indicates an expected call of CreateQuote.
+func (mr *MockQuoteCommandsMockRecorder) CreateQuote(ctx, req, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateQuote", reflect.TypeOf((*MockQuoteCommands)(nil).CreateQuote), ctx, req, userID)
+}
+
+// Requote mocks base method.
+func (m *MockQuoteCommands) Requote(ctx context.Context, req request.CreateReservationRequest, userID uuid.UUID) (*commands.QuoteResult, []string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Requote", ctx, req, userID)
+	ret0, _ := ret[0].(*commands.QuoteResult)
+	ret1, _ := ret[1].([]string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Requote indicates an expected call of Requote.
+func (mr *MockQuoteCommandsMockRecorder) Requote(ctx, req, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Requote", reflect.TypeOf((*MockQuoteCommands)(nil).Requote), ctx, req, userID)
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/commands/referral.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/commands/referral.go -destination=tests/mock/commands/referral_mock.go -package=commandsmock
+//
+
+// Package commandsmock is a generated GoMock package.
+package commandsmock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReferralCommands is a mock of ReferralCommands interface.
+type MockReferralCommands struct {
+	ctrl     *gomock.Controller
+	recorder *MockReferralCommandsMockRecorder
+	isgomock struct{}
+}
+
+// MockReferralCommandsMockRecorder is the mock recorder for MockReferralCommands.
+type MockReferralCommandsMockRecorder struct {
+	mock *MockReferralCommands
+}
+
+// NewMockReferralCommands creates a new mock instance.
+func NewMockReferralCommands(ctrl *gomock.Controller) *MockReferralCommands {
+	mock := &MockReferralCommands{ctrl: ctrl}
+	mock.recorder = &MockReferralCommandsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReferralCommands) EXPECT() *MockReferralCommandsMockRecorder {
+	return m.recorder
+}
+
+// IssueRewards mocks base method.
+func (m *MockReferralCommands) IssueRewards(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueRewards", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueRewards indicates an expected call of IssueRewards.
+func (mr *MockReferralCommandsMockRecorder) IssueRewards(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueRewards", reflect.TypeOf((*MockReferralCommands)(nil).IssueRewards), ctx)
+}
@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/commands/request_recording.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/commands/request_recording.go -destination=tests/mock/commands/request_recording_mock.go -package=commandsmock
+//
+
+// Package commandsmock is a generated GoMock package.
+package commandsmock
+
+import (
+	context "context"
+	request "gin-clean-starter/internal/handler/dto/request"
+	shared "gin-clean-starter/internal/usecase/shared"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRequestRecordingCommands is a mock of RequestRecordingCommands interface.
+type MockRequestRecordingCommands struct {
+	ctrl     *gomock.Controller
+	recorder *MockRequestRecordingCommandsMockRecorder
+	isgomock struct{}
+}
+
+// MockRequestRecordingCommandsMockRecorder is the mock recorder for MockRequestRecordingCommands.
+type MockRequestRecordingCommandsMockRecorder struct {
+	mock *MockRequestRecordingCommands
+}
+
+// NewMockRequestRecordingCommands creates a new mock instance.
+func NewMockRequestRecordingCommands(ctrl *gomock.Controller) *MockRequestRecordingCommands {
+	mock := &MockRequestRecordingCommands{ctrl: ctrl}
+	mock.recorder = &MockRequestRecordingCommandsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRequestRecordingCommands) EXPECT() *MockRequestRecordingCommandsMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockRequestRecordingCommands) Delete(ctx context.Context, id, actorID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id, actorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRequestRecordingCommandsMockRecorder) Delete(ctx, id, actorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRequestRecordingCommands)(nil).Delete), ctx, id, actorID)
+}
+
+// Enable mocks base method.
+func (m *MockRequestRecordingCommands) Enable(ctx context.Context, userID uuid.UUID, req request.EnableRequestRecordingRequest, actorID uuid.UUID) (*shared.RequestRecording, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enable", ctx, userID, req, actorID)
+	ret0, _ := ret[0].(*shared.RequestRecording)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Enable indicates an expected call of Enable.
+func (mr *MockRequestRecordingCommandsMockRecorder) Enable(ctx, userID, req, actorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enable", reflect.TypeOf((*MockRequestRecordingCommands)(nil).Enable), ctx, userID, req, actorID)
+}
+
+// Purge mocks base method.
+func (m *MockRequestRecordingCommands) Purge(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockRequestRecordingCommandsMockRecorder) Purge(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockRequestRecordingCommands)(nil).Purge), ctx)
+}
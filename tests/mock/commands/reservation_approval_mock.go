@@ -0,0 +1,114 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/commands/reservation_approval.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/commands/reservation_approval.go -destination=tests/mock/commands/reservation_approval_mock.go -package=commandsmock
+//
+
+// Package commandsmock is a generated GoMock package.
+package commandsmock
+
+import (
+	context "context"
+	commands "gin-clean-starter/internal/usecase/commands"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReservationApprovalCommands is a mock of ReservationApprovalCommands interface.
+type MockReservationApprovalCommands struct {
+	ctrl     *gomock.Controller
+	recorder *MockReservationApprovalCommandsMockRecorder
+	isgomock struct{}
+}
+
+// MockReservationApprovalCommandsMockRecorder is the mock recorder for MockReservationApprovalCommands.
+type MockReservationApprovalCommandsMockRecorder struct {
+	mock *MockReservationApprovalCommands
+}
+
+// NewMockReservationApprovalCommands creates a new mock instance.
+func NewMockReservationApprovalCommands(ctrl *gomock.Controller) *MockReservationApprovalCommands {
+	mock := &MockReservationApprovalCommands{ctrl: ctrl}
+	mock.recorder = &MockReservationApprovalCommandsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReservationApprovalCommands) EXPECT() *MockReservationApprovalCommandsMockRecorder {
+	return m.recorder
+}
+
+// AddApprover mocks base method.
+func (m *MockReservationApprovalCommands) AddApprover(ctx context.Context, resourceID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddApprover", ctx, resourceID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddApprover indicates an expected call of AddApprover.
+func (mr *MockReservationApprovalCommandsMockRecorder) AddApprover(ctx, resourceID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddApprover", reflect.TypeOf((*MockReservationApprovalCommands)(nil).AddApprover), ctx, resourceID, userID)
+}
+
+// Approve mocks base method.
+func (m *MockReservationApprovalCommands) Approve(ctx context.Context, reservationID, actorID uuid.UUID, actorRole string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Approve", ctx, reservationID, actorID, actorRole)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Approve indicates an expected call of Approve.
+func (mr *MockReservationApprovalCommandsMockRecorder) Approve(ctx, reservationID, actorID, actorRole any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Approve", reflect.TypeOf((*MockReservationApprovalCommands)(nil).Approve), ctx, reservationID, actorID, actorRole)
+}
+
+// Reject mocks base method.
+func (m *MockReservationApprovalCommands) Reject(ctx context.Context, reservationID uuid.UUID, reason string, actorID uuid.UUID, actorRole string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reject", ctx, reservationID, reason, actorID, actorRole)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reject indicates an expected call of Reject.
+func (mr *MockReservationApprovalCommandsMockRecorder) Reject(ctx, reservationID, reason, actorID, actorRole any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reject", reflect.TypeOf((*MockReservationApprovalCommands)(nil).Reject), ctx, reservationID, reason, actorID, actorRole)
+}
+
+// RemoveApprover mocks base method.
+func (m *MockReservationApprovalCommands) RemoveApprover(ctx context.Context, resourceID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveApprover", ctx, resourceID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveApprover indicates an expected call of RemoveApprover.
+func (mr *MockReservationApprovalCommandsMockRecorder) RemoveApprover(ctx, resourceID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveApprover", reflect.TypeOf((*MockReservationApprovalCommands)(nil).RemoveApprover), ctx, resourceID, userID)
+}
+
+// Sweep mocks base method.
+func (m *MockReservationApprovalCommands) Sweep(ctx context.Context) (commands.ApprovalSweep, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sweep", ctx)
+	ret0, _ := ret[0].(commands.ApprovalSweep)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Sweep indicates an expected call of Sweep.
+func (mr *MockReservationApprovalCommandsMockRecorder) Sweep(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sweep", reflect.TypeOf((*MockReservationApprovalCommands)(nil).Sweep), ctx)
+}
@@ -43,6 +43,35 @@ func (m *MockReservationCommands) EXPECT() *MockReservationCommandsMockRecorder
 	return m.recorder
 }
 
+// Cancel mocks base method.
+func (m *MockReservationCommands) Cancel(ctx context.Context, reservationID, actorID uuid.UUID, actorRole string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, reservationID, actorID, actorRole)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockReservationCommandsMockRecorder) Cancel(ctx, reservationID, actorID, actorRole any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockReservationCommands)(nil).Cancel), ctx, reservationID, actorID, actorRole)
+}
+
+// CreateGroup mocks base method.
+func (m *MockReservationCommands) CreateGroup(ctx context.Context, req request.CreateReservationGroupRequest, userID, idempotencyKey uuid.UUID) (*commands.CreateReservationGroupResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGroup", ctx, req, userID, idempotencyKey)
+	ret0, _ := ret[0].(*commands.CreateReservationGroupResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGroup indicates an expected call of CreateGroup.
+func (mr *MockReservationCommandsMockRecorder) CreateGroup(ctx, req, userID, idempotencyKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroup", reflect.TypeOf((*MockReservationCommands)(nil).CreateGroup), ctx, req, userID, idempotencyKey)
+}
+
 // CreateReservation mocks base method.
 func (m *MockReservationCommands) CreateReservation(ctx context.Context, req request.CreateReservationRequest, userID, idempotencyKey uuid.UUID) (*commands.CreateReservationResult, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,70 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/commands/resource_operator.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/commands/resource_operator.go -destination=tests/mock/commands/resource_operator_mock.go -package=commandsmock
+//
+
+// Package commandsmock is a generated GoMock package.
+package commandsmock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockResourceOperatorCommands is a mock of ResourceOperatorCommands interface.
+type MockResourceOperatorCommands struct {
+	ctrl     *gomock.Controller
+	recorder *MockResourceOperatorCommandsMockRecorder
+	isgomock struct{}
+}
+
+// MockResourceOperatorCommandsMockRecorder is the mock recorder for MockResourceOperatorCommands.
+type MockResourceOperatorCommandsMockRecorder struct {
+	mock *MockResourceOperatorCommands
+}
+
+// NewMockResourceOperatorCommands creates a new mock instance.
+func NewMockResourceOperatorCommands(ctrl *gomock.Controller) *MockResourceOperatorCommands {
+	mock := &MockResourceOperatorCommands{ctrl: ctrl}
+	mock.recorder = &MockResourceOperatorCommandsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResourceOperatorCommands) EXPECT() *MockResourceOperatorCommandsMockRecorder {
+	return m.recorder
+}
+
+// Assign mocks base method.
+func (m *MockResourceOperatorCommands) Assign(ctx context.Context, resourceID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assign", ctx, resourceID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Assign indicates an expected call of Assign.
+func (mr *MockResourceOperatorCommandsMockRecorder) Assign(ctx, resourceID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assign", reflect.TypeOf((*MockResourceOperatorCommands)(nil).Assign), ctx, resourceID, userID)
+}
+
+// Unassign mocks base method.
+func (m *MockResourceOperatorCommands) Unassign(ctx context.Context, resourceID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unassign", ctx, resourceID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unassign indicates an expected call of Unassign.
+func (mr *MockResourceOperatorCommandsMockRecorder) Unassign(ctx, resourceID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unassign", reflect.TypeOf((*MockResourceOperatorCommands)(nil).Unassign), ctx, resourceID, userID)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/commands/retention.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/commands/retention.go -destination=tests/mock/commands/retention_mock.go -package=commandsmock
+//
+
+// Package commandsmock is a generated GoMock package.
+package commandsmock
+
+import (
+	context "context"
+	commands "gin-clean-starter/internal/usecase/commands"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRetentionCommands is a mock of RetentionCommands interface.
+type MockRetentionCommands struct {
+	ctrl     *gomock.Controller
+	recorder *MockRetentionCommandsMockRecorder
+	isgomock struct{}
+}
+
+// MockRetentionCommandsMockRecorder is the mock recorder for MockRetentionCommands.
+type MockRetentionCommandsMockRecorder struct {
+	mock *MockRetentionCommands
+}
+
+// NewMockRetentionCommands creates a new mock instance.
+func NewMockRetentionCommands(ctrl *gomock.Controller) *MockRetentionCommands {
+	mock := &MockRetentionCommands{ctrl: ctrl}
+	mock.recorder = &MockRetentionCommandsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRetentionCommands) EXPECT() *MockRetentionCommandsMockRecorder {
+	return m.recorder
+}
+
+// Purge mocks base method.
+func (m *MockRetentionCommands) Purge(ctx context.Context) ([]commands.RetentionPurgeResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx)
+	ret0, _ := ret[0].([]commands.RetentionPurgeResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockRetentionCommandsMockRecorder) Purge(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockRetentionCommands)(nil).Purge), ctx)
+}
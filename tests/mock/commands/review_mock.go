@@ -43,6 +43,20 @@ func (m *MockReviewCommands) EXPECT() *MockReviewCommandsMockRecorder {
 	return m.recorder
 }
 
+// Approve mocks base method.
+func (m *MockReviewCommands) Approve(ctx context.Context, reviewID, actorID uuid.UUID, actorRole string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Approve", ctx, reviewID, actorID, actorRole)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Approve indicates an expected call of Approve.
+func (mr *MockReviewCommandsMockRecorder) Approve(ctx, reviewID, actorID, actorRole any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Approve", reflect.TypeOf((*MockReviewCommands)(nil).Approve), ctx, reviewID, actorID, actorRole)
+}
+
 // Create mocks base method.
 func (m *MockReviewCommands) Create(ctx context.Context, req request.CreateReviewRequest, userID uuid.UUID) (*commands.CreateReviewResult, error) {
 	m.ctrl.T.Helper()
@@ -72,6 +86,21 @@ func (mr *MockReviewCommandsMockRecorder) Delete(ctx, reviewID, actorID, actorRo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockReviewCommands)(nil).Delete), ctx, reviewID, actorID, actorRole)
 }
 
+// Import mocks base method.
+func (m *MockReviewCommands) Import(ctx context.Context, req request.ImportReviewsRequest, actorID uuid.UUID) (*commands.ReviewImportReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, req, actorID)
+	ret0, _ := ret[0].(*commands.ReviewImportReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockReviewCommandsMockRecorder) Import(ctx, req, actorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockReviewCommands)(nil).Import), ctx, req, actorID)
+}
+
 // Update mocks base method.
 func (m *MockReviewCommands) Update(ctx context.Context, reviewID uuid.UUID, req request.UpdateReviewRequest, actorID uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/commands/role.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/commands/role.go -destination=tests/mock/commands/role_mock.go -package=commandsmock
+//
+
+// Package commandsmock is a generated GoMock package.
+package commandsmock
+
+import (
+	context "context"
+	request "gin-clean-starter/internal/handler/dto/request"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRoleCommands is a mock of RoleCommands interface.
+type MockRoleCommands struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoleCommandsMockRecorder
+	isgomock struct{}
+}
+
+// MockRoleCommandsMockRecorder is the mock recorder for MockRoleCommands.
+type MockRoleCommandsMockRecorder struct {
+	mock *MockRoleCommands
+}
+
+// NewMockRoleCommands creates a new mock instance.
+func NewMockRoleCommands(ctrl *gomock.Controller) *MockRoleCommands {
+	mock := &MockRoleCommands{ctrl: ctrl}
+	mock.recorder = &MockRoleCommandsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoleCommands) EXPECT() *MockRoleCommandsMockRecorder {
+	return m.recorder
+}
+
+// ChangeUserRole mocks base method.
+func (m *MockRoleCommands) ChangeUserRole(ctx context.Context, userID uuid.UUID, req request.ChangeUserRoleRequest, actorID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeUserRole", ctx, userID, req, actorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeUserRole indicates an expected call of ChangeUserRole.
+func (mr *MockRoleCommandsMockRecorder) ChangeUserRole(ctx, userID, req, actorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeUserRole", reflect.TypeOf((*MockRoleCommands)(nil).ChangeUserRole), ctx, userID, req, actorID)
+}
+
+// Create mocks base method.
+func (m *MockRoleCommands) Create(ctx context.Context, req request.CreateRoleRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRoleCommandsMockRecorder) Create(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRoleCommands)(nil).Create), ctx, req)
+}
+
+// Delete mocks base method.
+func (m *MockRoleCommands) Delete(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRoleCommandsMockRecorder) Delete(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRoleCommands)(nil).Delete), ctx, name)
+}
+
+// UpdatePermissions mocks base method.
+func (m *MockRoleCommands) UpdatePermissions(ctx context.Context, name string, req request.UpdateRolePermissionsRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePermissions", ctx, name, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePermissions indicates an expected call of UpdatePermissions.
+func (mr *MockRoleCommandsMockRecorder) UpdatePermissions(ctx, name, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePermissions", reflect.TypeOf((*MockRoleCommands)(nil).UpdatePermissions), ctx, name, req)
+}
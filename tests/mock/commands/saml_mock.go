@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/commands/saml.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/commands/saml.go -destination=tests/mock/commands/saml_mock.go -package=commandsmock
+//
+
+// Package commandsmock is a generated GoMock package.
+package commandsmock
+
+import (
+	context "context"
+	request "gin-clean-starter/internal/handler/dto/request"
+	commands "gin-clean-starter/internal/usecase/commands"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSAMLCommands is a mock of SAMLCommands interface.
+type MockSAMLCommands struct {
+	ctrl     *gomock.Controller
+	recorder *MockSAMLCommandsMockRecorder
+	isgomock struct{}
+}
+
+// MockSAMLCommandsMockRecorder is the mock recorder for MockSAMLCommands.
+type MockSAMLCommandsMockRecorder struct {
+	mock *MockSAMLCommands
+}
+
+// NewMockSAMLCommands creates a new mock instance.
+func NewMockSAMLCommands(ctrl *gomock.Controller) *MockSAMLCommands {
+	mock := &MockSAMLCommands{ctrl: ctrl}
+	mock.recorder = &MockSAMLCommandsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSAMLCommands) EXPECT() *MockSAMLCommandsMockRecorder {
+	return m.recorder
+}
+
+// DeleteConnection mocks base method.
+func (m *MockSAMLCommands) DeleteConnection(ctx context.Context, companyID, actorID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConnection", ctx, companyID, actorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteConnection indicates an expected call of DeleteConnection.
+func (mr *MockSAMLCommandsMockRecorder) DeleteConnection(ctx, companyID, actorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConnection", reflect.TypeOf((*MockSAMLCommands)(nil).DeleteConnection), ctx, companyID, actorID)
+}
+
+// Login mocks base method.
+func (m *MockSAMLCommands) Login(ctx context.Context, companyID uuid.UUID, samlResponse, state string) (*commands.LoginResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Login", ctx, companyID, samlResponse, state)
+	ret0, _ := ret[0].(*commands.LoginResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Login indicates an expected call of Login.
+func (mr *MockSAMLCommandsMockRecorder) Login(ctx, companyID, samlResponse, state any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockSAMLCommands)(nil).Login), ctx, companyID, samlResponse, state)
+}
+
+// SetConnection mocks base method.
+func (m *MockSAMLCommands) SetConnection(ctx context.Context, companyID uuid.UUID, req request.SetSAMLConnectionRequest, actorID uuid.UUID, actorRole string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetConnection", ctx, companyID, req, actorID, actorRole)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetConnection indicates an expected call of SetConnection.
+func (mr *MockSAMLCommandsMockRecorder) SetConnection(ctx, companyID, req, actorID, actorRole any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetConnection", reflect.TypeOf((*MockSAMLCommands)(nil).SetConnection), ctx, companyID, req, actorID, actorRole)
+}
+
+// StartLogin mocks base method.
+func (m *MockSAMLCommands) StartLogin(ctx context.Context, companyID uuid.UUID) (*commands.SAMLLoginStart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartLogin", ctx, companyID)
+	ret0, _ := ret[0].(*commands.SAMLLoginStart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartLogin indicates an expected call of StartLogin.
+func (mr *MockSAMLCommandsMockRecorder) StartLogin(ctx, companyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartLogin", reflect.TypeOf((*MockSAMLCommands)(nil).StartLogin), ctx, companyID)
+}
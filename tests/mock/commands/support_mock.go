@@ -0,0 +1,74 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/commands/support.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/commands/support.go -destination=tests/mock/commands/support_mock.go -package=commandsmock
+//
+
+// Package commandsmock is a generated GoMock package.
+package commandsmock
+
+import (
+	context "context"
+	user "gin-clean-starter/internal/domain/user"
+	request "gin-clean-starter/internal/handler/dto/request"
+	commands "gin-clean-starter/internal/usecase/commands"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSupportCommands is a mock of SupportCommands interface.
+type MockSupportCommands struct {
+	ctrl     *gomock.Controller
+	recorder *MockSupportCommandsMockRecorder
+	isgomock struct{}
+}
+
+// MockSupportCommandsMockRecorder is the mock recorder for MockSupportCommands.
+type MockSupportCommandsMockRecorder struct {
+	mock *MockSupportCommands
+}
+
+// NewMockSupportCommands creates a new mock instance.
+func NewMockSupportCommands(ctrl *gomock.Controller) *MockSupportCommands {
+	mock := &MockSupportCommands{ctrl: ctrl}
+	mock.recorder = &MockSupportCommandsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSupportCommands) EXPECT() *MockSupportCommandsMockRecorder {
+	return m.recorder
+}
+
+// RecordRequest mocks base method.
+func (m *MockSupportCommands) RecordRequest(ctx context.Context, rec commands.SupportRequestRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordRequest", ctx, rec)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordRequest indicates an expected call of RecordRequest.
+func (mr *MockSupportCommandsMockRecorder) RecordRequest(ctx, rec any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordRequest", reflect.TypeOf((*MockSupportCommands)(nil).RecordRequest), ctx, rec)
+}
+
+// Start mocks base method.
+func (m *MockSupportCommands) Start(ctx context.Context, req request.StartSupportSessionRequest, actorID uuid.UUID, actorRole user.Role) (*commands.SupportSessionResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Start", ctx, req, actorID, actorRole)
+	ret0, _ := ret[0].(*commands.SupportSessionResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockSupportCommandsMockRecorder) Start(ctx, req, actorID, actorRole any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockSupportCommands)(nil).Start), ctx, req, actorID, actorRole)
+}
@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/commands/user_merge.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/commands/user_merge.go -destination=tests/mock/commands/user_merge_mock.go -package=commandsmock
+//
+
+// Package commandsmock is a generated GoMock package.
+package commandsmock
+
+import (
+	context "context"
+	commands "gin-clean-starter/internal/usecase/commands"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserMergeCommands is a mock of UserMergeCommands interface.
+type MockUserMergeCommands struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserMergeCommandsMockRecorder
+	isgomock struct{}
+}
+
+// MockUserMergeCommandsMockRecorder is the mock recorder for MockUserMergeCommands.
+type MockUserMergeCommandsMockRecorder struct {
+	mock *MockUserMergeCommands
+}
+
+// NewMockUserMergeCommands creates a new mock instance.
+func NewMockUserMergeCommands(ctrl *gomock.Controller) *MockUserMergeCommands {
+	mock := &MockUserMergeCommands{ctrl: ctrl}
+	mock.recorder = &MockUserMergeCommandsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserMergeCommands) EXPECT() *MockUserMergeCommandsMockRecorder {
+	return m.recorder
+}
+
+// Merge mocks base method.
+func (m *MockUserMergeCommands) Merge(ctx context.Context, sourceID, targetID, actorID uuid.UUID, dryRun bool) (*commands.UserMergeResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Merge", ctx, sourceID, targetID, actorID, dryRun)
+	ret0, _ := ret[0].(*commands.UserMergeResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Merge indicates an expected call of Merge.
+func (mr *MockUserMergeCommandsMockRecorder) Merge(ctx, sourceID, targetID, actorID, dryRun any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Merge", reflect.TypeOf((*MockUserMergeCommands)(nil).Merge), ctx, sourceID, targetID, actorID, dryRun)
+}
@@ -0,0 +1,5 @@
+// Package mock holds the gomock mocks generated from scripts/mocks.manifest; each
+// subdirectory is one mock package. Regenerate them all with `go generate ./tests/mock`.
+package mock
+
+//go:generate bash ../../scripts/generate_mocks.sh
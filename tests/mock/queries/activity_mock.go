@@ -0,0 +1,115 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/queries/activity.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/queries/activity.go -destination=tests/mock/queries/activity_mock.go -package=queriesmock
+//
+
+// Package queriesmock is a generated GoMock package.
+package queriesmock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	queries "gin-clean-starter/internal/usecase/queries"
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockActivityReadStore is a mock of ActivityReadStore interface.
+type MockActivityReadStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockActivityReadStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockActivityReadStoreMockRecorder is the mock recorder for MockActivityReadStore.
+type MockActivityReadStoreMockRecorder struct {
+	mock *MockActivityReadStore
+}
+
+// NewMockActivityReadStore creates a new mock instance.
+func NewMockActivityReadStore(ctrl *gomock.Controller) *MockActivityReadStore {
+	mock := &MockActivityReadStore{ctrl: ctrl}
+	mock.recorder = &MockActivityReadStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockActivityReadStore) EXPECT() *MockActivityReadStoreMockRecorder {
+	return m.recorder
+}
+
+// FindFirstPage mocks base method.
+func (m *MockActivityReadStore) FindFirstPage(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, kind *string, limit int32) ([]*queries.ActivityItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFirstPage", ctx, db, userID, kind, limit)
+	ret0, _ := ret[0].([]*queries.ActivityItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindFirstPage indicates an expected call of FindFirstPage.
+func (mr *MockActivityReadStoreMockRecorder) FindFirstPage(ctx, db, userID, kind, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFirstPage", reflect.TypeOf((*MockActivityReadStore)(nil).FindFirstPage), ctx, db, userID, kind, limit)
+}
+
+// FindKeyset mocks base method.
+func (m *MockActivityReadStore) FindKeyset(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, kind *string, lastOccurredAt time.Time, lastID uuid.UUID, limit int32) ([]*queries.ActivityItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindKeyset", ctx, db, userID, kind, lastOccurredAt, lastID, limit)
+	ret0, _ := ret[0].([]*queries.ActivityItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindKeyset indicates an expected call of FindKeyset.
+func (mr *MockActivityReadStoreMockRecorder) FindKeyset(ctx, db, userID, kind, lastOccurredAt, lastID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindKeyset", reflect.TypeOf((*MockActivityReadStore)(nil).FindKeyset), ctx, db, userID, kind, lastOccurredAt, lastID, limit)
+}
+
+// MockActivityQueries is a mock of ActivityQueries interface.
+type MockActivityQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockActivityQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockActivityQueriesMockRecorder is the mock recorder for MockActivityQueries.
+type MockActivityQueriesMockRecorder struct {
+	mock *MockActivityQueries
+}
+
+// NewMockActivityQueries creates a new mock instance.
+func NewMockActivityQueries(ctrl *gomock.Controller) *MockActivityQueries {
+	mock := &MockActivityQueries{ctrl: ctrl}
+	mock.recorder = &MockActivityQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockActivityQueries) EXPECT() *MockActivityQueriesMockRecorder {
+	return m.recorder
+}
+
+// ListMine mocks base method.
+func (m *MockActivityQueries) ListMine(ctx context.Context, userID uuid.UUID, kind *string, after *queries.Cursor, limit int) ([]*queries.ActivityItem, *queries.Cursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMine", ctx, userID, kind, after, limit)
+	ret0, _ := ret[0].([]*queries.ActivityItem)
+	ret1, _ := ret[1].(*queries.Cursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListMine indicates an expected call of ListMine.
+func (mr *MockActivityQueriesMockRecorder) ListMine(ctx, userID, kind, after, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMine", reflect.TypeOf((*MockActivityQueries)(nil).ListMine), ctx, userID, kind, after, limit)
+}
@@ -0,0 +1,158 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/queries/admin_search.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/queries/admin_search.go -destination=tests/mock/queries/admin_search_mock.go -package=queriesmock
+//
+
+// Package queriesmock is a generated GoMock package.
+package queriesmock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	queries "gin-clean-starter/internal/usecase/queries"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAdminSearchReadStore is a mock of AdminSearchReadStore interface.
+type MockAdminSearchReadStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminSearchReadStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockAdminSearchReadStoreMockRecorder is the mock recorder for MockAdminSearchReadStore.
+type MockAdminSearchReadStoreMockRecorder struct {
+	mock *MockAdminSearchReadStore
+}
+
+// NewMockAdminSearchReadStore creates a new mock instance.
+func NewMockAdminSearchReadStore(ctrl *gomock.Controller) *MockAdminSearchReadStore {
+	mock := &MockAdminSearchReadStore{ctrl: ctrl}
+	mock.recorder = &MockAdminSearchReadStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminSearchReadStore) EXPECT() *MockAdminSearchReadStoreMockRecorder {
+	return m.recorder
+}
+
+// FindReservationByID mocks base method.
+func (m *MockAdminSearchReadStore) FindReservationByID(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (*queries.SearchReservationHit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindReservationByID", ctx, db, id)
+	ret0, _ := ret[0].(*queries.SearchReservationHit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindReservationByID indicates an expected call of FindReservationByID.
+func (mr *MockAdminSearchReadStoreMockRecorder) FindReservationByID(ctx, db, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReservationByID", reflect.TypeOf((*MockAdminSearchReadStore)(nil).FindReservationByID), ctx, db, id)
+}
+
+// FindReservationsByNote mocks base method.
+func (m *MockAdminSearchReadStore) FindReservationsByNote(ctx context.Context, db sqlc.DBTX, term string, limit int32) ([]*queries.SearchReservationHit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindReservationsByNote", ctx, db, term, limit)
+	ret0, _ := ret[0].([]*queries.SearchReservationHit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindReservationsByNote indicates an expected call of FindReservationsByNote.
+func (mr *MockAdminSearchReadStoreMockRecorder) FindReservationsByNote(ctx, db, term, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReservationsByNote", reflect.TypeOf((*MockAdminSearchReadStore)(nil).FindReservationsByNote), ctx, db, term, limit)
+}
+
+// FindResources mocks base method.
+func (m *MockAdminSearchReadStore) FindResources(ctx context.Context, db sqlc.DBTX, term string, limit int32) ([]*queries.SearchResourceHit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindResources", ctx, db, term, limit)
+	ret0, _ := ret[0].([]*queries.SearchResourceHit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindResources indicates an expected call of FindResources.
+func (mr *MockAdminSearchReadStoreMockRecorder) FindResources(ctx, db, term, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindResources", reflect.TypeOf((*MockAdminSearchReadStore)(nil).FindResources), ctx, db, term, limit)
+}
+
+// FindReviews mocks base method.
+func (m *MockAdminSearchReadStore) FindReviews(ctx context.Context, db sqlc.DBTX, term string, limit int32) ([]*queries.SearchReviewHit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindReviews", ctx, db, term, limit)
+	ret0, _ := ret[0].([]*queries.SearchReviewHit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindReviews indicates an expected call of FindReviews.
+func (mr *MockAdminSearchReadStoreMockRecorder) FindReviews(ctx, db, term, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReviews", reflect.TypeOf((*MockAdminSearchReadStore)(nil).FindReviews), ctx, db, term, limit)
+}
+
+// FindUsers mocks base method.
+func (m *MockAdminSearchReadStore) FindUsers(ctx context.Context, db sqlc.DBTX, term string, limit int32) ([]*queries.SearchUserHit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUsers", ctx, db, term, limit)
+	ret0, _ := ret[0].([]*queries.SearchUserHit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUsers indicates an expected call of FindUsers.
+func (mr *MockAdminSearchReadStoreMockRecorder) FindUsers(ctx, db, term, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUsers", reflect.TypeOf((*MockAdminSearchReadStore)(nil).FindUsers), ctx, db, term, limit)
+}
+
+// MockAdminSearchQueries is a mock of AdminSearchQueries interface.
+type MockAdminSearchQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminSearchQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockAdminSearchQueriesMockRecorder is the mock recorder for MockAdminSearchQueries.
+type MockAdminSearchQueriesMockRecorder struct {
+	mock *MockAdminSearchQueries
+}
+
+// NewMockAdminSearchQueries creates a new mock instance.
+func NewMockAdminSearchQueries(ctrl *gomock.Controller) *MockAdminSearchQueries {
+	mock := &MockAdminSearchQueries{ctrl: ctrl}
+	mock.recorder = &MockAdminSearchQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminSearchQueries) EXPECT() *MockAdminSearchQueriesMockRecorder {
+	return m.recorder
+}
+
+// Search mocks base method.
+func (m *MockAdminSearchQueries) Search(ctx context.Context, query string, limit int) (*queries.SearchResults, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, query, limit)
+	ret0, _ := ret[0].(*queries.SearchResults)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockAdminSearchQueriesMockRecorder) Search(ctx, query, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockAdminSearchQueries)(nil).Search), ctx, query, limit)
+}
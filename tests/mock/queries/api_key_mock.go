@@ -0,0 +1,129 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/queries/api_key.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/queries/api_key.go -destination=tests/mock/queries/api_key_mock.go -package=queriesmock
+//
+
+// Package queriesmock is a generated GoMock package.
+package queriesmock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	queries "gin-clean-starter/internal/usecase/queries"
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAPIKeyReadStore is a mock of APIKeyReadStore interface.
+type MockAPIKeyReadStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIKeyReadStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockAPIKeyReadStoreMockRecorder is the mock recorder for MockAPIKeyReadStore.
+type MockAPIKeyReadStoreMockRecorder struct {
+	mock *MockAPIKeyReadStore
+}
+
+// NewMockAPIKeyReadStore creates a new mock instance.
+func NewMockAPIKeyReadStore(ctrl *gomock.Controller) *MockAPIKeyReadStore {
+	mock := &MockAPIKeyReadStore{ctrl: ctrl}
+	mock.recorder = &MockAPIKeyReadStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPIKeyReadStore) EXPECT() *MockAPIKeyReadStoreMockRecorder {
+	return m.recorder
+}
+
+// FindIdentity mocks base method.
+func (m *MockAPIKeyReadStore) FindIdentity(ctx context.Context, db sqlc.DBTX, keyHash []byte, now time.Time) (*queries.APIKeyIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindIdentity", ctx, db, keyHash, now)
+	ret0, _ := ret[0].(*queries.APIKeyIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindIdentity indicates an expected call of FindIdentity.
+func (mr *MockAPIKeyReadStoreMockRecorder) FindIdentity(ctx, db, keyHash, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindIdentity", reflect.TypeOf((*MockAPIKeyReadStore)(nil).FindIdentity), ctx, db, keyHash, now)
+}
+
+// ListByUser mocks base method.
+func (m *MockAPIKeyReadStore) ListByUser(ctx context.Context, db sqlc.DBTX, userID uuid.UUID) ([]*queries.APIKeyView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUser", ctx, db, userID)
+	ret0, _ := ret[0].([]*queries.APIKeyView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByUser indicates an expected call of ListByUser.
+func (mr *MockAPIKeyReadStoreMockRecorder) ListByUser(ctx, db, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUser", reflect.TypeOf((*MockAPIKeyReadStore)(nil).ListByUser), ctx, db, userID)
+}
+
+// MockAPIKeyQueries is a mock of APIKeyQueries interface.
+type MockAPIKeyQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIKeyQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockAPIKeyQueriesMockRecorder is the mock recorder for MockAPIKeyQueries.
+type MockAPIKeyQueriesMockRecorder struct {
+	mock *MockAPIKeyQueries
+}
+
+// NewMockAPIKeyQueries creates a new mock instance.
+func NewMockAPIKeyQueries(ctrl *gomock.Controller) *MockAPIKeyQueries {
+	mock := &MockAPIKeyQueries{ctrl: ctrl}
+	mock.recorder = &MockAPIKeyQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPIKeyQueries) EXPECT() *MockAPIKeyQueriesMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockAPIKeyQueries) Authenticate(ctx context.Context, key string) (*queries.APIKeyIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", ctx, key)
+	ret0, _ := ret[0].(*queries.APIKeyIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockAPIKeyQueriesMockRecorder) Authenticate(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockAPIKeyQueries)(nil).Authenticate), ctx, key)
+}
+
+// ListByUser mocks base method.
+func (m *MockAPIKeyQueries) ListByUser(ctx context.Context, userID uuid.UUID) ([]*queries.APIKeyView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUser", ctx, userID)
+	ret0, _ := ret[0].([]*queries.APIKeyView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByUser indicates an expected call of ListByUser.
+func (mr *MockAPIKeyQueriesMockRecorder) ListByUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUser", reflect.TypeOf((*MockAPIKeyQueries)(nil).ListByUser), ctx, userID)
+}
@@ -0,0 +1,98 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/queries/company_deletion.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/queries/company_deletion.go -destination=tests/mock/queries/company_deletion_mock.go -package=queriesmock
+//
+
+// Package queriesmock is a generated GoMock package.
+package queriesmock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	queries "gin-clean-starter/internal/usecase/queries"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCompanyDeletionReadStore is a mock of CompanyDeletionReadStore interface.
+type MockCompanyDeletionReadStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockCompanyDeletionReadStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockCompanyDeletionReadStoreMockRecorder is the mock recorder for MockCompanyDeletionReadStore.
+type MockCompanyDeletionReadStoreMockRecorder struct {
+	mock *MockCompanyDeletionReadStore
+}
+
+// NewMockCompanyDeletionReadStore creates a new mock instance.
+func NewMockCompanyDeletionReadStore(ctrl *gomock.Controller) *MockCompanyDeletionReadStore {
+	mock := &MockCompanyDeletionReadStore{ctrl: ctrl}
+	mock.recorder = &MockCompanyDeletionReadStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCompanyDeletionReadStore) EXPECT() *MockCompanyDeletionReadStoreMockRecorder {
+	return m.recorder
+}
+
+// FindByID mocks base method.
+func (m *MockCompanyDeletionReadStore) FindByID(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (*queries.CompanyDeletionDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, db, id)
+	ret0, _ := ret[0].(*queries.CompanyDeletionDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockCompanyDeletionReadStoreMockRecorder) FindByID(ctx, db, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockCompanyDeletionReadStore)(nil).FindByID), ctx, db, id)
+}
+
+// MockCompanyDeletionQueries is a mock of CompanyDeletionQueries interface.
+type MockCompanyDeletionQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockCompanyDeletionQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockCompanyDeletionQueriesMockRecorder is the mock recorder for MockCompanyDeletionQueries.
+type MockCompanyDeletionQueriesMockRecorder struct {
+	mock *MockCompanyDeletionQueries
+}
+
+// NewMockCompanyDeletionQueries creates a new mock instance.
+func NewMockCompanyDeletionQueries(ctrl *gomock.Controller) *MockCompanyDeletionQueries {
+	mock := &MockCompanyDeletionQueries{ctrl: ctrl}
+	mock.recorder = &MockCompanyDeletionQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCompanyDeletionQueries) EXPECT() *MockCompanyDeletionQueriesMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockCompanyDeletionQueries) Get(ctx context.Context, id uuid.UUID) (*queries.CompanyDeletionDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*queries.CompanyDeletionDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockCompanyDeletionQueriesMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCompanyDeletionQueries)(nil).Get), ctx, id)
+}
@@ -0,0 +1,115 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/queries/company_export.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/queries/company_export.go -destination=tests/mock/queries/company_export_mock.go -package=queriesmock
+//
+
+// Package queriesmock is a generated GoMock package.
+package queriesmock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	queries "gin-clean-starter/internal/usecase/queries"
+	io "io"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCompanyExportReadStore is a mock of CompanyExportReadStore interface.
+type MockCompanyExportReadStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockCompanyExportReadStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockCompanyExportReadStoreMockRecorder is the mock recorder for MockCompanyExportReadStore.
+type MockCompanyExportReadStoreMockRecorder struct {
+	mock *MockCompanyExportReadStore
+}
+
+// NewMockCompanyExportReadStore creates a new mock instance.
+func NewMockCompanyExportReadStore(ctrl *gomock.Controller) *MockCompanyExportReadStore {
+	mock := &MockCompanyExportReadStore{ctrl: ctrl}
+	mock.recorder = &MockCompanyExportReadStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCompanyExportReadStore) EXPECT() *MockCompanyExportReadStoreMockRecorder {
+	return m.recorder
+}
+
+// FindByID mocks base method.
+func (m *MockCompanyExportReadStore) FindByID(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (*queries.CompanyExportDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, db, id)
+	ret0, _ := ret[0].(*queries.CompanyExportDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockCompanyExportReadStoreMockRecorder) FindByID(ctx, db, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockCompanyExportReadStore)(nil).FindByID), ctx, db, id)
+}
+
+// MockCompanyExportQueries is a mock of CompanyExportQueries interface.
+type MockCompanyExportQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockCompanyExportQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockCompanyExportQueriesMockRecorder is the mock recorder for MockCompanyExportQueries.
+type MockCompanyExportQueriesMockRecorder struct {
+	mock *MockCompanyExportQueries
+}
+
+// NewMockCompanyExportQueries creates a new mock instance.
+func NewMockCompanyExportQueries(ctrl *gomock.Controller) *MockCompanyExportQueries {
+	mock := &MockCompanyExportQueries{ctrl: ctrl}
+	mock.recorder = &MockCompanyExportQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCompanyExportQueries) EXPECT() *MockCompanyExportQueriesMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockCompanyExportQueries) Get(ctx context.Context, id uuid.UUID) (*queries.CompanyExportDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*queries.CompanyExportDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockCompanyExportQueriesMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCompanyExportQueries)(nil).Get), ctx, id)
+}
+
+// OpenDownload mocks base method.
+func (m *MockCompanyExportQueries) OpenDownload(ctx context.Context, token string) (*queries.CompanyExportDetail, io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenDownload", ctx, token)
+	ret0, _ := ret[0].(*queries.CompanyExportDetail)
+	ret1, _ := ret[1].(io.ReadCloser)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// OpenDownload indicates an expected call of OpenDownload.
+func (mr *MockCompanyExportQueriesMockRecorder) OpenDownload(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenDownload", reflect.TypeOf((*MockCompanyExportQueries)(nil).OpenDownload), ctx, token)
+}
@@ -0,0 +1,128 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/queries/custom_field.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/queries/custom_field.go -destination=tests/mock/queries/custom_field_mock.go -package=queriesmock
+//
+
+// Package queriesmock is a generated GoMock package.
+package queriesmock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	shared "gin-clean-starter/internal/usecase/shared"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCustomFieldReadStore is a mock of CustomFieldReadStore interface.
+type MockCustomFieldReadStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockCustomFieldReadStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockCustomFieldReadStoreMockRecorder is the mock recorder for MockCustomFieldReadStore.
+type MockCustomFieldReadStoreMockRecorder struct {
+	mock *MockCustomFieldReadStore
+}
+
+// NewMockCustomFieldReadStore creates a new mock instance.
+func NewMockCustomFieldReadStore(ctrl *gomock.Controller) *MockCustomFieldReadStore {
+	mock := &MockCustomFieldReadStore{ctrl: ctrl}
+	mock.recorder = &MockCustomFieldReadStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCustomFieldReadStore) EXPECT() *MockCustomFieldReadStoreMockRecorder {
+	return m.recorder
+}
+
+// ListByCompany mocks base method.
+func (m *MockCustomFieldReadStore) ListByCompany(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID) ([]*shared.CustomFieldDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByCompany", ctx, db, companyID)
+	ret0, _ := ret[0].([]*shared.CustomFieldDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByCompany indicates an expected call of ListByCompany.
+func (mr *MockCustomFieldReadStoreMockRecorder) ListByCompany(ctx, db, companyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByCompany", reflect.TypeOf((*MockCustomFieldReadStore)(nil).ListByCompany), ctx, db, companyID)
+}
+
+// ListForResource mocks base method.
+func (m *MockCustomFieldReadStore) ListForResource(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID) ([]*shared.CustomFieldDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListForResource", ctx, db, resourceID)
+	ret0, _ := ret[0].([]*shared.CustomFieldDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListForResource indicates an expected call of ListForResource.
+func (mr *MockCustomFieldReadStoreMockRecorder) ListForResource(ctx, db, resourceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForResource", reflect.TypeOf((*MockCustomFieldReadStore)(nil).ListForResource), ctx, db, resourceID)
+}
+
+// MockCustomFieldQueries is a mock of CustomFieldQueries interface.
+type MockCustomFieldQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockCustomFieldQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockCustomFieldQueriesMockRecorder is the mock recorder for MockCustomFieldQueries.
+type MockCustomFieldQueriesMockRecorder struct {
+	mock *MockCustomFieldQueries
+}
+
+// NewMockCustomFieldQueries creates a new mock instance.
+func NewMockCustomFieldQueries(ctrl *gomock.Controller) *MockCustomFieldQueries {
+	mock := &MockCustomFieldQueries{ctrl: ctrl}
+	mock.recorder = &MockCustomFieldQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCustomFieldQueries) EXPECT() *MockCustomFieldQueriesMockRecorder {
+	return m.recorder
+}
+
+// ListByCompany mocks base method.
+func (m *MockCustomFieldQueries) ListByCompany(ctx context.Context, companyID uuid.UUID) ([]*shared.CustomFieldDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByCompany", ctx, companyID)
+	ret0, _ := ret[0].([]*shared.CustomFieldDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByCompany indicates an expected call of ListByCompany.
+func (mr *MockCustomFieldQueriesMockRecorder) ListByCompany(ctx, companyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByCompany", reflect.TypeOf((*MockCustomFieldQueries)(nil).ListByCompany), ctx, companyID)
+}
+
+// ListForResource mocks base method.
+func (m *MockCustomFieldQueries) ListForResource(ctx context.Context, resourceID uuid.UUID) ([]*shared.CustomFieldDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListForResource", ctx, resourceID)
+	ret0, _ := ret[0].([]*shared.CustomFieldDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListForResource indicates an expected call of ListForResource.
+func (mr *MockCustomFieldQueriesMockRecorder) ListForResource(ctx, resourceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForResource", reflect.TypeOf((*MockCustomFieldQueries)(nil).ListForResource), ctx, resourceID)
+}
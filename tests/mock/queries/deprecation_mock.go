@@ -0,0 +1,98 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/queries/deprecation.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/queries/deprecation.go -destination=tests/mock/queries/deprecation_mock.go -package=queriesmock
+//
+
+// Package queriesmock is a generated GoMock package.
+package queriesmock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	queries "gin-clean-starter/internal/usecase/queries"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDeprecationReadStore is a mock of DeprecationReadStore interface.
+type MockDeprecationReadStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeprecationReadStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockDeprecationReadStoreMockRecorder is the mock recorder for MockDeprecationReadStore.
+type MockDeprecationReadStoreMockRecorder struct {
+	mock *MockDeprecationReadStore
+}
+
+// NewMockDeprecationReadStore creates a new mock instance.
+func NewMockDeprecationReadStore(ctrl *gomock.Controller) *MockDeprecationReadStore {
+	mock := &MockDeprecationReadStore{ctrl: ctrl}
+	mock.recorder = &MockDeprecationReadStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeprecationReadStore) EXPECT() *MockDeprecationReadStoreMockRecorder {
+	return m.recorder
+}
+
+// ListCalls mocks base method.
+func (m *MockDeprecationReadStore) ListCalls(ctx context.Context, db sqlc.DBTX, from, to time.Time, route *string) ([]*queries.DeprecatedRouteConsumer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCalls", ctx, db, from, to, route)
+	ret0, _ := ret[0].([]*queries.DeprecatedRouteConsumer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCalls indicates an expected call of ListCalls.
+func (mr *MockDeprecationReadStoreMockRecorder) ListCalls(ctx, db, from, to, route any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCalls", reflect.TypeOf((*MockDeprecationReadStore)(nil).ListCalls), ctx, db, from, to, route)
+}
+
+// MockDeprecationQueries is a mock of DeprecationQueries interface.
+type MockDeprecationQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeprecationQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockDeprecationQueriesMockRecorder is the mock recorder for MockDeprecationQueries.
+type MockDeprecationQueriesMockRecorder struct {
+	mock *MockDeprecationQueries
+}
+
+// NewMockDeprecationQueries creates a new mock instance.
+func NewMockDeprecationQueries(ctrl *gomock.Controller) *MockDeprecationQueries {
+	mock := &MockDeprecationQueries{ctrl: ctrl}
+	mock.recorder = &MockDeprecationQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeprecationQueries) EXPECT() *MockDeprecationQueriesMockRecorder {
+	return m.recorder
+}
+
+// Report mocks base method.
+func (m *MockDeprecationQueries) Report(ctx context.Context, from, to *time.Time, route *string) (*queries.DeprecationReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Report", ctx, from, to, route)
+	ret0, _ := ret[0].(*queries.DeprecationReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Report indicates an expected call of Report.
+func (mr *MockDeprecationQueriesMockRecorder) Report(ctx, from, to, route any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Report", reflect.TypeOf((*MockDeprecationQueries)(nil).Report), ctx, from, to, route)
+}
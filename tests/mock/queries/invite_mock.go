@@ -0,0 +1,113 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/queries/invite.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/queries/invite.go -destination=tests/mock/queries/invite_mock.go -package=queriesmock
+//
+
+// Package queriesmock is a generated GoMock package.
+package queriesmock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	queries "gin-clean-starter/internal/usecase/queries"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockInviteReadStore is a mock of InviteReadStore interface.
+type MockInviteReadStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockInviteReadStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockInviteReadStoreMockRecorder is the mock recorder for MockInviteReadStore.
+type MockInviteReadStoreMockRecorder struct {
+	mock *MockInviteReadStore
+}
+
+// NewMockInviteReadStore creates a new mock instance.
+func NewMockInviteReadStore(ctrl *gomock.Controller) *MockInviteReadStore {
+	mock := &MockInviteReadStore{ctrl: ctrl}
+	mock.recorder = &MockInviteReadStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInviteReadStore) EXPECT() *MockInviteReadStoreMockRecorder {
+	return m.recorder
+}
+
+// ListByCompany mocks base method.
+func (m *MockInviteReadStore) ListByCompany(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID) ([]*queries.InviteView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByCompany", ctx, db, companyID)
+	ret0, _ := ret[0].([]*queries.InviteView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByCompany indicates an expected call of ListByCompany.
+func (mr *MockInviteReadStoreMockRecorder) ListByCompany(ctx, db, companyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByCompany", reflect.TypeOf((*MockInviteReadStore)(nil).ListByCompany), ctx, db, companyID)
+}
+
+// MockInviteQueries is a mock of InviteQueries interface.
+type MockInviteQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockInviteQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockInviteQueriesMockRecorder is the mock recorder for MockInviteQueries.
+type MockInviteQueriesMockRecorder struct {
+	mock *MockInviteQueries
+}
+
+// NewMockInviteQueries creates a new mock instance.
+func NewMockInviteQueries(ctrl *gomock.Controller) *MockInviteQueries {
+	mock := &MockInviteQueries{ctrl: ctrl}
+	mock.recorder = &MockInviteQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInviteQueries) EXPECT() *MockInviteQueriesMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockInviteQueries) List(ctx context.Context, actorID uuid.UUID) ([]*queries.InviteView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, actorID)
+	ret0, _ := ret[0].([]*queries.InviteView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockInviteQueriesMockRecorder) List(ctx, actorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockInviteQueries)(nil).List), ctx, actorID)
+}
+
+// ListByCompany mocks base method.
+func (m *MockInviteQueries) ListByCompany(ctx context.Context, companyID uuid.UUID) ([]*queries.InviteView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByCompany", ctx, companyID)
+	ret0, _ := ret[0].([]*queries.InviteView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByCompany indicates an expected call of ListByCompany.
+func (mr *MockInviteQueriesMockRecorder) ListByCompany(ctx, companyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByCompany", reflect.TypeOf((*MockInviteQueries)(nil).ListByCompany), ctx, companyID)
+}
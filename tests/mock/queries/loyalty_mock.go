@@ -0,0 +1,130 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/queries/loyalty.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/queries/loyalty.go -destination=tests/mock/queries/loyalty_mock.go -package=queriesmock
+//
+
+// Package queriesmock is a generated GoMock package.
+package queriesmock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	queries "gin-clean-starter/internal/usecase/queries"
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLoyaltyReadStore is a mock of LoyaltyReadStore interface.
+type MockLoyaltyReadStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoyaltyReadStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockLoyaltyReadStoreMockRecorder is the mock recorder for MockLoyaltyReadStore.
+type MockLoyaltyReadStoreMockRecorder struct {
+	mock *MockLoyaltyReadStore
+}
+
+// NewMockLoyaltyReadStore creates a new mock instance.
+func NewMockLoyaltyReadStore(ctrl *gomock.Controller) *MockLoyaltyReadStore {
+	mock := &MockLoyaltyReadStore{ctrl: ctrl}
+	mock.recorder = &MockLoyaltyReadStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoyaltyReadStore) EXPECT() *MockLoyaltyReadStoreMockRecorder {
+	return m.recorder
+}
+
+// Balance mocks base method.
+func (m *MockLoyaltyReadStore) Balance(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, now time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Balance", ctx, db, userID, now)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Balance indicates an expected call of Balance.
+func (mr *MockLoyaltyReadStoreMockRecorder) Balance(ctx, db, userID, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Balance", reflect.TypeOf((*MockLoyaltyReadStore)(nil).Balance), ctx, db, userID, now)
+}
+
+// FindEntriesFirstPage mocks base method.
+func (m *MockLoyaltyReadStore) FindEntriesFirstPage(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, limit int32) ([]*queries.PointsEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindEntriesFirstPage", ctx, db, userID, limit)
+	ret0, _ := ret[0].([]*queries.PointsEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindEntriesFirstPage indicates an expected call of FindEntriesFirstPage.
+func (mr *MockLoyaltyReadStoreMockRecorder) FindEntriesFirstPage(ctx, db, userID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindEntriesFirstPage", reflect.TypeOf((*MockLoyaltyReadStore)(nil).FindEntriesFirstPage), ctx, db, userID, limit)
+}
+
+// FindEntriesKeyset mocks base method.
+func (m *MockLoyaltyReadStore) FindEntriesKeyset(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, lastCreatedAt time.Time, lastID uuid.UUID, limit int32) ([]*queries.PointsEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindEntriesKeyset", ctx, db, userID, lastCreatedAt, lastID, limit)
+	ret0, _ := ret[0].([]*queries.PointsEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindEntriesKeyset indicates an expected call of FindEntriesKeyset.
+func (mr *MockLoyaltyReadStoreMockRecorder) FindEntriesKeyset(ctx, db, userID, lastCreatedAt, lastID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindEntriesKeyset", reflect.TypeOf((*MockLoyaltyReadStore)(nil).FindEntriesKeyset), ctx, db, userID, lastCreatedAt, lastID, limit)
+}
+
+// MockLoyaltyQueries is a mock of LoyaltyQueries interface.
+type MockLoyaltyQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoyaltyQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockLoyaltyQueriesMockRecorder is the mock recorder for MockLoyaltyQueries.
+type MockLoyaltyQueriesMockRecorder struct {
+	mock *MockLoyaltyQueries
+}
+
+// NewMockLoyaltyQueries creates a new mock instance.
+func NewMockLoyaltyQueries(ctrl *gomock.Controller) *MockLoyaltyQueries {
+	mock := &MockLoyaltyQueries{ctrl: ctrl}
+	mock.recorder = &MockLoyaltyQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoyaltyQueries) EXPECT() *MockLoyaltyQueriesMockRecorder {
+	return m.recorder
+}
+
+// GetHistory mocks base method.
+func (m *MockLoyaltyQueries) GetHistory(ctx context.Context, userID uuid.UUID, after *queries.Cursor, limit int) (*queries.PointsHistory, *queries.Cursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistory", ctx, userID, after, limit)
+	ret0, _ := ret[0].(*queries.PointsHistory)
+	ret1, _ := ret[1].(*queries.Cursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetHistory indicates an expected call of GetHistory.
+func (mr *MockLoyaltyQueriesMockRecorder) GetHistory(ctx, userID, after, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistory", reflect.TypeOf((*MockLoyaltyQueries)(nil).GetHistory), ctx, userID, after, limit)
+}
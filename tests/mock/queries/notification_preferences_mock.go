@@ -0,0 +1,98 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/queries/notification_preferences.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/queries/notification_preferences.go -destination=tests/mock/queries/notification_preferences_mock.go -package=queriesmock
+//
+
+// Package queriesmock is a generated GoMock package.
+package queriesmock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	queries "gin-clean-starter/internal/usecase/queries"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNotificationPreferencesReadStore is a mock of NotificationPreferencesReadStore interface.
+type MockNotificationPreferencesReadStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationPreferencesReadStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockNotificationPreferencesReadStoreMockRecorder is the mock recorder for MockNotificationPreferencesReadStore.
+type MockNotificationPreferencesReadStoreMockRecorder struct {
+	mock *MockNotificationPreferencesReadStore
+}
+
+// NewMockNotificationPreferencesReadStore creates a new mock instance.
+func NewMockNotificationPreferencesReadStore(ctrl *gomock.Controller) *MockNotificationPreferencesReadStore {
+	mock := &MockNotificationPreferencesReadStore{ctrl: ctrl}
+	mock.recorder = &MockNotificationPreferencesReadStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationPreferencesReadStore) EXPECT() *MockNotificationPreferencesReadStoreMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockNotificationPreferencesReadStore) Get(ctx context.Context, db sqlc.DBTX, userID uuid.UUID) (*queries.NotificationPreferencesView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, db, userID)
+	ret0, _ := ret[0].(*queries.NotificationPreferencesView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockNotificationPreferencesReadStoreMockRecorder) Get(ctx, db, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockNotificationPreferencesReadStore)(nil).Get), ctx, db, userID)
+}
+
+// MockNotificationPreferencesQueries is a mock of NotificationPreferencesQueries interface.
+type MockNotificationPreferencesQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationPreferencesQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockNotificationPreferencesQueriesMockRecorder is the mock recorder for MockNotificationPreferencesQueries.
+type MockNotificationPreferencesQueriesMockRecorder struct {
+	mock *MockNotificationPreferencesQueries
+}
+
+// NewMockNotificationPreferencesQueries creates a new mock instance.
+func NewMockNotificationPreferencesQueries(ctrl *gomock.Controller) *MockNotificationPreferencesQueries {
+	mock := &MockNotificationPreferencesQueries{ctrl: ctrl}
+	mock.recorder = &MockNotificationPreferencesQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationPreferencesQueries) EXPECT() *MockNotificationPreferencesQueriesMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockNotificationPreferencesQueries) Get(ctx context.Context, userID uuid.UUID) (*queries.NotificationPreferencesView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, userID)
+	ret0, _ := ret[0].(*queries.NotificationPreferencesView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockNotificationPreferencesQueriesMockRecorder) Get(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockNotificationPreferencesQueries)(nil).Get), ctx, userID)
+}
@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/queries/platform_stats.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/queries/platform_stats.go -destination=tests/mock/queries/platform_stats_mock.go -package=queriesmock
+//
+
+// Package queriesmock is a generated GoMock package.
+package queriesmock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	queries "gin-clean-starter/internal/usecase/queries"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPlatformStatsReadStore is a mock of PlatformStatsReadStore interface.
+type MockPlatformStatsReadStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockPlatformStatsReadStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockPlatformStatsReadStoreMockRecorder is the mock recorder for MockPlatformStatsReadStore.
+type MockPlatformStatsReadStoreMockRecorder struct {
+	mock *MockPlatformStatsReadStore
+}
+
+// NewMockPlatformStatsReadStore creates a new mock instance.
+func NewMockPlatformStatsReadStore(ctrl *gomock.Controller) *MockPlatformStatsReadStore {
+	mock := &MockPlatformStatsReadStore{ctrl: ctrl}
+	mock.recorder = &MockPlatformStatsReadStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPlatformStatsReadStore) EXPECT() *MockPlatformStatsReadStoreMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockPlatformStatsReadStore) Get(ctx context.Context, db sqlc.DBTX) (*queries.PlatformStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, db)
+	ret0, _ := ret[0].(*queries.PlatformStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockPlatformStatsReadStoreMockRecorder) Get(ctx, db any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockPlatformStatsReadStore)(nil).Get), ctx, db)
+}
+
+// MockPlatformStatsQueries is a mock of PlatformStatsQueries interface.
+type MockPlatformStatsQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockPlatformStatsQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockPlatformStatsQueriesMockRecorder is the mock recorder for MockPlatformStatsQueries.
+type MockPlatformStatsQueriesMockRecorder struct {
+	mock *MockPlatformStatsQueries
+}
+
+// NewMockPlatformStatsQueries creates a new mock instance.
+func NewMockPlatformStatsQueries(ctrl *gomock.Controller) *MockPlatformStatsQueries {
+	mock := &MockPlatformStatsQueries{ctrl: ctrl}
+	mock.recorder = &MockPlatformStatsQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPlatformStatsQueries) EXPECT() *MockPlatformStatsQueriesMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockPlatformStatsQueries) Get(ctx context.Context) (*queries.PlatformStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx)
+	ret0, _ := ret[0].(*queries.PlatformStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockPlatformStatsQueriesMockRecorder) Get(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockPlatformStatsQueries)(nil).Get), ctx)
+}
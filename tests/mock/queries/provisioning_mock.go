@@ -0,0 +1,174 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/queries/provisioning.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/queries/provisioning.go -destination=tests/mock/queries/provisioning_mock.go -package=queriesmock
+//
+
+// Package queriesmock is a generated GoMock package.
+package queriesmock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	queries "gin-clean-starter/internal/usecase/queries"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProvisioningReadStore is a mock of ProvisioningReadStore interface.
+type MockProvisioningReadStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockProvisioningReadStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockProvisioningReadStoreMockRecorder is the mock recorder for MockProvisioningReadStore.
+type MockProvisioningReadStoreMockRecorder struct {
+	mock *MockProvisioningReadStore
+}
+
+// NewMockProvisioningReadStore creates a new mock instance.
+func NewMockProvisioningReadStore(ctrl *gomock.Controller) *MockProvisioningReadStore {
+	mock := &MockProvisioningReadStore{ctrl: ctrl}
+	mock.recorder = &MockProvisioningReadStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProvisioningReadStore) EXPECT() *MockProvisioningReadStoreMockRecorder {
+	return m.recorder
+}
+
+// CountUsers mocks base method.
+func (m *MockProvisioningReadStore) CountUsers(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID, filter queries.ProvisionedUserFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUsers", ctx, db, companyID, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUsers indicates an expected call of CountUsers.
+func (mr *MockProvisioningReadStoreMockRecorder) CountUsers(ctx, db, companyID, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUsers", reflect.TypeOf((*MockProvisioningReadStore)(nil).CountUsers), ctx, db, companyID, filter)
+}
+
+// FindTokenCompany mocks base method.
+func (m *MockProvisioningReadStore) FindTokenCompany(ctx context.Context, db sqlc.DBTX, tokenHash []byte) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTokenCompany", ctx, db, tokenHash)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTokenCompany indicates an expected call of FindTokenCompany.
+func (mr *MockProvisioningReadStoreMockRecorder) FindTokenCompany(ctx, db, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTokenCompany", reflect.TypeOf((*MockProvisioningReadStore)(nil).FindTokenCompany), ctx, db, tokenHash)
+}
+
+// FindUser mocks base method.
+func (m *MockProvisioningReadStore) FindUser(ctx context.Context, db sqlc.DBTX, companyID, userID uuid.UUID) (*queries.ProvisionedUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUser", ctx, db, companyID, userID)
+	ret0, _ := ret[0].(*queries.ProvisionedUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUser indicates an expected call of FindUser.
+func (mr *MockProvisioningReadStoreMockRecorder) FindUser(ctx, db, companyID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUser", reflect.TypeOf((*MockProvisioningReadStore)(nil).FindUser), ctx, db, companyID, userID)
+}
+
+// ListUsers mocks base method.
+func (m *MockProvisioningReadStore) ListUsers(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID, filter queries.ProvisionedUserFilter, offset, limit int32) ([]*queries.ProvisionedUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsers", ctx, db, companyID, filter, offset, limit)
+	ret0, _ := ret[0].([]*queries.ProvisionedUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockProvisioningReadStoreMockRecorder) ListUsers(ctx, db, companyID, filter, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockProvisioningReadStore)(nil).ListUsers), ctx, db, companyID, filter, offset, limit)
+}
+
+// MockProvisioningQueries is a mock of ProvisioningQueries interface.
+type MockProvisioningQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockProvisioningQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockProvisioningQueriesMockRecorder is the mock recorder for MockProvisioningQueries.
+type MockProvisioningQueriesMockRecorder struct {
+	mock *MockProvisioningQueries
+}
+
+// NewMockProvisioningQueries creates a new mock instance.
+func NewMockProvisioningQueries(ctrl *gomock.Controller) *MockProvisioningQueries {
+	mock := &MockProvisioningQueries{ctrl: ctrl}
+	mock.recorder = &MockProvisioningQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProvisioningQueries) EXPECT() *MockProvisioningQueriesMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockProvisioningQueries) Authenticate(ctx context.Context, token string) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", ctx, token)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockProvisioningQueriesMockRecorder) Authenticate(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockProvisioningQueries)(nil).Authenticate), ctx, token)
+}
+
+// GetUser mocks base method.
+func (m *MockProvisioningQueries) GetUser(ctx context.Context, companyID, userID uuid.UUID) (*queries.ProvisionedUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", ctx, companyID, userID)
+	ret0, _ := ret[0].(*queries.ProvisionedUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockProvisioningQueriesMockRecorder) GetUser(ctx, companyID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockProvisioningQueries)(nil).GetUser), ctx, companyID, userID)
+}
+
+// ListUsers mocks base method.
+func (m *MockProvisioningQueries) ListUsers(ctx context.Context, companyID uuid.UUID, filter queries.ProvisionedUserFilter, offset, limit int) ([]*queries.ProvisionedUser, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsers", ctx, companyID, filter, offset, limit)
+	ret0, _ := ret[0].([]*queries.ProvisionedUser)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockProvisioningQueriesMockRecorder) ListUsers(ctx, companyID, filter, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockProvisioningQueries)(nil).ListUsers), ctx, companyID, filter, offset, limit)
+}
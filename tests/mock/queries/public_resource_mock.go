@@ -0,0 +1,141 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/queries/public_resource.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/queries/public_resource.go -destination=tests/mock/queries/public_resource_mock.go -package=queriesmock
+//
+
+// Package queriesmock is a generated GoMock package.
+package queriesmock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	queries "gin-clean-starter/internal/usecase/queries"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPublicResourceReadStore is a mock of PublicResourceReadStore interface.
+type MockPublicResourceReadStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockPublicResourceReadStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockPublicResourceReadStoreMockRecorder is the mock recorder for MockPublicResourceReadStore.
+type MockPublicResourceReadStoreMockRecorder struct {
+	mock *MockPublicResourceReadStore
+}
+
+// NewMockPublicResourceReadStore creates a new mock instance.
+func NewMockPublicResourceReadStore(ctrl *gomock.Controller) *MockPublicResourceReadStore {
+	mock := &MockPublicResourceReadStore{ctrl: ctrl}
+	mock.recorder = &MockPublicResourceReadStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPublicResourceReadStore) EXPECT() *MockPublicResourceReadStoreMockRecorder {
+	return m.recorder
+}
+
+// FindPublicBySlug mocks base method.
+func (m *MockPublicResourceReadStore) FindPublicBySlug(ctx context.Context, db sqlc.DBTX, slug string) (*queries.PublicResourcePage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPublicBySlug", ctx, db, slug)
+	ret0, _ := ret[0].(*queries.PublicResourcePage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPublicBySlug indicates an expected call of FindPublicBySlug.
+func (mr *MockPublicResourceReadStoreMockRecorder) FindPublicBySlug(ctx, db, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPublicBySlug", reflect.TypeOf((*MockPublicResourceReadStore)(nil).FindPublicBySlug), ctx, db, slug)
+}
+
+// ListPublic mocks base method.
+func (m *MockPublicResourceReadStore) ListPublic(ctx context.Context, db sqlc.DBTX, limit int32) ([]*queries.PublicResourceLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPublic", ctx, db, limit)
+	ret0, _ := ret[0].([]*queries.PublicResourceLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPublic indicates an expected call of ListPublic.
+func (mr *MockPublicResourceReadStoreMockRecorder) ListPublic(ctx, db, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPublic", reflect.TypeOf((*MockPublicResourceReadStore)(nil).ListPublic), ctx, db, limit)
+}
+
+// MockPublicResourceQueries is a mock of PublicResourceQueries interface.
+type MockPublicResourceQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockPublicResourceQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockPublicResourceQueriesMockRecorder is the mock recorder for MockPublicResourceQueries.
+type MockPublicResourceQueriesMockRecorder struct {
+	mock *MockPublicResourceQueries
+}
+
+// NewMockPublicResourceQueries creates a new mock instance.
+func NewMockPublicResourceQueries(ctrl *gomock.Controller) *MockPublicResourceQueries {
+	mock := &MockPublicResourceQueries{ctrl: ctrl}
+	mock.recorder = &MockPublicResourceQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPublicResourceQueries) EXPECT() *MockPublicResourceQueriesMockRecorder {
+	return m.recorder
+}
+
+// GenerateETag mocks base method.
+func (m *MockPublicResourceQueries) GenerateETag(page *queries.PublicResourcePage) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateETag", page)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GenerateETag indicates an expected call of GenerateETag.
+func (mr *MockPublicResourceQueriesMockRecorder) GenerateETag(page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateETag", reflect.TypeOf((*MockPublicResourceQueries)(nil).GenerateETag), page)
+}
+
+// GetBySlug mocks base method.
+func (m *MockPublicResourceQueries) GetBySlug(ctx context.Context, slug string) (*queries.PublicResourcePage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBySlug", ctx, slug)
+	ret0, _ := ret[0].(*queries.PublicResourcePage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBySlug indicates an expected call of GetBySlug.
+func (mr *MockPublicResourceQueriesMockRecorder) GetBySlug(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySlug", reflect.TypeOf((*MockPublicResourceQueries)(nil).GetBySlug), ctx, slug)
+}
+
+// ListForSitemap mocks base method.
+func (m *MockPublicResourceQueries) ListForSitemap(ctx context.Context) ([]*queries.PublicResourceLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListForSitemap", ctx)
+	ret0, _ := ret[0].([]*queries.PublicResourceLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListForSitemap indicates an expected call of ListForSitemap.
+func (mr *MockPublicResourceQueriesMockRecorder) ListForSitemap(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForSitemap", reflect.TypeOf((*MockPublicResourceQueries)(nil).ListForSitemap), ctx)
+}
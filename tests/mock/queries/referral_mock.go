@@ -0,0 +1,98 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/queries/referral.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/queries/referral.go -destination=tests/mock/queries/referral_mock.go -package=queriesmock
+//
+
+// Package queriesmock is a generated GoMock package.
+package queriesmock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	queries "gin-clean-starter/internal/usecase/queries"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReferralReadStore is a mock of ReferralReadStore interface.
+type MockReferralReadStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockReferralReadStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockReferralReadStoreMockRecorder is the mock recorder for MockReferralReadStore.
+type MockReferralReadStoreMockRecorder struct {
+	mock *MockReferralReadStore
+}
+
+// NewMockReferralReadStore creates a new mock instance.
+func NewMockReferralReadStore(ctrl *gomock.Controller) *MockReferralReadStore {
+	mock := &MockReferralReadStore{ctrl: ctrl}
+	mock.recorder = &MockReferralReadStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReferralReadStore) EXPECT() *MockReferralReadStoreMockRecorder {
+	return m.recorder
+}
+
+// FindOverview mocks base method.
+func (m *MockReferralReadStore) FindOverview(ctx context.Context, db sqlc.DBTX, userID uuid.UUID) (*queries.ReferralOverview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOverview", ctx, db, userID)
+	ret0, _ := ret[0].(*queries.ReferralOverview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOverview indicates an expected call of FindOverview.
+func (mr *MockReferralReadStoreMockRecorder) FindOverview(ctx, db, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOverview", reflect.TypeOf((*MockReferralReadStore)(nil).FindOverview), ctx, db, userID)
+}
+
+// MockReferralQueries is a mock of ReferralQueries interface.
+type MockReferralQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockReferralQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockReferralQueriesMockRecorder is the mock recorder for MockReferralQueries.
+type MockReferralQueriesMockRecorder struct {
+	mock *MockReferralQueries
+}
+
+// NewMockReferralQueries creates a new mock instance.
+func NewMockReferralQueries(ctrl *gomock.Controller) *MockReferralQueries {
+	mock := &MockReferralQueries{ctrl: ctrl}
+	mock.recorder = &MockReferralQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReferralQueries) EXPECT() *MockReferralQueriesMockRecorder {
+	return m.recorder
+}
+
+// GetOverview mocks base method.
+func (m *MockReferralQueries) GetOverview(ctx context.Context, userID uuid.UUID) (*queries.ReferralOverview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOverview", ctx, userID)
+	ret0, _ := ret[0].(*queries.ReferralOverview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOverview indicates an expected call of GetOverview.
+func (mr *MockReferralQueriesMockRecorder) GetOverview(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOverview", reflect.TypeOf((*MockReferralQueries)(nil).GetOverview), ctx, userID)
+}
@@ -0,0 +1,146 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/queries/request_recording.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/queries/request_recording.go -destination=tests/mock/queries/request_recording_mock.go -package=queriesmock
+//
+
+// Package queriesmock is a generated GoMock package.
+package queriesmock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	queries "gin-clean-starter/internal/usecase/queries"
+	shared "gin-clean-starter/internal/usecase/shared"
+	io "io"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRequestRecordingReadStore is a mock of RequestRecordingReadStore interface.
+type MockRequestRecordingReadStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockRequestRecordingReadStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockRequestRecordingReadStoreMockRecorder is the mock recorder for MockRequestRecordingReadStore.
+type MockRequestRecordingReadStoreMockRecorder struct {
+	mock *MockRequestRecordingReadStore
+}
+
+// NewMockRequestRecordingReadStore creates a new mock instance.
+func NewMockRequestRecordingReadStore(ctrl *gomock.Controller) *MockRequestRecordingReadStore {
+	mock := &MockRequestRecordingReadStore{ctrl: ctrl}
+	mock.recorder = &MockRequestRecordingReadStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRequestRecordingReadStore) EXPECT() *MockRequestRecordingReadStoreMockRecorder {
+	return m.recorder
+}
+
+// FindByID mocks base method.
+func (m *MockRequestRecordingReadStore) FindByID(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (*queries.RequestRecordingDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, db, id)
+	ret0, _ := ret[0].(*queries.RequestRecordingDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockRequestRecordingReadStoreMockRecorder) FindByID(ctx, db, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockRequestRecordingReadStore)(nil).FindByID), ctx, db, id)
+}
+
+// FindCapture mocks base method.
+func (m *MockRequestRecordingReadStore) FindCapture(ctx context.Context, db sqlc.DBTX, id uuid.UUID, seq int32) (*shared.RecordedRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCapture", ctx, db, id, seq)
+	ret0, _ := ret[0].(*shared.RecordedRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCapture indicates an expected call of FindCapture.
+func (mr *MockRequestRecordingReadStoreMockRecorder) FindCapture(ctx, db, id, seq any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCapture", reflect.TypeOf((*MockRequestRecordingReadStore)(nil).FindCapture), ctx, db, id, seq)
+}
+
+// ListCaptures mocks base method.
+func (m *MockRequestRecordingReadStore) ListCaptures(ctx context.Context, db sqlc.DBTX, id uuid.UUID) ([]*shared.RecordedRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCaptures", ctx, db, id)
+	ret0, _ := ret[0].([]*shared.RecordedRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCaptures indicates an expected call of ListCaptures.
+func (mr *MockRequestRecordingReadStoreMockRecorder) ListCaptures(ctx, db, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCaptures", reflect.TypeOf((*MockRequestRecordingReadStore)(nil).ListCaptures), ctx, db, id)
+}
+
+// MockRequestRecordingQueries is a mock of RequestRecordingQueries interface.
+type MockRequestRecordingQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockRequestRecordingQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockRequestRecordingQueriesMockRecorder is the mock recorder for MockRequestRecordingQueries.
+type MockRequestRecordingQueriesMockRecorder struct {
+	mock *MockRequestRecordingQueries
+}
+
+// NewMockRequestRecordingQueries creates a new mock instance.
+func NewMockRequestRecordingQueries(ctrl *gomock.Controller) *MockRequestRecordingQueries {
+	mock := &MockRequestRecordingQueries{ctrl: ctrl}
+	mock.recorder = &MockRequestRecordingQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRequestRecordingQueries) EXPECT() *MockRequestRecordingQueriesMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockRequestRecordingQueries) Get(ctx context.Context, id uuid.UUID) (*queries.RequestRecordingDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*queries.RequestRecordingDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockRequestRecordingQueriesMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRequestRecordingQueries)(nil).Get), ctx, id)
+}
+
+// OpenCapture mocks base method.
+func (m *MockRequestRecordingQueries) OpenCapture(ctx context.Context, id uuid.UUID, seq int32) (*shared.RecordedRequest, io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenCapture", ctx, id, seq)
+	ret0, _ := ret[0].(*shared.RecordedRequest)
+	ret1, _ := ret[1].(io.ReadCloser)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// OpenCapture indicates an expected call of OpenCapture.
+func (mr *MockRequestRecordingQueriesMockRecorder) OpenCapture(ctx, id, seq any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenCapture", reflect.TypeOf((*MockRequestRecordingQueries)(nil).OpenCapture), ctx, id, seq)
+}
@@ -0,0 +1,128 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/queries/reservation_approval.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/queries/reservation_approval.go -destination=tests/mock/queries/reservation_approval_mock.go -package=queriesmock
+//
+
+// Package queriesmock is a generated GoMock package.
+package queriesmock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	queries "gin-clean-starter/internal/usecase/queries"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReservationApprovalReadStore is a mock of ReservationApprovalReadStore interface.
+type MockReservationApprovalReadStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockReservationApprovalReadStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockReservationApprovalReadStoreMockRecorder is the mock recorder for MockReservationApprovalReadStore.
+type MockReservationApprovalReadStoreMockRecorder struct {
+	mock *MockReservationApprovalReadStore
+}
+
+// NewMockReservationApprovalReadStore creates a new mock instance.
+func NewMockReservationApprovalReadStore(ctrl *gomock.Controller) *MockReservationApprovalReadStore {
+	mock := &MockReservationApprovalReadStore{ctrl: ctrl}
+	mock.recorder = &MockReservationApprovalReadStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReservationApprovalReadStore) EXPECT() *MockReservationApprovalReadStoreMockRecorder {
+	return m.recorder
+}
+
+// ListApprovers mocks base method.
+func (m *MockReservationApprovalReadStore) ListApprovers(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID) ([]*queries.ResourceApproverView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListApprovers", ctx, db, resourceID)
+	ret0, _ := ret[0].([]*queries.ResourceApproverView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListApprovers indicates an expected call of ListApprovers.
+func (mr *MockReservationApprovalReadStoreMockRecorder) ListApprovers(ctx, db, resourceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApprovers", reflect.TypeOf((*MockReservationApprovalReadStore)(nil).ListApprovers), ctx, db, resourceID)
+}
+
+// ListPending mocks base method.
+func (m *MockReservationApprovalReadStore) ListPending(ctx context.Context, db sqlc.DBTX, approverID uuid.UUID, allResources bool, limit int32) ([]*queries.PendingApprovalView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPending", ctx, db, approverID, allResources, limit)
+	ret0, _ := ret[0].([]*queries.PendingApprovalView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPending indicates an expected call of ListPending.
+func (mr *MockReservationApprovalReadStoreMockRecorder) ListPending(ctx, db, approverID, allResources, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPending", reflect.TypeOf((*MockReservationApprovalReadStore)(nil).ListPending), ctx, db, approverID, allResources, limit)
+}
+
+// MockReservationApprovalQueries is a mock of ReservationApprovalQueries interface.
+type MockReservationApprovalQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockReservationApprovalQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockReservationApprovalQueriesMockRecorder is the mock recorder for MockReservationApprovalQueries.
+type MockReservationApprovalQueriesMockRecorder struct {
+	mock *MockReservationApprovalQueries
+}
+
+// NewMockReservationApprovalQueries creates a new mock instance.
+func NewMockReservationApprovalQueries(ctrl *gomock.Controller) *MockReservationApprovalQueries {
+	mock := &MockReservationApprovalQueries{ctrl: ctrl}
+	mock.recorder = &MockReservationApprovalQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReservationApprovalQueries) EXPECT() *MockReservationApprovalQueriesMockRecorder {
+	return m.recorder
+}
+
+// ListApprovers mocks base method.
+func (m *MockReservationApprovalQueries) ListApprovers(ctx context.Context, resourceID uuid.UUID) ([]*queries.ResourceApproverView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListApprovers", ctx, resourceID)
+	ret0, _ := ret[0].([]*queries.ResourceApproverView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListApprovers indicates an expected call of ListApprovers.
+func (mr *MockReservationApprovalQueriesMockRecorder) ListApprovers(ctx, resourceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApprovers", reflect.TypeOf((*MockReservationApprovalQueries)(nil).ListApprovers), ctx, resourceID)
+}
+
+// ListPending mocks base method.
+func (m *MockReservationApprovalQueries) ListPending(ctx context.Context, actorID uuid.UUID, actorRole string) ([]*queries.PendingApprovalView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPending", ctx, actorID, actorRole)
+	ret0, _ := ret[0].([]*queries.PendingApprovalView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPending indicates an expected call of ListPending.
+func (mr *MockReservationApprovalQueriesMockRecorder) ListPending(ctx, actorID, actorRole any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPending", reflect.TypeOf((*MockReservationApprovalQueries)(nil).ListPending), ctx, actorID, actorRole)
+}
@@ -11,6 +11,7 @@ package queriesmock
 
 import (
 	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
 	queries "gin-clean-starter/internal/usecase/queries"
 	reflect "reflect"
 	time "time"
@@ -43,6 +44,20 @@ func (m *MockReservationQueries) EXPECT() *MockReservationQueriesMockRecorder {
 	return m.recorder
 }
 
+// ExportForAdmin mocks base method.
+func (m *MockReservationQueries) ExportForAdmin(ctx context.Context, actorID uuid.UUID, actorRole string, companyID, resourceID *uuid.UUID, fields map[string]string, emit func(*queries.AdminReservationListItem) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportForAdmin", ctx, actorID, actorRole, companyID, resourceID, fields, emit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportForAdmin indicates an expected call of ExportForAdmin.
+func (mr *MockReservationQueriesMockRecorder) ExportForAdmin(ctx, actorID, actorRole, companyID, resourceID, fields, emit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportForAdmin", reflect.TypeOf((*MockReservationQueries)(nil).ExportForAdmin), ctx, actorID, actorRole, companyID, resourceID, fields, emit)
+}
+
 // GenerateETag mocks base method.
 func (m *MockReservationQueries) GenerateETag(reservation *queries.ReservationView) string {
 	m.ctrl.T.Helper()
@@ -73,18 +88,33 @@ func (mr *MockReservationQueriesMockRecorder) GetByID(ctx, actor, id any) *gomoc
 }
 
 // GetByIDWithRole mocks base method.
-func (m *MockReservationQueries) GetByIDWithRole(ctx context.Context, actorID uuid.UUID, actorRole string, id uuid.UUID) (*queries.ReservationView, error) {
+func (m *MockReservationQueries) GetByIDWithRole(ctx context.Context, actorID uuid.UUID, actorRole string, companyID *uuid.UUID, id uuid.UUID) (*queries.ReservationView, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByIDWithRole", ctx, actorID, actorRole, id)
+	ret := m.ctrl.Call(m, "GetByIDWithRole", ctx, actorID, actorRole, companyID, id)
 	ret0, _ := ret[0].(*queries.ReservationView)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByIDWithRole indicates an expected call of GetByIDWithRole.
-func (mr *MockReservationQueriesMockRecorder) GetByIDWithRole(ctx, actorID, actorRole, id any) *gomock.Call {
+func (mr *MockReservationQueriesMockRecorder) GetByIDWithRole(ctx, actorID, actorRole, companyID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDWithRole", reflect.TypeOf((*MockReservationQueries)(nil).GetByIDWithRole), ctx, actorID, actorRole, companyID, id)
+}
+
+// GetGroup mocks base method.
+func (m *MockReservationQueries) GetGroup(ctx context.Context, actor, groupID uuid.UUID) ([]*queries.ReservationView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroup", ctx, actor, groupID)
+	ret0, _ := ret[0].([]*queries.ReservationView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroup indicates an expected call of GetGroup.
+func (mr *MockReservationQueriesMockRecorder) GetGroup(ctx, actor, groupID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDWithRole", reflect.TypeOf((*MockReservationQueries)(nil).GetByIDWithRole), ctx, actorID, actorRole, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroup", reflect.TypeOf((*MockReservationQueries)(nil).GetGroup), ctx, actor, groupID)
 }
 
 // ListByUser mocks base method.
@@ -103,6 +133,22 @@ func (mr *MockReservationQueriesMockRecorder) ListByUser(ctx, userID, after, lim
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUser", reflect.TypeOf((*MockReservationQueries)(nil).ListByUser), ctx, userID, after, limit)
 }
 
+// ListForAdmin mocks base method.
+func (m *MockReservationQueries) ListForAdmin(ctx context.Context, actorID uuid.UUID, actorRole string, companyID, resourceID *uuid.UUID, fields map[string]string, after *queries.Cursor, limit int) ([]*queries.AdminReservationListItem, *queries.Cursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListForAdmin", ctx, actorID, actorRole, companyID, resourceID, fields, after, limit)
+	ret0, _ := ret[0].([]*queries.AdminReservationListItem)
+	ret1, _ := ret[1].(*queries.Cursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListForAdmin indicates an expected call of ListForAdmin.
+func (mr *MockReservationQueriesMockRecorder) ListForAdmin(ctx, actorID, actorRole, companyID, resourceID, fields, after, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForAdmin", reflect.TypeOf((*MockReservationQueries)(nil).ListForAdmin), ctx, actorID, actorRole, companyID, resourceID, fields, after, limit)
+}
+
 // MockReservationReadStore is a mock of ReservationReadStore interface.
 type MockReservationReadStore struct {
 	ctrl     *gomock.Controller
@@ -128,46 +174,91 @@ func (m *MockReservationReadStore) EXPECT() *MockReservationReadStoreMockRecorde
 }
 
 // FindByID mocks base method.
-func (m *MockReservationReadStore) FindByID(ctx context.Context, id uuid.UUID) (*queries.ReservationView, error) {
+func (m *MockReservationReadStore) FindByID(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (*queries.ReservationView, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret := m.ctrl.Call(m, "FindByID", ctx, db, id)
 	ret0, _ := ret[0].(*queries.ReservationView)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindByID indicates an expected call of FindByID.
-func (mr *MockReservationReadStoreMockRecorder) FindByID(ctx, id any) *gomock.Call {
+func (mr *MockReservationReadStoreMockRecorder) FindByID(ctx, db, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockReservationReadStore)(nil).FindByID), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockReservationReadStore)(nil).FindByID), ctx, db, id)
 }
 
 // FindByUserIDFirstPage mocks base method.
-func (m *MockReservationReadStore) FindByUserIDFirstPage(ctx context.Context, userID uuid.UUID, limit int32) ([]*queries.ReservationListItem, error) {
+func (m *MockReservationReadStore) FindByUserIDFirstPage(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, limit int32) ([]*queries.ReservationListItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByUserIDFirstPage", ctx, userID, limit)
+	ret := m.ctrl.Call(m, "FindByUserIDFirstPage", ctx, db, userID, limit)
 	ret0, _ := ret[0].([]*queries.ReservationListItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindByUserIDFirstPage indicates an expected call of FindByUserIDFirstPage.
-func (mr *MockReservationReadStoreMockRecorder) FindByUserIDFirstPage(ctx, userID, limit any) *gomock.Call {
+func (mr *MockReservationReadStoreMockRecorder) FindByUserIDFirstPage(ctx, db, userID, limit any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserIDFirstPage", reflect.TypeOf((*MockReservationReadStore)(nil).FindByUserIDFirstPage), ctx, userID, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserIDFirstPage", reflect.TypeOf((*MockReservationReadStore)(nil).FindByUserIDFirstPage), ctx, db, userID, limit)
 }
 
 // FindByUserIDKeyset mocks base method.
-func (m *MockReservationReadStore) FindByUserIDKeyset(ctx context.Context, userID uuid.UUID, lastCreatedAt time.Time, lastID uuid.UUID, limit int32) ([]*queries.ReservationListItem, error) {
+func (m *MockReservationReadStore) FindByUserIDKeyset(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, lastCreatedAt time.Time, lastID uuid.UUID, limit int32) ([]*queries.ReservationListItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByUserIDKeyset", ctx, userID, lastCreatedAt, lastID, limit)
+	ret := m.ctrl.Call(m, "FindByUserIDKeyset", ctx, db, userID, lastCreatedAt, lastID, limit)
 	ret0, _ := ret[0].([]*queries.ReservationListItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindByUserIDKeyset indicates an expected call of FindByUserIDKeyset.
-func (mr *MockReservationReadStoreMockRecorder) FindByUserIDKeyset(ctx, userID, lastCreatedAt, lastID, limit any) *gomock.Call {
+func (mr *MockReservationReadStoreMockRecorder) FindByUserIDKeyset(ctx, db, userID, lastCreatedAt, lastID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserIDKeyset", reflect.TypeOf((*MockReservationReadStore)(nil).FindByUserIDKeyset), ctx, db, userID, lastCreatedAt, lastID, limit)
+}
+
+// FindForAdminFirstPage mocks base method.
+func (m *MockReservationReadStore) FindForAdminFirstPage(ctx context.Context, db sqlc.DBTX, filter queries.AdminReservationFilter, limit int32) ([]*queries.AdminReservationListItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindForAdminFirstPage", ctx, db, filter, limit)
+	ret0, _ := ret[0].([]*queries.AdminReservationListItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindForAdminFirstPage indicates an expected call of FindForAdminFirstPage.
+func (mr *MockReservationReadStoreMockRecorder) FindForAdminFirstPage(ctx, db, filter, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindForAdminFirstPage", reflect.TypeOf((*MockReservationReadStore)(nil).FindForAdminFirstPage), ctx, db, filter, limit)
+}
+
+// FindForAdminKeyset mocks base method.
+func (m *MockReservationReadStore) FindForAdminKeyset(ctx context.Context, db sqlc.DBTX, filter queries.AdminReservationFilter, lastCreatedAt time.Time, lastID uuid.UUID, limit int32) ([]*queries.AdminReservationListItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindForAdminKeyset", ctx, db, filter, lastCreatedAt, lastID, limit)
+	ret0, _ := ret[0].([]*queries.AdminReservationListItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindForAdminKeyset indicates an expected call of FindForAdminKeyset.
+func (mr *MockReservationReadStoreMockRecorder) FindForAdminKeyset(ctx, db, filter, lastCreatedAt, lastID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindForAdminKeyset", reflect.TypeOf((*MockReservationReadStore)(nil).FindForAdminKeyset), ctx, db, filter, lastCreatedAt, lastID, limit)
+}
+
+// FindIDsByGroup mocks base method.
+func (m *MockReservationReadStore) FindIDsByGroup(ctx context.Context, db sqlc.DBTX, groupID, userID uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindIDsByGroup", ctx, db, groupID, userID)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindIDsByGroup indicates an expected call of FindIDsByGroup.
+func (mr *MockReservationReadStoreMockRecorder) FindIDsByGroup(ctx, db, groupID, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserIDKeyset", reflect.TypeOf((*MockReservationReadStore)(nil).FindByUserIDKeyset), ctx, userID, lastCreatedAt, lastID, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindIDsByGroup", reflect.TypeOf((*MockReservationReadStore)(nil).FindIDsByGroup), ctx, db, groupID, userID)
 }
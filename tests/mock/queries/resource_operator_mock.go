@@ -0,0 +1,98 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/queries/resource_operator.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/queries/resource_operator.go -destination=tests/mock/queries/resource_operator_mock.go -package=queriesmock
+//
+
+// Package queriesmock is a generated GoMock package.
+package queriesmock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	queries "gin-clean-starter/internal/usecase/queries"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockResourceOperatorReadStore is a mock of ResourceOperatorReadStore interface.
+type MockResourceOperatorReadStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockResourceOperatorReadStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockResourceOperatorReadStoreMockRecorder is the mock recorder for MockResourceOperatorReadStore.
+type MockResourceOperatorReadStoreMockRecorder struct {
+	mock *MockResourceOperatorReadStore
+}
+
+// NewMockResourceOperatorReadStore creates a new mock instance.
+func NewMockResourceOperatorReadStore(ctrl *gomock.Controller) *MockResourceOperatorReadStore {
+	mock := &MockResourceOperatorReadStore{ctrl: ctrl}
+	mock.recorder = &MockResourceOperatorReadStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResourceOperatorReadStore) EXPECT() *MockResourceOperatorReadStoreMockRecorder {
+	return m.recorder
+}
+
+// ListByResource mocks base method.
+func (m *MockResourceOperatorReadStore) ListByResource(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID) ([]*queries.ResourceOperatorView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByResource", ctx, db, resourceID)
+	ret0, _ := ret[0].([]*queries.ResourceOperatorView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByResource indicates an expected call of ListByResource.
+func (mr *MockResourceOperatorReadStoreMockRecorder) ListByResource(ctx, db, resourceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByResource", reflect.TypeOf((*MockResourceOperatorReadStore)(nil).ListByResource), ctx, db, resourceID)
+}
+
+// MockResourceOperatorQueries is a mock of ResourceOperatorQueries interface.
+type MockResourceOperatorQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockResourceOperatorQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockResourceOperatorQueriesMockRecorder is the mock recorder for MockResourceOperatorQueries.
+type MockResourceOperatorQueriesMockRecorder struct {
+	mock *MockResourceOperatorQueries
+}
+
+// NewMockResourceOperatorQueries creates a new mock instance.
+func NewMockResourceOperatorQueries(ctrl *gomock.Controller) *MockResourceOperatorQueries {
+	mock := &MockResourceOperatorQueries{ctrl: ctrl}
+	mock.recorder = &MockResourceOperatorQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResourceOperatorQueries) EXPECT() *MockResourceOperatorQueriesMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockResourceOperatorQueries) List(ctx context.Context, resourceID uuid.UUID) ([]*queries.ResourceOperatorView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, resourceID)
+	ret0, _ := ret[0].([]*queries.ResourceOperatorView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockResourceOperatorQueriesMockRecorder) List(ctx, resourceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockResourceOperatorQueries)(nil).List), ctx, resourceID)
+}
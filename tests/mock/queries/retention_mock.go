@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/queries/retention.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/queries/retention.go -destination=tests/mock/queries/retention_mock.go -package=queriesmock
+//
+
+// Package queriesmock is a generated GoMock package.
+package queriesmock
+
+import (
+	context "context"
+	queries "gin-clean-starter/internal/usecase/queries"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRetentionQueries is a mock of RetentionQueries interface.
+type MockRetentionQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockRetentionQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockRetentionQueriesMockRecorder is the mock recorder for MockRetentionQueries.
+type MockRetentionQueriesMockRecorder struct {
+	mock *MockRetentionQueries
+}
+
+// NewMockRetentionQueries creates a new mock instance.
+func NewMockRetentionQueries(ctrl *gomock.Controller) *MockRetentionQueries {
+	mock := &MockRetentionQueries{ctrl: ctrl}
+	mock.recorder = &MockRetentionQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRetentionQueries) EXPECT() *MockRetentionQueriesMockRecorder {
+	return m.recorder
+}
+
+// DryRun mocks base method.
+func (m *MockRetentionQueries) DryRun(ctx context.Context) (*queries.RetentionReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DryRun", ctx)
+	ret0, _ := ret[0].(*queries.RetentionReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DryRun indicates an expected call of DryRun.
+func (mr *MockRetentionQueriesMockRecorder) DryRun(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DryRun", reflect.TypeOf((*MockRetentionQueries)(nil).DryRun), ctx)
+}
@@ -11,6 +11,7 @@ package queriesmock
 
 import (
 	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
 	queries "gin-clean-starter/internal/usecase/queries"
 	reflect "reflect"
 	time "time"
@@ -44,93 +45,138 @@ func (m *MockReviewReadStore) EXPECT() *MockReviewReadStoreMockRecorder {
 }
 
 // FindByID mocks base method.
-func (m *MockReviewReadStore) FindByID(ctx context.Context, id uuid.UUID) (*queries.ReviewView, error) {
+func (m *MockReviewReadStore) FindByID(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (*queries.ReviewView, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret := m.ctrl.Call(m, "FindByID", ctx, db, id)
 	ret0, _ := ret[0].(*queries.ReviewView)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindByID indicates an expected call of FindByID.
-func (mr *MockReviewReadStoreMockRecorder) FindByID(ctx, id any) *gomock.Call {
+func (mr *MockReviewReadStoreMockRecorder) FindByID(ctx, db, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockReviewReadStore)(nil).FindByID), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockReviewReadStore)(nil).FindByID), ctx, db, id)
 }
 
 // FindByResourceFirstPage mocks base method.
-func (m *MockReviewReadStore) FindByResourceFirstPage(ctx context.Context, resourceID uuid.UUID, limit int32, minRating, maxRating *int) ([]*queries.ReviewListItem, error) {
+func (m *MockReviewReadStore) FindByResourceFirstPage(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID, limit int32, filters queries.ReviewFilters) ([]*queries.ReviewListItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByResourceFirstPage", ctx, resourceID, limit, minRating, maxRating)
+	ret := m.ctrl.Call(m, "FindByResourceFirstPage", ctx, db, resourceID, limit, filters)
 	ret0, _ := ret[0].([]*queries.ReviewListItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindByResourceFirstPage indicates an expected call of FindByResourceFirstPage.
-func (mr *MockReviewReadStoreMockRecorder) FindByResourceFirstPage(ctx, resourceID, limit, minRating, maxRating any) *gomock.Call {
+func (mr *MockReviewReadStoreMockRecorder) FindByResourceFirstPage(ctx, db, resourceID, limit, filters any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByResourceFirstPage", reflect.TypeOf((*MockReviewReadStore)(nil).FindByResourceFirstPage), ctx, resourceID, limit, minRating, maxRating)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByResourceFirstPage", reflect.TypeOf((*MockReviewReadStore)(nil).FindByResourceFirstPage), ctx, db, resourceID, limit, filters)
 }
 
 // FindByResourceKeyset mocks base method.
-func (m *MockReviewReadStore) FindByResourceKeyset(ctx context.Context, resourceID uuid.UUID, lastCreatedAt time.Time, lastID uuid.UUID, limit int32, minRating, maxRating *int) ([]*queries.ReviewListItem, error) {
+func (m *MockReviewReadStore) FindByResourceKeyset(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID, lastCreatedAt time.Time, lastID uuid.UUID, limit int32, filters queries.ReviewFilters) ([]*queries.ReviewListItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByResourceKeyset", ctx, resourceID, lastCreatedAt, lastID, limit, minRating, maxRating)
+	ret := m.ctrl.Call(m, "FindByResourceKeyset", ctx, db, resourceID, lastCreatedAt, lastID, limit, filters)
 	ret0, _ := ret[0].([]*queries.ReviewListItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindByResourceKeyset indicates an expected call of FindByResourceKeyset.
-func (mr *MockReviewReadStoreMockRecorder) FindByResourceKeyset(ctx, resourceID, lastCreatedAt, lastID, limit, minRating, maxRating any) *gomock.Call {
+func (mr *MockReviewReadStoreMockRecorder) FindByResourceKeyset(ctx, db, resourceID, lastCreatedAt, lastID, limit, filters any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByResourceKeyset", reflect.TypeOf((*MockReviewReadStore)(nil).FindByResourceKeyset), ctx, resourceID, lastCreatedAt, lastID, limit, minRating, maxRating)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByResourceKeyset", reflect.TypeOf((*MockReviewReadStore)(nil).FindByResourceKeyset), ctx, db, resourceID, lastCreatedAt, lastID, limit, filters)
 }
 
 // FindByUserFirstPage mocks base method.
-func (m *MockReviewReadStore) FindByUserFirstPage(ctx context.Context, userID uuid.UUID, limit int32) ([]*queries.ReviewListItem, error) {
+func (m *MockReviewReadStore) FindByUserFirstPage(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, limit int32) ([]*queries.ReviewListItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByUserFirstPage", ctx, userID, limit)
+	ret := m.ctrl.Call(m, "FindByUserFirstPage", ctx, db, userID, limit)
 	ret0, _ := ret[0].([]*queries.ReviewListItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindByUserFirstPage indicates an expected call of FindByUserFirstPage.
-func (mr *MockReviewReadStoreMockRecorder) FindByUserFirstPage(ctx, userID, limit any) *gomock.Call {
+func (mr *MockReviewReadStoreMockRecorder) FindByUserFirstPage(ctx, db, userID, limit any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserFirstPage", reflect.TypeOf((*MockReviewReadStore)(nil).FindByUserFirstPage), ctx, userID, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserFirstPage", reflect.TypeOf((*MockReviewReadStore)(nil).FindByUserFirstPage), ctx, db, userID, limit)
 }
 
 // FindByUserKeyset mocks base method.
-func (m *MockReviewReadStore) FindByUserKeyset(ctx context.Context, userID uuid.UUID, lastCreatedAt time.Time, lastID uuid.UUID, limit int32) ([]*queries.ReviewListItem, error) {
+func (m *MockReviewReadStore) FindByUserKeyset(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, lastCreatedAt time.Time, lastID uuid.UUID, limit int32) ([]*queries.ReviewListItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByUserKeyset", ctx, userID, lastCreatedAt, lastID, limit)
+	ret := m.ctrl.Call(m, "FindByUserKeyset", ctx, db, userID, lastCreatedAt, lastID, limit)
 	ret0, _ := ret[0].([]*queries.ReviewListItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindByUserKeyset indicates an expected call of FindByUserKeyset.
-func (mr *MockReviewReadStoreMockRecorder) FindByUserKeyset(ctx, userID, lastCreatedAt, lastID, limit any) *gomock.Call {
+func (mr *MockReviewReadStoreMockRecorder) FindByUserKeyset(ctx, db, userID, lastCreatedAt, lastID, limit any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserKeyset", reflect.TypeOf((*MockReviewReadStore)(nil).FindByUserKeyset), ctx, userID, lastCreatedAt, lastID, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserKeyset", reflect.TypeOf((*MockReviewReadStore)(nil).FindByUserKeyset), ctx, db, userID, lastCreatedAt, lastID, limit)
+}
+
+// FindFlaggedByResource mocks base method.
+func (m *MockReviewReadStore) FindFlaggedByResource(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID, limit int32) ([]*queries.FlaggedReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFlaggedByResource", ctx, db, resourceID, limit)
+	ret0, _ := ret[0].([]*queries.FlaggedReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindFlaggedByResource indicates an expected call of FindFlaggedByResource.
+func (mr *MockReviewReadStoreMockRecorder) FindFlaggedByResource(ctx, db, resourceID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFlaggedByResource", reflect.TypeOf((*MockReviewReadStore)(nil).FindFlaggedByResource), ctx, db, resourceID, limit)
+}
+
+// FindForExport mocks base method.
+func (m *MockReviewReadStore) FindForExport(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, afterCreatedAt time.Time, afterID uuid.UUID, limit int32) ([]*queries.ReviewExportItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindForExport", ctx, db, userID, afterCreatedAt, afterID, limit)
+	ret0, _ := ret[0].([]*queries.ReviewExportItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindForExport indicates an expected call of FindForExport.
+func (mr *MockReviewReadStoreMockRecorder) FindForExport(ctx, db, userID, afterCreatedAt, afterID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindForExport", reflect.TypeOf((*MockReviewReadStore)(nil).FindForExport), ctx, db, userID, afterCreatedAt, afterID, limit)
+}
+
+// FindRecentPublished mocks base method.
+func (m *MockReviewReadStore) FindRecentPublished(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID, limit int32) ([]*queries.ReviewFeedEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRecentPublished", ctx, db, resourceID, limit)
+	ret0, _ := ret[0].([]*queries.ReviewFeedEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRecentPublished indicates an expected call of FindRecentPublished.
+func (mr *MockReviewReadStoreMockRecorder) FindRecentPublished(ctx, db, resourceID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRecentPublished", reflect.TypeOf((*MockReviewReadStore)(nil).FindRecentPublished), ctx, db, resourceID, limit)
 }
 
 // GetResourceRatingStats mocks base method.
-func (m *MockReviewReadStore) GetResourceRatingStats(ctx context.Context, resourceID uuid.UUID) (*queries.ResourceRatingStats, error) {
+func (m *MockReviewReadStore) GetResourceRatingStats(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID) (*queries.ResourceRatingStats, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetResourceRatingStats", ctx, resourceID)
+	ret := m.ctrl.Call(m, "GetResourceRatingStats", ctx, db, resourceID)
 	ret0, _ := ret[0].(*queries.ResourceRatingStats)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetResourceRatingStats indicates an expected call of GetResourceRatingStats.
-func (mr *MockReviewReadStoreMockRecorder) GetResourceRatingStats(ctx, resourceID any) *gomock.Call {
+func (mr *MockReviewReadStoreMockRecorder) GetResourceRatingStats(ctx, db, resourceID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceRatingStats", reflect.TypeOf((*MockReviewReadStore)(nil).GetResourceRatingStats), ctx, resourceID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceRatingStats", reflect.TypeOf((*MockReviewReadStore)(nil).GetResourceRatingStats), ctx, db, resourceID)
 }
 
 // MockReviewQueries is a mock of ReviewQueries interface.
@@ -157,6 +203,49 @@ func (m *MockReviewQueries) EXPECT() *MockReviewQueriesMockRecorder {
 	return m.recorder
 }
 
+// ExportByUser mocks base method.
+func (m *MockReviewQueries) ExportByUser(ctx context.Context, userID uuid.UUID, emit func(*queries.ReviewExportItem) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportByUser", ctx, userID, emit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportByUser indicates an expected call of ExportByUser.
+func (mr *MockReviewQueriesMockRecorder) ExportByUser(ctx, userID, emit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportByUser", reflect.TypeOf((*MockReviewQueries)(nil).ExportByUser), ctx, userID, emit)
+}
+
+// Feed mocks base method.
+func (m *MockReviewQueries) Feed(ctx context.Context, resourceID uuid.UUID) (*queries.ReviewFeed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Feed", ctx, resourceID)
+	ret0, _ := ret[0].(*queries.ReviewFeed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Feed indicates an expected call of Feed.
+func (mr *MockReviewQueriesMockRecorder) Feed(ctx, resourceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Feed", reflect.TypeOf((*MockReviewQueries)(nil).Feed), ctx, resourceID)
+}
+
+// GenerateFeedETag mocks base method.
+func (m *MockReviewQueries) GenerateFeedETag(feed *queries.ReviewFeed) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateFeedETag", feed)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GenerateFeedETag indicates an expected call of GenerateFeedETag.
+func (mr *MockReviewQueriesMockRecorder) GenerateFeedETag(feed any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateFeedETag", reflect.TypeOf((*MockReviewQueries)(nil).GenerateFeedETag), feed)
+}
+
 // GetByID mocks base method.
 func (m *MockReviewQueries) GetByID(ctx context.Context, id uuid.UUID) (*queries.ReviewView, error) {
 	m.ctrl.T.Helper()
@@ -172,6 +261,21 @@ func (mr *MockReviewQueriesMockRecorder) GetByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockReviewQueries)(nil).GetByID), ctx, id)
 }
 
+// GetPublishedByID mocks base method.
+func (m *MockReviewQueries) GetPublishedByID(ctx context.Context, id uuid.UUID) (*queries.ReviewView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublishedByID", ctx, id)
+	ret0, _ := ret[0].(*queries.ReviewView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPublishedByID indicates an expected call of GetPublishedByID.
+func (mr *MockReviewQueriesMockRecorder) GetPublishedByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublishedByID", reflect.TypeOf((*MockReviewQueries)(nil).GetPublishedByID), ctx, id)
+}
+
 // GetResourceRatingStats mocks base method.
 func (m *MockReviewQueries) GetResourceRatingStats(ctx context.Context, resourceID uuid.UUID) (*queries.ResourceRatingStats, error) {
 	m.ctrl.T.Helper()
@@ -218,3 +322,18 @@ func (mr *MockReviewQueriesMockRecorder) ListByUser(ctx, userID, actorID, actorR
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUser", reflect.TypeOf((*MockReviewQueries)(nil).ListByUser), ctx, userID, actorID, actorRole, cursor, limit)
 }
+
+// ListFlagged mocks base method.
+func (m *MockReviewQueries) ListFlagged(ctx context.Context, resourceID, actorID uuid.UUID, actorRole string) ([]*queries.FlaggedReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFlagged", ctx, resourceID, actorID, actorRole)
+	ret0, _ := ret[0].([]*queries.FlaggedReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFlagged indicates an expected call of ListFlagged.
+func (mr *MockReviewQueriesMockRecorder) ListFlagged(ctx, resourceID, actorID, actorRole any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFlagged", reflect.TypeOf((*MockReviewQueries)(nil).ListFlagged), ctx, resourceID, actorID, actorRole)
+}
@@ -0,0 +1,157 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/queries/role.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/queries/role.go -destination=tests/mock/queries/role_mock.go -package=queriesmock
+//
+
+// Package queriesmock is a generated GoMock package.
+package queriesmock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	queries "gin-clean-starter/internal/usecase/queries"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRoleReadStore is a mock of RoleReadStore interface.
+type MockRoleReadStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoleReadStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockRoleReadStoreMockRecorder is the mock recorder for MockRoleReadStore.
+type MockRoleReadStoreMockRecorder struct {
+	mock *MockRoleReadStore
+}
+
+// NewMockRoleReadStore creates a new mock instance.
+func NewMockRoleReadStore(ctrl *gomock.Controller) *MockRoleReadStore {
+	mock := &MockRoleReadStore{ctrl: ctrl}
+	mock.recorder = &MockRoleReadStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoleReadStore) EXPECT() *MockRoleReadStoreMockRecorder {
+	return m.recorder
+}
+
+// FindByName mocks base method.
+func (m *MockRoleReadStore) FindByName(ctx context.Context, db sqlc.DBTX, name string) (*queries.RoleView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByName", ctx, db, name)
+	ret0, _ := ret[0].(*queries.RoleView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByName indicates an expected call of FindByName.
+func (mr *MockRoleReadStoreMockRecorder) FindByName(ctx, db, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByName", reflect.TypeOf((*MockRoleReadStore)(nil).FindByName), ctx, db, name)
+}
+
+// List mocks base method.
+func (m *MockRoleReadStore) List(ctx context.Context, db sqlc.DBTX) ([]*queries.RoleView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, db)
+	ret0, _ := ret[0].([]*queries.RoleView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockRoleReadStoreMockRecorder) List(ctx, db any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRoleReadStore)(nil).List), ctx, db)
+}
+
+// ListPermissions mocks base method.
+func (m *MockRoleReadStore) ListPermissions(ctx context.Context, db sqlc.DBTX) ([]*queries.PermissionView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPermissions", ctx, db)
+	ret0, _ := ret[0].([]*queries.PermissionView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPermissions indicates an expected call of ListPermissions.
+func (mr *MockRoleReadStoreMockRecorder) ListPermissions(ctx, db any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPermissions", reflect.TypeOf((*MockRoleReadStore)(nil).ListPermissions), ctx, db)
+}
+
+// MockRoleQueries is a mock of RoleQueries interface.
+type MockRoleQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoleQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockRoleQueriesMockRecorder is the mock recorder for MockRoleQueries.
+type MockRoleQueriesMockRecorder struct {
+	mock *MockRoleQueries
+}
+
+// NewMockRoleQueries creates a new mock instance.
+func NewMockRoleQueries(ctrl *gomock.Controller) *MockRoleQueries {
+	mock := &MockRoleQueries{ctrl: ctrl}
+	mock.recorder = &MockRoleQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoleQueries) EXPECT() *MockRoleQueriesMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockRoleQueries) Get(ctx context.Context, name string) (*queries.RoleView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, name)
+	ret0, _ := ret[0].(*queries.RoleView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockRoleQueriesMockRecorder) Get(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRoleQueries)(nil).Get), ctx, name)
+}
+
+// List mocks base method.
+func (m *MockRoleQueries) List(ctx context.Context) ([]*queries.RoleView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]*queries.RoleView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockRoleQueriesMockRecorder) List(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRoleQueries)(nil).List), ctx)
+}
+
+// ListPermissions mocks base method.
+func (m *MockRoleQueries) ListPermissions(ctx context.Context) ([]*queries.PermissionView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPermissions", ctx)
+	ret0, _ := ret[0].([]*queries.PermissionView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPermissions indicates an expected call of ListPermissions.
+func (mr *MockRoleQueriesMockRecorder) ListPermissions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPermissions", reflect.TypeOf((*MockRoleQueries)(nil).ListPermissions), ctx)
+}
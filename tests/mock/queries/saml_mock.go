@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/queries/saml.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/queries/saml.go -destination=tests/mock/queries/saml_mock.go -package=queriesmock
+//
+
+// Package queriesmock is a generated GoMock package.
+package queriesmock
+
+import (
+	context "context"
+	queries "gin-clean-starter/internal/usecase/queries"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSAMLQueries is a mock of SAMLQueries interface.
+type MockSAMLQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockSAMLQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockSAMLQueriesMockRecorder is the mock recorder for MockSAMLQueries.
+type MockSAMLQueriesMockRecorder struct {
+	mock *MockSAMLQueries
+}
+
+// NewMockSAMLQueries creates a new mock instance.
+func NewMockSAMLQueries(ctrl *gomock.Controller) *MockSAMLQueries {
+	mock := &MockSAMLQueries{ctrl: ctrl}
+	mock.recorder = &MockSAMLQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSAMLQueries) EXPECT() *MockSAMLQueriesMockRecorder {
+	return m.recorder
+}
+
+// GetConnection mocks base method.
+func (m *MockSAMLQueries) GetConnection(ctx context.Context, companyID uuid.UUID) (*queries.SAMLConnectionView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConnection", ctx, companyID)
+	ret0, _ := ret[0].(*queries.SAMLConnectionView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConnection indicates an expected call of GetConnection.
+func (mr *MockSAMLQueriesMockRecorder) GetConnection(ctx, companyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConnection", reflect.TypeOf((*MockSAMLQueries)(nil).GetConnection), ctx, companyID)
+}
+
+// Metadata mocks base method.
+func (m *MockSAMLQueries) Metadata(ctx context.Context, companyID uuid.UUID) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Metadata", ctx, companyID)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Metadata indicates an expected call of Metadata.
+func (mr *MockSAMLQueriesMockRecorder) Metadata(ctx, companyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Metadata", reflect.TypeOf((*MockSAMLQueries)(nil).Metadata), ctx, companyID)
+}
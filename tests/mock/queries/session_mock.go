@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/queries/session.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/queries/session.go -destination=tests/mock/queries/session_mock.go -package=queriesmock
+//
+
+// Package queriesmock is a generated GoMock package.
+package queriesmock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	queries "gin-clean-starter/internal/usecase/queries"
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSessionReadStore is a mock of SessionReadStore interface.
+type MockSessionReadStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionReadStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockSessionReadStoreMockRecorder is the mock recorder for MockSessionReadStore.
+type MockSessionReadStoreMockRecorder struct {
+	mock *MockSessionReadStore
+}
+
+// NewMockSessionReadStore creates a new mock instance.
+func NewMockSessionReadStore(ctrl *gomock.Controller) *MockSessionReadStore {
+	mock := &MockSessionReadStore{ctrl: ctrl}
+	mock.recorder = &MockSessionReadStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSessionReadStore) EXPECT() *MockSessionReadStoreMockRecorder {
+	return m.recorder
+}
+
+// ListLive mocks base method.
+func (m *MockSessionReadStore) ListLive(ctx context.Context, db sqlc.DBTX, userID uuid.UUID, now time.Time) ([]*queries.SessionView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLive", ctx, db, userID, now)
+	ret0, _ := ret[0].([]*queries.SessionView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLive indicates an expected call of ListLive.
+func (mr *MockSessionReadStoreMockRecorder) ListLive(ctx, db, userID, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLive", reflect.TypeOf((*MockSessionReadStore)(nil).ListLive), ctx, db, userID, now)
+}
+
+// MockSessionQueries is a mock of SessionQueries interface.
+type MockSessionQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockSessionQueriesMockRecorder is the mock recorder for MockSessionQueries.
+type MockSessionQueriesMockRecorder struct {
+	mock *MockSessionQueries
+}
+
+// NewMockSessionQueries creates a new mock instance.
+func NewMockSessionQueries(ctrl *gomock.Controller) *MockSessionQueries {
+	mock := &MockSessionQueries{ctrl: ctrl}
+	mock.recorder = &MockSessionQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSessionQueries) EXPECT() *MockSessionQueriesMockRecorder {
+	return m.recorder
+}
+
+// ListByUser mocks base method.
+func (m *MockSessionQueries) ListByUser(ctx context.Context, userID uuid.UUID) ([]*queries.SessionView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUser", ctx, userID)
+	ret0, _ := ret[0].([]*queries.SessionView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByUser indicates an expected call of ListByUser.
+func (mr *MockSessionQueriesMockRecorder) ListByUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUser", reflect.TypeOf((*MockSessionQueries)(nil).ListByUser), ctx, userID)
+}
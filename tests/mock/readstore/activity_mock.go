@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/readstore/activity.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/readstore/activity.go -destination=tests/mock/readstore/activity_mock.go -package=readstoremock
+//
+
+// Package readstoremock is a generated GoMock package.
+package readstoremock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockActivityReadQueries is a mock of ActivityReadQueries interface.
+type MockActivityReadQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockActivityReadQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockActivityReadQueriesMockRecorder is the mock recorder for MockActivityReadQueries.
+type MockActivityReadQueriesMockRecorder struct {
+	mock *MockActivityReadQueries
+}
+
+// NewMockActivityReadQueries creates a new mock instance.
+func NewMockActivityReadQueries(ctrl *gomock.Controller) *MockActivityReadQueries {
+	mock := &MockActivityReadQueries{ctrl: ctrl}
+	mock.recorder = &MockActivityReadQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockActivityReadQueries) EXPECT() *MockActivityReadQueriesMockRecorder {
+	return m.recorder
+}
+
+// ListUserActivityFirstPage mocks base method.
+func (m *MockActivityReadQueries) ListUserActivityFirstPage(ctx context.Context, db sqlc.DBTX, arg sqlc.ListUserActivityFirstPageParams) ([]sqlc.UserActivity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserActivityFirstPage", ctx, db, arg)
+	ret0, _ := ret[0].([]sqlc.UserActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserActivityFirstPage indicates an expected call of ListUserActivityFirstPage.
+func (mr *MockActivityReadQueriesMockRecorder) ListUserActivityFirstPage(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserActivityFirstPage", reflect.TypeOf((*MockActivityReadQueries)(nil).ListUserActivityFirstPage), ctx, db, arg)
+}
+
+// ListUserActivityKeyset mocks base method.
+func (m *MockActivityReadQueries) ListUserActivityKeyset(ctx context.Context, db sqlc.DBTX, arg sqlc.ListUserActivityKeysetParams) ([]sqlc.UserActivity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserActivityKeyset", ctx, db, arg)
+	ret0, _ := ret[0].([]sqlc.UserActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserActivityKeyset indicates an expected call of ListUserActivityKeyset.
+func (mr *MockActivityReadQueriesMockRecorder) ListUserActivityKeyset(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserActivityKeyset", reflect.TypeOf((*MockActivityReadQueries)(nil).ListUserActivityKeyset), ctx, db, arg)
+}
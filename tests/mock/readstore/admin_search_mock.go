@@ -0,0 +1,118 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/readstore/admin_search.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/readstore/admin_search.go -destination=tests/mock/readstore/admin_search_mock.go -package=readstoremock
+//
+
+// Package readstoremock is a generated GoMock package.
+package readstoremock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAdminSearchReadQueries is a mock of AdminSearchReadQueries interface.
+type MockAdminSearchReadQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminSearchReadQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockAdminSearchReadQueriesMockRecorder is the mock recorder for MockAdminSearchReadQueries.
+type MockAdminSearchReadQueriesMockRecorder struct {
+	mock *MockAdminSearchReadQueries
+}
+
+// NewMockAdminSearchReadQueries creates a new mock instance.
+func NewMockAdminSearchReadQueries(ctrl *gomock.Controller) *MockAdminSearchReadQueries {
+	mock := &MockAdminSearchReadQueries{ctrl: ctrl}
+	mock.recorder = &MockAdminSearchReadQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminSearchReadQueries) EXPECT() *MockAdminSearchReadQueriesMockRecorder {
+	return m.recorder
+}
+
+// AdminSearchReservationByID mocks base method.
+func (m *MockAdminSearchReadQueries) AdminSearchReservationByID(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (sqlc.AdminSearchReservationByIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdminSearchReservationByID", ctx, db, id)
+	ret0, _ := ret[0].(sqlc.AdminSearchReservationByIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdminSearchReservationByID indicates an expected call of AdminSearchReservationByID.
+func (mr *MockAdminSearchReadQueriesMockRecorder) AdminSearchReservationByID(ctx, db, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdminSearchReservationByID", reflect.TypeOf((*MockAdminSearchReadQueries)(nil).AdminSearchReservationByID), ctx, db, id)
+}
+
+// AdminSearchReservationNotes mocks base method.
+func (m *MockAdminSearchReadQueries) AdminSearchReservationNotes(ctx context.Context, db sqlc.DBTX, arg sqlc.AdminSearchReservationNotesParams) ([]sqlc.AdminSearchReservationNotesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdminSearchReservationNotes", ctx, db, arg)
+	ret0, _ := ret[0].([]sqlc.AdminSearchReservationNotesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdminSearchReservationNotes indicates an expected call of AdminSearchReservationNotes.
+func (mr *MockAdminSearchReadQueriesMockRecorder) AdminSearchReservationNotes(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdminSearchReservationNotes", reflect.TypeOf((*MockAdminSearchReadQueries)(nil).AdminSearchReservationNotes), ctx, db, arg)
+}
+
+// AdminSearchResources mocks base method.
+func (m *MockAdminSearchReadQueries) AdminSearchResources(ctx context.Context, db sqlc.DBTX, arg sqlc.AdminSearchResourcesParams) ([]sqlc.AdminSearchResourcesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdminSearchResources", ctx, db, arg)
+	ret0, _ := ret[0].([]sqlc.AdminSearchResourcesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdminSearchResources indicates an expected call of AdminSearchResources.
+func (mr *MockAdminSearchReadQueriesMockRecorder) AdminSearchResources(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdminSearchResources", reflect.TypeOf((*MockAdminSearchReadQueries)(nil).AdminSearchResources), ctx, db, arg)
+}
+
+// AdminSearchReviews mocks base method.
+func (m *MockAdminSearchReadQueries) AdminSearchReviews(ctx context.Context, db sqlc.DBTX, arg sqlc.AdminSearchReviewsParams) ([]sqlc.AdminSearchReviewsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdminSearchReviews", ctx, db, arg)
+	ret0, _ := ret[0].([]sqlc.AdminSearchReviewsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdminSearchReviews indicates an expected call of AdminSearchReviews.
+func (mr *MockAdminSearchReadQueriesMockRecorder) AdminSearchReviews(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdminSearchReviews", reflect.TypeOf((*MockAdminSearchReadQueries)(nil).AdminSearchReviews), ctx, db, arg)
+}
+
+// AdminSearchUsers mocks base method.
+func (m *MockAdminSearchReadQueries) AdminSearchUsers(ctx context.Context, db sqlc.DBTX, arg sqlc.AdminSearchUsersParams) ([]sqlc.AdminSearchUsersRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdminSearchUsers", ctx, db, arg)
+	ret0, _ := ret[0].([]sqlc.AdminSearchUsersRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdminSearchUsers indicates an expected call of AdminSearchUsers.
+func (mr *MockAdminSearchReadQueriesMockRecorder) AdminSearchUsers(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdminSearchUsers", reflect.TypeOf((*MockAdminSearchReadQueries)(nil).AdminSearchUsers), ctx, db, arg)
+}
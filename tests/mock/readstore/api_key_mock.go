@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/readstore/api_key.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/readstore/api_key.go -destination=tests/mock/readstore/api_key_mock.go -package=readstoremock
+//
+
+// Package readstoremock is a generated GoMock package.
+package readstoremock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAPIKeyReadQueries is a mock of APIKeyReadQueries interface.
+type MockAPIKeyReadQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIKeyReadQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockAPIKeyReadQueriesMockRecorder is the mock recorder for MockAPIKeyReadQueries.
+type MockAPIKeyReadQueriesMockRecorder struct {
+	mock *MockAPIKeyReadQueries
+}
+
+// NewMockAPIKeyReadQueries creates a new mock instance.
+func NewMockAPIKeyReadQueries(ctrl *gomock.Controller) *MockAPIKeyReadQueries {
+	mock := &MockAPIKeyReadQueries{ctrl: ctrl}
+	mock.recorder = &MockAPIKeyReadQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPIKeyReadQueries) EXPECT() *MockAPIKeyReadQueriesMockRecorder {
+	return m.recorder
+}
+
+// FindAPIKeyIdentity mocks base method.
+func (m *MockAPIKeyReadQueries) FindAPIKeyIdentity(ctx context.Context, db sqlc.DBTX, arg sqlc.FindAPIKeyIdentityParams) (sqlc.FindAPIKeyIdentityRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAPIKeyIdentity", ctx, db, arg)
+	ret0, _ := ret[0].(sqlc.FindAPIKeyIdentityRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAPIKeyIdentity indicates an expected call of FindAPIKeyIdentity.
+func (mr *MockAPIKeyReadQueriesMockRecorder) FindAPIKeyIdentity(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAPIKeyIdentity", reflect.TypeOf((*MockAPIKeyReadQueries)(nil).FindAPIKeyIdentity), ctx, db, arg)
+}
+
+// ListUserAPIKeys mocks base method.
+func (m *MockAPIKeyReadQueries) ListUserAPIKeys(ctx context.Context, db sqlc.DBTX, userID uuid.UUID) ([]sqlc.ListUserAPIKeysRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserAPIKeys", ctx, db, userID)
+	ret0, _ := ret[0].([]sqlc.ListUserAPIKeysRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserAPIKeys indicates an expected call of ListUserAPIKeys.
+func (mr *MockAPIKeyReadQueriesMockRecorder) ListUserAPIKeys(ctx, db, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserAPIKeys", reflect.TypeOf((*MockAPIKeyReadQueries)(nil).ListUserAPIKeys), ctx, db, userID)
+}
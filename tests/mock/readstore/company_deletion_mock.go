@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/readstore/company_deletion.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/readstore/company_deletion.go -destination=tests/mock/readstore/company_deletion_mock.go -package=readstoremock
+//
+
+// Package readstoremock is a generated GoMock package.
+package readstoremock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCompanyDeletionReadQueries is a mock of CompanyDeletionReadQueries interface.
+type MockCompanyDeletionReadQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockCompanyDeletionReadQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockCompanyDeletionReadQueriesMockRecorder is the mock recorder for MockCompanyDeletionReadQueries.
+type MockCompanyDeletionReadQueriesMockRecorder struct {
+	mock *MockCompanyDeletionReadQueries
+}
+
+// NewMockCompanyDeletionReadQueries creates a new mock instance.
+func NewMockCompanyDeletionReadQueries(ctrl *gomock.Controller) *MockCompanyDeletionReadQueries {
+	mock := &MockCompanyDeletionReadQueries{ctrl: ctrl}
+	mock.recorder = &MockCompanyDeletionReadQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCompanyDeletionReadQueries) EXPECT() *MockCompanyDeletionReadQueriesMockRecorder {
+	return m.recorder
+}
+
+// GetCompanyDeletion mocks base method.
+func (m *MockCompanyDeletionReadQueries) GetCompanyDeletion(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (sqlc.CompanyDeletions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCompanyDeletion", ctx, db, id)
+	ret0, _ := ret[0].(sqlc.CompanyDeletions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCompanyDeletion indicates an expected call of GetCompanyDeletion.
+func (mr *MockCompanyDeletionReadQueriesMockRecorder) GetCompanyDeletion(ctx, db, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompanyDeletion", reflect.TypeOf((*MockCompanyDeletionReadQueries)(nil).GetCompanyDeletion), ctx, db, id)
+}
@@ -0,0 +1,163 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/readstore/company_export.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/readstore/company_export.go -destination=tests/mock/readstore/company_export_mock.go -package=readstoremock
+//
+
+// Package readstoremock is a generated GoMock package.
+package readstoremock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCompanyExportReadQueries is a mock of CompanyExportReadQueries interface.
+type MockCompanyExportReadQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockCompanyExportReadQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockCompanyExportReadQueriesMockRecorder is the mock recorder for MockCompanyExportReadQueries.
+type MockCompanyExportReadQueriesMockRecorder struct {
+	mock *MockCompanyExportReadQueries
+}
+
+// NewMockCompanyExportReadQueries creates a new mock instance.
+func NewMockCompanyExportReadQueries(ctrl *gomock.Controller) *MockCompanyExportReadQueries {
+	mock := &MockCompanyExportReadQueries{ctrl: ctrl}
+	mock.recorder = &MockCompanyExportReadQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCompanyExportReadQueries) EXPECT() *MockCompanyExportReadQueriesMockRecorder {
+	return m.recorder
+}
+
+// CountCompanyExportRows mocks base method.
+func (m *MockCompanyExportReadQueries) CountCompanyExportRows(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID) (sqlc.CountCompanyExportRowsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountCompanyExportRows", ctx, db, companyID)
+	ret0, _ := ret[0].(sqlc.CountCompanyExportRowsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountCompanyExportRows indicates an expected call of CountCompanyExportRows.
+func (mr *MockCompanyExportReadQueriesMockRecorder) CountCompanyExportRows(ctx, db, companyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountCompanyExportRows", reflect.TypeOf((*MockCompanyExportReadQueries)(nil).CountCompanyExportRows), ctx, db, companyID)
+}
+
+// GetCompanyExport mocks base method.
+func (m *MockCompanyExportReadQueries) GetCompanyExport(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (sqlc.CompanyExports, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCompanyExport", ctx, db, id)
+	ret0, _ := ret[0].(sqlc.CompanyExports)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCompanyExport indicates an expected call of GetCompanyExport.
+func (mr *MockCompanyExportReadQueriesMockRecorder) GetCompanyExport(ctx, db, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompanyExport", reflect.TypeOf((*MockCompanyExportReadQueries)(nil).GetCompanyExport), ctx, db, id)
+}
+
+// GetCompanyExportProfile mocks base method.
+func (m *MockCompanyExportReadQueries) GetCompanyExportProfile(ctx context.Context, db sqlc.DBTX, id uuid.UUID) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCompanyExportProfile", ctx, db, id)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCompanyExportProfile indicates an expected call of GetCompanyExportProfile.
+func (mr *MockCompanyExportReadQueriesMockRecorder) GetCompanyExportProfile(ctx, db, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompanyExportProfile", reflect.TypeOf((*MockCompanyExportReadQueries)(nil).GetCompanyExportProfile), ctx, db, id)
+}
+
+// ListCompanyExportReservations mocks base method.
+func (m *MockCompanyExportReadQueries) ListCompanyExportReservations(ctx context.Context, db sqlc.DBTX, arg sqlc.ListCompanyExportReservationsParams) ([]sqlc.ListCompanyExportReservationsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCompanyExportReservations", ctx, db, arg)
+	ret0, _ := ret[0].([]sqlc.ListCompanyExportReservationsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCompanyExportReservations indicates an expected call of ListCompanyExportReservations.
+func (mr *MockCompanyExportReadQueriesMockRecorder) ListCompanyExportReservations(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCompanyExportReservations", reflect.TypeOf((*MockCompanyExportReadQueries)(nil).ListCompanyExportReservations), ctx, db, arg)
+}
+
+// ListCompanyExportResources mocks base method.
+func (m *MockCompanyExportReadQueries) ListCompanyExportResources(ctx context.Context, db sqlc.DBTX, arg sqlc.ListCompanyExportResourcesParams) ([]sqlc.ListCompanyExportResourcesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCompanyExportResources", ctx, db, arg)
+	ret0, _ := ret[0].([]sqlc.ListCompanyExportResourcesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCompanyExportResources indicates an expected call of ListCompanyExportResources.
+func (mr *MockCompanyExportReadQueriesMockRecorder) ListCompanyExportResources(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCompanyExportResources", reflect.TypeOf((*MockCompanyExportReadQueries)(nil).ListCompanyExportResources), ctx, db, arg)
+}
+
+// ListCompanyExportReviews mocks base method.
+func (m *MockCompanyExportReadQueries) ListCompanyExportReviews(ctx context.Context, db sqlc.DBTX, arg sqlc.ListCompanyExportReviewsParams) ([]sqlc.ListCompanyExportReviewsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCompanyExportReviews", ctx, db, arg)
+	ret0, _ := ret[0].([]sqlc.ListCompanyExportReviewsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCompanyExportReviews indicates an expected call of ListCompanyExportReviews.
+func (mr *MockCompanyExportReadQueriesMockRecorder) ListCompanyExportReviews(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCompanyExportReviews", reflect.TypeOf((*MockCompanyExportReadQueries)(nil).ListCompanyExportReviews), ctx, db, arg)
+}
+
+// ListCompanyExportUsers mocks base method.
+func (m *MockCompanyExportReadQueries) ListCompanyExportUsers(ctx context.Context, db sqlc.DBTX, arg sqlc.ListCompanyExportUsersParams) ([]sqlc.ListCompanyExportUsersRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCompanyExportUsers", ctx, db, arg)
+	ret0, _ := ret[0].([]sqlc.ListCompanyExportUsersRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCompanyExportUsers indicates an expected call of ListCompanyExportUsers.
+func (mr *MockCompanyExportReadQueriesMockRecorder) ListCompanyExportUsers(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCompanyExportUsers", reflect.TypeOf((*MockCompanyExportReadQueries)(nil).ListCompanyExportUsers), ctx, db, arg)
+}
+
+// ListExpiredCompanyExports mocks base method.
+func (m *MockCompanyExportReadQueries) ListExpiredCompanyExports(ctx context.Context, db sqlc.DBTX, arg sqlc.ListExpiredCompanyExportsParams) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExpiredCompanyExports", ctx, db, arg)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExpiredCompanyExports indicates an expected call of ListExpiredCompanyExports.
+func (mr *MockCompanyExportReadQueriesMockRecorder) ListExpiredCompanyExports(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpiredCompanyExports", reflect.TypeOf((*MockCompanyExportReadQueries)(nil).ListExpiredCompanyExports), ctx, db, arg)
+}
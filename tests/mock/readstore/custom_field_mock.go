@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/readstore/custom_field.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/readstore/custom_field.go -destination=tests/mock/readstore/custom_field_mock.go -package=readstoremock
+//
+
+// Package readstoremock is a generated GoMock package.
+package readstoremock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCustomFieldReadQueries is a mock of CustomFieldReadQueries interface.
+type MockCustomFieldReadQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockCustomFieldReadQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockCustomFieldReadQueriesMockRecorder is the mock recorder for MockCustomFieldReadQueries.
+type MockCustomFieldReadQueriesMockRecorder struct {
+	mock *MockCustomFieldReadQueries
+}
+
+// NewMockCustomFieldReadQueries creates a new mock instance.
+func NewMockCustomFieldReadQueries(ctrl *gomock.Controller) *MockCustomFieldReadQueries {
+	mock := &MockCustomFieldReadQueries{ctrl: ctrl}
+	mock.recorder = &MockCustomFieldReadQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCustomFieldReadQueries) EXPECT() *MockCustomFieldReadQueriesMockRecorder {
+	return m.recorder
+}
+
+// ListCustomFieldDefinitionsByCompany mocks base method.
+func (m *MockCustomFieldReadQueries) ListCustomFieldDefinitionsByCompany(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID) ([]sqlc.CustomFieldDefinitions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCustomFieldDefinitionsByCompany", ctx, db, companyID)
+	ret0, _ := ret[0].([]sqlc.CustomFieldDefinitions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCustomFieldDefinitionsByCompany indicates an expected call of ListCustomFieldDefinitionsByCompany.
+func (mr *MockCustomFieldReadQueriesMockRecorder) ListCustomFieldDefinitionsByCompany(ctx, db, companyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomFieldDefinitionsByCompany", reflect.TypeOf((*MockCustomFieldReadQueries)(nil).ListCustomFieldDefinitionsByCompany), ctx, db, companyID)
+}
+
+// ListCustomFieldDefinitionsForResource mocks base method.
+func (m *MockCustomFieldReadQueries) ListCustomFieldDefinitionsForResource(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID) ([]sqlc.CustomFieldDefinitions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCustomFieldDefinitionsForResource", ctx, db, resourceID)
+	ret0, _ := ret[0].([]sqlc.CustomFieldDefinitions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCustomFieldDefinitionsForResource indicates an expected call of ListCustomFieldDefinitionsForResource.
+func (mr *MockCustomFieldReadQueriesMockRecorder) ListCustomFieldDefinitionsForResource(ctx, db, resourceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomFieldDefinitionsForResource", reflect.TypeOf((*MockCustomFieldReadQueries)(nil).ListCustomFieldDefinitionsForResource), ctx, db, resourceID)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/readstore/deprecation.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/readstore/deprecation.go -destination=tests/mock/readstore/deprecation_mock.go -package=readstoremock
+//
+
+// Package readstoremock is a generated GoMock package.
+package readstoremock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDeprecationReadQueries is a mock of DeprecationReadQueries interface.
+type MockDeprecationReadQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeprecationReadQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockDeprecationReadQueriesMockRecorder is the mock recorder for MockDeprecationReadQueries.
+type MockDeprecationReadQueriesMockRecorder struct {
+	mock *MockDeprecationReadQueries
+}
+
+// NewMockDeprecationReadQueries creates a new mock instance.
+func NewMockDeprecationReadQueries(ctrl *gomock.Controller) *MockDeprecationReadQueries {
+	mock := &MockDeprecationReadQueries{ctrl: ctrl}
+	mock.recorder = &MockDeprecationReadQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeprecationReadQueries) EXPECT() *MockDeprecationReadQueriesMockRecorder {
+	return m.recorder
+}
+
+// ListDeprecatedRouteCalls mocks base method.
+func (m *MockDeprecationReadQueries) ListDeprecatedRouteCalls(ctx context.Context, db sqlc.DBTX, arg sqlc.ListDeprecatedRouteCallsParams) ([]sqlc.ListDeprecatedRouteCallsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeprecatedRouteCalls", ctx, db, arg)
+	ret0, _ := ret[0].([]sqlc.ListDeprecatedRouteCallsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeprecatedRouteCalls indicates an expected call of ListDeprecatedRouteCalls.
+func (mr *MockDeprecationReadQueriesMockRecorder) ListDeprecatedRouteCalls(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeprecatedRouteCalls", reflect.TypeOf((*MockDeprecationReadQueries)(nil).ListDeprecatedRouteCalls), ctx, db, arg)
+}
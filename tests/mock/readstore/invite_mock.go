@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/readstore/invite.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/readstore/invite.go -destination=tests/mock/readstore/invite_mock.go -package=readstoremock
+//
+
+// Package readstoremock is a generated GoMock package.
+package readstoremock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockInviteReadQueries is a mock of InviteReadQueries interface.
+type MockInviteReadQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockInviteReadQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockInviteReadQueriesMockRecorder is the mock recorder for MockInviteReadQueries.
+type MockInviteReadQueriesMockRecorder struct {
+	mock *MockInviteReadQueries
+}
+
+// NewMockInviteReadQueries creates a new mock instance.
+func NewMockInviteReadQueries(ctrl *gomock.Controller) *MockInviteReadQueries {
+	mock := &MockInviteReadQueries{ctrl: ctrl}
+	mock.recorder = &MockInviteReadQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInviteReadQueries) EXPECT() *MockInviteReadQueriesMockRecorder {
+	return m.recorder
+}
+
+// GetInviteByID mocks base method.
+func (m *MockInviteReadQueries) GetInviteByID(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (sqlc.Invites, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInviteByID", ctx, db, id)
+	ret0, _ := ret[0].(sqlc.Invites)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInviteByID indicates an expected call of GetInviteByID.
+func (mr *MockInviteReadQueriesMockRecorder) GetInviteByID(ctx, db, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInviteByID", reflect.TypeOf((*MockInviteReadQueries)(nil).GetInviteByID), ctx, db, id)
+}
+
+// ListInvitesByCompany mocks base method.
+func (m *MockInviteReadQueries) ListInvitesByCompany(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID) ([]sqlc.ListInvitesByCompanyRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInvitesByCompany", ctx, db, companyID)
+	ret0, _ := ret[0].([]sqlc.ListInvitesByCompanyRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInvitesByCompany indicates an expected call of ListInvitesByCompany.
+func (mr *MockInviteReadQueriesMockRecorder) ListInvitesByCompany(ctx, db, companyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInvitesByCompany", reflect.TypeOf((*MockInviteReadQueries)(nil).ListInvitesByCompany), ctx, db, companyID)
+}
@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/readstore/loyalty.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/readstore/loyalty.go -destination=tests/mock/readstore/loyalty_mock.go -package=readstoremock
+//
+
+// Package readstoremock is a generated GoMock package.
+package readstoremock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLoyaltyReadQueries is a mock of LoyaltyReadQueries interface.
+type MockLoyaltyReadQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoyaltyReadQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockLoyaltyReadQueriesMockRecorder is the mock recorder for MockLoyaltyReadQueries.
+type MockLoyaltyReadQueriesMockRecorder struct {
+	mock *MockLoyaltyReadQueries
+}
+
+// NewMockLoyaltyReadQueries creates a new mock instance.
+func NewMockLoyaltyReadQueries(ctrl *gomock.Controller) *MockLoyaltyReadQueries {
+	mock := &MockLoyaltyReadQueries{ctrl: ctrl}
+	mock.recorder = &MockLoyaltyReadQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoyaltyReadQueries) EXPECT() *MockLoyaltyReadQueriesMockRecorder {
+	return m.recorder
+}
+
+// GetLoyaltyBalance mocks base method.
+func (m *MockLoyaltyReadQueries) GetLoyaltyBalance(ctx context.Context, db sqlc.DBTX, arg sqlc.GetLoyaltyBalanceParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoyaltyBalance", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoyaltyBalance indicates an expected call of GetLoyaltyBalance.
+func (mr *MockLoyaltyReadQueriesMockRecorder) GetLoyaltyBalance(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoyaltyBalance", reflect.TypeOf((*MockLoyaltyReadQueries)(nil).GetLoyaltyBalance), ctx, db, arg)
+}
+
+// ListAccruableReservations mocks base method.
+func (m *MockLoyaltyReadQueries) ListAccruableReservations(ctx context.Context, db sqlc.DBTX, arg sqlc.ListAccruableReservationsParams) ([]sqlc.ListAccruableReservationsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccruableReservations", ctx, db, arg)
+	ret0, _ := ret[0].([]sqlc.ListAccruableReservationsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccruableReservations indicates an expected call of ListAccruableReservations.
+func (mr *MockLoyaltyReadQueriesMockRecorder) ListAccruableReservations(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccruableReservations", reflect.TypeOf((*MockLoyaltyReadQueries)(nil).ListAccruableReservations), ctx, db, arg)
+}
+
+// ListLoyaltyEntriesFirstPage mocks base method.
+func (m *MockLoyaltyReadQueries) ListLoyaltyEntriesFirstPage(ctx context.Context, db sqlc.DBTX, arg sqlc.ListLoyaltyEntriesFirstPageParams) ([]sqlc.ListLoyaltyEntriesFirstPageRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLoyaltyEntriesFirstPage", ctx, db, arg)
+	ret0, _ := ret[0].([]sqlc.ListLoyaltyEntriesFirstPageRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLoyaltyEntriesFirstPage indicates an expected call of ListLoyaltyEntriesFirstPage.
+func (mr *MockLoyaltyReadQueriesMockRecorder) ListLoyaltyEntriesFirstPage(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoyaltyEntriesFirstPage", reflect.TypeOf((*MockLoyaltyReadQueries)(nil).ListLoyaltyEntriesFirstPage), ctx, db, arg)
+}
+
+// ListLoyaltyEntriesKeyset mocks base method.
+func (m *MockLoyaltyReadQueries) ListLoyaltyEntriesKeyset(ctx context.Context, db sqlc.DBTX, arg sqlc.ListLoyaltyEntriesKeysetParams) ([]sqlc.ListLoyaltyEntriesKeysetRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLoyaltyEntriesKeyset", ctx, db, arg)
+	ret0, _ := ret[0].([]sqlc.ListLoyaltyEntriesKeysetRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLoyaltyEntriesKeyset indicates an expected call of ListLoyaltyEntriesKeyset.
+func (mr *MockLoyaltyReadQueriesMockRecorder) ListLoyaltyEntriesKeyset(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoyaltyEntriesKeyset", reflect.TypeOf((*MockLoyaltyReadQueries)(nil).ListLoyaltyEntriesKeyset), ctx, db, arg)
+}
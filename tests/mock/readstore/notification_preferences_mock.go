@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/readstore/notification_preferences.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/readstore/notification_preferences.go -destination=tests/mock/readstore/notification_preferences_mock.go -package=readstoremock
+//
+
+// Package readstoremock is a generated GoMock package.
+package readstoremock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNotificationPreferencesReadQueries is a mock of NotificationPreferencesReadQueries interface.
+type MockNotificationPreferencesReadQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationPreferencesReadQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockNotificationPreferencesReadQueriesMockRecorder is the mock recorder for MockNotificationPreferencesReadQueries.
+type MockNotificationPreferencesReadQueriesMockRecorder struct {
+	mock *MockNotificationPreferencesReadQueries
+}
+
+// NewMockNotificationPreferencesReadQueries creates a new mock instance.
+func NewMockNotificationPreferencesReadQueries(ctrl *gomock.Controller) *MockNotificationPreferencesReadQueries {
+	mock := &MockNotificationPreferencesReadQueries{ctrl: ctrl}
+	mock.recorder = &MockNotificationPreferencesReadQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationPreferencesReadQueries) EXPECT() *MockNotificationPreferencesReadQueriesMockRecorder {
+	return m.recorder
+}
+
+// GetNotificationPreferences mocks base method.
+func (m *MockNotificationPreferencesReadQueries) GetNotificationPreferences(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (sqlc.GetNotificationPreferencesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationPreferences", ctx, db, id)
+	ret0, _ := ret[0].(sqlc.GetNotificationPreferencesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationPreferences indicates an expected call of GetNotificationPreferences.
+func (mr *MockNotificationPreferencesReadQueriesMockRecorder) GetNotificationPreferences(ctx, db, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationPreferences", reflect.TypeOf((*MockNotificationPreferencesReadQueries)(nil).GetNotificationPreferences), ctx, db, id)
+}
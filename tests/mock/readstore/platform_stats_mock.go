@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/readstore/platform_stats.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/readstore/platform_stats.go -destination=tests/mock/readstore/platform_stats_mock.go -package=readstoremock
+//
+
+// Package readstoremock is a generated GoMock package.
+package readstoremock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPlatformStatsReadQueries is a mock of PlatformStatsReadQueries interface.
+type MockPlatformStatsReadQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockPlatformStatsReadQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockPlatformStatsReadQueriesMockRecorder is the mock recorder for MockPlatformStatsReadQueries.
+type MockPlatformStatsReadQueriesMockRecorder struct {
+	mock *MockPlatformStatsReadQueries
+}
+
+// NewMockPlatformStatsReadQueries creates a new mock instance.
+func NewMockPlatformStatsReadQueries(ctrl *gomock.Controller) *MockPlatformStatsReadQueries {
+	mock := &MockPlatformStatsReadQueries{ctrl: ctrl}
+	mock.recorder = &MockPlatformStatsReadQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPlatformStatsReadQueries) EXPECT() *MockPlatformStatsReadQueriesMockRecorder {
+	return m.recorder
+}
+
+// GetPlatformStats mocks base method.
+func (m *MockPlatformStatsReadQueries) GetPlatformStats(ctx context.Context, db sqlc.DBTX) (sqlc.GetPlatformStatsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlatformStats", ctx, db)
+	ret0, _ := ret[0].(sqlc.GetPlatformStatsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlatformStats indicates an expected call of GetPlatformStats.
+func (mr *MockPlatformStatsReadQueriesMockRecorder) GetPlatformStats(ctx, db any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlatformStats", reflect.TypeOf((*MockPlatformStatsReadQueries)(nil).GetPlatformStats), ctx, db)
+}
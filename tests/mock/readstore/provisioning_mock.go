@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/readstore/provisioning.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/readstore/provisioning.go -destination=tests/mock/readstore/provisioning_mock.go -package=readstoremock
+//
+
+// Package readstoremock is a generated GoMock package.
+package readstoremock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProvisioningReadQueries is a mock of ProvisioningReadQueries interface.
+type MockProvisioningReadQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockProvisioningReadQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockProvisioningReadQueriesMockRecorder is the mock recorder for MockProvisioningReadQueries.
+type MockProvisioningReadQueriesMockRecorder struct {
+	mock *MockProvisioningReadQueries
+}
+
+// NewMockProvisioningReadQueries creates a new mock instance.
+func NewMockProvisioningReadQueries(ctrl *gomock.Controller) *MockProvisioningReadQueries {
+	mock := &MockProvisioningReadQueries{ctrl: ctrl}
+	mock.recorder = &MockProvisioningReadQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProvisioningReadQueries) EXPECT() *MockProvisioningReadQueriesMockRecorder {
+	return m.recorder
+}
+
+// CountProvisionedUsers mocks base method.
+func (m *MockProvisioningReadQueries) CountProvisionedUsers(ctx context.Context, db sqlc.DBTX, arg sqlc.CountProvisionedUsersParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountProvisionedUsers", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountProvisionedUsers indicates an expected call of CountProvisionedUsers.
+func (mr *MockProvisioningReadQueriesMockRecorder) CountProvisionedUsers(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountProvisionedUsers", reflect.TypeOf((*MockProvisioningReadQueries)(nil).CountProvisionedUsers), ctx, db, arg)
+}
+
+// FindProvisioningTokenCompany mocks base method.
+func (m *MockProvisioningReadQueries) FindProvisioningTokenCompany(ctx context.Context, db sqlc.DBTX, tokenHash []byte) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindProvisioningTokenCompany", ctx, db, tokenHash)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindProvisioningTokenCompany indicates an expected call of FindProvisioningTokenCompany.
+func (mr *MockProvisioningReadQueriesMockRecorder) FindProvisioningTokenCompany(ctx, db, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindProvisioningTokenCompany", reflect.TypeOf((*MockProvisioningReadQueries)(nil).FindProvisioningTokenCompany), ctx, db, tokenHash)
+}
+
+// GetProvisionedUser mocks base method.
+func (m *MockProvisioningReadQueries) GetProvisionedUser(ctx context.Context, db sqlc.DBTX, arg sqlc.GetProvisionedUserParams) (sqlc.GetProvisionedUserRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProvisionedUser", ctx, db, arg)
+	ret0, _ := ret[0].(sqlc.GetProvisionedUserRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProvisionedUser indicates an expected call of GetProvisionedUser.
+func (mr *MockProvisioningReadQueriesMockRecorder) GetProvisionedUser(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProvisionedUser", reflect.TypeOf((*MockProvisioningReadQueries)(nil).GetProvisionedUser), ctx, db, arg)
+}
+
+// ListProvisionedUsers mocks base method.
+func (m *MockProvisioningReadQueries) ListProvisionedUsers(ctx context.Context, db sqlc.DBTX, arg sqlc.ListProvisionedUsersParams) ([]sqlc.ListProvisionedUsersRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProvisionedUsers", ctx, db, arg)
+	ret0, _ := ret[0].([]sqlc.ListProvisionedUsersRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProvisionedUsers indicates an expected call of ListProvisionedUsers.
+func (mr *MockProvisioningReadQueriesMockRecorder) ListProvisionedUsers(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProvisionedUsers", reflect.TypeOf((*MockProvisioningReadQueries)(nil).ListProvisionedUsers), ctx, db, arg)
+}
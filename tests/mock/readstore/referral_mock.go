@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/readstore/referral.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/readstore/referral.go -destination=tests/mock/readstore/referral_mock.go -package=readstoremock
+//
+
+// Package readstoremock is a generated GoMock package.
+package readstoremock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReferralReadQueries is a mock of ReferralReadQueries interface.
+type MockReferralReadQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockReferralReadQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockReferralReadQueriesMockRecorder is the mock recorder for MockReferralReadQueries.
+type MockReferralReadQueriesMockRecorder struct {
+	mock *MockReferralReadQueries
+}
+
+// NewMockReferralReadQueries creates a new mock instance.
+func NewMockReferralReadQueries(ctrl *gomock.Controller) *MockReferralReadQueries {
+	mock := &MockReferralReadQueries{ctrl: ctrl}
+	mock.recorder = &MockReferralReadQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReferralReadQueries) EXPECT() *MockReferralReadQueriesMockRecorder {
+	return m.recorder
+}
+
+// FindReferrerByCode mocks base method.
+func (m *MockReferralReadQueries) FindReferrerByCode(ctx context.Context, db sqlc.DBTX, referralCode string) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindReferrerByCode", ctx, db, referralCode)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindReferrerByCode indicates an expected call of FindReferrerByCode.
+func (mr *MockReferralReadQueriesMockRecorder) FindReferrerByCode(ctx, db, referralCode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReferrerByCode", reflect.TypeOf((*MockReferralReadQueries)(nil).FindReferrerByCode), ctx, db, referralCode)
+}
+
+// GetReferralSummary mocks base method.
+func (m *MockReferralReadQueries) GetReferralSummary(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (sqlc.GetReferralSummaryRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReferralSummary", ctx, db, id)
+	ret0, _ := ret[0].(sqlc.GetReferralSummaryRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReferralSummary indicates an expected call of GetReferralSummary.
+func (mr *MockReferralReadQueriesMockRecorder) GetReferralSummary(ctx, db, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReferralSummary", reflect.TypeOf((*MockReferralReadQueries)(nil).GetReferralSummary), ctx, db, id)
+}
+
+// ListReferralsByReferrer mocks base method.
+func (m *MockReferralReadQueries) ListReferralsByReferrer(ctx context.Context, db sqlc.DBTX, referrerID uuid.UUID) ([]sqlc.ListReferralsByReferrerRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReferralsByReferrer", ctx, db, referrerID)
+	ret0, _ := ret[0].([]sqlc.ListReferralsByReferrerRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReferralsByReferrer indicates an expected call of ListReferralsByReferrer.
+func (mr *MockReferralReadQueriesMockRecorder) ListReferralsByReferrer(ctx, db, referrerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReferralsByReferrer", reflect.TypeOf((*MockReferralReadQueries)(nil).ListReferralsByReferrer), ctx, db, referrerID)
+}
+
+// ListRewardableReferrals mocks base method.
+func (m *MockReferralReadQueries) ListRewardableReferrals(ctx context.Context, db sqlc.DBTX, arg sqlc.ListRewardableReferralsParams) ([]sqlc.ListRewardableReferralsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRewardableReferrals", ctx, db, arg)
+	ret0, _ := ret[0].([]sqlc.ListRewardableReferralsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRewardableReferrals indicates an expected call of ListRewardableReferrals.
+func (mr *MockReferralReadQueriesMockRecorder) ListRewardableReferrals(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRewardableReferrals", reflect.TypeOf((*MockReferralReadQueries)(nil).ListRewardableReferrals), ctx, db, arg)
+}
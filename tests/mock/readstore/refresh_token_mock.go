@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/readstore/refresh_token.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/readstore/refresh_token.go -destination=tests/mock/readstore/refresh_token_mock.go -package=readstoremock
+//
+
+// Package readstoremock is a generated GoMock package.
+package readstoremock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRefreshTokenReadQueries is a mock of RefreshTokenReadQueries interface.
+type MockRefreshTokenReadQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockRefreshTokenReadQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockRefreshTokenReadQueriesMockRecorder is the mock recorder for MockRefreshTokenReadQueries.
+type MockRefreshTokenReadQueriesMockRecorder struct {
+	mock *MockRefreshTokenReadQueries
+}
+
+// NewMockRefreshTokenReadQueries creates a new mock instance.
+func NewMockRefreshTokenReadQueries(ctrl *gomock.Controller) *MockRefreshTokenReadQueries {
+	mock := &MockRefreshTokenReadQueries{ctrl: ctrl}
+	mock.recorder = &MockRefreshTokenReadQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRefreshTokenReadQueries) EXPECT() *MockRefreshTokenReadQueriesMockRecorder {
+	return m.recorder
+}
+
+// IsRefreshTokenSessionRevoked mocks base method.
+func (m *MockRefreshTokenReadQueries) IsRefreshTokenSessionRevoked(ctx context.Context, db sqlc.DBTX, sessionID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsRefreshTokenSessionRevoked", ctx, db, sessionID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsRefreshTokenSessionRevoked indicates an expected call of IsRefreshTokenSessionRevoked.
+func (mr *MockRefreshTokenReadQueriesMockRecorder) IsRefreshTokenSessionRevoked(ctx, db, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsRefreshTokenSessionRevoked", reflect.TypeOf((*MockRefreshTokenReadQueries)(nil).IsRefreshTokenSessionRevoked), ctx, db, sessionID)
+}
+
+// IsSessionlessTokenRevoked mocks base method.
+func (m *MockRefreshTokenReadQueries) IsSessionlessTokenRevoked(ctx context.Context, db sqlc.DBTX, arg sqlc.IsSessionlessTokenRevokedParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsSessionlessTokenRevoked", ctx, db, arg)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsSessionlessTokenRevoked indicates an expected call of IsSessionlessTokenRevoked.
+func (mr *MockRefreshTokenReadQueriesMockRecorder) IsSessionlessTokenRevoked(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSessionlessTokenRevoked", reflect.TypeOf((*MockRefreshTokenReadQueries)(nil).IsSessionlessTokenRevoked), ctx, db, arg)
+}
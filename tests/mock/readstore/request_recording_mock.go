@@ -0,0 +1,119 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/readstore/request_recording.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/readstore/request_recording.go -destination=tests/mock/readstore/request_recording_mock.go -package=readstoremock
+//
+
+// Package readstoremock is a generated GoMock package.
+package readstoremock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	pgtype "github.com/jackc/pgx/v5/pgtype"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRequestRecordingReadQueries is a mock of RequestRecordingReadQueries interface.
+type MockRequestRecordingReadQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockRequestRecordingReadQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockRequestRecordingReadQueriesMockRecorder is the mock recorder for MockRequestRecordingReadQueries.
+type MockRequestRecordingReadQueriesMockRecorder struct {
+	mock *MockRequestRecordingReadQueries
+}
+
+// NewMockRequestRecordingReadQueries creates a new mock instance.
+func NewMockRequestRecordingReadQueries(ctrl *gomock.Controller) *MockRequestRecordingReadQueries {
+	mock := &MockRequestRecordingReadQueries{ctrl: ctrl}
+	mock.recorder = &MockRequestRecordingReadQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRequestRecordingReadQueries) EXPECT() *MockRequestRecordingReadQueriesMockRecorder {
+	return m.recorder
+}
+
+// GetRecordedRequest mocks base method.
+func (m *MockRequestRecordingReadQueries) GetRecordedRequest(ctx context.Context, db sqlc.DBTX, arg sqlc.GetRecordedRequestParams) (sqlc.RecordedRequests, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecordedRequest", ctx, db, arg)
+	ret0, _ := ret[0].(sqlc.RecordedRequests)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecordedRequest indicates an expected call of GetRecordedRequest.
+func (mr *MockRequestRecordingReadQueriesMockRecorder) GetRecordedRequest(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecordedRequest", reflect.TypeOf((*MockRequestRecordingReadQueries)(nil).GetRecordedRequest), ctx, db, arg)
+}
+
+// GetRequestRecording mocks base method.
+func (m *MockRequestRecordingReadQueries) GetRequestRecording(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (sqlc.GetRequestRecordingRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRequestRecording", ctx, db, id)
+	ret0, _ := ret[0].(sqlc.GetRequestRecordingRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRequestRecording indicates an expected call of GetRequestRecording.
+func (mr *MockRequestRecordingReadQueriesMockRecorder) GetRequestRecording(ctx, db, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequestRecording", reflect.TypeOf((*MockRequestRecordingReadQueries)(nil).GetRequestRecording), ctx, db, id)
+}
+
+// ListActiveRequestRecordingUsers mocks base method.
+func (m *MockRequestRecordingReadQueries) ListActiveRequestRecordingUsers(ctx context.Context, db sqlc.DBTX, now pgtype.Timestamptz) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveRequestRecordingUsers", ctx, db, now)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveRequestRecordingUsers indicates an expected call of ListActiveRequestRecordingUsers.
+func (mr *MockRequestRecordingReadQueriesMockRecorder) ListActiveRequestRecordingUsers(ctx, db, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveRequestRecordingUsers", reflect.TypeOf((*MockRequestRecordingReadQueries)(nil).ListActiveRequestRecordingUsers), ctx, db, now)
+}
+
+// ListExpiredRequestRecordings mocks base method.
+func (m *MockRequestRecordingReadQueries) ListExpiredRequestRecordings(ctx context.Context, db sqlc.DBTX, arg sqlc.ListExpiredRequestRecordingsParams) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExpiredRequestRecordings", ctx, db, arg)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExpiredRequestRecordings indicates an expected call of ListExpiredRequestRecordings.
+func (mr *MockRequestRecordingReadQueriesMockRecorder) ListExpiredRequestRecordings(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpiredRequestRecordings", reflect.TypeOf((*MockRequestRecordingReadQueries)(nil).ListExpiredRequestRecordings), ctx, db, arg)
+}
+
+// ListRecordedRequests mocks base method.
+func (m *MockRequestRecordingReadQueries) ListRecordedRequests(ctx context.Context, db sqlc.DBTX, recordingID uuid.UUID) ([]sqlc.RecordedRequests, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRecordedRequests", ctx, db, recordingID)
+	ret0, _ := ret[0].([]sqlc.RecordedRequests)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRecordedRequests indicates an expected call of ListRecordedRequests.
+func (mr *MockRequestRecordingReadQueriesMockRecorder) ListRecordedRequests(ctx, db, recordingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecordedRequests", reflect.TypeOf((*MockRequestRecordingReadQueries)(nil).ListRecordedRequests), ctx, db, recordingID)
+}
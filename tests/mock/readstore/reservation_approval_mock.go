@@ -0,0 +1,133 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/readstore/reservation_approval.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/readstore/reservation_approval.go -destination=tests/mock/readstore/reservation_approval_mock.go -package=readstoremock
+//
+
+// Package readstoremock is a generated GoMock package.
+package readstoremock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReservationApprovalReadQueries is a mock of ReservationApprovalReadQueries interface.
+type MockReservationApprovalReadQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockReservationApprovalReadQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockReservationApprovalReadQueriesMockRecorder is the mock recorder for MockReservationApprovalReadQueries.
+type MockReservationApprovalReadQueriesMockRecorder struct {
+	mock *MockReservationApprovalReadQueries
+}
+
+// NewMockReservationApprovalReadQueries creates a new mock instance.
+func NewMockReservationApprovalReadQueries(ctrl *gomock.Controller) *MockReservationApprovalReadQueries {
+	mock := &MockReservationApprovalReadQueries{ctrl: ctrl}
+	mock.recorder = &MockReservationApprovalReadQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReservationApprovalReadQueries) EXPECT() *MockReservationApprovalReadQueriesMockRecorder {
+	return m.recorder
+}
+
+// GetReservationApproval mocks base method.
+func (m *MockReservationApprovalReadQueries) GetReservationApproval(ctx context.Context, db sqlc.DBTX, reservationID uuid.UUID) (sqlc.GetReservationApprovalRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReservationApproval", ctx, db, reservationID)
+	ret0, _ := ret[0].(sqlc.GetReservationApprovalRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReservationApproval indicates an expected call of GetReservationApproval.
+func (mr *MockReservationApprovalReadQueriesMockRecorder) GetReservationApproval(ctx, db, reservationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReservationApproval", reflect.TypeOf((*MockReservationApprovalReadQueries)(nil).GetReservationApproval), ctx, db, reservationID)
+}
+
+// IsResourceApprover mocks base method.
+func (m *MockReservationApprovalReadQueries) IsResourceApprover(ctx context.Context, db sqlc.DBTX, arg sqlc.IsResourceApproverParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsResourceApprover", ctx, db, arg)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsResourceApprover indicates an expected call of IsResourceApprover.
+func (mr *MockReservationApprovalReadQueriesMockRecorder) IsResourceApprover(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsResourceApprover", reflect.TypeOf((*MockReservationApprovalReadQueries)(nil).IsResourceApprover), ctx, db, arg)
+}
+
+// ListPendingReservationApprovals mocks base method.
+func (m *MockReservationApprovalReadQueries) ListPendingReservationApprovals(ctx context.Context, db sqlc.DBTX, arg sqlc.ListPendingReservationApprovalsParams) ([]sqlc.ListPendingReservationApprovalsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingReservationApprovals", ctx, db, arg)
+	ret0, _ := ret[0].([]sqlc.ListPendingReservationApprovalsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingReservationApprovals indicates an expected call of ListPendingReservationApprovals.
+func (mr *MockReservationApprovalReadQueriesMockRecorder) ListPendingReservationApprovals(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingReservationApprovals", reflect.TypeOf((*MockReservationApprovalReadQueries)(nil).ListPendingReservationApprovals), ctx, db, arg)
+}
+
+// ListReservationApprovalsToEscalate mocks base method.
+func (m *MockReservationApprovalReadQueries) ListReservationApprovalsToEscalate(ctx context.Context, db sqlc.DBTX, arg sqlc.ListReservationApprovalsToEscalateParams) ([]sqlc.ListReservationApprovalsToEscalateRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReservationApprovalsToEscalate", ctx, db, arg)
+	ret0, _ := ret[0].([]sqlc.ListReservationApprovalsToEscalateRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReservationApprovalsToEscalate indicates an expected call of ListReservationApprovalsToEscalate.
+func (mr *MockReservationApprovalReadQueriesMockRecorder) ListReservationApprovalsToEscalate(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReservationApprovalsToEscalate", reflect.TypeOf((*MockReservationApprovalReadQueries)(nil).ListReservationApprovalsToEscalate), ctx, db, arg)
+}
+
+// ListResourceApproverIDs mocks base method.
+func (m *MockReservationApprovalReadQueries) ListResourceApproverIDs(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResourceApproverIDs", ctx, db, resourceID)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResourceApproverIDs indicates an expected call of ListResourceApproverIDs.
+func (mr *MockReservationApprovalReadQueriesMockRecorder) ListResourceApproverIDs(ctx, db, resourceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceApproverIDs", reflect.TypeOf((*MockReservationApprovalReadQueries)(nil).ListResourceApproverIDs), ctx, db, resourceID)
+}
+
+// ListResourceApprovers mocks base method.
+func (m *MockReservationApprovalReadQueries) ListResourceApprovers(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID) ([]sqlc.ListResourceApproversRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResourceApprovers", ctx, db, resourceID)
+	ret0, _ := ret[0].([]sqlc.ListResourceApproversRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResourceApprovers indicates an expected call of ListResourceApprovers.
+func (mr *MockReservationApprovalReadQueriesMockRecorder) ListResourceApprovers(ctx, db, resourceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceApprovers", reflect.TypeOf((*MockReservationApprovalReadQueries)(nil).ListResourceApprovers), ctx, db, resourceID)
+}
@@ -15,6 +15,7 @@ import (
 	reflect "reflect"
 
 	uuid "github.com/google/uuid"
+	pgtype "github.com/jackc/pgx/v5/pgtype"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -57,6 +58,51 @@ func (mr *MockReservationViewQueriesMockRecorder) GetReservationByID(ctx, db, id
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReservationByID", reflect.TypeOf((*MockReservationViewQueries)(nil).GetReservationByID), ctx, db, id)
 }
 
+// GetReservationDiscounts mocks base method.
+func (m *MockReservationViewQueries) GetReservationDiscounts(ctx context.Context, db sqlc.DBTX, reservationID uuid.UUID) ([]sqlc.GetReservationDiscountsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReservationDiscounts", ctx, db, reservationID)
+	ret0, _ := ret[0].([]sqlc.GetReservationDiscountsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReservationDiscounts indicates an expected call of GetReservationDiscounts.
+func (mr *MockReservationViewQueriesMockRecorder) GetReservationDiscounts(ctx, db, reservationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReservationDiscounts", reflect.TypeOf((*MockReservationViewQueries)(nil).GetReservationDiscounts), ctx, db, reservationID)
+}
+
+// GetReservationGroupID mocks base method.
+func (m *MockReservationViewQueries) GetReservationGroupID(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (pgtype.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReservationGroupID", ctx, db, id)
+	ret0, _ := ret[0].(pgtype.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReservationGroupID indicates an expected call of GetReservationGroupID.
+func (mr *MockReservationViewQueriesMockRecorder) GetReservationGroupID(ctx, db, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReservationGroupID", reflect.TypeOf((*MockReservationViewQueries)(nil).GetReservationGroupID), ctx, db, id)
+}
+
+// GetReservationIDsByGroup mocks base method.
+func (m *MockReservationViewQueries) GetReservationIDsByGroup(ctx context.Context, db sqlc.DBTX, arg sqlc.GetReservationIDsByGroupParams) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReservationIDsByGroup", ctx, db, arg)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReservationIDsByGroup indicates an expected call of GetReservationIDsByGroup.
+func (mr *MockReservationViewQueriesMockRecorder) GetReservationIDsByGroup(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReservationIDsByGroup", reflect.TypeOf((*MockReservationViewQueries)(nil).GetReservationIDsByGroup), ctx, db, arg)
+}
+
 // GetReservationsByUserIDFirstPage mocks base method.
 func (m *MockReservationViewQueries) GetReservationsByUserIDFirstPage(ctx context.Context, db sqlc.DBTX, arg sqlc.GetReservationsByUserIDFirstPageParams) ([]sqlc.GetReservationsByUserIDFirstPageRow, error) {
 	m.ctrl.T.Helper()
@@ -86,3 +132,48 @@ func (mr *MockReservationViewQueriesMockRecorder) GetReservationsByUserIDKeyset(
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReservationsByUserIDKeyset", reflect.TypeOf((*MockReservationViewQueries)(nil).GetReservationsByUserIDKeyset), ctx, db, arg)
 }
+
+// GetReservationsForAdminFirstPage mocks base method.
+func (m *MockReservationViewQueries) GetReservationsForAdminFirstPage(ctx context.Context, db sqlc.DBTX, arg sqlc.GetReservationsForAdminFirstPageParams) ([]sqlc.GetReservationsForAdminFirstPageRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReservationsForAdminFirstPage", ctx, db, arg)
+	ret0, _ := ret[0].([]sqlc.GetReservationsForAdminFirstPageRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReservationsForAdminFirstPage indicates an expected call of GetReservationsForAdminFirstPage.
+func (mr *MockReservationViewQueriesMockRecorder) GetReservationsForAdminFirstPage(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReservationsForAdminFirstPage", reflect.TypeOf((*MockReservationViewQueries)(nil).GetReservationsForAdminFirstPage), ctx, db, arg)
+}
+
+// GetReservationsForAdminKeyset mocks base method.
+func (m *MockReservationViewQueries) GetReservationsForAdminKeyset(ctx context.Context, db sqlc.DBTX, arg sqlc.GetReservationsForAdminKeysetParams) ([]sqlc.GetReservationsForAdminKeysetRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReservationsForAdminKeyset", ctx, db, arg)
+	ret0, _ := ret[0].([]sqlc.GetReservationsForAdminKeysetRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReservationsForAdminKeyset indicates an expected call of GetReservationsForAdminKeyset.
+func (mr *MockReservationViewQueriesMockRecorder) GetReservationsForAdminKeyset(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReservationsForAdminKeyset", reflect.TypeOf((*MockReservationViewQueries)(nil).GetReservationsForAdminKeyset), ctx, db, arg)
+}
+
+// ListCancelableReservationsInRange mocks base method.
+func (m *MockReservationViewQueries) ListCancelableReservationsInRange(ctx context.Context, db sqlc.DBTX, arg sqlc.ListCancelableReservationsInRangeParams) ([]sqlc.ListCancelableReservationsInRangeRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCancelableReservationsInRange", ctx, db, arg)
+	ret0, _ := ret[0].([]sqlc.ListCancelableReservationsInRangeRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCancelableReservationsInRange indicates an expected call of ListCancelableReservationsInRange.
+func (mr *MockReservationViewQueriesMockRecorder) ListCancelableReservationsInRange(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCancelableReservationsInRange", reflect.TypeOf((*MockReservationViewQueries)(nil).ListCancelableReservationsInRange), ctx, db, arg)
+}
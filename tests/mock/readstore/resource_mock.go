@@ -59,6 +59,21 @@ func (mr *MockResourceReadQueriesMockRecorder) GetAllResources(ctx, db any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllResources", reflect.TypeOf((*MockResourceReadQueries)(nil).GetAllResources), ctx, db)
 }
 
+// GetPublicResourceBySlug mocks base method.
+func (m *MockResourceReadQueries) GetPublicResourceBySlug(ctx context.Context, db sqlc.DBTX, slug string) (sqlc.GetPublicResourceBySlugRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublicResourceBySlug", ctx, db, slug)
+	ret0, _ := ret[0].(sqlc.GetPublicResourceBySlugRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPublicResourceBySlug indicates an expected call of GetPublicResourceBySlug.
+func (mr *MockResourceReadQueriesMockRecorder) GetPublicResourceBySlug(ctx, db, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicResourceBySlug", reflect.TypeOf((*MockResourceReadQueries)(nil).GetPublicResourceBySlug), ctx, db, slug)
+}
+
 // GetResourceByID mocks base method.
 func (m *MockResourceReadQueries) GetResourceByID(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (sqlc.Resources, error) {
 	m.ctrl.T.Helper()
@@ -74,6 +89,21 @@ func (mr *MockResourceReadQueriesMockRecorder) GetResourceByID(ctx, db, id any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceByID", reflect.TypeOf((*MockResourceReadQueries)(nil).GetResourceByID), ctx, db, id)
 }
 
+// ListPublicResourceSlugs mocks base method.
+func (m *MockResourceReadQueries) ListPublicResourceSlugs(ctx context.Context, db sqlc.DBTX, maxUrls int32) ([]sqlc.ListPublicResourceSlugsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPublicResourceSlugs", ctx, db, maxUrls)
+	ret0, _ := ret[0].([]sqlc.ListPublicResourceSlugsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPublicResourceSlugs indicates an expected call of ListPublicResourceSlugs.
+func (mr *MockResourceReadQueriesMockRecorder) ListPublicResourceSlugs(ctx, db, maxUrls any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPublicResourceSlugs", reflect.TypeOf((*MockResourceReadQueries)(nil).ListPublicResourceSlugs), ctx, db, maxUrls)
+}
+
 // SearchResourcesByName mocks base method.
 func (m *MockResourceReadQueries) SearchResourcesByName(ctx context.Context, db sqlc.DBTX, name pgtype.Text) ([]sqlc.Resources, error) {
 	m.ctrl.T.Helper()
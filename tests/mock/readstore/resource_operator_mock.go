@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/readstore/resource_operator.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/readstore/resource_operator.go -destination=tests/mock/readstore/resource_operator_mock.go -package=readstoremock
+//
+
+// Package readstoremock is a generated GoMock package.
+package readstoremock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockResourceOperatorReadQueries is a mock of ResourceOperatorReadQueries interface.
+type MockResourceOperatorReadQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockResourceOperatorReadQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockResourceOperatorReadQueriesMockRecorder is the mock recorder for MockResourceOperatorReadQueries.
+type MockResourceOperatorReadQueriesMockRecorder struct {
+	mock *MockResourceOperatorReadQueries
+}
+
+// NewMockResourceOperatorReadQueries creates a new mock instance.
+func NewMockResourceOperatorReadQueries(ctrl *gomock.Controller) *MockResourceOperatorReadQueries {
+	mock := &MockResourceOperatorReadQueries{ctrl: ctrl}
+	mock.recorder = &MockResourceOperatorReadQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResourceOperatorReadQueries) EXPECT() *MockResourceOperatorReadQueriesMockRecorder {
+	return m.recorder
+}
+
+// IsResourceOperator mocks base method.
+func (m *MockResourceOperatorReadQueries) IsResourceOperator(ctx context.Context, db sqlc.DBTX, arg sqlc.IsResourceOperatorParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsResourceOperator", ctx, db, arg)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsResourceOperator indicates an expected call of IsResourceOperator.
+func (mr *MockResourceOperatorReadQueriesMockRecorder) IsResourceOperator(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsResourceOperator", reflect.TypeOf((*MockResourceOperatorReadQueries)(nil).IsResourceOperator), ctx, db, arg)
+}
+
+// ListResourceOperators mocks base method.
+func (m *MockResourceOperatorReadQueries) ListResourceOperators(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID) ([]sqlc.ListResourceOperatorsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResourceOperators", ctx, db, resourceID)
+	ret0, _ := ret[0].([]sqlc.ListResourceOperatorsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResourceOperators indicates an expected call of ListResourceOperators.
+func (mr *MockResourceOperatorReadQueriesMockRecorder) ListResourceOperators(ctx, db, resourceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceOperators", reflect.TypeOf((*MockResourceOperatorReadQueries)(nil).ListResourceOperators), ctx, db, resourceID)
+}
@@ -42,6 +42,51 @@ func (m *MockReviewReadQueries) EXPECT() *MockReviewReadQueriesMockRecorder {
 	return m.recorder
 }
 
+// CountReviewsByLanguage mocks base method.
+func (m *MockReviewReadQueries) CountReviewsByLanguage(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID) ([]sqlc.CountReviewsByLanguageRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountReviewsByLanguage", ctx, db, resourceID)
+	ret0, _ := ret[0].([]sqlc.CountReviewsByLanguageRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountReviewsByLanguage indicates an expected call of CountReviewsByLanguage.
+func (mr *MockReviewReadQueriesMockRecorder) CountReviewsByLanguage(ctx, db, resourceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountReviewsByLanguage", reflect.TypeOf((*MockReviewReadQueries)(nil).CountReviewsByLanguage), ctx, db, resourceID)
+}
+
+// FindReviewImportAuthor mocks base method.
+func (m *MockReviewReadQueries) FindReviewImportAuthor(ctx context.Context, db sqlc.DBTX, arg sqlc.FindReviewImportAuthorParams) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindReviewImportAuthor", ctx, db, arg)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindReviewImportAuthor indicates an expected call of FindReviewImportAuthor.
+func (mr *MockReviewReadQueriesMockRecorder) FindReviewImportAuthor(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReviewImportAuthor", reflect.TypeOf((*MockReviewReadQueries)(nil).FindReviewImportAuthor), ctx, db, arg)
+}
+
+// FindSimilarRecentReview mocks base method.
+func (m *MockReviewReadQueries) FindSimilarRecentReview(ctx context.Context, db sqlc.DBTX, arg sqlc.FindSimilarRecentReviewParams) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSimilarRecentReview", ctx, db, arg)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSimilarRecentReview indicates an expected call of FindSimilarRecentReview.
+func (mr *MockReviewReadQueriesMockRecorder) FindSimilarRecentReview(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSimilarRecentReview", reflect.TypeOf((*MockReviewReadQueries)(nil).FindSimilarRecentReview), ctx, db, arg)
+}
+
 // GetResourceRatingStats mocks base method.
 func (m *MockReviewReadQueries) GetResourceRatingStats(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID) (sqlc.ResourceRatingStats, error) {
 	m.ctrl.T.Helper()
@@ -57,6 +102,21 @@ func (mr *MockReviewReadQueriesMockRecorder) GetResourceRatingStats(ctx, db, res
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceRatingStats", reflect.TypeOf((*MockReviewReadQueries)(nil).GetResourceRatingStats), ctx, db, resourceID)
 }
 
+// GetResourceReviewWindow mocks base method.
+func (m *MockReviewReadQueries) GetResourceReviewWindow(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (sqlc.GetResourceReviewWindowRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResourceReviewWindow", ctx, db, id)
+	ret0, _ := ret[0].(sqlc.GetResourceReviewWindowRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResourceReviewWindow indicates an expected call of GetResourceReviewWindow.
+func (mr *MockReviewReadQueriesMockRecorder) GetResourceReviewWindow(ctx, db, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceReviewWindow", reflect.TypeOf((*MockReviewReadQueries)(nil).GetResourceReviewWindow), ctx, db, id)
+}
+
 // GetReviewViewByID mocks base method.
 func (m *MockReviewReadQueries) GetReviewViewByID(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (sqlc.GetReviewViewByIDRow, error) {
 	m.ctrl.T.Helper()
@@ -131,3 +191,78 @@ func (mr *MockReviewReadQueriesMockRecorder) GetReviewsByUserKeyset(ctx, db, arg
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewsByUserKeyset", reflect.TypeOf((*MockReviewReadQueries)(nil).GetReviewsByUserKeyset), ctx, db, arg)
 }
+
+// ListFlaggedReviewsByResource mocks base method.
+func (m *MockReviewReadQueries) ListFlaggedReviewsByResource(ctx context.Context, db sqlc.DBTX, arg sqlc.ListFlaggedReviewsByResourceParams) ([]sqlc.ListFlaggedReviewsByResourceRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFlaggedReviewsByResource", ctx, db, arg)
+	ret0, _ := ret[0].([]sqlc.ListFlaggedReviewsByResourceRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFlaggedReviewsByResource indicates an expected call of ListFlaggedReviewsByResource.
+func (mr *MockReviewReadQueriesMockRecorder) ListFlaggedReviewsByResource(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFlaggedReviewsByResource", reflect.TypeOf((*MockReviewReadQueries)(nil).ListFlaggedReviewsByResource), ctx, db, arg)
+}
+
+// ListRecentPublishedReviews mocks base method.
+func (m *MockReviewReadQueries) ListRecentPublishedReviews(ctx context.Context, db sqlc.DBTX, arg sqlc.ListRecentPublishedReviewsParams) ([]sqlc.ListRecentPublishedReviewsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRecentPublishedReviews", ctx, db, arg)
+	ret0, _ := ret[0].([]sqlc.ListRecentPublishedReviewsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRecentPublishedReviews indicates an expected call of ListRecentPublishedReviews.
+func (mr *MockReviewReadQueriesMockRecorder) ListRecentPublishedReviews(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecentPublishedReviews", reflect.TypeOf((*MockReviewReadQueries)(nil).ListRecentPublishedReviews), ctx, db, arg)
+}
+
+// ListRecentReviewsForSummary mocks base method.
+func (m *MockReviewReadQueries) ListRecentReviewsForSummary(ctx context.Context, db sqlc.DBTX, arg sqlc.ListRecentReviewsForSummaryParams) ([]sqlc.ListRecentReviewsForSummaryRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRecentReviewsForSummary", ctx, db, arg)
+	ret0, _ := ret[0].([]sqlc.ListRecentReviewsForSummaryRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRecentReviewsForSummary indicates an expected call of ListRecentReviewsForSummary.
+func (mr *MockReviewReadQueriesMockRecorder) ListRecentReviewsForSummary(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecentReviewsForSummary", reflect.TypeOf((*MockReviewReadQueries)(nil).ListRecentReviewsForSummary), ctx, db, arg)
+}
+
+// ListReviewsForExport mocks base method.
+func (m *MockReviewReadQueries) ListReviewsForExport(ctx context.Context, db sqlc.DBTX, arg sqlc.ListReviewsForExportParams) ([]sqlc.ListReviewsForExportRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReviewsForExport", ctx, db, arg)
+	ret0, _ := ret[0].([]sqlc.ListReviewsForExportRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReviewsForExport indicates an expected call of ListReviewsForExport.
+func (mr *MockReviewReadQueriesMockRecorder) ListReviewsForExport(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReviewsForExport", reflect.TypeOf((*MockReviewReadQueries)(nil).ListReviewsForExport), ctx, db, arg)
+}
+
+// ListStaleReviewSummaries mocks base method.
+func (m *MockReviewReadQueries) ListStaleReviewSummaries(ctx context.Context, db sqlc.DBTX, limit int32) ([]sqlc.ListStaleReviewSummariesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStaleReviewSummaries", ctx, db, limit)
+	ret0, _ := ret[0].([]sqlc.ListStaleReviewSummariesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStaleReviewSummaries indicates an expected call of ListStaleReviewSummaries.
+func (mr *MockReviewReadQueriesMockRecorder) ListStaleReviewSummaries(ctx, db, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStaleReviewSummaries", reflect.TypeOf((*MockReviewReadQueries)(nil).ListStaleReviewSummaries), ctx, db, limit)
+}
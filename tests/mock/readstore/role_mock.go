@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/readstore/role.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/readstore/role.go -destination=tests/mock/readstore/role_mock.go -package=readstoremock
+//
+
+// Package readstoremock is a generated GoMock package.
+package readstoremock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRoleReadQueries is a mock of RoleReadQueries interface.
+type MockRoleReadQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoleReadQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockRoleReadQueriesMockRecorder is the mock recorder for MockRoleReadQueries.
+type MockRoleReadQueriesMockRecorder struct {
+	mock *MockRoleReadQueries
+}
+
+// NewMockRoleReadQueries creates a new mock instance.
+func NewMockRoleReadQueries(ctrl *gomock.Controller) *MockRoleReadQueries {
+	mock := &MockRoleReadQueries{ctrl: ctrl}
+	mock.recorder = &MockRoleReadQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoleReadQueries) EXPECT() *MockRoleReadQueriesMockRecorder {
+	return m.recorder
+}
+
+// GetRole mocks base method.
+func (m *MockRoleReadQueries) GetRole(ctx context.Context, db sqlc.DBTX, name string) (sqlc.Roles, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRole", ctx, db, name)
+	ret0, _ := ret[0].(sqlc.Roles)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRole indicates an expected call of GetRole.
+func (mr *MockRoleReadQueriesMockRecorder) GetRole(ctx, db, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRole", reflect.TypeOf((*MockRoleReadQueries)(nil).GetRole), ctx, db, name)
+}
+
+// ListPermissionNamesByRole mocks base method.
+func (m *MockRoleReadQueries) ListPermissionNamesByRole(ctx context.Context, db sqlc.DBTX, roleName string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPermissionNamesByRole", ctx, db, roleName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPermissionNamesByRole indicates an expected call of ListPermissionNamesByRole.
+func (mr *MockRoleReadQueriesMockRecorder) ListPermissionNamesByRole(ctx, db, roleName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPermissionNamesByRole", reflect.TypeOf((*MockRoleReadQueries)(nil).ListPermissionNamesByRole), ctx, db, roleName)
+}
+
+// ListPermissions mocks base method.
+func (m *MockRoleReadQueries) ListPermissions(ctx context.Context, db sqlc.DBTX) ([]sqlc.Permissions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPermissions", ctx, db)
+	ret0, _ := ret[0].([]sqlc.Permissions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPermissions indicates an expected call of ListPermissions.
+func (mr *MockRoleReadQueriesMockRecorder) ListPermissions(ctx, db any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPermissions", reflect.TypeOf((*MockRoleReadQueries)(nil).ListPermissions), ctx, db)
+}
+
+// ListRolePermissions mocks base method.
+func (m *MockRoleReadQueries) ListRolePermissions(ctx context.Context, db sqlc.DBTX) ([]sqlc.RolePermissions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRolePermissions", ctx, db)
+	ret0, _ := ret[0].([]sqlc.RolePermissions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRolePermissions indicates an expected call of ListRolePermissions.
+func (mr *MockRoleReadQueriesMockRecorder) ListRolePermissions(ctx, db any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRolePermissions", reflect.TypeOf((*MockRoleReadQueries)(nil).ListRolePermissions), ctx, db)
+}
+
+// ListRoles mocks base method.
+func (m *MockRoleReadQueries) ListRoles(ctx context.Context, db sqlc.DBTX) ([]sqlc.Roles, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoles", ctx, db)
+	ret0, _ := ret[0].([]sqlc.Roles)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoles indicates an expected call of ListRoles.
+func (mr *MockRoleReadQueriesMockRecorder) ListRoles(ctx, db any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoles", reflect.TypeOf((*MockRoleReadQueries)(nil).ListRoles), ctx, db)
+}
@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/readstore/saml_connection.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/readstore/saml_connection.go -destination=tests/mock/readstore/saml_connection_mock.go -package=readstoremock
+//
+
+// Package readstoremock is a generated GoMock package.
+package readstoremock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSAMLConnectionReadQueries is a mock of SAMLConnectionReadQueries interface.
+type MockSAMLConnectionReadQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockSAMLConnectionReadQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockSAMLConnectionReadQueriesMockRecorder is the mock recorder for MockSAMLConnectionReadQueries.
+type MockSAMLConnectionReadQueriesMockRecorder struct {
+	mock *MockSAMLConnectionReadQueries
+}
+
+// NewMockSAMLConnectionReadQueries creates a new mock instance.
+func NewMockSAMLConnectionReadQueries(ctrl *gomock.Controller) *MockSAMLConnectionReadQueries {
+	mock := &MockSAMLConnectionReadQueries{ctrl: ctrl}
+	mock.recorder = &MockSAMLConnectionReadQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSAMLConnectionReadQueries) EXPECT() *MockSAMLConnectionReadQueriesMockRecorder {
+	return m.recorder
+}
+
+// GetSAMLConnection mocks base method.
+func (m *MockSAMLConnectionReadQueries) GetSAMLConnection(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID) (sqlc.GetSAMLConnectionRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSAMLConnection", ctx, db, companyID)
+	ret0, _ := ret[0].(sqlc.GetSAMLConnectionRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSAMLConnection indicates an expected call of GetSAMLConnection.
+func (mr *MockSAMLConnectionReadQueriesMockRecorder) GetSAMLConnection(ctx, db, companyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSAMLConnection", reflect.TypeOf((*MockSAMLConnectionReadQueries)(nil).GetSAMLConnection), ctx, db, companyID)
+}
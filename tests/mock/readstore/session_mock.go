@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/readstore/session.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/readstore/session.go -destination=tests/mock/readstore/session_mock.go -package=readstoremock
+//
+
+// Package readstoremock is a generated GoMock package.
+package readstoremock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSessionReadQueries is a mock of SessionReadQueries interface.
+type MockSessionReadQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionReadQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockSessionReadQueriesMockRecorder is the mock recorder for MockSessionReadQueries.
+type MockSessionReadQueriesMockRecorder struct {
+	mock *MockSessionReadQueries
+}
+
+// NewMockSessionReadQueries creates a new mock instance.
+func NewMockSessionReadQueries(ctrl *gomock.Controller) *MockSessionReadQueries {
+	mock := &MockSessionReadQueries{ctrl: ctrl}
+	mock.recorder = &MockSessionReadQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSessionReadQueries) EXPECT() *MockSessionReadQueriesMockRecorder {
+	return m.recorder
+}
+
+// ListUserSessions mocks base method.
+func (m *MockSessionReadQueries) ListUserSessions(ctx context.Context, db sqlc.DBTX, arg sqlc.ListUserSessionsParams) ([]sqlc.ListUserSessionsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserSessions", ctx, db, arg)
+	ret0, _ := ret[0].([]sqlc.ListUserSessionsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserSessions indicates an expected call of ListUserSessions.
+func (mr *MockSessionReadQueriesMockRecorder) ListUserSessions(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserSessions", reflect.TypeOf((*MockSessionReadQueries)(nil).ListUserSessions), ctx, db, arg)
+}
@@ -0,0 +1,70 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/repository/activity.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/repository/activity.go -destination=tests/mock/repository/activity_mock.go -package=repositorymock
+//
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockActivityWriteQueries is a mock of ActivityWriteQueries interface.
+type MockActivityWriteQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockActivityWriteQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockActivityWriteQueriesMockRecorder is the mock recorder for MockActivityWriteQueries.
+type MockActivityWriteQueriesMockRecorder struct {
+	mock *MockActivityWriteQueries
+}
+
+// NewMockActivityWriteQueries creates a new mock instance.
+func NewMockActivityWriteQueries(ctrl *gomock.Controller) *MockActivityWriteQueries {
+	mock := &MockActivityWriteQueries{ctrl: ctrl}
+	mock.recorder = &MockActivityWriteQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockActivityWriteQueries) EXPECT() *MockActivityWriteQueriesMockRecorder {
+	return m.recorder
+}
+
+// CreateUserActivity mocks base method.
+func (m *MockActivityWriteQueries) CreateUserActivity(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateUserActivityParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserActivity", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateUserActivity indicates an expected call of CreateUserActivity.
+func (mr *MockActivityWriteQueriesMockRecorder) CreateUserActivity(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserActivity", reflect.TypeOf((*MockActivityWriteQueries)(nil).CreateUserActivity), ctx, db, arg)
+}
+
+// DeleteUserActivityBySubject mocks base method.
+func (m *MockActivityWriteQueries) DeleteUserActivityBySubject(ctx context.Context, db sqlc.DBTX, arg sqlc.DeleteUserActivityBySubjectParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserActivityBySubject", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserActivityBySubject indicates an expected call of DeleteUserActivityBySubject.
+func (mr *MockActivityWriteQueriesMockRecorder) DeleteUserActivityBySubject(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserActivityBySubject", reflect.TypeOf((*MockActivityWriteQueries)(nil).DeleteUserActivityBySubject), ctx, db, arg)
+}
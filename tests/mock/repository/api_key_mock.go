@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/repository/api_key.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/repository/api_key.go -destination=tests/mock/repository/api_key_mock.go -package=repositorymock
+//
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAPIKeyWriteQueries is a mock of APIKeyWriteQueries interface.
+type MockAPIKeyWriteQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIKeyWriteQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockAPIKeyWriteQueriesMockRecorder is the mock recorder for MockAPIKeyWriteQueries.
+type MockAPIKeyWriteQueriesMockRecorder struct {
+	mock *MockAPIKeyWriteQueries
+}
+
+// NewMockAPIKeyWriteQueries creates a new mock instance.
+func NewMockAPIKeyWriteQueries(ctrl *gomock.Controller) *MockAPIKeyWriteQueries {
+	mock := &MockAPIKeyWriteQueries{ctrl: ctrl}
+	mock.recorder = &MockAPIKeyWriteQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPIKeyWriteQueries) EXPECT() *MockAPIKeyWriteQueriesMockRecorder {
+	return m.recorder
+}
+
+// CreateAPIKey mocks base method.
+func (m *MockAPIKeyWriteQueries) CreateAPIKey(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateAPIKeyParams) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIKey", ctx, db, arg)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey.
+func (mr *MockAPIKeyWriteQueriesMockRecorder) CreateAPIKey(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockAPIKeyWriteQueries)(nil).CreateAPIKey), ctx, db, arg)
+}
+
+// RevokeAPIKey mocks base method.
+func (m *MockAPIKeyWriteQueries) RevokeAPIKey(ctx context.Context, db sqlc.DBTX, arg sqlc.RevokeAPIKeyParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAPIKey", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAPIKey indicates an expected call of RevokeAPIKey.
+func (mr *MockAPIKeyWriteQueriesMockRecorder) RevokeAPIKey(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIKey", reflect.TypeOf((*MockAPIKeyWriteQueries)(nil).RevokeAPIKey), ctx, db, arg)
+}
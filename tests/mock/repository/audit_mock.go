@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/repository/audit.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/repository/audit.go -destination=tests/mock/repository/audit_mock.go -package=repositorymock
+//
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuditWriteQueries is a mock of AuditWriteQueries interface.
+type MockAuditWriteQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditWriteQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditWriteQueriesMockRecorder is the mock recorder for MockAuditWriteQueries.
+type MockAuditWriteQueriesMockRecorder struct {
+	mock *MockAuditWriteQueries
+}
+
+// NewMockAuditWriteQueries creates a new mock instance.
+func NewMockAuditWriteQueries(ctrl *gomock.Controller) *MockAuditWriteQueries {
+	mock := &MockAuditWriteQueries{ctrl: ctrl}
+	mock.recorder = &MockAuditWriteQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditWriteQueries) EXPECT() *MockAuditWriteQueriesMockRecorder {
+	return m.recorder
+}
+
+// CreateAuditLog mocks base method.
+func (m *MockAuditWriteQueries) CreateAuditLog(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateAuditLogParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAuditLog", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAuditLog indicates an expected call of CreateAuditLog.
+func (mr *MockAuditWriteQueriesMockRecorder) CreateAuditLog(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuditLog", reflect.TypeOf((*MockAuditWriteQueries)(nil).CreateAuditLog), ctx, db, arg)
+}
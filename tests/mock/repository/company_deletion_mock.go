@@ -0,0 +1,261 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/repository/company_deletion.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/repository/company_deletion.go -destination=tests/mock/repository/company_deletion_mock.go -package=repositorymock
+//
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCompanyDeletionWriteQueries is a mock of CompanyDeletionWriteQueries interface.
+type MockCompanyDeletionWriteQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockCompanyDeletionWriteQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockCompanyDeletionWriteQueriesMockRecorder is the mock recorder for MockCompanyDeletionWriteQueries.
+type MockCompanyDeletionWriteQueriesMockRecorder struct {
+	mock *MockCompanyDeletionWriteQueries
+}
+
+// NewMockCompanyDeletionWriteQueries creates a new mock instance.
+func NewMockCompanyDeletionWriteQueries(ctrl *gomock.Controller) *MockCompanyDeletionWriteQueries {
+	mock := &MockCompanyDeletionWriteQueries{ctrl: ctrl}
+	mock.recorder = &MockCompanyDeletionWriteQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCompanyDeletionWriteQueries) EXPECT() *MockCompanyDeletionWriteQueriesMockRecorder {
+	return m.recorder
+}
+
+// AnonymizeCompanyReviews mocks base method.
+func (m *MockCompanyDeletionWriteQueries) AnonymizeCompanyReviews(ctx context.Context, db sqlc.DBTX, arg sqlc.AnonymizeCompanyReviewsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnonymizeCompanyReviews", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AnonymizeCompanyReviews indicates an expected call of AnonymizeCompanyReviews.
+func (mr *MockCompanyDeletionWriteQueriesMockRecorder) AnonymizeCompanyReviews(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnonymizeCompanyReviews", reflect.TypeOf((*MockCompanyDeletionWriteQueries)(nil).AnonymizeCompanyReviews), ctx, db, arg)
+}
+
+// CancelCompanyReservations mocks base method.
+func (m *MockCompanyDeletionWriteQueries) CancelCompanyReservations(ctx context.Context, db sqlc.DBTX, arg sqlc.CancelCompanyReservationsParams) ([]sqlc.CancelCompanyReservationsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelCompanyReservations", ctx, db, arg)
+	ret0, _ := ret[0].([]sqlc.CancelCompanyReservationsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelCompanyReservations indicates an expected call of CancelCompanyReservations.
+func (mr *MockCompanyDeletionWriteQueriesMockRecorder) CancelCompanyReservations(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelCompanyReservations", reflect.TypeOf((*MockCompanyDeletionWriteQueries)(nil).CancelCompanyReservations), ctx, db, arg)
+}
+
+// CheckpointCompanyDeletion mocks base method.
+func (m *MockCompanyDeletionWriteQueries) CheckpointCompanyDeletion(ctx context.Context, db sqlc.DBTX, arg sqlc.CheckpointCompanyDeletionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckpointCompanyDeletion", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckpointCompanyDeletion indicates an expected call of CheckpointCompanyDeletion.
+func (mr *MockCompanyDeletionWriteQueriesMockRecorder) CheckpointCompanyDeletion(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckpointCompanyDeletion", reflect.TypeOf((*MockCompanyDeletionWriteQueries)(nil).CheckpointCompanyDeletion), ctx, db, arg)
+}
+
+// ClaimCompanyDeletion mocks base method.
+func (m *MockCompanyDeletionWriteQueries) ClaimCompanyDeletion(ctx context.Context, db sqlc.DBTX, arg sqlc.ClaimCompanyDeletionParams) (sqlc.ClaimCompanyDeletionRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimCompanyDeletion", ctx, db, arg)
+	ret0, _ := ret[0].(sqlc.ClaimCompanyDeletionRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimCompanyDeletion indicates an expected call of ClaimCompanyDeletion.
+func (mr *MockCompanyDeletionWriteQueriesMockRecorder) ClaimCompanyDeletion(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimCompanyDeletion", reflect.TypeOf((*MockCompanyDeletionWriteQueries)(nil).ClaimCompanyDeletion), ctx, db, arg)
+}
+
+// CompleteCompanyDeletion mocks base method.
+func (m *MockCompanyDeletionWriteQueries) CompleteCompanyDeletion(ctx context.Context, db sqlc.DBTX, arg sqlc.CompleteCompanyDeletionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteCompanyDeletion", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteCompanyDeletion indicates an expected call of CompleteCompanyDeletion.
+func (mr *MockCompanyDeletionWriteQueriesMockRecorder) CompleteCompanyDeletion(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteCompanyDeletion", reflect.TypeOf((*MockCompanyDeletionWriteQueries)(nil).CompleteCompanyDeletion), ctx, db, arg)
+}
+
+// CreateCompanyDeletion mocks base method.
+func (m *MockCompanyDeletionWriteQueries) CreateCompanyDeletion(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateCompanyDeletionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCompanyDeletion", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCompanyDeletion indicates an expected call of CreateCompanyDeletion.
+func (mr *MockCompanyDeletionWriteQueriesMockRecorder) CreateCompanyDeletion(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCompanyDeletion", reflect.TypeOf((*MockCompanyDeletionWriteQueries)(nil).CreateCompanyDeletion), ctx, db, arg)
+}
+
+// DeleteCompany mocks base method.
+func (m *MockCompanyDeletionWriteQueries) DeleteCompany(ctx context.Context, db sqlc.DBTX, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCompany", ctx, db, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCompany indicates an expected call of DeleteCompany.
+func (mr *MockCompanyDeletionWriteQueriesMockRecorder) DeleteCompany(ctx, db, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCompany", reflect.TypeOf((*MockCompanyDeletionWriteQueries)(nil).DeleteCompany), ctx, db, id)
+}
+
+// DeleteCompanyObjects mocks base method.
+func (m *MockCompanyDeletionWriteQueries) DeleteCompanyObjects(ctx context.Context, db sqlc.DBTX, storageKeys []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCompanyObjects", ctx, db, storageKeys)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCompanyObjects indicates an expected call of DeleteCompanyObjects.
+func (mr *MockCompanyDeletionWriteQueriesMockRecorder) DeleteCompanyObjects(ctx, db, storageKeys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCompanyObjects", reflect.TypeOf((*MockCompanyDeletionWriteQueries)(nil).DeleteCompanyObjects), ctx, db, storageKeys)
+}
+
+// DeleteCompanyResources mocks base method.
+func (m *MockCompanyDeletionWriteQueries) DeleteCompanyResources(ctx context.Context, db sqlc.DBTX, arg sqlc.DeleteCompanyResourcesParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCompanyResources", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCompanyResources indicates an expected call of DeleteCompanyResources.
+func (mr *MockCompanyDeletionWriteQueriesMockRecorder) DeleteCompanyResources(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCompanyResources", reflect.TypeOf((*MockCompanyDeletionWriteQueries)(nil).DeleteCompanyResources), ctx, db, arg)
+}
+
+// DeleteCompanyUsers mocks base method.
+func (m *MockCompanyDeletionWriteQueries) DeleteCompanyUsers(ctx context.Context, db sqlc.DBTX, arg sqlc.DeleteCompanyUsersParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCompanyUsers", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCompanyUsers indicates an expected call of DeleteCompanyUsers.
+func (mr *MockCompanyDeletionWriteQueriesMockRecorder) DeleteCompanyUsers(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCompanyUsers", reflect.TypeOf((*MockCompanyDeletionWriteQueries)(nil).DeleteCompanyUsers), ctx, db, arg)
+}
+
+// EnsureDeletedUser mocks base method.
+func (m *MockCompanyDeletionWriteQueries) EnsureDeletedUser(ctx context.Context, db sqlc.DBTX, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureDeletedUser", ctx, db, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnsureDeletedUser indicates an expected call of EnsureDeletedUser.
+func (mr *MockCompanyDeletionWriteQueriesMockRecorder) EnsureDeletedUser(ctx, db, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureDeletedUser", reflect.TypeOf((*MockCompanyDeletionWriteQueries)(nil).EnsureDeletedUser), ctx, db, id)
+}
+
+// GetCompanyDeletionTarget mocks base method.
+func (m *MockCompanyDeletionWriteQueries) GetCompanyDeletionTarget(ctx context.Context, db sqlc.DBTX, arg sqlc.GetCompanyDeletionTargetParams) (sqlc.GetCompanyDeletionTargetRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCompanyDeletionTarget", ctx, db, arg)
+	ret0, _ := ret[0].(sqlc.GetCompanyDeletionTargetRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCompanyDeletionTarget indicates an expected call of GetCompanyDeletionTarget.
+func (mr *MockCompanyDeletionWriteQueriesMockRecorder) GetCompanyDeletionTarget(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompanyDeletionTarget", reflect.TypeOf((*MockCompanyDeletionWriteQueries)(nil).GetCompanyDeletionTarget), ctx, db, arg)
+}
+
+// ListCompanyObjects mocks base method.
+func (m *MockCompanyDeletionWriteQueries) ListCompanyObjects(ctx context.Context, db sqlc.DBTX, arg sqlc.ListCompanyObjectsParams) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCompanyObjects", ctx, db, arg)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCompanyObjects indicates an expected call of ListCompanyObjects.
+func (mr *MockCompanyDeletionWriteQueriesMockRecorder) ListCompanyObjects(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCompanyObjects", reflect.TypeOf((*MockCompanyDeletionWriteQueries)(nil).ListCompanyObjects), ctx, db, arg)
+}
+
+// LockCompanyForDeletion mocks base method.
+func (m *MockCompanyDeletionWriteQueries) LockCompanyForDeletion(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockCompanyForDeletion", ctx, db, companyID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockCompanyForDeletion indicates an expected call of LockCompanyForDeletion.
+func (mr *MockCompanyDeletionWriteQueriesMockRecorder) LockCompanyForDeletion(ctx, db, companyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockCompanyForDeletion", reflect.TypeOf((*MockCompanyDeletionWriteQueries)(nil).LockCompanyForDeletion), ctx, db, companyID)
+}
+
+// ReleaseCompanyDeletion mocks base method.
+func (m *MockCompanyDeletionWriteQueries) ReleaseCompanyDeletion(ctx context.Context, db sqlc.DBTX, arg sqlc.ReleaseCompanyDeletionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseCompanyDeletion", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseCompanyDeletion indicates an expected call of ReleaseCompanyDeletion.
+func (mr *MockCompanyDeletionWriteQueriesMockRecorder) ReleaseCompanyDeletion(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseCompanyDeletion", reflect.TypeOf((*MockCompanyDeletionWriteQueries)(nil).ReleaseCompanyDeletion), ctx, db, arg)
+}
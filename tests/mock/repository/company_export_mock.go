@@ -0,0 +1,130 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/repository/company_export.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/repository/company_export.go -destination=tests/mock/repository/company_export_mock.go -package=repositorymock
+//
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	pgtype "github.com/jackc/pgx/v5/pgtype"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCompanyExportWriteQueries is a mock of CompanyExportWriteQueries interface.
+type MockCompanyExportWriteQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockCompanyExportWriteQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockCompanyExportWriteQueriesMockRecorder is the mock recorder for MockCompanyExportWriteQueries.
+type MockCompanyExportWriteQueriesMockRecorder struct {
+	mock *MockCompanyExportWriteQueries
+}
+
+// NewMockCompanyExportWriteQueries creates a new mock instance.
+func NewMockCompanyExportWriteQueries(ctrl *gomock.Controller) *MockCompanyExportWriteQueries {
+	mock := &MockCompanyExportWriteQueries{ctrl: ctrl}
+	mock.recorder = &MockCompanyExportWriteQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCompanyExportWriteQueries) EXPECT() *MockCompanyExportWriteQueriesMockRecorder {
+	return m.recorder
+}
+
+// ClaimCompanyExport mocks base method.
+func (m *MockCompanyExportWriteQueries) ClaimCompanyExport(ctx context.Context, db sqlc.DBTX, arg sqlc.ClaimCompanyExportParams) (sqlc.ClaimCompanyExportRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimCompanyExport", ctx, db, arg)
+	ret0, _ := ret[0].(sqlc.ClaimCompanyExportRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimCompanyExport indicates an expected call of ClaimCompanyExport.
+func (mr *MockCompanyExportWriteQueriesMockRecorder) ClaimCompanyExport(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimCompanyExport", reflect.TypeOf((*MockCompanyExportWriteQueries)(nil).ClaimCompanyExport), ctx, db, arg)
+}
+
+// CompleteCompanyExport mocks base method.
+func (m *MockCompanyExportWriteQueries) CompleteCompanyExport(ctx context.Context, db sqlc.DBTX, arg sqlc.CompleteCompanyExportParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteCompanyExport", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteCompanyExport indicates an expected call of CompleteCompanyExport.
+func (mr *MockCompanyExportWriteQueriesMockRecorder) CompleteCompanyExport(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteCompanyExport", reflect.TypeOf((*MockCompanyExportWriteQueries)(nil).CompleteCompanyExport), ctx, db, arg)
+}
+
+// CreateCompanyExport mocks base method.
+func (m *MockCompanyExportWriteQueries) CreateCompanyExport(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateCompanyExportParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCompanyExport", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCompanyExport indicates an expected call of CreateCompanyExport.
+func (mr *MockCompanyExportWriteQueriesMockRecorder) CreateCompanyExport(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCompanyExport", reflect.TypeOf((*MockCompanyExportWriteQueries)(nil).CreateCompanyExport), ctx, db, arg)
+}
+
+// DeleteCompanyExport mocks base method.
+func (m *MockCompanyExportWriteQueries) DeleteCompanyExport(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (pgtype.Text, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCompanyExport", ctx, db, id)
+	ret0, _ := ret[0].(pgtype.Text)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCompanyExport indicates an expected call of DeleteCompanyExport.
+func (mr *MockCompanyExportWriteQueriesMockRecorder) DeleteCompanyExport(ctx, db, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCompanyExport", reflect.TypeOf((*MockCompanyExportWriteQueries)(nil).DeleteCompanyExport), ctx, db, id)
+}
+
+// FailCompanyExport mocks base method.
+func (m *MockCompanyExportWriteQueries) FailCompanyExport(ctx context.Context, db sqlc.DBTX, arg sqlc.FailCompanyExportParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailCompanyExport", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FailCompanyExport indicates an expected call of FailCompanyExport.
+func (mr *MockCompanyExportWriteQueriesMockRecorder) FailCompanyExport(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailCompanyExport", reflect.TypeOf((*MockCompanyExportWriteQueries)(nil).FailCompanyExport), ctx, db, arg)
+}
+
+// SetCompanyExportProgress mocks base method.
+func (m *MockCompanyExportWriteQueries) SetCompanyExportProgress(ctx context.Context, db sqlc.DBTX, arg sqlc.SetCompanyExportProgressParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCompanyExportProgress", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCompanyExportProgress indicates an expected call of SetCompanyExportProgress.
+func (mr *MockCompanyExportWriteQueriesMockRecorder) SetCompanyExportProgress(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCompanyExportProgress", reflect.TypeOf((*MockCompanyExportWriteQueries)(nil).SetCompanyExportProgress), ctx, db, arg)
+}
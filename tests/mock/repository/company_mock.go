@@ -0,0 +1,128 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/repository/company.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/repository/company.go -destination=tests/mock/repository/company_mock.go -package=repositorymock
+//
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCompanyWriteQueries is a mock of CompanyWriteQueries interface.
+type MockCompanyWriteQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockCompanyWriteQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockCompanyWriteQueriesMockRecorder is the mock recorder for MockCompanyWriteQueries.
+type MockCompanyWriteQueriesMockRecorder struct {
+	mock *MockCompanyWriteQueries
+}
+
+// NewMockCompanyWriteQueries creates a new mock instance.
+func NewMockCompanyWriteQueries(ctrl *gomock.Controller) *MockCompanyWriteQueries {
+	mock := &MockCompanyWriteQueries{ctrl: ctrl}
+	mock.recorder = &MockCompanyWriteQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCompanyWriteQueries) EXPECT() *MockCompanyWriteQueriesMockRecorder {
+	return m.recorder
+}
+
+// CreateCompany mocks base method.
+func (m *MockCompanyWriteQueries) CreateCompany(ctx context.Context, db sqlc.DBTX, name string) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCompany", ctx, db, name)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCompany indicates an expected call of CreateCompany.
+func (mr *MockCompanyWriteQueriesMockRecorder) CreateCompany(ctx, db, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCompany", reflect.TypeOf((*MockCompanyWriteQueries)(nil).CreateCompany), ctx, db, name)
+}
+
+// CreateCompanySettings mocks base method.
+func (m *MockCompanyWriteQueries) CreateCompanySettings(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateCompanySettingsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCompanySettings", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCompanySettings indicates an expected call of CreateCompanySettings.
+func (mr *MockCompanyWriteQueriesMockRecorder) CreateCompanySettings(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCompanySettings", reflect.TypeOf((*MockCompanyWriteQueries)(nil).CreateCompanySettings), ctx, db, arg)
+}
+
+// DeleteCompanyFeature mocks base method.
+func (m *MockCompanyWriteQueries) DeleteCompanyFeature(ctx context.Context, db sqlc.DBTX, arg sqlc.DeleteCompanyFeatureParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCompanyFeature", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCompanyFeature indicates an expected call of DeleteCompanyFeature.
+func (mr *MockCompanyWriteQueriesMockRecorder) DeleteCompanyFeature(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCompanyFeature", reflect.TypeOf((*MockCompanyWriteQueries)(nil).DeleteCompanyFeature), ctx, db, arg)
+}
+
+// SetCompanyBranding mocks base method.
+func (m *MockCompanyWriteQueries) SetCompanyBranding(ctx context.Context, db sqlc.DBTX, arg sqlc.SetCompanyBrandingParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCompanyBranding", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCompanyBranding indicates an expected call of SetCompanyBranding.
+func (mr *MockCompanyWriteQueriesMockRecorder) SetCompanyBranding(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCompanyBranding", reflect.TypeOf((*MockCompanyWriteQueries)(nil).SetCompanyBranding), ctx, db, arg)
+}
+
+// SetCompanyFeature mocks base method.
+func (m *MockCompanyWriteQueries) SetCompanyFeature(ctx context.Context, db sqlc.DBTX, arg sqlc.SetCompanyFeatureParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCompanyFeature", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCompanyFeature indicates an expected call of SetCompanyFeature.
+func (mr *MockCompanyWriteQueriesMockRecorder) SetCompanyFeature(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCompanyFeature", reflect.TypeOf((*MockCompanyWriteQueries)(nil).SetCompanyFeature), ctx, db, arg)
+}
+
+// SetCompanyReviewWindow mocks base method.
+func (m *MockCompanyWriteQueries) SetCompanyReviewWindow(ctx context.Context, db sqlc.DBTX, arg sqlc.SetCompanyReviewWindowParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCompanyReviewWindow", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCompanyReviewWindow indicates an expected call of SetCompanyReviewWindow.
+func (mr *MockCompanyWriteQueriesMockRecorder) SetCompanyReviewWindow(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCompanyReviewWindow", reflect.TypeOf((*MockCompanyWriteQueries)(nil).SetCompanyReviewWindow), ctx, db, arg)
+}
@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/repository/custom_field.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/repository/custom_field.go -destination=tests/mock/repository/custom_field_mock.go -package=repositorymock
+//
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCustomFieldWriteQueries is a mock of CustomFieldWriteQueries interface.
+type MockCustomFieldWriteQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockCustomFieldWriteQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockCustomFieldWriteQueriesMockRecorder is the mock recorder for MockCustomFieldWriteQueries.
+type MockCustomFieldWriteQueriesMockRecorder struct {
+	mock *MockCustomFieldWriteQueries
+}
+
+// NewMockCustomFieldWriteQueries creates a new mock instance.
+func NewMockCustomFieldWriteQueries(ctrl *gomock.Controller) *MockCustomFieldWriteQueries {
+	mock := &MockCustomFieldWriteQueries{ctrl: ctrl}
+	mock.recorder = &MockCustomFieldWriteQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCustomFieldWriteQueries) EXPECT() *MockCustomFieldWriteQueriesMockRecorder {
+	return m.recorder
+}
+
+// CreateCustomFieldDefinition mocks base method.
+func (m *MockCustomFieldWriteQueries) CreateCustomFieldDefinition(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateCustomFieldDefinitionParams) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCustomFieldDefinition", ctx, db, arg)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCustomFieldDefinition indicates an expected call of CreateCustomFieldDefinition.
+func (mr *MockCustomFieldWriteQueriesMockRecorder) CreateCustomFieldDefinition(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomFieldDefinition", reflect.TypeOf((*MockCustomFieldWriteQueries)(nil).CreateCustomFieldDefinition), ctx, db, arg)
+}
+
+// DeleteCustomFieldDefinition mocks base method.
+func (m *MockCustomFieldWriteQueries) DeleteCustomFieldDefinition(ctx context.Context, db sqlc.DBTX, arg sqlc.DeleteCustomFieldDefinitionParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCustomFieldDefinition", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCustomFieldDefinition indicates an expected call of DeleteCustomFieldDefinition.
+func (mr *MockCustomFieldWriteQueriesMockRecorder) DeleteCustomFieldDefinition(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomFieldDefinition", reflect.TypeOf((*MockCustomFieldWriteQueries)(nil).DeleteCustomFieldDefinition), ctx, db, arg)
+}
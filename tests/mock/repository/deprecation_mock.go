@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/repository/deprecation.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/repository/deprecation.go -destination=tests/mock/repository/deprecation_mock.go -package=repositorymock
+//
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDeprecationWriteQueries is a mock of DeprecationWriteQueries interface.
+type MockDeprecationWriteQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeprecationWriteQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockDeprecationWriteQueriesMockRecorder is the mock recorder for MockDeprecationWriteQueries.
+type MockDeprecationWriteQueriesMockRecorder struct {
+	mock *MockDeprecationWriteQueries
+}
+
+// NewMockDeprecationWriteQueries creates a new mock instance.
+func NewMockDeprecationWriteQueries(ctrl *gomock.Controller) *MockDeprecationWriteQueries {
+	mock := &MockDeprecationWriteQueries{ctrl: ctrl}
+	mock.recorder = &MockDeprecationWriteQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeprecationWriteQueries) EXPECT() *MockDeprecationWriteQueriesMockRecorder {
+	return m.recorder
+}
+
+// AddDeprecatedRouteCalls mocks base method.
+func (m *MockDeprecationWriteQueries) AddDeprecatedRouteCalls(ctx context.Context, db sqlc.DBTX, arg sqlc.AddDeprecatedRouteCallsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDeprecatedRouteCalls", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddDeprecatedRouteCalls indicates an expected call of AddDeprecatedRouteCalls.
+func (mr *MockDeprecationWriteQueriesMockRecorder) AddDeprecatedRouteCalls(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDeprecatedRouteCalls", reflect.TypeOf((*MockDeprecationWriteQueries)(nil).AddDeprecatedRouteCalls), ctx, db, arg)
+}
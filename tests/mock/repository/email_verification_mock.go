@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/repository/email_verification.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/repository/email_verification.go -destination=tests/mock/repository/email_verification_mock.go -package=repositorymock
+//
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	pgtype "github.com/jackc/pgx/v5/pgtype"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEmailVerificationWriteQueries is a mock of EmailVerificationWriteQueries interface.
+type MockEmailVerificationWriteQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmailVerificationWriteQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockEmailVerificationWriteQueriesMockRecorder is the mock recorder for MockEmailVerificationWriteQueries.
+type MockEmailVerificationWriteQueriesMockRecorder struct {
+	mock *MockEmailVerificationWriteQueries
+}
+
+// NewMockEmailVerificationWriteQueries creates a new mock instance.
+func NewMockEmailVerificationWriteQueries(ctrl *gomock.Controller) *MockEmailVerificationWriteQueries {
+	mock := &MockEmailVerificationWriteQueries{ctrl: ctrl}
+	mock.recorder = &MockEmailVerificationWriteQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmailVerificationWriteQueries) EXPECT() *MockEmailVerificationWriteQueriesMockRecorder {
+	return m.recorder
+}
+
+// ConsumeEmailVerification mocks base method.
+func (m *MockEmailVerificationWriteQueries) ConsumeEmailVerification(ctx context.Context, db sqlc.DBTX, arg sqlc.ConsumeEmailVerificationParams) (pgtype.Timestamptz, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeEmailVerification", ctx, db, arg)
+	ret0, _ := ret[0].(pgtype.Timestamptz)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeEmailVerification indicates an expected call of ConsumeEmailVerification.
+func (mr *MockEmailVerificationWriteQueriesMockRecorder) ConsumeEmailVerification(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeEmailVerification", reflect.TypeOf((*MockEmailVerificationWriteQueries)(nil).ConsumeEmailVerification), ctx, db, arg)
+}
+
+// CreateEmailVerification mocks base method.
+func (m *MockEmailVerificationWriteQueries) CreateEmailVerification(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateEmailVerificationParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEmailVerification", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateEmailVerification indicates an expected call of CreateEmailVerification.
+func (mr *MockEmailVerificationWriteQueriesMockRecorder) CreateEmailVerification(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEmailVerification", reflect.TypeOf((*MockEmailVerificationWriteQueries)(nil).CreateEmailVerification), ctx, db, arg)
+}
@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/repository/invite.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/repository/invite.go -destination=tests/mock/repository/invite_mock.go -package=repositorymock
+//
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockInviteWriteQueries is a mock of InviteWriteQueries interface.
+type MockInviteWriteQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockInviteWriteQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockInviteWriteQueriesMockRecorder is the mock recorder for MockInviteWriteQueries.
+type MockInviteWriteQueriesMockRecorder struct {
+	mock *MockInviteWriteQueries
+}
+
+// NewMockInviteWriteQueries creates a new mock instance.
+func NewMockInviteWriteQueries(ctrl *gomock.Controller) *MockInviteWriteQueries {
+	mock := &MockInviteWriteQueries{ctrl: ctrl}
+	mock.recorder = &MockInviteWriteQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInviteWriteQueries) EXPECT() *MockInviteWriteQueriesMockRecorder {
+	return m.recorder
+}
+
+// AcceptInvite mocks base method.
+func (m *MockInviteWriteQueries) AcceptInvite(ctx context.Context, db sqlc.DBTX, arg sqlc.AcceptInviteParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptInvite", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptInvite indicates an expected call of AcceptInvite.
+func (mr *MockInviteWriteQueriesMockRecorder) AcceptInvite(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvite", reflect.TypeOf((*MockInviteWriteQueries)(nil).AcceptInvite), ctx, db, arg)
+}
+
+// CreateInvite mocks base method.
+func (m *MockInviteWriteQueries) CreateInvite(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateInviteParams) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInvite", ctx, db, arg)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInvite indicates an expected call of CreateInvite.
+func (mr *MockInviteWriteQueriesMockRecorder) CreateInvite(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvite", reflect.TypeOf((*MockInviteWriteQueries)(nil).CreateInvite), ctx, db, arg)
+}
+
+// ReissueInvite mocks base method.
+func (m *MockInviteWriteQueries) ReissueInvite(ctx context.Context, db sqlc.DBTX, arg sqlc.ReissueInviteParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReissueInvite", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReissueInvite indicates an expected call of ReissueInvite.
+func (mr *MockInviteWriteQueriesMockRecorder) ReissueInvite(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReissueInvite", reflect.TypeOf((*MockInviteWriteQueries)(nil).ReissueInvite), ctx, db, arg)
+}
+
+// RevokeInvite mocks base method.
+func (m *MockInviteWriteQueries) RevokeInvite(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeInvite", ctx, db, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeInvite indicates an expected call of RevokeInvite.
+func (mr *MockInviteWriteQueriesMockRecorder) RevokeInvite(ctx, db, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeInvite", reflect.TypeOf((*MockInviteWriteQueries)(nil).RevokeInvite), ctx, db, id)
+}
@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/repository/login_failure.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/repository/login_failure.go -destination=tests/mock/repository/login_failure_mock.go -package=repositorymock
+//
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	pgtype "github.com/jackc/pgx/v5/pgtype"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLoginFailureWriteQueries is a mock of LoginFailureWriteQueries interface.
+type MockLoginFailureWriteQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoginFailureWriteQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockLoginFailureWriteQueriesMockRecorder is the mock recorder for MockLoginFailureWriteQueries.
+type MockLoginFailureWriteQueriesMockRecorder struct {
+	mock *MockLoginFailureWriteQueries
+}
+
+// NewMockLoginFailureWriteQueries creates a new mock instance.
+func NewMockLoginFailureWriteQueries(ctrl *gomock.Controller) *MockLoginFailureWriteQueries {
+	mock := &MockLoginFailureWriteQueries{ctrl: ctrl}
+	mock.recorder = &MockLoginFailureWriteQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoginFailureWriteQueries) EXPECT() *MockLoginFailureWriteQueriesMockRecorder {
+	return m.recorder
+}
+
+// ClearLoginFailures mocks base method.
+func (m *MockLoginFailureWriteQueries) ClearLoginFailures(ctx context.Context, db sqlc.DBTX, arg sqlc.ClearLoginFailuresParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearLoginFailures", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearLoginFailures indicates an expected call of ClearLoginFailures.
+func (mr *MockLoginFailureWriteQueriesMockRecorder) ClearLoginFailures(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearLoginFailures", reflect.TypeOf((*MockLoginFailureWriteQueries)(nil).ClearLoginFailures), ctx, db, arg)
+}
+
+// GetLoginLock mocks base method.
+func (m *MockLoginFailureWriteQueries) GetLoginLock(ctx context.Context, db sqlc.DBTX, arg sqlc.GetLoginLockParams) (pgtype.Timestamptz, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoginLock", ctx, db, arg)
+	ret0, _ := ret[0].(pgtype.Timestamptz)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoginLock indicates an expected call of GetLoginLock.
+func (mr *MockLoginFailureWriteQueriesMockRecorder) GetLoginLock(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoginLock", reflect.TypeOf((*MockLoginFailureWriteQueries)(nil).GetLoginLock), ctx, db, arg)
+}
+
+// LockLogin mocks base method.
+func (m *MockLoginFailureWriteQueries) LockLogin(ctx context.Context, db sqlc.DBTX, arg sqlc.LockLoginParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockLogin", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockLogin indicates an expected call of LockLogin.
+func (mr *MockLoginFailureWriteQueriesMockRecorder) LockLogin(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockLogin", reflect.TypeOf((*MockLoginFailureWriteQueries)(nil).LockLogin), ctx, db, arg)
+}
+
+// RecordLoginFailure mocks base method.
+func (m *MockLoginFailureWriteQueries) RecordLoginFailure(ctx context.Context, db sqlc.DBTX, arg sqlc.RecordLoginFailureParams) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordLoginFailure", ctx, db, arg)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordLoginFailure indicates an expected call of RecordLoginFailure.
+func (mr *MockLoginFailureWriteQueriesMockRecorder) RecordLoginFailure(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordLoginFailure", reflect.TypeOf((*MockLoginFailureWriteQueries)(nil).RecordLoginFailure), ctx, db, arg)
+}
+
+// UnlockLogins mocks base method.
+func (m *MockLoginFailureWriteQueries) UnlockLogins(ctx context.Context, db sqlc.DBTX, arg sqlc.UnlockLoginsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockLogins", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnlockLogins indicates an expected call of UnlockLogins.
+func (mr *MockLoginFailureWriteQueriesMockRecorder) UnlockLogins(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockLogins", reflect.TypeOf((*MockLoginFailureWriteQueries)(nil).UnlockLogins), ctx, db, arg)
+}
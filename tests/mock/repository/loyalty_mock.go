@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/repository/loyalty.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/repository/loyalty.go -destination=tests/mock/repository/loyalty_mock.go -package=repositorymock
+//
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLoyaltyWriteQueries is a mock of LoyaltyWriteQueries interface.
+type MockLoyaltyWriteQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoyaltyWriteQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockLoyaltyWriteQueriesMockRecorder is the mock recorder for MockLoyaltyWriteQueries.
+type MockLoyaltyWriteQueriesMockRecorder struct {
+	mock *MockLoyaltyWriteQueries
+}
+
+// NewMockLoyaltyWriteQueries creates a new mock instance.
+func NewMockLoyaltyWriteQueries(ctrl *gomock.Controller) *MockLoyaltyWriteQueries {
+	mock := &MockLoyaltyWriteQueries{ctrl: ctrl}
+	mock.recorder = &MockLoyaltyWriteQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoyaltyWriteQueries) EXPECT() *MockLoyaltyWriteQueriesMockRecorder {
+	return m.recorder
+}
+
+// CreateLoyaltyAccrual mocks base method.
+func (m *MockLoyaltyWriteQueries) CreateLoyaltyAccrual(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateLoyaltyAccrualParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLoyaltyAccrual", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLoyaltyAccrual indicates an expected call of CreateLoyaltyAccrual.
+func (mr *MockLoyaltyWriteQueriesMockRecorder) CreateLoyaltyAccrual(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoyaltyAccrual", reflect.TypeOf((*MockLoyaltyWriteQueries)(nil).CreateLoyaltyAccrual), ctx, db, arg)
+}
+
+// CreateLoyaltyRedemption mocks base method.
+func (m *MockLoyaltyWriteQueries) CreateLoyaltyRedemption(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateLoyaltyRedemptionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLoyaltyRedemption", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateLoyaltyRedemption indicates an expected call of CreateLoyaltyRedemption.
+func (mr *MockLoyaltyWriteQueriesMockRecorder) CreateLoyaltyRedemption(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoyaltyRedemption", reflect.TypeOf((*MockLoyaltyWriteQueries)(nil).CreateLoyaltyRedemption), ctx, db, arg)
+}
+
+// DrawLoyaltyLot mocks base method.
+func (m *MockLoyaltyWriteQueries) DrawLoyaltyLot(ctx context.Context, db sqlc.DBTX, arg sqlc.DrawLoyaltyLotParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DrawLoyaltyLot", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DrawLoyaltyLot indicates an expected call of DrawLoyaltyLot.
+func (mr *MockLoyaltyWriteQueriesMockRecorder) DrawLoyaltyLot(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DrawLoyaltyLot", reflect.TypeOf((*MockLoyaltyWriteQueries)(nil).DrawLoyaltyLot), ctx, db, arg)
+}
+
+// ExpireLoyaltyLots mocks base method.
+func (m *MockLoyaltyWriteQueries) ExpireLoyaltyLots(ctx context.Context, db sqlc.DBTX, arg sqlc.ExpireLoyaltyLotsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireLoyaltyLots", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireLoyaltyLots indicates an expected call of ExpireLoyaltyLots.
+func (mr *MockLoyaltyWriteQueriesMockRecorder) ExpireLoyaltyLots(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireLoyaltyLots", reflect.TypeOf((*MockLoyaltyWriteQueries)(nil).ExpireLoyaltyLots), ctx, db, arg)
+}
+
+// LockOpenLoyaltyLots mocks base method.
+func (m *MockLoyaltyWriteQueries) LockOpenLoyaltyLots(ctx context.Context, db sqlc.DBTX, arg sqlc.LockOpenLoyaltyLotsParams) ([]sqlc.LockOpenLoyaltyLotsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockOpenLoyaltyLots", ctx, db, arg)
+	ret0, _ := ret[0].([]sqlc.LockOpenLoyaltyLotsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockOpenLoyaltyLots indicates an expected call of LockOpenLoyaltyLots.
+func (mr *MockLoyaltyWriteQueriesMockRecorder) LockOpenLoyaltyLots(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockOpenLoyaltyLots", reflect.TypeOf((*MockLoyaltyWriteQueries)(nil).LockOpenLoyaltyLots), ctx, db, arg)
+}
@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/repository/notification_digest.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/repository/notification_digest.go -destination=tests/mock/repository/notification_digest_mock.go -package=repositorymock
+//
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNotificationDigestWriteQueries is a mock of NotificationDigestWriteQueries interface.
+type MockNotificationDigestWriteQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationDigestWriteQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockNotificationDigestWriteQueriesMockRecorder is the mock recorder for MockNotificationDigestWriteQueries.
+type MockNotificationDigestWriteQueriesMockRecorder struct {
+	mock *MockNotificationDigestWriteQueries
+}
+
+// NewMockNotificationDigestWriteQueries creates a new mock instance.
+func NewMockNotificationDigestWriteQueries(ctrl *gomock.Controller) *MockNotificationDigestWriteQueries {
+	mock := &MockNotificationDigestWriteQueries{ctrl: ctrl}
+	mock.recorder = &MockNotificationDigestWriteQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationDigestWriteQueries) EXPECT() *MockNotificationDigestWriteQueriesMockRecorder {
+	return m.recorder
+}
+
+// ListDueNotificationDigests mocks base method.
+func (m *MockNotificationDigestWriteQueries) ListDueNotificationDigests(ctx context.Context, db sqlc.DBTX, arg sqlc.ListDueNotificationDigestsParams) ([]sqlc.ListDueNotificationDigestsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDueNotificationDigests", ctx, db, arg)
+	ret0, _ := ret[0].([]sqlc.ListDueNotificationDigestsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDueNotificationDigests indicates an expected call of ListDueNotificationDigests.
+func (mr *MockNotificationDigestWriteQueriesMockRecorder) ListDueNotificationDigests(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDueNotificationDigests", reflect.TypeOf((*MockNotificationDigestWriteQueries)(nil).ListDueNotificationDigests), ctx, db, arg)
+}
+
+// SetNotificationDigest mocks base method.
+func (m *MockNotificationDigestWriteQueries) SetNotificationDigest(ctx context.Context, db sqlc.DBTX, arg sqlc.SetNotificationDigestParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNotificationDigest", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetNotificationDigest indicates an expected call of SetNotificationDigest.
+func (mr *MockNotificationDigestWriteQueriesMockRecorder) SetNotificationDigest(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNotificationDigest", reflect.TypeOf((*MockNotificationDigestWriteQueries)(nil).SetNotificationDigest), ctx, db, arg)
+}
+
+// TakeHeldNotificationJobs mocks base method.
+func (m *MockNotificationDigestWriteQueries) TakeHeldNotificationJobs(ctx context.Context, db sqlc.DBTX, arg sqlc.TakeHeldNotificationJobsParams) ([]sqlc.TakeHeldNotificationJobsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TakeHeldNotificationJobs", ctx, db, arg)
+	ret0, _ := ret[0].([]sqlc.TakeHeldNotificationJobsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TakeHeldNotificationJobs indicates an expected call of TakeHeldNotificationJobs.
+func (mr *MockNotificationDigestWriteQueriesMockRecorder) TakeHeldNotificationJobs(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TakeHeldNotificationJobs", reflect.TypeOf((*MockNotificationDigestWriteQueries)(nil).TakeHeldNotificationJobs), ctx, db, arg)
+}
@@ -55,6 +55,20 @@ func (mr *MockNotificationWriteQueriesMockRecorder) CreateNotificationJob(ctx, d
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotificationJob", reflect.TypeOf((*MockNotificationWriteQueries)(nil).CreateNotificationJob), ctx, db, arg)
 }
 
+// CreateUserNotificationJob mocks base method.
+func (m *MockNotificationWriteQueries) CreateUserNotificationJob(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateUserNotificationJobParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserNotificationJob", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateUserNotificationJob indicates an expected call of CreateUserNotificationJob.
+func (mr *MockNotificationWriteQueriesMockRecorder) CreateUserNotificationJob(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserNotificationJob", reflect.TypeOf((*MockNotificationWriteQueries)(nil).CreateUserNotificationJob), ctx, db, arg)
+}
+
 // UpdateNotificationJobStatus mocks base method.
 func (m *MockNotificationWriteQueries) UpdateNotificationJobStatus(ctx context.Context, db sqlc.DBTX, arg sqlc.UpdateNotificationJobStatusParams) error {
 	m.ctrl.T.Helper()
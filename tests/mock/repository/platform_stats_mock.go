@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/repository/platform_stats.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/repository/platform_stats.go -destination=tests/mock/repository/platform_stats_mock.go -package=repositorymock
+//
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	pgtype "github.com/jackc/pgx/v5/pgtype"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPlatformStatsWriteQueries is a mock of PlatformStatsWriteQueries interface.
+type MockPlatformStatsWriteQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockPlatformStatsWriteQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockPlatformStatsWriteQueriesMockRecorder is the mock recorder for MockPlatformStatsWriteQueries.
+type MockPlatformStatsWriteQueriesMockRecorder struct {
+	mock *MockPlatformStatsWriteQueries
+}
+
+// NewMockPlatformStatsWriteQueries creates a new mock instance.
+func NewMockPlatformStatsWriteQueries(ctrl *gomock.Controller) *MockPlatformStatsWriteQueries {
+	mock := &MockPlatformStatsWriteQueries{ctrl: ctrl}
+	mock.recorder = &MockPlatformStatsWriteQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPlatformStatsWriteQueries) EXPECT() *MockPlatformStatsWriteQueriesMockRecorder {
+	return m.recorder
+}
+
+// RefreshPlatformStats mocks base method.
+func (m *MockPlatformStatsWriteQueries) RefreshPlatformStats(ctx context.Context, db sqlc.DBTX, computedAt pgtype.Timestamptz) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshPlatformStats", ctx, db, computedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshPlatformStats indicates an expected call of RefreshPlatformStats.
+func (mr *MockPlatformStatsWriteQueriesMockRecorder) RefreshPlatformStats(ctx, db, computedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshPlatformStats", reflect.TypeOf((*MockPlatformStatsWriteQueries)(nil).RefreshPlatformStats), ctx, db, computedAt)
+}
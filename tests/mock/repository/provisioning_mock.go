@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/repository/provisioning.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/repository/provisioning.go -destination=tests/mock/repository/provisioning_mock.go -package=repositorymock
+//
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProvisioningWriteQueries is a mock of ProvisioningWriteQueries interface.
+type MockProvisioningWriteQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockProvisioningWriteQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockProvisioningWriteQueriesMockRecorder is the mock recorder for MockProvisioningWriteQueries.
+type MockProvisioningWriteQueriesMockRecorder struct {
+	mock *MockProvisioningWriteQueries
+}
+
+// NewMockProvisioningWriteQueries creates a new mock instance.
+func NewMockProvisioningWriteQueries(ctrl *gomock.Controller) *MockProvisioningWriteQueries {
+	mock := &MockProvisioningWriteQueries{ctrl: ctrl}
+	mock.recorder = &MockProvisioningWriteQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProvisioningWriteQueries) EXPECT() *MockProvisioningWriteQueriesMockRecorder {
+	return m.recorder
+}
+
+// CreateProvisioningToken mocks base method.
+func (m *MockProvisioningWriteQueries) CreateProvisioningToken(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateProvisioningTokenParams) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProvisioningToken", ctx, db, arg)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProvisioningToken indicates an expected call of CreateProvisioningToken.
+func (mr *MockProvisioningWriteQueriesMockRecorder) CreateProvisioningToken(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProvisioningToken", reflect.TypeOf((*MockProvisioningWriteQueries)(nil).CreateProvisioningToken), ctx, db, arg)
+}
+
+// RevokeProvisioningToken mocks base method.
+func (m *MockProvisioningWriteQueries) RevokeProvisioningToken(ctx context.Context, db sqlc.DBTX, arg sqlc.RevokeProvisioningTokenParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeProvisioningToken", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeProvisioningToken indicates an expected call of RevokeProvisioningToken.
+func (mr *MockProvisioningWriteQueriesMockRecorder) RevokeProvisioningToken(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeProvisioningToken", reflect.TypeOf((*MockProvisioningWriteQueries)(nil).RevokeProvisioningToken), ctx, db, arg)
+}
+
+// SetProvisionedUserActive mocks base method.
+func (m *MockProvisioningWriteQueries) SetProvisionedUserActive(ctx context.Context, db sqlc.DBTX, arg sqlc.SetProvisionedUserActiveParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProvisionedUserActive", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetProvisionedUserActive indicates an expected call of SetProvisionedUserActive.
+func (mr *MockProvisioningWriteQueriesMockRecorder) SetProvisionedUserActive(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProvisionedUserActive", reflect.TypeOf((*MockProvisioningWriteQueries)(nil).SetProvisionedUserActive), ctx, db, arg)
+}
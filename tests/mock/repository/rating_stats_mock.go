@@ -14,6 +14,7 @@ import (
 	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
 	reflect "reflect"
 
+	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -82,3 +83,32 @@ func (mr *MockRatingStatsQueriesMockRecorder) ApplyResourceRatingStatsOnUpdate(c
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyResourceRatingStatsOnUpdate", reflect.TypeOf((*MockRatingStatsQueries)(nil).ApplyResourceRatingStatsOnUpdate), ctx, db, arg)
 }
+
+// RecalculateResourceRatingStats mocks base method.
+func (m *MockRatingStatsQueries) RecalculateResourceRatingStats(ctx context.Context, db sqlc.DBTX, resourceID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecalculateResourceRatingStats", ctx, db, resourceID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecalculateResourceRatingStats indicates an expected call of RecalculateResourceRatingStats.
+func (mr *MockRatingStatsQueriesMockRecorder) RecalculateResourceRatingStats(ctx, db, resourceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecalculateResourceRatingStats", reflect.TypeOf((*MockRatingStatsQueries)(nil).RecalculateResourceRatingStats), ctx, db, resourceID)
+}
+
+// UpdateReviewSummary mocks base method.
+func (m *MockRatingStatsQueries) UpdateReviewSummary(ctx context.Context, db sqlc.DBTX, arg sqlc.UpdateReviewSummaryParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReviewSummary", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateReviewSummary indicates an expected call of UpdateReviewSummary.
+func (mr *MockRatingStatsQueriesMockRecorder) UpdateReviewSummary(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReviewSummary", reflect.TypeOf((*MockRatingStatsQueries)(nil).UpdateReviewSummary), ctx, db, arg)
+}
@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/repository/referral.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/repository/referral.go -destination=tests/mock/repository/referral_mock.go -package=repositorymock
+//
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReferralWriteQueries is a mock of ReferralWriteQueries interface.
+type MockReferralWriteQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockReferralWriteQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockReferralWriteQueriesMockRecorder is the mock recorder for MockReferralWriteQueries.
+type MockReferralWriteQueriesMockRecorder struct {
+	mock *MockReferralWriteQueries
+}
+
+// NewMockReferralWriteQueries creates a new mock instance.
+func NewMockReferralWriteQueries(ctrl *gomock.Controller) *MockReferralWriteQueries {
+	mock := &MockReferralWriteQueries{ctrl: ctrl}
+	mock.recorder = &MockReferralWriteQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReferralWriteQueries) EXPECT() *MockReferralWriteQueriesMockRecorder {
+	return m.recorder
+}
+
+// CreateReferral mocks base method.
+func (m *MockReferralWriteQueries) CreateReferral(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateReferralParams) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReferral", ctx, db, arg)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReferral indicates an expected call of CreateReferral.
+func (mr *MockReferralWriteQueriesMockRecorder) CreateReferral(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReferral", reflect.TypeOf((*MockReferralWriteQueries)(nil).CreateReferral), ctx, db, arg)
+}
+
+// CreateReferralCredit mocks base method.
+func (m *MockReferralWriteQueries) CreateReferralCredit(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateReferralCreditParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReferralCredit", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateReferralCredit indicates an expected call of CreateReferralCredit.
+func (mr *MockReferralWriteQueriesMockRecorder) CreateReferralCredit(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReferralCredit", reflect.TypeOf((*MockReferralWriteQueries)(nil).CreateReferralCredit), ctx, db, arg)
+}
+
+// MarkReferralRewarded mocks base method.
+func (m *MockReferralWriteQueries) MarkReferralRewarded(ctx context.Context, db sqlc.DBTX, arg sqlc.MarkReferralRewardedParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkReferralRewarded", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkReferralRewarded indicates an expected call of MarkReferralRewarded.
+func (mr *MockReferralWriteQueriesMockRecorder) MarkReferralRewarded(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkReferralRewarded", reflect.TypeOf((*MockReferralWriteQueries)(nil).MarkReferralRewarded), ctx, db, arg)
+}
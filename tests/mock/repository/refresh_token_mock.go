@@ -0,0 +1,199 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/repository/refresh_token.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/repository/refresh_token.go -destination=tests/mock/repository/refresh_token_mock.go -package=repositorymock
+//
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRefreshTokenWriteQueries is a mock of RefreshTokenWriteQueries interface.
+type MockRefreshTokenWriteQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockRefreshTokenWriteQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockRefreshTokenWriteQueriesMockRecorder is the mock recorder for MockRefreshTokenWriteQueries.
+type MockRefreshTokenWriteQueriesMockRecorder struct {
+	mock *MockRefreshTokenWriteQueries
+}
+
+// NewMockRefreshTokenWriteQueries creates a new mock instance.
+func NewMockRefreshTokenWriteQueries(ctrl *gomock.Controller) *MockRefreshTokenWriteQueries {
+	mock := &MockRefreshTokenWriteQueries{ctrl: ctrl}
+	mock.recorder = &MockRefreshTokenWriteQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRefreshTokenWriteQueries) EXPECT() *MockRefreshTokenWriteQueriesMockRecorder {
+	return m.recorder
+}
+
+// CreateRefreshToken mocks base method.
+func (m *MockRefreshTokenWriteQueries) CreateRefreshToken(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateRefreshTokenParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRefreshToken", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRefreshToken indicates an expected call of CreateRefreshToken.
+func (mr *MockRefreshTokenWriteQueriesMockRecorder) CreateRefreshToken(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRefreshToken", reflect.TypeOf((*MockRefreshTokenWriteQueries)(nil).CreateRefreshToken), ctx, db, arg)
+}
+
+// CreateRevokedToken mocks base method.
+func (m *MockRefreshTokenWriteQueries) CreateRevokedToken(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateRevokedTokenParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRevokedToken", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRevokedToken indicates an expected call of CreateRevokedToken.
+func (mr *MockRefreshTokenWriteQueriesMockRecorder) CreateRevokedToken(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRevokedToken", reflect.TypeOf((*MockRefreshTokenWriteQueries)(nil).CreateRevokedToken), ctx, db, arg)
+}
+
+// GetRefreshTokenForUpdate mocks base method.
+func (m *MockRefreshTokenWriteQueries) GetRefreshTokenForUpdate(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (sqlc.RefreshTokens, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRefreshTokenForUpdate", ctx, db, id)
+	ret0, _ := ret[0].(sqlc.RefreshTokens)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRefreshTokenForUpdate indicates an expected call of GetRefreshTokenForUpdate.
+func (mr *MockRefreshTokenWriteQueriesMockRecorder) GetRefreshTokenForUpdate(ctx, db, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRefreshTokenForUpdate", reflect.TypeOf((*MockRefreshTokenWriteQueries)(nil).GetRefreshTokenForUpdate), ctx, db, id)
+}
+
+// MarkRefreshTokenRotated mocks base method.
+func (m *MockRefreshTokenWriteQueries) MarkRefreshTokenRotated(ctx context.Context, db sqlc.DBTX, arg sqlc.MarkRefreshTokenRotatedParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRefreshTokenRotated", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkRefreshTokenRotated indicates an expected call of MarkRefreshTokenRotated.
+func (mr *MockRefreshTokenWriteQueriesMockRecorder) MarkRefreshTokenRotated(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRefreshTokenRotated", reflect.TypeOf((*MockRefreshTokenWriteQueries)(nil).MarkRefreshTokenRotated), ctx, db, arg)
+}
+
+// MarkUserSessionRevoked mocks base method.
+func (m *MockRefreshTokenWriteQueries) MarkUserSessionRevoked(ctx context.Context, db sqlc.DBTX, arg sqlc.MarkUserSessionRevokedParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkUserSessionRevoked", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkUserSessionRevoked indicates an expected call of MarkUserSessionRevoked.
+func (mr *MockRefreshTokenWriteQueriesMockRecorder) MarkUserSessionRevoked(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkUserSessionRevoked", reflect.TypeOf((*MockRefreshTokenWriteQueries)(nil).MarkUserSessionRevoked), ctx, db, arg)
+}
+
+// MarkUserSessionsRevoked mocks base method.
+func (m *MockRefreshTokenWriteQueries) MarkUserSessionsRevoked(ctx context.Context, db sqlc.DBTX, arg sqlc.MarkUserSessionsRevokedParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkUserSessionsRevoked", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkUserSessionsRevoked indicates an expected call of MarkUserSessionsRevoked.
+func (mr *MockRefreshTokenWriteQueriesMockRecorder) MarkUserSessionsRevoked(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkUserSessionsRevoked", reflect.TypeOf((*MockRefreshTokenWriteQueries)(nil).MarkUserSessionsRevoked), ctx, db, arg)
+}
+
+// RevokeRefreshTokenSession mocks base method.
+func (m *MockRefreshTokenWriteQueries) RevokeRefreshTokenSession(ctx context.Context, db sqlc.DBTX, arg sqlc.RevokeRefreshTokenSessionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeRefreshTokenSession", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeRefreshTokenSession indicates an expected call of RevokeRefreshTokenSession.
+func (mr *MockRefreshTokenWriteQueriesMockRecorder) RevokeRefreshTokenSession(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeRefreshTokenSession", reflect.TypeOf((*MockRefreshTokenWriteQueries)(nil).RevokeRefreshTokenSession), ctx, db, arg)
+}
+
+// RevokeUserRefreshTokens mocks base method.
+func (m *MockRefreshTokenWriteQueries) RevokeUserRefreshTokens(ctx context.Context, db sqlc.DBTX, arg sqlc.RevokeUserRefreshTokensParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeUserRefreshTokens", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeUserRefreshTokens indicates an expected call of RevokeUserRefreshTokens.
+func (mr *MockRefreshTokenWriteQueriesMockRecorder) RevokeUserRefreshTokens(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUserRefreshTokens", reflect.TypeOf((*MockRefreshTokenWriteQueries)(nil).RevokeUserRefreshTokens), ctx, db, arg)
+}
+
+// RevokeUserSession mocks base method.
+func (m *MockRefreshTokenWriteQueries) RevokeUserSession(ctx context.Context, db sqlc.DBTX, arg sqlc.RevokeUserSessionParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeUserSession", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeUserSession indicates an expected call of RevokeUserSession.
+func (mr *MockRefreshTokenWriteQueriesMockRecorder) RevokeUserSession(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUserSession", reflect.TypeOf((*MockRefreshTokenWriteQueries)(nil).RevokeUserSession), ctx, db, arg)
+}
+
+// UpsertUserSession mocks base method.
+func (m *MockRefreshTokenWriteQueries) UpsertUserSession(ctx context.Context, db sqlc.DBTX, arg sqlc.UpsertUserSessionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertUserSession", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertUserSession indicates an expected call of UpsertUserSession.
+func (mr *MockRefreshTokenWriteQueriesMockRecorder) UpsertUserSession(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertUserSession", reflect.TypeOf((*MockRefreshTokenWriteQueries)(nil).UpsertUserSession), ctx, db, arg)
+}
+
+// UpsertUserSessionCutoff mocks base method.
+func (m *MockRefreshTokenWriteQueries) UpsertUserSessionCutoff(ctx context.Context, db sqlc.DBTX, arg sqlc.UpsertUserSessionCutoffParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertUserSessionCutoff", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertUserSessionCutoff indicates an expected call of UpsertUserSessionCutoff.
+func (mr *MockRefreshTokenWriteQueriesMockRecorder) UpsertUserSessionCutoff(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertUserSessionCutoff", reflect.TypeOf((*MockRefreshTokenWriteQueries)(nil).UpsertUserSessionCutoff), ctx, db, arg)
+}
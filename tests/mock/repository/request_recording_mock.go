@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/repository/request_recording.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/repository/request_recording.go -destination=tests/mock/repository/request_recording_mock.go -package=repositorymock
+//
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRequestRecordingWriteQueries is a mock of RequestRecordingWriteQueries interface.
+type MockRequestRecordingWriteQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockRequestRecordingWriteQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockRequestRecordingWriteQueriesMockRecorder is the mock recorder for MockRequestRecordingWriteQueries.
+type MockRequestRecordingWriteQueriesMockRecorder struct {
+	mock *MockRequestRecordingWriteQueries
+}
+
+// NewMockRequestRecordingWriteQueries creates a new mock instance.
+func NewMockRequestRecordingWriteQueries(ctrl *gomock.Controller) *MockRequestRecordingWriteQueries {
+	mock := &MockRequestRecordingWriteQueries{ctrl: ctrl}
+	mock.recorder = &MockRequestRecordingWriteQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRequestRecordingWriteQueries) EXPECT() *MockRequestRecordingWriteQueriesMockRecorder {
+	return m.recorder
+}
+
+// ClaimRequestRecordingSlot mocks base method.
+func (m *MockRequestRecordingWriteQueries) ClaimRequestRecordingSlot(ctx context.Context, db sqlc.DBTX, arg sqlc.ClaimRequestRecordingSlotParams) (sqlc.ClaimRequestRecordingSlotRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimRequestRecordingSlot", ctx, db, arg)
+	ret0, _ := ret[0].(sqlc.ClaimRequestRecordingSlotRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimRequestRecordingSlot indicates an expected call of ClaimRequestRecordingSlot.
+func (mr *MockRequestRecordingWriteQueriesMockRecorder) ClaimRequestRecordingSlot(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimRequestRecordingSlot", reflect.TypeOf((*MockRequestRecordingWriteQueries)(nil).ClaimRequestRecordingSlot), ctx, db, arg)
+}
+
+// CreateRecordedRequest mocks base method.
+func (m *MockRequestRecordingWriteQueries) CreateRecordedRequest(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateRecordedRequestParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRecordedRequest", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRecordedRequest indicates an expected call of CreateRecordedRequest.
+func (mr *MockRequestRecordingWriteQueriesMockRecorder) CreateRecordedRequest(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecordedRequest", reflect.TypeOf((*MockRequestRecordingWriteQueries)(nil).CreateRecordedRequest), ctx, db, arg)
+}
+
+// CreateRequestRecording mocks base method.
+func (m *MockRequestRecordingWriteQueries) CreateRequestRecording(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateRequestRecordingParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRequestRecording", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRequestRecording indicates an expected call of CreateRequestRecording.
+func (mr *MockRequestRecordingWriteQueriesMockRecorder) CreateRequestRecording(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRequestRecording", reflect.TypeOf((*MockRequestRecordingWriteQueries)(nil).CreateRequestRecording), ctx, db, arg)
+}
+
+// DeleteRequestRecording mocks base method.
+func (m *MockRequestRecordingWriteQueries) DeleteRequestRecording(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRequestRecording", ctx, db, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRequestRecording indicates an expected call of DeleteRequestRecording.
+func (mr *MockRequestRecordingWriteQueriesMockRecorder) DeleteRequestRecording(ctx, db, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRequestRecording", reflect.TypeOf((*MockRequestRecordingWriteQueries)(nil).DeleteRequestRecording), ctx, db, id)
+}
+
+// ListRecordedRequestKeys mocks base method.
+func (m *MockRequestRecordingWriteQueries) ListRecordedRequestKeys(ctx context.Context, db sqlc.DBTX, recordingID uuid.UUID) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRecordedRequestKeys", ctx, db, recordingID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRecordedRequestKeys indicates an expected call of ListRecordedRequestKeys.
+func (mr *MockRequestRecordingWriteQueriesMockRecorder) ListRecordedRequestKeys(ctx, db, recordingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecordedRequestKeys", reflect.TypeOf((*MockRequestRecordingWriteQueries)(nil).ListRecordedRequestKeys), ctx, db, recordingID)
+}
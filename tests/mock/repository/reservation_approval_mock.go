@@ -0,0 +1,130 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/repository/reservation_approval.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/repository/reservation_approval.go -destination=tests/mock/repository/reservation_approval_mock.go -package=repositorymock
+//
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReservationApprovalWriteQueries is a mock of ReservationApprovalWriteQueries interface.
+type MockReservationApprovalWriteQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockReservationApprovalWriteQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockReservationApprovalWriteQueriesMockRecorder is the mock recorder for MockReservationApprovalWriteQueries.
+type MockReservationApprovalWriteQueriesMockRecorder struct {
+	mock *MockReservationApprovalWriteQueries
+}
+
+// NewMockReservationApprovalWriteQueries creates a new mock instance.
+func NewMockReservationApprovalWriteQueries(ctrl *gomock.Controller) *MockReservationApprovalWriteQueries {
+	mock := &MockReservationApprovalWriteQueries{ctrl: ctrl}
+	mock.recorder = &MockReservationApprovalWriteQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReservationApprovalWriteQueries) EXPECT() *MockReservationApprovalWriteQueriesMockRecorder {
+	return m.recorder
+}
+
+// AddResourceApprover mocks base method.
+func (m *MockReservationApprovalWriteQueries) AddResourceApprover(ctx context.Context, db sqlc.DBTX, arg sqlc.AddResourceApproverParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddResourceApprover", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddResourceApprover indicates an expected call of AddResourceApprover.
+func (mr *MockReservationApprovalWriteQueriesMockRecorder) AddResourceApprover(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddResourceApprover", reflect.TypeOf((*MockReservationApprovalWriteQueries)(nil).AddResourceApprover), ctx, db, arg)
+}
+
+// CreateReservationApproval mocks base method.
+func (m *MockReservationApprovalWriteQueries) CreateReservationApproval(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateReservationApprovalParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReservationApproval", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateReservationApproval indicates an expected call of CreateReservationApproval.
+func (mr *MockReservationApprovalWriteQueriesMockRecorder) CreateReservationApproval(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReservationApproval", reflect.TypeOf((*MockReservationApprovalWriteQueries)(nil).CreateReservationApproval), ctx, db, arg)
+}
+
+// DecideReservationApproval mocks base method.
+func (m *MockReservationApprovalWriteQueries) DecideReservationApproval(ctx context.Context, db sqlc.DBTX, arg sqlc.DecideReservationApprovalParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecideReservationApproval", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecideReservationApproval indicates an expected call of DecideReservationApproval.
+func (mr *MockReservationApprovalWriteQueriesMockRecorder) DecideReservationApproval(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecideReservationApproval", reflect.TypeOf((*MockReservationApprovalWriteQueries)(nil).DecideReservationApproval), ctx, db, arg)
+}
+
+// ExpireReservationApprovals mocks base method.
+func (m *MockReservationApprovalWriteQueries) ExpireReservationApprovals(ctx context.Context, db sqlc.DBTX, arg sqlc.ExpireReservationApprovalsParams) ([]sqlc.ExpireReservationApprovalsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireReservationApprovals", ctx, db, arg)
+	ret0, _ := ret[0].([]sqlc.ExpireReservationApprovalsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireReservationApprovals indicates an expected call of ExpireReservationApprovals.
+func (mr *MockReservationApprovalWriteQueriesMockRecorder) ExpireReservationApprovals(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireReservationApprovals", reflect.TypeOf((*MockReservationApprovalWriteQueries)(nil).ExpireReservationApprovals), ctx, db, arg)
+}
+
+// MarkReservationApprovalEscalated mocks base method.
+func (m *MockReservationApprovalWriteQueries) MarkReservationApprovalEscalated(ctx context.Context, db sqlc.DBTX, arg sqlc.MarkReservationApprovalEscalatedParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkReservationApprovalEscalated", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkReservationApprovalEscalated indicates an expected call of MarkReservationApprovalEscalated.
+func (mr *MockReservationApprovalWriteQueriesMockRecorder) MarkReservationApprovalEscalated(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkReservationApprovalEscalated", reflect.TypeOf((*MockReservationApprovalWriteQueries)(nil).MarkReservationApprovalEscalated), ctx, db, arg)
+}
+
+// RemoveResourceApprover mocks base method.
+func (m *MockReservationApprovalWriteQueries) RemoveResourceApprover(ctx context.Context, db sqlc.DBTX, arg sqlc.RemoveResourceApproverParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveResourceApprover", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveResourceApprover indicates an expected call of RemoveResourceApprover.
+func (mr *MockReservationApprovalWriteQueriesMockRecorder) RemoveResourceApprover(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveResourceApprover", reflect.TypeOf((*MockReservationApprovalWriteQueries)(nil).RemoveResourceApprover), ctx, db, arg)
+}
@@ -42,6 +42,36 @@ func (m *MockReservationWriteQueries) EXPECT() *MockReservationWriteQueriesMockR
 	return m.recorder
 }
 
+// CancelReservation mocks base method.
+func (m *MockReservationWriteQueries) CancelReservation(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelReservation", ctx, db, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelReservation indicates an expected call of CancelReservation.
+func (mr *MockReservationWriteQueriesMockRecorder) CancelReservation(ctx, db, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelReservation", reflect.TypeOf((*MockReservationWriteQueries)(nil).CancelReservation), ctx, db, id)
+}
+
+// CancelReservationsInRange mocks base method.
+func (m *MockReservationWriteQueries) CancelReservationsInRange(ctx context.Context, db sqlc.DBTX, arg sqlc.CancelReservationsInRangeParams) ([]sqlc.CancelReservationsInRangeRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelReservationsInRange", ctx, db, arg)
+	ret0, _ := ret[0].([]sqlc.CancelReservationsInRangeRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelReservationsInRange indicates an expected call of CancelReservationsInRange.
+func (mr *MockReservationWriteQueriesMockRecorder) CancelReservationsInRange(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelReservationsInRange", reflect.TypeOf((*MockReservationWriteQueries)(nil).CancelReservationsInRange), ctx, db, arg)
+}
+
 // CreateReservation mocks base method.
 func (m *MockReservationWriteQueries) CreateReservation(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateReservationParams) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -56,3 +86,76 @@ func (mr *MockReservationWriteQueriesMockRecorder) CreateReservation(ctx, db, ar
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReservation", reflect.TypeOf((*MockReservationWriteQueries)(nil).CreateReservation), ctx, db, arg)
 }
+
+// CreateReservationDiscount mocks base method.
+func (m *MockReservationWriteQueries) CreateReservationDiscount(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateReservationDiscountParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReservationDiscount", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateReservationDiscount indicates an expected call of CreateReservationDiscount.
+func (mr *MockReservationWriteQueriesMockRecorder) CreateReservationDiscount(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReservationDiscount", reflect.TypeOf((*MockReservationWriteQueries)(nil).CreateReservationDiscount), ctx, db, arg)
+}
+
+// HasReservationInSlot mocks base method.
+func (m *MockReservationWriteQueries) HasReservationInSlot(ctx context.Context, db sqlc.DBTX, arg sqlc.HasReservationInSlotParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasReservationInSlot", ctx, db, arg)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasReservationInSlot indicates an expected call of HasReservationInSlot.
+func (mr *MockReservationWriteQueriesMockRecorder) HasReservationInSlot(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasReservationInSlot", reflect.TypeOf((*MockReservationWriteQueries)(nil).HasReservationInSlot), ctx, db, arg)
+}
+
+// ResolvePendingReservation mocks base method.
+func (m *MockReservationWriteQueries) ResolvePendingReservation(ctx context.Context, db sqlc.DBTX, arg sqlc.ResolvePendingReservationParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolvePendingReservation", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolvePendingReservation indicates an expected call of ResolvePendingReservation.
+func (mr *MockReservationWriteQueriesMockRecorder) ResolvePendingReservation(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolvePendingReservation", reflect.TypeOf((*MockReservationWriteQueries)(nil).ResolvePendingReservation), ctx, db, arg)
+}
+
+// SetReservationGroup mocks base method.
+func (m *MockReservationWriteQueries) SetReservationGroup(ctx context.Context, db sqlc.DBTX, arg sqlc.SetReservationGroupParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReservationGroup", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetReservationGroup indicates an expected call of SetReservationGroup.
+func (mr *MockReservationWriteQueriesMockRecorder) SetReservationGroup(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReservationGroup", reflect.TypeOf((*MockReservationWriteQueries)(nil).SetReservationGroup), ctx, db, arg)
+}
+
+// TransferReservation mocks base method.
+func (m *MockReservationWriteQueries) TransferReservation(ctx context.Context, db sqlc.DBTX, arg sqlc.TransferReservationParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferReservation", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferReservation indicates an expected call of TransferReservation.
+func (mr *MockReservationWriteQueriesMockRecorder) TransferReservation(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferReservation", reflect.TypeOf((*MockReservationWriteQueries)(nil).TransferReservation), ctx, db, arg)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/repository/resource.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/repository/resource.go -destination=tests/mock/repository/resource_mock.go -package=repositorymock
+//
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockResourceWriteQueries is a mock of ResourceWriteQueries interface.
+type MockResourceWriteQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockResourceWriteQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockResourceWriteQueriesMockRecorder is the mock recorder for MockResourceWriteQueries.
+type MockResourceWriteQueriesMockRecorder struct {
+	mock *MockResourceWriteQueries
+}
+
+// NewMockResourceWriteQueries creates a new mock instance.
+func NewMockResourceWriteQueries(ctrl *gomock.Controller) *MockResourceWriteQueries {
+	mock := &MockResourceWriteQueries{ctrl: ctrl}
+	mock.recorder = &MockResourceWriteQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResourceWriteQueries) EXPECT() *MockResourceWriteQueriesMockRecorder {
+	return m.recorder
+}
+
+// CreateResources mocks base method.
+func (m *MockResourceWriteQueries) CreateResources(ctx context.Context, db sqlc.DBTX, arg []sqlc.CreateResourcesParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateResources", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateResources indicates an expected call of CreateResources.
+func (mr *MockResourceWriteQueriesMockRecorder) CreateResources(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateResources", reflect.TypeOf((*MockResourceWriteQueries)(nil).CreateResources), ctx, db, arg)
+}
@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/repository/resource_operator.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/repository/resource_operator.go -destination=tests/mock/repository/resource_operator_mock.go -package=repositorymock
+//
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockResourceOperatorWriteQueries is a mock of ResourceOperatorWriteQueries interface.
+type MockResourceOperatorWriteQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockResourceOperatorWriteQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockResourceOperatorWriteQueriesMockRecorder is the mock recorder for MockResourceOperatorWriteQueries.
+type MockResourceOperatorWriteQueriesMockRecorder struct {
+	mock *MockResourceOperatorWriteQueries
+}
+
+// NewMockResourceOperatorWriteQueries creates a new mock instance.
+func NewMockResourceOperatorWriteQueries(ctrl *gomock.Controller) *MockResourceOperatorWriteQueries {
+	mock := &MockResourceOperatorWriteQueries{ctrl: ctrl}
+	mock.recorder = &MockResourceOperatorWriteQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResourceOperatorWriteQueries) EXPECT() *MockResourceOperatorWriteQueriesMockRecorder {
+	return m.recorder
+}
+
+// AssignResourceOperator mocks base method.
+func (m *MockResourceOperatorWriteQueries) AssignResourceOperator(ctx context.Context, db sqlc.DBTX, arg sqlc.AssignResourceOperatorParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignResourceOperator", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignResourceOperator indicates an expected call of AssignResourceOperator.
+func (mr *MockResourceOperatorWriteQueriesMockRecorder) AssignResourceOperator(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignResourceOperator", reflect.TypeOf((*MockResourceOperatorWriteQueries)(nil).AssignResourceOperator), ctx, db, arg)
+}
+
+// UnassignResourceOperator mocks base method.
+func (m *MockResourceOperatorWriteQueries) UnassignResourceOperator(ctx context.Context, db sqlc.DBTX, arg sqlc.UnassignResourceOperatorParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnassignResourceOperator", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnassignResourceOperator indicates an expected call of UnassignResourceOperator.
+func (mr *MockResourceOperatorWriteQueriesMockRecorder) UnassignResourceOperator(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnassignResourceOperator", reflect.TypeOf((*MockResourceOperatorWriteQueries)(nil).UnassignResourceOperator), ctx, db, arg)
+}
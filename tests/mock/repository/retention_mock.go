@@ -0,0 +1,283 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/repository/retention.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/repository/retention.go -destination=tests/mock/repository/retention_mock.go -package=repositorymock
+//
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	pgtype "github.com/jackc/pgx/v5/pgtype"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRetentionQueries is a mock of RetentionQueries interface.
+type MockRetentionQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockRetentionQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockRetentionQueriesMockRecorder is the mock recorder for MockRetentionQueries.
+type MockRetentionQueriesMockRecorder struct {
+	mock *MockRetentionQueries
+}
+
+// NewMockRetentionQueries creates a new mock instance.
+func NewMockRetentionQueries(ctrl *gomock.Controller) *MockRetentionQueries {
+	mock := &MockRetentionQueries{ctrl: ctrl}
+	mock.recorder = &MockRetentionQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRetentionQueries) EXPECT() *MockRetentionQueriesMockRecorder {
+	return m.recorder
+}
+
+// CountRetentionAuditLogs mocks base method.
+func (m *MockRetentionQueries) CountRetentionAuditLogs(ctx context.Context, db sqlc.DBTX, cutoff pgtype.Timestamptz) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountRetentionAuditLogs", ctx, db, cutoff)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountRetentionAuditLogs indicates an expected call of CountRetentionAuditLogs.
+func (mr *MockRetentionQueriesMockRecorder) CountRetentionAuditLogs(ctx, db, cutoff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountRetentionAuditLogs", reflect.TypeOf((*MockRetentionQueries)(nil).CountRetentionAuditLogs), ctx, db, cutoff)
+}
+
+// CountRetentionIdempotencyKeys mocks base method.
+func (m *MockRetentionQueries) CountRetentionIdempotencyKeys(ctx context.Context, db sqlc.DBTX, cutoff pgtype.Timestamptz) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountRetentionIdempotencyKeys", ctx, db, cutoff)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountRetentionIdempotencyKeys indicates an expected call of CountRetentionIdempotencyKeys.
+func (mr *MockRetentionQueriesMockRecorder) CountRetentionIdempotencyKeys(ctx, db, cutoff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountRetentionIdempotencyKeys", reflect.TypeOf((*MockRetentionQueries)(nil).CountRetentionIdempotencyKeys), ctx, db, cutoff)
+}
+
+// CountRetentionLoginFailures mocks base method.
+func (m *MockRetentionQueries) CountRetentionLoginFailures(ctx context.Context, db sqlc.DBTX, cutoff pgtype.Timestamptz) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountRetentionLoginFailures", ctx, db, cutoff)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountRetentionLoginFailures indicates an expected call of CountRetentionLoginFailures.
+func (mr *MockRetentionQueriesMockRecorder) CountRetentionLoginFailures(ctx, db, cutoff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountRetentionLoginFailures", reflect.TypeOf((*MockRetentionQueries)(nil).CountRetentionLoginFailures), ctx, db, cutoff)
+}
+
+// CountRetentionNotificationJobs mocks base method.
+func (m *MockRetentionQueries) CountRetentionNotificationJobs(ctx context.Context, db sqlc.DBTX, cutoff pgtype.Timestamptz) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountRetentionNotificationJobs", ctx, db, cutoff)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountRetentionNotificationJobs indicates an expected call of CountRetentionNotificationJobs.
+func (mr *MockRetentionQueriesMockRecorder) CountRetentionNotificationJobs(ctx, db, cutoff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountRetentionNotificationJobs", reflect.TypeOf((*MockRetentionQueries)(nil).CountRetentionNotificationJobs), ctx, db, cutoff)
+}
+
+// CountRetentionRefreshTokens mocks base method.
+func (m *MockRetentionQueries) CountRetentionRefreshTokens(ctx context.Context, db sqlc.DBTX, cutoff pgtype.Timestamptz) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountRetentionRefreshTokens", ctx, db, cutoff)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountRetentionRefreshTokens indicates an expected call of CountRetentionRefreshTokens.
+func (mr *MockRetentionQueriesMockRecorder) CountRetentionRefreshTokens(ctx, db, cutoff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountRetentionRefreshTokens", reflect.TypeOf((*MockRetentionQueries)(nil).CountRetentionRefreshTokens), ctx, db, cutoff)
+}
+
+// CountRetentionRevokedTokens mocks base method.
+func (m *MockRetentionQueries) CountRetentionRevokedTokens(ctx context.Context, db sqlc.DBTX, cutoff pgtype.Timestamptz) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountRetentionRevokedTokens", ctx, db, cutoff)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountRetentionRevokedTokens indicates an expected call of CountRetentionRevokedTokens.
+func (mr *MockRetentionQueriesMockRecorder) CountRetentionRevokedTokens(ctx, db, cutoff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountRetentionRevokedTokens", reflect.TypeOf((*MockRetentionQueries)(nil).CountRetentionRevokedTokens), ctx, db, cutoff)
+}
+
+// CountRetentionUnverifiedUsers mocks base method.
+func (m *MockRetentionQueries) CountRetentionUnverifiedUsers(ctx context.Context, db sqlc.DBTX, cutoff pgtype.Timestamptz) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountRetentionUnverifiedUsers", ctx, db, cutoff)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountRetentionUnverifiedUsers indicates an expected call of CountRetentionUnverifiedUsers.
+func (mr *MockRetentionQueriesMockRecorder) CountRetentionUnverifiedUsers(ctx, db, cutoff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountRetentionUnverifiedUsers", reflect.TypeOf((*MockRetentionQueries)(nil).CountRetentionUnverifiedUsers), ctx, db, cutoff)
+}
+
+// CountRetentionUserSessions mocks base method.
+func (m *MockRetentionQueries) CountRetentionUserSessions(ctx context.Context, db sqlc.DBTX, cutoff pgtype.Timestamptz) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountRetentionUserSessions", ctx, db, cutoff)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountRetentionUserSessions indicates an expected call of CountRetentionUserSessions.
+func (mr *MockRetentionQueriesMockRecorder) CountRetentionUserSessions(ctx, db, cutoff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountRetentionUserSessions", reflect.TypeOf((*MockRetentionQueries)(nil).CountRetentionUserSessions), ctx, db, cutoff)
+}
+
+// DeleteRetentionAuditLogsBatch mocks base method.
+func (m *MockRetentionQueries) DeleteRetentionAuditLogsBatch(ctx context.Context, db sqlc.DBTX, arg sqlc.DeleteRetentionAuditLogsBatchParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRetentionAuditLogsBatch", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRetentionAuditLogsBatch indicates an expected call of DeleteRetentionAuditLogsBatch.
+func (mr *MockRetentionQueriesMockRecorder) DeleteRetentionAuditLogsBatch(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRetentionAuditLogsBatch", reflect.TypeOf((*MockRetentionQueries)(nil).DeleteRetentionAuditLogsBatch), ctx, db, arg)
+}
+
+// DeleteRetentionIdempotencyKeysBatch mocks base method.
+func (m *MockRetentionQueries) DeleteRetentionIdempotencyKeysBatch(ctx context.Context, db sqlc.DBTX, arg sqlc.DeleteRetentionIdempotencyKeysBatchParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRetentionIdempotencyKeysBatch", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRetentionIdempotencyKeysBatch indicates an expected call of DeleteRetentionIdempotencyKeysBatch.
+func (mr *MockRetentionQueriesMockRecorder) DeleteRetentionIdempotencyKeysBatch(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRetentionIdempotencyKeysBatch", reflect.TypeOf((*MockRetentionQueries)(nil).DeleteRetentionIdempotencyKeysBatch), ctx, db, arg)
+}
+
+// DeleteRetentionLoginFailuresBatch mocks base method.
+func (m *MockRetentionQueries) DeleteRetentionLoginFailuresBatch(ctx context.Context, db sqlc.DBTX, arg sqlc.DeleteRetentionLoginFailuresBatchParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRetentionLoginFailuresBatch", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRetentionLoginFailuresBatch indicates an expected call of DeleteRetentionLoginFailuresBatch.
+func (mr *MockRetentionQueriesMockRecorder) DeleteRetentionLoginFailuresBatch(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRetentionLoginFailuresBatch", reflect.TypeOf((*MockRetentionQueries)(nil).DeleteRetentionLoginFailuresBatch), ctx, db, arg)
+}
+
+// DeleteRetentionNotificationJobsBatch mocks base method.
+func (m *MockRetentionQueries) DeleteRetentionNotificationJobsBatch(ctx context.Context, db sqlc.DBTX, arg sqlc.DeleteRetentionNotificationJobsBatchParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRetentionNotificationJobsBatch", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRetentionNotificationJobsBatch indicates an expected call of DeleteRetentionNotificationJobsBatch.
+func (mr *MockRetentionQueriesMockRecorder) DeleteRetentionNotificationJobsBatch(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRetentionNotificationJobsBatch", reflect.TypeOf((*MockRetentionQueries)(nil).DeleteRetentionNotificationJobsBatch), ctx, db, arg)
+}
+
+// DeleteRetentionRefreshTokensBatch mocks base method.
+func (m *MockRetentionQueries) DeleteRetentionRefreshTokensBatch(ctx context.Context, db sqlc.DBTX, arg sqlc.DeleteRetentionRefreshTokensBatchParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRetentionRefreshTokensBatch", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRetentionRefreshTokensBatch indicates an expected call of DeleteRetentionRefreshTokensBatch.
+func (mr *MockRetentionQueriesMockRecorder) DeleteRetentionRefreshTokensBatch(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRetentionRefreshTokensBatch", reflect.TypeOf((*MockRetentionQueries)(nil).DeleteRetentionRefreshTokensBatch), ctx, db, arg)
+}
+
+// DeleteRetentionRevokedTokensBatch mocks base method.
+func (m *MockRetentionQueries) DeleteRetentionRevokedTokensBatch(ctx context.Context, db sqlc.DBTX, arg sqlc.DeleteRetentionRevokedTokensBatchParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRetentionRevokedTokensBatch", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRetentionRevokedTokensBatch indicates an expected call of DeleteRetentionRevokedTokensBatch.
+func (mr *MockRetentionQueriesMockRecorder) DeleteRetentionRevokedTokensBatch(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRetentionRevokedTokensBatch", reflect.TypeOf((*MockRetentionQueries)(nil).DeleteRetentionRevokedTokensBatch), ctx, db, arg)
+}
+
+// DeleteRetentionUnverifiedUsersBatch mocks base method.
+func (m *MockRetentionQueries) DeleteRetentionUnverifiedUsersBatch(ctx context.Context, db sqlc.DBTX, arg sqlc.DeleteRetentionUnverifiedUsersBatchParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRetentionUnverifiedUsersBatch", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRetentionUnverifiedUsersBatch indicates an expected call of DeleteRetentionUnverifiedUsersBatch.
+func (mr *MockRetentionQueriesMockRecorder) DeleteRetentionUnverifiedUsersBatch(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRetentionUnverifiedUsersBatch", reflect.TypeOf((*MockRetentionQueries)(nil).DeleteRetentionUnverifiedUsersBatch), ctx, db, arg)
+}
+
+// DeleteRetentionUserSessionsBatch mocks base method.
+func (m *MockRetentionQueries) DeleteRetentionUserSessionsBatch(ctx context.Context, db sqlc.DBTX, arg sqlc.DeleteRetentionUserSessionsBatchParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRetentionUserSessionsBatch", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRetentionUserSessionsBatch indicates an expected call of DeleteRetentionUserSessionsBatch.
+func (mr *MockRetentionQueriesMockRecorder) DeleteRetentionUserSessionsBatch(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRetentionUserSessionsBatch", reflect.TypeOf((*MockRetentionQueries)(nil).DeleteRetentionUserSessionsBatch), ctx, db, arg)
+}
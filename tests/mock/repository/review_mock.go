@@ -72,6 +72,36 @@ func (mr *MockReviewWriteQueriesMockRecorder) DeleteReview(ctx, db, id any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReview", reflect.TypeOf((*MockReviewWriteQueries)(nil).DeleteReview), ctx, db, id)
 }
 
+// ImportReview mocks base method.
+func (m *MockReviewWriteQueries) ImportReview(ctx context.Context, db sqlc.DBTX, arg sqlc.ImportReviewParams) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportReview", ctx, db, arg)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportReview indicates an expected call of ImportReview.
+func (mr *MockReviewWriteQueriesMockRecorder) ImportReview(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportReview", reflect.TypeOf((*MockReviewWriteQueries)(nil).ImportReview), ctx, db, arg)
+}
+
+// PublishReview mocks base method.
+func (m *MockReviewWriteQueries) PublishReview(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishReview", ctx, db, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishReview indicates an expected call of PublishReview.
+func (mr *MockReviewWriteQueriesMockRecorder) PublishReview(ctx, db, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishReview", reflect.TypeOf((*MockReviewWriteQueries)(nil).PublishReview), ctx, db, id)
+}
+
 // UpdateReview mocks base method.
 func (m *MockReviewWriteQueries) UpdateReview(ctx context.Context, db sqlc.DBTX, arg sqlc.UpdateReviewParams) (int32, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,113 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/repository/role.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/repository/role.go -destination=tests/mock/repository/role_mock.go -package=repositorymock
+//
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRoleWriteQueries is a mock of RoleWriteQueries interface.
+type MockRoleWriteQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoleWriteQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockRoleWriteQueriesMockRecorder is the mock recorder for MockRoleWriteQueries.
+type MockRoleWriteQueriesMockRecorder struct {
+	mock *MockRoleWriteQueries
+}
+
+// NewMockRoleWriteQueries creates a new mock instance.
+func NewMockRoleWriteQueries(ctrl *gomock.Controller) *MockRoleWriteQueries {
+	mock := &MockRoleWriteQueries{ctrl: ctrl}
+	mock.recorder = &MockRoleWriteQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoleWriteQueries) EXPECT() *MockRoleWriteQueriesMockRecorder {
+	return m.recorder
+}
+
+// AddRolePermissions mocks base method.
+func (m *MockRoleWriteQueries) AddRolePermissions(ctx context.Context, db sqlc.DBTX, arg []sqlc.AddRolePermissionsParams) *sqlc.AddRolePermissionsBatchResults {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRolePermissions", ctx, db, arg)
+	ret0, _ := ret[0].(*sqlc.AddRolePermissionsBatchResults)
+	return ret0
+}
+
+// AddRolePermissions indicates an expected call of AddRolePermissions.
+func (mr *MockRoleWriteQueriesMockRecorder) AddRolePermissions(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRolePermissions", reflect.TypeOf((*MockRoleWriteQueries)(nil).AddRolePermissions), ctx, db, arg)
+}
+
+// CreateRole mocks base method.
+func (m *MockRoleWriteQueries) CreateRole(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateRoleParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRole", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRole indicates an expected call of CreateRole.
+func (mr *MockRoleWriteQueriesMockRecorder) CreateRole(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRole", reflect.TypeOf((*MockRoleWriteQueries)(nil).CreateRole), ctx, db, arg)
+}
+
+// DeleteRole mocks base method.
+func (m *MockRoleWriteQueries) DeleteRole(ctx context.Context, db sqlc.DBTX, name string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRole", ctx, db, name)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRole indicates an expected call of DeleteRole.
+func (mr *MockRoleWriteQueriesMockRecorder) DeleteRole(ctx, db, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockRoleWriteQueries)(nil).DeleteRole), ctx, db, name)
+}
+
+// DeleteRolePermissions mocks base method.
+func (m *MockRoleWriteQueries) DeleteRolePermissions(ctx context.Context, db sqlc.DBTX, roleName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRolePermissions", ctx, db, roleName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRolePermissions indicates an expected call of DeleteRolePermissions.
+func (mr *MockRoleWriteQueriesMockRecorder) DeleteRolePermissions(ctx, db, roleName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRolePermissions", reflect.TypeOf((*MockRoleWriteQueries)(nil).DeleteRolePermissions), ctx, db, roleName)
+}
+
+// TouchRole mocks base method.
+func (m *MockRoleWriteQueries) TouchRole(ctx context.Context, db sqlc.DBTX, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchRole", ctx, db, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchRole indicates an expected call of TouchRole.
+func (mr *MockRoleWriteQueriesMockRecorder) TouchRole(ctx, db, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchRole", reflect.TypeOf((*MockRoleWriteQueries)(nil).TouchRole), ctx, db, name)
+}
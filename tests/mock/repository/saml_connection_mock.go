@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/repository/saml_connection.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/repository/saml_connection.go -destination=tests/mock/repository/saml_connection_mock.go -package=repositorymock
+//
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSAMLConnectionWriteQueries is a mock of SAMLConnectionWriteQueries interface.
+type MockSAMLConnectionWriteQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockSAMLConnectionWriteQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockSAMLConnectionWriteQueriesMockRecorder is the mock recorder for MockSAMLConnectionWriteQueries.
+type MockSAMLConnectionWriteQueriesMockRecorder struct {
+	mock *MockSAMLConnectionWriteQueries
+}
+
+// NewMockSAMLConnectionWriteQueries creates a new mock instance.
+func NewMockSAMLConnectionWriteQueries(ctrl *gomock.Controller) *MockSAMLConnectionWriteQueries {
+	mock := &MockSAMLConnectionWriteQueries{ctrl: ctrl}
+	mock.recorder = &MockSAMLConnectionWriteQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSAMLConnectionWriteQueries) EXPECT() *MockSAMLConnectionWriteQueriesMockRecorder {
+	return m.recorder
+}
+
+// DeleteSAMLConnection mocks base method.
+func (m *MockSAMLConnectionWriteQueries) DeleteSAMLConnection(ctx context.Context, db sqlc.DBTX, companyID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSAMLConnection", ctx, db, companyID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSAMLConnection indicates an expected call of DeleteSAMLConnection.
+func (mr *MockSAMLConnectionWriteQueriesMockRecorder) DeleteSAMLConnection(ctx, db, companyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSAMLConnection", reflect.TypeOf((*MockSAMLConnectionWriteQueries)(nil).DeleteSAMLConnection), ctx, db, companyID)
+}
+
+// UpsertSAMLConnection mocks base method.
+func (m *MockSAMLConnectionWriteQueries) UpsertSAMLConnection(ctx context.Context, db sqlc.DBTX, arg sqlc.UpsertSAMLConnectionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertSAMLConnection", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertSAMLConnection indicates an expected call of UpsertSAMLConnection.
+func (mr *MockSAMLConnectionWriteQueriesMockRecorder) UpsertSAMLConnection(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertSAMLConnection", reflect.TypeOf((*MockSAMLConnectionWriteQueries)(nil).UpsertSAMLConnection), ctx, db, arg)
+}
@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/repository/user_identity.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/repository/user_identity.go -destination=tests/mock/repository/user_identity_mock.go -package=repositorymock
+//
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserIdentityWriteQueries is a mock of UserIdentityWriteQueries interface.
+type MockUserIdentityWriteQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserIdentityWriteQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockUserIdentityWriteQueriesMockRecorder is the mock recorder for MockUserIdentityWriteQueries.
+type MockUserIdentityWriteQueriesMockRecorder struct {
+	mock *MockUserIdentityWriteQueries
+}
+
+// NewMockUserIdentityWriteQueries creates a new mock instance.
+func NewMockUserIdentityWriteQueries(ctrl *gomock.Controller) *MockUserIdentityWriteQueries {
+	mock := &MockUserIdentityWriteQueries{ctrl: ctrl}
+	mock.recorder = &MockUserIdentityWriteQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserIdentityWriteQueries) EXPECT() *MockUserIdentityWriteQueriesMockRecorder {
+	return m.recorder
+}
+
+// CreateUserIdentity mocks base method.
+func (m *MockUserIdentityWriteQueries) CreateUserIdentity(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateUserIdentityParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserIdentity", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateUserIdentity indicates an expected call of CreateUserIdentity.
+func (mr *MockUserIdentityWriteQueriesMockRecorder) CreateUserIdentity(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserIdentity", reflect.TypeOf((*MockUserIdentityWriteQueries)(nil).CreateUserIdentity), ctx, db, arg)
+}
+
+// GetUserIdentityUserID mocks base method.
+func (m *MockUserIdentityWriteQueries) GetUserIdentityUserID(ctx context.Context, db sqlc.DBTX, arg sqlc.GetUserIdentityUserIDParams) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserIdentityUserID", ctx, db, arg)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserIdentityUserID indicates an expected call of GetUserIdentityUserID.
+func (mr *MockUserIdentityWriteQueriesMockRecorder) GetUserIdentityUserID(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIdentityUserID", reflect.TypeOf((*MockUserIdentityWriteQueries)(nil).GetUserIdentityUserID), ctx, db, arg)
+}
+
+// TouchUserIdentity mocks base method.
+func (m *MockUserIdentityWriteQueries) TouchUserIdentity(ctx context.Context, db sqlc.DBTX, arg sqlc.TouchUserIdentityParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchUserIdentity", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchUserIdentity indicates an expected call of TouchUserIdentity.
+func (mr *MockUserIdentityWriteQueriesMockRecorder) TouchUserIdentity(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchUserIdentity", reflect.TypeOf((*MockUserIdentityWriteQueries)(nil).TouchUserIdentity), ctx, db, arg)
+}
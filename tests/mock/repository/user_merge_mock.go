@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/repository/user_merge.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/repository/user_merge.go -destination=tests/mock/repository/user_merge_mock.go -package=repositorymock
+//
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserMergeWriteQueries is a mock of UserMergeWriteQueries interface.
+type MockUserMergeWriteQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserMergeWriteQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockUserMergeWriteQueriesMockRecorder is the mock recorder for MockUserMergeWriteQueries.
+type MockUserMergeWriteQueriesMockRecorder struct {
+	mock *MockUserMergeWriteQueries
+}
+
+// NewMockUserMergeWriteQueries creates a new mock instance.
+func NewMockUserMergeWriteQueries(ctrl *gomock.Controller) *MockUserMergeWriteQueries {
+	mock := &MockUserMergeWriteQueries{ctrl: ctrl}
+	mock.recorder = &MockUserMergeWriteQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserMergeWriteQueries) EXPECT() *MockUserMergeWriteQueriesMockRecorder {
+	return m.recorder
+}
+
+// CountUserMerge mocks base method.
+func (m *MockUserMergeWriteQueries) CountUserMerge(ctx context.Context, db sqlc.DBTX, arg sqlc.CountUserMergeParams) (sqlc.CountUserMergeRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUserMerge", ctx, db, arg)
+	ret0, _ := ret[0].(sqlc.CountUserMergeRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUserMerge indicates an expected call of CountUserMerge.
+func (mr *MockUserMergeWriteQueriesMockRecorder) CountUserMerge(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUserMerge", reflect.TypeOf((*MockUserMergeWriteQueries)(nil).CountUserMerge), ctx, db, arg)
+}
+
+// ListUserMergeEncryptedMessages mocks base method.
+func (m *MockUserMergeWriteQueries) ListUserMergeEncryptedMessages(ctx context.Context, db sqlc.DBTX, sourceID uuid.UUID) ([]sqlc.ListUserMergeEncryptedMessagesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserMergeEncryptedMessages", ctx, db, sourceID)
+	ret0, _ := ret[0].([]sqlc.ListUserMergeEncryptedMessagesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserMergeEncryptedMessages indicates an expected call of ListUserMergeEncryptedMessages.
+func (mr *MockUserMergeWriteQueriesMockRecorder) ListUserMergeEncryptedMessages(ctx, db, sourceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserMergeEncryptedMessages", reflect.TypeOf((*MockUserMergeWriteQueries)(nil).ListUserMergeEncryptedMessages), ctx, db, sourceID)
+}
+
+// LockUserMergeAccounts mocks base method.
+func (m *MockUserMergeWriteQueries) LockUserMergeAccounts(ctx context.Context, db sqlc.DBTX, arg sqlc.LockUserMergeAccountsParams) ([]sqlc.LockUserMergeAccountsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockUserMergeAccounts", ctx, db, arg)
+	ret0, _ := ret[0].([]sqlc.LockUserMergeAccountsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockUserMergeAccounts indicates an expected call of LockUserMergeAccounts.
+func (mr *MockUserMergeWriteQueriesMockRecorder) LockUserMergeAccounts(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockUserMergeAccounts", reflect.TypeOf((*MockUserMergeWriteQueries)(nil).LockUserMergeAccounts), ctx, db, arg)
+}
+
+// MergeUser mocks base method.
+func (m *MockUserMergeWriteQueries) MergeUser(ctx context.Context, db sqlc.DBTX, arg sqlc.MergeUserParams) (sqlc.MergeUserRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeUser", ctx, db, arg)
+	ret0, _ := ret[0].(sqlc.MergeUserRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeUser indicates an expected call of MergeUser.
+func (mr *MockUserMergeWriteQueriesMockRecorder) MergeUser(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeUser", reflect.TypeOf((*MockUserMergeWriteQueries)(nil).MergeUser), ctx, db, arg)
+}
+
+// ReauthorReservationMessage mocks base method.
+func (m *MockUserMergeWriteQueries) ReauthorReservationMessage(ctx context.Context, db sqlc.DBTX, arg sqlc.ReauthorReservationMessageParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReauthorReservationMessage", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReauthorReservationMessage indicates an expected call of ReauthorReservationMessage.
+func (mr *MockUserMergeWriteQueriesMockRecorder) ReauthorReservationMessage(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReauthorReservationMessage", reflect.TypeOf((*MockUserMergeWriteQueries)(nil).ReauthorReservationMessage), ctx, db, arg)
+}
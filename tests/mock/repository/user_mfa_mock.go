@@ -0,0 +1,172 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/infra/repository/user_mfa.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/infra/repository/user_mfa.go -destination=tests/mock/repository/user_mfa_mock.go -package=repositorymock
+//
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMFAWriteQueries is a mock of MFAWriteQueries interface.
+type MockMFAWriteQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockMFAWriteQueriesMockRecorder
+	isgomock struct{}
+}
+
+// MockMFAWriteQueriesMockRecorder is the mock recorder for MockMFAWriteQueries.
+type MockMFAWriteQueriesMockRecorder struct {
+	mock *MockMFAWriteQueries
+}
+
+// NewMockMFAWriteQueries creates a new mock instance.
+func NewMockMFAWriteQueries(ctrl *gomock.Controller) *MockMFAWriteQueries {
+	mock := &MockMFAWriteQueries{ctrl: ctrl}
+	mock.recorder = &MockMFAWriteQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMFAWriteQueries) EXPECT() *MockMFAWriteQueriesMockRecorder {
+	return m.recorder
+}
+
+// CreateUserMFARecoveryCode mocks base method.
+func (m *MockMFAWriteQueries) CreateUserMFARecoveryCode(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateUserMFARecoveryCodeParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserMFARecoveryCode", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateUserMFARecoveryCode indicates an expected call of CreateUserMFARecoveryCode.
+func (mr *MockMFAWriteQueriesMockRecorder) CreateUserMFARecoveryCode(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserMFARecoveryCode", reflect.TypeOf((*MockMFAWriteQueries)(nil).CreateUserMFARecoveryCode), ctx, db, arg)
+}
+
+// DeleteUserMFA mocks base method.
+func (m *MockMFAWriteQueries) DeleteUserMFA(ctx context.Context, db sqlc.DBTX, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserMFA", ctx, db, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserMFA indicates an expected call of DeleteUserMFA.
+func (mr *MockMFAWriteQueriesMockRecorder) DeleteUserMFA(ctx, db, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserMFA", reflect.TypeOf((*MockMFAWriteQueries)(nil).DeleteUserMFA), ctx, db, userID)
+}
+
+// DeleteUserMFARecoveryCodes mocks base method.
+func (m *MockMFAWriteQueries) DeleteUserMFARecoveryCodes(ctx context.Context, db sqlc.DBTX, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserMFARecoveryCodes", ctx, db, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserMFARecoveryCodes indicates an expected call of DeleteUserMFARecoveryCodes.
+func (mr *MockMFAWriteQueriesMockRecorder) DeleteUserMFARecoveryCodes(ctx, db, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserMFARecoveryCodes", reflect.TypeOf((*MockMFAWriteQueries)(nil).DeleteUserMFARecoveryCodes), ctx, db, userID)
+}
+
+// EnableUserMFA mocks base method.
+func (m *MockMFAWriteQueries) EnableUserMFA(ctx context.Context, db sqlc.DBTX, arg sqlc.EnableUserMFAParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableUserMFA", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableUserMFA indicates an expected call of EnableUserMFA.
+func (mr *MockMFAWriteQueriesMockRecorder) EnableUserMFA(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableUserMFA", reflect.TypeOf((*MockMFAWriteQueries)(nil).EnableUserMFA), ctx, db, arg)
+}
+
+// GetUserMFAForUpdate mocks base method.
+func (m *MockMFAWriteQueries) GetUserMFAForUpdate(ctx context.Context, db sqlc.DBTX, userID uuid.UUID) (sqlc.GetUserMFAForUpdateRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserMFAForUpdate", ctx, db, userID)
+	ret0, _ := ret[0].(sqlc.GetUserMFAForUpdateRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserMFAForUpdate indicates an expected call of GetUserMFAForUpdate.
+func (mr *MockMFAWriteQueriesMockRecorder) GetUserMFAForUpdate(ctx, db, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserMFAForUpdate", reflect.TypeOf((*MockMFAWriteQueries)(nil).GetUserMFAForUpdate), ctx, db, userID)
+}
+
+// IsUserMFAEnabled mocks base method.
+func (m *MockMFAWriteQueries) IsUserMFAEnabled(ctx context.Context, db sqlc.DBTX, userID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsUserMFAEnabled", ctx, db, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsUserMFAEnabled indicates an expected call of IsUserMFAEnabled.
+func (mr *MockMFAWriteQueriesMockRecorder) IsUserMFAEnabled(ctx, db, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsUserMFAEnabled", reflect.TypeOf((*MockMFAWriteQueries)(nil).IsUserMFAEnabled), ctx, db, userID)
+}
+
+// UpdateUserMFAAttempts mocks base method.
+func (m *MockMFAWriteQueries) UpdateUserMFAAttempts(ctx context.Context, db sqlc.DBTX, arg sqlc.UpdateUserMFAAttemptsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserMFAAttempts", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserMFAAttempts indicates an expected call of UpdateUserMFAAttempts.
+func (mr *MockMFAWriteQueriesMockRecorder) UpdateUserMFAAttempts(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserMFAAttempts", reflect.TypeOf((*MockMFAWriteQueries)(nil).UpdateUserMFAAttempts), ctx, db, arg)
+}
+
+// UpsertPendingUserMFA mocks base method.
+func (m *MockMFAWriteQueries) UpsertPendingUserMFA(ctx context.Context, db sqlc.DBTX, arg sqlc.UpsertPendingUserMFAParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertPendingUserMFA", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertPendingUserMFA indicates an expected call of UpsertPendingUserMFA.
+func (mr *MockMFAWriteQueriesMockRecorder) UpsertPendingUserMFA(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertPendingUserMFA", reflect.TypeOf((*MockMFAWriteQueries)(nil).UpsertPendingUserMFA), ctx, db, arg)
+}
+
+// UseUserMFARecoveryCode mocks base method.
+func (m *MockMFAWriteQueries) UseUserMFARecoveryCode(ctx context.Context, db sqlc.DBTX, arg sqlc.UseUserMFARecoveryCodeParams) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseUserMFARecoveryCode", ctx, db, arg)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseUserMFARecoveryCode indicates an expected call of UseUserMFARecoveryCode.
+func (mr *MockMFAWriteQueriesMockRecorder) UseUserMFARecoveryCode(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseUserMFARecoveryCode", reflect.TypeOf((*MockMFAWriteQueries)(nil).UseUserMFARecoveryCode), ctx, db, arg)
+}
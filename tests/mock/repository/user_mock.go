@@ -42,6 +42,35 @@ func (m *MockUserWriteQueries) EXPECT() *MockUserWriteQueriesMockRecorder {
 	return m.recorder
 }
 
+// ActivateUser mocks base method.
+func (m *MockUserWriteQueries) ActivateUser(ctx context.Context, db sqlc.DBTX, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivateUser", ctx, db, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ActivateUser indicates an expected call of ActivateUser.
+func (mr *MockUserWriteQueriesMockRecorder) ActivateUser(ctx, db, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateUser", reflect.TypeOf((*MockUserWriteQueries)(nil).ActivateUser), ctx, db, id)
+}
+
+// CreateInactiveUser mocks base method.
+func (m *MockUserWriteQueries) CreateInactiveUser(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateInactiveUserParams) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInactiveUser", ctx, db, arg)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInactiveUser indicates an expected call of CreateInactiveUser.
+func (mr *MockUserWriteQueriesMockRecorder) CreateInactiveUser(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInactiveUser", reflect.TypeOf((*MockUserWriteQueries)(nil).CreateInactiveUser), ctx, db, arg)
+}
+
 // CreateUser mocks base method.
 func (m *MockUserWriteQueries) CreateUser(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateUserParams) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -57,6 +86,92 @@ func (mr *MockUserWriteQueriesMockRecorder) CreateUser(ctx, db, arg any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUserWriteQueries)(nil).CreateUser), ctx, db, arg)
 }
 
+// GetUserPasswordHashForUpdate mocks base method.
+func (m *MockUserWriteQueries) GetUserPasswordHashForUpdate(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserPasswordHashForUpdate", ctx, db, id)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserPasswordHashForUpdate indicates an expected call of GetUserPasswordHashForUpdate.
+func (mr *MockUserWriteQueriesMockRecorder) GetUserPasswordHashForUpdate(ctx, db, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPasswordHashForUpdate", reflect.TypeOf((*MockUserWriteQueries)(nil).GetUserPasswordHashForUpdate), ctx, db, id)
+}
+
+// SetUserExternalID mocks base method.
+func (m *MockUserWriteQueries) SetUserExternalID(ctx context.Context, db sqlc.DBTX, arg sqlc.SetUserExternalIDParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserExternalID", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUserExternalID indicates an expected call of SetUserExternalID.
+func (mr *MockUserWriteQueriesMockRecorder) SetUserExternalID(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserExternalID", reflect.TypeOf((*MockUserWriteQueries)(nil).SetUserExternalID), ctx, db, arg)
+}
+
+// SetUserPassword mocks base method.
+func (m *MockUserWriteQueries) SetUserPassword(ctx context.Context, db sqlc.DBTX, arg sqlc.SetUserPasswordParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserPassword", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUserPassword indicates an expected call of SetUserPassword.
+func (mr *MockUserWriteQueriesMockRecorder) SetUserPassword(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserPassword", reflect.TypeOf((*MockUserWriteQueries)(nil).SetUserPassword), ctx, db, arg)
+}
+
+// SetUserPhone mocks base method.
+func (m *MockUserWriteQueries) SetUserPhone(ctx context.Context, db sqlc.DBTX, arg sqlc.SetUserPhoneParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserPhone", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUserPhone indicates an expected call of SetUserPhone.
+func (mr *MockUserWriteQueriesMockRecorder) SetUserPhone(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserPhone", reflect.TypeOf((*MockUserWriteQueries)(nil).SetUserPhone), ctx, db, arg)
+}
+
+// SetUserRole mocks base method.
+func (m *MockUserWriteQueries) SetUserRole(ctx context.Context, db sqlc.DBTX, arg sqlc.SetUserRoleParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserRole", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUserRole indicates an expected call of SetUserRole.
+func (mr *MockUserWriteQueriesMockRecorder) SetUserRole(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserRole", reflect.TypeOf((*MockUserWriteQueries)(nil).SetUserRole), ctx, db, arg)
+}
+
+// TouchUserDevice mocks base method.
+func (m *MockUserWriteQueries) TouchUserDevice(ctx context.Context, db sqlc.DBTX, arg sqlc.TouchUserDeviceParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchUserDevice", ctx, db, arg)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TouchUserDevice indicates an expected call of TouchUserDevice.
+func (mr *MockUserWriteQueriesMockRecorder) TouchUserDevice(ctx, db, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchUserDevice", reflect.TypeOf((*MockUserWriteQueries)(nil).TouchUserDevice), ctx, db, arg)
+}
+
 // UpdateUserLastLogin mocks base method.
 func (m *MockUserWriteQueries) UpdateUserLastLogin(ctx context.Context, db sqlc.DBTX, id uuid.UUID) error {
 	m.ctrl.T.Helper()
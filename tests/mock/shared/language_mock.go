@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/shared/language.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/shared/language.go -destination=tests/mock/shared/language_mock.go -package=sharedmock
+//
+
+// Package sharedmock is a generated GoMock package.
+package sharedmock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLanguageDetector is a mock of LanguageDetector interface.
+type MockLanguageDetector struct {
+	ctrl     *gomock.Controller
+	recorder *MockLanguageDetectorMockRecorder
+	isgomock struct{}
+}
+
+// MockLanguageDetectorMockRecorder is the mock recorder for MockLanguageDetector.
+type MockLanguageDetectorMockRecorder struct {
+	mock *MockLanguageDetector
+}
+
+// NewMockLanguageDetector creates a new mock instance.
+func NewMockLanguageDetector(ctrl *gomock.Controller) *MockLanguageDetector {
+	mock := &MockLanguageDetector{ctrl: ctrl}
+	mock.recorder = &MockLanguageDetectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLanguageDetector) EXPECT() *MockLanguageDetectorMockRecorder {
+	return m.recorder
+}
+
+// Detect mocks base method.
+func (m *MockLanguageDetector) Detect(ctx context.Context, text string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Detect", ctx, text)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Detect indicates an expected call of Detect.
+func (mr *MockLanguageDetectorMockRecorder) Detect(ctx, text any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Detect", reflect.TypeOf((*MockLanguageDetector)(nil).Detect), ctx, text)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/shared/login_replay.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/shared/login_replay.go -destination=tests/mock/shared/login_replay_mock.go -package=sharedmock
+//
+
+// Package sharedmock is a generated GoMock package.
+package sharedmock
+
+import (
+	shared "gin-clean-starter/internal/usecase/shared"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLoginReplayCache is a mock of LoginReplayCache interface.
+type MockLoginReplayCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoginReplayCacheMockRecorder
+	isgomock struct{}
+}
+
+// MockLoginReplayCacheMockRecorder is the mock recorder for MockLoginReplayCache.
+type MockLoginReplayCacheMockRecorder struct {
+	mock *MockLoginReplayCache
+}
+
+// NewMockLoginReplayCache creates a new mock instance.
+func NewMockLoginReplayCache(ctrl *gomock.Controller) *MockLoginReplayCache {
+	mock := &MockLoginReplayCache{ctrl: ctrl}
+	mock.recorder = &MockLoginReplayCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoginReplayCache) EXPECT() *MockLoginReplayCacheMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockLoginReplayCache) Do(attempt shared.LoginAttempt, login func() (*shared.LoginReplay, error)) (*shared.LoginReplay, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", attempt, login)
+	ret0, _ := ret[0].(*shared.LoginReplay)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockLoginReplayCacheMockRecorder) Do(attempt, login any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockLoginReplayCache)(nil).Do), attempt, login)
+}
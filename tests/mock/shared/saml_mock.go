@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/shared/saml.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/shared/saml.go -destination=tests/mock/shared/saml_mock.go -package=sharedmock
+//
+
+// Package sharedmock is a generated GoMock package.
+package sharedmock
+
+import (
+	shared "gin-clean-starter/internal/usecase/shared"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSAMLServiceProvider is a mock of SAMLServiceProvider interface.
+type MockSAMLServiceProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockSAMLServiceProviderMockRecorder
+	isgomock struct{}
+}
+
+// MockSAMLServiceProviderMockRecorder is the mock recorder for MockSAMLServiceProvider.
+type MockSAMLServiceProviderMockRecorder struct {
+	mock *MockSAMLServiceProvider
+}
+
+// NewMockSAMLServiceProvider creates a new mock instance.
+func NewMockSAMLServiceProvider(ctrl *gomock.Controller) *MockSAMLServiceProvider {
+	mock := &MockSAMLServiceProvider{ctrl: ctrl}
+	mock.recorder = &MockSAMLServiceProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSAMLServiceProvider) EXPECT() *MockSAMLServiceProviderMockRecorder {
+	return m.recorder
+}
+
+// AuthnRequestURL mocks base method.
+func (m *MockSAMLServiceProvider) AuthnRequestURL(companyID uuid.UUID, idpMetadata []byte) (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthnRequestURL", companyID, idpMetadata)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AuthnRequestURL indicates an expected call of AuthnRequestURL.
+func (mr *MockSAMLServiceProviderMockRecorder) AuthnRequestURL(companyID, idpMetadata any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthnRequestURL", reflect.TypeOf((*MockSAMLServiceProvider)(nil).AuthnRequestURL), companyID, idpMetadata)
+}
+
+// Endpoints mocks base method.
+func (m *MockSAMLServiceProvider) Endpoints(companyID uuid.UUID) shared.SAMLEndpoints {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Endpoints", companyID)
+	ret0, _ := ret[0].(shared.SAMLEndpoints)
+	return ret0
+}
+
+// Endpoints indicates an expected call of Endpoints.
+func (mr *MockSAMLServiceProviderMockRecorder) Endpoints(companyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Endpoints", reflect.TypeOf((*MockSAMLServiceProvider)(nil).Endpoints), companyID)
+}
+
+// Metadata mocks base method.
+func (m *MockSAMLServiceProvider) Metadata(companyID uuid.UUID) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Metadata", companyID)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Metadata indicates an expected call of Metadata.
+func (mr *MockSAMLServiceProviderMockRecorder) Metadata(companyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Metadata", reflect.TypeOf((*MockSAMLServiceProvider)(nil).Metadata), companyID)
+}
+
+// ParseIDPMetadata mocks base method.
+func (m *MockSAMLServiceProvider) ParseIDPMetadata(metadata []byte) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseIDPMetadata", metadata)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseIDPMetadata indicates an expected call of ParseIDPMetadata.
+func (mr *MockSAMLServiceProviderMockRecorder) ParseIDPMetadata(metadata any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseIDPMetadata", reflect.TypeOf((*MockSAMLServiceProvider)(nil).ParseIDPMetadata), metadata)
+}
+
+// ParseResponse mocks base method.
+func (m *MockSAMLServiceProvider) ParseResponse(companyID uuid.UUID, idpMetadata []byte, samlResponse string, requestIDs []string) (*shared.SAMLAssertion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseResponse", companyID, idpMetadata, samlResponse, requestIDs)
+	ret0, _ := ret[0].(*shared.SAMLAssertion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseResponse indicates an expected call of ParseResponse.
+func (mr *MockSAMLServiceProviderMockRecorder) ParseResponse(companyID, idpMetadata, samlResponse, requestIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseResponse", reflect.TypeOf((*MockSAMLServiceProvider)(nil).ParseResponse), companyID, idpMetadata, samlResponse, requestIDs)
+}
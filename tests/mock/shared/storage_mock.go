@@ -0,0 +1,124 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/shared/storage.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/shared/storage.go -destination=tests/mock/shared/storage_mock.go -package=sharedmock
+//
+
+// Package sharedmock is a generated GoMock package.
+package sharedmock
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFileStorage is a mock of FileStorage interface.
+type MockFileStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockFileStorageMockRecorder
+	isgomock struct{}
+}
+
+// MockFileStorageMockRecorder is the mock recorder for MockFileStorage.
+type MockFileStorageMockRecorder struct {
+	mock *MockFileStorage
+}
+
+// NewMockFileStorage creates a new mock instance.
+func NewMockFileStorage(ctrl *gomock.Controller) *MockFileStorage {
+	mock := &MockFileStorage{ctrl: ctrl}
+	mock.recorder = &MockFileStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFileStorage) EXPECT() *MockFileStorageMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockFileStorage) Delete(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockFileStorageMockRecorder) Delete(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockFileStorage)(nil).Delete), ctx, key)
+}
+
+// Open mocks base method.
+func (m *MockFileStorage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Open", ctx, key)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Open indicates an expected call of Open.
+func (mr *MockFileStorageMockRecorder) Open(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockFileStorage)(nil).Open), ctx, key)
+}
+
+// Put mocks base method.
+func (m *MockFileStorage) Put(ctx context.Context, key string, content io.Reader) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", ctx, key, content)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockFileStorageMockRecorder) Put(ctx, key, content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockFileStorage)(nil).Put), ctx, key, content)
+}
+
+// MockFileScanner is a mock of FileScanner interface.
+type MockFileScanner struct {
+	ctrl     *gomock.Controller
+	recorder *MockFileScannerMockRecorder
+	isgomock struct{}
+}
+
+// MockFileScannerMockRecorder is the mock recorder for MockFileScanner.
+type MockFileScannerMockRecorder struct {
+	mock *MockFileScanner
+}
+
+// NewMockFileScanner creates a new mock instance.
+func NewMockFileScanner(ctrl *gomock.Controller) *MockFileScanner {
+	mock := &MockFileScanner{ctrl: ctrl}
+	mock.recorder = &MockFileScannerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFileScanner) EXPECT() *MockFileScannerMockRecorder {
+	return m.recorder
+}
+
+// Scan mocks base method.
+func (m *MockFileScanner) Scan(ctx context.Context, filename string, content []byte) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Scan", ctx, filename, content)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Scan indicates an expected call of Scan.
+func (mr *MockFileScannerMockRecorder) Scan(ctx, filename, content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scan", reflect.TypeOf((*MockFileScanner)(nil).Scan), ctx, filename, content)
+}
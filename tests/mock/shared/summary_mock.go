@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/shared/summary.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/shared/summary.go -destination=tests/mock/shared/summary_mock.go -package=sharedmock
+//
+
+// Package sharedmock is a generated GoMock package.
+package sharedmock
+
+import (
+	context "context"
+	shared "gin-clean-starter/internal/usecase/shared"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSummarizer is a mock of Summarizer interface.
+type MockSummarizer struct {
+	ctrl     *gomock.Controller
+	recorder *MockSummarizerMockRecorder
+	isgomock struct{}
+}
+
+// MockSummarizerMockRecorder is the mock recorder for MockSummarizer.
+type MockSummarizerMockRecorder struct {
+	mock *MockSummarizer
+}
+
+// NewMockSummarizer creates a new mock instance.
+func NewMockSummarizer(ctrl *gomock.Controller) *MockSummarizer {
+	mock := &MockSummarizer{ctrl: ctrl}
+	mock.recorder = &MockSummarizerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSummarizer) EXPECT() *MockSummarizerMockRecorder {
+	return m.recorder
+}
+
+// Summarize mocks base method.
+func (m *MockSummarizer) Summarize(ctx context.Context, reviews []shared.ReviewExcerpt) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Summarize", ctx, reviews)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Summarize indicates an expected call of Summarize.
+func (mr *MockSummarizerMockRecorder) Summarize(ctx, reviews any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Summarize", reflect.TypeOf((*MockSummarizer)(nil).Summarize), ctx, reviews)
+}
@@ -10,10 +10,9 @@
 package usecasemock
 
 import (
-	user "gin-clean-starter/internal/domain/user"
+	usecase "gin-clean-starter/internal/usecase"
 	reflect "reflect"
 
-	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -42,13 +41,12 @@ func (m *MockTokenValidator) EXPECT() *MockTokenValidatorMockRecorder {
 }
 
 // ValidateToken mocks base method.
-func (m *MockTokenValidator) ValidateToken(tokenString string) (uuid.UUID, user.Role, error) {
+func (m *MockTokenValidator) ValidateToken(tokenString string) (*usecase.AccessIdentity, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ValidateToken", tokenString)
-	ret0, _ := ret[0].(uuid.UUID)
-	ret1, _ := ret[1].(user.Role)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret0, _ := ret[0].(*usecase.AccessIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // ValidateToken indicates an expected call of ValidateToken.
@@ -0,0 +1,138 @@
+//go:build dbtest
+
+package queryplan
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// errExplained is returned to the sqlc caller once the plan is captured; the query itself never runs.
+var errExplained = errors.New("query explained, not executed")
+
+// explainDB is a sqlc.DBTX that runs EXPLAIN for each statement instead of executing it,
+// so tests exercise the exact SQL and argument types the generated code sends.
+type explainDB struct {
+	db   sqlc.DBTX
+	plan *planNode
+}
+
+var _ sqlc.DBTX = (*explainDB)(nil)
+
+func (e *explainDB) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	return pgconn.CommandTag{}, e.explain(ctx, sql, args)
+}
+
+func (e *explainDB) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	return nil, e.explain(ctx, sql, args)
+}
+
+func (e *explainDB) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	return explainedRow{err: e.explain(ctx, sql, args)}
+}
+
+// Bulk writes are not plan-checked; COPY and batches bypass the planner paths under test.
+func (e *explainDB) CopyFrom(context.Context, pgx.Identifier, []string, pgx.CopyFromSource) (int64, error) {
+	return 0, errors.New("explainDB: CopyFrom is not supported")
+}
+
+func (e *explainDB) SendBatch(context.Context, *pgx.Batch) pgx.BatchResults {
+	panic("explainDB: SendBatch is not supported")
+}
+
+// explain captures the plan and reports errExplained, or the database error if EXPLAIN failed.
+func (e *explainDB) explain(ctx context.Context, sql string, args []interface{}) error {
+	var raw string
+	// The newline keeps EXPLAIN out of the leading "-- name:" comment sqlc puts in each query.
+	if err := e.db.QueryRow(ctx, "EXPLAIN (FORMAT JSON)\n"+sql, args...).Scan(&raw); err != nil {
+		return fmt.Errorf("explain failed: %w", err)
+	}
+
+	var plans []struct {
+		Plan planNode `json:"Plan"`
+	}
+	if err := json.Unmarshal([]byte(raw), &plans); err != nil {
+		return fmt.Errorf("failed to decode plan: %w", err)
+	}
+	if len(plans) == 0 {
+		return errors.New("empty plan")
+	}
+
+	e.plan = &plans[0].Plan
+	return errExplained
+}
+
+type explainedRow struct {
+	err error
+}
+
+func (r explainedRow) Scan(...any) error {
+	return r.err
+}
+
+type planNode struct {
+	NodeType     string     `json:"Node Type"`
+	RelationName string     `json:"Relation Name"`
+	IndexName    string     `json:"Index Name"`
+	Plans        []planNode `json:"Plans"`
+}
+
+// walk visits the node and all of its children depth-first.
+func (n *planNode) walk(fn func(*planNode)) {
+	fn(n)
+	for i := range n.Plans {
+		n.Plans[i].walk(fn)
+	}
+}
+
+// seqScans lists relations read by a sequential scan anywhere in the plan.
+func (n *planNode) seqScans() []string {
+	var rels []string
+	n.walk(func(node *planNode) {
+		if node.NodeType == "Seq Scan" {
+			rels = append(rels, node.RelationName)
+		}
+	})
+	return rels
+}
+
+// indexes lists every index the plan reads.
+func (n *planNode) indexes() []string {
+	var names []string
+	n.walk(func(node *planNode) {
+		if node.IndexName != "" {
+			names = append(names, node.IndexName)
+		}
+	})
+	return names
+}
+
+// String renders the plan tree compactly for failure messages.
+func (n *planNode) String() string {
+	var b strings.Builder
+	var render func(node *planNode, depth int)
+	render = func(node *planNode, depth int) {
+		b.WriteString(strings.Repeat("  ", depth))
+		b.WriteString(node.NodeType)
+		if node.RelationName != "" {
+			b.WriteString(" on " + node.RelationName)
+		}
+		if node.IndexName != "" {
+			b.WriteString(" using " + node.IndexName)
+		}
+		b.WriteString("\n")
+		for i := range node.Plans {
+			render(&node.Plans[i], depth+1)
+		}
+	}
+	render(n, 0)
+	return b.String()
+}
@@ -0,0 +1,266 @@
+//go:build dbtest
+
+package queryplan
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	sqlc "gin-clean-starter/internal/infra/sqlc/generated"
+	"gin-clean-starter/internal/pkg/pgconv"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Tables big enough in production that a sequential scan on them is a regression.
+// Small lookup tables (resources, roles, resource_operators) may legitimately be scanned.
+var largeTables = map[string]bool{
+	"reservations": true,
+	"reviews":      true,
+}
+
+// planSample holds real keys from the synthetic dataset so each plan is built for
+// values that exist, with a keyset cursor in the middle of the result set.
+type planSample struct {
+	ResourceID    uuid.UUID
+	UserID        uuid.UUID
+	OperatorID    uuid.UUID
+	CursorTime    time.Time
+	CursorID      uuid.UUID
+	ReviewTime    time.Time
+	ReviewID      uuid.UUID
+	ReviewUserID  uuid.UUID
+	ConflictStart time.Time
+}
+
+func loadPlanSample(t *testing.T, db sqlc.DBTX) planSample {
+	t.Helper()
+	ctx := context.Background()
+
+	var s planSample
+	err := db.QueryRow(ctx, `
+		SELECT resource_id, user_id, created_at, id, lower(slot)
+		FROM reservations
+		ORDER BY created_at DESC, id DESC
+		OFFSET 1000 LIMIT 1`).Scan(&s.ResourceID, &s.UserID, &s.CursorTime, &s.CursorID, &s.ConflictStart)
+	require.NoError(t, err)
+
+	err = db.QueryRow(ctx, `
+		SELECT created_at, id, user_id
+		FROM reviews
+		WHERE resource_id = $1
+		ORDER BY created_at DESC, id DESC
+		OFFSET 10 LIMIT 1`, s.ResourceID).Scan(&s.ReviewTime, &s.ReviewID, &s.ReviewUserID)
+	require.NoError(t, err)
+
+	err = db.QueryRow(ctx, `SELECT user_id FROM resource_operators LIMIT 1`).Scan(&s.OperatorID)
+	require.NoError(t, err)
+
+	return s
+}
+
+type planCase struct {
+	name string
+	// run issues the query through db; its result is discarded.
+	run func(ctx context.Context, q *sqlc.Queries, db sqlc.DBTX, s planSample) error
+	// wantIndex, when set, must appear in the plan.
+	wantIndex string
+}
+
+func planCases() []planCase {
+	const limit = 21 // page size 20 plus the look-ahead row
+	ratingFilter := pgtype.Int4{Int32: 4, Valid: true}
+
+	return []planCase{
+		// Reviews: keyset listings
+		{
+			name: "reviews by resource first page",
+			run: func(ctx context.Context, q *sqlc.Queries, db sqlc.DBTX, s planSample) error {
+				_, err := q.GetReviewsByResourceFirstPage(ctx, db, sqlc.GetReviewsByResourceFirstPageParams{
+					ResourceID: s.ResourceID, Limit: limit,
+				})
+				return err
+			},
+			wantIndex: "idx_reviews_resource_id_created_desc",
+		},
+		{
+			name: "reviews by resource keyset",
+			run: func(ctx context.Context, q *sqlc.Queries, db sqlc.DBTX, s planSample) error {
+				_, err := q.GetReviewsByResourceKeyset(ctx, db, sqlc.GetReviewsByResourceKeysetParams{
+					ResourceID: s.ResourceID, CreatedAt: pgconv.TimeToPgtype(s.ReviewTime), ID: s.ReviewID, Limit: limit,
+				})
+				return err
+			},
+			wantIndex: "idx_reviews_resource_id_created_desc",
+		},
+		{
+			name: "reviews by resource keyset with rating filter",
+			run: func(ctx context.Context, q *sqlc.Queries, db sqlc.DBTX, s planSample) error {
+				_, err := q.GetReviewsByResourceKeyset(ctx, db, sqlc.GetReviewsByResourceKeysetParams{
+					ResourceID: s.ResourceID, CreatedAt: pgconv.TimeToPgtype(s.ReviewTime), ID: s.ReviewID, Limit: limit,
+					MinRating: ratingFilter,
+				})
+				return err
+			},
+		},
+		{
+			name: "reviews by user keyset",
+			run: func(ctx context.Context, q *sqlc.Queries, db sqlc.DBTX, s planSample) error {
+				_, err := q.GetReviewsByUserKeyset(ctx, db, sqlc.GetReviewsByUserKeysetParams{
+					UserID: s.ReviewUserID, CreatedAt: pgconv.TimeToPgtype(s.ReviewTime), ID: s.ReviewID, Limit: limit,
+				})
+				return err
+			},
+			wantIndex: "idx_reviews_user_id_created_desc",
+		},
+		{
+			name: "recent reviews for summary",
+			run: func(ctx context.Context, q *sqlc.Queries, db sqlc.DBTX, s planSample) error {
+				_, err := q.ListRecentReviewsForSummary(ctx, db, sqlc.ListRecentReviewsForSummaryParams{
+					ResourceID: s.ResourceID, Limit: limit,
+				})
+				return err
+			},
+			wantIndex: "idx_reviews_resource_id_created_desc",
+		},
+		{
+			name: "similar recent review by user",
+			run: func(ctx context.Context, q *sqlc.Queries, db sqlc.DBTX, s planSample) error {
+				_, err := q.FindSimilarRecentReview(ctx, db, sqlc.FindSimilarRecentReviewParams{
+					UserID: s.ReviewUserID, Lookback: 20, Comment: "Great service", Threshold: 0.8,
+				})
+				return err
+			},
+			wantIndex: "idx_reviews_user_id_created_desc",
+		},
+		{
+			name: "reviews by resource and language",
+			run: func(ctx context.Context, q *sqlc.Queries, db sqlc.DBTX, s planSample) error {
+				_, err := q.GetReviewsByResourceFirstPage(ctx, db, sqlc.GetReviewsByResourceFirstPageParams{
+					ResourceID: s.ResourceID, Limit: limit, Language: pgconv.StringToPgtype("de"),
+				})
+				return err
+			},
+			wantIndex: "idx_reviews_resource_language",
+		},
+		{
+			name: "review counts by language",
+			run: func(ctx context.Context, q *sqlc.Queries, db sqlc.DBTX, s planSample) error {
+				_, err := q.CountReviewsByLanguage(ctx, db, s.ResourceID)
+				return err
+			},
+			wantIndex: "idx_reviews_resource_language",
+		},
+		{
+			name: "flagged reviews by resource",
+			run: func(ctx context.Context, q *sqlc.Queries, db sqlc.DBTX, s planSample) error {
+				_, err := q.ListFlaggedReviewsByResource(ctx, db, sqlc.ListFlaggedReviewsByResourceParams{
+					ResourceID: s.ResourceID, Limit: limit,
+				})
+				return err
+			},
+			wantIndex: "idx_reviews_flagged",
+		},
+
+		// Reservations: keyset listings
+		{
+			name: "reservations by user keyset",
+			run: func(ctx context.Context, q *sqlc.Queries, db sqlc.DBTX, s planSample) error {
+				_, err := q.GetReservationsByUserIDKeyset(ctx, db, sqlc.GetReservationsByUserIDKeysetParams{
+					UserID: s.UserID, CreatedAt: pgconv.TimeToPgtype(s.CursorTime), ID: s.CursorID, Limit: limit,
+				})
+				return err
+			},
+			wantIndex: "idx_reservations_user_created_desc",
+		},
+		{
+			name: "admin reservations keyset",
+			run: func(ctx context.Context, q *sqlc.Queries, db sqlc.DBTX, s planSample) error {
+				_, err := q.GetReservationsForAdminKeyset(ctx, db, sqlc.GetReservationsForAdminKeysetParams{
+					CreatedAt: pgconv.TimeToPgtype(s.CursorTime), ID: s.CursorID, Limit: limit,
+				})
+				return err
+			},
+			wantIndex: "idx_reservations_created_desc",
+		},
+		{
+			name: "admin reservations keyset filtered by resource",
+			run: func(ctx context.Context, q *sqlc.Queries, db sqlc.DBTX, s planSample) error {
+				_, err := q.GetReservationsForAdminKeyset(ctx, db, sqlc.GetReservationsForAdminKeysetParams{
+					CreatedAt: pgconv.TimeToPgtype(s.CursorTime), ID: s.CursorID, Limit: limit,
+					ResourceID: pgconv.UUIDToPgtype(s.ResourceID),
+				})
+				return err
+			},
+		},
+		{
+			name: "admin reservations first page scoped to operator",
+			run: func(ctx context.Context, q *sqlc.Queries, db sqlc.DBTX, s planSample) error {
+				_, err := q.GetReservationsForAdminFirstPage(ctx, db, sqlc.GetReservationsForAdminFirstPageParams{
+					Limit: limit, OperatorID: pgconv.UUIDToPgtype(s.OperatorID),
+				})
+				return err
+			},
+		},
+
+		// Reservation conflict check: new slots are rejected by the reservations_no_overlap
+		// exclusion constraint, which probes its GiST index exactly like this query.
+		{
+			name: "reservation conflict check",
+			run: func(ctx context.Context, _ *sqlc.Queries, db sqlc.DBTX, s planSample) error {
+				_, err := db.Query(ctx, `
+					SELECT 1 FROM reservations
+					WHERE resource_id = $1 AND slot && tstzrange($2::timestamptz, $3::timestamptz, '[)')
+					LIMIT 1`, s.ResourceID, s.ConflictStart, s.ConflictStart.Add(30*time.Minute))
+				return err
+			},
+			wantIndex: "reservations_no_overlap",
+		},
+
+		// Rating stats
+		{
+			name: "resource rating stats",
+			run: func(ctx context.Context, q *sqlc.Queries, db sqlc.DBTX, s planSample) error {
+				_, err := q.GetResourceRatingStats(ctx, db, s.ResourceID)
+				return err
+			},
+		},
+		{
+			name: "rating stats update on new review",
+			run: func(ctx context.Context, q *sqlc.Queries, db sqlc.DBTX, s planSample) error {
+				return q.ApplyResourceRatingStatsOnCreate(ctx, db, sqlc.ApplyResourceRatingStatsOnCreateParams{
+					ResourceID: s.ResourceID, Rating: 5,
+				})
+			},
+		},
+	}
+}
+
+func TestQueryPlans(t *testing.T) {
+	pool := setupPlanDB(t)
+	sample := loadPlanSample(t, pool)
+	q := sqlc.New()
+
+	for _, tc := range planCases() {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			db := &explainDB{db: pool}
+
+			err := tc.run(ctx, q, db, sample)
+			require.True(t, errors.Is(err, errExplained), "query was not explained: %v", err)
+			require.NotNil(t, db.plan)
+
+			for _, rel := range db.plan.seqScans() {
+				assert.False(t, largeTables[rel], "sequential scan on %s:\n%s", rel, db.plan)
+			}
+			if tc.wantIndex != "" {
+				assert.Contains(t, db.plan.indexes(), tc.wantIndex, "expected index %s:\n%s", tc.wantIndex, db.plan)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+//go:build dbtest
+
+package queryplan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Synthetic dataset sizes. Large enough that PostgreSQL prefers a sequential scan
+// whenever no suitable index exists, so a dropped or mismatched index shows up in the plan.
+const (
+	syntheticUsers        = 5_000
+	syntheticResources    = 2_000
+	syntheticReservations = 200_000
+	syntheticReviews      = 100_000
+)
+
+func seedSyntheticData(ctx context.Context, pool *pgxpool.Pool) error {
+	statements := []string{
+		fmt.Sprintf(`
+			INSERT INTO users (email, password_hash, role, company_id, referral_code)
+			SELECT 'plan-user-' || g || '@example.com', 'x', 'viewer',
+			       (SELECT id FROM companies WHERE name = 'Default Company'), 'PLAN' || g
+			FROM generate_series(1, %d) AS g`, syntheticUsers),
+
+		fmt.Sprintf(`
+			INSERT INTO resources (name, lead_time_min)
+			SELECT 'Plan Resource ' || g, 0
+			FROM generate_series(1, %d) AS g`, syntheticResources),
+
+		// One-hour slots that never overlap, spread round-robin over users and resources.
+		fmt.Sprintf(`
+			WITH u AS (SELECT id, row_number() OVER (ORDER BY id) AS rn FROM users),
+			     r AS (SELECT id, row_number() OVER (ORDER BY id) AS rn FROM resources)
+			INSERT INTO reservations (resource_id, user_id, slot, status, price_cents, created_at, updated_at)
+			SELECT r.id, u.id,
+			       tstzrange(timestamptz '2030-01-01' + g * interval '1 hour',
+			                 timestamptz '2030-01-01' + (g + 1) * interval '1 hour', '[)'),
+			       CASE WHEN g %% 10 = 0 THEN 'canceled' ELSE 'confirmed' END,
+			       1000,
+			       now() - g * interval '1 minute',
+			       now() - g * interval '1 minute'
+			FROM generate_series(1, %d) AS g
+			JOIN u ON u.rn = (g %% (SELECT count(*) FROM users)) + 1
+			JOIN r ON r.rn = (g %% (SELECT count(*) FROM resources)) + 1`, syntheticReservations),
+
+		fmt.Sprintf(`
+			INSERT INTO reviews (id, user_id, resource_id, reservation_id, rating, comment, language, created_at, updated_at)
+			SELECT gen_random_uuid(), user_id, resource_id, id,
+			       1 + (abs(hashtext(id::text)) %% 5), 'Synthetic review',
+			       (ARRAY['en', 'de', 'fr', 'ja'])[1 + abs(hashtext(id::text || 'lang')) %% 4], created_at, created_at
+			FROM reservations
+			ORDER BY created_at DESC
+			LIMIT %d`, syntheticReviews),
+
+		`
+			INSERT INTO resource_rating_stats (resource_id, total_reviews, average_rating)
+			SELECT resource_id, count(*), round(avg(rating), 2)
+			FROM reviews
+			GROUP BY resource_id`,
+
+		// Every 10th resource gets an operator so assignment-scoped listings have matches.
+		`
+			INSERT INTO resource_operators (resource_id, user_id)
+			SELECT r.id, (SELECT id FROM users ORDER BY id LIMIT 1)
+			FROM (SELECT id, row_number() OVER (ORDER BY id) AS rn FROM resources) AS r
+			WHERE r.rn % 10 = 0`,
+
+		`ANALYZE`,
+	}
+
+	for _, stmt := range statements {
+		if _, err := pool.Exec(ctx, stmt); err != nil {
+			return fmt.Errorf("failed to seed synthetic data: %w", err)
+		}
+	}
+	return nil
+}
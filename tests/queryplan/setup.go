@@ -0,0 +1,134 @@
+//go:build dbtest
+
+package queryplan
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"gin-clean-starter/tests/common/dbtest"
+
+	"github.com/docker/go-connections/nat"
+	"github.com/jackc/pgx/v5/pgxpool"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	testUser     = "test"
+	testPassword = "testpass"
+)
+
+// ------------------------------------------------------------
+// Query Plan Environment Setup
+// Starts a dedicated PostgreSQL container, applies every migration in order,
+// and loads the synthetic dataset. Plans depend on table statistics, so the
+// dataset is analyzed before any EXPLAIN runs.
+// ------------------------------------------------------------
+func setupPlanDB(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
+	defer cancel()
+
+	container, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "postgres:17",
+			ExposedPorts: []string{"5432/tcp"},
+			Env: map[string]string{
+				"POSTGRES_USER":     testUser,
+				"POSTGRES_PASSWORD": testPassword,
+				"POSTGRES_DB":       "postgres",
+			},
+			Tmpfs: map[string]string{
+				"/var/lib/postgresql/data": "rw,size=1g",
+			},
+			Cmd: []string{
+				"postgres",
+				"-c", "fsync=off",
+				"-c", "full_page_writes=off",
+				"-c", "synchronous_commit=off",
+				"-c", "autovacuum=off", // Statistics come from the explicit ANALYZE after seeding
+			},
+			WaitingFor: wait.ForSQL("5432/tcp", "pgx", func(host string, port nat.Port) string {
+				return fmt.Sprintf("postgres://%s:%s@%s:%s/postgres?sslmode=disable",
+					testUser, testPassword, host, port.Port())
+			}).WithStartupTimeout(60 * time.Second),
+			Labels: map[string]string{"purpose": "query-plan-tests"},
+		},
+		Started: true,
+	})
+	require.NoError(t, err, "Failed to start PostgreSQL container")
+	t.Cleanup(func() {
+		cleanupCtx, cleanupCancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cleanupCancel()
+		if err := container.Terminate(cleanupCtx); err != nil {
+			slog.Warn("Failed to terminate PostgreSQL container", "error", err.Error())
+		}
+	})
+
+	host, err := container.Host(ctx)
+	require.NoError(t, err)
+	port, err := container.MappedPort(ctx, "5432/tcp")
+	require.NoError(t, err)
+
+	dsn := fmt.Sprintf("postgres://%s:%s@%s:%s/postgres?sslmode=disable", testUser, testPassword, host, port.Port())
+	pool, err := pgxpool.New(ctx, dsn)
+	require.NoError(t, err, "Failed to connect to database")
+	t.Cleanup(pool.Close)
+
+	require.NoError(t, applyMigrations(ctx, pool), "Failed to apply database migrations")
+	require.NoError(t, dbtest.SeedReferenceData(pool), "Failed to seed reference data")
+	require.NoError(t, seedSyntheticData(ctx, pool), "Failed to seed synthetic data")
+
+	return pool
+}
+
+// applyMigrations runs migrations/*.sql in file name order, so new migrations are picked up without edits here.
+func applyMigrations(ctx context.Context, pool *pgxpool.Pool) error {
+	dir, err := findMigrationsDir()
+	if err != nil {
+		return err
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.sql"))
+	if err != nil {
+		return fmt.Errorf("failed to list migrations: %w", err)
+	}
+	sort.Strings(files)
+
+	for _, file := range files {
+		sqlContent, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("failed to read migration file %s: %w", file, err)
+		}
+		if _, err := pool.Exec(ctx, string(sqlContent)); err != nil {
+			return fmt.Errorf("failed to execute migration %s: %w", file, err)
+		}
+	}
+
+	return nil
+}
+
+// Resolve the migrations directory relative to possible working dirs (package dir during `go test`).
+func findMigrationsDir() (string, error) {
+	candidates := []string{
+		"migrations",
+		filepath.Join("..", "migrations"),
+		filepath.Join("..", "..", "migrations"),
+	}
+	for _, cand := range candidates {
+		if info, err := os.Stat(cand); err == nil && info.IsDir() {
+			return cand, nil
+		}
+	}
+	return "", fmt.Errorf("migrations directory not found")
+}